@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/auto-devs/auto-devs/internal/runner"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	hostname, _ := os.Hostname()
+
+	// Parse command line flags
+	var (
+		serverAddr = flag.String("server", "localhost:9090", "Address of the worker accepting runners (RUNNER_LISTEN_ADDR)")
+		name       = flag.String("name", hostname, "Runner name for identification")
+		token      = flag.String("token", os.Getenv("AUTODEVS_RUNNER_TOKEN"), "Token the worker expects (RUNNER_TOKEN)")
+		capacity   = flag.Int("capacity", 2, "Number of AI executions to run at once")
+		useTLS     = flag.Bool("tls", false, "Connect to the worker over TLS")
+		tlsCA      = flag.String("tls-ca", "", "CA certificate to verify the worker's TLS certificate with, instead of the system roots (implies -tls)")
+		verbose    = flag.Bool("verbose", false, "Enable verbose logging")
+	)
+	flag.Parse()
+
+	// Setup logging
+	logLevel := slog.LevelInfo
+	if *verbose {
+		logLevel = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: logLevel,
+	}))
+	slog.SetDefault(logger)
+
+	if *capacity < 1 {
+		log.Fatal("Invalid -capacity: must be at least 1")
+	}
+
+	creds := insecure.NewCredentials()
+	if *useTLS || *tlsCA != "" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if *tlsCA != "" {
+			pem, err := os.ReadFile(*tlsCA)
+			if err != nil {
+				log.Fatalf("Failed to read -tls-ca: %v", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+				log.Fatal("Invalid -tls-ca: no PEM certificate found")
+			}
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(*serverAddr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		log.Fatalf("Failed to create connection to worker: %v", err)
+	}
+	defer conn.Close()
+
+	// Setup graceful shutdown
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	logger.Info("Starting runner", "name", *name, "server", *serverAddr, "capacity", *capacity)
+	agent := runner.NewAgent(*name, *token, *capacity)
+	if err := agent.Run(ctx, conn); err != nil {
+		log.Fatalf("Runner stopped: %v", err)
+	}
+	logger.Info("Runner stopped")
+}
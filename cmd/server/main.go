@@ -12,6 +12,7 @@ import (
 
 	"github.com/auto-devs/auto-devs/internal/di"
 	"github.com/auto-devs/auto-devs/internal/handler"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
 	"github.com/gin-gonic/gin"
 )
 
@@ -23,6 +24,19 @@ func isAPIRoute(path string) bool {
 		strings.HasPrefix(path, "/ws")
 }
 
+// @title Auto-Devs API
+// @version 1.0
+// @description API for managing projects, tasks, AI planning/implementation executions and worktrees.
+// @description Routes are documented under /api/v1, which is deprecated (see the Deprecation and Sunset headers). Every route is also served under /api/v2 with {data, meta} list envelopes and error bodies that always carry a code.
+// @BasePath /
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key for editor plugins and automation tools, configured with API_KEYS
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description "Bearer " followed by a token from /api/v1/auth/login or one of the user's API keys
 func main() {
 	gin.SetMode(gin.DebugMode)
 	// Initialize application with Wire dependency injection
@@ -38,6 +52,12 @@ func main() {
 		}
 	}()
 
+	// Export the spans of requests when a collector is configured
+	shutdownTracing, err := tracing.Setup(context.Background(), app.Config.Tracing.Endpoint, "auto-devs-server")
+	if err != nil {
+		log.Fatal("Failed to set up tracing:", err)
+	}
+
 	// TODO: think about auto migration later!
 	// // Run database migrations using GORM AutoMigrate
 	// if err := database.RunMigrations(app.GormDB); err != nil {
@@ -56,9 +76,21 @@ func main() {
 
 	// Setup Gin router
 	router := gin.Default()
+	if err := router.SetTrustedProxies(app.Config.Access.TrustedProxies); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES:", err)
+	}
+	adminAllowlist, err := handler.ParseIPAllowlist(app.Config.Access.AdminAllowlist)
+	if err != nil {
+		log.Fatal("Invalid ADMIN_IP_ALLOWLIST:", err)
+	}
+	webhookAllowlist, err := handler.ParseIPAllowlist(app.Config.Access.WebhookAllowlist)
+	if err != nil {
+		log.Fatal("Invalid WEBHOOK_IP_ALLOWLIST:", err)
+	}
+	accessPolicy := handler.AccessPolicy{Admin: adminAllowlist, Webhook: webhookAllowlist}
 
 	// Setup all routes with middleware
-	handler.SetupRoutes(router, app.ProjectUsecase, app.TaskUsecase, app.ExecutionUsecase, app.WorktreeUsecase, app.GormDB, app.WebSocketService)
+	handler.SetupRoutes(router, app.ProjectUsecase, app.TaskUsecase, app.ExecutionUsecase, app.WorktreeUsecase, app.OrganizationUsecase, app.JiraUsecase, app.GitHubProjectUsecase, app.GitHubIssueUsecase, app.EventUsecase, app.CommitUsecase, app.ReleaseNotesUsecase, app.AttachmentUsecase, app.Config.Attachments.MaxSize, app.UserProfileUsecase, app.JobMetricUsecase, app.EpicUsecase, app.PlanUsecase, app.AuthUsecase, app.ProjectMemberUsecase, app.WebhookUsecase, app.SlackUsecase, app.EmailUsecase, app.Config.Auth.Required, app.Config.APIKeys.Keys, app.Config.GitHub.WebhookSecret, app.Config.Slack.SigningSecret, accessPolicy, app.AuditUsecase, app.GraphQLService, app.GormDB, app.JobClient, app.WebSocketService)
 
 	runMode := app.Config.Server.RunMode
 
@@ -128,6 +160,9 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatal("Server forced to shutdown:", err)
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("Error flushing traces: %v", err)
+	}
 
 	log.Println("Server exited")
 }
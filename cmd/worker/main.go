@@ -6,21 +6,31 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"net"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/auto-devs/auto-devs/config"
 	"github.com/auto-devs/auto-devs/internal/di"
 	"github.com/auto-devs/auto-devs/internal/jobs"
+	"github.com/auto-devs/auto-devs/internal/runner"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
 	// Parse command line flags
 	var (
-		workerName = flag.String("worker", "default", "Worker name for identification")
-		verbose    = flag.Bool("verbose", false, "Enable verbose logging")
+		workerName    = flag.String("worker", "default", "Worker name for identification")
+		verbose       = flag.Bool("verbose", false, "Enable verbose logging")
+		queueSpec     = flag.String("queues", "", "Comma-separated queues to serve, each optionally with a priority (e.g. implementation:4,default:1); serves all queues when empty")
+		concurrency   = flag.Int("concurrency", jobs.DefaultConcurrency, "Number of jobs to run at once")
+		maxExecutions = flag.Int("max-executions", -1, "Maximum AI executions run at once, 0 for no limit; -1 uses EXECUTION_MAX_CONCURRENT")
+		runScheduler  = flag.Bool("scheduler", true, "Campaign to schedule the periodic jobs; the workers campaigning elect one to schedule them")
 	)
 	flag.Parse()
 
@@ -37,6 +47,14 @@ func main() {
 
 	logger.Info("Starting job worker", "worker_name", *workerName)
 
+	queues, err := jobs.ParseQueues(*queueSpec)
+	if err != nil {
+		log.Fatalf("Invalid -queues: %v", err)
+	}
+	if *concurrency < 1 {
+		log.Fatal("Invalid -concurrency: must be at least 1")
+	}
+
 	// Load configuration
 	cfg := config.Load()
 	if cfg == nil {
@@ -49,18 +67,107 @@ func main() {
 		log.Fatalf("Failed to initialize application: %v", err)
 	}
 
+	// Export the spans of jobs and executions when a collector is configured
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Endpoint, "auto-devs-worker")
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+
 	savePidToFile(app.Config.Worktree.BaseDirectory)
 	defer removePidFromFile(app.Config.Worktree.BaseDirectory)
 
 	// Use job processor from DI container
 	processor := app.JobProcessor
+	if *maxExecutions < 0 {
+		*maxExecutions = cfg.Execution.MaxConcurrent
+	}
+	processor.SetMaxConcurrentExecutions(*maxExecutions)
+	processor.SetRetryPolicy(cfg.Execution.MaxAttempts, time.Duration(cfg.Execution.RetryBackoff)*time.Second)
+	worker := jobs.WorkerID(*workerName)
+	processor.SetWorker(worker)
+
+	// Executions left running by a crash would keep their tasks in PLANNING
+	// or IMPLEMENTING forever
+	recovered, err := processor.RecoverOrphanedExecutions(context.Background())
+	if err != nil {
+		logger.Error("Failed to recover orphaned executions", "error", err)
+	} else if recovered > 0 {
+		logger.Warn("Recovered orphaned executions", "count", recovered)
+	}
+
+	// Executions are offloaded to remote runners when they can connect
+	var runnerServer *grpc.Server
+	if cfg.Runners.ListenAddr != "" {
+		// Runners are sent the prompts and environment of the executions
+		if cfg.Runners.Token == "" {
+			log.Fatal("RUNNER_TOKEN is required to accept runners on RUNNER_LISTEN_ADDR")
+		}
+		var serverOptions []grpc.ServerOption
+		if cfg.Runners.TLSCertFile != "" || cfg.Runners.TLSKeyFile != "" {
+			creds, err := credentials.NewServerTLSFromFile(cfg.Runners.TLSCertFile, cfg.Runners.TLSKeyFile)
+			if err != nil {
+				log.Fatalf("Failed to load the runner TLS certificate: %v", err)
+			}
+			serverOptions = append(serverOptions, grpc.Creds(creds))
+		} else {
+			logger.Warn("RUNNER_TLS_CERT_FILE is not set, runners are served in cleartext; put a TLS-terminating proxy in front of the worker")
+		}
+		listener, err := net.Listen("tcp", cfg.Runners.ListenAddr)
+		if err != nil {
+			log.Fatalf("Failed to listen for runners: %v", err)
+		}
+		hub := runner.NewHub(cfg.Runners.Token)
+		runnerServer = grpc.NewServer(serverOptions...)
+		runner.RegisterRunnerServer(runnerServer, hub)
+		app.ProcessManager.SetRemoteRunner(hub, cfg.Runners.LocalFallback)
+		go func() {
+			logger.Info("Accepting runners", "addr", cfg.Runners.ListenAddr, "tls", len(serverOptions) > 0, "local_fallback", cfg.Runners.LocalFallback)
+			if err := runnerServer.Serve(listener); err != nil {
+				logger.Error("Runner server failed", "error", err)
+			}
+		}()
+	}
 
 	// Create job server
 	redisAddr := fmt.Sprintf("%s:%s", cfg.Redis.Host, cfg.Redis.Port)
-	server := jobs.NewServer(redisAddr, cfg.Redis.Password, cfg.Redis.DB, processor)
+
+	// Workers share task locks so no two plan or implement the same task
+	taskLocker := jobs.NewRedisTaskLocker(redisAddr, cfg.Redis.Password, cfg.Redis.DB)
+	defer taskLocker.Close()
+	processor.SetTaskLocker(taskLocker)
+
+	// and the execution slots of the projects limiting their concurrency
+	projectSemaphore := jobs.NewRedisProjectSemaphore(redisAddr, cfg.Redis.Password, cfg.Redis.DB)
+	defer projectSemaphore.Close()
+	processor.SetProjectSemaphore(projectSemaphore, app.JobClient)
+
+	server := jobs.NewServer(redisAddr, cfg.Redis.Password, cfg.Redis.DB, processor, jobs.ServerOptions{
+		Queues:      queues,
+		Concurrency: *concurrency,
+		Metrics:     app.JobMetricRepo,
+		Worker:      worker,
+	})
 
 	// Create scheduler for periodic tasks
-	scheduler := jobs.NewScheduler(redisAddr, cfg.Redis.Password, cfg.Redis.DB)
+	var scheduler *jobs.Scheduler
+	if *runScheduler {
+		scheduler = jobs.NewScheduler(redisAddr, cfg.Redis.Password, cfg.Redis.DB)
+		if cfg.Purge.Enabled {
+			if err := scheduler.EnableSoftDeletePurge(&cfg.Purge); err != nil {
+				log.Fatalf("Invalid purge configuration: %v", err)
+			}
+		}
+		if cfg.Standup.Enabled {
+			if err := scheduler.EnableDailyStandup(&cfg.Standup); err != nil {
+				log.Fatalf("Invalid standup configuration: %v", err)
+			}
+		}
+		if cfg.Email.DigestEnabled {
+			if err := scheduler.EnableEmailDigest(&cfg.Email); err != nil {
+				log.Fatalf("Invalid email digest configuration: %v", err)
+			}
+		}
+	}
 
 	// Setup graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
@@ -79,7 +186,12 @@ func main() {
 	// Start the job server
 	logger.Info("Starting job server",
 		"redis_addr", redisAddr,
-		"worker_name", *workerName)
+		"worker", worker,
+		"queues", queues,
+		"concurrency", *concurrency,
+		"max_executions", *maxExecutions)
+
+	go processor.RunHeartbeat(ctx)
 
 	go func() {
 		if err := server.Start(); err != nil {
@@ -88,17 +200,26 @@ func main() {
 		}
 	}()
 
-	// Start the scheduler
-	logger.Info("Starting job scheduler",
-		"redis_addr", redisAddr,
-		"worker_name", *workerName)
-
-	go func() {
-		if err := scheduler.Start(); err != nil {
-			logger.Error("Job scheduler failed", "error", err)
-			cancel()
-		}
-	}()
+	// Start the scheduler, on the elected worker only so periodic jobs are
+	// not enqueued once per worker
+	schedulerDone := make(chan struct{})
+	if scheduler != nil {
+		logger.Info("Campaigning to schedule the periodic jobs",
+			"redis_addr", redisAddr,
+			"worker", worker)
+
+		election := jobs.NewRedisLeaderElection(redisAddr, cfg.Redis.Password, cfg.Redis.DB, worker)
+		defer election.Close()
+		go func() {
+			defer close(schedulerDone)
+			if err := scheduler.Run(ctx, election); err != nil {
+				logger.Error("Job scheduler failed", "error", err)
+				cancel()
+			}
+		}()
+	} else {
+		close(schedulerDone)
+	}
 
 	// Wait for shutdown signal
 	<-ctx.Done()
@@ -106,7 +227,16 @@ func main() {
 	// Graceful shutdown
 	logger.Info("Shutting down job worker...")
 	server.Stop()
-	scheduler.Stop()
+	if runnerServer != nil {
+		runnerServer.Stop()
+	}
+	// The scheduler stops with ctx, resigning its leadership
+	<-schedulerDone
+	// Previews run as children of this worker and would outlive it
+	processor.StopPreviews(context.Background())
+	if err := shutdownTracing(context.Background()); err != nil {
+		logger.Error("Failed to flush traces", "error", err)
+	}
 	logger.Info("Job worker stopped")
 }
 
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
@@ -17,8 +18,25 @@ type Config struct {
 	Redis                 RedisConfig
 	CentrifugeRedisBroker CentrifugeRedisBrokerConfig
 	GitHub                GitHubConfig
+	GitLab                GitLabConfig
+	Slack                 SlackConfig
 	App                   AppConfig
 	HermesKanban          HermesKanbanConfig
+	Purge                 PurgeConfig
+	Standup               StandupConfig
+	Email                 EmailConfig
+	Secrets               SecretsConfig
+	APIKeys               APIKeysConfig
+	Auth                  AuthConfig
+	Approval              ApprovalConfig
+	Access                AccessConfig
+	Attachments           AttachmentsConfig
+	Preview               PreviewConfig
+	Execution             ExecutionConfig
+	Undo                  UndoConfig
+	Backpressure          BackpressureConfig
+	Runners               RunnersConfig
+	Tracing               TracingConfig
 }
 
 type ServerConfig struct {
@@ -28,12 +46,17 @@ type ServerConfig struct {
 }
 
 type DatabaseConfig struct {
+	// Driver selects the backend: "postgres" (default) or "sqlite" for
+	// single-user local setups that don't want to run Postgres.
+	Driver   string
 	Host     string
 	Port     string
 	Username string
 	Password string
 	Name     string
 	SSLMode  string
+	// SQLitePath is the database file used when Driver is "sqlite"
+	SQLitePath string
 }
 
 type WorktreeConfig struct {
@@ -42,6 +65,9 @@ type WorktreeConfig struct {
 	MinDiskSpace    int64
 	CleanupInterval string
 	EnableLogging   bool
+	// PoolSize is how many warm worktrees of the base branch are kept per
+	// project for new tasks to branch from; 0 turns pooling off
+	PoolSize int
 }
 
 type RedisConfig struct {
@@ -62,6 +88,31 @@ type GitHubConfig struct {
 	BaseURL   string
 	UserAgent string
 	Timeout   int
+	// WebhookSecret verifies push webhook signatures; without it pushes are
+	// rejected
+	WebhookSecret string
+	// WebhookMaxAge is how many seconds after the push its webhook delivery
+	// is still accepted. Older deliveries are rejected as replays.
+	WebhookMaxAge int
+}
+
+// GitLabConfig configures opening merge requests for projects hosted on
+// GitLab. Without a token those projects get no merge requests.
+type GitLabConfig struct {
+	Token string
+	// BaseURL is the GitLab instance, gitlab.com by default
+	BaseURL string
+	Timeout int
+}
+
+// SlackConfig configures the Slack app projects post their notifications
+// with. Without a signing secret the approve and reject buttons are
+// rejected.
+type SlackConfig struct {
+	// APIURL is the Slack Web API bot messages are posted to
+	APIURL string
+	// SigningSecret verifies the interactivity requests of the Slack app
+	SigningSecret string
 }
 
 type AppConfig struct {
@@ -79,6 +130,202 @@ type HermesKanbanConfig struct {
 	Board string
 }
 
+// PurgeConfig configures the periodic hard-delete of soft-deleted records.
+// Rows are only removed once their deleted_at is older than RetentionDays.
+type PurgeConfig struct {
+	Enabled       bool
+	RetentionDays int
+	// DryRun counts the rows that would be purged without deleting them.
+	DryRun   bool
+	Interval string
+}
+
+// StandupConfig configures the daily standup summaries, written each morning
+// by an AI executor from the previous day of every project and sent through
+// the notification channels.
+type StandupConfig struct {
+	Enabled bool
+	// Schedule is the cron spec of the job, in UTC
+	Schedule string
+	AIType   string
+}
+
+// EmailConfig configures the SMTP server notification emails are sent
+// through. Without a host no emails are sent.
+type EmailConfig struct {
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// From is the sender address, e.g. "auto-devs <bot@acme.com>"
+	From string
+	// DigestEnabled emails each project's task movement of the previous
+	// day, on DigestSchedule, a cron spec in UTC
+	DigestEnabled  bool
+	DigestSchedule string
+}
+
+// SecretsConfig configures the encrypted store for per-project credentials
+// such as integration API tokens.
+type SecretsConfig struct {
+	// EncryptionKey is a base64-encoded 32-byte AES-256 key. Without it
+	// secrets can be neither stored nor read.
+	EncryptionKey string
+}
+
+// APIKeysConfig lists the static API keys accepted by the token-authenticated
+// endpoints used by editor plugins and automation tools.
+type APIKeysConfig struct {
+	// Keys maps each key to the name of its owner, which is also the
+	// assignee the owner's tasks are matched on
+	Keys map[string]string
+}
+
+// AuthConfig configures the user accounts signing in to the API
+type AuthConfig struct {
+	// Required rejects API requests that are not authenticated, with a
+	// user's token or API key or one of the static API keys. Off, requests
+	// are authenticated only when they carry credentials.
+	Required bool
+	// JWTSecret signs the tokens users sign in for. Without it a random
+	// secret is used, and tokens do not outlive the server.
+	JWTSecret string
+	// TokenTTLHours is how long a token is valid for
+	TokenTTLHours int
+	// RegistrationEnabled lets anyone register an account
+	RegistrationEnabled bool
+}
+
+// ApprovalConfig configures the step-up approval of high-risk task plans
+type ApprovalConfig struct {
+	// TOTPSecret is the base32 secret of the TOTP codes that confirm a
+	// high-risk plan on their own. Without it a second approver is needed.
+	TOTPSecret string
+}
+
+// AccessConfig restricts the client addresses allowed to reach the API.
+// Entries are IP addresses or CIDR ranges; an empty allowlist allows any.
+type AccessConfig struct {
+	// AdminAllowlist guards the REST, GraphQL and WebSocket API
+	AdminAllowlist []string
+	// WebhookAllowlist guards the repository host webhooks
+	WebhookAllowlist []string
+	// TrustedProxies are the proxies whose X-Forwarded-For header is
+	// believed. Without any, the client is the address connecting.
+	TrustedProxies []string
+}
+
+// AttachmentsConfig configures where task attachments are stored and what
+// may be uploaded
+type AttachmentsConfig struct {
+	// Storage is "local" (default), keeping files under LocalDir, or "s3"
+	Storage  string
+	LocalDir string
+	// MaxSize is the largest upload accepted, in bytes
+	MaxSize int64
+	// AllowedTypes are the media types accepted, sniffed from the content.
+	// An entry such as image/* accepts the whole type.
+	AllowedTypes []string
+	// URLSigningKey signs the download URLs of locally stored attachments.
+	// Without it a random key is used, so URLs stop working on restart.
+	URLSigningKey string
+	// URLExpiry is how many seconds download URLs are valid
+	URLExpiry int
+	S3        S3Config
+}
+
+// S3Config locates the S3 bucket, or S3-compatible store, attachments are
+// kept in
+type S3Config struct {
+	Bucket string
+	Region string
+	// Endpoint overrides the AWS endpoint, e.g. for MinIO
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket in the path rather than the host name
+	PathStyle bool
+}
+
+// PreviewConfig configures the preview environments the worker runs for
+// implemented tasks. Each preview gets a port from the range and is reached
+// at http://Host:port.
+type PreviewConfig struct {
+	Host           string
+	PortRangeStart int
+	PortRangeEnd   int
+	// StartTimeout is how many seconds a preview has to start listening on
+	// its port
+	StartTimeout int
+}
+
+// ExecutionConfig configures how AI executions are confined to their
+// worktree. Their file operations are always audited; Sandbox also makes
+// the rest of the filesystem read-only for them.
+type ExecutionConfig struct {
+	// Sandbox runs executions under bubblewrap (bwrap), which must be
+	// installed
+	Sandbox bool
+	// StatePaths are where the AI CLIs keep their settings and caches, the
+	// only paths outside their worktree executions may touch. A leading ~
+	// is the home directory.
+	StatePaths []string
+	// MaxConcurrent is how many AI executions a worker runs at once; zero
+	// lifts the limit
+	MaxConcurrent int
+	// MaxAttempts is how many times a failing planning or implementation
+	// execution is run before its task is left for the user; 1 turns
+	// automatic retries off. RetryBackoff is the seconds before the first
+	// retry, doubled for each further one.
+	MaxAttempts  int
+	RetryBackoff int
+}
+
+// BackpressureConfig sets the load past which new planning is refused with
+// an estimated wait, rather than queued behind hours of work. Zero disables
+// a limit.
+type BackpressureConfig struct {
+	// MaxPlanningQueueDepth is how many planning jobs may wait in the queue
+	MaxPlanningQueueDepth int
+	// MaxActiveExecutions is how many AI executions may be in flight
+	MaxActiveExecutions int
+}
+
+// UndoConfig configures the undo of destructive bulk operations on tasks
+type UndoConfig struct {
+	// Window is how many seconds after a bulk delete or archive it can
+	// still be undone
+	Window int
+}
+
+// RunnersConfig configures the remote runners executing the AI CLIs on
+// other machines than the worker's. Runners must see the worktrees at the
+// same paths, through shared storage.
+type RunnersConfig struct {
+	// ListenAddr is where the worker accepts runners; empty runs every
+	// execution on the worker itself
+	ListenAddr string
+	// Token is the secret runners present to register; the worker refuses
+	// to accept runners without one
+	Token string
+	// TLSCertFile and TLSKeyFile are the certificate and key the worker
+	// serves runners over TLS with; empty serves them in cleartext, for
+	// a TLS-terminating proxy in front of the worker
+	TLSCertFile string
+	TLSKeyFile  string
+	// LocalFallback runs an execution on the worker when no runner has
+	// free capacity, instead of failing it
+	LocalFallback bool
+}
+
+// TracingConfig configures the OpenTelemetry traces following a request
+// from the API through the job queue to the AI executions it starts
+type TracingConfig struct {
+	// Endpoint is the OTLP/HTTP collector the spans are exported to, such
+	// as http://localhost:4318; empty records no spans
+	Endpoint string
+}
+
 func Load() *Config {
 	// Load .env file if it exists
 	if err := godotenv.Load(); err != nil {
@@ -92,12 +339,14 @@ func Load() *Config {
 			RunMode: getEnv("SERVER_RUN_MODE", "dev"),
 		},
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			Username: getEnv("DB_USERNAME", "postgres"),
-			Password: getEnv("DB_PASSWORD", ""),
-			Name:     getEnv("DB_NAME", "autodevs"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Driver:     getEnv("DB_DRIVER", "postgres"),
+			Host:       getEnv("DB_HOST", "localhost"),
+			Port:       getEnv("DB_PORT", "5432"),
+			Username:   getEnv("DB_USERNAME", "postgres"),
+			Password:   getEnv("DB_PASSWORD", ""),
+			Name:       getEnv("DB_NAME", "autodevs"),
+			SSLMode:    getEnv("DB_SSLMODE", "disable"),
+			SQLitePath: getEnv("DB_SQLITE_PATH", "autodevs.db"),
 		},
 		Worktree: WorktreeConfig{
 			BaseDirectory:   getEnv("WORKTREE_BASE_DIR", "/worktrees"),
@@ -105,6 +354,7 @@ func Load() *Config {
 			MinDiskSpace:    getEnvAsInt64("WORKTREE_MIN_DISK_SPACE", 100*1024*1024), // 100MB
 			CleanupInterval: getEnv("WORKTREE_CLEANUP_INTERVAL", "24h"),
 			EnableLogging:   getEnvAsBool("WORKTREE_ENABLE_LOGGING", true),
+			PoolSize:        getEnvAsInt("WORKTREE_POOL_SIZE", 0),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -118,10 +368,21 @@ func Load() *Config {
 			DB:       getEnvAsInt("CENTRIFUGE_REDIS_DB", 2),
 		},
 		GitHub: GitHubConfig{
-			Token:     getEnv("GITHUB_TOKEN", ""),
-			BaseURL:   getEnv("GITHUB_BASE_URL", "https://api.github.com"),
-			UserAgent: getEnv("GITHUB_USER_AGENT", "auto-devs/1.0"),
-			Timeout:   getEnvAsInt("GITHUB_TIMEOUT", 30),
+			Token:         getEnv("GITHUB_TOKEN", ""),
+			BaseURL:       getEnv("GITHUB_BASE_URL", "https://api.github.com"),
+			UserAgent:     getEnv("GITHUB_USER_AGENT", "auto-devs/1.0"),
+			Timeout:       getEnvAsInt("GITHUB_TIMEOUT", 30),
+			WebhookSecret: getEnv("GITHUB_WEBHOOK_SECRET", ""),
+			WebhookMaxAge: getEnvAsInt("GITHUB_WEBHOOK_MAX_AGE", 600),
+		},
+		GitLab: GitLabConfig{
+			Token:   getEnv("GITLAB_TOKEN", ""),
+			BaseURL: getEnv("GITLAB_BASE_URL", "https://gitlab.com"),
+			Timeout: getEnvAsInt("GITLAB_TIMEOUT", 30),
+		},
+		Slack: SlackConfig{
+			APIURL:        getEnv("SLACK_API_URL", "https://slack.com/api"),
+			SigningSecret: getEnv("SLACK_SIGNING_SECRET", ""),
 		},
 		App: AppConfig{
 			BaseURL: getEnv("APP_BASE_URL", "http://localhost:8098"),
@@ -132,6 +393,92 @@ func Load() *Config {
 			Token:   getEnv("HERMES_KANBAN_TOKEN", ""),
 			Board:   getEnv("HERMES_KANBAN_BOARD", ""),
 		},
+		Purge: PurgeConfig{
+			Enabled:       getEnvAsBool("PURGE_ENABLED", false),
+			RetentionDays: getEnvAsInt("PURGE_RETENTION_DAYS", 30),
+			DryRun:        getEnvAsBool("PURGE_DRY_RUN", false),
+			Interval:      getEnv("PURGE_INTERVAL", "24h"),
+		},
+		Standup: StandupConfig{
+			Enabled:  getEnvAsBool("STANDUP_ENABLED", false),
+			Schedule: getEnv("STANDUP_SCHEDULE", "0 8 * * *"),
+			AIType:   getEnv("STANDUP_AI_TYPE", "claude-code"),
+		},
+		Email: EmailConfig{
+			SMTPHost:       getEnv("SMTP_HOST", ""),
+			SMTPPort:       getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername:   getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:   getEnv("SMTP_PASSWORD", ""),
+			From:           getEnv("SMTP_FROM", "auto-devs <auto-devs@localhost>"),
+			DigestEnabled:  getEnvAsBool("EMAIL_DIGEST_ENABLED", false),
+			DigestSchedule: getEnv("EMAIL_DIGEST_SCHEDULE", "0 7 * * *"),
+		},
+		Secrets: SecretsConfig{
+			EncryptionKey: getEnv("SECRETS_ENCRYPTION_KEY", ""),
+		},
+		APIKeys: APIKeysConfig{
+			Keys: parseAPIKeys(getEnv("API_KEYS", "")),
+		},
+		Auth: AuthConfig{
+			Required:            getEnvAsBool("AUTH_REQUIRED", false),
+			JWTSecret:           getEnv("AUTH_JWT_SECRET", ""),
+			TokenTTLHours:       getEnvAsInt("AUTH_TOKEN_TTL_HOURS", 24),
+			RegistrationEnabled: getEnvAsBool("AUTH_REGISTRATION_ENABLED", true),
+		},
+		Approval: ApprovalConfig{
+			TOTPSecret: getEnv("PLAN_APPROVAL_TOTP_SECRET", ""),
+		},
+		Access: AccessConfig{
+			AdminAllowlist:   splitList(getEnv("ADMIN_IP_ALLOWLIST", "")),
+			WebhookAllowlist: splitList(getEnv("WEBHOOK_IP_ALLOWLIST", "")),
+			TrustedProxies:   splitList(getEnv("TRUSTED_PROXIES", "")),
+		},
+		Attachments: AttachmentsConfig{
+			Storage:       getEnv("ATTACHMENTS_STORAGE", "local"),
+			LocalDir:      getEnv("ATTACHMENTS_DIR", "attachments"),
+			MaxSize:       getEnvAsInt64("ATTACHMENTS_MAX_SIZE", 10*1024*1024), // 10MB
+			AllowedTypes:  splitList(getEnv("ATTACHMENTS_ALLOWED_TYPES", "image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain,application/zip")),
+			URLSigningKey: getEnv("ATTACHMENTS_URL_SIGNING_KEY", ""),
+			URLExpiry:     getEnvAsInt("ATTACHMENTS_URL_EXPIRY", 900),
+			S3: S3Config{
+				Bucket:          getEnv("S3_BUCKET", ""),
+				Region:          getEnv("S3_REGION", "us-east-1"),
+				Endpoint:        getEnv("S3_ENDPOINT", ""),
+				AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+				PathStyle:       getEnvAsBool("S3_PATH_STYLE", false),
+			},
+		},
+		Preview: PreviewConfig{
+			Host:           getEnv("PREVIEW_HOST", "localhost"),
+			PortRangeStart: getEnvAsInt("PREVIEW_PORT_RANGE_START", 4100),
+			PortRangeEnd:   getEnvAsInt("PREVIEW_PORT_RANGE_END", 4199),
+			StartTimeout:   getEnvAsInt("PREVIEW_START_TIMEOUT", 300),
+		},
+		Execution: ExecutionConfig{
+			Sandbox:       getEnvAsBool("EXECUTION_SANDBOX", false),
+			StatePaths:    splitList(getEnv("EXECUTION_STATE_PATHS", "~/.claude,~/.claude.json,~/.cursor,~/.npm,~/.cache")),
+			MaxConcurrent: getEnvAsInt("EXECUTION_MAX_CONCURRENT", 0),
+			MaxAttempts:   getEnvAsInt("EXECUTION_MAX_ATTEMPTS", 3),
+			RetryBackoff:  getEnvAsInt("EXECUTION_RETRY_BACKOFF", 30),
+		},
+		Undo: UndoConfig{
+			Window: getEnvAsInt("UNDO_WINDOW", 300),
+		},
+		Backpressure: BackpressureConfig{
+			MaxPlanningQueueDepth: getEnvAsInt("PLANNING_MAX_QUEUE_DEPTH", 0),
+			MaxActiveExecutions:   getEnvAsInt("PLANNING_MAX_ACTIVE_EXECUTIONS", 0),
+		},
+		Runners: RunnersConfig{
+			ListenAddr:    getEnv("RUNNER_LISTEN_ADDR", ""),
+			Token:         getEnv("RUNNER_TOKEN", ""),
+			TLSCertFile:   getEnv("RUNNER_TLS_CERT_FILE", ""),
+			TLSKeyFile:    getEnv("RUNNER_TLS_KEY_FILE", ""),
+			LocalFallback: getEnvAsBool("RUNNER_LOCAL_FALLBACK", true),
+		},
+		Tracing: TracingConfig{
+			Endpoint: getEnv("TRACING_OTLP_ENDPOINT", ""),
+		},
 	}
 }
 
@@ -190,3 +537,27 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	}
 	return defaultValue
 }
+
+// parseAPIKeys parses comma-separated "owner:key" pairs
+func parseAPIKeys(value string) map[string]string {
+	keys := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		owner, key, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || owner == "" || key == "" {
+			continue
+		}
+		keys[key] = owner
+	}
+	return keys
+}
+
+// splitList splits a comma separated list, skipping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -15,35 +15,44 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/executions": {
-            "post": {
-                "description": "Create a new execution for a task",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/attachments/{id}/download": {
+            "get": {
+                "description": "Download an attachment through the signed URL returned with it. The URL needs no API key but stops working once it expires.",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "executions"
+                    "tasks"
                 ],
-                "summary": "Create a new execution",
+                "summary": "Download an attachment",
                 "parameters": [
                     {
-                        "description": "Execution creation data",
-                        "name": "execution",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ExecutionCreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Expiry of the URL, in Unix seconds",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the URL",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ExecutionResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -52,45 +61,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/executions/stats": {
-            "get": {
-                "description": "Get execution statistics for a task or globally",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "executions"
-                ],
-                "summary": "Get execution statistics",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by task ID",
-                        "name": "task_id",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/repository.ExecutionStats"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -104,57 +82,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/executions/{id}": {
+        "/api/v1/auth/api-keys": {
             "get": {
-                "description": "Get a single execution with detailed information",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "List the API keys of the signed-in user, the newest first. The keys themselves are only shown when created.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "executions"
-                ],
-                "summary": "Get execution by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Execution ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Include execution logs",
-                        "name": "include_logs",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 100,
-                        "description": "Maximum number of logs to include",
-                        "name": "log_limit",
-                        "in": "query"
-                    }
+                    "auth"
                 ],
+                "summary": "List your API keys",
                 "responses": {
                     "200": {
-                        "description": "When include_logs=true",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ExecutionWithLogsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.APIKeyListResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -167,8 +118,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update execution status, progress, or error information",
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create an API key authenticating as the signed-in user, for scripts and tools. The key is only returned this once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -176,32 +132,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "executions"
+                    "auth"
                 ],
-                "summary": "Update an execution",
+                "summary": "Create an API key",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Execution ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Execution update data",
-                        "name": "execution",
+                        "description": "Key name",
+                        "name": "key",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ExecutionUpdateRequest"
+                            "$ref": "#/definitions/dto.APIKeyCreateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.ExecutionResponse"
+                            "$ref": "#/definitions/dto.APIKeyCreatedResponse"
                         }
                     },
                     "400": {
@@ -210,8 +159,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -223,23 +172,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/auth/api-keys/{id}": {
             "delete": {
-                "description": "Delete an execution and all its associated logs",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Revoke one of the signed-in user's API keys",
                 "tags": [
-                    "executions"
+                    "auth"
                 ],
-                "summary": "Delete an execution",
+                "summary": "Revoke an API key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Execution ID",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -255,14 +205,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -270,9 +220,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/executions/{id}/logs": {
-            "get": {
-                "description": "Get logs for a specific execution with pagination and filtering",
+        "/api/v1/auth/login": {
+            "post": {
+                "description": "Check a user's password and return a token, sent as \"Authorization: Bearer\" until it expires.",
                 "consumes": [
                     "application/json"
                 ],
@@ -280,79 +230,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "executions"
+                    "auth"
                 ],
-                "summary": "Get execution logs",
+                "summary": "Sign in",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Execution ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "debug",
-                            "info",
-                            "warn",
-                            "error"
-                        ],
-                        "type": "string",
-                        "description": "Filter by log level",
-                        "name": "level",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by log source",
-                        "name": "source",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search in log messages",
-                        "name": "search",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "\"timestamp\"",
-                        "description": "Order by field",
-                        "name": "order_by",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "default": "\"desc\"",
-                        "description": "Order direction",
-                        "name": "order_dir",
-                        "in": "query"
+                        "description": "Username and password",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ExecutionLogListResponse"
+                            "$ref": "#/definitions/dto.LoginResponse"
                         }
                     },
                     "400": {
@@ -361,8 +257,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -376,36 +272,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects": {
+        "/api/v1/auth/me": {
             "get": {
-                "description": "Get a list of all projects",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get the user the request's token or API key authenticates",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "auth"
                 ],
-                "summary": "List all projects",
+                "summary": "Get the signed-in user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectListResponse"
+                            "$ref": "#/definitions/dto.UserResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/v1/auth/register": {
             "post": {
-                "description": "Create a new project with the provided details",
+                "description": "Create a user account. The username is the name the user's changes are recorded under, and their profile's. Registration can be turned off with AUTH_REGISTRATION_ENABLED.",
                 "consumes": [
                     "application/json"
                 ],
@@ -413,17 +313,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "auth"
                 ],
-                "summary": "Create a new project",
+                "summary": "Register a user",
                 "parameters": [
                     {
-                        "description": "Project creation data",
-                        "name": "project",
+                        "description": "Account data",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectCreateRequest"
+                            "$ref": "#/definitions/dto.RegisterRequest"
                         }
                     }
                 ],
@@ -431,7 +331,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
+                            "$ref": "#/definitions/dto.UserResponse"
                         }
                     },
                     "400": {
@@ -440,52 +340,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/projects/{id}": {
-            "get": {
-                "description": "Get a single project by its ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "projects"
-                ],
-                "summary": "Get a project by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -497,42 +359,54 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a project with the provided details",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/api/v1/automation/events": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
                 ],
+                "description": "List task events, newest first. Pass the returned cursor as ` + "`" + `after` + "`" + ` to receive only newer events on the next poll.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "automation"
                 ],
-                "summary": "Update a project",
+                "summary": "Poll the event feed",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Only events of this project",
+                        "name": "project_id",
+                        "in": "query"
                     },
                     {
-                        "description": "Project update data",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ProjectUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "Comma-separated event types (task.created, task.status_changed, task.deleted, task.commit_linked, project.budget_threshold_reached)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only events with a greater ID",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of events (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectResponse"
+                            "$ref": "#/definitions/dto.EventFeedResponse"
                         }
                     },
                     "400": {
@@ -541,8 +415,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -554,46 +428,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a project by its ID",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/api/v1/automation/me": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
                 ],
+                "description": "Return the owner of the API key, so automation tools can verify a connection",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
-                ],
-                "summary": "Delete a project",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "automation"
                 ],
+                "summary": "Test an automation API key",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.AutomationAuthResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -601,31 +461,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/archive": {
+        "/api/v1/automation/tasks": {
             "post": {
-                "description": "Archive a project (soft delete)",
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create a TODO task from a flat JSON or form payload, e.g. a Zapier or n8n action",
                 "consumes": [
-                    "application/json"
+                    "application/json",
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "automation"
                 ],
-                "summary": "Archive a project",
+                "summary": "Create a task from an automation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Task fields",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AutomationTaskCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -633,8 +504,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -648,9 +519,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/branches": {
+        "/api/v1/epics/{id}": {
             "get": {
-                "description": "Get all Git branches available in the project repository",
+                "description": "Get an epic with the progress of its tasks",
                 "consumes": [
                     "application/json"
                 ],
@@ -658,13 +529,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "epics"
                 ],
-                "summary": "List Git branches for a project",
+                "summary": "Get an epic",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Epic ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -674,7 +545,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ListBranchesResponse"
+                            "$ref": "#/definitions/dto.EpicResponse"
                         }
                     },
                     "400": {
@@ -696,11 +567,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/projects/{id}/git/reinit": {
-            "post": {
-                "description": "Reinitialize and reassign Git repository and GitHub repository URL for a project",
+            },
+            "put": {
+                "description": "Update the provided fields of an epic. Set clear_due_date to remove its due date.",
                 "consumes": [
                     "application/json"
                 ],
@@ -708,23 +577,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "epics"
                 ],
-                "summary": "Reinitialize Git repository for a project",
+                "summary": "Update an epic",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Epic ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "epic",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateEpicRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.EpicResponse"
                         }
                     },
                     "400": {
@@ -746,11 +624,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/projects/{id}/restore": {
-            "post": {
-                "description": "Restore an archived project (undelete)",
+            },
+            "delete": {
+                "description": "Delete an epic. Its tasks are kept, no longer grouped under an epic.",
                 "consumes": [
                     "application/json"
                 ],
@@ -758,13 +634,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "epics"
                 ],
-                "summary": "Restore an archived project",
+                "summary": "Delete an epic",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Epic ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -795,9 +671,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/statistics": {
-            "get": {
-                "description": "Get task statistics and completion data for a project",
+        "/api/v1/executions": {
+            "post": {
+                "description": "Create a new execution for a task",
                 "consumes": [
                     "application/json"
                 ],
@@ -805,23 +681,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "executions"
                 ],
-                "summary": "Get project statistics",
+                "summary": "Create a new execution",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Execution creation data",
+                        "name": "execution",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExecutionCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProjectStatisticsResponse"
+                            "$ref": "#/definitions/dto.ExecutionResponse"
                         }
                     },
                     "400": {
@@ -830,12 +708,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -845,9 +717,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/tasks": {
+        "/api/v1/executions/stats": {
             "get": {
-                "description": "Get all tasks for a specific project",
+                "description": "Get execution statistics for a task or globally",
                 "consumes": [
                     "application/json"
                 ],
@@ -855,23 +727,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "List tasks by project",
+                "summary": "Get execution statistics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter by task ID",
+                        "name": "task_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskListResponse"
+                            "$ref": "#/definitions/repository.ExecutionStats"
                         }
                     },
                     "400": {
@@ -889,9 +760,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/projects/{id}/tasks/done": {
+        "/api/v1/executions/{id}": {
             "get": {
-                "description": "Get tasks with DONE status for a specific project",
+                "description": "Get a single execution with detailed information",
                 "consumes": [
                     "application/json"
                 ],
@@ -899,23 +770,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "List DONE tasks by project",
+                "summary": "Get execution by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include execution logs",
+                        "name": "include_logs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of logs to include",
+                        "name": "log_limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "When include_logs=true",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskListResponse"
+                            "$ref": "#/definitions/dto.ExecutionWithLogsResponse"
                         }
                     },
                     "400": {
@@ -924,66 +809,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/tasks": {
-            "get": {
-                "description": "Get a list of tasks with optional filtering by status, project, or search term",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tasks"
-                ],
-                "summary": "List tasks with filtering",
-                "parameters": [
-                    {
-                        "enum": [
-                            "TODO",
-                            "PLANNING",
-                            "PLAN_REVIEWING",
-                            "IMPLEMENTING",
-                            "CODE_REVIEWING",
-                            "DONE",
-                            "CANCELLED"
-                        ],
-                        "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by project ID",
-                        "name": "project_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Search in title and description",
-                        "name": "search",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.TaskListResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -996,8 +823,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a new task with the provided details",
+            "put": {
+                "description": "Update execution status, progress, or error information",
                 "consumes": [
                     "application/json"
                 ],
@@ -1005,25 +832,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Create a new task",
+                "summary": "Update an execution",
                 "parameters": [
                     {
-                        "description": "Task creation data",
-                        "name": "task",
+                        "type": "string",
+                        "description": "Execution ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Execution update data",
+                        "name": "execution",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskCreateRequest"
+                            "$ref": "#/definitions/dto.ExecutionUpdateRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskResponse"
+                            "$ref": "#/definitions/dto.ExecutionResponse"
                         }
                     },
                     "400": {
@@ -1032,6 +866,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1039,11 +879,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/tasks/{id}": {
-            "get": {
-                "description": "Get a single task by its ID",
+            },
+            "delete": {
+                "description": "Delete an execution and all its associated logs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1051,24 +889,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Get a task by ID",
+                "summary": "Delete an execution",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.TaskResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1089,9 +924,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a task with the provided details",
+            }
+        },
+        "/api/v1/executions/{id}/answer": {
+            "post": {
+                "description": "Answer the question the AI asked during an implementation whose execution is WAITING_INPUT. The question and answer are saved as a task comment, and the execution resumes in the same AI session with the answer.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1099,24 +936,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Update a task",
+                "summary": "Answer an execution's question",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Task update data",
-                        "name": "task",
+                        "description": "Answer",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskUpdateRequest"
+                            "$ref": "#/definitions/dto.AnswerQuestionRequest"
                         }
                     }
                 ],
@@ -1124,7 +961,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskResponse"
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
                         }
                     },
                     "400": {
@@ -1139,53 +976,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete a task by its ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "tasks"
-                ],
-                "summary": "Delete a task",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1193,42 +997,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/{id}/approve-plan": {
-            "post": {
-                "description": "Approve the plan for a task and enqueue implementation job",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/executions/{id}/attempts": {
+            "get": {
+                "description": "Get the attempt chain the execution belongs to: its first attempt, then each retry of the failed attempt before it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Approve plan and start implementation",
+                "summary": "Get execution attempts",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Approve plan request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ApprovePlanRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                            "$ref": "#/definitions/dto.ExecutionAttemptListResponse"
                         }
                     },
                     "400": {
@@ -1237,12 +1029,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1252,33 +1038,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/{id}/diff": {
-            "get": {
-                "description": "Get the git diff between the base branch HEAD and task branch HEAD",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/executions/{id}/cancel": {
+            "post": {
+                "description": "Cancel a planning or implementation execution that has not finished. A job on the worker running it stops the AI CLI process, marks the execution CANCELLED and moves the task back to the status it had before the execution, with the usual WebSocket notifications. Comparison and dry-run executions cannot be cancelled.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Get git diff for a task",
+                "summary": "Cancel an execution",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Git diff output",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
                         }
                     },
                     "400": {
@@ -1293,18 +1076,35 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/tasks/{id}/executions": {
+        "/api/v1/executions/{id}/logs": {
             "get": {
-                "description": "Get all executions for a specific task with optional filtering",
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get logs for a specific execution with pagination and filtering. Logs of projects encrypting them are decrypted for requests with an API key; for others their message is withheld and they are flagged encrypted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1314,46 +1114,56 @@ const docTemplate = `{
                 "tags": [
                     "executions"
                 ],
-                "summary": "Get all executions for a task",
+                "summary": "Get execution logs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "enum": [
-                            "pending",
-                            "running",
-                            "paused",
-                            "completed",
-                            "failed",
-                            "cancelled"
+                            "debug",
+                            "info",
+                            "warn",
+                            "error"
                         ],
                         "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
+                        "description": "Filter by log level",
+                        "name": "level",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
+                        "type": "string",
+                        "description": "Filter by log source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in log messages",
+                        "name": "search",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "default": 10,
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
                         "description": "Page size",
                         "name": "page_size",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "\"started_at\"",
+                        "default": "\"timestamp\"",
                         "description": "Order by field",
                         "name": "order_by",
                         "in": "query"
@@ -1374,7 +1184,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ExecutionListResponse"
+                            "$ref": "#/definitions/dto.ExecutionLogListResponse"
                         }
                     },
                     "400": {
@@ -1383,6 +1193,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1398,33 +1214,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/{id}/open-with-cursor": {
+        "/api/v1/executions/{id}/retry": {
             "post": {
-                "description": "Open the task's worktree path with Cursor editor",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Run a failed planning or implementation execution again. The retry is a new execution numbered as the next attempt and linked to the failed one, and starts the task's planning or implementation again, with the usual WebSocket notifications. The task must still be where the failure left it: TODO, or PLAN_REVIEWING for an implementation. Workers also retry failed executions on their own, with exponential backoff, up to EXECUTION_MAX_ATTEMPTS.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Open task workspace with Cursor",
+                "summary": "Retry an execution",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
                         }
                     },
                     "400": {
@@ -1439,18 +1252,30 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/tasks/{id}/plans": {
+        "/api/v1/executions/{id}/review-comments": {
             "get": {
-                "description": "Get all plans for a specific task, sorted by created_at descending",
+                "description": "Get the findings of the AI review of the execution's changes, made before its pull request was opened",
                 "consumes": [
                     "application/json"
                 ],
@@ -1458,13 +1283,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Get plans for a task",
+                "summary": "Get execution review comments",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1474,7 +1299,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskPlansResponse"
+                            "$ref": "#/definitions/dto.ReviewCommentListResponse"
                         }
                     },
                     "400": {
@@ -1483,12 +1308,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1498,9 +1317,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/{id}/plans/{planId}": {
-            "put": {
-                "description": "Update a plan by its ID",
+        "/api/v1/executions/{id}/security-findings": {
+            "get": {
+                "description": "Get the issues the project's security scanner found in the files the execution changed, and the secrets the built-in secret scan found in its diff (scanner \"secrets\")",
                 "consumes": [
                     "application/json"
                 ],
@@ -1508,39 +1327,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "plans"
+                    "executions"
                 ],
-                "summary": "Update a plan",
+                "summary": "Get execution security findings",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Plan ID",
-                        "name": "planId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Plan update data",
-                        "name": "plan",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.PlanUpdateRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.PlanResponse"
+                            "$ref": "#/definitions/dto.SecurityFindingListResponse"
                         }
                     },
                     "400": {
@@ -1549,12 +1352,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1564,9 +1361,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/{id}/start-planning": {
-            "post": {
-                "description": "Start the planning phase for a task by selecting a branch and initiating background processing",
+        "/api/v1/executions/{id}/verification-runs": {
+            "get": {
+                "description": "Get the runs of the project's lint, build and test commands made in the worktree after the execution",
                 "consumes": [
                     "application/json"
                 ],
@@ -1574,32 +1371,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "executions"
                 ],
-                "summary": "Start planning for a task",
+                "summary": "Get execution verification runs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Task ID",
+                        "description": "Execution ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Start planning request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.StartPlanningRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                            "$ref": "#/definitions/dto.VerificationRunListResponse"
                         }
                     },
                     "400": {
@@ -1608,24 +1396,49 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/health": {
+            "get": {
+                "description": "Report service, database and Redis health. The status is degraded when either cannot be reached, or notifications wait for Redis; the frontend shows a banner then. Only an unreachable database answers 503, as the API keeps serving without Redis.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/handler.HealthResponse"
                         }
                     }
                 }
             }
         },
-        "/tasks/{id}/pull-request": {
+        "/api/v1/ide/tasks": {
             "post": {
-                "description": "Create a new pull request for the task",
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Create a TODO task assigned to the API key's owner, with the selected code and its location in the description",
                 "consumes": [
                     "application/json"
                 ],
@@ -1633,23 +1446,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "ide"
                 ],
-                "summary": "Create pull request for task",
+                "summary": "Create a task from an editor selection",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Selection and task title",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.IDETaskCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/entity.PullRequest"
+                            "$ref": "#/definitions/dto.TaskResponse"
                         }
                     },
                     "400": {
@@ -1658,8 +1473,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1673,9 +1488,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees": {
-            "post": {
-                "description": "Enqueue creation of a new Git worktree for a specific task. The\nworktree is created asynchronously in a background job to avoid\nrequest timeouts; the response returns a record with \"creating\"\nstatus that transitions to \"active\" once the job finishes.",
+        "/api/v1/ide/tasks/mine": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "List open tasks assigned to the API key's owner, most recently updated first",
                 "consumes": [
                     "application/json"
                 ],
@@ -1683,25 +1503,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "ide"
                 ],
-                "summary": "Create worktree for task",
+                "summary": "List the tasks assigned to the API key's owner",
                 "parameters": [
                     {
-                        "description": "Create worktree request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateWorktreeRequest"
-                        }
+                        "type": "string",
+                        "description": "Only tasks of this project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include DONE and CANCELLED tasks",
+                        "name": "include_done",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeResponse"
+                            "$ref": "#/definitions/dto.TaskListResponse"
                         }
                     },
                     "400": {
@@ -1710,8 +1546,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1725,9 +1561,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/cleanup": {
-            "post": {
-                "description": "Clean up a Git worktree for a specific task",
+        "/api/v1/ide/tasks/{id}/worktree": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Get the local worktree path and branch of a task, with a URI that opens it in VS Code",
                 "consumes": [
                     "application/json"
                 ],
@@ -1735,25 +1576,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "ide"
                 ],
-                "summary": "Cleanup worktree for task",
+                "summary": "Get where to open a task's worktree",
                 "parameters": [
                     {
-                        "description": "Cleanup worktree request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CleanupWorktreeRequest"
-                        }
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.IDEWorktreeResponse"
                         }
                     },
                     "400": {
@@ -1762,14 +1601,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1777,30 +1616,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/project/{projectId}": {
+        "/api/v1/jobs/metrics": {
             "get": {
-                "description": "Get all worktrees for a specific project",
+                "description": "Get how many jobs of each type the workers processed and failed each UTC day, and how long they ran, with totals per job type over the range. Retries count as runs. The range covers the UTC days of from and to, the last 30 days by default and at most 366 days. Durations are in seconds.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "jobs"
                 ],
-                "summary": "Get worktrees by project ID",
+                "summary": "Get the processing history of the background jobs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
-                        "in": "path",
-                        "required": true
+                        "description": "A time within the first day covered (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "A time within the last day covered (RFC 3339), today by default",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only jobs of this type, such as task:planning",
+                        "name": "job_type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreesResponse"
+                            "$ref": "#/definitions/dto.JobMetricsResponse"
                         }
                     },
                     "400": {
@@ -1818,21 +1671,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/project/{projectId}/active-count": {
+        "/api/v1/media/{id}": {
             "get": {
-                "description": "Get the count of active worktrees for a project",
+                "description": "Serve an image uploaded for a task's description or comments, for them to embed. Its URL needs no API key and does not expire.",
                 "produces": [
-                    "application/json"
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif",
+                    "image/webp"
                 ],
                 "tags": [
-                    "worktrees"
+                    "tasks"
                 ],
-                "summary": "Get active worktrees count",
+                "summary": "Get an uploaded image",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
+                        "description": "Image ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -1841,7 +1697,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeCountResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1850,6 +1706,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1859,36 +1721,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/project/{projectId}/statistics": {
+        "/api/v1/organizations": {
             "get": {
-                "description": "Get worktree statistics for a project",
+                "description": "Get a list of all organizations, only the caller's own for members of one",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Get worktree statistics",
+                "summary": "List organizations",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeStatisticsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.OrganizationListResponse"
                         }
                     },
                     "500": {
@@ -1898,36 +1763,51 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/worktrees/task/{taskId}": {
-            "get": {
-                "description": "Get worktree information for a specific task",
+            },
+            "post": {
+                "description": "Create a new organization (tenant) that projects can be scoped to. The first organization adopts the existing projects and users; the user creating a later one joins it. Members of an organization cannot create another.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Get worktree by task ID",
+                "summary": "Create a new organization",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
+                        "description": "Organization creation data",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrganizationCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeResponse"
+                            "$ref": "#/definitions/dto.OrganizationResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1941,21 +1821,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/{worktreeId}/branch": {
+        "/api/v1/organizations/{id}": {
             "get": {
-                "description": "Get branch information for a worktree",
+                "description": "Get a single organization by its ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Get branch info",
+                "summary": "Get an organization by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Organization ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -1964,7 +1847,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.BranchInfoResponse"
+                            "$ref": "#/definitions/dto.OrganizationResponse"
                         }
                     },
                     "400": {
@@ -1978,40 +1861,44 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
                     }
                 }
-            }
-        },
-        "/worktrees/{worktreeId}/health": {
-            "get": {
-                "description": "Get health information for a worktree",
+            },
+            "put": {
+                "description": "Update an organization's name or description",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Get worktree health",
+                "summary": "Update an organization",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Organization ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Organization update data",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.OrganizationUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeHealthResponse"
+                            "$ref": "#/definitions/dto.OrganizationResponse"
                         }
                     },
                     "400": {
@@ -2033,33 +1920,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/worktrees/{worktreeId}/initialize": {
-            "post": {
-                "description": "Initialize a worktree with basic configuration",
+            },
+            "delete": {
+                "description": "Delete an organization by its ID (soft delete)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Initialize worktree",
+                "summary": "Delete an organization",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Organization ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2082,31 +1967,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/{worktreeId}/recover": {
-            "post": {
-                "description": "Attempt to recover a worktree that is in error status",
+        "/api/v1/organizations/{id}/users/{username}": {
+            "put": {
+                "description": "Move a user of no organization yet into the organization, scoping the user's requests to it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "organizations"
                 ],
-                "summary": "Recover failed worktree",
+                "summary": "Add a user to an organization",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Organization ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2129,9 +2018,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/{worktreeId}/status": {
+        "/api/v1/project-webhooks/{id}": {
             "put": {
-                "description": "Update the status of a worktree",
+                "description": "Change the URL, events or active flag of a webhook, and its secret when one is given.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2139,24 +2028,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "projects"
                 ],
-                "summary": "Update worktree status",
+                "summary": "Update a project webhook",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Webhook ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Update status request",
-                        "name": "status",
+                        "description": "Webhook",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateWorktreeStatusRequest"
+                            "$ref": "#/definitions/dto.ProjectWebhookRequest"
                         }
                     }
                 ],
@@ -2164,8 +2053,53 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SuccessResponse"
+                            "$ref": "#/definitions/dto.ProjectWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a webhook with its deliveries and secret.",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete a project webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -2173,6 +2107,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2188,30 +2128,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/worktrees/{worktreeId}/validate": {
+        "/api/v1/project-webhooks/{id}/deliveries": {
             "get": {
-                "description": "Validate the health and integrity of a worktree",
+                "description": "List the latest deliveries of a webhook with their payload, status, attempts and the receiver's last response.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "worktrees"
+                    "projects"
                 ],
-                "summary": "Validate worktree",
+                "summary": "List webhook deliveries",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Worktree ID",
-                        "name": "worktreeId",
+                        "description": "Webhook ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Deliveries to list, at most 200",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.WorktreeValidationResponse"
+                            "$ref": "#/definitions/dto.WebhookDeliveryListResponse"
                         }
                     },
                     "400": {
@@ -2220,6 +2167,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -2234,843 +2187,13287 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.ActiveTaskCounts": {
-            "type": "object",
-            "properties": {
-                "code_reviewing": {
-                    "type": "integer"
-                },
-                "implementing": {
-                    "type": "integer"
-                },
-                "plan_reviewing": {
-                    "type": "integer"
-                },
-                "planning": {
-                    "type": "integer"
-                }
-            }
-        },
-        "dto.ApprovePlanRequest": {
-            "type": "object",
-            "required": [
-                "ai_type"
-            ],
-            "properties": {
-                "ai_type": {
-                    "type": "string",
-                    "example": "claude-code"
-                }
-            }
-        },
-        "dto.BranchInfoResponse": {
-            "type": "object",
-            "properties": {
-                "branch_info": {
-                    "$ref": "#/definitions/usecase.BranchInfo"
-                }
-            }
         },
-        "dto.CleanupWorktreeRequest": {
-            "type": "object",
-            "required": [
+        "/api/v1/projects": {
+            "get": {
+                "description": "Get a list of all projects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List all projects",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new project with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create a new project",
+                "parameters": [
+                    {
+                        "description": "Project creation data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}": {
+            "get": {
+                "description": "Get a single project by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a project with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project update data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a project by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/activity": {
+            "get": {
+                "description": "Get what happened to the project's tasks, most recent first: task creations, status changes, plan approvals, executions starting and finishing, and pull requests being opened, merged or closed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project's activity feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size, 0 for the whole feed",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectActivityListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/cycle-time": {
+            "get": {
+                "description": "Measure the project's tasks completed within the date range from their status history: lead time from creation to DONE, cycle time from when work started to DONE, and the time spent in each status. Durations are in hours. With format=csv the tasks are returned as a CSV file, and with format=pdf as a PDF summary for sharing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get cycle time and lead time analytics of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks completed at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks completed at or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CycleTimeAnalyticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/executors": {
+            "get": {
+                "description": "Compare the AI executors that ran the project's tasks on success rate, average duration, auto-fix attempts, runs per task, pull request merge rate and cost. A pull request is credited to the executor of the last completed run of its task before it was opened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Compare the executors of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only executions started at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only executions started at or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExecutorAnalyticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/plan-reviews": {
+            "get": {
+                "description": "Measure the time from a task entering PLAN_REVIEWING to its plan being approved or rejected, as average and percentiles for the project and per reviewer, and list the plans still waiting for review. A task sent back to TODO or PLANNING, or cancelled, counts as rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get plan review latency analytics of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only reviews decided at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only reviews decided at or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PlanReviewAnalyticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/pull-requests": {
+            "get": {
+                "description": "Report the merge rate, time to merge and pull requests closed without merge of the project's pull requests, optionally broken down by the week or month they were opened in. The merge rate is the share of merged pull requests among the merged and closed ones.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get pull request analytics of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only pull requests opened at or after this time (RFC 3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only pull requests opened at or before this time (RFC 3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Break the pull requests down by the period they were opened in",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PullRequestAnalyticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/velocity": {
+            "get": {
+                "description": "Get the tasks completed, pull requests merged and AI runs per completed task of each week, Monday to Monday UTC, up to the current one. The figures are rolled up every hour; weeks not rolled up yet count as zero. With format=csv the weeks are returned as a CSV file for sprint reviews, and with format=pdf as a PDF summary for sharing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the weekly velocity of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 12,
+                        "description": "Number of weeks, the current one included",
+                        "name": "weeks",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VelocityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/analytics/workload": {
+            "get": {
+                "description": "Get the open tasks of each assignee, by status, with their estimated hours and the AI runs pending, running or paused on them, to balance work between people and the AI queue. Unassigned tasks are reported under an empty assignee. Done, cancelled and archived tasks are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the workload of a project by assignee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkloadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/archive": {
+            "post": {
+                "description": "Archive a project (soft delete)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Archive a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/board": {
+            "get": {
+                "description": "Get the project's unarchived tasks grouped into one column per status, as light cards ordered by priority and then most recently updated, with the number of tasks in each column. The cards of the done column are left out unless include_done is set; its count is always given. epic_id limits the board, counts included, to the tasks of an epic.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a project's Kanban board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include the cards of done tasks",
+                        "name": "include_done",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only show the tasks of this epic",
+                        "name": "epic_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskBoardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/branches": {
+            "get": {
+                "description": "Get all Git branches available in the project repository",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List Git branches for a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ListBranchesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/email-templates": {
+            "get": {
+                "description": "List the subject and body of each email the project's members get: PLAN_READY, EXECUTION_FAILED and DAILY_DIGEST. Types the project did not customize show the default template.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "email"
+                ],
+                "summary": "List a project's email templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmailTemplateListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/email-templates/{type}": {
+            "put": {
+                "description": "Replace the subject and body of one type of email the project's members get. Both are Go templates over ProjectName, ProjectURL, Message, TaskKey, TaskTitle, TaskURL, Plan, HighRisk, Phase, Error and, for DAILY_DIGEST, From, To and Movements; templates using other fields are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "email"
+                ],
+                "summary": "Customize a project's email template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "PLAN_READY",
+                            "EXECUTION_FAILED",
+                            "DAILY_DIGEST"
+                        ],
+                        "type": "string",
+                        "description": "Notification type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Email template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateEmailTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmailTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Go back to the default subject and body for one type of email, which is returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "email"
+                ],
+                "summary": "Reset a project's email template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "PLAN_READY",
+                            "EXECUTION_FAILED",
+                            "DAILY_DIGEST"
+                        ],
+                        "type": "string",
+                        "description": "Notification type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmailTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/epics": {
+            "get": {
+                "description": "List the project's epics with the progress of their tasks: done and total counts, and estimated against actual hours. Epics due first, then the newest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "epics"
+                ],
+                "summary": "List a project's epics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EpicListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create an epic to group the project's tasks toward a goal or milestone. Tasks join it through their epic_id.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "epics"
+                ],
+                "summary": "Create an epic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Epic",
+                        "name": "epic",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateEpicRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EpicResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/events": {
+            "get": {
+                "description": "Server-Sent Events fallback for clients that cannot use the WebSocket, e.g. behind proxies blocking it. Every event is a message the project's WebSocket subscribers get, named after its type, with the message as data. The stream opens with a subscription event; its data's resumed is true when the events since Last-Event-ID follow, and false when the client must reload what it shows. Reconnecting browsers send Last-Event-ID themselves; last_event_id sets it for clients that cannot send the header. Events are kept 15 minutes for resuming.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Stream a project's events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received, to resume after it",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Same as the Last-Event-ID header",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "text/event-stream",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/git/reinit": {
+            "post": {
+                "description": "Reinitialize and reassign Git repository and GitHub repository URL for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Reinitialize Git repository for a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/github-project/import": {
+            "post": {
+                "description": "Create a task for every item on a GitHub Projects (v2) board and refresh the fields of previously imported ones. Board columns map onto the status new tasks start in; see column_mapping. Uses the server's GITHUB_TOKEN, which needs the read:project scope.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "github"
+                ],
+                "summary": "Import a GitHub project board as tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitHub project board to import",
+                        "name": "board",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubProjectImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubProjectImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/github-token": {
+            "get": {
+                "description": "Tell whether the project's pull requests use its own GitHub token or the global one. The token is never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get whether a project has its own GitHub token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Store the token the project's pull requests are opened, commented on and tracked with, encrypted in the project's secrets. It replaces the global token for this project.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Set a project's GitHub token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitHub token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the project's GitHub token, going back to the global one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete a project's GitHub token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/import/github-issues": {
+            "post": {
+                "description": "Create a task for every selected issue of a GitHub repository and refresh the title, body, labels and assignee of previously imported ones. Issues are selected by state, labels and numbers. Each new task is linked from its issue with a comment; with post_comments the plan and the pull request are posted to the issue too. Uses the server's GITHUB_TOKEN, which needs read and write access to issues.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "github"
+                ],
+                "summary": "Import GitHub issues as tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "GitHub issues to import",
+                        "name": "issues",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubIssueImportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubIssueImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/jira": {
+            "get": {
+                "description": "Get the Jira site, JQL and status mappings configured for a project. The API token is never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jira"
+                ],
+                "summary": "Get a project's Jira integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JiraIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create or replace a project's Jira integration. The API token is stored encrypted in the secrets store; omit it to keep the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jira"
+                ],
+                "summary": "Configure a project's Jira integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Jira integration settings",
+                        "name": "integration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.JiraIntegrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JiraIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a project's Jira integration and its stored API token. Imported tasks are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jira"
+                ],
+                "summary": "Remove a project's Jira integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/jira/import": {
+            "post": {
+                "description": "Create a task for every issue matching the integration's JQL and refresh the fields of previously imported ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jira"
+                ],
+                "summary": "Import Jira issues as tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.JiraImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/members": {
+            "get": {
+                "description": "List the members of a project and their role. Users reach only the projects they are members of.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List project members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectMemberListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/members/{username}": {
+            "put": {
+                "description": "Give a user a role in a project: owner, maintainer, reviewer or viewer. Only owners manage members, and the first member must be an owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add a project member or change their role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Role",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a user from a project. Only owners manage members, and a project with members keeps an owner.",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Remove a project member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/notification-preferences": {
+            "get": {
+                "description": "List whether each type of notification posted to the project's Slack channel is muted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "List a project's notification preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Mute or unmute types of notification posted to the project's Slack channel: TASK_CREATED, PLAN_READY, EXECUTION_FAILED or PR_MERGED. Types left out keep their setting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Mute or unmute a project's notifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Muted notification types",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateNotificationPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreferenceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/release-notes": {
+            "get": {
+                "description": "List the release notes generated for the project, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "release-notes"
+                ],
+                "summary": "List a project's release notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReleaseNotesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Queue release notes on the tasks completed, or the pull requests merged, in the date range. An AI executor groups the changes into features, fixes and improvements; poll the notes until their status is completed or failed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "release-notes"
+                ],
+                "summary": "Generate release notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Release notes request",
+                        "name": "release_notes",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateReleaseNotesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReleaseNotesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/restore": {
+            "post": {
+                "description": "Restore an archived project (undelete)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Restore an archived project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/settings": {
+            "get": {
+                "description": "Get the project's settings, created with the defaults on first access. They include the prompt template applied to every AI run on the project's tasks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project's settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the given fields of the project's settings, such as the prompt prefix and suffix and the context files quoted in every AI run on its tasks. Omitted fields are left unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update a project's settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectSettingsUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/slack": {
+            "get": {
+                "description": "Get how a project posts its notifications to Slack. The webhook URL and bot token are never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Get a project's Slack integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlackIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Post the project's task, plan, failure and merge notifications to a Slack incoming webhook, or to a channel with a bot token. Credentials are stored encrypted in the secrets store; omit both to keep the current ones. Interactive adds Approve and Reject buttons to plans ready for review, which needs the Slack app's interactivity URL set to /api/v1/webhooks/slack.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Configure a project's Slack integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slack integration settings",
+                        "name": "integration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlackIntegrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SlackIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop posting the project's notifications to Slack and remove its stored credentials. Muted notification types are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack"
+                ],
+                "summary": "Remove a project's Slack integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/spend": {
+            "get": {
+                "description": "Report the tokens and cost of the project's AI runs completed in a calendar month (UTC), per executor, against the project's monthly budget. With format=csv the executors are returned as a CSV file, and with format=pdf as a PDF summary for sharing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get the AI spend of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month as YYYY-MM, the current month by default",
+                        "name": "month",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectSpendResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/statistics": {
+            "get": {
+                "description": "Get task statistics and completion data for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/tasks": {
+            "get": {
+                "description": "Get all tasks for a specific project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List tasks by project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/tasks/bulk-archive": {
+            "post": {
+                "description": "Archive the selected tasks of the project. Selected tasks of other projects, and tasks already archived, are ignored. The response carries an undo token that unarchives the tasks with POST /api/v1/undo/{token} until expires_at.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Archive several tasks of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tasks to archive",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkTaskOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UndoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/tasks/bulk-delete": {
+            "post": {
+                "description": "Delete the selected tasks of the project. Selected tasks of other projects are ignored. The response carries an undo token that restores the deleted tasks with POST /api/v1/undo/{token} until expires_at.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete several tasks of a project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tasks to delete",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkTaskOperationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UndoOperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/tasks/done": {
+            "get": {
+                "description": "Get tasks with DONE status for a specific project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List DONE tasks by project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/verification-pipeline": {
+            "get": {
+                "description": "Get the ordered steps implementations go through before their pull request is opened. Projects without a saved pipeline get the one their lint, build, test, security scan and AI review settings describe.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project's verification pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerificationPipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the project's verification pipeline. Steps run in the given order; setup steps need a command, lint, build and test steps default to the project's commands.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update a project's verification pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pipeline steps",
+                        "name": "pipeline",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerificationPipelineUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerificationPipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the project's saved verification pipeline, going back to the one its settings describe",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Reset a project's verification pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.VerificationPipelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/webhooks": {
+            "get": {
+                "description": "List the webhooks the project's task lifecycle events are posted to. Only owners manage webhooks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List project webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectWebhookListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Post the project's task lifecycle events (task_created, status_changed, plan_ready, execution_completed, pr_merged) to a URL. Each delivery is signed with the webhook's secret in the X-AutoDevs-Signature-256 header; the secret is generated unless given and is only returned here.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add a project webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateProjectWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/workflow-presets": {
+            "get": {
+                "description": "Get the preset of every workflow type (feature, bugfix, refactor). A preset adds instructions to the planning and implementation prompts of the tasks created with its type, and can require their changes to touch a test. Types without a saved preset get the built-in one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get a project's workflow presets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowPresetListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/workflow-presets/{workflow}": {
+            "put": {
+                "description": "Replace the project's preset of a workflow type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update a project's workflow preset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "feature",
+                            "bugfix",
+                            "refactor"
+                        ],
+                        "type": "string",
+                        "description": "Workflow type",
+                        "name": "workflow",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preset",
+                        "name": "preset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowPresetUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowPresetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the project's saved preset of a workflow type, going back to the built-in one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Reset a project's workflow preset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "feature",
+                            "bugfix",
+                            "refactor"
+                        ],
+                        "type": "string",
+                        "description": "Workflow type",
+                        "name": "workflow",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowPresetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/worktrees": {
+            "get": {
+                "description": "List the worktrees of a project, largest first, with the task each belongs\nto, its age and its size on disk, so operators can pick which to clean up.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "List project worktrees with disk usage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectWorktreesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/worktrees/cleanup": {
+            "post": {
+                "description": "Remove the worktrees of the given tasks of a project to reclaim disk space.\nActive worktrees are only removed with force. Each task is reported\nseparately; one failure does not stop the rest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Clean up project worktrees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tasks whose worktrees to remove",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CleanupProjectWorktreesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CleanupProjectWorktreesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/release-notes/{id}": {
+            "get": {
+                "description": "Get release notes with their status and, once generated, their Markdown content",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "release-notes"
+                ],
+                "summary": "Get release notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Release notes ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReleaseNotesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/release-notes/{id}/download": {
+            "get": {
+                "description": "Download generated release notes as a Markdown file",
+                "produces": [
+                    "text/markdown"
+                ],
+                "tags": [
+                    "release-notes"
+                ],
+                "summary": "Download release notes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Release notes ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/search": {
+            "get": {
+                "description": "Search the names and descriptions of projects, the titles and descriptions of tasks, the content of plans and the titles and bodies of pull requests, for a command palette. Results are tagged with their type and ranked, best match first. A task key such as AD-42 or #42 also finds the task, its plans and its pull requests. Archived projects and tasks are left out.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Quick search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Maximum number of results",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks": {
+            "get": {
+                "description": "Get a list of tasks with optional filtering by status, project, or search term",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List tasks with filtering",
+                "parameters": [
+                    {
+                        "enum": [
+                            "TODO",
+                            "PLANNING",
+                            "PLAN_REVIEWING",
+                            "IMPLEMENTING",
+                            "CODE_REVIEWING",
+                            "DONE",
+                            "CANCELLED"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by project ID",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search in title and description",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new task with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task creation data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}": {
+            "get": {
+                "description": "Get a single task by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a task with the provided details. Omitted fields are left unchanged; list fields in clear_fields to reset them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task update data",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a task by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only the provided fields of a task. Optional fields (description, assigned_to, due_date, estimated_hours, actual_hours, tags, epic_id) are cleared by listing them in clear_fields or, for description, by sending an empty string.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Partially update a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update or clear",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/approve-plan": {
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Approve the plan for a task and enqueue implementation job. The plan of a high-risk task needs step-up approval: approvals made with two different API keys, or one with a TOTP code. Until it has it, approvals are recorded and answered with 202. With scheduled_at, the implementation job is enqueued to start then and the task stays in PLAN_REVIEWING until it does.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Approve plan and start implementation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Approve plan request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApprovePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Approval recorded, another is needed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/attachments": {
+            "get": {
+                "description": "List the files attached to the task, oldest first, each with a freshly signed download URL",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List a task's attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskAttachmentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Upload a file as multipart form data. Its size and media type, sniffed from the content, must be within the configured limits. The uploader is the owner of the API key the request carries, if any.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Attach a file to a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskAttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/changes": {
+            "get": {
+                "description": "Get the task's changes against its base branch, parsed into files, hunks and lines with their stats. They are read from the task's worktree or, with source=pr, from its pull request on GitHub; without a source, from the worktree while it is on disk and the pull request otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a task's changes file by file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "worktree",
+                            "pr"
+                        ],
+                        "type": "string",
+                        "description": "Where to read the changes from",
+                        "name": "source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/commits": {
+            "get": {
+                "description": "List the pushed commits whose messages reference the task, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List a task's commits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskCommitListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/comparison": {
+            "get": {
+                "description": "Get both variants of the task's latest executor comparison, with each completed variant's diff against the base branch",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get the latest executor comparison of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExecutorComparisonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Run the task with each of two executors side by side, each in a worktree and on a branch of its own, from the same base branch. The task must be in TODO or PLAN_REVIEWING status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Compare two executors on a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Executors to compare",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartComparisonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/comparison/select": {
+            "post": {
+                "description": "Keep the variant's worktree and branch as the task's, remove the other variant, and carry the winning changes through verification to a pull request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Pick the winner of an executor comparison",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Winning variant",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SelectComparisonWinnerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/diff": {
+            "get": {
+                "description": "Get the git diff between the base branch HEAD and task branch HEAD",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get git diff for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Git diff output",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/dry-run": {
+            "post": {
+                "description": "Run the executor on the task in a throwaway worktree from its base branch and attach the diff it produced to the task as dry-run-\u003cexecution\u003e.diff. Nothing is committed or pushed, and the task keeps its status and worktree. The task must be in TODO or PLAN_REVIEWING status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Preview a task's implementation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Executor to run",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartDryRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/executions": {
+            "get": {
+                "description": "Get all executions for a specific task with optional filtering",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "executions"
+                ],
+                "summary": "Get all executions for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "running",
+                            "paused",
+                            "completed",
+                            "failed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "\"started_at\"",
+                        "description": "Order by field",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "\"desc\"",
+                        "description": "Order direction",
+                        "name": "order_dir",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExecutionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/full": {
+            "get": {
+                "description": "Get the task with its latest plan, a summary of its latest execution with its log count, its pull request and its comments, oldest first, in one response",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a task with everything its detail view shows",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskFullResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/git-operations": {
+            "get": {
+                "description": "List the audit trail of the git operations done for the task (worktree create/delete, commit, push, branch delete), oldest first, with who asked for them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List a task's git operations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitOperationListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/media": {
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Upload an image pasted into the task's description or comments, as multipart form data. It is kept as an attachment of the task and served at a lasting URL; the Markdown returned embeds it. Images referenced by a task are passed to the AI executor as context files.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Upload an image for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskMediaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/open-with-cursor": {
+            "post": {
+                "description": "Open the task's worktree path with Cursor editor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Open task workspace with Cursor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/plan/reject": {
+            "post": {
+                "description": "Reject the plan of a task in PLAN_REVIEWING, keeping the reviewer's feedback on it, and send the task back to PLANNING. The executor writes a new plan given the rejected plan and the feedback; each planning run counts as one more of the task's planning iterations, numbered in its status history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Reject a plan with feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Feedback and executor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectPlanWithFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/plans": {
+            "get": {
+                "description": "Get all plans for a specific task, sorted by created_at descending",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get plans for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskPlansResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Weak entity tag of the response"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/plans/{planId}": {
+            "put": {
+                "description": "Replace the content of a task's plan, steps included, while the task is in PLAN_REVIEWING. Each edit is saved as a new version of the plan, and approving the plan implements the edited content. An edit touching the project's high-risk paths marks the task high-risk.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "plans"
+                ],
+                "summary": "Edit a plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plan ID",
+                        "name": "planId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Plan update data",
+                        "name": "plan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PlanUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/plans/{planId}/versions": {
+            "get": {
+                "description": "List the versions of a task's plan, oldest first: the generated plan, then one per edit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "plans"
+                ],
+                "summary": "List a plan's versions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plan ID",
+                        "name": "planId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PlanVersionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/pull-request": {
+            "get": {
+                "description": "Get the pull request linked to the task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get pull request for task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.PullRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new pull request for the task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create pull request for task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.PullRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/reject-plan": {
+            "post": {
+                "description": "Send a task in PLAN_REVIEWING back to TODO, recording the reviewer and reason in its status history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Reject a plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reviewer and reason, both optional",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RejectPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/request-changes": {
+            "post": {
+                "description": "Send a task in CODE_REVIEWING back to IMPLEMENTING with the reviewer's feedback, also saved as a comment. The executor makes the changes in the task's worktree, given the feedback and the changes made so far, and pushes them to the pull request in a commit of their own.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Request changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Feedback and executor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RequestChangesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/scheduled-jobs": {
+            "get": {
+                "description": "List the planning and implementation jobs of the task waiting for their scheduled start time, soonest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List a task's scheduled jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ScheduledJobResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/scheduled-jobs/{job_id}": {
+            "delete": {
+                "description": "Cancel a planning or implementation job that has not started yet. The task keeps its status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Cancel a task's scheduled job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/start-implementing-direct": {
+            "post": {
+                "description": "Move a TODO task straight to IMPLEMENTING and enqueue the implementation job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Start implementation without planning",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Implementation options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartImplementingDirectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/start-planning": {
+            "post": {
+                "description": "Start the planning phase for a task by selecting a branch and initiating background processing. With scheduled_at, the planning job is enqueued to start then and the task stays in TODO until it does.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Start planning for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Start planning request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StartPlanningResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too much planning work in progress; details carry the estimated wait",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/status-history": {
+            "get": {
+                "description": "List the status changes of a task, oldest first. Each change to PLANNING has its planning_iteration, from 1: a plan rejected with feedback is planned again in the next iteration.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a task's status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TaskStatusHistoryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree": {
+            "get": {
+                "description": "Get the branch, uncommitted file count, commits ahead of and behind the base branch, and last commit of the task's worktree",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get the state of a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskWorktreeStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree/files": {
+            "get": {
+                "description": "List a directory of the task's worktree, directories first. Paths are relative to the worktree root and may not leave it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List files in a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Directory to list, relative to the worktree root (default the root)",
+                        "name": "path",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeFilesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree/files/content": {
+            "get": {
+                "description": "Get the content of a file in the task's worktree. Binary files come without content, and files over 1 MiB are truncated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get a file from a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path, relative to the worktree root",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeFileContentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree/recreate": {
+            "post": {
+                "description": "Check the task branch out again at the task's worktree path after the directory was removed by cleanup or by hand. When the branch is gone too it is created again from the base branch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Recreate a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree/snapshots": {
+            "get": {
+                "description": "List the snapshots taken of the task's worktree before each implementation run and auto-fix attempt, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List snapshots of a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.WorktreeSnapshotResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/worktree/snapshots/{snapshot_id}/restore": {
+            "post": {
+                "description": "Revert the task's worktree to the snapshot taken before a run. Commits and changes made since are discarded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Restore a snapshot of a task's worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID",
+                        "name": "snapshot_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/undo/{token}": {
+            "post": {
+                "description": "Restore the tasks of a bulk delete or archive with the undo token it returned. A token works once, and only within the undo window after the operation (UNDO_WINDOW seconds, 300 by default).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Undo a bulk delete or archive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Undo token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UndoOperationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "get": {
+                "description": "Get the profiles of the users named, in the order asked for and once each, for rendering assignees, creators, approvers and comment authors. Users who have not saved a profile get a default one showing their username.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the profiles of users",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Usernames, repeated for each user",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{username}": {
+            "get": {
+                "description": "Get the profile of a user, or the default one when they have not saved one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a user's profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Change the display name or timezone of a user. Users change their own profile, with their API key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user's profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{username}/avatar": {
+            "get": {
+                "description": "Serve the user's avatar image. Its URL, as returned in the profile, needs no API key and changes with the avatar.",
+                "produces": [
+                    "image/png",
+                    "image/jpeg",
+                    "image/gif",
+                    "image/webp"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get a user's avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Upload a PNG, JPEG, GIF or WebP image of at most 2 MiB as multipart form data, replacing the user's avatar. Users change their own avatar, with their API key.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Upload a user's avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Remove the user's avatar. Users change their own avatar, with their API key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Remove a user's avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhook-deliveries/{id}/redeliver": {
+            "post": {
+                "description": "Post the payload of a delivery again, as a new delivery retried like any other.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Redeliver a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/github": {
+            "post": {
+                "description": "Link pushed commits to the tasks their messages reference (AD-42 or a task ID prefix of at least 8 characters). Requests must be signed with the configured webhook secret. pull_request events and completed check_suite events queue a sync of the tracked PRs they concern, answering a PullRequestSyncResponse; repositories sending them are no longer polled while they keep doing so. Pushes and PR events older than GITHUB_WEBHOOK_MAX_AGE and deliveries already processed are rejected. Other events are acknowledged and ignored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Receive a GitHub webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "GitHub event name",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HMAC-SHA256 signature of the body",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unique ID of the delivery",
+                        "name": "X-GitHub-Delivery",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Push payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GitHubPushPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommitLinkResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommitLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhooks/slack": {
+            "post": {
+                "description": "Interactivity URL of the Slack app. Approves or rejects the plan whose Approve or Reject button was clicked, as the Slack user, and replaces the buttons of the message with the outcome. Requests must be signed with SLACK_SIGNING_SECRET and be at most 5 minutes old. Other actions, such as Open task, are acknowledged and ignored.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Receive a Slack button click",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "v0 HMAC-SHA256 signature of the request",
+                        "name": "X-Slack-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unix time the request was sent",
+                        "name": "X-Slack-Request-Timestamp",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "block_actions payload",
+                        "name": "payload",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees": {
+            "post": {
+                "description": "Enqueue creation of a new Git worktree for a specific task. The\nworktree is created asynchronously in a background job to avoid\nrequest timeouts; the response returns a record with \"creating\"\nstatus that transitions to \"active\" once the job finishes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Create worktree for task",
+                "parameters": [
+                    {
+                        "description": "Create worktree request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWorktreeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/cleanup": {
+            "post": {
+                "description": "Clean up a Git worktree for a specific task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Cleanup worktree for task",
+                "parameters": [
+                    {
+                        "description": "Cleanup worktree request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CleanupWorktreeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/project/{projectId}": {
+            "get": {
+                "description": "Get all worktrees for a specific project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get worktrees by project ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 for the whole list (v2 defaults to 20)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/project/{projectId}/active-count": {
+            "get": {
+                "description": "Get the count of active worktrees for a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get active worktrees count",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeCountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/project/{projectId}/statistics": {
+            "get": {
+                "description": "Get worktree statistics for a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get worktree statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeStatisticsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/task/{taskId}": {
+            "get": {
+                "description": "Get worktree information for a specific task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get worktree by task ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/branch": {
+            "get": {
+                "description": "Get branch information for a worktree",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get branch info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BranchInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/health": {
+            "get": {
+                "description": "Get health information for a worktree",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Get worktree health",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeHealthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/initialize": {
+            "post": {
+                "description": "Initialize a worktree with basic configuration",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Initialize worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/recover": {
+            "post": {
+                "description": "Attempt to recover a worktree that is in error status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Recover failed worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/status": {
+            "put": {
+                "description": "Update the status of a worktree",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Update worktree status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update status request",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWorktreeStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/worktrees/{worktreeId}/validate": {
+            "get": {
+                "description": "Validate the health and integrity of a worktree",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "worktrees"
+                ],
+                "summary": "Validate worktree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Worktree ID",
+                        "name": "worktreeId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorktreeValidationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "post": {
+                "description": "Run a GraphQL query or mutation over projects, tasks and their plans, executions and pull requests. Execution errors are reported in the errors field with status 200.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "Execute a GraphQL operation",
+                "parameters": [
+                    {
+                        "description": "GraphQL request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/connect": {
+            "get": {
+                "description": "Upgrade the HTTP connection to a WebSocket (Centrifuge protocol) for real-time task, project and execution events",
+                "tags": [
+                    "websocket"
+                ],
+                "summary": "Open a WebSocket connection",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIKeyCreateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "CI pipeline"
+                }
+            }
+        },
+        "dto.APIKeyCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "adk_3f9a1c2e..."
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "CI pipeline"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "adk_3f9a1c2e"
+                }
+            }
+        },
+        "dto.APIKeyListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.APIKeyResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "CI pipeline"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "adk_3f9a1c2e"
+                }
+            }
+        },
+        "dto.ActiveTaskCounts": {
+            "type": "object",
+            "properties": {
+                "code_reviewing": {
+                    "type": "integer"
+                },
+                "implementing": {
+                    "type": "integer"
+                },
+                "plan_reviewing": {
+                    "type": "integer"
+                },
+                "planning": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.AnswerQuestionRequest": {
+            "type": "object",
+            "required": [
+                "answer"
+            ],
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "No, remove it and redirect to /sign-in"
+                },
+                "answerer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.ApprovePlanRequest": {
+            "type": "object",
+            "required": [
+                "ai_type"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "reviewer": {
+                    "description": "Reviewer is recorded in the status history as who approved the plan",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                },
+                "scheduled_at": {
+                    "description": "ScheduledAt delays implementation until then, at most 30 days\nahead; the task stays in PLAN_REVIEWING until it starts",
+                    "type": "string",
+                    "example": "2026-01-02T02:00:00Z"
+                },
+                "totp_code": {
+                    "description": "TOTPCode confirms the approval of a high-risk task's plan on its own,\ninstead of a second approver",
+                    "type": "string",
+                    "example": "123456"
+                }
+            }
+        },
+        "dto.AssigneeWorkloadResponse": {
+            "type": "object",
+            "properties": {
+                "ai_tasks": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "assignee": {
+                    "description": "Assignee is empty for unassigned tasks",
+                    "type": "string",
+                    "example": "alice"
+                },
+                "by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "estimated_hours": {
+                    "type": "number",
+                    "example": 26.5
+                },
+                "in_flight_executions": {
+                    "description": "InFlightExecutions counts the AI runs pending, running or paused, and\nAITasks the open tasks with at least one",
+                    "type": "integer",
+                    "example": 3
+                },
+                "open_tasks": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "unestimated": {
+                    "description": "Unestimated counts the open tasks without an estimate",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.AutomationAuthResponse": {
+            "type": "object",
+            "properties": {
+                "owner": {
+                    "type": "string",
+                    "example": "zapier"
+                }
+            }
+        },
+        "dto.AutomationTaskCreateRequest": {
+            "type": "object",
+            "required": [
+                "project_id",
+                "title"
+            ],
+            "properties": {
+                "assigned_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Submitted through the support form"
+                },
+                "priority": {
+                    "enum": [
+                        "LOW",
+                        "MEDIUM",
+                        "HIGH",
+                        "URGENT"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "tags": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "support,bug"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Customer reported login failure"
+                }
+            }
+        },
+        "dto.BranchInfoResponse": {
+            "type": "object",
+            "properties": {
+                "branch_info": {
+                    "$ref": "#/definitions/usecase.BranchInfo"
+                }
+            }
+        },
+        "dto.BulkTaskOperationRequest": {
+            "type": "object",
+            "required": [
+                "task_ids"
+            ],
+            "properties": {
+                "task_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"123e4567-e89b-12d3-a456-426614174000\"]"
+                    ]
+                }
+            }
+        },
+        "dto.CleanupProjectWorktreesRequest": {
+            "type": "object",
+            "required": [
+                "task_ids"
+            ],
+            "properties": {
+                "force": {
+                    "description": "Also remove worktrees that are still active",
+                    "type": "boolean"
+                },
+                "task_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CleanupProjectWorktreesResponse": {
+            "type": "object",
+            "properties": {
+                "cleaned_count": {
+                    "type": "integer"
+                },
+                "failed_count": {
+                    "type": "integer"
+                },
+                "reclaimed_bytes": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.WorktreeCleanupResult"
+                    }
+                }
+            }
+        },
+        "dto.CleanupWorktreeRequest": {
+            "type": "object",
+            "required": [
+                "project_id",
+                "task_id"
+            ],
+            "properties": {
+                "branch_name": {
+                    "description": "Optional branch name to delete",
+                    "type": "string"
+                },
+                "force": {
+                    "description": "Force cleanup even if worktree is active",
+                    "type": "boolean"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CommitLinkResponse": {
+            "type": "object",
+            "properties": {
+                "linked": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "projects": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ComparisonVariantResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "task-123e4567-add-login-a"
+                },
+                "diff": {
+                    "description": "Diff is empty until the variant's execution has completed",
+                    "type": "string"
+                },
+                "execution": {
+                    "$ref": "#/definitions/dto.ExecutionResponse"
+                },
+                "selected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "variant": {
+                    "type": "string",
+                    "example": "a"
+                },
+                "worktree_path": {
+                    "type": "string",
+                    "example": "/worktrees/project-1/variants/task-123e4567-a"
+                }
+            }
+        },
+        "dto.CreateEpicRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Replace the invoice generator"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Invoicing v2"
+                }
+            }
+        },
+        "dto.CreateProjectWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WebhookEvent"
+                    },
+                    "example": [
+                        "status_changed",
+                        "pr_merged"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "0f8fad5bd9cb469fa16570867728950e"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.acme.com/auto-devs"
+                }
+            }
+        },
+        "dto.CreateReleaseNotesRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "to"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "claude-code"
+                },
+                "from": {
+                    "description": "From and To bound when the tasks were completed or the pull requests\nmerged",
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "source": {
+                    "description": "Source is what the notes are written from, the completed tasks by\ndefault",
+                    "type": "string",
+                    "enum": [
+                        "tasks",
+                        "pull_requests"
+                    ],
+                    "example": "tasks"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-03-31T23:59:59Z"
+                },
+                "version": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "v1.4.0"
+                }
+            }
+        },
+        "dto.CreateWorktreeRequest": {
+            "type": "object",
+            "required": [
+                "project_id",
+                "task_id",
+                "task_title"
+            ],
+            "properties": {
+                "base_branch_name": {
+                    "description": "Optional base branch override",
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Optional repository URL to clone",
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "task_title": {
+                    "type": "string"
+                },
+                "use_remote_branch": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CycleTimeAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "average_time_in_status_hours": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "cycle_time": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "lead_time": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskCycleTimeResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DailyJobMetricsResponse": {
+            "type": "object",
+            "properties": {
+                "average_duration_seconds": {
+                    "type": "number",
+                    "example": 12.4
+                },
+                "day": {
+                    "type": "string",
+                    "example": "2024-03-05T00:00:00Z"
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "failure_rate": {
+                    "type": "number",
+                    "example": 0.071
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "task:planning"
+                },
+                "max_duration_seconds": {
+                    "type": "number",
+                    "example": 95.2
+                },
+                "processed": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.DiffHunkResponse": {
+            "type": "object",
+            "properties": {
+                "header": {
+                    "type": "string",
+                    "example": "func Login(w http.ResponseWriter, r *http.Request) {"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiffLineResponse"
+                    }
+                },
+                "new_lines": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "new_start": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "old_lines": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "old_start": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "dto.DiffLineResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "\treturn nil"
+                },
+                "new_line": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "old_line": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "context",
+                        "added",
+                        "deleted",
+                        "no_newline"
+                    ],
+                    "example": "added"
+                }
+            }
+        },
+        "dto.DurationPercentilesResponse": {
+            "type": "object",
+            "properties": {
+                "average_hours": {
+                    "type": "number",
+                    "example": 30.5
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "p50_hours": {
+                    "type": "number",
+                    "example": 24
+                },
+                "p75_hours": {
+                    "type": "number",
+                    "example": 36
+                },
+                "p90_hours": {
+                    "type": "number",
+                    "example": 60
+                },
+                "p95_hours": {
+                    "type": "number",
+                    "example": 72
+                }
+            }
+        },
+        "dto.EmailTemplateListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EmailTemplateResponse"
+                    }
+                }
+            }
+        },
+        "dto.EmailTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "A plan waits for your review."
+                },
+                "custom": {
+                    "description": "Custom is false for the default templates the project did not change",
+                    "type": "boolean",
+                    "example": true
+                },
+                "subject": {
+                    "type": "string",
+                    "example": "Plan of a task ready for review"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.NotificationType"
+                        }
+                    ],
+                    "example": "PLAN_READY"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "updated_by": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.EpicListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EpicResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.EpicProgressResponse": {
+            "type": "object",
+            "properties": {
+                "actual_hours": {
+                    "type": "number",
+                    "example": 14.5
+                },
+                "cancelled_tasks": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "done_tasks": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "epic_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "estimated_hours": {
+                    "type": "number",
+                    "example": 32
+                },
+                "percent_complete": {
+                    "type": "number",
+                    "example": 42.9
+                },
+                "total_tasks": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "dto.EpicResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Replace the invoice generator"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "progress": {
+                    "$ref": "#/definitions/dto.EpicProgressResponse"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Invoicing v2"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "INVALID_REQUEST",
+                "VALIDATION_FAILED",
+                "UNAUTHORIZED",
+                "FORBIDDEN",
+                "NOT_FOUND",
+                "CONFLICT",
+                "RATE_LIMITED",
+                "INTERNAL_ERROR",
+                "SERVICE_UNAVAILABLE",
+                "INVALID_ID",
+                "PROJECT_NOT_FOUND",
+                "TASK_NOT_FOUND",
+                "PLAN_NOT_FOUND",
+                "EXECUTION_NOT_FOUND",
+                "WORKTREE_NOT_FOUND",
+                "PULL_REQUEST_NOT_FOUND",
+                "ORGANIZATION_NOT_FOUND",
+                "JIRA_NOT_CONFIGURED",
+                "GITHUB_PROJECT_NOT_FOUND",
+                "GITHUB_REPOSITORY_NOT_FOUND",
+                "SNAPSHOT_NOT_FOUND",
+                "COMPARISON_NOT_FOUND",
+                "FILE_NOT_FOUND",
+                "RELEASE_NOTES_NOT_FOUND",
+                "ATTACHMENT_NOT_FOUND",
+                "UNDO_TOKEN_NOT_FOUND",
+                "AVATAR_NOT_FOUND",
+                "EPIC_NOT_FOUND",
+                "SCHEDULED_JOB_NOT_FOUND",
+                "API_KEY_NOT_FOUND",
+                "USER_NOT_FOUND",
+                "PROJECT_MEMBER_NOT_FOUND",
+                "WEBHOOK_NOT_FOUND",
+                "WEBHOOK_DELIVERY_NOT_FOUND",
+                "SLACK_NOT_CONFIGURED",
+                "INVALID_TRANSITION",
+                "PLAN_NOT_IN_REVIEW",
+                "DUPLICATE_NAME",
+                "DUPLICATE_SLUG",
+                "SECRETS_DISABLED",
+                "WORKTREE_EXISTS",
+                "VARIANT_INCOMPLETE",
+                "RELEASE_NOTES_NOT_READY",
+                "WEBHOOK_EXPIRED",
+                "WEBHOOK_REPLAYED",
+                "STEP_UP_REQUIRED",
+                "INVALID_TOTP_CODE",
+                "TOTP_NOT_CONFIGURED",
+                "IP_NOT_ALLOWED",
+                "ATTACHMENT_TOO_LARGE",
+                "ATTACHMENT_TYPE_NOT_ALLOWED",
+                "DOWNLOAD_URL_INVALID",
+                "UNDO_EXPIRED",
+                "UNDO_ALREADY_USED",
+                "PLANNING_OVERLOADED",
+                "QUEUE_UNAVAILABLE",
+                "ALREADY_SCHEDULED",
+                "USERNAME_TAKEN",
+                "INVALID_CREDENTIALS",
+                "REGISTRATION_DISABLED",
+                "PROJECT_OWNER_REQUIRED",
+                "ORGANIZATION_REQUIRED"
+            ],
+            "x-enum-varnames": [
+                "ErrorCodeInvalidRequest",
+                "ErrorCodeValidationFailed",
+                "ErrorCodeUnauthorized",
+                "ErrorCodeForbidden",
+                "ErrorCodeNotFound",
+                "ErrorCodeConflict",
+                "ErrorCodeRateLimited",
+                "ErrorCodeInternal",
+                "ErrorCodeServiceUnavailable",
+                "ErrorCodeInvalidID",
+                "ErrorCodeProjectNotFound",
+                "ErrorCodeTaskNotFound",
+                "ErrorCodePlanNotFound",
+                "ErrorCodeExecutionNotFound",
+                "ErrorCodeWorktreeNotFound",
+                "ErrorCodePullRequestNotFound",
+                "ErrorCodeOrganizationNotFound",
+                "ErrorCodeJiraNotConfigured",
+                "ErrorCodeGitHubProjectNotFound",
+                "ErrorCodeGitHubRepoNotFound",
+                "ErrorCodeSnapshotNotFound",
+                "ErrorCodeComparisonNotFound",
+                "ErrorCodeFileNotFound",
+                "ErrorCodeReleaseNotesNotFound",
+                "ErrorCodeAttachmentNotFound",
+                "ErrorCodeUndoTokenNotFound",
+                "ErrorCodeAvatarNotFound",
+                "ErrorCodeEpicNotFound",
+                "ErrorCodeScheduledJobNotFound",
+                "ErrorCodeAPIKeyNotFound",
+                "ErrorCodeUserNotFound",
+                "ErrorCodeMemberNotFound",
+                "ErrorCodeWebhookNotFound",
+                "ErrorCodeDeliveryNotFound",
+                "ErrorCodeSlackNotConfigured",
+                "ErrorCodeInvalidTransition",
+                "ErrorCodePlanNotInReview",
+                "ErrorCodeDuplicateName",
+                "ErrorCodeDuplicateSlug",
+                "ErrorCodeSecretsDisabled",
+                "ErrorCodeWorktreeExists",
+                "ErrorCodeVariantIncomplete",
+                "ErrorCodeReleaseNotesNotReady",
+                "ErrorCodeWebhookExpired",
+                "ErrorCodeWebhookReplayed",
+                "ErrorCodeStepUpRequired",
+                "ErrorCodeInvalidTOTPCode",
+                "ErrorCodeTOTPNotConfigured",
+                "ErrorCodeIPNotAllowed",
+                "ErrorCodeAttachmentTooLarge",
+                "ErrorCodeAttachmentType",
+                "ErrorCodeDownloadURLInvalid",
+                "ErrorCodeUndoExpired",
+                "ErrorCodeUndoAlreadyUsed",
+                "ErrorCodePlanningOverloaded",
+                "ErrorCodeQueueUnavailable",
+                "ErrorCodeAlreadyScheduled",
+                "ErrorCodeUsernameTaken",
+                "ErrorCodeInvalidCredentials",
+                "ErrorCodeRegistrationDisabled",
+                "ErrorCodeOwnerRequired",
+                "ErrorCodeOrganizationRequired"
+            ]
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                },
+                "error_code": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ErrorCode"
+                        }
+                    ],
+                    "example": "INVALID_REQUEST"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "The provided data is invalid"
+                }
+            }
+        },
+        "dto.EventFeedResponse": {
+            "type": "object",
+            "properties": {
+                "cursor": {
+                    "description": "Cursor is the newest event ID seen; pass it as ` + "`" + `after` + "`" + ` on the next poll",
+                    "type": "integer",
+                    "example": 1042
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EventResponse"
+                    }
+                }
+            }
+        },
+        "dto.EventResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "data": {
+                    "description": "Data is the subject's snapshot when the event happened",
+                    "type": "object"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1042
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174001"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.EventType"
+                        }
+                    ],
+                    "example": "task.status_changed"
+                }
+            }
+        },
+        "dto.ExecutionAttemptListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutionResponse"
+                    }
+                }
+            }
+        },
+        "dto.ExecutionCreateRequest": {
+            "type": "object",
+            "required": [
+                "task_id"
+            ],
+            "properties": {
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "dto.ExecutionListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutionResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ExecutionLogListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutionLogResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ExecutionLogResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 1234
+                },
+                "encrypted": {
+                    "description": "Encrypted logs have their message and parsed content withheld",
+                    "type": "boolean",
+                    "example": false
+                },
+                "execution_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "is_error": {
+                    "type": "boolean"
+                },
+                "level": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.LogLevel"
+                        }
+                    ],
+                    "example": "info"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "log_type": {
+                    "description": "Structured fields",
+                    "type": "string",
+                    "example": "assistant"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Process started successfully"
+                },
+                "metadata": {},
+                "num_turns": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "parsed_content": {},
+                "process_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "stdout"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "tool_name": {
+                    "type": "string",
+                    "example": "read_file"
+                },
+                "tool_use_id": {
+                    "type": "string",
+                    "example": "toolu_01ABC..."
+                }
+            }
+        },
+        "dto.ExecutionResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "base_coverage": {
+                    "type": "number",
+                    "example": 79.9
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2024-01-01T01:00:00Z"
+                },
+                "coverage": {
+                    "description": "Coverage percentages after the execution and on the base branch, and\nthe difference in percentage points",
+                    "type": "number",
+                    "example": 81.4
+                },
+                "coverage_delta": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "dry_run": {
+                    "description": "DryRun executions previewed an implementation in a throwaway worktree",
+                    "type": "boolean",
+                    "example": false
+                },
+                "duration": {
+                    "type": "integer",
+                    "example": 3600000000000
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Process failed"
+                },
+                "fix_attempts": {
+                    "description": "FixAttempts is how many times the AI was asked to fix a failing build\nor test run",
+                    "type": "integer",
+                    "example": 1
+                },
+                "heartbeat_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "phase": {
+                    "description": "Phase is planning or implementation, and Attempt numbers its runs\nfrom the first one; a retry links to the failed execution it retries",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionPhase"
+                        }
+                    ],
+                    "example": "implementation"
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 0.75
+                },
+                "question": {
+                    "description": "Question is what the AI asked while the execution waits for input",
+                    "type": "string",
+                    "example": "Should the old /login endpoint keep working?"
+                },
+                "result": {
+                    "$ref": "#/definitions/entity.ExecutionResult"
+                },
+                "retry_of_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionStatus"
+                        }
+                    ],
+                    "example": "running"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "worker": {
+                    "description": "Worker runs the execution and last reported it alive at HeartbeatAt",
+                    "type": "string",
+                    "example": "impl-1@build-host"
+                }
+            }
+        },
+        "dto.ExecutionSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2024-01-01T01:00:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Process failed"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "log_count": {
+                    "type": "integer",
+                    "example": 128
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 0.75
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionStatus"
+                        }
+                    ],
+                    "example": "RUNNING"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                }
+            }
+        },
+        "dto.ExecutionUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Process failed"
+                },
+                "progress": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.5
+                },
+                "status": {
+                    "enum": [
+                        "pending",
+                        "running",
+                        "paused",
+                        "completed",
+                        "failed",
+                        "cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionStatus"
+                        }
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "dto.ExecutionWithLogsResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "base_coverage": {
+                    "type": "number",
+                    "example": 79.9
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2024-01-01T01:00:00Z"
+                },
+                "coverage": {
+                    "description": "Coverage percentages after the execution and on the base branch, and\nthe difference in percentage points",
+                    "type": "number",
+                    "example": 81.4
+                },
+                "coverage_delta": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "dry_run": {
+                    "description": "DryRun executions previewed an implementation in a throwaway worktree",
+                    "type": "boolean",
+                    "example": false
+                },
+                "duration": {
+                    "type": "integer",
+                    "example": 3600000000000
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Process failed"
+                },
+                "fix_attempts": {
+                    "description": "FixAttempts is how many times the AI was asked to fix a failing build\nor test run",
+                    "type": "integer",
+                    "example": 1
+                },
+                "heartbeat_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:30:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutionLogResponse"
+                    }
+                },
+                "phase": {
+                    "description": "Phase is planning or implementation, and Attempt numbers its runs\nfrom the first one; a retry links to the failed execution it retries",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionPhase"
+                        }
+                    ],
+                    "example": "implementation"
+                },
+                "progress": {
+                    "type": "number",
+                    "example": 0.75
+                },
+                "question": {
+                    "description": "Question is what the AI asked while the execution waits for input",
+                    "type": "string",
+                    "example": "Should the old /login endpoint keep working?"
+                },
+                "result": {
+                    "$ref": "#/definitions/entity.ExecutionResult"
+                },
+                "retry_of_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionStatus"
+                        }
+                    ],
+                    "example": "running"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "worker": {
+                    "description": "Worker runs the execution and last reported it alive at HeartbeatAt",
+                    "type": "string",
+                    "example": "impl-1@build-host"
+                }
+            }
+        },
+        "dto.ExecutorAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "executors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutorStatsResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ExecutorComparisonResponse": {
+            "type": "object",
+            "properties": {
+                "base_branch_name": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ComparisonVariantResponse"
+                    }
+                }
+            }
+        },
+        "dto.ExecutorSpendResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "cost_usd": {
+                    "type": "number",
+                    "example": 57.31
+                },
+                "executions": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "input_tokens": {
+                    "type": "integer",
+                    "example": 1250000
+                },
+                "output_tokens": {
+                    "type": "integer",
+                    "example": 86000
+                }
+            }
+        },
+        "dto.ExecutorStatsResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "average_duration_minutes": {
+                    "type": "number",
+                    "example": 12.5
+                },
+                "average_fix_attempts": {
+                    "type": "number",
+                    "example": 0.4
+                },
+                "average_runs_per_task": {
+                    "type": "number",
+                    "example": 1.6
+                },
+                "cancelled": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "closed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "completed": {
+                    "type": "integer",
+                    "example": 34
+                },
+                "cost_usd": {
+                    "type": "number",
+                    "example": 57.31
+                },
+                "executions": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "merge_rate": {
+                    "type": "number",
+                    "example": 0.9
+                },
+                "merged": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "pull_requests": {
+                    "type": "integer",
+                    "example": 22
+                },
+                "success_rate": {
+                    "type": "number",
+                    "example": 0.87
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "dto.FileDiffResponse": {
+            "type": "object",
+            "properties": {
+                "additions": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "binary": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "deletions": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "hunks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiffHunkResponse"
+                    }
+                },
+                "new_path": {
+                    "type": "string",
+                    "example": "internal/auth/login.go"
+                },
+                "old_path": {
+                    "description": "OldPath is empty for an added file and NewPath for a deleted one",
+                    "type": "string",
+                    "example": "internal/auth/login.go"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "added",
+                        "deleted",
+                        "modified",
+                        "renamed"
+                    ],
+                    "example": "modified"
+                }
+            }
+        },
+        "dto.GitBranchResponse": {
+            "type": "object",
+            "properties": {
+                "is_current": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "is_remote": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "last_commit": {
+                    "type": "string",
+                    "example": "abc123def"
+                },
+                "last_updated": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "main"
+                }
+            }
+        },
+        "dto.GitHubIssueImportRequest": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "bug",
+                        "ready"
+                    ]
+                },
+                "numbers": {
+                    "description": "Numbers select the issues to import; every matching issue when empty",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        12,
+                        15
+                    ]
+                },
+                "post_comments": {
+                    "description": "PostComments posts the plan and the pull request of the tasks to\ntheir issues",
+                    "type": "boolean",
+                    "example": true
+                },
+                "repository": {
+                    "description": "Repository is the full name of the repository; defaults to the\nproject's repository",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "acme/app"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "closed",
+                        "all"
+                    ],
+                    "example": "open"
+                }
+            }
+        },
+        "dto.GitHubIssueImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        15
+                    ]
+                },
+                "repository": {
+                    "type": "string",
+                    "example": "acme/app"
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.GitHubProjectImportRequest": {
+            "type": "object",
+            "required": [
+                "number",
+                "owner"
+            ],
+            "properties": {
+                "column_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.TaskStatus"
+                    }
+                },
+                "number": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 3
+                },
+                "owner": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "acme"
+                },
+                "status_field": {
+                    "description": "StatusField is the single-select field whose options are the board\ncolumns; defaults to \"Status\"",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Status"
+                }
+            }
+        },
+        "dto.GitHubProjectImportResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "type": "string",
+                    "example": "Roadmap"
+                },
+                "columns": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.TaskStatus"
+                    }
+                },
+                "created": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.GitHubPushPayload": {
+            "type": "object",
+            "properties": {
+                "commits": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "author": {
+                                "type": "object",
+                                "properties": {
+                                    "email": {
+                                        "type": "string"
+                                    },
+                                    "name": {
+                                        "type": "string"
+                                    }
+                                }
+                            },
+                            "id": {
+                                "type": "string"
+                            },
+                            "message": {
+                                "type": "string"
+                            },
+                            "timestamp": {
+                                "type": "string"
+                            },
+                            "url": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "ref": {
+                    "type": "string"
+                },
+                "repository": {
+                    "type": "object",
+                    "properties": {
+                        "clone_url": {
+                            "type": "string"
+                        },
+                        "git_url": {
+                            "type": "string"
+                        },
+                        "html_url": {
+                            "type": "string"
+                        },
+                        "pushed_at": {
+                            "description": "PushedAt is the Unix time of the push",
+                            "type": "integer"
+                        },
+                        "ssh_url": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "dto.GitHubTokenRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.GitHubTokenResponse": {
+            "type": "object",
+            "properties": {
+                "configured": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.GitOperationListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GitOperationResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.GitOperationResponse": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "job:task:implementation"
+                },
+                "commit_sha": {
+                    "type": "string",
+                    "example": "9fceb02d0ae598e95dc970b74767f19372d61af8"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 840
+                },
+                "error": {
+                    "type": "string"
+                },
+                "execution_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "operation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GitOperationType"
+                        }
+                    ],
+                    "example": "push"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "succeeded": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "target": {
+                    "type": "string",
+                    "example": "origin/task-42-expired-tokens"
+                },
+                "working_dir": {
+                    "type": "string",
+                    "example": "/worktrees/project-1/task-42"
+                }
+            }
+        },
+        "dto.GraphQLError": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "dto.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "query { task(id: \"123e4567-e89b-12d3-a456-426614174000\") { title plans { content } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "dto.GraphQLResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GraphQLError"
+                    }
+                }
+            }
+        },
+        "dto.IDETaskCreateRequest": {
+            "type": "object",
+            "required": [
+                "file_path",
+                "project_id",
+                "title"
+            ],
+            "properties": {
+                "end_line": {
+                    "type": "integer",
+                    "example": 58
+                },
+                "file_path": {
+                    "description": "The editor selection the task is about",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "internal/auth/token.go"
+                },
+                "language": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "go"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "This panics when the refresh token is empty"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "selection": {
+                    "type": "string",
+                    "maxLength": 3500,
+                    "example": "func refresh(token string) error {"
+                },
+                "start_line": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 42
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Handle expired tokens"
+                }
+            }
+        },
+        "dto.IDEWorktreeResponse": {
+            "type": "object",
+            "properties": {
+                "base_branch_name": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "task-123-add-login"
+                },
+                "editor_uri": {
+                    "description": "EditorURI opens the worktree in VS Code",
+                    "type": "string",
+                    "example": "vscode://file/worktrees/project-1/task-123"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "worktree_path": {
+                    "type": "string",
+                    "example": "/worktrees/project-1/task-123"
+                }
+            }
+        },
+        "dto.JiraImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.JiraIntegrationRequest": {
+            "type": "object",
+            "required": [
+                "base_url",
+                "email",
+                "jql"
+            ],
+            "properties": {
+                "api_token": {
+                    "description": "APIToken is write-only; omit it to keep the stored token",
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "ATATT3xFfGF0..."
+                },
+                "base_url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://acme.atlassian.net"
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "bot@acme.com"
+                },
+                "jql": {
+                    "type": "string",
+                    "example": "project = PROJ AND labels = auto-devs"
+                },
+                "status_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.TaskStatus"
+                    }
+                },
+                "sync_status_back": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "transition_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.JiraIntegrationResponse": {
+            "type": "object",
+            "properties": {
+                "base_url": {
+                    "type": "string",
+                    "example": "https://acme.atlassian.net"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "bot@acme.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "jql": {
+                    "type": "string",
+                    "example": "project = PROJ AND labels = auto-devs"
+                },
+                "last_imported_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/entity.TaskStatus"
+                    }
+                },
+                "sync_status_back": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "transition_mapping": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.JobMetricsResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DailyJobMetricsResponse"
+                    }
+                },
+                "from": {
+                    "description": "From is the first day covered and To the day after the last one",
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-04-01T00:00:00Z"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JobTypeMetricsResponse"
+                    }
+                }
+            }
+        },
+        "dto.JobTypeMetricsResponse": {
+            "type": "object",
+            "properties": {
+                "average_duration_seconds": {
+                    "type": "number",
+                    "example": 10.8
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "failure_rate": {
+                    "type": "number",
+                    "example": 0.029
+                },
+                "job_type": {
+                    "type": "string",
+                    "example": "task:planning"
+                },
+                "max_duration_seconds": {
+                    "type": "number",
+                    "example": 181.5
+                },
+                "processed": {
+                    "type": "integer",
+                    "example": 420
+                }
+            }
+        },
+        "dto.ListBranchesResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GitBranchResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "example": "correct-horse-battery"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2024-01-16T10:30:00Z"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "token_type": {
+                    "type": "string",
+                    "example": "Bearer"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.NotificationPreferenceListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationPreferenceResponse"
+                    }
+                }
+            }
+        },
+        "dto.NotificationPreferenceResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.NotificationChannel"
+                        }
+                    ],
+                    "example": "slack"
+                },
+                "muted": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.NotificationType"
+                        }
+                    ],
+                    "example": "PLAN_READY"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "updated_by": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.OrganizationCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "slug"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Owns the platform services"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Platform Team"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "platform-team"
+                }
+            }
+        },
+        "dto.OrganizationListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.OrganizationResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.OrganizationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Owns the platform services"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Platform Team"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "platform-team"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.OrganizationUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Owns the platform services"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Platform Team"
+                }
+            }
+        },
+        "dto.PendingPlanReviewResponse": {
+            "type": "object",
+            "properties": {
+                "submitted_at": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Add search"
+                },
+                "waiting_hours": {
+                    "type": "number",
+                    "example": 26
+                }
+            }
+        },
+        "dto.PlanResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "# Plan\n\nThis is a plan for a task"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "feedback": {
+                    "description": "Feedback is why the plan was rejected",
+                    "type": "string",
+                    "example": "Reuse the existing session store instead of adding one"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "DRAFT"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "version": {
+                    "description": "Version is the version an edit of the plan was saved as",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.PlanReviewAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "by_reviewer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReviewerLatencyResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "latency": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                },
+                "pending": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PendingPlanReviewResponse"
+                    }
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "rejected": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PlanReviewResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PlanReviewResponse": {
+            "type": "object",
+            "properties": {
+                "decided_at": {
+                    "type": "string"
+                },
+                "latency_hours": {
+                    "type": "number",
+                    "example": 5.5
+                },
+                "outcome": {
+                    "type": "string",
+                    "example": "approved"
+                },
+                "reviewer": {
+                    "type": "string",
+                    "example": "alice"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Add login page"
+                }
+            }
+        },
+        "dto.PlanUpdateRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Implement user authentication"
+                },
+                "edited_by": {
+                    "description": "EditedBy is recorded on the plan version the edit creates",
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.PlanVersionListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PlanVersionResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.PlanVersionResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "# Plan\n\nThis is a plan for a task"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "alice"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.ProjectActivityListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectActivityResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ProjectActivityResponse": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "alice"
+                },
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "execution_id": {
+                    "type": "string"
+                },
+                "execution_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionStatus"
+                        }
+                    ],
+                    "example": "completed"
+                },
+                "from_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "TODO"
+                },
+                "occurred_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "plan_id": {
+                    "type": "string"
+                },
+                "pull_request_number": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "pull_request_url": {
+                    "type": "string",
+                    "example": "https://github.com/acme/billing/pull/42"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "task_title": {
+                    "type": "string",
+                    "example": "Fix invoice rounding"
+                },
+                "to_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "PLANNING"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "task_created",
+                        "status_changed",
+                        "plan_approved",
+                        "execution_started",
+                        "execution_finished",
+                        "pr_opened",
+                        "pr_merged",
+                        "pr_closed"
+                    ],
+                    "example": "status_changed"
+                }
+            }
+        },
+        "dto.ProjectCreateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "worktree_base_path"
+            ],
+            "properties": {
+                "ai_review_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "allowed_commands": {
+                    "description": "Shell commands AI executions may run, any when empty, and commands\nthey may never run, matched by prefix",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go test",
+                        "make"
+                    ]
+                },
+                "auto_commit_lint_fixes": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "auto_fix_attempts": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 0,
+                    "example": 2
+                },
+                "budget_alert_thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        50,
+                        80,
+                        100
+                    ]
+                },
+                "build_command": {
+                    "type": "string",
+                    "example": "npm run build"
+                },
+                "coverage_command": {
+                    "type": "string",
+                    "example": "npx jest --coverage --coverageReporters=text-summary"
+                },
+                "denied_commands": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "npm publish",
+                        "curl",
+                        "rm -rf /"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Project description"
+                },
+                "encrypt_execution_logs": {
+                    "description": "Encrypt the message bodies of execution logs at rest, needs\nSECRETS_ENCRYPTION_KEY",
+                    "type": "boolean",
+                    "example": false
+                },
+                "export_patches": {
+                    "description": "No remote git provider: attach the changes of tasks to them as a\npatch and a git bundle instead of opening pull requests",
+                    "type": "boolean",
+                    "example": false
+                },
+                "git_provider": {
+                    "description": "Provider hosting the repository, where pull requests (merge requests\non GitLab) are opened; detected from the repository URL when empty",
+                    "type": "string",
+                    "enum": [
+                        "github",
+                        "gitlab"
+                    ],
+                    "example": "gitlab"
+                },
+                "high_risk_paths": {
+                    "description": "Path fragments making a task high-risk when its plan touches a\nmatching path, the defaults when empty",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "auth",
+                        "payments/",
+                        "migrations/"
+                    ]
+                },
+                "init_workspace_script": {
+                    "type": "string",
+                    "example": "npm install \u0026\u0026 npm run build"
+                },
+                "lint_command": {
+                    "type": "string",
+                    "example": "npx prettier --write . \u0026\u0026 npx eslint ."
+                },
+                "locale": {
+                    "description": "Language of the project's notifications and generated pull request\nand commit texts, en when empty",
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "vi"
+                },
+                "max_concurrent_executions": {
+                    "description": "Planning and implementation executions of the project running at\nonce across the workers, 0 for no limit",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "monthly_budget_usd": {
+                    "description": "Monthly AI spend budget in USD, 0 for none, and the percentages of it\nthat raise an alert (80 and 100 when empty)",
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 200
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "My Project"
+                },
+                "post_review_comments": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "preview_command": {
+                    "type": "string",
+                    "example": "npm run dev -- --port $PORT"
+                },
+                "preview_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "security_scanner": {
+                    "type": "string",
+                    "enum": [
+                        "gosec",
+                        "semgrep"
+                    ],
+                    "example": "gosec"
+                },
+                "test_command": {
+                    "type": "string",
+                    "example": "npm test"
+                },
+                "test_failure_policy": {
+                    "type": "string",
+                    "enum": [
+                        "block",
+                        "flag"
+                    ],
+                    "example": "block"
+                },
+                "worktree_base_path": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "/tmp/projects/repo"
+                }
+            }
+        },
+        "dto.ProjectListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ProjectMemberListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectMemberResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ProjectMemberRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "maintainer",
+                        "reviewer",
+                        "viewer"
+                    ],
+                    "example": "maintainer"
+                }
+            }
+        },
+        "dto.ProjectMemberResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "maintainer"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.ProjectResponse": {
+            "type": "object",
+            "properties": {
+                "active_task_counts": {
+                    "$ref": "#/definitions/dto.ActiveTaskCounts"
+                },
+                "ai_review_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "allowed_commands": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go test",
+                        "make"
+                    ]
+                },
+                "auto_commit_lint_fixes": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "auto_fix_attempts": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "budget_alert_thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        80,
+                        100
+                    ]
+                },
+                "build_command": {
+                    "type": "string",
+                    "example": "npm run build"
+                },
+                "coverage_command": {
+                    "type": "string",
+                    "example": "npx jest --coverage --coverageReporters=text-summary"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "denied_commands": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "npm publish",
+                        "curl",
+                        "rm -rf /"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Project description"
+                },
+                "encrypt_execution_logs": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "export_patches": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "git_provider": {
+                    "type": "string",
+                    "example": "gitlab"
+                },
+                "high_risk_paths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "auth",
+                        "login",
+                        "password",
+                        "payment",
+                        "billing",
+                        "checkout"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "init_workspace_script": {
+                    "type": "string",
+                    "example": "npm install \u0026\u0026 npm run build"
+                },
+                "lint_command": {
+                    "type": "string",
+                    "example": "npx prettier --write . \u0026\u0026 npx eslint ."
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "max_concurrent_executions": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "monthly_budget_usd": {
+                    "type": "number",
+                    "example": 200
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My Project"
+                },
+                "organization_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "post_review_comments": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "preview_command": {
+                    "type": "string",
+                    "example": "npm run dev -- --port $PORT"
+                },
+                "preview_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "repository_url": {
+                    "type": "string",
+                    "example": "https://github.com/user/repo.git"
+                },
+                "security_scanner": {
+                    "type": "string",
+                    "example": "gosec"
+                },
+                "test_command": {
+                    "type": "string",
+                    "example": "npm test"
+                },
+                "test_failure_policy": {
+                    "type": "string",
+                    "example": "block"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "worktree_base_path": {
+                    "type": "string",
+                    "example": "/tmp/projects/repo"
+                }
+            }
+        },
+        "dto.ProjectSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "auto_archive_days": {
+                    "type": "integer"
+                },
+                "context_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "email_notifications": {
+                    "type": "boolean"
+                },
+                "git_auto_sync": {
+                    "type": "boolean"
+                },
+                "git_branch": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notifications_enabled": {
+                    "type": "boolean"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "prompt_prefix": {
+                    "type": "string"
+                },
+                "prompt_suffix": {
+                    "type": "string"
+                },
+                "slack_webhook_url": {
+                    "type": "string"
+                },
+                "task_prefix": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProjectSettingsUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "auto_archive_days": {
+                    "type": "integer"
+                },
+                "context_files": {
+                    "description": "ContextFiles are repository paths of files quoted in those prompts;\nleft unchanged when omitted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "docs/ARCHITECTURE.md",
+                        "CONTRIBUTING.md"
+                    ]
+                },
+                "email_notifications": {
+                    "type": "boolean"
+                },
+                "git_auto_sync": {
+                    "type": "boolean"
+                },
+                "git_branch": {
+                    "type": "string"
+                },
+                "notifications_enabled": {
+                    "type": "boolean"
+                },
+                "prompt_prefix": {
+                    "description": "PromptPrefix and PromptSuffix are added before and after the prompt of\nevery AI run on the project's tasks",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Follow the conventions in docs/CONVENTIONS.md."
+                },
+                "prompt_suffix": {
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Never edit generated files."
+                },
+                "slack_webhook_url": {
+                    "type": "string"
+                },
+                "task_prefix": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProjectSpendResponse": {
+            "type": "object",
+            "properties": {
+                "alert_thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        80,
+                        100
+                    ]
+                },
+                "budget_usd": {
+                    "type": "number",
+                    "example": 200
+                },
+                "budget_used_percent": {
+                    "type": "number",
+                    "example": 40.5
+                },
+                "by_executor": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ExecutorSpendResponse"
+                    }
+                },
+                "cost_usd": {
+                    "type": "number",
+                    "example": 81.07
+                },
+                "executions": {
+                    "type": "integer",
+                    "example": 58
+                },
+                "input_tokens": {
+                    "type": "integer",
+                    "example": 1730000
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2024-03"
+                },
+                "output_tokens": {
+                    "type": "integer",
+                    "example": 112000
+                },
+                "project_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProjectStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "completion_percent": {
+                    "type": "number"
+                },
+                "last_activity_at": {
+                    "type": "string"
+                },
+                "recent_activity": {
+                    "type": "integer"
+                },
+                "tasks_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "total_tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ProjectUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "ai_review_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "allowed_commands": {
+                    "description": "An empty list clears the allowed or denied commands",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "go test",
+                        "make"
+                    ]
+                },
+                "auto_commit_lint_fixes": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "auto_fix_attempts": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 0,
+                    "example": 2
+                },
+                "budget_alert_thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        50,
+                        80,
+                        100
+                    ]
+                },
+                "build_command": {
+                    "type": "string",
+                    "example": "npm run build"
+                },
+                "coverage_command": {
+                    "type": "string",
+                    "example": "npx jest --coverage --coverageReporters=text-summary"
+                },
+                "denied_commands": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "npm publish",
+                        "curl",
+                        "rm -rf /"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Updated description"
+                },
+                "encrypt_execution_logs": {
+                    "description": "Logs already written stay as they are when this is changed",
+                    "type": "boolean",
+                    "example": true
+                },
+                "export_patches": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "git_provider": {
+                    "description": "\"auto\" detects the provider from the repository URL again",
+                    "type": "string",
+                    "enum": [
+                        "github",
+                        "gitlab",
+                        "auto"
+                    ],
+                    "example": "gitlab"
+                },
+                "high_risk_paths": {
+                    "description": "An empty list of high-risk paths restores the defaults",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "auth",
+                        "payments/",
+                        "migrations/"
+                    ]
+                },
+                "init_workspace_script": {
+                    "type": "string",
+                    "example": "npm install \u0026\u0026 npm run build"
+                },
+                "lint_command": {
+                    "type": "string",
+                    "example": "npx prettier --write . \u0026\u0026 npx eslint ."
+                },
+                "locale": {
+                    "type": "string",
+                    "enum": [
+                        "en",
+                        "vi"
+                    ],
+                    "example": "vi"
+                },
+                "max_concurrent_executions": {
+                    "description": "Tasks already waiting for a slot are let through as slots free up",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                },
+                "monthly_budget_usd": {
+                    "description": "An empty list of thresholds restores the defaults",
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 200
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Updated Project Name"
+                },
+                "post_review_comments": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "preview_command": {
+                    "type": "string",
+                    "example": "npm run dev -- --port $PORT"
+                },
+                "preview_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "repository_url": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://github.com/user/repo.git"
+                },
+                "security_scanner": {
+                    "type": "string",
+                    "enum": [
+                        "gosec",
+                        "semgrep",
+                        "none"
+                    ],
+                    "example": "gosec"
+                },
+                "test_command": {
+                    "type": "string",
+                    "example": "npm test"
+                },
+                "test_failure_policy": {
+                    "type": "string",
+                    "enum": [
+                        "block",
+                        "flag"
+                    ],
+                    "example": "block"
+                },
+                "worktree_base_path": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "/tmp/projects/repo"
+                }
+            }
+        },
+        "dto.ProjectWebhookListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectWebhookResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ProjectWebhookRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "description": "Events the webhook is sent, every event when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WebhookEvent"
+                    },
+                    "example": [
+                        "status_changed",
+                        "pr_merged"
+                    ]
+                },
+                "secret": {
+                    "description": "Secret is write-only; omit it to generate one for a new webhook and to\nkeep the current one otherwise",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16,
+                    "example": "0f8fad5bd9cb469fa16570867728950e"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://hooks.acme.com/auto-devs"
+                }
+            }
+        },
+        "dto.ProjectWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WebhookEvent"
+                    },
+                    "example": [
+                        "status_changed",
+                        "pr_merged"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://hooks.acme.com/auto-devs"
+                }
+            }
+        },
+        "dto.ProjectWorktreeResponse": {
+            "type": "object",
+            "properties": {
+                "age_seconds": {
+                    "type": "integer",
+                    "example": 86400
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "task-123-add-login-page"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "disk_usage": {
+                    "description": "in bytes",
+                    "type": "integer",
+                    "example": 52428800
+                },
+                "id": {
+                    "type": "string"
+                },
+                "on_disk": {
+                    "description": "OnDisk is false when the worktree directory no longer exists",
+                    "type": "boolean"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WorktreeStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "task_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "DONE"
+                },
+                "task_title": {
+                    "type": "string",
+                    "example": "Add login page"
+                },
+                "worktree_path": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ProjectWorktreesResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectWorktreeResponse"
+                    }
+                },
+                "total_disk_usage": {
+                    "description": "in bytes",
+                    "type": "integer",
+                    "example": 104857600
+                }
+            }
+        },
+        "dto.PullRequestAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "closed_without_merge": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "from": {
+                    "type": "string"
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "merge_rate": {
+                    "type": "number",
+                    "example": 0.86
+                },
+                "merged": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "open": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "opened": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "period": {
+                    "type": "string",
+                    "example": "week"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PullRequestPeriodResponse"
+                    }
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "time_to_merge": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PullRequestPeriodResponse": {
+            "type": "object",
+            "properties": {
+                "closed_without_merge": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "merge_rate": {
+                    "type": "number",
+                    "example": 0.86
+                },
+                "merged": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "open": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "opened": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-04T00:00:00Z"
+                },
+                "time_to_merge": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                }
+            }
+        },
+        "dto.PullRequestSyncResponse": {
+            "type": "object",
+            "properties": {
+                "queued": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice@example.com"
+                },
+                "password": {
+                    "description": "Password is 8 to 72 bytes long",
+                    "type": "string",
+                    "example": "correct-horse-battery"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.RejectPlanRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Misses the migration for existing users"
+                },
+                "reviewer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.RejectPlanWithFeedbackRequest": {
+            "type": "object",
+            "required": [
+                "ai_type",
+                "feedback"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "feedback": {
+                    "description": "Feedback is what the new plan has to address",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Reuse the existing session store instead of adding one"
+                },
+                "reviewer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.ReleaseNotesListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReleaseNotesResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.ReleaseNotesResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "changes": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string",
+                    "example": "# Release notes for v1.4.0"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ReleaseNotesSource"
+                        }
+                    ],
+                    "example": "tasks"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ReleaseNotesStatus"
+                        }
+                    ],
+                    "example": "completed"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-03-31T23:59:59Z"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "v1.4.0"
+                }
+            }
+        },
+        "dto.RequestChangesRequest": {
+            "type": "object",
+            "required": [
+                "ai_type",
+                "feedback"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "feedback": {
+                    "description": "Feedback is what the executor is asked to change",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Validate the email before saving the user"
+                },
+                "reviewer": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.ReviewCommentListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReviewCommentResponse"
+                    }
+                }
+            }
+        },
+        "dto.ReviewCommentResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "The error returned by Close is ignored"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "execution_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "file_path": {
+                    "type": "string",
+                    "example": "internal/auth/login.go"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "posted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "severity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ReviewSeverity"
+                        }
+                    ],
+                    "example": "warning"
+                }
+            }
+        },
+        "dto.ReviewerLatencyResponse": {
+            "type": "object",
+            "properties": {
+                "approved": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "latency": {
+                    "$ref": "#/definitions/dto.DurationPercentilesResponse"
+                },
+                "rejected": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "reviewer": {
+                    "type": "string",
+                    "example": "alice"
+                }
+            }
+        },
+        "dto.ScheduledJobResponse": {
+            "type": "object",
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5f0c3d6e-6a1b-4c2d-9e8f-1a2b3c4d5e6f"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "planning",
+                        "implementation"
+                    ],
+                    "example": "planning"
+                },
+                "scheduled_at": {
+                    "type": "string",
+                    "example": "2026-01-02T02:00:00Z"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "dto.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SearchResultResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "query": {
+                    "type": "string",
+                    "example": "invoice"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.SearchResultResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "pr_number": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "project_name": {
+                    "type": "string",
+                    "example": "Billing Service"
+                },
+                "score": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "snippet": {
+                    "type": "string",
+                    "example": "…rounds each invoice line before summing…"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "IMPLEMENTING"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "task_key": {
+                    "type": "string",
+                    "example": "AD-42"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Fix invoice rounding"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "project",
+                        "task",
+                        "plan",
+                        "pull_request"
+                    ],
+                    "example": "task"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.SecurityFindingListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SecurityFindingResponse"
+                    }
+                }
+            }
+        },
+        "dto.SecurityFindingResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "execution_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "file_path": {
+                    "type": "string",
+                    "example": "internal/auth/login.go"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Potential hardcoded credentials"
+                },
+                "rule_id": {
+                    "type": "string",
+                    "example": "G101"
+                },
+                "scanner": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SecurityScanner"
+                        }
+                    ],
+                    "example": "gosec"
+                },
+                "severity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SecuritySeverity"
+                        }
+                    ],
+                    "example": "high"
+                }
+            }
+        },
+        "dto.SelectComparisonWinnerRequest": {
+            "type": "object",
+            "required": [
+                "variant"
+            ],
+            "properties": {
+                "variant": {
+                    "type": "string",
+                    "example": "a"
+                }
+            }
+        },
+        "dto.SlackIntegrationRequest": {
+            "type": "object",
+            "properties": {
+                "bot_token": {
+                    "type": "string",
+                    "minLength": 1,
+                    "example": "xoxb-1234-5678"
+                },
+                "channel": {
+                    "description": "Channel is required with a bot token",
+                    "type": "string",
+                    "maxLength": 80,
+                    "example": "#auto-devs"
+                },
+                "interactive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "webhook_url": {
+                    "description": "WebhookURL and BotToken are write-only; give one to switch how\nnotifications are posted, or neither to keep the stored one",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://hooks.slack.com/services/T000/B000/XXXX"
+                }
+            }
+        },
+        "dto.SlackIntegrationResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string",
+                    "example": "#auto-devs"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "delivery": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SlackDelivery"
+                        }
+                    ],
+                    "example": "webhook"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "interactive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.StartComparisonRequest": {
+            "type": "object",
+            "required": [
+                "ai_types"
+            ],
+            "properties": {
+                "ai_types": {
+                    "description": "AITypes are the two executors to run the task with, as variants a and b",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "claude-code",
+                        "cursor-agent"
+                    ]
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "main"
+                }
+            }
+        },
+        "dto.StartDryRunRequest": {
+            "type": "object",
+            "required": [
+                "ai_type"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                }
+            }
+        },
+        "dto.StartImplementingDirectRequest": {
+            "type": "object",
+            "required": [
+                "ai_type",
+                "branch_name"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "use_remote_branch": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.StartPlanningRequest": {
+            "type": "object",
+            "required": [
+                "ai_type",
+                "branch_name"
+            ],
+            "properties": {
+                "ai_type": {
+                    "type": "string",
+                    "example": "claude-code"
+                },
+                "auto_implement": {
+                    "type": "boolean"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "main"
+                },
+                "scheduled_at": {
+                    "description": "ScheduledAt delays planning until then, at most 30 days ahead; the\ntask stays in TODO until it starts",
+                    "type": "string",
+                    "example": "2026-01-02T02:00:00Z"
+                },
+                "use_remote_branch": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.StartPlanningResponse": {
+            "type": "object",
+            "properties": {
+                "job_id": {
+                    "type": "string",
+                    "example": "task-123-planning-456"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Planning started successfully"
+                },
+                "scheduled_at": {
+                    "description": "ScheduledAt is when the job starts, for scheduled jobs",
+                    "type": "string",
+                    "example": "2026-01-02T02:00:00Z"
+                }
+            }
+        },
+        "dto.SuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "message": {
+                    "type": "string",
+                    "example": "Operation completed successfully"
+                }
+            }
+        },
+        "dto.TaskAttachmentListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskAttachmentResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.TaskAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "download_url": {
+                    "type": "string",
+                    "example": "http://localhost:8098/api/v1/attachments/123e4567-e89b-12d3-a456-426614174000/download?expires=1705315500\u0026signature=9b1f..."
+                },
+                "file_size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "login-error.png"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/png"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "uploaded_by": {
+                    "type": "string",
+                    "example": "alice"
+                },
+                "url_expires_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:45:00Z"
+                }
+            }
+        },
+        "dto.TaskBoardColumnResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "display_name": {
+                    "type": "string",
+                    "example": "Implementing"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "IMPLEMENTING"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskCardResponse"
+                    }
+                }
+            }
+        },
+        "dto.TaskBoardResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskBoardColumnResponse"
+                    }
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 24
+                }
+            }
+        },
+        "dto.TaskCardResponse": {
+            "type": "object",
+            "properties": {
+                "branch_name": {
+                    "type": "string",
+                    "example": "task/implement-auth"
+                },
+                "epic_id": {
+                    "type": "string"
+                },
+                "git_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskGitStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "parent_task_id": {
+                    "type": "string"
+                },
+                "priority": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "pull_request": {
+                    "type": "string",
+                    "example": "https://github.com/acme/app/pull/42"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "IMPLEMENTING"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "backend",
+                        "auth"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Implement user authentication"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.TaskChangesResponse": {
+            "type": "object",
+            "properties": {
+                "additions": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "deletions": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FileDiffResponse"
+                    }
+                },
+                "files_changed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "source": {
+                    "description": "Source is worktree or pr, empty when the task has neither",
+                    "type": "string",
+                    "example": "worktree"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "dto.TaskCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Login still fails on Safari"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "alice"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                }
+            }
+        },
+        "dto.TaskCommitListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskCommitResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "dto.TaskCommitResponse": {
+            "type": "object",
+            "properties": {
+                "author_email": {
+                    "type": "string",
+                    "example": "alice@example.com"
+                },
+                "author_name": {
+                    "type": "string",
+                    "example": "Alice"
+                },
+                "branch": {
+                    "type": "string",
+                    "example": "task-42-expired-tokens"
+                },
+                "committed_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "linked_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:31:00Z"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "AD-42 Handle expired tokens"
+                },
+                "sha": {
+                    "type": "string",
+                    "example": "9fceb02d0ae598e95dc970b74767f19372d61af8"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://github.com/acme/app/commit/9fceb02"
+                }
+            }
+        },
+        "dto.TaskCreateRequest": {
+            "type": "object",
+            "required": [
                 "project_id",
-                "task_id"
+                "title"
             ],
             "properties": {
-                "branch_name": {
-                    "description": "Optional branch name to delete",
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Add JWT-based authentication system"
+                },
+                "epic_id": {
+                    "description": "EpicID groups the task under an epic of the same project",
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "kanban_task_id": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "a1b2c3d4"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Implement user authentication"
+                },
+                "workflow_type": {
+                    "description": "WorkflowType adjusts the task's prompts and verification with the\nproject's preset of that type",
+                    "enum": [
+                        "feature",
+                        "bugfix",
+                        "refactor"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WorkflowType"
+                        }
+                    ],
+                    "example": "bugfix"
+                }
+            }
+        },
+        "dto.TaskCycleTimeResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
                     "type": "string"
                 },
-                "force": {
-                    "description": "Force cleanup even if worktree is active",
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
+                },
+                "cycle_time_hours": {
+                    "type": "number",
+                    "example": 20.5
+                },
+                "lead_time_hours": {
+                    "type": "number",
+                    "example": 48
                 },
-                "project_id": {
+                "started_at": {
                     "type": "string"
                 },
                 "task_id": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "time_in_status_hours": {
+                    "description": "TimeInStatusHours is the time spent in each status before completion",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Add login page"
                 }
             }
         },
-        "dto.CreateWorktreeRequest": {
+        "dto.TaskFullResponse": {
             "type": "object",
-            "required": [
-                "project_id",
-                "task_id",
-                "task_title"
-            ],
             "properties": {
-                "base_branch_name": {
-                    "description": "Optional base branch override",
-                    "type": "string"
-                },
-                "project_id": {
-                    "type": "string"
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskCommentResponse"
+                    }
                 },
-                "repository": {
-                    "description": "Optional repository URL to clone",
-                    "type": "string"
+                "latest_execution": {
+                    "$ref": "#/definitions/dto.ExecutionSummaryResponse"
                 },
-                "task_id": {
-                    "type": "string"
+                "latest_plan": {
+                    "$ref": "#/definitions/dto.PlanResponse"
                 },
-                "task_title": {
-                    "type": "string"
+                "pull_request": {
+                    "$ref": "#/definitions/entity.PullRequest"
                 },
-                "use_remote_branch": {
-                    "type": "boolean"
+                "task": {
+                    "$ref": "#/definitions/dto.TaskResponse"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.TaskListResponse": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskResponse"
                     }
                 },
-                "error": {
-                    "type": "string",
-                    "example": "Invalid request"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "message": {
-                    "type": "string",
-                    "example": "The provided data is invalid"
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
-        "dto.ExecutionCreateRequest": {
+        "dto.TaskMediaResponse": {
             "type": "object",
-            "required": [
-                "task_id"
-            ],
             "properties": {
+                "file_size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "login-error.png"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "markdown": {
+                    "type": "string",
+                    "example": "![login-error.png](http://localhost:8098/api/v1/media/123e4567-e89b-12d3-a456-426614174000)"
+                },
+                "mime_type": {
+                    "type": "string",
+                    "example": "image/png"
+                },
                 "task_id": {
+                    "type": "string"
+                },
+                "url": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "http://localhost:8098/api/v1/media/123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         },
-        "dto.ExecutionListResponse": {
+        "dto.TaskPlansResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ExecutionResponse"
+                        "$ref": "#/definitions/dto.PlanResponse"
                     }
                 },
-                "meta": {
-                    "$ref": "#/definitions/dto.PaginationMeta"
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
-        "dto.ExecutionLogListResponse": {
+        "dto.TaskResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "assigned_to": {
+                    "type": "string",
+                    "example": "user-123"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "example": "feature/user-auth"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Add JWT-based authentication system"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2024-02-01T17:00:00Z"
+                },
+                "epic_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "error_logs": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ExecutionLogResponse"
+                        "type": "string"
                     }
                 },
-                "meta": {
-                    "$ref": "#/definitions/dto.PaginationMeta"
+                "git_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskGitStatus"
+                        }
+                    ],
+                    "example": "none"
+                },
+                "github_issue_number": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "github_issue_repository": {
+                    "type": "string",
+                    "example": "acme/app"
+                },
+                "github_project_item_id": {
+                    "type": "string",
+                    "example": "PVTI_lADOAxk3Nc4AZ1bXzgLmH0o"
+                },
+                "high_risk": {
+                    "description": "High-risk tasks need step-up approval of their plan",
+                    "type": "boolean",
+                    "example": false
+                },
+                "high_risk_reason": {
+                    "type": "string",
+                    "example": "Plan touches internal/auth/session.go"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "jira_issue_key": {
+                    "type": "string",
+                    "example": "PROJ-123"
+                },
+                "kanban_task_id": {
+                    "type": "string",
+                    "example": "a1b2c3d4"
+                },
+                "key": {
+                    "description": "Reference commit messages with this key",
+                    "type": "string",
+                    "example": "AD-42"
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "planning_iterations": {
+                    "description": "PlanningIterations counts the planning runs, one more per plan\nrejected with feedback",
+                    "type": "integer",
+                    "example": 1
+                },
+                "preview_url": {
+                    "type": "string",
+                    "example": "http://localhost:4100"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "pull_request": {
+                    "type": "string",
+                    "example": "https://github.com/user/repo/pull/123"
+                },
+                "queue_position": {
+                    "description": "QueuePosition is the task's place in the queue of tasks waiting for\none of their project's execution slots, 1 being next",
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "TODO"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Implement user authentication"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "workflow_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WorkflowType"
+                        }
+                    ],
+                    "example": "bugfix"
+                },
+                "worktree_path": {
+                    "type": "string",
+                    "example": "/tmp/worktrees/task-123"
                 }
             }
         },
-        "dto.ExecutionLogResponse": {
+        "dto.TaskStatusHistoryResponse": {
             "type": "object",
             "properties": {
+                "changed_by": {
+                    "type": "string",
+                    "example": "user123"
+                },
                 "created_at": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "2024-01-15T10:30:00Z"
+                },
+                "from_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "TODO"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "planning_iteration": {
+                    "description": "PlanningIteration numbers the changes to PLANNING, from 1",
+                    "type": "integer",
+                    "example": 2
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Requirements changed"
+                },
+                "task_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "to_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TaskStatus"
+                        }
+                    ],
+                    "example": "PLANNING"
+                }
+            }
+        },
+        "dto.TaskUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "assigned_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "user-123"
+                },
+                "branch_name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "feature/user-auth"
+                },
+                "clear_fields": {
+                    "description": "ClearFields resets the listed fields; omitted fields are left unchanged",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "due_date",
+                        "assigned_to"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Updated description"
                 },
-                "duration_ms": {
-                    "type": "integer",
-                    "example": 1234
+                "due_date": {
+                    "type": "string",
+                    "example": "2024-02-01T17:00:00Z"
                 },
-                "execution_id": {
+                "epic_id": {
+                    "description": "EpicID moves the task under an epic of the same project",
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "id": {
+                "high_risk_reason": {
+                    "description": "HighRiskReason marks the task high-risk, so its plan needs step-up\napproval. A high-risk task stays high-risk.",
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "maxLength": 500,
+                    "example": "Changes how refunds are paid out"
                 },
-                "is_error": {
-                    "type": "boolean"
+                "pull_request": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "https://github.com/user/repo/pull/123"
                 },
-                "level": {
+                "status": {
+                    "enum": [
+                        "TODO",
+                        "PLANNING",
+                        "PLAN_REVIEWING",
+                        "IMPLEMENTING",
+                        "CODE_REVIEWING",
+                        "DONE",
+                        "CANCELLED"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/entity.LogLevel"
+                            "$ref": "#/definitions/entity.TaskStatus"
                         }
                     ],
-                    "example": "info"
-                },
-                "line": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "TODO"
                 },
-                "log_type": {
-                    "description": "Structured fields",
+                "title": {
                     "type": "string",
-                    "example": "assistant"
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Updated task title"
+                }
+            }
+        },
+        "dto.TaskWorktreeStatusResponse": {
+            "type": "object",
+            "properties": {
+                "ahead": {
+                    "description": "Ahead and Behind count commits relative to the base branch; null when\nthe base branch cannot be found",
+                    "type": "integer",
+                    "example": 2
                 },
-                "message": {
+                "base_branch_name": {
                     "type": "string",
-                    "example": "Process started successfully"
+                    "example": "main"
                 },
-                "metadata": {},
-                "num_turns": {
+                "behind": {
                     "type": "integer",
-                    "example": 5
+                    "example": 0
                 },
-                "parsed_content": {},
-                "process_id": {
+                "branch_name": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "task-123-add-login"
                 },
-                "source": {
-                    "type": "string",
-                    "example": "stdout"
+                "dirty_files": {
+                    "description": "DirtyFiles counts modified, staged and untracked files",
+                    "type": "integer",
+                    "example": 3
                 },
-                "timestamp": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                "last_commit": {
+                    "$ref": "#/definitions/dto.WorktreeCommitResponse"
                 },
-                "tool_name": {
+                "task_id": {
                     "type": "string",
-                    "example": "read_file"
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "tool_use_id": {
+                "worktree_path": {
                     "type": "string",
-                    "example": "toolu_01ABC..."
+                    "example": "/worktrees/project-1/task-123"
                 }
             }
         },
-        "dto.ExecutionResponse": {
+        "dto.UndoOperationResponse": {
             "type": "object",
             "properties": {
-                "completed_at": {
-                    "type": "string",
-                    "example": "2024-01-01T01:00:00Z"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "duration": {
+                "affected_count": {
                     "type": "integer",
-                    "example": 3600000000000
-                },
-                "error": {
-                    "type": "string",
-                    "example": "Process failed"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": 3
                 },
-                "progress": {
-                    "type": "number",
-                    "example": 0.75
-                },
-                "result": {
-                    "$ref": "#/definitions/entity.ExecutionResult"
-                },
-                "started_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                "expires_at": {
+                    "type": "string"
                 },
-                "status": {
+                "operation": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/entity.ExecutionStatus"
+                            "$ref": "#/definitions/entity.UndoOperationType"
                         }
                     ],
-                    "example": "running"
+                    "example": "bulk_delete"
                 },
-                "task_id": {
+                "project_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "updated_at": {
+                "task_ids": {
+                    "description": "TaskIDs are the tasks the operation changed; selected tasks of other\nprojects, or already archived, are left out",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "undo_token": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "undone_at": {
+                    "type": "string"
                 }
             }
         },
-        "dto.ExecutionUpdateRequest": {
+        "dto.UpdateEmailTemplateRequest": {
             "type": "object",
+            "required": [
+                "body",
+                "subject"
+            ],
             "properties": {
-                "error": {
+                "body": {
                     "type": "string",
-                    "example": "Process failed"
-                },
-                "progress": {
-                    "type": "number",
-                    "maximum": 1,
-                    "minimum": 0,
-                    "example": 0.5
+                    "example": "A plan waits for your review."
                 },
-                "status": {
-                    "enum": [
-                        "pending",
-                        "running",
-                        "paused",
-                        "completed",
-                        "failed",
-                        "cancelled"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.ExecutionStatus"
-                        }
-                    ],
-                    "example": "running"
+                "subject": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Plan of a task ready for review"
                 }
             }
         },
-        "dto.ExecutionWithLogsResponse": {
+        "dto.UpdateEpicRequest": {
             "type": "object",
             "properties": {
-                "completed_at": {
-                    "type": "string",
-                    "example": "2024-01-01T01:00:00Z"
+                "clear_due_date": {
+                    "description": "ClearDueDate removes the due date",
+                    "type": "boolean",
+                    "example": false
                 },
-                "created_at": {
+                "description": {
                     "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                    "maxLength": 5000,
+                    "example": "Replace the invoice generator"
                 },
-                "duration": {
-                    "type": "integer",
-                    "example": 3600000000000
+                "due_date": {
+                    "type": "string",
+                    "example": "2024-06-30T00:00:00Z"
                 },
-                "error": {
+                "title": {
                     "type": "string",
-                    "example": "Process failed"
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Invoicing v2"
+                }
+            }
+        },
+        "dto.UpdateNotificationPreferencesRequest": {
+            "type": "object",
+            "required": [
+                "muted"
+            ],
+            "properties": {
+                "muted": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    },
+                    "example": {
+                        "TASK_CREATED": true
+                    }
+                }
+            }
+        },
+        "dto.UpdateUserProfileRequest": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Alice Nguyen"
                 },
-                "id": {
+                "timezone": {
+                    "description": "Timezone is an IANA time zone name; an empty one resets it to UTC",
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "maxLength": 64,
+                    "example": "Asia/Ho_Chi_Minh"
+                }
+            }
+        },
+        "dto.UpdateWorktreeStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/entity.WorktreeStatus"
+                }
+            }
+        },
+        "dto.UserProfileListResponse": {
+            "type": "object",
+            "properties": {
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "logs": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ExecutionLogResponse"
+                        "$ref": "#/definitions/dto.UserProfileResponse"
                     }
                 },
-                "progress": {
-                    "type": "number",
-                    "example": 0.75
-                },
-                "result": {
-                    "$ref": "#/definitions/entity.ExecutionResult"
-                },
-                "started_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.ExecutionStatus"
-                        }
-                    ],
-                    "example": "running"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "task_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                "total": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
-        "dto.GitBranchResponse": {
+        "dto.UserProfileResponse": {
             "type": "object",
             "properties": {
-                "is_current": {
-                    "type": "boolean",
-                    "example": true
+                "avatar_url": {
+                    "type": "string",
+                    "example": "http://localhost:8098/api/v1/users/alice/avatar?v=1705315500"
                 },
-                "is_remote": {
-                    "type": "boolean",
-                    "example": false
+                "display_name": {
+                    "type": "string",
+                    "example": "Alice Nguyen"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Alice Nguyen"
                 },
-                "last_commit": {
+                "timezone": {
                     "type": "string",
-                    "example": "abc123def"
+                    "example": "Asia/Ho_Chi_Minh"
                 },
-                "last_updated": {
+                "updated_at": {
                     "type": "string",
                     "example": "2024-01-15T10:30:00Z"
                 },
-                "name": {
+                "username": {
                     "type": "string",
-                    "example": "main"
+                    "example": "alice"
                 }
             }
         },
-        "dto.ListBranchesResponse": {
+        "dto.UserResponse": {
             "type": "object",
             "properties": {
-                "branches": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.GitBranchResponse"
-                    }
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "total": {
-                    "type": "integer"
+                "email": {
+                    "type": "string",
+                    "example": "alice@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "organization_id": {
+                    "description": "OrganizationID is the organization the user's requests are scoped to",
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "alice"
                 }
             }
         },
-        "dto.PaginationMeta": {
+        "dto.VelocityResponse": {
             "type": "object",
             "properties": {
-                "page": {
-                    "type": "integer",
-                    "example": 1
+                "average_executions_per_task": {
+                    "type": "number",
+                    "example": 1.5
                 },
-                "page_size": {
+                "average_tasks_per_week": {
+                    "type": "number",
+                    "example": 3.5
+                },
+                "executions": {
                     "type": "integer",
-                    "example": 10
+                    "example": 63
                 },
-                "total": {
+                "generated_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "pull_requests_merged": {
                     "type": "integer",
-                    "example": 100
+                    "example": 38
+                },
+                "rolled_up_at": {
+                    "type": "string"
                 },
-                "total_pages": {
+                "tasks_completed": {
                     "type": "integer",
-                    "example": 10
+                    "example": 42
+                },
+                "weeks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WeeklyVelocityResponse"
+                    }
                 }
             }
         },
-        "dto.PlanResponse": {
+        "dto.VerificationPipelineResponse": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "# Plan\n\nThis is a plan for a task"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
+                "is_default": {
+                    "description": "IsDefault is set when the project has no saved pipeline and runs the\none its settings describe",
+                    "type": "boolean",
+                    "example": false
                 },
-                "id": {
+                "project_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "DRAFT"
-                },
-                "task_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VerificationPipelineStepResponse"
+                    }
                 },
                 "updated_at": {
                     "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
+                    "example": "2024-01-01T00:00:00Z"
                 }
             }
         },
-        "dto.PlanUpdateRequest": {
+        "dto.VerificationPipelineStepRequest": {
             "type": "object",
             "required": [
-                "content"
+                "step"
             ],
             "properties": {
-                "content": {
+                "command": {
                     "type": "string",
-                    "example": "Implement user authentication"
+                    "example": "npm test -- --ci"
+                },
+                "step": {
+                    "enum": [
+                        "setup",
+                        "lint",
+                        "build",
+                        "test",
+                        "security_scan",
+                        "review",
+                        "test_changes"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.VerificationStep"
+                        }
+                    ],
+                    "example": "test"
                 }
             }
         },
-        "dto.ProjectCreateRequest": {
+        "dto.VerificationPipelineStepResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "worktree_base_path"
-            ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "example": "Project description"
-                },
-                "init_workspace_script": {
-                    "type": "string",
-                    "example": "npm install \u0026\u0026 npm run build"
-                },
-                "name": {
+                "command": {
+                    "description": "Command is the step's own command, empty when it uses the project's",
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "My Project"
+                    "example": "npm test -- --ci"
                 },
-                "worktree_base_path": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "example": "/tmp/projects/repo"
+                "step": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.VerificationStep"
+                        }
+                    ],
+                    "example": "test"
                 }
             }
         },
-        "dto.ProjectListResponse": {
+        "dto.VerificationPipelineUpdateRequest": {
             "type": "object",
+            "required": [
+                "steps"
+            ],
             "properties": {
-                "page": {
-                    "type": "integer"
-                },
-                "page_size": {
-                    "type": "integer"
-                },
-                "projects": {
+                "steps": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/dto.ProjectResponse"
+                        "$ref": "#/definitions/dto.VerificationPipelineStepRequest"
                     }
-                },
-                "total": {
-                    "type": "integer"
                 }
             }
         },
-        "dto.ProjectResponse": {
+        "dto.VerificationRunListResponse": {
             "type": "object",
             "properties": {
-                "active_task_counts": {
-                    "$ref": "#/definitions/dto.ActiveTaskCounts"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VerificationRunResponse"
+                    }
+                }
+            }
+        },
+        "dto.VerificationRunResponse": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer",
+                    "example": 0
                 },
-                "created_at": {
+                "command": {
                     "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
+                    "example": "npm test"
                 },
-                "description": {
+                "completed_at": {
                     "type": "string",
-                    "example": "Project description"
+                    "example": "2024-01-01T00:00:42Z"
                 },
-                "id": {
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 42000
+                },
+                "execution_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "init_workspace_script": {
-                    "type": "string",
-                    "example": "npm install \u0026\u0026 npm run build"
+                "exit_code": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "name": {
-                    "type": "string",
-                    "example": "My Project"
+                "fixes_committed": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "repository_url": {
+                "id": {
                     "type": "string",
-                    "example": "https://github.com/user/repo.git"
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "updated_at": {
+                "output": {
                     "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
+                    "example": "1 failing"
                 },
-                "worktree_base_path": {
+                "passed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "started_at": {
                     "type": "string",
-                    "example": "/tmp/projects/repo"
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.VerificationRunStatus"
+                        }
+                    ],
+                    "example": "failed"
+                },
+                "step": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.VerificationStep"
+                        }
+                    ],
+                    "example": "test"
                 }
             }
         },
-        "dto.ProjectStatisticsResponse": {
+        "dto.WebhookDeliveryListResponse": {
             "type": "object",
             "properties": {
-                "completion_percent": {
-                    "type": "number"
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "last_activity_at": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                    }
                 },
-                "recent_activity": {
-                    "type": "integer"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "tasks_by_status": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "total_tasks": {
-                    "type": "integer"
+                "total": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         },
-        "dto.ProjectUpdateRequest": {
+        "dto.WebhookDeliveryResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 1000,
-                    "example": "Updated description"
-                },
-                "init_workspace_script": {
-                    "type": "string",
-                    "example": "npm install \u0026\u0026 npm run build"
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "name": {
+                "created_at": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "Updated Project Name"
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "repository_url": {
+                "error": {
                     "type": "string",
-                    "maxLength": 500,
-                    "example": "https://github.com/user/repo.git"
+                    "example": "webhook answered 502 Bad Gateway"
                 },
-                "worktree_base_path": {
-                    "type": "string",
-                    "maxLength": 500,
-                    "example": "/tmp/projects/repo"
-                }
-            }
-        },
-        "dto.StartPlanningRequest": {
-            "type": "object",
-            "required": [
-                "ai_type",
-                "branch_name"
-            ],
-            "properties": {
-                "ai_type": {
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WebhookEvent"
+                        }
+                    ],
+                    "example": "status_changed"
+                },
+                "id": {
                     "type": "string",
-                    "example": "claude-code"
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "auto_implement": {
-                    "type": "boolean"
+                "last_attempt_at": {
+                    "type": "string",
+                    "example": "2024-01-15T10:30:00Z"
                 },
-                "branch_name": {
+                "payload": {
                     "type": "string",
-                    "example": "main"
+                    "example": "{\"event\":\"status_changed\"}"
                 },
-                "use_remote_branch": {
-                    "type": "boolean"
+                "redelivery_of": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "response_status": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WebhookDeliveryStatus"
+                        }
+                    ],
+                    "example": "succeeded"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
                 }
             }
         },
-        "dto.StartPlanningResponse": {
+        "dto.WeeklyVelocityResponse": {
             "type": "object",
             "properties": {
-                "job_id": {
-                    "type": "string",
-                    "example": "task-123-planning-456"
+                "average_executions_per_task": {
+                    "type": "number",
+                    "example": 1.57
                 },
-                "message": {
+                "executions": {
+                    "description": "Executions counts the AI runs of the tasks completed that week",
+                    "type": "integer",
+                    "example": 11
+                },
+                "pull_requests_merged": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "tasks_completed": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "week_start": {
                     "type": "string",
-                    "example": "Planning started successfully"
+                    "example": "2024-03-04T00:00:00Z"
                 }
             }
         },
-        "dto.SuccessResponse": {
+        "dto.WorkflowPresetListResponse": {
             "type": "object",
             "properties": {
-                "data": {},
-                "message": {
-                    "type": "string",
-                    "example": "Operation completed successfully"
+                "presets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorkflowPresetResponse"
+                    }
                 }
             }
         },
-        "dto.TaskCreateRequest": {
+        "dto.WorkflowPresetResponse": {
             "type": "object",
-            "required": [
-                "project_id",
-                "title"
-            ],
             "properties": {
-                "description": {
+                "implementation_instructions": {
                     "type": "string",
-                    "maxLength": 5000,
-                    "example": "Add JWT-based authentication system"
+                    "example": "Write a failing regression test first."
                 },
-                "kanban_task_id": {
+                "is_default": {
+                    "description": "IsDefault is set when the project has no saved preset of the workflow\ntype and uses the built-in one",
+                    "type": "boolean",
+                    "example": false
+                },
+                "planning_instructions": {
                     "type": "string",
-                    "maxLength": 64,
-                    "example": "a1b2c3d4"
+                    "example": "Find the root cause before planning the fix."
                 },
                 "project_id": {
                     "type": "string",
                     "example": "123e4567-e89b-12d3-a456-426614174000"
                 },
-                "title": {
+                "require_test_changes": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "updated_at": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "Implement user authentication"
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "workflow": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WorkflowType"
+                        }
+                    ],
+                    "example": "bugfix"
                 }
             }
         },
-        "dto.TaskListResponse": {
+        "dto.WorkflowPresetUpdateRequest": {
             "type": "object",
             "properties": {
-                "tasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.TaskResponse"
-                    }
+                "implementation_instructions": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Write a failing regression test first."
                 },
-                "total": {
-                    "type": "integer"
+                "planning_instructions": {
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Find the root cause before planning the fix."
+                },
+                "require_test_changes": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "dto.TaskPlansResponse": {
+        "dto.WorkloadResponse": {
             "type": "object",
             "properties": {
-                "plans": {
+                "assignees": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.PlanResponse"
+                        "$ref": "#/definitions/dto.AssigneeWorkloadResponse"
                     }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "total": {
+                    "$ref": "#/definitions/dto.AssigneeWorkloadResponse"
                 }
             }
         },
-        "dto.TaskResponse": {
+        "dto.WorktreeCommitResponse": {
             "type": "object",
             "properties": {
-                "branch_name": {
-                    "type": "string",
-                    "example": "feature/user-auth"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
-                },
-                "description": {
+                "author": {
                     "type": "string",
-                    "example": "Add JWT-based authentication system"
-                },
-                "error_logs": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "git_status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.TaskGitStatus"
-                        }
-                    ],
-                    "example": "none"
+                    "example": "Auto-Devs"
                 },
-                "id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                "date": {
+                    "type": "string"
                 },
-                "kanban_task_id": {
+                "hash": {
                     "type": "string",
-                    "example": "a1b2c3d4"
+                    "example": "9fceb02d0ae598e95dc970b74767f19372d61af8"
                 },
-                "project_id": {
+                "subject": {
                     "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                    "example": "Implement task: Add login"
+                }
+            }
+        },
+        "dto.WorktreeCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WorktreeFileContentResponse": {
+            "type": "object",
+            "properties": {
+                "binary": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "pull_request": {
+                "content": {
+                    "description": "Content is empty for binary files, and holds only the first 1 MiB of\ntruncated ones",
                     "type": "string",
-                    "example": "https://github.com/user/repo/pull/123"
+                    "example": "package main"
                 },
-                "status": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.TaskStatus"
-                        }
-                    ],
-                    "example": "TODO"
+                "modified_at": {
+                    "type": "string"
                 },
-                "title": {
+                "path": {
                     "type": "string",
-                    "example": "Implement user authentication"
+                    "example": "cmd/server/main.go"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2024-01-15T10:30:00Z"
+                "size": {
+                    "type": "integer",
+                    "example": 1024
                 },
-                "worktree_path": {
-                    "type": "string",
-                    "example": "/tmp/worktrees/task-123"
+                "truncated": {
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
-        "dto.TaskUpdateRequest": {
+        "dto.WorktreeFileEntryResponse": {
             "type": "object",
             "properties": {
-                "branch_name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "example": "feature/user-auth"
+                "modified_at": {
+                    "type": "string"
                 },
-                "description": {
+                "name": {
                     "type": "string",
-                    "maxLength": 5000,
-                    "example": "Updated description"
+                    "example": "main.go"
                 },
-                "pull_request": {
+                "path": {
+                    "description": "Path is relative to the worktree root",
                     "type": "string",
-                    "maxLength": 255,
-                    "example": "https://github.com/user/repo/pull/123"
+                    "example": "cmd/server/main.go"
                 },
-                "status": {
-                    "enum": [
-                        "TODO",
-                        "PLANNING",
-                        "PLAN_REVIEWING",
-                        "IMPLEMENTING",
-                        "CODE_REVIEWING",
-                        "DONE",
-                        "CANCELLED"
-                    ],
+                "size": {
+                    "type": "integer",
+                    "example": 1024
+                },
+                "type": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/entity.TaskStatus"
+                            "$ref": "#/definitions/usecase.WorktreeFileType"
                         }
                     ],
-                    "example": "TODO"
-                },
-                "title": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1,
-                    "example": "Updated task title"
-                }
-            }
-        },
-        "dto.UpdateWorktreeStatusRequest": {
-            "type": "object",
-            "required": [
-                "status"
-            ],
-            "properties": {
-                "status": {
-                    "$ref": "#/definitions/entity.WorktreeStatus"
+                    "example": "file"
                 }
             }
         },
-        "dto.WorktreeCountResponse": {
+        "dto.WorktreeFilesResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorktreeFileEntryResponse"
+                    }
+                },
+                "path": {
+                    "type": "string",
+                    "example": "cmd/server"
                 }
             }
         },
@@ -3093,6 +15490,31 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.WorktreeSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "base_commit": {
+                    "type": "string",
+                    "example": "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "hash": {
+                    "type": "string",
+                    "example": "9fceb02d0ae598e95dc970b74767f19372d61af8"
+                },
+                "id": {
+                    "description": "ID is the execution ID the snapshot was taken for, with a -fix-\u003cn\u003e\nsuffix for auto-fix attempts",
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000-fix-1"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Before auto-fix attempt 1 of execution 123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
         "dto.WorktreeStatisticsResponse": {
             "type": "object",
             "properties": {
@@ -3112,41 +15534,120 @@ const docTemplate = `{
         "dto.WorktreesResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
+                "has_more": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Worktree"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "worktrees": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/entity.Worktree"
-                    }
+                "page_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 100
                 }
             }
         },
+        "entity.EventType": {
+            "type": "string",
+            "enum": [
+                "task.created",
+                "task.status_changed",
+                "task.deleted",
+                "task.commit_linked",
+                "project.budget_threshold_reached"
+            ],
+            "x-enum-varnames": [
+                "EventTypeTaskCreated",
+                "EventTypeTaskStatusChanged",
+                "EventTypeTaskDeleted",
+                "EventTypeTaskCommitLinked",
+                "EventTypeBudgetThreshold"
+            ]
+        },
         "entity.Execution": {
             "type": "object",
             "properties": {
+                "ai_type": {
+                    "type": "string"
+                },
+                "attempt": {
+                    "type": "integer"
+                },
+                "base_coverage": {
+                    "type": "number"
+                },
+                "branch_name": {
+                    "type": "string"
+                },
+                "comparison_id": {
+                    "description": "ComparisonID groups the executions of an executor comparison, each run\nby AIType in its own worktree on its own branch. It is nil for a\nregular run in the task worktree.",
+                    "type": "string"
+                },
                 "completed_at": {
                     "type": "string"
                 },
+                "cost_usd": {
+                    "type": "number"
+                },
+                "coverage": {
+                    "description": "Coverage is the total test coverage in percent of the worktree after\nthe execution, BaseCoverage that of the base branch",
+                    "type": "number"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "deleted_at": {
                     "type": "string"
                 },
+                "dry_run": {
+                    "description": "DryRun executions ran in a throwaway worktree to preview the changes\nof an implementation; only their diff is kept, as a task attachment",
+                    "type": "boolean"
+                },
                 "error_message": {
                     "type": "string"
                 },
+                "fix_attempts": {
+                    "description": "FixAttempts is how many times the AI was asked to fix a failing build\nor test run after the execution",
+                    "type": "integer"
+                },
+                "heartbeat_at": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "input_tokens": {
+                    "description": "InputTokens, OutputTokens and CostUSD are what the AI run reported it\nused, all zero when its executor does not report usage",
+                    "type": "integer"
+                },
                 "logs": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/entity.ExecutionLog"
                     }
                 },
+                "output_tokens": {
+                    "type": "integer"
+                },
+                "phase": {
+                    "description": "Phase is empty for comparison and dry-run executions, which are not\nretried. Attempt numbers the executions of a phase from the first one,\neach retry linked by RetryOfID to the failed execution it retries.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ExecutionPhase"
+                        }
+                    ]
+                },
                 "processes": {
                     "type": "array",
                     "items": {
@@ -3156,10 +15657,32 @@ const docTemplate = `{
                 "progress": {
                     "type": "number"
                 },
+                "question": {
+                    "description": "Question is what the AI asked the user while the execution waits for\ninput, and SessionID the AI CLI session resumed with the answer",
+                    "type": "string"
+                },
                 "result": {
                     "description": "JSON serialized ExecutionResult",
                     "type": "string"
                 },
+                "retry_of_id": {
+                    "type": "string"
+                },
+                "review_comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ReviewComment"
+                    }
+                },
+                "security_findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.SecurityFinding"
+                    }
+                },
+                "session_id": {
+                    "type": "string"
+                },
                 "started_at": {
                     "type": "string"
                 },
@@ -3177,8 +15700,28 @@ const docTemplate = `{
                 "task_id": {
                     "type": "string"
                 },
+                "trace_id": {
+                    "description": "TraceID is the OpenTelemetry trace the execution ran in, empty when\ntracing is off",
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "variant": {
+                    "type": "string"
+                },
+                "verification_runs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.VerificationRun"
+                    }
+                },
+                "worker": {
+                    "description": "Worker names the worker running the execution, and HeartbeatAt is when\nit last reported the execution alive. An active execution whose worker\nstopped reporting was orphaned by a crash.",
+                    "type": "string"
+                },
+                "worktree_path": {
+                    "type": "string"
                 }
             }
         },
@@ -3191,6 +15734,10 @@ const docTemplate = `{
                 "duration_ms": {
                     "type": "integer"
                 },
+                "encrypted": {
+                    "description": "Encrypted is set when Message holds the sealed message and parsed\ncontent of the log rather than the message itself",
+                    "type": "boolean"
+                },
                 "execution": {
                     "description": "Relationships",
                     "allOf": [
@@ -3253,11 +15800,26 @@ const docTemplate = `{
                 "tool_use_id": {
                     "type": "string"
                 },
+                "trace_id": {
+                    "description": "TraceID is the trace of the execution the log was written by",
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
+        "entity.ExecutionPhase": {
+            "type": "string",
+            "enum": [
+                "planning",
+                "implementation"
+            ],
+            "x-enum-varnames": [
+                "ExecutionPhasePlanning",
+                "ExecutionPhaseImplementation"
+            ]
+        },
         "entity.ExecutionResult": {
             "type": "object",
             "properties": {
@@ -3287,7 +15849,8 @@ const docTemplate = `{
                 "PAUSED",
                 "COMPLETED",
                 "FAILED",
-                "CANCELLED"
+                "CANCELLED",
+                "WAITING_INPUT"
             ],
             "x-enum-varnames": [
                 "ExecutionStatusPending",
@@ -3295,7 +15858,36 @@ const docTemplate = `{
                 "ExecutionStatusPaused",
                 "ExecutionStatusCompleted",
                 "ExecutionStatusFailed",
-                "ExecutionStatusCancelled"
+                "ExecutionStatusCancelled",
+                "ExecutionStatusWaitingInput"
+            ]
+        },
+        "entity.GitOperationType": {
+            "type": "string",
+            "enum": [
+                "worktree_create",
+                "worktree_delete",
+                "commit",
+                "push",
+                "branch_delete"
+            ],
+            "x-enum-varnames": [
+                "GitOperationWorktreeCreate",
+                "GitOperationWorktreeDelete",
+                "GitOperationCommit",
+                "GitOperationPush",
+                "GitOperationBranchDelete"
+            ]
+        },
+        "entity.GitProvider": {
+            "type": "string",
+            "enum": [
+                "github",
+                "gitlab"
+            ],
+            "x-enum-varnames": [
+                "GitProviderGitHub",
+                "GitProviderGitLab"
             ]
         },
         "entity.JSONB": {
@@ -3317,6 +15909,42 @@ const docTemplate = `{
                 "LogLevelError"
             ]
         },
+        "entity.NotificationChannel": {
+            "type": "string",
+            "enum": [
+                "slack",
+                "email"
+            ],
+            "x-enum-varnames": [
+                "NotificationChannelSlack",
+                "NotificationChannelEmail"
+            ]
+        },
+        "entity.NotificationType": {
+            "type": "string",
+            "enum": [
+                "TASK_STATUS_CHANGED",
+                "TASK_CREATED",
+                "TASK_UPDATED",
+                "TASK_DELETED",
+                "DAILY_STANDUP",
+                "PLAN_READY",
+                "EXECUTION_FAILED",
+                "PR_MERGED",
+                "DAILY_DIGEST"
+            ],
+            "x-enum-varnames": [
+                "NotificationTypeTaskStatusChanged",
+                "NotificationTypeTaskCreated",
+                "NotificationTypeTaskUpdated",
+                "NotificationTypeTaskDeleted",
+                "NotificationTypeDailyStandup",
+                "NotificationTypePlanReady",
+                "NotificationTypeExecutionFailed",
+                "NotificationTypePRMerged",
+                "NotificationTypeDailyDigest"
+            ]
+        },
         "entity.Plan": {
             "type": "object",
             "required": [
@@ -3334,6 +15962,10 @@ const docTemplate = `{
                 "deleted_at": {
                     "type": "string"
                 },
+                "feedback": {
+                    "description": "Feedback is the reviewer's reason for rejecting the plan, given to\nthe planning run replacing it",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -3462,30 +16094,106 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "ai_review_enabled": {
+                    "type": "boolean"
+                },
+                "allowed_commands": {
+                    "description": "AllowedCommands and DeniedCommands restrict the shell commands AI\nexecutions may run, one command prefix per line. See CommandPolicy.",
+                    "type": "string"
+                },
+                "auto_commit_lint_fixes": {
+                    "type": "boolean"
+                },
+                "auto_fix_attempts": {
+                    "type": "integer"
+                },
+                "budget_alert_thresholds": {
+                    "type": "string"
+                },
+                "build_command": {
+                    "type": "string"
+                },
+                "coverage_command": {
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "deleted_at": {
                     "type": "string"
                 },
+                "denied_commands": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string",
                     "maxLength": 1000
                 },
+                "encrypt_execution_logs": {
+                    "description": "EncryptExecutionLogs seals the message bodies of the project's\nexecution logs at rest, see ExecutionLog.Encrypted",
+                    "type": "boolean"
+                },
+                "export_patches": {
+                    "description": "ExportPatches is for projects without a remote git provider: the\nchanges of a task are attached to it as a patch and a git bundle\ninstead of pushed and opened as a pull request",
+                    "type": "boolean"
+                },
+                "git_provider": {
+                    "description": "GitProvider hosts the project's repository, where its tasks' pull\nrequests are opened: merge requests on GitLab. Empty detects it from\nRepositoryURL.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GitProvider"
+                        }
+                    ]
+                },
+                "high_risk_paths": {
+                    "description": "HighRiskPaths are fragments of paths that make a task high-risk when\nits plan touches a matching path, one per line. See\nHighRiskPathPatterns.",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "init_workspace_script": {
                     "type": "string"
                 },
+                "lint_command": {
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "Locale is the language of the project's notifications and generated\npull request and commit texts, see i18n.Locale",
+                    "type": "string"
+                },
+                "max_concurrent_executions": {
+                    "description": "MaxConcurrentExecutions bounds how many planning and implementation\nexecutions of the project run at once across the workers, 0 for no\nlimit. Tasks over it wait in the project's queue.",
+                    "type": "integer"
+                },
+                "monthly_budget_usd": {
+                    "description": "MonthlyBudgetUSD is what the project may spend on AI runs in a month,\n0 for no budget. BudgetAlertThresholds lists the percentages of it\nthat raise an alert when reached, comma separated.",
+                    "type": "number"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 1
                 },
+                "organization_id": {
+                    "type": "string"
+                },
+                "post_review_comments": {
+                    "type": "boolean"
+                },
+                "preview_command": {
+                    "description": "docker compose is used when empty",
+                    "type": "string"
+                },
+                "preview_enabled": {
+                    "type": "boolean"
+                },
                 "repository_url": {
                     "type": "string"
                 },
+                "security_scanner": {
+                    "$ref": "#/definitions/entity.SecurityScanner"
+                },
                 "tasks": {
                     "description": "Relationships",
                     "type": "array",
@@ -3493,6 +16201,12 @@ const docTemplate = `{
                         "$ref": "#/definitions/entity.Task"
                     }
                 },
+                "test_command": {
+                    "type": "string"
+                },
+                "test_failure_policy": {
+                    "$ref": "#/definitions/entity.TestFailurePolicy"
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -3585,6 +16299,10 @@ const docTemplate = `{
                 "merged_by": {
                     "type": "string"
                 },
+                "quality_gate_comment_id": {
+                    "description": "QualityGateCommentID is the GitHub ID of the comment summing up the\nverification results, updated on every push",
+                    "type": "integer"
+                },
                 "repository": {
                     "type": "string"
                 },
@@ -3607,38 +16325,186 @@ const docTemplate = `{
                         }
                     ]
                 },
-                "task": {
-                    "description": "Relationships",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.Task"
-                        }
-                    ]
+                "task": {
+                    "description": "Relationships",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Task"
+                        }
+                    ]
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PullRequestStatus": {
+            "type": "string",
+            "enum": [
+                "OPEN",
+                "MERGED",
+                "CLOSED"
+            ],
+            "x-enum-varnames": [
+                "PullRequestStatusOpen",
+                "PullRequestStatusMerged",
+                "PullRequestStatusClosed"
+            ]
+        },
+        "entity.ReleaseNotesSource": {
+            "type": "string",
+            "enum": [
+                "tasks",
+                "pull_requests"
+            ],
+            "x-enum-varnames": [
+                "ReleaseNotesSourceTasks",
+                "ReleaseNotesSourcePullRequests"
+            ]
+        },
+        "entity.ReleaseNotesStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "generating",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ReleaseNotesStatusPending",
+                "ReleaseNotesStatusGenerating",
+                "ReleaseNotesStatusCompleted",
+                "ReleaseNotesStatusFailed"
+            ]
+        },
+        "entity.ReviewComment": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "execution_id": {
+                    "type": "string"
+                },
+                "file_path": {
+                    "description": "FilePath is relative to the repository root; empty for findings about\nthe change as a whole",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "line": {
+                    "description": "Line is the line in the new version of the file, when the finding has one",
+                    "type": "integer"
+                },
+                "posted": {
+                    "description": "Posted is set once the comment was added to the pull request",
+                    "type": "boolean"
+                },
+                "severity": {
+                    "$ref": "#/definitions/entity.ReviewSeverity"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ReviewSeverity": {
+            "type": "string",
+            "enum": [
+                "info",
+                "warning",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "ReviewSeverityInfo",
+                "ReviewSeverityWarning",
+                "ReviewSeverityError"
+            ]
+        },
+        "entity.SecurityFinding": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "execution_id": {
+                    "type": "string"
+                },
+                "file_path": {
+                    "description": "FilePath is relative to the repository root",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
                 },
-                "task_id": {
+                "rule_id": {
+                    "description": "RuleID is the scanner's identifier of the check, e.g. G101",
                     "type": "string"
                 },
-                "title": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "scanner": {
+                    "$ref": "#/definitions/entity.SecurityScanner"
                 },
-                "updated_at": {
+                "severity": {
+                    "$ref": "#/definitions/entity.SecuritySeverity"
+                },
+                "task_id": {
                     "type": "string"
                 }
             }
         },
-        "entity.PullRequestStatus": {
+        "entity.SecurityScanner": {
             "type": "string",
             "enum": [
-                "OPEN",
-                "MERGED",
-                "CLOSED"
+                "gosec",
+                "semgrep",
+                "secrets"
             ],
             "x-enum-varnames": [
-                "PullRequestStatusOpen",
-                "PullRequestStatusMerged",
-                "PullRequestStatusClosed"
+                "SecurityScannerGosec",
+                "SecurityScannerSemgrep",
+                "SecurityScannerSecrets"
+            ]
+        },
+        "entity.SecuritySeverity": {
+            "type": "string",
+            "enum": [
+                "low",
+                "medium",
+                "high"
+            ],
+            "x-enum-varnames": [
+                "SecuritySeverityLow",
+                "SecuritySeverityMedium",
+                "SecuritySeverityHigh"
+            ]
+        },
+        "entity.SlackDelivery": {
+            "type": "string",
+            "enum": [
+                "webhook",
+                "bot"
+            ],
+            "x-enum-varnames": [
+                "SlackDeliveryWebhook",
+                "SlackDeliveryBot"
             ]
         },
         "entity.Task": {
@@ -3670,6 +16536,12 @@ const docTemplate = `{
                 "branch_name": {
                     "type": "string"
                 },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TaskComment"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -3683,6 +16555,10 @@ const docTemplate = `{
                 "due_date": {
                     "type": "string"
                 },
+                "epic_id": {
+                    "description": "Epic the task is grouped under",
+                    "type": "string"
+                },
                 "error_logs": {
                     "type": "array",
                     "items": {
@@ -3694,9 +16570,39 @@ const docTemplate = `{
                     "maximum": 999.99,
                     "minimum": 0
                 },
+                "executions": {
+                    "description": "Executions, PullRequests and Comments are only loaded for the detail\nview of the task, see TaskDetail",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Execution"
+                    }
+                },
                 "git_status": {
                     "$ref": "#/definitions/entity.TaskGitStatus"
                 },
+                "github_issue_comments": {
+                    "description": "Whether the plan and PR are posted back to the GitHub issue",
+                    "type": "boolean"
+                },
+                "github_issue_number": {
+                    "description": "Number of the GitHub issue the task was imported from",
+                    "type": "integer"
+                },
+                "github_issue_repository": {
+                    "description": "Repository (owner/name) of the GitHub issue the task was imported from",
+                    "type": "string"
+                },
+                "github_project_item_id": {
+                    "description": "GitHub project item the task was imported from",
+                    "type": "string"
+                },
+                "high_risk": {
+                    "description": "HighRisk tasks only have their plan implemented once it has step-up\napproval, see PlanApproval. HighRiskReason tells why, e.g. which\nsensitive paths the plan touches.",
+                    "type": "boolean"
+                },
+                "high_risk_reason": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -3706,10 +16612,18 @@ const docTemplate = `{
                 "is_template": {
                     "type": "boolean"
                 },
+                "jira_issue_key": {
+                    "description": "Jira issue the task was imported from",
+                    "type": "string"
+                },
                 "kanban_task_id": {
                     "description": "Hermes kanban card ID for callback",
                     "type": "string"
                 },
+                "number": {
+                    "description": "Assigned by the database, see TaskKey",
+                    "type": "integer"
+                },
                 "parent_task": {
                     "$ref": "#/definitions/entity.Task"
                 },
@@ -3722,6 +16636,14 @@ const docTemplate = `{
                         "$ref": "#/definitions/entity.Plan"
                     }
                 },
+                "planning_iterations": {
+                    "description": "PlanningIterations counts the planning runs of the task, one more\neach time a rejected plan is planned again with feedback",
+                    "type": "integer"
+                },
+                "preview_url": {
+                    "description": "Running preview environment of the worktree",
+                    "type": "string"
+                },
                 "priority": {
                     "enum": [
                         "LOW",
@@ -3749,6 +16671,16 @@ const docTemplate = `{
                 "pull_request": {
                     "type": "string"
                 },
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PullRequest"
+                    }
+                },
+                "queue_position": {
+                    "description": "QueuePosition is the task's place among the tasks waiting for one of\ntheir project's execution slots, 1 being next, and nil when the task\nis not waiting",
+                    "type": "integer"
+                },
                 "status": {
                     "enum": [
                         "TODO",
@@ -3789,6 +16721,14 @@ const docTemplate = `{
                 "updated_at": {
                     "type": "string"
                 },
+                "workflow_type": {
+                    "description": "WorkflowType selects the project's workflow preset adjusting how the\ntask is planned, implemented and verified; none applies when empty",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.WorkflowType"
+                        }
+                    ]
+                },
                 "worktree_path": {
                     "type": "string"
                 }
@@ -3851,6 +16791,40 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.TaskComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "task": {
+                    "description": "Relationships",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Task"
+                        }
+                    ]
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
         "entity.TaskGitStatus": {
             "type": "string",
             "enum": [
@@ -3906,6 +16880,162 @@ const docTemplate = `{
                 "TaskStatusCANCELLED"
             ]
         },
+        "entity.TestFailurePolicy": {
+            "type": "string",
+            "enum": [
+                "block",
+                "flag"
+            ],
+            "x-enum-varnames": [
+                "TestFailurePolicyBlock",
+                "TestFailurePolicyFlag"
+            ]
+        },
+        "entity.UndoOperationType": {
+            "type": "string",
+            "enum": [
+                "bulk_delete",
+                "bulk_archive"
+            ],
+            "x-enum-varnames": [
+                "UndoOperationBulkDelete",
+                "UndoOperationBulkArchive"
+            ]
+        },
+        "entity.VerificationRun": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "Attempt is 0 for the verification of the implementation, and n for the\none after the nth auto-fix attempt",
+                    "type": "integer"
+                },
+                "command": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "execution_id": {
+                    "type": "string"
+                },
+                "exit_code": {
+                    "description": "ExitCode is -1 when the command could not be started or timed out",
+                    "type": "integer"
+                },
+                "fixes_committed": {
+                    "description": "FixesCommitted is set when the files the command changed were committed",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "output": {
+                    "type": "string"
+                },
+                "passed": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entity.VerificationRunStatus"
+                },
+                "step": {
+                    "$ref": "#/definitions/entity.VerificationStep"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.VerificationRunStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "passed",
+                "failed",
+                "skipped"
+            ],
+            "x-enum-varnames": [
+                "VerificationRunStatusPending",
+                "VerificationRunStatusRunning",
+                "VerificationRunStatusPassed",
+                "VerificationRunStatusFailed",
+                "VerificationRunStatusSkipped"
+            ]
+        },
+        "entity.VerificationStep": {
+            "type": "string",
+            "enum": [
+                "setup",
+                "lint",
+                "build",
+                "test",
+                "security_scan",
+                "review",
+                "test_changes"
+            ],
+            "x-enum-varnames": [
+                "VerificationStepSetup",
+                "VerificationStepLint",
+                "VerificationStepBuild",
+                "VerificationStepTest",
+                "VerificationStepSecurityScan",
+                "VerificationStepReview",
+                "VerificationStepTestChanges"
+            ]
+        },
+        "entity.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "entity.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "task_created",
+                "status_changed",
+                "plan_ready",
+                "execution_completed",
+                "pr_merged"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventTaskCreated",
+                "WebhookEventStatusChanged",
+                "WebhookEventPlanReady",
+                "WebhookEventExecutionCompleted",
+                "WebhookEventPRMerged"
+            ]
+        },
+        "entity.WorkflowType": {
+            "type": "string",
+            "enum": [
+                "feature",
+                "bugfix",
+                "refactor"
+            ],
+            "x-enum-varnames": [
+                "WorkflowTypeFeature",
+                "WorkflowTypeBugfix",
+                "WorkflowTypeRefactor"
+            ]
+        },
         "entity.Worktree": {
             "type": "object",
             "required": [
@@ -4006,6 +17136,52 @@ const docTemplate = `{
                 "WorktreeStatusError"
             ]
         },
+        "handler.DatabaseHealth": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "database": {
+                    "$ref": "#/definitions/handler.DatabaseHealth"
+                },
+                "redis": {
+                    "$ref": "#/definitions/handler.RedisHealth"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RedisHealth": {
+            "type": "object",
+            "properties": {
+                "buffered_notifications": {
+                    "description": "BufferedNotifications counts the WebSocket notifications waiting\nfor Redis",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "repository.ExecutionStats": {
             "type": "object",
             "properties": {
@@ -4067,6 +17243,36 @@ const docTemplate = `{
                 }
             }
         },
+        "usecase.WorktreeCleanupResult": {
+            "type": "object",
+            "properties": {
+                "cleaned": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "reclaimed_bytes": {
+                    "type": "integer"
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.WorktreeFileType": {
+            "type": "string",
+            "enum": [
+                "file",
+                "dir",
+                "symlink"
+            ],
+            "x-enum-varnames": [
+                "WorktreeFileTypeFile",
+                "WorktreeFileTypeDir",
+                "WorktreeFileTypeSymlink"
+            ]
+        },
         "usecase.WorktreeHealthInfo": {
             "type": "object",
             "properties": {
@@ -4148,17 +17354,31 @@ const docTemplate = `{
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API key for editor plugins and automation tools, configured with API_KEYS",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "\"Bearer \" followed by a token from /api/v1/auth/login or one of the user's API keys",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
 // SwaggerInfo holds exported Swagger Info so clients can modify it
 var SwaggerInfo = &swag.Spec{
-	Version:          "",
+	Version:          "1.0",
 	Host:             "",
-	BasePath:         "",
+	BasePath:         "/",
 	Schemes:          []string{},
-	Title:            "",
-	Description:      "",
+	Title:            "Auto-Devs API",
+	Description:      "API for managing projects, tasks, AI planning/implementation executions and worktrees.\nRoutes are documented under /api/v1, which is deprecated (see the Deprecation and Sunset headers). Every route is also served under /api/v2 with {data, meta} list envelopes and error bodies that always carry a code.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,10 @@
+// Stands in for a claude-code release notes run: prints the stream-json
+// result event of release notes with one feature
+const notes = '# Release notes\n\n## New features\n\n- Fake release notes: the completed tasks are listed here.\n'
+
+async function main() {
+    await new Promise(resolve => setTimeout(resolve, 1000))
+    console.log(JSON.stringify({ type: 'result', subtype: 'success', is_error: false, result: notes }))
+}
+
+main()
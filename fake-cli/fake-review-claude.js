@@ -0,0 +1,15 @@
+// Stands in for a claude-code review run: prints the stream-json result
+// event of a review with one finding
+const review = '```json\n' + JSON.stringify([
+    {
+        severity: 'info',
+        comment: 'Fake review: consider adding a test covering the new behaviour.',
+    },
+]) + '\n```'
+
+async function main() {
+    await new Promise(resolve => setTimeout(resolve, 1000))
+    console.log(JSON.stringify({ type: 'result', subtype: 'success', is_error: false, result: review }))
+}
+
+main()
@@ -0,0 +1,10 @@
+// Stands in for a claude-code daily standup run: prints the stream-json
+// result event of a short standup summary
+const summary = '- Fake standup: the tasks completed yesterday are listed here.\n- Nothing failed and no review is pending.\n'
+
+async function main() {
+    await new Promise(resolve => setTimeout(resolve, 1000))
+    console.log(JSON.stringify({ type: 'result', subtype: 'success', is_error: false, result: summary }))
+}
+
+main()
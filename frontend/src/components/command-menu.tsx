@@ -4,11 +4,17 @@ import {
   IconArrowRightDashed,
   IconChevronRight,
   IconDeviceLaptop,
+  IconFileText,
+  IconFolder,
+  IconGitPullRequest,
+  IconListCheck,
   IconMoon,
   IconSun,
 } from '@tabler/icons-react'
+import type { SearchResult, SearchResultType } from '@/types/search'
 import { useSearch } from '@/context/search-context'
 import { useTheme } from '@/context/theme-context'
+import { useGlobalSearch } from '@/hooks/use-search'
 import {
   CommandDialog,
   CommandEmpty,
@@ -21,10 +27,36 @@ import {
 import { sidebarData } from './layout/data/sidebar-data'
 import { ScrollArea } from './ui/scroll-area'
 
+const searchResultIcons: Record<SearchResultType, typeof IconFolder> = {
+  project: IconFolder,
+  task: IconListCheck,
+  plan: IconFileText,
+  pull_request: IconGitPullRequest,
+}
+
+function searchResultLabel(result: SearchResult) {
+  switch (result.type) {
+    case 'project':
+      return 'Project'
+    case 'task':
+      return result.task_key ?? 'Task'
+    case 'plan':
+      return result.task_key ? `Plan of ${result.task_key}` : 'Plan'
+    case 'pull_request':
+      return result.pr_number ? `PR #${result.pr_number}` : 'Pull request'
+  }
+}
+
 export function CommandMenu() {
   const navigate = useNavigate()
   const { setTheme } = useTheme()
   const { open, setOpen } = useSearch()
+  const [query, setQuery] = React.useState('')
+  const { data: searchResults } = useGlobalSearch(open ? query : '')
+
+  React.useEffect(() => {
+    if (!open) setQuery('')
+  }, [open])
 
   const runCommand = React.useCallback(
     (command: () => unknown) => {
@@ -34,12 +66,61 @@ export function CommandMenu() {
     [setOpen]
   )
 
+  // Plans and pull requests open on their task
+  const openSearchResult = (result: SearchResult) => {
+    if (result.type === 'project' || !result.task_id) {
+      navigate({
+        to: '/projects/$projectId',
+        params: { projectId: result.project_id },
+      })
+      return
+    }
+    navigate({
+      to: '/projects/$projectId/tasks/$taskId',
+      params: { projectId: result.project_id, taskId: result.task_id },
+    })
+  }
+
   return (
     <CommandDialog modal open={open} onOpenChange={setOpen}>
-      <CommandInput placeholder='Type a command or search...' />
+      <CommandInput
+        placeholder='Type a command or search...'
+        value={query}
+        onValueChange={setQuery}
+      />
       <CommandList>
         <ScrollArea type='hover' className='h-72 pr-1'>
           <CommandEmpty>No results found.</CommandEmpty>
+          {query.trim() && searchResults && searchResults.items.length > 0 && (
+            <>
+              <CommandGroup heading='Search results'>
+                {searchResults.items.map((result) => {
+                  const Icon = searchResultIcons[result.type]
+                  return (
+                    <CommandItem
+                      key={`${result.type}-${result.id}`}
+                      value={`${result.type}-${result.id}`}
+                      // Results are already matched and ranked by the server
+                      keywords={[query]}
+                      onSelect={() => {
+                        runCommand(() => openSearchResult(result))
+                      }}
+                    >
+                      <Icon className='text-muted-foreground' />
+                      <div className='flex min-w-0 flex-col'>
+                        <span className='truncate'>{result.title}</span>
+                        <span className='text-muted-foreground truncate text-xs'>
+                          {searchResultLabel(result)} · {result.project_name}
+                          {result.snippet && ` · ${result.snippet}`}
+                        </span>
+                      </div>
+                    </CommandItem>
+                  )
+                })}
+              </CommandGroup>
+              <CommandSeparator />
+            </>
+          )}
           {sidebarData.navGroups.map((group) => (
             <CommandGroup key={group.title} heading={group.title}>
               {group.items.map((navItem, i) => {
@@ -9,6 +9,7 @@ import {
   MoreHorizontal,
 } from 'lucide-react'
 import { cn } from '@/lib/utils'
+import { useRetryExecution } from '@/hooks/use-executions'
 import { Badge } from '@/components/ui/badge'
 import { Button } from '@/components/ui/button'
 import { Card, CardContent } from '@/components/ui/card'
@@ -137,6 +138,12 @@ function ExecutionHeader({
       <div className='min-w-0 flex-1'>
         <div className='text-muted-foreground flex items-center gap-2 text-sm'>
           <span>#{execution.id.slice(-8)}</span>
+          {execution.attempt > 1 && (
+            <>
+              <span>•</span>
+              <span>Attempt {execution.attempt}</span>
+            </>
+          )}
           <span>•</span>
           <ExecutionDuration
             startedAt={execution.started_at}
@@ -201,8 +208,10 @@ function ExecutionActions({
   const canResume = execution.status === 'PAUSED'
   const canStop =
     execution.status === 'RUNNING' || execution.status === 'PAUSED'
-  const canRetry =
-    execution.status === 'FAILED' || execution.status === 'CANCELLED'
+  // Failed planning and implementation executions run again as their next
+  // attempt
+  const canRetry = execution.status === 'FAILED' && !!execution.phase
+  const retryExecution = useRetryExecution()
 
   const dropdownItems = []
   if (canPause) {
@@ -230,7 +239,7 @@ function ExecutionActions({
     dropdownItems.push({
       label: 'Retry',
       icon: Play,
-      onClick: () => onUpdate?.('pending'),
+      onClick: () => retryExecution.mutate(execution.id),
     })
   }
 
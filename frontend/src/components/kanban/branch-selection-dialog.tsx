@@ -72,10 +72,10 @@ export function BranchSelectionDialog({
 
     try {
       const data = await projectsApi.getProjectBranches(projectId, useRemoteBranch)
-      setBranches(data.branches || [])
+      setBranches(data.items || [])
 
       // Auto-select current branch if available (local branches only)
-      const currentBranch = data.branches?.find(
+      const currentBranch = data.items?.find(
         (branch: GitBranch) => branch.is_current
       )
       if (currentBranch) {
@@ -97,10 +97,10 @@ export function ProjectBoard({ projectId }: ProjectBoardProps) {
 
   // Keep local tasks in sync with server data
   useEffect(() => {
-    const baseTasks = tasksResponse?.tasks || []
+    const baseTasks = tasksResponse?.items || []
     let merged = baseTasks
     if (showDoneTasks) {
-      const done = doneTasksResponse?.tasks || []
+      const done = doneTasksResponse?.items || []
       const existing = new Set(baseTasks.map((t) => t.id))
       merged = [...baseTasks, ...done.filter((t) => !existing.has(t.id))]
     }
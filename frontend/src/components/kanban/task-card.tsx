@@ -42,6 +42,11 @@ export function TaskCard({
               <Badge variant='secondary' className='text-xs'>
                 {statusTitle}
               </Badge>
+              {task.queue_position != null && (
+                <Badge variant='outline' className='text-xs'>
+                  Queued #{task.queue_position}
+                </Badge>
+              )}
               {task.git_info && (
                 <GitStatusBadge
                   status={task.git_info.status}
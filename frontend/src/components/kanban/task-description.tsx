@@ -0,0 +1,35 @@
+import type { ReactNode } from 'react'
+
+// Matches Markdown images, ![alt](url), as inserted by pasting a screenshot
+const markdownImagePattern = /!\[((?:\\.|[^\]\\])*)\]\(([^)\s]+)\)/g
+
+interface TaskDescriptionProps {
+  text: string
+}
+
+// TaskDescription renders text as is, except for Markdown images of http(s)
+// URLs, which are shown inline
+export function TaskDescription({ text }: TaskDescriptionProps) {
+  const parts: ReactNode[] = []
+  let last = 0
+  for (const match of text.matchAll(markdownImagePattern)) {
+    const [whole, alt, url] = match
+    if (!/^https?:\/\//i.test(url)) {
+      continue
+    }
+    parts.push(text.slice(last, match.index))
+    parts.push(
+      <a key={match.index} href={url} target='_blank' rel='noreferrer'>
+        <img
+          src={url}
+          alt={alt.replace(/\\(.)/g, '$1')}
+          loading='lazy'
+          className='my-2 max-h-80 max-w-full rounded border'
+        />
+      </a>
+    )
+    last = match.index + whole.length
+  }
+  parts.push(text.slice(last))
+  return <>{parts}</>
+}
@@ -1,16 +1,15 @@
 import { useState } from 'react'
 import { useNavigate, useParams } from '@tanstack/react-router'
-import type { Task } from '@/types/task'
+import type { PullRequest } from '@/types/pull-request'
+import type { Task, TaskComment } from '@/types/task'
 import { ExternalLink } from 'lucide-react'
 import { Diff, parseDiff, Hunk } from 'react-diff-view'
 import 'react-diff-view/style/index.css'
 import { getStatusColor, getStatusTitle } from '@/lib/kanban'
 import { useTaskExecutions } from '@/hooks/use-executions'
-import {
-  usePullRequestByTask,
-  useCreatePullRequest,
-} from '@/hooks/use-pull-requests'
-import { useTaskDiff } from '@/hooks/use-tasks'
+import { useCreatePullRequest } from '@/hooks/use-pull-requests'
+import { useTaskDiff, useTaskFull } from '@/hooks/use-tasks'
+import { useUserProfiles } from '@/hooks/use-users'
 import { Badge } from '@/components/ui/badge'
 import { Button } from '@/components/ui/button'
 import { Separator } from '@/components/ui/separator'
@@ -25,8 +24,10 @@ import { Tabs, TabsList, TabsTrigger, TabsContent } from '@/components/ui/tabs'
 import { ExecutionList } from '../executions'
 import { PlanReview } from '../planning'
 import { TaskActions } from './task-actions'
+import { TaskDescription } from './task-description'
 import { TaskHistory } from './task-history'
 import { TaskMetadata } from './task-metadata'
+import { UserLabel } from '../user-label'
 
 interface TaskDetailSheetProps {
   open: boolean
@@ -58,6 +59,9 @@ export function TaskDetailSheet({
   const navigate = useNavigate()
   const params = useParams({ strict: false }) as { projectId?: string }
   const [showHistory, setShowHistory] = useState(false)
+  const { data: taskFull, isLoading: isTaskFullLoading } = useTaskFull(
+    task?.id ?? ''
+  )
 
   // Handle sheet close and URL cleanup
   const handleOpenChange = (isOpen: boolean) => {
@@ -105,7 +109,7 @@ export function TaskDetailSheet({
           </SheetHeader>
           <SheetDescription className='px-4 text-sm whitespace-pre-wrap'>
             {/* Description */}
-            {task.description}
+            <TaskDescription text={task.description} />
           </SheetDescription>
 
           <div className='space-y-6 px-4 pb-6'>
@@ -131,6 +135,10 @@ export function TaskDetailSheet({
               />
             </div>
 
+            {taskFull && taskFull.comments.length > 0 && (
+              <TaskComments comments={taskFull.comments} />
+            )}
+
             <Separator />
 
             {/* Tabs for Plan Review, Code Changes, Executions, and Metadata */}
@@ -147,7 +155,11 @@ export function TaskDetailSheet({
               </TabsContent>
 
               <TabsContent value='code-changes' className='mt-4'>
-                <CodeChanges taskId={task.id} />
+                <CodeChanges
+                  taskId={task.id}
+                  pullRequest={taskFull?.pull_request}
+                  isPRLoading={isTaskFullLoading}
+                />
               </TabsContent>
 
               <TabsContent value='executions' className='mt-4'>
@@ -193,7 +205,7 @@ function TaskExecutions({ taskId }: { taskId: string }) {
     order_dir: 'desc',
   })
 
-  const executions = executionsData?.data || []
+  const executions = executionsData?.items || []
 
   return (
     <>
@@ -223,10 +235,47 @@ function parseDiffString(diffString: string) {
   }
 }
 
-// CodeChanges component for the code changes tab
-function CodeChanges({ taskId }: { taskId: string }) {
-  const { data: pullRequest, isLoading: isPRLoading } =
-    usePullRequestByTask(taskId)
+// TaskComments lists the comments on the task, oldest first
+function TaskComments({ comments }: { comments: TaskComment[] }) {
+  const { profiles } = useUserProfiles(
+    comments.map((comment) => comment.created_by)
+  )
+
+  return (
+    <div>
+      <h4 className='mb-3 text-sm font-medium'>Comments</h4>
+      <div className='space-y-3'>
+        {comments.map((comment) => (
+          <div key={comment.id} className='rounded-md border p-3 text-sm'>
+            <div className='text-muted-foreground mb-1 text-xs'>
+              <UserLabel
+                username={comment.created_by}
+                profile={profiles.get(comment.created_by)}
+              />{' '}
+              ·{' '}
+              {new Date(comment.created_at).toLocaleString()}
+            </div>
+            <div className='whitespace-pre-wrap'>
+              <TaskDescription text={comment.comment} />
+            </div>
+          </div>
+        ))}
+      </div>
+    </div>
+  )
+}
+
+// CodeChanges component for the code changes tab. The pull request comes
+// with the rest of the task detail.
+function CodeChanges({
+  taskId,
+  pullRequest,
+  isPRLoading,
+}: {
+  taskId: string
+  pullRequest?: PullRequest
+  isPRLoading: boolean
+}) {
   const {
     data: diff,
     isLoading: isDiffLoading,
@@ -1,8 +1,11 @@
-import { useEffect } from 'react'
+import { useEffect, useState } from 'react'
+import type { ClipboardEvent } from 'react'
 import * as z from 'zod'
 import { useForm } from 'react-hook-form'
 import { zodResolver } from '@hookform/resolvers/zod'
+import { toast } from 'sonner'
 import type { Task } from '@/types/task'
+import { tasksApi } from '@/lib/api/tasks'
 import { useCreateTask, useUpdateTask } from '@/hooks/use-tasks'
 import { Button } from '@/components/ui/button'
 import {
@@ -74,7 +77,41 @@ export function TaskFormModal({
     }
   }, [open, mode, task, form])
 
-  const isLoading = createTaskMutation.isPending || updateTaskMutation.isPending
+  const [isUploading, setIsUploading] = useState(false)
+  const isLoading =
+    createTaskMutation.isPending || updateTaskMutation.isPending || isUploading
+
+  // Pasted screenshots are uploaded and embedded as Markdown images. A new
+  // task has nowhere to upload them to until it is created.
+  const handleDescriptionPaste = async (
+    event: ClipboardEvent<HTMLTextAreaElement>
+  ) => {
+    const image = Array.from(event.clipboardData.files).find((file) =>
+      file.type.startsWith('image/')
+    )
+    if (!image || mode !== 'edit' || !task) {
+      return
+    }
+    event.preventDefault()
+    const { selectionStart, selectionEnd } = event.currentTarget
+
+    setIsUploading(true)
+    try {
+      const media = await tasksApi.uploadTaskMedia(task.id, image)
+      const description = form.getValues('description') || ''
+      form.setValue(
+        'description',
+        description.slice(0, selectionStart) +
+          media.markdown +
+          description.slice(selectionEnd),
+        { shouldDirty: true, shouldValidate: true }
+      )
+    } catch {
+      toast.error('Failed to upload image')
+    } finally {
+      setIsUploading(false)
+    }
+  }
 
   const onSubmit = async (values: TaskFormValues) => {
     try {
@@ -143,9 +180,14 @@ export function TaskFormModal({
                   <FormLabel>Description</FormLabel>
                   <FormControl>
                     <Textarea
-                      placeholder='Enter task description...'
+                      placeholder={
+                        mode === 'edit'
+                          ? 'Enter task description... Paste a screenshot to embed it.'
+                          : 'Enter task description...'
+                      }
                       className='min-h-[100px]'
                       {...field}
+                      onPaste={handleDescriptionPaste}
                       disabled={isLoading}
                     />
                   </FormControl>
@@ -5,6 +5,7 @@ import { SearchProvider } from '@/context/search-context'
 import { WebSocketProvider } from '@/context/websocket-context'
 import { SidebarProvider } from '@/components/ui/sidebar'
 import { AppSidebar } from '@/components/layout/app-sidebar'
+import { DegradedModeBanner } from '@/components/layout/degraded-mode-banner'
 import { RealTimeNotifications } from '@/components/notifications/real-time-notifications'
 import SkipToMain from '@/components/skip-to-main'
 
@@ -62,6 +63,7 @@ export function AuthenticatedLayout({ children }: Props) {
               'has-[main.fixed-main]:group-data-[scroll-locked=1]/body:h-svh'
             )}
           >
+            <DegradedModeBanner />
             {children ? children : <Outlet />}
 
             {/* Global Real-time Notifications */}
@@ -0,0 +1,34 @@
+import { AlertTriangle } from 'lucide-react'
+import { useHealth } from '@/hooks/use-health'
+import { Alert, AlertDescription, AlertTitle } from '@/components/ui/alert'
+
+// DegradedModeBanner warns that the server runs degraded, from its health
+// check, so missing live updates or slow actions are not a surprise
+export function DegradedModeBanner() {
+  const { data: health } = useHealth()
+
+  if (!health || health.status !== 'degraded') {
+    return null
+  }
+
+  let description =
+    'Some services are unavailable. Data may be out of date until they recover.'
+  if (health.database.status === 'error') {
+    description =
+      'The database cannot be reached. Changes cannot be saved until it recovers.'
+  } else if (health.redis && health.redis.status !== 'ok') {
+    description =
+      'Live updates are delayed and starting work may be slow while the job queue recovers.'
+    if (health.redis.buffered_notifications > 0) {
+      description += ` ${health.redis.buffered_notifications} notifications will be delivered once it is back.`
+    }
+  }
+
+  return (
+    <Alert variant='destructive' className='rounded-none border-x-0 border-t-0'>
+      <AlertTriangle />
+      <AlertTitle>Running in degraded mode</AlertTitle>
+      <AlertDescription>{description}</AlertDescription>
+    </Alert>
+  )
+}
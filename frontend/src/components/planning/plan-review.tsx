@@ -35,7 +35,7 @@ export function PlanReview({ task }: PlanReviewProps) {
   const { data, isLoading, error, refetch } = useGetTaskPlans(task.id)
   const updatePlan = useUpdatePlan()
   const [editingPlan, setEditingPlan] = useState<string | null>(null)
-  const plans = data?.plans
+  const plans = data?.items
 
   const handleEditPlan = (planId: string) => {
     setEditingPlan(planId)
@@ -0,0 +1,25 @@
+import type { UserProfile } from '@/types/user'
+import { Avatar, AvatarFallback, AvatarImage } from '@/components/ui/avatar'
+
+interface UserLabelProps {
+  username: string
+  // The user's profile, when loaded; the username is shown until then
+  profile?: UserProfile
+}
+
+// UserLabel shows a user recorded by name with their avatar and display name
+export function UserLabel({ username, profile }: UserLabelProps) {
+  const name = profile?.name ?? username
+
+  return (
+    <span className='inline-flex items-center gap-1.5' title={username}>
+      <Avatar className='h-4 w-4'>
+        {profile?.avatar_url && <AvatarImage src={profile.avatar_url} />}
+        <AvatarFallback className='text-[8px]'>
+          {name.slice(0, 2).toUpperCase()}
+        </AvatarFallback>
+      </Avatar>
+      <span>{name}</span>
+    </span>
+  )
+}
@@ -9,4 +9,9 @@ export const API_ENDPOINTS = {
   TASKS: '/tasks',
   EXECUTIONS: '/executions',
   PULL_REQUESTS: '/pull-requests',
+  SEARCH: '/search',
+  USERS: '/users',
+  AUTH: '/auth',
+  EPICS: '/epics',
+  HEALTH: '/health',
 } as const
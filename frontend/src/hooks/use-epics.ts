@@ -0,0 +1,84 @@
+import { useEffect } from 'react'
+import { useMutation, useQuery, useQueryClient } from '@tanstack/react-query'
+import type { CreateEpicRequest, UpdateEpicRequest } from '@/types/epic'
+import { toast } from 'sonner'
+import { epicsApi } from '@/lib/api/epics'
+import { useWebSocketContext } from '@/context/websocket-context'
+
+const EPICS_QUERY_KEY = 'epics'
+
+// useProjectEpics lists the project's epics with their progress, refetched
+// whenever the server reports a new rollup
+export function useProjectEpics(projectId: string) {
+  const queryClient = useQueryClient()
+  const { subscribe, unsubscribe } = useWebSocketContext()
+
+  useEffect(() => {
+    const onProgressUpdated = () => {
+      queryClient.invalidateQueries({
+        queryKey: [EPICS_QUERY_KEY, projectId],
+      })
+    }
+    subscribe('epic_progress_updated', onProgressUpdated)
+    return () => unsubscribe('epic_progress_updated', onProgressUpdated)
+  }, [projectId, queryClient, subscribe, unsubscribe])
+
+  return useQuery({
+    queryKey: [EPICS_QUERY_KEY, projectId],
+    queryFn: () => epicsApi.getProjectEpics(projectId),
+    enabled: !!projectId,
+  })
+}
+
+export function useCreateEpic(projectId: string) {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (data: CreateEpicRequest) =>
+      epicsApi.createEpic(projectId, data),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [EPICS_QUERY_KEY, projectId] })
+      toast.success('Epic created successfully')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to create epic')
+    },
+  })
+}
+
+export function useUpdateEpic(projectId: string) {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      epicId,
+      data,
+    }: {
+      epicId: string
+      data: UpdateEpicRequest
+    }) => epicsApi.updateEpic(epicId, data),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [EPICS_QUERY_KEY, projectId] })
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to update epic')
+    },
+  })
+}
+
+export function useDeleteEpic(projectId: string) {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: epicsApi.deleteEpic,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [EPICS_QUERY_KEY, projectId] })
+      // Tasks of the epic are no longer grouped
+      queryClient.invalidateQueries({ queryKey: ['tasks', projectId] })
+      toast.success('Epic deleted successfully')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to delete epic')
+    },
+  })
+}
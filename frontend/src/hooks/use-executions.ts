@@ -1,6 +1,14 @@
-import { useQuery } from '@tanstack/react-query'
-import type { ExecutionFilters } from '@/types/execution'
+import { useEffect } from 'react'
+import { useQuery, useMutation, useQueryClient } from '@tanstack/react-query'
+import type {
+  AnswerQuestionRequest,
+  ExecutionFilters,
+  ExecutionLog,
+  ExecutionWithLogs,
+} from '@/types/execution'
+import { toast } from 'sonner'
 import { executionsApi } from '@/lib/api/executions'
+import { useWebSocketContext } from '@/context/websocket-context'
 
 const EXECUTIONS_QUERY_KEY = 'executions'
 const EXECUTION_QUERY_KEY = 'execution'
@@ -22,7 +30,35 @@ export function useTaskExecutions(taskId: string, filters?: ExecutionFilters) {
   })
 }
 
+// useExecution gets the execution with its logs. The log lines a running
+// execution prints are streamed in over the WebSocket; polling catches up
+// with whatever the stream missed.
 export function useExecution(executionId: string | null) {
+  const queryClient = useQueryClient()
+  const { subscribe, unsubscribe } = useWebSocketContext()
+
+  useEffect(() => {
+    if (!executionId) return
+    const onExecutionLog = (message: any) => {
+      if (message.data?.execution_id !== executionId) return
+      const streamed: ExecutionLog[] = message.data.logs || []
+      queryClient.setQueryData<ExecutionWithLogs>(
+        [EXECUTION_QUERY_KEY, executionId],
+        (execution) => {
+          if (!execution) return execution
+          // A line printed again replaces the one received before
+          const byLine = new Map(
+            (execution.logs || []).map((log) => [log.line, log])
+          )
+          streamed.forEach((log) => byLine.set(log.line, log))
+          return { ...execution, logs: Array.from(byLine.values()) }
+        }
+      )
+    }
+    subscribe('execution_log', onExecutionLog)
+    return () => unsubscribe('execution_log', onExecutionLog)
+  }, [executionId, queryClient, subscribe, unsubscribe])
+
   return useQuery({
     queryKey: [EXECUTION_QUERY_KEY, executionId],
     queryFn: () => executionsApi.getExecutionWithLogs(executionId || ''),
@@ -30,9 +66,65 @@ export function useExecution(executionId: string | null) {
     refetchInterval: (data) => {
       const executionStatus = data.state?.data?.status
       if (executionStatus === 'RUNNING' || executionStatus === 'PENDING') {
-        return 1000
+        return 5000
       }
       return false
     },
   })
 }
+
+export function useAnswerQuestion() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      executionId,
+      request,
+    }: {
+      executionId: string
+      request: AnswerQuestionRequest
+    }) => executionsApi.answerQuestion(executionId, request),
+    onSuccess: () => {
+      toast.success('Question answered, execution resumed')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to answer question')
+    },
+    onSettled: (_data, _error, { executionId }) => {
+      queryClient.invalidateQueries({ queryKey: [EXECUTIONS_QUERY_KEY] })
+      queryClient.invalidateQueries({
+        queryKey: [EXECUTION_QUERY_KEY, executionId],
+      })
+    },
+  })
+}
+
+// Get the attempt chain of an execution
+export function useExecutionAttempts(executionId: string | null) {
+  return useQuery({
+    queryKey: [EXECUTION_QUERY_KEY, executionId, 'attempts'],
+    queryFn: () => executionsApi.getExecutionAttempts(executionId || ''),
+    enabled: !!executionId,
+  })
+}
+
+export function useRetryExecution() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: (executionId: string) =>
+      executionsApi.retryExecution(executionId),
+    onSuccess: () => {
+      toast.success('Execution retry started')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to retry execution')
+    },
+    onSettled: (_data, _error, executionId) => {
+      queryClient.invalidateQueries({ queryKey: [EXECUTIONS_QUERY_KEY] })
+      queryClient.invalidateQueries({
+        queryKey: [EXECUTION_QUERY_KEY, executionId],
+      })
+    },
+  })
+}
@@ -0,0 +1,14 @@
+import { useQuery } from '@tanstack/react-query'
+import { healthApi } from '@/lib/api/health'
+
+// useHealth polls the health of the API server, more often while it is
+// degraded so recovery shows quickly
+export function useHealth() {
+  return useQuery({
+    queryKey: ['health'],
+    queryFn: healthApi.getHealth,
+    refetchInterval: (query) =>
+      query.state.data?.status === 'degraded' ? 10 * 1000 : 60 * 1000,
+    retry: false,
+  })
+}
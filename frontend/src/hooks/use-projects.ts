@@ -4,7 +4,10 @@ import type {
   CreateProjectRequest,
   UpdateProjectRequest,
   ProjectFilters,
+  UpdateWorkflowPresetRequest,
+  UpdateProjectSettingsRequest,
 } from '@/types/project'
+import type { WorkflowType } from '@/types/task'
 import { toast } from 'sonner'
 import { projectsApi } from '@/lib/api/projects'
 
@@ -12,6 +15,11 @@ const QUERY_KEYS = {
   projects: ['projects'] as const,
   project: (id: string) => ['projects', id] as const,
   statistics: (id: string) => ['projects', id, 'statistics'] as const,
+  activity: (id: string, page: number) =>
+    ['projects', id, 'activity', page] as const,
+  settings: (id: string) => ['projects', id, 'settings'] as const,
+  workflowPresets: (id: string) =>
+    ['projects', id, 'workflow-presets'] as const,
 }
 
 export function useProjects(filters?: ProjectFilters) {
@@ -40,6 +48,108 @@ export function useProjectStatistics(projectId: string) {
   })
 }
 
+export function useProjectActivity(projectId: string, page = 1) {
+  return useQuery({
+    queryKey: QUERY_KEYS.activity(projectId, page),
+    queryFn: () => projectsApi.getProjectActivity(projectId, page),
+    enabled: !!projectId,
+    staleTime: 30000, // 30 seconds
+  })
+}
+
+export function useProjectSettings(projectId: string) {
+  return useQuery({
+    queryKey: QUERY_KEYS.settings(projectId),
+    queryFn: () => projectsApi.getProjectSettings(projectId),
+    enabled: !!projectId,
+    staleTime: 5 * 60 * 1000, // 5 minutes
+  })
+}
+
+export function useUpdateProjectSettings() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      projectId,
+      settings,
+    }: {
+      projectId: string
+      settings: UpdateProjectSettingsRequest
+    }) => projectsApi.updateProjectSettings(projectId, settings),
+    onSuccess: (settings) => {
+      queryClient.setQueryData(QUERY_KEYS.settings(settings.project_id), settings)
+      toast.success('Project settings updated successfully!')
+    },
+    onError: (error: any) => {
+      toast.error(
+        error.response?.data?.message || 'Failed to update project settings'
+      )
+    },
+  })
+}
+
+export function useWorkflowPresets(projectId: string) {
+  return useQuery({
+    queryKey: QUERY_KEYS.workflowPresets(projectId),
+    queryFn: () => projectsApi.getWorkflowPresets(projectId),
+    enabled: !!projectId,
+    staleTime: 5 * 60 * 1000, // 5 minutes
+  })
+}
+
+export function useUpdateWorkflowPreset() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      projectId,
+      workflow,
+      preset,
+    }: {
+      projectId: string
+      workflow: WorkflowType
+      preset: UpdateWorkflowPresetRequest
+    }) => projectsApi.updateWorkflowPreset(projectId, workflow, preset),
+    onSuccess: (_, { projectId }) => {
+      queryClient.invalidateQueries({
+        queryKey: QUERY_KEYS.workflowPresets(projectId),
+      })
+      toast.success('Workflow preset updated successfully!')
+    },
+    onError: (error: any) => {
+      toast.error(
+        error.response?.data?.message || 'Failed to update workflow preset'
+      )
+    },
+  })
+}
+
+export function useResetWorkflowPreset() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      projectId,
+      workflow,
+    }: {
+      projectId: string
+      workflow: WorkflowType
+    }) => projectsApi.resetWorkflowPreset(projectId, workflow),
+    onSuccess: (_, { projectId }) => {
+      queryClient.invalidateQueries({
+        queryKey: QUERY_KEYS.workflowPresets(projectId),
+      })
+      toast.success('Workflow preset reset to the default')
+    },
+    onError: (error: any) => {
+      toast.error(
+        error.response?.data?.message || 'Failed to reset workflow preset'
+      )
+    },
+  })
+}
+
 export function useCreateProject() {
   const queryClient = useQueryClient()
 
@@ -24,6 +24,7 @@ export function useCreatePullRequest() {
       })
       // Set the new pull request data in the cache
       queryClient.setQueryData(['pull-request-by-task', taskId], data)
+      queryClient.invalidateQueries({ queryKey: ['tasks', 'full', taskId] })
     },
   })
 }
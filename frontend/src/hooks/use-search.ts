@@ -0,0 +1,26 @@
+import { useEffect, useState } from 'react'
+import { keepPreviousData, useQuery } from '@tanstack/react-query'
+import { searchApi } from '@/lib/api/search'
+
+// Wait for the user to stop typing before searching
+const SEARCH_DEBOUNCE_MS = 200
+
+export function useGlobalSearch(query: string) {
+  const [debouncedQuery, setDebouncedQuery] = useState(query.trim())
+
+  useEffect(() => {
+    const timeout = setTimeout(
+      () => setDebouncedQuery(query.trim()),
+      SEARCH_DEBOUNCE_MS
+    )
+    return () => clearTimeout(timeout)
+  }, [query])
+
+  return useQuery({
+    queryKey: ['search', debouncedQuery],
+    queryFn: () => searchApi.search(debouncedQuery),
+    enabled: debouncedQuery.length > 0,
+    placeholderData: keepPreviousData,
+    staleTime: 30000,
+  })
+}
@@ -4,6 +4,10 @@ import type {
   UpdateTaskRequest,
   StartPlanningRequest,
   ApprovePlanRequest,
+  RequestChangesRequest,
+  RejectPlanWithFeedbackRequest,
+  TaskChangesSource,
+  UndoOperationResponse,
 } from '@/types/task'
 import { toast } from 'sonner'
 import { tasksApi } from '@/lib/api/tasks'
@@ -19,6 +23,18 @@ export function useTasks(projectId: string) {
   })
 }
 
+export function useProjectBoard(
+  projectId: string,
+  includeDone = false,
+  epicId?: string
+) {
+  return useQuery({
+    queryKey: [TASKS_QUERY_KEY, projectId, 'board', includeDone, epicId],
+    queryFn: () => tasksApi.getProjectBoard(projectId, includeDone, epicId),
+    enabled: !!projectId,
+  })
+}
+
 export function useDoneTasks(projectId: string, enabled: boolean) {
   return useQuery({
     queryKey: [TASKS_QUERY_KEY, projectId, 'done'],
@@ -93,6 +109,70 @@ export function useDeleteTask() {
   })
 }
 
+// Bulk deletes and archives offer an undo in their toast while the undo
+// window lasts
+function useBulkTaskOperation(
+  mutationFn: (variables: {
+    projectId: string
+    taskIds: string[]
+  }) => Promise<UndoOperationResponse>,
+  verb: 'delete' | 'archive'
+) {
+  const queryClient = useQueryClient()
+  const undo = useUndoOperation()
+
+  return useMutation({
+    mutationFn,
+    onSuccess: (operation) => {
+      queryClient.invalidateQueries({ queryKey: [TASKS_QUERY_KEY] })
+
+      const count = operation.affected_count
+      toast.success(`${count} ${count === 1 ? 'task' : 'tasks'} ${verb}d`, {
+        duration: Math.max(
+          new Date(operation.expires_at).getTime() - Date.now(),
+          0
+        ),
+        action: {
+          label: 'Undo',
+          onClick: () => undo.mutate(operation.undo_token),
+        },
+      })
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || `Failed to ${verb} tasks`)
+    },
+  })
+}
+
+export function useBulkDeleteTasks() {
+  return useBulkTaskOperation(
+    ({ projectId, taskIds }) => tasksApi.bulkDeleteTasks(projectId, taskIds),
+    'delete'
+  )
+}
+
+export function useBulkArchiveTasks() {
+  return useBulkTaskOperation(
+    ({ projectId, taskIds }) => tasksApi.bulkArchiveTasks(projectId, taskIds),
+    'archive'
+  )
+}
+
+export function useUndoOperation() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: tasksApi.undoOperation,
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: [TASKS_QUERY_KEY] })
+      toast.success('Undone')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to undo')
+    },
+  })
+}
+
 // Optimistic update for drag and drop
 export function useOptimisticTaskUpdate() {
   const queryClient = useQueryClient()
@@ -103,7 +183,7 @@ export function useOptimisticTaskUpdate() {
 
       return {
         ...old,
-        tasks: old.tasks.map((task: Task) =>
+        items: old.items.map((task: Task) =>
           task.id === taskId ? { ...task, status: newStatus } : task
         ),
       }
@@ -164,7 +244,7 @@ export function useStartPlanning() {
         if (!old) return old
         return {
           ...old,
-          tasks: old.tasks.map((task: Task) =>
+          items: old.items.map((task: Task) =>
             task.id === taskId
               ? { ...task, status: 'PLANNING' as Task['status'] }
               : task
@@ -215,7 +295,7 @@ export function useApprovePlan() {
         if (!old) return old
         return {
           ...old,
-          tasks: old.tasks.map((task: Task) =>
+          items: old.items.map((task: Task) =>
             task.id === mutatedTask.taskId
               ? { ...task, status: 'IMPLEMENTING' as Task['status'] }
               : task
@@ -245,6 +325,52 @@ export function useApprovePlan() {
   })
 }
 
+export function useRejectPlanWithFeedback() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      taskId,
+      request,
+    }: {
+      taskId: string
+      request: RejectPlanWithFeedbackRequest
+    }) => tasksApi.rejectPlanWithFeedback(taskId, request),
+    onSuccess: () => {
+      toast.success('Plan rejected, planning started again')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to reject plan')
+    },
+    onSettled: () => {
+      queryClient.invalidateQueries({ queryKey: [TASKS_QUERY_KEY] })
+    },
+  })
+}
+
+export function useRequestChanges() {
+  const queryClient = useQueryClient()
+
+  return useMutation({
+    mutationFn: ({
+      taskId,
+      request,
+    }: {
+      taskId: string
+      request: RequestChangesRequest
+    }) => tasksApi.requestChanges(taskId, request),
+    onSuccess: () => {
+      toast.success('Changes requested, implementation started')
+    },
+    onError: (error: any) => {
+      toast.error(error.response?.data?.message || 'Failed to request changes')
+    },
+    onSettled: () => {
+      queryClient.invalidateQueries({ queryKey: [TASKS_QUERY_KEY] })
+    },
+  })
+}
+
 export function useStartImplementingDirect() {
   const queryClient = useQueryClient()
 
@@ -268,7 +394,7 @@ export function useStartImplementingDirect() {
         if (!old) return old
         return {
           ...old,
-          tasks: old.tasks.map((task: Task) =>
+          items: old.items.map((task: Task) =>
             task.id === taskId
               ? { ...task, status: 'IMPLEMENTING' as Task['status'] }
               : task
@@ -318,7 +444,7 @@ export function useChangeTaskStatus() {
         if (!old) return old
         return {
           ...old,
-          tasks: old.tasks.map((task: Task) =>
+          items: old.items.map((task: Task) =>
             task.id === taskId ? { ...task, status } : task
           ),
         }
@@ -354,6 +480,16 @@ export function useChangeTaskStatus() {
   })
 }
 
+// useTaskFull loads everything the task detail sheet shows in one request
+export function useTaskFull(taskId: string) {
+  return useQuery({
+    queryKey: [TASKS_QUERY_KEY, 'full', taskId],
+    queryFn: () => tasksApi.getTaskFull(taskId),
+    enabled: !!taskId,
+    staleTime: 30000,
+  })
+}
+
 export function useGetTaskPlans(taskId: string) {
   return useQuery({
     queryKey: [TASKS_QUERY_KEY, 'plans', taskId],
@@ -412,3 +548,13 @@ export function useTaskDiff(taskId: string) {
     retry: 1, // Only retry once on failure
   })
 }
+
+export function useTaskChanges(taskId: string, source?: TaskChangesSource) {
+  return useQuery({
+    queryKey: [TASKS_QUERY_KEY, 'changes', taskId, source],
+    queryFn: () => tasksApi.getTaskChanges(taskId, source),
+    enabled: !!taskId,
+    staleTime: 30000, // 30 seconds
+    retry: 1, // Only retry once on failure
+  })
+}
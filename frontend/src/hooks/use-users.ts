@@ -0,0 +1,30 @@
+import { useMemo } from 'react'
+import { useQuery } from '@tanstack/react-query'
+import type { UserProfile } from '@/types/user'
+import { usersApi } from '@/lib/api/users'
+
+// useUserProfiles fetches the profiles of the users named, once each, and
+// maps them by username
+export function useUserProfiles(usernames: string[]) {
+  const unique = useMemo(
+    () => Array.from(new Set(usernames.filter(Boolean))).sort(),
+    [usernames]
+  )
+
+  const query = useQuery({
+    queryKey: ['user-profiles', unique],
+    queryFn: () => usersApi.getUserProfiles(unique),
+    enabled: unique.length > 0,
+    staleTime: 5 * 60 * 1000,
+  })
+
+  const profiles = useMemo(() => {
+    const byUsername = new Map<string, UserProfile>()
+    query.data?.items.forEach((profile) =>
+      byUsername.set(profile.username, profile)
+    )
+    return byUsername
+  }, [query.data])
+
+  return { ...query, profiles }
+}
@@ -0,0 +1,58 @@
+import axios from 'axios'
+import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
+import type {
+  CreatedUserAPIKey,
+  LoginRequest,
+  LoginResponse,
+  RegisterRequest,
+  User,
+  UserAPIKeysResponse,
+} from '@/types/auth'
+
+const api = axios.create({
+  baseURL: API_CONFIG.BASE_URL,
+  timeout: API_CONFIG.TIMEOUT,
+})
+
+const bearer = (token: string) => ({ Authorization: `Bearer ${token}` })
+
+export const authApi = {
+  async register(data: RegisterRequest): Promise<User> {
+    const response = await api.post(`${API_ENDPOINTS.AUTH}/register`, data)
+    return response.data
+  },
+
+  async login(data: LoginRequest): Promise<LoginResponse> {
+    const response = await api.post(`${API_ENDPOINTS.AUTH}/login`, data)
+    return response.data
+  },
+
+  async getCurrentUser(token: string): Promise<User> {
+    const response = await api.get(`${API_ENDPOINTS.AUTH}/me`, {
+      headers: bearer(token),
+    })
+    return response.data
+  },
+
+  async listAPIKeys(token: string): Promise<UserAPIKeysResponse> {
+    const response = await api.get(`${API_ENDPOINTS.AUTH}/api-keys`, {
+      headers: bearer(token),
+    })
+    return response.data
+  },
+
+  async createAPIKey(token: string, name: string): Promise<CreatedUserAPIKey> {
+    const response = await api.post(
+      `${API_ENDPOINTS.AUTH}/api-keys`,
+      { name },
+      { headers: bearer(token) }
+    )
+    return response.data
+  },
+
+  async deleteAPIKey(token: string, id: string): Promise<void> {
+    await api.delete(`${API_ENDPOINTS.AUTH}/api-keys/${id}`, {
+      headers: bearer(token),
+    })
+  },
+}
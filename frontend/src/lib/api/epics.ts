@@ -0,0 +1,44 @@
+import axios from 'axios'
+import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
+import type {
+  CreateEpicRequest,
+  Epic,
+  EpicsResponse,
+  UpdateEpicRequest,
+} from '@/types/epic'
+
+const api = axios.create({
+  baseURL: API_CONFIG.BASE_URL,
+  timeout: API_CONFIG.TIMEOUT,
+})
+
+export const epicsApi = {
+  async getProjectEpics(projectId: string): Promise<EpicsResponse> {
+    const response = await api.get(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/epics`
+    )
+    return response.data
+  },
+
+  async getEpic(epicId: string): Promise<Epic> {
+    const response = await api.get(`${API_ENDPOINTS.EPICS}/${epicId}`)
+    return response.data
+  },
+
+  async createEpic(projectId: string, data: CreateEpicRequest): Promise<Epic> {
+    const response = await api.post(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/epics`,
+      data
+    )
+    return response.data
+  },
+
+  async updateEpic(epicId: string, data: UpdateEpicRequest): Promise<Epic> {
+    const response = await api.put(`${API_ENDPOINTS.EPICS}/${epicId}`, data)
+    return response.data
+  },
+
+  async deleteEpic(epicId: string): Promise<void> {
+    await api.delete(`${API_ENDPOINTS.EPICS}/${epicId}`)
+  },
+}
@@ -10,9 +10,11 @@ import type {
   LogStats,
   CreateExecutionRequest,
   UpdateExecutionRequest,
+  AnswerQuestionRequest,
   ExecutionFilters,
   ExecutionLogFilters,
 } from '@/types/execution'
+import type { StartPlanningResponse } from '@/types/task'
 
 const api = axios.create({
   baseURL: API_CONFIG.BASE_URL,
@@ -155,6 +157,44 @@ export const executionsApi = {
     return response.data
   },
 
+  // Answer the question an execution waits on, resuming it
+  async answerQuestion(
+    executionId: string,
+    data: AnswerQuestionRequest
+  ): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.EXECUTIONS}/${executionId}/answer`,
+      data
+    )
+    return response.data
+  },
+
+  // Cancel an unfinished execution, stopping its AI process
+  async cancelExecution(executionId: string): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.EXECUTIONS}/${executionId}/cancel`
+    )
+    return response.data
+  },
+
+  // Retry a failed execution as its next attempt
+  async retryExecution(executionId: string): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.EXECUTIONS}/${executionId}/retry`
+    )
+    return response.data
+  },
+
+  // Get the attempt chain an execution belongs to, first attempt first
+  async getExecutionAttempts(
+    executionId: string
+  ): Promise<{ items: Execution[] }> {
+    const response = await api.get(
+      `${API_ENDPOINTS.EXECUTIONS}/${executionId}/attempts`
+    )
+    return response.data
+  },
+
   // Delete execution
   async deleteExecution(executionId: string): Promise<void> {
     await api.delete(`${API_ENDPOINTS.EXECUTIONS}/${executionId}`)
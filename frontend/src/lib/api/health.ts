@@ -0,0 +1,17 @@
+import axios from 'axios'
+import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
+import type { HealthResponse } from '@/types/health'
+
+const api = axios.create({
+  baseURL: API_CONFIG.BASE_URL,
+  timeout: API_CONFIG.TIMEOUT,
+  // The health body is served with 503 while the database is down
+  validateStatus: (status) => status === 200 || status === 503,
+})
+
+export const healthApi = {
+  async getHealth(): Promise<HealthResponse> {
+    const response = await api.get(API_ENDPOINTS.HEALTH)
+    return response.data
+  },
+}
@@ -7,7 +7,14 @@ import type {
   ProjectsResponse,
   ProjectFilters,
   ProjectStatistics,
+  ProjectActivityResponse,
+  WorkflowPreset,
+  UpdateWorkflowPresetRequest,
+  ProjectSettings,
+  UpdateProjectSettingsRequest,
 } from '@/types/project'
+import type { ListResponse } from '@/types/list'
+import type { WorkflowType } from '@/types/task'
 
 const api = axios.create({
   baseURL: API_CONFIG.BASE_URL,
@@ -59,6 +66,18 @@ export const projectsApi = {
     return response.data
   },
 
+  async getProjectActivity(
+    projectId: string,
+    page = 1,
+    pageSize = 50
+  ): Promise<ProjectActivityResponse> {
+    const response = await api.get(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/activity`,
+      { params: { page, page_size: pageSize } }
+    )
+    return response.data
+  },
+
   async createProject(project: CreateProjectRequest): Promise<Project> {
     const response = await api.post(API_ENDPOINTS.PROJECTS, project)
     return response.data
@@ -87,6 +106,55 @@ export const projectsApi = {
     await api.post(`${API_ENDPOINTS.PROJECTS}/${projectId}/restore`)
   },
 
+  async getProjectSettings(projectId: string): Promise<ProjectSettings> {
+    const response = await api.get(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/settings`
+    )
+    return response.data
+  },
+
+  async updateProjectSettings(
+    projectId: string,
+    settings: UpdateProjectSettingsRequest
+  ): Promise<ProjectSettings> {
+    const response = await api.put(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/settings`,
+      settings
+    )
+    return response.data
+  },
+
+  async getWorkflowPresets(
+    projectId: string
+  ): Promise<{ presets: WorkflowPreset[] }> {
+    const response = await api.get(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/workflow-presets`
+    )
+    return response.data
+  },
+
+  async updateWorkflowPreset(
+    projectId: string,
+    workflow: WorkflowType,
+    preset: UpdateWorkflowPresetRequest
+  ): Promise<WorkflowPreset> {
+    const response = await api.put(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/workflow-presets/${workflow}`,
+      preset
+    )
+    return response.data
+  },
+
+  async resetWorkflowPreset(
+    projectId: string,
+    workflow: WorkflowType
+  ): Promise<WorkflowPreset> {
+    const response = await api.delete(
+      `${API_ENDPOINTS.PROJECTS}/${projectId}/workflow-presets/${workflow}`
+    )
+    return response.data
+  },
+
   async reinitGitRepository(projectId: string): Promise<void> {
     await api.post(`${API_ENDPOINTS.PROJECTS}/${projectId}/git/reinit`)
   },
@@ -94,16 +162,15 @@ export const projectsApi = {
   async getProjectBranches(
     projectId: string,
     includeRemote?: boolean
-  ): Promise<{
-    branches: Array<{
+  ): Promise<
+    ListResponse<{
       name: string
       is_current: boolean
       is_remote?: boolean
       last_commit: string
       last_updated: string
     }>
-    total: number
-  }> {
+  > {
     const params = includeRemote ? '?include_remote=true' : ''
     const response = await api.get(
       `${API_ENDPOINTS.PROJECTS}/${projectId}/branches${params}`
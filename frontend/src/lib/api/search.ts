@@ -0,0 +1,21 @@
+import axios from 'axios'
+import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
+import type { SearchResponse } from '@/types/search'
+
+const api = axios.create({
+  baseURL: API_CONFIG.BASE_URL,
+  timeout: API_CONFIG.TIMEOUT,
+})
+
+export const searchApi = {
+  async search(query: string, limit?: number): Promise<SearchResponse> {
+    const params = new URLSearchParams()
+    params.append('q', query)
+    if (limit) {
+      params.append('limit', limit.toString())
+    }
+
+    const response = await api.get(`${API_ENDPOINTS.SEARCH}?${params}`)
+    return response.data
+  },
+}
@@ -2,6 +2,9 @@ import axios from 'axios'
 import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
 import type {
   Task,
+  TaskBoardResponse,
+  TaskChangesResponse,
+  TaskChangesSource,
   TaskStatus,
   CreateTaskRequest,
   UpdateTaskRequest,
@@ -10,7 +13,14 @@ import type {
   StartPlanningRequest,
   StartPlanningResponse,
   ApprovePlanRequest,
+  RequestChangesRequest,
+  RejectPlanWithFeedbackRequest,
+  ScheduledJob,
   TaskPlansResponse,
+  PlanVersionsResponse,
+  TaskMediaResponse,
+  TaskFullResponse,
+  UndoOperationResponse,
 } from '@/types/task'
 
 const api = axios.create({
@@ -44,6 +54,11 @@ export const tasksApi = {
     return response.data
   },
 
+  async getTaskFull(taskId: string): Promise<TaskFullResponse> {
+    const response = await api.get(`${API_ENDPOINTS.TASKS}/${taskId}/full`)
+    return response.data
+  },
+
   async createTask(task: CreateTaskRequest): Promise<Task> {
     const response = await api.post(API_ENDPOINTS.TASKS, task)
     return response.data
@@ -87,6 +102,39 @@ export const tasksApi = {
     return response.data
   },
 
+  async rejectPlanWithFeedback(
+    taskId: string,
+    request: RejectPlanWithFeedbackRequest
+  ): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.TASKS}/${taskId}/plan/reject`,
+      request
+    )
+    return response.data
+  },
+
+  async requestChanges(
+    taskId: string,
+    request: RequestChangesRequest
+  ): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.TASKS}/${taskId}/request-changes`,
+      request
+    )
+    return response.data
+  },
+
+  async getScheduledJobs(taskId: string): Promise<ScheduledJob[]> {
+    const response = await api.get(
+      `${API_ENDPOINTS.TASKS}/${taskId}/scheduled-jobs`
+    )
+    return response.data
+  },
+
+  async cancelScheduledJob(taskId: string, jobId: string): Promise<void> {
+    await api.delete(`${API_ENDPOINTS.TASKS}/${taskId}/scheduled-jobs/${jobId}`)
+  },
+
   async openWithCursor(taskId: string): Promise<void> {
     await api.post(`${API_ENDPOINTS.TASKS}/${taskId}/open-with-cursor`)
   },
@@ -99,11 +147,64 @@ export const tasksApi = {
   async updatePlan(
     taskId: string,
     planId: string,
-    content: string
+    content: string,
+    editedBy?: string
   ): Promise<void> {
     await api.put(`${API_ENDPOINTS.TASKS}/${taskId}/plans/${planId}`, {
       content,
+      edited_by: editedBy,
+    })
+  },
+
+  async getPlanVersions(
+    taskId: string,
+    planId: string
+  ): Promise<PlanVersionsResponse> {
+    const response = await api.get(
+      `${API_ENDPOINTS.TASKS}/${taskId}/plans/${planId}/versions`
+    )
+    return response.data
+  },
+
+  async getProjectBoard(
+    projectId: string,
+    includeDone = false,
+    epicId?: string
+  ): Promise<TaskBoardResponse> {
+    const response = await api.get(`/projects/${projectId}/board`, {
+      params: {
+        ...(includeDone && { include_done: true }),
+        ...(epicId && { epic_id: epicId }),
+      },
     })
+    return response.data
+  },
+
+  async bulkDeleteTasks(
+    projectId: string,
+    taskIds: string[]
+  ): Promise<UndoOperationResponse> {
+    const response = await api.post(
+      `/projects/${projectId}/tasks/bulk-delete`,
+      { task_ids: taskIds }
+    )
+    return response.data
+  },
+
+  async bulkArchiveTasks(
+    projectId: string,
+    taskIds: string[]
+  ): Promise<UndoOperationResponse> {
+    const response = await api.post(
+      `/projects/${projectId}/tasks/bulk-archive`,
+      { task_ids: taskIds }
+    )
+    return response.data
+  },
+
+  async undoOperation(token: string): Promise<UndoOperationResponse> {
+    const response = await api.post(`/undo/${token}`)
+    return response.data
   },
 
   async getTaskDiff(taskId: string): Promise<string> {
@@ -113,6 +214,16 @@ export const tasksApi = {
     return response.data
   },
 
+  async getTaskChanges(
+    taskId: string,
+    source?: TaskChangesSource
+  ): Promise<TaskChangesResponse> {
+    const response = await api.get(`${API_ENDPOINTS.TASKS}/${taskId}/changes`, {
+      params: source ? { source } : undefined,
+    })
+    return response.data
+  },
+
   async createPullRequestForTask(taskId: string): Promise<any> {
     const response = await api.post(
       `${API_ENDPOINTS.TASKS}/${taskId}/pull-request`
@@ -130,4 +241,26 @@ export const tasksApi = {
     )
     return response.data
   },
+
+  // startDryRun previews the implementation; its diff is attached to the task
+  async startDryRun(
+    taskId: string,
+    request: { ai_type: string }
+  ): Promise<StartPlanningResponse> {
+    const response = await api.post(
+      `${API_ENDPOINTS.TASKS}/${taskId}/dry-run`,
+      request
+    )
+    return response.data
+  },
+
+  async uploadTaskMedia(taskId: string, file: File): Promise<TaskMediaResponse> {
+    const form = new FormData()
+    form.append('file', file)
+    const response = await api.post(
+      `${API_ENDPOINTS.TASKS}/${taskId}/media`,
+      form
+    )
+    return response.data
+  },
 }
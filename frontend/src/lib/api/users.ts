@@ -0,0 +1,57 @@
+import axios from 'axios'
+import { API_CONFIG, API_ENDPOINTS } from '@/config/api'
+import type {
+  UpdateUserProfileRequest,
+  UserProfile,
+  UserProfilesResponse,
+} from '@/types/user'
+
+const api = axios.create({
+  baseURL: API_CONFIG.BASE_URL,
+  timeout: API_CONFIG.TIMEOUT,
+})
+
+const userPath = (username: string) =>
+  `${API_ENDPOINTS.USERS}/${encodeURIComponent(username)}`
+
+export const usersApi = {
+  async getUserProfiles(usernames: string[]): Promise<UserProfilesResponse> {
+    const params = new URLSearchParams()
+    usernames.forEach((username) => params.append('username', username))
+
+    const response = await api.get(`${API_ENDPOINTS.USERS}?${params}`)
+    return response.data
+  },
+
+  async updateUserProfile(
+    username: string,
+    apiKey: string,
+    data: UpdateUserProfileRequest
+  ): Promise<UserProfile> {
+    const response = await api.put(userPath(username), data, {
+      headers: { 'X-API-Key': apiKey },
+    })
+    return response.data
+  },
+
+  async uploadAvatar(
+    username: string,
+    apiKey: string,
+    file: File
+  ): Promise<UserProfile> {
+    const form = new FormData()
+    form.append('file', file)
+
+    const response = await api.put(`${userPath(username)}/avatar`, form, {
+      headers: { 'X-API-Key': apiKey },
+    })
+    return response.data
+  },
+
+  async deleteAvatar(username: string, apiKey: string): Promise<UserProfile> {
+    const response = await api.delete(`${userPath(username)}/avatar`, {
+      headers: { 'X-API-Key': apiKey },
+    })
+    return response.data
+  },
+}
@@ -131,7 +131,7 @@ export function ProjectDetail() {
     )
   }
 
-  const tasks = tasksResponse?.tasks || []
+  const tasks = tasksResponse?.items || []
 
   return (
     <>
@@ -171,10 +171,10 @@ export function ProjectList() {
               <TabsTrigger value='archived' className='flex items-center gap-2'>
                 <Archive className='h-4 w-4' />
                 Archived
-                {archivedProjectsData?.projects?.length &&
-                  archivedProjectsData?.projects?.length > 0 && (
+                {archivedProjectsData?.items?.length &&
+                  archivedProjectsData?.items?.length > 0 && (
                     <Badge variant='secondary' className='ml-1'>
-                      {archivedProjectsData?.projects?.length}
+                      {archivedProjectsData?.items?.length}
                     </Badge>
                   )}
               </TabsTrigger>
@@ -190,14 +190,14 @@ export function ProjectList() {
                 </div>
               ) : (
                 <div className='grid gap-6 md:grid-cols-2 lg:grid-cols-3'>
-                  {activeProjectsData?.projects.map((project) => (
+                  {activeProjectsData?.items.map((project) => (
                     <ProjectCard key={project.id} project={project} />
                   ))}
                 </div>
               )}
 
               {/* Empty State for Active Projects */}
-              {!activeLoading && activeProjectsData?.projects.length === 0 && (
+              {!activeLoading && activeProjectsData?.items.length === 0 && (
                 <div className='flex h-[400px] items-center justify-center'>
                   <div className='text-center'>
                     <div className='bg-muted mx-auto mb-4 flex h-12 w-12 items-center justify-center rounded-full'>
@@ -220,9 +220,9 @@ export function ProjectList() {
               {/* Results Info for Active Projects */}
               {!activeLoading &&
                 activeProjectsData &&
-                activeProjectsData.projects.length > 0 && (
+                activeProjectsData.items.length > 0 && (
                   <div className='text-muted-foreground text-center text-sm'>
-                    Showing {activeProjectsData.projects.length} of{' '}
+                    Showing {activeProjectsData.items.length} of{' '}
                     {activeProjectsData.total} active projects
                   </div>
                 )}
@@ -238,7 +238,7 @@ export function ProjectList() {
                 </div>
               ) : (
                 <div className='grid gap-6 md:grid-cols-2 lg:grid-cols-3'>
-                  {archivedProjectsData?.projects.map((project) => (
+                  {archivedProjectsData?.items.map((project) => (
                     <ArchivedProjectCard
                       key={project.id}
                       project={project}
@@ -250,7 +250,7 @@ export function ProjectList() {
 
               {/* Empty State for Archived Projects */}
               {!archivedLoading &&
-                archivedProjectsData?.projects.length === 0 && (
+                archivedProjectsData?.items.length === 0 && (
                   <div className='flex h-[400px] items-center justify-center'>
                     <div className='text-center'>
                       <div className='bg-muted mx-auto mb-4 flex h-12 w-12 items-center justify-center rounded-full'>
@@ -271,9 +271,9 @@ export function ProjectList() {
               {/* Results Info for Archived Projects */}
               {!archivedLoading &&
                 archivedProjectsData &&
-                archivedProjectsData.projects.length > 0 && (
+                archivedProjectsData.items.length > 0 && (
                   <div className='text-muted-foreground text-center text-sm'>
-                    Showing {archivedProjectsData.projects.length} of{' '}
+                    Showing {archivedProjectsData.items.length} of{' '}
                     {archivedProjectsData.total} archived projects
                   </div>
                 )}
@@ -0,0 +1,44 @@
+import type { ListResponse } from './list'
+
+// A user account. username is the name the user's changes are recorded
+// under, and their profile's.
+export interface User {
+  id: string
+  username: string
+  email?: string
+  created_at: string
+}
+
+export interface RegisterRequest {
+  username: string
+  email?: string
+  password: string
+}
+
+export interface LoginRequest {
+  username: string
+  password: string
+}
+
+// token is sent as "Authorization: Bearer" until expires_at
+export interface LoginResponse {
+  token: string
+  token_type: string
+  expires_at: string
+  user: User
+}
+
+export interface UserAPIKey {
+  id: string
+  name: string
+  prefix: string
+  last_used_at?: string
+  created_at: string
+}
+
+// key is only returned when the API key is created
+export interface CreatedUserAPIKey extends UserAPIKey {
+  key: string
+}
+
+export type UserAPIKeysResponse = ListResponse<UserAPIKey>
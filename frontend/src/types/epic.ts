@@ -0,0 +1,39 @@
+import type { ListResponse } from './list'
+
+// EpicProgress rolls up the tasks of an epic. Cancelled tasks are left out
+// of percent_complete.
+export interface EpicProgress {
+  epic_id: string
+  total_tasks: number
+  done_tasks: number
+  cancelled_tasks: number
+  percent_complete: number
+  estimated_hours: number
+  actual_hours: number
+}
+
+export interface Epic {
+  id: string
+  project_id: string
+  title: string
+  description?: string
+  due_date?: string
+  progress: EpicProgress
+  created_at: string
+  updated_at: string
+}
+
+export type EpicsResponse = ListResponse<Epic>
+
+export interface CreateEpicRequest {
+  title: string
+  description?: string
+  due_date?: string
+}
+
+export interface UpdateEpicRequest {
+  title?: string
+  description?: string
+  due_date?: string
+  clear_due_date?: boolean
+}
@@ -1,3 +1,5 @@
+import type { ListResponse } from './list'
+
 export type ExecutionStatus =
   | 'PENDING'
   | 'RUNNING'
@@ -5,6 +7,7 @@ export type ExecutionStatus =
   | 'COMPLETED'
   | 'FAILED'
   | 'CANCELLED'
+  | 'WAITING_INPUT'
 
 type LogLevel = 'debug' | 'info' | 'warn' | 'error'
 
@@ -25,6 +28,17 @@ export interface Execution {
   progress: number // 0.0 to 1.0
   result?: ExecutionResult
   duration?: number // in nanoseconds
+  // question the AI asked while the execution is WAITING_INPUT
+  question?: string
+  // dry runs previewed an implementation in a throwaway worktree
+  dry_run?: boolean
+  // planning or implementation; attempt numbers its runs from the first
+  // one, and a retry links to the failed execution it retries
+  phase?: 'planning' | 'implementation'
+  attempt: number
+  retry_of_id?: string
+  // OpenTelemetry trace the execution ran in, when tracing is on
+  trace_id?: string
   created_at: string
   updated_at: string
 }
@@ -48,6 +62,7 @@ export interface ExecutionLog {
   is_error?: boolean
   duration_ms?: number
   num_turns?: number
+  trace_id?: string
 }
 
 export interface ExecutionWithLogs extends Execution {
@@ -81,6 +96,12 @@ export interface CreateExecutionRequest {
   task_id: string
 }
 
+// AnswerQuestionRequest answers the question an execution waits on
+export interface AnswerQuestionRequest {
+  answer: string
+  answerer?: string
+}
+
 export interface UpdateExecutionRequest {
   status?: ExecutionStatus
   progress?: number
@@ -115,25 +136,9 @@ export interface ExecutionLogFilters {
 }
 
 // Response types
-export interface ExecutionListResponse {
-  data: Execution[]
-  meta: {
-    page: number
-    page_size: number
-    total: number
-    total_pages: number
-  }
-}
+export type ExecutionListResponse = ListResponse<Execution>
 
-export interface ExecutionLogListResponse {
-  data: ExecutionLog[]
-  meta: {
-    page: number
-    page_size: number
-    total: number
-    total_pages: number
-  }
-}
+export type ExecutionLogListResponse = ListResponse<ExecutionLog>
 
 // Status colors for UI
 export const EXECUTION_STATUS_COLORS: Record<ExecutionStatus, string> = {
@@ -143,4 +148,5 @@ export const EXECUTION_STATUS_COLORS: Record<ExecutionStatus, string> = {
   COMPLETED: 'bg-green-100 text-green-800',
   FAILED: 'bg-red-100 text-red-800',
   CANCELLED: 'bg-gray-100 text-gray-600',
+  WAITING_INPUT: 'bg-orange-100 text-orange-800',
 }
@@ -0,0 +1,18 @@
+// The health of the API server. status is degraded while the database or
+// Redis cannot be reached, or notifications wait for Redis.
+export interface HealthResponse {
+  status: 'ok' | 'degraded'
+  timestamp: string
+  version: string
+  database: ComponentHealth
+  redis?: RedisHealth
+}
+
+export interface ComponentHealth {
+  status: string
+  error?: string
+}
+
+export interface RedisHealth extends ComponentHealth {
+  buffered_notifications: number
+}
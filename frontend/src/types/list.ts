@@ -0,0 +1,8 @@
+// Envelope returned by every list endpoint
+export interface ListResponse<T> {
+  items: T[]
+  total: number
+  page: number
+  page_size: number
+  has_more: boolean
+}
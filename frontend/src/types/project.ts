@@ -1,3 +1,6 @@
+import type { ListResponse } from './list'
+import type { WorkflowType } from './task'
+
 export interface ActiveTaskCounts {
   planning: number
   plan_reviewing: number
@@ -5,6 +8,12 @@ export interface ActiveTaskCounts {
   code_reviewing: number
 }
 
+// Language of a project's notifications and generated PR and commit texts
+export type ProjectLocale = 'en' | 'vi'
+
+// Detected from the repository URL when unset
+export type GitProvider = 'github' | 'gitlab'
+
 export interface Project {
   id: string
   name: string
@@ -12,6 +21,11 @@ export interface Project {
   repository_url?: string
   worktree_base_path?: string
   init_workspace_script?: string
+  locale: ProjectLocale
+  export_patches: boolean
+  git_provider?: GitProvider
+  // AI executions running at once, 0 for no limit
+  max_concurrent_executions: number
   created_at: string
   updated_at: string
   active_task_counts: ActiveTaskCounts
@@ -22,6 +36,10 @@ export interface CreateProjectRequest {
   description?: string
   worktree_base_path: string
   init_workspace_script?: string
+  locale?: ProjectLocale
+  export_patches?: boolean
+  git_provider?: GitProvider
+  max_concurrent_executions?: number
 }
 
 export interface UpdateProjectRequest {
@@ -30,6 +48,11 @@ export interface UpdateProjectRequest {
   repository_url?: string
   worktree_base_path?: string
   init_workspace_script?: string
+  locale?: ProjectLocale
+  export_patches?: boolean
+  // 'auto' detects the provider from the repository URL again
+  git_provider?: GitProvider | 'auto'
+  max_concurrent_executions?: number
 }
 
 export interface ProjectFilters {
@@ -39,12 +62,7 @@ export interface ProjectFilters {
   archived?: boolean
 }
 
-export interface ProjectsResponse {
-  projects: Project[]
-  total: number
-  page: number
-  limit: number
-}
+export type ProjectsResponse = ListResponse<Project>
 
 export interface ProjectStatistics {
   total_tasks: number
@@ -59,3 +77,86 @@ export interface ProjectStatistics {
   }
   recent_activity: number
 }
+
+export type ProjectActivityType =
+  | 'task_created'
+  | 'status_changed'
+  | 'plan_approved'
+  | 'execution_started'
+  | 'execution_finished'
+  | 'pr_opened'
+  | 'pr_merged'
+  | 'pr_closed'
+
+// An entry of a project's activity feed; only the fields of its type are set
+export interface ProjectActivity {
+  type: ProjectActivityType
+  task_id: string
+  task_title: string
+  occurred_at: string
+  actor?: string
+  from_status?: string
+  to_status?: string
+  reason?: string
+  plan_id?: string
+  execution_id?: string
+  execution_status?: string
+  ai_type?: string
+  pull_request_number?: number
+  pull_request_url?: string
+}
+
+export interface ProjectActivityResponse extends ListResponse<ProjectActivity> {
+  project_id: string
+}
+
+// A project's preset of a workflow type; is_default is set for the
+// built-in one
+export interface WorkflowPreset {
+  project_id: string
+  workflow: WorkflowType
+  planning_instructions: string
+  implementation_instructions: string
+  require_test_changes: boolean
+  is_default: boolean
+  updated_at?: string
+}
+
+export interface UpdateWorkflowPresetRequest {
+  planning_instructions: string
+  implementation_instructions: string
+  require_test_changes: boolean
+}
+
+// A project's settings; the prompt prefix, suffix and context files are
+// applied to every AI run on its tasks
+export interface ProjectSettings {
+  id: string
+  project_id: string
+  auto_archive_days?: number
+  notifications_enabled: boolean
+  email_notifications: boolean
+  slack_webhook_url?: string
+  git_branch: string
+  git_auto_sync: boolean
+  task_prefix: string
+  prompt_prefix?: string
+  prompt_suffix?: string
+  context_files: string[]
+  created_at: string
+  updated_at: string
+}
+
+// Omitted fields are left unchanged
+export interface UpdateProjectSettingsRequest {
+  auto_archive_days?: number
+  notifications_enabled?: boolean
+  email_notifications?: boolean
+  slack_webhook_url?: string
+  git_branch?: string
+  git_auto_sync?: boolean
+  task_prefix?: string
+  prompt_prefix?: string
+  prompt_suffix?: string
+  context_files?: string[]
+}
@@ -0,0 +1,24 @@
+import type { ListResponse } from './list'
+
+export type SearchResultType = 'project' | 'task' | 'plan' | 'pull_request'
+
+// A quick search result. Tasks, plans and pull requests carry the ID and key
+// of their task, and pull requests their number.
+export interface SearchResult {
+  type: SearchResultType
+  id: string
+  project_id: string
+  project_name: string
+  task_id?: string
+  task_key?: string
+  title: string
+  snippet?: string
+  status?: string
+  pr_number?: number
+  score: number
+  updated_at: string
+}
+
+export interface SearchResponse extends ListResponse<SearchResult> {
+  query: string
+}
@@ -1,3 +1,6 @@
+import type { ListResponse } from './list'
+import type { PullRequest } from './pull-request'
+
 export type TaskStatus =
   | 'TODO'
   | 'PLANNING'
@@ -30,6 +33,10 @@ interface TaskGitInfo {
   last_sync?: string
 }
 
+// Selects the project's workflow preset adjusting how a task is planned,
+// implemented and verified
+export type WorkflowType = 'feature' | 'bugfix' | 'refactor'
+
 export interface Task {
   id: string
   project_id: string
@@ -44,6 +51,12 @@ export interface Task {
   updated_at: string
   completed_at?: string
   worktree_path?: string
+  epic_id?: string
+  workflow_type?: WorkflowType
+  // Planning runs so far, one more per plan rejected with feedback
+  planning_iterations?: number
+  // Place in the queue of a project running as many executions as it allows
+  queue_position?: number | null
   // Git information
   git_info?: TaskGitInfo
   // Error logs
@@ -57,16 +70,29 @@ export interface TaskPlan {
   status: string
   created_at: string
   updated_at: string
+  // The version an edit was saved as
+  version?: number
+  // Why the plan was rejected
+  feedback?: string
 }
 
-export interface TaskPlansResponse {
-  plans: TaskPlan[]
+export type TaskPlansResponse = ListResponse<TaskPlan>
+
+export interface PlanVersion {
+  version: number
+  content: string
+  created_by: string
+  created_at: string
 }
 
+export type PlanVersionsResponse = ListResponse<PlanVersion>
+
 export interface CreateTaskRequest {
   project_id: string
   title: string
   description?: string
+  epic_id?: string
+  workflow_type?: WorkflowType
 }
 
 export interface UpdateTaskRequest {
@@ -76,6 +102,7 @@ export interface UpdateTaskRequest {
   plan?: string
   branch_name?: string
   pr_url?: string
+  epic_id?: string
 }
 
 export interface TaskFilters {
@@ -87,12 +114,7 @@ export interface TaskFilters {
   sortOrder?: 'asc' | 'desc'
 }
 
-export interface TasksResponse {
-  tasks: Task[]
-  total: number
-  page: number
-  limit: number
-}
+export type TasksResponse = ListResponse<Task>
 
 // Start Planning types
 export interface StartPlanningRequest {
@@ -100,15 +122,166 @@ export interface StartPlanningRequest {
   ai_type: string
   auto_implement?: boolean
   use_remote_branch?: boolean
+  // ISO timestamp to start planning at, at most 30 days ahead
+  scheduled_at?: string
 }
 
 export interface StartPlanningResponse {
   message: string
   job_id: string
+  scheduled_at?: string
 }
 
 export interface ApprovePlanRequest {
   ai_type: string
+  // ISO timestamp to start implementation at, at most 30 days ahead
+  scheduled_at?: string
+}
+
+// ScheduledJob is a planning or implementation job waiting for its start time
+export interface ScheduledJob {
+  id: string
+  task_id: string
+  kind: 'planning' | 'implementation'
+  ai_type: string
+  scheduled_at: string
+}
+
+// RequestChangesRequest sends a task in code review back to implementation
+// with the reviewer's feedback
+export interface RequestChangesRequest {
+  feedback: string
+  ai_type: string
+  reviewer?: string
+}
+
+// RejectPlanWithFeedbackRequest rejects a plan under review and plans the
+// task again with the reviewer's feedback
+export interface RejectPlanWithFeedbackRequest {
+  feedback: string
+  ai_type: string
+  reviewer?: string
+}
+
+export interface TaskComment {
+  id: string
+  comment: string
+  created_by: string
+  created_at: string
+  updated_at: string
+}
+
+export interface ExecutionSummary {
+  id: string
+  status: string
+  ai_type?: string
+  started_at: string
+  completed_at?: string
+  progress: number
+  error?: string
+  log_count: number
+  updated_at: string
+}
+
+// TaskFullResponse is everything the task detail sheet shows, in one request
+export interface TaskFullResponse {
+  task: Task
+  latest_plan?: TaskPlan
+  latest_execution?: ExecutionSummary
+  pull_request?: PullRequest
+  comments: TaskComment[]
+}
+
+// TaskCard is what a board card shows of a task
+export interface TaskCard {
+  id: string
+  title: string
+  status: TaskStatus
+  priority: 'LOW' | 'MEDIUM' | 'HIGH' | 'URGENT'
+  tags?: string[]
+  git_status: string
+  branch_name?: string
+  pull_request?: string
+  parent_task_id?: string
+  epic_id?: string
+  updated_at: string
+}
+
+// TaskBoardColumn counts every task in the status, even when the cards of
+// done tasks were left out
+export interface TaskBoardColumn {
+  status: TaskStatus
+  display_name: string
+  count: number
+  tasks: TaskCard[]
+}
+
+export interface TaskBoardResponse {
+  project_id: string
+  total: number
+  columns: TaskBoardColumn[]
+}
+
+export type UndoOperationType = 'bulk_delete' | 'bulk_archive'
+
+// A bulk delete or archive, undoable with its token until expires_at
+export interface UndoOperationResponse {
+  operation: UndoOperationType
+  project_id: string
+  task_ids: string[]
+  affected_count: number
+  undo_token: string
+  expires_at: string
+  undone_at?: string
+}
+
+export type TaskChangesSource = 'worktree' | 'pr'
+
+export interface DiffLine {
+  type: 'context' | 'added' | 'deleted' | 'no_newline'
+  content: string
+  old_line?: number
+  new_line?: number
+}
+
+export interface DiffHunk {
+  old_start: number
+  old_lines: number
+  new_start: number
+  new_lines: number
+  header: string
+  lines: DiffLine[]
+}
+
+export interface FileDiff {
+  old_path: string
+  new_path: string
+  status: 'added' | 'deleted' | 'modified' | 'renamed'
+  binary: boolean
+  additions: number
+  deletions: number
+  hunks: DiffHunk[]
+}
+
+// TaskChangesResponse is what a task changed against its base branch, file
+// by file; source is empty when the task has neither a worktree nor a PR
+export interface TaskChangesResponse {
+  task_id: string
+  source: TaskChangesSource | ''
+  files_changed: number
+  additions: number
+  deletions: number
+  files: FileDiff[]
+}
+
+export interface TaskMediaResponse {
+  id: string
+  task_id: string
+  filename: string
+  file_size: number
+  mime_type: string
+  url: string
+  markdown: string
 }
 
 export function getAIs(forPlanning: boolean) {
@@ -132,9 +305,14 @@ export function getAIs(forPlanning: boolean) {
     value: 'deep-seek',
     description: 'Deep Seek',
   }
+  const ollama = {
+    name: 'Ollama',
+    value: 'ollama',
+    description: 'Local model served by Ollama',
+  }
   // Cursor Agent does not support planning, so it is not included in the planning AIs
   if (forPlanning) {
-    return [claudeCode, deepSeek, fakeCode]
+    return [claudeCode, deepSeek, ollama, fakeCode]
   }
-  return [claudeCode, deepSeek, fakeCode, cursorAgent]
+  return [claudeCode, deepSeek, ollama, fakeCode, cursorAgent]
 }
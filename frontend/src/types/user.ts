@@ -0,0 +1,19 @@
+import type { ListResponse } from './list'
+
+// The profile of a user recorded by name in created_by, assigned_to,
+// approver and comment author fields. name is what to show for them.
+export interface UserProfile {
+  username: string
+  display_name: string
+  name: string
+  timezone: string
+  avatar_url?: string
+  updated_at?: string
+}
+
+export type UserProfilesResponse = ListResponse<UserProfile>
+
+export interface UpdateUserProfileRequest {
+  display_name?: string
+  timezone?: string
+}
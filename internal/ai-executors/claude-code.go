@@ -33,6 +33,40 @@ func (e *ClaudeCodeExecutor) GetImplementationCommand(ctx context.Context, task
 	return command, prompt, nil, nil
 }
 
+func (e *ClaudeCodeExecutor) GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error) {
+	// Without --dangerously-skip-permissions, edits are denied in print mode
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateReviewPrompt(task, diff), nil, nil
+}
+
+func (e *ClaudeCodeExecutor) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --dangerously-skip-permissions --verbose --output-format=stream-json"
+	return command, generateFixPrompt(task, run), nil, nil
+}
+
+func (e *ClaudeCodeExecutor) GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateReleaseNotesPrompt(notes, changes), nil, nil
+}
+
+func (e *ClaudeCodeExecutor) GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateStandupPrompt(report), nil, nil
+}
+
+func (e *ClaudeCodeExecutor) GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error) {
+	args, err := resumeArgs(sessionID)
+	if err != nil {
+		return "", "", nil, err
+	}
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --dangerously-skip-permissions --verbose --output-format=stream-json" + args
+	return command, generateAnswerPrompt(answer), nil, nil
+}
+
+func (e *ClaudeCodeExecutor) ApplyCommandPolicy(command string, policy entity.CommandPolicy) string {
+	return applyClaudeCommandPolicy(command, policy)
+}
+
 func (e *ClaudeCodeExecutor) ParseOutputToLogs(output string) []*entity.ExecutionLog {
 	lines := strings.Split(output, "\n")
 	logs := make([]*entity.ExecutionLog, 0, len(lines))
@@ -104,7 +138,7 @@ func (e *ClaudeCodeExecutor) getImplementationPrompt(_ context.Context, task *en
 		Task Description: %s
 		`, task.Title, task.Description)
 	}
-	return prompt, nil
+	return prompt + questionInstructions, nil
 }
 
 // generatePlanningPrompt creates a structured prompt for AI planning phase
@@ -141,3 +175,31 @@ func (e *ClaudeCodeExecutor) ParseOutputToPlan(output string) (string, error) {
 	planContent := planOutput.Message.Content[0].Input.Plan
 	return planContent, nil
 }
+
+func (e *ClaudeCodeExecutor) ParseOutputToReview(output string) (string, error) {
+	return parseStreamJSONResult(output)
+}
+
+func (e *ClaudeCodeExecutor) ParseOutputToReleaseNotes(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseReleaseNotes(answer)
+}
+
+func (e *ClaudeCodeExecutor) ParseOutputToStandup(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseStandup(answer)
+}
+
+func (e *ClaudeCodeExecutor) ParseOutputToQuestion(output string) (string, string) {
+	return parseQuestion(output)
+}
+
+func (e *ClaudeCodeExecutor) ParseOutputToUsage(output string) *entity.ExecutionUsage {
+	return parseStreamJSONUsage(output)
+}
@@ -0,0 +1,40 @@
+package aiexecutors
+
+import (
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+const skipPermissionsFlag = "--dangerously-skip-permissions"
+
+// applyClaudeCommandPolicy adds Claude Code permission rules enforcing
+// policy to a claude-code command. Denied commands become --disallowedTools
+// rules, which hold in every permission mode. Allowed commands become
+// --allowedTools rules; those are ignored when permissions are skipped, so
+// such runs accept file edits instead and, in print mode, Claude Code
+// refuses every shell command no rule allows.
+func applyClaudeCommandPolicy(command string, policy entity.CommandPolicy) string {
+	if len(policy.Allowed) > 0 {
+		command = strings.Replace(command, skipPermissionsFlag, "--permission-mode=acceptEdits", 1)
+		command += " --allowedTools " + bashRules(policy.Allowed)
+	}
+	if len(policy.Denied) > 0 {
+		command += " --disallowedTools " + bashRules(policy.Denied)
+	}
+	return command
+}
+
+// bashRules returns the shell-quoted Bash permission rules matching commands
+// by prefix
+func bashRules(commands []string) string {
+	rules := make([]string, len(commands))
+	for i, command := range commands {
+		rules[i] = shellQuote("Bash(" + command + ":*)")
+	}
+	return strings.Join(rules, " ")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,23 @@
+package aiexecutors
+
+import (
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyClaudeCommandPolicy(t *testing.T) {
+	const implementation = "claude -p --dangerously-skip-permissions --output-format=stream-json"
+
+	denied := applyClaudeCommandPolicy(implementation, entity.CommandPolicy{Denied: []string{"npm publish", "echo 'hi'"}})
+	assert.Equal(t, implementation+` --disallowedTools 'Bash(npm publish:*)' 'Bash(echo '\''hi'\'':*)'`, denied)
+
+	allowed := applyClaudeCommandPolicy(implementation, entity.CommandPolicy{Allowed: []string{"go test"}, Denied: []string{"curl"}})
+	assert.Equal(t, "claude -p --permission-mode=acceptEdits --output-format=stream-json"+
+		` --allowedTools 'Bash(go test:*)' --disallowedTools 'Bash(curl:*)'`, allowed,
+		"allowed rules are ignored when permissions are skipped")
+
+	planning := "claude -p --permission-mode=plan"
+	assert.Equal(t, planning+` --allowedTools 'Bash(make:*)'`, applyClaudeCommandPolicy(planning, entity.CommandPolicy{Allowed: []string{"make"}}))
+}
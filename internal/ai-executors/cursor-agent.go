@@ -28,6 +28,36 @@ func (e *CursorAgentExecutor) GetImplementationCommand(ctx context.Context, task
 	return command, prompt, nil, nil
 }
 
+func (e *CursorAgentExecutor) GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error) {
+	// Without --force, file changes are not applied
+	command := "cursor-agent -p --output-format=stream-json"
+	return command, generateReviewPrompt(task, diff), nil, nil
+}
+
+func (e *CursorAgentExecutor) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	command := "cursor-agent -p --output-format=stream-json --force"
+	return command, generateFixPrompt(task, run), nil, nil
+}
+
+func (e *CursorAgentExecutor) GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error) {
+	command := "cursor-agent -p --output-format=stream-json"
+	return command, generateReleaseNotesPrompt(notes, changes), nil, nil
+}
+
+func (e *CursorAgentExecutor) GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error) {
+	command := "cursor-agent -p --output-format=stream-json"
+	return command, generateStandupPrompt(report), nil, nil
+}
+
+func (e *CursorAgentExecutor) GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error) {
+	args, err := resumeArgs(sessionID)
+	if err != nil {
+		return "", "", nil, err
+	}
+	command := "cursor-agent -p --output-format=stream-json --force" + args
+	return command, generateAnswerPrompt(answer), nil, nil
+}
+
 func (e *CursorAgentExecutor) ParseOutputToLogs(output string) []*entity.ExecutionLog {
 	lines := strings.Split(output, "\n")
 	logs := make([]*entity.ExecutionLog, 0, len(lines))
@@ -87,9 +117,37 @@ func (e *CursorAgentExecutor) getImplementationPrompt(_ context.Context, task *e
 		Task Description: %s
 		`, task.Title, task.Description)
 	}
-	return prompt, nil
+	return prompt + questionInstructions, nil
 }
 
 func (e *CursorAgentExecutor) ParseOutputToPlan(output string) (string, error) {
 	return "", fmt.Errorf(NOT_SUPPORT_PLANNING)
 }
+
+func (e *CursorAgentExecutor) ParseOutputToReview(output string) (string, error) {
+	return parseStreamJSONResult(output)
+}
+
+func (e *CursorAgentExecutor) ParseOutputToReleaseNotes(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseReleaseNotes(answer)
+}
+
+func (e *CursorAgentExecutor) ParseOutputToStandup(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseStandup(answer)
+}
+
+func (e *CursorAgentExecutor) ParseOutputToQuestion(output string) (string, string) {
+	return parseQuestion(output)
+}
+
+func (e *CursorAgentExecutor) ParseOutputToUsage(output string) *entity.ExecutionUsage {
+	return parseStreamJSONUsage(output)
+}
@@ -68,6 +68,39 @@ func (e *DeepSeekExecutor) GetImplementationCommand(ctx context.Context, task *e
 	return command, prompt, e.getEnvVars(), nil
 }
 
+func (e *DeepSeekExecutor) GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateReviewPrompt(task, diff), e.getEnvVars(), nil
+}
+
+func (e *DeepSeekExecutor) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --dangerously-skip-permissions --verbose --output-format=stream-json"
+	return command, generateFixPrompt(task, run), e.getEnvVars(), nil
+}
+
+func (e *DeepSeekExecutor) GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateReleaseNotesPrompt(notes, changes), e.getEnvVars(), nil
+}
+
+func (e *DeepSeekExecutor) GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error) {
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --verbose --output-format=stream-json"
+	return command, generateStandupPrompt(report), e.getEnvVars(), nil
+}
+
+func (e *DeepSeekExecutor) GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error) {
+	args, err := resumeArgs(sessionID)
+	if err != nil {
+		return "", "", nil, err
+	}
+	command := "npx -y @anthropic-ai/claude-code@2.1.119 -p --dangerously-skip-permissions --verbose --output-format=stream-json" + args
+	return command, generateAnswerPrompt(answer), e.getEnvVars(), nil
+}
+
+func (e *DeepSeekExecutor) ApplyCommandPolicy(command string, policy entity.CommandPolicy) string {
+	return applyClaudeCommandPolicy(command, policy)
+}
+
 func (e *DeepSeekExecutor) ParseOutputToLogs(output string) []*entity.ExecutionLog {
 	lines := strings.Split(output, "\n")
 	logs := make([]*entity.ExecutionLog, 0, len(lines))
@@ -139,7 +172,7 @@ func (e *DeepSeekExecutor) getImplementationPrompt(_ context.Context, task *enti
 		Task Description: %s
 		`, task.Title, task.Description)
 	}
-	return prompt, nil
+	return prompt + questionInstructions, nil
 }
 
 // generatePlanningPrompt creates a structured prompt for AI planning phase
@@ -176,3 +209,31 @@ func (e *DeepSeekExecutor) ParseOutputToPlan(output string) (string, error) {
 	planContent := planOutput.Message.Content[0].Input.Plan
 	return planContent, nil
 }
+
+func (e *DeepSeekExecutor) ParseOutputToReview(output string) (string, error) {
+	return parseStreamJSONResult(output)
+}
+
+func (e *DeepSeekExecutor) ParseOutputToReleaseNotes(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseReleaseNotes(answer)
+}
+
+func (e *DeepSeekExecutor) ParseOutputToStandup(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseStandup(answer)
+}
+
+func (e *DeepSeekExecutor) ParseOutputToQuestion(output string) (string, string) {
+	return parseQuestion(output)
+}
+
+func (e *DeepSeekExecutor) ParseOutputToUsage(output string) *entity.ExecutionUsage {
+	return parseStreamJSONUsage(output)
+}
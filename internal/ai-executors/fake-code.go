@@ -47,6 +47,62 @@ func (e *FakeCodeExecutor) GetImplementationCommand(ctx context.Context, task *e
 	return command, prompt, nil, nil
 }
 
+func (e *FakeCodeExecutor) GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error) {
+	projectPath, err := os.Getwd()
+	if err != nil {
+		return "", "", nil, err
+	}
+	fakeCliPath := filepath.Join(projectPath, "fake-cli", "fake-review-claude.js")
+	command := fmt.Sprintf("node %s", fakeCliPath)
+	return command, generateReviewPrompt(task, diff), nil, nil
+}
+
+func (e *FakeCodeExecutor) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	projectPath, err := os.Getwd()
+	if err != nil {
+		return "", "", nil, err
+	}
+	fakeCliPath := filepath.Join(projectPath, "fake-cli", "fake-claude.js")
+	command := fmt.Sprintf("node %s", fakeCliPath)
+	return command, generateFixPrompt(task, run), nil, nil
+}
+
+func (e *FakeCodeExecutor) GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error) {
+	projectPath, err := os.Getwd()
+	if err != nil {
+		return "", "", nil, err
+	}
+	fakeCliPath := filepath.Join(projectPath, "fake-cli", "fake-release-notes-claude.js")
+	command := fmt.Sprintf("node %s", fakeCliPath)
+	return command, generateReleaseNotesPrompt(notes, changes), nil, nil
+}
+
+func (e *FakeCodeExecutor) GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error) {
+	projectPath, err := os.Getwd()
+	if err != nil {
+		return "", "", nil, err
+	}
+	fakeCliPath := filepath.Join(projectPath, "fake-cli", "fake-standup-claude.js")
+	command := fmt.Sprintf("node %s", fakeCliPath)
+	return command, generateStandupPrompt(report), nil, nil
+}
+
+func (e *FakeCodeExecutor) GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error) {
+	projectPath, err := os.Getwd()
+	if err != nil {
+		return "", "", nil, err
+	}
+	fakeCliPath := filepath.Join(projectPath, "fake-cli", "fake-claude.js")
+	command := fmt.Sprintf("node %s", fakeCliPath)
+	return command, generateAnswerPrompt(answer), nil, nil
+}
+
+// ApplyCommandPolicy leaves command unchanged: the fake CLI replays canned
+// output and runs no shell commands
+func (e *FakeCodeExecutor) ApplyCommandPolicy(command string, policy entity.CommandPolicy) string {
+	return command
+}
+
 func (e *FakeCodeExecutor) ParseOutputToLogs(output string) []*entity.ExecutionLog {
 	lines := strings.Split(output, "\n")
 	logs := make([]*entity.ExecutionLog, 0, len(lines))
@@ -106,7 +162,7 @@ func (e *FakeCodeExecutor) getImplementationPrompt(_ context.Context, task *enti
 		Task Description: %s
 		`, task.Title, task.Description)
 	}
-	return prompt, nil
+	return prompt + questionInstructions, nil
 }
 
 // generatePlanningPrompt creates a structured prompt for AI planning phase
@@ -193,3 +249,31 @@ func (e *FakeCodeExecutor) ParseOutputToPlan(output string) (string, error) {
 	planContent := planOutput.Message.Content[0].Input.Plan
 	return planContent, nil
 }
+
+func (e *FakeCodeExecutor) ParseOutputToReview(output string) (string, error) {
+	return parseStreamJSONResult(output)
+}
+
+func (e *FakeCodeExecutor) ParseOutputToReleaseNotes(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseReleaseNotes(answer)
+}
+
+func (e *FakeCodeExecutor) ParseOutputToStandup(output string) (string, error) {
+	answer, err := parseStreamJSONResult(output)
+	if err != nil {
+		return "", err
+	}
+	return parseStandup(answer)
+}
+
+func (e *FakeCodeExecutor) ParseOutputToQuestion(output string) (string, string) {
+	return parseQuestion(output)
+}
+
+func (e *FakeCodeExecutor) ParseOutputToUsage(output string) *entity.ExecutionUsage {
+	return parseStreamJSONUsage(output)
+}
@@ -0,0 +1,36 @@
+package aiexecutors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// maxFixOutputBytes bounds the failure output quoted in the fix prompt; the
+// end of the output is kept, where build and test tools report what failed
+const maxFixOutputBytes = 20 * 1024
+
+// generateFixPrompt asks for the changes made for the task to be fixed so the
+// failing verification step passes, quoting the end of its output
+func generateFixPrompt(task *entity.Task, run *entity.VerificationRun) string {
+	var prompt strings.Builder
+	prompt.WriteString(`
+	You implemented the task below in this worktree, but the project's verification failed afterwards.
+	Fix the code so the failing command passes. Keep the changes focused on the failure:
+	do not rewrite unrelated code, and do not weaken, skip or delete tests to make them pass.
+	You may run the command yourself to check the fix.
+	`)
+	prompt.WriteString(fmt.Sprintf("\nTask: %s\nTask Description: %s\n", task.Title, task.Description))
+	if len(task.Plans) > 0 {
+		prompt.WriteString(fmt.Sprintf("Plan: %s\n", task.Plans[0].Content))
+	}
+
+	output := run.Output
+	if len(output) > maxFixOutputBytes {
+		output = "[output truncated]\n" + output[len(output)-maxFixOutputBytes:]
+	}
+	prompt.WriteString(fmt.Sprintf("\nFailing step: %s\nCommand: %s\nExit code: %d\nOutput:\n```\n%s\n```\n",
+		run.Step.GetDisplayName(), run.Command, run.ExitCode, strings.TrimSpace(output)))
+	return prompt.String()
+}
@@ -0,0 +1,21 @@
+package aiexecutors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateFixPrompt(t *testing.T) {
+	task := &entity.Task{Title: "Add login", Description: "Log users in", Plans: []entity.Plan{{Content: "1. Add the handler"}}}
+	run := &entity.VerificationRun{Step: entity.VerificationStepTest, Command: "go test ./...", ExitCode: 1, Output: strings.Repeat("x", maxFixOutputBytes) + "--- FAIL: TestLogin\n"}
+
+	prompt := generateFixPrompt(task, run)
+	assert.Contains(t, prompt, "Task: Add login")
+	assert.Contains(t, prompt, "Plan: 1. Add the handler")
+	assert.Contains(t, prompt, "Failing step: Test\nCommand: go test ./...\nExit code: 1\n")
+	assert.Contains(t, prompt, "[output truncated]")
+	assert.Contains(t, prompt, "--- FAIL: TestLogin\n```", "the end of the output is kept")
+}
@@ -0,0 +1,66 @@
+package aiexecutors
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// questionPrefix starts the line of a final answer asking the user a question
+const questionPrefix = "QUESTION:"
+
+// questionInstructions lets an implementation ask the user instead of
+// guessing when the task leaves a decision open
+const questionInstructions = `
+		If you cannot go on without a decision only the user can make, stop and end your final
+		answer with a single line starting with "` + questionPrefix + `" followed by your question.
+		The user's answer is sent back to you in this session. Do not ask when a sensible default exists.
+		`
+
+// sessionIDPattern matches the agent session IDs safe to pass to --resume
+var sessionIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// parseQuestion returns the question the agent asked at the end of a
+// stream-json run, and the session to resume with the answer. The question
+// is empty when the run finished without one, or when it cannot be resumed.
+func parseQuestion(output string) (string, string) {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		var result streamJSONResult
+		if err := json.Unmarshal([]byte(strings.TrimSpace(lines[i])), &result); err != nil || result.Type != "result" {
+			continue
+		}
+		if result.IsError || !sessionIDPattern.MatchString(result.SessionID) {
+			return "", ""
+		}
+		answerLines := strings.Split(strings.TrimSpace(result.Result), "\n")
+		last := strings.TrimSpace(answerLines[len(answerLines)-1])
+		question, ok := strings.CutPrefix(last, questionPrefix)
+		if !ok || strings.TrimSpace(question) == "" {
+			return "", ""
+		}
+		return strings.TrimSpace(question), result.SessionID
+	}
+	return "", ""
+}
+
+// resumeArgs returns the arguments resuming agent session sessionID, which
+// must be one parseQuestion returned
+func resumeArgs(sessionID string) (string, error) {
+	if !sessionIDPattern.MatchString(sessionID) {
+		return "", fmt.Errorf("invalid session id %q", sessionID)
+	}
+	return " --resume " + sessionID, nil
+}
+
+// generateAnswerPrompt sends the user's answer to the question the agent
+// asked, in the session it asked it in
+func generateAnswerPrompt(answer string) string {
+	return fmt.Sprintf(`
+		The user answered your question:
+		%s
+
+		Go on with the task.
+		`, answer) + questionInstructions
+}
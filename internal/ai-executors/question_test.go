@@ -0,0 +1,49 @@
+package aiexecutors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuestion(t *testing.T) {
+	asked := `{"type":"assistant","message":{"content":[{"type":"text","text":"Looking at the login form"}]}}
+{"type":"result","subtype":"success","is_error":false,"result":"I added the form.\nQUESTION: Should the old /login endpoint keep working?","session_id":"9d3ac8dd-5572-4bdc-ae86-ff1071e369e7"}`
+	question, sessionID := parseQuestion(asked)
+	assert.Equal(t, "Should the old /login endpoint keep working?", question)
+	assert.Equal(t, "9d3ac8dd-5572-4bdc-ae86-ff1071e369e7", sessionID)
+
+	tests := map[string]string{
+		"no question":        `{"type":"result","is_error":false,"result":"Done.","session_id":"abc"}`,
+		"question not last":  `{"type":"result","is_error":false,"result":"QUESTION: which one?\nI picked the first.","session_id":"abc"}`,
+		"failed run":         `{"type":"result","is_error":true,"result":"QUESTION: which one?","session_id":"abc"}`,
+		"no session":         `{"type":"result","is_error":false,"result":"QUESTION: which one?"}`,
+		"unsafe session":     `{"type":"result","is_error":false,"result":"QUESTION: which one?","session_id":"abc; rm -rf /"}`,
+		"empty question":     `{"type":"result","is_error":false,"result":"QUESTION:   ","session_id":"abc"}`,
+		"no result event":    `{"type":"assistant","message":{}}`,
+		"spaced no question": `{ "type": "result", "is_error": false, "result": "Done.", "session_id": "abc" }`,
+	}
+	for name, output := range tests {
+		t.Run(name, func(t *testing.T) {
+			question, sessionID := parseQuestion(output)
+			assert.Empty(t, question)
+			assert.Empty(t, sessionID)
+		})
+	}
+}
+
+func TestClaudeCodeExecutor_GetResumeCommand(t *testing.T) {
+	executor := NewClaudeCodeExecutor()
+
+	command, prompt, _, err := executor.GetResumeCommand(context.Background(), &entity.Task{}, "abc-123", "Keep it working")
+	require.NoError(t, err)
+	assert.Contains(t, command, " --resume abc-123")
+	assert.Contains(t, prompt, "Keep it working")
+	assert.Contains(t, prompt, questionPrefix)
+
+	_, _, _, err = executor.GetResumeCommand(context.Background(), &entity.Task{}, "abc; rm -rf /", "yes")
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package aiexecutors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// maxReleaseChangeDescriptionBytes bounds each change description quoted in
+// the release notes prompt, which can list hundreds of changes
+const maxReleaseChangeDescriptionBytes = 1000
+
+// generateReleaseNotesPrompt asks for release notes on the changes, grouped
+// by kind and written for people outside the team, answered in Markdown
+func generateReleaseNotesPrompt(notes *entity.ReleaseNotes, changes []entity.ReleaseChange) string {
+	var prompt strings.Builder
+	prompt.WriteString(`
+	Write the release notes of a software project from the changes listed below.
+	Do not modify any file and do not run any command; everything you need is in this message.
+
+	The readers are users and stakeholders, not the developers: describe what changed for them,
+	in plain language, rather than how it was implemented. Group the changes under these headings,
+	leaving out empty ones: "New features", "Improvements", "Bug fixes", "Other changes".
+	Merge changes that are parts of the same feature and leave out purely internal ones
+	(refactoring, tests, tooling) unless nothing else is left.
+
+	Answer with the release notes in Markdown and nothing else, starting with a level 1 heading.
+	`)
+	title := "Release notes"
+	if notes.Version != "" {
+		title = fmt.Sprintf("Release notes for %s", notes.Version)
+	}
+	prompt.WriteString(fmt.Sprintf("\nHeading: %s\nPeriod: %s to %s\n", title,
+		notes.From.Format("2006-01-02"), notes.To.Format("2006-01-02")))
+
+	prompt.WriteString("\nChanges:\n")
+	for _, change := range changes {
+		prompt.WriteString(fmt.Sprintf("\n- %s", change.Title))
+		if change.PullRequestNumber > 0 {
+			prompt.WriteString(fmt.Sprintf(" (pull request #%d)", change.PullRequestNumber))
+		}
+		prompt.WriteString("\n")
+		description := strings.TrimSpace(change.Description)
+		if len(description) > maxReleaseChangeDescriptionBytes {
+			description = description[:maxReleaseChangeDescriptionBytes] + " [truncated]"
+		}
+		if description != "" {
+			prompt.WriteString(fmt.Sprintf("  %s\n", strings.ReplaceAll(description, "\n", "\n  ")))
+		}
+	}
+	return prompt.String()
+}
+
+// parseReleaseNotes returns the release notes in an agent's final answer
+func parseReleaseNotes(answer string) (string, error) {
+	content := unwrapMarkdown(answer)
+	if content == "" {
+		return "", fmt.Errorf("release notes are empty")
+	}
+	return content, nil
+}
+
+// unwrapMarkdown returns a Markdown answer without the code block some
+// agents put it in
+func unwrapMarkdown(answer string) string {
+	content := strings.TrimSpace(answer)
+	for _, fence := range []string{"```markdown", "```md", "```"} {
+		if strings.HasPrefix(content, fence) && strings.HasSuffix(content, "```") && len(content) > len(fence)+3 {
+			return strings.TrimSpace(content[len(fence) : len(content)-3])
+		}
+	}
+	return content
+}
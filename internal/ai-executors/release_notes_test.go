@@ -0,0 +1,42 @@
+package aiexecutors
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateReleaseNotesPrompt(t *testing.T) {
+	notes := &entity.ReleaseNotes{
+		Version: "v1.4.0",
+		From:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+	changes := []entity.ReleaseChange{
+		{Title: "Add login page", Description: "Users sign in\nwith email", PullRequestNumber: 42},
+		{Title: "Fix search", Description: strings.Repeat("x", maxReleaseChangeDescriptionBytes+1)},
+	}
+
+	prompt := generateReleaseNotesPrompt(notes, changes)
+	assert.Contains(t, prompt, "Heading: Release notes for v1.4.0\nPeriod: 2024-03-01 to 2024-03-31")
+	assert.Contains(t, prompt, "- Add login page (pull request #42)\n  Users sign in\n  with email\n")
+	assert.Contains(t, prompt, "- Fix search\n")
+	assert.Contains(t, prompt, " [truncated]")
+}
+
+func TestParseReleaseNotes(t *testing.T) {
+	notes, err := parseReleaseNotes("```markdown\n# Release notes\n\n- Login\n```")
+	require.NoError(t, err)
+	assert.Equal(t, "# Release notes\n\n- Login", notes)
+
+	notes, err = parseReleaseNotes("  # Release notes\n")
+	require.NoError(t, err)
+	assert.Equal(t, "# Release notes", notes)
+
+	_, err = parseReleaseNotes("```\n```")
+	assert.Error(t, err)
+}
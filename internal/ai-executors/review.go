@@ -0,0 +1,113 @@
+package aiexecutors
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// generateReviewPrompt asks for a critique of the diff against the task, its
+// plan and the repository's conventions, answered with a JSON array that
+// ParseReviewComments reads back
+func generateReviewPrompt(task *entity.Task, diff string) string {
+	var prompt strings.Builder
+	prompt.WriteString(`
+	You are reviewing changes made by another AI agent for the task below, before a pull request is opened.
+	Do not modify any file. Read the surrounding code and the repository's own guidelines
+	(README, CONTRIBUTING, CLAUDE.md, AGENTS.md, linters' configuration) as needed.
+
+	Check that the diff does what the task and plan ask, and look for bugs, missing error handling,
+	security issues, missing tests and departures from the conventions of the repository.
+	Only report real problems; an empty list is a good answer for a good change.
+
+	Answer with a single JSON array and nothing else, in a ` + "```json" + ` code block. Each finding is an object:
+	{"file": "path/from/repo/root.go", "line": 42, "severity": "info|warning|error", "comment": "what is wrong and how to fix it"}
+	Use the line number in the new version of the file. Leave out "file" and "line" for findings about the change as a whole.
+	`)
+	prompt.WriteString(fmt.Sprintf("\nTask: %s\nTask Description: %s\n", task.Title, task.Description))
+	if len(task.Plans) > 0 {
+		prompt.WriteString(fmt.Sprintf("Plan: %s\n", task.Plans[0].Content))
+	}
+	prompt.WriteString(fmt.Sprintf("\nDiff:\n```diff\n%s\n```\n", diff))
+	return prompt.String()
+}
+
+// reviewFinding is a finding as the review prompt asks for it
+type reviewFinding struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Severity string `json:"severity"`
+	Comment  string `json:"comment"`
+}
+
+// ParseReviewComments reads the findings out of a reviewer's answer: the JSON
+// array in its last json code block, or the answer itself when it has no code
+// block. Findings with an unknown severity are kept as info.
+func ParseReviewComments(review string) ([]entity.ReviewComment, error) {
+	payload := strings.TrimSpace(review)
+	if start := strings.LastIndex(payload, "```json"); start >= 0 {
+		payload = payload[start+len("```json"):]
+		if end := strings.Index(payload, "```"); end >= 0 {
+			payload = payload[:end]
+		}
+	}
+
+	var findings []reviewFinding
+	if err := json.Unmarshal([]byte(strings.TrimSpace(payload)), &findings); err != nil {
+		return nil, fmt.Errorf("failed to parse review findings: %w", err)
+	}
+
+	comments := make([]entity.ReviewComment, 0, len(findings))
+	for _, finding := range findings {
+		body := strings.TrimSpace(finding.Comment)
+		if body == "" {
+			continue
+		}
+		comment := entity.ReviewComment{
+			FilePath: strings.TrimPrefix(strings.TrimSpace(finding.File), "./"),
+			Severity: entity.ReviewSeverity(strings.ToLower(finding.Severity)),
+			Body:     body,
+		}
+		if !comment.Severity.IsValid() {
+			comment.Severity = entity.ReviewSeverityInfo
+		}
+		if comment.FilePath != "" && finding.Line > 0 {
+			line := finding.Line
+			comment.Line = &line
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+// streamJSONResult is the last event of a stream-json run, holding the
+// agent's final answer
+type streamJSONResult struct {
+	Type    string `json:"type"`
+	IsError bool   `json:"is_error"`
+	Result  string `json:"result"`
+	// SessionID is the agent session, which a later run can resume
+	SessionID string `json:"session_id"`
+}
+
+// parseStreamJSONResult returns the final answer of a claude-code or
+// cursor-agent run made with --output-format=stream-json
+func parseStreamJSONResult(output string) (string, error) {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !strings.Contains(lines[i], "\"type\":\"result\"") {
+			continue
+		}
+		var result streamJSONResult
+		if err := json.Unmarshal([]byte(lines[i]), &result); err != nil {
+			return "", err
+		}
+		if result.IsError {
+			return "", fmt.Errorf("agent run failed: %s", result.Result)
+		}
+		return result.Result, nil
+	}
+	return "", fmt.Errorf("no result found in output")
+}
@@ -0,0 +1,52 @@
+package aiexecutors
+
+import (
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReviewComments(t *testing.T) {
+	review := "The change looks mostly fine.\n\n```json\n[\n" +
+		`{"file": "./auth/login.go", "line": 12, "severity": "ERROR", "comment": "Password is logged"},` +
+		`{"severity": "nitpick", "comment": "Consider a follow-up for rate limiting"},` +
+		`{"file": "auth/login_test.go", "line": 0, "severity": "warning", "comment": "No test for the lockout"},` +
+		`{"file": "auth/login.go", "severity": "info", "comment": "  "}` +
+		"\n]\n```"
+
+	comments, err := ParseReviewComments(review)
+	require.NoError(t, err)
+	require.Len(t, comments, 3, "findings without a comment are dropped")
+
+	assert.Equal(t, "auth/login.go", comments[0].FilePath)
+	assert.Equal(t, 12, *comments[0].Line)
+	assert.Equal(t, entity.ReviewSeverityError, comments[0].Severity)
+	assert.Equal(t, entity.ReviewSeverityInfo, comments[1].Severity, "unknown severities are kept as info")
+	assert.Empty(t, comments[1].FilePath)
+	assert.Nil(t, comments[2].Line)
+
+	comments, err = ParseReviewComments("[]")
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+
+	_, err = ParseReviewComments("Looks good to me!")
+	assert.Error(t, err)
+}
+
+func TestParseStreamJSONResult(t *testing.T) {
+	output := `{"type":"system","subtype":"init"}
+{"type":"assistant","message":{"content":[{"type":"text","text":"Reviewing"}]}}
+{"type":"result","subtype":"success","is_error":false,"result":"[]"}
+`
+	result, err := parseStreamJSONResult(output)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", result)
+
+	_, err = parseStreamJSONResult(`{"type":"result","subtype":"error_max_turns","is_error":true,"result":"Max turns"}`)
+	assert.Error(t, err)
+
+	_, err = parseStreamJSONResult(`{"type":"assistant"}`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,65 @@
+package aiexecutors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+)
+
+// maxStandupDetailBytes bounds each execution error quoted in the standup
+// prompt, which can be a whole stack trace
+const maxStandupDetailBytes = 500
+
+// generateStandupPrompt asks for the daily standup summary of a project
+// from what happened in it over the day, answered in Markdown
+func generateStandupPrompt(report *entity.StandupReport) string {
+	var prompt strings.Builder
+	prompt.WriteString(`
+	Write the daily standup summary of a software project from the facts listed below.
+	Do not modify any file and do not run any command; everything you need is in this message.
+
+	The readers are the team starting their day: in a few short bullet points, say what was done,
+	what went wrong and needs attention, and what waits for their review. Mention tasks by title,
+	point out failures that look related, and skip the sections with nothing to say.
+
+	Answer with the summary in Markdown and nothing else, without a heading.
+	`)
+	prompt.WriteString(fmt.Sprintf("\nProject: %s\nDay: %s\n", report.ProjectName, report.From.Format("2006-01-02")))
+	if locale := i18n.Parse(report.ProjectLocale); locale != i18n.DefaultLocale {
+		prompt.WriteString(fmt.Sprintf("Language: write the summary in the language of the %q locale\n", locale))
+	}
+	prompt.WriteString(fmt.Sprintf("AI executions started: %d\n", report.Executions))
+
+	writeTasks := func(heading string, tasks []entity.StandupTask) {
+		prompt.WriteString(fmt.Sprintf("\n%s:\n", heading))
+		if len(tasks) == 0 {
+			prompt.WriteString("- none\n")
+			return
+		}
+		for _, task := range tasks {
+			prompt.WriteString(fmt.Sprintf("- %s (%s)\n", task.Title, task.Status))
+			detail := strings.TrimSpace(task.Detail)
+			if len(detail) > maxStandupDetailBytes {
+				detail = detail[:maxStandupDetailBytes] + " [truncated]"
+			}
+			if detail != "" {
+				prompt.WriteString(fmt.Sprintf("  %s\n", strings.ReplaceAll(detail, "\n", "\n  ")))
+			}
+		}
+	}
+	writeTasks("Tasks completed", report.CompletedTasks)
+	writeTasks("Tasks whose execution failed, with the error", report.FailedTasks)
+	writeTasks("Tasks waiting for review", report.PendingReviews)
+	return prompt.String()
+}
+
+// parseStandup returns the standup summary in an agent's final answer
+func parseStandup(answer string) (string, error) {
+	content := unwrapMarkdown(answer)
+	if content == "" {
+		return "", fmt.Errorf("standup summary is empty")
+	}
+	return content, nil
+}
@@ -0,0 +1,45 @@
+package aiexecutors
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateStandupPrompt(t *testing.T) {
+	report := &entity.StandupReport{
+		ProjectName: "Shop",
+		From:        time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		Executions:  3,
+		CompletedTasks: []entity.StandupTask{
+			{Title: "Add login", Status: entity.TaskStatusDONE},
+		},
+		FailedTasks: []entity.StandupTask{
+			{Title: "Add search", Status: entity.TaskStatusCODEREVIEWING, Detail: "tests failed\n" + strings.Repeat("x", maxStandupDetailBytes)},
+		},
+	}
+
+	prompt := generateStandupPrompt(report)
+	assert.Contains(t, prompt, "Project: Shop\nDay: 2024-03-04\nAI executions started: 3\n")
+	assert.Contains(t, prompt, "Tasks completed:\n- Add login (DONE)\n")
+	assert.Contains(t, prompt, "- Add search (CODE_REVIEWING)\n  tests failed\n  xxx")
+	assert.Contains(t, prompt, " [truncated]")
+	assert.Contains(t, prompt, "Tasks waiting for review:\n- none\n")
+	assert.NotContains(t, prompt, "Language:")
+
+	report.ProjectLocale = "vi-VN"
+	assert.Contains(t, generateStandupPrompt(report), "Language: write the summary in the language of the \"vi\" locale\n")
+}
+
+func TestParseStandup(t *testing.T) {
+	summary, err := parseStandup("```markdown\n- Login shipped\n```")
+	require.NoError(t, err)
+	assert.Equal(t, "- Login shipped", summary)
+
+	_, err = parseStandup("  \n")
+	assert.Error(t, err)
+}
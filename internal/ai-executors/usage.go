@@ -0,0 +1,42 @@
+package aiexecutors
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// streamJSONUsage is what the result event of a stream-json run reports
+// about the tokens the run used and its cost
+type streamJSONUsage struct {
+	TotalCostUSD float64 `json:"total_cost_usd"`
+	Usage        struct {
+		InputTokens              int64 `json:"input_tokens"`
+		CacheCreationInputTokens int64 `json:"cache_creation_input_tokens"`
+		CacheReadInputTokens     int64 `json:"cache_read_input_tokens"`
+		OutputTokens             int64 `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+// parseStreamJSONUsage returns the usage reported by the result event of a
+// run made with --output-format=stream-json, or nil when the output has no
+// result event
+func parseStreamJSONUsage(output string) *entity.ExecutionUsage {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !strings.Contains(lines[i], "\"type\":\"result\"") {
+			continue
+		}
+		var result streamJSONUsage
+		if err := json.Unmarshal([]byte(lines[i]), &result); err != nil {
+			return nil
+		}
+		return &entity.ExecutionUsage{
+			InputTokens:  result.Usage.InputTokens + result.Usage.CacheCreationInputTokens + result.Usage.CacheReadInputTokens,
+			OutputTokens: result.Usage.OutputTokens,
+			CostUSD:      result.TotalCostUSD,
+		}
+	}
+	return nil
+}
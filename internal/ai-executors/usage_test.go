@@ -0,0 +1,30 @@
+package aiexecutors
+
+import (
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStreamJSONUsage(t *testing.T) {
+	output := `{"type":"system","subtype":"init","session_id":"9d3ac8dd"}
+{"type":"assistant","message":{"content":[{"type":"text","text":"Done"}],"usage":{"input_tokens":3,"output_tokens":12}}}
+{"type":"result","subtype":"success","is_error":false,"result":"Done","total_cost_usd":0.0421,"usage":{"input_tokens":120,"cache_creation_input_tokens":400,"cache_read_input_tokens":6000,"output_tokens":850}}
+`
+	usage := parseStreamJSONUsage(output)
+	require.NotNil(t, usage)
+	assert.Equal(t, entity.ExecutionUsage{InputTokens: 6520, OutputTokens: 850, CostUSD: 0.0421}, *usage)
+
+	t.Run("no result", func(t *testing.T) {
+		assert.Nil(t, parseStreamJSONUsage(`{"type":"assistant","message":{"content":[]}}`))
+		assert.Nil(t, parseStreamJSONUsage("plain text output"))
+	})
+
+	t.Run("result without usage", func(t *testing.T) {
+		usage := parseStreamJSONUsage(`{"type":"result","subtype":"success","is_error":false,"result":"Done","duration_ms":1200}`)
+		require.NotNil(t, usage)
+		assert.Equal(t, entity.ExecutionUsage{}, *usage)
+	})
+}
@@ -0,0 +1,166 @@
+// Package cli implements the autodevs command-line client, which drives the
+// task workflow (create, plan, approve, follow execution logs) over the REST
+// API.
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// version is reported to MCP clients
+const version = "1.0"
+
+const (
+	defaultServerURL    = "http://localhost:8098"
+	defaultPollInterval = 2 * time.Second
+)
+
+// ErrUsage is returned for invalid command lines, after usage has been
+// printed.
+var ErrUsage = errors.New("invalid usage")
+
+type command struct {
+	usage   string
+	summary string
+	run     func(ctx context.Context, a *app, args []string) error
+}
+
+// commands maps "<resource> <verb>" to its implementation.
+var commands = map[string]command{
+	"project list": {"project list [--archived]", "List projects", projectList},
+	"task create":  {"task create --project ID --title TITLE [--description TEXT]", "Create a task", taskCreate},
+	"task list":    {"task list --project ID [--all]", "List a project's tasks", taskList},
+	"task show":    {"task show TASK_ID", "Show a task", taskShow},
+	"task plan":    {"task plan TASK_ID [--branch main] [--ai claude-code] [--auto-implement]", "Start planning a task", taskPlan},
+	"plan show":    {"plan show TASK_ID", "Show the plans of a task", planShow},
+	"plan approve": {"plan approve TASK_ID [--ai claude-code] [--reviewer NAME] [--totp CODE]", "Approve a task's plan and start implementing", planApprove},
+	"plan reject":  {"plan reject TASK_ID [--reviewer NAME] [--reason TEXT]", "Reject a task's plan and send the task back to TODO", planReject},
+	"exec list":    {"exec list TASK_ID", "List the executions of a task", execList},
+	"exec logs":    {"exec logs EXECUTION_ID [--follow]", "Print execution logs", execLogs},
+	"mcp serve":    {"mcp serve", "Serve the Model Context Protocol over stdio", mcpServe},
+}
+
+type app struct {
+	client       *Client
+	in           io.Reader
+	out          io.Writer
+	errOut       io.Writer
+	json         bool
+	pollInterval time.Duration
+}
+
+// Run executes one CLI invocation; args excludes the program name.
+// Connection settings default to the AUTODEVS_SERVER, AUTODEVS_API_KEY and
+// AUTODEVS_ORG environment variables.
+func Run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("autodevs", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	server := fs.String("server", envOr("AUTODEVS_SERVER", defaultServerURL), "API server URL")
+	apiKey := fs.String("api-key", os.Getenv("AUTODEVS_API_KEY"), "API key")
+	org := fs.String("org", os.Getenv("AUTODEVS_ORG"), "Organization ID or slug")
+	jsonOutput := fs.Bool("json", false, "Print raw JSON responses")
+	fs.Usage = func() { printUsage(stderr, fs) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return ErrUsage
+	}
+
+	rest := fs.Args()
+	if len(rest) < 2 {
+		fs.Usage()
+		return ErrUsage
+	}
+	cmd, ok := commands[rest[0]+" "+rest[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n\n", strings.Join(rest[:2], " "))
+		fs.Usage()
+		return ErrUsage
+	}
+
+	a := &app{
+		client:       NewClient(*server, *apiKey, *org),
+		in:           stdin,
+		out:          stdout,
+		errOut:       stderr,
+		json:         *jsonOutput,
+		pollInterval: defaultPollInterval,
+	}
+	return cmd.run(ctx, a, rest[2:])
+}
+
+func printUsage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(w, "Usage: autodevs [flags] <command> [args]")
+	fmt.Fprintln(w, "\nCommands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s\t%s\n", commands[name].usage, commands[name].summary)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nFlags:")
+	fs.PrintDefaults()
+}
+
+// parseCommandFlags parses a command's flags, allowing them before or after
+// its positional arguments, and checks the number of positional arguments.
+func parseCommandFlags(fs *flag.FlagSet, args []string, positional int) ([]string, error) {
+	fs.SetOutput(io.Discard)
+
+	var values []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUsage, err)
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		values = append(values, args[0])
+		args = args[1:]
+	}
+
+	if len(values) != positional {
+		return nil, fmt.Errorf("%w: expected %d argument(s), got %d", ErrUsage, positional, len(values))
+	}
+	return values, nil
+}
+
+// print writes v as JSON in --json mode, or as the given table otherwise.
+func (a *app) print(v any, table func(w io.Writer)) error {
+	if a.json {
+		enc := json.NewEncoder(a.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCLI(t *testing.T, server *httptest.Server, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := Run(context.Background(), append([]string{"--server", server.URL, "--api-key", "secret", "--org", "acme"}, args...), nil, &stdout, &stderr)
+	return stdout.String(), err
+}
+
+func TestRun_TaskCreate(t *testing.T) {
+	projectID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/tasks", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "acme", r.Header.Get(organizationHeader))
+
+		var req dto.TaskCreateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, dto.TaskCreateRequest{ProjectID: projectID, Title: "Add login", Description: "JWT"}, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(dto.TaskResponse{ID: uuid.New(), ProjectID: projectID, Title: req.Title, Status: entity.TaskStatusTODO})
+	}))
+	defer server.Close()
+
+	out, err := runCLI(t, server, "task", "create", "--project", projectID.String(), "--title", "Add login", "--description", "JWT")
+
+	require.NoError(t, err)
+	assert.Contains(t, out, "Add login")
+	assert.Contains(t, out, "TODO")
+}
+
+func TestRun_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(dto.NewErrorResponseWithCode(nil, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+	}))
+	defer server.Close()
+
+	_, err := runCLI(t, server, "task", "show", uuid.NewString())
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+	assert.Equal(t, dto.ErrorCodeTaskNotFound, apiErr.ErrorCode)
+	assert.Equal(t, "Task not found", apiErr.Message)
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	for _, args := range [][]string{
+		{},
+		{"task"},
+		{"task", "explode"},
+		{"task", "show"},
+		{"task", "show", "not-a-uuid"},
+		{"task", "create", "--project", uuid.NewString()},
+	} {
+		_, err := runCLI(t, server, args...)
+		assert.ErrorIs(t, err, ErrUsage, "args %v", args)
+	}
+}
+
+func TestExecLogs_Follow(t *testing.T) {
+	executionID := uuid.New()
+	logs := make([]dto.ExecutionLogResponse, 0, logsPageSize+2)
+	for i := range logsPageSize + 2 {
+		logs = append(logs, dto.ExecutionLogResponse{Level: entity.LogLevelInfo, Message: "line " + strconv.Itoa(i)})
+	}
+
+	// The execution writes its last log between the first and second polls
+	var polls atomic.Int32
+	available := func() []dto.ExecutionLogResponse {
+		if polls.Load() == 0 {
+			return logs[:len(logs)-1]
+		}
+		return logs
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/executions/"+executionID.String()+"/logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "asc", r.URL.Query().Get("order_dir"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		items := available()
+		start := min((page-1)*logsPageSize, len(items))
+		end := min(start+logsPageSize, len(items))
+
+		_ = json.NewEncoder(w).Encode(dto.ExecutionLogListResponse{
+			Items:    items[start:end],
+			ListMeta: dto.NewListMeta(len(items), page, logsPageSize),
+		})
+	})
+	mux.HandleFunc("/api/v2/executions/"+executionID.String(), func(w http.ResponseWriter, r *http.Request) {
+		status := entity.ExecutionStatusRunning
+		if polls.Add(1) > 1 {
+			status = entity.ExecutionStatusCompleted
+		}
+		_ = json.NewEncoder(w).Encode(dto.ExecutionResponse{ID: executionID, Status: status})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var out bytes.Buffer
+	a := &app{client: NewClient(server.URL, "", ""), out: &out, pollInterval: time.Millisecond}
+	require.NoError(t, execLogs(context.Background(), a, []string{executionID.String(), "--follow"}))
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, len(logs))
+	assert.Contains(t, string(lines[0]), "[INFO] line 0")
+	assert.Contains(t, string(lines[len(lines)-1]), "line "+strconv.Itoa(len(logs)-1))
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+
+	// organizationHeader mirrors handler.OrganizationHeader
+	organizationHeader = "X-Organization-ID"
+)
+
+// APIError is a failed API call, decoded from the server's standard error
+// body so callers can branch on the error code.
+type APIError struct {
+	Status    int
+	ErrorCode dto.ErrorCode
+	Message   string
+}
+
+func (e *APIError) Error() string {
+	if e.ErrorCode != "" {
+		return fmt.Sprintf("%s (%s, HTTP %d)", e.Message, e.ErrorCode, e.Status)
+	}
+	return fmt.Sprintf("%s (HTTP %d)", e.Message, e.Status)
+}
+
+// Client calls the auto-devs REST API (v2).
+type Client struct {
+	baseURL      string
+	apiKey       string
+	organization string
+	httpClient   *http.Client
+}
+
+// NewClient builds a Client for the server at serverURL. The API key is sent
+// as a bearer token; the organization, when set, scopes every request.
+func NewClient(serverURL, apiKey, organization string) *Client {
+	return &Client{
+		baseURL:      strings.TrimRight(serverURL, "/") + "/api/v2",
+		apiKey:       apiKey,
+		organization: organization,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+func (c *Client) ListProjects(ctx context.Context, archived bool) (*dto.ProjectListResponse, error) {
+	query := url.Values{
+		"page_size": {"0"},
+		"archived":  {strconv.FormatBool(archived)},
+	}
+	var resp dto.ProjectListResponse
+	return &resp, c.do(ctx, http.MethodGet, "/projects?"+query.Encode(), nil, &resp)
+}
+
+func (c *Client) CreateTask(ctx context.Context, req dto.TaskCreateRequest) (*dto.TaskResponse, error) {
+	var resp dto.TaskResponse
+	return &resp, c.do(ctx, http.MethodPost, "/tasks", req, &resp)
+}
+
+func (c *Client) ListTasks(ctx context.Context, projectID uuid.UUID, includeDone bool) (*dto.TaskListResponse, error) {
+	query := url.Values{
+		"page_size":    {"0"},
+		"include_done": {strconv.FormatBool(includeDone)},
+	}
+	var resp dto.TaskListResponse
+	return &resp, c.do(ctx, http.MethodGet, "/projects/"+projectID.String()+"/tasks?"+query.Encode(), nil, &resp)
+}
+
+func (c *Client) GetTask(ctx context.Context, taskID uuid.UUID) (*dto.TaskResponse, error) {
+	var resp dto.TaskResponse
+	return &resp, c.do(ctx, http.MethodGet, "/tasks/"+taskID.String(), nil, &resp)
+}
+
+func (c *Client) StartPlanning(ctx context.Context, taskID uuid.UUID, req dto.StartPlanningRequest) (*dto.StartPlanningResponse, error) {
+	var resp dto.StartPlanningResponse
+	return &resp, c.do(ctx, http.MethodPost, "/tasks/"+taskID.String()+"/start-planning", req, &resp)
+}
+
+func (c *Client) ListPlans(ctx context.Context, taskID uuid.UUID) (*dto.TaskPlansResponse, error) {
+	var resp dto.TaskPlansResponse
+	return &resp, c.do(ctx, http.MethodGet, "/tasks/"+taskID.String()+"/plans?page_size=0", nil, &resp)
+}
+
+func (c *Client) ApprovePlan(ctx context.Context, taskID uuid.UUID, req dto.ApprovePlanRequest) (*dto.StartPlanningResponse, error) {
+	var resp dto.StartPlanningResponse
+	return &resp, c.do(ctx, http.MethodPost, "/tasks/"+taskID.String()+"/approve-plan", req, &resp)
+}
+
+func (c *Client) RejectPlan(ctx context.Context, taskID uuid.UUID, req dto.RejectPlanRequest) (*dto.TaskResponse, error) {
+	var resp dto.TaskResponse
+	return &resp, c.do(ctx, http.MethodPost, "/tasks/"+taskID.String()+"/reject-plan", req, &resp)
+}
+
+func (c *Client) ListExecutions(ctx context.Context, taskID uuid.UUID) (*dto.ExecutionListResponse, error) {
+	var resp dto.ExecutionListResponse
+	return &resp, c.do(ctx, http.MethodGet, "/tasks/"+taskID.String()+"/executions?page_size=100", nil, &resp)
+}
+
+func (c *Client) GetExecution(ctx context.Context, executionID uuid.UUID) (*dto.ExecutionResponse, error) {
+	var resp dto.ExecutionResponse
+	return &resp, c.do(ctx, http.MethodGet, "/executions/"+executionID.String(), nil, &resp)
+}
+
+// ExecutionLogs returns one page of an execution's logs, oldest first.
+func (c *Client) ExecutionLogs(ctx context.Context, executionID uuid.UUID, page, pageSize int) (*dto.ExecutionLogListResponse, error) {
+	query := url.Values{
+		"page":      {strconv.Itoa(page)},
+		"page_size": {strconv.Itoa(pageSize)},
+		"order_by":  {"timestamp"},
+		"order_dir": {"asc"},
+	}
+	var resp dto.ExecutionLogListResponse
+	return &resp, c.do(ctx, http.MethodGet, "/executions/"+executionID.String()+"/logs?"+query.Encode(), nil, &resp)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, out any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	if c.organization != "" {
+		req.Header.Set(organizationHeader, c.organization)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return decodeAPIError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s %s response: %w", method, path, err)
+	}
+	return nil
+}
+
+func decodeAPIError(resp *http.Response) error {
+	apiErr := &APIError{Status: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 8192))
+	var errResp dto.ErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && (errResp.Message != "" || errResp.Error != "") {
+		apiErr.ErrorCode = errResp.ErrorCode
+		apiErr.Message = errResp.Message
+		if errResp.Error != "" && errResp.Error != errResp.Message {
+			apiErr.Message = strings.TrimPrefix(errResp.Message+": "+errResp.Error, ": ")
+		}
+	}
+	return apiErr
+}
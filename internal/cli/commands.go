@@ -0,0 +1,332 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/mcp"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultBranch = "main"
+	defaultAIType = "claude-code"
+
+	logsPageSize = 100
+)
+
+func projectList(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("project list", flag.ContinueOnError)
+	archived := fs.Bool("archived", false, "List archived projects instead")
+	if _, err := parseCommandFlags(fs, args, 0); err != nil {
+		return err
+	}
+
+	resp, err := a.client.ListProjects(ctx, *archived)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tREPOSITORY")
+		for _, p := range resp.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", p.ID, p.Name, p.RepositoryURL)
+		}
+	})
+}
+
+func taskCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("task create", flag.ContinueOnError)
+	project := fs.String("project", "", "Project ID")
+	title := fs.String("title", "", "Task title")
+	description := fs.String("description", "", "Task description")
+	if _, err := parseCommandFlags(fs, args, 0); err != nil {
+		return err
+	}
+	projectID, err := parseID("project", *project)
+	if err != nil {
+		return err
+	}
+	if *title == "" {
+		return fmt.Errorf("%w: --title is required", ErrUsage)
+	}
+
+	task, err := a.client.CreateTask(ctx, dto.TaskCreateRequest{
+		ProjectID:   projectID,
+		Title:       *title,
+		Description: *description,
+	})
+	if err != nil {
+		return err
+	}
+	return a.print(task, func(w io.Writer) { printTask(w, task) })
+}
+
+func taskList(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("task list", flag.ContinueOnError)
+	project := fs.String("project", "", "Project ID")
+	all := fs.Bool("all", false, "Include DONE tasks")
+	if _, err := parseCommandFlags(fs, args, 0); err != nil {
+		return err
+	}
+	projectID, err := parseID("project", *project)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.ListTasks(ctx, projectID, *all)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tSTATUS\tTITLE")
+		for _, t := range resp.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", t.ID, t.Status, t.Title)
+		}
+	})
+}
+
+func taskShow(ctx context.Context, a *app, args []string) error {
+	taskID, err := parseTaskArg(flag.NewFlagSet("task show", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	task, err := a.client.GetTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return a.print(task, func(w io.Writer) { printTask(w, task) })
+}
+
+func taskPlan(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("task plan", flag.ContinueOnError)
+	branch := fs.String("branch", defaultBranch, "Base branch for the task worktree")
+	aiType := fs.String("ai", defaultAIType, "AI executor")
+	autoImplement := fs.Bool("auto-implement", false, "Start implementing as soon as the plan is ready")
+	remote := fs.Bool("remote", false, "Branch from the remote copy of --branch")
+	taskID, err := parseTaskArg(fs, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.StartPlanning(ctx, taskID, dto.StartPlanningRequest{
+		BranchName:      *branch,
+		AIType:          *aiType,
+		AutoImplement:   *autoImplement,
+		UseRemoteBranch: *remote,
+	})
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		fmt.Fprintf(w, "%s (job %s)\n", resp.Message, resp.JobID)
+	})
+}
+
+func planShow(ctx context.Context, a *app, args []string) error {
+	taskID, err := parseTaskArg(flag.NewFlagSet("plan show", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.ListPlans(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		if len(resp.Items) == 0 {
+			fmt.Fprintln(w, "No plans yet")
+			return
+		}
+		// Plans are sorted newest first; show the current one in full
+		plan := resp.Items[0]
+		fmt.Fprintf(w, "Plan %s (%s, updated %s)\n\n", plan.ID, plan.Status, plan.UpdatedAt.Format(time.RFC3339))
+		fmt.Fprintln(w, plan.Content)
+	})
+}
+
+func planApprove(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("plan approve", flag.ContinueOnError)
+	aiType := fs.String("ai", defaultAIType, "AI executor")
+	reviewer := fs.String("reviewer", "", "Who approved the plan")
+	totpCode := fs.String("totp", "", "TOTP code confirming the plan of a high-risk task")
+	taskID, err := parseTaskArg(fs, args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.ApprovePlan(ctx, taskID, dto.ApprovePlanRequest{AIType: *aiType, Reviewer: *reviewer, TOTPCode: *totpCode})
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		// High-risk plans waiting for another approval have no job yet
+		if resp.JobID == "" {
+			fmt.Fprintln(w, resp.Message)
+			return
+		}
+		fmt.Fprintf(w, "%s (job %s)\n", resp.Message, resp.JobID)
+	})
+}
+
+func planReject(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("plan reject", flag.ContinueOnError)
+	reviewer := fs.String("reviewer", "", "Who rejected the plan")
+	reason := fs.String("reason", "", "Why the plan was rejected")
+	taskID, err := parseTaskArg(fs, args)
+	if err != nil {
+		return err
+	}
+
+	task, err := a.client.RejectPlan(ctx, taskID, dto.RejectPlanRequest{Reviewer: *reviewer, Reason: *reason})
+	if err != nil {
+		return err
+	}
+	return a.print(task, func(w io.Writer) {
+		fmt.Fprintf(w, "Plan rejected, task %s is back in %s\n", task.ID, task.Status)
+	})
+}
+
+func execList(ctx context.Context, a *app, args []string) error {
+	taskID, err := parseTaskArg(flag.NewFlagSet("exec list", flag.ContinueOnError), args)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.ListExecutions(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return a.print(resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tSTATUS\tSTARTED\tERROR")
+		for _, e := range resp.Items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.ID, e.Status, e.StartedAt.Format(time.RFC3339), e.Error)
+		}
+	})
+}
+
+func execLogs(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("exec logs", flag.ContinueOnError)
+	follow := fs.Bool("follow", false, "Keep printing new logs until the execution finishes")
+	fs.BoolVar(follow, "f", false, "Shorthand for --follow")
+	values, err := parseCommandFlags(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	executionID, err := parseID("execution", values[0])
+	if err != nil {
+		return err
+	}
+
+	// Logs are read oldest first; offset counts the ones already printed
+	offset := 0
+	for {
+		page := offset/logsPageSize + 1
+		resp, err := a.client.ExecutionLogs(ctx, executionID, page, logsPageSize)
+		if err != nil {
+			return err
+		}
+
+		skip := min(offset%logsPageSize, len(resp.Items))
+		for _, log := range resp.Items[skip:] {
+			if err := a.printLog(log); err != nil {
+				return err
+			}
+		}
+		offset += len(resp.Items) - skip
+
+		if resp.HasMore {
+			continue
+		}
+		if !*follow {
+			return nil
+		}
+
+		execution, err := a.client.GetExecution(ctx, executionID)
+		if err != nil {
+			return err
+		}
+		if isFinished(execution.Status) && offset >= resp.Total {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(a.pollInterval):
+		}
+	}
+}
+
+func (a *app) printLog(log dto.ExecutionLogResponse) error {
+	if a.json {
+		return a.print(log, nil)
+	}
+	message := log.Message
+	if log.Encrypted {
+		message = "(encrypted, needs an API key)"
+	}
+	_, err := fmt.Fprintf(a.out, "%s [%s] %s\n", log.Timestamp.Format(time.TimeOnly), log.Level, message)
+	return err
+}
+
+func printTask(w io.Writer, task *dto.TaskResponse) {
+	fmt.Fprintf(w, "ID:\t%s\n", task.ID)
+	fmt.Fprintf(w, "Project:\t%s\n", task.ProjectID)
+	fmt.Fprintf(w, "Title:\t%s\n", task.Title)
+	fmt.Fprintf(w, "Status:\t%s\n", task.Status)
+	if task.BranchName != nil {
+		fmt.Fprintf(w, "Branch:\t%s\n", *task.BranchName)
+	}
+	if task.PullRequest != nil {
+		fmt.Fprintf(w, "Pull request:\t%s\n", *task.PullRequest)
+	}
+	if task.Description != "" {
+		fmt.Fprintf(w, "\n%s\n", task.Description)
+	}
+}
+
+func isFinished(status entity.ExecutionStatus) bool {
+	switch status {
+	case entity.ExecutionStatusCompleted, entity.ExecutionStatusFailed, entity.ExecutionStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// parseTaskArg parses a command taking a single TASK_ID argument.
+func parseTaskArg(fs *flag.FlagSet, args []string) (uuid.UUID, error) {
+	values, err := parseCommandFlags(fs, args, 1)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return parseID("task", values[0])
+}
+
+func parseID(kind, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, fmt.Errorf("%w: a %s ID is required", ErrUsage, kind)
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: invalid %s ID %q", ErrUsage, kind, value)
+	}
+	return id, nil
+}
+
+// mcpServe runs an MCP server on stdin/stdout for AI assistants, backed by
+// the same API client (and credentials) as the other commands.
+func mcpServe(ctx context.Context, a *app, args []string) error {
+	if _, err := parseCommandFlags(flag.NewFlagSet("mcp serve", flag.ContinueOnError), args, 0); err != nil {
+		return err
+	}
+
+	logger := slog.New(slog.NewTextHandler(a.errOut, nil))
+	return mcp.NewServer(a.client, version, logger).Serve(ctx, a.in, a.out)
+}
@@ -4,16 +4,31 @@
 package di
 
 import (
+	"crypto/rand"
+	"fmt"
 	"time"
 
 	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/graph"
 	"github.com/auto-devs/auto-devs/internal/jobs"
 	"github.com/auto-devs/auto-devs/internal/repository"
 	"github.com/auto-devs/auto-devs/internal/repository/postgres"
 	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/internal/service/email"
 	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/auto-devs/auto-devs/internal/service/github"
+	"github.com/auto-devs/auto-devs/internal/service/githubissues"
+	"github.com/auto-devs/auto-devs/internal/service/githubprojects"
 	"github.com/auto-devs/auto-devs/internal/service/kanban"
+	"github.com/auto-devs/auto-devs/internal/service/preview"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/service/slack"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+	"github.com/auto-devs/auto-devs/internal/service/vcs/gitlab"
+	"github.com/auto-devs/auto-devs/internal/service/verification"
+	"github.com/auto-devs/auto-devs/internal/service/webhook"
 	worktreesvc "github.com/auto-devs/auto-devs/internal/service/worktree"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/auto-devs/auto-devs/internal/websocket"
@@ -32,16 +47,53 @@ var ProviderSet = wire.NewSet(
 	ProvideWorktreeRepository,
 	postgres.NewAuditRepository,
 	postgres.NewExecutionRepository,
-	postgres.NewExecutionLogRepository,
+	ProvideExecutionLogRepository,
 	postgres.NewPullRequestRepository,
+	postgres.NewPurgeRepository,
+	postgres.NewOrganizationRepository,
+	postgres.NewSecretRepository,
+	postgres.NewJiraIntegrationRepository,
+	postgres.NewEventRepository,
+	postgres.NewTaskCommitRepository,
+	postgres.NewVelocityRollupRepository,
+	postgres.NewVerificationRunRepository,
+	postgres.NewReviewCommentRepository,
+	postgres.NewSecurityFindingRepository,
+	postgres.NewReleaseNotesRepository,
+	postgres.NewGitOperationRepository,
+	postgres.NewWebhookDeliveryRepository,
+	postgres.NewUndoOperationRepository,
+	postgres.NewPlanApprovalRepository,
+	postgres.NewTaskAttachmentRepository,
+	postgres.NewUserProfileRepository,
+	postgres.NewJobMetricRepository,
+	postgres.NewEpicRepository,
+	postgres.NewUserRepository,
+	postgres.NewProjectMemberRepository,
+	postgres.NewProjectWebhookRepository,
+	postgres.NewSlackIntegrationRepository,
+	postgres.NewNotificationPreferenceRepository,
+	postgres.NewEmailTemplateRepository,
 	// Service providers
 	ProvideGitManager,
 	ProvideProjectGitService,
 	ProvideGitHubService,
+	ProvideGitHubProjectsClient,
+	ProvideGitHubIssuesClient,
+	ProvideSlackClient,
+	ProvideSlackNotificationHandler,
+	ProvideEmailSender,
+	ProvideEmailNotificationHandler,
 	ProvidePRCreator,
 	ProvideKanbanClient,
+	ProvideSecretStore,
+	ProvideLogSealer,
+	ProvideAttachmentStorage,
 	ProvideIntegratedWorktreeService,
 	ProvideWorktreeManager,
+	ProvideVerificationRunner,
+	ProvidePreviewManager,
+	webhook.NewSender,
 	// WebSocket service provider
 	ProvideWebSocketService,
 	// AI Service providers
@@ -54,12 +106,31 @@ var ProviderSet = wire.NewSet(
 	ProvideJobClientAdapter,
 	ProvideJobProcessor,
 	// Usecase providers
-	usecase.NewNotificationUsecase,
+	ProvideNotificationUsecase,
 	ProvideAuditUsecase,
 	ProvideProjectUsecase,
 	ProvideWorktreeUsecase,
 	ProvideTaskUsecase,
 	ProvideExecutionUsecase,
+	usecase.NewOrganizationUsecase,
+	usecase.NewJiraUsecase,
+	usecase.NewGitHubProjectUsecase,
+	ProvideGitHubIssueUsecase,
+	usecase.NewSlackUsecase,
+	ProvideEmailUsecase,
+	usecase.NewEventUsecase,
+	ProvideCommitUsecase,
+	usecase.NewReleaseNotesUsecase,
+	ProvideAttachmentUsecase,
+	ProvideUserProfileUsecase,
+	usecase.NewJobMetricUsecase,
+	usecase.NewEpicUsecase,
+	usecase.NewPlanUsecase,
+	ProvideAuthUsecase,
+	usecase.NewProjectMemberUsecase,
+	usecase.NewProjectWebhookUsecase,
+	// GraphQL
+	graph.NewService,
 )
 
 // InitializeApp builds the entire dependency tree
@@ -73,22 +144,43 @@ func InitializeApp() (*App, error) {
 
 // App represents the initialized application with all dependencies
 type App struct {
-	Config              *config.Config
-	GormDB              *database.GormDB
-	ProjectRepo         repository.ProjectRepository
-	TaskRepo            repository.TaskRepository
-	PlanRepo            repository.PlanRepository
-	WorktreeRepo        repository.WorktreeRepository
-	AuditRepo           repository.AuditRepository
-	ExecutionRepo       repository.ExecutionRepository
-	ExecutionLogRepo    repository.ExecutionLogRepository
-	PullRequestRepo     repository.PullRequestRepository
-	AuditUsecase        usecase.AuditUsecase
-	ProjectUsecase      usecase.ProjectUsecase
-	TaskUsecase         usecase.TaskUsecase
-	WorktreeUsecase     usecase.WorktreeUsecase
-	NotificationUsecase usecase.NotificationUsecase
-	ExecutionUsecase    usecase.ExecutionUsecase
+	Config               *config.Config
+	GormDB               *database.GormDB
+	ProjectRepo          repository.ProjectRepository
+	TaskRepo             repository.TaskRepository
+	PlanRepo             repository.PlanRepository
+	WorktreeRepo         repository.WorktreeRepository
+	AuditRepo            repository.AuditRepository
+	ExecutionRepo        repository.ExecutionRepository
+	ExecutionLogRepo     repository.ExecutionLogRepository
+	PullRequestRepo      repository.PullRequestRepository
+	OrganizationRepo     repository.OrganizationRepository
+	JobMetricRepo        repository.JobMetricRepository
+	AuditUsecase         usecase.AuditUsecase
+	ProjectUsecase       usecase.ProjectUsecase
+	TaskUsecase          usecase.TaskUsecase
+	WorktreeUsecase      usecase.WorktreeUsecase
+	NotificationUsecase  usecase.NotificationUsecase
+	ExecutionUsecase     usecase.ExecutionUsecase
+	OrganizationUsecase  usecase.OrganizationUsecase
+	JiraUsecase          usecase.JiraUsecase
+	GitHubProjectUsecase usecase.GitHubProjectUsecase
+	GitHubIssueUsecase   usecase.GitHubIssueUsecase
+	SlackUsecase         usecase.SlackUsecase
+	EmailUsecase         usecase.EmailUsecase
+	EventUsecase         usecase.EventUsecase
+	CommitUsecase        usecase.CommitUsecase
+	ReleaseNotesUsecase  usecase.ReleaseNotesUsecase
+	AttachmentUsecase    usecase.AttachmentUsecase
+	UserProfileUsecase   usecase.UserProfileUsecase
+	JobMetricUsecase     usecase.JobMetricUsecase
+	EpicUsecase          usecase.EpicUsecase
+	PlanUsecase          usecase.PlanUsecase
+	AuthUsecase          usecase.AuthUsecase
+	ProjectMemberUsecase usecase.ProjectMemberUsecase
+	WebhookUsecase       usecase.ProjectWebhookUsecase
+	// GraphQL Service
+	GraphQLService *graph.Service
 	// WebSocket Service
 	WebSocketService *websocket.Service
 	// AI Services
@@ -120,12 +212,32 @@ func NewApp(
 	executionRepo repository.ExecutionRepository,
 	executionLogRepo repository.ExecutionLogRepository,
 	pullRequestRepo repository.PullRequestRepository,
+	organizationRepo repository.OrganizationRepository,
+	jobMetricRepo repository.JobMetricRepository,
 	auditUsecase usecase.AuditUsecase,
 	projectUsecase usecase.ProjectUsecase,
 	taskUsecase usecase.TaskUsecase,
 	worktreeUsecase usecase.WorktreeUsecase,
 	notificationUsecase usecase.NotificationUsecase,
 	executionUsecase usecase.ExecutionUsecase,
+	organizationUsecase usecase.OrganizationUsecase,
+	jiraUsecase usecase.JiraUsecase,
+	githubProjectUsecase usecase.GitHubProjectUsecase,
+	githubIssueUsecase usecase.GitHubIssueUsecase,
+	slackUsecase usecase.SlackUsecase,
+	emailUsecase usecase.EmailUsecase,
+	eventUsecase usecase.EventUsecase,
+	commitUsecase usecase.CommitUsecase,
+	releaseNotesUsecase usecase.ReleaseNotesUsecase,
+	attachmentUsecase usecase.AttachmentUsecase,
+	userProfileUsecase usecase.UserProfileUsecase,
+	jobMetricUsecase usecase.JobMetricUsecase,
+	epicUsecase usecase.EpicUsecase,
+	planUsecase usecase.PlanUsecase,
+	authUsecase usecase.AuthUsecase,
+	projectMemberUsecase usecase.ProjectMemberUsecase,
+	webhookUsecase usecase.ProjectWebhookUsecase,
+	graphqlService *graph.Service,
 	wsService *websocket.Service,
 	cliManager *ai.CLIManager,
 	processManager *ai.ProcessManager,
@@ -139,39 +251,69 @@ func NewApp(
 	jobProcessor *jobs.Processor,
 ) *App {
 	return &App{
-		Config:              cfg,
-		GormDB:              gormDB,
-		ProjectRepo:         projectRepo,
-		TaskRepo:            taskRepo,
-		PlanRepo:            planRepo,
-		WorktreeRepo:        worktreeRepo,
-		AuditRepo:           auditRepo,
-		ExecutionRepo:       executionRepo,
-		ExecutionLogRepo:    executionLogRepo,
-		PullRequestRepo:     pullRequestRepo,
-		AuditUsecase:        auditUsecase,
-		ProjectUsecase:      projectUsecase,
-		TaskUsecase:         taskUsecase,
-		WorktreeUsecase:     worktreeUsecase,
-		NotificationUsecase: notificationUsecase,
-		ExecutionUsecase:    executionUsecase,
-		WebSocketService:    wsService,
-		CLIManager:          cliManager,
-		ProcessManager:      processManager,
-		ExecutionService:    executionService,
-		PlanningService:     planningService,
-		GitManager:          gitManager,
-		WorktreeManager:     worktreeManager,
-		PRCreator:           prCreator,
-		JobClient:           jobClient,
-		JobClientAdapter:    jobClientAdapter,
-		JobProcessor:        jobProcessor,
+		Config:               cfg,
+		GormDB:               gormDB,
+		ProjectRepo:          projectRepo,
+		TaskRepo:             taskRepo,
+		PlanRepo:             planRepo,
+		WorktreeRepo:         worktreeRepo,
+		AuditRepo:            auditRepo,
+		ExecutionRepo:        executionRepo,
+		ExecutionLogRepo:     executionLogRepo,
+		PullRequestRepo:      pullRequestRepo,
+		OrganizationRepo:     organizationRepo,
+		JobMetricRepo:        jobMetricRepo,
+		AuditUsecase:         auditUsecase,
+		ProjectUsecase:       projectUsecase,
+		TaskUsecase:          taskUsecase,
+		WorktreeUsecase:      worktreeUsecase,
+		NotificationUsecase:  notificationUsecase,
+		ExecutionUsecase:     executionUsecase,
+		OrganizationUsecase:  organizationUsecase,
+		JiraUsecase:          jiraUsecase,
+		GitHubProjectUsecase: githubProjectUsecase,
+		GitHubIssueUsecase:   githubIssueUsecase,
+		SlackUsecase:         slackUsecase,
+		EmailUsecase:         emailUsecase,
+		EventUsecase:         eventUsecase,
+		CommitUsecase:        commitUsecase,
+		ReleaseNotesUsecase:  releaseNotesUsecase,
+		AttachmentUsecase:    attachmentUsecase,
+		UserProfileUsecase:   userProfileUsecase,
+		JobMetricUsecase:     jobMetricUsecase,
+		EpicUsecase:          epicUsecase,
+		PlanUsecase:          planUsecase,
+		AuthUsecase:          authUsecase,
+		ProjectMemberUsecase: projectMemberUsecase,
+		WebhookUsecase:       webhookUsecase,
+		GraphQLService:       graphqlService,
+		WebSocketService:     wsService,
+		CLIManager:           cliManager,
+		ProcessManager:       processManager,
+		ExecutionService:     executionService,
+		PlanningService:      planningService,
+		GitManager:           gitManager,
+		WorktreeManager:      worktreeManager,
+		PRCreator:            prCreator,
+		JobClient:            jobClient,
+		JobClientAdapter:     jobClientAdapter,
+		JobProcessor:         jobProcessor,
 	}
 }
 
 // ProvideGormDB provides a GORM database connection
 func ProvideGormDB(cfg *config.Config) (*database.GormDB, error) {
-	return database.NewGormDB(cfg)
+	db, err := database.NewGormDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite can't run the Postgres migrations, so build its schema from the entities
+	if database.IsSQLite(db.DB) {
+		if err := database.RunSQLiteMigrations(db); err != nil {
+			return nil, err
+		}
+	}
+	return db, nil
 }
 
 // ProvideWorktreeRepository provides a WorktreeRepository instance
@@ -179,26 +321,38 @@ func ProvideWorktreeRepository(gormDB *database.GormDB) repository.WorktreeRepos
 	return postgres.NewWorktreeRepository(gormDB)
 }
 
+// ProvideExecutionLogRepository provides an ExecutionLogRepository sealing
+// the logs of projects that encrypt them
+func ProvideExecutionLogRepository(gormDB *database.GormDB, logSealer *secrets.LogSealer, executionRepo repository.ExecutionRepository, taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository) repository.ExecutionLogRepository {
+	return secrets.NewSealingExecutionLogRepository(postgres.NewExecutionLogRepository(gormDB), logSealer, executionRepo, taskRepo, projectRepo)
+}
+
 // ProvideAuditService provides an AuditService instance
 func ProvideAuditUsecase(auditRepo repository.AuditRepository) usecase.AuditUsecase {
 	return usecase.NewAuditUsecase(auditRepo)
 }
 
 // ProvideGitManager provides a GitManager instance
-func ProvideGitManager(cfg *config.Config) (*git.GitManager, error) {
+func ProvideGitManager(cfg *config.Config, gitOperationRepo repository.GitOperationRepository) (*git.GitManager, error) {
 	gitConfig := &git.ManagerConfig{
 		DefaultTimeout: 30,
 		MaxRetries:     3,
 		EnableLogging:  true,
+		Recorder:       gitOperationRepo,
 	}
 	return git.NewGitManager(gitConfig)
 }
 
 // ProvideIntegratedWorktreeService provides an IntegratedWorktreeService instance
-func ProvideIntegratedWorktreeService(cfg *config.Config, gitManager *git.GitManager) (*worktreesvc.IntegratedWorktreeService, error) {
+func ProvideIntegratedWorktreeService(cfg *config.Config, gitManager *git.GitManager, gitOperationRepo repository.GitOperationRepository) (*worktreesvc.IntegratedWorktreeService, error) {
 	integratedConfig := &worktreesvc.IntegratedConfig{
 		Worktree: &cfg.Worktree,
-		Git:      &git.ManagerConfig{},
+		Git: &git.ManagerConfig{
+			DefaultTimeout: 30 * time.Second,
+			MaxRetries:     3,
+			EnableLogging:  true,
+			Recorder:       gitOperationRepo,
+		},
 	}
 	return worktreesvc.NewIntegratedWorktreeService(integratedConfig)
 }
@@ -209,8 +363,8 @@ func ProvideProjectGitService(gitManager *git.GitManager) git.ProjectGitServiceI
 }
 
 // ProvideProjectUsecase provides a ProjectUsecase instance
-func ProvideProjectUsecase(projectRepo repository.ProjectRepository, auditUsecase usecase.AuditUsecase, gitService git.ProjectGitServiceInterface) usecase.ProjectUsecase {
-	return usecase.NewProjectUsecase(projectRepo, auditUsecase, gitService)
+func ProvideProjectUsecase(projectRepo repository.ProjectRepository, auditUsecase usecase.AuditUsecase, gitService git.ProjectGitServiceInterface, executionRepo repository.ExecutionRepository, eventRepo repository.EventRepository, secretStore secrets.Store, memberRepo repository.ProjectMemberRepository) usecase.ProjectUsecase {
+	return usecase.NewProjectUsecase(projectRepo, auditUsecase, gitService, executionRepo, eventRepo, secretStore, memberRepo)
 }
 
 // ProvideWorktreeUsecase provides a WorktreeUsecase instance
@@ -218,14 +372,33 @@ func ProvideWorktreeUsecase(
 	worktreeRepo repository.WorktreeRepository,
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
 	integratedWorktreeSvc *worktreesvc.IntegratedWorktreeService,
 	gitManager *git.GitManager,
 	jobClient usecase.JobClientInterface,
 ) usecase.WorktreeUsecase {
-	return usecase.NewWorktreeUsecase(worktreeRepo, taskRepo, projectRepo, integratedWorktreeSvc, gitManager, jobClient)
+	return usecase.NewWorktreeUsecase(worktreeRepo, taskRepo, projectRepo, memberRepo, integratedWorktreeSvc, gitManager, jobClient)
 }
 
-// ProvideTaskUsecase provides a TaskUsecase instance
+// ProvideCommitUsecase provides a CommitUsecase instance, accepting push
+// webhooks for the configured age
+func ProvideCommitUsecase(
+	cfg *config.Config,
+	commitRepo repository.TaskCommitRepository,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	eventRepo repository.EventRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	pullRequestRepo repository.PullRequestRepository,
+	memberRepo repository.ProjectMemberRepository,
+	jobClient usecase.JobClientInterface,
+) usecase.CommitUsecase {
+	maxAge := time.Duration(cfg.GitHub.WebhookMaxAge) * time.Second
+	return usecase.NewCommitUsecase(commitRepo, taskRepo, projectRepo, eventRepo, deliveryRepo, pullRequestRepo, memberRepo, jobClient, maxAge)
+}
+
+// ProvideTaskUsecase provides a TaskUsecase instance, keeping bulk deletes
+// and archives undoable for the configured window
 func ProvideTaskUsecase(
 	taskRepo repository.TaskRepository,
 	pullRequestRepo repository.PullRequestRepository,
@@ -236,8 +409,22 @@ func ProvideTaskUsecase(
 	jobClient usecase.JobClientInterface,
 	gitManager *git.GitManager,
 	prCreator *github.PRCreator,
+	eventRepo repository.EventRepository,
+	executionRepo repository.ExecutionRepository,
+	velocityRepo repository.VelocityRollupRepository,
+	gitOperationRepo repository.GitOperationRepository,
+	planApprovalRepo repository.PlanApprovalRepository,
+	undoRepo repository.UndoOperationRepository,
+	epicRepo repository.EpicRepository,
+	memberRepo repository.ProjectMemberRepository,
+	webhookUsecase usecase.ProjectWebhookUsecase,
+	cfg *config.Config,
 ) usecase.TaskUsecase {
-	return usecase.NewTaskUsecase(taskRepo, pullRequestRepo, projectRepo, planRepo, notificationUsecase, worktreeUsecase, jobClient, gitManager, prCreator)
+	undoWindow := time.Duration(cfg.Undo.Window) * time.Second
+	return usecase.NewTaskUsecase(taskRepo, pullRequestRepo, projectRepo, planRepo, notificationUsecase, worktreeUsecase, jobClient, gitManager, prCreator, eventRepo, executionRepo, velocityRepo, gitOperationRepo, planApprovalRepo, undoRepo, epicRepo, memberRepo, webhookUsecase, cfg.Approval.TOTPSecret, undoWindow, usecase.PlanningLimits{
+		MaxQueueDepth:       cfg.Backpressure.MaxPlanningQueueDepth,
+		MaxActiveExecutions: cfg.Backpressure.MaxActiveExecutions,
+	})
 }
 
 // ProvideCLIManager provides a CLIManager instance
@@ -258,9 +445,16 @@ func ProvideProcessManager() *ai.ProcessManager {
 	return ai.NewProcessManager()
 }
 
-// ProvideExecutionService provides an ExecutionService instance
-func ProvideExecutionService(cliManager *ai.CLIManager, processManager *ai.ProcessManager) *ai.ExecutionService {
-	return ai.NewExecutionService(cliManager, processManager)
+// ProvideExecutionService provides an ExecutionService instance, keeping
+// executions to their worktree as configured
+func ProvideExecutionService(cfg *config.Config, cliManager *ai.CLIManager, processManager *ai.ProcessManager) (*ai.ExecutionService, error) {
+	isolation, err := ai.NewFileIsolation(&cfg.Execution)
+	if err != nil {
+		return nil, err
+	}
+	executionService := ai.NewExecutionService(cliManager, processManager)
+	executionService.SetFileIsolation(isolation)
+	return executionService, nil
 }
 
 // ProvidePlanningService provides a PlanningService instance
@@ -300,8 +494,33 @@ func ProvideJobProcessor(
 	prRepo repository.PullRequestRepository,
 	githubService github.GitHubServiceInterface,
 	kanbanClient kanban.Client,
+	jiraUsecase usecase.JiraUsecase,
+	purgeRepo repository.PurgeRepository,
+	verificationRunRepo repository.VerificationRunRepository,
+	verificationRunner *verification.Runner,
+	reviewCommentRepo repository.ReviewCommentRepository,
+	securityFindingRepo repository.SecurityFindingRepository,
+	previewManager *preview.Manager,
+	releaseNotesUsecase usecase.ReleaseNotesUsecase,
+	attachmentUsecase usecase.AttachmentUsecase,
+	epicUsecase usecase.EpicUsecase,
+	webhookUsecase usecase.ProjectWebhookUsecase,
+	webhookDeliveryRepo repository.WebhookDeliveryRepository,
+	githubIssueUsecase usecase.GitHubIssueUsecase,
+	notificationUsecase usecase.NotificationUsecase,
+	emailUsecase usecase.EmailUsecase,
 ) *jobs.Processor {
-	return jobs.NewProcessor(taskUsecase, projectUsecase, worktreeUsecase, planningService, executionService, planRepo, executionRepo, executionLogRepo, wsService, gitManager, prCreator, prRepo, githubService, kanbanClient)
+	return jobs.NewProcessor(taskUsecase, projectUsecase, worktreeUsecase, planningService, executionService, planRepo, executionRepo, executionLogRepo, wsService, gitManager, prCreator, prRepo, githubService, kanbanClient, jiraUsecase, purgeRepo, verificationRunRepo, verificationRunner, reviewCommentRepo, securityFindingRepo, previewManager, releaseNotesUsecase, attachmentUsecase, epicUsecase, webhookUsecase, webhookDeliveryRepo, githubIssueUsecase, notificationUsecase, emailUsecase)
+}
+
+// ProvideVerificationRunner provides the runner for post-implementation lint, build and test commands
+func ProvideVerificationRunner() *verification.Runner {
+	return verification.NewRunner(verification.DefaultTimeout)
+}
+
+// ProvidePreviewManager provides the manager running task preview environments
+func ProvidePreviewManager(cfg *config.Config) *preview.Manager {
+	return preview.NewManager(&cfg.Preview)
 }
 
 // ProvideKanbanClient provides a Hermes Kanban client instance
@@ -309,24 +528,184 @@ func ProvideKanbanClient(cfg *config.Config) kanban.Client {
 	return kanban.NewClient(&cfg.HermesKanban)
 }
 
+// ProvideSecretStore provides the encrypted project secrets store
+func ProvideSecretStore(cfg *config.Config, secretRepo repository.SecretRepository) (secrets.Store, error) {
+	return secrets.NewStore(&cfg.Secrets, secretRepo)
+}
+
+// ProvideLogSealer provides the encryption of execution logs, with the key
+// of the secrets store
+func ProvideLogSealer(cfg *config.Config) (*secrets.LogSealer, error) {
+	return secrets.NewLogSealer(&cfg.Secrets)
+}
+
+// ProvideAttachmentStorage provides the storage of task attachments
+func ProvideAttachmentStorage(cfg *config.Config) (storage.Storage, error) {
+	return storage.New(&cfg.Attachments)
+}
+
+// ProvideAttachmentUsecase provides the task attachment usecase. Without a
+// configured signing key, download URLs are signed with a random one.
+func ProvideAttachmentUsecase(attachmentRepo repository.TaskAttachmentRepository, taskRepo repository.TaskRepository, memberRepo repository.ProjectMemberRepository, store storage.Storage, cfg *config.Config) (usecase.AttachmentUsecase, error) {
+	signingKey := []byte(cfg.Attachments.URLSigningKey)
+	if len(signingKey) == 0 {
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return nil, fmt.Errorf("failed to generate attachment URL signing key: %w", err)
+		}
+	}
+	return usecase.NewAttachmentUsecase(attachmentRepo, taskRepo, memberRepo, store, usecase.AttachmentOptions{
+		MaxSize:      cfg.Attachments.MaxSize,
+		AllowedTypes: cfg.Attachments.AllowedTypes,
+		BaseURL:      cfg.App.BaseURL,
+		SigningKey:   signingKey,
+		URLExpiry:    time.Duration(cfg.Attachments.URLExpiry) * time.Second,
+	}), nil
+}
+
+// ProvideAuthUsecase provides the auth usecase. Without a configured JWT
+// secret, tokens are signed with a random one.
+func ProvideAuthUsecase(userRepo repository.UserRepository, cfg *config.Config) (usecase.AuthUsecase, error) {
+	secret := []byte(cfg.Auth.JWTSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("failed to generate JWT secret: %w", err)
+		}
+	}
+	return usecase.NewAuthUsecase(userRepo, secret, time.Duration(cfg.Auth.TokenTTLHours)*time.Hour, cfg.Auth.RegistrationEnabled), nil
+}
+
+// ProvideUserProfileUsecase provides the user profile usecase, which keeps
+// avatars in the attachment storage
+func ProvideUserProfileUsecase(profileRepo repository.UserProfileRepository, store storage.Storage, cfg *config.Config) usecase.UserProfileUsecase {
+	return usecase.NewUserProfileUsecase(profileRepo, store, cfg.App.BaseURL)
+}
+
 // ProvideWebSocketService provides a WebSocket service instance
 func ProvideWebSocketService(cfg *config.Config) *websocket.Service {
 	return websocket.NewService(&cfg.CentrifugeRedisBroker)
 }
 
-func ProvideExecutionUsecase(executionRepo repository.ExecutionRepository, executionLogRepo repository.ExecutionLogRepository, taskRepo repository.TaskRepository) usecase.ExecutionUsecase {
-	return usecase.NewExecutionUsecase(executionRepo, executionLogRepo, taskRepo)
+func ProvideExecutionUsecase(executionRepo repository.ExecutionRepository, executionLogRepo repository.ExecutionLogRepository, taskRepo repository.TaskRepository, verificationRunRepo repository.VerificationRunRepository, reviewCommentRepo repository.ReviewCommentRepository, securityFindingRepo repository.SecurityFindingRepository, memberRepo repository.ProjectMemberRepository, logSealer *secrets.LogSealer) usecase.ExecutionUsecase {
+	return usecase.NewExecutionUsecase(executionRepo, executionLogRepo, taskRepo, verificationRunRepo, reviewCommentRepo, securityFindingRepo, memberRepo, logSealer)
 }
 
-// ProvideGitHubService provides a GitHub service instance
-func ProvideGitHubService(cfg *config.Config) github.GitHubServiceInterface {
+// ProvideGitHubService provides the git provider service of each project:
+// GitHub, using the project's own token and the configured one for projects
+// without, or GitLab when a GitLab token is configured
+func ProvideGitHubService(cfg *config.Config, secretStore secrets.Store, projectRepo repository.ProjectRepository) github.GitHubServiceInterface {
 	githubConfig := &github.GitHubConfig{
 		Token:     cfg.GitHub.Token,
 		BaseURL:   cfg.GitHub.BaseURL,
 		UserAgent: cfg.GitHub.UserAgent,
 		Timeout:   cfg.GitHub.Timeout,
 	}
-	return github.NewGitHubServiceV2(githubConfig)
+	var gitlabService vcs.Service
+	if cfg.GitLab.Token != "" {
+		gitlabService = gitlab.NewClient(cfg.GitLab.BaseURL, cfg.GitLab.Token, time.Duration(cfg.GitLab.Timeout)*time.Second)
+	}
+	return vcs.NewRouter(projectRepo, github.NewProjectGitHubService(githubConfig, secretStore), gitlabService)
+}
+
+// ProvideGitHubProjectsClient provides a GitHub Projects client sharing the
+// GitHub integration's token
+func ProvideGitHubProjectsClient(cfg *config.Config) githubprojects.Client {
+	return githubprojects.NewClient(cfg.GitHub.BaseURL, cfg.GitHub.Token)
+}
+
+// ProvideGitHubIssuesClient provides a GitHub issues client sharing the
+// GitHub integration's token
+func ProvideGitHubIssuesClient(cfg *config.Config) githubissues.Client {
+	return githubissues.NewClient(cfg.GitHub.BaseURL, cfg.GitHub.Token)
+}
+
+// ProvideGitHubIssueUsecase provides a GitHubIssueUsecase instance, linking
+// issues to the tasks in the configured UI
+func ProvideGitHubIssueUsecase(
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	client githubissues.Client,
+	cfg *config.Config,
+) usecase.GitHubIssueUsecase {
+	return usecase.NewGitHubIssueUsecase(taskRepo, projectRepo, memberRepo, client, cfg.App.BaseURL)
+}
+
+// ProvideSlackClient provides the client posting project notifications to
+// Slack
+func ProvideSlackClient(cfg *config.Config) slack.Client {
+	return slack.NewClient(cfg.Slack.APIURL)
+}
+
+// ProvideSlackNotificationHandler provides the Slack notification handler,
+// linking messages to the tasks in the configured UI
+func ProvideSlackNotificationHandler(
+	slackRepo repository.SlackIntegrationRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	secretStore secrets.Store,
+	client slack.Client,
+	cfg *config.Config,
+) *usecase.SlackNotificationHandler {
+	return usecase.NewSlackNotificationHandler(slackRepo, preferenceRepo, secretStore, client, cfg.App.BaseURL)
+}
+
+// ProvideEmailSender provides the SMTP sender of notification emails, or nil
+// when no SMTP server is configured
+func ProvideEmailSender(cfg *config.Config) email.Sender {
+	if cfg.Email.SMTPHost == "" {
+		return nil
+	}
+	return email.NewSMTPSender(email.SMTPConfig{
+		Host:     cfg.Email.SMTPHost,
+		Port:     cfg.Email.SMTPPort,
+		Username: cfg.Email.SMTPUsername,
+		Password: cfg.Email.SMTPPassword,
+		From:     cfg.Email.From,
+	})
+}
+
+// ProvideEmailNotificationHandler provides the email notification handler,
+// or nil when no SMTP server is configured
+func ProvideEmailNotificationHandler(
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	templateRepo repository.EmailTemplateRepository,
+	sender email.Sender,
+	cfg *config.Config,
+) *usecase.EmailNotificationHandler {
+	if sender == nil {
+		return nil
+	}
+	return usecase.NewEmailNotificationHandler(projectRepo, memberRepo, templateRepo, sender, cfg.App.BaseURL)
+}
+
+// ProvideEmailUsecase provides an EmailUsecase instance linking the emails to
+// the configured UI
+func ProvideEmailUsecase(
+	templateRepo repository.EmailTemplateRepository,
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	memberRepo repository.ProjectMemberRepository,
+	sender email.Sender,
+	cfg *config.Config,
+) usecase.EmailUsecase {
+	return usecase.NewEmailUsecase(templateRepo, projectRepo, taskRepo, memberRepo, sender, cfg.App.BaseURL)
+}
+
+// ProvideNotificationUsecase provides a NotificationUsecase instance posting
+// the notifications projects can get on Slack to their channel, and emailing
+// plan reviews and failed executions when SMTP is configured
+func ProvideNotificationUsecase(slackHandler *usecase.SlackNotificationHandler, emailHandler *usecase.EmailNotificationHandler) usecase.NotificationUsecase {
+	notificationUsecase := usecase.NewNotificationUsecase()
+	for _, notificationType := range entity.SlackNotificationTypes {
+		_ = notificationUsecase.RegisterHandler(notificationType, slackHandler)
+	}
+	if emailHandler != nil {
+		_ = notificationUsecase.RegisterHandler(entity.NotificationTypePlanReady, emailHandler)
+		_ = notificationUsecase.RegisterHandler(entity.NotificationTypeExecutionFailed, emailHandler)
+	}
+	return notificationUsecase
 }
 
 // ProvidePRCreator provides a PR creator instance
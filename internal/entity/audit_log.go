@@ -14,6 +14,8 @@ const (
 	AuditActionDelete  AuditAction = "DELETE"
 	AuditActionArchive AuditAction = "ARCHIVE"
 	AuditActionRestore AuditAction = "RESTORE"
+	// AuditActionReject records a request refused by the access policy
+	AuditActionReject AuditAction = "REJECT"
 )
 
 type AuditLog struct {
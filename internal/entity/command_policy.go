@@ -0,0 +1,37 @@
+package entity
+
+import "strings"
+
+// CommandPolicy restricts the shell commands a project's AI executions may
+// run. Commands are matched by prefix, so "npm publish" also covers
+// "npm publish --tag next".
+type CommandPolicy struct {
+	// Allowed, when not empty, are the only commands that may run
+	Allowed []string
+	// Denied may never run, even when allowed
+	Denied []string
+}
+
+// IsEmpty reports whether the policy lets executions run any command
+func (p CommandPolicy) IsEmpty() bool {
+	return len(p.Allowed) == 0 && len(p.Denied) == 0
+}
+
+// CommandPolicy returns the project's command policy
+func (p *Project) CommandPolicy() CommandPolicy {
+	return CommandPolicy{
+		Allowed: splitCommands(p.AllowedCommands),
+		Denied:  splitCommands(p.DeniedCommands),
+	}
+}
+
+// splitCommands splits commands stored one per line, skipping blank lines
+func splitCommands(commands string) []string {
+	var out []string
+	for _, command := range strings.Split(commands, "\n") {
+		if command = strings.TrimSpace(command); command != "" {
+			out = append(out, command)
+		}
+	}
+	return out
+}
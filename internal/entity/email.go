@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailNotificationTypes are the notifications emailed to the members of
+// projects with email notifications on: plans waiting for review and
+// failed executions right away, and the daily digest
+var EmailNotificationTypes = []NotificationType{
+	NotificationTypePlanReady,
+	NotificationTypeExecutionFailed,
+	NotificationTypeDailyDigest,
+}
+
+// EmailTemplate replaces the default email of a type of notification for a
+// project. Subject and Body are Go text/templates executed with an
+// EmailTemplateData.
+type EmailTemplate struct {
+	ID        uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID        `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_email_templates_project_type"`
+	Type      NotificationType `json:"type" gorm:"size:50;not null;uniqueIndex:idx_email_templates_project_type"`
+	Subject   string           `json:"subject" gorm:"size:255;not null"`
+	Body      string           `json:"body" gorm:"type:text;not null"`
+	// UpdatedBy is the user who last changed the template
+	UpdatedBy *string   `json:"updated_by,omitempty" gorm:"size:255"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// EmailTemplateData is what email templates are executed with. The task
+// fields are set for PLAN_READY and EXECUTION_FAILED, the digest fields for
+// DAILY_DIGEST.
+type EmailTemplateData struct {
+	ProjectName string
+	// ProjectURL and TaskURL link to the auto-devs UI
+	ProjectURL string
+	Message    string
+
+	TaskKey   string
+	TaskTitle string
+	TaskURL   string
+	// Plan is the plan waiting for review
+	Plan     string
+	HighRisk bool
+	// Phase and Error are those of the failed execution
+	Phase string
+	Error string
+
+	// From and To bound the day summarized by a digest
+	From      time.Time
+	To        time.Time
+	Movements []TaskMovement
+}
+
+// EmailDigest is the task movement of a project over a day, the facts its
+// daily digest is written from
+type EmailDigest struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	From        time.Time
+	To          time.Time
+	// Movements are the status changes of the project's tasks over the day,
+	// in the order they happened
+	Movements []TaskMovement
+}
+
+// TaskMovement is a change of status of a task
+type TaskMovement struct {
+	TaskKey string
+	Title   string
+	// From is empty for tasks created over the day
+	From      TaskStatus
+	To        TaskStatus
+	ChangedBy string
+	ChangedAt time.Time
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Epic groups a project's tasks toward a larger goal or milestone, see
+// Task.EpicID
+type Epic struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID   uuid.UUID `json:"project_id" gorm:"type:uuid;not null;index"`
+	Title       string    `json:"title" gorm:"size:255;not null"`
+	Description string    `json:"description,omitempty" gorm:"type:text"`
+	// DueDate is when the milestone should be reached, optional
+	DueDate   *time.Time `json:"due_date,omitempty"`
+	CreatedAt time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for GORM
+func (Epic) TableName() string {
+	return "epics"
+}
+
+// EpicProgress rolls up the tasks of an epic, templates left out
+type EpicProgress struct {
+	EpicID         uuid.UUID `json:"epic_id"`
+	TotalTasks     int       `json:"total_tasks"`
+	DoneTasks      int       `json:"done_tasks"`
+	CancelledTasks int       `json:"cancelled_tasks"`
+	// EstimatedHours and ActualHours sum the hours of the tasks that have
+	// them
+	EstimatedHours float64 `json:"estimated_hours"`
+	ActualHours    float64 `json:"actual_hours"`
+}
+
+// PercentComplete is the share of the epic's tasks that are done, cancelled
+// tasks left out, from 0 to 100
+func (p *EpicProgress) PercentComplete() float64 {
+	remaining := p.TotalTasks - p.CancelledTasks
+	if remaining <= 0 {
+		return 0
+	}
+	return float64(p.DoneTasks) * 100 / float64(remaining)
+}
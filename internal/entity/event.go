@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventTypeTaskCreated       EventType = "task.created"
+	EventTypeTaskStatusChanged EventType = "task.status_changed"
+	EventTypeTaskDeleted       EventType = "task.deleted"
+	EventTypeTaskCommitLinked  EventType = "task.commit_linked"
+	EventTypeBudgetThreshold   EventType = "project.budget_threshold_reached"
+)
+
+// Event is an entry of the append-only feed that automation tools poll. IDs
+// increase monotonically, so the highest ID seen is a cursor for the next
+// poll.
+type Event struct {
+	ID        int64      `json:"id" gorm:"primaryKey;autoIncrement"`
+	Type      EventType  `json:"type" gorm:"size:64;not null;index:idx_events_type,priority:1"`
+	ProjectID uuid.UUID  `json:"project_id" gorm:"type:uuid;not null;index:idx_events_project_id,priority:1"`
+	TaskID    *uuid.UUID `json:"task_id,omitempty" gorm:"type:uuid"`
+	// Data is the JSON snapshot of the subject at the time of the event
+	Data      string    `json:"data" gorm:"type:jsonb;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// EventFilter selects events from the feed
+type EventFilter struct {
+	ProjectID *uuid.UUID
+	Types     []EventType
+	// AfterID returns only events newer than the given cursor
+	AfterID int64
+	Limit   int
+}
@@ -17,13 +17,26 @@ const (
 	ExecutionStatusCompleted ExecutionStatus = "COMPLETED"
 	ExecutionStatusFailed    ExecutionStatus = "FAILED"
 	ExecutionStatusCancelled ExecutionStatus = "CANCELLED"
+
+	// ExecutionStatusWaitingInput is an execution stopped on a question the
+	// AI asked the user, resumed once the user answers
+	ExecutionStatusWaitingInput ExecutionStatus = "WAITING_INPUT"
+)
+
+// ExecutionPhase is the step of a task's workflow an execution ran
+type ExecutionPhase string
+
+const (
+	ExecutionPhasePlanning       ExecutionPhase = "planning"
+	ExecutionPhaseImplementation ExecutionPhase = "implementation"
 )
 
 // IsValid checks if the execution status is valid
 func (es ExecutionStatus) IsValid() bool {
 	switch es {
 	case ExecutionStatusPending, ExecutionStatusRunning, ExecutionStatusPaused,
-		ExecutionStatusCompleted, ExecutionStatusFailed, ExecutionStatusCancelled:
+		ExecutionStatusCompleted, ExecutionStatusFailed, ExecutionStatusCancelled,
+		ExecutionStatusWaitingInput:
 		return true
 	default:
 		return false
@@ -40,14 +53,64 @@ type Execution struct {
 	ErrorMessage string          `json:"error_message,omitempty" gorm:"type:text"`
 	Progress     float64         `json:"progress" gorm:"default:0.0;check:progress >= 0 AND progress <= 1"`
 	Result       *string         `json:"result,omitempty" gorm:"type:jsonb"` // JSON serialized ExecutionResult
-	CreatedAt    time.Time       `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt    time.Time       `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt    gorm.DeletedAt  `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string" swaggertype:"string"`
+	// Coverage is the total test coverage in percent of the worktree after
+	// the execution, BaseCoverage that of the base branch
+	Coverage     *float64 `json:"coverage,omitempty"`
+	BaseCoverage *float64 `json:"base_coverage,omitempty"`
+	// FixAttempts is how many times the AI was asked to fix a failing build
+	// or test run after the execution
+	FixAttempts int            `json:"fix_attempts" gorm:"not null;default:0"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string" swaggertype:"string"`
+
+	// ComparisonID groups the executions of an executor comparison, each run
+	// by AIType in its own worktree on its own branch. It is nil for a
+	// regular run in the task worktree.
+	ComparisonID *uuid.UUID `json:"comparison_id,omitempty" gorm:"type:uuid;index"`
+	Variant      string     `json:"variant,omitempty" gorm:"size:20"`
+	AIType       string     `json:"ai_type,omitempty" gorm:"size:50"`
+	WorktreePath string     `json:"worktree_path,omitempty" gorm:"type:text"`
+	BranchName   string     `json:"branch_name,omitempty" gorm:"size:255"`
+	// DryRun executions ran in a throwaway worktree to preview the changes
+	// of an implementation; only their diff is kept, as a task attachment
+	DryRun bool `json:"dry_run,omitempty" gorm:"not null;default:false"`
+
+	// Worker names the worker running the execution, and HeartbeatAt is when
+	// it last reported the execution alive. An active execution whose worker
+	// stopped reporting was orphaned by a crash.
+	Worker      string     `json:"worker,omitempty" gorm:"size:255;index"`
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+
+	// Question is what the AI asked the user while the execution waits for
+	// input, and SessionID the AI CLI session resumed with the answer
+	Question  string `json:"question,omitempty" gorm:"type:text"`
+	SessionID string `json:"session_id,omitempty" gorm:"size:255"`
+
+	// InputTokens, OutputTokens and CostUSD are what the AI run reported it
+	// used, all zero when its executor does not report usage
+	InputTokens  int64   `json:"input_tokens" gorm:"not null;default:0"`
+	OutputTokens int64   `json:"output_tokens" gorm:"not null;default:0"`
+	CostUSD      float64 `json:"cost_usd" gorm:"column:cost_usd;type:numeric(12,6);not null;default:0"`
+
+	// Phase is empty for comparison and dry-run executions, which are not
+	// retried. Attempt numbers the executions of a phase from the first one,
+	// each retry linked by RetryOfID to the failed execution it retries.
+	Phase     ExecutionPhase `json:"phase,omitempty" gorm:"size:20"`
+	Attempt   int            `json:"attempt" gorm:"not null;default:1"`
+	RetryOfID *uuid.UUID     `json:"retry_of_id,omitempty" gorm:"type:uuid;index"`
+
+	// TraceID is the OpenTelemetry trace the execution ran in, empty when
+	// tracing is off
+	TraceID string `json:"trace_id,omitempty" gorm:"size:32;index"`
 
 	// Relationships
-	Task      *Task          `json:"task,omitempty" gorm:"foreignKey:TaskID;references:ID"`
-	Processes []Process      `json:"processes,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
-	Logs      []ExecutionLog `json:"logs,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
+	Task             *Task             `json:"task,omitempty" gorm:"foreignKey:TaskID;references:ID"`
+	Processes        []Process         `json:"processes,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
+	Logs             []ExecutionLog    `json:"logs,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
+	VerificationRuns []VerificationRun `json:"verification_runs,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
+	ReviewComments   []ReviewComment   `json:"review_comments,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
+	SecurityFindings []SecurityFinding `json:"security_findings,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
 }
 
 // ExecutionResult represents the result of an execution
@@ -58,6 +121,14 @@ type ExecutionResult struct {
 	Duration time.Duration          `json:"duration" swaggertype:"integer"`
 }
 
+// ExecutionUsage is the tokens an AI run used and what it cost. Input tokens
+// include those read from or written to the prompt cache.
+type ExecutionUsage struct {
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	CostUSD      float64 `json:"cost_usd"`
+}
+
 // TableName returns the table name for GORM
 func (Execution) TableName() string {
 	return "executions"
@@ -81,6 +152,15 @@ func (e *Execution) IsCompleted() bool {
 		e.Status == ExecutionStatusCancelled
 }
 
+// CurrentAttempt is the execution's attempt, 1 for executions recorded
+// before retries were numbered
+func (e *Execution) CurrentAttempt() int {
+	if e.Attempt < 1 {
+		return 1
+	}
+	return e.Attempt
+}
+
 // IsActive checks if the execution is currently active
 func (e *Execution) IsActive() bool {
 	return e.Status == ExecutionStatusRunning ||
@@ -94,3 +174,13 @@ func (e *Execution) GetDuration() time.Duration {
 	}
 	return time.Since(e.StartedAt)
 }
+
+// GetCoverageDelta returns how many percentage points the execution's
+// changes moved the test coverage, or nil when either side was not measured
+func (e *Execution) GetCoverageDelta() *float64 {
+	if e.Coverage == nil || e.BaseCoverage == nil {
+		return nil
+	}
+	delta := *e.Coverage - *e.BaseCoverage
+	return &delta
+}
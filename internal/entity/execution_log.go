@@ -48,6 +48,11 @@ type ExecutionLog struct {
 	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 	Line      int       `json:"line" gorm:"type:int"`
+	// Encrypted is set when Message holds the sealed message and parsed
+	// content of the log rather than the message itself
+	Encrypted bool `json:"encrypted" gorm:"not null;default:false"`
+	// TraceID is the trace of the execution the log was written by
+	TraceID string `json:"trace_id,omitempty" gorm:"size:32"`
 
 	// Relationships
 	Execution *Execution `json:"execution,omitempty" gorm:"foreignKey:ExecutionID;references:ID"`
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GitOperationType is a git operation changing a repository or its remote
+type GitOperationType string
+
+const (
+	GitOperationWorktreeCreate GitOperationType = "worktree_create"
+	GitOperationWorktreeDelete GitOperationType = "worktree_delete"
+	GitOperationCommit         GitOperationType = "commit"
+	GitOperationPush           GitOperationType = "push"
+	GitOperationBranchDelete   GitOperationType = "branch_delete"
+)
+
+// GitOperation is the audit record of a git operation, kept for compliance
+// and incident review. Records outlive the tasks and projects they were done
+// for.
+type GitOperation struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID   *uuid.UUID       `json:"project_id,omitempty" gorm:"type:uuid"`
+	TaskID      *uuid.UUID       `json:"task_id,omitempty" gorm:"type:uuid;index"`
+	ExecutionID *uuid.UUID       `json:"execution_id,omitempty" gorm:"type:uuid"`
+	Operation   GitOperationType `json:"operation" gorm:"size:30;not null"`
+	// Actor is who asked for the operation: job:<type> for background jobs,
+	// api or api:<key owner> for API requests, system otherwise
+	Actor string `json:"actor" gorm:"size:255;not null"`
+	// WorkingDir is the repository or worktree the operation ran in
+	WorkingDir string `json:"working_dir" gorm:"size:1000;not null"`
+	// Target is the worktree path, branch or remote branch operated on
+	Target string `json:"target,omitempty" gorm:"size:1000"`
+	// CommitSHA is the commit created or pushed
+	CommitSHA  string    `json:"commit_sha,omitempty" gorm:"size:64"`
+	Succeeded  bool      `json:"succeeded" gorm:"not null"`
+	Error      string    `json:"error,omitempty" gorm:"type:text"`
+	DurationMs int64     `json:"duration_ms" gorm:"not null;default:0"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for GORM
+func (GitOperation) TableName() string {
+	return "git_operations"
+}
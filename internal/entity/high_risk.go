@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultHighRiskPaths are the path fragments that make a task high-risk
+// when its plan mentions a path containing one, for projects that set none
+var DefaultHighRiskPaths = []string{"auth", "login", "password", "payment", "billing", "checkout"}
+
+// maxHighRiskReasonPaths bounds the paths listed in a task's high-risk reason
+const maxHighRiskReasonPaths = 5
+
+// planPathPattern matches what looks like a file path in a plan: words
+// joined by slashes, or a file name with an extension
+var planPathPattern = regexp.MustCompile(`[\w.\-]+(?:/[\w.\-]+)+|[\w\-]+\.[A-Za-z]{1,8}\b`)
+
+// HighRiskPathPatterns returns the project's high-risk path fragments,
+// DefaultHighRiskPaths when it sets none
+func (p *Project) HighRiskPathPatterns() []string {
+	if patterns := splitCommands(p.HighRiskPaths); len(patterns) > 0 {
+		return patterns
+	}
+	return DefaultHighRiskPaths
+}
+
+// HighRiskPathsIn returns the paths plan mentions that contain one of
+// patterns, ignoring case, in the order they are first mentioned
+func HighRiskPathsIn(plan string, patterns []string) []string {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, path := range planPathPattern.FindAllString(plan, -1) {
+		path = strings.Trim(path, ".")
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		lower := strings.ToLower(path)
+		for _, pattern := range patterns {
+			if pattern != "" && strings.Contains(lower, strings.ToLower(pattern)) {
+				paths = append(paths, path)
+				break
+			}
+		}
+	}
+	return paths
+}
+
+// HighRiskReason describes why a plan touching paths is high-risk
+func HighRiskReason(paths []string) string {
+	listed := paths
+	if len(listed) > maxHighRiskReasonPaths {
+		listed = listed[:maxHighRiskReasonPaths]
+	}
+	reason := "Plan touches " + strings.Join(listed, ", ")
+	if more := len(paths) - len(listed); more > 0 {
+		reason += fmt.Sprintf(" and %d more", more)
+	}
+	return reason
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighRiskPathsIn(t *testing.T) {
+	plan := "1. Update internal/auth/session.go to rotate tokens\n" +
+		"2. Add a test in internal/auth/session_test.go\n" +
+		"3. Touch up README.md and internal/Auth/session.go."
+
+	assert.Equal(t,
+		[]string{"internal/auth/session.go", "internal/auth/session_test.go", "internal/Auth/session.go"},
+		HighRiskPathsIn(plan, DefaultHighRiskPaths))
+	assert.Empty(t, HighRiskPathsIn(plan, []string{"billing"}))
+	assert.Empty(t, HighRiskPathsIn("Rewrite the authentication flow", DefaultHighRiskPaths))
+}
+
+func TestHighRiskReason(t *testing.T) {
+	assert.Equal(t, "Plan touches auth.go, login.go", HighRiskReason([]string{"auth.go", "login.go"}))
+	assert.Equal(t, "Plan touches a, b, c, d, e and 2 more", HighRiskReason([]string{"a", "b", "c", "d", "e", "f", "g"}))
+}
+
+func TestHasStepUpApproval(t *testing.T) {
+	alice := &PlanApproval{Approver: "alice", Method: PlanApprovalAPIKey}
+	bob := &PlanApproval{Approver: "bob", Method: PlanApprovalAPIKey}
+	withTOTP := &PlanApproval{Approver: "totp", Method: PlanApprovalTOTP}
+
+	assert.False(t, HasStepUpApproval(nil))
+	assert.False(t, HasStepUpApproval([]*PlanApproval{alice}))
+	assert.False(t, HasStepUpApproval([]*PlanApproval{alice, alice}))
+	assert.True(t, HasStepUpApproval([]*PlanApproval{alice, bob}))
+	assert.True(t, HasStepUpApproval([]*PlanApproval{withTOTP}))
+}
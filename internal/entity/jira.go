@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// JiraAPITokenSecret is the project secret holding the Jira API token
+const JiraAPITokenSecret = "jira_api_token"
+
+// JiraIntegration links a project to a Jira site. Issues matching JQL are
+// imported as tasks; when SyncStatusBack is set, task status changes are
+// pushed back to the issue as workflow transitions.
+type JiraIntegration struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex"`
+	BaseURL   string    `json:"base_url" gorm:"size:500;not null"`
+	Email     string    `json:"email" gorm:"size:255;not null"`
+	JQL       string    `json:"jql" gorm:"column:jql;type:text;not null"`
+
+	// StatusMapping maps Jira status names to the status an imported task
+	// starts in. Unmapped statuses fall back on the status category.
+	StatusMapping     map[string]TaskStatus `json:"status_mapping" gorm:"-"`
+	StatusMappingJSON string                `json:"-" gorm:"column:status_mapping;type:jsonb"`
+
+	// TransitionMapping maps task statuses to the Jira status the issue is
+	// moved to. Statuses without an entry are not synced.
+	TransitionMapping     map[TaskStatus]string `json:"transition_mapping" gorm:"-"`
+	TransitionMappingJSON string                `json:"-" gorm:"column:transition_mapping;type:jsonb"`
+
+	SyncStatusBack bool       `json:"sync_status_back" gorm:"default:false"`
+	LastImportedAt *time.Time `json:"last_imported_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// BeforeSave GORM hook to serialize the mappings
+func (j *JiraIntegration) BeforeSave(tx *gorm.DB) error {
+	statusJSON, err := json.Marshal(j.StatusMapping)
+	if err != nil {
+		return err
+	}
+	transitionJSON, err := json.Marshal(j.TransitionMapping)
+	if err != nil {
+		return err
+	}
+	j.StatusMappingJSON = string(statusJSON)
+	j.TransitionMappingJSON = string(transitionJSON)
+	return nil
+}
+
+// AfterFind GORM hook to deserialize the mappings
+func (j *JiraIntegration) AfterFind(tx *gorm.DB) error {
+	if j.StatusMappingJSON != "" {
+		if err := json.Unmarshal([]byte(j.StatusMappingJSON), &j.StatusMapping); err != nil {
+			return err
+		}
+	}
+	if j.TransitionMappingJSON != "" {
+		if err := json.Unmarshal([]byte(j.TransitionMappingJSON), &j.TransitionMapping); err != nil {
+			return err
+		}
+	}
+	return nil
+}
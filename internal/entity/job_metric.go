@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobMetric is how the background jobs of one type were processed over one
+// day UTC. Workers add to it as they finish jobs, so the history outlives
+// the few days asynq keeps in Redis.
+type JobMetric struct {
+	ID      uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Day     time.Time `json:"day" gorm:"not null;uniqueIndex:idx_job_metrics_day_type"`
+	JobType string    `json:"job_type" gorm:"size:100;not null;uniqueIndex:idx_job_metrics_day_type"`
+	// Processed counts every run of a job, retries included
+	Processed int64 `json:"processed" gorm:"not null;default:0"`
+	Failed    int64 `json:"failed" gorm:"not null;default:0"`
+	// TotalDurationMs and MaxDurationMs are how long the job handlers ran.
+	// Planning and implementation jobs return once their execution started.
+	TotalDurationMs int64     `json:"total_duration_ms" gorm:"not null;default:0"`
+	MaxDurationMs   int64     `json:"max_duration_ms" gorm:"not null;default:0"`
+	CreatedAt       time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// AverageDuration returns how long a run took on average, 0 without runs
+func (m *JobMetric) AverageDuration() time.Duration {
+	if m.Processed == 0 {
+		return 0
+	}
+	return time.Duration(m.TotalDurationMs/m.Processed) * time.Millisecond
+}
+
+// FailureRate returns the share of runs that failed, 0 without runs
+func (m *JobMetric) FailureRate() float64 {
+	if m.Processed == 0 {
+		return 0
+	}
+	return float64(m.Failed) / float64(m.Processed)
+}
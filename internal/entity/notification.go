@@ -14,8 +14,51 @@ const (
 	NotificationTypeTaskCreated      NotificationType = "TASK_CREATED"
 	NotificationTypeTaskUpdated      NotificationType = "TASK_UPDATED"
 	NotificationTypeTaskDeleted      NotificationType = "TASK_DELETED"
+	// NotificationTypeDailyStandup carries a project's daily standup summary
+	NotificationTypeDailyStandup NotificationType = "DAILY_STANDUP"
+	// NotificationTypePlanReady carries a plan waiting for review
+	NotificationTypePlanReady NotificationType = "PLAN_READY"
+	// NotificationTypeExecutionFailed carries an AI execution that failed
+	NotificationTypeExecutionFailed NotificationType = "EXECUTION_FAILED"
+	// NotificationTypePRMerged carries the pull request of a task that was
+	// merged
+	NotificationTypePRMerged NotificationType = "PR_MERGED"
+	// NotificationTypeDailyDigest is the daily email summarizing the task
+	// movement of a project
+	NotificationTypeDailyDigest NotificationType = "DAILY_DIGEST"
 )
 
+// SlackNotificationTypes are the notifications posted to a project's Slack
+// channel, unless muted
+var SlackNotificationTypes = []NotificationType{
+	NotificationTypeTaskCreated,
+	NotificationTypePlanReady,
+	NotificationTypeExecutionFailed,
+	NotificationTypePRMerged,
+}
+
+// NotificationChannel is where notifications are delivered outside the app
+type NotificationChannel string
+
+const (
+	NotificationChannelSlack NotificationChannel = "slack"
+	NotificationChannelEmail NotificationChannel = "email"
+)
+
+// NotificationPreference mutes, or unmutes, a type of notification on one
+// channel of a project. Types without a preference are delivered.
+type NotificationPreference struct {
+	ID        uuid.UUID           `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID           `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_notification_preferences_project_channel_type"`
+	Channel   NotificationChannel `json:"channel" gorm:"size:30;not null;uniqueIndex:idx_notification_preferences_project_channel_type"`
+	Type      NotificationType    `json:"type" gorm:"size:50;not null;uniqueIndex:idx_notification_preferences_project_channel_type"`
+	Muted     bool                `json:"muted" gorm:"not null;default:false"`
+	// UpdatedBy is the user who last changed the preference
+	UpdatedBy *string   `json:"updated_by,omitempty" gorm:"size:255"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
 // NotificationEvent represents a notification event
 type NotificationEvent struct {
 	ID        uuid.UUID        `json:"id"`
@@ -38,6 +81,9 @@ type TaskStatusChangeNotificationData struct {
 	Reason       *string     `json:"reason,omitempty"`
 	ProjectID    uuid.UUID   `json:"project_id"`
 	ProjectName  string      `json:"project_name"`
+
+	// ProjectLocale is the locale of the notification message
+	ProjectLocale string `json:"project_locale,omitempty"`
 }
 
 // NotificationHandler defines the interface for handling notifications
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Organization is a tenant. Projects (and everything hanging off them) are
+// scoped to at most one organization.
+type Organization struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name        string         `json:"name" gorm:"size:255;not null"`
+	Slug        string         `json:"slug" gorm:"size:100;not null"`
+	Description string         `json:"description" gorm:"size:1000"`
+	CreatedAt   time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
+
+	// Relationships
+	Projects []Project `json:"projects,omitempty" gorm:"foreignKey:OrganizationID"`
+}
@@ -68,6 +68,10 @@ type Plan struct {
 	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
 
+	// Feedback is the reviewer's reason for rejecting the plan, given to
+	// the planning run replacing it
+	Feedback *string `json:"feedback,omitempty" gorm:"type:text"`
+
 	// Relationships
 	Task Task `json:"task,omitempty" gorm:"foreignKey:TaskID"`
 }
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlanApprovalMethod is how an approver confirmed a high-risk plan
+type PlanApprovalMethod string
+
+const (
+	// PlanApprovalAPIKey approvals are made with an API key, whose owner is
+	// the approver. Two different approvers are needed.
+	PlanApprovalAPIKey PlanApprovalMethod = "api_key"
+	// PlanApprovalTOTP approvals carry a valid TOTP code and are enough on
+	// their own
+	PlanApprovalTOTP PlanApprovalMethod = "totp"
+)
+
+// PlanApproval records an approval of the plan of a high-risk task, which
+// is only implemented once its approvals give it step-up approval. A new
+// plan needs new approvals.
+type PlanApproval struct {
+	ID       uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TaskID   uuid.UUID          `json:"task_id" gorm:"type:uuid;not null;index"`
+	PlanID   uuid.UUID          `json:"plan_id" gorm:"type:uuid;not null;uniqueIndex:idx_plan_approvals_plan_approver"`
+	Approver string             `json:"approver" gorm:"size:255;not null;uniqueIndex:idx_plan_approvals_plan_approver"`
+	Method   PlanApprovalMethod `json:"method" gorm:"size:20;not null"`
+	// CreatedAt is when the approval was made
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for GORM
+func (PlanApproval) TableName() string {
+	return "plan_approvals"
+}
+
+// HasStepUpApproval reports whether approvals of a plan are enough to
+// implement it when its task is high-risk: one TOTP confirmation, or two
+// different approvers
+func HasStepUpApproval(approvals []*PlanApproval) bool {
+	approvers := make(map[string]bool)
+	for _, approval := range approvals {
+		if approval.Method == PlanApprovalTOTP {
+			return true
+		}
+		approvers[approval.Approver] = true
+	}
+	return len(approvers) >= 2
+}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,16 +11,117 @@ import (
 )
 
 type Project struct {
-	ID               uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Name             string         `json:"name" gorm:"size:255;not null" validate:"required,min=1,max=255"`
-	Description      string         `json:"description" gorm:"size:1000" validate:"max=1000"`
-	RepositoryURL    string         `json:"repository_url" gorm:"column:repository_url;size:500"`
-	WorktreeBasePath     string         `json:"worktree_base_path" gorm:"column:worktree_base_path;size:500"`
-	InitWorkspaceScript  string         `json:"init_workspace_script" gorm:"column:init_workspace_script;type:text"`
-	CreatedAt            time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt        time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt        gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
+	ID                  uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name                string            `json:"name" gorm:"size:255;not null" validate:"required,min=1,max=255"`
+	Description         string            `json:"description" gorm:"size:1000" validate:"max=1000"`
+	RepositoryURL       string            `json:"repository_url" gorm:"column:repository_url;size:500"`
+	WorktreeBasePath    string            `json:"worktree_base_path" gorm:"column:worktree_base_path;size:500"`
+	InitWorkspaceScript string            `json:"init_workspace_script" gorm:"column:init_workspace_script;type:text"`
+	LintCommand         string            `json:"lint_command" gorm:"column:lint_command;type:text"`
+	AutoCommitLintFixes bool              `json:"auto_commit_lint_fixes" gorm:"column:auto_commit_lint_fixes;not null;default:false"`
+	BuildCommand        string            `json:"build_command" gorm:"column:build_command;type:text"`
+	TestCommand         string            `json:"test_command" gorm:"column:test_command;type:text"`
+	TestFailurePolicy   TestFailurePolicy `json:"test_failure_policy" gorm:"column:test_failure_policy;size:20"`
+	CoverageCommand     string            `json:"coverage_command" gorm:"column:coverage_command;type:text"`
+	SecurityScanner     SecurityScanner   `json:"security_scanner" gorm:"column:security_scanner;size:20"`
+	AIReviewEnabled     bool              `json:"ai_review_enabled" gorm:"column:ai_review_enabled;not null;default:false"`
+	PostReviewComments  bool              `json:"post_review_comments" gorm:"column:post_review_comments;not null;default:false"`
+	AutoFixAttempts     int               `json:"auto_fix_attempts" gorm:"column:auto_fix_attempts;not null;default:0"`
+	PreviewEnabled      bool              `json:"preview_enabled" gorm:"column:preview_enabled;not null;default:false"`
+	PreviewCommand      string            `json:"preview_command" gorm:"column:preview_command;type:text"` // docker compose is used when empty
+	OrganizationID      *uuid.UUID        `json:"organization_id,omitempty" gorm:"type:uuid;index"`
+	CreatedAt           time.Time         `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt           time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt           gorm.DeletedAt    `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
+
+	// MonthlyBudgetUSD is what the project may spend on AI runs in a month,
+	// 0 for no budget. BudgetAlertThresholds lists the percentages of it
+	// that raise an alert when reached, comma separated.
+	MonthlyBudgetUSD      float64 `json:"monthly_budget_usd" gorm:"column:monthly_budget_usd;type:numeric(12,2);not null;default:0"`
+	BudgetAlertThresholds string  `json:"budget_alert_thresholds,omitempty" gorm:"column:budget_alert_thresholds;size:100"`
+
+	// AllowedCommands and DeniedCommands restrict the shell commands AI
+	// executions may run, one command prefix per line. See CommandPolicy.
+	AllowedCommands string `json:"allowed_commands,omitempty" gorm:"column:allowed_commands;type:text"`
+	DeniedCommands  string `json:"denied_commands,omitempty" gorm:"column:denied_commands;type:text"`
+
+	// EncryptExecutionLogs seals the message bodies of the project's
+	// execution logs at rest, see ExecutionLog.Encrypted
+	EncryptExecutionLogs bool `json:"encrypt_execution_logs" gorm:"column:encrypt_execution_logs;not null;default:false"`
+
+	// HighRiskPaths are fragments of paths that make a task high-risk when
+	// its plan touches a matching path, one per line. See
+	// HighRiskPathPatterns.
+	HighRiskPaths string `json:"high_risk_paths,omitempty" gorm:"column:high_risk_paths;type:text"`
+
+	// Locale is the language of the project's notifications and generated
+	// pull request and commit texts, see i18n.Locale
+	Locale string `json:"locale" gorm:"column:locale;size:10;not null;default:'en'"`
+
+	// ExportPatches is for projects without a remote git provider: the
+	// changes of a task are attached to it as a patch and a git bundle
+	// instead of pushed and opened as a pull request
+	ExportPatches bool `json:"export_patches" gorm:"column:export_patches;not null;default:false"`
+
+	// GitProvider hosts the project's repository, where its tasks' pull
+	// requests are opened: merge requests on GitLab. Empty detects it from
+	// RepositoryURL.
+	GitProvider GitProvider `json:"git_provider,omitempty" gorm:"column:git_provider;size:20"`
+
+	// MaxConcurrentExecutions bounds how many planning and implementation
+	// executions of the project run at once across the workers, 0 for no
+	// limit. Tasks over it wait in the project's queue.
+	MaxConcurrentExecutions int `json:"max_concurrent_executions" gorm:"column:max_concurrent_executions;not null;default:0"`
 
 	// Relationships
 	Tasks []Task `json:"tasks,omitempty" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	// Settings are loaded for the AI runs on the project's tasks, which
+	// apply their prompt template
+	Settings *ProjectSettings `json:"-" gorm:"-"`
+}
+
+// DefaultBudgetAlertThresholds are the budget percentages that raise an
+// alert when a project with a budget sets none of its own
+var DefaultBudgetAlertThresholds = []int{80, 100}
+
+// MaxBudgetAlertThreshold bounds the budget percentages an alert can be set at
+const MaxBudgetAlertThreshold = 1000
+
+// AlertThresholds returns the project's budget alert thresholds in ascending
+// order, ignoring malformed entries
+func (p *Project) AlertThresholds() []int {
+	if strings.TrimSpace(p.BudgetAlertThresholds) == "" {
+		return DefaultBudgetAlertThresholds
+	}
+	var thresholds []int
+	for _, part := range strings.Split(p.BudgetAlertThresholds, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || threshold <= 0 {
+			continue
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Ints(thresholds)
+	return thresholds
+}
+
+// GitProvider is the service hosting a project's repository
+type GitProvider string
+
+const (
+	GitProviderGitHub GitProvider = "github"
+	GitProviderGitLab GitProvider = "gitlab"
+)
+
+// IsValid reports whether the provider is a known one
+func (p GitProvider) IsValid() bool {
+	return p == GitProviderGitHub || p == GitProviderGitLab
+}
+
+// DisplayName returns the provider's name as written in messages
+func (p GitProvider) DisplayName() string {
+	if p == GitProviderGitLab {
+		return "GitLab"
+	}
+	return "GitHub"
 }
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectActivityType is what happened in an entry of a project's activity
+// feed
+type ProjectActivityType string
+
+const (
+	ProjectActivityTaskCreated       ProjectActivityType = "task_created"
+	ProjectActivityStatusChanged     ProjectActivityType = "status_changed"
+	ProjectActivityPlanApproved      ProjectActivityType = "plan_approved"
+	ProjectActivityExecutionStarted  ProjectActivityType = "execution_started"
+	ProjectActivityExecutionFinished ProjectActivityType = "execution_finished"
+	ProjectActivityPROpened          ProjectActivityType = "pr_opened"
+	ProjectActivityPRMerged          ProjectActivityType = "pr_merged"
+	ProjectActivityPRClosed          ProjectActivityType = "pr_closed"
+)
+
+// ProjectActivity is an entry of a project's activity feed. It is read from
+// the records of the project's tasks rather than stored, so only the fields
+// of its type are set.
+type ProjectActivity struct {
+	Type       ProjectActivityType
+	TaskID     uuid.UUID
+	TaskTitle  string
+	OccurredAt time.Time
+	// Actor is who changed the status, approved the plan, or opened or
+	// merged the pull request, when it is known
+	Actor *string
+
+	FromStatus *TaskStatus
+	ToStatus   *TaskStatus
+	Reason     *string
+
+	PlanID *uuid.UUID
+
+	ExecutionID     *uuid.UUID
+	ExecutionStatus *ExecutionStatus
+	AIType          *string
+
+	PullRequestNumber *int
+	PullRequestURL    *string
+}
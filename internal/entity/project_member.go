@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectRole is what a member may do in a project. Each role may do what
+// the roles below it may.
+type ProjectRole string
+
+const (
+	// ProjectRoleViewer reads the project and its tasks
+	ProjectRoleViewer ProjectRole = "viewer"
+	// ProjectRoleReviewer also approves and rejects plans, requests
+	// changes, comments and answers the questions of executions
+	ProjectRoleReviewer ProjectRole = "reviewer"
+	// ProjectRoleMaintainer also creates, edits and deletes tasks and
+	// starts, cancels and retries their executions
+	ProjectRoleMaintainer ProjectRole = "maintainer"
+	// ProjectRoleOwner also changes the project, its settings and members
+	ProjectRoleOwner ProjectRole = "owner"
+)
+
+// ProjectRoles lists the roles from the least to the most permitted
+var ProjectRoles = []ProjectRole{ProjectRoleViewer, ProjectRoleReviewer, ProjectRoleMaintainer, ProjectRoleOwner}
+
+// IsValid checks if the project role is valid
+func (r ProjectRole) IsValid() bool {
+	return r.rank() >= 0
+}
+
+// AtLeast reports whether the role may do what role may
+func (r ProjectRole) AtLeast(role ProjectRole) bool {
+	return r.IsValid() && r.rank() >= role.rank()
+}
+
+func (r ProjectRole) rank() int {
+	for i, role := range ProjectRoles {
+		if r == role {
+			return i
+		}
+	}
+	return -1
+}
+
+// ProjectMember is a user's membership of a project
+type ProjectMember struct {
+	ProjectID uuid.UUID   `json:"project_id" gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID   `json:"user_id" gorm:"type:uuid;primaryKey;index"`
+	Role      ProjectRole `json:"role" gorm:"size:20;not null"`
+	CreatedAt time.Time   `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time   `json:"updated_at" gorm:"autoUpdateTime"`
+
+	User *User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName returns the table name for GORM
+func (ProjectMember) TableName() string {
+	return "project_members"
+}
@@ -16,9 +16,29 @@ type ProjectSettings struct {
 	GitBranch            string `json:"git_branch" gorm:"size:255;default:'main'"`
 	GitAutoSync          bool   `json:"git_auto_sync" gorm:"default:false"`
 	TaskPrefix           string `json:"task_prefix" gorm:"size:10"`
-	CreatedAt            time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt            time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+
+	// PromptPrefix and PromptSuffix are added before and after the prompt
+	// of every AI run on the project's tasks
+	PromptPrefix string `json:"prompt_prefix,omitempty" gorm:"type:text"`
+	PromptSuffix string `json:"prompt_suffix,omitempty" gorm:"type:text"`
+
+	// ContextFiles are paths in the repository, one per line, of files such
+	// as architecture docs and coding guidelines quoted in those prompts.
+	// See ContextFileList.
+	ContextFiles string    `json:"context_files,omitempty" gorm:"type:text"`
+	CreatedAt    time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 
 	// Relationships
 	Project Project `json:"project,omitempty" gorm:"foreignKey:ProjectID"`
-}
\ No newline at end of file
+}
+
+// ContextFileList returns the paths of the project's context files
+func (s *ProjectSettings) ContextFileList() []string {
+	return splitCommands(s.ContextFiles)
+}
+
+// HasPromptTemplate reports whether the settings add anything to prompts
+func (s *ProjectSettings) HasPromptTemplate() bool {
+	return s.PromptPrefix != "" || s.PromptSuffix != "" || len(s.ContextFileList()) > 0
+}
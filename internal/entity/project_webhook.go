@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookEvent is a task lifecycle event a project webhook can be sent
+type WebhookEvent string
+
+const (
+	// WebhookEventTaskCreated is sent the task created
+	WebhookEventTaskCreated WebhookEvent = "task_created"
+	// WebhookEventStatusChanged is sent the task and its old and new status
+	WebhookEventStatusChanged WebhookEvent = "status_changed"
+	// WebhookEventPlanReady is sent the plan an AI wrote, once it waits for
+	// review
+	WebhookEventPlanReady WebhookEvent = "plan_ready"
+	// WebhookEventExecutionCompleted is sent the AI execution that finished,
+	// whether it completed or failed
+	WebhookEventExecutionCompleted WebhookEvent = "execution_completed"
+	// WebhookEventPRMerged is sent the pull request of the task that was
+	// merged
+	WebhookEventPRMerged WebhookEvent = "pr_merged"
+)
+
+// WebhookEvents lists the events project webhooks can be sent
+var WebhookEvents = []WebhookEvent{
+	WebhookEventTaskCreated,
+	WebhookEventStatusChanged,
+	WebhookEventPlanReady,
+	WebhookEventExecutionCompleted,
+	WebhookEventPRMerged,
+}
+
+// IsValid checks if the webhook event is valid
+func (e WebhookEvent) IsValid() bool {
+	for _, event := range WebhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSecretName returns the name of the project secret holding the key
+// the webhook's deliveries are signed with
+func WebhookSecretName(webhookID uuid.UUID) string {
+	return fmt.Sprintf("webhook_secret_%s", webhookID)
+}
+
+// ProjectWebhook is a URL the project's task lifecycle events are posted
+// to, signed with a secret kept in the project's secrets
+type ProjectWebhook struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;index"`
+	URL       string    `json:"url" gorm:"size:2048;not null"`
+
+	// Events are the events the webhook is sent, every event when empty
+	Events     []WebhookEvent `json:"events" gorm:"-"`
+	EventsJSON string         `json:"-" gorm:"column:events;type:jsonb"`
+
+	Active    bool      `json:"active" gorm:"not null;default:true"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for GORM
+func (ProjectWebhook) TableName() string {
+	return "project_webhooks"
+}
+
+// Subscribes reports whether the webhook is sent event
+func (w *ProjectWebhook) Subscribes(event WebhookEvent) bool {
+	if !w.Active {
+		return false
+	}
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, subscribed := range w.Events {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+// BeforeSave GORM hook to serialize the events
+func (w *ProjectWebhook) BeforeSave(tx *gorm.DB) error {
+	events := w.Events
+	if events == nil {
+		events = []WebhookEvent{}
+	}
+	eventsJSON, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	w.EventsJSON = string(eventsJSON)
+	return nil
+}
+
+// AfterFind GORM hook to deserialize the events
+func (w *ProjectWebhook) AfterFind(tx *gorm.DB) error {
+	if w.EventsJSON == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(w.EventsJSON), &w.Events)
+}
+
+// WebhookDeliveryStatus is where a delivery of a project webhook is at
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries are still being attempted
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded deliveries were answered with a 2xx status
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed deliveries failed every attempt
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// ProjectWebhookDelivery is an event posted, or being posted, to a project
+// webhook, kept with the outcome of its last attempt
+type ProjectWebhookDelivery struct {
+	ID        uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	WebhookID uuid.UUID    `json:"webhook_id" gorm:"type:uuid;not null;index"`
+	Event     WebhookEvent `json:"event" gorm:"size:50;not null"`
+	// Payload is the JSON body posted
+	Payload string                `json:"payload" gorm:"type:jsonb;not null"`
+	Status  WebhookDeliveryStatus `json:"status" gorm:"size:20;not null;default:'pending'"`
+	// Attempts counts the times the payload was posted
+	Attempts       int        `json:"attempts" gorm:"not null;default:0"`
+	ResponseStatus *int       `json:"response_status,omitempty"`
+	Error          string     `json:"error,omitempty" gorm:"type:text"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	// RedeliveryOf is the delivery whose payload this one posts again
+	RedeliveryOf *uuid.UUID `json:"redelivery_of,omitempty" gorm:"type:uuid"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"autoCreateTime;index"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+
+	Webhook *ProjectWebhook `json:"webhook,omitempty" gorm:"foreignKey:WebhookID"`
+}
+
+// TableName returns the table name for GORM
+func (ProjectWebhookDelivery) TableName() string {
+	return "project_webhook_deliveries"
+}
@@ -8,6 +8,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// GitHubTokenSecret is the project secret holding the token its pull
+// requests are opened and tracked with
+const GitHubTokenSecret = "github_token"
+
 // PullRequestStatus represents the status of a pull request
 type PullRequestStatus string
 
@@ -84,9 +88,12 @@ type PullRequest struct {
 	Additions      *int              `json:"additions,omitempty"`
 	Deletions      *int              `json:"deletions,omitempty"`
 	ChangedFiles   *int              `json:"changed_files,omitempty"`
-	CreatedAt      time.Time         `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt      time.Time         `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt      gorm.DeletedAt    `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
+	// QualityGateCommentID is the GitHub ID of the comment summing up the
+	// verification results, updated on every push
+	QualityGateCommentID *int64         `json:"quality_gate_comment_id,omitempty" gorm:"column:quality_gate_comment_id"`
+	CreatedAt            time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt            time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt            gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
 
 	// Relationships
 	Task *Task `json:"task,omitempty" gorm:"foreignKey:TaskID"`
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReleaseNotesSource is what release notes are written from
+type ReleaseNotesSource string
+
+const (
+	// ReleaseNotesSourceTasks covers the tasks completed in the date range
+	ReleaseNotesSourceTasks ReleaseNotesSource = "tasks"
+	// ReleaseNotesSourcePullRequests covers the pull requests merged in the
+	// date range
+	ReleaseNotesSourcePullRequests ReleaseNotesSource = "pull_requests"
+)
+
+// IsValid checks if the release notes source is valid
+func (s ReleaseNotesSource) IsValid() bool {
+	return s == ReleaseNotesSourceTasks || s == ReleaseNotesSourcePullRequests
+}
+
+// ReleaseNotesStatus is where release notes are in their generation
+type ReleaseNotesStatus string
+
+const (
+	ReleaseNotesStatusPending    ReleaseNotesStatus = "pending"
+	ReleaseNotesStatusGenerating ReleaseNotesStatus = "generating"
+	ReleaseNotesStatusCompleted  ReleaseNotesStatus = "completed"
+	ReleaseNotesStatusFailed     ReleaseNotesStatus = "failed"
+)
+
+// ReleaseNotes are human-readable notes on a project's changes over a date
+// range, written by an AI executor from the tasks or pull requests in it
+type ReleaseNotes struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;index"`
+	// Version names the release, optional
+	Version string             `json:"version,omitempty" gorm:"size:100"`
+	Source  ReleaseNotesSource `json:"source" gorm:"size:20;not null"`
+	From    time.Time          `json:"from" gorm:"column:from_time;not null"`
+	To      time.Time          `json:"to" gorm:"column:to_time;not null"`
+	AIType  string             `json:"ai_type" gorm:"column:ai_type;size:50;not null"`
+	Status  ReleaseNotesStatus `json:"status" gorm:"size:20;not null;default:'pending'"`
+	// Changes is the number of tasks or pull requests covered
+	Changes int `json:"changes" gorm:"not null;default:0"`
+	// Content is the notes in Markdown, empty until generated
+	Content     string     `json:"content,omitempty" gorm:"type:text"`
+	Error       string     `json:"error,omitempty" gorm:"type:text"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName returns the table name for GORM
+func (ReleaseNotes) TableName() string {
+	return "release_notes"
+}
+
+// ReleaseChange is a task or pull request covered by release notes
+type ReleaseChange struct {
+	TaskID      uuid.UUID
+	Title       string
+	Description string
+	// PullRequestNumber and PullRequestURL are set for changes from merged
+	// pull requests
+	PullRequestNumber int
+	PullRequestURL    string
+	// CompletedAt is when the task was completed or the pull request merged
+	CompletedAt time.Time
+}
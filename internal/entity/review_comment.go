@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReviewSeverity is how serious the AI reviewer considers a finding
+type ReviewSeverity string
+
+const (
+	ReviewSeverityInfo    ReviewSeverity = "info"
+	ReviewSeverityWarning ReviewSeverity = "warning"
+	ReviewSeverityError   ReviewSeverity = "error"
+)
+
+// IsValid checks if the review severity is valid
+func (s ReviewSeverity) IsValid() bool {
+	switch s {
+	case ReviewSeverityInfo, ReviewSeverityWarning, ReviewSeverityError:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReviewComment is a finding of the AI review of an implementation's diff,
+// made before its pull request is opened
+type ReviewComment struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ExecutionID uuid.UUID `json:"execution_id" gorm:"type:uuid;not null;index"`
+	TaskID      uuid.UUID `json:"task_id" gorm:"type:uuid;not null;index"`
+	// FilePath is relative to the repository root; empty for findings about
+	// the change as a whole
+	FilePath string `json:"file_path,omitempty" gorm:"size:500"`
+	// Line is the line in the new version of the file, when the finding has one
+	Line     *int           `json:"line,omitempty"`
+	Severity ReviewSeverity `json:"severity" gorm:"size:20;not null"`
+	Body     string         `json:"body" gorm:"type:text;not null"`
+	// Posted is set once the comment was added to the pull request
+	Posted    bool      `json:"posted" gorm:"not null;default:false"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
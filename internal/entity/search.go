@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchResultType is the kind of entity a quick search result is
+type SearchResultType string
+
+const (
+	SearchResultProject     SearchResultType = "project"
+	SearchResultTask        SearchResultType = "task"
+	SearchResultPlan        SearchResultType = "plan"
+	SearchResultPullRequest SearchResultType = "pull_request"
+)
+
+// SearchResult is a project, task, plan or pull request matching a quick
+// search. Plans and pull requests are found through their task, whose ID
+// and number they carry.
+type SearchResult struct {
+	Type        SearchResultType
+	ID          uuid.UUID
+	ProjectID   uuid.UUID
+	ProjectName string
+	TaskID      *uuid.UUID
+	TaskNumber  *int64
+	// Title is the name of the project, the title of the task or pull
+	// request, or the title of the plan's task
+	Title string
+	// Text is what else was searched: the description of the project or
+	// task, the plan's content or the pull request's body
+	Text              string
+	Status            string
+	PullRequestNumber *int
+	UpdatedAt         time.Time
+
+	// Score ranks the result against the others, higher first, and Snippet
+	// quotes the part of Text that matched; both are set by the use case
+	Score   float64
+	Snippet string
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectSecret is a named credential (e.g. an integration API token)
+// belonging to a project. Ciphertext is sealed by the secrets store; the
+// plaintext is never persisted or serialized.
+type ProjectSecret struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID  uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_project_secrets_project_name"`
+	Name       string    `json:"name" gorm:"size:100;not null;uniqueIndex:idx_project_secrets_project_name"`
+	Ciphertext string    `json:"-" gorm:"type:text;not null"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
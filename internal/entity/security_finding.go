@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SecurityScanner is a static analysis tool a project scans its changes with
+type SecurityScanner string
+
+const (
+	SecurityScannerGosec   SecurityScanner = "gosec"
+	SecurityScannerSemgrep SecurityScanner = "semgrep"
+	// SecurityScannerSecrets is the built-in secret scan every
+	// implementation's diff goes through before it is pushed. It is not a
+	// project setting.
+	SecurityScannerSecrets SecurityScanner = "secrets"
+)
+
+// IsValid checks if the security scanner is supported
+func (s SecurityScanner) IsValid() bool {
+	return s == SecurityScannerGosec || s == SecurityScannerSemgrep
+}
+
+// SecuritySeverity is the severity a scanner reports a finding with,
+// normalized across scanners
+type SecuritySeverity string
+
+const (
+	SecuritySeverityLow    SecuritySeverity = "low"
+	SecuritySeverityMedium SecuritySeverity = "medium"
+	SecuritySeverityHigh   SecuritySeverity = "high"
+)
+
+// SecurityFinding is an issue the project's security scanner found in a file
+// changed by an implementation
+type SecurityFinding struct {
+	ID          uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ExecutionID uuid.UUID       `json:"execution_id" gorm:"type:uuid;not null;index"`
+	TaskID      uuid.UUID       `json:"task_id" gorm:"type:uuid;not null;index"`
+	Scanner     SecurityScanner `json:"scanner" gorm:"size:20;not null"`
+	// RuleID is the scanner's identifier of the check, e.g. G101
+	RuleID string `json:"rule_id" gorm:"size:255;not null"`
+	// FilePath is relative to the repository root
+	FilePath  string           `json:"file_path" gorm:"size:500;not null"`
+	Line      int              `json:"line"`
+	Severity  SecuritySeverity `json:"severity" gorm:"size:20;not null"`
+	Message   string           `json:"message" gorm:"type:text;not null"`
+	CreatedAt time.Time        `json:"created_at" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Project secrets holding the credentials of a Slack integration
+const (
+	SlackWebhookURLSecret = "slack_webhook_url"
+	SlackBotTokenSecret   = "slack_bot_token"
+)
+
+// SlackDelivery is how notifications reach a project's Slack channel
+type SlackDelivery string
+
+const (
+	// SlackDeliveryWebhook posts to an incoming webhook, which is bound to
+	// its channel
+	SlackDeliveryWebhook SlackDelivery = "webhook"
+	// SlackDeliveryBot posts to Channel with a bot token
+	SlackDeliveryBot SlackDelivery = "bot"
+)
+
+// SlackIntegration posts a project's notifications to a Slack channel. The
+// webhook URL or bot token is kept in the secrets store.
+type SlackIntegration struct {
+	ID        uuid.UUID     `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID     `json:"project_id" gorm:"type:uuid;not null;uniqueIndex"`
+	Delivery  SlackDelivery `json:"delivery" gorm:"size:20;not null"`
+	// Channel is the channel ID or #name bot messages are posted to
+	Channel string `json:"channel,omitempty" gorm:"size:255"`
+	// Interactive adds approve and reject buttons to plans ready for review
+	Interactive bool      `json:"interactive" gorm:"not null;default:false"`
+	CreatedAt   time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StandupReport is what happened in a project over a day, the facts its
+// daily standup summary is written from
+type StandupReport struct {
+	ProjectID   uuid.UUID
+	ProjectName string
+	// ProjectLocale is the locale the summary is written and sent in
+	ProjectLocale string
+	From          time.Time
+	To            time.Time
+	// Executions is the number of AI executions started over the day
+	Executions int
+	// CompletedTasks went to DONE over the day
+	CompletedTasks []StandupTask
+	// FailedTasks had an execution fail over the day
+	FailedTasks []StandupTask
+	// PendingReviews wait for a plan or code review at the time of the report
+	PendingReviews []StandupTask
+}
+
+// IsEmpty reports whether nothing happened and nothing waits for review,
+// leaving nothing to summarize
+func (r *StandupReport) IsEmpty() bool {
+	return r.Executions == 0 && len(r.CompletedTasks) == 0 && len(r.FailedTasks) == 0 && len(r.PendingReviews) == 0
+}
+
+// StandupTask is a task mentioned in a standup report
+type StandupTask struct {
+	TaskID uuid.UUID
+	Title  string
+	Status TaskStatus
+	// Detail is the error of a failed execution, empty otherwise
+	Detail string
+}
@@ -73,6 +73,23 @@ func (tp TaskPriority) GetDisplayName() string {
 	}
 }
 
+// Rank orders priorities on the board, 0 for the most urgent. Unknown
+// priorities rank last.
+func (tp TaskPriority) Rank() int {
+	switch tp {
+	case TaskPriorityUrgent:
+		return 0
+	case TaskPriorityHigh:
+		return 1
+	case TaskPriorityMedium:
+		return 2
+	case TaskPriorityLow:
+		return 3
+	default:
+		return 4
+	}
+}
+
 // GetAllTaskPriorities returns all valid task priorities
 func GetAllTaskPriorities() []TaskPriority {
 	return []TaskPriority{
@@ -264,33 +281,60 @@ func (ts TaskStatus) GetDisplayName() string {
 }
 
 type Task struct {
-	ID             uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ProjectID      uuid.UUID      `json:"project_id" gorm:"type:uuid;not null" validate:"required"`
-	Title          string         `json:"title" gorm:"size:255;not null" validate:"required,min=1,max=255"`
-	Description    string         `json:"description" gorm:"size:1000" validate:"max=1000"`
-	Status         TaskStatus     `json:"status" gorm:"size:50;not null;default:'TODO'" validate:"required,oneof=TODO PLANNING PLAN_REVIEWING IMPLEMENTING CODE_REVIEWING DONE CANCELLED"`
-	Priority       TaskPriority   `json:"priority" gorm:"size:20;default:'MEDIUM'" validate:"oneof=LOW MEDIUM HIGH URGENT"`
-	BranchName     *string        `json:"branch_name,omitempty" gorm:"size:255"`
-	PullRequest    *string        `json:"pull_request,omitempty" gorm:"size:255"`
-	WorktreePath   *string        `json:"worktree_path,omitempty" gorm:"type:text"`
-	GitStatus      TaskGitStatus  `json:"git_status" gorm:"size:50;default:'none'"`
-	EstimatedHours *float64       `json:"estimated_hours,omitempty" gorm:"type:decimal(5,2)" validate:"min=0,max=999.99"`
-	ActualHours    *float64       `json:"actual_hours,omitempty" gorm:"type:decimal(5,2)" validate:"min=0,max=999.99"`
-	Tags           []string       `json:"tags,omitempty" gorm:"-"` // Will be stored as JSON in database
-	TagsJSON       string         `json:"-" gorm:"column:tags;type:jsonb"`
-	ParentTaskID   *uuid.UUID     `json:"parent_task_id,omitempty" gorm:"type:uuid"`
-	IsArchived     bool           `json:"is_archived" gorm:"default:false"`
-	IsTemplate     bool           `json:"is_template" gorm:"default:false"`
-	TemplateID     *uuid.UUID     `json:"template_id,omitempty" gorm:"type:uuid"`
-	AssignedTo     *string        `json:"assigned_to,omitempty" gorm:"size:255"` // User ID for future assignment
-	KanbanTaskID   *string        `json:"kanban_task_id,omitempty" gorm:"size:64"` // Hermes kanban card ID for callback
-	DueDate        *time.Time     `json:"due_date,omitempty"`
-	CreatedAt      time.Time      `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt      time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt      gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
-	BaseBranchName  *string  `json:"base_branch_name,omitempty" gorm:"size:255"`
-	ErrorLogEntries []string `json:"error_logs,omitempty" gorm:"-"`
-	ErrorLogsJSON   string   `json:"-" gorm:"column:error_logs;type:text"`
+	ID                    uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Number                int64          `json:"number" gorm:"<-:create;default:nextval('tasks_number_seq');uniqueIndex:idx_tasks_number"` // Assigned by the database, see TaskKey
+	ProjectID             uuid.UUID      `json:"project_id" gorm:"type:uuid;not null" validate:"required"`
+	Title                 string         `json:"title" gorm:"size:255;not null" validate:"required,min=1,max=255"`
+	Description           string         `json:"description" gorm:"size:1000" validate:"max=1000"`
+	Status                TaskStatus     `json:"status" gorm:"size:50;not null;default:'TODO'" validate:"required,oneof=TODO PLANNING PLAN_REVIEWING IMPLEMENTING CODE_REVIEWING DONE CANCELLED"`
+	Priority              TaskPriority   `json:"priority" gorm:"size:20;default:'MEDIUM'" validate:"oneof=LOW MEDIUM HIGH URGENT"`
+	BranchName            *string        `json:"branch_name,omitempty" gorm:"size:255"`
+	PullRequest           *string        `json:"pull_request,omitempty" gorm:"size:255"`
+	WorktreePath          *string        `json:"worktree_path,omitempty" gorm:"type:text"`
+	PreviewURL            *string        `json:"preview_url,omitempty" gorm:"column:preview_url;size:255"` // Running preview environment of the worktree
+	GitStatus             TaskGitStatus  `json:"git_status" gorm:"size:50;default:'none'"`
+	EstimatedHours        *float64       `json:"estimated_hours,omitempty" gorm:"type:decimal(5,2)" validate:"min=0,max=999.99"`
+	ActualHours           *float64       `json:"actual_hours,omitempty" gorm:"type:decimal(5,2)" validate:"min=0,max=999.99"`
+	Tags                  []string       `json:"tags,omitempty" gorm:"-"` // Will be stored as JSON in database
+	TagsJSON              string         `json:"-" gorm:"column:tags;type:jsonb"`
+	ParentTaskID          *uuid.UUID     `json:"parent_task_id,omitempty" gorm:"type:uuid"`
+	EpicID                *uuid.UUID     `json:"epic_id,omitempty" gorm:"type:uuid;index"` // Epic the task is grouped under
+	IsArchived            bool           `json:"is_archived" gorm:"default:false"`
+	IsTemplate            bool           `json:"is_template" gorm:"default:false"`
+	TemplateID            *uuid.UUID     `json:"template_id,omitempty" gorm:"type:uuid"`
+	AssignedTo            *string        `json:"assigned_to,omitempty" gorm:"size:255"`                                            // User ID for future assignment
+	KanbanTaskID          *string        `json:"kanban_task_id,omitempty" gorm:"size:64"`                                          // Hermes kanban card ID for callback
+	JiraIssueKey          *string        `json:"jira_issue_key,omitempty" gorm:"size:64"`                                          // Jira issue the task was imported from
+	GitHubProjectItemID   *string        `json:"github_project_item_id,omitempty" gorm:"column:github_project_item_id;size:100"`   // GitHub project item the task was imported from
+	GitHubIssueRepository *string        `json:"github_issue_repository,omitempty" gorm:"column:github_issue_repository;size:255"` // Repository (owner/name) of the GitHub issue the task was imported from
+	GitHubIssueNumber     *int           `json:"github_issue_number,omitempty" gorm:"column:github_issue_number"`                  // Number of the GitHub issue the task was imported from
+	GitHubIssueComments   bool           `json:"github_issue_comments" gorm:"column:github_issue_comments;default:false"`          // Whether the plan and PR are posted back to the GitHub issue
+	DueDate               *time.Time     `json:"due_date,omitempty"`
+	CreatedAt             time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt             time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt             gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
+	BaseBranchName        *string        `json:"base_branch_name,omitempty" gorm:"size:255"`
+	ErrorLogEntries       []string       `json:"error_logs,omitempty" gorm:"-"`
+	ErrorLogsJSON         string         `json:"-" gorm:"column:error_logs;type:text"`
+
+	// HighRisk tasks only have their plan implemented once it has step-up
+	// approval, see PlanApproval. HighRiskReason tells why, e.g. which
+	// sensitive paths the plan touches.
+	HighRisk       bool    `json:"high_risk" gorm:"column:high_risk;not null;default:false"`
+	HighRiskReason *string `json:"high_risk_reason,omitempty" gorm:"column:high_risk_reason;size:500"`
+
+	// WorkflowType selects the project's workflow preset adjusting how the
+	// task is planned, implemented and verified; none applies when empty
+	WorkflowType WorkflowType `json:"workflow_type,omitempty" gorm:"column:workflow_type;size:20"`
+
+	// PlanningIterations counts the planning runs of the task, one more
+	// each time a rejected plan is planned again with feedback
+	PlanningIterations int `json:"planning_iterations" gorm:"column:planning_iterations;not null;default:0"`
+
+	// QueuePosition is the task's place among the tasks waiting for one of
+	// their project's execution slots, 1 being next, and nil when the task
+	// is not waiting
+	QueuePosition *int `json:"queue_position,omitempty" gorm:"column:queue_position"`
 
 	// Relationships
 	Project    *Project       `json:"project,omitempty" gorm:"foreignKey:ProjectID"`
@@ -298,6 +342,46 @@ type Task struct {
 	Subtasks   []Task         `json:"subtasks,omitempty" gorm:"foreignKey:ParentTaskID"`
 	AuditLogs  []TaskAuditLog `json:"audit_logs,omitempty" gorm:"foreignKey:TaskID"`
 	Plans      []Plan         `json:"plan,omitempty" gorm:"foreignKey:TaskID"`
+	// Executions, PullRequests and Comments are only loaded for the detail
+	// view of the task, see TaskDetail
+	Executions   []Execution   `json:"executions,omitempty" gorm:"foreignKey:TaskID"`
+	PullRequests []PullRequest `json:"pull_requests,omitempty" gorm:"foreignKey:TaskID"`
+	Comments     []TaskComment `json:"comments,omitempty" gorm:"foreignKey:TaskID"`
+}
+
+// TaskDetail is a task as its detail view shows it, read at once: Plans,
+// Executions and PullRequests hold only the latest of each, Comments all of
+// them, oldest first
+type TaskDetail struct {
+	Task *Task
+	// LatestExecutionLogCount is how many log lines the latest execution has
+	LatestExecutionLogCount int64
+}
+
+// LatestPlan returns the latest plan of the task, nil when it has none
+func (d *TaskDetail) LatestPlan() *Plan {
+	if len(d.Task.Plans) == 0 {
+		return nil
+	}
+	return &d.Task.Plans[0]
+}
+
+// LatestExecution returns the latest execution of the task, nil when it has
+// none
+func (d *TaskDetail) LatestExecution() *Execution {
+	if len(d.Task.Executions) == 0 {
+		return nil
+	}
+	return &d.Task.Executions[0]
+}
+
+// PullRequest returns the latest pull request of the task, nil when it has
+// none
+func (d *TaskDetail) PullRequest() *PullRequest {
+	if len(d.Task.PullRequests) == 0 {
+		return nil
+	}
+	return &d.Task.PullRequests[0]
 }
 
 // TaskAuditLog tracks all modifications to tasks
@@ -350,6 +434,10 @@ type TaskStatusHistory struct {
 	CreatedAt  time.Time      `json:"created_at" gorm:"autoCreateTime"`
 	DeletedAt  gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index" swaggertype:"string"`
 
+	// PlanningIteration numbers the changes to PLANNING, the first planning
+	// run being 1; zero for the other changes
+	PlanningIteration int `json:"planning_iteration,omitempty" gorm:"-"`
+
 	// Relationships
 	Task Task `json:"task,omitempty" gorm:"foreignKey:TaskID"`
 }
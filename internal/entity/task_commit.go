@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskKeyPrefix is the prefix of the short task references (AD-<number>)
+// recognised in commit messages
+const TaskKeyPrefix = "AD"
+
+// TaskKey returns the short reference of a task number, e.g. AD-42
+func TaskKey(number int64) string {
+	return fmt.Sprintf("%s-%d", TaskKeyPrefix, number)
+}
+
+// TaskCommit is a pushed commit whose message references a task
+type TaskCommit struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	TaskID      uuid.UUID  `json:"task_id" gorm:"type:uuid;not null;uniqueIndex:idx_task_commits_task_sha"`
+	SHA         string     `json:"sha" gorm:"column:sha;size:40;not null;uniqueIndex:idx_task_commits_task_sha"`
+	Message     string     `json:"message" gorm:"type:text;not null"`
+	AuthorName  string     `json:"author_name" gorm:"size:255"`
+	AuthorEmail string     `json:"author_email" gorm:"size:255"`
+	URL         string     `json:"url" gorm:"size:500"`
+	Branch      string     `json:"branch" gorm:"size:255"`
+	CommittedAt *time.Time `json:"committed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UndoOperationType is the bulk operation an undo token reverts
+type UndoOperationType string
+
+const (
+	UndoOperationBulkDelete  UndoOperationType = "bulk_delete"
+	UndoOperationBulkArchive UndoOperationType = "bulk_archive"
+)
+
+// UndoOperation records a destructive bulk operation on a project's tasks so
+// it can be reverted with its token until ExpiresAt
+type UndoOperation struct {
+	Token     string            `json:"token" gorm:"size:64;primaryKey"`
+	ProjectID uuid.UUID         `json:"project_id" gorm:"type:uuid;not null"`
+	Operation UndoOperationType `json:"operation" gorm:"size:30;not null"`
+	// TaskIDs are the tasks the operation changed, which undoing restores
+	TaskIDs     []uuid.UUID `json:"task_ids" gorm:"-"`
+	TaskIDsJSON string      `json:"-" gorm:"column:task_ids;type:text;not null"`
+	ExpiresAt   time.Time   `json:"expires_at" gorm:"not null;index"`
+	UndoneAt    *time.Time  `json:"undone_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for GORM
+func (UndoOperation) TableName() string {
+	return "undo_operations"
+}
+
+// BeforeCreate GORM hook to convert TaskIDs to TaskIDsJSON before saving
+func (o *UndoOperation) BeforeCreate(tx *gorm.DB) error {
+	taskIDs := o.TaskIDs
+	if taskIDs == nil {
+		taskIDs = []uuid.UUID{}
+	}
+	taskIDsJSON, err := json.Marshal(taskIDs)
+	if err != nil {
+		return err
+	}
+	o.TaskIDsJSON = string(taskIDsJSON)
+	return nil
+}
+
+// AfterFind GORM hook to convert TaskIDsJSON to TaskIDs after loading
+func (o *UndoOperation) AfterFind(tx *gorm.DB) error {
+	if o.TaskIDsJSON != "" {
+		return json.Unmarshal([]byte(o.TaskIDsJSON), &o.TaskIDs)
+	}
+	return nil
+}
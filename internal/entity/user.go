@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User is an account signing in to the API. Its username is the name the
+// user is recorded under, see UserProfile.
+type User struct {
+	ID       uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Username string    `json:"username" gorm:"size:255;not null;uniqueIndex"`
+	Email    *string   `json:"email,omitempty" gorm:"size:255"`
+	// PasswordHash is the bcrypt hash of the user's password
+	PasswordHash string `json:"-" gorm:"size:255;not null"`
+	// OrganizationID is the organization the user's requests are scoped to
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty" gorm:"type:uuid;index"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for GORM
+func (User) TableName() string {
+	return "users"
+}
+
+// UserAPIKey is a key a user created for scripts and tools, which
+// authenticates as the user. Only the key's hash is kept.
+type UserAPIKey struct {
+	ID     uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID uuid.UUID `json:"user_id" gorm:"type:uuid;not null;index"`
+	Name   string    `json:"name" gorm:"size:255;not null"`
+	// Prefix is the start of the key, shown to tell keys apart
+	Prefix string `json:"prefix" gorm:"size:16;not null"`
+	// KeyHash is the hex SHA-256 hash of the key
+	KeyHash    string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// TableName returns the table name for GORM
+func (UserAPIKey) TableName() string {
+	return "user_api_keys"
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// DefaultTimezone is the timezone of users who have not set one
+const DefaultTimezone = "UTC"
+
+// UserProfile describes a user by the name they are recorded under: the
+// owner of their API key, as stored in the created_by, assigned_to and
+// approver fields and as the author of comments. Users without a saved
+// profile are shown with a default one.
+type UserProfile struct {
+	Username    string `json:"username" gorm:"size:255;primaryKey"`
+	DisplayName string `json:"display_name" gorm:"size:255"`
+	// Timezone is an IANA time zone name such as Asia/Ho_Chi_Minh
+	Timezone string `json:"timezone" gorm:"size:64;not null;default:'UTC'"`
+	// AvatarPath is the key of the avatar image in the attachment storage
+	AvatarPath      *string    `json:"-" gorm:"size:500"`
+	AvatarMimeType  string     `json:"-" gorm:"size:100"`
+	AvatarSize      int64      `json:"-"`
+	AvatarUpdatedAt *time.Time `json:"avatar_updated_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt       time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for GORM
+func (UserProfile) TableName() string {
+	return "user_profiles"
+}
+
+// NewUserProfile returns the default profile of a user who has not saved
+// one
+func NewUserProfile(username string) *UserProfile {
+	return &UserProfile{Username: username, Timezone: DefaultTimezone}
+}
+
+// Name returns the name to show for the user, their display name or else
+// their username
+func (p *UserProfile) Name() string {
+	if p.DisplayName != "" {
+		return p.DisplayName
+	}
+	return p.Username
+}
+
+// HasAvatar reports whether the user uploaded an avatar
+func (p *UserProfile) HasAvatar() bool {
+	return p.AvatarPath != nil
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VelocityRollup is a project's throughput over one week, Monday to Monday
+// UTC. It is recomputed by the velocity rollup job.
+type VelocityRollup struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_velocity_rollups_project_week"`
+	WeekStart time.Time `json:"week_start" gorm:"not null;uniqueIndex:idx_velocity_rollups_project_week"`
+	// TasksCompleted counts the tasks that went to DONE during the week and
+	// are still DONE
+	TasksCompleted     int `json:"tasks_completed" gorm:"not null;default:0"`
+	PullRequestsMerged int `json:"pull_requests_merged" gorm:"not null;default:0"`
+	// Executions counts every AI run of the tasks completed during the week,
+	// whenever it ran
+	Executions int       `json:"executions" gorm:"not null;default:0"`
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// AverageExecutionsPerTask returns how many AI runs the week's completed
+// tasks took on average, 0 when none were completed
+func (r *VelocityRollup) AverageExecutionsPerTask() float64 {
+	if r.TasksCompleted == 0 {
+		return 0
+	}
+	return float64(r.Executions) / float64(r.TasksCompleted)
+}
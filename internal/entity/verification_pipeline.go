@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VerificationPipelineStep is one step of a project's verification pipeline
+type VerificationPipelineStep struct {
+	Step VerificationStep `json:"step"`
+	// Command overrides the project's command for lint, build and test steps,
+	// and is required for setup steps. Security scan, review and test
+	// changes steps take none.
+	Command string `json:"command,omitempty"`
+}
+
+// CommandFor returns the command the step runs for project: its own, or for
+// lint, build and test steps the project's
+func (s VerificationPipelineStep) CommandFor(project *Project) string {
+	if s.Command != "" {
+		return s.Command
+	}
+	switch s.Step {
+	case VerificationStepLint:
+		return project.LintCommand
+	case VerificationStepBuild:
+		return project.BuildCommand
+	case VerificationStepTest:
+		return project.TestCommand
+	default:
+		return ""
+	}
+}
+
+// VerificationPipeline is the ordered list of steps a project's
+// implementations go through before their pull request is opened. Projects
+// without one get DefaultVerificationPipeline.
+type VerificationPipeline struct {
+	ID        uuid.UUID                  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID                  `json:"project_id" gorm:"type:uuid;not null;uniqueIndex"`
+	Steps     []VerificationPipelineStep `json:"steps" gorm:"-"`
+	StepsJSON string                     `json:"-" gorm:"column:steps;type:jsonb;not null"`
+	CreatedAt time.Time                  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time                  `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// IsDefault reports whether the pipeline was derived from the project's
+// settings rather than saved
+func (p *VerificationPipeline) IsDefault() bool {
+	return p.ID == uuid.Nil
+}
+
+// BeforeSave GORM hook to serialize the steps
+func (p *VerificationPipeline) BeforeSave(tx *gorm.DB) error {
+	stepsJSON, err := json.Marshal(p.Steps)
+	if err != nil {
+		return err
+	}
+	p.StepsJSON = string(stepsJSON)
+	return nil
+}
+
+// AfterFind GORM hook to deserialize the steps
+func (p *VerificationPipeline) AfterFind(tx *gorm.DB) error {
+	if p.StepsJSON == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(p.StepsJSON), &p.Steps)
+}
+
+// DefaultVerificationPipeline returns the pipeline the project's settings
+// describe: lint, build, test, security scan and AI review, each when
+// configured
+func DefaultVerificationPipeline(project *Project) *VerificationPipeline {
+	pipeline := &VerificationPipeline{ProjectID: project.ID, Steps: []VerificationPipelineStep{}}
+	if project.LintCommand != "" {
+		pipeline.Steps = append(pipeline.Steps, VerificationPipelineStep{Step: VerificationStepLint})
+	}
+	if project.BuildCommand != "" {
+		pipeline.Steps = append(pipeline.Steps, VerificationPipelineStep{Step: VerificationStepBuild})
+	}
+	if project.TestCommand != "" {
+		pipeline.Steps = append(pipeline.Steps, VerificationPipelineStep{Step: VerificationStepTest})
+	}
+	if project.SecurityScanner != "" {
+		pipeline.Steps = append(pipeline.Steps, VerificationPipelineStep{Step: VerificationStepSecurityScan})
+	}
+	if project.AIReviewEnabled {
+		pipeline.Steps = append(pipeline.Steps, VerificationPipelineStep{Step: VerificationStepReview})
+	}
+	return pipeline
+}
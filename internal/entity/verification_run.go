@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationStep is a project command run in the task worktree after an
+// implementation, before its pull request is opened
+type VerificationStep string
+
+const (
+	// VerificationStepSetup runs a preparation command, e.g. installing
+	// dependencies
+	VerificationStepSetup VerificationStep = "setup"
+	VerificationStepLint  VerificationStep = "lint"
+	VerificationStepBuild VerificationStep = "build"
+	VerificationStepTest  VerificationStep = "test"
+	// VerificationStepSecurityScan runs the project's security scanner over
+	// the changed files
+	VerificationStepSecurityScan VerificationStep = "security_scan"
+	// VerificationStepReview has the AI review the changes
+	VerificationStepReview VerificationStep = "review"
+	// VerificationStepTestChanges checks the changes touch a test file
+	VerificationStepTestChanges VerificationStep = "test_changes"
+)
+
+// IsValid checks if the verification step is valid
+func (s VerificationStep) IsValid() bool {
+	switch s {
+	case VerificationStepSetup, VerificationStepLint, VerificationStepBuild, VerificationStepTest,
+		VerificationStepSecurityScan, VerificationStepReview, VerificationStepTestChanges:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetDisplayName returns the human-readable name of the step
+func (s VerificationStep) GetDisplayName() string {
+	switch s {
+	case VerificationStepSetup:
+		return "Setup"
+	case VerificationStepLint:
+		return "Lint"
+	case VerificationStepBuild:
+		return "Build"
+	case VerificationStepTest:
+		return "Test"
+	case VerificationStepSecurityScan:
+		return "Security Scan"
+	case VerificationStepReview:
+		return "Review"
+	case VerificationStepTestChanges:
+		return "Test Changes"
+	default:
+		return string(s)
+	}
+}
+
+// TestFailurePolicy decides what happens to the pull request of an
+// implementation whose tests fail
+type TestFailurePolicy string
+
+const (
+	// TestFailurePolicyBlock keeps the changes in the worktree and opens no
+	// pull request
+	TestFailurePolicyBlock TestFailurePolicy = "block"
+	// TestFailurePolicyFlag opens the pull request anyway, with the failure
+	// called out in its description
+	TestFailurePolicyFlag TestFailurePolicy = "flag"
+)
+
+// IsValid checks if the test failure policy is valid
+func (p TestFailurePolicy) IsValid() bool {
+	return p == TestFailurePolicyBlock || p == TestFailurePolicyFlag
+}
+
+// MaxAutoFixAttempts bounds how many times a project may have the AI fix a
+// failing build or test run
+const MaxAutoFixAttempts = 5
+
+// VerificationRunStatus is where a verification run is in its pipeline
+type VerificationRunStatus string
+
+const (
+	VerificationRunStatusPending VerificationRunStatus = "pending"
+	VerificationRunStatusRunning VerificationRunStatus = "running"
+	VerificationRunStatusPassed  VerificationRunStatus = "passed"
+	VerificationRunStatusFailed  VerificationRunStatus = "failed"
+	// VerificationRunStatusSkipped is set on the steps after one that blocked
+	// the pull request, and on steps with nothing to do
+	VerificationRunStatusSkipped VerificationRunStatus = "skipped"
+)
+
+// VerificationRun is a run of one step of the project's verification
+// pipeline. All of an execution's runs are created pending when the pipeline
+// starts.
+type VerificationRun struct {
+	ID          uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ExecutionID uuid.UUID        `json:"execution_id" gorm:"type:uuid;not null;index"`
+	TaskID      uuid.UUID        `json:"task_id" gorm:"type:uuid;not null;index"`
+	Step        VerificationStep `json:"step" gorm:"size:20;not null;default:'test'"`
+	Position    int              `json:"position" gorm:"not null;default:0"`
+	// Attempt is 0 for the verification of the implementation, and n for the
+	// one after the nth auto-fix attempt
+	Attempt int                   `json:"attempt" gorm:"not null;default:0"`
+	Status  VerificationRunStatus `json:"status" gorm:"size:20;not null;default:'pending'"`
+	Command string                `json:"command" gorm:"type:text;not null"`
+	Passed  bool                  `json:"passed" gorm:"not null"`
+	// ExitCode is -1 when the command could not be started or timed out
+	ExitCode int    `json:"exit_code"`
+	Output   string `json:"output" gorm:"type:text"`
+	// FixesCommitted is set when the files the command changed were committed
+	FixesCommitted bool      `json:"fixes_committed" gorm:"not null;default:false"`
+	StartedAt      time.Time `json:"started_at" gorm:"not null"`
+	CompletedAt    time.Time `json:"completed_at" gorm:"not null"`
+	CreatedAt      time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// GetDuration returns how long the command ran
+func (r *VerificationRun) GetDuration() time.Duration {
+	return r.CompletedAt.Sub(r.StartedAt)
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// WebhookProviderGitHub is the provider of GitHub webhook deliveries
+const WebhookProviderGitHub = "github"
+
+// WebhookDelivery is a webhook delivery that has been processed, kept for as
+// long as the delivery would be accepted so a replay of it is recognised
+type WebhookDelivery struct {
+	Provider   string    `json:"provider" gorm:"size:30;primaryKey"`
+	DeliveryID string    `json:"delivery_id" gorm:"size:255;primaryKey"`
+	ReceivedAt time.Time `json:"received_at" gorm:"not null;index"`
+}
+
+// TableName returns the table name for GORM
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookSource is a repository whose host sends pull request webhooks, so
+// its pull requests are synced as the events arrive rather than polled
+type WebhookSource struct {
+	Provider string `json:"provider" gorm:"size:30;primaryKey"`
+	// Repository is the owner/name path of the repository
+	Repository  string    `json:"repository" gorm:"size:255;primaryKey"`
+	LastEventAt time.Time `json:"last_event_at" gorm:"not null;index"`
+}
+
+// TableName returns the table name for GORM
+func (WebhookSource) TableName() string {
+	return "webhook_sources"
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WorkflowType is the kind of change a task makes, chosen when it is
+// created. It selects the project's workflow preset for the task.
+type WorkflowType string
+
+const (
+	WorkflowTypeFeature  WorkflowType = "feature"
+	WorkflowTypeBugfix   WorkflowType = "bugfix"
+	WorkflowTypeRefactor WorkflowType = "refactor"
+)
+
+// WorkflowTypes lists the workflow types in the order they are presented
+var WorkflowTypes = []WorkflowType{WorkflowTypeFeature, WorkflowTypeBugfix, WorkflowTypeRefactor}
+
+// IsValid checks if the workflow type is valid
+func (w WorkflowType) IsValid() bool {
+	switch w {
+	case WorkflowTypeFeature, WorkflowTypeBugfix, WorkflowTypeRefactor:
+		return true
+	default:
+		return false
+	}
+}
+
+// WorkflowPreset adjusts how a project's tasks of one workflow type are
+// planned, implemented and verified. Projects without a saved preset for a
+// type get DefaultWorkflowPreset.
+type WorkflowPreset struct {
+	ID        uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID    `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_workflow_presets_project_workflow"`
+	Workflow  WorkflowType `json:"workflow" gorm:"size:20;not null;uniqueIndex:idx_workflow_presets_project_workflow"`
+	// PlanningInstructions and ImplementationInstructions are added to the
+	// planning and implementation prompts of the tasks
+	PlanningInstructions       string `json:"planning_instructions" gorm:"type:text"`
+	ImplementationInstructions string `json:"implementation_instructions" gorm:"type:text"`
+	// RequireTestChanges adds a test changes step to the verification
+	// pipeline, blocking the pull request of changes that touch no test
+	RequireTestChanges bool      `json:"require_test_changes" gorm:"not null;default:false"`
+	CreatedAt          time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// TableName returns the table name for GORM
+func (WorkflowPreset) TableName() string {
+	return "workflow_presets"
+}
+
+// IsDefault reports whether the preset is the built-in one rather than
+// saved
+func (p *WorkflowPreset) IsDefault() bool {
+	return p.ID == uuid.Nil
+}
+
+// DefaultWorkflowPreset returns the built-in preset of workflow: a bugfix
+// reproduces the bug in a regression test first, a refactor keeps the
+// behaviour unchanged and a feature is covered by tests
+func DefaultWorkflowPreset(projectID uuid.UUID, workflow WorkflowType) *WorkflowPreset {
+	preset := &WorkflowPreset{ProjectID: projectID, Workflow: workflow}
+	switch workflow {
+	case WorkflowTypeBugfix:
+		preset.PlanningInstructions = "This task fixes a bug. Find its root cause before planning the fix, and plan a regression test reproducing it."
+		preset.ImplementationInstructions = "This task fixes a bug. First write a regression test that reproduces it and fails, then fix the root cause so the test passes. Keep the fix as small as possible."
+		preset.RequireTestChanges = true
+	case WorkflowTypeRefactor:
+		preset.PlanningInstructions = "This task is a refactoring. Plan changes that keep the behaviour and the public interfaces unchanged."
+		preset.ImplementationInstructions = "This task is a refactoring. Do not change the behaviour or the public interfaces, and do not change existing tests other than to follow renames; they must keep passing."
+	case WorkflowTypeFeature:
+		preset.ImplementationInstructions = "This task adds a feature. Cover the new behaviour with tests."
+	}
+	return preset
+}
+
+// Instructions returns what the preset adds to the planning prompt, or to
+// the implementation prompt
+func (p *WorkflowPreset) Instructions(planning bool) string {
+	if planning {
+		return p.PlanningInstructions
+	}
+	return p.ImplementationInstructions
+}
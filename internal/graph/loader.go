@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"context"
+	"sync"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// batchLoader is a small dataloader. Keys are registered when their parent
+// objects are resolved (e.g. every task of a list), and the first Load fetches
+// all registered keys with one query. Later loads are served from the cache.
+type batchLoader[V any] struct {
+	fetch func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]V, error)
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+	cache   map[uuid.UUID]V
+}
+
+func newBatchLoader[V any](fetch func(ctx context.Context, keys []uuid.UUID) (map[uuid.UUID]V, error)) *batchLoader[V] {
+	return &batchLoader[V]{
+		fetch:   fetch,
+		pending: make(map[uuid.UUID]struct{}),
+		cache:   make(map[uuid.UUID]V),
+	}
+}
+
+// Register queues keys for the next batch
+func (l *batchLoader[V]) Register(keys ...uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if _, ok := l.cache[key]; !ok {
+			l.pending[key] = struct{}{}
+		}
+	}
+}
+
+// Load returns the value for key, fetching it together with every pending key
+func (l *batchLoader[V]) Load(ctx context.Context, key uuid.UUID) (V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if v, ok := l.cache[key]; ok {
+		return v, nil
+	}
+
+	l.pending[key] = struct{}{}
+	keys := make([]uuid.UUID, 0, len(l.pending))
+	for k := range l.pending {
+		keys = append(keys, k)
+	}
+
+	values, err := l.fetch(ctx, keys)
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+
+	for _, k := range keys {
+		l.cache[k] = values[k]
+		delete(l.pending, k)
+	}
+	return l.cache[key], nil
+}
+
+// loaders holds the per-request dataloaders
+type loaders struct {
+	projects     *batchLoader[*entity.Project]
+	projectTasks *batchLoader[[]*entity.Task]
+	plans        *batchLoader[[]*entity.Plan]
+	executions   *batchLoader[[]*entity.Execution]
+	pullRequests *batchLoader[*entity.PullRequest]
+}
+
+func newLoaders(
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	planRepo repository.PlanRepository,
+	executionRepo repository.ExecutionRepository,
+	pullRequestRepo repository.PullRequestRepository,
+) *loaders {
+	return &loaders{
+		projects: newBatchLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*entity.Project, error) {
+			projects, err := projectRepo.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[uuid.UUID]*entity.Project, len(projects))
+			for _, p := range projects {
+				byID[p.ID] = p
+			}
+			return byID, nil
+		}),
+		projectTasks: newBatchLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*entity.Task, error) {
+			tasks, err := taskRepo.GetByProjectIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			return groupBy(tasks, func(t *entity.Task) uuid.UUID { return t.ProjectID }), nil
+		}),
+		plans: newBatchLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*entity.Plan, error) {
+			plans, err := planRepo.ListByTaskIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			return groupBy(plans, func(p *entity.Plan) uuid.UUID { return p.TaskID }), nil
+		}),
+		executions: newBatchLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]*entity.Execution, error) {
+			executions, err := executionRepo.GetByTaskIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			return groupBy(executions, func(e *entity.Execution) uuid.UUID { return e.TaskID }), nil
+		}),
+		pullRequests: newBatchLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*entity.PullRequest, error) {
+			prs, err := pullRequestRepo.GetByTaskIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			// Results are newest first; keep the latest PR per task
+			byTask := make(map[uuid.UUID]*entity.PullRequest, len(prs))
+			for _, pr := range prs {
+				if _, ok := byTask[pr.TaskID]; !ok {
+					byTask[pr.TaskID] = pr
+				}
+			}
+			return byTask, nil
+		}),
+	}
+}
+
+func groupBy[T any](items []*T, key func(*T) uuid.UUID) map[uuid.UUID][]*T {
+	grouped := make(map[uuid.UUID][]*T)
+	for _, item := range items {
+		k := key(item)
+		grouped[k] = append(grouped[k], item)
+	}
+	return grouped
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,182 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type createTaskInput struct {
+	ProjectID   graphql.ID
+	Title       string
+	Description *string
+}
+
+type updateTaskInput struct {
+	Title       *string
+	Description *string
+	Status      *string
+	Priority    *string
+	AssignedTo  *string
+	DueDate     *graphql.Time
+	BranchName  *string
+	ClearFields *[]string
+}
+
+func (r *Resolver) CreateTask(ctx context.Context, args struct{ Input createTaskInput }) (*taskResolver, error) {
+	projectID, err := parseID(args.Input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := usecase.CreateTaskRequest{
+		ProjectID: projectID,
+		Title:     args.Input.Title,
+	}
+	if args.Input.Description != nil {
+		req.Description = *args.Input.Description
+	}
+
+	task, err := r.taskUsecase.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, task)[0], nil
+}
+
+func (r *Resolver) UpdateTask(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input updateTaskInput
+}) (*taskResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	in := args.Input
+	req := usecase.UpdateTaskRequest{
+		AssignedTo: in.AssignedTo,
+		BranchName: in.BranchName,
+	}
+	if in.Title != nil {
+		req.Title = *in.Title
+	}
+	if in.Description != nil {
+		if *in.Description == "" {
+			req.ClearFields = append(req.ClearFields, usecase.TaskFieldDescription)
+		} else {
+			req.Description = *in.Description
+		}
+	}
+	if in.Status != nil {
+		status := entity.TaskStatus(*in.Status)
+		req.Status = &status
+	}
+	if in.Priority != nil {
+		priority := entity.TaskPriority(*in.Priority)
+		req.Priority = &priority
+	}
+	if in.DueDate != nil {
+		dueDate := in.DueDate.Time
+		req.DueDate = &dueDate
+	}
+	if in.ClearFields != nil {
+		req.ClearFields = append(req.ClearFields, *in.ClearFields...)
+	}
+
+	task, err := r.taskUsecase.Update(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, task)[0], nil
+}
+
+func (r *Resolver) UpdateTaskStatus(ctx context.Context, args struct {
+	ID     graphql.ID
+	Status string
+}) (*taskResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	task, err := r.taskUsecase.UpdateStatus(ctx, id, entity.TaskStatus(args.Status))
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, task)[0], nil
+}
+
+func (r *Resolver) DeleteTask(ctx context.Context, args struct{ ID graphql.ID }) (bool, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return false, err
+	}
+	if err := r.taskUsecase.Delete(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Resolver) StartPlanning(ctx context.Context, args struct {
+	TaskID          graphql.ID
+	BranchName      string
+	AIType          string
+	AutoImplement   *bool
+	UseRemoteBranch *bool
+}) (string, error) {
+	taskID, err := parseID(args.TaskID)
+	if err != nil {
+		return "", err
+	}
+	return r.taskUsecase.StartPlanning(ctx, taskID, args.BranchName, args.AIType, boolValue(args.AutoImplement), boolValue(args.UseRemoteBranch), nil)
+}
+
+func (r *Resolver) ApprovePlan(ctx context.Context, args struct {
+	TaskID graphql.ID
+	AIType string
+}) (string, error) {
+	taskID, err := parseID(args.TaskID)
+	if err != nil {
+		return "", err
+	}
+	return r.taskUsecase.ApprovePlan(ctx, taskID, args.AIType, nil)
+}
+
+func (r *Resolver) StartImplementing(ctx context.Context, args struct {
+	TaskID          graphql.ID
+	BranchName      string
+	AIType          string
+	UseRemoteBranch *bool
+}) (string, error) {
+	taskID, err := parseID(args.TaskID)
+	if err != nil {
+		return "", err
+	}
+	return r.taskUsecase.StartImplementingDirect(ctx, taskID, args.BranchName, args.AIType, boolValue(args.UseRemoteBranch))
+}
+
+func (r *Resolver) CreatePullRequest(ctx context.Context, args struct{ TaskID graphql.ID }) (*pullRequestResolver, error) {
+	taskID, err := parseID(args.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := r.taskUsecase.CreatePullRequest(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return &pullRequestResolver{pr: pr}, nil
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Service executes GraphQL requests. Reads of nested objects (tasks of a
+// project, plans/executions/pull requests of a task) are batched per request.
+type Service struct {
+	schema *graphql.Schema
+
+	projectRepo     repository.ProjectRepository
+	taskRepo        repository.TaskRepository
+	planRepo        repository.PlanRepository
+	executionRepo   repository.ExecutionRepository
+	pullRequestRepo repository.PullRequestRepository
+}
+
+// NewService creates the GraphQL service
+func NewService(
+	projectUsecase usecase.ProjectUsecase,
+	taskUsecase usecase.TaskUsecase,
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	planRepo repository.PlanRepository,
+	executionRepo repository.ExecutionRepository,
+	pullRequestRepo repository.PullRequestRepository,
+) *Service {
+	root := &Resolver{
+		projectUsecase: projectUsecase,
+		taskUsecase:    taskUsecase,
+	}
+	return &Service{
+		schema:          graphql.MustParseSchema(schemaSDL, root),
+		projectRepo:     projectRepo,
+		taskRepo:        taskRepo,
+		planRepo:        planRepo,
+		executionRepo:   executionRepo,
+		pullRequestRepo: pullRequestRepo,
+	}
+}
+
+// Exec runs a GraphQL operation with a fresh set of dataloaders
+func (s *Service) Exec(ctx context.Context, query, operationName string, variables map[string]interface{}) *graphql.Response {
+	ctx = withLoaders(ctx, newLoaders(s.projectRepo, s.taskRepo, s.planRepo, s.executionRepo, s.pullRequestRepo))
+	return s.schema.Exec(ctx, query, operationName, variables)
+}
+
+// Resolver is the root resolver for queries and mutations
+type Resolver struct {
+	projectUsecase usecase.ProjectUsecase
+	taskUsecase    usecase.TaskUsecase
+}
+
+func parseID(id graphql.ID) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(string(id))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid ID %q", id)
+	}
+	return parsed, nil
+}
+
+func (r *Resolver) Project(ctx context.Context, args struct{ ID graphql.ID }) (*projectResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := r.projectUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newProjectResolvers(ctx, project)[0], nil
+}
+
+func (r *Resolver) Projects(ctx context.Context, args struct {
+	Search   *string
+	Archived *bool
+	Page     *int32
+	PageSize *int32
+}) ([]*projectResolver, error) {
+	params := usecase.GetProjectsParams{
+		SortBy:    "created_at",
+		SortOrder: "desc",
+		Page:      1,
+		PageSize:  100,
+		Archived:  args.Archived,
+	}
+	if args.Search != nil {
+		params.Search = *args.Search
+	}
+	if args.Page != nil && *args.Page > 0 {
+		params.Page = int(*args.Page)
+	}
+	if args.PageSize != nil && *args.PageSize > 0 {
+		params.PageSize = int(*args.PageSize)
+	}
+
+	result, err := r.projectUsecase.GetAll(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return newProjectResolvers(ctx, result.Projects...), nil
+}
+
+func (r *Resolver) Task(ctx context.Context, args struct{ ID graphql.ID }) (*taskResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	task, err := r.taskUsecase.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, task)[0], nil
+}
+
+func (r *Resolver) Tasks(ctx context.Context, args struct {
+	ProjectID graphql.ID
+	Statuses  *[]string
+}) ([]*taskResolver, error) {
+	projectID, err := parseID(args.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := r.taskUsecase.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, filterTasksByStatus(tasks, args.Statuses)...), nil
+}
+
+func filterTasksByStatus(tasks []*entity.Task, statuses *[]string) []*entity.Task {
+	if statuses == nil {
+		return tasks
+	}
+	filtered := make([]*entity.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if slices.Contains(*statuses, string(task.Status)) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
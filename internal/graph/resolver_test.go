@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeps struct {
+	projectUsecase  *usecase.ProjectUsecaseMock
+	taskUsecase     *usecase.TaskUsecaseMock
+	projectRepo     *repository.ProjectRepositoryMock
+	taskRepo        *repository.TaskRepositoryMock
+	planRepo        *repository.PlanRepositoryMock
+	executionRepo   *repository.ExecutionRepositoryMock
+	pullRequestRepo *repository.PullRequestRepositoryMock
+}
+
+func newTestService(t *testing.T) (*Service, testDeps) {
+	deps := testDeps{
+		projectUsecase:  usecase.NewProjectUsecaseMock(t),
+		taskUsecase:     usecase.NewTaskUsecaseMock(t),
+		projectRepo:     repository.NewProjectRepositoryMock(t),
+		taskRepo:        repository.NewTaskRepositoryMock(t),
+		planRepo:        repository.NewPlanRepositoryMock(t),
+		executionRepo:   repository.NewExecutionRepositoryMock(t),
+		pullRequestRepo: repository.NewPullRequestRepositoryMock(t),
+	}
+	service := NewService(deps.projectUsecase, deps.taskUsecase, deps.projectRepo, deps.taskRepo, deps.planRepo, deps.executionRepo, deps.pullRequestRepo)
+	return service, deps
+}
+
+func TestService_TaskListBatchesNestedObjects(t *testing.T) {
+	service, deps := newTestService(t)
+
+	projectID := uuid.New()
+	taskA := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "A", Status: entity.TaskStatusTODO}
+	taskB := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "B", Status: entity.TaskStatusIMPLEMENTING}
+
+	deps.taskUsecase.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{taskA, taskB}, nil)
+	// Each nested field is fetched once for both tasks
+	deps.planRepo.EXPECT().ListByTaskIDs(mock.Anything, mock.MatchedBy(func(ids []uuid.UUID) bool {
+		return assert.ElementsMatch(t, []uuid.UUID{taskA.ID, taskB.ID}, ids)
+	})).Return([]*entity.Plan{{ID: uuid.New(), TaskID: taskB.ID, Content: "plan for B"}}, nil).Once()
+	deps.executionRepo.EXPECT().GetByTaskIDs(mock.Anything, mock.Anything).Return([]*entity.Execution{
+		{ID: uuid.New(), TaskID: taskA.ID, Status: entity.ExecutionStatusRunning, Progress: 0.5},
+	}, nil).Once()
+	deps.pullRequestRepo.EXPECT().GetByTaskIDs(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	deps.projectRepo.EXPECT().GetByIDs(mock.Anything, []uuid.UUID{projectID}).Return([]*entity.Project{{ID: projectID, Name: "Project"}}, nil).Once()
+
+	resp := service.Exec(context.Background(), `query($projectId: ID!) {
+		tasks(projectId: $projectId) {
+			title
+			project { name }
+			plans { content }
+			executions { status progress }
+			pullRequest { number }
+		}
+	}`, "", map[string]interface{}{"projectId": projectID.String()})
+	require.Empty(t, resp.Errors)
+
+	assert.JSONEq(t, `{"tasks": [
+		{"title": "A", "project": {"name": "Project"}, "plans": [], "executions": [{"status": "RUNNING", "progress": 0.5}], "pullRequest": null},
+		{"title": "B", "project": {"name": "Project"}, "plans": [{"content": "plan for B"}], "executions": [], "pullRequest": null}
+	]}`, string(resp.Data))
+}
+
+func TestService_UpdateTaskClearsFields(t *testing.T) {
+	service, deps := newTestService(t)
+
+	taskID := uuid.New()
+	deps.taskUsecase.EXPECT().Update(mock.Anything, taskID, usecase.UpdateTaskRequest{
+		Title:       "Renamed",
+		ClearFields: []string{usecase.TaskFieldDescription, usecase.TaskFieldDueDate},
+	}).Return(&entity.Task{ID: taskID, Title: "Renamed"}, nil)
+
+	resp := service.Exec(context.Background(), `mutation($id: ID!) {
+		updateTask(id: $id, input: {title: "Renamed", description: "", clearFields: ["due_date"]}) { title dueDate }
+	}`, "", map[string]interface{}{"id": taskID.String()})
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"updateTask": {"title": "Renamed", "dueDate": null}}`, string(resp.Data))
+}
+
+func TestService_InvalidID(t *testing.T) {
+	service, _ := newTestService(t)
+
+	resp := service.Exec(context.Background(), `{ task(id: "nope") { id } }`, "", nil)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "invalid ID")
+
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Nil(t, data["task"])
+}
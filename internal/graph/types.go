@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+type projectResolver struct {
+	project *entity.Project
+}
+
+// newProjectResolvers wraps projects and registers them with the task loader
+// so that selecting tasks on a list of projects costs a single query.
+func newProjectResolvers(ctx context.Context, projects ...*entity.Project) []*projectResolver {
+	l := loadersFrom(ctx)
+	resolvers := make([]*projectResolver, len(projects))
+	for i, p := range projects {
+		l.projectTasks.Register(p.ID)
+		resolvers[i] = &projectResolver{project: p}
+	}
+	return resolvers
+}
+
+func (r *projectResolver) ID() graphql.ID          { return graphql.ID(r.project.ID.String()) }
+func (r *projectResolver) Name() string            { return r.project.Name }
+func (r *projectResolver) Description() string     { return r.project.Description }
+func (r *projectResolver) RepositoryURL() string   { return r.project.RepositoryURL }
+func (r *projectResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.project.CreatedAt} }
+func (r *projectResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.project.UpdatedAt} }
+
+func (r *projectResolver) OrganizationID() *graphql.ID {
+	if r.project.OrganizationID == nil {
+		return nil
+	}
+	id := graphql.ID(r.project.OrganizationID.String())
+	return &id
+}
+
+func (r *projectResolver) Tasks(ctx context.Context, args struct{ Statuses *[]string }) ([]*taskResolver, error) {
+	tasks, err := loadersFrom(ctx).projectTasks.Load(ctx, r.project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskResolvers(ctx, filterTasksByStatus(tasks, args.Statuses)...), nil
+}
+
+type taskResolver struct {
+	task *entity.Task
+}
+
+// newTaskResolvers wraps tasks and registers them with the nested-object
+// loaders so that plans, executions and pull requests are fetched in batches.
+func newTaskResolvers(ctx context.Context, tasks ...*entity.Task) []*taskResolver {
+	l := loadersFrom(ctx)
+	resolvers := make([]*taskResolver, len(tasks))
+	for i, t := range tasks {
+		l.projects.Register(t.ProjectID)
+		l.plans.Register(t.ID)
+		l.executions.Register(t.ID)
+		l.pullRequests.Register(t.ID)
+		resolvers[i] = &taskResolver{task: t}
+	}
+	return resolvers
+}
+
+func (r *taskResolver) ID() graphql.ID          { return graphql.ID(r.task.ID.String()) }
+func (r *taskResolver) ProjectID() graphql.ID   { return graphql.ID(r.task.ProjectID.String()) }
+func (r *taskResolver) Title() string           { return r.task.Title }
+func (r *taskResolver) Description() string     { return r.task.Description }
+func (r *taskResolver) Status() string          { return string(r.task.Status) }
+func (r *taskResolver) Priority() string        { return string(r.task.Priority) }
+func (r *taskResolver) GitStatus() string       { return string(r.task.GitStatus) }
+func (r *taskResolver) BranchName() *string     { return r.task.BranchName }
+func (r *taskResolver) WorktreePath() *string   { return r.task.WorktreePath }
+func (r *taskResolver) AssignedTo() *string     { return r.task.AssignedTo }
+func (r *taskResolver) DueDate() *graphql.Time  { return optionalTime(r.task.DueDate) }
+func (r *taskResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.task.CreatedAt} }
+func (r *taskResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.task.UpdatedAt} }
+
+func (r *taskResolver) Tags() []string {
+	if r.task.Tags == nil {
+		return []string{}
+	}
+	return r.task.Tags
+}
+
+func (r *taskResolver) Project(ctx context.Context) (*projectResolver, error) {
+	project, err := loadersFrom(ctx).projects.Load(ctx, r.task.ProjectID)
+	if err != nil || project == nil {
+		return nil, err
+	}
+	return newProjectResolvers(ctx, project)[0], nil
+}
+
+func (r *taskResolver) Plans(ctx context.Context) ([]*planResolver, error) {
+	plans, err := loadersFrom(ctx).plans.Load(ctx, r.task.ID)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*planResolver, len(plans))
+	for i, p := range plans {
+		resolvers[i] = &planResolver{plan: p}
+	}
+	return resolvers, nil
+}
+
+func (r *taskResolver) Executions(ctx context.Context) ([]*executionResolver, error) {
+	executions, err := loadersFrom(ctx).executions.Load(ctx, r.task.ID)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*executionResolver, len(executions))
+	for i, e := range executions {
+		resolvers[i] = &executionResolver{execution: e}
+	}
+	return resolvers, nil
+}
+
+func (r *taskResolver) PullRequest(ctx context.Context) (*pullRequestResolver, error) {
+	pr, err := loadersFrom(ctx).pullRequests.Load(ctx, r.task.ID)
+	if err != nil || pr == nil {
+		return nil, err
+	}
+	return &pullRequestResolver{pr: pr}, nil
+}
+
+type planResolver struct {
+	plan *entity.Plan
+}
+
+func (r *planResolver) ID() graphql.ID          { return graphql.ID(r.plan.ID.String()) }
+func (r *planResolver) TaskID() graphql.ID      { return graphql.ID(r.plan.TaskID.String()) }
+func (r *planResolver) Status() string          { return string(r.plan.Status) }
+func (r *planResolver) Content() string         { return r.plan.Content }
+func (r *planResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.plan.CreatedAt} }
+func (r *planResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.plan.UpdatedAt} }
+
+type executionResolver struct {
+	execution *entity.Execution
+}
+
+func (r *executionResolver) ID() graphql.ID     { return graphql.ID(r.execution.ID.String()) }
+func (r *executionResolver) TaskID() graphql.ID { return graphql.ID(r.execution.TaskID.String()) }
+func (r *executionResolver) Status() string     { return string(r.execution.Status) }
+func (r *executionResolver) Progress() float64  { return r.execution.Progress }
+func (r *executionResolver) StartedAt() graphql.Time {
+	return graphql.Time{Time: r.execution.StartedAt}
+}
+func (r *executionResolver) CompletedAt() *graphql.Time { return optionalTime(r.execution.CompletedAt) }
+
+func (r *executionResolver) ErrorMessage() *string {
+	if r.execution.ErrorMessage == "" {
+		return nil
+	}
+	return &r.execution.ErrorMessage
+}
+
+type pullRequestResolver struct {
+	pr *entity.PullRequest
+}
+
+func (r *pullRequestResolver) ID() graphql.ID          { return graphql.ID(r.pr.ID.String()) }
+func (r *pullRequestResolver) TaskID() graphql.ID      { return graphql.ID(r.pr.TaskID.String()) }
+func (r *pullRequestResolver) Number() int32           { return int32(r.pr.GitHubPRNumber) }
+func (r *pullRequestResolver) Repository() string      { return r.pr.Repository }
+func (r *pullRequestResolver) Title() string           { return r.pr.Title }
+func (r *pullRequestResolver) Status() string          { return string(r.pr.Status) }
+func (r *pullRequestResolver) URL() string             { return r.pr.GitHubURL }
+func (r *pullRequestResolver) HeadBranch() string      { return r.pr.HeadBranch }
+func (r *pullRequestResolver) BaseBranch() string      { return r.pr.BaseBranch }
+func (r *pullRequestResolver) IsDraft() bool           { return r.pr.IsDraft }
+func (r *pullRequestResolver) MergedAt() *graphql.Time { return optionalTime(r.pr.MergedAt) }
+func (r *pullRequestResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.pr.CreatedAt} }
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// IPAllowlist is a set of IP addresses and CIDR ranges. A nil or empty
+// allowlist allows every address.
+type IPAllowlist struct {
+	prefixes []netip.Prefix
+}
+
+// ParseIPAllowlist parses entries that are IP addresses or CIDR ranges
+func ParseIPAllowlist(entries []string) (*IPAllowlist, error) {
+	allowlist := &IPAllowlist{}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR range %q in IP allowlist: %w", entry, err)
+			}
+			allowlist.prefixes = append(allowlist.prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q in IP allowlist: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		allowlist.prefixes = append(allowlist.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return allowlist, nil
+}
+
+// Allows reports whether ip is in the allowlist. An address that does not
+// parse is only allowed by an empty allowlist.
+func (l *IPAllowlist) Allows(ip string) bool {
+	if l == nil || len(l.prefixes) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range l.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessPolicy restricts the client addresses allowed to reach the API.
+// Health checks and the frontend are reachable from anywhere.
+type AccessPolicy struct {
+	// Admin guards the REST, GraphQL and WebSocket API and its docs
+	Admin *IPAllowlist
+	// Webhook guards the repository host webhooks
+	Webhook *IPAllowlist
+}
+
+// allowlistFor returns the allowlist guarding path and its name, or nil
+// when path is not guarded
+func (p AccessPolicy) allowlistFor(path string) (*IPAllowlist, string) {
+	switch {
+	case strings.HasPrefix(path, "/api/") && strings.HasSuffix(path, "/health"):
+		return nil, ""
+	case strings.HasPrefix(path, "/api/") && strings.Contains(path, "/webhooks/"):
+		return p.Webhook, "webhook"
+	case strings.HasPrefix(path, "/api/"),
+		path == "/graphql",
+		path == "/ws", strings.HasPrefix(path, "/ws/"),
+		strings.HasPrefix(path, "/swagger/"):
+		return p.Admin, "admin"
+	}
+	return nil, ""
+}
+
+// AccessPolicyMiddleware refuses requests from client addresses outside the
+// allowlist guarding their path. Refusals are logged and recorded in the
+// audit log; past a burst, only logged, so a flood of them cannot fill it.
+func AccessPolicyMiddleware(policy AccessPolicy, auditUsecase usecase.AuditUsecase) gin.HandlerFunc {
+	auditLimiter := rate.NewLimiter(rate.Every(time.Second), 60)
+
+	return func(c *gin.Context) {
+		allowlist, name := policy.allowlistFor(c.Request.URL.Path)
+		clientIP := c.ClientIP()
+		if allowlist.Allows(clientIP) {
+			c.Next()
+			return
+		}
+
+		description := fmt.Sprintf("%s %s refused: %s is not in the %s IP allowlist", c.Request.Method, c.Request.URL.Path, clientIP, name)
+		log.Printf("Access policy: %s", description)
+		if auditUsecase != nil && auditLimiter.Allow() {
+			// Recorded even when the client has gone away
+			ctx := context.WithoutCancel(c.Request.Context())
+			if err := auditUsecase.LogRejectedRequest(ctx, clientIP, c.Request.UserAgent(), description); err != nil {
+				log.Printf("Access policy: failed to audit refused request: %v", err)
+			}
+		}
+
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:     "IP address not allowed",
+			Message:   "Requests from this address are not allowed",
+			Code:      http.StatusForbidden,
+			ErrorCode: dto.ErrorCodeIPNotAllowed,
+		})
+		c.Abort()
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIPAllowlist(t *testing.T) {
+	allowlist, err := ParseIPAllowlist([]string{"10.0.0.0/8", " 192.168.1.20 ", "", "2001:db8::/32"})
+	require.NoError(t, err)
+
+	assert.True(t, allowlist.Allows("10.1.2.3"))
+	assert.True(t, allowlist.Allows("192.168.1.20"))
+	assert.True(t, allowlist.Allows("::ffff:192.168.1.20"))
+	assert.True(t, allowlist.Allows("2001:db8::1"))
+	assert.False(t, allowlist.Allows("192.168.1.21"))
+	assert.False(t, allowlist.Allows("not-an-ip"))
+
+	empty, err := ParseIPAllowlist(nil)
+	require.NoError(t, err)
+	assert.True(t, empty.Allows("203.0.113.7"))
+
+	_, err = ParseIPAllowlist([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = ParseIPAllowlist([]string{"example.com"})
+	assert.Error(t, err)
+}
+
+func TestAccessPolicyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	admin, err := ParseIPAllowlist([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	webhook, err := ParseIPAllowlist([]string{"192.30.252.0/22"})
+	require.NoError(t, err)
+
+	auditUsecase := usecase.NewAuditUsecaseMock(t)
+	router := gin.New()
+	router.Use(AccessPolicyMiddleware(AccessPolicy{Admin: admin, Webhook: webhook}, auditUsecase))
+	for _, path := range []string{"/api/v1/projects", "/api/v1/webhooks/github", "/api/v1/health", "/"} {
+		router.Any(path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+
+	serve := func(method, path, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr + ":40000"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1/projects", "10.4.5.6").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/api/v1/webhooks/github", "192.30.252.10").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/api/v1/health", "203.0.113.7").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/", "203.0.113.7").Code)
+
+	auditUsecase.EXPECT().LogRejectedRequest(mock.Anything, "203.0.113.7", mock.Anything,
+		"GET /api/v1/projects refused: 203.0.113.7 is not in the admin IP allowlist").Return(nil).Once()
+	w := serve(http.MethodGet, "/api/v1/projects", "203.0.113.7")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "IP_NOT_ALLOWED")
+
+	// Webhooks are guarded by their own allowlist only
+	auditUsecase.EXPECT().LogRejectedRequest(mock.Anything, "10.4.5.6", mock.Anything,
+		"POST /api/v1/webhooks/github refused: 10.4.5.6 is not in the webhook IP allowlist").Return(nil).Once()
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/api/v1/webhooks/github", "10.4.5.6").Code)
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/pkg/pdf"
+	"github.com/gin-gonic/gin"
+)
+
+// defaultVelocityWeeks is a quarter, about six two-week sprints
+const defaultVelocityWeeks = 12
+
+// Export formats of the analytics endpoints besides JSON
+const (
+	exportFormatCSV = "csv"
+	exportFormatPDF = "pdf"
+)
+
+// analyticsReport is an analytics response flattened for export: a table of
+// its details and, for the PDF, a title and a few summary lines above it
+type analyticsReport struct {
+	// filename is without extension
+	filename string
+	title    string
+	summary  []string
+	header   []string
+	rows     [][]string
+}
+
+// exported reports whether the format asks for a file rather than JSON
+func exported(format string) bool {
+	return format == exportFormatCSV || format == exportFormatPDF
+}
+
+// writeAnalyticsReport sends the report as a CSV or PDF attachment
+func writeAnalyticsReport(c *gin.Context, format string, report analyticsReport) {
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, report.filename, format))
+
+	if format == exportFormatPDF {
+		doc := pdf.New(report.title)
+		for _, line := range report.summary {
+			doc.Text(line)
+		}
+		doc.Table(report.header, report.rows)
+		c.Data(http.StatusOK, "application/pdf", doc.Bytes())
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write(report.header)
+	_ = w.WriteAll(report.rows)
+}
+
+// velocityReport has one row per week, oldest first
+func velocityReport(response dto.VelocityResponse) analyticsReport {
+	report := analyticsReport{
+		filename: fmt.Sprintf("velocity-%s", response.ProjectID),
+		title:    "Weekly velocity",
+		summary: []string{
+			fmt.Sprintf("Project %s, generated %s", response.ProjectID, response.GeneratedAt.UTC().Format(time.RFC3339)),
+			fmt.Sprintf("%d tasks completed, %s a week on average; %d pull requests merged; %s AI runs per task",
+				response.TasksCompleted, formatDecimal(response.AverageTasksPerWeek),
+				response.PullRequestsMerged, formatDecimal(response.AverageExecutionsPerTask)),
+		},
+		header: []string{"week_start", "tasks_completed", "pull_requests_merged", "executions", "average_executions_per_task"},
+	}
+	for _, week := range response.Weeks {
+		report.rows = append(report.rows, []string{
+			week.WeekStart.Format("2006-01-02"),
+			strconv.Itoa(week.TasksCompleted),
+			strconv.Itoa(week.PullRequestsMerged),
+			strconv.Itoa(week.Executions),
+			formatDecimal(week.AverageExecutionsPerTask),
+		})
+	}
+	return report
+}
+
+// cycleTimeReport has one row per completed task, in the order of the
+// response
+func cycleTimeReport(response dto.CycleTimeAnalyticsResponse) analyticsReport {
+	percentiles := func(name string, stats dto.DurationPercentilesResponse) string {
+		return fmt.Sprintf("%s over %d tasks, in hours: average %s, p50 %s, p75 %s, p90 %s, p95 %s", name, stats.Count,
+			formatDecimal(stats.AverageHours), formatDecimal(stats.P50Hours), formatDecimal(stats.P75Hours),
+			formatDecimal(stats.P90Hours), formatDecimal(stats.P95Hours))
+	}
+	report := analyticsReport{
+		filename: fmt.Sprintf("cycle-time-%s", response.ProjectID),
+		title:    "Cycle time and lead time",
+		summary: []string{
+			fmt.Sprintf("Project %s, tasks completed %s, generated %s", response.ProjectID,
+				formatDateRange(response.From, response.To), response.GeneratedAt.UTC().Format(time.RFC3339)),
+			percentiles("Lead time", response.LeadTime),
+			percentiles("Cycle time", response.CycleTime),
+		},
+		header: []string{"task_id", "title", "created_at", "started_at", "completed_at", "lead_time_hours", "cycle_time_hours"},
+	}
+	for _, task := range response.Tasks {
+		startedAt, cycleTime := "", ""
+		if task.StartedAt != nil {
+			startedAt = task.StartedAt.UTC().Format(time.RFC3339)
+		}
+		if task.CycleTimeHours != nil {
+			cycleTime = formatDecimal(*task.CycleTimeHours)
+		}
+		report.rows = append(report.rows, []string{
+			task.TaskID.String(),
+			task.Title,
+			task.CreatedAt.UTC().Format(time.RFC3339),
+			startedAt,
+			task.CompletedAt.UTC().Format(time.RFC3339),
+			formatDecimal(task.LeadTimeHours),
+			cycleTime,
+		})
+	}
+	return report
+}
+
+// spendReport has one row per executor and a last one with the month's total
+func spendReport(response dto.ProjectSpendResponse) analyticsReport {
+	budget := "No monthly budget set"
+	if response.BudgetUsedPercent != nil {
+		budget = fmt.Sprintf("%s%% of the $%s monthly budget used",
+			formatDecimal(*response.BudgetUsedPercent), formatDecimal(response.BudgetUSD))
+	}
+	report := analyticsReport{
+		filename: fmt.Sprintf("spend-%s-%s", response.ProjectID, response.Month),
+		title:    fmt.Sprintf("AI spend, %s", response.Month),
+		summary: []string{
+			fmt.Sprintf("Project %s", response.ProjectID),
+			fmt.Sprintf("$%s over %d AI runs. %s.", formatDecimal(response.CostUSD), response.Executions, budget),
+		},
+		header: []string{"month", "ai_type", "executions", "input_tokens", "output_tokens", "cost_usd"},
+	}
+	row := func(aiType string, executions, inputTokens, outputTokens int64, cost float64) []string {
+		return []string{
+			response.Month,
+			aiType,
+			strconv.FormatInt(executions, 10),
+			strconv.FormatInt(inputTokens, 10),
+			strconv.FormatInt(outputTokens, 10),
+			formatDecimal(cost),
+		}
+	}
+	for _, executor := range response.ByExecutor {
+		report.rows = append(report.rows, row(executor.AIType, executor.Executions, executor.InputTokens, executor.OutputTokens, executor.CostUSD))
+	}
+	report.rows = append(report.rows, row("total", response.Executions, response.InputTokens, response.OutputTokens, response.CostUSD))
+	return report
+}
+
+func formatDecimal(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+func formatDateRange(from, to *time.Time) string {
+	switch {
+	case from != nil && to != nil:
+		return fmt.Sprintf("from %s to %s", from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	case from != nil:
+		return fmt.Sprintf("since %s", from.UTC().Format(time.RFC3339))
+	case to != nil:
+		return fmt.Sprintf("until %s", to.UTC().Format(time.RFC3339))
+	}
+	return "at any time"
+}
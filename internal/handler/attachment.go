@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// multipartOverhead is what a multipart upload may add to the size of its
+// file, for its boundaries and part headers
+const multipartOverhead = 1 << 20
+
+// AttachmentHandler uploads files attached to tasks and serves their signed
+// download URLs
+type AttachmentHandler struct {
+	attachmentUsecase usecase.AttachmentUsecase
+	maxUploadSize     int64
+}
+
+func NewAttachmentHandler(attachmentUsecase usecase.AttachmentUsecase, maxUploadSize int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentUsecase: attachmentUsecase,
+		maxUploadSize:     maxUploadSize,
+	}
+}
+
+// UploadTaskAttachment godoc
+// @Summary Attach a file to a task
+// @Description Upload a file as multipart form data. Its size and media type, sniffed from the content, must be within the configured limits. The uploader is the owner of the API key the request carries, if any.
+// @Tags tasks
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param file formData file true "File to attach"
+// @Success 201 {object} dto.TaskAttachmentResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 415 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
+// @Router /api/v1/tasks/{id}/attachments [post]
+func (h *AttachmentHandler) UploadTaskAttachment(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	req, file, ok := h.readUpload(c, taskID)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentUsecase.Upload(c.Request.Context(), req)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to upload attachment")
+		return
+	}
+
+	downloadURL, expiresAt, err := h.attachmentUsecase.DownloadURL(c.Request.Context(), attachment)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to sign download URL")
+		return
+	}
+	c.JSON(http.StatusCreated, dto.TaskAttachmentResponseFromEntity(attachment, downloadURL, expiresAt))
+}
+
+// UploadTaskMedia godoc
+// @Summary Upload an image for a task
+// @Description Upload an image pasted into the task's description or comments, as multipart form data. It is kept as an attachment of the task and served at a lasting URL; the Markdown returned embeds it. Images referenced by a task are passed to the AI executor as context files.
+// @Tags tasks
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param file formData file true "Image to upload"
+// @Success 201 {object} dto.TaskMediaResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 415 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
+// @Router /api/v1/tasks/{id}/media [post]
+func (h *AttachmentHandler) UploadTaskMedia(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	req, file, ok := h.readUpload(c, taskID)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentUsecase.UploadMedia(c.Request.Context(), req)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to upload image")
+		return
+	}
+	c.JSON(http.StatusCreated, dto.TaskMediaResponseFromEntity(attachment, h.attachmentUsecase.MediaURL(attachment)))
+}
+
+// readUpload opens the file of a multipart upload for the task, responding
+// to the request itself when it carries none
+func (h *AttachmentHandler) readUpload(c *gin.Context, taskID uuid.UUID) (usecase.UploadAttachmentRequest, multipart.File, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, usecase.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge, "Attachment is too large")
+			return usecase.UploadAttachmentRequest{}, nil, false
+		}
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "A file is required in the file field"))
+		return usecase.UploadAttachmentRequest{}, nil, false
+	}
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Failed to read the uploaded file"))
+		return usecase.UploadAttachmentRequest{}, nil, false
+	}
+
+	return usecase.UploadAttachmentRequest{
+		TaskID:     taskID,
+		Filename:   header.Filename,
+		Size:       header.Size,
+		Content:    file,
+		UploadedBy: apiKeyOwner(c),
+	}, file, true
+}
+
+// ListTaskAttachments godoc
+// @Summary List a task's attachments
+// @Description List the files attached to the task, oldest first, each with a freshly signed download URL
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.TaskAttachmentListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/attachments [get]
+func (h *AttachmentHandler) ListTaskAttachments(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	attachments, err := h.attachmentUsecase.ListByTaskID(c.Request.Context(), taskID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list attachments")
+		return
+	}
+
+	page, meta := paginate(c, attachments)
+	items := make([]dto.TaskAttachmentResponse, len(page))
+	for i, attachment := range page {
+		downloadURL, expiresAt, err := h.attachmentUsecase.DownloadURL(c.Request.Context(), attachment)
+		if err != nil {
+			respondError(c, err, http.StatusInternalServerError, "Failed to sign download URL")
+			return
+		}
+		items[i] = dto.TaskAttachmentResponseFromEntity(attachment, downloadURL, expiresAt)
+	}
+	c.JSON(http.StatusOK, dto.TaskAttachmentListResponse{TaskID: taskID, Items: items, ListMeta: meta})
+}
+
+// DownloadAttachment godoc
+// @Summary Download an attachment
+// @Description Download an attachment through the signed URL returned with it. The URL needs no API key but stops working once it expires.
+// @Tags tasks
+// @Produce octet-stream
+// @Param id path string true "Attachment ID"
+// @Param expires query int true "Expiry of the URL, in Unix seconds"
+// @Param signature query string true "Signature of the URL"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/attachments/{id}/download [get]
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid attachment ID"))
+		return
+	}
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		respondError(c, usecase.ErrDownloadURLInvalid, http.StatusForbidden, "Invalid download URL")
+		return
+	}
+
+	attachment, content, err := h.attachmentUsecase.OpenSigned(c.Request.Context(), id, expires, c.Query("signature"))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to download attachment")
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.FileSize, attachment.MimeType, content, map[string]string{
+		"Content-Disposition": storage.ContentDisposition(attachment.Filename),
+	})
+}
+
+// GetMedia godoc
+// @Summary Get an uploaded image
+// @Description Serve an image uploaded for a task's description or comments, for them to embed. Its URL needs no API key and does not expire.
+// @Tags tasks
+// @Produce image/png
+// @Produce image/jpeg
+// @Produce image/gif
+// @Produce image/webp
+// @Param id path string true "Image ID"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/media/{id} [get]
+func (h *AttachmentHandler) GetMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid image ID"))
+		return
+	}
+
+	attachment, content, err := h.attachmentUsecase.OpenMedia(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get image")
+		return
+	}
+	defer content.Close()
+
+	// An image never changes under its ID
+	c.DataFromReader(http.StatusOK, attachment.FileSize, attachment.MimeType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("inline", map[string]string{"filename": attachment.Filename}),
+		"Cache-Control":          "private, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
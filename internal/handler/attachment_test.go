@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func serveAttachments(attachmentUsecase usecase.AttachmentUsecase, req *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewAttachmentHandler(attachmentUsecase, 16)
+	router.POST("/tasks/:id/attachments", OptionalAPIKey(APIKeyMiddleware(map[string]string{"alice-key": "alice"})), handler.UploadTaskAttachment)
+	router.POST("/tasks/:id/media", handler.UploadTaskMedia)
+	router.GET("/attachments/:id/download", handler.DownloadAttachment)
+	router.GET("/media/:id", handler.GetMedia)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func multipartUpload(t *testing.T, path, filename, content string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAttachmentHandler_UploadTaskAttachment(t *testing.T) {
+	taskID := uuid.New()
+	path := "/tasks/" + taskID.String() + "/attachments"
+
+	t.Run("stores the file as the key owner", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachment := &entity.TaskAttachment{ID: uuid.New(), TaskID: taskID, Filename: "notes.txt", FileSize: 5, MimeType: "text/plain", UploadedBy: "alice"}
+		attachmentUsecase.EXPECT().Upload(mock.Anything, mock.MatchedBy(func(req usecase.UploadAttachmentRequest) bool {
+			content, _ := io.ReadAll(req.Content)
+			return req.TaskID == taskID && req.Filename == "notes.txt" && req.Size == 5 && string(content) == "hello" && req.UploadedBy == "alice"
+		})).Return(attachment, nil)
+		attachmentUsecase.EXPECT().DownloadURL(mock.Anything, attachment).Return("http://localhost/download", time.Now(), nil)
+
+		req := multipartUpload(t, path, "notes.txt", "hello")
+		req.Header.Set(APIKeyHeader, "alice-key")
+		w := serveAttachments(attachmentUsecase, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"download_url":"http://localhost/download"`)
+	})
+
+	t.Run("rejects a body over the limit before reading it all", func(t *testing.T) {
+		w := serveAttachments(usecase.NewAttachmentUsecaseMock(t), multipartUpload(t, path, "big.txt", strings.Repeat("a", multipartOverhead+32)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "ATTACHMENT_TOO_LARGE")
+	})
+
+	t.Run("maps a disallowed type", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachmentUsecase.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil, usecase.ErrAttachmentTypeNotAllowed)
+
+		w := serveAttachments(attachmentUsecase, multipartUpload(t, path, "tool.exe", "MZ"))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+}
+
+func TestAttachmentHandler_DownloadAttachment(t *testing.T) {
+	id := uuid.New()
+
+	t.Run("serves the file", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachment := &entity.TaskAttachment{ID: id, Filename: "notes.txt", FileSize: 5, MimeType: "text/plain"}
+		attachmentUsecase.EXPECT().OpenSigned(mock.Anything, id, int64(1700000000), "sig").Return(attachment, io.NopCloser(strings.NewReader("hello")), nil)
+
+		w := serveAttachments(attachmentUsecase, httptest.NewRequest(http.MethodGet, "/attachments/"+id.String()+"/download?expires=1700000000&signature=sig", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "hello", w.Body.String())
+		assert.Equal(t, `attachment; filename=notes.txt`, w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("refuses an invalid signature", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachmentUsecase.EXPECT().OpenSigned(mock.Anything, id, int64(1700000000), "forged").Return(nil, nil, usecase.ErrDownloadURLInvalid)
+
+		w := serveAttachments(attachmentUsecase, httptest.NewRequest(http.MethodGet, "/attachments/"+id.String()+"/download?expires=1700000000&signature=forged", nil))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Contains(t, w.Body.String(), "DOWNLOAD_URL_INVALID")
+	})
+}
+
+func TestAttachmentHandler_Media(t *testing.T) {
+	taskID := uuid.New()
+	image := &entity.TaskAttachment{ID: uuid.New(), TaskID: taskID, Filename: "login [error].png", FileSize: 3, MimeType: "image/png"}
+	mediaURL := "http://localhost/api/v1/media/" + image.ID.String()
+
+	t.Run("upload returns Markdown embedding the image", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachmentUsecase.EXPECT().UploadMedia(mock.Anything, mock.MatchedBy(func(req usecase.UploadAttachmentRequest) bool {
+			return req.TaskID == taskID && req.Filename == "login [error].png" && req.Size == 3
+		})).Return(image, nil)
+		attachmentUsecase.EXPECT().MediaURL(image).Return(mediaURL)
+
+		w := serveAttachments(attachmentUsecase, multipartUpload(t, "/tasks/"+taskID.String()+"/media", "login [error].png", "png"))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"markdown":"![login \\[error\\].png](`+mediaURL+`)"`)
+	})
+
+	t.Run("serves the image inline", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachmentUsecase.EXPECT().OpenMedia(mock.Anything, image.ID).Return(image, io.NopCloser(strings.NewReader("png")), nil)
+
+		w := serveAttachments(attachmentUsecase, httptest.NewRequest(http.MethodGet, "/media/"+image.ID.String(), nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "png", w.Body.String())
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.True(t, strings.HasPrefix(w.Header().Get("Content-Disposition"), "inline;"))
+	})
+
+	t.Run("maps a missing image", func(t *testing.T) {
+		attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+		attachmentUsecase.EXPECT().OpenMedia(mock.Anything, image.ID).Return(nil, nil, usecase.ErrAttachmentNotFound)
+
+		w := serveAttachments(attachmentUsecase, httptest.NewRequest(http.MethodGet, "/media/"+image.ID.String(), nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AuthHandler registers and signs in users, and manages the API keys they
+// use from scripts and tools
+type AuthHandler struct {
+	authUsecase usecase.AuthUsecase
+}
+
+func NewAuthHandler(authUsecase usecase.AuthUsecase) *AuthHandler {
+	return &AuthHandler{authUsecase: authUsecase}
+}
+
+// Register godoc
+// @Summary Register a user
+// @Description Create a user account. The username is the name the user's changes are recorded under, and their profile's. Registration can be turned off with AUTH_REGISTRATION_ENABLED.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param user body dto.RegisterRequest true "Account data"
+// @Success 201 {object} dto.UserResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/auth/register [post]
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req dto.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	user, err := h.authUsecase.Register(c.Request.Context(), usecase.RegisterUserRequest{
+		Username: req.Username,
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to register user")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.UserResponseFromEntity(user))
+}
+
+// Login godoc
+// @Summary Sign in
+// @Description Check a user's password and return a token, sent as "Authorization: Bearer" until it expires.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param credentials body dto.LoginRequest true "Username and password"
+// @Success 200 {object} dto.LoginResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/auth/login [post]
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	user, token, err := h.authUsecase.Login(c.Request.Context(), req.Username, req.Password)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to sign in")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LoginResponse{
+		Token:     token.Token,
+		TokenType: "Bearer",
+		ExpiresAt: token.ExpiresAt,
+		User:      dto.UserResponseFromEntity(user),
+	})
+}
+
+// GetCurrentUser godoc
+// @Summary Get the signed-in user
+// @Description Get the user the request's token or API key authenticates
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UserResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /api/v1/auth/me [get]
+func (h *AuthHandler) GetCurrentUser(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.UserResponseFromEntity(currentUser(c)))
+}
+
+// ListAPIKeys godoc
+// @Summary List your API keys
+// @Description List the API keys of the signed-in user, the newest first. The keys themselves are only shown when created.
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.APIKeyListResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/auth/api-keys [get]
+func (h *AuthHandler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.authUsecase.ListAPIKeys(c.Request.Context(), currentUser(c).ID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list API keys")
+		return
+	}
+
+	items := make([]dto.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		items[i] = dto.APIKeyResponseFromEntity(key)
+	}
+	c.JSON(http.StatusOK, dto.APIKeyListResponse{Items: items, ListMeta: dto.NewListMeta(len(items), 1, 0)})
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Create an API key authenticating as the signed-in user, for scripts and tools. The key is only returned this once.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param key body dto.APIKeyCreateRequest true "Key name"
+// @Success 201 {object} dto.APIKeyCreatedResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/auth/api-keys [post]
+func (h *AuthHandler) CreateAPIKey(c *gin.Context) {
+	var req dto.APIKeyCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	apiKey, key, err := h.authUsecase.CreateAPIKey(c.Request.Context(), currentUser(c).ID, req.Name)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to create API key")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.APIKeyCreatedResponse{APIKeyResponse: dto.APIKeyResponseFromEntity(apiKey), Key: key})
+}
+
+// DeleteAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke one of the signed-in user's API keys
+// @Tags auth
+// @Security BearerAuth
+// @Param id path string true "API key ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/auth/api-keys/{id} [delete]
+func (h *AuthHandler) DeleteAPIKey(c *gin.Context) {
+	keyID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid API key ID"))
+		return
+	}
+
+	if err := h.authUsecase.DeleteAPIKey(c.Request.Context(), currentUser(c).ID, keyID); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to revoke API key")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+const currentUserKey = "current_user"
+
+// publicRoutes are served without credentials when authentication is
+// required: signing in, and the routes authenticated otherwise or fetched
+// by browsers as images and links
+var publicRoutes = map[string]bool{
+	"POST /auth/register":           true,
+	"POST /auth/login":              true,
+	"POST /webhooks/github":         true,
+	"POST /webhooks/slack":          true,
+	"GET /attachments/:id/download": true,
+	"GET /media/:id":                true,
+	"GET /users/:username/avatar":   true,
+}
+
+// AuthMiddleware authenticates the requests carrying a user's token or API
+// key, or one of the static API keys, as a bearer token or in the
+// X-API-Key header. The user is recorded as the actor of the changes the
+// request makes, see currentUser and apiKeyOwner. Credentials that
+// authenticate nobody are rejected outside publicRoutes, and when required
+// so are requests without credentials.
+func AuthMiddleware(authUsecase usecase.AuthUsecase, apiKeys map[string]string, required bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		public := isPublicRoute(c)
+		presented := presentedAPIKey(c)
+		if presented == "" {
+			if required && !public {
+				c.JSON(http.StatusUnauthorized, dto.NewErrorResponse(errors.New("missing token or API key"), http.StatusUnauthorized, "Authentication required"))
+				c.Abort()
+				return
+			}
+			c.Request = c.Request.WithContext(repository.WithAnonymous(c.Request.Context()))
+			c.Next()
+			return
+		}
+
+		if owner := matchAPIKey(apiKeys, presented); owner != "" {
+			authenticateAs(c, owner, "api:"+owner)
+			c.Next()
+			return
+		}
+
+		user, err := authUsecase.Authenticate(c.Request.Context(), presented)
+		if err != nil {
+			if public && errors.Is(err, usecase.ErrInvalidToken) {
+				// A stale token does not keep its user from signing in again
+				c.Request = c.Request.WithContext(repository.WithAnonymous(c.Request.Context()))
+				c.Next()
+				return
+			}
+			respondError(c, err, http.StatusInternalServerError, "Invalid token or API key")
+			c.Abort()
+			return
+		}
+
+		c.Set(currentUserKey, user)
+		authenticateAs(c, user.Username, "user:"+user.Username)
+		// The user's project roles apply to what the request does
+		c.Request = c.Request.WithContext(repository.WithUserID(c.Request.Context(), user.ID))
+		c.Next()
+	}
+}
+
+// RequireUser rejects requests AuthMiddleware did not authenticate as a user
+func RequireUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if currentUser(c) == nil {
+			c.JSON(http.StatusUnauthorized, dto.NewErrorResponse(errors.New("missing user token or API key"), http.StatusUnauthorized, "Sign in required"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// currentUser returns the user the request authenticated as, or nil
+func currentUser(c *gin.Context) *entity.User {
+	user, _ := c.Get(currentUserKey)
+	if user, ok := user.(*entity.User); ok {
+		return user
+	}
+	return nil
+}
+
+// isPublicRoute reports whether the request is to one of publicRoutes, in
+// any API version
+func isPublicRoute(c *gin.Context) bool {
+	path := c.FullPath()
+	for _, prefix := range []string{apiV1Prefix, apiV2Prefix} {
+		if route, ok := strings.CutPrefix(path, prefix); ok {
+			return publicRoutes[c.Request.Method+" "+route]
+		}
+	}
+	return false
+}
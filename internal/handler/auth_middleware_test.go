@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupAuthRouter(authUsecase usecase.AuthUsecase, required bool, actor *string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	v1 := router.Group(apiV1Prefix, AuthMiddleware(authUsecase, map[string]string{"static-key": "ci"}, required))
+	record := func(c *gin.Context) {
+		*actor = repository.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	}
+	v1.GET("/projects", record)
+	v1.POST("/auth/login", record)
+	v1.GET("/auth/me", RequireUser(), record)
+	return router
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	alice := &entity.User{ID: uuid.New(), Username: "alice"}
+	serve := func(router *gin.Engine, method, path, credential string) int {
+		req := httptest.NewRequest(method, path, nil)
+		if credential != "" {
+			req.Header.Set("Authorization", "Bearer "+credential)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("requests without credentials pass unless required", func(t *testing.T) {
+		var actor string
+		router := setupAuthRouter(usecase.NewAuthUsecaseMock(t), false, &actor)
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "/api/v1/projects", ""))
+		assert.Empty(t, actor)
+		assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/api/v1/auth/me", ""), "signing in is still needed for the user's own routes")
+
+		router = setupAuthRouter(usecase.NewAuthUsecaseMock(t), true, &actor)
+		assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/api/v1/projects", ""))
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodPost, "/api/v1/auth/login", ""), "signing in needs no credentials")
+	})
+
+	t.Run("user tokens and API keys authenticate their user", func(t *testing.T) {
+		authUsecase := usecase.NewAuthUsecaseMock(t)
+		authUsecase.EXPECT().Authenticate(mock.Anything, "alice-token").Return(alice, nil)
+		var actor string
+		router := setupAuthRouter(authUsecase, true, &actor)
+
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "/api/v1/projects", "alice-token"))
+		assert.Equal(t, "alice", actor)
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "/api/v1/auth/me", "alice-token"))
+	})
+
+	t.Run("static API keys authenticate their owner", func(t *testing.T) {
+		var actor string
+		router := setupAuthRouter(usecase.NewAuthUsecaseMock(t), true, &actor)
+
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "/api/v1/projects", "static-key"))
+		assert.Equal(t, "ci", actor)
+		assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/api/v1/auth/me", "static-key"), "static keys belong to no user")
+	})
+
+	t.Run("invalid credentials are rejected outside public routes", func(t *testing.T) {
+		authUsecase := usecase.NewAuthUsecaseMock(t)
+		authUsecase.EXPECT().Authenticate(mock.Anything, "stale-token").Return(nil, fmt.Errorf("%w: token has expired", usecase.ErrInvalidToken))
+		var actor string
+		router := setupAuthRouter(authUsecase, false, &actor)
+
+		assert.Equal(t, http.StatusUnauthorized, serve(router, http.MethodGet, "/api/v1/projects", "stale-token"))
+		assert.Equal(t, http.StatusOK, serve(router, http.MethodPost, "/api/v1/auth/login", "stale-token"))
+	})
+}
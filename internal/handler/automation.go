@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AutomationHandler serves the API-key authenticated endpoints that no-code
+// tools such as Zapier and n8n connect to: a polling event feed and inbound
+// actions.
+type AutomationHandler struct {
+	taskUsecase  usecase.TaskUsecase
+	eventUsecase usecase.EventUsecase
+	wsService    *websocket.Service
+}
+
+func NewAutomationHandler(taskUsecase usecase.TaskUsecase, eventUsecase usecase.EventUsecase, wsService *websocket.Service) *AutomationHandler {
+	return &AutomationHandler{
+		taskUsecase:  taskUsecase,
+		eventUsecase: eventUsecase,
+		wsService:    wsService,
+	}
+}
+
+// GetAuthenticatedOwner godoc
+// @Summary Test an automation API key
+// @Description Return the owner of the API key, so automation tools can verify a connection
+// @Tags automation
+// @Produce json
+// @Security APIKeyAuth
+// @Success 200 {object} dto.AutomationAuthResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /api/v1/automation/me [get]
+func (h *AutomationHandler) GetAuthenticatedOwner(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.AutomationAuthResponse{Owner: apiKeyOwner(c)})
+}
+
+// ListEvents godoc
+// @Summary Poll the event feed
+// @Description List task events, newest first. Pass the returned cursor as `after` to receive only newer events on the next poll.
+// @Tags automation
+// @Produce json
+// @Security APIKeyAuth
+// @Param project_id query string false "Only events of this project"
+// @Param type query string false "Comma-separated event types (task.created, task.status_changed, task.deleted, task.commit_linked, project.budget_threshold_reached)"
+// @Param after query int false "Only events with a greater ID"
+// @Param limit query int false "Maximum number of events (default 50, max 200)"
+// @Success 200 {object} dto.EventFeedResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/automation/events [get]
+func (h *AutomationHandler) ListEvents(c *gin.Context) {
+	var req usecase.ListEventsRequest
+
+	if projectIDStr := c.Query("project_id"); projectIDStr != "" {
+		projectID, err := uuid.Parse(projectIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+			return
+		}
+		req.ProjectID = &projectID
+	}
+	if types := c.Query("type"); types != "" {
+		for _, t := range strings.Split(types, ",") {
+			req.Types = append(req.Types, entity.EventType(strings.TrimSpace(t)))
+		}
+	}
+	if after := c.Query("after"); after != "" {
+		afterID, err := strconv.ParseInt(after, 10, 64)
+		if err != nil || afterID < 0 {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(errors.New("after must be a non-negative integer"), http.StatusBadRequest, "Invalid cursor"))
+			return
+		}
+		req.AfterID = afterID
+	}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(errors.New("limit must be a positive integer"), http.StatusBadRequest, "Invalid limit"))
+			return
+		}
+		req.Limit = n
+	}
+
+	events, err := h.eventUsecase.List(c.Request.Context(), req)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list events")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EventFeedResponseFromEntities(events, req.AfterID))
+}
+
+// CreateTask godoc
+// @Summary Create a task from an automation
+// @Description Create a TODO task from a flat JSON or form payload, e.g. a Zapier or n8n action
+// @Tags automation
+// @Accept json,x-www-form-urlencoded
+// @Produce json
+// @Security APIKeyAuth
+// @Param task body dto.AutomationTaskCreateRequest true "Task fields"
+// @Success 201 {object} dto.TaskResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/automation/tasks [post]
+func (h *AutomationHandler) CreateTask(c *gin.Context) {
+	var req dto.AutomationTaskCreateRequest
+	if err := c.ShouldBind(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	usecaseReq := usecase.CreateTaskRequest{
+		ProjectID:   req.ProjectUUID(),
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    req.Priority,
+		Tags:        req.TagList(),
+	}
+	if req.AssignedTo != "" {
+		usecaseReq.AssignedTo = &req.AssignedTo
+	}
+
+	task, err := h.taskUsecase.Create(c.Request.Context(), usecaseReq)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to create task"))
+		return
+	}
+
+	response := dto.TaskResponseFromEntity(task)
+	if h.wsService != nil {
+		if err := h.wsService.NotifyTaskCreated(response, task.ProjectID); err != nil {
+			log.Printf("Failed to send WebSocket notification for task creation: %v", err)
+		}
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAutomationRouter(taskUsecase usecase.TaskUsecase, eventUsecase usecase.EventUsecase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	automation := router.Group("/automation", APIKeyMiddleware(map[string]string{"secret-key": "zapier"}))
+	handler := NewAutomationHandler(taskUsecase, eventUsecase, nil)
+	automation.GET("/events", handler.ListEvents)
+	automation.POST("/tasks", handler.CreateTask)
+	return router
+}
+
+func TestAutomationHandler_ListEvents(t *testing.T) {
+	projectID := uuid.New()
+	eventUsecase := usecase.NewEventUsecaseMock(t)
+	eventUsecase.EXPECT().List(mock.Anything, usecase.ListEventsRequest{
+		ProjectID: &projectID,
+		Types:     []entity.EventType{entity.EventTypeTaskCreated, entity.EventTypeTaskStatusChanged},
+		AfterID:   10,
+	}).Return([]*entity.Event{
+		{ID: 12, Type: entity.EventTypeTaskStatusChanged, ProjectID: projectID, Data: `{"status":"DONE"}`},
+		{ID: 11, Type: entity.EventTypeTaskCreated, ProjectID: projectID, Data: `{"status":"TODO"}`},
+	}, nil)
+	router := setupAutomationRouter(usecase.NewTaskUsecaseMock(t), eventUsecase)
+
+	req := httptest.NewRequest(http.MethodGet, "/automation/events?project_id="+projectID.String()+"&type=task.created,task.status_changed&after=10", nil)
+	req.Header.Set(APIKeyHeader, "secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var feed dto.EventFeedResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &feed))
+	assert.Equal(t, int64(12), feed.Cursor)
+	require.Len(t, feed.Events, 2)
+	assert.JSONEq(t, `{"status":"DONE"}`, string(feed.Events[0].Data))
+}
+
+func TestAutomationHandler_ListEvents_EmptyKeepsCursor(t *testing.T) {
+	eventUsecase := usecase.NewEventUsecaseMock(t)
+	eventUsecase.EXPECT().List(mock.Anything, usecase.ListEventsRequest{AfterID: 42}).Return(nil, nil)
+	router := setupAutomationRouter(usecase.NewTaskUsecaseMock(t), eventUsecase)
+
+	req := httptest.NewRequest(http.MethodGet, "/automation/events?after=42", nil)
+	req.Header.Set(APIKeyHeader, "secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"events":[],"cursor":42}`, w.Body.String())
+}
+
+func TestAutomationHandler_CreateTask_FormPayload(t *testing.T) {
+	projectID := uuid.New()
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req usecase.CreateTaskRequest) bool {
+		return req.ProjectID == projectID &&
+			req.Title == "Login broken" &&
+			req.Priority == entity.TaskPriorityHigh &&
+			assert.ObjectsAreEqual([]string{"support", "bug"}, req.Tags) &&
+			req.AssignedTo == nil
+	})).Return(&entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Login broken", Status: entity.TaskStatusTODO}, nil)
+	router := setupAutomationRouter(taskUsecase, usecase.NewEventUsecaseMock(t))
+
+	form := url.Values{
+		"project_id": {projectID.String()},
+		"title":      {"Login broken"},
+		"priority":   {"HIGH"},
+		"tags":       {" support, bug ,"},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/automation/tasks", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	githubEventHeader     = "X-GitHub-Event"
+	githubSignatureHeader = "X-Hub-Signature-256"
+	githubDeliveryHeader  = "X-GitHub-Delivery"
+
+	// maxWebhookBodySize bounds push payloads; GitHub caps them at 25 MB
+	maxWebhookBodySize = 25 << 20
+)
+
+// CommitHandler links pushed commits to tasks and syncs pull requests from
+// repository host webhooks, and lists the commits per task
+type CommitHandler struct {
+	commitUsecase usecase.CommitUsecase
+	githubSecret  string
+}
+
+func NewCommitHandler(commitUsecase usecase.CommitUsecase, githubSecret string) *CommitHandler {
+	return &CommitHandler{
+		commitUsecase: commitUsecase,
+		githubSecret:  githubSecret,
+	}
+}
+
+// GitHubWebhook godoc
+// @Summary Receive a GitHub webhook
+// @Description Link pushed commits to the tasks their messages reference (AD-42 or a task ID prefix of at least 8 characters). Requests must be signed with the configured webhook secret. pull_request events and completed check_suite events queue a sync of the tracked PRs they concern, answering a PullRequestSyncResponse; repositories sending them are no longer polled while they keep doing so. Pushes and PR events older than GITHUB_WEBHOOK_MAX_AGE and deliveries already processed are rejected. Other events are acknowledged and ignored.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param X-GitHub-Event header string true "GitHub event name"
+// @Param X-Hub-Signature-256 header string true "HMAC-SHA256 signature of the body"
+// @Param X-GitHub-Delivery header string true "Unique ID of the delivery"
+// @Param payload body dto.GitHubPushPayload true "Push payload"
+// @Success 200 {object} dto.CommitLinkResponse
+// @Success 202 {object} dto.PullRequestSyncResponse
+// @Success 202 {object} dto.CommitLinkResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/github [post]
+func (h *CommitHandler) GitHubWebhook(c *gin.Context) {
+	if h.githubSecret == "" {
+		c.JSON(http.StatusServiceUnavailable, dto.NewErrorResponse(errors.New("GITHUB_WEBHOOK_SECRET is not set"), http.StatusServiceUnavailable, "GitHub webhook is not configured"))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Failed to read webhook body"))
+		return
+	}
+	if !validGitHubSignature(h.githubSecret, body, c.GetHeader(githubSignatureHeader)) {
+		c.JSON(http.StatusUnauthorized, dto.NewErrorResponse(errors.New("signature does not match"), http.StatusUnauthorized, "Invalid webhook signature"))
+		return
+	}
+
+	switch c.GetHeader(githubEventHeader) {
+	case "push":
+	case "pull_request":
+		var payload dto.GitHubPullRequestPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid pull_request payload"))
+			return
+		}
+		h.syncPullRequests(c, payload.ToPullRequestEvent())
+		return
+	case "check_suite":
+		var payload dto.GitHubCheckSuitePayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid check_suite payload"))
+			return
+		}
+		// Only a finished suite changes whether its PRs can be merged
+		if payload.Action != "completed" {
+			c.JSON(http.StatusAccepted, dto.PullRequestSyncResponse{})
+			return
+		}
+		h.syncPullRequests(c, payload.ToPullRequestEvent())
+		return
+	case "ping":
+		c.JSON(http.StatusOK, dto.CommitLinkResponse{})
+		return
+	default:
+		c.JSON(http.StatusAccepted, dto.CommitLinkResponse{})
+		return
+	}
+
+	var payload dto.GitHubPushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid push payload"))
+		return
+	}
+
+	push := payload.ToPushEvent()
+	push.DeliveryID = c.GetHeader(githubDeliveryHeader)
+	result, err := h.commitUsecase.LinkPushedCommits(c.Request.Context(), push)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to link pushed commits")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CommitLinkResponseFromResult(result))
+}
+
+// syncPullRequests queues a sync of the PRs a webhook event concerns
+func (h *CommitHandler) syncPullRequests(c *gin.Context, event usecase.PullRequestEvent) {
+	event.DeliveryID = c.GetHeader(githubDeliveryHeader)
+	result, err := h.commitUsecase.SyncPullRequests(c.Request.Context(), event)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to sync pull requests")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.PullRequestSyncResponse{Queued: result.Queued})
+}
+
+// ListTaskCommits godoc
+// @Summary List a task's commits
+// @Description List the pushed commits whose messages reference the task, most recent first
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.TaskCommitListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/commits [get]
+func (h *CommitHandler) ListTaskCommits(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	commits, err := h.commitUsecase.ListTaskCommits(c.Request.Context(), id)
+	if errors.Is(err, usecase.ErrProjectForbidden) {
+		respondError(c, err, http.StatusForbidden, "Failed to list task commits")
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	page, meta := paginate(c, commits)
+	c.JSON(http.StatusOK, dto.TaskCommitListResponseFromEntities(id, page, meta))
+}
+
+// validGitHubSignature checks an X-Hub-Signature-256 header against the body
+func validGitHubSignature(secret string, body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
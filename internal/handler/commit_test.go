@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testWebhookSecret = "webhook-secret"
+
+func githubSignature(body string) string {
+	mac := hmac.New(sha256.New, []byte(testWebhookSecret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func postGitHubWebhook(commitUsecase usecase.CommitUsecase, secret, event, body, signature string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/webhooks/github", NewCommitHandler(commitUsecase, secret).GitHubWebhook)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(body))
+	req.Header.Set(githubEventHeader, event)
+	req.Header.Set(githubSignatureHeader, signature)
+	req.Header.Set(githubDeliveryHeader, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCommitHandler_GitHubWebhook(t *testing.T) {
+	pushedAt := time.Now().Unix()
+	body := fmt.Sprintf(`{"ref":"refs/heads/main","repository":{"html_url":"https://github.com/acme/app","pushed_at":%d},"commits":[{"id":"abc","message":"AD-1 fix","author":{"name":"Alice"}}]}`, pushedAt)
+
+	t.Run("links pushed commits", func(t *testing.T) {
+		commitUsecase := usecase.NewCommitUsecaseMock(t)
+		commitUsecase.EXPECT().LinkPushedCommits(mock.Anything, mock.MatchedBy(func(push usecase.PushEvent) bool {
+			return push.Provider == "github" &&
+				push.DeliveryID == "72d3162e-cc78-11e3-81ab-4c9367dc0958" &&
+				push.SentAt.Unix() == pushedAt &&
+				push.Branch == "main" &&
+				push.RepositoryURLs[0] == "https://github.com/acme/app" &&
+				len(push.Commits) == 1 && push.Commits[0].SHA == "abc" && push.Commits[0].AuthorName == "Alice"
+		})).Return(&usecase.CommitLinkResult{Projects: 1, Linked: 1}, nil)
+
+		w := postGitHubWebhook(commitUsecase, testWebhookSecret, "push", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"projects":1,"linked":1}`, w.Body.String())
+	})
+
+	t.Run("rejects a replayed delivery", func(t *testing.T) {
+		commitUsecase := usecase.NewCommitUsecaseMock(t)
+		commitUsecase.EXPECT().LinkPushedCommits(mock.Anything, mock.Anything).Return(nil, usecase.ErrWebhookDeliveryReplayed)
+
+		w := postGitHubWebhook(commitUsecase, testWebhookSecret, "push", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "WEBHOOK_REPLAYED")
+	})
+
+	t.Run("rejects an expired delivery", func(t *testing.T) {
+		commitUsecase := usecase.NewCommitUsecaseMock(t)
+		commitUsecase.EXPECT().LinkPushedCommits(mock.Anything, mock.Anything).Return(nil, usecase.ErrWebhookDeliveryExpired)
+
+		w := postGitHubWebhook(commitUsecase, testWebhookSecret, "push", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "WEBHOOK_EXPIRED")
+	})
+
+	t.Run("rejects a bad signature", func(t *testing.T) {
+		w := postGitHubWebhook(usecase.NewCommitUsecaseMock(t), testWebhookSecret, "push", body, githubSignature(body+" "))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("rejects pushes without a configured secret", func(t *testing.T) {
+		w := postGitHubWebhook(usecase.NewCommitUsecaseMock(t), "", "push", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	t.Run("ignores other events", func(t *testing.T) {
+		w := postGitHubWebhook(usecase.NewCommitUsecaseMock(t), testWebhookSecret, "issues", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+}
+
+func TestCommitHandler_GitHubWebhook_PullRequests(t *testing.T) {
+	t.Run("syncs the PR of a pull_request event", func(t *testing.T) {
+		body := `{"action":"closed","number":7,"pull_request":{"updated_at":"2024-01-15T10:30:00Z"},"repository":{"full_name":"acme/app"}}`
+		commitUsecase := usecase.NewCommitUsecaseMock(t)
+		commitUsecase.EXPECT().SyncPullRequests(mock.Anything, usecase.PullRequestEvent{
+			Provider:   "github",
+			DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			SentAt:     time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			Repository: "acme/app",
+			Numbers:    []int{7},
+		}).Return(&usecase.PullRequestSyncResult{Queued: 1}, nil)
+
+		w := postGitHubWebhook(commitUsecase, testWebhookSecret, "pull_request", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.JSONEq(t, `{"queued":1}`, w.Body.String())
+	})
+
+	t.Run("syncs the PRs of a completed check suite", func(t *testing.T) {
+		body := `{"action":"completed","check_suite":{"updated_at":"2024-01-15T10:30:00Z","pull_requests":[{"number":7},{"number":9}]},"repository":{"full_name":"acme/app"}}`
+		commitUsecase := usecase.NewCommitUsecaseMock(t)
+		commitUsecase.EXPECT().SyncPullRequests(mock.Anything, mock.MatchedBy(func(event usecase.PullRequestEvent) bool {
+			return event.Repository == "acme/app" && len(event.Numbers) == 2 && event.Numbers[1] == 9
+		})).Return(&usecase.PullRequestSyncResult{Queued: 2}, nil)
+
+		w := postGitHubWebhook(commitUsecase, testWebhookSecret, "check_suite", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.JSONEq(t, `{"queued":2}`, w.Body.String())
+	})
+
+	t.Run("ignores check suites still running", func(t *testing.T) {
+		body := `{"action":"requested","check_suite":{"pull_requests":[{"number":7}]},"repository":{"full_name":"acme/app"}}`
+
+		w := postGitHubWebhook(usecase.NewCommitUsecaseMock(t), testWebhookSecret, "check_suite", body, githubSignature(body))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.JSONEq(t, `{"queued":0}`, w.Body.String())
+	})
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// TaskAttachmentResponse is a file attached to a task, with a signed URL to
+// download it from
+type TaskAttachmentResponse struct {
+	ID           uuid.UUID `json:"id"`
+	TaskID       uuid.UUID `json:"task_id"`
+	Filename     string    `json:"filename" example:"login-error.png"`
+	FileSize     int64     `json:"file_size" example:"48213"`
+	MimeType     string    `json:"mime_type" example:"image/png"`
+	UploadedBy   string    `json:"uploaded_by" example:"alice"`
+	CreatedAt    time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	DownloadURL  string    `json:"download_url" example:"http://localhost:8098/api/v1/attachments/123e4567-e89b-12d3-a456-426614174000/download?expires=1705315500&signature=9b1f..."`
+	URLExpiresAt time.Time `json:"url_expires_at" example:"2024-01-15T10:45:00Z"`
+}
+
+type TaskAttachmentListResponse struct {
+	TaskID uuid.UUID                `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items  []TaskAttachmentResponse `json:"items"`
+	ListMeta
+}
+
+func TaskAttachmentResponseFromEntity(attachment *entity.TaskAttachment, downloadURL string, urlExpiresAt time.Time) TaskAttachmentResponse {
+	return TaskAttachmentResponse{
+		ID:           attachment.ID,
+		TaskID:       attachment.TaskID,
+		Filename:     attachment.Filename,
+		FileSize:     attachment.FileSize,
+		MimeType:     attachment.MimeType,
+		UploadedBy:   attachment.UploadedBy,
+		CreatedAt:    attachment.CreatedAt,
+		DownloadURL:  downloadURL,
+		URLExpiresAt: urlExpiresAt,
+	}
+}
+
+// TaskMediaResponse is an image uploaded for a task's description or
+// comments, with the Markdown that embeds it
+type TaskMediaResponse struct {
+	ID       uuid.UUID `json:"id"`
+	TaskID   uuid.UUID `json:"task_id"`
+	Filename string    `json:"filename" example:"login-error.png"`
+	FileSize int64     `json:"file_size" example:"48213"`
+	MimeType string    `json:"mime_type" example:"image/png"`
+	URL      string    `json:"url" example:"http://localhost:8098/api/v1/media/123e4567-e89b-12d3-a456-426614174000"`
+	Markdown string    `json:"markdown" example:"![login-error.png](http://localhost:8098/api/v1/media/123e4567-e89b-12d3-a456-426614174000)"`
+}
+
+func TaskMediaResponseFromEntity(attachment *entity.TaskAttachment, url string) TaskMediaResponse {
+	return TaskMediaResponse{
+		ID:       attachment.ID,
+		TaskID:   attachment.TaskID,
+		Filename: attachment.Filename,
+		FileSize: attachment.FileSize,
+		MimeType: attachment.MimeType,
+		URL:      url,
+		Markdown: fmt.Sprintf("![%s](%s)", markdownAltText(attachment.Filename), url),
+	}
+}
+
+// markdownAltText escapes what would end the alt text of a Markdown image
+func markdownAltText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(text)
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type RegisterRequest struct {
+	Username string `json:"username" binding:"required,max=255" example:"alice"`
+	Email    string `json:"email,omitempty" binding:"omitempty,max=255" example:"alice@example.com"`
+	// Password is 8 to 72 bytes long
+	Password string `json:"password" binding:"required" example:"correct-horse-battery"`
+}
+
+type LoginRequest struct {
+	Username string `json:"username" binding:"required" example:"alice"`
+	Password string `json:"password" binding:"required" example:"correct-horse-battery"`
+}
+
+// UserResponse describes a user account. Username is the name the user is
+// recorded under and their profile's.
+type UserResponse struct {
+	ID       uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username string    `json:"username" example:"alice"`
+	Email    *string   `json:"email,omitempty" example:"alice@example.com"`
+	// OrganizationID is the organization the user's requests are scoped to
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt      time.Time  `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// LoginResponse carries the token to send as "Authorization: Bearer" until
+// it expires
+type LoginResponse struct {
+	Token     string       `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	TokenType string       `json:"token_type" example:"Bearer"`
+	ExpiresAt time.Time    `json:"expires_at" example:"2024-01-16T10:30:00Z"`
+	User      UserResponse `json:"user"`
+}
+
+func UserResponseFromEntity(user *entity.User) UserResponse {
+	return UserResponse{
+		ID:             user.ID,
+		Username:       user.Username,
+		Email:          user.Email,
+		OrganizationID: user.OrganizationID,
+		CreatedAt:      user.CreatedAt,
+	}
+}
+
+type APIKeyCreateRequest struct {
+	Name string `json:"name" binding:"required,max=255" example:"CI pipeline"`
+}
+
+// APIKeyResponse describes an API key of a user; the key itself is only
+// returned when created
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name       string     `json:"name" example:"CI pipeline"`
+	Prefix     string     `json:"prefix" example:"adk_3f9a1c2e"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	CreatedAt  time.Time  `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// APIKeyCreatedResponse is a new API key, with the key to send as
+// "Authorization: Bearer" or X-API-Key. It is not shown again.
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"adk_3f9a1c2e..."`
+}
+
+type APIKeyListResponse struct {
+	Items []APIKeyResponse `json:"items"`
+	ListMeta
+}
+
+func APIKeyResponseFromEntity(key *entity.UserAPIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		LastUsedAt: key.LastUsedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
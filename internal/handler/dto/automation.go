@@ -0,0 +1,83 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Automation request DTOs
+
+// AutomationTaskCreateRequest is a flat task payload that no-code tools can
+// send as JSON or as a form post. Tags are a comma-separated list.
+type AutomationTaskCreateRequest struct {
+	ProjectID   string              `json:"project_id" form:"project_id" binding:"required,uuid" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title       string              `json:"title" form:"title" binding:"required,min=1,max=255" example:"Customer reported login failure"`
+	Description string              `json:"description" form:"description" binding:"max=5000" example:"Submitted through the support form"`
+	Priority    entity.TaskPriority `json:"priority" form:"priority" binding:"omitempty,oneof=LOW MEDIUM HIGH URGENT" example:"HIGH"`
+	Tags        string              `json:"tags" form:"tags" binding:"max=500" example:"support,bug"`
+	AssignedTo  string              `json:"assigned_to" form:"assigned_to" binding:"max=255" example:"alice"`
+}
+
+// ProjectUUID returns the parsed project ID; binding has already validated it
+func (r AutomationTaskCreateRequest) ProjectUUID() uuid.UUID {
+	return uuid.MustParse(r.ProjectID)
+}
+
+// TagList splits Tags into trimmed, non-empty tags
+func (r AutomationTaskCreateRequest) TagList() []string {
+	var tags []string
+	for _, tag := range strings.Split(r.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// Automation response DTOs
+type AutomationAuthResponse struct {
+	Owner string `json:"owner" example:"zapier"`
+}
+
+type EventResponse struct {
+	ID        int64            `json:"id" example:"1042"`
+	Type      entity.EventType `json:"type" example:"task.status_changed"`
+	ProjectID uuid.UUID        `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TaskID    *uuid.UUID       `json:"task_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174001"`
+	// Data is the subject's snapshot when the event happened
+	Data      json.RawMessage `json:"data" swaggertype:"object"`
+	CreatedAt time.Time       `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type EventFeedResponse struct {
+	Events []EventResponse `json:"events"`
+	// Cursor is the newest event ID seen; pass it as `after` on the next poll
+	Cursor int64 `json:"cursor" example:"1042"`
+}
+
+// EventFeedResponseFromEntities converts newest-first events into a feed
+// response, carrying after forward as the cursor when there is nothing new
+func EventFeedResponseFromEntities(events []*entity.Event, after int64) EventFeedResponse {
+	response := EventFeedResponse{
+		Events: make([]EventResponse, len(events)),
+		Cursor: after,
+	}
+	for i, event := range events {
+		response.Events[i] = EventResponse{
+			ID:        event.ID,
+			Type:      event.Type,
+			ProjectID: event.ProjectID,
+			TaskID:    event.TaskID,
+			Data:      json.RawMessage(event.Data),
+			CreatedAt: event.CreatedAt,
+		}
+		if event.ID > response.Cursor {
+			response.Cursor = event.ID
+		}
+	}
+	return response
+}
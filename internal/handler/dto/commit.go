@@ -0,0 +1,166 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// GitHubPushPayload is the subset of GitHub's push webhook payload used to
+// link commits to tasks
+type GitHubPushPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		HTMLURL  string `json:"html_url"`
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		GitURL   string `json:"git_url"`
+		// PushedAt is the Unix time of the push
+		PushedAt int64 `json:"pushed_at"`
+	} `json:"repository"`
+	Commits []struct {
+		ID        string     `json:"id"`
+		Message   string     `json:"message"`
+		Timestamp *time.Time `json:"timestamp"`
+		URL       string     `json:"url"`
+		Author    struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"author"`
+	} `json:"commits"`
+}
+
+// ToPushEvent converts the payload into the host-agnostic push event
+func (p GitHubPushPayload) ToPushEvent() usecase.PushEvent {
+	push := usecase.PushEvent{
+		Provider:       entity.WebhookProviderGitHub,
+		RepositoryURLs: []string{p.Repository.HTMLURL, p.Repository.CloneURL, p.Repository.SSHURL, p.Repository.GitURL},
+		Branch:         strings.TrimPrefix(p.Ref, "refs/heads/"),
+		Commits:        make([]usecase.PushedCommit, len(p.Commits)),
+	}
+	for i, commit := range p.Commits {
+		push.Commits[i] = usecase.PushedCommit{
+			SHA:         commit.ID,
+			Message:     commit.Message,
+			AuthorName:  commit.Author.Name,
+			AuthorEmail: commit.Author.Email,
+			URL:         commit.URL,
+			Timestamp:   commit.Timestamp,
+		}
+	}
+	if p.Repository.PushedAt > 0 {
+		push.SentAt = time.Unix(p.Repository.PushedAt, 0)
+	}
+	return push
+}
+
+// GitHubPullRequestPayload is the subset of GitHub's pull_request webhook
+// payload used to sync the PR
+type GitHubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ToPullRequestEvent converts the payload into the host-agnostic event
+func (p GitHubPullRequestPayload) ToPullRequestEvent() usecase.PullRequestEvent {
+	return usecase.PullRequestEvent{
+		Provider:   entity.WebhookProviderGitHub,
+		SentAt:     p.PullRequest.UpdatedAt,
+		Repository: p.Repository.FullName,
+		Numbers:    []int{p.Number},
+	}
+}
+
+// GitHubCheckSuitePayload is the subset of GitHub's check_suite webhook
+// payload used to sync the PRs the suite ran for
+type GitHubCheckSuitePayload struct {
+	Action     string `json:"action"`
+	CheckSuite struct {
+		UpdatedAt    time.Time `json:"updated_at"`
+		PullRequests []struct {
+			Number int `json:"number"`
+		} `json:"pull_requests"`
+	} `json:"check_suite"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ToPullRequestEvent converts the payload into the host-agnostic event
+func (p GitHubCheckSuitePayload) ToPullRequestEvent() usecase.PullRequestEvent {
+	event := usecase.PullRequestEvent{
+		Provider:   entity.WebhookProviderGitHub,
+		SentAt:     p.CheckSuite.UpdatedAt,
+		Repository: p.Repository.FullName,
+		Numbers:    make([]int, len(p.CheckSuite.PullRequests)),
+	}
+	for i, pr := range p.CheckSuite.PullRequests {
+		event.Numbers[i] = pr.Number
+	}
+	return event
+}
+
+// Commit response DTOs
+type CommitLinkResponse struct {
+	Projects int `json:"projects" example:"1"`
+	Linked   int `json:"linked" example:"2"`
+}
+
+// PullRequestSyncResponse reports the PRs a webhook queued a sync of
+type PullRequestSyncResponse struct {
+	Queued int `json:"queued" example:"1"`
+}
+
+type TaskCommitResponse struct {
+	SHA         string     `json:"sha" example:"9fceb02d0ae598e95dc970b74767f19372d61af8"`
+	Message     string     `json:"message" example:"AD-42 Handle expired tokens"`
+	AuthorName  string     `json:"author_name" example:"Alice"`
+	AuthorEmail string     `json:"author_email" example:"alice@example.com"`
+	URL         string     `json:"url,omitempty" example:"https://github.com/acme/app/commit/9fceb02"`
+	Branch      string     `json:"branch,omitempty" example:"task-42-expired-tokens"`
+	CommittedAt *time.Time `json:"committed_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	LinkedAt    time.Time  `json:"linked_at" example:"2024-01-15T10:31:00Z"`
+}
+
+type TaskCommitListResponse struct {
+	TaskID uuid.UUID            `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items  []TaskCommitResponse `json:"items"`
+	ListMeta
+}
+
+func CommitLinkResponseFromResult(result *usecase.CommitLinkResult) CommitLinkResponse {
+	return CommitLinkResponse{
+		Projects: result.Projects,
+		Linked:   result.Linked,
+	}
+}
+
+func TaskCommitListResponseFromEntities(taskID uuid.UUID, commits []*entity.TaskCommit, meta ListMeta) TaskCommitListResponse {
+	items := make([]TaskCommitResponse, len(commits))
+	for i, commit := range commits {
+		items[i] = TaskCommitResponse{
+			SHA:         commit.SHA,
+			Message:     commit.Message,
+			AuthorName:  commit.AuthorName,
+			AuthorEmail: commit.AuthorEmail,
+			URL:         commit.URL,
+			Branch:      commit.Branch,
+			CommittedAt: commit.CommittedAt,
+			LinkedAt:    commit.CreatedAt,
+		}
+	}
+	return TaskCommitListResponse{
+		TaskID:   taskID,
+		Items:    items,
+		ListMeta: meta,
+	}
+}
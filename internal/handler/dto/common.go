@@ -1,11 +1,14 @@
 package dto
 
+import "github.com/auto-devs/auto-devs/pkg/i18n"
+
 // Common response DTOs
 type ErrorResponse struct {
-	Error   string            `json:"error" example:"Invalid request"`
-	Message string            `json:"message" example:"The provided data is invalid"`
-	Code    int               `json:"code" example:"400"`
-	Details map[string]string `json:"details,omitempty"`
+	Error     string            `json:"error" example:"Invalid request"`
+	Message   string            `json:"message" example:"The provided data is invalid"`
+	Code      int               `json:"code" example:"400"`
+	ErrorCode ErrorCode         `json:"error_code" example:"INVALID_REQUEST"`
+	Details   map[string]string `json:"details,omitempty"`
 }
 
 type SuccessResponse struct {
@@ -19,16 +22,13 @@ type PaginationQuery struct {
 	PageSize int `form:"page_size,default=10" binding:"min=1,max=100" example:"10"`
 }
 
-type PaginationMeta struct {
-	Page       int `json:"page" example:"1"`
-	PageSize   int `json:"page_size" example:"10"`
-	Total      int `json:"total" example:"100"`
-	TotalPages int `json:"total_pages" example:"10"`
-}
-
-type PaginatedResponse struct {
-	Data interface{}    `json:"data"`
-	Meta PaginationMeta `json:"meta"`
+// ListMeta is embedded in every list response, so all list endpoints share
+// the {items, total, page, page_size, has_more} envelope.
+type ListMeta struct {
+	Total    int  `json:"total" example:"100"`
+	Page     int  `json:"page" example:"1"`
+	PageSize int  `json:"page_size" example:"10"`
+	HasMore  bool `json:"has_more" example:"true"`
 }
 
 // Filter DTOs for tasks
@@ -42,19 +42,48 @@ type TaskFilterQuery struct {
 
 // Helper functions
 func NewErrorResponse(err error, code int, message string) ErrorResponse {
+	return NewErrorResponseWithCode(err, code, ErrorCodeFor(err, code), message)
+}
+
+// NewErrorResponseWithCode builds an error response with an explicit error
+// code, for failures the handler can classify better than the error itself
+// (e.g. which resource was not found).
+func NewErrorResponseWithCode(err error, code int, errorCode ErrorCode, message string) ErrorResponse {
+	errMsg := message
+	if err != nil {
+		errMsg = err.Error()
+	}
 	return ErrorResponse{
-		Error:   err.Error(),
-		Message: message,
-		Code:    code,
+		Error:     errMsg,
+		Message:   message,
+		Code:      code,
+		ErrorCode: errorCode,
 	}
 }
 
-func NewValidationErrorResponse(details map[string]string) ErrorResponse {
+// NewValidationErrorResponse builds the response for failed field
+// validations, with its texts in locale
+func NewValidationErrorResponse(locale i18n.Locale, details map[string]string) ErrorResponse {
 	return ErrorResponse{
-		Error:   "Validation failed",
-		Message: "The provided data failed validation",
-		Code:    400,
-		Details: details,
+		Error:     i18n.T(locale, "validation.failed"),
+		Message:   i18n.T(locale, "validation.failed_message"),
+		Code:      400,
+		ErrorCode: ErrorCodeValidationFailed,
+		Details:   details,
+	}
+}
+
+// NewListMeta describes one page of a list with the given total size. A page
+// size of 0 means the whole list was returned in a single page.
+func NewListMeta(total, page, pageSize int) ListMeta {
+	if pageSize <= 0 {
+		return ListMeta{Total: total, Page: 1, PageSize: total}
+	}
+	return ListMeta{
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+		HasMore:  page*pageSize < total,
 	}
 }
 
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// UpdateEmailTemplateRequest replaces a project's email template of a type.
+// Subject and body are Go text/templates over the fields of
+// entity.EmailTemplateData.
+type UpdateEmailTemplateRequest struct {
+	Subject string `json:"subject" binding:"required,max=255" example:"Plan of a task ready for review"`
+	Body    string `json:"body" binding:"required" example:"A plan waits for your review."`
+}
+
+type EmailTemplateResponse struct {
+	Type    entity.NotificationType `json:"type" example:"PLAN_READY"`
+	Subject string                  `json:"subject" example:"Plan of a task ready for review"`
+	Body    string                  `json:"body" example:"A plan waits for your review."`
+	// Custom is false for the default templates the project did not change
+	Custom    bool       `json:"custom" example:"true"`
+	UpdatedBy *string    `json:"updated_by,omitempty" example:"alice"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+type EmailTemplateListResponse struct {
+	Data []EmailTemplateResponse `json:"data"`
+}
+
+func EmailTemplateResponseFromEntity(template *entity.EmailTemplate) EmailTemplateResponse {
+	response := EmailTemplateResponse{
+		Type:      template.Type,
+		Subject:   template.Subject,
+		Body:      template.Body,
+		Custom:    template.ID != uuid.Nil,
+		UpdatedBy: template.UpdatedBy,
+	}
+	if response.Custom {
+		updatedAt := template.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
+
+func EmailTemplateListResponseFromEntities(templates []*entity.EmailTemplate) EmailTemplateListResponse {
+	data := make([]EmailTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		data = append(data, EmailTemplateResponseFromEntity(template))
+	}
+	return EmailTemplateListResponse{Data: data}
+}
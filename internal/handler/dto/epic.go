@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+type CreateEpicRequest struct {
+	Title       string     `json:"title" binding:"required,max=255" example:"Invoicing v2"`
+	Description string     `json:"description" binding:"max=5000" example:"Replace the invoice generator"`
+	DueDate     *time.Time `json:"due_date,omitempty" example:"2024-06-30T00:00:00Z"`
+}
+
+type UpdateEpicRequest struct {
+	Title       *string    `json:"title,omitempty" binding:"omitempty,min=1,max=255" example:"Invoicing v2"`
+	Description *string    `json:"description,omitempty" binding:"omitempty,max=5000" example:"Replace the invoice generator"`
+	DueDate     *time.Time `json:"due_date,omitempty" example:"2024-06-30T00:00:00Z"`
+	// ClearDueDate removes the due date
+	ClearDueDate bool `json:"clear_due_date,omitempty" example:"false"`
+}
+
+// EpicProgressResponse rolls up the tasks of an epic. Cancelled tasks are
+// left out of percent_complete.
+type EpicProgressResponse struct {
+	EpicID          uuid.UUID `json:"epic_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TotalTasks      int       `json:"total_tasks" example:"8"`
+	DoneTasks       int       `json:"done_tasks" example:"3"`
+	CancelledTasks  int       `json:"cancelled_tasks" example:"1"`
+	PercentComplete float64   `json:"percent_complete" example:"42.9"`
+	EstimatedHours  float64   `json:"estimated_hours" example:"32"`
+	ActualHours     float64   `json:"actual_hours" example:"14.5"`
+}
+
+type EpicResponse struct {
+	ID          uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID   uuid.UUID            `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title       string               `json:"title" example:"Invoicing v2"`
+	Description string               `json:"description,omitempty" example:"Replace the invoice generator"`
+	DueDate     *time.Time           `json:"due_date,omitempty" example:"2024-06-30T00:00:00Z"`
+	Progress    EpicProgressResponse `json:"progress"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type EpicListResponse struct {
+	ProjectID uuid.UUID      `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items     []EpicResponse `json:"items"`
+	ListMeta
+}
+
+func EpicProgressResponseFromEntity(progress *entity.EpicProgress) EpicProgressResponse {
+	return EpicProgressResponse{
+		EpicID:          progress.EpicID,
+		TotalTasks:      progress.TotalTasks,
+		DoneTasks:       progress.DoneTasks,
+		CancelledTasks:  progress.CancelledTasks,
+		PercentComplete: progress.PercentComplete(),
+		EstimatedHours:  progress.EstimatedHours,
+		ActualHours:     progress.ActualHours,
+	}
+}
+
+func EpicResponseFromSummary(summary *usecase.EpicSummary) EpicResponse {
+	epic := summary.Epic
+	return EpicResponse{
+		ID:          epic.ID,
+		ProjectID:   epic.ProjectID,
+		Title:       epic.Title,
+		Description: epic.Description,
+		DueDate:     epic.DueDate,
+		Progress:    EpicProgressResponseFromEntity(summary.Progress),
+		CreatedAt:   epic.CreatedAt,
+		UpdatedAt:   epic.UpdatedAt,
+	}
+}
+
+func EpicListResponseFromSummaries(projectID uuid.UUID, summaries []*usecase.EpicSummary, meta ListMeta) EpicListResponse {
+	items := make([]EpicResponse, len(summaries))
+	for i, summary := range summaries {
+		items[i] = EpicResponseFromSummary(summary)
+	}
+	return EpicListResponse{
+		ProjectID: projectID,
+		Items:     items,
+		ListMeta:  meta,
+	}
+}
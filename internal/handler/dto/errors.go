@@ -0,0 +1,318 @@
+package dto
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/service/githubissues"
+	"github.com/auto-devs/auto-devs/internal/service/githubprojects"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+)
+
+// ErrorCode is a stable, machine-readable identifier carried by every error
+// response so that clients can branch on failures without parsing messages.
+type ErrorCode string
+
+// Generic codes, derived from the HTTP status when nothing more specific applies
+const (
+	ErrorCodeInvalidRequest     ErrorCode = "INVALID_REQUEST"
+	ErrorCodeValidationFailed   ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden          ErrorCode = "FORBIDDEN"
+	ErrorCodeNotFound           ErrorCode = "NOT_FOUND"
+	ErrorCodeConflict           ErrorCode = "CONFLICT"
+	ErrorCodeRateLimited        ErrorCode = "RATE_LIMITED"
+	ErrorCodeInternal           ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+)
+
+// Resource codes
+const (
+	ErrorCodeInvalidID             ErrorCode = "INVALID_ID"
+	ErrorCodeProjectNotFound       ErrorCode = "PROJECT_NOT_FOUND"
+	ErrorCodeTaskNotFound          ErrorCode = "TASK_NOT_FOUND"
+	ErrorCodePlanNotFound          ErrorCode = "PLAN_NOT_FOUND"
+	ErrorCodeExecutionNotFound     ErrorCode = "EXECUTION_NOT_FOUND"
+	ErrorCodeWorktreeNotFound      ErrorCode = "WORKTREE_NOT_FOUND"
+	ErrorCodePullRequestNotFound   ErrorCode = "PULL_REQUEST_NOT_FOUND"
+	ErrorCodeOrganizationNotFound  ErrorCode = "ORGANIZATION_NOT_FOUND"
+	ErrorCodeJiraNotConfigured     ErrorCode = "JIRA_NOT_CONFIGURED"
+	ErrorCodeGitHubProjectNotFound ErrorCode = "GITHUB_PROJECT_NOT_FOUND"
+	ErrorCodeGitHubRepoNotFound    ErrorCode = "GITHUB_REPOSITORY_NOT_FOUND"
+	ErrorCodeSnapshotNotFound      ErrorCode = "SNAPSHOT_NOT_FOUND"
+	ErrorCodeComparisonNotFound    ErrorCode = "COMPARISON_NOT_FOUND"
+	ErrorCodeFileNotFound          ErrorCode = "FILE_NOT_FOUND"
+	ErrorCodeReleaseNotesNotFound  ErrorCode = "RELEASE_NOTES_NOT_FOUND"
+	ErrorCodeAttachmentNotFound    ErrorCode = "ATTACHMENT_NOT_FOUND"
+	ErrorCodeUndoTokenNotFound     ErrorCode = "UNDO_TOKEN_NOT_FOUND"
+	ErrorCodeAvatarNotFound        ErrorCode = "AVATAR_NOT_FOUND"
+	ErrorCodeEpicNotFound          ErrorCode = "EPIC_NOT_FOUND"
+	ErrorCodeScheduledJobNotFound  ErrorCode = "SCHEDULED_JOB_NOT_FOUND"
+	ErrorCodeAPIKeyNotFound        ErrorCode = "API_KEY_NOT_FOUND"
+	ErrorCodeUserNotFound          ErrorCode = "USER_NOT_FOUND"
+	ErrorCodeMemberNotFound        ErrorCode = "PROJECT_MEMBER_NOT_FOUND"
+	ErrorCodeWebhookNotFound       ErrorCode = "WEBHOOK_NOT_FOUND"
+	ErrorCodeDeliveryNotFound      ErrorCode = "WEBHOOK_DELIVERY_NOT_FOUND"
+	ErrorCodeSlackNotConfigured    ErrorCode = "SLACK_NOT_CONFIGURED"
+)
+
+// Domain codes
+const (
+	ErrorCodeInvalidTransition    ErrorCode = "INVALID_TRANSITION"
+	ErrorCodePlanNotInReview      ErrorCode = "PLAN_NOT_IN_REVIEW"
+	ErrorCodeDuplicateName        ErrorCode = "DUPLICATE_NAME"
+	ErrorCodeDuplicateSlug        ErrorCode = "DUPLICATE_SLUG"
+	ErrorCodeSecretsDisabled      ErrorCode = "SECRETS_DISABLED"
+	ErrorCodeWorktreeExists       ErrorCode = "WORKTREE_EXISTS"
+	ErrorCodeVariantIncomplete    ErrorCode = "VARIANT_INCOMPLETE"
+	ErrorCodeReleaseNotesNotReady ErrorCode = "RELEASE_NOTES_NOT_READY"
+	ErrorCodeWebhookExpired       ErrorCode = "WEBHOOK_EXPIRED"
+	ErrorCodeWebhookReplayed      ErrorCode = "WEBHOOK_REPLAYED"
+	ErrorCodeStepUpRequired       ErrorCode = "STEP_UP_REQUIRED"
+	ErrorCodeInvalidTOTPCode      ErrorCode = "INVALID_TOTP_CODE"
+	ErrorCodeTOTPNotConfigured    ErrorCode = "TOTP_NOT_CONFIGURED"
+	ErrorCodeIPNotAllowed         ErrorCode = "IP_NOT_ALLOWED"
+	ErrorCodeAttachmentTooLarge   ErrorCode = "ATTACHMENT_TOO_LARGE"
+	ErrorCodeAttachmentType       ErrorCode = "ATTACHMENT_TYPE_NOT_ALLOWED"
+	ErrorCodeDownloadURLInvalid   ErrorCode = "DOWNLOAD_URL_INVALID"
+	ErrorCodeUndoExpired          ErrorCode = "UNDO_EXPIRED"
+	ErrorCodeUndoAlreadyUsed      ErrorCode = "UNDO_ALREADY_USED"
+	ErrorCodePlanningOverloaded   ErrorCode = "PLANNING_OVERLOADED"
+	ErrorCodeQueueUnavailable     ErrorCode = "QUEUE_UNAVAILABLE"
+	ErrorCodeAlreadyScheduled     ErrorCode = "ALREADY_SCHEDULED"
+	ErrorCodeUsernameTaken        ErrorCode = "USERNAME_TAKEN"
+	ErrorCodeInvalidCredentials   ErrorCode = "INVALID_CREDENTIALS"
+	ErrorCodeRegistrationDisabled ErrorCode = "REGISTRATION_DISABLED"
+	ErrorCodeOwnerRequired        ErrorCode = "PROJECT_OWNER_REQUIRED"
+	ErrorCodeOrganizationRequired ErrorCode = "ORGANIZATION_REQUIRED"
+)
+
+// domainErrorCodes maps usecase sentinel errors to their codes
+var domainErrorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{usecase.ErrProjectNameRequired, ErrorCodeValidationFailed},
+	{usecase.ErrProjectNameTooShort, ErrorCodeValidationFailed},
+	{usecase.ErrProjectNameTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrDescriptionTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrRepoURLRequired, ErrorCodeValidationFailed},
+	{usecase.ErrRepoURLInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrRepoURLTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrTestFailurePolicyInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrSecurityScannerInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrAutoFixAttemptsInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrMaxConcurrentExecutionsInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrMonthlyBudgetInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrBudgetAlertThresholdsInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrCommandPolicyInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrHighRiskPathsInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrLocaleInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrGitProviderInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrSearchQueryRequired, ErrorCodeValidationFailed},
+	{usecase.ErrGitHubTokenRequired, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationPipelineEmpty, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationStepInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationStepDuplicate, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationStepCommandRequired, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationStepScannerRequired, ErrorCodeValidationFailed},
+	{usecase.ErrVerificationStepCommandNotAllowed, ErrorCodeValidationFailed},
+	{usecase.ErrWorkflowTypeInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrPromptTemplateTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrContextFilesInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrProjectNameExists, ErrorCodeDuplicateName},
+	{usecase.ErrOrganizationNameRequired, ErrorCodeValidationFailed},
+	{usecase.ErrOrganizationSlugInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrOrganizationSlugExists, ErrorCodeDuplicateSlug},
+	{usecase.ErrOrganizationNotFound, ErrorCodeOrganizationNotFound},
+	{usecase.ErrOrganizationForbidden, ErrorCodeForbidden},
+	{usecase.ErrOrganizationRequired, ErrorCodeOrganizationRequired},
+	{usecase.ErrOrganizationUserNotFound, ErrorCodeUserNotFound},
+	{usecase.ErrTaskFieldNotClearable, ErrorCodeValidationFailed},
+	{usecase.ErrTaskFieldConflict, ErrorCodeValidationFailed},
+	{usecase.ErrTaskNotInPlanReview, ErrorCodePlanNotInReview},
+	{usecase.ErrPlanNotEditable, ErrorCodePlanNotInReview},
+	{usecase.ErrPlanAlreadyApproved, ErrorCodeInvalidTransition},
+	{usecase.ErrPlanNotFound, ErrorCodePlanNotFound},
+	{usecase.ErrPlanContentRequired, ErrorCodeValidationFailed},
+	{usecase.ErrTaskNotInCodeReview, ErrorCodeInvalidTransition},
+	{usecase.ErrFeedbackRequired, ErrorCodeValidationFailed},
+	{usecase.ErrTaskHasNoWorktree, ErrorCodeWorktreeNotFound},
+	{usecase.ErrWorktreeMissing, ErrorCodeWorktreeNotFound},
+	{usecase.ErrWorktreeExists, ErrorCodeWorktreeExists},
+	{usecase.ErrTaskHasNoPullRequest, ErrorCodePullRequestNotFound},
+	{usecase.ErrChangesSourceInvalid, ErrorCodeValidationFailed},
+	{git.ErrSnapshotNotFound, ErrorCodeSnapshotNotFound},
+	{usecase.ErrDateRangeInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrVelocityWeeksInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrJobMetricsRangeTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrAnalyticsPeriodInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrWorktreePathInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrWorktreePathNotDir, ErrorCodeValidationFailed},
+	{usecase.ErrWorktreePathIsDir, ErrorCodeValidationFailed},
+	{usecase.ErrWorktreeFileIrregular, ErrorCodeValidationFailed},
+	{usecase.ErrWorktreeFileNotFound, ErrorCodeFileNotFound},
+	{usecase.ErrComparisonExecutorsInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrTaskNotReadyForComparison, ErrorCodeInvalidTransition},
+	{usecase.ErrTaskNotReadyForDryRun, ErrorCodeInvalidTransition},
+	{usecase.ErrComparisonNotFound, ErrorCodeComparisonNotFound},
+	{usecase.ErrComparisonVariantNotFound, ErrorCodeComparisonNotFound},
+	{usecase.ErrComparisonVariantNotCompleted, ErrorCodeVariantIncomplete},
+	{usecase.ErrExecutionNotFound, ErrorCodeExecutionNotFound},
+	{usecase.ErrExecutionNotWaitingInput, ErrorCodeInvalidTransition},
+	{usecase.ErrExecutionNotCancellable, ErrorCodeInvalidTransition},
+	{usecase.ErrExecutionNotRetryable, ErrorCodeInvalidTransition},
+	{usecase.ErrAnswerRequired, ErrorCodeValidationFailed},
+	{usecase.ErrProjectNotFound, ErrorCodeProjectNotFound},
+	{usecase.ErrJiraNotConfigured, ErrorCodeJiraNotConfigured},
+	{usecase.ErrJiraBaseURLInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrJiraEmailRequired, ErrorCodeValidationFailed},
+	{usecase.ErrJiraJQLRequired, ErrorCodeValidationFailed},
+	{usecase.ErrJiraAPITokenRequired, ErrorCodeValidationFailed},
+	{usecase.ErrJiraImportStatusInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrJiraTransitionStatusInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrGitHubProjectOwnerRequired, ErrorCodeValidationFailed},
+	{usecase.ErrGitHubProjectNumberInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrGitHubProjectImportStatusInvalid, ErrorCodeValidationFailed},
+	{githubprojects.ErrBoardNotFound, ErrorCodeGitHubProjectNotFound},
+	{usecase.ErrGitHubIssueRepositoryInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrGitHubIssueStateInvalid, ErrorCodeValidationFailed},
+	{githubissues.ErrNotFound, ErrorCodeGitHubRepoNotFound},
+	{usecase.ErrEventTypeInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrReleaseNotesSourceInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrReleaseNotesNoChanges, ErrorCodeValidationFailed},
+	{usecase.ErrReleaseNotesNotFound, ErrorCodeReleaseNotesNotFound},
+	{usecase.ErrReleaseNotesNotReady, ErrorCodeReleaseNotesNotReady},
+	{usecase.ErrWebhookDeliveryIDRequired, ErrorCodeValidationFailed},
+	{usecase.ErrWebhookDeliveryExpired, ErrorCodeWebhookExpired},
+	{usecase.ErrWebhookDeliveryReplayed, ErrorCodeWebhookReplayed},
+	{usecase.ErrStepUpApprovalRequired, ErrorCodeStepUpRequired},
+	{usecase.ErrTOTPCodeInvalid, ErrorCodeInvalidTOTPCode},
+	{usecase.ErrTOTPNotConfigured, ErrorCodeTOTPNotConfigured},
+	{usecase.ErrTaskNotFound, ErrorCodeTaskNotFound},
+	{usecase.ErrAttachmentNotFound, ErrorCodeAttachmentNotFound},
+	{usecase.ErrAttachmentEmpty, ErrorCodeValidationFailed},
+	{usecase.ErrAttachmentTooLarge, ErrorCodeAttachmentTooLarge},
+	{usecase.ErrAttachmentTypeNotAllowed, ErrorCodeAttachmentType},
+	{usecase.ErrDownloadURLInvalid, ErrorCodeDownloadURLInvalid},
+	{usecase.ErrTaskIDsRequired, ErrorCodeValidationFailed},
+	{usecase.ErrUndoTokenNotFound, ErrorCodeUndoTokenNotFound},
+	{usecase.ErrUndoExpired, ErrorCodeUndoExpired},
+	{usecase.ErrUndoAlreadyUsed, ErrorCodeUndoAlreadyUsed},
+	{usecase.ErrUsernameInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrDisplayNameTooLong, ErrorCodeValidationFailed},
+	{usecase.ErrTimezoneInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrAvatarNotFound, ErrorCodeAvatarNotFound},
+	{usecase.ErrAvatarEmpty, ErrorCodeValidationFailed},
+	{usecase.ErrAvatarTooLarge, ErrorCodeAttachmentTooLarge},
+	{usecase.ErrAvatarTypeNotAllowed, ErrorCodeAttachmentType},
+	{usecase.ErrPlanningOverloaded, ErrorCodePlanningOverloaded},
+	{usecase.ErrJobQueueUnavailable, ErrorCodeQueueUnavailable},
+	{usecase.ErrScheduleInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrTaskAlreadyScheduled, ErrorCodeAlreadyScheduled},
+	{usecase.ErrScheduledJobNotFound, ErrorCodeScheduledJobNotFound},
+	{usecase.ErrEpicNotFound, ErrorCodeEpicNotFound},
+	{usecase.ErrEpicTitleRequired, ErrorCodeValidationFailed},
+	{usecase.ErrEpicProjectMismatch, ErrorCodeValidationFailed},
+	{usecase.ErrRegistrationDisabled, ErrorCodeRegistrationDisabled},
+	{usecase.ErrUsernameTaken, ErrorCodeUsernameTaken},
+	{usecase.ErrPasswordInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrEmailInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrInvalidCredentials, ErrorCodeInvalidCredentials},
+	{usecase.ErrInvalidToken, ErrorCodeUnauthorized},
+	{usecase.ErrAPIKeyNameInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrAPIKeyNotFound, ErrorCodeAPIKeyNotFound},
+	{usecase.ErrProjectForbidden, ErrorCodeForbidden},
+	{usecase.ErrProjectRoleInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrProjectMemberNotFound, ErrorCodeMemberNotFound},
+	{usecase.ErrUserNotFound, ErrorCodeUserNotFound},
+	{usecase.ErrProjectOwnerRequired, ErrorCodeOwnerRequired},
+	{usecase.ErrWebhookNotFound, ErrorCodeWebhookNotFound},
+	{usecase.ErrWebhookURLInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrWebhookURLNotAllowed, ErrorCodeValidationFailed},
+	{usecase.ErrWebhookEventInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrWebhookDeliveryNotFound, ErrorCodeDeliveryNotFound},
+	{usecase.ErrSlackNotConfigured, ErrorCodeSlackNotConfigured},
+	{usecase.ErrSlackCredentialsRequired, ErrorCodeValidationFailed},
+	{usecase.ErrSlackCredentialsConflict, ErrorCodeValidationFailed},
+	{usecase.ErrSlackWebhookURLInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrSlackChannelRequired, ErrorCodeValidationFailed},
+	{usecase.ErrSlackActionInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrSlackInteractivityOff, ErrorCodeForbidden},
+	{usecase.ErrNotificationTypeInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrEmailTemplateTypeInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrEmailTemplateInvalid, ErrorCodeValidationFailed},
+	{usecase.ErrEmailSubjectRequired, ErrorCodeValidationFailed},
+	{secrets.ErrNoEncryptionKey, ErrorCodeSecretsDisabled},
+}
+
+// ErrorCodeFor returns the code for err, falling back to a generic code for
+// the HTTP status when err is not a known domain error.
+func ErrorCodeFor(err error, status int) ErrorCode {
+	if err != nil {
+		var transitionErr *entity.TaskStatusValidationError
+		if errors.As(err, &transitionErr) {
+			return ErrorCodeInvalidTransition
+		}
+		for _, m := range domainErrorCodes {
+			if errors.Is(err, m.err) {
+				return m.code
+			}
+		}
+	}
+	return ErrorCodeForStatus(status)
+}
+
+// ErrorCodeForStatus returns the generic code for an HTTP status
+func ErrorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeInvalidRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusConflict:
+		return ErrorCodeConflict
+	case http.StatusUnprocessableEntity:
+		return ErrorCodeValidationFailed
+	case http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	case http.StatusServiceUnavailable:
+		return ErrorCodeServiceUnavailable
+	}
+	return ErrorCodeInternal
+}
+
+// HTTPStatus returns the status a domain code should be served with, or
+// fallback for codes that do not imply one.
+func (c ErrorCode) HTTPStatus(fallback int) int {
+	switch c {
+	case ErrorCodeValidationFailed, ErrorCodeInvalidID, ErrorCodeTOTPNotConfigured:
+		return http.StatusBadRequest
+	case ErrorCodeProjectNotFound, ErrorCodeWorktreeNotFound, ErrorCodeJiraNotConfigured, ErrorCodeGitHubProjectNotFound, ErrorCodeGitHubRepoNotFound, ErrorCodeSnapshotNotFound, ErrorCodeComparisonNotFound, ErrorCodeFileNotFound, ErrorCodeReleaseNotesNotFound, ErrorCodeTaskNotFound, ErrorCodeAttachmentNotFound, ErrorCodePullRequestNotFound, ErrorCodeUndoTokenNotFound, ErrorCodeAvatarNotFound, ErrorCodeEpicNotFound, ErrorCodeExecutionNotFound, ErrorCodeScheduledJobNotFound, ErrorCodeAPIKeyNotFound, ErrorCodeUserNotFound, ErrorCodeMemberNotFound, ErrorCodeWebhookNotFound, ErrorCodeDeliveryNotFound, ErrorCodeSlackNotConfigured, ErrorCodeOrganizationNotFound:
+		return http.StatusNotFound
+	case ErrorCodeDuplicateName, ErrorCodeDuplicateSlug, ErrorCodeInvalidTransition, ErrorCodePlanNotInReview, ErrorCodeWorktreeExists, ErrorCodeVariantIncomplete, ErrorCodeReleaseNotesNotReady, ErrorCodeWebhookReplayed, ErrorCodeUndoAlreadyUsed, ErrorCodeAlreadyScheduled, ErrorCodeUsernameTaken, ErrorCodeOwnerRequired:
+		return http.StatusConflict
+	case ErrorCodeUndoExpired:
+		return http.StatusGone
+	case ErrorCodeWebhookExpired, ErrorCodeInvalidCredentials, ErrorCodeUnauthorized:
+		return http.StatusUnauthorized
+	case ErrorCodeStepUpRequired, ErrorCodeInvalidTOTPCode, ErrorCodeDownloadURLInvalid, ErrorCodeRegistrationDisabled, ErrorCodeForbidden, ErrorCodeOrganizationRequired:
+		return http.StatusForbidden
+	case ErrorCodeAttachmentTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case ErrorCodeAttachmentType:
+		return http.StatusUnsupportedMediaType
+	case ErrorCodeSecretsDisabled, ErrorCodeQueueUnavailable:
+		return http.StatusServiceUnavailable
+	case ErrorCodePlanningOverloaded:
+		return http.StatusTooManyRequests
+	}
+	return fallback
+}
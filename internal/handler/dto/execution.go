@@ -29,8 +29,28 @@ type ExecutionResponse struct {
 	Progress    float64                 `json:"progress" example:"0.75"`
 	Result      *entity.ExecutionResult `json:"result,omitempty"`
 	Duration    *time.Duration          `json:"duration,omitempty" swaggertype:"integer" example:"3600000000000"`
-	CreatedAt   time.Time               `json:"created_at" example:"2024-01-01T00:00:00Z"`
-	UpdatedAt   time.Time               `json:"updated_at" example:"2024-01-01T00:00:00Z"`
+	// Coverage percentages after the execution and on the base branch, and
+	// the difference in percentage points
+	Coverage      *float64 `json:"coverage,omitempty" example:"81.4"`
+	BaseCoverage  *float64 `json:"base_coverage,omitempty" example:"79.9"`
+	CoverageDelta *float64 `json:"coverage_delta,omitempty" example:"1.5"`
+	// FixAttempts is how many times the AI was asked to fix a failing build
+	// or test run
+	FixAttempts int `json:"fix_attempts" example:"1"`
+	// Worker runs the execution and last reported it alive at HeartbeatAt
+	Worker      string     `json:"worker,omitempty" example:"impl-1@build-host"`
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty" example:"2024-01-01T00:30:00Z"`
+	// Question is what the AI asked while the execution waits for input
+	Question string `json:"question,omitempty" example:"Should the old /login endpoint keep working?"`
+	// DryRun executions previewed an implementation in a throwaway worktree
+	DryRun bool `json:"dry_run,omitempty" example:"false"`
+	// Phase is planning or implementation, and Attempt numbers its runs
+	// from the first one; a retry links to the failed execution it retries
+	Phase     entity.ExecutionPhase `json:"phase,omitempty" example:"implementation"`
+	Attempt   int                   `json:"attempt" example:"2"`
+	RetryOfID *uuid.UUID            `json:"retry_of_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt time.Time             `json:"created_at" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt time.Time             `json:"updated_at" example:"2024-01-01T00:00:00Z"`
 }
 
 type ExecutionWithLogsResponse struct {
@@ -39,8 +59,8 @@ type ExecutionWithLogsResponse struct {
 }
 
 type ExecutionListResponse struct {
-	Data []ExecutionResponse `json:"data"`
-	Meta PaginationMeta      `json:"meta"`
+	Items []ExecutionResponse `json:"items"`
+	ListMeta
 }
 
 // Execution log response DTOs
@@ -63,11 +83,13 @@ type ExecutionLogResponse struct {
     NumTurns      *int        `json:"num_turns,omitempty" example:"5"`
 	CreatedAt   time.Time       `json:"created_at" example:"2024-01-01T00:00:00Z"`
 	Line        int             `json:"line" example:"1"`
+	// Encrypted logs have their message and parsed content withheld
+	Encrypted bool `json:"encrypted,omitempty" example:"false"`
 }
 
 type ExecutionLogListResponse struct {
-	Data []ExecutionLogResponse `json:"data"`
-	Meta PaginationMeta         `json:"meta"`
+	Items []ExecutionLogResponse `json:"items"`
+	ListMeta
 }
 
 // Filter and query DTOs
@@ -101,14 +123,25 @@ type ExecutionLogFilterQuery struct {
 // Conversion functions
 func ToExecutionResponse(execution *entity.Execution) ExecutionResponse {
 	response := ExecutionResponse{
-		ID:        execution.ID,
-		TaskID:    execution.TaskID,
-		Status:    execution.Status,
-		StartedAt: execution.StartedAt,
-		Error:     execution.ErrorMessage,
-		Progress:  execution.Progress,
-		CreatedAt: execution.CreatedAt,
-		UpdatedAt: execution.UpdatedAt,
+		ID:            execution.ID,
+		TaskID:        execution.TaskID,
+		Status:        execution.Status,
+		StartedAt:     execution.StartedAt,
+		Error:         execution.ErrorMessage,
+		Progress:      execution.Progress,
+		Coverage:      execution.Coverage,
+		BaseCoverage:  execution.BaseCoverage,
+		CoverageDelta: execution.GetCoverageDelta(),
+		FixAttempts:   execution.FixAttempts,
+		Worker:        execution.Worker,
+		HeartbeatAt:   execution.HeartbeatAt,
+		Question:      execution.Question,
+		DryRun:        execution.DryRun,
+		Phase:         execution.Phase,
+		Attempt:       execution.CurrentAttempt(),
+		RetryOfID:     execution.RetryOfID,
+		CreatedAt:     execution.CreatedAt,
+		UpdatedAt:     execution.UpdatedAt,
 	}
 
 	if execution.CompletedAt != nil {
@@ -169,29 +202,165 @@ func ToExecutionLogResponse(log *entity.ExecutionLog) ExecutionLogResponse {
         response.ParsedContent = log.ParsedContent
     }
 
+	if log.Encrypted {
+		response.Message = ""
+		response.ParsedContent = nil
+		response.Encrypted = true
+	}
+
 	return response
 }
 
-func ToExecutionListResponse(executions []*entity.Execution, meta PaginationMeta) ExecutionListResponse {
+func ToExecutionListResponse(executions []*entity.Execution, meta ListMeta) ExecutionListResponse {
 	responses := make([]ExecutionResponse, len(executions))
 	for i, execution := range executions {
 		responses[i] = ToExecutionResponse(execution)
 	}
 
 	return ExecutionListResponse{
-		Data: responses,
-		Meta: meta,
+		Items:    responses,
+		ListMeta: meta,
 	}
 }
 
-func ToExecutionLogListResponse(logs []*entity.ExecutionLog, meta PaginationMeta) ExecutionLogListResponse {
+func ToExecutionLogListResponse(logs []*entity.ExecutionLog, meta ListMeta) ExecutionLogListResponse {
 	responses := make([]ExecutionLogResponse, len(logs))
 	for i, log := range logs {
 		responses[i] = ToExecutionLogResponse(log)
 	}
 
 	return ExecutionLogListResponse{
-		Data: responses,
-		Meta: meta,
+		Items:    responses,
+		ListMeta: meta,
+	}
+}
+
+// Verification run response DTOs
+type VerificationRunResponse struct {
+	ID             uuid.UUID                    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExecutionID    uuid.UUID                    `json:"execution_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Step           entity.VerificationStep      `json:"step" example:"test"`
+	Position       int                          `json:"position" example:"2"`
+	Attempt        int                          `json:"attempt" example:"0"`
+	Status         entity.VerificationRunStatus `json:"status" example:"failed"`
+	Command        string                       `json:"command" example:"npm test"`
+	Passed         bool                         `json:"passed" example:"false"`
+	ExitCode       int                          `json:"exit_code" example:"1"`
+	Output         string                       `json:"output" example:"1 failing"`
+	FixesCommitted bool                         `json:"fixes_committed" example:"false"`
+	DurationMs     int64                        `json:"duration_ms" example:"42000"`
+	StartedAt      time.Time                    `json:"started_at" example:"2024-01-01T00:00:00Z"`
+	CompletedAt    time.Time                    `json:"completed_at" example:"2024-01-01T00:00:42Z"`
+}
+
+// ExecutionAttemptListResponse is the attempt chain of an execution, first
+// attempt first
+type ExecutionAttemptListResponse struct {
+	Items []ExecutionResponse `json:"items"`
+}
+
+func ToExecutionAttemptListResponse(attempts []*entity.Execution) ExecutionAttemptListResponse {
+	items := make([]ExecutionResponse, len(attempts))
+	for i, attempt := range attempts {
+		items[i] = ToExecutionResponse(attempt)
+	}
+	return ExecutionAttemptListResponse{Items: items}
+}
+
+type VerificationRunListResponse struct {
+	Items []VerificationRunResponse `json:"items"`
+}
+
+func ToVerificationRunListResponse(runs []*entity.VerificationRun) VerificationRunListResponse {
+	items := make([]VerificationRunResponse, len(runs))
+	for i, run := range runs {
+		items[i] = VerificationRunResponse{
+			ID:             run.ID,
+			ExecutionID:    run.ExecutionID,
+			Step:           run.Step,
+			Position:       run.Position,
+			Attempt:        run.Attempt,
+			Status:         run.Status,
+			Command:        run.Command,
+			Passed:         run.Passed,
+			ExitCode:       run.ExitCode,
+			Output:         run.Output,
+			FixesCommitted: run.FixesCommitted,
+			DurationMs:     run.GetDuration().Milliseconds(),
+			StartedAt:      run.StartedAt,
+			CompletedAt:    run.CompletedAt,
+		}
+	}
+
+	return VerificationRunListResponse{Items: items}
+}
+
+// Review comment response DTOs
+type ReviewCommentResponse struct {
+	ID          uuid.UUID             `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExecutionID uuid.UUID             `json:"execution_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	FilePath    string                `json:"file_path,omitempty" example:"internal/auth/login.go"`
+	Line        *int                  `json:"line,omitempty" example:"42"`
+	Severity    entity.ReviewSeverity `json:"severity" example:"warning"`
+	Body        string                `json:"body" example:"The error returned by Close is ignored"`
+	Posted      bool                  `json:"posted" example:"true"`
+	CreatedAt   time.Time             `json:"created_at" example:"2024-01-01T00:00:00Z"`
+}
+
+type ReviewCommentListResponse struct {
+	Items []ReviewCommentResponse `json:"items"`
+}
+
+func ToReviewCommentListResponse(comments []*entity.ReviewComment) ReviewCommentListResponse {
+	items := make([]ReviewCommentResponse, len(comments))
+	for i, comment := range comments {
+		items[i] = ReviewCommentResponse{
+			ID:          comment.ID,
+			ExecutionID: comment.ExecutionID,
+			FilePath:    comment.FilePath,
+			Line:        comment.Line,
+			Severity:    comment.Severity,
+			Body:        comment.Body,
+			Posted:      comment.Posted,
+			CreatedAt:   comment.CreatedAt,
+		}
 	}
+
+	return ReviewCommentListResponse{Items: items}
+}
+
+// Security finding response DTOs
+type SecurityFindingResponse struct {
+	ID          uuid.UUID               `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ExecutionID uuid.UUID               `json:"execution_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Scanner     entity.SecurityScanner  `json:"scanner" example:"gosec"`
+	RuleID      string                  `json:"rule_id" example:"G101"`
+	FilePath    string                  `json:"file_path" example:"internal/auth/login.go"`
+	Line        int                     `json:"line" example:"42"`
+	Severity    entity.SecuritySeverity `json:"severity" example:"high"`
+	Message     string                  `json:"message" example:"Potential hardcoded credentials"`
+	CreatedAt   time.Time               `json:"created_at" example:"2024-01-01T00:00:00Z"`
+}
+
+type SecurityFindingListResponse struct {
+	Items []SecurityFindingResponse `json:"items"`
+}
+
+func ToSecurityFindingListResponse(findings []*entity.SecurityFinding) SecurityFindingListResponse {
+	items := make([]SecurityFindingResponse, len(findings))
+	for i, finding := range findings {
+		items[i] = SecurityFindingResponse{
+			ID:          finding.ID,
+			ExecutionID: finding.ExecutionID,
+			Scanner:     finding.Scanner,
+			RuleID:      finding.RuleID,
+			FilePath:    finding.FilePath,
+			Line:        finding.Line,
+			Severity:    finding.Severity,
+			Message:     finding.Message,
+			CreatedAt:   finding.CreatedAt,
+		}
+	}
+
+	return SecurityFindingListResponse{Items: items}
 }
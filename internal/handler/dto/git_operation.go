@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// GitOperationResponse is the audit record of a git operation done for a task
+type GitOperationResponse struct {
+	ID          uuid.UUID               `json:"id"`
+	ProjectID   *uuid.UUID              `json:"project_id,omitempty"`
+	ExecutionID *uuid.UUID              `json:"execution_id,omitempty"`
+	Operation   entity.GitOperationType `json:"operation" example:"push"`
+	Actor       string                  `json:"actor" example:"job:task:implementation"`
+	WorkingDir  string                  `json:"working_dir" example:"/worktrees/project-1/task-42"`
+	Target      string                  `json:"target,omitempty" example:"origin/task-42-expired-tokens"`
+	CommitSHA   string                  `json:"commit_sha,omitempty" example:"9fceb02d0ae598e95dc970b74767f19372d61af8"`
+	Succeeded   bool                    `json:"succeeded" example:"true"`
+	Error       string                  `json:"error,omitempty"`
+	DurationMs  int64                   `json:"duration_ms" example:"840"`
+	CreatedAt   time.Time               `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type GitOperationListResponse struct {
+	TaskID uuid.UUID              `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items  []GitOperationResponse `json:"items"`
+	ListMeta
+}
+
+func GitOperationListResponseFromEntities(taskID uuid.UUID, operations []*entity.GitOperation, meta ListMeta) GitOperationListResponse {
+	items := make([]GitOperationResponse, len(operations))
+	for i, operation := range operations {
+		items[i] = GitOperationResponse{
+			ID:          operation.ID,
+			ProjectID:   operation.ProjectID,
+			ExecutionID: operation.ExecutionID,
+			Operation:   operation.Operation,
+			Actor:       operation.Actor,
+			WorkingDir:  operation.WorkingDir,
+			Target:      operation.Target,
+			CommitSHA:   operation.CommitSHA,
+			Succeeded:   operation.Succeeded,
+			Error:       operation.Error,
+			DurationMs:  operation.DurationMs,
+			CreatedAt:   operation.CreatedAt,
+		}
+	}
+	return GitOperationListResponse{
+		TaskID:   taskID,
+		Items:    items,
+		ListMeta: meta,
+	}
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+)
+
+// GitHub project import request DTOs
+type GitHubProjectImportRequest struct {
+	Owner  string `json:"owner" binding:"required,max=100" example:"acme"`
+	Number int    `json:"number" binding:"required,min=1" example:"3"`
+	// StatusField is the single-select field whose options are the board
+	// columns; defaults to "Status"
+	StatusField   string                       `json:"status_field,omitempty" binding:"max=100" example:"Status"`
+	ColumnMapping map[string]entity.TaskStatus `json:"column_mapping,omitempty"`
+}
+
+// GitHub project import response DTOs
+type GitHubProjectImportResponse struct {
+	Board     string                       `json:"board" example:"Roadmap"`
+	Columns   map[string]entity.TaskStatus `json:"columns"`
+	Created   int                          `json:"created" example:"8"`
+	Updated   int                          `json:"updated" example:"2"`
+	Unchanged int                          `json:"unchanged" example:"14"`
+}
+
+func GitHubProjectImportResponseFromResult(result *usecase.GitHubProjectImportResult) GitHubProjectImportResponse {
+	return GitHubProjectImportResponse{
+		Board:     result.Board,
+		Columns:   result.Columns,
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Unchanged: result.Unchanged,
+	}
+}
+
+// GitHub issue import request DTOs
+type GitHubIssueImportRequest struct {
+	// Repository is the full name of the repository; defaults to the
+	// project's repository
+	Repository string   `json:"repository,omitempty" binding:"max=255" example:"acme/app"`
+	Labels     []string `json:"labels,omitempty" example:"bug,ready"`
+	State      string   `json:"state,omitempty" binding:"omitempty,oneof=open closed all" example:"open"`
+	// Numbers select the issues to import; every matching issue when empty
+	Numbers []int `json:"numbers,omitempty" binding:"omitempty,dive,min=1" example:"12,15"`
+	// PostComments posts the plan and the pull request of the tasks to
+	// their issues
+	PostComments bool `json:"post_comments,omitempty" example:"true"`
+}
+
+// GitHub issue import response DTOs
+type GitHubIssueImportResponse struct {
+	Repository string `json:"repository" example:"acme/app"`
+	Created    int    `json:"created" example:"3"`
+	Updated    int    `json:"updated" example:"1"`
+	Unchanged  int    `json:"unchanged" example:"0"`
+	Missing    []int  `json:"missing,omitempty" example:"15"`
+}
+
+func GitHubIssueImportResponseFromResult(result *usecase.GitHubIssueImportResult) GitHubIssueImportResponse {
+	return GitHubIssueImportResponse{
+		Repository: result.Repository,
+		Created:    result.Created,
+		Updated:    result.Updated,
+		Unchanged:  result.Unchanged,
+		Missing:    result.Missing,
+	}
+}
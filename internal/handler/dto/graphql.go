@@ -0,0 +1,20 @@
+package dto
+
+// GraphQLRequest is a standard GraphQL-over-HTTP request body
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required" example:"query { task(id: \"123e4567-e89b-12d3-a456-426614174000\") { title plans { content } } }"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLResponse is a standard GraphQL-over-HTTP response body
+type GraphQLResponse struct {
+	Data   interface{}    `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError describes a single GraphQL execution error
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
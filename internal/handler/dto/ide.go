@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+// IDE request DTOs
+type IDETaskCreateRequest struct {
+	ProjectID uuid.UUID `json:"project_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title     string    `json:"title" binding:"required,min=1,max=255" example:"Handle expired tokens"`
+	Note      string    `json:"note" binding:"max=1000" example:"This panics when the refresh token is empty"`
+	// The editor selection the task is about
+	FilePath  string `json:"file_path" binding:"required,max=1000" example:"internal/auth/token.go"`
+	StartLine int    `json:"start_line" binding:"min=0" example:"42"`
+	EndLine   int    `json:"end_line" binding:"omitempty,gtefield=StartLine" example:"58"`
+	Language  string `json:"language" binding:"max=50" example:"go"`
+	Selection string `json:"selection" binding:"max=3500" example:"func refresh(token string) error {"`
+}
+
+// IDE response DTOs
+type IDEWorktreeResponse struct {
+	TaskID         uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WorktreePath   string    `json:"worktree_path" example:"/worktrees/project-1/task-123"`
+	BranchName     *string   `json:"branch_name,omitempty" example:"task-123-add-login"`
+	BaseBranchName *string   `json:"base_branch_name,omitempty" example:"main"`
+	// EditorURI opens the worktree in VS Code
+	EditorURI string `json:"editor_uri" example:"vscode://file/worktrees/project-1/task-123"`
+}
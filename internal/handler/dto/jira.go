@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// Jira integration request DTOs
+type JiraIntegrationRequest struct {
+	BaseURL string `json:"base_url" binding:"required,url,max=500" example:"https://acme.atlassian.net"`
+	Email   string `json:"email" binding:"required,email,max=255" example:"bot@acme.com"`
+	// APIToken is write-only; omit it to keep the stored token
+	APIToken          *string                      `json:"api_token,omitempty" binding:"omitempty,min=1" example:"ATATT3xFfGF0..."`
+	JQL               string                       `json:"jql" binding:"required" example:"project = PROJ AND labels = auto-devs"`
+	StatusMapping     map[string]entity.TaskStatus `json:"status_mapping,omitempty"`
+	TransitionMapping map[entity.TaskStatus]string `json:"transition_mapping,omitempty"`
+	SyncStatusBack    bool                         `json:"sync_status_back" example:"true"`
+}
+
+// Jira integration response DTOs
+type JiraIntegrationResponse struct {
+	ID                uuid.UUID                    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID         uuid.UUID                    `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	BaseURL           string                       `json:"base_url" example:"https://acme.atlassian.net"`
+	Email             string                       `json:"email" example:"bot@acme.com"`
+	JQL               string                       `json:"jql" example:"project = PROJ AND labels = auto-devs"`
+	StatusMapping     map[string]entity.TaskStatus `json:"status_mapping"`
+	TransitionMapping map[entity.TaskStatus]string `json:"transition_mapping"`
+	SyncStatusBack    bool                         `json:"sync_status_back" example:"true"`
+	LastImportedAt    *time.Time                   `json:"last_imported_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	CreatedAt         time.Time                    `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt         time.Time                    `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type JiraImportResponse struct {
+	Created   int `json:"created" example:"3"`
+	Updated   int `json:"updated" example:"1"`
+	Unchanged int `json:"unchanged" example:"12"`
+}
+
+func JiraIntegrationResponseFromEntity(integration *entity.JiraIntegration) JiraIntegrationResponse {
+	return JiraIntegrationResponse{
+		ID:                integration.ID,
+		ProjectID:         integration.ProjectID,
+		BaseURL:           integration.BaseURL,
+		Email:             integration.Email,
+		JQL:               integration.JQL,
+		StatusMapping:     integration.StatusMapping,
+		TransitionMapping: integration.TransitionMapping,
+		SyncStatusBack:    integration.SyncStatusBack,
+		LastImportedAt:    integration.LastImportedAt,
+		CreatedAt:         integration.CreatedAt,
+		UpdatedAt:         integration.UpdatedAt,
+	}
+}
+
+func JiraImportResponseFromResult(result *usecase.JiraImportResult) JiraImportResponse {
+	return JiraImportResponse{
+		Created:   result.Created,
+		Updated:   result.Updated,
+		Unchanged: result.Unchanged,
+	}
+}
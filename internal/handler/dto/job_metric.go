@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+)
+
+type JobMetricsQuery struct {
+	// From and To are times within the first and last UTC days covered
+	From    *time.Time `form:"from" example:"2024-03-01T00:00:00Z"`
+	To      *time.Time `form:"to" example:"2024-03-31T00:00:00Z"`
+	JobType string     `form:"job_type" binding:"omitempty,max=100" example:"task:planning"`
+}
+
+// DailyJobMetricsResponse is how the jobs of one type were processed over
+// one UTC day. Durations are in seconds.
+type DailyJobMetricsResponse struct {
+	Day                    time.Time `json:"day" example:"2024-03-05T00:00:00Z"`
+	JobType                string    `json:"job_type" example:"task:planning"`
+	Processed              int64     `json:"processed" example:"42"`
+	Failed                 int64     `json:"failed" example:"3"`
+	FailureRate            float64   `json:"failure_rate" example:"0.071"`
+	AverageDurationSeconds float64   `json:"average_duration_seconds" example:"12.4"`
+	MaxDurationSeconds     float64   `json:"max_duration_seconds" example:"95.2"`
+}
+
+// JobTypeMetricsResponse sums the days of a job type. Durations are in
+// seconds.
+type JobTypeMetricsResponse struct {
+	JobType                string  `json:"job_type" example:"task:planning"`
+	Processed              int64   `json:"processed" example:"420"`
+	Failed                 int64   `json:"failed" example:"12"`
+	FailureRate            float64 `json:"failure_rate" example:"0.029"`
+	AverageDurationSeconds float64 `json:"average_duration_seconds" example:"10.8"`
+	MaxDurationSeconds     float64 `json:"max_duration_seconds" example:"181.5"`
+}
+
+type JobMetricsResponse struct {
+	// From is the first day covered and To the day after the last one
+	From   time.Time                 `json:"from" example:"2024-03-01T00:00:00Z"`
+	To     time.Time                 `json:"to" example:"2024-04-01T00:00:00Z"`
+	Days   []DailyJobMetricsResponse `json:"days"`
+	Totals []JobTypeMetricsResponse  `json:"totals"`
+}
+
+// JobMetricsResponseFromMetrics converts job metrics to their response
+func JobMetricsResponseFromMetrics(metrics *usecase.JobMetrics) JobMetricsResponse {
+	response := JobMetricsResponse{
+		From:   metrics.From,
+		To:     metrics.To,
+		Days:   make([]DailyJobMetricsResponse, len(metrics.Days)),
+		Totals: make([]JobTypeMetricsResponse, len(metrics.Totals)),
+	}
+	for i, day := range metrics.Days {
+		response.Days[i] = dailyJobMetricsResponse(day)
+	}
+	for i, total := range metrics.Totals {
+		response.Totals[i] = JobTypeMetricsResponse{
+			JobType:                total.JobType,
+			Processed:              total.Processed,
+			Failed:                 total.Failed,
+			FailureRate:            total.FailureRate(),
+			AverageDurationSeconds: total.AverageDuration.Seconds(),
+			MaxDurationSeconds:     total.MaxDuration.Seconds(),
+		}
+	}
+	return response
+}
+
+func dailyJobMetricsResponse(metric *entity.JobMetric) DailyJobMetricsResponse {
+	return DailyJobMetricsResponse{
+		Day:                    metric.Day,
+		JobType:                metric.JobType,
+		Processed:              metric.Processed,
+		Failed:                 metric.Failed,
+		FailureRate:            metric.FailureRate(),
+		AverageDurationSeconds: metric.AverageDuration().Seconds(),
+		MaxDurationSeconds:     (time.Duration(metric.MaxDurationMs) * time.Millisecond).Seconds(),
+	}
+}
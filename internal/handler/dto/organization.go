@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Organization request DTOs
+type OrganizationCreateRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=255" example:"Platform Team"`
+	Slug        string `json:"slug" binding:"required,min=1,max=100" example:"platform-team"`
+	Description string `json:"description" binding:"max=1000" example:"Owns the platform services"`
+}
+
+type OrganizationUpdateRequest struct {
+	Name        *string `json:"name,omitempty" binding:"omitempty,min=1,max=255" example:"Platform Team"`
+	Description *string `json:"description,omitempty" binding:"omitempty,max=1000" example:"Owns the platform services"`
+}
+
+// Organization response DTOs
+type OrganizationResponse struct {
+	ID          uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name        string    `json:"name" example:"Platform Team"`
+	Slug        string    `json:"slug" example:"platform-team"`
+	Description string    `json:"description" example:"Owns the platform services"`
+	CreatedAt   time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type OrganizationListResponse struct {
+	Items []OrganizationResponse `json:"items"`
+	ListMeta
+}
+
+func OrganizationResponseFromEntity(org *entity.Organization) OrganizationResponse {
+	return OrganizationResponse{
+		ID:          org.ID,
+		Name:        org.Name,
+		Slug:        org.Slug,
+		Description: org.Description,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+	}
+}
+
+func OrganizationListResponseFromEntities(orgs []*entity.Organization, meta ListMeta) OrganizationListResponse {
+	responses := make([]OrganizationResponse, len(orgs))
+	for i, org := range orgs {
+		responses[i] = OrganizationResponseFromEntity(org)
+	}
+	return OrganizationListResponse{
+		Items:    responses,
+		ListMeta: meta,
+	}
+}
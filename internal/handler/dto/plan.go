@@ -14,6 +14,10 @@ type PlanResponse struct {
 	Status    string    `json:"status" example:"DRAFT"`
 	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt time.Time `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+	// Version is the version an edit of the plan was saved as
+	Version int `json:"version,omitempty" example:"2"`
+	// Feedback is why the plan was rejected
+	Feedback *string `json:"feedback,omitempty" example:"Reuse the existing session store instead of adding one"`
 }
 
 func (p *PlanResponse) FromEntity(plan *entity.Plan) {
@@ -23,4 +27,27 @@ func (p *PlanResponse) FromEntity(plan *entity.Plan) {
 	p.Status = string(plan.Status)
 	p.CreatedAt = plan.CreatedAt
 	p.UpdatedAt = plan.UpdatedAt
+	p.Feedback = plan.Feedback
+}
+
+// PlanVersionResponse is one version of a plan's content
+type PlanVersionResponse struct {
+	Version   int       `json:"version" example:"2"`
+	Content   string    `json:"content" example:"# Plan\n\nThis is a plan for a task"`
+	CreatedBy string    `json:"created_by" example:"alice"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+func PlanVersionResponseFromEntity(version *entity.PlanVersion) PlanVersionResponse {
+	return PlanVersionResponse{
+		Version:   version.Version,
+		Content:   version.Content,
+		CreatedBy: version.CreatedBy,
+		CreatedAt: version.CreatedAt,
+	}
+}
+
+type PlanVersionListResponse struct {
+	Items []PlanVersionResponse `json:"items"`
+	ListMeta
 }
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strings"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
@@ -14,6 +15,52 @@ type ProjectCreateRequest struct {
 	Description         string `json:"description" binding:"max=1000" example:"Project description"`
 	WorktreeBasePath    string `json:"worktree_base_path" binding:"required,max=500" example:"/tmp/projects/repo"`
 	InitWorkspaceScript string `json:"init_workspace_script" example:"npm install && npm run build"`
+	LintCommand         string `json:"lint_command" example:"npx prettier --write . && npx eslint ."`
+	AutoCommitLintFixes bool   `json:"auto_commit_lint_fixes" example:"true"`
+	BuildCommand        string `json:"build_command" example:"npm run build"`
+	TestCommand         string `json:"test_command" example:"npm test"`
+	TestFailurePolicy   string `json:"test_failure_policy" binding:"omitempty,oneof=block flag" example:"block"`
+	CoverageCommand     string `json:"coverage_command" example:"npx jest --coverage --coverageReporters=text-summary"`
+	SecurityScanner     string `json:"security_scanner" binding:"omitempty,oneof=gosec semgrep" example:"gosec"`
+	AIReviewEnabled     bool   `json:"ai_review_enabled" example:"true"`
+	PostReviewComments  bool   `json:"post_review_comments" example:"false"`
+	AutoFixAttempts     int    `json:"auto_fix_attempts" binding:"min=0,max=5" example:"2"`
+	PreviewEnabled      bool   `json:"preview_enabled" example:"true"`
+	PreviewCommand      string `json:"preview_command" example:"npm run dev -- --port $PORT"`
+
+	// Monthly AI spend budget in USD, 0 for none, and the percentages of it
+	// that raise an alert (80 and 100 when empty)
+	MonthlyBudgetUSD      float64 `json:"monthly_budget_usd" binding:"min=0" example:"200"`
+	BudgetAlertThresholds []int   `json:"budget_alert_thresholds" binding:"omitempty,dive,min=1,max=1000" example:"50,80,100"`
+
+	// Shell commands AI executions may run, any when empty, and commands
+	// they may never run, matched by prefix
+	AllowedCommands []string `json:"allowed_commands" binding:"omitempty,max=50,dive,min=1,max=200" example:"go test,make"`
+	DeniedCommands  []string `json:"denied_commands" binding:"omitempty,max=50,dive,min=1,max=200" example:"npm publish,curl,rm -rf /"`
+
+	// Encrypt the message bodies of execution logs at rest, needs
+	// SECRETS_ENCRYPTION_KEY
+	EncryptExecutionLogs bool `json:"encrypt_execution_logs" example:"false"`
+
+	// Path fragments making a task high-risk when its plan touches a
+	// matching path, the defaults when empty
+	HighRiskPaths []string `json:"high_risk_paths" binding:"omitempty,max=50,dive,min=1,max=200" example:"auth,payments/,migrations/"`
+
+	// Language of the project's notifications and generated pull request
+	// and commit texts, en when empty
+	Locale string `json:"locale" binding:"omitempty,oneof=en vi" example:"vi"`
+
+	// No remote git provider: attach the changes of tasks to them as a
+	// patch and a git bundle instead of opening pull requests
+	ExportPatches bool `json:"export_patches" example:"false"`
+
+	// Provider hosting the repository, where pull requests (merge requests
+	// on GitLab) are opened; detected from the repository URL when empty
+	GitProvider string `json:"git_provider,omitempty" binding:"omitempty,oneof=github gitlab" example:"gitlab"`
+
+	// Planning and implementation executions of the project running at
+	// once across the workers, 0 for no limit
+	MaxConcurrentExecutions int `json:"max_concurrent_executions" binding:"min=0" example:"2"`
 }
 
 type ProjectUpdateRequest struct {
@@ -22,6 +69,42 @@ type ProjectUpdateRequest struct {
 	RepositoryURL       *string `json:"repository_url,omitempty" binding:"omitempty,url,max=500" example:"https://github.com/user/repo.git"`
 	WorktreeBasePath    *string `json:"worktree_base_path,omitempty" binding:"omitempty,max=500" example:"/tmp/projects/repo"`
 	InitWorkspaceScript *string `json:"init_workspace_script,omitempty" example:"npm install && npm run build"`
+	LintCommand         *string `json:"lint_command,omitempty" example:"npx prettier --write . && npx eslint ."`
+	AutoCommitLintFixes *bool   `json:"auto_commit_lint_fixes,omitempty" example:"true"`
+	BuildCommand        *string `json:"build_command,omitempty" example:"npm run build"`
+	TestCommand         *string `json:"test_command,omitempty" example:"npm test"`
+	TestFailurePolicy   *string `json:"test_failure_policy,omitempty" binding:"omitempty,oneof=block flag" example:"block"`
+	CoverageCommand     *string `json:"coverage_command,omitempty" example:"npx jest --coverage --coverageReporters=text-summary"`
+	SecurityScanner     *string `json:"security_scanner,omitempty" binding:"omitempty,oneof=gosec semgrep none" example:"gosec"`
+	AIReviewEnabled     *bool   `json:"ai_review_enabled,omitempty" example:"true"`
+	PostReviewComments  *bool   `json:"post_review_comments,omitempty" example:"false"`
+	AutoFixAttempts     *int    `json:"auto_fix_attempts,omitempty" binding:"omitempty,min=0,max=5" example:"2"`
+	PreviewEnabled      *bool   `json:"preview_enabled,omitempty" example:"true"`
+	PreviewCommand      *string `json:"preview_command,omitempty" example:"npm run dev -- --port $PORT"`
+
+	// An empty list of thresholds restores the defaults
+	MonthlyBudgetUSD      *float64 `json:"monthly_budget_usd,omitempty" binding:"omitempty,min=0" example:"200"`
+	BudgetAlertThresholds []int    `json:"budget_alert_thresholds,omitempty" binding:"omitempty,dive,min=1,max=1000" example:"50,80,100"`
+
+	// An empty list clears the allowed or denied commands
+	AllowedCommands []string `json:"allowed_commands,omitempty" binding:"omitempty,max=50,dive,min=1,max=200" example:"go test,make"`
+	DeniedCommands  []string `json:"denied_commands,omitempty" binding:"omitempty,max=50,dive,min=1,max=200" example:"npm publish,curl,rm -rf /"`
+
+	// Logs already written stay as they are when this is changed
+	EncryptExecutionLogs *bool `json:"encrypt_execution_logs,omitempty" example:"true"`
+
+	// An empty list of high-risk paths restores the defaults
+	HighRiskPaths []string `json:"high_risk_paths,omitempty" binding:"omitempty,max=50,dive,min=1,max=200" example:"auth,payments/,migrations/"`
+
+	Locale *string `json:"locale,omitempty" binding:"omitempty,oneof=en vi" example:"vi"`
+
+	ExportPatches *bool `json:"export_patches,omitempty" example:"true"`
+
+	// "auto" detects the provider from the repository URL again
+	GitProvider *string `json:"git_provider,omitempty" binding:"omitempty,oneof=github gitlab auto" example:"gitlab"`
+
+	// Tasks already waiting for a slot are let through as slots free up
+	MaxConcurrentExecutions *int `json:"max_concurrent_executions,omitempty" binding:"omitempty,min=0" example:"2"`
 }
 
 type ActiveTaskCounts struct {
@@ -39,9 +122,36 @@ type ProjectResponse struct {
 	RepositoryURL       string         `json:"repository_url,omitempty" example:"https://github.com/user/repo.git"`
 	WorktreeBasePath    string         `json:"worktree_base_path,omitempty" example:"/tmp/projects/repo"`
 	InitWorkspaceScript string         `json:"init_workspace_script,omitempty" example:"npm install && npm run build"`
+	LintCommand         string         `json:"lint_command,omitempty" example:"npx prettier --write . && npx eslint ."`
+	AutoCommitLintFixes bool           `json:"auto_commit_lint_fixes" example:"true"`
+	BuildCommand        string         `json:"build_command,omitempty" example:"npm run build"`
+	TestCommand         string         `json:"test_command,omitempty" example:"npm test"`
+	TestFailurePolicy   string         `json:"test_failure_policy,omitempty" example:"block"`
+	CoverageCommand     string         `json:"coverage_command,omitempty" example:"npx jest --coverage --coverageReporters=text-summary"`
+	SecurityScanner     string         `json:"security_scanner,omitempty" example:"gosec"`
+	AIReviewEnabled     bool           `json:"ai_review_enabled" example:"true"`
+	PostReviewComments  bool           `json:"post_review_comments" example:"false"`
+	AutoFixAttempts     int            `json:"auto_fix_attempts" example:"2"`
+	PreviewEnabled      bool           `json:"preview_enabled" example:"true"`
+	PreviewCommand      string         `json:"preview_command,omitempty" example:"npm run dev -- --port $PORT"`
+	OrganizationID      *uuid.UUID     `json:"organization_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
 	CreatedAt           time.Time      `json:"created_at" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt           time.Time      `json:"updated_at" example:"2024-01-15T10:30:00Z"`
 	ActiveTaskCounts    ActiveTaskCounts `json:"active_task_counts"`
+
+	MonthlyBudgetUSD      float64 `json:"monthly_budget_usd" example:"200"`
+	BudgetAlertThresholds []int   `json:"budget_alert_thresholds" example:"80,100"`
+
+	AllowedCommands []string `json:"allowed_commands" example:"go test,make"`
+	DeniedCommands  []string `json:"denied_commands" example:"npm publish,curl,rm -rf /"`
+
+	EncryptExecutionLogs bool     `json:"encrypt_execution_logs" example:"false"`
+	HighRiskPaths        []string `json:"high_risk_paths" example:"auth,login,password,payment,billing,checkout"`
+	Locale               string   `json:"locale" example:"en"`
+	ExportPatches        bool     `json:"export_patches" example:"false"`
+	GitProvider          string   `json:"git_provider,omitempty" example:"gitlab"`
+
+	MaxConcurrentExecutions int `json:"max_concurrent_executions" example:"2"`
 }
 
 type ProjectWithTasksResponse struct {
@@ -50,10 +160,8 @@ type ProjectWithTasksResponse struct {
 }
 
 type ProjectListResponse struct {
-	Projects []ProjectResponse `json:"projects"`
-	Total    int               `json:"total"`
-	Page     int               `json:"page"`
-	PageSize int               `json:"page_size"`
+	Items []ProjectResponse `json:"items"`
+	ListMeta
 }
 
 type ProjectStatisticsResponse struct {
@@ -74,6 +182,9 @@ type ProjectSettingsResponse struct {
 	GitBranch            string    `json:"git_branch"`
 	GitAutoSync          bool      `json:"git_auto_sync"`
 	TaskPrefix           string    `json:"task_prefix"`
+	PromptPrefix         string    `json:"prompt_prefix,omitempty"`
+	PromptSuffix         string    `json:"prompt_suffix,omitempty"`
+	ContextFiles         []string  `json:"context_files"`
 	CreatedAt            time.Time `json:"created_at"`
 	UpdatedAt            time.Time `json:"updated_at"`
 }
@@ -86,6 +197,68 @@ type ProjectSettingsUpdateRequest struct {
 	GitBranch            *string `json:"git_branch,omitempty"`
 	GitAutoSync          *bool   `json:"git_auto_sync,omitempty"`
 	TaskPrefix           *string `json:"task_prefix,omitempty"`
+	// PromptPrefix and PromptSuffix are added before and after the prompt of
+	// every AI run on the project's tasks
+	PromptPrefix *string `json:"prompt_prefix,omitempty" binding:"omitempty,max=10000" example:"Follow the conventions in docs/CONVENTIONS.md."`
+	PromptSuffix *string `json:"prompt_suffix,omitempty" binding:"omitempty,max=10000" example:"Never edit generated files."`
+	// ContextFiles are repository paths of files quoted in those prompts;
+	// left unchanged when omitted
+	ContextFiles []string `json:"context_files,omitempty" example:"docs/ARCHITECTURE.md,CONTRIBUTING.md"`
+}
+
+// ProjectSpendQuery selects the month of a spend report, the current one by
+// default
+type ProjectSpendQuery struct {
+	Month  *time.Time `form:"month" time_format:"2006-01" time_utc:"1" example:"2024-03"`
+	Format string     `form:"format" binding:"omitempty,oneof=json csv pdf" example:"pdf"`
+}
+
+// ExecutorSpendResponse is the AI spend of one executor
+type ExecutorSpendResponse struct {
+	AIType       string  `json:"ai_type" example:"claude-code"`
+	Executions   int64   `json:"executions" example:"42"`
+	InputTokens  int64   `json:"input_tokens" example:"1250000"`
+	OutputTokens int64   `json:"output_tokens" example:"86000"`
+	CostUSD      float64 `json:"cost_usd" example:"57.31"`
+}
+
+// ProjectSpendResponse is a project's AI spend in a calendar month (UTC)
+type ProjectSpendResponse struct {
+	ProjectID         uuid.UUID               `json:"project_id"`
+	Month             string                  `json:"month" example:"2024-03"`
+	Executions        int64                   `json:"executions" example:"58"`
+	InputTokens       int64                   `json:"input_tokens" example:"1730000"`
+	OutputTokens      int64                   `json:"output_tokens" example:"112000"`
+	CostUSD           float64                 `json:"cost_usd" example:"81.07"`
+	ByExecutor        []ExecutorSpendResponse `json:"by_executor"`
+	BudgetUSD         float64                 `json:"budget_usd" example:"200"`
+	BudgetUsedPercent *float64                `json:"budget_used_percent,omitempty" example:"40.5"`
+	AlertThresholds   []int                   `json:"alert_thresholds" example:"80,100"`
+}
+
+func ProjectSpendResponseFromUsecase(spend *usecase.MonthlySpend) ProjectSpendResponse {
+	response := ProjectSpendResponse{
+		ProjectID:         spend.ProjectID,
+		Month:             spend.Month.Format("2006-01"),
+		Executions:        spend.Executions,
+		InputTokens:       spend.InputTokens,
+		OutputTokens:      spend.OutputTokens,
+		CostUSD:           spend.CostUSD,
+		ByExecutor:        make([]ExecutorSpendResponse, len(spend.ByExecutor)),
+		BudgetUSD:         spend.BudgetUSD,
+		BudgetUsedPercent: spend.BudgetUsedPercent,
+		AlertThresholds:   spend.AlertThresholds,
+	}
+	for i, executor := range spend.ByExecutor {
+		response.ByExecutor[i] = ExecutorSpendResponse{
+			AIType:       executor.AIType,
+			Executions:   executor.Executions,
+			InputTokens:  executor.InputTokens,
+			OutputTokens: executor.OutputTokens,
+			CostUSD:      executor.CostUSD,
+		}
+	}
+	return response
 }
 
 type UpdateRepositoryURLRequest struct {
@@ -118,8 +291,32 @@ func (p *ProjectResponse) FromEntity(project *entity.Project) {
 	p.RepositoryURL = project.RepositoryURL
 	p.WorktreeBasePath = project.WorktreeBasePath
 	p.InitWorkspaceScript = project.InitWorkspaceScript
+	p.LintCommand = project.LintCommand
+	p.AutoCommitLintFixes = project.AutoCommitLintFixes
+	p.BuildCommand = project.BuildCommand
+	p.TestCommand = project.TestCommand
+	p.TestFailurePolicy = string(project.TestFailurePolicy)
+	p.CoverageCommand = project.CoverageCommand
+	p.SecurityScanner = string(project.SecurityScanner)
+	p.AIReviewEnabled = project.AIReviewEnabled
+	p.PostReviewComments = project.PostReviewComments
+	p.AutoFixAttempts = project.AutoFixAttempts
+	p.PreviewEnabled = project.PreviewEnabled
+	p.PreviewCommand = project.PreviewCommand
+	p.OrganizationID = project.OrganizationID
 	p.CreatedAt = project.CreatedAt
 	p.UpdatedAt = project.UpdatedAt
+	p.MonthlyBudgetUSD = project.MonthlyBudgetUSD
+	p.BudgetAlertThresholds = project.AlertThresholds()
+	policy := project.CommandPolicy()
+	p.AllowedCommands = policy.Allowed
+	p.DeniedCommands = policy.Denied
+	p.EncryptExecutionLogs = project.EncryptExecutionLogs
+	p.HighRiskPaths = project.HighRiskPathPatterns()
+	p.Locale = project.Locale
+	p.ExportPatches = project.ExportPatches
+	p.GitProvider = string(project.GitProvider)
+	p.MaxConcurrentExecutions = project.MaxConcurrentExecutions
 }
 
 func (p *ProjectWithTasksResponse) FromEntity(project *entity.Project) {
@@ -142,10 +339,8 @@ func ProjectListResponseFromEntities(projects []*entity.Project) ProjectListResp
 		responses[i] = ProjectResponseFromEntity(project)
 	}
 	return ProjectListResponse{
-		Projects: responses,
-		Total:    len(projects),
-		Page:     1,
-		PageSize: len(projects),
+		Items:    responses,
+		ListMeta: NewListMeta(len(projects), 1, 0),
 	}
 }
 
@@ -164,10 +359,8 @@ func ProjectListResponseFromResult(result *usecase.GetProjectsResult) ProjectLis
 		responses[i] = resp
 	}
 	return ProjectListResponse{
-		Projects: responses,
-		Total:    result.Total,
-		Page:     result.Page,
-		PageSize: result.PageSize,
+		Items:    responses,
+		ListMeta: NewListMeta(result.Total, result.Page, result.PageSize),
 	}
 }
 
@@ -206,6 +399,9 @@ func ProjectSettingsResponseFromEntity(settings *entity.ProjectSettings) Project
 		GitBranch:            settings.GitBranch,
 		GitAutoSync:          settings.GitAutoSync,
 		TaskPrefix:           settings.TaskPrefix,
+		PromptPrefix:         settings.PromptPrefix,
+		PromptSuffix:         settings.PromptSuffix,
+		ContextFiles:         settings.ContextFileList(),
 		CreatedAt:            settings.CreatedAt,
 		UpdatedAt:            settings.UpdatedAt,
 	}
@@ -234,9 +430,9 @@ func GitStatusResponseFromUsecase(status *usecase.GitStatus) GitStatusResponse {
 	return response
 }
 
-func (req *ProjectSettingsUpdateRequest) ToEntity() *entity.ProjectSettings {
-	settings := &entity.ProjectSettings{}
-
+// ApplyTo sets the fields of the request on settings, leaving the omitted
+// ones unchanged
+func (req *ProjectSettingsUpdateRequest) ApplyTo(settings *entity.ProjectSettings) {
 	if req.AutoArchiveDays != nil {
 		settings.AutoArchiveDays = req.AutoArchiveDays
 	}
@@ -258,6 +454,112 @@ func (req *ProjectSettingsUpdateRequest) ToEntity() *entity.ProjectSettings {
 	if req.TaskPrefix != nil {
 		settings.TaskPrefix = *req.TaskPrefix
 	}
+	if req.PromptPrefix != nil {
+		settings.PromptPrefix = *req.PromptPrefix
+	}
+	if req.PromptSuffix != nil {
+		settings.PromptSuffix = *req.PromptSuffix
+	}
+	if req.ContextFiles != nil {
+		settings.ContextFiles = strings.Join(req.ContextFiles, "\n")
+	}
+}
+
+// GitHubTokenRequest sets the GitHub token of a project
+type GitHubTokenRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// GitHubTokenResponse tells whether a project has its own GitHub token; the
+// token itself is never returned
+type GitHubTokenResponse struct {
+	Configured bool `json:"configured"`
+}
+
+// ProjectActivityResponse is an entry of a project's activity feed. Only
+// the fields of its type are set.
+type ProjectActivityResponse struct {
+	Type       string    `json:"type" example:"status_changed" enums:"task_created,status_changed,plan_approved,execution_started,execution_finished,pr_opened,pr_merged,pr_closed"`
+	TaskID     uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TaskTitle  string    `json:"task_title" example:"Fix invoice rounding"`
+	OccurredAt time.Time `json:"occurred_at" example:"2024-01-15T10:30:00Z"`
+	Actor      *string   `json:"actor,omitempty" example:"alice"`
+
+	FromStatus *entity.TaskStatus `json:"from_status,omitempty" example:"TODO"`
+	ToStatus   *entity.TaskStatus `json:"to_status,omitempty" example:"PLANNING"`
+	Reason     *string            `json:"reason,omitempty"`
+
+	PlanID *uuid.UUID `json:"plan_id,omitempty"`
 
-	return settings
+	ExecutionID     *uuid.UUID              `json:"execution_id,omitempty"`
+	ExecutionStatus *entity.ExecutionStatus `json:"execution_status,omitempty" example:"completed"`
+	AIType          *string                 `json:"ai_type,omitempty" example:"claude-code"`
+
+	PullRequestNumber *int    `json:"pull_request_number,omitempty" example:"42"`
+	PullRequestURL    *string `json:"pull_request_url,omitempty" example:"https://github.com/acme/billing/pull/42"`
+}
+
+// ProjectActivityListResponse is a page of a project's activity feed, most
+// recent first
+type ProjectActivityListResponse struct {
+	ProjectID uuid.UUID                 `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items     []ProjectActivityResponse `json:"items"`
+	ListMeta
+}
+
+func ProjectActivityListResponseFromEntities(projectID uuid.UUID, activity []*entity.ProjectActivity, meta ListMeta) ProjectActivityListResponse {
+	items := make([]ProjectActivityResponse, len(activity))
+	for i, entry := range activity {
+		items[i] = ProjectActivityResponse{
+			Type:              string(entry.Type),
+			TaskID:            entry.TaskID,
+			TaskTitle:         entry.TaskTitle,
+			OccurredAt:        entry.OccurredAt,
+			Actor:             entry.Actor,
+			FromStatus:        entry.FromStatus,
+			ToStatus:          entry.ToStatus,
+			Reason:            entry.Reason,
+			PlanID:            entry.PlanID,
+			ExecutionID:       entry.ExecutionID,
+			ExecutionStatus:   entry.ExecutionStatus,
+			AIType:            entry.AIType,
+			PullRequestNumber: entry.PullRequestNumber,
+			PullRequestURL:    entry.PullRequestURL,
+		}
+	}
+	return ProjectActivityListResponse{
+		ProjectID: projectID,
+		Items:     items,
+		ListMeta:  meta,
+	}
+}
+
+// ProjectMemberRequest gives a user a role in a project
+type ProjectMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=owner maintainer reviewer viewer" example:"maintainer"`
+}
+
+// ProjectMemberResponse is a user's membership of a project
+type ProjectMemberResponse struct {
+	UserID    uuid.UUID `json:"user_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Username  string    `json:"username" example:"alice"`
+	Role      string    `json:"role" example:"maintainer"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type ProjectMemberListResponse struct {
+	Items []ProjectMemberResponse `json:"items"`
+	ListMeta
+}
+
+func ProjectMemberResponseFromEntity(member *entity.ProjectMember) ProjectMemberResponse {
+	response := ProjectMemberResponse{
+		UserID:    member.UserID,
+		Role:      string(member.Role),
+		CreatedAt: member.CreatedAt,
+	}
+	if member.User != nil {
+		response.Username = member.User.Username
+	}
+	return response
 }
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type CreateReleaseNotesRequest struct {
+	Version string `json:"version" binding:"max=100" example:"v1.4.0"`
+	// Source is what the notes are written from, the completed tasks by
+	// default
+	Source string `json:"source" binding:"omitempty,oneof=tasks pull_requests" example:"tasks"`
+	// From and To bound when the tasks were completed or the pull requests
+	// merged
+	From   time.Time `json:"from" binding:"required" example:"2024-03-01T00:00:00Z"`
+	To     time.Time `json:"to" binding:"required" example:"2024-03-31T23:59:59Z"`
+	AIType string    `json:"ai_type" binding:"max=50" example:"claude-code"`
+}
+
+type ReleaseNotesResponse struct {
+	ID          uuid.UUID                 `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID   uuid.UUID                 `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Version     string                    `json:"version,omitempty" example:"v1.4.0"`
+	Source      entity.ReleaseNotesSource `json:"source" example:"tasks"`
+	From        time.Time                 `json:"from" example:"2024-03-01T00:00:00Z"`
+	To          time.Time                 `json:"to" example:"2024-03-31T23:59:59Z"`
+	AIType      string                    `json:"ai_type" example:"claude-code"`
+	Status      entity.ReleaseNotesStatus `json:"status" example:"completed"`
+	Changes     int                       `json:"changes" example:"14"`
+	Content     string                    `json:"content,omitempty" example:"# Release notes for v1.4.0"`
+	Error       string                    `json:"error,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	CompletedAt *time.Time                `json:"completed_at,omitempty"`
+}
+
+type ReleaseNotesListResponse struct {
+	ProjectID uuid.UUID              `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items     []ReleaseNotesResponse `json:"items"`
+	ListMeta
+}
+
+func ReleaseNotesResponseFromEntity(notes *entity.ReleaseNotes) ReleaseNotesResponse {
+	return ReleaseNotesResponse{
+		ID:          notes.ID,
+		ProjectID:   notes.ProjectID,
+		Version:     notes.Version,
+		Source:      notes.Source,
+		From:        notes.From,
+		To:          notes.To,
+		AIType:      notes.AIType,
+		Status:      notes.Status,
+		Changes:     notes.Changes,
+		Content:     notes.Content,
+		Error:       notes.Error,
+		CreatedAt:   notes.CreatedAt,
+		CompletedAt: notes.CompletedAt,
+	}
+}
+
+func ReleaseNotesListResponseFromEntities(projectID uuid.UUID, notes []*entity.ReleaseNotes, meta ListMeta) ReleaseNotesListResponse {
+	items := make([]ReleaseNotesResponse, len(notes))
+	for i, n := range notes {
+		items[i] = ReleaseNotesResponseFromEntity(n)
+	}
+	return ReleaseNotesListResponse{
+		ProjectID: projectID,
+		Items:     items,
+		ListMeta:  meta,
+	}
+}
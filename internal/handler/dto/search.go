@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// SearchQuery is a quick search across projects, tasks, plans and pull
+// requests
+type SearchQuery struct {
+	Q     string `form:"q" binding:"required,max=200" example:"invoice"`
+	Limit int    `form:"limit,default=20" binding:"min=1,max=50" example:"20"`
+}
+
+// SearchResultResponse is a quick search result. Tasks, plans and pull
+// requests carry the ID and key of their task, and pull requests their
+// number.
+type SearchResultResponse struct {
+	Type        string     `json:"type" example:"task" enums:"project,task,plan,pull_request"`
+	ID          uuid.UUID  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID   uuid.UUID  `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectName string     `json:"project_name" example:"Billing Service"`
+	TaskID      *uuid.UUID `json:"task_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TaskKey     *string    `json:"task_key,omitempty" example:"AD-42"`
+	Title       string     `json:"title" example:"Fix invoice rounding"`
+	Snippet     string     `json:"snippet,omitempty" example:"…rounds each invoice line before summing…"`
+	Status      string     `json:"status,omitempty" example:"IMPLEMENTING"`
+	PRNumber    *int       `json:"pr_number,omitempty" example:"42"`
+	Score       float64    `json:"score" example:"0.8"`
+	UpdatedAt   time.Time  `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// SearchResponse lists quick search results, best match first
+type SearchResponse struct {
+	Query string                 `json:"query" example:"invoice"`
+	Items []SearchResultResponse `json:"items"`
+	ListMeta
+}
+
+func SearchResponseFromEntities(query string, results []*entity.SearchResult) SearchResponse {
+	items := make([]SearchResultResponse, len(results))
+	for i, result := range results {
+		items[i] = SearchResultResponse{
+			Type:        string(result.Type),
+			ID:          result.ID,
+			ProjectID:   result.ProjectID,
+			ProjectName: result.ProjectName,
+			TaskID:      result.TaskID,
+			Title:       result.Title,
+			Snippet:     result.Snippet,
+			Status:      result.Status,
+			PRNumber:    result.PullRequestNumber,
+			Score:       result.Score,
+			UpdatedAt:   result.UpdatedAt,
+		}
+		if result.TaskNumber != nil {
+			key := entity.TaskKey(*result.TaskNumber)
+			items[i].TaskKey = &key
+		}
+	}
+	return SearchResponse{
+		Query:    query,
+		Items:    items,
+		ListMeta: NewListMeta(len(items), 1, 0),
+	}
+}
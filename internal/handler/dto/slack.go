@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/slack"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// Slack integration request DTOs
+type SlackIntegrationRequest struct {
+	// WebhookURL and BotToken are write-only; give one to switch how
+	// notifications are posted, or neither to keep the stored one
+	WebhookURL *string `json:"webhook_url,omitempty" binding:"omitempty,url,max=500" example:"https://hooks.slack.com/services/T000/B000/XXXX"`
+	BotToken   *string `json:"bot_token,omitempty" binding:"omitempty,min=1" example:"xoxb-1234-5678"`
+	// Channel is required with a bot token
+	Channel     string `json:"channel" binding:"max=80" example:"#auto-devs"`
+	Interactive bool   `json:"interactive" example:"true"`
+}
+
+// Slack integration response DTOs
+type SlackIntegrationResponse struct {
+	ID          uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID   uuid.UUID            `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Delivery    entity.SlackDelivery `json:"delivery" example:"webhook"`
+	Channel     string               `json:"channel,omitempty" example:"#auto-devs"`
+	Interactive bool                 `json:"interactive" example:"true"`
+	CreatedAt   time.Time            `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt   time.Time            `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+func SlackIntegrationResponseFromEntity(integration *entity.SlackIntegration) SlackIntegrationResponse {
+	return SlackIntegrationResponse{
+		ID:          integration.ID,
+		ProjectID:   integration.ProjectID,
+		Delivery:    integration.Delivery,
+		Channel:     integration.Channel,
+		Interactive: integration.Interactive,
+		CreatedAt:   integration.CreatedAt,
+		UpdatedAt:   integration.UpdatedAt,
+	}
+}
+
+// UpdateNotificationPreferencesRequest mutes (true) or unmutes (false)
+// types of notification; types left out keep their setting
+type UpdateNotificationPreferencesRequest struct {
+	Muted map[entity.NotificationType]bool `json:"muted" binding:"required" example:"TASK_CREATED:true"`
+}
+
+type NotificationPreferenceResponse struct {
+	Channel   entity.NotificationChannel `json:"channel" example:"slack"`
+	Type      entity.NotificationType    `json:"type" example:"PLAN_READY"`
+	Muted     bool                       `json:"muted" example:"false"`
+	UpdatedBy *string                    `json:"updated_by,omitempty" example:"alice"`
+	UpdatedAt *time.Time                 `json:"updated_at,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+type NotificationPreferenceListResponse struct {
+	Data []NotificationPreferenceResponse `json:"data"`
+}
+
+func NotificationPreferenceListResponseFromEntities(preferences []*entity.NotificationPreference) NotificationPreferenceListResponse {
+	data := make([]NotificationPreferenceResponse, 0, len(preferences))
+	for _, preference := range preferences {
+		response := NotificationPreferenceResponse{
+			Channel:   preference.Channel,
+			Type:      preference.Type,
+			Muted:     preference.Muted,
+			UpdatedBy: preference.UpdatedBy,
+		}
+		// Types never changed are not stored
+		if !preference.UpdatedAt.IsZero() {
+			updatedAt := preference.UpdatedAt
+			response.UpdatedAt = &updatedAt
+		}
+		data = append(data, response)
+	}
+	return NotificationPreferenceListResponse{Data: data}
+}
+
+// SlackInteractionPayload is the part of a Slack block_actions payload
+// auto-devs reads
+type SlackInteractionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+	Message     struct {
+		Blocks []slack.Block `json:"blocks"`
+	} `json:"message"`
+}
+
+// ToInteraction returns the first action of the payload, if it has one
+func (p SlackInteractionPayload) ToInteraction() (usecase.SlackInteraction, bool) {
+	if p.Type != "block_actions" || len(p.Actions) == 0 {
+		return usecase.SlackInteraction{}, false
+	}
+	userName := p.User.Username
+	if userName == "" {
+		userName = p.User.Name
+	}
+	return usecase.SlackInteraction{
+		ActionID:    p.Actions[0].ActionID,
+		Value:       p.Actions[0].Value,
+		UserName:    userName,
+		ResponseURL: p.ResponseURL,
+		Blocks:      p.Message.Blocks,
+	}, true
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/google/uuid"
 )
 
@@ -13,6 +14,11 @@ type TaskCreateRequest struct {
 	Title        string    `json:"title" binding:"required,min=1,max=255" example:"Implement user authentication"`
 	Description  string    `json:"description" binding:"max=5000" example:"Add JWT-based authentication system"`
 	KanbanTaskID *string   `json:"kanban_task_id,omitempty" binding:"omitempty,max=64" example:"a1b2c3d4"`
+	// EpicID groups the task under an epic of the same project
+	EpicID *uuid.UUID `json:"epic_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// WorkflowType adjusts the task's prompts and verification with the
+	// project's preset of that type
+	WorkflowType entity.WorkflowType `json:"workflow_type,omitempty" binding:"omitempty,oneof=feature bugfix refactor" example:"bugfix"`
 }
 
 type TaskUpdateRequest struct {
@@ -21,6 +27,15 @@ type TaskUpdateRequest struct {
 	Status      *entity.TaskStatus `json:"status,omitempty" binding:"omitempty,oneof=TODO PLANNING PLAN_REVIEWING IMPLEMENTING CODE_REVIEWING DONE CANCELLED" example:"TODO"`
 	BranchName  *string            `json:"branch_name,omitempty" binding:"omitempty,max=255" example:"feature/user-auth"`
 	PullRequest *string            `json:"pull_request,omitempty" binding:"omitempty,max=255" example:"https://github.com/user/repo/pull/123"`
+	AssignedTo  *string            `json:"assigned_to,omitempty" binding:"omitempty,max=255" example:"user-123"`
+	DueDate     *time.Time         `json:"due_date,omitempty" example:"2024-02-01T17:00:00Z"`
+	// HighRiskReason marks the task high-risk, so its plan needs step-up
+	// approval. A high-risk task stays high-risk.
+	HighRiskReason *string `json:"high_risk_reason,omitempty" binding:"omitempty,max=500" example:"Changes how refunds are paid out"`
+	// EpicID moves the task under an epic of the same project
+	EpicID *uuid.UUID `json:"epic_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// ClearFields resets the listed fields; omitted fields are left unchanged
+	ClearFields []string `json:"clear_fields,omitempty" binding:"omitempty,dive,oneof=description assigned_to due_date estimated_hours actual_hours tags epic_id" example:"due_date,assigned_to"`
 }
 
 type TaskStatusUpdateRequest struct {
@@ -39,6 +54,42 @@ type BulkStatusUpdateRequest struct {
 	ChangedBy *string           `json:"changed_by,omitempty" example:"user123"`
 }
 
+// BulkTaskOperationRequest selects the tasks of a bulk delete or archive
+type BulkTaskOperationRequest struct {
+	TaskIDs []uuid.UUID `json:"task_ids" binding:"required,min=1" example:"[\"123e4567-e89b-12d3-a456-426614174000\"]"`
+}
+
+// UndoOperationResponse describes a bulk delete or archive and the token
+// that undoes it until expires_at
+type UndoOperationResponse struct {
+	Operation entity.UndoOperationType `json:"operation" example:"bulk_delete"`
+	ProjectID uuid.UUID                `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// TaskIDs are the tasks the operation changed; selected tasks of other
+	// projects, or already archived, are left out
+	TaskIDs       []uuid.UUID `json:"task_ids"`
+	AffectedCount int         `json:"affected_count" example:"3"`
+	UndoToken     string      `json:"undo_token" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	ExpiresAt     time.Time   `json:"expires_at"`
+	UndoneAt      *time.Time  `json:"undone_at,omitempty"`
+}
+
+// UndoOperationResponseFromEntity converts an undo operation to its response
+func UndoOperationResponseFromEntity(operation *entity.UndoOperation) UndoOperationResponse {
+	taskIDs := operation.TaskIDs
+	if taskIDs == nil {
+		taskIDs = []uuid.UUID{}
+	}
+	return UndoOperationResponse{
+		Operation:     operation.Operation,
+		ProjectID:     operation.ProjectID,
+		TaskIDs:       taskIDs,
+		AffectedCount: len(taskIDs),
+		UndoToken:     operation.Token,
+		ExpiresAt:     operation.ExpiresAt,
+		UndoneAt:      operation.UndoneAt,
+	}
+}
+
 type TaskAdvancedFilterQuery struct {
 	ProjectID     *string    `form:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
 	Status        *string    `form:"status" example:"TODO"`
@@ -54,19 +105,41 @@ type TaskAdvancedFilterQuery struct {
 
 // Task response DTOs
 type TaskResponse struct {
-	ID           uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	ProjectID    uuid.UUID            `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Title        string               `json:"title" example:"Implement user authentication"`
-	Description  string               `json:"description" example:"Add JWT-based authentication system"`
-	Status       entity.TaskStatus    `json:"status" example:"TODO"`
-	GitStatus    entity.TaskGitStatus `json:"git_status" example:"none"`
-	BranchName   *string              `json:"branch_name,omitempty" example:"feature/user-auth"`
-	PullRequest  *string              `json:"pull_request,omitempty" example:"https://github.com/user/repo/pull/123"`
-	WorktreePath *string              `json:"worktree_path,omitempty" example:"/tmp/worktrees/task-123"`
-	KanbanTaskID *string              `json:"kanban_task_id,omitempty" example:"a1b2c3d4"`
-	ErrorLogs    []string             `json:"error_logs,omitempty"`
-	CreatedAt    time.Time            `json:"created_at" example:"2024-01-15T10:30:00Z"`
-	UpdatedAt    time.Time            `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+	ID                    uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Number                int64                `json:"number,omitempty" example:"42"`
+	Key                   string               `json:"key,omitempty" example:"AD-42"` // Reference commit messages with this key
+	ProjectID             uuid.UUID            `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title                 string               `json:"title" example:"Implement user authentication"`
+	Description           string               `json:"description" example:"Add JWT-based authentication system"`
+	Status                entity.TaskStatus    `json:"status" example:"TODO"`
+	GitStatus             entity.TaskGitStatus `json:"git_status" example:"none"`
+	BranchName            *string              `json:"branch_name,omitempty" example:"feature/user-auth"`
+	PullRequest           *string              `json:"pull_request,omitempty" example:"https://github.com/user/repo/pull/123"`
+	WorktreePath          *string              `json:"worktree_path,omitempty" example:"/tmp/worktrees/task-123"`
+	PreviewURL            *string              `json:"preview_url,omitempty" example:"http://localhost:4100"`
+	KanbanTaskID          *string              `json:"kanban_task_id,omitempty" example:"a1b2c3d4"`
+	JiraIssueKey          *string              `json:"jira_issue_key,omitempty" example:"PROJ-123"`
+	GitHubProjectItemID   *string              `json:"github_project_item_id,omitempty" example:"PVTI_lADOAxk3Nc4AZ1bXzgLmH0o"`
+	GitHubIssueRepository *string              `json:"github_issue_repository,omitempty" example:"acme/app"`
+	GitHubIssueNumber     *int                 `json:"github_issue_number,omitempty" example:"42"`
+	AssignedTo            *string              `json:"assigned_to,omitempty" example:"user-123"`
+	DueDate               *time.Time           `json:"due_date,omitempty" example:"2024-02-01T17:00:00Z"`
+	EpicID                *uuid.UUID           `json:"epic_id,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WorkflowType          entity.WorkflowType  `json:"workflow_type,omitempty" example:"bugfix"`
+	ErrorLogs             []string             `json:"error_logs,omitempty"`
+	CreatedAt             time.Time            `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt             time.Time            `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+
+	// High-risk tasks need step-up approval of their plan
+	HighRisk       bool    `json:"high_risk" example:"false"`
+	HighRiskReason *string `json:"high_risk_reason,omitempty" example:"Plan touches internal/auth/session.go"`
+
+	// PlanningIterations counts the planning runs, one more per plan
+	// rejected with feedback
+	PlanningIterations int `json:"planning_iterations" example:"1"`
+	// QueuePosition is the task's place in the queue of tasks waiting for
+	// one of their project's execution slots, 1 being next
+	QueuePosition *int `json:"queue_position,omitempty" example:"2"`
 }
 
 type TaskWithProjectResponse struct {
@@ -75,12 +148,45 @@ type TaskWithProjectResponse struct {
 }
 
 type TaskListResponse struct {
-	Tasks []TaskResponse `json:"tasks"`
-	Total int            `json:"total"`
+	Items []TaskResponse `json:"items"`
+	ListMeta
 }
 
 type TaskPlansResponse struct {
-	Plans []PlanResponse `json:"plans"`
+	Items []PlanResponse `json:"items"`
+	ListMeta
+}
+
+// TaskFullResponse is a task with everything its detail view shows, so the
+// view needs a single request
+type TaskFullResponse struct {
+	Task            TaskResponse              `json:"task"`
+	LatestPlan      *PlanResponse             `json:"latest_plan,omitempty"`
+	LatestExecution *ExecutionSummaryResponse `json:"latest_execution,omitempty"`
+	PullRequest     *entity.PullRequest       `json:"pull_request,omitempty"`
+	Comments        []TaskCommentResponse     `json:"comments"`
+}
+
+// ExecutionSummaryResponse is the state of an execution without its result,
+// with how many log lines it has
+type ExecutionSummaryResponse struct {
+	ID          uuid.UUID              `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status      entity.ExecutionStatus `json:"status" example:"RUNNING"`
+	AIType      string                 `json:"ai_type,omitempty" example:"claude-code"`
+	StartedAt   time.Time              `json:"started_at" example:"2024-01-01T00:00:00Z"`
+	CompletedAt *time.Time             `json:"completed_at,omitempty" example:"2024-01-01T01:00:00Z"`
+	Progress    float64                `json:"progress" example:"0.75"`
+	Error       string                 `json:"error,omitempty" example:"Process failed"`
+	LogCount    int64                  `json:"log_count" example:"128"`
+	UpdatedAt   time.Time              `json:"updated_at" example:"2024-01-01T00:00:00Z"`
+}
+
+type TaskCommentResponse struct {
+	ID        uuid.UUID `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Comment   string    `json:"comment" example:"Login still fails on Safari"`
+	CreatedBy string    `json:"created_by" example:"alice"`
+	CreatedAt time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-01-15T10:30:00Z"`
 }
 
 type TasksByStatusResponse struct {
@@ -97,6 +203,8 @@ type TaskStatusHistoryResponse struct {
 	ChangedBy  *string            `json:"changed_by,omitempty" example:"user123"`
 	Reason     *string            `json:"reason,omitempty" example:"Requirements changed"`
 	CreatedAt  time.Time          `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	// PlanningIteration numbers the changes to PLANNING, from 1
+	PlanningIteration int `json:"planning_iteration,omitempty" example:"2"`
 }
 
 type TaskStatusAnalyticsResponse struct {
@@ -136,6 +244,10 @@ type TaskGitStatusValidationResponse struct {
 // Helper functions to convert between entity and DTO
 func (t *TaskResponse) FromEntity(task *entity.Task) {
 	t.ID = task.ID
+	if task.Number > 0 {
+		t.Number = task.Number
+		t.Key = entity.TaskKey(task.Number)
+	}
 	t.ProjectID = task.ProjectID
 	t.Title = task.Title
 	t.Description = task.Description
@@ -144,8 +256,21 @@ func (t *TaskResponse) FromEntity(task *entity.Task) {
 	t.BranchName = task.BranchName
 	t.PullRequest = task.PullRequest
 	t.WorktreePath = task.WorktreePath
+	t.PreviewURL = task.PreviewURL
 	t.KanbanTaskID = task.KanbanTaskID
+	t.JiraIssueKey = task.JiraIssueKey
+	t.GitHubProjectItemID = task.GitHubProjectItemID
+	t.GitHubIssueRepository = task.GitHubIssueRepository
+	t.GitHubIssueNumber = task.GitHubIssueNumber
+	t.AssignedTo = task.AssignedTo
+	t.DueDate = task.DueDate
+	t.EpicID = task.EpicID
+	t.WorkflowType = task.WorkflowType
 	t.ErrorLogs = task.ErrorLogEntries
+	t.HighRisk = task.HighRisk
+	t.HighRiskReason = task.HighRiskReason
+	t.PlanningIterations = task.PlanningIterations
+	t.QueuePosition = task.QueuePosition
 	t.CreatedAt = task.CreatedAt
 	t.UpdatedAt = task.UpdatedAt
 }
@@ -163,15 +288,198 @@ func TaskResponseFromEntity(task *entity.Task) TaskResponse {
 	return resp
 }
 
-func TaskListResponseFromEntities(tasks []*entity.Task) TaskListResponse {
+func TaskListResponseFromEntities(tasks []*entity.Task, meta ListMeta) TaskListResponse {
 	responses := make([]TaskResponse, len(tasks))
 	for i, task := range tasks {
 		responses[i] = TaskResponseFromEntity(task)
 	}
 	return TaskListResponse{
-		Tasks: responses,
-		Total: len(tasks),
+		Items:    responses,
+		ListMeta: meta,
+	}
+}
+
+func TaskFullResponseFromDetail(detail *entity.TaskDetail) TaskFullResponse {
+	resp := TaskFullResponse{
+		Task:     TaskResponseFromEntity(detail.Task),
+		Comments: make([]TaskCommentResponse, len(detail.Task.Comments)),
+	}
+	if plan := detail.LatestPlan(); plan != nil {
+		resp.LatestPlan = &PlanResponse{}
+		resp.LatestPlan.FromEntity(plan)
+	}
+	if execution := detail.LatestExecution(); execution != nil {
+		resp.LatestExecution = &ExecutionSummaryResponse{
+			ID:          execution.ID,
+			Status:      execution.Status,
+			AIType:      execution.AIType,
+			StartedAt:   execution.StartedAt,
+			CompletedAt: execution.CompletedAt,
+			Progress:    execution.Progress,
+			Error:       execution.ErrorMessage,
+			LogCount:    detail.LatestExecutionLogCount,
+			UpdatedAt:   execution.UpdatedAt,
+		}
+	}
+	resp.PullRequest = detail.PullRequest()
+	for i, comment := range detail.Task.Comments {
+		resp.Comments[i] = TaskCommentResponse{
+			ID:        comment.ID,
+			Comment:   comment.Comment,
+			CreatedBy: comment.CreatedBy,
+			CreatedAt: comment.CreatedAt,
+			UpdatedAt: comment.UpdatedAt,
+		}
+	}
+	return resp
+}
+
+// TaskBoardResponse is a project's Kanban board, one column per task status
+// in workflow order
+type TaskBoardResponse struct {
+	ProjectID uuid.UUID                 `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Total     int                       `json:"total" example:"24"`
+	Columns   []TaskBoardColumnResponse `json:"columns"`
+}
+
+// TaskBoardColumnResponse is the cards of one status, most urgent and then
+// most recently updated first. Count includes cards that were left out.
+type TaskBoardColumnResponse struct {
+	Status      entity.TaskStatus  `json:"status" example:"IMPLEMENTING"`
+	DisplayName string             `json:"display_name" example:"Implementing"`
+	Count       int                `json:"count" example:"3"`
+	Tasks       []TaskCardResponse `json:"tasks"`
+}
+
+// TaskCardResponse is what a board card shows of a task
+type TaskCardResponse struct {
+	ID           uuid.UUID            `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title        string               `json:"title" example:"Implement user authentication"`
+	Status       entity.TaskStatus    `json:"status" example:"IMPLEMENTING"`
+	Priority     entity.TaskPriority  `json:"priority" example:"HIGH"`
+	Tags         []string             `json:"tags,omitempty" example:"backend,auth"`
+	GitStatus    entity.TaskGitStatus `json:"git_status" example:"active"`
+	BranchName   *string              `json:"branch_name,omitempty" example:"task/implement-auth"`
+	PullRequest  *string              `json:"pull_request,omitempty" example:"https://github.com/acme/app/pull/42"`
+	ParentTaskID *uuid.UUID           `json:"parent_task_id,omitempty"`
+	EpicID       *uuid.UUID           `json:"epic_id,omitempty"`
+	UpdatedAt    time.Time            `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// TaskBoardResponseFromUsecase converts a task board to its response
+func TaskBoardResponseFromUsecase(board *usecase.TaskBoard) TaskBoardResponse {
+	resp := TaskBoardResponse{
+		ProjectID: board.ProjectID,
+		Columns:   make([]TaskBoardColumnResponse, len(board.Columns)),
+	}
+	for i, column := range board.Columns {
+		cards := make([]TaskCardResponse, len(column.Tasks))
+		for j, task := range column.Tasks {
+			cards[j] = TaskCardResponse{
+				ID:           task.ID,
+				Title:        task.Title,
+				Status:       task.Status,
+				Priority:     task.Priority,
+				Tags:         task.Tags,
+				GitStatus:    task.GitStatus,
+				BranchName:   task.BranchName,
+				PullRequest:  task.PullRequest,
+				ParentTaskID: task.ParentTaskID,
+				EpicID:       task.EpicID,
+				UpdatedAt:    task.UpdatedAt,
+			}
+		}
+		resp.Columns[i] = TaskBoardColumnResponse{
+			Status:      column.Status,
+			DisplayName: column.Status.GetDisplayName(),
+			Count:       column.Count,
+			Tasks:       cards,
+		}
+		resp.Total += column.Count
+	}
+	return resp
+}
+
+// TaskChangesResponse is what a task changed against its base branch, file
+// by file
+type TaskChangesResponse struct {
+	TaskID uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	// Source is worktree or pr, empty when the task has neither
+	Source       string             `json:"source" example:"worktree"`
+	FilesChanged int                `json:"files_changed" example:"2"`
+	Additions    int                `json:"additions" example:"12"`
+	Deletions    int                `json:"deletions" example:"3"`
+	Files        []FileDiffResponse `json:"files"`
+}
+
+// FileDiffResponse is the change to one file
+type FileDiffResponse struct {
+	// OldPath is empty for an added file and NewPath for a deleted one
+	OldPath   string             `json:"old_path" example:"internal/auth/login.go"`
+	NewPath   string             `json:"new_path" example:"internal/auth/login.go"`
+	Status    string             `json:"status" example:"modified" enums:"added,deleted,modified,renamed"`
+	Binary    bool               `json:"binary" example:"false"`
+	Additions int                `json:"additions" example:"10"`
+	Deletions int                `json:"deletions" example:"2"`
+	Hunks     []DiffHunkResponse `json:"hunks"`
+}
+
+// DiffHunkResponse is a run of changed lines of a file, with their context
+type DiffHunkResponse struct {
+	OldStart int                `json:"old_start" example:"12"`
+	OldLines int                `json:"old_lines" example:"6"`
+	NewStart int                `json:"new_start" example:"12"`
+	NewLines int                `json:"new_lines" example:"14"`
+	Header   string             `json:"header" example:"func Login(w http.ResponseWriter, r *http.Request) {"`
+	Lines    []DiffLineResponse `json:"lines"`
+}
+
+// DiffLineResponse is a line of a hunk. Line numbers are omitted on the side
+// the line is not in.
+type DiffLineResponse struct {
+	Type    string `json:"type" example:"added" enums:"context,added,deleted,no_newline"`
+	Content string `json:"content" example:"\treturn nil"`
+	OldLine int    `json:"old_line,omitempty" example:"0"`
+	NewLine int    `json:"new_line,omitempty" example:"18"`
+}
+
+// TaskChangesResponseFromChanges converts parsed task changes to their response
+func TaskChangesResponseFromChanges(changes *usecase.TaskChanges) TaskChangesResponse {
+	resp := TaskChangesResponse{
+		TaskID:       changes.TaskID,
+		Source:       changes.Source,
+		FilesChanged: len(changes.Files),
+		Files:        make([]FileDiffResponse, len(changes.Files)),
 	}
+	for i, file := range changes.Files {
+		resp.Additions += file.Additions
+		resp.Deletions += file.Deletions
+		hunks := make([]DiffHunkResponse, len(file.Hunks))
+		for j, hunk := range file.Hunks {
+			lines := make([]DiffLineResponse, len(hunk.Lines))
+			for k, line := range hunk.Lines {
+				lines[k] = DiffLineResponse{Type: line.Type, Content: line.Content, OldLine: line.OldLine, NewLine: line.NewLine}
+			}
+			hunks[j] = DiffHunkResponse{
+				OldStart: hunk.OldStart,
+				OldLines: hunk.OldLines,
+				NewStart: hunk.NewStart,
+				NewLines: hunk.NewLines,
+				Header:   hunk.Header,
+				Lines:    lines,
+			}
+		}
+		resp.Files[i] = FileDiffResponse{
+			OldPath:   file.OldPath,
+			NewPath:   file.NewPath,
+			Status:    file.Status,
+			Binary:    file.Binary,
+			Additions: file.Additions,
+			Deletions: file.Deletions,
+			Hunks:     hunks,
+		}
+	}
+	return resp
 }
 
 func TaskStatusHistoryResponseFromEntity(history *entity.TaskStatusHistory) TaskStatusHistoryResponse {
@@ -183,6 +491,8 @@ func TaskStatusHistoryResponseFromEntity(history *entity.TaskStatusHistory) Task
 		ChangedBy:  history.ChangedBy,
 		Reason:     history.Reason,
 		CreatedAt:  history.CreatedAt,
+
+		PlanningIteration: history.PlanningIteration,
 	}
 }
 
@@ -222,16 +532,82 @@ type StartPlanningRequest struct {
 	AIType          string `json:"ai_type" binding:"required" example:"claude-code"`
 	AutoImplement   bool   `json:"auto_implement"`
 	UseRemoteBranch bool   `json:"use_remote_branch"`
+	// ScheduledAt delays planning until then, at most 30 days ahead; the
+	// task stays in TODO until it starts
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty" example:"2026-01-02T02:00:00Z"`
 }
 
 type StartPlanningResponse struct {
 	Message string `json:"message" example:"Planning started successfully"`
 	JobID   string `json:"job_id" example:"task-123-planning-456"`
+	// ScheduledAt is when the job starts, for scheduled jobs
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty" example:"2026-01-02T02:00:00Z"`
 }
 
 // Approve Plan DTOs
 type ApprovePlanRequest struct {
 	AIType string `json:"ai_type" binding:"required" example:"claude-code"`
+	// Reviewer is recorded in the status history as who approved the plan
+	Reviewer string `json:"reviewer,omitempty" binding:"max=255" example:"alice"`
+	// TOTPCode confirms the approval of a high-risk task's plan on its own,
+	// instead of a second approver
+	TOTPCode string `json:"totp_code,omitempty" binding:"omitempty,len=6,numeric" example:"123456"`
+	// ScheduledAt delays implementation until then, at most 30 days
+	// ahead; the task stays in PLAN_REVIEWING until it starts
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty" example:"2026-01-02T02:00:00Z"`
+}
+
+// ScheduledJobResponse is a planning or implementation job of a task
+// waiting for its start time
+type ScheduledJobResponse struct {
+	ID          string    `json:"id" example:"5f0c3d6e-6a1b-4c2d-9e8f-1a2b3c4d5e6f"`
+	TaskID      uuid.UUID `json:"task_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Kind        string    `json:"kind" example:"planning" enums:"planning,implementation"`
+	AIType      string    `json:"ai_type" example:"claude-code"`
+	ScheduledAt time.Time `json:"scheduled_at" example:"2026-01-02T02:00:00Z"`
+}
+
+// ScheduledJobResponsesFromJobs converts scheduled jobs to their responses
+func ScheduledJobResponsesFromJobs(jobs []usecase.ScheduledJob) []ScheduledJobResponse {
+	responses := make([]ScheduledJobResponse, len(jobs))
+	for i, job := range jobs {
+		responses[i] = ScheduledJobResponse{
+			ID:          job.ID,
+			TaskID:      job.TaskID,
+			Kind:        job.Kind,
+			AIType:      job.AIType,
+			ScheduledAt: job.ScheduledAt,
+		}
+	}
+	return responses
+}
+
+type RejectPlanRequest struct {
+	Reviewer string `json:"reviewer,omitempty" binding:"max=255" example:"alice"`
+	Reason   string `json:"reason,omitempty" binding:"max=500" example:"Misses the migration for existing users"`
+}
+
+// RequestChangesRequest sends a task in code review back to implementation
+type RequestChangesRequest struct {
+	Reviewer string `json:"reviewer,omitempty" binding:"max=255" example:"alice"`
+	// Feedback is what the executor is asked to change
+	Feedback string `json:"feedback" binding:"required,max=10000" example:"Validate the email before saving the user"`
+	AIType   string `json:"ai_type" binding:"required" example:"claude-code"`
+}
+
+// RejectPlanWithFeedbackRequest rejects a plan and plans the task again
+// with the feedback
+type RejectPlanWithFeedbackRequest struct {
+	Reviewer string `json:"reviewer,omitempty" binding:"max=255" example:"alice"`
+	// Feedback is what the new plan has to address
+	Feedback string `json:"feedback" binding:"required,max=10000" example:"Reuse the existing session store instead of adding one"`
+	AIType   string `json:"ai_type" binding:"required" example:"claude-code"`
+}
+
+// AnswerQuestionRequest answers the question an execution waits on
+type AnswerQuestionRequest struct {
+	Answerer string `json:"answerer,omitempty" binding:"max=255" example:"alice"`
+	Answer   string `json:"answer" binding:"required,max=10000" example:"No, remove it and redirect to /sign-in"`
 }
 
 // Git Branches DTOs
@@ -244,12 +620,14 @@ type GitBranchResponse struct {
 }
 
 type ListBranchesResponse struct {
-	Branches []GitBranchResponse `json:"branches"`
-	Total    int                 `json:"total"`
+	Items []GitBranchResponse `json:"items"`
+	ListMeta
 }
 
 type PlanUpdateRequest struct {
 	Content string `json:"content" binding:"required" example:"Implement user authentication"`
+	// EditedBy is recorded on the plan version the edit creates
+	EditedBy string `json:"edited_by,omitempty" example:"alice"`
 }
 
 // Start Implementing Direct DTOs
@@ -258,3 +636,475 @@ type StartImplementingDirectRequest struct {
 	AIType          string `json:"ai_type" binding:"required" example:"claude-code"`
 	UseRemoteBranch bool   `json:"use_remote_branch"`
 }
+
+// StartDryRunRequest previews an implementation without keeping it
+type StartDryRunRequest struct {
+	AIType string `json:"ai_type" binding:"required" example:"claude-code"`
+}
+
+// Executor comparison DTOs
+type StartComparisonRequest struct {
+	// AITypes are the two executors to run the task with, as variants a and b
+	AITypes    []string `json:"ai_types" binding:"required,len=2" example:"claude-code,cursor-agent"`
+	BranchName string   `json:"branch_name" example:"main"`
+}
+
+type SelectComparisonWinnerRequest struct {
+	Variant string `json:"variant" binding:"required" example:"a"`
+}
+
+// ComparisonVariantResponse is one side of an executor comparison
+type ComparisonVariantResponse struct {
+	Variant      string            `json:"variant" example:"a"`
+	AIType       string            `json:"ai_type" example:"claude-code"`
+	BranchName   string            `json:"branch_name" example:"task-123e4567-add-login-a"`
+	WorktreePath string            `json:"worktree_path" example:"/worktrees/project-1/variants/task-123e4567-a"`
+	Execution    ExecutionResponse `json:"execution"`
+	// Diff is empty until the variant's execution has completed
+	Diff     string `json:"diff"`
+	Selected bool   `json:"selected" example:"false"`
+}
+
+type ExecutorComparisonResponse struct {
+	ID             uuid.UUID                   `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	TaskID         uuid.UUID                   `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	BaseBranchName string                      `json:"base_branch_name" example:"main"`
+	Variants       []ComparisonVariantResponse `json:"variants"`
+}
+
+// ExecutorComparisonResponseFromComparison converts an executor comparison to its response
+func ExecutorComparisonResponseFromComparison(comparison *usecase.ExecutorComparison) ExecutorComparisonResponse {
+	response := ExecutorComparisonResponse{
+		ID:             comparison.ID,
+		TaskID:         comparison.TaskID,
+		BaseBranchName: comparison.BaseBranchName,
+		Variants:       make([]ComparisonVariantResponse, len(comparison.Variants)),
+	}
+	for i, variant := range comparison.Variants {
+		response.Variants[i] = ComparisonVariantResponse{
+			Variant:      variant.Execution.Variant,
+			AIType:       variant.Execution.AIType,
+			BranchName:   variant.Execution.BranchName,
+			WorktreePath: variant.Execution.WorktreePath,
+			Execution:    ToExecutionResponse(variant.Execution),
+			Diff:         variant.Diff,
+			Selected:     variant.Selected,
+		}
+	}
+	return response
+}
+
+// Cycle time analytics DTOs
+type CycleTimeAnalyticsQuery struct {
+	// From and To bound when the tasks were completed
+	From   *time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To     *time.Time `form:"to" example:"2024-03-31T23:59:59Z"`
+	Format string     `form:"format" binding:"omitempty,oneof=json csv pdf" example:"pdf"`
+}
+
+// DurationPercentilesResponse summarises a set of durations, in hours
+type DurationPercentilesResponse struct {
+	Count        int     `json:"count" example:"12"`
+	AverageHours float64 `json:"average_hours" example:"30.5"`
+	P50Hours     float64 `json:"p50_hours" example:"24"`
+	P75Hours     float64 `json:"p75_hours" example:"36"`
+	P90Hours     float64 `json:"p90_hours" example:"60"`
+	P95Hours     float64 `json:"p95_hours" example:"72"`
+}
+
+// TaskCycleTimeResponse is how long a completed task took, in hours
+type TaskCycleTimeResponse struct {
+	TaskID         uuid.UUID  `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title          string     `json:"title" example:"Add login page"`
+	CreatedAt      time.Time  `json:"created_at"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	CompletedAt    time.Time  `json:"completed_at"`
+	LeadTimeHours  float64    `json:"lead_time_hours" example:"48"`
+	CycleTimeHours *float64   `json:"cycle_time_hours,omitempty" example:"20.5"`
+	// TimeInStatusHours is the time spent in each status before completion
+	TimeInStatusHours map[entity.TaskStatus]float64 `json:"time_in_status_hours"`
+}
+
+type CycleTimeAnalyticsResponse struct {
+	ProjectID                uuid.UUID                     `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	From                     *time.Time                    `json:"from,omitempty"`
+	To                       *time.Time                    `json:"to,omitempty"`
+	LeadTime                 DurationPercentilesResponse   `json:"lead_time"`
+	CycleTime                DurationPercentilesResponse   `json:"cycle_time"`
+	AverageTimeInStatusHours map[entity.TaskStatus]float64 `json:"average_time_in_status_hours"`
+	Tasks                    []TaskCycleTimeResponse       `json:"tasks"`
+	GeneratedAt              time.Time                     `json:"generated_at"`
+}
+
+func durationPercentilesResponse(stats usecase.DurationPercentiles) DurationPercentilesResponse {
+	return DurationPercentilesResponse{
+		Count:        stats.Count,
+		AverageHours: stats.Average.Hours(),
+		P50Hours:     stats.P50.Hours(),
+		P75Hours:     stats.P75.Hours(),
+		P90Hours:     stats.P90.Hours(),
+		P95Hours:     stats.P95.Hours(),
+	}
+}
+
+func statusHours(durations map[entity.TaskStatus]time.Duration) map[entity.TaskStatus]float64 {
+	hours := make(map[entity.TaskStatus]float64, len(durations))
+	for status, d := range durations {
+		hours[status] = d.Hours()
+	}
+	return hours
+}
+
+// CycleTimeAnalyticsResponseFromAnalytics converts cycle time analytics to their response
+func CycleTimeAnalyticsResponseFromAnalytics(analytics *usecase.CycleTimeAnalytics) CycleTimeAnalyticsResponse {
+	response := CycleTimeAnalyticsResponse{
+		ProjectID:                analytics.ProjectID,
+		From:                     analytics.From,
+		To:                       analytics.To,
+		LeadTime:                 durationPercentilesResponse(analytics.LeadTime),
+		CycleTime:                durationPercentilesResponse(analytics.CycleTime),
+		AverageTimeInStatusHours: statusHours(analytics.AverageTimeInStatus),
+		Tasks:                    make([]TaskCycleTimeResponse, len(analytics.Tasks)),
+		GeneratedAt:              analytics.GeneratedAt,
+	}
+	for i, task := range analytics.Tasks {
+		response.Tasks[i] = TaskCycleTimeResponse{
+			TaskID:            task.TaskID,
+			Title:             task.Title,
+			CreatedAt:         task.CreatedAt,
+			StartedAt:         task.StartedAt,
+			CompletedAt:       task.CompletedAt,
+			LeadTimeHours:     task.LeadTime.Hours(),
+			TimeInStatusHours: statusHours(task.TimeInStatus),
+		}
+		if task.CycleTime != nil {
+			hours := task.CycleTime.Hours()
+			response.Tasks[i].CycleTimeHours = &hours
+		}
+	}
+	return response
+}
+
+// Executor analytics DTOs
+type ExecutorAnalyticsQuery struct {
+	// From and To bound when the executions started
+	From *time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To   *time.Time `form:"to" example:"2024-03-31T23:59:59Z"`
+}
+
+// ExecutorStatsResponse is how one executor fared. Rates are between 0 and 1.
+type ExecutorStatsResponse struct {
+	AIType                 string   `json:"ai_type" example:"claude-code"`
+	Executions             int      `json:"executions" example:"40"`
+	Completed              int      `json:"completed" example:"34"`
+	Failed                 int      `json:"failed" example:"5"`
+	Cancelled              int      `json:"cancelled" example:"1"`
+	SuccessRate            *float64 `json:"success_rate,omitempty" example:"0.87"`
+	AverageDurationMinutes float64  `json:"average_duration_minutes" example:"12.5"`
+	AverageFixAttempts     float64  `json:"average_fix_attempts" example:"0.4"`
+	Tasks                  int      `json:"tasks" example:"25"`
+	AverageRunsPerTask     float64  `json:"average_runs_per_task" example:"1.6"`
+	PullRequests           int      `json:"pull_requests" example:"22"`
+	Merged                 int      `json:"merged" example:"18"`
+	Closed                 int      `json:"closed" example:"2"`
+	MergeRate              *float64 `json:"merge_rate,omitempty" example:"0.9"`
+	CostUSD                float64  `json:"cost_usd" example:"57.31"`
+}
+
+type ExecutorAnalyticsResponse struct {
+	ProjectID   uuid.UUID               `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	From        *time.Time              `json:"from,omitempty"`
+	To          *time.Time              `json:"to,omitempty"`
+	Executors   []ExecutorStatsResponse `json:"executors"`
+	GeneratedAt time.Time               `json:"generated_at"`
+}
+
+// ExecutorAnalyticsResponseFromAnalytics converts executor analytics to their response
+func ExecutorAnalyticsResponseFromAnalytics(analytics *usecase.ExecutorAnalytics) ExecutorAnalyticsResponse {
+	response := ExecutorAnalyticsResponse{
+		ProjectID:   analytics.ProjectID,
+		From:        analytics.From,
+		To:          analytics.To,
+		Executors:   make([]ExecutorStatsResponse, len(analytics.Executors)),
+		GeneratedAt: analytics.GeneratedAt,
+	}
+	for i, stats := range analytics.Executors {
+		response.Executors[i] = ExecutorStatsResponse{
+			AIType:                 stats.AIType,
+			Executions:             stats.Executions,
+			Completed:              stats.Completed,
+			Failed:                 stats.Failed,
+			Cancelled:              stats.Cancelled,
+			SuccessRate:            stats.SuccessRate,
+			AverageDurationMinutes: stats.AverageDuration.Minutes(),
+			AverageFixAttempts:     stats.AverageFixAttempts,
+			Tasks:                  stats.Tasks,
+			AverageRunsPerTask:     stats.AverageRunsPerTask,
+			PullRequests:           stats.PullRequests,
+			Merged:                 stats.Merged,
+			Closed:                 stats.Closed,
+			MergeRate:              stats.MergeRate,
+			CostUSD:                stats.CostUSD,
+		}
+	}
+	return response
+}
+
+// Velocity DTOs
+type VelocityQuery struct {
+	Weeks  int    `form:"weeks" binding:"omitempty,min=1,max=104" example:"12"`
+	Format string `form:"format" binding:"omitempty,oneof=json csv pdf" example:"csv"`
+}
+
+// WeeklyVelocityResponse is a project's throughput over one week
+type WeeklyVelocityResponse struct {
+	WeekStart          time.Time `json:"week_start" example:"2024-03-04T00:00:00Z"`
+	TasksCompleted     int       `json:"tasks_completed" example:"7"`
+	PullRequestsMerged int       `json:"pull_requests_merged" example:"6"`
+	// Executions counts the AI runs of the tasks completed that week
+	Executions               int     `json:"executions" example:"11"`
+	AverageExecutionsPerTask float64 `json:"average_executions_per_task" example:"1.57"`
+}
+
+type VelocityResponse struct {
+	ProjectID                uuid.UUID                `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Weeks                    []WeeklyVelocityResponse `json:"weeks"`
+	TasksCompleted           int                      `json:"tasks_completed" example:"42"`
+	PullRequestsMerged       int                      `json:"pull_requests_merged" example:"38"`
+	Executions               int                      `json:"executions" example:"63"`
+	AverageTasksPerWeek      float64                  `json:"average_tasks_per_week" example:"3.5"`
+	AverageExecutionsPerTask float64                  `json:"average_executions_per_task" example:"1.5"`
+	RolledUpAt               *time.Time               `json:"rolled_up_at,omitempty"`
+	GeneratedAt              time.Time                `json:"generated_at"`
+}
+
+// VelocityResponseFromReport converts a velocity report to its response
+func VelocityResponseFromReport(report *usecase.VelocityReport) VelocityResponse {
+	response := VelocityResponse{
+		ProjectID:                report.ProjectID,
+		Weeks:                    make([]WeeklyVelocityResponse, len(report.Weeks)),
+		TasksCompleted:           report.TasksCompleted,
+		PullRequestsMerged:       report.PullRequestsMerged,
+		Executions:               report.Executions,
+		AverageTasksPerWeek:      report.AverageTasksPerWeek,
+		AverageExecutionsPerTask: report.AverageExecutionsPerTask,
+		RolledUpAt:               report.RolledUpAt,
+		GeneratedAt:              report.GeneratedAt,
+	}
+	for i, rollup := range report.Weeks {
+		response.Weeks[i] = WeeklyVelocityResponse{
+			WeekStart:                rollup.WeekStart,
+			TasksCompleted:           rollup.TasksCompleted,
+			PullRequestsMerged:       rollup.PullRequestsMerged,
+			Executions:               rollup.Executions,
+			AverageExecutionsPerTask: rollup.AverageExecutionsPerTask(),
+		}
+	}
+	return response
+}
+
+// Plan review analytics DTOs
+type PlanReviewAnalyticsQuery struct {
+	// From and To bound when the reviews were decided
+	From *time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To   *time.Time `form:"to" example:"2024-03-31T23:59:59Z"`
+}
+
+// PlanReviewResponse is one decided plan review, its latency in hours
+type PlanReviewResponse struct {
+	TaskID       uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title        string    `json:"title" example:"Add login page"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+	DecidedAt    time.Time `json:"decided_at"`
+	Outcome      string    `json:"outcome" example:"approved"`
+	Reviewer     string    `json:"reviewer,omitempty" example:"alice"`
+	LatencyHours float64   `json:"latency_hours" example:"5.5"`
+}
+
+type PendingPlanReviewResponse struct {
+	TaskID       uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Title        string    `json:"title" example:"Add search"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+	WaitingHours float64   `json:"waiting_hours" example:"26"`
+}
+
+// ReviewerLatencyResponse is how fast one reviewer decided. Decisions with
+// no recorded reviewer are grouped under an empty reviewer.
+type ReviewerLatencyResponse struct {
+	Reviewer string                      `json:"reviewer" example:"alice"`
+	Approved int                         `json:"approved" example:"9"`
+	Rejected int                         `json:"rejected" example:"2"`
+	Latency  DurationPercentilesResponse `json:"latency"`
+}
+
+type PlanReviewAnalyticsResponse struct {
+	ProjectID   uuid.UUID                   `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	From        *time.Time                  `json:"from,omitempty"`
+	To          *time.Time                  `json:"to,omitempty"`
+	Approved    int                         `json:"approved" example:"18"`
+	Rejected    int                         `json:"rejected" example:"4"`
+	Latency     DurationPercentilesResponse `json:"latency"`
+	ByReviewer  []ReviewerLatencyResponse   `json:"by_reviewer"`
+	Reviews     []PlanReviewResponse        `json:"reviews"`
+	Pending     []PendingPlanReviewResponse `json:"pending"`
+	GeneratedAt time.Time                   `json:"generated_at"`
+}
+
+// PlanReviewAnalyticsResponseFromAnalytics converts plan review analytics to their response
+func PlanReviewAnalyticsResponseFromAnalytics(analytics *usecase.PlanReviewAnalytics) PlanReviewAnalyticsResponse {
+	response := PlanReviewAnalyticsResponse{
+		ProjectID:   analytics.ProjectID,
+		From:        analytics.From,
+		To:          analytics.To,
+		Approved:    analytics.Approved,
+		Rejected:    analytics.Rejected,
+		Latency:     durationPercentilesResponse(analytics.Latency),
+		ByReviewer:  make([]ReviewerLatencyResponse, len(analytics.ByReviewer)),
+		Reviews:     make([]PlanReviewResponse, len(analytics.Reviews)),
+		Pending:     make([]PendingPlanReviewResponse, len(analytics.Pending)),
+		GeneratedAt: analytics.GeneratedAt,
+	}
+	for i, reviewer := range analytics.ByReviewer {
+		response.ByReviewer[i] = ReviewerLatencyResponse{
+			Reviewer: reviewer.Reviewer,
+			Approved: reviewer.Approved,
+			Rejected: reviewer.Rejected,
+			Latency:  durationPercentilesResponse(reviewer.Latency),
+		}
+	}
+	for i, review := range analytics.Reviews {
+		response.Reviews[i] = PlanReviewResponse{
+			TaskID:       review.TaskID,
+			Title:        review.Title,
+			SubmittedAt:  review.SubmittedAt,
+			DecidedAt:    review.DecidedAt,
+			Outcome:      string(review.Outcome),
+			Reviewer:     review.Reviewer,
+			LatencyHours: review.Latency.Hours(),
+		}
+	}
+	for i, pending := range analytics.Pending {
+		response.Pending[i] = PendingPlanReviewResponse{
+			TaskID:       pending.TaskID,
+			Title:        pending.Title,
+			SubmittedAt:  pending.SubmittedAt,
+			WaitingHours: pending.Waiting.Hours(),
+		}
+	}
+	return response
+}
+
+// Pull request analytics DTOs
+type PullRequestAnalyticsQuery struct {
+	// From and To bound when the pull requests were opened
+	From   *time.Time `form:"from" example:"2024-01-01T00:00:00Z"`
+	To     *time.Time `form:"to" example:"2024-03-31T23:59:59Z"`
+	Period string     `form:"period" binding:"omitempty,oneof=week month" example:"week"`
+}
+
+// PullRequestStatsResponse sums up a set of pull requests. MergeRate is
+// between 0 and 1.
+type PullRequestStatsResponse struct {
+	Opened             int                         `json:"opened" example:"24"`
+	Merged             int                         `json:"merged" example:"18"`
+	ClosedWithoutMerge int                         `json:"closed_without_merge" example:"3"`
+	Open               int                         `json:"open" example:"3"`
+	MergeRate          *float64                    `json:"merge_rate,omitempty" example:"0.86"`
+	TimeToMerge        DurationPercentilesResponse `json:"time_to_merge"`
+}
+
+type PullRequestPeriodResponse struct {
+	Start time.Time `json:"start" example:"2024-03-04T00:00:00Z"`
+	PullRequestStatsResponse
+}
+
+type PullRequestAnalyticsResponse struct {
+	ProjectID uuid.UUID  `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	From      *time.Time `json:"from,omitempty"`
+	To        *time.Time `json:"to,omitempty"`
+	PullRequestStatsResponse
+	Period      string                      `json:"period,omitempty" example:"week"`
+	Periods     []PullRequestPeriodResponse `json:"periods,omitempty"`
+	GeneratedAt time.Time                   `json:"generated_at"`
+}
+
+func pullRequestStatsResponse(stats usecase.PullRequestStats) PullRequestStatsResponse {
+	return PullRequestStatsResponse{
+		Opened:             stats.Opened,
+		Merged:             stats.Merged,
+		ClosedWithoutMerge: stats.ClosedWithoutMerge,
+		Open:               stats.Open,
+		MergeRate:          stats.MergeRate,
+		TimeToMerge:        durationPercentilesResponse(stats.TimeToMerge),
+	}
+}
+
+// PullRequestAnalyticsResponseFromAnalytics converts pull request analytics to their response
+func PullRequestAnalyticsResponseFromAnalytics(analytics *usecase.PullRequestAnalytics) PullRequestAnalyticsResponse {
+	response := PullRequestAnalyticsResponse{
+		ProjectID:                analytics.ProjectID,
+		From:                     analytics.From,
+		To:                       analytics.To,
+		PullRequestStatsResponse: pullRequestStatsResponse(analytics.PullRequestStats),
+		Period:                   string(analytics.Period),
+		GeneratedAt:              analytics.GeneratedAt,
+	}
+	if analytics.Periods != nil {
+		response.Periods = make([]PullRequestPeriodResponse, len(analytics.Periods))
+		for i, period := range analytics.Periods {
+			response.Periods[i] = PullRequestPeriodResponse{
+				Start:                    period.Start,
+				PullRequestStatsResponse: pullRequestStatsResponse(period.PullRequestStats),
+			}
+		}
+	}
+	return response
+}
+
+// AssigneeWorkloadResponse is the open work of one assignee
+type AssigneeWorkloadResponse struct {
+	// Assignee is empty for unassigned tasks
+	Assignee       string                    `json:"assignee" example:"alice"`
+	OpenTasks      int                       `json:"open_tasks" example:"7"`
+	ByStatus       map[entity.TaskStatus]int `json:"by_status"`
+	EstimatedHours float64                   `json:"estimated_hours" example:"26.5"`
+	// Unestimated counts the open tasks without an estimate
+	Unestimated int `json:"unestimated" example:"2"`
+	// InFlightExecutions counts the AI runs pending, running or paused, and
+	// AITasks the open tasks with at least one
+	InFlightExecutions int `json:"in_flight_executions" example:"3"`
+	AITasks            int `json:"ai_tasks" example:"2"`
+}
+
+type WorkloadResponse struct {
+	ProjectID   uuid.UUID                  `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Assignees   []AssigneeWorkloadResponse `json:"assignees"`
+	Total       AssigneeWorkloadResponse   `json:"total"`
+	GeneratedAt time.Time                  `json:"generated_at"`
+}
+
+func assigneeWorkloadResponse(workload usecase.AssigneeWorkload) AssigneeWorkloadResponse {
+	return AssigneeWorkloadResponse{
+		Assignee:           workload.Assignee,
+		OpenTasks:          workload.OpenTasks,
+		ByStatus:           workload.ByStatus,
+		EstimatedHours:     workload.EstimatedHours,
+		Unestimated:        workload.Unestimated,
+		InFlightExecutions: workload.InFlightExecutions,
+		AITasks:            workload.AITasks,
+	}
+}
+
+// WorkloadResponseFromReport converts a workload report to its response
+func WorkloadResponseFromReport(report *usecase.WorkloadReport) WorkloadResponse {
+	response := WorkloadResponse{
+		ProjectID:   report.ProjectID,
+		Assignees:   make([]AssigneeWorkloadResponse, len(report.Assignees)),
+		Total:       assigneeWorkloadResponse(report.Total),
+		GeneratedAt: report.GeneratedAt,
+	}
+	for i, workload := range report.Assignees {
+		response.Assignees[i] = assigneeWorkloadResponse(*workload)
+	}
+	return response
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// UpdateUserProfileRequest changes the fields of a profile it sets
+type UpdateUserProfileRequest struct {
+	DisplayName *string `json:"display_name,omitempty" binding:"omitempty,max=255" example:"Alice Nguyen"`
+	// Timezone is an IANA time zone name; an empty one resets it to UTC
+	Timezone *string `json:"timezone,omitempty" binding:"omitempty,max=64" example:"Asia/Ho_Chi_Minh"`
+}
+
+// UserProfilesQuery names the users whose profiles to return
+type UserProfilesQuery struct {
+	Usernames []string `form:"username" binding:"required,min=1,max=100,dive,required,max=255" example:"alice"`
+}
+
+// UserProfileResponse describes a user recorded by name in created_by,
+// assigned_to, approver and comment author fields. Name is what to show
+// for them: their display name, or else their username.
+type UserProfileResponse struct {
+	Username    string     `json:"username" example:"alice"`
+	DisplayName string     `json:"display_name" example:"Alice Nguyen"`
+	Name        string     `json:"name" example:"Alice Nguyen"`
+	Timezone    string     `json:"timezone" example:"Asia/Ho_Chi_Minh"`
+	AvatarURL   string     `json:"avatar_url,omitempty" example:"http://localhost:8098/api/v1/users/alice/avatar?v=1705315500"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+type UserProfileListResponse struct {
+	Items []UserProfileResponse `json:"items"`
+	ListMeta
+}
+
+// UserProfileResponseFromEntity converts a profile; default profiles of
+// users who have not saved one have no update time
+func UserProfileResponseFromEntity(profile *entity.UserProfile, avatarURL string) UserProfileResponse {
+	response := UserProfileResponse{
+		Username:    profile.Username,
+		DisplayName: profile.DisplayName,
+		Name:        profile.Name(),
+		Timezone:    profile.Timezone,
+		AvatarURL:   avatarURL,
+	}
+	if !profile.UpdatedAt.IsZero() {
+		updatedAt := profile.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Verification pipeline DTOs
+type VerificationPipelineStepRequest struct {
+	Step    entity.VerificationStep `json:"step" binding:"required,oneof=setup lint build test security_scan review test_changes" example:"test"`
+	Command string                  `json:"command" example:"npm test -- --ci"`
+}
+
+type VerificationPipelineUpdateRequest struct {
+	Steps []VerificationPipelineStepRequest `json:"steps" binding:"required,min=1,dive"`
+}
+
+type VerificationPipelineStepResponse struct {
+	Step entity.VerificationStep `json:"step" example:"test"`
+	// Command is the step's own command, empty when it uses the project's
+	Command string `json:"command,omitempty" example:"npm test -- --ci"`
+}
+
+type VerificationPipelineResponse struct {
+	ProjectID uuid.UUID                          `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Steps     []VerificationPipelineStepResponse `json:"steps"`
+	// IsDefault is set when the project has no saved pipeline and runs the
+	// one its settings describe
+	IsDefault bool       `json:"is_default" example:"false"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2024-01-01T00:00:00Z"`
+}
+
+func (req *VerificationPipelineUpdateRequest) ToEntitySteps() []entity.VerificationPipelineStep {
+	steps := make([]entity.VerificationPipelineStep, len(req.Steps))
+	for i, step := range req.Steps {
+		steps[i] = entity.VerificationPipelineStep{Step: step.Step, Command: step.Command}
+	}
+	return steps
+}
+
+func VerificationPipelineResponseFromEntity(pipeline *entity.VerificationPipeline) VerificationPipelineResponse {
+	response := VerificationPipelineResponse{
+		ProjectID: pipeline.ProjectID,
+		Steps:     make([]VerificationPipelineStepResponse, len(pipeline.Steps)),
+		IsDefault: pipeline.IsDefault(),
+	}
+	for i, step := range pipeline.Steps {
+		response.Steps[i] = VerificationPipelineStepResponse{Step: step.Step, Command: step.Command}
+	}
+	if !pipeline.IsDefault() {
+		response.UpdatedAt = &pipeline.UpdatedAt
+	}
+	return response
+}
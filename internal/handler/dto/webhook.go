@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ProjectWebhookRequest configures an outbound webhook of a project
+type ProjectWebhookRequest struct {
+	URL string `json:"url" binding:"required,url,max=2048" example:"https://hooks.acme.com/auto-devs"`
+	// Events the webhook is sent, every event when empty
+	Events []entity.WebhookEvent `json:"events,omitempty" example:"status_changed,pr_merged"`
+	// Secret is write-only; omit it to generate one for a new webhook and to
+	// keep the current one otherwise
+	Secret *string `json:"secret,omitempty" binding:"omitempty,min=16,max=255" example:"0f8fad5bd9cb469fa16570867728950e"`
+	Active *bool   `json:"active,omitempty" example:"true"`
+}
+
+type ProjectWebhookResponse struct {
+	ID        uuid.UUID             `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	ProjectID uuid.UUID             `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	URL       string                `json:"url" example:"https://hooks.acme.com/auto-devs"`
+	Events    []entity.WebhookEvent `json:"events" example:"status_changed,pr_merged"`
+	Active    bool                  `json:"active" example:"true"`
+	CreatedAt time.Time             `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt time.Time             `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// CreateProjectWebhookResponse is a new webhook with the secret its
+// deliveries are signed with, which is never shown again
+type CreateProjectWebhookResponse struct {
+	ProjectWebhookResponse
+	Secret string `json:"secret" example:"0f8fad5bd9cb469fa16570867728950e"`
+}
+
+type ProjectWebhookListResponse struct {
+	Items []ProjectWebhookResponse `json:"items"`
+	ListMeta
+}
+
+// WebhookDeliveryResponse is an event posted, or being posted, to a webhook
+// with the outcome of its last attempt
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID                    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WebhookID      uuid.UUID                    `json:"webhook_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Event          entity.WebhookEvent          `json:"event" example:"status_changed"`
+	Payload        string                       `json:"payload" example:"{\"event\":\"status_changed\"}"`
+	Status         entity.WebhookDeliveryStatus `json:"status" example:"succeeded"`
+	Attempts       int                          `json:"attempts" example:"1"`
+	ResponseStatus *int                         `json:"response_status,omitempty" example:"200"`
+	Error          string                       `json:"error,omitempty" example:"webhook answered 502 Bad Gateway"`
+	LastAttemptAt  *time.Time                   `json:"last_attempt_at,omitempty" example:"2024-01-15T10:30:00Z"`
+	RedeliveryOf   *uuid.UUID                   `json:"redelivery_of,omitempty" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt      time.Time                    `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+type WebhookDeliveryListResponse struct {
+	WebhookID uuid.UUID                 `json:"webhook_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items     []WebhookDeliveryResponse `json:"items"`
+	ListMeta
+}
+
+func ProjectWebhookResponseFromEntity(hook *entity.ProjectWebhook) ProjectWebhookResponse {
+	events := hook.Events
+	if events == nil {
+		events = []entity.WebhookEvent{}
+	}
+	return ProjectWebhookResponse{
+		ID:        hook.ID,
+		ProjectID: hook.ProjectID,
+		URL:       hook.URL,
+		Events:    events,
+		Active:    hook.Active,
+		CreatedAt: hook.CreatedAt,
+		UpdatedAt: hook.UpdatedAt,
+	}
+}
+
+func WebhookDeliveryResponseFromEntity(delivery *entity.ProjectWebhookDelivery) WebhookDeliveryResponse {
+	return WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
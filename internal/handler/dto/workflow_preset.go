@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Workflow preset DTOs
+type WorkflowPresetUpdateRequest struct {
+	PlanningInstructions       string `json:"planning_instructions" binding:"max=5000" example:"Find the root cause before planning the fix."`
+	ImplementationInstructions string `json:"implementation_instructions" binding:"max=5000" example:"Write a failing regression test first."`
+	RequireTestChanges         bool   `json:"require_test_changes" example:"true"`
+}
+
+type WorkflowPresetResponse struct {
+	ProjectID                  uuid.UUID           `json:"project_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Workflow                   entity.WorkflowType `json:"workflow" example:"bugfix"`
+	PlanningInstructions       string              `json:"planning_instructions" example:"Find the root cause before planning the fix."`
+	ImplementationInstructions string              `json:"implementation_instructions" example:"Write a failing regression test first."`
+	RequireTestChanges         bool                `json:"require_test_changes" example:"true"`
+	// IsDefault is set when the project has no saved preset of the workflow
+	// type and uses the built-in one
+	IsDefault bool       `json:"is_default" example:"false"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty" example:"2024-01-01T00:00:00Z"`
+}
+
+type WorkflowPresetListResponse struct {
+	Presets []WorkflowPresetResponse `json:"presets"`
+}
+
+func (req *WorkflowPresetUpdateRequest) ToEntity(projectID uuid.UUID, workflow entity.WorkflowType) *entity.WorkflowPreset {
+	return &entity.WorkflowPreset{
+		ProjectID:                  projectID,
+		Workflow:                   workflow,
+		PlanningInstructions:       req.PlanningInstructions,
+		ImplementationInstructions: req.ImplementationInstructions,
+		RequireTestChanges:         req.RequireTestChanges,
+	}
+}
+
+func WorkflowPresetResponseFromEntity(preset *entity.WorkflowPreset) WorkflowPresetResponse {
+	response := WorkflowPresetResponse{
+		ProjectID:                  preset.ProjectID,
+		Workflow:                   preset.Workflow,
+		PlanningInstructions:       preset.PlanningInstructions,
+		ImplementationInstructions: preset.ImplementationInstructions,
+		RequireTestChanges:         preset.RequireTestChanges,
+		IsDefault:                  preset.IsDefault(),
+	}
+	if !preset.IsDefault() {
+		response.UpdatedAt = &preset.UpdatedAt
+	}
+	return response
+}
+
+func WorkflowPresetListResponseFromEntities(presets []*entity.WorkflowPreset) WorkflowPresetListResponse {
+	response := WorkflowPresetListResponse{Presets: make([]WorkflowPresetResponse, len(presets))}
+	for i, preset := range presets {
+		response.Presets[i] = WorkflowPresetResponseFromEntity(preset)
+	}
+	return response
+}
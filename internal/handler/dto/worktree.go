@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/google/uuid"
 )
@@ -39,8 +40,8 @@ type WorktreeResponse struct {
 
 // WorktreesResponse represents a list of worktrees response
 type WorktreesResponse struct {
-	Worktrees []*entity.Worktree `json:"worktrees"`
-	Count     int                `json:"count"`
+	Items []*entity.Worktree `json:"items"`
+	ListMeta
 }
 
 // WorktreeValidationResponse represents a worktree validation response
@@ -202,3 +203,206 @@ type WorktreeConfigurationUpdateRequest struct {
 	EnableLogging   *bool          `json:"enable_logging,omitempty"`
 	LogLevel        *string        `json:"log_level,omitempty"`
 }
+
+// TaskWorktreeStatusResponse is the live git state of a task's worktree
+type TaskWorktreeStatusResponse struct {
+	TaskID         uuid.UUID `json:"task_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	WorktreePath   string    `json:"worktree_path" example:"/worktrees/project-1/task-123"`
+	BranchName     string    `json:"branch_name" example:"task-123-add-login"`
+	BaseBranchName string    `json:"base_branch_name" example:"main"`
+	// DirtyFiles counts modified, staged and untracked files
+	DirtyFiles int `json:"dirty_files" example:"3"`
+	// Ahead and Behind count commits relative to the base branch; null when
+	// the base branch cannot be found
+	Ahead      *int                    `json:"ahead" example:"2"`
+	Behind     *int                    `json:"behind" example:"0"`
+	LastCommit *WorktreeCommitResponse `json:"last_commit,omitempty"`
+}
+
+// WorktreeCommitResponse is a commit in a task's worktree
+type WorktreeCommitResponse struct {
+	Hash    string    `json:"hash" example:"9fceb02d0ae598e95dc970b74767f19372d61af8"`
+	Author  string    `json:"author" example:"Auto-Devs"`
+	Subject string    `json:"subject" example:"Implement task: Add login"`
+	Date    time.Time `json:"date"`
+}
+
+// TaskWorktreeStatusResponseFromStatus converts a worktree status to its response
+func TaskWorktreeStatusResponseFromStatus(status *usecase.TaskWorktreeStatus) TaskWorktreeStatusResponse {
+	response := TaskWorktreeStatusResponse{
+		TaskID:         status.TaskID,
+		WorktreePath:   status.WorktreePath,
+		BranchName:     status.Branch,
+		BaseBranchName: status.BaseBranchName,
+		DirtyFiles:     status.DirtyFiles,
+		Ahead:          status.Ahead,
+		Behind:         status.Behind,
+	}
+	if status.LastCommit != nil {
+		response.LastCommit = &WorktreeCommitResponse{
+			Hash:    status.LastCommit.Hash,
+			Author:  status.LastCommit.Author,
+			Subject: status.LastCommit.Subject,
+			Date:    status.LastCommit.Date,
+		}
+	}
+	return response
+}
+
+// WorktreeSnapshotResponse is a saved state of a task's worktree, taken
+// before an implementation or auto-fix run
+type WorktreeSnapshotResponse struct {
+	// ID is the execution ID the snapshot was taken for, with a -fix-<n>
+	// suffix for auto-fix attempts
+	ID         string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000-fix-1"`
+	Hash       string    `json:"hash" example:"9fceb02d0ae598e95dc970b74767f19372d61af8"`
+	BaseCommit string    `json:"base_commit" example:"4b825dc642cb6eb9a060e54bf8d69288fbee4904"`
+	Message    string    `json:"message" example:"Before auto-fix attempt 1 of execution 123e4567-e89b-12d3-a456-426614174000"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WorktreeSnapshotResponsesFromSnapshots converts worktree snapshots to their responses
+func WorktreeSnapshotResponsesFromSnapshots(snapshots []git.Snapshot) []WorktreeSnapshotResponse {
+	responses := make([]WorktreeSnapshotResponse, len(snapshots))
+	for i, snapshot := range snapshots {
+		responses[i] = WorktreeSnapshotResponse{
+			ID:         snapshot.ID,
+			Hash:       snapshot.Hash,
+			BaseCommit: snapshot.Base,
+			Message:    snapshot.Message,
+			CreatedAt:  snapshot.CreatedAt,
+		}
+	}
+	return responses
+}
+
+// ProjectWorktreeResponse is a project worktree with its task, age and size
+// on disk
+type ProjectWorktreeResponse struct {
+	ID           uuid.UUID             `json:"id"`
+	TaskID       uuid.UUID             `json:"task_id"`
+	TaskTitle    string                `json:"task_title" example:"Add login page"`
+	TaskStatus   entity.TaskStatus     `json:"task_status" example:"DONE"`
+	BranchName   string                `json:"branch_name" example:"task-123-add-login-page"`
+	WorktreePath string                `json:"worktree_path"`
+	Status       entity.WorktreeStatus `json:"status" example:"active"`
+	CreatedAt    time.Time             `json:"created_at"`
+	AgeSeconds   int64                 `json:"age_seconds" example:"86400"`
+	DiskUsage    int64                 `json:"disk_usage" example:"52428800"` // in bytes
+	// OnDisk is false when the worktree directory no longer exists
+	OnDisk bool `json:"on_disk"`
+}
+
+// ProjectWorktreesResponse lists the worktrees of a project, largest first
+type ProjectWorktreesResponse struct {
+	Items          []ProjectWorktreeResponse `json:"items"`
+	TotalDiskUsage int64                     `json:"total_disk_usage" example:"104857600"` // in bytes
+}
+
+// ProjectWorktreesResponseFromUsage converts worktree usage to a response
+func ProjectWorktreesResponseFromUsage(usages []*usecase.WorktreeUsage) ProjectWorktreesResponse {
+	response := ProjectWorktreesResponse{Items: make([]ProjectWorktreeResponse, len(usages))}
+	for i, usage := range usages {
+		response.Items[i] = ProjectWorktreeResponse{
+			ID:           usage.Worktree.ID,
+			TaskID:       usage.Worktree.TaskID,
+			TaskTitle:    usage.TaskTitle,
+			TaskStatus:   usage.TaskStatus,
+			BranchName:   usage.Worktree.BranchName,
+			WorktreePath: usage.Worktree.WorktreePath,
+			Status:       usage.Worktree.Status,
+			CreatedAt:    usage.Worktree.CreatedAt,
+			AgeSeconds:   int64(usage.Age.Seconds()),
+			DiskUsage:    usage.DiskUsage,
+			OnDisk:       usage.OnDisk,
+		}
+		response.TotalDiskUsage += usage.DiskUsage
+	}
+	return response
+}
+
+// CleanupProjectWorktreesRequest selects the task worktrees to remove
+type CleanupProjectWorktreesRequest struct {
+	TaskIDs []uuid.UUID `json:"task_ids" binding:"required,min=1"`
+	Force   bool        `json:"force"` // Also remove worktrees that are still active
+}
+
+// CleanupProjectWorktreesResponse reports the outcome of a bulk cleanup
+type CleanupProjectWorktreesResponse struct {
+	Results        []*usecase.WorktreeCleanupResult `json:"results"`
+	CleanedCount   int                              `json:"cleaned_count"`
+	FailedCount    int                              `json:"failed_count"`
+	ReclaimedBytes int64                            `json:"reclaimed_bytes"`
+}
+
+// CleanupProjectWorktreesResponseFromResults totals bulk cleanup results
+func CleanupProjectWorktreesResponseFromResults(results []*usecase.WorktreeCleanupResult) CleanupProjectWorktreesResponse {
+	response := CleanupProjectWorktreesResponse{Results: results}
+	for _, result := range results {
+		if result.Cleaned {
+			response.CleanedCount++
+			response.ReclaimedBytes += result.ReclaimedBytes
+		} else {
+			response.FailedCount++
+		}
+	}
+	return response
+}
+
+// WorktreeFileEntryResponse is a file or directory in a task's worktree
+type WorktreeFileEntryResponse struct {
+	Name string `json:"name" example:"main.go"`
+	// Path is relative to the worktree root
+	Path       string                   `json:"path" example:"cmd/server/main.go"`
+	Type       usecase.WorktreeFileType `json:"type" example:"file"`
+	Size       int64                    `json:"size" example:"1024"`
+	ModifiedAt time.Time                `json:"modified_at"`
+}
+
+// WorktreeFilesResponse is a directory listing of a task's worktree
+type WorktreeFilesResponse struct {
+	Path    string                      `json:"path" example:"cmd/server"`
+	Entries []WorktreeFileEntryResponse `json:"entries"`
+}
+
+// WorktreeFilesResponseFromEntries converts a worktree directory listing to its response
+func WorktreeFilesResponseFromEntries(path string, entries []usecase.WorktreeFileEntry) WorktreeFilesResponse {
+	response := WorktreeFilesResponse{
+		Path:    path,
+		Entries: make([]WorktreeFileEntryResponse, len(entries)),
+	}
+	for i, entry := range entries {
+		response.Entries[i] = WorktreeFileEntryResponse{
+			Name:       entry.Name,
+			Path:       entry.Path,
+			Type:       entry.Type,
+			Size:       entry.Size,
+			ModifiedAt: entry.ModifiedAt,
+		}
+	}
+	return response
+}
+
+// WorktreeFileContentResponse is a file read from a task's worktree
+type WorktreeFileContentResponse struct {
+	Path       string    `json:"path" example:"cmd/server/main.go"`
+	Size       int64     `json:"size" example:"1024"`
+	ModifiedAt time.Time `json:"modified_at"`
+	// Content is empty for binary files, and holds only the first 1 MiB of
+	// truncated ones
+	Content   string `json:"content" example:"package main"`
+	Binary    bool   `json:"binary" example:"false"`
+	Truncated bool   `json:"truncated" example:"false"`
+}
+
+// WorktreeFileContentResponseFromContent converts a worktree file to its response
+func WorktreeFileContentResponseFromContent(content *usecase.WorktreeFileContent) WorktreeFileContentResponse {
+	return WorktreeFileContentResponse{
+		Path:       content.Path,
+		Size:       content.Size,
+		ModifiedAt: content.ModifiedAt,
+		Content:    content.Content,
+		Binary:     content.Binary,
+		Truncated:  content.Truncated,
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EmailHandler customizes the notification emails of the projects
+type EmailHandler struct {
+	emailUsecase usecase.EmailUsecase
+}
+
+func NewEmailHandler(emailUsecase usecase.EmailUsecase) *EmailHandler {
+	return &EmailHandler{emailUsecase: emailUsecase}
+}
+
+// ListEmailTemplates godoc
+// @Summary List a project's email templates
+// @Description List the subject and body of each email the project's members get: PLAN_READY, EXECUTION_FAILED and DAILY_DIGEST. Types the project did not customize show the default template.
+// @Tags email
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.EmailTemplateListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/email-templates [get]
+func (h *EmailHandler) ListEmailTemplates(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	templates, err := h.emailUsecase.ListTemplates(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list email templates")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EmailTemplateListResponseFromEntities(templates))
+}
+
+// UpdateEmailTemplate godoc
+// @Summary Customize a project's email template
+// @Description Replace the subject and body of one type of email the project's members get. Both are Go templates over ProjectName, ProjectURL, Message, TaskKey, TaskTitle, TaskURL, Plan, HighRisk, Phase, Error and, for DAILY_DIGEST, From, To and Movements; templates using other fields are rejected.
+// @Tags email
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param type path string true "Notification type" Enums(PLAN_READY, EXECUTION_FAILED, DAILY_DIGEST)
+// @Param template body dto.UpdateEmailTemplateRequest true "Email template"
+// @Success 200 {object} dto.EmailTemplateResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/email-templates/{type} [put]
+func (h *EmailHandler) UpdateEmailTemplate(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.UpdateEmailTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	template, err := h.emailUsecase.UpdateTemplate(c.Request.Context(), projectID, entity.NotificationType(c.Param("type")), usecase.UpdateEmailTemplateRequest{
+		Subject: req.Subject,
+		Body:    req.Body,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update email template")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EmailTemplateResponseFromEntity(template))
+}
+
+// ResetEmailTemplate godoc
+// @Summary Reset a project's email template
+// @Description Go back to the default subject and body for one type of email, which is returned
+// @Tags email
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param type path string true "Notification type" Enums(PLAN_READY, EXECUTION_FAILED, DAILY_DIGEST)
+// @Success 200 {object} dto.EmailTemplateResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/email-templates/{type} [delete]
+func (h *EmailHandler) ResetEmailTemplate(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	template, err := h.emailUsecase.ResetTemplate(c.Request.Context(), projectID, entity.NotificationType(c.Param("type")))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to reset email template")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EmailTemplateResponseFromEntity(template))
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EpicHandler manages the epics grouping a project's tasks, with the
+// progress of their tasks rolled up
+type EpicHandler struct {
+	epicUsecase usecase.EpicUsecase
+}
+
+func NewEpicHandler(epicUsecase usecase.EpicUsecase) *EpicHandler {
+	return &EpicHandler{epicUsecase: epicUsecase}
+}
+
+// CreateEpic godoc
+// @Summary Create an epic
+// @Description Create an epic to group the project's tasks toward a goal or milestone. Tasks join it through their epic_id.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param epic body dto.CreateEpicRequest true "Epic"
+// @Success 201 {object} dto.EpicResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/epics [post]
+func (h *EpicHandler) CreateEpic(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.CreateEpicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	summary, err := h.epicUsecase.Create(c.Request.Context(), usecase.CreateEpicRequest{
+		ProjectID:   projectID,
+		Title:       req.Title,
+		Description: req.Description,
+		DueDate:     req.DueDate,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to create epic")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.EpicResponseFromSummary(summary))
+}
+
+// ListProjectEpics godoc
+// @Summary List a project's epics
+// @Description List the project's epics with the progress of their tasks: done and total counts, and estimated against actual hours. Epics due first, then the newest.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.EpicListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/epics [get]
+func (h *EpicHandler) ListProjectEpics(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	summaries, err := h.epicUsecase.ListByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list epics")
+		return
+	}
+
+	page, meta := paginate(c, summaries)
+	c.JSON(http.StatusOK, dto.EpicListResponseFromSummaries(projectID, page, meta))
+}
+
+// GetEpic godoc
+// @Summary Get an epic
+// @Description Get an epic with the progress of its tasks
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Param id path string true "Epic ID"
+// @Success 200 {object} dto.EpicResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/epics/{id} [get]
+func (h *EpicHandler) GetEpic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid epic ID"))
+		return
+	}
+
+	summary, err := h.epicUsecase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EpicResponseFromSummary(summary))
+}
+
+// UpdateEpic godoc
+// @Summary Update an epic
+// @Description Update the provided fields of an epic. Set clear_due_date to remove its due date.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Param id path string true "Epic ID"
+// @Param epic body dto.UpdateEpicRequest true "Fields to update"
+// @Success 200 {object} dto.EpicResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/epics/{id} [put]
+func (h *EpicHandler) UpdateEpic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid epic ID"))
+		return
+	}
+
+	var req dto.UpdateEpicRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	summary, err := h.epicUsecase.Update(c.Request.Context(), id, usecase.UpdateEpicRequest{
+		Title:        req.Title,
+		Description:  req.Description,
+		DueDate:      req.DueDate,
+		ClearDueDate: req.ClearDueDate,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update epic")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.EpicResponseFromSummary(summary))
+}
+
+// DeleteEpic godoc
+// @Summary Delete an epic
+// @Description Delete an epic. Its tasks are kept, no longer grouped under an epic.
+// @Tags epics
+// @Accept json
+// @Produce json
+// @Param id path string true "Epic ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/epics/{id} [delete]
+func (h *EpicHandler) DeleteEpic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid epic ID"))
+		return
+	}
+
+	if err := h.epicUsecase.Delete(c.Request.Context(), id); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete epic")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func serveEpics(epicUsecase usecase.EpicUsecase, method, target, body string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	h := NewEpicHandler(epicUsecase)
+	router.POST("/projects/:id/epics", h.CreateEpic)
+	router.GET("/projects/:id/epics", h.ListProjectEpics)
+	router.GET("/epics/:id", h.GetEpic)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestEpicHandler(t *testing.T) {
+	projectID := uuid.New()
+	epicID := uuid.New()
+	summary := &usecase.EpicSummary{
+		Epic: &entity.Epic{ID: epicID, ProjectID: projectID, Title: "Invoicing v2"},
+		Progress: &entity.EpicProgress{
+			EpicID: epicID, TotalTasks: 5, DoneTasks: 2, CancelledTasks: 1, EstimatedHours: 12, ActualHours: 7.5,
+		},
+	}
+
+	t.Run("creates an epic", func(t *testing.T) {
+		epicUsecase := usecase.NewEpicUsecaseMock(t)
+		epicUsecase.EXPECT().Create(mock.Anything, usecase.CreateEpicRequest{ProjectID: projectID, Title: "Invoicing v2"}).Return(summary, nil)
+
+		w := serveEpics(epicUsecase, http.MethodPost, fmt.Sprintf("/projects/%s/epics", projectID), `{"title":"Invoicing v2"}`)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resp dto.EpicResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, epicID, resp.ID)
+		assert.Equal(t, 50.0, resp.Progress.PercentComplete)
+		assert.Equal(t, 7.5, resp.Progress.ActualHours)
+	})
+
+	t.Run("requires a title", func(t *testing.T) {
+		epicUsecase := usecase.NewEpicUsecaseMock(t)
+
+		w := serveEpics(epicUsecase, http.MethodPost, fmt.Sprintf("/projects/%s/epics", projectID), `{}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("lists the project's epics", func(t *testing.T) {
+		epicUsecase := usecase.NewEpicUsecaseMock(t)
+		epicUsecase.EXPECT().ListByProjectID(mock.Anything, projectID).Return([]*usecase.EpicSummary{summary}, nil)
+
+		w := serveEpics(epicUsecase, http.MethodGet, fmt.Sprintf("/projects/%s/epics", projectID), "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp dto.EpicListResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, 5, resp.Items[0].Progress.TotalTasks)
+	})
+
+	t.Run("unknown epic", func(t *testing.T) {
+		epicUsecase := usecase.NewEpicUsecaseMock(t)
+		epicUsecase.EXPECT().GetByID(mock.Anything, epicID).Return(nil, usecase.ErrEpicNotFound)
+
+		w := serveEpics(epicUsecase, http.MethodGet, "/epics/"+epicID.String(), "")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), dto.ErrorCodeEpicNotFound)
+	})
+}
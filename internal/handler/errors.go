@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// respondError writes the standard error body. Known domain errors (failed
+// validation, duplicates, invalid transitions) are served with the status
+// their error code implies; anything else uses fallback.
+func respondError(c *gin.Context, err error, fallback int, message string) {
+	status := dto.ErrorCodeFor(err, fallback).HTTPStatus(fallback)
+	c.JSON(status, dto.NewErrorResponse(err, status, message))
+}
+
+// respondBindingError writes the error body for a request that could not be
+// bound. Failed field validations are described one by one in the request's
+// locale; malformed requests get the standard error body.
+func respondBindingError(c *gin.Context, err error, message string) {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		locale := i18n.FromContext(c.Request.Context())
+		c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse(locale, validationErrorDetails(locale, validationErrors)))
+		return
+	}
+	c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, message))
+}
+
+// respondPlanningOverloaded writes the 429 refusing new planning, with the
+// load and the estimated wait in the details and Retry-After. It reports
+// whether err was such a refusal.
+func respondPlanningOverloaded(c *gin.Context, err error) bool {
+	var overload *usecase.PlanningOverloadError
+	if !errors.As(err, &overload) {
+		return false
+	}
+	wait := strconv.Itoa(int(math.Ceil(overload.EstimatedWait.Seconds())))
+	response := dto.NewErrorResponse(err, http.StatusTooManyRequests, "Too much planning work in progress, try again later")
+	response.Details = map[string]string{
+		"queue_depth":            strconv.Itoa(overload.QueueDepth),
+		"active_executions":      strconv.Itoa(overload.ActiveExecutions),
+		"estimated_wait_seconds": wait,
+	}
+	c.Header("Retry-After", wait)
+	c.JSON(http.StatusTooManyRequests, response)
+	return true
+}
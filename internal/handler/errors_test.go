@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/service/githubprojects"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRespondError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   dto.ErrorCode
+	}{
+		{
+			name:       "invalid status transition",
+			err:        fmt.Errorf("invalid status transition: %w", entity.ValidateStatusTransition(entity.TaskStatusDONE, entity.TaskStatusPLANNING)),
+			wantStatus: http.StatusConflict,
+			wantCode:   dto.ErrorCodeInvalidTransition,
+		},
+		{
+			name:       "plan approval outside review",
+			err:        fmt.Errorf("%w, current status: TODO", usecase.ErrTaskNotInPlanReview),
+			wantStatus: http.StatusConflict,
+			wantCode:   dto.ErrorCodePlanNotInReview,
+		},
+		{
+			name:       "domain validation error",
+			err:        usecase.ErrTaskFieldNotClearable,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   dto.ErrorCodeValidationFailed,
+		},
+		{
+			name:       "duplicate project name",
+			err:        usecase.ErrProjectNameExists,
+			wantStatus: http.StatusConflict,
+			wantCode:   dto.ErrorCodeDuplicateName,
+		},
+		{
+			name:       "jira integration not configured",
+			err:        usecase.ErrJiraNotConfigured,
+			wantStatus: http.StatusNotFound,
+			wantCode:   dto.ErrorCodeJiraNotConfigured,
+		},
+		{
+			name:       "github project board not found",
+			err:        fmt.Errorf("failed to read github project: %w", githubprojects.ErrBoardNotFound),
+			wantStatus: http.StatusNotFound,
+			wantCode:   dto.ErrorCodeGitHubProjectNotFound,
+		},
+		{
+			name:       "secrets store without a key",
+			err:        fmt.Errorf("failed to store jira API token: %w", secrets.ErrNoEncryptionKey),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   dto.ErrorCodeSecretsDisabled,
+		},
+		{
+			name:       "job queue unreachable",
+			err:        fmt.Errorf("failed to enqueue planning job: %w", usecase.ErrJobQueueUnavailable),
+			wantStatus: http.StatusServiceUnavailable,
+			wantCode:   dto.ErrorCodeQueueUnavailable,
+		},
+		{
+			name:       "unknown error keeps the fallback",
+			err:        errors.New("database is down"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   dto.ErrorCodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			respondError(c, tt.err, http.StatusInternalServerError, "Failed")
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var resp dto.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantCode, resp.ErrorCode)
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			assert.Equal(t, tt.err.Error(), resp.Error)
+		})
+	}
+}
+
+func TestNewErrorResponse_NilError(t *testing.T) {
+	resp := dto.NewErrorResponse(nil, http.StatusBadRequest, "Invalid status value")
+
+	assert.Equal(t, "Invalid status value", resp.Error)
+	assert.Equal(t, dto.ErrorCodeInvalidRequest, resp.ErrorCode)
+}
+
+func TestRespondBindingError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	type request struct {
+		Name string `json:"name" binding:"required"`
+	}
+	bind := func(acceptLanguage, body string) dto.ErrorResponse {
+		router := gin.New()
+		router.Use(LocaleMiddleware())
+		router.POST("/", func(c *gin.Context) {
+			var req request
+			if err := c.ShouldBindJSON(&req); err != nil {
+				respondBindingError(c, err, "Invalid request data")
+			}
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Accept-Language", acceptLanguage)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resp dto.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := bind("", `{}`)
+	assert.Equal(t, dto.ErrorCodeValidationFailed, resp.ErrorCode)
+	assert.Equal(t, map[string]string{"Name": "This field is required"}, resp.Details)
+
+	resp = bind("vi-VN,vi;q=0.9", `{}`)
+	assert.Equal(t, "Xác thực thất bại", resp.Error)
+	assert.Equal(t, map[string]string{"Name": "Trường này là bắt buộc"}, resp.Details)
+
+	resp = bind("vi", `{`)
+	assert.Equal(t, dto.ErrorCodeInvalidRequest, resp.ErrorCode, "malformed bodies are not validation failures")
+	assert.Equal(t, "Invalid request data", resp.Message)
+}
+
+func TestRespondPlanningOverloaded(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	overload := &usecase.PlanningOverloadError{QueueDepth: 12, ActiveExecutions: 4, EstimatedWait: 90*time.Minute + 500*time.Millisecond}
+
+	require.True(t, respondPlanningOverloaded(c, fmt.Errorf("start planning: %w", overload)))
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "5401", w.Header().Get("Retry-After"))
+	var resp dto.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, dto.ErrorCodePlanningOverloaded, resp.ErrorCode)
+	assert.Equal(t, map[string]string{
+		"queue_depth":            "12",
+		"active_executions":      "4",
+		"estimated_wait_seconds": "5401",
+	}, resp.Details)
+
+	assert.False(t, respondPlanningOverloaded(c, errors.New("connection refused")))
+	assert.False(t, respondPlanningOverloaded(c, nil))
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// weakETag derives a weak entity tag from the values a response body is
+// rendered from: the ID and update time of each resource, plus anything else
+// the body depends on (such as list totals). Weak tags are enough since bodies
+// are re-rendered from the same rows rather than stored byte-for-byte.
+func weakETag(parts ...any) string {
+	h := fnv.New64a()
+	for _, part := range parts {
+		if t, ok := part.(time.Time); ok {
+			part = t.UnixNano()
+		}
+		fmt.Fprintf(h, "%v;", part)
+	}
+	return fmt.Sprintf(`W/"%x"`, h.Sum64())
+}
+
+// taskListETag tags one page of a task list.
+func taskListETag(tasks []*entity.Task, meta dto.ListMeta) string {
+	parts := make([]any, 0, 2*len(tasks)+1)
+	parts = append(parts, meta)
+	for _, task := range tasks {
+		parts = append(parts, task.ID, task.UpdatedAt)
+	}
+	return weakETag(parts...)
+}
+
+// taskFullETag tags a task with what its detail view shows, changing when
+// any part of it does.
+func taskFullETag(response dto.TaskFullResponse) string {
+	parts := []any{response.Task.ID, response.Task.UpdatedAt}
+	if plan := response.LatestPlan; plan != nil {
+		parts = append(parts, plan.ID, plan.UpdatedAt)
+	}
+	if execution := response.LatestExecution; execution != nil {
+		parts = append(parts, execution.ID, execution.UpdatedAt, execution.LogCount)
+	}
+	if pr := response.PullRequest; pr != nil {
+		parts = append(parts, pr.ID, pr.UpdatedAt)
+	}
+	for _, comment := range response.Comments {
+		parts = append(parts, comment.ID, comment.UpdatedAt)
+	}
+	return weakETag(parts...)
+}
+
+// taskBoardETag tags a board, changing when a card or a column count does.
+func taskBoardETag(response dto.TaskBoardResponse) string {
+	parts := []any{response.Total}
+	for _, column := range response.Columns {
+		parts = append(parts, column.Status, column.Count)
+		for _, card := range column.Tasks {
+			parts = append(parts, card.ID, card.UpdatedAt)
+		}
+	}
+	return weakETag(parts...)
+}
+
+// checkNotModified sets the ETag of a GET response and answers 304 Not
+// Modified when the client's If-None-Match already holds it. Handlers return
+// without writing a body when it reports true.
+func checkNotModified(c *gin.Context, etag string) bool {
+	c.Header("ETag", etag)
+	// Clients may cache the response but must revalidate it on every use
+	c.Header("Cache-Control", "private, no-cache")
+
+	if !etagMatches(c.GetHeader("If-None-Match"), etag) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches applies the weak comparison of RFC 9110 to an If-None-Match
+// header value.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
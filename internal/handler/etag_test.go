@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeakETag(t *testing.T) {
+	id := uuid.New()
+	updatedAt := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	etag := weakETag(id, updatedAt)
+	assert.Regexp(t, `^W/"[0-9a-f]+"$`, etag)
+	assert.Equal(t, etag, weakETag(id, updatedAt.In(time.FixedZone("UTC+7", 7*3600))))
+	assert.NotEqual(t, etag, weakETag(id, updatedAt.Add(time.Microsecond)))
+	assert.NotEqual(t, etag, weakETag(uuid.New(), updatedAt))
+}
+
+func TestEtagMatches(t *testing.T) {
+	const etag = `W/"abc"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"no header", "", false},
+		{"same weak tag", `W/"abc"`, true},
+		{"strong form of the tag", `"abc"`, true},
+		{"one of a list", `"xyz", W/"abc"`, true},
+		{"wildcard", "*", true},
+		{"different tag", `W/"xyz"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, etagMatches(tt.ifNoneMatch, etag))
+		})
+	}
+}
+
+func TestCheckNotModified(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/resource", func(c *gin.Context) {
+		if checkNotModified(c, `W/"v1"`) {
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"name": "resource"})
+	})
+
+	t.Run("fresh request gets the body and its tag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/resource", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `W/"v1"`, w.Header().Get("ETag"))
+		assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+		assert.JSONEq(t, `{"name": "resource"}`, w.Body.String())
+	})
+
+	t.Run("matching tag is not modified", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+		req.Header.Set("If-None-Match", `W/"v1"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Equal(t, `W/"v1"`, w.Header().Get("ETag"))
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("stale tag gets the body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+		req.Header.Set("If-None-Match", `W/"v0"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Body.String())
+	})
+}
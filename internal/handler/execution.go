@@ -42,13 +42,13 @@ func (h *ExecutionHandler) GetTaskExecutions(c *gin.Context) {
 	taskIDStr := c.Param("id")
 	taskID, err := uuid.Parse(taskIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var query dto.ExecutionFilterQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid query parameters"))
+		respondBindingError(c, err, "Invalid query parameters")
 		return
 	}
 
@@ -90,18 +90,7 @@ func (h *ExecutionHandler) GetTaskExecutions(c *gin.Context) {
 		return
 	}
 
-	// Calculate pagination metadata
-	totalPages := int(total) / query.PageSize
-	if int(total)%query.PageSize > 0 {
-		totalPages++
-	}
-
-	meta := dto.PaginationMeta{
-		Page:       query.Page,
-		PageSize:   query.PageSize,
-		Total:      int(total),
-		TotalPages: totalPages,
-	}
+	meta := dto.NewListMeta(int(total), query.Page, query.PageSize)
 
 	response := dto.ToExecutionListResponse(executions, meta)
 	c.JSON(http.StatusOK, response)
@@ -126,7 +115,7 @@ func (h *ExecutionHandler) GetExecutionByID(c *gin.Context) {
 	executionIDStr := c.Param("id")
 	executionID, err := uuid.Parse(executionIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid execution ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
 		return
 	}
 
@@ -163,7 +152,7 @@ func (h *ExecutionHandler) GetExecutionByID(c *gin.Context) {
 
 // GetExecutionLogs godoc
 // @Summary Get execution logs
-// @Description Get logs for a specific execution with pagination and filtering
+// @Description Get logs for a specific execution with pagination and filtering. Logs of projects encrypting them are decrypted for requests with an API key; for others their message is withheld and they are flagged encrypted.
 // @Tags executions
 // @Accept json
 // @Produce json
@@ -177,27 +166,32 @@ func (h *ExecutionHandler) GetExecutionByID(c *gin.Context) {
 // @Param order_dir query string false "Order direction" default("desc") Enums(asc,desc)
 // @Success 200 {object} dto.ExecutionLogListResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
 // @Router /api/v1/executions/{id}/logs [get]
 func (h *ExecutionHandler) GetExecutionLogs(c *gin.Context) {
 	executionIDStr := c.Param("id")
 	executionID, err := uuid.Parse(executionIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid execution ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
 		return
 	}
 
 	var query dto.ExecutionLogFilterQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid query parameters"))
+		respondBindingError(c, err, "Invalid query parameters")
 		return
 	}
 
-	// Build filter request
+	// Build filter request. Only authenticated requests ask for encrypted
+	// logs to be opened, which the usecase does for maintainers of the
+	// project.
 	filterReq := usecase.GetExecutionLogsRequest{
-		Limit:  query.PageSize,
-		Offset: (query.Page - 1) * query.PageSize,
+		Limit:   query.PageSize,
+		Offset:  (query.Page - 1) * query.PageSize,
+		Decrypt: apiKeyOwner(c) != "",
 	}
 
 	// Apply optional filters
@@ -234,21 +228,117 @@ func (h *ExecutionHandler) GetExecutionLogs(c *gin.Context) {
 		return
 	}
 
-	// Calculate pagination metadata
-	totalPages := int(total) / query.PageSize
-	if int(total)%query.PageSize > 0 {
-		totalPages++
+	meta := dto.NewListMeta(int(total), query.Page, query.PageSize)
+
+	response := dto.ToExecutionLogListResponse(logs, meta)
+	c.JSON(http.StatusOK, response)
+}
+
+// GetExecutionVerificationRuns godoc
+// @Summary Get execution verification runs
+// @Description Get the runs of the project's lint, build and test commands made in the worktree after the execution
+// @Tags executions
+// @Accept json
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 200 {object} dto.VerificationRunListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/verification-runs [get]
+func (h *ExecutionHandler) GetExecutionVerificationRuns(c *gin.Context) {
+	executionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
 	}
 
-	meta := dto.PaginationMeta{
-		Page:       query.Page,
-		PageSize:   query.PageSize,
-		Total:      int(total),
-		TotalPages: totalPages,
+	runs, err := h.executionUsecase.GetVerificationRuns(c.Request.Context(), executionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get execution verification runs"))
+		return
 	}
 
-	response := dto.ToExecutionLogListResponse(logs, meta)
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, dto.ToVerificationRunListResponse(runs))
+}
+
+// GetExecutionAttempts godoc
+// @Summary Get execution attempts
+// @Description Get the attempt chain the execution belongs to: its first attempt, then each retry of the failed attempt before it
+// @Tags executions
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 200 {object} dto.ExecutionAttemptListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/attempts [get]
+func (h *ExecutionHandler) GetExecutionAttempts(c *gin.Context) {
+	executionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	attempts, err := h.executionUsecase.GetAttempts(c.Request.Context(), executionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get execution attempts"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToExecutionAttemptListResponse(attempts))
+}
+
+// GetExecutionReviewComments godoc
+// @Summary Get execution review comments
+// @Description Get the findings of the AI review of the execution's changes, made before its pull request was opened
+// @Tags executions
+// @Accept json
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 200 {object} dto.ReviewCommentListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/review-comments [get]
+func (h *ExecutionHandler) GetExecutionReviewComments(c *gin.Context) {
+	executionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	comments, err := h.executionUsecase.GetReviewComments(c.Request.Context(), executionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get execution review comments"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToReviewCommentListResponse(comments))
+}
+
+// GetExecutionSecurityFindings godoc
+// @Summary Get execution security findings
+// @Description Get the issues the project's security scanner found in the files the execution changed, and the secrets the built-in secret scan found in its diff (scanner "secrets")
+// @Tags executions
+// @Accept json
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 200 {object} dto.SecurityFindingListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/security-findings [get]
+func (h *ExecutionHandler) GetExecutionSecurityFindings(c *gin.Context) {
+	executionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	findings, err := h.executionUsecase.GetSecurityFindings(c.Request.Context(), executionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get execution security findings"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSecurityFindingListResponse(findings))
 }
 
 // CreateExecution godoc
@@ -265,7 +355,7 @@ func (h *ExecutionHandler) GetExecutionLogs(c *gin.Context) {
 func (h *ExecutionHandler) CreateExecution(c *gin.Context) {
 	var req dto.ExecutionCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -300,13 +390,13 @@ func (h *ExecutionHandler) UpdateExecution(c *gin.Context) {
 	executionIDStr := c.Param("id")
 	executionID, err := uuid.Parse(executionIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid execution ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
 		return
 	}
 
 	var req dto.ExecutionUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -342,7 +432,7 @@ func (h *ExecutionHandler) DeleteExecution(c *gin.Context) {
 	executionIDStr := c.Param("id")
 	executionID, err := uuid.Parse(executionIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid execution ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
 		return
 	}
 
@@ -372,7 +462,7 @@ func (h *ExecutionHandler) GetExecutionStats(c *gin.Context) {
 	if taskIDStr := c.Query("task_id"); taskIDStr != "" {
 		parsedTaskID, err := uuid.Parse(taskIDStr)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 			return
 		}
 		taskID = &parsedTaskID
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionHandler_GetExecutionLogs_EncryptedLogs(t *testing.T) {
+	executionID := uuid.New()
+	path := "/executions/" + executionID.String() + "/logs"
+
+	setup := func(executionUsecase usecase.ExecutionUsecase) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		apiKeyAuth := APIKeyMiddleware(map[string]string{"secret-key": "alice"})
+		router.GET("/executions/:id/logs", OptionalAPIKey(apiKeyAuth), NewExecutionHandler(executionUsecase).GetExecutionLogs)
+		return router
+	}
+	// The usecase returns the logs it could not open still encrypted
+	expectLogs := func(executionUsecase *usecase.ExecutionUsecaseMock, decrypt bool) {
+		executionUsecase.EXPECT().GetExecutionLogs(mock.Anything, executionID, mock.MatchedBy(func(req usecase.GetExecutionLogsRequest) bool {
+			return req.Decrypt == decrypt
+		})).Return([]*entity.ExecutionLog{
+			{ExecutionID: executionID, Message: "sealed", Encrypted: !decrypt},
+		}, 1, nil)
+	}
+	get := func(router *gin.Engine, header, value string) (*httptest.ResponseRecorder, dto.ExecutionLogListResponse) {
+		req := httptest.NewRequest(http.MethodGet, path+"?page=1&page_size=50", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var response dto.ExecutionLogListResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w, response
+	}
+
+	t.Run("anonymous requests get encrypted logs withheld", func(t *testing.T) {
+		executionUsecase := usecase.NewExecutionUsecaseMock(t)
+		expectLogs(executionUsecase, false)
+
+		w, response := get(setup(executionUsecase), "", "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, response.Items, 1)
+		assert.True(t, response.Items[0].Encrypted)
+		assert.Empty(t, response.Items[0].Message)
+	})
+
+	t.Run("requests with an API key get logs decrypted", func(t *testing.T) {
+		executionUsecase := usecase.NewExecutionUsecaseMock(t)
+		expectLogs(executionUsecase, true)
+
+		w, response := get(setup(executionUsecase), APIKeyHeader, "secret-key")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, response.Items, 1)
+		assert.False(t, response.Items[0].Encrypted)
+		assert.Equal(t, "sealed", response.Items[0].Message)
+	})
+
+	t.Run("unknown key is rejected", func(t *testing.T) {
+		w, _ := get(setup(usecase.NewExecutionUsecaseMock(t)), "Authorization", "Bearer wrong-key")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type GitHubProjectHandler struct {
+	githubProjectUsecase usecase.GitHubProjectUsecase
+	githubIssueUsecase   usecase.GitHubIssueUsecase
+}
+
+func NewGitHubProjectHandler(githubProjectUsecase usecase.GitHubProjectUsecase, githubIssueUsecase usecase.GitHubIssueUsecase) *GitHubProjectHandler {
+	return &GitHubProjectHandler{
+		githubProjectUsecase: githubProjectUsecase,
+		githubIssueUsecase:   githubIssueUsecase,
+	}
+}
+
+// ImportGitHubProject godoc
+// @Summary Import a GitHub project board as tasks
+// @Description Create a task for every item on a GitHub Projects (v2) board and refresh the fields of previously imported ones. Board columns map onto the status new tasks start in; see column_mapping. Uses the server's GITHUB_TOKEN, which needs the read:project scope.
+// @Tags github
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param board body dto.GitHubProjectImportRequest true "GitHub project board to import"
+// @Success 200 {object} dto.GitHubProjectImportResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 502 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/github-project/import [post]
+func (h *GitHubProjectHandler) ImportGitHubProject(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.GitHubProjectImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	result, err := h.githubProjectUsecase.Import(c.Request.Context(), projectID, usecase.ImportGitHubProjectRequest{
+		Owner:         req.Owner,
+		Number:        req.Number,
+		StatusField:   req.StatusField,
+		ColumnMapping: req.ColumnMapping,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusBadGateway, "Failed to import GitHub project")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GitHubProjectImportResponseFromResult(result))
+}
+
+// ImportGitHubIssues godoc
+// @Summary Import GitHub issues as tasks
+// @Description Create a task for every selected issue of a GitHub repository and refresh the title, body, labels and assignee of previously imported ones. Issues are selected by state, labels and numbers. Each new task is linked from its issue with a comment; with post_comments the plan and the pull request are posted to the issue too. Uses the server's GITHUB_TOKEN, which needs read and write access to issues.
+// @Tags github
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param issues body dto.GitHubIssueImportRequest true "GitHub issues to import"
+// @Success 200 {object} dto.GitHubIssueImportResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 502 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/import/github-issues [post]
+func (h *GitHubProjectHandler) ImportGitHubIssues(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.GitHubIssueImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	result, err := h.githubIssueUsecase.Import(c.Request.Context(), projectID, usecase.ImportGitHubIssuesRequest{
+		Repository:   req.Repository,
+		Labels:       req.Labels,
+		State:        req.State,
+		Numbers:      req.Numbers,
+		PostComments: req.PostComments,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusBadGateway, "Failed to import GitHub issues")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GitHubIssueImportResponseFromResult(result))
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/graph"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+type GraphQLHandler struct {
+	service *graph.Service
+}
+
+func NewGraphQLHandler(service *graph.Service) *GraphQLHandler {
+	return &GraphQLHandler{
+		service: service,
+	}
+}
+
+// SetupGraphQLRoutes registers the GraphQL endpoint. It is unversioned and
+// honours the same organization scoping and authentication as the REST API.
+func SetupGraphQLRoutes(router *gin.Engine, graphqlHandler *GraphQLHandler, organizationUsecase usecase.OrganizationUsecase, auth gin.HandlerFunc) {
+	router.POST("/graphql", auth, TenantMiddleware(organizationUsecase), graphqlHandler.Query)
+}
+
+// Query godoc
+// @Summary Execute a GraphQL operation
+// @Description Run a GraphQL query or mutation over projects, tasks and their plans, executions and pull requests. Execution errors are reported in the errors field with status 200.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body dto.GraphQLRequest true "GraphQL request"
+// @Success 200 {object} dto.GraphQLResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req dto.GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid GraphQL request")
+		return
+	}
+
+	response := h.service.Exec(c.Request.Context(), req.Query, req.OperationName, req.Variables)
+	c.JSON(http.StatusOK, response)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/auto-devs/auto-devs/internal/websocket"
 	"github.com/auto-devs/auto-devs/pkg/database"
 	"github.com/gin-gonic/gin"
 )
@@ -13,6 +14,7 @@ type HealthResponse struct {
 	Timestamp time.Time      `json:"timestamp"`
 	Version   string         `json:"version"`
 	Database  DatabaseHealth `json:"database"`
+	Redis     *RedisHealth   `json:"redis,omitempty"`
 }
 
 type DatabaseHealth struct {
@@ -20,14 +22,40 @@ type DatabaseHealth struct {
 	Error  string `json:"error,omitempty"`
 }
 
-func SetupHealthRoutes(router *gin.Engine, db *database.GormDB) {
+// RedisHealth reports the Redis server jobs and notifications go through.
+// While it is unreachable, jobs are enqueued with retries and notifications
+// are held until it is back.
+type RedisHealth struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	// BufferedNotifications counts the WebSocket notifications waiting
+	// for Redis
+	BufferedNotifications int `json:"buffered_notifications"`
+}
+
+// RedisChecker checks the Redis server can be reached
+type RedisChecker interface {
+	Ping() error
+}
+
+// SetupHealthRoutes registers the health check. redis and wsService may be
+// nil, leaving Redis out of the check.
+func SetupHealthRoutes(router *gin.Engine, db *database.GormDB, redis RedisChecker, wsService *websocket.Service) {
 	v1 := router.Group("/api/v1")
 	{
-		v1.GET("/health", healthCheck(db))
+		v1.GET("/health", healthCheck(db, redis, wsService))
 	}
 }
 
-func healthCheck(db *database.GormDB) gin.HandlerFunc {
+// healthCheck godoc
+// @Summary Health check
+// @Description Report service, database and Redis health. The status is degraded when either cannot be reached, or notifications wait for Redis; the frontend shows a banner then. Only an unreachable database answers 503, as the API keeps serving without Redis.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Failure 503 {object} HealthResponse
+// @Router /api/v1/health [get]
+func healthCheck(db *database.GormDB, redis RedisChecker, wsService *websocket.Service) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		dbHealth := DatabaseHealth{
 			Status: "ok",
@@ -45,8 +73,10 @@ func healthCheck(db *database.GormDB) gin.HandlerFunc {
 			}
 		}
 
+		redisHealth := checkRedis(redis, wsService)
+
 		overallStatus := "ok"
-		if dbHealth.Status == "error" {
+		if dbHealth.Status == "error" || (redisHealth != nil && redisHealth.Status != "ok") {
 			overallStatus = "degraded"
 		}
 
@@ -55,13 +85,35 @@ func healthCheck(db *database.GormDB) gin.HandlerFunc {
 			Timestamp: time.Now(),
 			Version:   "1.0.0",
 			Database:  dbHealth,
+			Redis:     redisHealth,
 		}
 
 		statusCode := http.StatusOK
-		if overallStatus == "degraded" {
+		if dbHealth.Status == "error" {
 			statusCode = http.StatusServiceUnavailable
 		}
 
 		c.JSON(statusCode, response)
 	}
 }
+
+// checkRedis reports whether Redis can be reached and how many
+// notifications wait for it, or nil when Redis is not checked
+func checkRedis(redis RedisChecker, wsService *websocket.Service) *RedisHealth {
+	if redis == nil {
+		return nil
+	}
+
+	health := &RedisHealth{Status: "ok"}
+	if wsService != nil {
+		health.BufferedNotifications = wsService.BufferedNotifications()
+	}
+	if err := redis.Ping(); err != nil {
+		health.Status = "error"
+		health.Error = err.Error()
+	} else if health.BufferedNotifications > 0 {
+		// Reachable again, but the notifications are not all delivered yet
+		health.Status = "recovering"
+	}
+	return health
+}
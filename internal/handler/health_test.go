@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type redisCheckerFunc func() error
+
+func (f redisCheckerFunc) Ping() error { return f() }
+
+func TestCheckRedis(t *testing.T) {
+	t.Run("reports a reachable Redis", func(t *testing.T) {
+		health := checkRedis(redisCheckerFunc(func() error { return nil }), nil)
+
+		require.NotNil(t, health)
+		assert.Equal(t, "ok", health.Status)
+		assert.Empty(t, health.Error)
+	})
+
+	t.Run("reports an unreachable Redis", func(t *testing.T) {
+		health := checkRedis(redisCheckerFunc(func() error { return errors.New("connection refused") }), nil)
+
+		require.NotNil(t, health)
+		assert.Equal(t, "error", health.Status)
+		assert.Equal(t, "connection refused", health.Error)
+	})
+
+	t.Run("leaves Redis out without a checker", func(t *testing.T) {
+		assert.Nil(t, checkRedis(nil, nil))
+	})
+}
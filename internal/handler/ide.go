@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// IDEHandler serves the compact, API-key authenticated endpoints that editor
+// plugins drive the workflow through.
+type IDEHandler struct {
+	taskUsecase usecase.TaskUsecase
+	wsService   *websocket.Service
+}
+
+func NewIDEHandler(taskUsecase usecase.TaskUsecase, wsService *websocket.Service) *IDEHandler {
+	return &IDEHandler{
+		taskUsecase: taskUsecase,
+		wsService:   wsService,
+	}
+}
+
+// CreateTaskFromSelection godoc
+// @Summary Create a task from an editor selection
+// @Description Create a TODO task assigned to the API key's owner, with the selected code and its location in the description
+// @Tags ide
+// @Accept json
+// @Produce json
+// @Security APIKeyAuth
+// @Param task body dto.IDETaskCreateRequest true "Selection and task title"
+// @Success 201 {object} dto.TaskResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/ide/tasks [post]
+func (h *IDEHandler) CreateTaskFromSelection(c *gin.Context) {
+	var req dto.IDETaskCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	owner := apiKeyOwner(c)
+	task, err := h.taskUsecase.Create(c.Request.Context(), usecase.CreateTaskRequest{
+		ProjectID:   req.ProjectID,
+		Title:       req.Title,
+		Description: selectionDescription(req),
+		AssignedTo:  &owner,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to create task"))
+		return
+	}
+
+	response := dto.TaskResponseFromEntity(task)
+	if h.wsService != nil {
+		if err := h.wsService.NotifyTaskCreated(response, task.ProjectID); err != nil {
+			log.Printf("Failed to send WebSocket notification for task creation: %v", err)
+		}
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListMyTasks godoc
+// @Summary List the tasks assigned to the API key's owner
+// @Description List open tasks assigned to the API key's owner, most recently updated first
+// @Tags ide
+// @Accept json
+// @Produce json
+// @Security APIKeyAuth
+// @Param project_id query string false "Only tasks of this project"
+// @Param include_done query bool false "Include DONE and CANCELLED tasks"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.TaskListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/ide/tasks/mine [get]
+func (h *IDEHandler) ListMyTasks(c *gin.Context) {
+	owner := apiKeyOwner(c)
+	orderBy, orderDir := "updated_at", "desc"
+	req := usecase.GetTasksFilterRequest{
+		AssignedTo: &owner,
+		OrderBy:    &orderBy,
+		OrderDir:   &orderDir,
+	}
+
+	if projectIDStr := c.Query("project_id"); projectIDStr != "" {
+		projectID, err := uuid.Parse(projectIDStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+			return
+		}
+		req.ProjectID = &projectID
+	}
+	if c.Query("include_done") != "true" {
+		req.Statuses = openTaskStatuses()
+	}
+
+	tasks, err := h.taskUsecase.GetTasksWithFilters(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to fetch tasks"))
+		return
+	}
+
+	page, meta := paginate(c, tasks)
+	c.JSON(http.StatusOK, dto.TaskListResponseFromEntities(page, meta))
+}
+
+// GetTaskWorktree godoc
+// @Summary Get where to open a task's worktree
+// @Description Get the local worktree path and branch of a task, with a URI that opens it in VS Code
+// @Tags ide
+// @Accept json
+// @Produce json
+// @Security APIKeyAuth
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.IDEWorktreeResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/ide/tasks/{id}/worktree [get]
+func (h *IDEHandler) GetTaskWorktree(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+	if task.WorktreePath == nil || *task.WorktreePath == "" {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(errors.New("task has no worktree"), http.StatusNotFound, dto.ErrorCodeWorktreeNotFound, "Task does not have a worktree yet"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.IDEWorktreeResponse{
+		TaskID:         task.ID,
+		WorktreePath:   *task.WorktreePath,
+		BranchName:     task.BranchName,
+		BaseBranchName: task.BaseBranchName,
+		EditorURI:      "vscode://file" + *task.WorktreePath,
+	})
+}
+
+// openTaskStatuses are the statuses of tasks still being worked on
+func openTaskStatuses() []entity.TaskStatus {
+	var statuses []entity.TaskStatus
+	for _, status := range entity.GetAllTaskStatuses() {
+		if status != entity.TaskStatusDONE && status != entity.TaskStatusCANCELLED {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// selectionDescription renders the note, the selection's location and the
+// selected code as the markdown task description.
+func selectionDescription(req dto.IDETaskCreateRequest) string {
+	var b strings.Builder
+	if req.Note != "" {
+		b.WriteString(req.Note + "\n\n")
+	}
+
+	location := req.FilePath
+	switch {
+	case req.StartLine > 0 && req.EndLine > req.StartLine:
+		location += fmt.Sprintf(":%d-%d", req.StartLine, req.EndLine)
+	case req.StartLine > 0:
+		location += fmt.Sprintf(":%d", req.StartLine)
+	}
+	fmt.Fprintf(&b, "`%s`", location)
+
+	if req.Selection != "" {
+		fmt.Fprintf(&b, "\n\n```%s\n%s\n```", req.Language, strings.TrimRight(req.Selection, "\n"))
+	}
+	return b.String()
+}
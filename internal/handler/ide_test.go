@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupIDERouter(taskUsecase usecase.TaskUsecase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	ide := router.Group("/ide", APIKeyMiddleware(map[string]string{"secret-key": "alice"}))
+	handler := NewIDEHandler(taskUsecase, nil)
+	ide.POST("/tasks", handler.CreateTaskFromSelection)
+	ide.GET("/tasks/:id/worktree", handler.GetTaskWorktree)
+	return router
+}
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	taskID := uuid.New()
+	path := "/ide/tasks/" + taskID.String() + "/worktree"
+	worktree := "/worktrees/p/t"
+
+	t.Run("missing key is rejected", func(t *testing.T) {
+		router := setupIDERouter(usecase.NewTaskUsecaseMock(t))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("unknown key is rejected", func(t *testing.T) {
+		router := setupIDERouter(usecase.NewTaskUsecaseMock(t))
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(APIKeyHeader, "wrong-key")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	for name, header := range map[string][2]string{
+		"X-API-Key header": {APIKeyHeader, "secret-key"},
+		"bearer token":     {"Authorization", "Bearer secret-key"},
+	} {
+		t.Run(name+" is accepted", func(t *testing.T) {
+			taskUsecase := usecase.NewTaskUsecaseMock(t)
+			taskUsecase.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, WorktreePath: &worktree}, nil)
+			router := setupIDERouter(taskUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Header.Set(header[0], header[1])
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Contains(t, w.Body.String(), `"editor_uri":"vscode://file/worktrees/p/t"`)
+		})
+	}
+}
+
+func TestIDEHandler_CreateTaskFromSelection(t *testing.T) {
+	projectID := uuid.New()
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req usecase.CreateTaskRequest) bool {
+		return req.ProjectID == projectID &&
+			req.AssignedTo != nil && *req.AssignedTo == "alice" &&
+			req.Description == "Breaks on empty input\n\n`main.go:3-4`\n\n```go\nif x {\n}\n```"
+	})).Return(&entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Fix it", Status: entity.TaskStatusTODO}, nil)
+	router := setupIDERouter(taskUsecase)
+
+	body, _ := json.Marshal(map[string]any{
+		"project_id": projectID,
+		"title":      "Fix it",
+		"note":       "Breaks on empty input",
+		"file_path":  "main.go",
+		"start_line": 3,
+		"end_line":   4,
+		"language":   "go",
+		"selection":  "if x {\n}\n",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/ide/tasks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(APIKeyHeader, "secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestIDEHandler_GetTaskWorktree_NoWorktree(t *testing.T) {
+	taskID := uuid.New()
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID}, nil)
+	router := setupIDERouter(taskUsecase)
+
+	req := httptest.NewRequest(http.MethodGet, "/ide/tasks/"+taskID.String()+"/worktree", nil)
+	req.Header.Set(APIKeyHeader, "secret-key")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
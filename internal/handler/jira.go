@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type JiraHandler struct {
+	jiraUsecase usecase.JiraUsecase
+}
+
+func NewJiraHandler(jiraUsecase usecase.JiraUsecase) *JiraHandler {
+	return &JiraHandler{
+		jiraUsecase: jiraUsecase,
+	}
+}
+
+// GetJiraIntegration godoc
+// @Summary Get a project's Jira integration
+// @Description Get the Jira site, JQL and status mappings configured for a project. The API token is never returned.
+// @Tags jira
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.JiraIntegrationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/jira [get]
+func (h *JiraHandler) GetJiraIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	integration, err := h.jiraUsecase.Get(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to fetch Jira integration")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.JiraIntegrationResponseFromEntity(integration))
+}
+
+// ConfigureJiraIntegration godoc
+// @Summary Configure a project's Jira integration
+// @Description Create or replace a project's Jira integration. The API token is stored encrypted in the secrets store; omit it to keep the current one.
+// @Tags jira
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param integration body dto.JiraIntegrationRequest true "Jira integration settings"
+// @Success 200 {object} dto.JiraIntegrationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/jira [put]
+func (h *JiraHandler) ConfigureJiraIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.JiraIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	integration, err := h.jiraUsecase.Configure(c.Request.Context(), projectID, usecase.ConfigureJiraRequest{
+		BaseURL:           req.BaseURL,
+		Email:             req.Email,
+		APIToken:          req.APIToken,
+		JQL:               req.JQL,
+		StatusMapping:     req.StatusMapping,
+		TransitionMapping: req.TransitionMapping,
+		SyncStatusBack:    req.SyncStatusBack,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to configure Jira integration")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.JiraIntegrationResponseFromEntity(integration))
+}
+
+// DeleteJiraIntegration godoc
+// @Summary Remove a project's Jira integration
+// @Description Remove a project's Jira integration and its stored API token. Imported tasks are kept.
+// @Tags jira
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/jira [delete]
+func (h *JiraHandler) DeleteJiraIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	if err := h.jiraUsecase.Delete(c.Request.Context(), projectID); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete Jira integration")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ImportJiraIssues godoc
+// @Summary Import Jira issues as tasks
+// @Description Create a task for every issue matching the integration's JQL and refresh the fields of previously imported ones
+// @Tags jira
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.JiraImportResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 502 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/jira/import [post]
+func (h *JiraHandler) ImportJiraIssues(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	result, err := h.jiraUsecase.Import(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusBadGateway, "Failed to import Jira issues")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.JiraImportResponseFromResult(result))
+}
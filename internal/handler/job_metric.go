@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// JobMetricHandler serves the history of how the background jobs were
+// processed, for charting their throughput
+type JobMetricHandler struct {
+	jobMetricUsecase usecase.JobMetricUsecase
+}
+
+func NewJobMetricHandler(jobMetricUsecase usecase.JobMetricUsecase) *JobMetricHandler {
+	return &JobMetricHandler{jobMetricUsecase: jobMetricUsecase}
+}
+
+// GetJobMetrics godoc
+// @Summary Get the processing history of the background jobs
+// @Description Get how many jobs of each type the workers processed and failed each UTC day, and how long they ran, with totals per job type over the range. Retries count as runs. The range covers the UTC days of from and to, the last 30 days by default and at most 366 days. Durations are in seconds.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param from query string false "A time within the first day covered (RFC 3339)"
+// @Param to query string false "A time within the last day covered (RFC 3339), today by default"
+// @Param job_type query string false "Only jobs of this type, such as task:planning"
+// @Success 200 {object} dto.JobMetricsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/jobs/metrics [get]
+func (h *JobMetricHandler) GetJobMetrics(c *gin.Context) {
+	var query dto.JobMetricsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	metrics, err := h.jobMetricUsecase.GetJobMetrics(c.Request.Context(), query.From, query.To, query.JobType)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get job metrics")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.JobMetricsResponseFromMetrics(metrics))
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func serveJobMetrics(jobMetricUsecase usecase.JobMetricUsecase, target string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/jobs/metrics", NewJobMetricHandler(jobMetricUsecase).GetJobMetrics)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func TestJobMetricHandler_GetJobMetrics(t *testing.T) {
+	day := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns the days and totals", func(t *testing.T) {
+		jobMetricUsecase := usecase.NewJobMetricUsecaseMock(t)
+		jobMetricUsecase.EXPECT().GetJobMetrics(mock.Anything, mock.MatchedBy(func(from *time.Time) bool {
+			return from != nil && from.Equal(day)
+		}), (*time.Time)(nil), "task:planning").Return(&usecase.JobMetrics{
+			From: day,
+			To:   day.AddDate(0, 0, 1),
+			Days: []*entity.JobMetric{
+				{Day: day, JobType: "task:planning", Processed: 4, Failed: 1, TotalDurationMs: 10000, MaxDurationMs: 4000},
+			},
+			Totals: []*usecase.JobTypeMetrics{
+				{JobType: "task:planning", Processed: 4, Failed: 1, AverageDuration: 2500 * time.Millisecond, MaxDuration: 4 * time.Second},
+			},
+		}, nil)
+
+		w := serveJobMetrics(jobMetricUsecase, "/jobs/metrics?from=2024-03-05T00:00:00Z&job_type=task:planning")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{
+			"from": "2024-03-05T00:00:00Z",
+			"to": "2024-03-06T00:00:00Z",
+			"days": [{"day": "2024-03-05T00:00:00Z", "job_type": "task:planning", "processed": 4, "failed": 1, "failure_rate": 0.25, "average_duration_seconds": 2.5, "max_duration_seconds": 4}],
+			"totals": [{"job_type": "task:planning", "processed": 4, "failed": 1, "failure_rate": 0.25, "average_duration_seconds": 2.5, "max_duration_seconds": 4}]
+		}`, w.Body.String())
+	})
+
+	t.Run("rejects a range over a year", func(t *testing.T) {
+		jobMetricUsecase := usecase.NewJobMetricUsecaseMock(t)
+		jobMetricUsecase.EXPECT().GetJobMetrics(mock.Anything, mock.Anything, mock.Anything, "").Return(nil, usecase.ErrJobMetricsRangeTooLong)
+
+		w := serveJobMetrics(jobMetricUsecase, "/jobs/metrics?from=2020-01-01T00:00:00Z")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("fails when the metrics cannot be read", func(t *testing.T) {
+		jobMetricUsecase := usecase.NewJobMetricUsecaseMock(t)
+		jobMetricUsecase.EXPECT().GetJobMetrics(mock.Anything, mock.Anything, mock.Anything, "").Return(nil, errors.New("database is down"))
+
+		w := serveJobMetrics(jobMetricUsecase, "/jobs/metrics")
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -7,9 +7,15 @@ import (
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/time/rate"
 )
 
@@ -31,14 +37,58 @@ func CORSMiddleware() gin.HandlerFunc {
 			"*", // Allow all origins for WebSocket
 		}, // React dev servers
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "Upgrade", "Connection", "Sec-WebSocket-Key", "Sec-WebSocket-Version", "Sec-WebSocket-Protocol"},
-		ExposeHeaders:    []string{"Content-Length", "X-Total-Count"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "Upgrade", "Connection", "Sec-WebSocket-Key", "Sec-WebSocket-Version", "Sec-WebSocket-Protocol", OrganizationHeader, APIKeyHeader, "If-None-Match", "traceparent", "tracestate"},
+		ExposeHeaders:    []string{"Content-Length", "X-Total-Count", "ETag", TraceIDHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
 	return cors.New(config)
 }
 
+// TraceIDHeader returns the ID of the trace a request was served in, to find
+// the jobs and AI executions it started
+const TraceIDHeader = "X-Trace-ID"
+
+// TracingMiddleware serves every request in a span, continuing the trace of
+// the caller's traceparent header if any. The WebSocket connection, open for
+// as long as the page, is not traced.
+func TracingMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.URL.Path == "/ws" {
+			c.Next()
+			return
+		}
+
+		// Unmatched paths are left out of the span name, which would take
+		// any value
+		route := c.FullPath()
+		name := c.Request.Method + " " + route
+		if route == "" {
+			name = c.Request.Method
+		}
+		ctx, span := tracing.Start(tracing.ExtractHTTP(c.Request.Context(), c.Request.Header), name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", c.Request.URL.Path),
+			))
+		defer span.End()
+		c.Request = c.Request.WithContext(ctx)
+		if traceID := tracing.TraceID(ctx); traceID != "" {
+			c.Header(TraceIDHeader, traceID)
+		}
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
 // RequestLoggingMiddleware logs API requests and responses
 func RequestLoggingMiddleware() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
@@ -73,9 +123,10 @@ func ErrorHandlingMiddleware() gin.HandlerFunc {
 
 		if err, ok := recovered.(string); ok {
 			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-				Error:   err,
-				Message: "Internal server error",
-				Code:    http.StatusInternalServerError,
+				Error:     err,
+				Message:   "Internal server error",
+				Code:      http.StatusInternalServerError,
+				ErrorCode: dto.ErrorCodeInternal,
 			})
 		}
 		c.AbortWithStatus(http.StatusInternalServerError)
@@ -99,12 +150,8 @@ func ValidationErrorMiddleware() gin.HandlerFunc {
 
 			// If it's a validation error, format it properly
 			if validationErrors, ok := err.Err.(validator.ValidationErrors); ok {
-				details := make(map[string]string)
-				for _, fieldErr := range validationErrors {
-					details[fieldErr.Field()] = getValidationErrorMessage(fieldErr)
-				}
-
-				c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse(details))
+				locale := i18n.FromContext(c.Request.Context())
+				c.JSON(http.StatusBadRequest, dto.NewValidationErrorResponse(locale, validationErrorDetails(locale, validationErrors)))
 				c.Abort()
 				return
 			}
@@ -113,25 +160,26 @@ func ValidationErrorMiddleware() gin.HandlerFunc {
 }
 
 // getValidationErrorMessage returns a user-friendly validation error message
-func getValidationErrorMessage(fe validator.FieldError) string {
+// in locale
+func getValidationErrorMessage(locale i18n.Locale, fe validator.FieldError) string {
 	switch fe.Tag() {
-	case "required":
-		return "This field is required"
-	case "min":
-		return "This field must be at least " + fe.Param() + " characters long"
-	case "max":
-		return "This field must be at most " + fe.Param() + " characters long"
-	case "email":
-		return "This field must be a valid email address"
-	case "url":
-		return "This field must be a valid URL"
-	case "uuid":
-		return "This field must be a valid UUID"
-	case "oneof":
-		return "This field must be one of: " + fe.Param()
+	case "required", "email", "url", "uuid":
+		return i18n.T(locale, "validation."+fe.Tag())
+	case "min", "max", "oneof":
+		return i18n.T(locale, "validation."+fe.Tag(), fe.Param())
 	default:
-		return "This field is invalid"
+		return i18n.T(locale, "validation.invalid")
+	}
+}
+
+// validationErrorDetails describes every failed field validation in locale,
+// by field name
+func validationErrorDetails(locale i18n.Locale, validationErrors validator.ValidationErrors) map[string]string {
+	details := make(map[string]string)
+	for _, fieldErr := range validationErrors {
+		details[fieldErr.Field()] = getValidationErrorMessage(locale, fieldErr)
 	}
+	return details
 }
 
 // RateLimitMiddleware implements basic rate limiting
@@ -148,9 +196,10 @@ func RateLimitMiddleware() gin.HandlerFunc {
 
 		if !limiter.Allow() {
 			c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
-				Error:   "Rate limit exceeded",
-				Message: "Too many requests, please try again later",
-				Code:    http.StatusTooManyRequests,
+				Error:     "Rate limit exceeded",
+				Message:   "Too many requests, please try again later",
+				Code:      http.StatusTooManyRequests,
+				ErrorCode: dto.ErrorCodeRateLimited,
 			})
 			c.Abort()
 			return
@@ -176,6 +225,26 @@ func SecurityHeadersMiddleware() gin.HandlerFunc {
 	}
 }
 
+// GitOperationScopeMiddleware records the git operations a request leads to
+// as done by the API. APIKeyMiddleware narrows the actor to the key's owner.
+func GitOperationScopeMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(git.WithOperationScope(c.Request.Context(), git.OperationScope{Actor: "api"}))
+		c.Next()
+	}
+}
+
+// LocaleMiddleware selects the locale of the texts generated for a request
+// from its Accept-Language header, see i18n.FromContext
+func LocaleMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := i18n.FromAcceptLanguage(c.GetHeader("Accept-Language"))
+		c.Request = c.Request.WithContext(i18n.WithLocale(c.Request.Context(), locale))
+		c.Header("Content-Language", string(locale))
+		c.Next()
+	}
+}
+
 // WebSocketMiddleware provides HTTP middleware for WebSocket endpoints
 func WebSocketMiddleware() gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrganizationHandler struct {
+	organizationUsecase usecase.OrganizationUsecase
+}
+
+func NewOrganizationHandler(organizationUsecase usecase.OrganizationUsecase) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationUsecase: organizationUsecase,
+	}
+}
+
+// CreateOrganization godoc
+// @Summary Create a new organization
+// @Description Create a new organization (tenant) that projects can be scoped to. The first organization adopts the existing projects and users; the user creating a later one joins it. Members of an organization cannot create another.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param organization body dto.OrganizationCreateRequest true "Organization creation data"
+// @Success 201 {object} dto.OrganizationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/organizations [post]
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	var req dto.OrganizationCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	org, err := h.organizationUsecase.Create(c.Request.Context(), usecase.CreateOrganizationRequest{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrOrganizationSlugExists):
+			c.JSON(http.StatusConflict, dto.NewErrorResponse(err, http.StatusConflict, "Organization slug already exists"))
+		case errors.Is(err, usecase.ErrOrganizationNameRequired), errors.Is(err, usecase.ErrOrganizationSlugInvalid):
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		case errors.Is(err, usecase.ErrOrganizationForbidden):
+			c.JSON(http.StatusForbidden, dto.NewErrorResponse(err, http.StatusForbidden, "Organization not allowed"))
+		default:
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to create organization"))
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.OrganizationResponseFromEntity(org))
+}
+
+// ListOrganizations godoc
+// @Summary List organizations
+// @Description Get a list of all organizations, only the caller's own for members of one
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.OrganizationListResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/organizations [get]
+func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
+	orgs, err := h.organizationUsecase.GetAll(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to fetch organizations"))
+		return
+	}
+
+	page, meta := paginate(c, orgs)
+	c.JSON(http.StatusOK, dto.OrganizationListResponseFromEntities(page, meta))
+}
+
+// GetOrganization godoc
+// @Summary Get an organization by ID
+// @Description Get a single organization by its ID
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 200 {object} dto.OrganizationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/organizations/{id} [get]
+func (h *OrganizationHandler) GetOrganization(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid organization ID"))
+		return
+	}
+
+	org, err := h.organizationUsecase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeOrganizationNotFound, "Organization not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.OrganizationResponseFromEntity(org))
+}
+
+// UpdateOrganization godoc
+// @Summary Update an organization
+// @Description Update an organization's name or description
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param organization body dto.OrganizationUpdateRequest true "Organization update data"
+// @Success 200 {object} dto.OrganizationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/organizations/{id} [put]
+func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid organization ID"))
+		return
+	}
+
+	var req dto.OrganizationUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	org, err := h.organizationUsecase.Update(c.Request.Context(), id, usecase.UpdateOrganizationRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update organization")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.OrganizationResponseFromEntity(org))
+}
+
+// DeleteOrganization godoc
+// @Summary Delete an organization
+// @Description Delete an organization by its ID (soft delete)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/organizations/{id} [delete]
+func (h *OrganizationHandler) DeleteOrganization(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid organization ID"))
+		return
+	}
+
+	if err := h.organizationUsecase.Delete(c.Request.Context(), id); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete organization")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AddOrganizationUser godoc
+// @Summary Add a user to an organization
+// @Description Move a user of no organization yet into the organization, scoping the user's requests to it
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param username path string true "Username"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/organizations/{id}/users/{username} [put]
+func (h *OrganizationHandler) AddOrganizationUser(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid organization ID"))
+		return
+	}
+
+	if err := h.organizationUsecase.AddUser(c.Request.Context(), id, c.Param("username")); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to add user to organization")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
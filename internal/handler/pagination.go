@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	maxListPageSize = 100
+
+	// defaultPageSizeKey overrides the default page size of list endpoints
+	// for a route group (v1 returns whole lists, v2 pages them).
+	defaultPageSizeKey = "default_page_size"
+)
+
+// listPagination reads the page and page_size query parameters of a list
+// endpoint. A page size of 0 means the whole list is returned in one page.
+func listPagination(c *gin.Context) (page, pageSize int) {
+	pageSize = c.GetInt(defaultPageSizeKey)
+	if _, ok := c.GetQuery("page_size"); ok {
+		pageSize = queryInt(c, "page_size", 0)
+	}
+	if pageSize > maxListPageSize {
+		pageSize = maxListPageSize
+	}
+	return queryInt(c, "page", 1), pageSize
+}
+
+// paginate slices a list loaded in full down to the page requested by the
+// query, for endpoints whose usecase cannot paginate in the database.
+func paginate[T any](c *gin.Context, items []T) ([]T, dto.ListMeta) {
+	page, pageSize := listPagination(c)
+	meta := dto.NewListMeta(len(items), page, pageSize)
+	if pageSize <= 0 {
+		return items, meta
+	}
+
+	start := min((page-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+	return items[start:end], meta
+}
+
+func queryInt(c *gin.Context, key string, defaultValue int) int {
+	if v, err := strconv.Atoi(c.Query(key)); err == nil && v > 0 {
+		return v
+	}
+	return defaultValue
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// PlanHandler manages the edits of the plans under review, each saved as a
+// new version of the plan
+type PlanHandler struct {
+	planUsecase usecase.PlanUsecase
+}
+
+func NewPlanHandler(planUsecase usecase.PlanUsecase) *PlanHandler {
+	return &PlanHandler{planUsecase: planUsecase}
+}
+
+// UpdateTaskPlan godoc
+// @Summary Edit a plan
+// @Description Replace the content of a task's plan, steps included, while the task is in PLAN_REVIEWING. Each edit is saved as a new version of the plan, and approving the plan implements the edited content. An edit touching the project's high-risk paths marks the task high-risk.
+// @Tags plans
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param planId path string true "Plan ID"
+// @Param plan body dto.PlanUpdateRequest true "Plan update data"
+// @Success 200 {object} dto.PlanResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/plans/{planId} [put]
+func (h *PlanHandler) UpdateTaskPlan(c *gin.Context) {
+	taskID, planID, ok := parsePlanParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.PlanUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	plan, version, err := h.planUsecase.UpdateContent(c.Request.Context(), taskID, planID, usecase.UpdatePlanContentRequest{
+		Content:  req.Content,
+		EditedBy: req.EditedBy,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update plan")
+		return
+	}
+
+	response := &dto.PlanResponse{}
+	response.FromEntity(plan)
+	response.Version = version.Version
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTaskPlanVersions godoc
+// @Summary List a plan's versions
+// @Description List the versions of a task's plan, oldest first: the generated plan, then one per edit.
+// @Tags plans
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param planId path string true "Plan ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.PlanVersionListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/plans/{planId}/versions [get]
+func (h *PlanHandler) GetTaskPlanVersions(c *gin.Context) {
+	taskID, planID, ok := parsePlanParams(c)
+	if !ok {
+		return
+	}
+
+	versions, err := h.planUsecase.GetVersions(c.Request.Context(), taskID, planID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get plan versions")
+		return
+	}
+
+	page, meta := paginate(c, versions)
+	items := make([]dto.PlanVersionResponse, len(page))
+	for i, version := range page {
+		items[i] = dto.PlanVersionResponseFromEntity(version)
+	}
+	c.JSON(http.StatusOK, dto.PlanVersionListResponse{Items: items, ListMeta: meta})
+}
+
+// parsePlanParams parses the task and plan IDs of the path, responding 400
+// when either is invalid
+func parsePlanParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return uuid.Nil, uuid.Nil, false
+	}
+	planID, err := uuid.Parse(c.Param("planId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid plan ID"))
+		return uuid.Nil, uuid.Nil, false
+	}
+	return taskID, planID, true
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/auto-devs/auto-devs/internal/handler/dto"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/gin-gonic/gin"
@@ -34,7 +36,7 @@ func NewProjectHandler(projectUsecase usecase.ProjectUsecase) *ProjectHandler {
 func (h *ProjectHandler) CreateProject(c *gin.Context) {
 	var req dto.ProjectCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -43,11 +45,37 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 		Description:         req.Description,
 		WorktreeBasePath:    req.WorktreeBasePath,
 		InitWorkspaceScript: req.InitWorkspaceScript,
+		LintCommand:         req.LintCommand,
+		AutoCommitLintFixes: req.AutoCommitLintFixes,
+		BuildCommand:        req.BuildCommand,
+		TestCommand:         req.TestCommand,
+		TestFailurePolicy:   req.TestFailurePolicy,
+		CoverageCommand:     req.CoverageCommand,
+		SecurityScanner:     req.SecurityScanner,
+		AIReviewEnabled:     req.AIReviewEnabled,
+		PostReviewComments:  req.PostReviewComments,
+		AutoFixAttempts:     req.AutoFixAttempts,
+		PreviewEnabled:      req.PreviewEnabled,
+		PreviewCommand:      req.PreviewCommand,
+
+		MonthlyBudgetUSD:      req.MonthlyBudgetUSD,
+		BudgetAlertThresholds: req.BudgetAlertThresholds,
+
+		AllowedCommands: req.AllowedCommands,
+		DeniedCommands:  req.DeniedCommands,
+
+		EncryptExecutionLogs: req.EncryptExecutionLogs,
+		HighRiskPaths:        req.HighRiskPaths,
+		Locale:               req.Locale,
+		ExportPatches:        req.ExportPatches,
+		GitProvider:          req.GitProvider,
+
+		MaxConcurrentExecutions: req.MaxConcurrentExecutions,
 	}
 
 	project, err := h.projectUsecase.Create(c.Request.Context(), usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to create project"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to create project")
 		return
 	}
 
@@ -71,13 +99,13 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	project, err := h.projectUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Project not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeProjectNotFound, "Project not found"))
 		return
 	}
 
@@ -93,6 +121,8 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 // @Tags projects
 // @Accept json
 // @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
 // @Success 200 {object} dto.ProjectListResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/projects [get]
@@ -101,20 +131,7 @@ func (h *ProjectHandler) ListProjects(c *gin.Context) {
 	search := c.Query("search")
 	sortBy := c.DefaultQuery("sort_by", "created_at")
 	sortOrder := c.DefaultQuery("sort_order", "desc")
-	page := 1
-	pageSize := 0
-
-	if pageStr := c.Query("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = p
-		}
-	}
-
-	if sizeStr := c.Query("page_size"); sizeStr != "" {
-		if s, err := strconv.Atoi(sizeStr); err == nil && s >= 0 {
-			pageSize = s
-		}
-	}
+	page, pageSize := listPagination(c)
 
 	var archived *bool
 	if archivedStr := c.Query("archived"); archivedStr != "" {
@@ -159,13 +176,13 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	var req dto.ProjectUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -185,10 +202,50 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 	if req.InitWorkspaceScript != nil {
 		usecaseReq.InitWorkspaceScript = *req.InitWorkspaceScript
 	}
+	if req.LintCommand != nil {
+		usecaseReq.LintCommand = *req.LintCommand
+	}
+	usecaseReq.AutoCommitLintFixes = req.AutoCommitLintFixes
+	if req.BuildCommand != nil {
+		usecaseReq.BuildCommand = *req.BuildCommand
+	}
+	if req.TestCommand != nil {
+		usecaseReq.TestCommand = *req.TestCommand
+	}
+	if req.TestFailurePolicy != nil {
+		usecaseReq.TestFailurePolicy = *req.TestFailurePolicy
+	}
+	if req.CoverageCommand != nil {
+		usecaseReq.CoverageCommand = *req.CoverageCommand
+	}
+	if req.SecurityScanner != nil {
+		usecaseReq.SecurityScanner = *req.SecurityScanner
+	}
+	usecaseReq.AIReviewEnabled = req.AIReviewEnabled
+	usecaseReq.PostReviewComments = req.PostReviewComments
+	usecaseReq.AutoFixAttempts = req.AutoFixAttempts
+	usecaseReq.PreviewEnabled = req.PreviewEnabled
+	if req.PreviewCommand != nil {
+		usecaseReq.PreviewCommand = *req.PreviewCommand
+	}
+	usecaseReq.MonthlyBudgetUSD = req.MonthlyBudgetUSD
+	usecaseReq.BudgetAlertThresholds = req.BudgetAlertThresholds
+	usecaseReq.AllowedCommands = req.AllowedCommands
+	usecaseReq.DeniedCommands = req.DeniedCommands
+	usecaseReq.EncryptExecutionLogs = req.EncryptExecutionLogs
+	usecaseReq.HighRiskPaths = req.HighRiskPaths
+	if req.Locale != nil {
+		usecaseReq.Locale = *req.Locale
+	}
+	usecaseReq.ExportPatches = req.ExportPatches
+	if req.GitProvider != nil {
+		usecaseReq.GitProvider = *req.GitProvider
+	}
+	usecaseReq.MaxConcurrentExecutions = req.MaxConcurrentExecutions
 
 	project, err := h.projectUsecase.Update(c.Request.Context(), id, usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update project"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update project")
 		return
 	}
 
@@ -212,7 +269,7 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -241,13 +298,13 @@ func (h *ProjectHandler) GetProjectStatistics(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	stats, err := h.projectUsecase.GetStatistics(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Project not found or failed to get statistics"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeProjectNotFound, "Project not found or failed to get statistics"))
 		return
 	}
 
@@ -255,6 +312,90 @@ func (h *ProjectHandler) GetProjectStatistics(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// defaultActivityPageSize is the page size of the activity feed when the
+// request does not ask for one, as the feed grows without bound
+const defaultActivityPageSize = 50
+
+// GetProjectActivity godoc
+// @Summary Get a project's activity feed
+// @Description Get what happened to the project's tasks, most recent first: task creations, status changes, plan approvals, executions starting and finishing, and pull requests being opened, merged or closed
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole feed" default(50)
+// @Success 200 {object} dto.ProjectActivityListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/activity [get]
+func (h *ProjectHandler) GetProjectActivity(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	page, pageSize := listPagination(c)
+	if _, ok := c.GetQuery("page_size"); !ok && pageSize == 0 {
+		pageSize = defaultActivityPageSize
+	}
+
+	activity, total, err := h.projectUsecase.GetActivity(c.Request.Context(), id, pageSize, (page-1)*pageSize)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get project activity")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectActivityListResponseFromEntities(id, activity, dto.NewListMeta(total, page, pageSize)))
+}
+
+// GetProjectSpend godoc
+// @Summary Get the AI spend of a project
+// @Description Report the tokens and cost of the project's AI runs completed in a calendar month (UTC), per executor, against the project's monthly budget. With format=csv the executors are returned as a CSV file, and with format=pdf as a PDF summary for sharing.
+// @Tags projects
+// @Accept json
+// @Produce json,text/csv,application/pdf
+// @Param id path string true "Project ID"
+// @Param month query string false "Month as YYYY-MM, the current month by default"
+// @Param format query string false "Response format" Enums(json, csv, pdf) default(json)
+// @Success 200 {object} dto.ProjectSpendResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/spend [get]
+func (h *ProjectHandler) GetProjectSpend(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.ProjectSpendQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+	month := time.Now()
+	if query.Month != nil {
+		month = *query.Month
+	}
+
+	spend, err := h.projectUsecase.GetMonthlySpend(c.Request.Context(), id, month)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get project spend")
+		return
+	}
+
+	response := dto.ProjectSpendResponseFromUsecase(spend)
+	if exported(query.Format) {
+		writeAnalyticsReport(c, query.Format, spendReport(response))
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // ArchiveProject godoc
 // @Summary Archive a project
 // @Description Archive a project (soft delete)
@@ -271,7 +412,7 @@ func (h *ProjectHandler) ArchiveProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -300,7 +441,7 @@ func (h *ProjectHandler) RestoreProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -319,6 +460,350 @@ func (h *ProjectHandler) RestoreProject(c *gin.Context) {
 
 
 
+// GetVerificationPipeline godoc
+// @Summary Get a project's verification pipeline
+// @Description Get the ordered steps implementations go through before their pull request is opened. Projects without a saved pipeline get the one their lint, build, test, security scan and AI review settings describe.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.VerificationPipelineResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/verification-pipeline [get]
+func (h *ProjectHandler) GetVerificationPipeline(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	pipeline, err := h.projectUsecase.GetVerificationPipeline(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get verification pipeline")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.VerificationPipelineResponseFromEntity(pipeline))
+}
+
+// UpdateVerificationPipeline godoc
+// @Summary Update a project's verification pipeline
+// @Description Replace the project's verification pipeline. Steps run in the given order; setup steps need a command, lint, build and test steps default to the project's commands.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param pipeline body dto.VerificationPipelineUpdateRequest true "Pipeline steps"
+// @Success 200 {object} dto.VerificationPipelineResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/verification-pipeline [put]
+func (h *ProjectHandler) UpdateVerificationPipeline(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.VerificationPipelineUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	pipeline, err := h.projectUsecase.SaveVerificationPipeline(c.Request.Context(), id, req.ToEntitySteps())
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update verification pipeline")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.VerificationPipelineResponseFromEntity(pipeline))
+}
+
+// ResetVerificationPipeline godoc
+// @Summary Reset a project's verification pipeline
+// @Description Delete the project's saved verification pipeline, going back to the one its settings describe
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.VerificationPipelineResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/verification-pipeline [delete]
+func (h *ProjectHandler) ResetVerificationPipeline(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	pipeline, err := h.projectUsecase.ResetVerificationPipeline(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to reset verification pipeline")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.VerificationPipelineResponseFromEntity(pipeline))
+}
+
+// GetProjectSettings godoc
+// @Summary Get a project's settings
+// @Description Get the project's settings, created with the defaults on first access. They include the prompt template applied to every AI run on the project's tasks.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.ProjectSettingsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/settings [get]
+func (h *ProjectHandler) GetProjectSettings(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	settings, err := h.projectUsecase.GetSettings(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get project settings")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectSettingsResponseFromEntity(settings))
+}
+
+// UpdateProjectSettings godoc
+// @Summary Update a project's settings
+// @Description Update the given fields of the project's settings, such as the prompt prefix and suffix and the context files quoted in every AI run on its tasks. Omitted fields are left unchanged.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param settings body dto.ProjectSettingsUpdateRequest true "Settings"
+// @Success 200 {object} dto.ProjectSettingsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/settings [put]
+func (h *ProjectHandler) UpdateProjectSettings(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.ProjectSettingsUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	settings, err := h.projectUsecase.GetSettings(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get project settings")
+		return
+	}
+	req.ApplyTo(settings)
+
+	settings, err = h.projectUsecase.UpdateSettings(c.Request.Context(), id, settings)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update project settings")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectSettingsResponseFromEntity(settings))
+}
+
+// GetWorkflowPresets godoc
+// @Summary Get a project's workflow presets
+// @Description Get the preset of every workflow type (feature, bugfix, refactor). A preset adds instructions to the planning and implementation prompts of the tasks created with its type, and can require their changes to touch a test. Types without a saved preset get the built-in one.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.WorkflowPresetListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/workflow-presets [get]
+func (h *ProjectHandler) GetWorkflowPresets(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	presets, err := h.projectUsecase.GetWorkflowPresets(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get workflow presets")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkflowPresetListResponseFromEntities(presets))
+}
+
+// UpdateWorkflowPreset godoc
+// @Summary Update a project's workflow preset
+// @Description Replace the project's preset of a workflow type
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param workflow path string true "Workflow type" Enums(feature, bugfix, refactor)
+// @Param preset body dto.WorkflowPresetUpdateRequest true "Preset"
+// @Success 200 {object} dto.WorkflowPresetResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/workflow-presets/{workflow} [put]
+func (h *ProjectHandler) UpdateWorkflowPreset(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.WorkflowPresetUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	preset, err := h.projectUsecase.SaveWorkflowPreset(c.Request.Context(), req.ToEntity(id, entity.WorkflowType(c.Param("workflow"))))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update workflow preset")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkflowPresetResponseFromEntity(preset))
+}
+
+// ResetWorkflowPreset godoc
+// @Summary Reset a project's workflow preset
+// @Description Delete the project's saved preset of a workflow type, going back to the built-in one
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param workflow path string true "Workflow type" Enums(feature, bugfix, refactor)
+// @Success 200 {object} dto.WorkflowPresetResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/workflow-presets/{workflow} [delete]
+func (h *ProjectHandler) ResetWorkflowPreset(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	preset, err := h.projectUsecase.ResetWorkflowPreset(c.Request.Context(), id, entity.WorkflowType(c.Param("workflow")))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to reset workflow preset")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkflowPresetResponseFromEntity(preset))
+}
+
+// GetGitHubToken godoc
+// @Summary Get whether a project has its own GitHub token
+// @Description Tell whether the project's pull requests use its own GitHub token or the global one. The token is never returned.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.GitHubTokenResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/github-token [get]
+func (h *ProjectHandler) GetGitHubToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	configured, err := h.projectUsecase.HasGitHubToken(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get GitHub token")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GitHubTokenResponse{Configured: configured})
+}
+
+// SetGitHubToken godoc
+// @Summary Set a project's GitHub token
+// @Description Store the token the project's pull requests are opened, commented on and tracked with, encrypted in the project's secrets. It replaces the global token for this project.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param token body dto.GitHubTokenRequest true "GitHub token"
+// @Success 200 {object} dto.GitHubTokenResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/github-token [put]
+func (h *ProjectHandler) SetGitHubToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.GitHubTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	if err := h.projectUsecase.SetGitHubToken(c.Request.Context(), id, req.Token); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to set GitHub token")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GitHubTokenResponse{Configured: true})
+}
+
+// DeleteGitHubToken godoc
+// @Summary Delete a project's GitHub token
+// @Description Remove the project's GitHub token, going back to the global one
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.GitHubTokenResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/github-token [delete]
+func (h *ProjectHandler) DeleteGitHubToken(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	if err := h.projectUsecase.DeleteGitHubToken(c.Request.Context(), id); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete GitHub token")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.GitHubTokenResponse{Configured: false})
+}
+
 // ReinitGitRepository godoc
 // @Summary Reinitialize Git repository for a project
 // @Description Reinitialize and reassign Git repository and GitHub repository URL for a project
@@ -335,13 +820,13 @@ func (h *ProjectHandler) ReinitGitRepository(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	err = h.projectUsecase.ReinitGitRepository(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to reinitialize Git repository"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to reinitialize Git repository")
 		return
 	}
 
@@ -357,6 +842,8 @@ func (h *ProjectHandler) ReinitGitRepository(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
 // @Success 200 {object} dto.ListBranchesResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
@@ -366,7 +853,7 @@ func (h *ProjectHandler) ListBranches(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -374,7 +861,7 @@ func (h *ProjectHandler) ListBranches(c *gin.Context) {
 
 	branches, err := h.projectUsecase.ListBranches(c.Request.Context(), id, includeRemote)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to list branches"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to list branches")
 		return
 	}
 
@@ -390,9 +877,10 @@ func (h *ProjectHandler) ListBranches(c *gin.Context) {
 		}
 	}
 
+	page, meta := paginate(c, branchResponses)
 	response := dto.ListBranchesResponse{
-		Branches: branchResponses,
-		Total:    len(branchResponses),
+		Items:    page,
+		ListMeta: meta,
 	}
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// eventStreamKeepAlive keeps proxies from closing idle event streams
+	eventStreamKeepAlive = 25 * time.Second
+	// eventStreamRetry is how long browsers wait before reconnecting, in
+	// milliseconds
+	eventStreamRetry = 3000
+)
+
+// StreamProjectEvents godoc
+// @Summary Stream a project's events
+// @Description Server-Sent Events fallback for clients that cannot use the WebSocket, e.g. behind proxies blocking it. Every event is a message the project's WebSocket subscribers get, named after its type, with the message as data. The stream opens with a subscription event; its data's resumed is true when the events since Last-Event-ID follow, and false when the client must reload what it shows. Reconnecting browsers send Last-Event-ID themselves; last_event_id sets it for clients that cannot send the header. Events are kept 15 minutes for resuming.
+// @Tags projects
+// @Produce text/event-stream
+// @Param id path string true "Project ID"
+// @Param Last-Event-ID header string false "ID of the last event received, to resume after it"
+// @Param last_event_id query string false "Same as the Last-Event-ID header"
+// @Success 200 {string} string "text/event-stream"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/events [get]
+func (h *ProjectHandlerWithWebSocket) StreamProjectEvents(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+	// Only the users who may see the project get its events
+	if _, err := h.projectUsecase.GetByID(c.Request.Context(), projectID); err != nil {
+		respondError(c, err, http.StatusNotFound, "Project not found")
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	stream, err := h.wsService.StreamProject(c.Request.Context(), eventStreamUserID(c), projectID, lastEventID)
+	if errors.Is(err, websocket.ErrInvalidStreamPosition) {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeValidationFailed, "Invalid Last-Event-ID"))
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, dto.NewErrorResponse(err, http.StatusServiceUnavailable, "Event stream unavailable"))
+		return
+	}
+	defer stream.Close()
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// Keep nginx from buffering the events
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", eventStreamRetry)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-stream.Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(c.Writer, ": keepalive\n\n")
+		case event := <-stream.Events():
+			fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data)
+		}
+		c.Writer.Flush()
+	}
+}
+
+// eventStreamUserID returns who the event stream is for, like the
+// WebSocket names its connections
+func eventStreamUserID(c *gin.Context) string {
+	if userID, ok := repository.UserIDFromContext(c.Request.Context()); ok {
+		return userID.String()
+	}
+	if owner := apiKeyOwner(c); owner != "" {
+		return staticKeyUserPrefix + owner
+	}
+	return websocket.AnonymousUserID
+}
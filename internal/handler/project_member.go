@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ProjectMemberHandler manages who may do what in a project
+type ProjectMemberHandler struct {
+	memberUsecase usecase.ProjectMemberUsecase
+}
+
+func NewProjectMemberHandler(memberUsecase usecase.ProjectMemberUsecase) *ProjectMemberHandler {
+	return &ProjectMemberHandler{memberUsecase: memberUsecase}
+}
+
+// ListMembers godoc
+// @Summary List project members
+// @Description List the members of a project and their role. Users reach only the projects they are members of.
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.ProjectMemberListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/members [get]
+func (h *ProjectMemberHandler) ListMembers(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	members, err := h.memberUsecase.ListMembers(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list project members")
+		return
+	}
+
+	items := make([]dto.ProjectMemberResponse, len(members))
+	for i, member := range members {
+		items[i] = dto.ProjectMemberResponseFromEntity(member)
+	}
+	c.JSON(http.StatusOK, dto.ProjectMemberListResponse{Items: items, ListMeta: dto.NewListMeta(len(items), 1, 0)})
+}
+
+// SetMember godoc
+// @Summary Add a project member or change their role
+// @Description Give a user a role in a project: owner, maintainer, reviewer or viewer. Only owners manage members, and the first member must be an owner.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param username path string true "Username"
+// @Param member body dto.ProjectMemberRequest true "Role"
+// @Success 200 {object} dto.ProjectMemberResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/members/{username} [put]
+func (h *ProjectMemberHandler) SetMember(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+	var req dto.ProjectMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	member, err := h.memberUsecase.SetMember(c.Request.Context(), projectID, c.Param("username"), entity.ProjectRole(req.Role))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to save project member")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectMemberResponseFromEntity(member))
+}
+
+// RemoveMember godoc
+// @Summary Remove a project member
+// @Description Remove a user from a project. Only owners manage members, and a project with members keeps an owner.
+// @Tags projects
+// @Param id path string true "Project ID"
+// @Param username path string true "Username"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/members/{username} [delete]
+func (h *ProjectMemberHandler) RemoveMember(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	if err := h.memberUsecase.RemoveMember(c.Request.Context(), projectID, c.Param("username")); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to remove project member")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -8,9 +8,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/repository"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -38,9 +40,19 @@ func setupGinRouter(handler *ProjectHandler) *gin.Engine {
 		projects.PUT("/:id", handler.UpdateProject)
 		projects.DELETE("/:id", handler.DeleteProject)
 projects.GET("/:id/statistics", handler.GetProjectStatistics)
+		projects.GET("/:id/spend", handler.GetProjectSpend)
+		projects.GET("/:id/activity", handler.GetProjectActivity)
 		projects.POST("/:id/archive", handler.ArchiveProject)
 		projects.POST("/:id/restore", handler.RestoreProject)
+		projects.GET("/:id/verification-pipeline", handler.GetVerificationPipeline)
+		projects.PUT("/:id/verification-pipeline", handler.UpdateVerificationPipeline)
+		projects.GET("/:id/settings", handler.GetProjectSettings)
+		projects.PUT("/:id/settings", handler.UpdateProjectSettings)
+		projects.GET("/:id/workflow-presets", handler.GetWorkflowPresets)
+		projects.PUT("/:id/workflow-presets/:workflow", handler.UpdateWorkflowPreset)
+		projects.DELETE("/:id/workflow-presets/:workflow", handler.ResetWorkflowPreset)
 	}
+	v1.GET("/search", handler.Search)
 
 	return router
 }
@@ -130,7 +142,7 @@ func TestProjectHandler_ListProjects(t *testing.T) {
 		var response dto.ProjectListResponse
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		require.NoError(t, err)
-		assert.Len(t, response.Projects, 2)
+		assert.Len(t, response.Items, 2)
 		assert.Equal(t, 2, response.Total)
 	})
 
@@ -219,6 +231,147 @@ func TestProjectHandler_GetProjectStatistics(t *testing.T) {
 	assert.Equal(t, 3, response.TasksByStatus[string(entity.TaskStatusTODO)])
 }
 
+func TestProjectHandler_GetProjectSpend_Export(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	projectID := uuid.New()
+	spend := &usecase.MonthlySpend{
+		ProjectID:    projectID,
+		Month:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Executions:   5,
+		InputTokens:  10000,
+		OutputTokens: 1000,
+		CostUSD:      80,
+		ByExecutor: []*repository.ExecutorSpend{
+			{AIType: "claude-code", Executions: 3, InputTokens: 9000, OutputTokens: 700, CostUSD: 60},
+			{AIType: "cursor-agent", Executions: 2, InputTokens: 1000, OutputTokens: 300, CostUSD: 20},
+		},
+	}
+	mockUsecase.On("GetMonthlySpend", mock.Anything, projectID, spend.Month).Return(spend, nil)
+
+	get := func(format string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/spend?month=2024-03&format=%s", projectID, format), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("csv")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, fmt.Sprintf(`attachment; filename="spend-%s-2024-03.csv"`, projectID), w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "month,ai_type,executions,input_tokens,output_tokens,cost_usd\n"+
+		"2024-03,claude-code,3,9000,700,60.00\n"+
+		"2024-03,cursor-agent,2,1000,300,20.00\n"+
+		"2024-03,total,5,10000,1000,80.00\n", w.Body.String())
+
+	w = get("pdf")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte("%PDF-")))
+	assert.Contains(t, w.Body.String(), "(AI spend, 2024-03)")
+
+	assert.Equal(t, http.StatusBadRequest, get("xlsx").Code)
+}
+
+func TestProjectHandler_GetProjectActivity(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	projectID := uuid.New()
+	toStatus := entity.TaskStatusPLANNING
+	activity := []*entity.ProjectActivity{
+		{Type: entity.ProjectActivityStatusChanged, TaskID: uuid.New(), TaskTitle: "Fix rounding", OccurredAt: time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC), ToStatus: &toStatus},
+	}
+
+	get := func(query string) (*httptest.ResponseRecorder, dto.ProjectActivityListResponse) {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/activity%s", projectID, query), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		var response dto.ProjectActivityListResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w, response
+	}
+
+	t.Run("pages the feed by default", func(t *testing.T) {
+		mockUsecase.EXPECT().GetActivity(mock.Anything, projectID, defaultActivityPageSize, 0).Return(activity, 120, nil).Once()
+
+		w, response := get("")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 120, response.Total)
+		assert.True(t, response.HasMore)
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, "status_changed", response.Items[0].Type)
+		assert.Equal(t, &toStatus, response.Items[0].ToStatus)
+		assert.NotContains(t, w.Body.String(), "pull_request_number")
+	})
+
+	t.Run("a requested page", func(t *testing.T) {
+		mockUsecase.EXPECT().GetActivity(mock.Anything, projectID, 10, 20).Return(activity, 21, nil).Once()
+
+		_, response := get("?page=3&page_size=10")
+		assert.Equal(t, 3, response.Page)
+		assert.False(t, response.HasMore)
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		mockUsecase.EXPECT().GetActivity(mock.Anything, projectID, defaultActivityPageSize, 0).Return(nil, 0, fmt.Errorf("%w: record not found", usecase.ErrProjectNotFound)).Once()
+
+		w, _ := get("")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestProjectHandler_Search(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	taskID := uuid.New()
+	number := int64(42)
+	prNumber := 7
+	results := []*entity.SearchResult{
+		{Type: entity.SearchResultTask, ID: taskID, ProjectName: "Billing", TaskID: &taskID, TaskNumber: &number, Title: "Fix invoice rounding", Score: 0.76},
+		{Type: entity.SearchResultPullRequest, ID: uuid.New(), TaskID: &taskID, TaskNumber: &number, Title: "Round totals", PullRequestNumber: &prNumber, Snippet: "…each invoice…", Score: 0.18},
+	}
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/api/v1/search"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns ranked, typed results", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(mock.Anything, "invoice", 20).Return(results, nil).Once()
+
+		w := get("?q=invoice")
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.SearchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 2, response.Total)
+		require.Len(t, response.Items, 2)
+		assert.Equal(t, "task", response.Items[0].Type)
+		assert.Equal(t, "AD-42", *response.Items[0].TaskKey)
+		assert.Nil(t, response.Items[0].PRNumber)
+		assert.Equal(t, "pull_request", response.Items[1].Type)
+		assert.Equal(t, &prNumber, response.Items[1].PRNumber)
+		assert.Equal(t, "…each invoice…", response.Items[1].Snippet)
+	})
+
+	t.Run("requires a query", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get("").Code)
+		assert.Equal(t, http.StatusBadRequest, get("?q=invoice&limit=500").Code)
+	})
+
+	t.Run("blank query", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(mock.Anything, "  ", 5).Return(nil, usecase.ErrSearchQueryRequired).Once()
+
+		assert.Equal(t, http.StatusBadRequest, get("?q=%20%20&limit=5").Code)
+	})
+}
+
 func TestProjectHandler_ArchiveProject(t *testing.T) {
 	handler, mockUsecase := setupProjectHandler(t)
 	router := setupGinRouter(handler)
@@ -296,6 +449,132 @@ func TestProjectHandler_DeleteProject(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, w.Code)
 }
 
+func TestProjectHandler_VerificationPipeline(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	projectID := uuid.New()
+	project := &entity.Project{ID: projectID, TestCommand: "npm test"}
+	mockUsecase.On("GetVerificationPipeline", mock.Anything, projectID).Return(entity.DefaultVerificationPipeline(project), nil)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/verification-pipeline", projectID), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response dto.VerificationPipelineResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.True(t, response.IsDefault)
+	assert.Equal(t, []dto.VerificationPipelineStepResponse{{Step: entity.VerificationStepTest}}, response.Steps)
+
+	steps := []entity.VerificationPipelineStep{
+		{Step: entity.VerificationStepSetup, Command: "npm ci"},
+		{Step: entity.VerificationStepTest},
+	}
+	mockUsecase.On("SaveVerificationPipeline", mock.Anything, projectID, steps).
+		Return(&entity.VerificationPipeline{ID: uuid.New(), ProjectID: projectID, Steps: steps}, nil)
+
+	body := `{"steps": [{"step": "setup", "command": "npm ci"}, {"step": "test"}]}`
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("/api/v1/projects/%s/verification-pipeline", projectID), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.False(t, response.IsDefault)
+	assert.Len(t, response.Steps, 2)
+
+	mockUsecase.On("SaveVerificationPipeline", mock.Anything, projectID, []entity.VerificationPipelineStep{{Step: entity.VerificationStepSetup}}).
+		Return(nil, usecase.ErrVerificationStepCommandRequired)
+
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("/api/v1/projects/%s/verification-pipeline", projectID), bytes.NewBufferString(`{"steps": [{"step": "setup"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("/api/v1/projects/%s/verification-pipeline", projectID), bytes.NewBufferString(`{"steps": [{"step": "deploy"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "unknown steps are rejected")
+}
+
+func TestProjectHandler_UpdateProjectSettings(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	projectID := uuid.New()
+	current := &entity.ProjectSettings{ID: uuid.New(), ProjectID: projectID, NotificationsEnabled: true, GitBranch: "main", PromptSuffix: "Never edit generated files."}
+	mockUsecase.On("GetSettings", mock.Anything, projectID).Return(current, nil)
+	mockUsecase.On("UpdateSettings", mock.Anything, projectID, mock.MatchedBy(func(settings *entity.ProjectSettings) bool {
+		return settings.ID == current.ID && settings.NotificationsEnabled && settings.PromptPrefix == "Follow the conventions." &&
+			settings.PromptSuffix == "Never edit generated files." && settings.ContextFiles == "docs/ARCHITECTURE.md\nCONTRIBUTING.md"
+	})).Return(func(_ context.Context, _ uuid.UUID, settings *entity.ProjectSettings) *entity.ProjectSettings {
+		return settings
+	}, nil)
+
+	body := `{"prompt_prefix": "Follow the conventions.", "context_files": ["docs/ARCHITECTURE.md", "CONTRIBUTING.md"]}`
+	req, _ := http.NewRequest("PUT", fmt.Sprintf("/api/v1/projects/%s/settings", projectID), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var response dto.ProjectSettingsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Follow the conventions.", response.PromptPrefix)
+	assert.Equal(t, "Never edit generated files.", response.PromptSuffix, "omitted fields are left unchanged")
+	assert.Equal(t, []string{"docs/ARCHITECTURE.md", "CONTRIBUTING.md"}, response.ContextFiles)
+}
+
+func TestProjectHandler_WorkflowPresets(t *testing.T) {
+	handler, mockUsecase := setupProjectHandler(t)
+	router := setupGinRouter(handler)
+
+	projectID := uuid.New()
+	mockUsecase.On("GetWorkflowPresets", mock.Anything, projectID).Return([]*entity.WorkflowPreset{
+		entity.DefaultWorkflowPreset(projectID, entity.WorkflowTypeFeature),
+		entity.DefaultWorkflowPreset(projectID, entity.WorkflowTypeBugfix),
+		entity.DefaultWorkflowPreset(projectID, entity.WorkflowTypeRefactor),
+	}, nil)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/workflow-presets", projectID), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var list dto.WorkflowPresetListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Len(t, list.Presets, 3)
+	assert.True(t, list.Presets[1].IsDefault)
+	assert.True(t, list.Presets[1].RequireTestChanges)
+
+	preset := &entity.WorkflowPreset{ProjectID: projectID, Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "Reproduce it first", RequireTestChanges: true}
+	mockUsecase.On("SaveWorkflowPreset", mock.Anything, preset).
+		Return(&entity.WorkflowPreset{ID: uuid.New(), ProjectID: projectID, Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "Reproduce it first", RequireTestChanges: true}, nil)
+
+	body := `{"implementation_instructions": "Reproduce it first", "require_test_changes": true}`
+	req, _ = http.NewRequest("PUT", fmt.Sprintf("/api/v1/projects/%s/workflow-presets/bugfix", projectID), bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response dto.WorkflowPresetResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.False(t, response.IsDefault)
+	assert.Equal(t, "Reproduce it first", response.ImplementationInstructions)
+
+	mockUsecase.On("ResetWorkflowPreset", mock.Anything, projectID, entity.WorkflowType("hotfix")).Return(nil, usecase.ErrWorkflowTypeInvalid)
+
+	req, _ = http.NewRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/workflow-presets/hotfix", projectID), nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "unknown workflow types are rejected")
+}
+
 // Helper function for creating string pointers
 func stringPtr(s string) *string {
 	return &s
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ProjectWebhookHandler manages the outbound webhooks a project's task
+// lifecycle events are posted to, and their deliveries
+type ProjectWebhookHandler struct {
+	webhookUsecase usecase.ProjectWebhookUsecase
+}
+
+func NewProjectWebhookHandler(webhookUsecase usecase.ProjectWebhookUsecase) *ProjectWebhookHandler {
+	return &ProjectWebhookHandler{webhookUsecase: webhookUsecase}
+}
+
+// ListWebhooks godoc
+// @Summary List project webhooks
+// @Description List the webhooks the project's task lifecycle events are posted to. Only owners manage webhooks.
+// @Tags projects
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.ProjectWebhookListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/webhooks [get]
+func (h *ProjectWebhookHandler) ListWebhooks(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	hooks, err := h.webhookUsecase.ListWebhooks(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list webhooks")
+		return
+	}
+
+	items := make([]dto.ProjectWebhookResponse, len(hooks))
+	for i, hook := range hooks {
+		items[i] = dto.ProjectWebhookResponseFromEntity(hook)
+	}
+	c.JSON(http.StatusOK, dto.ProjectWebhookListResponse{Items: items, ListMeta: dto.NewListMeta(len(items), 1, 0)})
+}
+
+// CreateWebhook godoc
+// @Summary Add a project webhook
+// @Description Post the project's task lifecycle events (task_created, status_changed, plan_ready, execution_completed, pr_merged) to a URL. Each delivery is signed with the webhook's secret in the X-AutoDevs-Signature-256 header; the secret is generated unless given and is only returned here.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param webhook body dto.ProjectWebhookRequest true "Webhook"
+// @Success 201 {object} dto.CreateProjectWebhookResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/webhooks [post]
+func (h *ProjectWebhookHandler) CreateWebhook(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+	var req dto.ProjectWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	hook, secret, err := h.webhookUsecase.CreateWebhook(c.Request.Context(), projectID, webhookRequestFromDTO(req))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreateProjectWebhookResponse{
+		ProjectWebhookResponse: dto.ProjectWebhookResponseFromEntity(hook),
+		Secret:                 secret,
+	})
+}
+
+// UpdateWebhook godoc
+// @Summary Update a project webhook
+// @Description Change the URL, events or active flag of a webhook, and its secret when one is given.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param webhook body dto.ProjectWebhookRequest true "Webhook"
+// @Success 200 {object} dto.ProjectWebhookResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/project-webhooks/{id} [put]
+func (h *ProjectWebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid webhook ID"))
+		return
+	}
+	var req dto.ProjectWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	hook, err := h.webhookUsecase.UpdateWebhook(c.Request.Context(), id, webhookRequestFromDTO(req))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectWebhookResponseFromEntity(hook))
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a project webhook
+// @Description Delete a webhook with its deliveries and secret.
+// @Tags projects
+// @Param id path string true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/project-webhooks/{id} [delete]
+func (h *ProjectWebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid webhook ID"))
+		return
+	}
+
+	if err := h.webhookUsecase.DeleteWebhook(c.Request.Context(), id); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete webhook")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the latest deliveries of a webhook with their payload, status, attempts and the receiver's last response.
+// @Tags projects
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param limit query int false "Deliveries to list, at most 200" default(50)
+// @Success 200 {object} dto.WebhookDeliveryListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/project-webhooks/{id}/deliveries [get]
+func (h *ProjectWebhookHandler) ListDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid webhook ID"))
+		return
+	}
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(errors.New("limit must be a positive integer"), http.StatusBadRequest, "Invalid limit"))
+			return
+		}
+		limit = n
+	}
+
+	deliveries, err := h.webhookUsecase.ListDeliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list webhook deliveries")
+		return
+	}
+
+	items := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		items[i] = dto.WebhookDeliveryResponseFromEntity(delivery)
+	}
+	c.JSON(http.StatusOK, dto.WebhookDeliveryListResponse{WebhookID: id, Items: items, ListMeta: dto.NewListMeta(len(items), 1, 0)})
+}
+
+// RedeliverWebhook godoc
+// @Summary Redeliver a webhook delivery
+// @Description Post the payload of a delivery again, as a new delivery retried like any other.
+// @Tags projects
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 202 {object} dto.WebhookDeliveryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/webhook-deliveries/{id}/redeliver [post]
+func (h *ProjectWebhookHandler) RedeliverWebhook(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid delivery ID"))
+		return
+	}
+
+	delivery, err := h.webhookUsecase.Redeliver(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to redeliver webhook")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.WebhookDeliveryResponseFromEntity(delivery))
+}
+
+func webhookRequestFromDTO(req dto.ProjectWebhookRequest) usecase.ProjectWebhookRequest {
+	return usecase.ProjectWebhookRequest{
+		URL:    req.URL,
+		Events: req.Events,
+		Secret: req.Secret,
+		Active: req.Active,
+	}
+}
@@ -30,20 +30,20 @@ func (h *ProjectHandlerWithWebSocket) UpdateProject(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	var req dto.ProjectUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Get the original project to track changes
 	originalProject, err := h.projectUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Project not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeProjectNotFound, "Project not found"))
 		return
 	}
 
@@ -85,10 +85,165 @@ func (h *ProjectHandlerWithWebSocket) UpdateProject(c *gin.Context) {
 			"new": *req.InitWorkspaceScript,
 		}
 	}
+	if req.LintCommand != nil && *req.LintCommand != originalProject.LintCommand {
+		usecaseReq.LintCommand = *req.LintCommand
+		changes["lint_command"] = map[string]interface{}{
+			"old": originalProject.LintCommand,
+			"new": *req.LintCommand,
+		}
+	}
+	if req.AutoCommitLintFixes != nil && *req.AutoCommitLintFixes != originalProject.AutoCommitLintFixes {
+		usecaseReq.AutoCommitLintFixes = req.AutoCommitLintFixes
+		changes["auto_commit_lint_fixes"] = map[string]interface{}{
+			"old": originalProject.AutoCommitLintFixes,
+			"new": *req.AutoCommitLintFixes,
+		}
+	}
+	if req.BuildCommand != nil && *req.BuildCommand != originalProject.BuildCommand {
+		usecaseReq.BuildCommand = *req.BuildCommand
+		changes["build_command"] = map[string]interface{}{
+			"old": originalProject.BuildCommand,
+			"new": *req.BuildCommand,
+		}
+	}
+	if req.TestCommand != nil && *req.TestCommand != originalProject.TestCommand {
+		usecaseReq.TestCommand = *req.TestCommand
+		changes["test_command"] = map[string]interface{}{
+			"old": originalProject.TestCommand,
+			"new": *req.TestCommand,
+		}
+	}
+	if req.TestFailurePolicy != nil && *req.TestFailurePolicy != string(originalProject.TestFailurePolicy) {
+		usecaseReq.TestFailurePolicy = *req.TestFailurePolicy
+		changes["test_failure_policy"] = map[string]interface{}{
+			"old": originalProject.TestFailurePolicy,
+			"new": *req.TestFailurePolicy,
+		}
+	}
+	if req.CoverageCommand != nil && *req.CoverageCommand != originalProject.CoverageCommand {
+		usecaseReq.CoverageCommand = *req.CoverageCommand
+		changes["coverage_command"] = map[string]interface{}{
+			"old": originalProject.CoverageCommand,
+			"new": *req.CoverageCommand,
+		}
+	}
+	if req.SecurityScanner != nil && *req.SecurityScanner != string(originalProject.SecurityScanner) {
+		usecaseReq.SecurityScanner = *req.SecurityScanner
+		changes["security_scanner"] = map[string]interface{}{
+			"old": originalProject.SecurityScanner,
+			"new": *req.SecurityScanner,
+		}
+	}
+	if req.AIReviewEnabled != nil && *req.AIReviewEnabled != originalProject.AIReviewEnabled {
+		usecaseReq.AIReviewEnabled = req.AIReviewEnabled
+		changes["ai_review_enabled"] = map[string]interface{}{
+			"old": originalProject.AIReviewEnabled,
+			"new": *req.AIReviewEnabled,
+		}
+	}
+	if req.PostReviewComments != nil && *req.PostReviewComments != originalProject.PostReviewComments {
+		usecaseReq.PostReviewComments = req.PostReviewComments
+		changes["post_review_comments"] = map[string]interface{}{
+			"old": originalProject.PostReviewComments,
+			"new": *req.PostReviewComments,
+		}
+	}
+	if req.AutoFixAttempts != nil && *req.AutoFixAttempts != originalProject.AutoFixAttempts {
+		usecaseReq.AutoFixAttempts = req.AutoFixAttempts
+		changes["auto_fix_attempts"] = map[string]interface{}{
+			"old": originalProject.AutoFixAttempts,
+			"new": *req.AutoFixAttempts,
+		}
+	}
+	if req.PreviewEnabled != nil && *req.PreviewEnabled != originalProject.PreviewEnabled {
+		usecaseReq.PreviewEnabled = req.PreviewEnabled
+		changes["preview_enabled"] = map[string]interface{}{
+			"old": originalProject.PreviewEnabled,
+			"new": *req.PreviewEnabled,
+		}
+	}
+	if req.PreviewCommand != nil && *req.PreviewCommand != originalProject.PreviewCommand {
+		usecaseReq.PreviewCommand = *req.PreviewCommand
+		changes["preview_command"] = map[string]interface{}{
+			"old": originalProject.PreviewCommand,
+			"new": *req.PreviewCommand,
+		}
+	}
+	if req.MonthlyBudgetUSD != nil && *req.MonthlyBudgetUSD != originalProject.MonthlyBudgetUSD {
+		usecaseReq.MonthlyBudgetUSD = req.MonthlyBudgetUSD
+		changes["monthly_budget_usd"] = map[string]interface{}{
+			"old": originalProject.MonthlyBudgetUSD,
+			"new": *req.MonthlyBudgetUSD,
+		}
+	}
+	if req.BudgetAlertThresholds != nil {
+		usecaseReq.BudgetAlertThresholds = req.BudgetAlertThresholds
+		changes["budget_alert_thresholds"] = map[string]interface{}{
+			"old": originalProject.AlertThresholds(),
+			"new": req.BudgetAlertThresholds,
+		}
+	}
+	originalPolicy := originalProject.CommandPolicy()
+	if req.AllowedCommands != nil {
+		usecaseReq.AllowedCommands = req.AllowedCommands
+		changes["allowed_commands"] = map[string]interface{}{
+			"old": originalPolicy.Allowed,
+			"new": req.AllowedCommands,
+		}
+	}
+	if req.DeniedCommands != nil {
+		usecaseReq.DeniedCommands = req.DeniedCommands
+		changes["denied_commands"] = map[string]interface{}{
+			"old": originalPolicy.Denied,
+			"new": req.DeniedCommands,
+		}
+	}
+	if req.EncryptExecutionLogs != nil && *req.EncryptExecutionLogs != originalProject.EncryptExecutionLogs {
+		usecaseReq.EncryptExecutionLogs = req.EncryptExecutionLogs
+		changes["encrypt_execution_logs"] = map[string]interface{}{
+			"old": originalProject.EncryptExecutionLogs,
+			"new": *req.EncryptExecutionLogs,
+		}
+	}
+	if req.HighRiskPaths != nil {
+		usecaseReq.HighRiskPaths = req.HighRiskPaths
+		changes["high_risk_paths"] = map[string]interface{}{
+			"old": originalProject.HighRiskPathPatterns(),
+			"new": req.HighRiskPaths,
+		}
+	}
+	if req.Locale != nil && *req.Locale != originalProject.Locale {
+		usecaseReq.Locale = *req.Locale
+		changes["locale"] = map[string]interface{}{
+			"old": originalProject.Locale,
+			"new": *req.Locale,
+		}
+	}
+	if req.ExportPatches != nil && *req.ExportPatches != originalProject.ExportPatches {
+		usecaseReq.ExportPatches = req.ExportPatches
+		changes["export_patches"] = map[string]interface{}{
+			"old": originalProject.ExportPatches,
+			"new": *req.ExportPatches,
+		}
+	}
+	if req.GitProvider != nil && *req.GitProvider != string(originalProject.GitProvider) {
+		usecaseReq.GitProvider = *req.GitProvider
+		changes["git_provider"] = map[string]interface{}{
+			"old": originalProject.GitProvider,
+			"new": *req.GitProvider,
+		}
+	}
+	if req.MaxConcurrentExecutions != nil && *req.MaxConcurrentExecutions != originalProject.MaxConcurrentExecutions {
+		usecaseReq.MaxConcurrentExecutions = req.MaxConcurrentExecutions
+		changes["max_concurrent_executions"] = map[string]interface{}{
+			"old": originalProject.MaxConcurrentExecutions,
+			"new": *req.MaxConcurrentExecutions,
+		}
+	}
 
 	project, err := h.projectUsecase.Update(c.Request.Context(), id, usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update project"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update project")
 		return
 	}
 
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ReleaseNotesHandler has AI executors write release notes from a project's
+// completed tasks or merged pull requests
+type ReleaseNotesHandler struct {
+	releaseNotesUsecase usecase.ReleaseNotesUsecase
+}
+
+func NewReleaseNotesHandler(releaseNotesUsecase usecase.ReleaseNotesUsecase) *ReleaseNotesHandler {
+	return &ReleaseNotesHandler{
+		releaseNotesUsecase: releaseNotesUsecase,
+	}
+}
+
+// CreateReleaseNotes godoc
+// @Summary Generate release notes
+// @Description Queue release notes on the tasks completed, or the pull requests merged, in the date range. An AI executor groups the changes into features, fixes and improvements; poll the notes until their status is completed or failed.
+// @Tags release-notes
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param release_notes body dto.CreateReleaseNotesRequest true "Release notes request"
+// @Success 202 {object} dto.ReleaseNotesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/release-notes [post]
+func (h *ReleaseNotesHandler) CreateReleaseNotes(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.CreateReleaseNotesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	notes, err := h.releaseNotesUsecase.Create(c.Request.Context(), usecase.CreateReleaseNotesRequest{
+		ProjectID: projectID,
+		Version:   req.Version,
+		Source:    entity.ReleaseNotesSource(req.Source),
+		From:      req.From,
+		To:        req.To,
+		AIType:    req.AIType,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to create release notes")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.ReleaseNotesResponseFromEntity(notes))
+}
+
+// ListProjectReleaseNotes godoc
+// @Summary List a project's release notes
+// @Description List the release notes generated for the project, newest first
+// @Tags release-notes
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.ReleaseNotesListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/release-notes [get]
+func (h *ReleaseNotesHandler) ListProjectReleaseNotes(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	notes, err := h.releaseNotesUsecase.ListByProjectID(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list release notes")
+		return
+	}
+
+	page, meta := paginate(c, notes)
+	c.JSON(http.StatusOK, dto.ReleaseNotesListResponseFromEntities(projectID, page, meta))
+}
+
+// GetReleaseNotes godoc
+// @Summary Get release notes
+// @Description Get release notes with their status and, once generated, their Markdown content
+// @Tags release-notes
+// @Accept json
+// @Produce json
+// @Param id path string true "Release notes ID"
+// @Success 200 {object} dto.ReleaseNotesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/release-notes/{id} [get]
+func (h *ReleaseNotesHandler) GetReleaseNotes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid release notes ID"))
+		return
+	}
+
+	notes, err := h.releaseNotesUsecase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get release notes")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReleaseNotesResponseFromEntity(notes))
+}
+
+// DownloadReleaseNotes godoc
+// @Summary Download release notes
+// @Description Download generated release notes as a Markdown file
+// @Tags release-notes
+// @Produce text/markdown
+// @Param id path string true "Release notes ID"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/release-notes/{id}/download [get]
+func (h *ReleaseNotesHandler) DownloadReleaseNotes(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid release notes ID"))
+		return
+	}
+
+	notes, err := h.releaseNotesUsecase.GetByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get release notes")
+		return
+	}
+	if notes.Status != entity.ReleaseNotesStatusCompleted {
+		respondError(c, usecase.ErrReleaseNotesNotReady, http.StatusConflict, "Release notes are not ready")
+		return
+	}
+
+	filename := fmt.Sprintf("release-notes-%s", notes.ID)
+	if notes.Version != "" {
+		filename = fmt.Sprintf("release-notes-%s", strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '_' || r == '-' {
+				return r
+			}
+			return '-'
+		}, notes.Version))
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.md"`, filename))
+	c.Data(http.StatusOK, "text/markdown; charset=utf-8", []byte(notes.Content))
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func serveReleaseNotes(releaseNotesUsecase usecase.ReleaseNotesUsecase, req *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewReleaseNotesHandler(releaseNotesUsecase)
+	router.POST("/projects/:id/release-notes", handler.CreateReleaseNotes)
+	router.GET("/release-notes/:id/download", handler.DownloadReleaseNotes)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestReleaseNotesHandler_CreateReleaseNotes(t *testing.T) {
+	projectID := uuid.New()
+	body := `{"version":"v1.4.0","source":"pull_requests","from":"2024-03-01T00:00:00Z","to":"2024-03-31T23:59:59Z"}`
+
+	t.Run("queues the notes", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		releaseNotesUsecase.EXPECT().Create(mock.Anything, mock.MatchedBy(func(req usecase.CreateReleaseNotesRequest) bool {
+			return req.ProjectID == projectID && req.Version == "v1.4.0" && req.Source == entity.ReleaseNotesSourcePullRequests
+		})).Return(&entity.ReleaseNotes{ID: uuid.New(), ProjectID: projectID, Status: entity.ReleaseNotesStatusPending, Changes: 3}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/projects/"+projectID.String()+"/release-notes", strings.NewReader(body))
+		w := serveReleaseNotes(releaseNotesUsecase, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"pending"`)
+	})
+
+	t.Run("rejects a range without changes", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		releaseNotesUsecase.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, usecase.ErrReleaseNotesNoChanges)
+
+		req := httptest.NewRequest(http.MethodPost, "/projects/"+projectID.String()+"/release-notes", strings.NewReader(body))
+		w := serveReleaseNotes(releaseNotesUsecase, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("requires the date range", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/projects/"+projectID.String()+"/release-notes", strings.NewReader(`{"version":"v1.4.0"}`))
+		w := serveReleaseNotes(usecase.NewReleaseNotesUsecaseMock(t), req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestReleaseNotesHandler_DownloadReleaseNotes(t *testing.T) {
+	id := uuid.New()
+
+	t.Run("sends the Markdown", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		releaseNotesUsecase.EXPECT().GetByID(mock.Anything, id).Return(&entity.ReleaseNotes{
+			ID: id, Version: "v1.4.0 (beta)", Status: entity.ReleaseNotesStatusCompleted, Content: "# v1.4.0\n",
+		}, nil)
+
+		w := serveReleaseNotes(releaseNotesUsecase, httptest.NewRequest(http.MethodGet, "/release-notes/"+id.String()+"/download", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `attachment; filename="release-notes-v1.4.0--beta-.md"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "# v1.4.0\n", w.Body.String())
+	})
+
+	t.Run("conflicts until generated", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		releaseNotesUsecase.EXPECT().GetByID(mock.Anything, id).Return(&entity.ReleaseNotes{ID: id, Status: entity.ReleaseNotesStatusGenerating}, nil)
+
+		w := serveReleaseNotes(releaseNotesUsecase, httptest.NewRequest(http.MethodGet, "/release-notes/"+id.String()+"/download", nil))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
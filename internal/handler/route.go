@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/auto-devs/auto-devs/docs"
+	"github.com/auto-devs/auto-devs/internal/graph"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/auto-devs/auto-devs/internal/websocket"
 	"github.com/auto-devs/auto-devs/pkg/database"
@@ -11,103 +12,376 @@ import (
 )
 
 // SetupRoutes configures all API routes and middleware
-func SetupRoutes(router *gin.Engine, projectUsecase usecase.ProjectUsecase, taskUsecase usecase.TaskUsecase, executionUsecase usecase.ExecutionUsecase, worktreeUsecase usecase.WorktreeUsecase, db *database.GormDB, wsService *websocket.Service) {
+func SetupRoutes(router *gin.Engine, projectUsecase usecase.ProjectUsecase, taskUsecase usecase.TaskUsecase, executionUsecase usecase.ExecutionUsecase, worktreeUsecase usecase.WorktreeUsecase, organizationUsecase usecase.OrganizationUsecase, jiraUsecase usecase.JiraUsecase, githubProjectUsecase usecase.GitHubProjectUsecase, githubIssueUsecase usecase.GitHubIssueUsecase, eventUsecase usecase.EventUsecase, commitUsecase usecase.CommitUsecase, releaseNotesUsecase usecase.ReleaseNotesUsecase, attachmentUsecase usecase.AttachmentUsecase, maxAttachmentSize int64, userProfileUsecase usecase.UserProfileUsecase, jobMetricUsecase usecase.JobMetricUsecase, epicUsecase usecase.EpicUsecase, planUsecase usecase.PlanUsecase, authUsecase usecase.AuthUsecase, projectMemberUsecase usecase.ProjectMemberUsecase, webhookUsecase usecase.ProjectWebhookUsecase, slackUsecase usecase.SlackUsecase, emailUsecase usecase.EmailUsecase, authRequired bool, apiKeys map[string]string, githubWebhookSecret string, slackSigningSecret string, accessPolicy AccessPolicy, auditUsecase usecase.AuditUsecase, graphqlService *graph.Service, db *database.GormDB, redis RedisChecker, wsService *websocket.Service) {
 	// Initialize handlers
 	projectHandler := NewProjectHandlerWithWebSocket(projectUsecase, wsService)
-	taskHandler := NewTaskHandlerWithWebSocket(taskUsecase, wsService)
+	taskHandler := NewTaskHandlerWithWebSocket(taskUsecase, epicUsecase, wsService)
 	executionHandler := NewExecutionHandler(executionUsecase)
 	worktreeHandler := NewWorktreeHandler(worktreeUsecase)
+	organizationHandler := NewOrganizationHandler(organizationUsecase)
+	jiraHandler := NewJiraHandler(jiraUsecase)
+	githubProjectHandler := NewGitHubProjectHandler(githubProjectUsecase, githubIssueUsecase)
+	ideHandler := NewIDEHandler(taskUsecase, wsService)
+	automationHandler := NewAutomationHandler(taskUsecase, eventUsecase, wsService)
+	commitHandler := NewCommitHandler(commitUsecase, githubWebhookSecret)
+	releaseNotesHandler := NewReleaseNotesHandler(releaseNotesUsecase)
+	attachmentHandler := NewAttachmentHandler(attachmentUsecase, maxAttachmentSize)
+	userHandler := NewUserHandler(userProfileUsecase)
+	jobMetricHandler := NewJobMetricHandler(jobMetricUsecase)
+	epicHandler := NewEpicHandler(epicUsecase)
+	planHandler := NewPlanHandler(planUsecase)
+	authHandler := NewAuthHandler(authUsecase)
+	projectMemberHandler := NewProjectMemberHandler(projectMemberUsecase)
+	projectWebhookHandler := NewProjectWebhookHandler(webhookUsecase)
+	slackHandler := NewSlackHandler(slackUsecase, slackSigningSecret)
+	emailHandler := NewEmailHandler(emailUsecase)
+	wsService.SetAuthorizer(NewWebSocketAuthorizer(authUsecase, projectMemberUsecase, apiKeys, authRequired))
+	auth := AuthMiddleware(authUsecase, apiKeys, authRequired)
+	apiKeyAuth := APIKeyMiddleware(apiKeys)
+	graphqlHandler := NewGraphQLHandler(graphqlService)
 	wsHandler := wsService.GetHandler()
 
 	// Global middleware
+	router.Use(TracingMiddleware())
 	router.Use(SecurityHeadersMiddleware())
 	router.Use(CORSMiddleware())
 	router.Use(RequestLoggingMiddleware())
 	router.Use(ErrorHandlingMiddleware())
+	router.Use(AccessPolicyMiddleware(accessPolicy, auditUsecase))
 	router.Use(RateLimitMiddleware())
+	router.Use(LocaleMiddleware())
 	router.Use(ValidationErrorMiddleware())
+	router.Use(GitOperationScopeMiddleware())
 
-	docs.SwaggerInfo.BasePath = "/api/v1"
+	docs.SwaggerInfo.BasePath = "/"
 	// Swagger documentation endpoints (must be before other routes)
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, ginSwagger.PersistAuthorization(true)))
 	// SetupSwaggerRoutes(router)
 
 	// Health check endpoint (no versioning for health)
-	SetupHealthRoutes(router, db)
+	SetupHealthRoutes(router, db, redis, wsService)
 
 	// WebSocket endpoints
 	SetupWebSocketRoutes(router, wsHandler, wsService)
 	// router.GET("/ws", WebSocketMiddleware(), wsHandler.GetWebSocketHandler())
 
-	// API v1 routes
-	v1 := router.Group("/api/v1")
-	{
-		// Project routes
-		projects := v1.Group("/projects")
-		{
-			projects.POST("", projectHandler.CreateProject)
-			projects.GET("", projectHandler.ListProjects)
-			projects.GET("/:id", projectHandler.GetProject)
-			projects.PUT("/:id", projectHandler.UpdateProject)
-			projects.DELETE("/:id", projectHandler.DeleteProject)
-			projects.GET("/:id/statistics", projectHandler.GetProjectStatistics)
-			projects.POST("/:id/archive", projectHandler.ArchiveProject)
-			projects.POST("/:id/restore", projectHandler.RestoreProject)
-
-			// Git repository management endpoints
-			projects.POST("/:id/git/reinit", projectHandler.ReinitGitRepository)
-			// Git branches endpoint
-			projects.GET("/:id/branches", projectHandler.ListBranches)
-
-			// Project-scoped task routes
-			projects.GET("/:id/tasks", taskHandler.ListTasksByProject)
-			projects.GET("/:id/tasks/done", taskHandler.ListDoneTasksByProject)
-		}
-
-		// Task routes
-		tasks := v1.Group("/tasks")
-		{
-			tasks.POST("", taskHandler.CreateTask)
-			tasks.GET("", taskHandler.ListTasks)
-			tasks.GET("/:id", taskHandler.GetTask)
-			tasks.PUT("/:id", taskHandler.UpdateTask)
-			tasks.DELETE("/:id", taskHandler.DeleteTask)
-
-			// Planning workflow endpoints
-			tasks.POST("/:id/start-planning", taskHandler.StartPlanning)
-			tasks.POST("/:id/approve-plan", taskHandler.ApprovePlan)
-			tasks.POST("/:id/start-implementing-direct", taskHandler.StartImplementingDirect)
-
-			// Execution endpoints for tasks
-			tasks.GET("/:id/executions", executionHandler.GetTaskExecutions)
-
-			// Pull request endpoints
-			tasks.GET("/:id/pull-request", taskHandler.GetPullRequest)
-			tasks.POST("/:id/pull-request", taskHandler.CreatePullRequest)
-
-			// Plan endpoints
-			tasks.GET("/:id/plans", taskHandler.GetTaskPlans)
-			tasks.PUT("/:id/plans/:planId", taskHandler.UpdateTaskPlan)
-
-			// Open with Cursor endpoint
-			tasks.POST("/:id/open-with-cursor", taskHandler.OpenWithCursor)
-
-			// Git diff endpoint
-			tasks.GET("/:id/diff", taskHandler.GetTaskDiff)
-		}
-
-		// Execution routes
-		executions := v1.Group("/executions")
-		{
-			executions.POST("", executionHandler.CreateExecution)
-			executions.GET("/stats", executionHandler.GetExecutionStats)
-			executions.GET("/:id", executionHandler.GetExecutionByID)
-			executions.PUT("/:id", executionHandler.UpdateExecution)
-			executions.DELETE("/:id", executionHandler.DeleteExecution)
-			executions.GET("/:id/logs", executionHandler.GetExecutionLogs)
-		}
-
-		// Worktree routes
-		RegisterWorktreeRoutes(v1, worktreeHandler)
-	}
+	// GraphQL endpoint
+	SetupGraphQLRoutes(router, graphqlHandler, organizationUsecase, auth)
+
+	// API v1 routes (deprecated, see DeprecationMiddleware)
+	v1 := router.Group(apiV1Prefix)
+	v1.Use(DeprecationMiddleware(apiV1DeprecatedAt, apiV1SunsetAt, apiV1Prefix, apiV2Prefix))
+	v1.Use(auth)
+	v1.Use(TenantMiddleware(organizationUsecase))
+	registerAPIRoutes(v1, projectHandler, taskHandler, executionHandler, worktreeHandler, organizationHandler, jiraHandler, githubProjectHandler, ideHandler, automationHandler, commitHandler, releaseNotesHandler, attachmentHandler, userHandler, jobMetricHandler, epicHandler, planHandler, authHandler, projectMemberHandler, projectWebhookHandler, slackHandler, emailHandler, apiKeyAuth)
+
+	// API v2 routes share the v1 handlers through the compatibility shim
+	v2 := router.Group(apiV2Prefix)
+	v2.Use(auth)
+	v2.Use(TenantMiddleware(organizationUsecase))
+	v2.Use(V2CompatibilityMiddleware())
+	registerAPIRoutes(v2, projectHandler, taskHandler, executionHandler, worktreeHandler, organizationHandler, jiraHandler, githubProjectHandler, ideHandler, automationHandler, commitHandler, releaseNotesHandler, attachmentHandler, userHandler, jobMetricHandler, epicHandler, planHandler, authHandler, projectMemberHandler, projectWebhookHandler, slackHandler, emailHandler, apiKeyAuth)
 }
+
+// registerAPIRoutes registers the versioned REST endpoints on the given group
+func registerAPIRoutes(v1 *gin.RouterGroup, projectHandler *ProjectHandlerWithWebSocket, taskHandler *TaskHandlerWithWebSocket, executionHandler *ExecutionHandler, worktreeHandler *WorktreeHandler, organizationHandler *OrganizationHandler, jiraHandler *JiraHandler, githubProjectHandler *GitHubProjectHandler, ideHandler *IDEHandler, automationHandler *AutomationHandler, commitHandler *CommitHandler, releaseNotesHandler *ReleaseNotesHandler, attachmentHandler *AttachmentHandler, userHandler *UserHandler, jobMetricHandler *JobMetricHandler, epicHandler *EpicHandler, planHandler *PlanHandler, authHandler *AuthHandler, projectMemberHandler *ProjectMemberHandler, projectWebhookHandler *ProjectWebhookHandler, slackHandler *SlackHandler, emailHandler *EmailHandler, apiKeyAuth gin.HandlerFunc) {
+	// User accounts, and the API keys users create for scripts and tools
+	auth := v1.Group("/auth")
+	{
+		auth.POST("/register", authHandler.Register)
+		auth.POST("/login", authHandler.Login)
+		auth.GET("/me", RequireUser(), authHandler.GetCurrentUser)
+		auth.GET("/api-keys", RequireUser(), authHandler.ListAPIKeys)
+		auth.POST("/api-keys", RequireUser(), authHandler.CreateAPIKey)
+		auth.DELETE("/api-keys/:id", RequireUser(), authHandler.DeleteAPIKey)
+	}
+
+	// Organization routes
+	organizations := v1.Group("/organizations")
+	{
+		organizations.POST("", organizationHandler.CreateOrganization)
+		organizations.GET("", organizationHandler.ListOrganizations)
+		organizations.GET("/:id", organizationHandler.GetOrganization)
+		organizations.PUT("/:id", organizationHandler.UpdateOrganization)
+		organizations.DELETE("/:id", organizationHandler.DeleteOrganization)
+		organizations.PUT("/:id/users/:username", organizationHandler.AddOrganizationUser)
+	}
+
+	// Project routes
+	projects := v1.Group("/projects")
+	{
+		projects.POST("", projectHandler.CreateProject)
+		projects.GET("", projectHandler.ListProjects)
+		projects.GET("/:id", projectHandler.GetProject)
+		projects.PUT("/:id", projectHandler.UpdateProject)
+		projects.DELETE("/:id", projectHandler.DeleteProject)
+		projects.GET("/:id/statistics", projectHandler.GetProjectStatistics)
+		// Server-Sent Events fallback of the WebSocket
+		projects.GET("/:id/events", projectHandler.StreamProjectEvents)
+		projects.GET("/:id/analytics/cycle-time", taskHandler.GetProjectCycleTimeAnalytics)
+		projects.GET("/:id/analytics/executors", taskHandler.GetProjectExecutorAnalytics)
+		projects.GET("/:id/analytics/velocity", taskHandler.GetProjectVelocity)
+		projects.GET("/:id/analytics/plan-reviews", taskHandler.GetProjectPlanReviewAnalytics)
+		projects.GET("/:id/analytics/pull-requests", taskHandler.GetProjectPullRequestAnalytics)
+		projects.GET("/:id/analytics/workload", taskHandler.GetProjectWorkload)
+		projects.GET("/:id/spend", projectHandler.GetProjectSpend)
+		projects.GET("/:id/activity", projectHandler.GetProjectActivity)
+		projects.POST("/:id/archive", projectHandler.ArchiveProject)
+		projects.POST("/:id/restore", projectHandler.RestoreProject)
+
+		// Members and their roles
+		projects.GET("/:id/members", projectMemberHandler.ListMembers)
+		projects.PUT("/:id/members/:username", projectMemberHandler.SetMember)
+		projects.DELETE("/:id/members/:username", projectMemberHandler.RemoveMember)
+
+		// Outbound webhooks the project's task lifecycle events are posted to
+		projects.GET("/:id/webhooks", projectWebhookHandler.ListWebhooks)
+		projects.POST("/:id/webhooks", projectWebhookHandler.CreateWebhook)
+
+		// Verification pipeline endpoints
+		projects.GET("/:id/verification-pipeline", projectHandler.GetVerificationPipeline)
+		projects.PUT("/:id/verification-pipeline", projectHandler.UpdateVerificationPipeline)
+		projects.DELETE("/:id/verification-pipeline", projectHandler.ResetVerificationPipeline)
+
+		// Settings endpoints
+		projects.GET("/:id/settings", projectHandler.GetProjectSettings)
+		projects.PUT("/:id/settings", projectHandler.UpdateProjectSettings)
+
+		// Workflow preset endpoints
+		projects.GET("/:id/workflow-presets", projectHandler.GetWorkflowPresets)
+		projects.PUT("/:id/workflow-presets/:workflow", projectHandler.UpdateWorkflowPreset)
+		projects.DELETE("/:id/workflow-presets/:workflow", projectHandler.ResetWorkflowPreset)
+
+		// Git repository management endpoints
+		projects.POST("/:id/git/reinit", projectHandler.ReinitGitRepository)
+		// Git branches endpoint
+		projects.GET("/:id/branches", projectHandler.ListBranches)
+
+		// Project-scoped task routes
+		projects.GET("/:id/tasks", taskHandler.ListTasksByProject)
+		projects.GET("/:id/tasks/done", taskHandler.ListDoneTasksByProject)
+		// Bulk operations, undoable with the token they return
+		projects.POST("/:id/tasks/bulk-delete", taskHandler.BulkDeleteTasks)
+		projects.POST("/:id/tasks/bulk-archive", taskHandler.BulkArchiveTasks)
+		// Tasks grouped into the columns of the Kanban board
+		projects.GET("/:id/board", taskHandler.GetProjectBoard)
+
+		// Worktree disk usage and bulk cleanup
+		projects.GET("/:id/worktrees", worktreeHandler.ListProjectWorktrees)
+		projects.POST("/:id/worktrees/cleanup", worktreeHandler.CleanupProjectWorktrees)
+
+		// Project GitHub token, used instead of the global one
+		projects.GET("/:id/github-token", projectHandler.GetGitHubToken)
+		projects.PUT("/:id/github-token", projectHandler.SetGitHubToken)
+		projects.DELETE("/:id/github-token", projectHandler.DeleteGitHubToken)
+
+		// Jira integration endpoints
+		projects.GET("/:id/jira", jiraHandler.GetJiraIntegration)
+		projects.PUT("/:id/jira", jiraHandler.ConfigureJiraIntegration)
+		projects.DELETE("/:id/jira", jiraHandler.DeleteJiraIntegration)
+		projects.POST("/:id/jira/import", jiraHandler.ImportJiraIssues)
+
+		// Slack notifications and the types muted on them
+		projects.GET("/:id/slack", slackHandler.GetSlackIntegration)
+		projects.PUT("/:id/slack", slackHandler.ConfigureSlackIntegration)
+		projects.DELETE("/:id/slack", slackHandler.DeleteSlackIntegration)
+		projects.GET("/:id/notification-preferences", slackHandler.ListNotificationPreferences)
+		projects.PUT("/:id/notification-preferences", slackHandler.UpdateNotificationPreferences)
+		// Notification emails
+		projects.GET("/:id/email-templates", emailHandler.ListEmailTemplates)
+		projects.PUT("/:id/email-templates/:type", emailHandler.UpdateEmailTemplate)
+		projects.DELETE("/:id/email-templates/:type", emailHandler.ResetEmailTemplate)
+
+		// GitHub Projects and issues import endpoints
+		projects.POST("/:id/github-project/import", githubProjectHandler.ImportGitHubProject)
+		projects.POST("/:id/import/github-issues", githubProjectHandler.ImportGitHubIssues)
+
+		// Release notes written from completed tasks or merged pull requests
+		projects.POST("/:id/release-notes", releaseNotesHandler.CreateReleaseNotes)
+		projects.GET("/:id/release-notes", releaseNotesHandler.ListProjectReleaseNotes)
+
+		// Epics grouping the project's tasks, with their progress
+		projects.POST("/:id/epics", epicHandler.CreateEpic)
+		projects.GET("/:id/epics", epicHandler.ListProjectEpics)
+	}
+
+	epics := v1.Group("/epics")
+	{
+		epics.GET("/:id", epicHandler.GetEpic)
+		epics.PUT("/:id", epicHandler.UpdateEpic)
+		epics.DELETE("/:id", epicHandler.DeleteEpic)
+	}
+
+	// Quick search across projects, tasks, plans and pull requests
+	v1.GET("/search", projectHandler.Search)
+
+	// Profiles of the users recorded by name on tasks, plans and comments;
+	// users change their own, signed in or with their API key
+	users := v1.Group("/users")
+	{
+		users.GET("", userHandler.ListUserProfiles)
+		users.GET("/:username", userHandler.GetUserProfile)
+		users.PUT("/:username", apiKeyAuth, userHandler.UpdateUserProfile)
+		users.GET("/:username/avatar", userHandler.GetUserAvatar)
+		users.PUT("/:username/avatar", apiKeyAuth, userHandler.UploadUserAvatar)
+		users.DELETE("/:username/avatar", apiKeyAuth, userHandler.DeleteUserAvatar)
+	}
+
+	// Daily history of the background jobs the workers processed
+	v1.GET("/jobs/metrics", jobMetricHandler.GetJobMetrics)
+
+	// Task routes
+	tasks := v1.Group("/tasks")
+	{
+		tasks.POST("", taskHandler.CreateTask)
+		tasks.GET("", taskHandler.ListTasks)
+		tasks.GET("/:id", taskHandler.GetTask)
+		// Everything the task detail view shows, in one request
+		tasks.GET("/:id/full", taskHandler.GetTaskFull)
+		tasks.PUT("/:id", taskHandler.UpdateTask)
+		tasks.PATCH("/:id", taskHandler.PatchTask)
+		tasks.DELETE("/:id", taskHandler.DeleteTask)
+		tasks.GET("/:id/status-history", taskHandler.GetStatusHistory)
+
+		// Planning workflow endpoints
+		tasks.POST("/:id/start-planning", taskHandler.StartPlanning)
+		tasks.POST("/:id/approve-plan", OptionalAPIKey(apiKeyAuth), taskHandler.ApprovePlan)
+		tasks.POST("/:id/reject-plan", taskHandler.RejectPlan)
+		tasks.POST("/:id/plan/reject", taskHandler.RejectPlanWithFeedback)
+		tasks.POST("/:id/request-changes", taskHandler.RequestChanges)
+		tasks.POST("/:id/start-implementing-direct", taskHandler.StartImplementingDirect)
+		// Planning and implementation jobs waiting for their start time
+		tasks.GET("/:id/scheduled-jobs", taskHandler.ListScheduledJobs)
+		tasks.DELETE("/:id/scheduled-jobs/:job_id", taskHandler.CancelScheduledJob)
+
+		// Execution endpoints for tasks
+		tasks.GET("/:id/executions", executionHandler.GetTaskExecutions)
+
+		// Pull request endpoints
+		tasks.GET("/:id/pull-request", taskHandler.GetPullRequest)
+		tasks.POST("/:id/pull-request", taskHandler.CreatePullRequest)
+
+		// Plan endpoints
+		tasks.GET("/:id/plans", taskHandler.GetTaskPlans)
+		tasks.PUT("/:id/plans/:planId", planHandler.UpdateTaskPlan)
+		tasks.GET("/:id/plans/:planId/versions", planHandler.GetTaskPlanVersions)
+
+		// Open with Cursor endpoint
+		tasks.POST("/:id/open-with-cursor", taskHandler.OpenWithCursor)
+
+		// Git diff endpoint
+		tasks.GET("/:id/diff", taskHandler.GetTaskDiff)
+		// The same changes parsed file by file, from the worktree or the PR
+		tasks.GET("/:id/changes", taskHandler.GetTaskChanges)
+
+		// Live state of the task's worktree
+		tasks.GET("/:id/worktree", taskHandler.GetTaskWorktreeStatus)
+		tasks.POST("/:id/worktree/recreate", taskHandler.RecreateTaskWorktree)
+		// Snapshots taken before implementation and auto-fix runs
+		tasks.GET("/:id/worktree/snapshots", taskHandler.ListTaskWorktreeSnapshots)
+		tasks.POST("/:id/worktree/snapshots/:snapshot_id/restore", taskHandler.RestoreTaskWorktreeSnapshot)
+		// Read-only file browser over the task's worktree
+		tasks.GET("/:id/worktree/files", taskHandler.ListTaskWorktreeFiles)
+		tasks.GET("/:id/worktree/files/content", taskHandler.GetTaskWorktreeFileContent)
+
+		// Executor comparison: two executors run the task side by side
+		tasks.POST("/:id/comparison", taskHandler.StartTaskComparison)
+		tasks.GET("/:id/comparison", taskHandler.GetTaskComparison)
+		tasks.POST("/:id/comparison/select", taskHandler.SelectTaskComparisonWinner)
+		// Dry run: the implementation's diff as an attachment, nothing kept
+		tasks.POST("/:id/dry-run", taskHandler.StartTaskDryRun)
+
+		// Commits linked from pushed commit messages
+		tasks.GET("/:id/commits", commitHandler.ListTaskCommits)
+		// Audit trail of the git operations done for the task
+		tasks.GET("/:id/git-operations", taskHandler.ListTaskGitOperations)
+
+		// Files attached to the task
+		tasks.POST("/:id/attachments", OptionalAPIKey(apiKeyAuth), attachmentHandler.UploadTaskAttachment)
+		tasks.GET("/:id/attachments", attachmentHandler.ListTaskAttachments)
+		// Images pasted into the task's description or comments
+		tasks.POST("/:id/media", OptionalAPIKey(apiKeyAuth), attachmentHandler.UploadTaskMedia)
+	}
+
+	// Undo of bulk task operations within the undo window
+	undo := v1.Group("/undo")
+	{
+		undo.POST("/:token", taskHandler.UndoOperation)
+	}
+
+	// Execution routes
+	executions := v1.Group("/executions")
+	{
+		executions.POST("", executionHandler.CreateExecution)
+		executions.GET("/stats", executionHandler.GetExecutionStats)
+		executions.GET("/:id", executionHandler.GetExecutionByID)
+		executions.PUT("/:id", executionHandler.UpdateExecution)
+		executions.DELETE("/:id", executionHandler.DeleteExecution)
+		executions.GET("/:id/logs", OptionalAPIKey(apiKeyAuth), executionHandler.GetExecutionLogs)
+		executions.GET("/:id/verification-runs", executionHandler.GetExecutionVerificationRuns)
+		executions.GET("/:id/attempts", executionHandler.GetExecutionAttempts)
+		executions.GET("/:id/review-comments", executionHandler.GetExecutionReviewComments)
+		executions.GET("/:id/security-findings", executionHandler.GetExecutionSecurityFindings)
+		executions.POST("/:id/answer", taskHandler.AnswerExecutionQuestion)
+		executions.POST("/:id/cancel", taskHandler.CancelExecution)
+		executions.POST("/:id/retry", taskHandler.RetryExecution)
+	}
+
+	// Release notes routes
+	releaseNotes := v1.Group("/release-notes")
+	{
+		releaseNotes.GET("/:id", releaseNotesHandler.GetReleaseNotes)
+		releaseNotes.GET("/:id/download", releaseNotesHandler.DownloadReleaseNotes)
+	}
+
+	// Attachment downloads, authenticated by the signature of their URL
+	attachments := v1.Group("/attachments")
+	{
+		attachments.GET("/:id/download", attachmentHandler.DownloadAttachment)
+	}
+
+	// Uploaded images, served at lasting URLs for descriptions and comments
+	// to embed
+	media := v1.Group("/media")
+	{
+		media.GET("/:id", attachmentHandler.GetMedia)
+	}
+
+	// Editor plugin routes, authenticated with an API key
+	ide := v1.Group("/ide", apiKeyAuth)
+	{
+		ide.POST("/tasks", ideHandler.CreateTaskFromSelection)
+		ide.GET("/tasks/mine", ideHandler.ListMyTasks)
+		ide.GET("/tasks/:id/worktree", ideHandler.GetTaskWorktree)
+	}
+
+	projectWebhooks := v1.Group("/project-webhooks")
+	{
+		projectWebhooks.PUT("/:id", projectWebhookHandler.UpdateWebhook)
+		projectWebhooks.DELETE("/:id", projectWebhookHandler.DeleteWebhook)
+		projectWebhooks.GET("/:id/deliveries", projectWebhookHandler.ListDeliveries)
+	}
+	webhookDeliveries := v1.Group("/webhook-deliveries")
+	{
+		webhookDeliveries.POST("/:id/redeliver", projectWebhookHandler.RedeliverWebhook)
+	}
+
+	// Repository host webhooks and Slack button clicks, authenticated by
+	// signature
+	webhooks := v1.Group("/webhooks")
+	{
+		webhooks.POST("/github", commitHandler.GitHubWebhook)
+		webhooks.POST("/slack", slackHandler.SlackInteraction)
+	}
+
+	// Zapier/n8n routes, authenticated with an API key
+	automation := v1.Group("/automation", apiKeyAuth)
+	{
+		automation.GET("/me", automationHandler.GetAuthenticatedOwner)
+		automation.GET("/events", automationHandler.ListEvents)
+		automation.POST("/tasks", automationHandler.CreateTask)
+	}
+
+	// Worktree routes
+	RegisterWorktreeRoutes(v1, worktreeHandler)
+}
\ No newline at end of file
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// Search godoc
+// @Summary Quick search
+// @Description Search the names and descriptions of projects, the titles and descriptions of tasks, the content of plans and the titles and bodies of pull requests, for a command palette. Results are tagged with their type and ranked, best match first. A task key such as AD-42 or #42 also finds the task, its plans and its pull requests. Archived projects and tasks are left out.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query"
+// @Param limit query int false "Maximum number of results" default(20)
+// @Success 200 {object} dto.SearchResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/search [get]
+func (h *ProjectHandler) Search(c *gin.Context) {
+	var query dto.SearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	results, err := h.projectUsecase.Search(c.Request.Context(), query.Q, query.Limit)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to search")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SearchResponseFromEntities(query.Q, results))
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	slackSignatureHeader = "X-Slack-Signature"
+	slackTimestampHeader = "X-Slack-Request-Timestamp"
+
+	// maxSlackRequestAge rejects replayed interactivity requests, as Slack
+	// recommends
+	maxSlackRequestAge = 5 * time.Minute
+	// maxSlackBodySize bounds interactivity payloads, which carry the
+	// message the button is on
+	maxSlackBodySize = 1 << 20
+)
+
+// SlackHandler configures the Slack notifications of the projects and
+// receives the clicks on their buttons
+type SlackHandler struct {
+	slackUsecase  usecase.SlackUsecase
+	signingSecret string
+}
+
+func NewSlackHandler(slackUsecase usecase.SlackUsecase, signingSecret string) *SlackHandler {
+	return &SlackHandler{
+		slackUsecase:  slackUsecase,
+		signingSecret: signingSecret,
+	}
+}
+
+// GetSlackIntegration godoc
+// @Summary Get a project's Slack integration
+// @Description Get how a project posts its notifications to Slack. The webhook URL and bot token are never returned.
+// @Tags slack
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.SlackIntegrationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/slack [get]
+func (h *SlackHandler) GetSlackIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	integration, err := h.slackUsecase.Get(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to fetch Slack integration")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SlackIntegrationResponseFromEntity(integration))
+}
+
+// ConfigureSlackIntegration godoc
+// @Summary Configure a project's Slack integration
+// @Description Post the project's task, plan, failure and merge notifications to a Slack incoming webhook, or to a channel with a bot token. Credentials are stored encrypted in the secrets store; omit both to keep the current ones. Interactive adds Approve and Reject buttons to plans ready for review, which needs the Slack app's interactivity URL set to /api/v1/webhooks/slack.
+// @Tags slack
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param integration body dto.SlackIntegrationRequest true "Slack integration settings"
+// @Success 200 {object} dto.SlackIntegrationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/slack [put]
+func (h *SlackHandler) ConfigureSlackIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.SlackIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	integration, err := h.slackUsecase.Configure(c.Request.Context(), projectID, usecase.ConfigureSlackRequest{
+		WebhookURL:  req.WebhookURL,
+		BotToken:    req.BotToken,
+		Channel:     req.Channel,
+		Interactive: req.Interactive,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to configure Slack integration")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SlackIntegrationResponseFromEntity(integration))
+}
+
+// DeleteSlackIntegration godoc
+// @Summary Remove a project's Slack integration
+// @Description Stop posting the project's notifications to Slack and remove its stored credentials. Muted notification types are kept.
+// @Tags slack
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/slack [delete]
+func (h *SlackHandler) DeleteSlackIntegration(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	if err := h.slackUsecase.Delete(c.Request.Context(), projectID); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to delete Slack integration")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListNotificationPreferences godoc
+// @Summary List a project's notification preferences
+// @Description List whether each type of notification posted to the project's Slack channel is muted
+// @Tags slack
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.NotificationPreferenceListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/notification-preferences [get]
+func (h *SlackHandler) ListNotificationPreferences(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	preferences, err := h.slackUsecase.ListPreferences(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list notification preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NotificationPreferenceListResponseFromEntities(preferences))
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Mute or unmute a project's notifications
+// @Description Mute or unmute types of notification posted to the project's Slack channel: TASK_CREATED, PLAN_READY, EXECUTION_FAILED or PR_MERGED. Types left out keep their setting.
+// @Tags slack
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param preferences body dto.UpdateNotificationPreferencesRequest true "Muted notification types"
+// @Success 200 {object} dto.NotificationPreferenceListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/notification-preferences [put]
+func (h *SlackHandler) UpdateNotificationPreferences(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	preferences, err := h.slackUsecase.UpdatePreferences(c.Request.Context(), projectID, req.Muted)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update notification preferences")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NotificationPreferenceListResponseFromEntities(preferences))
+}
+
+// SlackInteraction godoc
+// @Summary Receive a Slack button click
+// @Description Interactivity URL of the Slack app. Approves or rejects the plan whose Approve or Reject button was clicked, as the Slack user, and replaces the buttons of the message with the outcome. Requests must be signed with SLACK_SIGNING_SECRET and be at most 5 minutes old. Other actions, such as Open task, are acknowledged and ignored.
+// @Tags webhooks
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param X-Slack-Signature header string true "v0 HMAC-SHA256 signature of the request"
+// @Param X-Slack-Request-Timestamp header string true "Unix time the request was sent"
+// @Param payload formData string true "block_actions payload"
+// @Success 200
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/webhooks/slack [post]
+func (h *SlackHandler) SlackInteraction(c *gin.Context) {
+	if h.signingSecret == "" {
+		c.JSON(http.StatusServiceUnavailable, dto.NewErrorResponse(errors.New("SLACK_SIGNING_SECRET is not set"), http.StatusServiceUnavailable, "Slack interactivity is not configured"))
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxSlackBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Failed to read Slack request body"))
+		return
+	}
+	if err := verifySlackSignature(h.signingSecret, body, c.GetHeader(slackTimestampHeader), c.GetHeader(slackSignatureHeader), time.Now()); err != nil {
+		c.JSON(http.StatusUnauthorized, dto.NewErrorResponse(err, http.StatusUnauthorized, "Invalid Slack signature"))
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid Slack request body"))
+		return
+	}
+	var payload dto.SlackInteractionPayload
+	if err := json.Unmarshal([]byte(form.Get("payload")), &payload); err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid Slack interaction payload"))
+		return
+	}
+
+	interaction, ok := payload.ToInteraction()
+	if !ok {
+		c.Status(http.StatusOK)
+		return
+	}
+	if err := h.slackUsecase.HandleInteraction(c.Request.Context(), interaction); err != nil {
+		// Link buttons are sent here too
+		if errors.Is(err, usecase.ErrSlackActionInvalid) {
+			c.Status(http.StatusOK)
+			return
+		}
+		respondError(c, err, http.StatusInternalServerError, "Failed to handle Slack interaction")
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// verifySlackSignature checks an X-Slack-Signature header against the
+// timestamp and body, rejecting requests older than maxSlackRequestAge
+func verifySlackSignature(secret string, body []byte, timestamp, header string, now time.Time) error {
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("missing or invalid request timestamp")
+	}
+	if age := now.Sub(time.Unix(sentAt, 0)); age > maxSlackRequestAge || age < -maxSlackRequestAge {
+		return fmt.Errorf("request timestamp is %s old", age.Round(time.Second))
+	}
+
+	signature, ok := strings.CutPrefix(header, "v0=")
+	if !ok {
+		return errors.New("signature does not match")
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.New("signature does not match")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
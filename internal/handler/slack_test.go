@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testSlackSigningSecret = "slack-signing-secret"
+
+func slackSignature(timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(testSlackSigningSecret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func postSlackInteraction(slackUsecase usecase.SlackUsecase, secret, timestamp, body, signature string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/webhooks/slack", NewSlackHandler(slackUsecase, secret).SlackInteraction)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/slack", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(slackTimestampHeader, timestamp)
+	req.Header.Set(slackSignatureHeader, signature)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestSlackHandler_SlackInteraction(t *testing.T) {
+	payload := `{"type":"block_actions","user":{"username":"alice"},"response_url":"https://hooks.slack.com/actions/1","actions":[{"action_id":"approve_plan","value":"task/plan"}],"message":{"blocks":[{"type":"section","text":{"type":"mrkdwn","text":"Plan ready"}}]}}`
+	body := url.Values{"payload": {payload}}.Encode()
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	t.Run("hands the click to the usecase", func(t *testing.T) {
+		slackUsecase := usecase.NewSlackUsecaseMock(t)
+		slackUsecase.EXPECT().HandleInteraction(mock.Anything, mock.MatchedBy(func(interaction usecase.SlackInteraction) bool {
+			return interaction.ActionID == usecase.SlackActionApprovePlan &&
+				interaction.Value == "task/plan" &&
+				interaction.UserName == "alice" &&
+				interaction.ResponseURL == "https://hooks.slack.com/actions/1" &&
+				len(interaction.Blocks) == 1
+		})).Return(nil)
+
+		w := postSlackInteraction(slackUsecase, testSlackSigningSecret, now, body, slackSignature(now, body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("acknowledges link buttons", func(t *testing.T) {
+		slackUsecase := usecase.NewSlackUsecaseMock(t)
+		slackUsecase.EXPECT().HandleInteraction(mock.Anything, mock.Anything).Return(usecase.ErrSlackActionInvalid)
+
+		w := postSlackInteraction(slackUsecase, testSlackSigningSecret, now, body, slackSignature(now, body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("rejects a bad signature", func(t *testing.T) {
+		w := postSlackInteraction(usecase.NewSlackUsecaseMock(t), testSlackSigningSecret, now, body, "v0=deadbeef")
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("rejects an old request", func(t *testing.T) {
+		old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+
+		w := postSlackInteraction(usecase.NewSlackUsecaseMock(t), testSlackSigningSecret, old, body, slackSignature(old, body))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("is unavailable without a signing secret", func(t *testing.T) {
+		w := postSlackInteraction(usecase.NewSlackUsecaseMock(t), "", now, body, slackSignature(now, body))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
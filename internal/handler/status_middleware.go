@@ -30,7 +30,7 @@ func TaskStatusValidationMiddleware() gin.HandlerFunc {
 		if method == "POST" && strings.Contains(path, "/tasks") {
 			var taskReq dto.TaskCreateRequest
 			if err := c.ShouldBindJSON(&taskReq); err != nil {
-				c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+				respondBindingError(c, err, "Invalid request data")
 				c.Abort()
 				return
 			}
@@ -46,7 +46,7 @@ func TaskStatusValidationMiddleware() gin.HandlerFunc {
 			if strings.Contains(path, "/bulk-status") {
 				var bulkReq dto.BulkStatusUpdateRequest
 				if err := c.ShouldBindJSON(&bulkReq); err != nil {
-					c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+					respondBindingError(c, err, "Invalid request data")
 					c.Abort()
 					return
 				}
@@ -65,7 +65,7 @@ func TaskStatusValidationMiddleware() gin.HandlerFunc {
 			} else if strings.Contains(path, "/status-with-history") {
 				var statusReq dto.TaskStatusUpdateWithHistoryRequest
 				if err := c.ShouldBindJSON(&statusReq); err != nil {
-					c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+					respondBindingError(c, err, "Invalid request data")
 					c.Abort()
 					return
 				}
@@ -84,7 +84,7 @@ func TaskStatusValidationMiddleware() gin.HandlerFunc {
 			} else {
 				var statusReq dto.TaskStatusUpdateRequest
 				if err := c.ShouldBindJSON(&statusReq); err != nil {
-					c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+					respondBindingError(c, err, "Invalid request data")
 					c.Abort()
 					return
 				}
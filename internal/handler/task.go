@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"slices"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/auto-devs/auto-devs/internal/handler/dto"
@@ -34,7 +36,7 @@ func NewTaskHandler(taskUsecase usecase.TaskUsecase) *TaskHandler {
 func (h *TaskHandler) CreateTask(c *gin.Context) {
 	var req dto.TaskCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -43,6 +45,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 		Title:        req.Title,
 		Description:  req.Description,
 		KanbanTaskID: req.KanbanTaskID,
+		WorkflowType: req.WorkflowType,
 	}
 
 	task, err := h.taskUsecase.Create(c.Request.Context(), usecaseReq)
@@ -62,7 +65,10 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} dto.TaskResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -71,13 +77,17 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	if checkNotModified(c, weakETag(task.ID, task.UpdatedAt)) {
 		return
 	}
 
@@ -85,6 +95,40 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetTaskFull godoc
+// @Summary Get a task with everything its detail view shows
+// @Description Get the task with its latest plan, a summary of its latest execution with its log count, its pull request and its comments, oldest first, in one response
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} dto.TaskFullResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/full [get]
+func (h *TaskHandler) GetTaskFull(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	detail, err := h.taskUsecase.GetDetail(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	response := dto.TaskFullResponseFromDetail(detail)
+	if checkNotModified(c, taskFullETag(response)) {
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
 // GetTaskPlans godoc
 // @Summary Get plans for a task
 // @Description Get all plans for a specific task, sorted by created_at descending
@@ -92,7 +136,12 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} dto.TaskPlansResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -101,7 +150,7 @@ func (h *TaskHandler) GetTaskPlans(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
@@ -116,60 +165,47 @@ func (h *TaskHandler) GetTaskPlans(c *gin.Context) {
 		planResponses[i].FromEntity(&plan)
 	}
 
+	page, meta := paginate(c, planResponses)
+	etagParts := []any{meta}
+	for _, plan := range page {
+		etagParts = append(etagParts, plan.ID, plan.UpdatedAt)
+	}
+	if checkNotModified(c, weakETag(etagParts...)) {
+		return
+	}
+
 	response := dto.TaskPlansResponse{
-		Plans: planResponses,
+		Items:    page,
+		ListMeta: meta,
 	}
 	c.JSON(http.StatusOK, response)
 }
 
-// UpdatePlan godoc
-// @Summary Update a plan
-// @Description Update a plan by its ID
-// @Tags plans
-// @Accept json
+// GetStatusHistory godoc
+// @Summary Get a task's status history
+// @Description List the status changes of a task, oldest first. Each change to PLANNING has its planning_iteration, from 1: a plan rejected with feedback is planned again in the next iteration.
+// @Tags tasks
 // @Produce json
 // @Param id path string true "Task ID"
-// @Param planId path string true "Plan ID"
-// @Param plan body dto.PlanUpdateRequest true "Plan update data"
-// @Success 200 {object} dto.PlanResponse
+// @Success 200 {array} dto.TaskStatusHistoryResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /api/v1/tasks/{id}/plans/{planId} [put]
-func (h *TaskHandler) UpdateTaskPlan(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := uuid.Parse(idStr)
+// @Router /api/v1/tasks/{id}/status-history [get]
+func (h *TaskHandler) GetStatusHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid plan ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
-	planIdStr := c.Param("planId")
-	planId, err := uuid.Parse(planIdStr)
+	history, err := h.taskUsecase.GetStatusHistory(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid plan ID"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to get status history")
 		return
 	}
 
-	var req dto.PlanUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
-		return
-	}
-
-	usecaseReq := usecase.UpdateTaskPlanRequest{
-		Content: req.Content,
-	}
-
-	plan, err := h.taskUsecase.UpdateTaskPlan(c.Request.Context(), id, planId, usecaseReq)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update plan"))
-		return
-	}
-
-	response := &dto.PlanResponse{}
-	response.FromEntity(plan)
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, dto.TaskStatusHistoryListFromEntities(history))
 }
 
 // ListTasks godoc
@@ -181,6 +217,8 @@ func (h *TaskHandler) UpdateTaskPlan(c *gin.Context) {
 // @Param status query string false "Filter by status" Enums(TODO, PLANNING, PLAN_REVIEWING, IMPLEMENTING, CODE_REVIEWING, DONE, CANCELLED)
 // @Param project_id query string false "Filter by project ID"
 // @Param search query string false "Search in title and description"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
 // @Success 200 {object} dto.TaskListResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -188,7 +226,7 @@ func (h *TaskHandler) UpdateTaskPlan(c *gin.Context) {
 func (h *TaskHandler) ListTasks(c *gin.Context) {
 	var query dto.TaskFilterQuery
 	if err := c.ShouldBindQuery(&query); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid query parameters"))
+		respondBindingError(c, err, "Invalid query parameters")
 		return
 	}
 
@@ -203,7 +241,7 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 	} else if query.ProjectID != nil {
 		projectID, parseErr := uuid.Parse(*query.ProjectID)
 		if parseErr != nil {
-			c.JSON(http.StatusBadRequest, dto.NewErrorResponse(parseErr, http.StatusBadRequest, "Invalid project ID"))
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(parseErr, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 			return
 		}
 		tasks, err = h.taskUsecase.GetByProjectID(c.Request.Context(), projectID)
@@ -219,10 +257,145 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 		return
 	}
 
-	response := dto.TaskListResponseFromEntities(tasks)
+	page, meta := paginate(c, tasks)
+	response := dto.TaskListResponseFromEntities(page, meta)
 	c.JSON(http.StatusOK, response)
 }
 
+// GetProjectBoard godoc
+// @Summary Get a project's Kanban board
+// @Description Get the project's unarchived tasks grouped into one column per status, as light cards ordered by priority and then most recently updated, with the number of tasks in each column. The cards of the done column are left out unless include_done is set; its count is always given. epic_id limits the board, counts included, to the tasks of an epic.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param include_done query bool false "Include the cards of done tasks" default(false)
+// @Param epic_id query string false "Only show the tasks of this epic"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} dto.TaskBoardResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/board [get]
+func (h *TaskHandler) GetProjectBoard(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	includeDone := false
+	if v := c.Query("include_done"); v != "" {
+		if v == "1" || v == "true" || v == "True" {
+			includeDone = true
+		}
+	}
+
+	var epicID *uuid.UUID
+	if v := c.Query("epic_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid epic ID"))
+			return
+		}
+		epicID = &id
+	}
+
+	board, err := h.taskUsecase.GetBoard(c.Request.Context(), projectID, includeDone, epicID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get project board")
+		return
+	}
+
+	response := dto.TaskBoardResponseFromUsecase(board)
+	if checkNotModified(c, taskBoardETag(response)) {
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// BulkDeleteTasks godoc
+// @Summary Delete several tasks of a project
+// @Description Delete the selected tasks of the project. Selected tasks of other projects are ignored. The response carries an undo token that restores the deleted tasks with POST /api/v1/undo/{token} until expires_at.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body dto.BulkTaskOperationRequest true "Tasks to delete"
+// @Success 200 {object} dto.UndoOperationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/tasks/bulk-delete [post]
+func (h *TaskHandler) BulkDeleteTasks(c *gin.Context) {
+	h.bulkTaskOperation(c, h.taskUsecase.BulkDelete, "Failed to delete tasks")
+}
+
+// BulkArchiveTasks godoc
+// @Summary Archive several tasks of a project
+// @Description Archive the selected tasks of the project. Selected tasks of other projects, and tasks already archived, are ignored. The response carries an undo token that unarchives the tasks with POST /api/v1/undo/{token} until expires_at.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body dto.BulkTaskOperationRequest true "Tasks to archive"
+// @Success 200 {object} dto.UndoOperationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/tasks/bulk-archive [post]
+func (h *TaskHandler) BulkArchiveTasks(c *gin.Context) {
+	h.bulkTaskOperation(c, h.taskUsecase.BulkArchive, "Failed to archive tasks")
+}
+
+// bulkTaskOperation runs an undoable bulk operation on the project's tasks
+// selected in the request body
+func (h *TaskHandler) bulkTaskOperation(c *gin.Context, operation func(context.Context, uuid.UUID, []uuid.UUID) (*entity.UndoOperation, error), failureMessage string) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.BulkTaskOperationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	undoOperation, err := operation(c.Request.Context(), projectID, req.TaskIDs)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, failureMessage)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UndoOperationResponseFromEntity(undoOperation))
+}
+
+// UndoOperation godoc
+// @Summary Undo a bulk delete or archive
+// @Description Restore the tasks of a bulk delete or archive with the undo token it returned. A token works once, and only within the undo window after the operation (UNDO_WINDOW seconds, 300 by default).
+// @Tags tasks
+// @Produce json
+// @Param token path string true "Undo token"
+// @Success 200 {object} dto.UndoOperationResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 410 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/undo/{token} [post]
+func (h *TaskHandler) UndoOperation(c *gin.Context) {
+	undoOperation, err := h.taskUsecase.Undo(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to undo operation")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UndoOperationResponseFromEntity(undoOperation))
+}
+
 // ListTasksByProject godoc
 // @Summary List tasks by project
 // @Description Get all tasks for a specific project
@@ -230,7 +403,12 @@ func (h *TaskHandler) ListTasks(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} dto.TaskListResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/projects/{id}/tasks [get]
@@ -238,7 +416,7 @@ func (h *TaskHandler) ListTasksByProject(c *gin.Context) {
 	projectIDStr := c.Param("id")
 	projectID, err := uuid.Parse(projectIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -279,10 +457,238 @@ func (h *TaskHandler) ListTasksByProject(c *gin.Context) {
 		return
 	}
 
-	response := dto.TaskListResponseFromEntities(tasks)
+	page, meta := paginate(c, tasks)
+	if checkNotModified(c, taskListETag(page, meta)) {
+		return
+	}
+
+	response := dto.TaskListResponseFromEntities(page, meta)
 	c.JSON(http.StatusOK, response)
 }
 
+// GetProjectCycleTimeAnalytics godoc
+// @Summary Get cycle time and lead time analytics of a project
+// @Description Measure the project's tasks completed within the date range from their status history: lead time from creation to DONE, cycle time from when work started to DONE, and the time spent in each status. Durations are in hours. With format=csv the tasks are returned as a CSV file, and with format=pdf as a PDF summary for sharing.
+// @Tags projects
+// @Accept json
+// @Produce json,text/csv,application/pdf
+// @Param id path string true "Project ID"
+// @Param from query string false "Only tasks completed at or after this time (RFC 3339)"
+// @Param to query string false "Only tasks completed at or before this time (RFC 3339)"
+// @Param format query string false "Response format" Enums(json, csv, pdf) default(json)
+// @Success 200 {object} dto.CycleTimeAnalyticsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/cycle-time [get]
+func (h *TaskHandler) GetProjectCycleTimeAnalytics(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.CycleTimeAnalyticsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	analytics, err := h.taskUsecase.GetCycleTimeAnalytics(c.Request.Context(), projectID, query.From, query.To)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get cycle time analytics")
+		return
+	}
+
+	response := dto.CycleTimeAnalyticsResponseFromAnalytics(analytics)
+	if exported(query.Format) {
+		writeAnalyticsReport(c, query.Format, cycleTimeReport(response))
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetProjectExecutorAnalytics godoc
+// @Summary Compare the executors of a project
+// @Description Compare the AI executors that ran the project's tasks on success rate, average duration, auto-fix attempts, runs per task, pull request merge rate and cost. A pull request is credited to the executor of the last completed run of its task before it was opened.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param from query string false "Only executions started at or after this time (RFC 3339)"
+// @Param to query string false "Only executions started at or before this time (RFC 3339)"
+// @Success 200 {object} dto.ExecutorAnalyticsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/executors [get]
+func (h *TaskHandler) GetProjectExecutorAnalytics(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.ExecutorAnalyticsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	analytics, err := h.taskUsecase.GetExecutorAnalytics(c.Request.Context(), projectID, query.From, query.To)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get executor analytics")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ExecutorAnalyticsResponseFromAnalytics(analytics))
+}
+
+// GetProjectVelocity godoc
+// @Summary Get the weekly velocity of a project
+// @Description Get the tasks completed, pull requests merged and AI runs per completed task of each week, Monday to Monday UTC, up to the current one. The figures are rolled up every hour; weeks not rolled up yet count as zero. With format=csv the weeks are returned as a CSV file for sprint reviews, and with format=pdf as a PDF summary for sharing.
+// @Tags projects
+// @Accept json
+// @Produce json,text/csv,application/pdf
+// @Param id path string true "Project ID"
+// @Param weeks query int false "Number of weeks, the current one included" default(12)
+// @Param format query string false "Response format" Enums(json, csv, pdf) default(json)
+// @Success 200 {object} dto.VelocityResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/velocity [get]
+func (h *TaskHandler) GetProjectVelocity(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.VelocityQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+	if query.Weeks == 0 {
+		query.Weeks = defaultVelocityWeeks
+	}
+
+	report, err := h.taskUsecase.GetWeeklyVelocity(c.Request.Context(), projectID, query.Weeks)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get velocity")
+		return
+	}
+
+	response := dto.VelocityResponseFromReport(report)
+	if exported(query.Format) {
+		writeAnalyticsReport(c, query.Format, velocityReport(response))
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetProjectPlanReviewAnalytics godoc
+// @Summary Get plan review latency analytics of a project
+// @Description Measure the time from a task entering PLAN_REVIEWING to its plan being approved or rejected, as average and percentiles for the project and per reviewer, and list the plans still waiting for review. A task sent back to TODO or PLANNING, or cancelled, counts as rejected.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param from query string false "Only reviews decided at or after this time (RFC 3339)"
+// @Param to query string false "Only reviews decided at or before this time (RFC 3339)"
+// @Success 200 {object} dto.PlanReviewAnalyticsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/plan-reviews [get]
+func (h *TaskHandler) GetProjectPlanReviewAnalytics(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.PlanReviewAnalyticsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	analytics, err := h.taskUsecase.GetPlanReviewAnalytics(c.Request.Context(), projectID, query.From, query.To)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get plan review analytics")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PlanReviewAnalyticsResponseFromAnalytics(analytics))
+}
+
+// GetProjectPullRequestAnalytics godoc
+// @Summary Get pull request analytics of a project
+// @Description Report the merge rate, time to merge and pull requests closed without merge of the project's pull requests, optionally broken down by the week or month they were opened in. The merge rate is the share of merged pull requests among the merged and closed ones.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param from query string false "Only pull requests opened at or after this time (RFC 3339)"
+// @Param to query string false "Only pull requests opened at or before this time (RFC 3339)"
+// @Param period query string false "Break the pull requests down by the period they were opened in" Enums(week, month)
+// @Success 200 {object} dto.PullRequestAnalyticsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/pull-requests [get]
+func (h *TaskHandler) GetProjectPullRequestAnalytics(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var query dto.PullRequestAnalyticsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	analytics, err := h.taskUsecase.GetPullRequestAnalytics(c.Request.Context(), projectID, query.From, query.To, usecase.AnalyticsPeriod(query.Period))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get pull request analytics")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PullRequestAnalyticsResponseFromAnalytics(analytics))
+}
+
+// GetProjectWorkload godoc
+// @Summary Get the workload of a project by assignee
+// @Description Get the open tasks of each assignee, by status, with their estimated hours and the AI runs pending, running or paused on them, to balance work between people and the AI queue. Unassigned tasks are reported under an empty assignee. Done, cancelled and archived tasks are left out.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.WorkloadResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/analytics/workload [get]
+func (h *TaskHandler) GetProjectWorkload(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	report, err := h.taskUsecase.GetWorkload(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get workload")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorkloadResponseFromReport(report))
+}
+
 // ListDoneTasksByProject godoc
 // @Summary List DONE tasks by project
 // @Description Get tasks with DONE status for a specific project
@@ -290,7 +696,12 @@ func (h *TaskHandler) ListTasksByProject(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Param If-None-Match header string false "ETag of a cached copy"
 // @Success 200 {object} dto.TaskListResponse
+// @Header 200 {string} ETag "Weak entity tag of the response"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/projects/{id}/tasks/done [get]
@@ -298,7 +709,7 @@ func (h *TaskHandler) ListDoneTasksByProject(c *gin.Context) {
 	projectIDStr := c.Param("id")
 	projectID, err := uuid.Parse(projectIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid project ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
@@ -315,13 +726,18 @@ func (h *TaskHandler) ListDoneTasksByProject(c *gin.Context) {
 		}
 	}
 
-	response := dto.TaskListResponseFromEntities(filtered)
+	page, meta := paginate(c, filtered)
+	if checkNotModified(c, taskListETag(page, meta)) {
+		return
+	}
+
+	response := dto.TaskListResponseFromEntities(page, meta)
 	c.JSON(http.StatusOK, response)
 }
 
 // UpdateTask godoc
 // @Summary Update a task
-// @Description Update a task with the provided details
+// @Description Update a task with the provided details. Omitted fields are left unchanged; list fields in clear_fields to reset them.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -336,13 +752,13 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.TaskUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -350,7 +766,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	if req.Title != nil {
 		usecaseReq.Title = *req.Title
 	}
-	if req.Description != nil {
+	if req.Description != nil && *req.Description != "" {
 		usecaseReq.Description = *req.Description
 	}
 	if req.Status != nil {
@@ -362,10 +778,14 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	if req.PullRequest != nil {
 		usecaseReq.PullRequest = req.PullRequest
 	}
+	usecaseReq.AssignedTo = req.AssignedTo
+	usecaseReq.DueDate = req.DueDate
+	usecaseReq.HighRiskReason = req.HighRiskReason
+	usecaseReq.ClearFields = taskUpdateClearFields(req)
 
 	task, err := h.taskUsecase.Update(c.Request.Context(), id, usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task")
 		return
 	}
 
@@ -373,6 +793,37 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// taskUpdateClearFields returns the fields a task update should clear. An
+// explicit empty description is treated as a request to clear it.
+func taskUpdateClearFields(req dto.TaskUpdateRequest) []string {
+	fields := append([]string(nil), req.ClearFields...)
+	if req.Description != nil && *req.Description == "" && !slices.Contains(fields, usecase.TaskFieldDescription) {
+		fields = append(fields, usecase.TaskFieldDescription)
+	}
+	return fields
+}
+
+// clearedTaskFieldValue returns the current value of a clearable task field
+func clearedTaskFieldValue(task *entity.Task, field string) interface{} {
+	switch field {
+	case usecase.TaskFieldDescription:
+		return task.Description
+	case usecase.TaskFieldAssignedTo:
+		return task.AssignedTo
+	case usecase.TaskFieldDueDate:
+		return task.DueDate
+	case usecase.TaskFieldEstimatedHours:
+		return task.EstimatedHours
+	case usecase.TaskFieldActualHours:
+		return task.ActualHours
+	case usecase.TaskFieldTags:
+		return task.Tags
+	case usecase.TaskFieldEpicID:
+		return task.EpicID
+	}
+	return nil
+}
+
 // DeleteTask godoc
 // @Summary Delete a task
 // @Description Delete a task by its ID
@@ -389,7 +840,7 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
@@ -406,7 +857,7 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 
 // StartPlanning godoc
 // @Summary Start planning for a task
-// @Description Start the planning phase for a task by selecting a branch and initiating background processing
+// @Description Start the planning phase for a task by selecting a branch and initiating background processing. With scheduled_at, the planning job is enqueued to start then and the task stays in TODO until it does.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -415,26 +866,27 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 // @Success 200 {object} dto.StartPlanningResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse "Too much planning work in progress; details carry the estimated wait"
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /api/v1/tasks/{id}/start-planning [post]
 func (h *TaskHandler) StartPlanning(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.StartPlanningRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Validate that task exists and is in TODO status
 	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -442,11 +894,18 @@ func (h *TaskHandler) StartPlanning(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(nil, http.StatusBadRequest, "Task must be in TODO status to start planning"))
 		return
 	}
+	if req.ScheduledAt != nil {
+		h.schedulePlanning(c, id, req)
+		return
+	}
 
 	// Start planning (this will enqueue a background job)
-	jobID, err := h.taskUsecase.StartPlanning(c.Request.Context(), id, req.BranchName, req.AIType, req.AutoImplement, req.UseRemoteBranch)
+	jobID, err := h.taskUsecase.StartPlanning(c.Request.Context(), id, req.BranchName, req.AIType, req.AutoImplement, req.UseRemoteBranch, nil)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to start planning"))
+		if respondPlanningOverloaded(c, err) {
+			return
+		}
+		respondError(c, err, http.StatusInternalServerError, "Failed to start planning")
 		return
 	}
 
@@ -459,35 +918,39 @@ func (h *TaskHandler) StartPlanning(c *gin.Context) {
 
 // ApprovePlan godoc
 // @Summary Approve plan and start implementation
-// @Description Approve the plan for a task and enqueue implementation job
+// @Description Approve the plan for a task and enqueue implementation job. The plan of a high-risk task needs step-up approval: approvals made with two different API keys, or one with a TOTP code. Until it has it, approvals are recorded and answered with 202. With scheduled_at, the implementation job is enqueued to start then and the task stays in PLAN_REVIEWING until it does.
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID"
 // @Param request body dto.ApprovePlanRequest true "Approve plan request"
 // @Success 200 {object} dto.StartPlanningResponse
+// @Success 202 {object} dto.StartPlanningResponse "Approval recorded, another is needed"
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
 // @Router /api/v1/tasks/{id}/approve-plan [post]
 func (h *TaskHandler) ApprovePlan(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.ApprovePlanRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Validate that task exists and is in PLAN_REVIEWING status
 	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -495,11 +958,18 @@ func (h *TaskHandler) ApprovePlan(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(nil, http.StatusBadRequest, "Task must be in PLAN_REVIEWING status to approve plan"))
 		return
 	}
+	if !h.stepUpPlanApproval(c, id, req) {
+		return
+	}
+	if req.ScheduledAt != nil {
+		h.scheduleImplementation(c, id, req)
+		return
+	}
 
 	// Approve plan and start implementation (this will enqueue a background job)
-	jobID, err := h.taskUsecase.ApprovePlan(c.Request.Context(), id, req.AIType)
+	jobID, err := h.taskUsecase.ApprovePlan(c.Request.Context(), id, req.AIType, nil)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to approve plan and start implementation"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to approve plan and start implementation")
 		return
 	}
 
@@ -510,17 +980,49 @@ func (h *TaskHandler) ApprovePlan(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// stepUpPlanApproval records the request's approval of the task's plan,
+// made by the owner of its API key if any. It reports whether the plan may
+// be implemented, having answered the request when it may not.
+func (h *TaskHandler) stepUpPlanApproval(c *gin.Context, taskID uuid.UUID, req dto.ApprovePlanRequest) bool {
+	approved, err := h.taskUsecase.RecordPlanApproval(c.Request.Context(), taskID, usecase.PlanApprovalRequest{
+		Approver: apiKeyOwner(c),
+		TOTPCode: req.TOTPCode,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to approve plan")
+		return false
+	}
+	if !approved {
+		c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+			Message: "Approval recorded; this high-risk task needs another approver, or a TOTP code, before implementation starts",
+		})
+		return false
+	}
+	return true
+}
+
+// GetPullRequest godoc
+// @Summary Get pull request for task
+// @Description Get the pull request linked to the task
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} entity.PullRequest
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/pull-request [get]
 func (h *TaskHandler) GetPullRequest(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	pr, err := h.taskUsecase.GetPullRequest(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Pull request not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodePullRequestNotFound, "Pull request not found"))
 		return
 	}
 
@@ -538,12 +1040,12 @@ func (h *TaskHandler) GetPullRequest(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /tasks/{id}/pull-request [post]
+// @Router /api/v1/tasks/{id}/pull-request [post]
 func (h *TaskHandler) CreatePullRequest(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
@@ -572,14 +1074,14 @@ func (h *TaskHandler) OpenWithCursor(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	// Get task to check if it has a worktree path
 	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -602,6 +1104,399 @@ func (h *TaskHandler) OpenWithCursor(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// GetTaskChanges godoc
+// @Summary Get a task's changes file by file
+// @Description Get the task's changes against its base branch, parsed into files, hunks and lines with their stats. They are read from the task's worktree or, with source=pr, from its pull request on GitHub; without a source, from the worktree while it is on disk and the pull request otherwise.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param source query string false "Where to read the changes from" Enums(worktree, pr)
+// @Success 200 {object} dto.TaskChangesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/changes [get]
+func (h *TaskHandler) GetTaskChanges(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	changes, err := h.taskUsecase.GetTaskChanges(c.Request.Context(), id, c.Query("source"))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get task changes")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TaskChangesResponseFromChanges(changes))
+}
+
+// GetTaskWorktreeStatus godoc
+// @Summary Get the state of a task's worktree
+// @Description Get the branch, uncommitted file count, commits ahead of and behind the base branch, and last commit of the task's worktree
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.TaskWorktreeStatusResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree [get]
+func (h *TaskHandler) GetTaskWorktreeStatus(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	status, err := h.taskUsecase.GetWorktreeStatus(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get worktree status")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TaskWorktreeStatusResponseFromStatus(status))
+}
+
+// RecreateTaskWorktree godoc
+// @Summary Recreate a task's worktree
+// @Description Check the task branch out again at the task's worktree path after the directory was removed by cleanup or by hand. When the branch is gone too it is created again from the base branch.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.TaskResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree/recreate [post]
+func (h *TaskHandler) RecreateTaskWorktree(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	task, err := h.taskUsecase.RecreateWorktree(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to recreate worktree")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.TaskResponseFromEntity(task))
+}
+
+// ListTaskWorktreeSnapshots godoc
+// @Summary List snapshots of a task's worktree
+// @Description List the snapshots taken of the task's worktree before each implementation run and auto-fix attempt, newest first
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {array} dto.WorktreeSnapshotResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree/snapshots [get]
+func (h *TaskHandler) ListTaskWorktreeSnapshots(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	snapshots, err := h.taskUsecase.ListWorktreeSnapshots(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list worktree snapshots")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorktreeSnapshotResponsesFromSnapshots(snapshots))
+}
+
+// RestoreTaskWorktreeSnapshot godoc
+// @Summary Restore a snapshot of a task's worktree
+// @Description Revert the task's worktree to the snapshot taken before a run. Commits and changes made since are discarded.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param snapshot_id path string true "Snapshot ID"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree/snapshots/{snapshot_id}/restore [post]
+func (h *TaskHandler) RestoreTaskWorktreeSnapshot(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	if err := h.taskUsecase.RestoreWorktreeSnapshot(c.Request.Context(), id, c.Param("snapshot_id")); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to restore worktree snapshot")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Worktree restored to snapshot"})
+}
+
+// ListTaskGitOperations godoc
+// @Summary List a task's git operations
+// @Description List the audit trail of the git operations done for the task (worktree create/delete, commit, push, branch delete), oldest first, with who asked for them
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
+// @Success 200 {object} dto.GitOperationListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/git-operations [get]
+func (h *TaskHandler) ListTaskGitOperations(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	operations, err := h.taskUsecase.ListGitOperations(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	page, meta := paginate(c, operations)
+	c.JSON(http.StatusOK, dto.GitOperationListResponseFromEntities(id, page, meta))
+}
+
+// ListTaskWorktreeFiles godoc
+// @Summary List files in a task's worktree
+// @Description List a directory of the task's worktree, directories first. Paths are relative to the worktree root and may not leave it.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param path query string false "Directory to list, relative to the worktree root (default the root)"
+// @Success 200 {object} dto.WorktreeFilesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree/files [get]
+func (h *TaskHandler) ListTaskWorktreeFiles(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	path := c.Query("path")
+	entries, err := h.taskUsecase.ListWorktreeFiles(c.Request.Context(), id, path)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list worktree files")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorktreeFilesResponseFromEntries(path, entries))
+}
+
+// GetTaskWorktreeFileContent godoc
+// @Summary Get a file from a task's worktree
+// @Description Get the content of a file in the task's worktree. Binary files come without content, and files over 1 MiB are truncated.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param path query string true "File path, relative to the worktree root"
+// @Success 200 {object} dto.WorktreeFileContentResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/worktree/files/content [get]
+func (h *TaskHandler) GetTaskWorktreeFileContent(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	path := c.Query("path")
+	if path == "" {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(nil, http.StatusBadRequest, "path is required"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	content, err := h.taskUsecase.ReadWorktreeFile(c.Request.Context(), id, path)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to read worktree file")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.WorktreeFileContentResponseFromContent(content))
+}
+
+// StartTaskComparison godoc
+// @Summary Compare two executors on a task
+// @Description Run the task with each of two executors side by side, each in a worktree and on a branch of its own, from the same base branch. The task must be in TODO or PLAN_REVIEWING status.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.StartComparisonRequest true "Executors to compare"
+// @Success 202 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/comparison [post]
+func (h *TaskHandler) StartTaskComparison(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.StartComparisonRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	jobID, err := h.taskUsecase.StartComparison(c.Request.Context(), id, req.BranchName, req.AITypes)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to start executor comparison")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+		Message: "Executor comparison started successfully",
+		JobID:   jobID,
+	})
+}
+
+// GetTaskComparison godoc
+// @Summary Get the latest executor comparison of a task
+// @Description Get both variants of the task's latest executor comparison, with each completed variant's diff against the base branch
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {object} dto.ExecutorComparisonResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/comparison [get]
+func (h *TaskHandler) GetTaskComparison(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	comparison, err := h.taskUsecase.GetComparison(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get executor comparison")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ExecutorComparisonResponseFromComparison(comparison))
+}
+
+// SelectTaskComparisonWinner godoc
+// @Summary Pick the winner of an executor comparison
+// @Description Keep the variant's worktree and branch as the task's, remove the other variant, and carry the winning changes through verification to a pull request
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.SelectComparisonWinnerRequest true "Winning variant"
+// @Success 202 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/comparison/select [post]
+func (h *TaskHandler) SelectTaskComparisonWinner(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.SelectComparisonWinnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	if _, err := h.taskUsecase.GetByID(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
+		return
+	}
+
+	jobID, err := h.taskUsecase.SelectComparisonWinner(c.Request.Context(), id, req.Variant)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to select comparison winner")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+		Message: "Continuing with variant " + req.Variant,
+		JobID:   jobID,
+	})
+}
+
 // GetTaskDiff godoc
 // @Summary Get git diff for a task
 // @Description Get the git diff between the base branch HEAD and task branch HEAD
@@ -618,7 +1513,7 @@ func (h *TaskHandler) GetTaskDiff(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StartTaskDryRun godoc
+// @Summary Preview a task's implementation
+// @Description Run the executor on the task in a throwaway worktree from its base branch and attach the diff it produced to the task as dry-run-<execution>.diff. Nothing is committed or pushed, and the task keeps its status and worktree. The task must be in TODO or PLAN_REVIEWING status.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.StartDryRunRequest true "Executor to run"
+// @Success 202 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/dry-run [post]
+func (h *TaskHandler) StartTaskDryRun(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.StartDryRunRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	jobID, err := h.taskUsecase.StartDryRun(c.Request.Context(), id, req.AIType)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to start dry run")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+		Message: "Dry run started successfully",
+		JobID:   jobID,
+	})
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// schedulePlanning enqueues the task's planning to start at
+// req.ScheduledAt. The task stays in TODO until the job runs.
+func (h *TaskHandler) schedulePlanning(c *gin.Context, id uuid.UUID, req dto.StartPlanningRequest) {
+	jobID, err := h.taskUsecase.StartPlanning(c.Request.Context(), id, req.BranchName, req.AIType, req.AutoImplement, req.UseRemoteBranch, req.ScheduledAt)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to schedule planning")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.StartPlanningResponse{
+		Message:     "Planning scheduled successfully",
+		JobID:       jobID,
+		ScheduledAt: req.ScheduledAt,
+	})
+}
+
+// scheduleImplementation enqueues the implementation of the task's approved
+// plan to start at req.ScheduledAt. The task stays in PLAN_REVIEWING until
+// the job runs.
+func (h *TaskHandler) scheduleImplementation(c *gin.Context, id uuid.UUID, req dto.ApprovePlanRequest) {
+	jobID, err := h.taskUsecase.ApprovePlan(c.Request.Context(), id, req.AIType, req.ScheduledAt)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to schedule implementation")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.StartPlanningResponse{
+		Message:     "Plan approved and implementation scheduled successfully",
+		JobID:       jobID,
+		ScheduledAt: req.ScheduledAt,
+	})
+}
+
+// ListScheduledJobs godoc
+// @Summary List a task's scheduled jobs
+// @Description List the planning and implementation jobs of the task waiting for their scheduled start time, soonest first
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Success 200 {array} dto.ScheduledJobResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/scheduled-jobs [get]
+func (h *TaskHandler) ListScheduledJobs(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	jobs, err := h.taskUsecase.ListScheduledJobs(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list scheduled jobs")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ScheduledJobResponsesFromJobs(jobs))
+}
+
+// CancelScheduledJob godoc
+// @Summary Cancel a task's scheduled job
+// @Description Cancel a planning or implementation job that has not started yet. The task keeps its status.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param job_id path string true "Job ID"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/scheduled-jobs/{job_id} [delete]
+func (h *TaskHandler) CancelScheduledJob(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	if err := h.taskUsecase.CancelScheduledJob(c.Request.Context(), id, c.Param("job_id")); err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to cancel scheduled job")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
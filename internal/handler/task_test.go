@@ -0,0 +1,406 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskHandler_GetTaskFull(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	updatedAt := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	task := &entity.Task{
+		ID:        uuid.New(),
+		Title:     "Fix login",
+		Status:    entity.TaskStatusIMPLEMENTING,
+		UpdatedAt: updatedAt,
+		Plans:     []entity.Plan{{ID: uuid.New(), Content: "plan", Status: entity.PlanStatusAPPROVED, UpdatedAt: updatedAt}},
+		Executions: []entity.Execution{{
+			ID: uuid.New(), Status: entity.ExecutionStatusRunning, AIType: "claude-code", Progress: 0.5, UpdatedAt: updatedAt,
+		}},
+		PullRequests: []entity.PullRequest{{ID: uuid.New(), GitHubPRNumber: 7, UpdatedAt: updatedAt}},
+		Comments: []entity.TaskComment{
+			{ID: uuid.New(), Comment: "first", CreatedBy: "alice"},
+			{ID: uuid.New(), Comment: "second", CreatedBy: "bob"},
+		},
+	}
+	detail := &entity.TaskDetail{Task: task, LatestExecutionLogCount: 12}
+
+	serve := func(taskUsecase usecase.TaskUsecase, id string, ifNoneMatch string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.GET("/tasks/:id/full", NewTaskHandler(taskUsecase).GetTaskFull)
+		req := httptest.NewRequest(http.MethodGet, "/tasks/"+id+"/full", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns the task with its related records", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetDetail(mock.Anything, task.ID).Return(detail, nil)
+
+		w := serve(taskUsecase, task.ID.String(), "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.TaskFullResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, task.ID, response.Task.ID)
+		require.NotNil(t, response.LatestPlan)
+		assert.Equal(t, task.Plans[0].ID, response.LatestPlan.ID)
+		require.NotNil(t, response.LatestExecution)
+		assert.Equal(t, int64(12), response.LatestExecution.LogCount)
+		assert.Equal(t, "claude-code", response.LatestExecution.AIType)
+		require.NotNil(t, response.PullRequest)
+		assert.Equal(t, 7, response.PullRequest.GitHubPRNumber)
+		require.Len(t, response.Comments, 2)
+		assert.Equal(t, "first", response.Comments[0].Comment)
+	})
+
+	t.Run("revalidates against new logs", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetDetail(mock.Anything, task.ID).Return(detail, nil).Twice()
+
+		etag := serve(taskUsecase, task.ID.String(), "").Header().Get("ETag")
+		assert.Equal(t, http.StatusNotModified, serve(taskUsecase, task.ID.String(), etag).Code)
+
+		moreLogs := &entity.TaskDetail{Task: task, LatestExecutionLogCount: 13}
+		taskUsecase.EXPECT().GetDetail(mock.Anything, task.ID).Return(moreLogs, nil).Once()
+		assert.Equal(t, http.StatusOK, serve(taskUsecase, task.ID.String(), etag).Code)
+	})
+
+	t.Run("a task without related records", func(t *testing.T) {
+		bare := &entity.Task{ID: uuid.New()}
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetDetail(mock.Anything, bare.ID).Return(&entity.TaskDetail{Task: bare}, nil)
+
+		w := serve(taskUsecase, bare.ID.String(), "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "latest_plan")
+		assert.NotContains(t, w.Body.String(), "latest_execution")
+		assert.Contains(t, w.Body.String(), `"comments":[]`)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		id := uuid.New()
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetDetail(mock.Anything, id).Return(nil, assert.AnError)
+
+		assert.Equal(t, http.StatusNotFound, serve(taskUsecase, id.String(), "").Code)
+	})
+}
+
+func TestTaskHandler_GetTaskChanges(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	task := &entity.Task{ID: uuid.New(), Title: "Fix login"}
+
+	serve := func(taskUsecase usecase.TaskUsecase, id string, query string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.GET("/tasks/:id/changes", NewTaskHandler(taskUsecase).GetTaskChanges)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tasks/"+id+"/changes"+query, nil))
+		return w
+	}
+
+	t.Run("returns the files with their totals", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		taskUsecase.EXPECT().GetTaskChanges(mock.Anything, task.ID, "pr").Return(&usecase.TaskChanges{
+			TaskID: task.ID,
+			Source: usecase.TaskChangesSourcePR,
+			Files: git.ParseUnifiedDiff("diff --git a/login.go b/login.go\n--- a/login.go\n+++ b/login.go\n" +
+				"@@ -1,2 +1,2 @@ package auth\n-old\n+new\n ctx\n" +
+				"diff --git a/new.go b/new.go\nnew file mode 100644\n--- /dev/null\n+++ b/new.go\n@@ -0,0 +1 @@\n+package auth\n"),
+		}, nil)
+
+		w := serve(taskUsecase, task.ID.String(), "?source=pr")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.TaskChangesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "pr", response.Source)
+		assert.Equal(t, 2, response.FilesChanged)
+		assert.Equal(t, 2, response.Additions)
+		assert.Equal(t, 1, response.Deletions)
+		require.Len(t, response.Files, 2)
+		assert.Equal(t, "login.go", response.Files[0].OldPath)
+		require.Len(t, response.Files[0].Hunks, 1)
+		assert.Equal(t, "package auth", response.Files[0].Hunks[0].Header)
+		assert.Equal(t, []dto.DiffLineResponse{
+			{Type: "deleted", Content: "old", OldLine: 1},
+			{Type: "added", Content: "new", NewLine: 1},
+			{Type: "context", Content: "ctx", OldLine: 2, NewLine: 2},
+		}, response.Files[0].Hunks[0].Lines)
+		assert.Equal(t, "added", response.Files[1].Status)
+		assert.Empty(t, response.Files[1].OldPath)
+	})
+
+	t.Run("a task with nothing to read changes from", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		taskUsecase.EXPECT().GetTaskChanges(mock.Anything, task.ID, "").Return(&usecase.TaskChanges{TaskID: task.ID, Files: []git.FileDiff{}}, nil)
+
+		w := serve(taskUsecase, task.ID.String(), "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"files":[]`)
+	})
+
+	t.Run("an unknown source", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		taskUsecase.EXPECT().GetTaskChanges(mock.Anything, task.ID, "svn").Return(nil, usecase.ErrChangesSourceInvalid)
+
+		assert.Equal(t, http.StatusBadRequest, serve(taskUsecase, task.ID.String(), "?source=svn").Code)
+	})
+
+	t.Run("a pull request that was never opened", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		taskUsecase.EXPECT().GetTaskChanges(mock.Anything, task.ID, "pr").Return(nil, usecase.ErrTaskHasNoPullRequest)
+
+		assert.Equal(t, http.StatusNotFound, serve(taskUsecase, task.ID.String(), "?source=pr").Code)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		id := uuid.New()
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, id).Return(nil, assert.AnError)
+
+		assert.Equal(t, http.StatusNotFound, serve(taskUsecase, id.String(), "").Code)
+	})
+}
+
+func TestTaskHandler_GetProjectBoard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	projectID := uuid.New()
+	card := &entity.Task{ID: uuid.New(), Title: "Fix login", Status: entity.TaskStatusTODO, Priority: entity.TaskPriorityHigh, UpdatedAt: time.Now()}
+	board := &usecase.TaskBoard{ProjectID: projectID, Columns: []*usecase.TaskBoardColumn{
+		{Status: entity.TaskStatusTODO, Count: 1, Tasks: []*entity.Task{card}},
+		{Status: entity.TaskStatusDONE, Count: 4, Tasks: []*entity.Task{}},
+	}}
+
+	serve := func(taskUsecase usecase.TaskUsecase, query string, ifNoneMatch string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.GET("/projects/:id/board", NewTaskHandler(taskUsecase).GetProjectBoard)
+		req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID.String()+"/board"+query, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns the columns with their counts", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetBoard(mock.Anything, projectID, false, (*uuid.UUID)(nil)).Return(board, nil)
+
+		w := serve(taskUsecase, "", "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.TaskBoardResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 5, response.Total)
+		require.Len(t, response.Columns, 2)
+		assert.Equal(t, "To Do", response.Columns[0].DisplayName)
+		require.Len(t, response.Columns[0].Tasks, 1)
+		assert.Equal(t, card.ID, response.Columns[0].Tasks[0].ID)
+		assert.Equal(t, entity.TaskPriorityHigh, response.Columns[0].Tasks[0].Priority)
+		assert.Equal(t, 4, response.Columns[1].Count)
+		assert.Contains(t, w.Body.String(), `"tasks":[]`)
+	})
+
+	t.Run("includes done cards on request and revalidates", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetBoard(mock.Anything, projectID, true, (*uuid.UUID)(nil)).Return(board, nil).Twice()
+
+		etag := serve(taskUsecase, "?include_done=true", "").Header().Get("ETag")
+		require.NotEmpty(t, etag)
+		assert.Equal(t, http.StatusNotModified, serve(taskUsecase, "?include_done=true", etag).Code)
+	})
+
+	t.Run("filters by epic", func(t *testing.T) {
+		epicID := uuid.New()
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetBoard(mock.Anything, projectID, false, &epicID).Return(board, nil)
+
+		assert.Equal(t, http.StatusOK, serve(taskUsecase, "?epic_id="+epicID.String(), "").Code)
+	})
+
+	t.Run("invalid or unknown epic", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		assert.Equal(t, http.StatusBadRequest, serve(taskUsecase, "?epic_id=nope", "").Code)
+
+		epicID := uuid.New()
+		taskUsecase.EXPECT().GetBoard(mock.Anything, projectID, false, &epicID).Return(nil, usecase.ErrEpicNotFound)
+		w := serve(taskUsecase, "?epic_id="+epicID.String(), "")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), string(dto.ErrorCodeEpicNotFound))
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetBoard(mock.Anything, projectID, false, (*uuid.UUID)(nil)).Return(nil, usecase.ErrProjectNotFound)
+
+		assert.Equal(t, http.StatusNotFound, serve(taskUsecase, "", "").Code)
+	})
+}
+
+func TestTaskHandler_BulkDeleteTasks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	projectID := uuid.New()
+	taskIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	serve := func(taskUsecase usecase.TaskUsecase, body string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.POST("/projects/:id/tasks/bulk-delete", NewTaskHandler(taskUsecase).BulkDeleteTasks)
+		req := httptest.NewRequest(http.MethodPost, "/projects/"+projectID.String()+"/tasks/bulk-delete", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("returns the undo token", func(t *testing.T) {
+		expiresAt := time.Now().Add(5 * time.Minute).UTC()
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().BulkDelete(mock.Anything, projectID, taskIDs).Return(&entity.UndoOperation{
+			Token:     "undo-token",
+			ProjectID: projectID,
+			Operation: entity.UndoOperationBulkDelete,
+			TaskIDs:   taskIDs[:1],
+			ExpiresAt: expiresAt,
+		}, nil)
+
+		body, err := json.Marshal(dto.BulkTaskOperationRequest{TaskIDs: taskIDs})
+		require.NoError(t, err)
+		w := serve(taskUsecase, string(body))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.UndoOperationResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "undo-token", response.UndoToken)
+		assert.Equal(t, 1, response.AffectedCount)
+		assert.Equal(t, taskIDs[:1], response.TaskIDs)
+		assert.True(t, expiresAt.Equal(response.ExpiresAt))
+	})
+
+	t.Run("requires task IDs", func(t *testing.T) {
+		w := serve(usecase.NewTaskUsecaseMock(t), `{"task_ids":[]}`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTaskHandler_UndoOperation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(taskUsecase usecase.TaskUsecase) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.POST("/undo/:token", NewTaskHandler(taskUsecase).UndoOperation)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/undo/undo-token", nil))
+		return w
+	}
+
+	t.Run("restores the tasks", func(t *testing.T) {
+		undoneAt := time.Now()
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().Undo(mock.Anything, "undo-token").Return(&entity.UndoOperation{
+			Token:     "undo-token",
+			Operation: entity.UndoOperationBulkArchive,
+			TaskIDs:   []uuid.UUID{uuid.New()},
+			UndoneAt:  &undoneAt,
+		}, nil)
+
+		w := serve(taskUsecase)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.UndoOperationResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, entity.UndoOperationBulkArchive, response.Operation)
+		assert.NotNil(t, response.UndoneAt)
+	})
+
+	for _, tc := range []struct {
+		err    error
+		status int
+		code   dto.ErrorCode
+	}{
+		{usecase.ErrUndoTokenNotFound, http.StatusNotFound, dto.ErrorCodeUndoTokenNotFound},
+		{usecase.ErrUndoAlreadyUsed, http.StatusConflict, dto.ErrorCodeUndoAlreadyUsed},
+		{usecase.ErrUndoExpired, http.StatusGone, dto.ErrorCodeUndoExpired},
+	} {
+		t.Run(string(tc.code), func(t *testing.T) {
+			taskUsecase := usecase.NewTaskUsecaseMock(t)
+			taskUsecase.EXPECT().Undo(mock.Anything, "undo-token").Return(nil, tc.err)
+
+			w := serve(taskUsecase)
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Contains(t, w.Body.String(), string(tc.code))
+		})
+	}
+}
+
+func TestTaskHandler_StartPlanning_Scheduled(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	taskID := uuid.New()
+	scheduledAt := time.Now().Add(6 * time.Hour).UTC().Truncate(time.Second)
+
+	serve := func(taskUsecase usecase.TaskUsecase, body string) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.POST("/tasks/:id/start-planning", NewTaskHandler(taskUsecase).StartPlanning)
+		req := httptest.NewRequest(http.MethodPost, "/tasks/"+taskID.String()+"/start-planning", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	body, err := json.Marshal(dto.StartPlanningRequest{BranchName: "main", AIType: "claude-code", ScheduledAt: &scheduledAt})
+	require.NoError(t, err)
+
+	t.Run("enqueues the job for later", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, Status: entity.TaskStatusTODO}, nil)
+		taskUsecase.EXPECT().StartPlanning(mock.Anything, taskID, "main", "claude-code", false, false, mock.MatchedBy(func(at *time.Time) bool {
+			return at != nil && at.Equal(scheduledAt)
+		})).Return("job-1", nil)
+
+		w := serve(taskUsecase, string(body))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		var response dto.StartPlanningResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "job-1", response.JobID)
+		require.NotNil(t, response.ScheduledAt)
+		assert.True(t, scheduledAt.Equal(*response.ScheduledAt))
+	})
+
+	t.Run("already scheduled", func(t *testing.T) {
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, Status: entity.TaskStatusTODO}, nil)
+		taskUsecase.EXPECT().StartPlanning(mock.Anything, taskID, "main", "claude-code", false, false, mock.Anything).Return("", usecase.ErrTaskAlreadyScheduled)
+
+		w := serve(taskUsecase, string(body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
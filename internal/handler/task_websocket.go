@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"log"
 	"net/http"
 
@@ -15,13 +16,15 @@ import (
 // TaskHandlerWithWebSocket extends the basic task handler with WebSocket notifications
 type TaskHandlerWithWebSocket struct {
 	*TaskHandler
-	wsService *websocket.Service
+	epicUsecase usecase.EpicUsecase // Rolls up the epics of changed tasks; nil for no epic notifications
+	wsService   *websocket.Service
 }
 
 // NewTaskHandlerWithWebSocket creates a new task handler with WebSocket support
-func NewTaskHandlerWithWebSocket(taskUsecase usecase.TaskUsecase, wsService *websocket.Service) *TaskHandlerWithWebSocket {
+func NewTaskHandlerWithWebSocket(taskUsecase usecase.TaskUsecase, epicUsecase usecase.EpicUsecase, wsService *websocket.Service) *TaskHandlerWithWebSocket {
 	return &TaskHandlerWithWebSocket{
 		TaskHandler: NewTaskHandler(taskUsecase),
+		epicUsecase: epicUsecase,
 		wsService:   wsService,
 	}
 }
@@ -30,7 +33,7 @@ func NewTaskHandlerWithWebSocket(taskUsecase usecase.TaskUsecase, wsService *web
 func (h *TaskHandlerWithWebSocket) CreateTask(c *gin.Context) {
 	var req dto.TaskCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
@@ -39,11 +42,13 @@ func (h *TaskHandlerWithWebSocket) CreateTask(c *gin.Context) {
 		Title:        req.Title,
 		Description:  req.Description,
 		KanbanTaskID: req.KanbanTaskID,
+		EpicID:       req.EpicID,
+		WorkflowType: req.WorkflowType,
 	}
 
 	task, err := h.taskUsecase.Create(c.Request.Context(), usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to create task"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to create task")
 		return
 	}
 
@@ -53,6 +58,7 @@ func (h *TaskHandlerWithWebSocket) CreateTask(c *gin.Context) {
 	if err := h.wsService.NotifyTaskCreated(response, task.ProjectID); err != nil {
 		log.Printf("Failed to send WebSocket notification for task creation: %v", err)
 	}
+	h.notifyEpicProgress(c.Request.Context(), task.ProjectID, task.EpicID)
 
 	c.JSON(http.StatusCreated, response)
 }
@@ -62,20 +68,20 @@ func (h *TaskHandlerWithWebSocket) UpdateTask(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.TaskUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Get the original task to track changes
 	originalTask, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -89,7 +95,7 @@ func (h *TaskHandlerWithWebSocket) UpdateTask(c *gin.Context) {
 			"new": *req.Title,
 		}
 	}
-	if req.Description != nil && *req.Description != originalTask.Description {
+	if req.Description != nil && *req.Description != "" && *req.Description != originalTask.Description {
 		usecaseReq.Description = *req.Description
 		changes["description"] = map[string]interface{}{
 			"old": originalTask.Description,
@@ -117,10 +123,45 @@ func (h *TaskHandlerWithWebSocket) UpdateTask(c *gin.Context) {
 			"new": req.Status,
 		}
 	}
+	if req.AssignedTo != nil && (originalTask.AssignedTo == nil || *req.AssignedTo != *originalTask.AssignedTo) {
+		usecaseReq.AssignedTo = req.AssignedTo
+		changes["assigned_to"] = map[string]interface{}{
+			"old": originalTask.AssignedTo,
+			"new": req.AssignedTo,
+		}
+	}
+	if req.DueDate != nil && (originalTask.DueDate == nil || !req.DueDate.Equal(*originalTask.DueDate)) {
+		usecaseReq.DueDate = req.DueDate
+		changes["due_date"] = map[string]interface{}{
+			"old": originalTask.DueDate,
+			"new": req.DueDate,
+		}
+	}
+	if req.HighRiskReason != nil {
+		usecaseReq.HighRiskReason = req.HighRiskReason
+		changes["high_risk"] = map[string]interface{}{
+			"old": originalTask.HighRisk,
+			"new": true,
+		}
+	}
+	if req.EpicID != nil && (originalTask.EpicID == nil || *req.EpicID != *originalTask.EpicID) {
+		usecaseReq.EpicID = req.EpicID
+		changes["epic_id"] = map[string]interface{}{
+			"old": originalTask.EpicID,
+			"new": req.EpicID,
+		}
+	}
+	usecaseReq.ClearFields = taskUpdateClearFields(req)
+	for _, field := range usecaseReq.ClearFields {
+		changes[field] = map[string]interface{}{
+			"old": clearedTaskFieldValue(originalTask, field),
+			"new": nil,
+		}
+	}
 
 	task, err := h.taskUsecase.Update(c.Request.Context(), id, usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task")
 		return
 	}
 
@@ -131,36 +172,55 @@ func (h *TaskHandlerWithWebSocket) UpdateTask(c *gin.Context) {
 		if err := h.wsService.NotifyTaskUpdated(task.ID, task.ProjectID, changes, response); err != nil {
 			log.Printf("Failed to send WebSocket notification for task update: %v", err)
 		}
+		// A task moved between epics changes the progress of both
+		h.notifyEpicProgress(c.Request.Context(), task.ProjectID, originalTask.EpicID, task.EpicID)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// PatchTask godoc
+// @Summary Partially update a task
+// @Description Update only the provided fields of a task. Optional fields (description, assigned_to, due_date, estimated_hours, actual_hours, tags, epic_id) are cleared by listing them in clear_fields or, for description, by sending an empty string.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param task body dto.TaskUpdateRequest true "Fields to update or clear"
+// @Success 200 {object} dto.TaskResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id} [patch]
+func (h *TaskHandlerWithWebSocket) PatchTask(c *gin.Context) {
+	h.UpdateTask(c)
+}
+
 // UpdateTaskStatus updates a task status and sends WebSocket notification
 func (h *TaskHandlerWithWebSocket) UpdateTaskStatus(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.TaskStatusUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Get the original task to track status change
 	originalTask, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
 	task, err := h.taskUsecase.UpdateStatus(c.Request.Context(), id, req.Status)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task status"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task status")
 		return
 	}
 
@@ -184,6 +244,7 @@ func (h *TaskHandlerWithWebSocket) UpdateTaskStatus(c *gin.Context) {
 		if err := h.wsService.NotifyStatusChanged(task.ID, task.ProjectID, "task", string(originalTask.Status), string(task.Status)); err != nil {
 			log.Printf("Failed to send WebSocket notification for status change: %v", err)
 		}
+		h.notifyEpicProgress(c.Request.Context(), task.ProjectID, task.EpicID)
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -194,14 +255,14 @@ func (h *TaskHandlerWithWebSocket) DeleteTask(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	// Get the task before deleting to get the project ID
 	task, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -215,29 +276,54 @@ func (h *TaskHandlerWithWebSocket) DeleteTask(c *gin.Context) {
 	if err := h.wsService.NotifyTaskDeleted(task.ID, task.ProjectID); err != nil {
 		log.Printf("Failed to send WebSocket notification for task deletion: %v", err)
 	}
+	h.notifyEpicProgress(c.Request.Context(), task.ProjectID, task.EpicID)
 
 	c.Status(http.StatusNoContent)
 }
 
+// notifyEpicProgress sends the progress of each epic given, skipping tasks
+// without one
+func (h *TaskHandlerWithWebSocket) notifyEpicProgress(ctx context.Context, projectID uuid.UUID, epicIDs ...*uuid.UUID) {
+	if h.epicUsecase == nil {
+		return
+	}
+	notified := make(map[uuid.UUID]bool)
+	for _, epicID := range epicIDs {
+		if epicID == nil || notified[*epicID] {
+			continue
+		}
+		notified[*epicID] = true
+
+		progress, err := h.epicUsecase.GetProgress(ctx, *epicID)
+		if err != nil {
+			log.Printf("Failed to get epic progress for WebSocket notification: %v", err)
+			continue
+		}
+		if err := h.wsService.NotifyEpicProgressUpdated(*epicID, projectID, progress); err != nil {
+			log.Printf("Failed to send WebSocket notification for epic progress: %v", err)
+		}
+	}
+}
+
 // StartPlanning starts planning for a task with immediate status update and WebSocket notification
 func (h *TaskHandlerWithWebSocket) StartPlanning(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.StartPlanningRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Get the original task to track changes
 	originalTask, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -245,11 +331,21 @@ func (h *TaskHandlerWithWebSocket) StartPlanning(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(nil, http.StatusBadRequest, "Task must be in TODO status to start planning"))
 		return
 	}
+	// A scheduled start leaves the task in TODO, there is nothing to notify
+	if req.ScheduledAt != nil {
+		h.schedulePlanning(c, id, req)
+		return
+	}
+
+	// Refused before the status changes, so the board does not flicker
+	if err := h.taskUsecase.CheckPlanningCapacity(c.Request.Context()); respondPlanningOverloaded(c, err) {
+		return
+	}
 
 	// Immediately update task status to PLANNING to provide instant UI feedback
 	updatedTask, err := h.taskUsecase.UpdateStatus(c.Request.Context(), id, entity.TaskStatusPLANNING)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task status"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task status")
 		return
 	}
 
@@ -274,13 +370,16 @@ func (h *TaskHandlerWithWebSocket) StartPlanning(c *gin.Context) {
 	}
 
 	// Start the background planning job using the usecase
-	jobID, err := h.TaskHandler.taskUsecase.StartPlanning(c.Request.Context(), id, req.BranchName, req.AIType, req.AutoImplement, req.UseRemoteBranch)
+	jobID, err := h.TaskHandler.taskUsecase.StartPlanning(c.Request.Context(), id, req.BranchName, req.AIType, req.AutoImplement, req.UseRemoteBranch, nil)
 	if err != nil {
 		// Revert status if job enqueueing fails
 		_, revertErr := h.taskUsecase.UpdateStatus(c.Request.Context(), id, entity.TaskStatusTODO)
 		if revertErr != nil {
 			log.Printf("Failed to revert task status after job enqueueing failed: %v", revertErr)
 		}
+		if respondPlanningOverloaded(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to start planning"))
 		return
 	}
@@ -293,23 +392,35 @@ func (h *TaskHandlerWithWebSocket) StartPlanning(c *gin.Context) {
 }
 
 // StartImplementingDirect skips planning and starts implementation directly with WebSocket notification
+// @Summary Start implementation without planning
+// @Description Move a TODO task straight to IMPLEMENTING and enqueue the implementation job
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.StartImplementingDirectRequest true "Implementation options"
+// @Success 200 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/start-implementing-direct [post]
 func (h *TaskHandlerWithWebSocket) StartImplementingDirect(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.StartImplementingDirectRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	originalTask, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -321,7 +432,7 @@ func (h *TaskHandlerWithWebSocket) StartImplementingDirect(c *gin.Context) {
 	// Immediately update task status to IMPLEMENTING for instant UI feedback
 	updatedTask, err := h.taskUsecase.UpdateStatus(c.Request.Context(), id, entity.TaskStatusIMPLEMENTING)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task status"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task status")
 		return
 	}
 
@@ -381,20 +492,20 @@ func (h *TaskHandlerWithWebSocket) ApprovePlan(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid task ID"))
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	var req dto.ApprovePlanRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Invalid request data"))
+		respondBindingError(c, err, "Invalid request data")
 		return
 	}
 
 	// Get the original task to track changes
 	originalTask, err := h.taskUsecase.GetByID(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.NewErrorResponse(err, http.StatusNotFound, "Task not found"))
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeTaskNotFound, "Task not found"))
 		return
 	}
 
@@ -402,11 +513,18 @@ func (h *TaskHandlerWithWebSocket) ApprovePlan(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(nil, http.StatusBadRequest, "Task must be in PLAN_REVIEWING status to approve plan"))
 		return
 	}
+	if !h.stepUpPlanApproval(c, id, req) {
+		return
+	}
+	if req.ScheduledAt != nil {
+		h.scheduleImplementation(c, id, req)
+		return
+	}
 
 	// Immediately update task status to IMPLEMENTING to provide instant UI feedback
-	updatedTask, err := h.taskUsecase.UpdateStatus(c.Request.Context(), id, entity.TaskStatusIMPLEMENTING)
+	updatedTask, err := h.taskUsecase.UpdateStatusBy(c.Request.Context(), id, entity.TaskStatusIMPLEMENTING, optionalString(req.Reviewer), nil)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update task status"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to update task status")
 		return
 	}
 
@@ -431,14 +549,14 @@ func (h *TaskHandlerWithWebSocket) ApprovePlan(c *gin.Context) {
 	}
 
 	// Start the background implementation job using the usecase
-	jobID, err := h.TaskHandler.taskUsecase.ApprovePlan(c.Request.Context(), id, req.AIType)
+	jobID, err := h.TaskHandler.taskUsecase.ApprovePlan(c.Request.Context(), id, req.AIType, nil)
 	if err != nil {
 		// Revert status if job enqueueing fails
 		_, revertErr := h.taskUsecase.UpdateStatus(c.Request.Context(), id, entity.TaskStatusPLANREVIEWING)
 		if revertErr != nil {
 			log.Printf("Failed to revert task status after job enqueueing failed: %v", revertErr)
 		}
-		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to approve plan and start implementation"))
+		respondError(c, err, http.StatusInternalServerError, "Failed to approve plan and start implementation")
 		return
 	}
 
@@ -448,3 +566,287 @@ func (h *TaskHandlerWithWebSocket) ApprovePlan(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, planningResponse)
 }
+
+// RejectPlan rejects a plan and sends the task back to TODO with WebSocket notification
+// @Summary Reject a plan
+// @Description Send a task in PLAN_REVIEWING back to TODO, recording the reviewer and reason in its status history
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.RejectPlanRequest true "Reviewer and reason, both optional"
+// @Success 200 {object} dto.TaskResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/reject-plan [post]
+func (h *TaskHandlerWithWebSocket) RejectPlan(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.RejectPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	task, err := h.taskUsecase.RejectPlan(c.Request.Context(), id, optionalString(req.Reviewer), optionalString(req.Reason))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to reject plan")
+		return
+	}
+
+	response := dto.TaskResponseFromEntity(task)
+	changes := map[string]interface{}{
+		"status": map[string]interface{}{
+			"old": entity.TaskStatusPLANREVIEWING,
+			"new": task.Status,
+		},
+	}
+	if err := h.wsService.NotifyTaskUpdated(task.ID, task.ProjectID, changes, response); err != nil {
+		log.Printf("Failed to send WebSocket notification for task update: %v", err)
+	}
+	if err := h.wsService.NotifyStatusChanged(task.ID, task.ProjectID, "task", string(entity.TaskStatusPLANREVIEWING), string(task.Status)); err != nil {
+		log.Printf("Failed to send WebSocket notification for status change: %v", err)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RejectPlanWithFeedback rejects a plan and plans the task again with the
+// reviewer's feedback, with WebSocket notification
+// @Summary Reject a plan with feedback
+// @Description Reject the plan of a task in PLAN_REVIEWING, keeping the reviewer's feedback on it, and send the task back to PLANNING. The executor writes a new plan given the rejected plan and the feedback; each planning run counts as one more of the task's planning iterations, numbered in its status history.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.RejectPlanWithFeedbackRequest true "Feedback and executor"
+// @Success 200 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/plan/reject [post]
+func (h *TaskHandlerWithWebSocket) RejectPlanWithFeedback(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.RejectPlanWithFeedbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	task, jobID, err := h.taskUsecase.RejectPlanWithFeedback(c.Request.Context(), id, usecase.RejectPlanWithFeedbackRequest{
+		Reviewer: optionalString(req.Reviewer),
+		Feedback: req.Feedback,
+		AIType:   req.AIType,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to reject plan")
+		return
+	}
+
+	response := dto.TaskResponseFromEntity(task)
+	changes := map[string]interface{}{
+		"status": map[string]interface{}{
+			"old": entity.TaskStatusPLANREVIEWING,
+			"new": task.Status,
+		},
+	}
+	if err := h.wsService.NotifyTaskUpdated(task.ID, task.ProjectID, changes, response); err != nil {
+		log.Printf("Failed to send WebSocket notification for task update: %v", err)
+	}
+	if err := h.wsService.NotifyStatusChanged(task.ID, task.ProjectID, "task", string(entity.TaskStatusPLANREVIEWING), string(task.Status)); err != nil {
+		log.Printf("Failed to send WebSocket notification for status change: %v", err)
+	}
+
+	c.JSON(http.StatusOK, dto.StartPlanningResponse{
+		Message: "Plan rejected, planning started again",
+		JobID:   jobID,
+	})
+}
+
+// RequestChanges sends a task in code review back to implementation with the
+// reviewer's feedback, with WebSocket notification
+// @Summary Request changes
+// @Description Send a task in CODE_REVIEWING back to IMPLEMENTING with the reviewer's feedback, also saved as a comment. The executor makes the changes in the task's worktree, given the feedback and the changes made so far, and pushes them to the pull request in a commit of their own.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID"
+// @Param request body dto.RequestChangesRequest true "Feedback and executor"
+// @Success 200 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/tasks/{id}/request-changes [post]
+func (h *TaskHandlerWithWebSocket) RequestChanges(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
+		return
+	}
+
+	var req dto.RequestChangesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	task, jobID, err := h.taskUsecase.RequestChanges(c.Request.Context(), id, usecase.RequestChangesRequest{
+		Reviewer: optionalString(req.Reviewer),
+		Feedback: req.Feedback,
+		AIType:   req.AIType,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to request changes")
+		return
+	}
+
+	response := dto.TaskResponseFromEntity(task)
+	changes := map[string]interface{}{
+		"status": map[string]interface{}{
+			"old": entity.TaskStatusCODEREVIEWING,
+			"new": task.Status,
+		},
+	}
+	if err := h.wsService.NotifyTaskUpdated(task.ID, task.ProjectID, changes, response); err != nil {
+		log.Printf("Failed to send WebSocket notification for task update: %v", err)
+	}
+	if err := h.wsService.NotifyStatusChanged(task.ID, task.ProjectID, "task", string(entity.TaskStatusCODEREVIEWING), string(task.Status)); err != nil {
+		log.Printf("Failed to send WebSocket notification for status change: %v", err)
+	}
+
+	c.JSON(http.StatusOK, dto.StartPlanningResponse{
+		Message: "Changes requested, implementation started",
+		JobID:   jobID,
+	})
+}
+
+// AnswerExecutionQuestion answers the question an execution waits on and
+// resumes it
+// @Summary Answer an execution's question
+// @Description Answer the question the AI asked during an implementation whose execution is WAITING_INPUT. The question and answer are saved as a task comment, and the execution resumes in the same AI session with the answer.
+// @Tags executions
+// @Accept json
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Param request body dto.AnswerQuestionRequest true "Answer"
+// @Success 200 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/answer [post]
+func (h *TaskHandlerWithWebSocket) AnswerExecutionQuestion(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	var req dto.AnswerQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request data")
+		return
+	}
+
+	_, jobID, err := h.taskUsecase.AnswerQuestion(c.Request.Context(), id, usecase.AnswerQuestionRequest{
+		Answerer: optionalString(req.Answerer),
+		Answer:   req.Answer,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to answer question")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.StartPlanningResponse{
+		Message: "Question answered, execution resumed",
+		JobID:   jobID,
+	})
+}
+
+// CancelExecution cancels an unfinished execution
+// @Summary Cancel an execution
+// @Description Cancel a planning or implementation execution that has not finished. A job on the worker running it stops the AI CLI process, marks the execution CANCELLED and moves the task back to the status it had before the execution, with the usual WebSocket notifications. Comparison and dry-run executions cannot be cancelled.
+// @Tags executions
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 202 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/cancel [post]
+func (h *TaskHandlerWithWebSocket) CancelExecution(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	_, jobID, err := h.taskUsecase.CancelExecution(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to cancel execution")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+		Message: "Execution cancellation requested",
+		JobID:   jobID,
+	})
+}
+
+// RetryExecution runs a failed execution again
+// @Summary Retry an execution
+// @Description Run a failed planning or implementation execution again. The retry is a new execution numbered as the next attempt and linked to the failed one, and starts the task's planning or implementation again, with the usual WebSocket notifications. The task must still be where the failure left it: TODO, or PLAN_REVIEWING for an implementation. Workers also retry failed executions on their own, with exponential backoff, up to EXECUTION_MAX_ATTEMPTS.
+// @Tags executions
+// @Produce json
+// @Param id path string true "Execution ID"
+// @Success 202 {object} dto.StartPlanningResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/executions/{id}/retry [post]
+func (h *TaskHandlerWithWebSocket) RetryExecution(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid execution ID"))
+		return
+	}
+
+	_, jobID, err := h.taskUsecase.RetryExecution(c.Request.Context(), id, 0)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to retry execution")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.StartPlanningResponse{
+		Message: "Execution retry started",
+		JobID:   jobID,
+	})
+}
+
+// optionalString returns nil for an empty string
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
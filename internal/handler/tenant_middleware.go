@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// OrganizationHeader selects the organization (tenant) a request with one
+// of the static API keys is scoped to
+const OrganizationHeader = "X-Organization-ID"
+
+// tenantExemptRoutes are the route prefixes served unscoped once
+// organizations exist: the user's account, and the organizations to create
+// or join
+var tenantExemptRoutes = []string{"/auth/", "/organizations"}
+
+// TenantMiddleware scopes the request to the organization of the principal
+// AuthMiddleware authenticated, and must run after it. A user's requests
+// are scoped to the user's organization; the static API keys are the
+// operator's, who picks one with the X-Organization-ID header. Tenant-aware
+// repositories read the organization from the request context. Unscoped
+// requests are served until the first organization is created, so
+// single-team deployments keep working unchanged, and rejected from then
+// on outside publicRoutes and tenantExemptRoutes.
+func TenantMiddleware(organizationUsecase usecase.OrganizationUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var requested uuid.UUID
+		if header := c.GetHeader(OrganizationHeader); header != "" {
+			orgID, err := uuid.Parse(header)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid organization ID"))
+				c.Abort()
+				return
+			}
+			requested = orgID
+		}
+
+		var orgID uuid.UUID
+		user := currentUser(c)
+		switch {
+		case user != nil && user.OrganizationID != nil:
+			orgID = *user.OrganizationID
+		case user == nil && apiKeyOwner(c) != "" && requested != uuid.Nil:
+			if _, err := organizationUsecase.GetByID(c.Request.Context(), requested); err != nil {
+				c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeOrganizationNotFound, "Organization not found"))
+				c.Abort()
+				return
+			}
+			orgID = requested
+		}
+		if requested != uuid.Nil && requested != orgID {
+			c.JSON(http.StatusForbidden, dto.NewErrorResponseWithCode(errors.New("not a member of the organization"), http.StatusForbidden, dto.ErrorCodeForbidden, "Organization not allowed"))
+			c.Abort()
+			return
+		}
+
+		if orgID != uuid.Nil {
+			c.Set("organization_id", orgID)
+			c.Request = c.Request.WithContext(repository.WithOrganizationID(c.Request.Context(), orgID))
+			c.Next()
+			return
+		}
+
+		if isPublicRoute(c) || isTenantExemptRoute(c) {
+			c.Next()
+			return
+		}
+		scoped, err := organizationUsecase.HasOrganizations(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to check organizations"))
+			c.Abort()
+			return
+		}
+		if scoped {
+			respondError(c, usecase.ErrOrganizationRequired, http.StatusForbidden, "Organization required")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// isTenantExemptRoute reports whether the request is to one of
+// tenantExemptRoutes, in any API version
+func isTenantExemptRoute(c *gin.Context) bool {
+	path := c.FullPath()
+	for _, prefix := range []string{apiV1Prefix, apiV2Prefix} {
+		if route, ok := strings.CutPrefix(path, prefix); ok {
+			for _, exempt := range tenantExemptRoutes {
+				if strings.HasPrefix(route, exempt) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
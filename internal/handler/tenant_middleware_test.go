@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupTenantRouter serves /api/v1/ping and /api/v1/organizations behind
+// TenantMiddleware, for requests authenticated by authenticate
+func setupTenantRouter(orgUsecase usecase.OrganizationUsecase, authenticate gin.HandlerFunc, seen *uuid.UUID) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	v1 := router.Group(apiV1Prefix)
+	v1.Use(authenticate, TenantMiddleware(orgUsecase))
+	handler := func(c *gin.Context) {
+		if orgID, ok := repository.OrganizationIDFromContext(c.Request.Context()); ok {
+			*seen = orgID
+		}
+		c.Status(http.StatusOK)
+	}
+	v1.GET("/ping", handler)
+	v1.GET("/organizations", handler)
+	return router
+}
+
+func asUser(user *entity.User) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(currentUserKey, user)
+		authenticateAs(c, user.Username, "user:"+user.Username)
+	}
+}
+
+func asStaticKey(c *gin.Context) {
+	authenticateAs(c, "ci", "api:ci")
+}
+
+func anonymous(c *gin.Context) {}
+
+func TestTenantMiddleware(t *testing.T) {
+	serve := func(router *gin.Engine, path, orgHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if orgHeader != "" {
+			req.Header.Set(OrganizationHeader, orgHeader)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("user requests are scoped to the user's organization", func(t *testing.T) {
+		orgID := uuid.New()
+		var seen uuid.UUID
+		router := setupTenantRouter(usecase.NewOrganizationUsecaseMock(t), asUser(&entity.User{Username: "alice", OrganizationID: &orgID}), &seen)
+
+		w := serve(router, "/api/v1/ping", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, orgID, seen)
+	})
+
+	t.Run("users cannot pick another organization", func(t *testing.T) {
+		orgID := uuid.New()
+		var seen uuid.UUID
+		router := setupTenantRouter(usecase.NewOrganizationUsecaseMock(t), asUser(&entity.User{Username: "alice", OrganizationID: &orgID}), &seen)
+
+		w := serve(router, "/api/v1/ping", uuid.New().String())
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, uuid.Nil, seen)
+	})
+
+	t.Run("static API keys pick the organization", func(t *testing.T) {
+		orgID := uuid.New()
+		orgUsecase := usecase.NewOrganizationUsecaseMock(t)
+		orgUsecase.EXPECT().GetByID(mock.Anything, orgID).Return(&entity.Organization{ID: orgID}, nil)
+		var seen uuid.UUID
+		router := setupTenantRouter(orgUsecase, asStaticKey, &seen)
+
+		w := serve(router, "/api/v1/ping", orgID.String())
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, orgID, seen)
+	})
+
+	t.Run("anonymous requests cannot pick an organization", func(t *testing.T) {
+		var seen uuid.UUID
+		router := setupTenantRouter(usecase.NewOrganizationUsecaseMock(t), anonymous, &seen)
+
+		w := serve(router, "/api/v1/ping", uuid.New().String())
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, uuid.Nil, seen)
+	})
+
+	t.Run("unscoped requests are served until organizations exist", func(t *testing.T) {
+		orgUsecase := usecase.NewOrganizationUsecaseMock(t)
+		orgUsecase.EXPECT().HasOrganizations(mock.Anything).Return(false, nil)
+		var seen uuid.UUID
+		router := setupTenantRouter(orgUsecase, asUser(&entity.User{Username: "alice"}), &seen)
+
+		w := serve(router, "/api/v1/ping", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, uuid.Nil, seen)
+	})
+
+	t.Run("unscoped requests are rejected once organizations exist", func(t *testing.T) {
+		for name, authenticate := range map[string]gin.HandlerFunc{
+			"user without organization": asUser(&entity.User{Username: "alice"}),
+			"static API key":            asStaticKey,
+			"anonymous":                 anonymous,
+		} {
+			t.Run(name, func(t *testing.T) {
+				orgUsecase := usecase.NewOrganizationUsecaseMock(t)
+				orgUsecase.EXPECT().HasOrganizations(mock.Anything).Return(true, nil)
+				var seen uuid.UUID
+				router := setupTenantRouter(orgUsecase, authenticate, &seen)
+
+				w := serve(router, "/api/v1/ping", "")
+
+				assert.Equal(t, http.StatusForbidden, w.Code)
+				assert.Contains(t, w.Body.String(), "ORGANIZATION_REQUIRED")
+			})
+		}
+	})
+
+	t.Run("organization routes are served unscoped", func(t *testing.T) {
+		var seen uuid.UUID
+		router := setupTenantRouter(usecase.NewOrganizationUsecaseMock(t), asUser(&entity.User{Username: "alice"}), &seen)
+
+		w := serve(router, "/api/v1/organizations", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("malformed organization ID is rejected", func(t *testing.T) {
+		var seen uuid.UUID
+		router := setupTenantRouter(usecase.NewOrganizationUsecaseMock(t), asStaticKey, &seen)
+
+		w := serve(router, "/api/v1/ping", "not-a-uuid")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("unknown organization is rejected", func(t *testing.T) {
+		orgID := uuid.New()
+		orgUsecase := usecase.NewOrganizationUsecaseMock(t)
+		orgUsecase.EXPECT().GetByID(mock.Anything, orgID).Return(nil, errors.New("organization not found"))
+		var seen uuid.UUID
+		router := setupTenantRouter(orgUsecase, asStaticKey, &seen)
+
+		w := serve(router, "/api/v1/ping", orgID.String())
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, uuid.Nil, seen)
+	})
+}
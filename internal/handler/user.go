@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// errProfileNotOwned is returned when a profile is changed with the API key
+// of another user
+var errProfileNotOwned = errors.New("profiles can only be changed with their user's own API key")
+
+// UserHandler serves the profiles and avatars of the users recorded by name
+// on tasks, plans and comments
+type UserHandler struct {
+	userProfileUsecase usecase.UserProfileUsecase
+}
+
+func NewUserHandler(userProfileUsecase usecase.UserProfileUsecase) *UserHandler {
+	return &UserHandler{userProfileUsecase: userProfileUsecase}
+}
+
+// ListUserProfiles godoc
+// @Summary Get the profiles of users
+// @Description Get the profiles of the users named, in the order asked for and once each, for rendering assignees, creators, approvers and comment authors. Users who have not saved a profile get a default one showing their username.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param username query []string true "Usernames, repeated for each user" collectionFormat(multi)
+// @Success 200 {object} dto.UserProfileListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/users [get]
+func (h *UserHandler) ListUserProfiles(c *gin.Context) {
+	var query dto.UserProfilesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		respondBindingError(c, err, "Invalid query parameters")
+		return
+	}
+
+	profiles, err := h.userProfileUsecase.List(c.Request.Context(), query.Usernames)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get user profiles")
+		return
+	}
+
+	items := make([]dto.UserProfileResponse, len(profiles))
+	for i, profile := range profiles {
+		items[i] = dto.UserProfileResponseFromEntity(profile, h.userProfileUsecase.AvatarURL(profile))
+	}
+	c.JSON(http.StatusOK, dto.UserProfileListResponse{Items: items, ListMeta: dto.NewListMeta(len(items), 1, 0)})
+}
+
+// GetUserProfile godoc
+// @Summary Get a user's profile
+// @Description Get the profile of a user, or the default one when they have not saved one
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} dto.UserProfileResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/users/{username} [get]
+func (h *UserHandler) GetUserProfile(c *gin.Context) {
+	profile, err := h.userProfileUsecase.Get(c.Request.Context(), c.Param("username"))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get user profile")
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserProfileResponseFromEntity(profile, h.userProfileUsecase.AvatarURL(profile)))
+}
+
+// UpdateUserProfile godoc
+// @Summary Update a user's profile
+// @Description Change the display name or timezone of a user. Users change their own profile, with their API key.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param profile body dto.UpdateUserProfileRequest true "Fields to change"
+// @Success 200 {object} dto.UserProfileResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
+// @Router /api/v1/users/{username} [put]
+func (h *UserHandler) UpdateUserProfile(c *gin.Context) {
+	username, ok := h.ownUsername(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateUserProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request body")
+		return
+	}
+
+	profile, err := h.userProfileUsecase.Update(c.Request.Context(), username, usecase.UpdateUserProfileRequest{
+		DisplayName: req.DisplayName,
+		Timezone:    req.Timezone,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to update user profile")
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserProfileResponseFromEntity(profile, h.userProfileUsecase.AvatarURL(profile)))
+}
+
+// UploadUserAvatar godoc
+// @Summary Upload a user's avatar
+// @Description Upload a PNG, JPEG, GIF or WebP image of at most 2 MiB as multipart form data, replacing the user's avatar. Users change their own avatar, with their API key.
+// @Tags users
+// @Accept multipart/form-data
+// @Produce json
+// @Param username path string true "Username"
+// @Param file formData file true "Avatar image"
+// @Success 200 {object} dto.UserProfileResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 413 {object} dto.ErrorResponse
+// @Failure 415 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
+// @Router /api/v1/users/{username}/avatar [put]
+func (h *UserHandler) UploadUserAvatar(c *gin.Context) {
+	username, ok := h.ownUsername(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, usecase.MaxAvatarSize+multipartOverhead)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, usecase.ErrAvatarTooLarge, http.StatusRequestEntityTooLarge, "Avatar is too large")
+			return
+		}
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "A file is required in the file field"))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponse(err, http.StatusBadRequest, "Failed to read the uploaded file"))
+		return
+	}
+	defer file.Close()
+
+	profile, err := h.userProfileUsecase.UploadAvatar(c.Request.Context(), usecase.UploadAvatarRequest{
+		Username: username,
+		Size:     header.Size,
+		Content:  file,
+	})
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to upload avatar")
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserProfileResponseFromEntity(profile, h.userProfileUsecase.AvatarURL(profile)))
+}
+
+// DeleteUserAvatar godoc
+// @Summary Remove a user's avatar
+// @Description Remove the user's avatar. Users change their own avatar, with their API key.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} dto.UserProfileResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Security APIKeyAuth
+// @Router /api/v1/users/{username}/avatar [delete]
+func (h *UserHandler) DeleteUserAvatar(c *gin.Context) {
+	username, ok := h.ownUsername(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.userProfileUsecase.DeleteAvatar(c.Request.Context(), username)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to remove avatar")
+		return
+	}
+	c.JSON(http.StatusOK, dto.UserProfileResponseFromEntity(profile, h.userProfileUsecase.AvatarURL(profile)))
+}
+
+// GetUserAvatar godoc
+// @Summary Get a user's avatar
+// @Description Serve the user's avatar image. Its URL, as returned in the profile, needs no API key and changes with the avatar.
+// @Tags users
+// @Produce image/png
+// @Produce image/jpeg
+// @Produce image/gif
+// @Produce image/webp
+// @Param username path string true "Username"
+// @Success 200 {file} file
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/users/{username}/avatar [get]
+func (h *UserHandler) GetUserAvatar(c *gin.Context) {
+	profile, content, err := h.userProfileUsecase.OpenAvatar(c.Request.Context(), c.Param("username"))
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to get avatar")
+		return
+	}
+	defer content.Close()
+
+	// The URL carries the version of the avatar, so the current one may be
+	// cached for good; other requests revalidate soon
+	cacheControl := "private, max-age=300"
+	if profile.AvatarUpdatedAt != nil && c.Query("v") == strconv.FormatInt(profile.AvatarUpdatedAt.Unix(), 10) {
+		cacheControl = "private, max-age=31536000, immutable"
+	}
+	c.DataFromReader(http.StatusOK, profile.AvatarSize, profile.AvatarMimeType, content, map[string]string{
+		"Cache-Control":          cacheControl,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// ownUsername returns the username of the profile the request changes,
+// responding to the request itself when its API key belongs to someone else
+func (h *UserHandler) ownUsername(c *gin.Context) (string, bool) {
+	username := c.Param("username")
+	if apiKeyOwner(c) != username {
+		c.JSON(http.StatusForbidden, dto.NewErrorResponseWithCode(errProfileNotOwned, http.StatusForbidden, dto.ErrorCodeForbidden, "Cannot change another user's profile"))
+		return "", false
+	}
+	return username, true
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func serveUsers(userProfileUsecase usecase.UserProfileUsecase, req *http.Request) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := NewUserHandler(userProfileUsecase)
+	apiKeyAuth := APIKeyMiddleware(map[string]string{"alice-key": "alice"})
+	router.GET("/users", handler.ListUserProfiles)
+	router.PUT("/users/:username", apiKeyAuth, handler.UpdateUserProfile)
+	router.GET("/users/:username/avatar", handler.GetUserAvatar)
+	router.PUT("/users/:username/avatar", apiKeyAuth, handler.UploadUserAvatar)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestUserHandler_ListUserProfiles(t *testing.T) {
+	userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+	alice := &entity.UserProfile{Username: "alice", DisplayName: "Alice Nguyen", Timezone: "Asia/Ho_Chi_Minh", UpdatedAt: time.Now()}
+	bob := entity.NewUserProfile("bob")
+	userProfileUsecase.EXPECT().List(mock.Anything, []string{"alice", "bob"}).Return([]*entity.UserProfile{alice, bob}, nil)
+	userProfileUsecase.EXPECT().AvatarURL(alice).Return("http://localhost:8098/api/v1/users/alice/avatar?v=1")
+	userProfileUsecase.EXPECT().AvatarURL(bob).Return("")
+
+	w := serveUsers(userProfileUsecase, httptest.NewRequest(http.MethodGet, "/users?username=alice&username=bob", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var response dto.UserProfileListResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response.Items, 2)
+	assert.Equal(t, "Alice Nguyen", response.Items[0].Name)
+	assert.NotEmpty(t, response.Items[0].AvatarURL)
+	assert.Equal(t, "bob", response.Items[1].Name)
+	assert.Nil(t, response.Items[1].UpdatedAt, "a default profile was never saved")
+
+	w = serveUsers(userProfileUsecase, httptest.NewRequest(http.MethodGet, "/users", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestUserHandler_UpdateUserProfile(t *testing.T) {
+	update := func(userProfileUsecase usecase.UserProfileUsecase, username, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/users/"+username, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+		return serveUsers(userProfileUsecase, req)
+	}
+
+	t.Run("users change their own profile", func(t *testing.T) {
+		userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+		profile := &entity.UserProfile{Username: "alice", DisplayName: "Alice", Timezone: "Europe/Paris"}
+		userProfileUsecase.EXPECT().Update(mock.Anything, "alice", mock.MatchedBy(func(req usecase.UpdateUserProfileRequest) bool {
+			return req.DisplayName == nil && *req.Timezone == "Europe/Paris"
+		})).Return(profile, nil)
+		userProfileUsecase.EXPECT().AvatarURL(profile).Return("")
+
+		w := update(userProfileUsecase, "alice", "alice-key", `{"timezone": "Europe/Paris"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"timezone":"Europe/Paris"`)
+	})
+
+	t.Run("not another user's", func(t *testing.T) {
+		w := update(usecase.NewUserProfileUsecaseMock(t), "bob", "alice-key", `{"display_name": "Bob"}`)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, http.StatusUnauthorized, update(usecase.NewUserProfileUsecaseMock(t), "alice", "", `{}`).Code)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+		userProfileUsecase.EXPECT().Update(mock.Anything, "alice", mock.Anything).Return(nil, usecase.ErrTimezoneInvalid)
+
+		w := update(userProfileUsecase, "alice", "alice-key", `{"timezone": "Mars/Olympus"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUserHandler_Avatar(t *testing.T) {
+	avatarPath := "avatars/1"
+	updatedAt := time.Unix(1700000000, 0)
+	profile := &entity.UserProfile{Username: "alice", AvatarPath: &avatarPath, AvatarMimeType: "image/png", AvatarSize: 5, AvatarUpdatedAt: &updatedAt}
+
+	t.Run("upload", func(t *testing.T) {
+		userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+		userProfileUsecase.EXPECT().UploadAvatar(mock.Anything, mock.MatchedBy(func(req usecase.UploadAvatarRequest) bool {
+			content, _ := io.ReadAll(req.Content)
+			return req.Username == "alice" && req.Size == 5 && string(content) == "image"
+		})).Return(profile, nil)
+		userProfileUsecase.EXPECT().AvatarURL(profile).Return("http://localhost:8098/api/v1/users/alice/avatar?v=1700000000")
+
+		req := multipartUpload(t, "/users/alice/avatar", "me.png", "image")
+		req.Method = http.MethodPut
+		req.Header.Set("X-API-Key", "alice-key")
+		w := serveUsers(userProfileUsecase, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "avatar?v=1700000000")
+	})
+
+	t.Run("serve", func(t *testing.T) {
+		userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+		userProfileUsecase.EXPECT().OpenAvatar(mock.Anything, "alice").Return(profile, io.NopCloser(strings.NewReader("image")), nil).Twice()
+
+		w := serveUsers(userProfileUsecase, httptest.NewRequest(http.MethodGet, "/users/alice/avatar?v=1700000000", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, "image", w.Body.String())
+		assert.Contains(t, w.Header().Get("Cache-Control"), "immutable")
+
+		w = serveUsers(userProfileUsecase, httptest.NewRequest(http.MethodGet, "/users/alice/avatar?v=1", nil))
+		assert.Equal(t, "private, max-age=300", w.Header().Get("Cache-Control"), "an outdated version is not cached for good")
+	})
+
+	t.Run("none", func(t *testing.T) {
+		userProfileUsecase := usecase.NewUserProfileUsecaseMock(t)
+		userProfileUsecase.EXPECT().OpenAvatar(mock.Anything, "bob").Return(nil, nil, usecase.ErrAvatarNotFound)
+
+		w := serveUsers(userProfileUsecase, httptest.NewRequest(http.MethodGet, "/users/bob/avatar", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "AVATAR_NOT_FOUND")
+	})
+}
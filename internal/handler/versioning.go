@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	apiV1Prefix = "/api/v1"
+	apiV2Prefix = "/api/v2"
+
+	v2DefaultPageSize = 20
+)
+
+// API v1 is deprecated in favour of v2 and is removed after the sunset date
+var (
+	apiV1DeprecatedAt = time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	apiV1SunsetAt     = time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// DeprecationMiddleware marks every response of a deprecated API version with
+// the Deprecation (RFC 9745) and Sunset (RFC 8594) headers, plus a Link to the
+// same resource on the successor version.
+func DeprecationMiddleware(deprecatedAt, sunsetAt time.Time, oldPrefix, newPrefix string) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(deprecatedAt.Unix(), 10)
+	sunset := sunsetAt.UTC().Format(http.TimeFormat)
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		c.Header("Sunset", sunset)
+		if strings.HasPrefix(c.Request.URL.Path, oldPrefix) {
+			successor := newPrefix + strings.TrimPrefix(c.Request.URL.Path, oldPrefix)
+			c.Header("Link", "<"+successor+">; rel=\"successor-version\"")
+		}
+		c.Next()
+	}
+}
+
+// bufferedResponseWriter holds the handler's response so the v2 shim can
+// rewrite it before anything reaches the client. Only JSON is rewritten, so
+// other responses, and responses the handler flushes such as Server-Sent
+// Events streams, pass through as they are written.
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body        bytes.Buffer
+	status      int
+	passThrough bool
+}
+
+// checkPassThrough starts passing the response through once its content
+// type is set to something other than JSON
+func (w *bufferedResponseWriter) checkPassThrough() {
+	contentType := w.Header().Get("Content-Type")
+	if !w.passThrough && contentType != "" && !strings.HasPrefix(contentType, "application/json") {
+		w.startPassThrough()
+	}
+}
+
+// startPassThrough writes what was held and the writes after it to the
+// client
+func (w *bufferedResponseWriter) startPassThrough() {
+	if w.passThrough {
+		return
+	}
+	w.passThrough = true
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+		w.body.Reset()
+	}
+}
+
+func (w *bufferedResponseWriter) WriteHeader(code int) {
+	w.status = code
+	if w.passThrough {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	w.checkPassThrough()
+}
+
+func (w *bufferedResponseWriter) WriteHeaderNow() {
+	if w.passThrough {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.checkPassThrough()
+	if w.passThrough {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *bufferedResponseWriter) Flush() {
+	w.startPassThrough()
+	w.ResponseWriter.Flush()
+}
+
+func (w *bufferedResponseWriter) Status() int {
+	if w.passThrough {
+		return w.ResponseWriter.Status()
+	}
+	return w.status
+}
+
+func (w *bufferedResponseWriter) Size() int {
+	if w.passThrough {
+		return w.ResponseWriter.Size()
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedResponseWriter) Written() bool {
+	if w.passThrough {
+		return w.ResponseWriter.Written()
+	}
+	return w.body.Len() > 0
+}
+
+// V2CompatibilityMiddleware lets API v2 reuse the v1 handlers:
+//   - list endpoints are paginated by default (v1 returns whole lists unless
+//     page_size is given)
+//   - error bodies always carry the HTTP status in "code" and an "error_code"
+func V2CompatibilityMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(defaultPageSizeKey, v2DefaultPageSize)
+
+		original := c.Writer
+		buffered := &bufferedResponseWriter{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = buffered
+
+		c.Next()
+
+		c.Writer = original
+		if buffered.passThrough {
+			return
+		}
+		body := buffered.body.Bytes()
+		status := buffered.status
+
+		if rewritten, ok := rewriteV2Response(c, status, body); ok {
+			body = rewritten
+			original.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		}
+
+		original.WriteHeader(status)
+		_, _ = original.Write(body)
+	}
+}
+
+func rewriteV2Response(c *gin.Context, status int, body []byte) ([]byte, bool) {
+	if status < http.StatusBadRequest || len(body) == 0 ||
+		!strings.HasPrefix(c.Writer.Header().Get("Content-Type"), "application/json") {
+		return nil, false
+	}
+
+	var errResp dto.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil || (errResp.Code != 0 && errResp.ErrorCode != "") {
+		return nil, false
+	}
+	if errResp.Code == 0 {
+		errResp.Code = status
+	}
+	if errResp.ErrorCode == "" {
+		errResp.ErrorCode = dto.ErrorCodeForStatus(status)
+	}
+	rewritten, err := json.Marshal(errResp)
+	return rewritten, err == nil
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupVersionedRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	listTasks := func(c *gin.Context) {
+		tasks := make([]gin.H, 5)
+		for i := range tasks {
+			tasks[i] = gin.H{"index": i}
+		}
+		page, meta := paginate(c, tasks)
+		c.JSON(http.StatusOK, struct {
+			Items []gin.H `json:"items"`
+			dto.ListMeta
+		}{page, meta})
+	}
+	getTask := func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Task not found"})
+	}
+
+	v1 := router.Group(apiV1Prefix)
+	v1.Use(DeprecationMiddleware(apiV1DeprecatedAt, apiV1SunsetAt, apiV1Prefix, apiV2Prefix))
+	v1.GET("/tasks", listTasks)
+
+	v2 := router.Group(apiV2Prefix)
+	v2.Use(V2CompatibilityMiddleware())
+	v2.GET("/tasks", listTasks)
+	v2.GET("/tasks/:id", getTask)
+	return router
+}
+
+func TestDeprecationMiddleware(t *testing.T) {
+	router := setupVersionedRouter()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/tasks?page=2", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "@"+strconv.FormatInt(apiV1DeprecatedAt.Unix(), 10), w.Header().Get("Deprecation"))
+	sunset, err := http.ParseTime(w.Header().Get("Sunset"))
+	require.NoError(t, err)
+	assert.True(t, sunset.Equal(apiV1SunsetAt))
+	assert.Equal(t, `</api/v2/tasks>; rel="successor-version"`, w.Header().Get("Link"))
+	assert.Contains(t, w.Body.String(), `"total":5,"page":1,"page_size":5,"has_more":false`)
+}
+
+func TestV2CompatibilityMiddleware(t *testing.T) {
+	router := setupVersionedRouter()
+
+	type envelope struct {
+		Items []json.RawMessage `json:"items"`
+		dto.ListMeta
+	}
+	decode := func(t *testing.T, w *httptest.ResponseRecorder) envelope {
+		var resp envelope
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	t.Run("lists are paginated by default", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/tasks", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Deprecation"))
+		resp := decode(t, w)
+		assert.Equal(t, dto.ListMeta{Total: 5, Page: 1, PageSize: v2DefaultPageSize}, resp.ListMeta)
+		assert.Len(t, resp.Items, 5)
+	})
+
+	t.Run("requested page is sliced", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/tasks?page=2&page_size=2", nil))
+
+		resp := decode(t, w)
+		assert.Equal(t, dto.ListMeta{Total: 5, Page: 2, PageSize: 2, HasMore: true}, resp.ListMeta)
+		require.Len(t, resp.Items, 2)
+		assert.JSONEq(t, `{"index": 2}`, string(resp.Items[0]))
+	})
+
+	t.Run("page past the end is empty", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/tasks?page=9", nil))
+
+		assert.JSONEq(t, `{"items": [], "total": 5, "page": 9, "page_size": 20, "has_more": false}`, w.Body.String())
+	})
+
+	t.Run("error body gets the status and error codes", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2/tasks/abc", nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.JSONEq(t, `{"error": "Task not found", "message": "", "code": 404, "error_code": "NOT_FOUND"}`, w.Body.String())
+	})
+}
+
+func TestV2CompatibilityMiddleware_StreamsEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	done := make(chan struct{})
+	router.Group(apiV2Prefix).Use(V2CompatibilityMiddleware()).GET("/projects/:id/events", func(c *gin.Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.Status(http.StatusOK)
+		fmt.Fprint(c.Writer, "event: subscription\ndata: {}\n\n")
+		c.Writer.Flush()
+		<-done
+	})
+	server := httptest.NewServer(router)
+	defer server.Close()
+	defer close(done)
+
+	resp, err := http.Get(server.URL + "/api/v2/projects/abc/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	// The event arrives while the stream is still open
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: subscription\n", line)
+}
+
+func TestSetupRoutes_ServesV2ForEveryV1Route(t *testing.T) {
+	var v1Routes []string
+	v2Routes := make(map[string]bool)
+
+	router := gin.New()
+	for _, prefix := range []string{apiV1Prefix, apiV2Prefix} {
+		registerAPIRoutes(router.Group(prefix),
+			NewProjectHandlerWithWebSocket(nil, nil),
+			NewTaskHandlerWithWebSocket(nil, nil, nil),
+			NewExecutionHandler(nil),
+			NewWorktreeHandler(nil),
+			NewOrganizationHandler(nil),
+			NewJiraHandler(nil),
+			NewGitHubProjectHandler(nil, nil),
+			NewIDEHandler(nil, nil),
+			NewAutomationHandler(nil, nil, nil),
+			NewCommitHandler(nil, ""),
+			NewReleaseNotesHandler(nil),
+			NewAttachmentHandler(nil, 0),
+			NewUserHandler(nil),
+			NewJobMetricHandler(nil),
+			NewEpicHandler(nil),
+			NewPlanHandler(nil),
+			NewAuthHandler(nil),
+			NewProjectMemberHandler(nil),
+			NewProjectWebhookHandler(nil),
+			NewSlackHandler(nil, ""),
+			NewEmailHandler(nil),
+			APIKeyMiddleware(nil),
+		)
+	}
+	for _, r := range router.Routes() {
+		if rest, ok := strings.CutPrefix(r.Path, apiV1Prefix); ok {
+			v1Routes = append(v1Routes, r.Method+" "+rest)
+		} else if rest, ok := strings.CutPrefix(r.Path, apiV2Prefix); ok {
+			v2Routes[r.Method+" "+rest] = true
+		}
+	}
+
+	require.NotEmpty(t, v1Routes)
+	for _, route := range v1Routes {
+		assert.True(t, v2Routes[route], "missing v2 route for %s", route)
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/google/uuid"
+)
+
+// staticKeyUserPrefix starts the WebSocket user IDs of static API key owners
+const staticKeyUserPrefix = "api:"
+
+var errWebSocketAuthRequired = errors.New("authentication required")
+
+// webSocketAuthorizer authenticates WebSocket connections with the tokens and
+// API keys AuthMiddleware accepts, and lets them subscribe to the projects
+// their user may see
+type webSocketAuthorizer struct {
+	authUsecase   usecase.AuthUsecase
+	memberUsecase usecase.ProjectMemberUsecase
+	apiKeys       map[string]string
+	required      bool
+}
+
+// NewWebSocketAuthorizer creates the authorizer of WebSocket connections.
+// Without required, connections without a token are served anonymously.
+func NewWebSocketAuthorizer(authUsecase usecase.AuthUsecase, memberUsecase usecase.ProjectMemberUsecase, apiKeys map[string]string, required bool) websocket.Authorizer {
+	return &webSocketAuthorizer{
+		authUsecase:   authUsecase,
+		memberUsecase: memberUsecase,
+		apiKeys:       apiKeys,
+		required:      required,
+	}
+}
+
+func (a *webSocketAuthorizer) AuthenticateConnection(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		if a.required {
+			return "", errWebSocketAuthRequired
+		}
+		return websocket.AnonymousUserID, nil
+	}
+	if owner := matchAPIKey(a.apiKeys, token); owner != "" {
+		return staticKeyUserPrefix + owner, nil
+	}
+	user, err := a.authUsecase.Authenticate(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	return user.ID.String(), nil
+}
+
+func (a *webSocketAuthorizer) AuthorizeProjectSubscription(ctx context.Context, userID string, projectID uuid.UUID) error {
+	// Like on the API, the static API keys are not restricted, and
+	// anonymous connections only see the projects without members
+	if strings.HasPrefix(userID, staticKeyUserPrefix) {
+		return nil
+	}
+	if userID == websocket.AnonymousUserID {
+		return a.memberUsecase.Authorize(repository.WithAnonymous(ctx), projectID, entity.ProjectRoleViewer)
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return errWebSocketAuthRequired
+	}
+	return a.memberUsecase.Authorize(repository.WithUserID(ctx, id), projectID, entity.ProjectRoleViewer)
+}
@@ -11,6 +11,17 @@ func SetupWebSocketRoutes(router *gin.Engine, wsHandler *websocket.Handler, wsSe
 	ws := router.Group("/ws")
 	{
 		// WebSocket connection endpoint
-		ws.GET("/connect", wsHandler.GetWebSocketHandler())
+		ws.GET("/connect", webSocketConnect(wsHandler))
 	}
 }
+
+// webSocketConnect godoc
+// @Summary Open a WebSocket connection
+// @Description Upgrade the HTTP connection to a WebSocket (Centrifuge protocol) for real-time task, project and execution events
+// @Tags websocket
+// @Success 101 "Switching Protocols"
+// @Failure 400 "Bad Request"
+// @Router /ws/connect [get]
+func webSocketConnect(wsHandler *websocket.Handler) gin.HandlerFunc {
+	return wsHandler.GetWebSocketHandler()
+}
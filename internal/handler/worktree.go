@@ -33,14 +33,11 @@ func NewWorktreeHandler(worktreeUsecase usecase.WorktreeUsecase) *WorktreeHandle
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees [post]
+// @Router /api/v1/worktrees [post]
 func (h *WorktreeHandler) CreateWorktreeForTask(c *gin.Context) {
 	var req dto.CreateWorktreeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+		respondBindingError(c, err, "Invalid request body")
 		return
 	}
 
@@ -56,10 +53,7 @@ func (h *WorktreeHandler) CreateWorktreeForTask(c *gin.Context) {
 
 	worktree, err := h.worktreeUsecase.EnqueueWorktreeCreation(c.Request.Context(), usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to enqueue worktree creation",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to enqueue worktree creation"))
 		return
 	}
 
@@ -80,14 +74,11 @@ func (h *WorktreeHandler) CreateWorktreeForTask(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/cleanup [post]
+// @Router /api/v1/worktrees/cleanup [post]
 func (h *WorktreeHandler) CleanupWorktreeForTask(c *gin.Context) {
 	var req dto.CleanupWorktreeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+		respondBindingError(c, err, "Invalid request body")
 		return
 	}
 
@@ -101,10 +92,7 @@ func (h *WorktreeHandler) CleanupWorktreeForTask(c *gin.Context) {
 
 	err := h.worktreeUsecase.CleanupWorktreeForTask(c.Request.Context(), usecaseReq)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to cleanup worktree",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to cleanup worktree"))
 		return
 	}
 
@@ -122,24 +110,18 @@ func (h *WorktreeHandler) CleanupWorktreeForTask(c *gin.Context) {
 // @Success 200 {object} dto.WorktreeResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/task/{taskId} [get]
+// @Router /api/v1/worktrees/task/{taskId} [get]
 func (h *WorktreeHandler) GetWorktreeByTaskID(c *gin.Context) {
 	taskIDStr := c.Param("taskId")
 	taskID, err := uuid.Parse(taskIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid task ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid task ID"))
 		return
 	}
 
 	worktree, err := h.worktreeUsecase.GetWorktreeByTaskID(c.Request.Context(), taskID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{
-			Error:   "Worktree not found",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusNotFound, dto.NewErrorResponseWithCode(err, http.StatusNotFound, dto.ErrorCodeWorktreeNotFound, "Worktree not found"))
 		return
 	}
 
@@ -154,36 +136,98 @@ func (h *WorktreeHandler) GetWorktreeByTaskID(c *gin.Context) {
 // @Tags worktrees
 // @Produce json
 // @Param projectId path string true "Project ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size, 0 for the whole list (v2 defaults to 20)"
 // @Success 200 {object} dto.WorktreesResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/project/{projectId} [get]
+// @Router /api/v1/worktrees/project/{projectId} [get]
 func (h *WorktreeHandler) GetWorktreesByProjectID(c *gin.Context) {
 	projectIDStr := c.Param("projectId")
 	projectID, err := uuid.Parse(projectIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid project ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	worktrees, err := h.worktreeUsecase.GetWorktreesByProjectID(c.Request.Context(), projectID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get worktrees",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get worktrees"))
 		return
 	}
 
+	page, meta := paginate(c, worktrees)
 	c.JSON(http.StatusOK, dto.WorktreesResponse{
-		Worktrees: worktrees,
-		Count:     len(worktrees),
+		Items:    page,
+		ListMeta: meta,
 	})
 }
 
+// ListProjectWorktrees lists a project's worktrees with their disk usage
+// @Summary List project worktrees with disk usage
+// @Description List the worktrees of a project, largest first, with the task each belongs
+// @Description to, its age and its size on disk, so operators can pick which to clean up.
+// @Tags worktrees
+// @Produce json
+// @Param id path string true "Project ID"
+// @Success 200 {object} dto.ProjectWorktreesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/worktrees [get]
+func (h *WorktreeHandler) ListProjectWorktrees(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	usages, err := h.worktreeUsecase.ListProjectWorktreeUsage(c.Request.Context(), projectID)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to list project worktrees")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProjectWorktreesResponseFromUsage(usages))
+}
+
+// CleanupProjectWorktrees removes the worktrees of several project tasks
+// @Summary Clean up project worktrees
+// @Description Remove the worktrees of the given tasks of a project to reclaim disk space.
+// @Description Active worktrees are only removed with force. Each task is reported
+// @Description separately; one failure does not stop the rest.
+// @Tags worktrees
+// @Accept json
+// @Produce json
+// @Param id path string true "Project ID"
+// @Param request body dto.CleanupProjectWorktreesRequest true "Tasks whose worktrees to remove"
+// @Success 200 {object} dto.CleanupProjectWorktreesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /api/v1/projects/{id}/worktrees/cleanup [post]
+func (h *WorktreeHandler) CleanupProjectWorktrees(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
+		return
+	}
+
+	var req dto.CleanupProjectWorktreesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondBindingError(c, err, "Invalid request body")
+		return
+	}
+
+	results, err := h.worktreeUsecase.CleanupProjectWorktrees(c.Request.Context(), projectID, req.TaskIDs, req.Force)
+	if err != nil {
+		respondError(c, err, http.StatusInternalServerError, "Failed to clean up project worktrees")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CleanupProjectWorktreesResponseFromResults(results))
+}
+
 // UpdateWorktreeStatus updates the status of a worktree
 // @Summary Update worktree status
 // @Description Update the status of a worktree
@@ -196,33 +240,24 @@ func (h *WorktreeHandler) GetWorktreesByProjectID(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/status [put]
+// @Router /api/v1/worktrees/{worktreeId}/status [put]
 func (h *WorktreeHandler) UpdateWorktreeStatus(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	var req dto.UpdateWorktreeStatusRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid request body",
-			Message: err.Error(),
-		})
+		respondBindingError(c, err, "Invalid request body")
 		return
 	}
 
 	err = h.worktreeUsecase.UpdateWorktreeStatus(c.Request.Context(), worktreeID, req.Status)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to update worktree status",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to update worktree status"))
 		return
 	}
 
@@ -241,24 +276,18 @@ func (h *WorktreeHandler) UpdateWorktreeStatus(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/validate [get]
+// @Router /api/v1/worktrees/{worktreeId}/validate [get]
 func (h *WorktreeHandler) ValidateWorktree(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	result, err := h.worktreeUsecase.ValidateWorktree(c.Request.Context(), worktreeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to validate worktree",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to validate worktree"))
 		return
 	}
 
@@ -277,24 +306,18 @@ func (h *WorktreeHandler) ValidateWorktree(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/health [get]
+// @Router /api/v1/worktrees/{worktreeId}/health [get]
 func (h *WorktreeHandler) GetWorktreeHealth(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	health, err := h.worktreeUsecase.GetWorktreeHealth(c.Request.Context(), worktreeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get worktree health",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get worktree health"))
 		return
 	}
 
@@ -313,24 +336,18 @@ func (h *WorktreeHandler) GetWorktreeHealth(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/branch [get]
+// @Router /api/v1/worktrees/{worktreeId}/branch [get]
 func (h *WorktreeHandler) GetBranchInfo(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	branchInfo, err := h.worktreeUsecase.GetBranchInfo(c.Request.Context(), worktreeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get branch info",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get branch info"))
 		return
 	}
 
@@ -349,24 +366,18 @@ func (h *WorktreeHandler) GetBranchInfo(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/initialize [post]
+// @Router /api/v1/worktrees/{worktreeId}/initialize [post]
 func (h *WorktreeHandler) InitializeWorktree(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	err = h.worktreeUsecase.InitializeWorktree(c.Request.Context(), worktreeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to initialize worktree",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to initialize worktree"))
 		return
 	}
 
@@ -385,24 +396,18 @@ func (h *WorktreeHandler) InitializeWorktree(c *gin.Context) {
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/{worktreeId}/recover [post]
+// @Router /api/v1/worktrees/{worktreeId}/recover [post]
 func (h *WorktreeHandler) RecoverFailedWorktree(c *gin.Context) {
 	worktreeIDStr := c.Param("worktreeId")
 	worktreeID, err := uuid.Parse(worktreeIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid worktree ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid worktree ID"))
 		return
 	}
 
 	err = h.worktreeUsecase.RecoverFailedWorktree(c.Request.Context(), worktreeID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to recover worktree",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to recover worktree"))
 		return
 	}
 
@@ -420,24 +425,18 @@ func (h *WorktreeHandler) RecoverFailedWorktree(c *gin.Context) {
 // @Success 200 {object} dto.WorktreeStatisticsResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/project/{projectId}/statistics [get]
+// @Router /api/v1/worktrees/project/{projectId}/statistics [get]
 func (h *WorktreeHandler) GetWorktreeStatistics(c *gin.Context) {
 	projectIDStr := c.Param("projectId")
 	projectID, err := uuid.Parse(projectIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid project ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	statistics, err := h.worktreeUsecase.GetWorktreeStatistics(c.Request.Context(), projectID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get worktree statistics",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get worktree statistics"))
 		return
 	}
 
@@ -455,24 +454,18 @@ func (h *WorktreeHandler) GetWorktreeStatistics(c *gin.Context) {
 // @Success 200 {object} dto.WorktreeCountResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
-// @Router /worktrees/project/{projectId}/active-count [get]
+// @Router /api/v1/worktrees/project/{projectId}/active-count [get]
 func (h *WorktreeHandler) GetActiveWorktreesCount(c *gin.Context) {
 	projectIDStr := c.Param("projectId")
 	projectID, err := uuid.Parse(projectIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error:   "Invalid project ID",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, dto.NewErrorResponseWithCode(err, http.StatusBadRequest, dto.ErrorCodeInvalidID, "Invalid project ID"))
 		return
 	}
 
 	count, err := h.worktreeUsecase.GetActiveWorktreesCount(c.Request.Context(), projectID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Failed to get active worktrees count",
-			Message: err.Error(),
-		})
+		c.JSON(http.StatusInternalServerError, dto.NewErrorResponse(err, http.StatusInternalServerError, "Failed to get active worktrees count"))
 		return
 	}
 
@@ -4,14 +4,26 @@ import (
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
 )
 
 // ClientInterface defines the interface for job client operations
 type ClientInterface interface {
 	EnqueueTaskPlanningString(payload *TaskPlanningPayload, delay time.Duration) (string, error)
 	EnqueueTaskImplementationString(payload *TaskImplementationPayload, delay time.Duration) (string, error)
+	EnqueueTaskComparisonString(payload *TaskComparisonPayload, delay time.Duration) (string, error)
+	EnqueueComparisonSelectString(payload *ComparisonSelectPayload) (string, error)
 	EnqueueWorktreeCreateString(payload *WorktreeCreatePayload, delay time.Duration) (string, error)
 	EnqueueKanbanNotifyString(payload *KanbanNotifyPayload) (string, error)
+	EnqueueJiraSyncString(payload *JiraSyncPayload) (string, error)
+	EnqueuePreviewStopString(payload *PreviewStopPayload) (string, error)
+	EnqueueReleaseNotesString(payload *ReleaseNotesPayload) (string, error)
+	EnqueueExecutionCancelString(payload *ExecutionCancelPayload, worker string) (string, error)
+	EnqueueWebhookDeliveryString(payload *WebhookDeliveryPayload) (string, error)
+	EnqueuePRSyncString(payload *PRSyncPayload) (string, error)
+	PlanningQueueDepth() (int, error)
+	ScheduledJobs(taskID uuid.UUID) ([]usecase.ScheduledJob, error)
+	CancelScheduledJob(taskID uuid.UUID, jobID string) error
 	Close() error
 }
 
@@ -37,6 +49,11 @@ func (a *JobClientAdapter) EnqueueTaskPlanning(payload *usecase.TaskPlanningPayl
 		AIType:          payload.AIType,
 		AutoImplement:   payload.AutoImplement,
 		UseRemoteBranch: payload.UseRemoteBranch,
+		Scheduled:       payload.Scheduled,
+		Feedback:        payload.Feedback,
+		RetryOfID:       payload.RetryOfID,
+		Attempt:         payload.Attempt,
+		TraceContext:    payload.TraceContext,
 	}
 
 	// Enqueue the job
@@ -52,10 +69,18 @@ func (a *JobClientAdapter) EnqueueTaskPlanning(payload *usecase.TaskPlanningPayl
 func (a *JobClientAdapter) EnqueueTaskImplementation(payload *usecase.TaskImplementationPayload, delay time.Duration) (string, error) {
 	// Convert usecase payload to jobs package payload
 	jobPayload := &TaskImplementationPayload{
-		TaskID:          payload.TaskID,
-		ProjectID:       payload.ProjectID,
-		AIType:          payload.AIType,
-		UseRemoteBranch: payload.UseRemoteBranch,
+		TaskID:            payload.TaskID,
+		ProjectID:         payload.ProjectID,
+		AIType:            payload.AIType,
+		UseRemoteBranch:   payload.UseRemoteBranch,
+		ChangeRequest:     payload.ChangeRequest,
+		ResumeExecutionID: payload.ResumeExecutionID,
+		Answer:            payload.Answer,
+		Scheduled:         payload.Scheduled,
+		DryRun:            payload.DryRun,
+		RetryOfID:         payload.RetryOfID,
+		Attempt:           payload.Attempt,
+		TraceContext:      payload.TraceContext,
 	}
 
 	// Enqueue the job
@@ -67,6 +92,24 @@ func (a *JobClientAdapter) EnqueueTaskImplementation(payload *usecase.TaskImplem
 	return jobID, nil
 }
 
+// EnqueueTaskComparison enqueues an executor comparison job
+func (a *JobClientAdapter) EnqueueTaskComparison(payload *usecase.TaskComparisonPayload, delay time.Duration) (string, error) {
+	return a.client.EnqueueTaskComparisonString(&TaskComparisonPayload{
+		TaskID:    payload.TaskID,
+		ProjectID: payload.ProjectID,
+		AITypes:   payload.AITypes,
+	}, delay)
+}
+
+// EnqueueComparisonSelect enqueues a comparison select job
+func (a *JobClientAdapter) EnqueueComparisonSelect(payload *usecase.ComparisonSelectPayload) (string, error) {
+	return a.client.EnqueueComparisonSelectString(&ComparisonSelectPayload{
+		TaskID:      payload.TaskID,
+		ProjectID:   payload.ProjectID,
+		ExecutionID: payload.ExecutionID,
+	})
+}
+
 // EnqueueKanbanNotify enqueues a kanban notify job
 func (a *JobClientAdapter) EnqueueKanbanNotify(payload *usecase.KanbanNotifyPayload) (string, error) {
 	jobPayload := &KanbanNotifyPayload{
@@ -79,6 +122,48 @@ func (a *JobClientAdapter) EnqueueKanbanNotify(payload *usecase.KanbanNotifyPayl
 	return a.client.EnqueueKanbanNotifyString(jobPayload)
 }
 
+// EnqueueJiraSync enqueues a jira sync job
+func (a *JobClientAdapter) EnqueueJiraSync(payload *usecase.JiraSyncPayload) (string, error) {
+	jobPayload := &JiraSyncPayload{
+		TaskID:    payload.TaskID,
+		NewStatus: payload.NewStatus,
+	}
+
+	return a.client.EnqueueJiraSyncString(jobPayload)
+}
+
+// EnqueuePreviewStop enqueues a preview stop job
+func (a *JobClientAdapter) EnqueuePreviewStop(payload *usecase.PreviewStopPayload) (string, error) {
+	return a.client.EnqueuePreviewStopString(&PreviewStopPayload{TaskID: payload.TaskID})
+}
+
+// EnqueueReleaseNotes enqueues a release notes generation job
+func (a *JobClientAdapter) EnqueueReleaseNotes(payload *usecase.ReleaseNotesPayload) (string, error) {
+	return a.client.EnqueueReleaseNotesString(&ReleaseNotesPayload{ReleaseNotesID: payload.ReleaseNotesID})
+}
+
+// EnqueueExecutionCancel enqueues an execution cancellation job for the
+// worker running the execution
+func (a *JobClientAdapter) EnqueueExecutionCancel(payload *usecase.ExecutionCancelPayload) (string, error) {
+	return a.client.EnqueueExecutionCancelString(&ExecutionCancelPayload{ExecutionID: payload.ExecutionID}, payload.Worker)
+}
+
+// EnqueueWebhookDelivery enqueues a job posting a delivery of a project
+// webhook
+func (a *JobClientAdapter) EnqueueWebhookDelivery(payload *usecase.WebhookDeliveryPayload) (string, error) {
+	return a.client.EnqueueWebhookDeliveryString(&WebhookDeliveryPayload{DeliveryID: payload.DeliveryID})
+}
+
+// EnqueuePRSync enqueues a job syncing a single PR
+func (a *JobClientAdapter) EnqueuePRSync(payload *usecase.PRSyncPayload) (string, error) {
+	return a.client.EnqueuePRSyncString(&PRSyncPayload{PullRequestID: payload.PullRequestID})
+}
+
+// PlanningQueueDepth returns how many planning jobs wait to be run
+func (a *JobClientAdapter) PlanningQueueDepth() (int, error) {
+	return a.client.PlanningQueueDepth()
+}
+
 // EnqueueWorktreeCreate enqueues a worktree creation job
 func (a *JobClientAdapter) EnqueueWorktreeCreate(payload *usecase.WorktreeCreatePayload, delay time.Duration) (string, error) {
 	// Convert usecase payload to jobs package payload
@@ -98,3 +183,13 @@ func (a *JobClientAdapter) EnqueueWorktreeCreate(payload *usecase.WorktreeCreate
 
 	return jobID, nil
 }
+
+// ScheduledJobs returns the task's jobs waiting for their start time
+func (a *JobClientAdapter) ScheduledJobs(taskID uuid.UUID) ([]usecase.ScheduledJob, error) {
+	return a.client.ScheduledJobs(taskID)
+}
+
+// CancelScheduledJob deletes one of the task's scheduled jobs
+func (a *JobClientAdapter) CancelScheduledJob(taskID uuid.UUID, jobID string) error {
+	return a.client.CancelScheduledJob(taskID, jobID)
+}
@@ -25,6 +25,16 @@ func (m *MockClient) EnqueueTaskImplementationString(payload *TaskImplementation
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) EnqueueTaskComparisonString(payload *TaskComparisonPayload, delay time.Duration) (string, error) {
+	args := m.Called(payload, delay)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueueComparisonSelectString(payload *ComparisonSelectPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockClient) EnqueueWorktreeCreateString(payload *WorktreeCreatePayload, delay time.Duration) (string, error) {
 	args := m.Called(payload, delay)
 	return args.String(0), args.Error(1)
@@ -35,6 +45,52 @@ func (m *MockClient) EnqueueKanbanNotifyString(payload *KanbanNotifyPayload) (st
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockClient) EnqueueJiraSyncString(payload *JiraSyncPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueuePreviewStopString(payload *PreviewStopPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueueExecutionCancelString(payload *ExecutionCancelPayload, worker string) (string, error) {
+	args := m.Called(payload, worker)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueueWebhookDeliveryString(payload *WebhookDeliveryPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueuePRSyncString(payload *PRSyncPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) EnqueueReleaseNotesString(payload *ReleaseNotesPayload) (string, error) {
+	args := m.Called(payload)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockClient) PlanningQueueDepth() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockClient) ScheduledJobs(taskID uuid.UUID) ([]usecase.ScheduledJob, error) {
+	args := m.Called(taskID)
+	jobs, _ := args.Get(0).([]usecase.ScheduledJob)
+	return jobs, args.Error(1)
+}
+
+func (m *MockClient) CancelScheduledJob(taskID uuid.UUID, jobID string) error {
+	args := m.Called(taskID, jobID)
+	return args.Error(0)
+}
+
 func (m *MockClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
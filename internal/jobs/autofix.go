@@ -0,0 +1,95 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+)
+
+// fixTimeout bounds an auto-fix execution
+const fixTimeout = 30 * time.Minute
+
+// autoFix has the AI fix the implementation while a failing build or test run
+// blocks the pull request, running the pipeline again after every attempt, up
+// to the project's auto-fix attempts. It returns the runs of the last
+// verification and the run that still blocks the pull request, if any.
+func (p *Processor) autoFix(ctx context.Context, project *entity.Project, pipeline *entity.VerificationPipeline, task *entity.Task, execution *entity.Execution, aiExecutor ai.AiCodingCli, runs []entity.VerificationRun, blockedBy *entity.VerificationRun) ([]entity.VerificationRun, *entity.VerificationRun) {
+	for blockedBy != nil && autoFixable(blockedBy) && aiExecutor != nil && execution.FixAttempts < project.AutoFixAttempts {
+		execution.FixAttempts++
+		p.logger.Info("Asking the AI to fix the failure",
+			"task_id", task.ID,
+			"step", blockedBy.Step,
+			"attempt", execution.FixAttempts,
+			"max_attempts", project.AutoFixAttempts)
+		if err := p.executionRepo.UpdateFixAttempts(ctx, execution.ID, execution.FixAttempts); err != nil {
+			p.logger.Error("Failed to save fix attempts", "error", err, "execution_id", execution.ID)
+		}
+
+		p.snapshotWorktree(ctx, task, fixSnapshotID(execution, execution.FixAttempts),
+			fmt.Sprintf("Before auto-fix attempt %d of execution %s", execution.FixAttempts, execution.ID))
+		if err := p.runFix(ctx, task, execution, aiExecutor, blockedBy); err != nil {
+			p.logger.Error("Auto-fix attempt failed", "error", err, "task_id", task.ID, "attempt", execution.FixAttempts)
+			break
+		}
+		p.commitFix(ctx, task, blockedBy, execution.FixAttempts)
+
+		runs, blockedBy = p.verifyImplementation(ctx, project, pipeline, task, execution, aiExecutor)
+	}
+	return runs, blockedBy
+}
+
+// fixSnapshotID names the snapshot taken before an auto-fix attempt
+func fixSnapshotID(execution *entity.Execution, attempt int) string {
+	return fmt.Sprintf("%s-fix-%d", execution.ID, attempt)
+}
+
+// autoFixable reports whether the AI is asked to fix a run blocking the pull
+// request: only build and test failures are
+func autoFixable(run *entity.VerificationRun) bool {
+	return !run.Passed && (run.Step == entity.VerificationStepBuild || run.Step == entity.VerificationStepTest)
+}
+
+// runFix runs an AI execution fixing the failure of run in the task
+// worktree. The implementation is committed first so the fix gets a commit
+// of its own.
+func (p *Processor) runFix(ctx context.Context, task *entity.Task, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli, run *entity.VerificationRun) error {
+	if p.gitManager != nil {
+		if _, err := p.gitManager.CommitAll(ctx, *task.WorktreePath, implementationCommitMessage(task)); err != nil {
+			p.logger.Error("Failed to commit implementation before the fix", "error", err, "task_id", task.ID)
+		}
+	}
+
+	execution, injectEnvVars, err := p.executionService.StartFixExecution(task, aiExecutor, run)
+	if err != nil {
+		return fmt.Errorf("failed to start fix execution: %w", err)
+	}
+	_, err = p.runToCompletion(ctx, dbExecution, execution, injectEnvVars, fixTimeout)
+	return err
+}
+
+func (p *Processor) commitFix(ctx context.Context, task *entity.Task, run *entity.VerificationRun, attempt int) {
+	if p.gitManager == nil {
+		return
+	}
+	message := i18n.T(taskLocale(task), "commit.fix", run.Step, attempt, run.Command)
+	committed, err := p.gitManager.CommitAll(ctx, *task.WorktreePath, message)
+	if err != nil {
+		p.logger.Error("Failed to commit fix", "error", err, "task_id", task.ID, "attempt", attempt)
+		return
+	}
+	if !committed {
+		p.logger.Warn("Auto-fix attempt changed nothing", "task_id", task.ID, "attempt", attempt)
+	}
+}
+
+// fixAttemptsNote completes blockedReason when the AI tried to fix the failure
+func fixAttemptsNote(execution *entity.Execution) string {
+	if execution.FixAttempts == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" after %d auto-fix attempt(s)", execution.FixAttempts)
+}
@@ -0,0 +1,109 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fixingCli is an AiCodingCli whose fix runs a shell command in the worktree
+type fixingCli struct {
+	ai.AiCodingCli
+	fixCommand string
+}
+
+func (c *fixingCli) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	return c.fixCommand, "", nil, nil
+}
+
+func newAutoFixProcessor(t *testing.T) *Processor {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	cliManager, err := ai.NewCLIManager(nil)
+	require.NoError(t, err)
+
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	executionRepo.EXPECT().UpdateFixAttempts(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	processor, _ := newVerificationProcessor(t)
+	processor.gitManager = gitManager
+	processor.executionRepo = executionRepo
+	processor.executionService = ai.NewExecutionService(cliManager, ai.NewProcessManager())
+	return processor
+}
+
+func TestAutoFix(t *testing.T) {
+	project := &entity.Project{BuildCommand: "grep -q fixed main.go", AutoFixAttempts: 2}
+
+	t.Run("fixed", func(t *testing.T) {
+		worktree := initRepo(t)
+		task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree}
+		processor := newAutoFixProcessor(t)
+		execution := &entity.Execution{ID: uuid.New()}
+		pipeline := entity.DefaultVerificationPipeline(project)
+		cli := &fixingCli{fixCommand: "echo '// fixed' >> main.go"}
+
+		runs, blockedBy := processor.verifyImplementation(context.Background(), project, pipeline, task, execution, nil)
+		require.NotNil(t, blockedBy)
+
+		runs, blockedBy = processor.autoFix(context.Background(), project, pipeline, task, execution, cli, runs, blockedBy)
+		assert.Nil(t, blockedBy)
+		assert.Equal(t, 1, execution.FixAttempts)
+		require.Len(t, runs, 1)
+		assert.True(t, runs[0].Passed)
+		assert.Equal(t, 1, runs[0].Attempt)
+		assert.Equal(t, []string{"Fix build failure", "Implement task: Add main", "initial"}, gitLog(t, worktree))
+
+		snapshots, err := processor.gitManager.ListSnapshots(context.Background(), worktree, task.ID.String())
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1, "the worktree is saved before the fix")
+		assert.Equal(t, fixSnapshotID(execution, 1), snapshots[0].ID)
+	})
+
+	t.Run("gives up after the project's attempts", func(t *testing.T) {
+		worktree := initRepo(t)
+		task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree}
+		processor := newAutoFixProcessor(t)
+		execution := &entity.Execution{ID: uuid.New()}
+		pipeline := entity.DefaultVerificationPipeline(project)
+
+		runs, blockedBy := processor.verifyImplementation(context.Background(), project, pipeline, task, execution, nil)
+		runs, blockedBy = processor.autoFix(context.Background(), project, pipeline, task, execution, &fixingCli{fixCommand: "true"}, runs, blockedBy)
+		require.NotNil(t, blockedBy)
+		assert.Equal(t, entity.VerificationStepBuild, blockedBy.Step)
+		assert.Equal(t, 2, execution.FixAttempts)
+		assert.Equal(t, 2, runs[0].Attempt)
+		assert.Equal(t, " after 2 auto-fix attempt(s)", fixAttemptsNote(execution))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		worktree := initRepo(t)
+		task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree}
+		processor, _ := newVerificationProcessor(t)
+		execution := &entity.Execution{ID: uuid.New()}
+		disabled := &entity.Project{BuildCommand: project.BuildCommand}
+		pipeline := entity.DefaultVerificationPipeline(disabled)
+
+		runs, blockedBy := processor.verifyImplementation(context.Background(), disabled, pipeline, task, execution, nil)
+		_, blockedBy = processor.autoFix(context.Background(), disabled, pipeline, task, execution, &fixingCli{fixCommand: "true"}, runs, blockedBy)
+		require.NotNil(t, blockedBy)
+		assert.Zero(t, execution.FixAttempts)
+		assert.Empty(t, fixAttemptsNote(execution))
+	})
+}
+
+func TestAutoFixable(t *testing.T) {
+	assert.True(t, autoFixable(&entity.VerificationRun{Step: entity.VerificationStepBuild}))
+	assert.True(t, autoFixable(&entity.VerificationRun{Step: entity.VerificationStepTest}))
+	assert.False(t, autoFixable(&entity.VerificationRun{Step: entity.VerificationStepTest, Passed: true}))
+	assert.False(t, autoFixable(&entity.VerificationRun{Step: entity.VerificationStepSecurityScan}))
+	assert.False(t, autoFixable(&entity.VerificationRun{Step: entity.VerificationStepSetup}))
+}
@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+)
+
+// branchKeepReason returns why cleanup must keep the task's branch, or ""
+// when it may be deleted. The project's default branch, the task's base
+// branch, branches protected on the project's git provider and branches with an open pull
+// request are kept. A check that cannot be made keeps the branch too.
+func (p *Processor) branchKeepReason(ctx context.Context, project *entity.Project, task *entity.Task, branch string) string {
+	if task.BaseBranchName != nil && *task.BaseBranchName == branch {
+		return "it is the task's base branch"
+	}
+
+	defaultBranch, err := p.gitManager.RemoteDefaultBranch(ctx, project.WorktreeBasePath, "origin")
+	if err != nil {
+		return fmt.Sprintf("the project's default branch is unknown: %v", err)
+	}
+	if defaultBranch == branch {
+		return "it is the project's default branch"
+	}
+
+	if p.prRepo != nil {
+		pr, err := p.prRepo.GetByTaskID(ctx, task.ID)
+		if err != nil {
+			return fmt.Sprintf("failed to get the task's pull request: %v", err)
+		}
+		if pr != nil && pr.Status == entity.PullRequestStatusOpen && pr.HeadBranch == branch {
+			return fmt.Sprintf("pull request #%d is open", pr.GitHubPRNumber)
+		}
+	}
+
+	repo := vcs.RepositoryFor(project)
+	if repo == "" || p.githubService == nil {
+		return ""
+	}
+	ctx = vcs.WithProjectID(ctx, project.ID)
+	provider := vcs.ProviderFor(project).DisplayName()
+
+	defaultBranch, err = p.githubService.GetDefaultBranch(ctx, repo)
+	if err != nil {
+		return fmt.Sprintf("failed to get the default branch from %s: %v", provider, err)
+	}
+	if defaultBranch == branch {
+		return "it is the project's default branch"
+	}
+
+	protected, err := p.githubService.IsBranchProtected(ctx, repo, branch)
+	if err != nil {
+		return fmt.Sprintf("failed to check branch protection on %s: %v", provider, err)
+	}
+	if protected {
+		return "it is protected on " + provider
+	}
+
+	open, err := p.githubService.HasOpenPullRequest(ctx, repo, branch)
+	if err != nil {
+		return fmt.Sprintf("failed to check pull requests on %s: %v", provider, err)
+	}
+	if open {
+		return "it has an open pull request on " + provider
+	}
+
+	return ""
+}
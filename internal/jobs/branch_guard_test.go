@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os/exec"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/service/github"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// branchGitHub answers the branch questions cleanup asks GitHub
+type branchGitHub struct {
+	github.GitHubServiceInterface
+	defaultBranch string
+	protected     map[string]bool
+	openPRs       map[string]bool
+	err           error
+}
+
+func (g *branchGitHub) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	return g.defaultBranch, g.err
+}
+
+func (g *branchGitHub) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	return g.protected[branch], nil
+}
+
+func (g *branchGitHub) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	return g.openPRs[branch], nil
+}
+
+func TestBranchKeepReason(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	repo := initRepo(t)
+	for _, args := range [][]string{
+		{"update-ref", "refs/remotes/origin/trunk", "HEAD"},
+		{"symbolic-ref", "refs/remotes/origin/HEAD", "refs/remotes/origin/trunk"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	base := "develop"
+	project := &entity.Project{ID: uuid.New(), WorktreeBasePath: repo, RepositoryURL: "https://github.com/acme/app"}
+	task := &entity.Task{ID: uuid.New(), BaseBranchName: &base}
+
+	newProcessor := func(t *testing.T, pr *entity.PullRequest, gh *branchGitHub) *Processor {
+		prRepo := repository.NewPullRequestRepositoryMock(t)
+		prRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(pr, nil).Maybe()
+		return &Processor{gitManager: gitManager, prRepo: prRepo, githubService: gh, logger: slog.Default()}
+	}
+
+	tests := []struct {
+		name   string
+		branch string
+		pr     *entity.PullRequest
+		github *branchGitHub
+		reason string
+	}{
+		{name: "task branch", branch: "task-42", github: &branchGitHub{defaultBranch: "main"}},
+		{name: "base branch", branch: "develop", github: &branchGitHub{}, reason: "it is the task's base branch"},
+		{name: "local default branch", branch: "trunk", github: &branchGitHub{}, reason: "it is the project's default branch"},
+		{name: "GitHub default branch", branch: "main", github: &branchGitHub{defaultBranch: "main"}, reason: "it is the project's default branch"},
+		{
+			name:   "tracked open pull request",
+			branch: "task-42",
+			pr:     &entity.PullRequest{Status: entity.PullRequestStatusOpen, HeadBranch: "task-42", GitHubPRNumber: 7},
+			github: &branchGitHub{},
+			reason: "pull request #7 is open",
+		},
+		{
+			name:   "merged pull request",
+			branch: "task-42",
+			pr:     &entity.PullRequest{Status: entity.PullRequestStatusMerged, HeadBranch: "task-42", GitHubPRNumber: 7},
+			github: &branchGitHub{defaultBranch: "main"},
+		},
+		{name: "protected branch", branch: "release", github: &branchGitHub{defaultBranch: "main", protected: map[string]bool{"release": true}}, reason: "it is protected on GitHub"},
+		{name: "open pull request on GitHub", branch: "task-42", github: &branchGitHub{defaultBranch: "main", openPRs: map[string]bool{"task-42": true}}, reason: "it has an open pull request on GitHub"},
+		{name: "GitHub unreachable", branch: "task-42", github: &branchGitHub{err: errors.New("timeout")}, reason: "failed to get the default branch from GitHub: timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor := newProcessor(t, tt.pr, tt.github)
+
+			assert.Equal(t, tt.reason, processor.branchKeepReason(context.Background(), project, task, tt.branch))
+		})
+	}
+
+	t.Run("projects outside GitHub only use the local checks", func(t *testing.T) {
+		local := *project
+		local.RepositoryURL = "https://gitlab.com/acme/app"
+		processor := &Processor{gitManager: gitManager, logger: slog.Default()}
+
+		assert.Equal(t, "", processor.branchKeepReason(context.Background(), &local, task, "task-42"))
+		assert.Equal(t, "it is the project's default branch", processor.branchKeepReason(context.Background(), &local, task, "trunk"))
+	})
+}
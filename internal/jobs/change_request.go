@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+)
+
+// maxChangeRequestSummary bounds the feedback quoted in the subject of the
+// commit addressing it
+const maxChangeRequestSummary = 50
+
+// withChangeRequestContext returns a copy of task whose description carries
+// the reviewer's feedback and the changes made so far, for the executor to
+// address the feedback on top of them. The changes are left out when they
+// cannot be read, the executor then finds them in the worktree.
+func (p *Processor) withChangeRequestContext(ctx context.Context, task *entity.Task, feedback string) *entity.Task {
+	var description strings.Builder
+	description.WriteString(strings.TrimRight(task.Description, "\n"))
+	description.WriteString("\n\nThis task was implemented and is in code review. The reviewer requested these changes:\n\n")
+	description.WriteString(feedback)
+
+	diff, err := p.reviewDiff(ctx, task)
+	if err != nil {
+		p.logger.Warn("Failed to get the changes made so far for the change request", "task_id", task.ID, "error", err)
+	} else if strings.TrimSpace(diff) != "" {
+		description.WriteString("\n\nThe changes made so far are committed in this worktree. Build on them rather than starting over:\n\n```diff\n")
+		description.WriteString(strings.TrimRight(diff, "\n"))
+		description.WriteString("\n```")
+	}
+
+	withRequest := *task
+	withRequest.Description = description.String()
+	return &withRequest
+}
+
+// commitChangeRequest commits the changes addressing the feedback apart from
+// the implementation, so reviewers see what the follow-up changed
+func (p *Processor) commitChangeRequest(ctx context.Context, task *entity.Task, feedback string) {
+	if p.gitManager == nil || task.WorktreePath == nil {
+		return
+	}
+	committed, err := p.gitManager.CommitAll(ctx, *task.WorktreePath, changeRequestCommitMessage(task, feedback))
+	if err != nil {
+		p.logger.Error("Failed to commit the requested changes", "error", err, "task_id", task.ID)
+		return
+	}
+	if !committed {
+		p.logger.Warn("Change request execution changed nothing", "task_id", task.ID)
+	}
+}
+
+// changeRequestCommitMessage is the message of the commit holding the
+// changes made for feedback, quoting its first line
+func changeRequestCommitMessage(task *entity.Task, feedback string) string {
+	summary, _, _ := strings.Cut(strings.TrimSpace(feedback), "\n")
+	if runes := []rune(summary); len(runes) > maxChangeRequestSummary {
+		summary = string(runes[:maxChangeRequestSummary-3]) + "..."
+	}
+	return i18n.T(taskLocale(task), "commit.change_request", summary, task.ID.String())
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeRequest(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	base := strings.TrimSpace(string(out))
+	out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", "task/add-main").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add main", Description: "Add the entry point", WorktreePath: &worktree, BaseBranchName: &base}
+	processor := &Processor{gitManager: gitManager, logger: slog.Default()}
+
+	withRequest := processor.withChangeRequestContext(context.Background(), task, "Print a greeting")
+	assert.Equal(t, "Add the entry point", task.Description, "the task itself is left as is")
+	assert.True(t, strings.HasPrefix(withRequest.Description, "Add the entry point\n\nThis task was implemented and is in code review. The reviewer requested these changes:\n\nPrint a greeting\n\n"))
+	assert.Contains(t, withRequest.Description, "```diff\n")
+	assert.Contains(t, withRequest.Description, "+package main")
+
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "main.go"), []byte("package main\n\nfunc main() { println(\"hello\") }\n"), 0o644))
+	processor.commitChangeRequest(context.Background(), task, "Print a greeting\nwhen the program starts")
+	assert.Equal(t, []string{"Address review feedback: Print a greeting", "Implement task: Add main", "initial"}, gitLog(t, worktree))
+}
+
+func TestChangeRequestCommitMessage(t *testing.T) {
+	task := &entity.Task{ID: uuid.New()}
+
+	message := changeRequestCommitMessage(task, strings.Repeat("a", 80))
+	subject, _, _ := strings.Cut(message, "\n")
+	assert.Equal(t, "Address review feedback: "+strings.Repeat("a", 47)+"...", subject)
+	assert.Contains(t, message, "Task ID: "+task.ID.String())
+}
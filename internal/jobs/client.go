@@ -1,15 +1,19 @@
 package jobs
 
 import (
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/auto-devs/auto-devs/pkg/tracing"
 	"github.com/hibiken/asynq"
 )
 
 // Client wraps asynq.Client for job enqueueing
 type Client struct {
-	client *asynq.Client
+	client    *asynq.Client
+	inspector *asynq.Inspector
 }
 
 // Ensure Client implements ClientInterface
@@ -24,18 +28,47 @@ func NewClient(redisAddr, redisPassword string, redisDB int) *Client {
 	}
 
 	return &Client{
-		client: asynq.NewClient(redisOpt),
+		client:    asynq.NewClient(redisOpt),
+		inspector: asynq.NewInspector(redisOpt),
 	}
 }
 
 // Close closes the client connection
 func (c *Client) Close() error {
-	return c.client.Close()
+	return errors.Join(c.client.Close(), c.inspector.Close())
+}
+
+// Ping checks the Redis server the jobs are queued on can be reached
+func (c *Client) Ping() error {
+	return c.client.Ping()
+}
+
+// PlanningQueueDepth returns how many planning jobs wait to be run, pending,
+// scheduled or to be retried
+func (c *Client) PlanningQueueDepth() (int, error) {
+	// A queue exists once a job was enqueued in it
+	queues, err := c.inspector.Queues()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queues: %w", err)
+	}
+	if !slices.Contains(queues, QueuePlanning) {
+		return 0, nil
+	}
+	info, err := c.inspector.GetQueueInfo(QueuePlanning)
+	if err != nil {
+		return 0, fmt.Errorf("failed to inspect planning queue: %w", err)
+	}
+	return info.Pending + info.Scheduled + info.Retry, nil
 }
 
 // EnqueueTaskPlanning enqueues a task planning job
 func (c *Client) EnqueueTaskPlanning(payload *TaskPlanningPayload, delay time.Duration) (*asynq.TaskInfo, error) {
-	task, err := NewTaskPlanningJob(payload.TaskID, payload.BranchName, payload.ProjectID, payload.AIType, payload.AutoImplement, payload.UseRemoteBranch)
+	traced := *payload
+	traceContext, span := startEnqueueSpan(payload.TraceContext, TypeTaskPlanning, QueuePlanning)
+	defer span.End()
+	traced.TraceContext = traceContext
+
+	task, err := NewTaskPlanningTask(traced)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task planning job: %w", err)
 	}
@@ -44,15 +77,16 @@ func (c *Client) EnqueueTaskPlanning(payload *TaskPlanningPayload, delay time.Du
 	opts := []asynq.Option{
 		asynq.MaxRetry(1),
 		asynq.Timeout(30 * time.Minute), // Planning can take a while
-		asynq.Queue("planning"),         // Use dedicated queue for planning jobs
+		asynq.Queue(QueuePlanning),      // Use dedicated queue for planning jobs
 	}
 
 	if delay > 0 {
 		opts = append(opts, asynq.ProcessIn(delay))
 	}
 
-	taskInfo, err := c.client.Enqueue(task, opts...)
+	taskInfo, err := c.enqueue(task, opts...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, fmt.Errorf("failed to enqueue task planning job: %w", err)
 	}
 
@@ -70,7 +104,12 @@ func (c *Client) EnqueueTaskPlanningString(payload *TaskPlanningPayload, delay t
 
 // EnqueueTaskImplementation enqueues a task implementation job
 func (c *Client) EnqueueTaskImplementation(payload *TaskImplementationPayload, delay time.Duration) (*asynq.TaskInfo, error) {
-	task, err := NewTaskImplementationJob(payload.TaskID, payload.ProjectID, payload.AIType, payload.UseRemoteBranch)
+	traced := *payload
+	traceContext, span := startEnqueueSpan(payload.TraceContext, TypeTaskImplementation, QueueImplementation)
+	defer span.End()
+	traced.TraceContext = traceContext
+
+	task, err := NewTaskImplementationTask(traced)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task implementation job: %w", err)
 	}
@@ -78,16 +117,17 @@ func (c *Client) EnqueueTaskImplementation(payload *TaskImplementationPayload, d
 	// Set task options
 	opts := []asynq.Option{
 		asynq.MaxRetry(1),
-		asynq.Timeout(60 * time.Minute), // Implementation can take longer than planning
-		asynq.Queue("implementation"),   // Use dedicated queue for implementation jobs
+		asynq.Timeout(60 * time.Minute),  // Implementation can take longer than planning
+		asynq.Queue(QueueImplementation), // Use dedicated queue for implementation jobs
 	}
 
 	if delay > 0 {
 		opts = append(opts, asynq.ProcessIn(delay))
 	}
 
-	taskInfo, err := c.client.Enqueue(task, opts...)
+	taskInfo, err := c.enqueue(task, opts...)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, fmt.Errorf("failed to enqueue task implementation job: %w", err)
 	}
 
@@ -103,6 +143,70 @@ func (c *Client) EnqueueTaskImplementationString(payload *TaskImplementationPayl
 	return taskInfo.ID, nil
 }
 
+// EnqueueTaskComparison enqueues an executor comparison job
+func (c *Client) EnqueueTaskComparison(payload *TaskComparisonPayload, delay time.Duration) (*asynq.TaskInfo, error) {
+	task, err := NewTaskComparisonTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task comparison job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(1),
+		asynq.Timeout(60 * time.Minute),
+		asynq.Queue(QueueImplementation),
+	}
+
+	if delay > 0 {
+		opts = append(opts, asynq.ProcessIn(delay))
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue task comparison job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueTaskComparisonString enqueues an executor comparison job and returns job ID as string
+func (c *Client) EnqueueTaskComparisonString(payload *TaskComparisonPayload, delay time.Duration) (string, error) {
+	taskInfo, err := c.EnqueueTaskComparison(payload, delay)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueueComparisonSelect enqueues a comparison select job
+func (c *Client) EnqueueComparisonSelect(payload *ComparisonSelectPayload) (*asynq.TaskInfo, error) {
+	task, err := NewComparisonSelectTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comparison select job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(1),
+		asynq.Timeout(60 * time.Minute), // Verification and auto-fix run before the pull request
+		asynq.Queue(QueueImplementation),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue comparison select job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueComparisonSelectString enqueues a comparison select job and returns job ID as string
+func (c *Client) EnqueueComparisonSelectString(payload *ComparisonSelectPayload) (string, error) {
+	taskInfo, err := c.EnqueueComparisonSelect(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
 // EnqueueWorktreeCreate enqueues a worktree creation job
 func (c *Client) EnqueueWorktreeCreate(payload *WorktreeCreatePayload, delay time.Duration) (*asynq.TaskInfo, error) {
 	task, err := NewWorktreeCreateJob(payload.WorktreeID, payload.TaskID, payload.ProjectID, payload.BaseBranchName, payload.UseRemoteBranch)
@@ -114,14 +218,14 @@ func (c *Client) EnqueueWorktreeCreate(payload *WorktreeCreatePayload, delay tim
 	opts := []asynq.Option{
 		asynq.MaxRetry(2),
 		asynq.Timeout(10 * time.Minute), // Init workspace script can take a few minutes
-		asynq.Queue("critical"),         // Worktree creation blocks the user workflow
+		asynq.Queue(QueueCritical),      // Worktree creation blocks the user workflow
 	}
 
 	if delay > 0 {
 		opts = append(opts, asynq.ProcessIn(delay))
 	}
 
-	taskInfo, err := c.client.Enqueue(task, opts...)
+	taskInfo, err := c.enqueue(task, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to enqueue worktree create job: %w", err)
 	}
@@ -150,10 +254,10 @@ func (c *Client) EnqueueKanbanNotify(payload *KanbanNotifyPayload) (*asynq.TaskI
 	opts := []asynq.Option{
 		asynq.MaxRetry(10),
 		asynq.Timeout(1 * time.Minute),
-		asynq.Queue("default"),
+		asynq.Queue(QueueDefault),
 	}
 
-	taskInfo, err := c.client.Enqueue(task, opts...)
+	taskInfo, err := c.enqueue(task, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to enqueue kanban notify job: %w", err)
 	}
@@ -170,6 +274,196 @@ func (c *Client) EnqueueKanbanNotifyString(payload *KanbanNotifyPayload) (string
 	return taskInfo.ID, nil
 }
 
+// EnqueuePRSync enqueues a job syncing a single PR
+func (c *Client) EnqueuePRSync(payload *PRSyncPayload) (*asynq.TaskInfo, error) {
+	task, err := NewPRSyncTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PR sync job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(1 * time.Minute),
+		asynq.Queue(QueueFor(TypePRSync)),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue PR sync job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueuePRSyncString enqueues a PR sync job and returns job ID as string
+func (c *Client) EnqueuePRSyncString(payload *PRSyncPayload) (string, error) {
+	taskInfo, err := c.EnqueuePRSync(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueueWebhookDelivery enqueues a webhook delivery job
+func (c *Client) EnqueueWebhookDelivery(payload *WebhookDeliveryPayload) (*asynq.TaskInfo, error) {
+	task, err := NewWebhookDeliveryTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery job: %w", err)
+	}
+
+	// Retried with exponential backoff, over a few hours of the receiver
+	// being down
+	opts := []asynq.Option{
+		asynq.MaxRetry(WebhookDeliveryMaxRetry),
+		asynq.Timeout(1 * time.Minute),
+		asynq.Queue(QueueFor(TypeWebhookDelivery)),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue webhook delivery job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueWebhookDeliveryString enqueues a webhook delivery job and returns
+// job ID as string
+func (c *Client) EnqueueWebhookDeliveryString(payload *WebhookDeliveryPayload) (string, error) {
+	taskInfo, err := c.EnqueueWebhookDelivery(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueueJiraSync enqueues a jira sync job
+func (c *Client) EnqueueJiraSync(payload *JiraSyncPayload) (*asynq.TaskInfo, error) {
+	task, err := NewJiraSyncTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jira sync job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Timeout(1 * time.Minute),
+		asynq.Queue(QueueDefault),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue jira sync job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueJiraSyncString enqueues a jira sync job and returns job ID as string
+func (c *Client) EnqueueJiraSyncString(payload *JiraSyncPayload) (string, error) {
+	taskInfo, err := c.EnqueueJiraSync(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueuePreviewStop enqueues a preview stop job
+func (c *Client) EnqueuePreviewStop(payload *PreviewStopPayload) (*asynq.TaskInfo, error) {
+	task, err := NewPreviewStopTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create preview stop job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(5 * time.Minute), // docker compose down can take a while
+		asynq.Queue(QueueDefault),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue preview stop job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueuePreviewStopString enqueues a preview stop job and returns job ID as string
+func (c *Client) EnqueuePreviewStopString(payload *PreviewStopPayload) (string, error) {
+	taskInfo, err := c.EnqueuePreviewStop(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueueExecutionCancel enqueues an execution cancellation job on the
+// queue of worker, the one running the execution, or on the critical queue
+// for an execution no worker runs
+func (c *Client) EnqueueExecutionCancel(payload *ExecutionCancelPayload, worker string) (*asynq.TaskInfo, error) {
+	task, err := NewExecutionCancelTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create execution cancel job: %w", err)
+	}
+
+	queue := QueueFor(TypeExecutionCancel)
+	if worker != "" {
+		queue = WorkerQueue(worker)
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(1 * time.Minute),
+		asynq.Queue(queue),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue execution cancel job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueExecutionCancelString enqueues an execution cancellation job and
+// returns job ID as string
+func (c *Client) EnqueueExecutionCancelString(payload *ExecutionCancelPayload, worker string) (string, error) {
+	taskInfo, err := c.EnqueueExecutionCancel(payload, worker)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
+// EnqueueReleaseNotes enqueues a release notes generation job
+func (c *Client) EnqueueReleaseNotes(payload *ReleaseNotesPayload) (*asynq.TaskInfo, error) {
+	task, err := NewReleaseNotesTask(*payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create release notes job: %w", err)
+	}
+
+	opts := []asynq.Option{
+		asynq.MaxRetry(1),
+		asynq.Timeout(20 * time.Minute), // The AI run is bounded by releaseNotesTimeout
+		asynq.Queue(QueueDefault),
+	}
+
+	taskInfo, err := c.enqueue(task, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue release notes job: %w", err)
+	}
+
+	return taskInfo, nil
+}
+
+// EnqueueReleaseNotesString enqueues a release notes generation job and returns job ID as string
+func (c *Client) EnqueueReleaseNotesString(payload *ReleaseNotesPayload) (string, error) {
+	taskInfo, err := c.EnqueueReleaseNotes(payload)
+	if err != nil {
+		return "", err
+	}
+	return taskInfo.ID, nil
+}
+
 // GetTaskInfo retrieves information about a task
 func (c *Client) GetTaskInfo(queue, taskID string) (*asynq.TaskInfo, error) {
 	// Note: asynq.Client doesn't have GetTaskInfo method
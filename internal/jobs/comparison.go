@@ -0,0 +1,337 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// ProcessTaskComparison runs the task once per executor, side by side, each
+// in a worktree and on a branch of its own. When every variant has finished
+// the task goes back to its previous status so the user can pick a winner.
+func (p *Processor) ProcessTaskComparison(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseTaskComparisonPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse task comparison payload: %w", err)
+	}
+
+	p.logger.Info("Processing task comparison job",
+		"task_id", payload.TaskID,
+		"project_id", payload.ProjectID,
+		"ai_types", payload.AITypes)
+
+	// The lock is held until every variant is finished with
+	unlock, err := p.lockTask(ctx, payload.TaskID)
+	if err != nil {
+		return err
+	}
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			unlock()
+		}
+	}()
+
+	// Resolve every executor before anything is created
+	aiExecutors := make([]ai.AiCodingCli, len(payload.AITypes))
+	for i, aiType := range payload.AITypes {
+		aiExecutors[i], err = p.getAiExecutor(aiType)
+		if err != nil {
+			return fmt.Errorf("failed to get AI executor: %w", err)
+		}
+	}
+
+	currentTask, err := p.taskUsecase.GetByID(ctx, payload.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	// The variants run under a single slot of the project
+	releaseSlot, err := p.acquireProjectSlot(ctx, currentTask, func(delay time.Duration) error {
+		_, err := p.jobRequeuer.EnqueueTaskComparison(payload, delay)
+		return err
+	})
+	if err != nil || releaseSlot == nil {
+		return err
+	}
+	unlockTask := unlock
+	unlock = func() {
+		releaseSlot()
+		unlockTask()
+	}
+	// Every variant is restricted to the project's command policy
+	currentTask.Project, err = p.projectUsecase.GetByID(ctx, payload.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	fallbackStatus := entity.TaskStatusTODO
+	if currentTask.Status == entity.TaskStatusPLANREVIEWING {
+		fallbackStatus = entity.TaskStatusPLANREVIEWING
+	}
+
+	if err := p.updateTaskStatus(ctx, payload.TaskID, entity.TaskStatusIMPLEMENTING); err != nil {
+		return fmt.Errorf("failed to update task status to IMPLEMENTING: %w", err)
+	}
+
+	plan, err := p.planRepo.GetByTaskID(ctx, payload.TaskID)
+	if err == nil && plan != nil &&
+		(plan.Status == entity.PlanStatusAPPROVED || plan.Status == entity.PlanStatusREVIEWING) {
+		currentTask.Plans = []entity.Plan{*plan}
+	}
+
+	comparisonID := uuid.New()
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		failures  []string
+	)
+	recordFailure := func(variant, reason string) {
+		mu.Lock()
+		defer mu.Unlock()
+		failures = append(failures, fmt.Sprintf("variant %s: %s", variant, reason))
+	}
+
+	for i, aiType := range payload.AITypes {
+		variant := usecase.ComparisonVariantName(i)
+		dbExecution := &entity.Execution{ComparisonID: &comparisonID, Variant: variant, AIType: aiType}
+		done, err := p.startVariantExecution(ctx, currentTask, dbExecution, aiExecutors[i])
+		if err != nil {
+			p.logger.Error("Failed to start comparison variant", "task_id", payload.TaskID, "variant", variant, "error", err)
+			recordFailure(variant, err.Error())
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if reason := <-done; reason != "" {
+				recordFailure(variant, reason)
+				return
+			}
+			// Keep the variant's changes so its diff outlives the worktree
+			p.snapshotWorktree(context.Background(), variantTask(currentTask, dbExecution), usecase.ComparisonResultSnapshotID(dbExecution.ID),
+				fmt.Sprintf("Result of comparison variant %s (%s)", variant, aiType))
+			mu.Lock()
+			succeeded++
+			mu.Unlock()
+		}()
+	}
+
+	handedOff = true
+	go func() {
+		defer unlock()
+		wg.Wait()
+
+		_ = p.updateTaskStatus(context.Background(), payload.TaskID, fallbackStatus)
+		if succeeded == 0 {
+			_ = p.taskUsecase.AppendErrorLog(context.Background(), payload.TaskID,
+				fmt.Sprintf("Executor comparison failed: %v", failures))
+			return
+		}
+		p.logger.Info("Executor comparison finished",
+			"task_id", payload.TaskID,
+			"comparison_id", comparisonID,
+			"succeeded", succeeded,
+			"failures", failures)
+	}()
+
+	return nil
+}
+
+// startVariantExecution creates a worktree of its own for the variant of
+// dbExecution, apart from the task worktree, and starts the execution in it.
+// dbExecution is saved once it has started. The returned channel receives an
+// empty string once the execution completes, or the reason it failed.
+func (p *Processor) startVariantExecution(ctx context.Context, task *entity.Task, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli) (<-chan string, error) {
+	variant := dbExecution.Variant
+	worktree, err := p.worktreeUsecase.CreateVariantWorktree(ctx, task.ID, variant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create variant worktree: %w", err)
+	}
+	dbExecution.WorktreePath = worktree.WorktreePath
+	dbExecution.BranchName = worktree.BranchName
+	worktreeTask := variantTask(task, dbExecution)
+
+	release, err := p.acquireExecutionSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execution, injectEnvVars, err := p.executionService.StartExecution(worktreeTask, aiExecutor, false)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("failed to start AI execution: %w", err)
+	}
+
+	dbExecution.TaskID = task.ID
+	dbExecution.Status = entity.ExecutionStatus(execution.Status)
+	dbExecution.StartedAt = execution.StartedAt
+	dbExecution.Progress = execution.Progress
+	dbExecution.Worker = p.worker
+	dbExecution.TraceID = tracing.TraceID(ctx)
+	if err := p.executionRepo.Create(ctx, dbExecution); err != nil {
+		release()
+		return nil, fmt.Errorf("failed to save execution to database: %w", err)
+	}
+
+	stdoutChannel := make(chan string)
+	stderrChannel := make(chan string)
+	execution.RegisterStdoutChannel(stdoutChannel)
+	execution.RegisterStderrChannel(stderrChannel)
+
+	p.executionService.RunExecution(execution, injectEnvVars)
+	releaseWhenDone(execution, release)
+
+	p.logger.Info("Variant execution started",
+		"task_id", task.ID,
+		"variant", variant,
+		"ai_type", dbExecution.AIType,
+		"execution_id", dbExecution.ID)
+
+	done := make(chan string, 1)
+	go func() {
+		for {
+			select {
+			case <-execution.GetContextDoneChannel():
+				completedAt := time.Now()
+				if execution.Error != "" {
+					p.logger.Error("Variant execution failed", "task_id", task.ID, "variant", variant, "error", execution.Error)
+					p.recordFileAccessViolation(context.Background(), dbExecution, execution)
+					if err := p.executionRepo.MarkFailed(context.Background(), dbExecution.ID, completedAt, execution.Error); err != nil {
+						p.logger.Error("Failed to mark execution as failed", "error", err, "execution_id", dbExecution.ID)
+					}
+					p.recordSpend(context.Background(), task, dbExecution, aiExecutor, execution)
+					p.publishExecutionCompleted(context.Background(), task, dbExecution, completedAt, execution.Error)
+					done <- execution.Error
+					return
+				}
+
+				if err := p.executionRepo.MarkCompleted(context.Background(), dbExecution.ID, completedAt, nil); err != nil {
+					p.logger.Error("Failed to mark execution as completed", "error", err, "execution_id", dbExecution.ID)
+				}
+				p.recordSpend(context.Background(), task, dbExecution, aiExecutor, execution)
+				p.publishExecutionCompleted(context.Background(), task, dbExecution, completedAt, "")
+				done <- ""
+				return
+			case stdout := <-stdoutChannel:
+				logs := aiExecutor.ParseOutputToLogs(stdout)
+				for _, log := range logs {
+					log.ExecutionID = dbExecution.ID
+					log.TraceID = dbExecution.TraceID
+				}
+				if err := p.executionLogRepo.BatchInsertOrUpdate(context.Background(), logs); err != nil {
+					p.logger.Error("Failed to insert or update logs", "error", err, "execution_id", dbExecution.ID)
+				} else {
+					p.publishExecutionLogs(task, dbExecution.ID, logs)
+				}
+			case stderr := <-stderrChannel:
+				p.logger.Error("AI execution stderr", "task_id", task.ID, "variant", variant, "stderr", stderr)
+			}
+		}
+	}()
+
+	return done, nil
+}
+
+// variantTask is the task as seen from the worktree of a variant execution
+func variantTask(task *entity.Task, execution *entity.Execution) *entity.Task {
+	variantTask := *task
+	variantTask.WorktreePath = &execution.WorktreePath
+	variantTask.BranchName = &execution.BranchName
+	return &variantTask
+}
+
+// ProcessComparisonSelect keeps the winning variant's worktree as the task
+// worktree, removes the others and carries the winning changes through
+// verification to a pull request, as a regular implementation would.
+func (p *Processor) ProcessComparisonSelect(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseComparisonSelectPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse comparison select payload: %w", err)
+	}
+
+	p.logger.Info("Processing comparison select job",
+		"task_id", payload.TaskID,
+		"execution_id", payload.ExecutionID)
+
+	// The lock is held until the winner's implementation is finished
+	unlock, err := p.lockTask(ctx, payload.TaskID)
+	if err != nil {
+		return err
+	}
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			unlock()
+		}
+	}()
+
+	winner, err := p.executionRepo.GetByID(ctx, payload.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("failed to get winning execution: %w", err)
+	}
+	aiExecutor, err := p.getAiExecutor(winner.AIType)
+	if err != nil {
+		return fmt.Errorf("failed to get AI executor: %w", err)
+	}
+
+	executions, err := p.executionRepo.GetLatestComparison(ctx, payload.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get comparison: %w", err)
+	}
+	for _, execution := range executions {
+		if execution.ID == winner.ID {
+			continue
+		}
+		if err := p.worktreeUsecase.RemoveVariantWorktree(ctx, payload.ProjectID, execution.WorktreePath, execution.BranchName); err != nil {
+			p.logger.Warn("Failed to remove losing variant worktree",
+				"task_id", payload.TaskID, "variant", execution.Variant, "error", err)
+		}
+	}
+
+	if _, err := p.worktreeUsecase.AdoptVariantWorktree(ctx, payload.TaskID, winner.WorktreePath, winner.BranchName); err != nil {
+		_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Failed to adopt variant %s: %s", winner.Variant, err.Error()))
+		return fmt.Errorf("failed to adopt variant worktree: %w", err)
+	}
+
+	project, err := p.projectUsecase.GetByID(ctx, payload.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	projectTask, err := p.taskUsecase.GetByID(ctx, payload.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+
+	fallbackStatus := entity.TaskStatusTODO
+	plan, err := p.planRepo.GetByTaskID(ctx, payload.TaskID)
+	if err == nil && plan != nil &&
+		(plan.Status == entity.PlanStatusAPPROVED || plan.Status == entity.PlanStatusREVIEWING) {
+		projectTask.Plans = []entity.Plan{*plan}
+		fallbackStatus = entity.TaskStatusPLANREVIEWING
+	}
+
+	if err := p.updateTaskStatus(ctx, payload.TaskID, entity.TaskStatusIMPLEMENTING); err != nil {
+		return fmt.Errorf("failed to update task status to IMPLEMENTING: %w", err)
+	}
+
+	p.logger.Info("Continuing with comparison winner",
+		"task_id", payload.TaskID,
+		"variant", winner.Variant,
+		"ai_type", winner.AIType)
+
+	handedOff = true
+	go func() {
+		defer unlock()
+		p.finishImplementation(context.WithoutCancel(ctx), project, projectTask, plan, winner, aiExecutor, fallbackStatus)
+	}()
+
+	return nil
+}
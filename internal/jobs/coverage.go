@@ -0,0 +1,142 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+)
+
+// coveragePercentPattern matches the percentages coverage tools print, e.g.
+// "coverage: 71.3% of statements" or "Lines : 80.12% ( 401/500 )"
+var coveragePercentPattern = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*%`)
+
+// measureCoverage runs the project's coverage command in the task worktree
+// and in a throwaway worktree of the base branch, and stores both totals on
+// the execution. Either side is left nil when it could not be measured;
+// coverage is informational and never blocks the pull request.
+func (p *Processor) measureCoverage(ctx context.Context, project *entity.Project, task *entity.Task, execution *entity.Execution) {
+	if project.CoverageCommand == "" || task.WorktreePath == nil || p.verificationRunner == nil {
+		return
+	}
+
+	execution.Coverage = p.worktreeCoverage(ctx, project, task)
+	if execution.Coverage != nil {
+		execution.BaseCoverage = p.baseCoverage(ctx, project, task)
+	}
+
+	p.logger.Info("Coverage measured",
+		"task_id", task.ID,
+		"coverage", formatCoverage(execution.Coverage),
+		"base_coverage", formatCoverage(execution.BaseCoverage))
+
+	if err := p.executionRepo.UpdateCoverage(ctx, execution.ID, execution.Coverage, execution.BaseCoverage); err != nil {
+		p.logger.Error("Failed to save coverage", "error", err, "execution_id", execution.ID)
+	}
+}
+
+// worktreeCoverage measures the coverage of the implementation. As with the
+// lint step, the implementation is committed first so the reports the command
+// writes can be discarded instead of ending up in the pull request.
+func (p *Processor) worktreeCoverage(ctx context.Context, project *entity.Project, task *entity.Task) *float64 {
+	worktreePath := *task.WorktreePath
+
+	separated := false
+	if p.gitManager != nil {
+		if _, err := p.gitManager.CommitAll(ctx, worktreePath, implementationCommitMessage(task)); err != nil {
+			p.logger.Error("Failed to commit implementation before measuring coverage", "error", err, "task_id", task.ID)
+		} else {
+			separated = true
+		}
+	}
+
+	coverage := p.runCoverage(ctx, worktreePath, project.CoverageCommand, task)
+
+	if separated {
+		if err := p.gitManager.DiscardChanges(ctx, worktreePath); err != nil {
+			p.logger.Error("Failed to discard coverage reports", "error", err, "task_id", task.ID)
+		}
+	}
+	return coverage
+}
+
+// baseCoverage measures the coverage of the task's base branch in a detached
+// worktree, prepared with the project's init workspace script like task
+// worktrees are
+func (p *Processor) baseCoverage(ctx context.Context, project *entity.Project, task *entity.Task) *float64 {
+	if task.BaseBranchName == nil || p.gitManager == nil {
+		return nil
+	}
+	worktreePath := *task.WorktreePath
+
+	basePath, err := os.MkdirTemp("", "auto-devs-coverage-")
+	if err != nil {
+		p.logger.Error("Failed to create base coverage directory", "error", err, "task_id", task.ID)
+		return nil
+	}
+	defer os.RemoveAll(basePath)
+
+	if err := p.gitManager.CreateDetachedWorktree(ctx, worktreePath, *task.BaseBranchName, basePath); err != nil {
+		// Worktrees created from the remote branch may not have it locally
+		if err := p.gitManager.CreateDetachedWorktree(ctx, worktreePath, "origin/"+*task.BaseBranchName, basePath); err != nil {
+			p.logger.Error("Failed to check out base branch for coverage", "error", err, "task_id", task.ID)
+			return nil
+		}
+	}
+	defer func() {
+		err := p.gitManager.DeleteWorktree(ctx, &git.DeleteWorktreeRequest{WorkingDir: worktreePath, WorktreePath: basePath})
+		if err != nil {
+			p.logger.Error("Failed to delete base coverage worktree", "error", err, "task_id", task.ID, "path", basePath)
+		}
+	}()
+
+	if project.InitWorkspaceScript != "" {
+		result := p.verificationRunner.Run(ctx, basePath, project.InitWorkspaceScript)
+		if !result.Passed {
+			p.logger.Error("Init workspace script failed in base coverage worktree", "task_id", task.ID, "exit_code", result.ExitCode)
+			return nil
+		}
+	}
+
+	return p.runCoverage(ctx, basePath, project.CoverageCommand, task)
+}
+
+func (p *Processor) runCoverage(ctx context.Context, dir, command string, task *entity.Task) *float64 {
+	result := p.verificationRunner.Run(ctx, dir, command)
+	if !result.Passed {
+		p.logger.Warn("Coverage command failed", "task_id", task.ID, "dir", dir, "exit_code", result.ExitCode)
+		return nil
+	}
+	percent, ok := parseCoveragePercent(result.Output)
+	if !ok {
+		p.logger.Warn("Coverage command printed no percentage", "task_id", task.ID, "dir", dir)
+		return nil
+	}
+	return &percent
+}
+
+// parseCoveragePercent returns the last percentage in output, which is where
+// coverage tools print the total
+func parseCoveragePercent(output string) (float64, bool) {
+	matches := coveragePercentPattern.FindAllStringSubmatch(output, -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+	percent, err := strconv.ParseFloat(matches[len(matches)-1][1], 64)
+	if err != nil || percent > 100 {
+		return 0, false
+	}
+	return percent, true
+}
+
+// formatCoverage renders a coverage percentage for the logs
+func formatCoverage(percent *float64) string {
+	if percent == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f%%", *percent)
+}
@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCoveragePercent(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		percent float64
+		ok      bool
+	}{
+		{"go test", "ok  \tapp/auth\t0.2s\tcoverage: 64.0% of statements\nok  \tapp/api\t0.3s\tcoverage: 71.3% of statements\n", 71.3, true},
+		{"go tool cover", "app/auth/login.go:12:\tLogin\t100.0%\ntotal:\t\t\t(statements)\t82.5%\n", 82.5, true},
+		{"jest text-summary", "Statements   : 80.12% ( 401/500 )\nLines        : 79 % ( 395/500 )\n", 79, true},
+		{"no percentage", "PASS\n", 0, false},
+		{"out of range", "coverage: 250%\n", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percent, ok := parseCoveragePercent(tt.output)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.percent, percent)
+		})
+	}
+}
+
+func TestMeasureCoverage(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	baseBranch := strings.TrimSpace(string(out))
+	out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", "task-branch").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree, BaseBranchName: &baseBranch}
+	execution := &entity.Execution{ID: uuid.New()}
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	executionRepo.EXPECT().UpdateCoverage(mock.Anything, execution.ID, mock.Anything, mock.Anything).Return(nil).Once()
+	processor, _ := newVerificationProcessor(t)
+	processor.gitManager = gitManager
+	processor.executionRepo = executionRepo
+
+	// 10% with the implementation's main.go, 0% on the base branch
+	project := &entity.Project{CoverageCommand: "touch coverage.out; echo \"coverage: $(ls *.go 2>/dev/null | wc -l | tr -d ' ')0% of statements\""}
+	processor.measureCoverage(context.Background(), project, task, execution)
+
+	require.NotNil(t, execution.Coverage)
+	require.NotNil(t, execution.BaseCoverage)
+	assert.Equal(t, 10.0, *execution.Coverage)
+	assert.Equal(t, 0.0, *execution.BaseCoverage)
+	assert.Equal(t, 10.0, *execution.GetCoverageDelta())
+
+	assert.Equal(t, []string{"Implement task: Add main", "initial"}, gitLog(t, worktree))
+	pending, err := gitManager.HasPendingChanges(context.Background(), worktree)
+	require.NoError(t, err)
+	assert.False(t, pending, "coverage reports are discarded")
+	out, err = exec.Command("git", "-C", worktree, "worktree", "list").CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Len(t, strings.Split(strings.TrimSpace(string(out)), "\n"), 1, "the base branch worktree is removed")
+}
@@ -0,0 +1,133 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+)
+
+// processDryRun runs the executor in a throwaway worktree from the task's
+// base branch and attaches the diff it produced to the task. The worktree
+// is removed afterwards; the task's status, worktree and branch are left
+// alone, and nothing is committed or pushed.
+func (p *Processor) processDryRun(ctx context.Context, payload *TaskImplementationPayload) error {
+	// The lock is held until the dry run is finished with
+	unlock, err := p.lockTask(ctx, payload.TaskID)
+	if err != nil {
+		return err
+	}
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			unlock()
+		}
+	}()
+
+	aiExecutor, err := p.getAiExecutor(payload.AIType)
+	if err != nil {
+		return fmt.Errorf("failed to get AI executor: %w", err)
+	}
+	currentTask, err := p.taskUsecase.GetByID(ctx, payload.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	releaseSlot, err := p.acquireProjectSlot(ctx, currentTask, func(delay time.Duration) error {
+		_, err := p.jobRequeuer.EnqueueTaskImplementation(payload, delay)
+		return err
+	})
+	if err != nil || releaseSlot == nil {
+		return err
+	}
+	unlockTask := unlock
+	unlock = func() {
+		releaseSlot()
+		unlockTask()
+	}
+	// The dry run is restricted to the project's command policy
+	currentTask.Project, err = p.projectUsecase.GetByID(ctx, payload.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	plan, err := p.planRepo.GetByTaskID(ctx, payload.TaskID)
+	if err == nil && plan != nil &&
+		(plan.Status == entity.PlanStatusAPPROVED || plan.Status == entity.PlanStatusREVIEWING) {
+		currentTask.Plans = []entity.Plan{*plan}
+	}
+
+	dbExecution := &entity.Execution{Variant: usecase.DryRunVariant, AIType: payload.AIType, DryRun: true}
+	done, err := p.startVariantExecution(ctx, currentTask, dbExecution, aiExecutor)
+	if err != nil {
+		p.removeDryRunWorktree(ctx, currentTask, dbExecution)
+		_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Dry run failed: %s", err.Error()))
+		return fmt.Errorf("failed to start dry run: %w", err)
+	}
+
+	handedOff = true
+	go func() {
+		defer unlock()
+		ctx := context.WithoutCancel(ctx)
+		defer p.removeDryRunWorktree(ctx, currentTask, dbExecution)
+
+		if reason := <-done; reason != "" {
+			_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Dry run failed: %s", reason))
+			return
+		}
+		if err := p.attachDryRunDiff(ctx, currentTask, dbExecution); err != nil {
+			p.logger.Error("Failed to attach dry run diff", "error", err, "task_id", payload.TaskID, "execution_id", dbExecution.ID)
+			_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Failed to attach the dry run diff: %s", err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+// attachDryRunDiff attaches what the dry run changed in its worktree,
+// against the task's base branch, to the task
+func (p *Processor) attachDryRunDiff(ctx context.Context, task *entity.Task, execution *entity.Execution) error {
+	if p.attachmentUsecase == nil {
+		return errors.New("attachments are not available")
+	}
+	baseBranch := "main"
+	if task.BaseBranchName != nil && *task.BaseBranchName != "" {
+		baseBranch = *task.BaseBranchName
+	}
+
+	diff, err := p.gitManager.DiffWorktree(ctx, execution.WorktreePath, baseBranch)
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		p.logger.Info("Dry run made no changes", "task_id", task.ID, "execution_id", execution.ID)
+		return nil
+	}
+
+	filename := usecase.DryRunDiffFilename(execution.ID)
+	if _, err := p.attachmentUsecase.UploadArtifact(ctx, usecase.UploadAttachmentRequest{
+		TaskID:     task.ID,
+		Filename:   filename,
+		Size:       int64(len(diff)),
+		Content:    strings.NewReader(diff),
+		UploadedBy: artifactUploader,
+	}); err != nil {
+		return err
+	}
+
+	p.logger.Info("Attached dry run diff", "task_id", task.ID, "execution_id", execution.ID, "filename", filename)
+	return nil
+}
+
+// removeDryRunWorktree removes the dry run's throwaway worktree and branch,
+// if it got that far
+func (p *Processor) removeDryRunWorktree(ctx context.Context, task *entity.Task, execution *entity.Execution) {
+	if execution.WorktreePath == "" {
+		return
+	}
+	if err := p.worktreeUsecase.RemoveVariantWorktree(ctx, task.ProjectID, execution.WorktreePath, execution.BranchName); err != nil {
+		p.logger.Warn("Failed to remove dry run worktree", "task_id", task.ID, "worktree_path", execution.WorktreePath, "error", err)
+	}
+}
@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachDryRunDiff(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	base := strings.TrimSpace(string(out))
+
+	task := &entity.Task{ID: uuid.New(), BaseBranchName: &base}
+	execution := &entity.Execution{ID: uuid.New(), WorktreePath: worktree, DryRun: true}
+	attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+	var uploaded string
+	attachmentUsecase.EXPECT().UploadArtifact(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req usecase.UploadAttachmentRequest) (*entity.TaskAttachment, error) {
+		assert.Equal(t, task.ID, req.TaskID)
+		assert.Equal(t, usecase.DryRunDiffFilename(execution.ID), req.Filename)
+		content, err := io.ReadAll(req.Content)
+		require.NoError(t, err)
+		uploaded = string(content)
+		return &entity.TaskAttachment{ID: uuid.New(), TaskID: req.TaskID, Filename: req.Filename}, nil
+	}).Once()
+	processor := &Processor{gitManager: gitManager, attachmentUsecase: attachmentUsecase, logger: slog.Default()}
+
+	require.NoError(t, processor.attachDryRunDiff(context.Background(), task, execution))
+
+	assert.Contains(t, uploaded, "+package main", "the untracked file the run wrote")
+	assert.Equal(t, []string{"initial"}, gitLog(t, worktree), "nothing is committed")
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// ProcessEmailDigest emails every project with email notifications on the
+// task movement of the previous day, in UTC. A project whose digest fails
+// is logged and skipped; the job is not retried, so no project gets its
+// digest twice.
+func (p *Processor) ProcessEmailDigest(ctx context.Context, task *asynq.Task) error {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	from := to.AddDate(0, 0, -1)
+	p.logger.Info("Processing email digest job", "day", from.Format("2006-01-02"))
+
+	sent, err := p.emailUsecase.SendDigests(ctx, from, to)
+	if err != nil {
+		if sent == 0 {
+			return fmt.Errorf("failed to send email digests: %v: %w", err, asynq.SkipRetry)
+		}
+		p.logger.Warn("Failed to send some email digests", "error", err)
+	}
+
+	p.logger.Info("Completed email digest job", "sent", sent)
+	return nil
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+)
+
+// enqueueBackoff is how long enqueueing waits before each retry, riding out
+// a Redis restart or failover of a few seconds
+var enqueueBackoff = []time.Duration{250 * time.Millisecond, 750 * time.Millisecond, 2 * time.Second}
+
+// enqueue enqueues task, retrying while Redis cannot be reached
+func (c *Client) enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+	return retryEnqueue(task.Type(), func() (*asynq.TaskInfo, error) {
+		return c.client.Enqueue(task, opts...)
+	}, time.Sleep)
+}
+
+// retryEnqueue calls enqueue until it succeeds, sleeping enqueueBackoff
+// between the attempts. A job refused as a duplicate is not retried. When
+// every attempt failed, the error wraps usecase.ErrJobQueueUnavailable.
+func retryEnqueue(jobType string, enqueue func() (*asynq.TaskInfo, error), sleep func(time.Duration)) (*asynq.TaskInfo, error) {
+	info, err := enqueue()
+	for _, delay := range enqueueBackoff {
+		if err == nil || !retryableEnqueueError(err) {
+			return info, err
+		}
+		slog.Warn("Failed to enqueue job, retrying", "task_type", jobType, "retry_in", delay, "error", err)
+		sleep(delay)
+		info, err = enqueue()
+	}
+	if err != nil && retryableEnqueueError(err) {
+		return nil, fmt.Errorf("%w: %v", usecase.ErrJobQueueUnavailable, err)
+	}
+	return info, err
+}
+
+func retryableEnqueueError(err error) bool {
+	return !errors.Is(err, asynq.ErrDuplicateTask) && !errors.Is(err, asynq.ErrTaskIDConflict)
+}
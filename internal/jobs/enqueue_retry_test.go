@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyEnqueue fails with the errors given, then succeeds
+func flakyEnqueue(errs ...error) (func() (*asynq.TaskInfo, error), *int) {
+	calls := 0
+	return func() (*asynq.TaskInfo, error) {
+		calls++
+		if calls <= len(errs) {
+			return nil, errs[calls-1]
+		}
+		return &asynq.TaskInfo{ID: "job-1"}, nil
+	}, &calls
+}
+
+func TestRetryEnqueue(t *testing.T) {
+	redisDown := errors.New("dial tcp 127.0.0.1:6379: connect: connection refused")
+
+	t.Run("retries with backoff until Redis is back", func(t *testing.T) {
+		enqueue, calls := flakyEnqueue(redisDown, redisDown)
+		var slept []time.Duration
+
+		info, err := retryEnqueue(TypeTaskPlanning, enqueue, func(d time.Duration) { slept = append(slept, d) })
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", info.ID)
+		assert.Equal(t, 3, *calls)
+		assert.Equal(t, enqueueBackoff[:2], slept)
+	})
+
+	t.Run("reports the queue unavailable after the last attempt", func(t *testing.T) {
+		enqueue, calls := flakyEnqueue(redisDown, redisDown, redisDown, redisDown)
+
+		_, err := retryEnqueue(TypeTaskPlanning, enqueue, func(time.Duration) {})
+		assert.ErrorIs(t, err, usecase.ErrJobQueueUnavailable)
+		assert.Equal(t, len(enqueueBackoff)+1, *calls)
+	})
+
+	t.Run("does not retry a duplicate job", func(t *testing.T) {
+		enqueue, calls := flakyEnqueue(asynq.ErrDuplicateTask)
+
+		_, err := retryEnqueue(TypeTaskPlanning, enqueue, func(time.Duration) { t.Fatal("should not sleep") })
+		assert.ErrorIs(t, err, asynq.ErrDuplicateTask)
+		assert.NotErrorIs(t, err, usecase.ErrJobQueueUnavailable)
+		assert.Equal(t, 1, *calls)
+	})
+}
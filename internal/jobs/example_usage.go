@@ -92,7 +92,7 @@ func ExampleJobProcessing() {
 	}
 
 	// 3. Create server
-	server := NewServer(redisAddr, redisPassword, redisDB, processor)
+	server := NewServer(redisAddr, redisPassword, redisDB, processor, ServerOptions{})
 
 	// 4. Register handlers
 	server.RegisterHandlers()
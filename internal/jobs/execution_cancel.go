@@ -0,0 +1,131 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// runningExecutions are the planning and implementation executions a
+// worker runs, by their database ID, so they can be cancelled
+type runningExecutions struct {
+	mu         sync.Mutex
+	executions map[uuid.UUID]runningExecution
+}
+
+// runningExecution is an AI execution the worker runs
+type runningExecution struct {
+	execution *ai.Execution
+	// fallbackStatus is the status the task goes back to when the
+	// execution is cancelled
+	fallbackStatus entity.TaskStatus
+}
+
+// trackExecution records that the worker runs the execution saved as id
+func (p *Processor) trackExecution(id uuid.UUID, execution *ai.Execution, fallbackStatus entity.TaskStatus) {
+	p.running.mu.Lock()
+	defer p.running.mu.Unlock()
+	if p.running.executions == nil {
+		p.running.executions = make(map[uuid.UUID]runningExecution)
+	}
+	p.running.executions[id] = runningExecution{execution: execution, fallbackStatus: fallbackStatus}
+}
+
+// untrackExecution forgets the execution saved as id once it is finished
+// with
+func (p *Processor) untrackExecution(id uuid.UUID) {
+	p.running.mu.Lock()
+	defer p.running.mu.Unlock()
+	delete(p.running.executions, id)
+}
+
+func (p *Processor) runningExecution(id uuid.UUID) (runningExecution, bool) {
+	p.running.mu.Lock()
+	defer p.running.mu.Unlock()
+	running, ok := p.running.executions[id]
+	return running, ok
+}
+
+// ProcessExecutionCancel stops an execution: it kills the AI CLI process
+// when this worker runs it, marks the execution CANCELLED and puts its task
+// back to where the execution can be started again. An execution that
+// finished in the meantime is left as it is.
+func (p *Processor) ProcessExecutionCancel(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseExecutionCancelPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse execution cancel payload: %w", err)
+	}
+
+	execution, err := p.executionRepo.GetByID(ctx, payload.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("failed to get execution: %w", err)
+	}
+	if execution.IsCompleted() {
+		p.logger.Info("Execution already finished, nothing to cancel", "execution_id", execution.ID, "status", execution.Status)
+		return nil
+	}
+
+	running, isRunning := p.runningExecution(execution.ID)
+	if isRunning {
+		// The goroutine watching the execution sees it cancelled and leaves
+		// the rest to this job
+		if err := p.executionService.CancelExecution(running.execution.ID); err != nil {
+			p.logger.Info("Execution finished before it could be cancelled", "execution_id", execution.ID, "error", err)
+			return nil
+		}
+	}
+
+	cancelled, err := p.executionRepo.MarkCancelled(ctx, execution.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return nil
+	}
+	p.logger.Info("Execution cancelled", "execution_id", execution.ID, "task_id", execution.TaskID, "killed_process", isRunning)
+	p.notifyExecutionCancelled(ctx, execution)
+
+	if !isRunning {
+		p.resetTask(ctx, execution.TaskID, "The execution was cancelled")
+		return nil
+	}
+	if err := p.updateTaskStatus(ctx, execution.TaskID, running.fallbackStatus); err != nil {
+		return nil
+	}
+	_ = p.taskUsecase.AppendErrorLog(ctx, execution.TaskID, fmt.Sprintf("The execution was cancelled; the task was moved back to %s", running.fallbackStatus))
+	return nil
+}
+
+// notifyExecutionCancelled best-effort tells the project's clients the
+// execution was cancelled
+func (p *Processor) notifyExecutionCancelled(ctx context.Context, execution *entity.Execution) {
+	task, err := p.taskUsecase.GetByID(ctx, execution.TaskID)
+	if err != nil {
+		p.logger.Warn("Failed to get task of cancelled execution", "task_id", execution.TaskID, "error", err)
+		return
+	}
+
+	if p.redisBroker != nil {
+		if err := p.redisBroker.PublishStatusChanged(task.ID, task.ProjectID,
+			"execution", string(execution.Status), string(entity.ExecutionStatusCancelled)); err != nil {
+			p.logger.Warn("Failed to publish execution status via Redis broker",
+				"execution_id", execution.ID, "error", err)
+		} else {
+			return
+		}
+	}
+
+	if p.wsService != nil {
+		if err := p.wsService.NotifyStatusChanged(task.ID, task.ProjectID,
+			"execution", string(execution.Status), string(entity.ExecutionStatusCancelled)); err != nil {
+			p.logger.Warn("Failed to notify execution status via WebSocket",
+				"execution_id", execution.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessExecutionCancel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("marks an execution waiting for input cancelled", func(t *testing.T) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusCODEREVIEWING}
+		execution := &entity.Execution{ID: uuid.New(), TaskID: task.ID, Status: entity.ExecutionStatusWaitingInput}
+
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		executionRepo.EXPECT().GetByID(ctx, execution.ID).Return(execution, nil).Once()
+		executionRepo.EXPECT().MarkCancelled(ctx, execution.ID, mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+		executionRepo.EXPECT().GetByTaskID(ctx, task.ID).Return([]*entity.Execution{
+			{TaskID: task.ID, Status: entity.ExecutionStatusCancelled},
+		}, nil).Once()
+
+		// The task was moved on by hand in the meantime, and is left there
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+
+		processor := &Processor{executionRepo: executionRepo, taskUsecase: taskUsecase, logger: slog.Default()}
+		jobTask, err := NewExecutionCancelTask(ExecutionCancelPayload{ExecutionID: execution.ID})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessExecutionCancel(ctx, jobTask))
+	})
+
+	t.Run("leaves a finished execution alone", func(t *testing.T) {
+		execution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New(), Status: entity.ExecutionStatusCompleted}
+
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		executionRepo.EXPECT().GetByID(ctx, execution.ID).Return(execution, nil).Once()
+
+		processor := &Processor{executionRepo: executionRepo, logger: slog.Default()}
+		jobTask, err := NewExecutionCancelTask(ExecutionCancelPayload{ExecutionID: execution.ID})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessExecutionCancel(ctx, jobTask))
+	})
+}
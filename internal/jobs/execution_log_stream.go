@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+)
+
+// publishExecutionLogs best-effort sends the log lines an execution just
+// printed to the clients of the task's project, so they can tail its
+// output. Projects encrypting their execution logs get the lines without
+// their message.
+func (p *Processor) publishExecutionLogs(task *entity.Task, executionID uuid.UUID, logs []*entity.ExecutionLog) {
+	if len(logs) == 0 {
+		return
+	}
+	lines := streamedLogs(task.Project, logs)
+
+	if p.redisBroker != nil {
+		if err := p.redisBroker.PublishExecutionLog(executionID, task.ID, task.ProjectID, lines); err != nil {
+			p.logger.Warn("Failed to publish execution logs via Redis broker",
+				"execution_id", executionID, "error", err)
+		} else {
+			return
+		}
+	}
+
+	if p.wsService != nil {
+		if err := p.wsService.NotifyExecutionLog(executionID, task.ID, task.ProjectID, lines); err != nil {
+			p.logger.Warn("Failed to send execution logs via WebSocket",
+				"execution_id", executionID, "error", err)
+		}
+	}
+}
+
+// streamedLogs returns the logs as the API returns them, without their
+// message and parsed content when project encrypts its execution logs
+func streamedLogs(project *entity.Project, logs []*entity.ExecutionLog) []dto.ExecutionLogResponse {
+	encrypts := project != nil && project.EncryptExecutionLogs
+	lines := make([]dto.ExecutionLogResponse, len(logs))
+	for i, log := range logs {
+		lines[i] = dto.ToExecutionLogResponse(log)
+		if encrypts {
+			lines[i].Message = ""
+			lines[i].ParsedContent = nil
+			lines[i].Encrypted = true
+		}
+	}
+	return lines
+}
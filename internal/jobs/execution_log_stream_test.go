@@ -0,0 +1,27 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamedLogs(t *testing.T) {
+	logs := []*entity.ExecutionLog{{Line: 1, Level: entity.LogLevelInfo, Message: "Reading main.go", ParsedContent: entity.JSONB{"type": "assistant"}}}
+
+	lines := streamedLogs(&entity.Project{}, logs)
+	require.Len(t, lines, 1)
+	assert.Equal(t, 1, lines[0].Line)
+	assert.Equal(t, "Reading main.go", lines[0].Message)
+	assert.NotNil(t, lines[0].ParsedContent)
+
+	withheld := streamedLogs(&entity.Project{EncryptExecutionLogs: true}, logs)
+	require.Len(t, withheld, 1)
+	assert.Equal(t, 1, withheld[0].Line)
+	assert.Empty(t, withheld[0].Message)
+	assert.Nil(t, withheld[0].ParsedContent)
+	assert.True(t, withheld[0].Encrypted)
+	assert.Equal(t, "Reading main.go", logs[0].Message, "the saved logs are left as they are")
+}
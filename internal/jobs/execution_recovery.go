@@ -0,0 +1,153 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	// executionHeartbeatInterval is how often a worker reports the
+	// executions it runs alive
+	executionHeartbeatInterval = 30 * time.Second
+	// executionStaleAfter is how long an execution goes unreported before
+	// its worker is taken for crashed
+	executionStaleAfter = 3 * time.Minute
+)
+
+// WorkerID identifies the worker named name on this host. A worker
+// restarted under the same name takes the executions it left running for
+// orphaned, so names must be unique per host.
+func WorkerID(name string) string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return name + "@" + host
+}
+
+// SetWorker sets the worker, as returned by WorkerID, recorded on the
+// executions the processor starts
+func (p *Processor) SetWorker(worker string) {
+	p.worker = worker
+}
+
+// RecoverOrphanedExecutions fails the executions the worker left running
+// before it restarted and those no worker reported alive lately, and puts
+// their tasks back to where the execution can be started again. It returns
+// how many executions were recovered.
+func (p *Processor) RecoverOrphanedExecutions(ctx context.Context) (int, error) {
+	return p.recoverOrphanedExecutions(ctx, p.worker)
+}
+
+// RunHeartbeat reports the worker's executions alive until ctx is done,
+// and recovers those other workers stopped reporting
+func (p *Processor) RunHeartbeat(ctx context.Context) {
+	ticker := time.NewTicker(executionHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := p.executionRepo.Heartbeat(ctx, p.worker, now); err != nil {
+				p.logger.Error("Failed to report executions alive", "error", err, "worker", p.worker)
+			}
+			if _, err := p.recoverOrphanedExecutions(ctx, ""); err != nil {
+				p.logger.Error("Failed to recover orphaned executions", "error", err)
+			}
+		}
+	}
+}
+
+// recoverOrphanedExecutions recovers the active executions of worker, when
+// set, and those not reported alive for executionStaleAfter
+func (p *Processor) recoverOrphanedExecutions(ctx context.Context, worker string) (int, error) {
+	now := time.Now()
+	orphaned, err := p.executionRepo.GetOrphaned(ctx, worker, now.Add(-executionStaleAfter))
+	if err != nil {
+		return 0, err
+	}
+
+	var taskIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	recovered := 0
+	for _, execution := range orphaned {
+		reason := "The worker running the execution stopped"
+		if execution.Worker != "" {
+			reason = fmt.Sprintf("The worker running the execution (%s) stopped", execution.Worker)
+		}
+		if err := p.executionRepo.MarkFailed(ctx, execution.ID, now, reason); err != nil {
+			p.logger.Error("Failed to fail orphaned execution", "error", err, "execution_id", execution.ID)
+			continue
+		}
+		p.logger.Warn("Failed orphaned execution", "execution_id", execution.ID, "task_id", execution.TaskID, "worker", execution.Worker)
+		recovered++
+		if !seen[execution.TaskID] {
+			seen[execution.TaskID] = true
+			taskIDs = append(taskIDs, execution.TaskID)
+		}
+	}
+
+	for _, taskID := range taskIDs {
+		p.resetTask(ctx, taskID, "The worker stopped")
+	}
+	return recovered, nil
+}
+
+// resetTask puts a task whose execution was orphaned or cancelled back to
+// where it can be started again, unless another of its executions still
+// runs. The note left on the task starts with cause.
+func (p *Processor) resetTask(ctx context.Context, taskID uuid.UUID, cause string) {
+	executions, err := p.executionRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		p.logger.Error("Failed to get task executions", "error", err, "task_id", taskID)
+		return
+	}
+	for _, execution := range executions {
+		if !execution.IsCompleted() {
+			return
+		}
+	}
+
+	task, err := p.taskUsecase.GetByID(ctx, taskID)
+	if err != nil {
+		p.logger.Error("Failed to get task of stopped execution", "error", err, "task_id", taskID)
+		return
+	}
+	var plan *entity.Plan
+	if task.Status == entity.TaskStatusIMPLEMENTING {
+		// A missing plan sends the task back to TODO
+		plan, _ = p.planRepo.GetByTaskID(ctx, taskID)
+	}
+	status, ok := orphanedTaskStatus(task, plan)
+	if !ok {
+		return
+	}
+
+	if err := p.updateTaskStatus(ctx, taskID, status); err != nil {
+		return
+	}
+	_ = p.taskUsecase.AppendErrorLog(ctx, taskID, fmt.Sprintf("%s during %s; the task was moved back to %s", cause, task.Status, status))
+}
+
+// orphanedTaskStatus returns the status a task goes back to once the
+// execution it was planned or implemented by is orphaned, and false when the
+// task has moved on since
+func orphanedTaskStatus(task *entity.Task, plan *entity.Plan) (entity.TaskStatus, bool) {
+	switch task.Status {
+	case entity.TaskStatusPLANNING:
+		return entity.TaskStatusTODO, true
+	case entity.TaskStatusIMPLEMENTING:
+		if plan != nil && (plan.Status == entity.PlanStatusAPPROVED || plan.Status == entity.PlanStatusREVIEWING) {
+			return entity.TaskStatusPLANREVIEWING, true
+		}
+		return entity.TaskStatusTODO, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanedTaskStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status entity.TaskStatus
+		plan   *entity.Plan
+		want   entity.TaskStatus
+		reset  bool
+	}{
+		{name: "planning", status: entity.TaskStatusPLANNING, want: entity.TaskStatusTODO, reset: true},
+		{name: "implementing an approved plan", status: entity.TaskStatusIMPLEMENTING, plan: &entity.Plan{Status: entity.PlanStatusAPPROVED}, want: entity.TaskStatusPLANREVIEWING, reset: true},
+		{name: "implementing without a plan", status: entity.TaskStatusIMPLEMENTING, want: entity.TaskStatusTODO, reset: true},
+		{name: "implementing after a rejected plan", status: entity.TaskStatusIMPLEMENTING, plan: &entity.Plan{Status: entity.PlanStatusREJECTED}, want: entity.TaskStatusTODO, reset: true},
+		{name: "moved on", status: entity.TaskStatusCODEREVIEWING},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reset := orphanedTaskStatus(&entity.Task{Status: tt.status}, tt.plan)
+			assert.Equal(t, tt.reset, reset)
+			assert.Equal(t, tt.want, status)
+		})
+	}
+}
+
+func TestRecoverOrphanedExecutions(t *testing.T) {
+	ctx := context.Background()
+	comparedTaskID := uuid.New()
+	reviewedTaskID := uuid.New()
+	orphaned := []*entity.Execution{
+		{ID: uuid.New(), TaskID: comparedTaskID, Worker: "impl-1@host-a", Status: entity.ExecutionStatusRunning},
+		{ID: uuid.New(), TaskID: reviewedTaskID, Status: entity.ExecutionStatusPending},
+	}
+
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	executionRepo.EXPECT().GetOrphaned(ctx, "impl-1@host-a", mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(_ context.Context, _ string, staleBefore time.Time) ([]*entity.Execution, error) {
+			assert.WithinDuration(t, time.Now().Add(-executionStaleAfter), staleBefore, time.Second)
+			return orphaned, nil
+		}).Once()
+	executionRepo.EXPECT().MarkFailed(ctx, orphaned[0].ID, mock.AnythingOfType("time.Time"), "The worker running the execution (impl-1@host-a) stopped").Return(nil).Once()
+	executionRepo.EXPECT().MarkFailed(ctx, orphaned[1].ID, mock.AnythingOfType("time.Time"), "The worker running the execution stopped").Return(nil).Once()
+	// The other side of the comparison is still running on another worker
+	executionRepo.EXPECT().GetByTaskID(ctx, comparedTaskID).Return([]*entity.Execution{
+		{TaskID: comparedTaskID, Status: entity.ExecutionStatusFailed},
+		{TaskID: comparedTaskID, Status: entity.ExecutionStatusRunning},
+	}, nil).Once()
+	executionRepo.EXPECT().GetByTaskID(ctx, reviewedTaskID).Return([]*entity.Execution{
+		{TaskID: reviewedTaskID, Status: entity.ExecutionStatusFailed},
+	}, nil).Once()
+
+	// The task was moved on by hand in the meantime
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(ctx, reviewedTaskID).Return(&entity.Task{ID: reviewedTaskID, Status: entity.TaskStatusCODEREVIEWING}, nil).Once()
+
+	processor := &Processor{executionRepo: executionRepo, taskUsecase: taskUsecase, worker: "impl-1@host-a", logger: slog.Default()}
+	recovered, err := processor.RecoverOrphanedExecutions(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, recovered)
+}
+
+func TestWorkerID(t *testing.T) {
+	assert.Regexp(t, `^impl-1@.+$`, WorkerID("impl-1"))
+}
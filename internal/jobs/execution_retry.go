@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// maxRetryDelay caps the backoff between the attempts of an execution
+const maxRetryDelay = time.Hour
+
+// executionRetryPolicy is how many times a failed planning or
+// implementation execution is run in all, and the backoff before its first
+// retry, doubled for each further one
+type executionRetryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// SetRetryPolicy has failed planning and implementation executions retried
+// until they ran maxAttempts times. The first retry waits backoff, each
+// further one twice as long as the previous, up to an hour. One attempt or
+// less turns automatic retries off. It must be set before the worker starts
+// processing jobs.
+func (p *Processor) SetRetryPolicy(maxAttempts int, backoff time.Duration) {
+	p.retryPolicy = executionRetryPolicy{maxAttempts: maxAttempts, backoff: backoff}
+}
+
+// retryDelay is how long the retry of the failed attempt waits
+func (r executionRetryPolicy) retryDelay(attempt int) time.Duration {
+	delay := r.backoff
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
+
+// retryFailedExecution enqueues the next attempt of the execution that just
+// failed, unless it used up its attempts
+func (p *Processor) retryFailedExecution(ctx context.Context, execution *entity.Execution) {
+	attempt := execution.CurrentAttempt()
+	if attempt >= p.retryPolicy.maxAttempts {
+		return
+	}
+
+	delay := p.retryPolicy.retryDelay(attempt)
+	_, jobID, err := p.taskUsecase.RetryExecution(ctx, execution.ID, delay)
+	if err != nil {
+		p.logger.Warn("Failed to retry execution", "execution_id", execution.ID, "task_id", execution.TaskID, "error", err)
+		return
+	}
+	p.logger.Info("Execution retry enqueued", "execution_id", execution.ID, "task_id", execution.TaskID,
+		"attempt", attempt+1, "max_attempts", p.retryPolicy.maxAttempts, "retry_in", delay, "job_id", jobID)
+	_ = p.taskUsecase.AppendErrorLog(ctx, execution.TaskID,
+		fmt.Sprintf("Retrying in %s as attempt %d of %d", delay, attempt+1, p.retryPolicy.maxAttempts))
+}
+
+// staleRetry reports whether a retry job of phase is stale: the failed
+// execution was retried some other way, or its task moved on from status
+func (p *Processor) staleRetry(ctx context.Context, retryOfID uuid.UUID, phase entity.ExecutionPhase, status entity.TaskStatus) bool {
+	if !usecase.CanRetryExecution(phase, status) {
+		p.logger.Info("Skipping execution retry, the task moved on", "execution_id", retryOfID, "status", status)
+		return true
+	}
+	retries, err := p.executionRepo.GetRetries(ctx, retryOfID)
+	if err != nil {
+		p.logger.Warn("Failed to get execution retries", "execution_id", retryOfID, "error", err)
+		return false
+	}
+	if len(retries) > 0 {
+		p.logger.Info("Skipping execution retry, it was already retried", "execution_id", retryOfID, "retry_id", retries[0].ID)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRetryPolicy_RetryDelay(t *testing.T) {
+	policy := executionRetryPolicy{maxAttempts: 20, backoff: 30 * time.Second}
+
+	assert.Equal(t, 30*time.Second, policy.retryDelay(1))
+	assert.Equal(t, time.Minute, policy.retryDelay(2))
+	assert.Equal(t, 2*time.Minute, policy.retryDelay(3))
+	assert.Equal(t, maxRetryDelay, policy.retryDelay(15), "the backoff is capped")
+}
+
+func TestRetryFailedExecution(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enqueues the next attempt after the backoff", func(t *testing.T) {
+		execution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New(), Phase: entity.ExecutionPhaseImplementation, Attempt: 2}
+
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().RetryExecution(ctx, execution.ID, 2*time.Minute).Return(execution, "job-1", nil).Once()
+		taskUsecase.EXPECT().AppendErrorLog(ctx, execution.TaskID, "Retrying in 2m0s as attempt 3 of 3").Return(nil).Once()
+
+		processor := &Processor{taskUsecase: taskUsecase, logger: slog.Default()}
+		processor.SetRetryPolicy(3, time.Minute)
+		processor.retryFailedExecution(ctx, execution)
+	})
+
+	t.Run("stops once the attempts are used up", func(t *testing.T) {
+		execution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New(), Phase: entity.ExecutionPhasePlanning, Attempt: 3}
+
+		processor := &Processor{taskUsecase: usecase.NewTaskUsecaseMock(t), logger: slog.Default()}
+		processor.SetRetryPolicy(3, time.Minute)
+		processor.retryFailedExecution(ctx, execution)
+	})
+
+	t.Run("is off by default", func(t *testing.T) {
+		execution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New(), Phase: entity.ExecutionPhasePlanning}
+
+		processor := &Processor{taskUsecase: usecase.NewTaskUsecaseMock(t), logger: slog.Default()}
+		processor.retryFailedExecution(ctx, execution)
+	})
+}
+
+func TestProcessTaskPlanning_SkipsStaleRetry(t *testing.T) {
+	ctx := context.Background()
+	failedID := uuid.New()
+
+	t.Run("when the task moved on", func(t *testing.T) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusPLANREVIEWING}
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+
+		processor := &Processor{taskUsecase: taskUsecase, logger: slog.Default()}
+		jobTask, err := NewTaskPlanningTask(TaskPlanningPayload{TaskID: task.ID, ProjectID: task.ProjectID, RetryOfID: &failedID, Attempt: 2})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessTaskPlanning(ctx, jobTask))
+	})
+
+	t.Run("when the failed execution was already retried", func(t *testing.T) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusTODO}
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		executionRepo.EXPECT().GetRetries(mock.Anything, failedID).Return([]*entity.Execution{{ID: uuid.New(), RetryOfID: &failedID}}, nil).Once()
+
+		processor := &Processor{taskUsecase: taskUsecase, executionRepo: executionRepo, logger: slog.Default()}
+		jobTask, err := NewTaskPlanningTask(TaskPlanningPayload{TaskID: task.ID, ProjectID: task.ProjectID, RetryOfID: &failedID, Attempt: 2})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessTaskPlanning(ctx, jobTask))
+	})
+}
@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+)
+
+// SetMaxConcurrentExecutions limits how many AI executions the worker runs
+// at once. Each one spawns a CLI process heavy on CPU and IO, so jobs
+// starting an execution wait for a free slot. Zero or less lifts the
+// limit. It must be set before the worker starts processing jobs.
+func (p *Processor) SetMaxConcurrentExecutions(max int) {
+	if max <= 0 {
+		p.executionSlots = nil
+		return
+	}
+	p.executionSlots = make(chan struct{}, max)
+}
+
+// acquireExecutionSlot waits for a free execution slot and returns the
+// function freeing it again, which may be called more than once. It fails
+// when ctx is done first.
+func (p *Processor) acquireExecutionSlot(ctx context.Context) (func(), error) {
+	if p.executionSlots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case p.executionSlots <- struct{}{}:
+	default:
+		p.logger.Info("Waiting for a free execution slot", "max_concurrent_executions", cap(p.executionSlots))
+		select {
+		case p.executionSlots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no free execution slot: %w", ctx.Err())
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-p.executionSlots })
+	}, nil
+}
+
+// releaseWhenDone frees an execution slot once execution is done
+func releaseWhenDone(execution *ai.Execution, release func()) {
+	go func() {
+		<-execution.GetContextDoneChannel()
+		release()
+	}()
+}
@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireExecutionSlot_Unlimited(t *testing.T) {
+	processor := &Processor{logger: slog.Default()}
+
+	for i := 0; i < 10; i++ {
+		_, err := processor.acquireExecutionSlot(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func TestAcquireExecutionSlot_WaitsForFreeSlot(t *testing.T) {
+	processor := &Processor{logger: slog.Default()}
+	processor.SetMaxConcurrentExecutions(1)
+
+	release, err := processor.acquireExecutionSlot(context.Background())
+	require.NoError(t, err)
+
+	acquired := make(chan struct{})
+	go func() {
+		if _, err := processor.acquireExecutionSlot(context.Background()); err == nil {
+			close(acquired)
+		}
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second execution started while the only slot was taken")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+	release() // Releasing twice frees the slot once
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("second execution did not start once the slot was freed")
+	}
+	assert.Len(t, processor.executionSlots, 1)
+}
+
+func TestAcquireExecutionSlot_ContextDone(t *testing.T) {
+	processor := &Processor{logger: slog.Default()}
+	processor.SetMaxConcurrentExecutions(1)
+	_, err := processor.acquireExecutionSlot(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = processor.acquireExecutionSlot(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+)
+
+// securityLogLine numbers the security entries in an execution's logs, apart
+// from the lines of its output
+const securityLogLine = -1
+
+// recordFileAccessViolation adds a security entry to the logs of dbExecution
+// when execution, one of its AI runs, was stopped for touching a file outside
+// the worktree
+func (p *Processor) recordFileAccessViolation(ctx context.Context, dbExecution *entity.Execution, execution *ai.Execution) {
+	violation := execution.Violation
+	if violation == nil || dbExecution == nil {
+		return
+	}
+
+	p.logger.Warn("AI execution stopped for file access outside the worktree",
+		"execution_id", dbExecution.ID,
+		"task_id", dbExecution.TaskID,
+		"tool", violation.Tool,
+		"path", violation.Path)
+
+	entry := &entity.ExecutionLog{
+		ExecutionID: dbExecution.ID,
+		Level:       entity.LogLevelError,
+		Message:     "Execution stopped by the filesystem guard: " + violation.Error(),
+		Source:      "security",
+		LogType:     "security",
+		Metadata: entity.JSONB{
+			"tool":     violation.Tool,
+			"path":     violation.Path,
+			"worktree": execution.WorkingDir,
+		},
+		Line: securityLogLine,
+	}
+	if err := p.executionLogRepo.Create(ctx, entry); err != nil {
+		p.logger.Error("Failed to save file access violation", "error", err, "execution_id", dbExecution.ID)
+	}
+}
@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordFileAccessViolation(t *testing.T) {
+	logRepo := repository.NewExecutionLogRepositoryMock(t)
+	processor := &Processor{executionLogRepo: logRepo, logger: slog.Default()}
+	dbExecution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New()}
+
+	// Executions stopped for anything else leave no security entry
+	processor.recordFileAccessViolation(context.Background(), dbExecution, &ai.Execution{Error: "exit code 1"})
+
+	var saved *entity.ExecutionLog
+	logRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, log *entity.ExecutionLog) error {
+		saved = log
+		return nil
+	})
+	execution := &ai.Execution{WorkingDir: "/worktrees/task", Violation: &ai.FileAccessViolation{Tool: "Write", Path: "/etc/hosts"}}
+	processor.recordFileAccessViolation(context.Background(), dbExecution, execution)
+
+	require.NotNil(t, saved)
+	assert.Equal(t, dbExecution.ID, saved.ExecutionID)
+	assert.Equal(t, entity.LogLevelError, saved.Level)
+	assert.Equal(t, "security", saved.Source)
+	assert.Equal(t, securityLogLine, saved.Line)
+	assert.Contains(t, saved.Message, "Write tried to access /etc/hosts outside the worktree")
+	assert.Equal(t, "/worktrees/task", saved.Metadata["worktree"])
+}
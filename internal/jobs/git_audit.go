@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// operationScopePayload holds the IDs job payloads share
+type operationScopePayload struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	TaskID      uuid.UUID `json:"task_id"`
+	ExecutionID uuid.UUID `json:"execution_id"`
+}
+
+// gitOperationScope records the job, and the project, task and execution
+// its payload names, with the git operations its handler does
+func gitOperationScope(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		var payload operationScopePayload
+		_ = json.Unmarshal(task.Payload(), &payload)
+		ctx = git.WithOperationScope(ctx, git.OperationScope{
+			ProjectID:   payload.ProjectID,
+			TaskID:      payload.TaskID,
+			ExecutionID: payload.ExecutionID,
+			Actor:       "job:" + task.Type(),
+		})
+		return next.ProcessTask(ctx, task)
+	})
+}
@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+)
+
+// flagHighRiskPlan marks the task high-risk when its plan touches paths
+// matching the project's high-risk paths, so the plan needs step-up
+// approval. Tasks already high-risk are left as they are.
+func (p *Processor) flagHighRiskPlan(ctx context.Context, taskID uuid.UUID, planContent string) error {
+	task, err := p.taskUsecase.GetByID(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.HighRisk {
+		return nil
+	}
+	project, err := p.projectUsecase.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	paths := entity.HighRiskPathsIn(planContent, project.HighRiskPathPatterns())
+	if len(paths) == 0 {
+		return nil
+	}
+
+	reason := entity.HighRiskReason(paths)
+	if _, err := p.taskUsecase.Update(ctx, taskID, usecase.UpdateTaskRequest{HighRiskReason: &reason}); err != nil {
+		return fmt.Errorf("failed to mark task high-risk: %w", err)
+	}
+	p.logger.Info("Task marked high-risk, its plan needs step-up approval", "task_id", taskID, "reason", reason)
+	return nil
+}
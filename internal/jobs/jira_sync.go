@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/service/jira"
+	"github.com/hibiken/asynq"
+)
+
+// ProcessJiraSync pushes a task's new status back to its Jira issue as a
+// workflow transition. Workflows without a transition into the mapped status
+// will not grow one on retry, so that case is not retried.
+func (p *Processor) ProcessJiraSync(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseJiraSyncPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse jira sync payload: %w", err)
+	}
+
+	p.logger.Info("Processing jira sync job",
+		"task_id", payload.TaskID,
+		"new_status", payload.NewStatus,
+	)
+
+	if p.jiraUsecase == nil {
+		p.logger.Warn("Jira usecase not configured, skipping sync job", "task_id", payload.TaskID)
+		return nil
+	}
+
+	if err := p.jiraUsecase.SyncTaskStatus(ctx, payload.TaskID, payload.NewStatus); err != nil {
+		if errors.Is(err, jira.ErrNoTransition) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to sync task %s to jira: %w", payload.TaskID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/jira"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessJiraSync(t *testing.T) {
+	taskID := uuid.New()
+	job, err := NewJiraSyncTask(JiraSyncPayload{TaskID: taskID, NewStatus: entity.TaskStatusDONE})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		syncErr   error
+		wantErr   bool
+		skipRetry bool
+	}{
+		{name: "synced"},
+		{name: "jira unavailable is retried", syncErr: errors.New("jira API returned 503"), wantErr: true},
+		{name: "missing transition is not retried", syncErr: fmt.Errorf("%w: PROJ-1", jira.ErrNoTransition), wantErr: true, skipRetry: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jiraUsecase := usecase.NewJiraUsecaseMock(t)
+			jiraUsecase.EXPECT().SyncTaskStatus(context.Background(), taskID, entity.TaskStatusDONE).Return(tt.syncErr).Once()
+			processor := &Processor{jiraUsecase: jiraUsecase, logger: slog.Default()}
+
+			err := processor.ProcessJiraSync(context.Background(), job)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.skipRetry, errors.Is(err, asynq.SkipRetry))
+		})
+	}
+}
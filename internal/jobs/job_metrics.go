@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/hibiken/asynq"
+)
+
+// jobMetricsTimeout bounds recording a run, so a slow database does not
+// hold the worker slot of the job
+const jobMetricsTimeout = 5 * time.Second
+
+// recordJobMetrics adds every run of a job to the day's rollup of its type.
+// A run that could not be recorded is only logged; it never fails the job.
+func recordJobMetrics(metrics repository.JobMetricRepository, logger *slog.Logger, now func() time.Time) asynq.MiddlewareFunc {
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+			start := now()
+			err := next.ProcessTask(ctx, task)
+			end := now()
+
+			recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jobMetricsTimeout)
+			defer cancel()
+			day := end.UTC().Truncate(24 * time.Hour)
+			if recordErr := metrics.Record(recordCtx, day, task.Type(), end.Sub(start), err != nil); recordErr != nil {
+				logger.Warn("Failed to record job metrics", "task_type", task.Type(), "error", recordErr)
+			}
+			return err
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// steppingClock returns times a step apart, starting at start
+func steppingClock(start time.Time, step time.Duration) func() time.Time {
+	next := start
+	return func() time.Time {
+		now := next
+		next = next.Add(step)
+		return now
+	}
+}
+
+func TestRecordJobMetrics(t *testing.T) {
+	start := time.Date(2024, 3, 5, 23, 59, 59, 0, time.FixedZone("ICT", 7*60*60))
+	day := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	task := asynq.NewTask(TypeVelocityRollup, nil)
+
+	t.Run("records a successful run", func(t *testing.T) {
+		metrics := repository.NewJobMetricRepositoryMock(t)
+		metrics.EXPECT().Record(mock.Anything, day, TypeVelocityRollup, 3*time.Second, false).Return(nil).Once()
+
+		handler := recordJobMetrics(metrics, slog.Default(), steppingClock(start, 3*time.Second))(asynq.HandlerFunc(func(context.Context, *asynq.Task) error {
+			return nil
+		}))
+		assert.NoError(t, handler.ProcessTask(context.Background(), task))
+	})
+
+	t.Run("records a failed run and returns its error", func(t *testing.T) {
+		metrics := repository.NewJobMetricRepositoryMock(t)
+		metrics.EXPECT().Record(mock.Anything, day, TypeVelocityRollup, time.Second, true).Return(nil).Once()
+
+		jobErr := errors.New("boom")
+		handler := recordJobMetrics(metrics, slog.Default(), steppingClock(start, time.Second))(asynq.HandlerFunc(func(context.Context, *asynq.Task) error {
+			return jobErr
+		}))
+		assert.ErrorIs(t, handler.ProcessTask(context.Background(), task), jobErr)
+	})
+
+	t.Run("does not fail the job when the run cannot be recorded", func(t *testing.T) {
+		metrics := repository.NewJobMetricRepositoryMock(t)
+		metrics.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database is down")).Once()
+
+		handler := recordJobMetrics(metrics, slog.Default(), steppingClock(start, time.Second))(asynq.HandlerFunc(func(context.Context, *asynq.Task) error {
+			return nil
+		}))
+		assert.NoError(t, handler.ProcessTask(context.Background(), task))
+	})
+
+	t.Run("records runs whose context was canceled", func(t *testing.T) {
+		metrics := repository.NewJobMetricRepositoryMock(t)
+		metrics.EXPECT().Record(mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Err() == nil
+		}), day, TypeVelocityRollup, time.Second, true).Return(nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		handler := recordJobMetrics(metrics, slog.Default(), steppingClock(start, time.Second))(asynq.HandlerFunc(func(ctx context.Context, _ *asynq.Task) error {
+			cancel()
+			return ctx.Err()
+		}))
+		assert.ErrorIs(t, handler.ProcessTask(ctx, task), context.Canceled)
+	})
+}
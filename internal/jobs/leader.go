@@ -0,0 +1,139 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Leadership elects the one worker scheduling the periodic jobs
+type Leadership interface {
+	// Campaign waits until this worker leads, or ctx is done. lost is
+	// closed when the lead is lost; resign gives it up.
+	Campaign(ctx context.Context) (lost <-chan struct{}, resign func(), err error)
+}
+
+const (
+	// schedulerLeaderKey holds the lease of the worker leading the scheduling
+	schedulerLeaderKey = "auto-devs:scheduler-leader"
+	// leaderLeaseTTL is how long after its leader crashed another worker
+	// takes over at most
+	leaderLeaseTTL = 15 * time.Second
+	// leaderRetryInterval is how often followers try to take the lease
+	leaderRetryInterval = 5 * time.Second
+)
+
+// RedisLeaderElection elects a leader through a lease in Redis. The leader
+// renews its lease; once it stops doing so, another worker takes it over
+// within leaderLeaseTTL.
+type RedisLeaderElection struct {
+	client *redis.Client
+	worker string
+	logger *slog.Logger
+}
+
+// NewRedisLeaderElection creates an election among the workers sharing the
+// Redis of the job queues, worker identifying this one
+func NewRedisLeaderElection(redisAddr, redisPassword string, redisDB int, worker string) *RedisLeaderElection {
+	return &RedisLeaderElection{
+		client: redis.NewClient(&redis.Options{
+			Addr:     redisAddr,
+			Password: redisPassword,
+			DB:       redisDB,
+		}),
+		worker: worker,
+		logger: slog.Default().With("component", "leader-election"),
+	}
+}
+
+// Close closes the Redis client
+func (e *RedisLeaderElection) Close() error {
+	return e.client.Close()
+}
+
+// Leader returns the worker leading, empty when none does
+func (e *RedisLeaderElection) Leader(ctx context.Context) (string, error) {
+	value, err := e.client.Get(ctx, schedulerLeaderKey).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get scheduler leader: %w", err)
+	}
+	leader, _, _ := strings.Cut(value, "#")
+	return leader, nil
+}
+
+// Campaign waits until this worker holds the lease, or ctx is done
+func (e *RedisLeaderElection) Campaign(ctx context.Context) (<-chan struct{}, func(), error) {
+	// The token tells this term apart from any other, of the same worker
+	// included
+	token := e.worker + "#" + uuid.NewString()
+
+	for waited := false; ; waited = true {
+		ok, err := e.client.SetNX(ctx, schedulerLeaderKey, token, leaderLeaseTTL).Result()
+		if err != nil && ctx.Err() == nil {
+			e.logger.Error("Failed to campaign for scheduler leadership", "error", err)
+		}
+		if ok {
+			break
+		}
+		if !waited {
+			if leader, err := e.Leader(ctx); err == nil && leader != "" {
+				e.logger.Info("Following scheduler leader", "leader", leader)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(leaderRetryInterval):
+		}
+	}
+	e.logger.Info("Became scheduler leader", "worker", e.worker)
+
+	lost := make(chan struct{})
+	stop := make(chan struct{})
+	go e.renew(token, stop, lost)
+
+	var once sync.Once
+	return lost, func() {
+		once.Do(func() {
+			close(stop)
+			if err := releaseLockScript.Run(context.Background(), e.client, []string{schedulerLeaderKey}, token).Err(); err != nil {
+				e.logger.Error("Failed to resign scheduler leadership", "error", err)
+			}
+		})
+	}, nil
+}
+
+// renew extends the lease every third of its TTL until stop is closed,
+// closing lost once the lease is another's or may have expired
+func (e *RedisLeaderElection) renew(token string, stop <-chan struct{}, lost chan<- struct{}) {
+	ticker := time.NewTicker(leaderLeaseTTL / 3)
+	defer ticker.Stop()
+	renewedAt := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			renewed, err := renewLockScript.Run(context.Background(), e.client, []string{schedulerLeaderKey}, token, leaderLeaseTTL.Milliseconds()).Int()
+			switch {
+			case err != nil && time.Since(renewedAt) < leaderLeaseTTL:
+				e.logger.Error("Failed to renew scheduler leadership", "error", err)
+			case err != nil || renewed == 0:
+				e.logger.Warn("Scheduler leadership lease expired", "error", err)
+				close(lost)
+				return
+			default:
+				renewedAt = time.Now()
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+const (
+	// mediaContextRoot is the directory of the worktree context files are
+	// written to. It ignores itself, so they are never committed.
+	mediaContextRoot = ".autodevs"
+	// mediaContextDir is where the images a task references are written in
+	// its worktree, for the AI executor to read
+	mediaContextDir = mediaContextRoot + "/media"
+	// maxMediaContextFiles bounds how many images are passed to one execution
+	maxMediaContextFiles = 20
+)
+
+// withMediaContext returns the task to run the AI executor on: task itself
+// when its description and comments reference no uploaded image, otherwise
+// a copy whose description lists the images, written into the worktree.
+// Images that cannot be written are left out, never failing the execution.
+func (p *Processor) withMediaContext(ctx context.Context, task *entity.Task) *entity.Task {
+	if p.attachmentUsecase == nil || task.WorktreePath == nil {
+		return task
+	}
+
+	texts := []string{task.Description}
+	comments, err := p.taskUsecase.GetComments(ctx, task.ID)
+	if err != nil {
+		p.logger.Warn("Failed to get task comments for media context", "task_id", task.ID, "error", err)
+	}
+	for _, comment := range comments {
+		texts = append(texts, comment.Comment)
+	}
+
+	media, err := p.attachmentUsecase.ReferencedMedia(ctx, texts)
+	if err != nil {
+		p.logger.Warn("Failed to find media referenced by task", "task_id", task.ID, "error", err)
+		return task
+	}
+	if len(media) == 0 {
+		return task
+	}
+	if len(media) > maxMediaContextFiles {
+		p.logger.Warn("Task references too many images, passing the first ones",
+			"task_id", task.ID, "images", len(media), "limit", maxMediaContextFiles)
+		media = media[:maxMediaContextFiles]
+	}
+
+	dir, err := prepareMediaContextDir(*task.WorktreePath)
+	if err != nil {
+		p.logger.Warn("Failed to prepare media context directory", "task_id", task.ID, "error", err)
+		return task
+	}
+
+	var files []string
+	for _, attachment := range media {
+		file, err := p.writeMediaContextFile(ctx, dir, attachment)
+		if err != nil {
+			p.logger.Warn("Failed to write media context file", "task_id", task.ID, "attachment_id", attachment.ID, "error", err)
+			continue
+		}
+		files = append(files, fmt.Sprintf("- %s (%s)", file, attachment.Filename))
+	}
+	if len(files) == 0 {
+		return task
+	}
+
+	withMedia := *task
+	withMedia.Description = strings.TrimRight(task.Description, "\n") +
+		"\n\nImages referenced by this task and its comments, saved in the worktree for you to view:\n" +
+		strings.Join(files, "\n")
+	return &withMedia
+}
+
+// prepareMediaContextDir creates the media directory of the worktree and
+// returns its path
+func prepareMediaContextDir(worktreePath string) (string, error) {
+	dir := filepath.Join(worktreePath, filepath.FromSlash(mediaContextDir))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create media directory: %w", err)
+	}
+	ignoreFile := filepath.Join(worktreePath, mediaContextRoot, ".gitignore")
+	if err := os.WriteFile(ignoreFile, []byte("*\n"), 0o644); err != nil {
+		return "", fmt.Errorf("failed to ignore media directory: %w", err)
+	}
+	return dir, nil
+}
+
+// writeMediaContextFile copies an uploaded image into dir and returns its
+// path relative to the worktree
+func (p *Processor) writeMediaContextFile(ctx context.Context, dir string, attachment *entity.TaskAttachment) (string, error) {
+	_, content, err := p.attachmentUsecase.OpenMedia(ctx, attachment.ID)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+
+	name := attachment.ID.String() + mediaExtension(attachment.MimeType)
+	target, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		return "", fmt.Errorf("failed to create media file: %w", err)
+	}
+	_, err = io.Copy(target, content)
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write media file: %w", err)
+	}
+	return mediaContextDir + "/" + name, nil
+}
+
+// mediaExtension returns the file extension of an image's media type, for
+// tools that go by extension
+func mediaExtension(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	if extensions, _ := mime.ExtensionsByType(mimeType); len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMediaContext(t *testing.T) {
+	worktree := t.TempDir()
+	image := &entity.TaskAttachment{ID: uuid.New(), Filename: "login-error.png", MimeType: "image/png"}
+	task := &entity.Task{ID: uuid.New(), Title: "Fix login", Description: "Login fails", WorktreePath: &worktree}
+	comments := []*entity.TaskComment{{Comment: "Screenshot: ![login-error.png](http://localhost/api/v1/media/" + image.ID.String() + ")"}}
+
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetComments(mock.Anything, task.ID).Return(comments, nil)
+	attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+	attachmentUsecase.EXPECT().ReferencedMedia(mock.Anything, []string{task.Description, comments[0].Comment}).Return([]*entity.TaskAttachment{image}, nil)
+	attachmentUsecase.EXPECT().OpenMedia(mock.Anything, image.ID).Return(image, io.NopCloser(strings.NewReader("png")), nil)
+	processor := &Processor{taskUsecase: taskUsecase, attachmentUsecase: attachmentUsecase, logger: slog.Default()}
+
+	withMedia := processor.withMediaContext(context.Background(), task)
+
+	file := mediaContextDir + "/" + image.ID.String() + ".png"
+	assert.Equal(t, "Login fails", task.Description, "the task itself is left as is")
+	assert.Equal(t, "Login fails\n\nImages referenced by this task and its comments, saved in the worktree for you to view:\n- "+file+" (login-error.png)", withMedia.Description)
+	content, err := os.ReadFile(filepath.Join(worktree, file))
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(content))
+	ignore, err := os.ReadFile(filepath.Join(worktree, mediaContextRoot, ".gitignore"))
+	require.NoError(t, err)
+	assert.Equal(t, "*\n", string(ignore))
+}
+
+func TestWithMediaContext_NoMedia(t *testing.T) {
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), Description: "Plain text", WorktreePath: &worktree}
+
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetComments(mock.Anything, task.ID).Return(nil, nil)
+	attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+	attachmentUsecase.EXPECT().ReferencedMedia(mock.Anything, []string{"Plain text"}).Return(nil, nil)
+	processor := &Processor{taskUsecase: taskUsecase, attachmentUsecase: attachmentUsecase, logger: slog.Default()}
+
+	assert.Same(t, task, processor.withMediaContext(context.Background(), task))
+	assert.NoDirExists(t, filepath.Join(worktree, mediaContextRoot))
+}
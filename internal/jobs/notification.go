@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// notify sends a notification about the task with send, which is given the
+// task's project. Notifications never fail the job they are sent from.
+func (p *Processor) notify(ctx context.Context, task *entity.Task, send func(project *entity.Project) error) {
+	if p.notificationUsecase == nil || task == nil {
+		return
+	}
+	project := task.Project
+	if project == nil {
+		var err error
+		if project, err = p.projectUsecase.GetByID(ctx, task.ProjectID); err != nil {
+			p.logger.Warn("Failed to get project for notification", "task_id", task.ID, "error", err)
+			return
+		}
+	}
+	if err := send(project); err != nil {
+		p.logger.Warn("Failed to send notification", "task_id", task.ID, "error", err)
+	}
+}
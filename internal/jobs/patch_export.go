@@ -0,0 +1,117 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+)
+
+// artifactUploader is recorded as the uploader of the attachments auto-devs
+// generates
+const artifactUploader = "auto-devs"
+
+// executePatchExportWorkflow is the counterpart of executePRCreationWorkflow
+// for projects without a remote git provider. The changes are committed in
+// the worktree and attached to the task as a patch series, for git am, and
+// a git bundle of the branch, for git fetch; nothing is pushed. It returns
+// whether the export was blocked because the changes add secrets.
+func (p *Processor) executePatchExportWorkflow(ctx context.Context, projectTask *entity.Task, dbExecution *entity.Execution) bool {
+	p.logger.Info("Starting patch export workflow", "task_id", projectTask.ID)
+
+	if projectTask.WorktreePath == nil || projectTask.BranchName == nil || projectTask.BaseBranchName == nil {
+		p.logger.Error("Task has no worktree or branches, cannot export changes", "task_id", projectTask.ID)
+		return false
+	}
+	worktreePath := *projectTask.WorktreePath
+
+	// The patch leaves the machine as surely as a push would
+	secrets, err := p.scanForSecrets(ctx, projectTask, dbExecution)
+	if err != nil {
+		p.logger.Error("Failed to scan changes for secrets, export blocked", "error", err, "task_id", projectTask.ID)
+		_ = p.taskUsecase.AppendErrorLog(ctx, projectTask.ID, secretScanFailedReason(err))
+		return true
+	}
+	if len(secrets) > 0 {
+		p.logger.Warn("Secrets found in the changes, export blocked", "task_id", projectTask.ID, "findings", len(secrets))
+		_ = p.taskUsecase.AppendErrorLog(ctx, projectTask.ID, secretsFoundReason(secrets))
+		return true
+	}
+
+	if _, err := p.gitManager.CommitAll(ctx, worktreePath, implementationCommitMessage(projectTask)); err != nil {
+		p.logger.Error("Failed to commit changes", "error", err, "task_id", projectTask.ID)
+		return false
+	}
+
+	base := *projectTask.BaseBranchName
+	patch, err := p.gitManager.FormatPatch(ctx, worktreePath, base, "HEAD")
+	if err != nil {
+		p.logger.Error("Failed to export changes as a patch", "error", err, "task_id", projectTask.ID)
+		return false
+	}
+	if patch == "" {
+		p.logger.Info("No changes to export", "task_id", projectTask.ID)
+		return false
+	}
+	if p.attachmentUsecase == nil {
+		p.logger.Warn("Patch export skipped - attachments are not available", "task_id", projectTask.ID)
+		return false
+	}
+
+	name := strings.ReplaceAll(*projectTask.BranchName, "/", "-")
+	if _, err := p.attachmentUsecase.UploadArtifact(ctx, usecase.UploadAttachmentRequest{
+		TaskID:     projectTask.ID,
+		Filename:   name + ".patch",
+		Size:       int64(len(patch)),
+		Content:    strings.NewReader(patch),
+		UploadedBy: artifactUploader,
+	}); err != nil {
+		p.logger.Error("Failed to attach patch", "error", err, "task_id", projectTask.ID)
+		return false
+	}
+
+	if err := p.attachBundle(ctx, projectTask, name+".bundle"); err != nil {
+		p.logger.Error("Failed to attach bundle", "error", err, "task_id", projectTask.ID)
+		return false
+	}
+
+	p.logger.Info("Exported changes as patch and bundle attachments", "task_id", projectTask.ID, "branch", *projectTask.BranchName)
+	return false
+}
+
+// attachBundle attaches a git bundle of the task's branch, since it left the
+// base branch, to the task as filename
+func (p *Processor) attachBundle(ctx context.Context, task *entity.Task, filename string) error {
+	dir, err := os.MkdirTemp("", "auto-devs-bundle-")
+	if err != nil {
+		return fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, filename)
+	if err := p.gitManager.CreateBundle(ctx, *task.WorktreePath, path, *task.BaseBranchName, *task.BranchName); err != nil {
+		return err
+	}
+	bundle, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer bundle.Close()
+	info, err := bundle.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat bundle: %w", err)
+	}
+
+	_, err = p.attachmentUsecase.UploadArtifact(ctx, usecase.UploadAttachmentRequest{
+		TaskID:     task.ID,
+		Filename:   filename,
+		Size:       info.Size(),
+		Content:    bundle,
+		UploadedBy: artifactUploader,
+	})
+	return err
+}
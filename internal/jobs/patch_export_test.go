@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutePatchExportWorkflow(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	base := strings.TrimSpace(string(out))
+	branch := "task/add-login"
+	out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", branch).CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "login.go"), []byte("package main\n\nfunc login() {}\n"), 0o644))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add login", WorktreePath: &worktree, BaseBranchName: &base, BranchName: &branch}
+	attachmentUsecase := usecase.NewAttachmentUsecaseMock(t)
+	uploaded := map[string]string{}
+	attachmentUsecase.EXPECT().UploadArtifact(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req usecase.UploadAttachmentRequest) (*entity.TaskAttachment, error) {
+		assert.Equal(t, task.ID, req.TaskID)
+		assert.Equal(t, artifactUploader, req.UploadedBy)
+		content, err := io.ReadAll(req.Content)
+		require.NoError(t, err)
+		assert.Len(t, content, int(req.Size))
+		uploaded[req.Filename] = string(content)
+		return &entity.TaskAttachment{ID: uuid.New(), TaskID: req.TaskID, Filename: req.Filename}, nil
+	}).Times(2)
+	processor := &Processor{gitManager: gitManager, attachmentUsecase: attachmentUsecase, logger: slog.Default()}
+
+	blocked := processor.executePatchExportWorkflow(context.Background(), task, &entity.Execution{ID: uuid.New()})
+
+	assert.False(t, blocked)
+	assert.Equal(t, []string{"Implement task: Add login", "initial"}, gitLog(t, worktree), "the changes are committed, not pushed")
+	assert.Contains(t, uploaded["task-add-login.patch"], "Subject: [PATCH] Implement task: Add login")
+	assert.Contains(t, uploaded["task-add-login.patch"], "+func login() {}")
+	assert.True(t, strings.HasPrefix(uploaded["task-add-login.bundle"], "# v2 git bundle"), "a bundle of the branch")
+}
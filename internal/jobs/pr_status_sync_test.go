@@ -1,12 +1,32 @@
 package jobs
 
 import (
+	"context"
+	"log/slog"
 	"testing"
 
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/github"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// prGitHub answers PR lookups with the PRs it holds, recording them
+type prGitHub struct {
+	github.GitHubServiceInterface
+	prs     map[int]*entity.PullRequest
+	fetched []int
+}
+
+func (g *prGitHub) GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error) {
+	g.fetched = append(g.fetched, prNumber)
+	return g.prs[prNumber], nil
+}
+
 func TestNewPRStatusSyncJob(t *testing.T) {
 	job, err := NewPRStatusSyncJob()
 	require.NoError(t, err)
@@ -21,4 +41,56 @@ func TestParsePRStatusSyncPayload(t *testing.T) {
 	payload, err := ParsePRStatusSyncPayload(job)
 	require.NoError(t, err)
 	assert.NotNil(t, payload)
-}
\ No newline at end of file
+}
+
+func TestProcessPRStatusSync_SkipsWebhookSources(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	hooked := &entity.PullRequest{ID: uuid.New(), TaskID: task.ID, Repository: "acme/hooked", GitHubPRNumber: 1, Status: entity.PullRequestStatusOpen}
+	polled := &entity.PullRequest{ID: uuid.New(), TaskID: task.ID, Repository: "acme/polled", GitHubPRNumber: 2, Status: entity.PullRequestStatusOpen}
+	mergeable := true
+	gh := &prGitHub{prs: map[int]*entity.PullRequest{
+		2: {Status: entity.PullRequestStatusOpen, Mergeable: &mergeable},
+	}}
+
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	prRepo.EXPECT().GetOpenPRs(mock.Anything).Return([]*entity.PullRequest{hooked, polled}, nil)
+	// The status is unchanged but the PR became mergeable
+	prRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(pr *entity.PullRequest) bool {
+		return pr.ID == polled.ID && pr.Mergeable != nil && *pr.Mergeable
+	})).Return(nil).Once()
+	deliveryRepo := repository.NewWebhookDeliveryRepositoryMock(t)
+	deliveryRepo.EXPECT().ListSourcesSince(mock.Anything, entity.WebhookProviderGitHub, mock.Anything).Return([]string{"acme/hooked"}, nil)
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+
+	processor := &Processor{prRepo: prRepo, githubService: gh, taskUsecase: taskUsecase, webhookDeliveryRepo: deliveryRepo, logger: slog.Default()}
+	job, err := NewPRStatusSyncJob()
+	require.NoError(t, err)
+
+	require.NoError(t, processor.ProcessPRStatusSync(context.Background(), job))
+	assert.Equal(t, []int{2}, gh.fetched)
+}
+
+func TestProcessPRSync(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	open := &entity.PullRequest{ID: uuid.New(), TaskID: task.ID, Repository: "acme/app", GitHubPRNumber: 7, Status: entity.PullRequestStatusOpen}
+	closed := &entity.PullRequest{ID: uuid.New(), TaskID: task.ID, Repository: "acme/app", GitHubPRNumber: 8, Status: entity.PullRequestStatusClosed}
+	gh := &prGitHub{prs: map[int]*entity.PullRequest{
+		7: {Status: entity.PullRequestStatusOpen},
+	}}
+
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	prRepo.EXPECT().GetByID(mock.Anything, open.ID).Return(open, nil)
+	prRepo.EXPECT().GetByID(mock.Anything, closed.ID).Return(closed, nil)
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	processor := &Processor{prRepo: prRepo, githubService: gh, taskUsecase: taskUsecase, logger: slog.Default()}
+
+	for _, pr := range []*entity.PullRequest{open, closed} {
+		job, err := NewPRSyncTask(PRSyncPayload{PullRequestID: pr.ID})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessPRSync(context.Background(), job))
+	}
+	// A PR no longer open is not fetched again
+	assert.Equal(t, []int{7}, gh.fetched)
+}
@@ -0,0 +1,97 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// startPreview runs the project's preview environment in the task worktree
+// and records its URL on the task. A preview that fails to start is logged
+// on the task; it never holds up the review.
+func (p *Processor) startPreview(ctx context.Context, project *entity.Project, task *entity.Task) {
+	if !project.PreviewEnabled || p.previewManager == nil || task.WorktreePath == nil {
+		return
+	}
+
+	preview, err := p.previewManager.Start(ctx, task.ID, *task.WorktreePath, project.PreviewCommand)
+	if err != nil {
+		p.logger.Error("Failed to start preview", "error", err, "task_id", task.ID)
+		_ = p.taskUsecase.AppendErrorLog(ctx, task.ID, fmt.Sprintf("Preview environment failed to start: %v", err))
+		return
+	}
+	p.logger.Info("Preview started", "task_id", task.ID, "url", preview.URL, "command", preview.Command)
+
+	p.updatePreviewURL(ctx, task.ID, &preview.URL)
+}
+
+// stopPreview tears down the task's preview, if this worker runs one, and
+// clears its URL
+func (p *Processor) stopPreview(ctx context.Context, taskID uuid.UUID) error {
+	if p.previewManager == nil {
+		return nil
+	}
+	if _, ok := p.previewManager.Get(taskID); !ok {
+		p.logger.Debug("No preview running for task", "task_id", taskID)
+		return nil
+	}
+
+	if err := p.previewManager.Stop(ctx, taskID); err != nil {
+		return fmt.Errorf("failed to stop preview: %w", err)
+	}
+	p.logger.Info("Preview stopped", "task_id", taskID)
+
+	p.updatePreviewURL(ctx, taskID, nil)
+	return nil
+}
+
+// StopPreviews tears down every preview this worker runs, for shutdown
+func (p *Processor) StopPreviews(ctx context.Context) {
+	if p.previewManager == nil {
+		return
+	}
+	for _, taskID := range p.previewManager.StopAll(ctx) {
+		p.updatePreviewURL(ctx, taskID, nil)
+	}
+}
+
+// ProcessPreviewStop tears down the preview of a task that is done or
+// cancelled
+func (p *Processor) ProcessPreviewStop(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParsePreviewStopPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse preview stop payload: %w", err)
+	}
+	return p.stopPreview(ctx, payload.TaskID)
+}
+
+// updatePreviewURL saves the task's preview URL and tells the project's
+// clients about it
+func (p *Processor) updatePreviewURL(ctx context.Context, taskID uuid.UUID, previewURL *string) {
+	task, err := p.taskUsecase.UpdatePreviewURL(ctx, taskID, previewURL)
+	if err != nil {
+		p.logger.Error("Failed to save preview URL", "error", err, "task_id", taskID)
+		return
+	}
+
+	if p.wsService == nil {
+		return
+	}
+	changes := map[string]interface{}{
+		"preview_url": previewURL,
+	}
+	taskResponse := map[string]interface{}{
+		"id":          task.ID.String(),
+		"project_id":  task.ProjectID.String(),
+		"title":       task.Title,
+		"status":      string(task.Status),
+		"preview_url": task.PreviewURL,
+		"updated_at":  task.UpdatedAt,
+	}
+	if err := p.wsService.NotifyTaskUpdated(task.ID, task.ProjectID, changes, taskResponse); err != nil {
+		p.logger.Error("Failed to send preview URL notification", "error", err, "task_id", taskID)
+	}
+}
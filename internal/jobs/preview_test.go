@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/preview"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPreviewProcessor(t *testing.T) (*Processor, *usecase.TaskUsecaseMock) {
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	return &Processor{
+		taskUsecase: taskUsecase,
+		previewManager: preview.NewManager(&config.PreviewConfig{
+			Host:           "localhost",
+			PortRangeStart: port,
+			PortRangeEnd:   port,
+			StartTimeout:   10,
+		}),
+		logger: slog.Default(),
+	}, taskUsecase
+}
+
+func TestPreview_StartAndStop(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 not available")
+	}
+	processor, taskUsecase := newPreviewProcessor(t)
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	project := &entity.Project{PreviewEnabled: true, PreviewCommand: "exec python3 -m http.server $PORT"}
+
+	var previewURL *string
+	taskUsecase.EXPECT().UpdatePreviewURL(mock.Anything, task.ID, mock.Anything).RunAndReturn(func(ctx context.Context, taskID uuid.UUID, url *string) (*entity.Task, error) {
+		previewURL = url
+		return &entity.Task{ID: taskID, PreviewURL: url}, nil
+	}).Twice()
+
+	processor.startPreview(context.Background(), project, task)
+	require.NotNil(t, previewURL)
+	running, ok := processor.previewManager.Get(task.ID)
+	require.True(t, ok)
+	assert.Equal(t, running.URL, *previewURL)
+
+	payload, err := NewPreviewStopTask(PreviewStopPayload{TaskID: task.ID})
+	require.NoError(t, err)
+	require.NoError(t, processor.ProcessPreviewStop(context.Background(), payload))
+	assert.Nil(t, previewURL)
+	_, ok = processor.previewManager.Get(task.ID)
+	assert.False(t, ok)
+}
+
+func TestPreview_FailedStart(t *testing.T) {
+	processor, taskUsecase := newPreviewProcessor(t)
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+
+	taskUsecase.EXPECT().AppendErrorLog(mock.Anything, task.ID, mock.MatchedBy(func(message string) bool {
+		return strings.HasPrefix(message, "Preview environment failed to start")
+	})).Return(nil).Once()
+
+	processor.startPreview(context.Background(), &entity.Project{PreviewEnabled: true, PreviewCommand: "exit 1"}, task)
+	// taskUsecase mock asserts the URL is not recorded
+}
+
+func TestPreview_Disabled(t *testing.T) {
+	processor, _ := newPreviewProcessor(t)
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+
+	processor.startPreview(context.Background(), &entity.Project{PreviewCommand: "exit 1"}, task)
+
+	// Stopping a preview this worker does not run changes nothing
+	payload, err := NewPreviewStopTask(PreviewStopPayload{TaskID: task.ID})
+	require.NoError(t, err)
+	assert.NoError(t, processor.ProcessPreviewStop(context.Background(), payload))
+	assert.Equal(t, TypePreviewStop, payload.Type())
+}
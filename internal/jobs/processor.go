@@ -2,6 +2,7 @@ package jobs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -15,30 +16,58 @@ import (
 	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/auto-devs/auto-devs/internal/service/github"
 	"github.com/auto-devs/auto-devs/internal/service/kanban"
+	"github.com/auto-devs/auto-devs/internal/service/preview"
+	"github.com/auto-devs/auto-devs/internal/service/verification"
 	"github.com/auto-devs/auto-devs/internal/usecase"
 	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
 	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Processor handles background job processing
 type Processor struct {
-	taskUsecase      usecase.TaskUsecase
-	projectUsecase   usecase.ProjectUsecase
-	worktreeUsecase  usecase.WorktreeUsecase
-	planningService  *ai.PlanningService
-	executionService *ai.ExecutionService
-	planRepo         repository.PlanRepository
-	executionRepo    repository.ExecutionRepository
-	executionLogRepo repository.ExecutionLogRepository
-	wsService        *websocket.Service
-	redisBroker      *RedisBrokerClient // Redis broker client for cross-process messaging
-	gitManager       *git.GitManager
-	prCreator        *github.PRCreator
-	prRepo           repository.PullRequestRepository
-	githubService    github.GitHubServiceInterface
-	kanbanClient     kanban.Client
-	logger           *slog.Logger
+	taskUsecase         usecase.TaskUsecase
+	projectUsecase      usecase.ProjectUsecase
+	worktreeUsecase     usecase.WorktreeUsecase
+	planningService     *ai.PlanningService
+	executionService    *ai.ExecutionService
+	planRepo            repository.PlanRepository
+	executionRepo       repository.ExecutionRepository
+	executionLogRepo    repository.ExecutionLogRepository
+	wsService           *websocket.Service
+	redisBroker         *RedisBrokerClient // Redis broker client for cross-process messaging
+	gitManager          *git.GitManager
+	prCreator           *github.PRCreator
+	prRepo              repository.PullRequestRepository
+	githubService       github.GitHubServiceInterface
+	kanbanClient        kanban.Client
+	jiraUsecase         usecase.JiraUsecase
+	purgeRepo           repository.PurgeRepository
+	verificationRunRepo repository.VerificationRunRepository
+	verificationRunner  *verification.Runner // Runs the project's lint, build, test and security scan commands after implementation
+	reviewCommentRepo   repository.ReviewCommentRepository
+	securityFindingRepo repository.SecurityFindingRepository
+	previewManager      *preview.Manager // Runs the preview environments of implemented tasks
+	releaseNotesUsecase usecase.ReleaseNotesUsecase
+	attachmentUsecase   usecase.AttachmentUsecase            // Finds the images a task references, for the AI executor
+	epicUsecase         usecase.EpicUsecase                  // Rolls up the epics of tasks changing status
+	webhookUsecase      usecase.ProjectWebhookUsecase        // Posts plans, executions and merges to the project webhooks
+	webhookDeliveryRepo repository.WebhookDeliveryRepository // Knows the repositories sending PR webhooks, which are not polled
+	githubIssueUsecase  usecase.GitHubIssueUsecase           // Posts plans and PRs to the GitHub issues tasks were imported from
+	notificationUsecase usecase.NotificationUsecase          // Notifies the project channels of plans, failed executions and merges
+	emailUsecase        usecase.EmailUsecase                 // Sends the daily email digests
+	executionSlots      chan struct{}                        // Bounds the AI executions running at once; nil for no limit
+	worker              string                               // Recorded on the executions started, see WorkerID
+	taskLocker          TaskLocker                           // Keeps other workers off the tasks being processed; nil for no locking
+	running             runningExecutions                    // The AI executions this worker runs, so they can be cancelled
+	retryPolicy         executionRetryPolicy                 // How failed executions are retried; zero for no automatic retries
+	projectSemaphore    ProjectSemaphore                     // Bounds the executions running at once per project; nil for no limits
+	jobRequeuer         JobRequeuer                          // Requeues the jobs waiting for a slot of their project
+	logger              *slog.Logger
 }
 
 // NewProcessor creates a new job processor
@@ -57,23 +86,53 @@ func NewProcessor(
 	prRepo repository.PullRequestRepository,
 	githubService github.GitHubServiceInterface,
 	kanbanClient kanban.Client,
+	jiraUsecase usecase.JiraUsecase,
+	purgeRepo repository.PurgeRepository,
+	verificationRunRepo repository.VerificationRunRepository,
+	verificationRunner *verification.Runner,
+	reviewCommentRepo repository.ReviewCommentRepository,
+	securityFindingRepo repository.SecurityFindingRepository,
+	previewManager *preview.Manager,
+	releaseNotesUsecase usecase.ReleaseNotesUsecase,
+	attachmentUsecase usecase.AttachmentUsecase,
+	epicUsecase usecase.EpicUsecase,
+	webhookUsecase usecase.ProjectWebhookUsecase,
+	webhookDeliveryRepo repository.WebhookDeliveryRepository,
+	githubIssueUsecase usecase.GitHubIssueUsecase,
+	notificationUsecase usecase.NotificationUsecase,
+	emailUsecase usecase.EmailUsecase,
 ) *Processor {
 	return &Processor{
-		taskUsecase:      taskUsecase,
-		projectUsecase:   projectUsecase,
-		worktreeUsecase:  worktreeUsecase,
-		planningService:  planningService,
-		executionService: executionService,
-		planRepo:         planRepo,
-		executionRepo:    executionRepo,
-		executionLogRepo: executionLogRepo,
-		wsService:        wsService,
-		gitManager:       gitManager,
-		prCreator:        prCreator,
-		prRepo:           prRepo,
-		githubService:    githubService,
-		kanbanClient:     kanbanClient,
-		logger:           slog.Default().With("component", "job-processor"),
+		taskUsecase:         taskUsecase,
+		projectUsecase:      projectUsecase,
+		worktreeUsecase:     worktreeUsecase,
+		planningService:     planningService,
+		executionService:    executionService,
+		planRepo:            planRepo,
+		executionRepo:       executionRepo,
+		executionLogRepo:    executionLogRepo,
+		wsService:           wsService,
+		gitManager:          gitManager,
+		prCreator:           prCreator,
+		prRepo:              prRepo,
+		githubService:       githubService,
+		kanbanClient:        kanbanClient,
+		jiraUsecase:         jiraUsecase,
+		purgeRepo:           purgeRepo,
+		verificationRunRepo: verificationRunRepo,
+		verificationRunner:  verificationRunner,
+		reviewCommentRepo:   reviewCommentRepo,
+		securityFindingRepo: securityFindingRepo,
+		previewManager:      previewManager,
+		releaseNotesUsecase: releaseNotesUsecase,
+		attachmentUsecase:   attachmentUsecase,
+		epicUsecase:         epicUsecase,
+		webhookUsecase:      webhookUsecase,
+		webhookDeliveryRepo: webhookDeliveryRepo,
+		githubIssueUsecase:  githubIssueUsecase,
+		notificationUsecase: notificationUsecase,
+		emailUsecase:        emailUsecase,
+		logger:              slog.Default().With("component", "job-processor"),
 	}
 }
 
@@ -94,24 +153,54 @@ func NewProcessorWithRedisBroker(
 	prRepo repository.PullRequestRepository,
 	githubService github.GitHubServiceInterface,
 	kanbanClient kanban.Client,
+	jiraUsecase usecase.JiraUsecase,
+	purgeRepo repository.PurgeRepository,
+	verificationRunRepo repository.VerificationRunRepository,
+	verificationRunner *verification.Runner,
+	reviewCommentRepo repository.ReviewCommentRepository,
+	securityFindingRepo repository.SecurityFindingRepository,
+	previewManager *preview.Manager,
+	releaseNotesUsecase usecase.ReleaseNotesUsecase,
+	attachmentUsecase usecase.AttachmentUsecase,
+	epicUsecase usecase.EpicUsecase,
+	webhookUsecase usecase.ProjectWebhookUsecase,
+	webhookDeliveryRepo repository.WebhookDeliveryRepository,
+	githubIssueUsecase usecase.GitHubIssueUsecase,
+	notificationUsecase usecase.NotificationUsecase,
+	emailUsecase usecase.EmailUsecase,
 ) *Processor {
 	return &Processor{
-		taskUsecase:      taskUsecase,
-		projectUsecase:   projectUsecase,
-		worktreeUsecase:  worktreeUsecase,
-		planningService:  planningService,
-		executionService: executionService,
-		planRepo:         planRepo,
-		executionRepo:    executionRepo,
-		executionLogRepo: executionLogRepo,
-		wsService:        wsService,
-		redisBroker:      redisBroker,
-		gitManager:       gitManager,
-		prCreator:        prCreator,
-		prRepo:           prRepo,
-		githubService:    githubService,
-		kanbanClient:     kanbanClient,
-		logger:           slog.Default().With("component", "job-processor"),
+		taskUsecase:         taskUsecase,
+		projectUsecase:      projectUsecase,
+		worktreeUsecase:     worktreeUsecase,
+		planningService:     planningService,
+		executionService:    executionService,
+		planRepo:            planRepo,
+		executionRepo:       executionRepo,
+		executionLogRepo:    executionLogRepo,
+		wsService:           wsService,
+		redisBroker:         redisBroker,
+		gitManager:          gitManager,
+		prCreator:           prCreator,
+		prRepo:              prRepo,
+		githubService:       githubService,
+		kanbanClient:        kanbanClient,
+		jiraUsecase:         jiraUsecase,
+		purgeRepo:           purgeRepo,
+		verificationRunRepo: verificationRunRepo,
+		verificationRunner:  verificationRunner,
+		reviewCommentRepo:   reviewCommentRepo,
+		securityFindingRepo: securityFindingRepo,
+		previewManager:      previewManager,
+		releaseNotesUsecase: releaseNotesUsecase,
+		attachmentUsecase:   attachmentUsecase,
+		epicUsecase:         epicUsecase,
+		webhookUsecase:      webhookUsecase,
+		webhookDeliveryRepo: webhookDeliveryRepo,
+		githubIssueUsecase:  githubIssueUsecase,
+		notificationUsecase: notificationUsecase,
+		emailUsecase:        emailUsecase,
+		logger:              slog.Default().With("component", "job-processor"),
 	}
 }
 
@@ -129,6 +218,18 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 		"branch_name", payload.BranchName,
 		"project_id", payload.ProjectID)
 
+	// The lock is held until the execution started below is finished with
+	unlock, err := p.lockTask(ctx, payload.TaskID)
+	if err != nil {
+		return err
+	}
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			unlock()
+		}
+	}()
+
 	// Step 1: Check current task status and update to PLANNING if needed
 	currentTask, err := p.taskUsecase.GetByID(ctx, payload.TaskID)
 	if err != nil {
@@ -136,6 +237,26 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 			"task_id", payload.TaskID, "error", err)
 		return fmt.Errorf("failed to get task: %w", err)
 	}
+	// A scheduled run is stale once the task was planned some other way
+	if payload.Scheduled && currentTask.Status != entity.TaskStatusTODO {
+		p.logger.Info("Skipping scheduled planning, task is no longer in TODO", "task_id", payload.TaskID, "status", currentTask.Status)
+		return nil
+	}
+	if payload.RetryOfID != nil && p.staleRetry(ctx, *payload.RetryOfID, entity.ExecutionPhasePlanning, currentTask.Status) {
+		return nil
+	}
+	releaseSlot, err := p.acquireProjectSlot(ctx, currentTask, func(delay time.Duration) error {
+		_, err := p.jobRequeuer.EnqueueTaskPlanning(payload, delay)
+		return err
+	})
+	if err != nil || releaseSlot == nil {
+		return err
+	}
+	unlockTask := unlock
+	unlock = func() {
+		releaseSlot()
+		unlockTask()
+	}
 
 	// Only update status to PLANNING if it's not already PLANNING
 	// This handles cases where the status was already updated by the handler
@@ -190,7 +311,9 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 		err = p.updateTaskWithGitInfo(ctx, payload.TaskID, worktree.BranchName, worktree.WorktreePath)
 		if err != nil {
 			// Cleanup worktree on failure
-			_ = p.cleanupWorktree(ctx, worktree.WorktreePath)
+			if cleanupErr := p.cleanupWorktree(ctx, project, worktree); cleanupErr != nil {
+				p.logger.Error("Failed to clean up worktree", "task_id", payload.TaskID, "error", cleanupErr)
+			}
 			_ = p.updateTaskStatus(ctx, payload.TaskID, entity.TaskStatusTODO)
 			_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Failed to update task with git info: %s", err.Error()))
 			p.logger.Error("Failed to update task with git info",
@@ -214,11 +337,35 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 		return fmt.Errorf("failed to get AI executor: %w", err)
 	}
 
-	execution, injectEnvVars, err := p.executionService.StartExecution(projectTask, aiExecutor, true)
+	release, err := p.acquireExecutionSlot(ctx)
+	if err != nil {
+		p.logger.Error("Failed to start AI execution", "task_id", payload.TaskID, "error", err)
+		return err
+	}
+
+	// The execution is restricted to the project's command policy and gets
+	// its prompt template
+	p.loadProjectSettings(ctx, project)
+	projectTask.Project = project
+	planningTask := projectTask
+	if payload.Feedback != "" {
+		planningTask = p.withPlanFeedbackContext(ctx, projectTask, payload.Feedback)
+	}
+	// The span of the execution is ended once it is finished with, below
+	execCtx, execSpan := startExecutionSpan(ctx, payload.TaskID, payload.AIType, entity.ExecutionPhasePlanning)
+	traceID := tracing.TraceID(execCtx)
+	execution, injectEnvVars, err := p.executionService.StartExecution(p.withWorkflowContext(ctx, p.withMediaContext(ctx, planningTask), true), aiExecutor, true)
 	if err != nil {
+		release()
+		tracing.End(execSpan, err)
 		p.logger.Error("Failed to start AI execution", "task_id", payload.TaskID, "error", err)
 		return fmt.Errorf("failed to start AI execution: %w", err)
 	}
+	if iteration, err := p.taskUsecase.RecordPlanningIteration(ctx, payload.TaskID); err != nil {
+		p.logger.Warn("Failed to record planning iteration", "task_id", payload.TaskID, "error", err)
+	} else {
+		p.logger.Info("Planning iteration started", "task_id", payload.TaskID, "iteration", iteration)
+	}
 
 	// map execution to entity.Execution
 	dbExecution := &entity.Execution{
@@ -227,10 +374,18 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 		StartedAt: execution.StartedAt,
 		Progress:  execution.Progress,
 		Result:    nil,
+		AIType:    payload.AIType,
+		Worker:    p.worker,
+		Phase:     entity.ExecutionPhasePlanning,
+		Attempt:   max(payload.Attempt, 1),
+		RetryOfID: payload.RetryOfID,
+		TraceID:   traceID,
 	}
 
 	err = p.executionRepo.Create(ctx, dbExecution)
 	if err != nil {
+		release()
+		tracing.End(execSpan, err)
 		p.logger.Error("Failed to save execution to database", "task_id", payload.TaskID, "execution_id", execution.ID, "error", err)
 		return fmt.Errorf("failed to save execution to database: %w", err)
 	}
@@ -240,24 +395,40 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 	execution.RegisterStdoutChannel(stdoutChannel)
 	execution.RegisterStderrChannel(stderrChannel)
 
+	execSpan.SetAttributes(attribute.String("execution.id", dbExecution.ID.String()))
+	p.trackExecution(dbExecution.ID, execution, entity.TaskStatusTODO)
 	p.executionService.RunExecution(execution, injectEnvVars)
+	releaseWhenDone(execution, release)
 
+	handedOff = true
 	go func() {
+		defer unlock()
+		defer p.untrackExecution(dbExecution.ID)
+		defer endExecutionSpan(execSpan, execution)
 		for {
 			time.Sleep(1 * time.Second)
 			select {
 			case <-execution.GetContextDoneChannel():
-				backgroundCtx := context.Background()
+				if execution.IsCancelled() {
+					// ProcessExecutionCancel records the cancellation
+					return
+				}
+				// An implementation started automatically goes on in the trace
+				backgroundCtx := trace.ContextWithSpan(context.Background(), execSpan)
 				completedAt := time.Now()
 
 				if execution.Error != "" {
 					p.logger.Error("AI Planning execution failed", "task_id", payload.TaskID, "execution_id", execution.ID, "error", execution.Error)
 					_ = p.updateTaskStatus(backgroundCtx, payload.TaskID, entity.TaskStatusTODO)
 					_ = p.taskUsecase.AppendErrorLog(backgroundCtx, payload.TaskID, fmt.Sprintf("Planning failed: %s", execution.Error))
+					p.recordFileAccessViolation(backgroundCtx, dbExecution, execution)
 					err := p.executionRepo.MarkFailed(backgroundCtx, dbExecution.ID, completedAt, execution.Error)
 					if err != nil {
 						p.logger.Error("Failed to mark execution as failed", "error", err, "execution_id", dbExecution.ID)
 					}
+					p.publishExecutionCompleted(backgroundCtx, projectTask, dbExecution, completedAt, execution.Error)
+					p.recordSpend(backgroundCtx, projectTask, dbExecution, aiExecutor, execution)
+					p.retryFailedExecution(backgroundCtx, dbExecution)
 				} else {
 					p.logger.Info("AI Planning execution completed successfully", "task_id", payload.TaskID, "execution_id", execution.ID)
 					_ = p.updateTaskStatus(backgroundCtx, payload.TaskID, entity.TaskStatusPLANREVIEWING)
@@ -265,6 +436,8 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 					if err != nil {
 						p.logger.Error("Failed to mark execution as completed", "error", err, "execution_id", dbExecution.ID)
 					}
+					p.publishExecutionCompleted(backgroundCtx, projectTask, dbExecution, completedAt, "")
+					p.recordSpend(backgroundCtx, projectTask, dbExecution, aiExecutor, execution)
 					result := execution.Result
 					p.logger.Info("AI Planning execution result", "task_id", payload.TaskID, "execution_id", execution.ID, "result", result)
 					if result != nil {
@@ -277,8 +450,10 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 							p.logger.Error("Failed to save plan", "error", err, "execution_id", dbExecution.ID)
 						} else if payload.AutoImplement {
 							p.logger.Info("Auto-implement enabled, enqueuing implementation job", "task_id", payload.TaskID)
-							_, err := p.taskUsecase.ApprovePlan(backgroundCtx, payload.TaskID, payload.AIType)
-							if err != nil {
+							_, err := p.taskUsecase.ApprovePlan(backgroundCtx, payload.TaskID, payload.AIType, nil)
+							if errors.Is(err, usecase.ErrStepUpApprovalRequired) {
+								p.logger.Info("High-risk plan needs step-up approval, not implementing it automatically", "task_id", payload.TaskID)
+							} else if err != nil {
 								p.logger.Error("Failed to auto-enqueue implementation job", "error", err, "task_id", payload.TaskID)
 							}
 						}
@@ -292,10 +467,13 @@ func (p *Processor) ProcessTaskPlanning(ctx context.Context, task *asynq.Task) e
 				// assign execution id to each log
 				for _, log := range logs {
 					log.ExecutionID = dbExecution.ID
+					log.TraceID = traceID
 				}
 				err := p.executionLogRepo.BatchInsertOrUpdate(context.Background(), logs)
 				if err != nil {
 					p.logger.Error("Failed to insert or update logs", "error", err, "execution_id", dbExecution.ID)
+				} else {
+					p.publishExecutionLogs(projectTask, dbExecution.ID, logs)
 				}
 			case stderr := <-stderrChannel:
 				p.logger.Error("AI Planning execution stderr", "task_id", payload.TaskID, "execution_id", execution.ID, "stderr", stderr)
@@ -326,11 +504,26 @@ func (p *Processor) getAiExecutor(aiType string) (ai.AiCodingCli, error) {
 	case "deep-seek":
 		aiExecutor := aiexecutors.NewDeepSeekExecutor()
 		return aiExecutor, nil
+	case "ollama":
+		aiExecutor := aiexecutors.NewOllamaExecutor()
+		return aiExecutor, nil
 	default:
 		return nil, fmt.Errorf("invalid execution type: %s", aiType)
 	}
 }
 
+// loadProjectSettings loads the project's settings, whose prompt template
+// the AI runs on its tasks apply. Runs go on without it when they cannot be
+// loaded.
+func (p *Processor) loadProjectSettings(ctx context.Context, project *entity.Project) {
+	settings, err := p.projectUsecase.GetSettings(ctx, project.ID)
+	if err != nil {
+		p.logger.Warn("Failed to load project settings, running without its prompt template", "error", err, "project_id", project.ID)
+		return
+	}
+	project.Settings = settings
+}
+
 func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.Task) error {
 	p.logger.Info("Processing task implementation job!!!!!!")
 
@@ -343,6 +536,22 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 		"task_id", payload.TaskID,
 		"project_id", payload.ProjectID)
 
+	if payload.DryRun {
+		return p.processDryRun(ctx, payload)
+	}
+
+	// The lock is held until the execution started below is finished with
+	unlock, err := p.lockTask(ctx, payload.TaskID)
+	if err != nil {
+		return err
+	}
+	handedOff := false
+	defer func() {
+		if !handedOff {
+			unlock()
+		}
+	}()
+
 	// Step 1: Check current task status and update to IMPLEMENTING if needed
 	currentTask, err := p.taskUsecase.GetByID(ctx, payload.TaskID)
 	if err != nil {
@@ -350,15 +559,43 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 			"task_id", payload.TaskID, "error", err)
 		return fmt.Errorf("failed to get task: %w", err)
 	}
+	// A scheduled run is stale once the task left plan review
+	if payload.Scheduled && currentTask.Status != entity.TaskStatusPLANREVIEWING {
+		p.logger.Info("Skipping scheduled implementation, task is no longer in PLAN_REVIEWING", "task_id", payload.TaskID, "status", currentTask.Status)
+		return nil
+	}
+	if payload.RetryOfID != nil && p.staleRetry(ctx, *payload.RetryOfID, entity.ExecutionPhaseImplementation, currentTask.Status) {
+		return nil
+	}
+	releaseSlot, err := p.acquireProjectSlot(ctx, currentTask, func(delay time.Duration) error {
+		_, err := p.jobRequeuer.EnqueueTaskImplementation(payload, delay)
+		return err
+	})
+	if err != nil || releaseSlot == nil {
+		return err
+	}
+	unlockTask := unlock
+	unlock = func() {
+		releaseSlot()
+		unlockTask()
+	}
 
 	// Determine fallback status for error recovery.
 	// Tasks arriving from the planning flow (PLANREVIEWING → IMPLEMENTING) should revert
 	// to PLANREVIEWING on failure so the approved plan context is preserved.
 	// Direct-implementation tasks (TODO → IMPLEMENTING) revert to TODO.
+	// Follow-ups of a change request go back to CODE_REVIEWING, where their
+	// pull request still is.
 	fallbackStatus := entity.TaskStatusTODO
 	if currentTask.Status == entity.TaskStatusPLANREVIEWING {
 		fallbackStatus = entity.TaskStatusPLANREVIEWING
 	}
+	if payload.ChangeRequest != "" {
+		fallbackStatus = entity.TaskStatusCODEREVIEWING
+	}
+	if payload.ResumeExecutionID != nil {
+		fallbackStatus = p.resumeFallbackStatus(ctx, currentTask)
+	}
 
 	// Only update status to IMPLEMENTING if it's not already IMPLEMENTING
 	// This handles cases where the status was already updated by the handler
@@ -411,7 +648,9 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 
 		err = p.updateTaskWithGitInfo(ctx, payload.TaskID, worktree.BranchName, worktree.WorktreePath)
 		if err != nil {
-			_ = p.cleanupWorktree(ctx, worktree.WorktreePath)
+			if cleanupErr := p.cleanupWorktree(ctx, project, worktree); cleanupErr != nil {
+				p.logger.Error("Failed to clean up worktree", "task_id", payload.TaskID, "error", cleanupErr)
+			}
 			_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
 			_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Failed to update task with git info: %s", err.Error()))
 			p.logger.Error("Failed to update task with git info",
@@ -448,46 +687,103 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 		p.logger.Error("Failed to get AI executor", "task_id", payload.TaskID, "error", err)
 		return fmt.Errorf("failed to get AI executor: %w", err)
 	}
-	execution, injectEnvVars, err := p.executionService.StartExecution(projectTask, aiExecutor, false)
+	release, err := p.acquireExecutionSlot(ctx)
 	if err != nil {
 		_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
 		p.logger.Error("Failed to start AI execution", "task_id", payload.TaskID, "error", err)
-		return fmt.Errorf("failed to start AI execution: %w", err)
+		return err
 	}
+	p.loadProjectSettings(ctx, project)
+	projectTask.Project = project
+	executionTask := p.withWorkflowContext(ctx, p.withMediaContext(ctx, projectTask), false)
+	if payload.ChangeRequest != "" {
+		executionTask = p.withChangeRequestContext(ctx, executionTask, payload.ChangeRequest)
+	}
+	var execution *ai.Execution
+	var injectEnvVars map[string]string
+	var dbExecution *entity.Execution
+	// The span of the execution is ended once it is finished with, below
+	execCtx, execSpan := startExecutionSpan(ctx, payload.TaskID, payload.AIType, entity.ExecutionPhaseImplementation)
+	traceID := tracing.TraceID(execCtx)
+	if payload.ResumeExecutionID != nil {
+		// The answered execution goes on in its own session, on the changes
+		// its earlier run left in the worktree
+		execution, injectEnvVars, dbExecution, err = p.startResumedExecution(ctx, payload, executionTask, aiExecutor)
+		if err != nil {
+			release()
+			tracing.End(execSpan, err)
+			_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
+			_ = p.taskUsecase.AppendErrorLog(ctx, payload.TaskID, fmt.Sprintf("Failed to resume execution: %s", err.Error()))
+			p.logger.Error("Failed to resume AI execution", "task_id", payload.TaskID, "execution_id", *payload.ResumeExecutionID, "error", err)
+			return fmt.Errorf("failed to resume AI execution: %w", err)
+		}
+	} else {
+		execution, injectEnvVars, err = p.executionService.StartExecution(executionTask, aiExecutor, false)
+		if err != nil {
+			release()
+			tracing.End(execSpan, err)
+			_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
+			p.logger.Error("Failed to start AI execution", "task_id", payload.TaskID, "error", err)
+			return fmt.Errorf("failed to start AI execution: %w", err)
+		}
 
-	// Map AI execution to entity.Execution and save to database
-	dbExecution := &entity.Execution{
-		TaskID:    payload.TaskID,
-		Status:    entity.ExecutionStatus(execution.Status),
-		StartedAt: execution.StartedAt,
-		Progress:  execution.Progress,
-		Result:    nil,
-	}
+		// Map AI execution to entity.Execution and save to database
+		dbExecution = &entity.Execution{
+			TaskID:    payload.TaskID,
+			Status:    entity.ExecutionStatus(execution.Status),
+			StartedAt: execution.StartedAt,
+			Progress:  execution.Progress,
+			Result:    nil,
+			AIType:    payload.AIType,
+			Worker:    p.worker,
+			Phase:     entity.ExecutionPhaseImplementation,
+			Attempt:   max(payload.Attempt, 1),
+			RetryOfID: payload.RetryOfID,
+			TraceID:   traceID,
+		}
 
-	err = p.executionRepo.Create(ctx, dbExecution)
-	if err != nil {
-		_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
-		p.logger.Error("Failed to save execution to database", "task_id", payload.TaskID, "execution_id", execution.ID, "error", err)
-		return fmt.Errorf("failed to save execution to database: %w", err)
-	}
+		err = p.executionRepo.Create(ctx, dbExecution)
+		if err != nil {
+			release()
+			tracing.End(execSpan, err)
+			_ = p.updateTaskStatus(ctx, payload.TaskID, fallbackStatus)
+			p.logger.Error("Failed to save execution to database", "task_id", payload.TaskID, "execution_id", execution.ID, "error", err)
+			return fmt.Errorf("failed to save execution to database: %w", err)
+		}
 
-	p.logger.Info("Execution saved to database",
-		"task_id", payload.TaskID,
-		"ai_execution_id", execution.ID,
-		"db_execution_id", dbExecution.ID)
+		p.logger.Info("Execution saved to database",
+			"task_id", payload.TaskID,
+			"ai_execution_id", execution.ID,
+			"db_execution_id", dbExecution.ID)
+
+		// Keep what a previous attempt left in the worktree, so this run can be
+		// reverted to it
+		p.snapshotWorktree(ctx, projectTask, dbExecution.ID.String(), fmt.Sprintf("Before implementation execution %s", dbExecution.ID))
+	}
 
 	stdoutChannel := make(chan string)
 	stderrChannel := make(chan string)
 	execution.RegisterStdoutChannel(stdoutChannel)
 	execution.RegisterStderrChannel(stderrChannel)
 
+	execSpan.SetAttributes(attribute.String("execution.id", dbExecution.ID.String()))
+	p.trackExecution(dbExecution.ID, execution, fallbackStatus)
 	p.executionService.RunExecution(execution, injectEnvVars)
+	releaseWhenDone(execution, release)
 
+	handedOff = true
 	go func() {
+		defer unlock()
+		defer p.untrackExecution(dbExecution.ID)
+		defer endExecutionSpan(execSpan, execution)
 		for {
 			time.Sleep(1 * time.Second)
 			select {
 			case <-execution.GetContextDoneChannel():
+				if execution.IsCancelled() {
+					// ProcessExecutionCancel records the cancellation
+					return
+				}
 				completedAt := time.Now()
 
 				// Check if execution completed successfully or failed
@@ -495,12 +791,16 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 					p.logger.Error("AI execution failed", "task_id", payload.TaskID, "execution_id", execution.ID, "error", execution.Error)
 					_ = p.updateTaskStatus(context.Background(), payload.TaskID, fallbackStatus)
 					_ = p.taskUsecase.AppendErrorLog(context.Background(), payload.TaskID, fmt.Sprintf("Implementation failed: %s", execution.Error))
+					p.recordFileAccessViolation(context.Background(), dbExecution, execution)
 
 					// Mark execution as failed
 					err := p.executionRepo.MarkFailed(context.Background(), dbExecution.ID, completedAt, execution.Error)
 					if err != nil {
 						p.logger.Error("Failed to mark execution as failed", "error", err, "execution_id", dbExecution.ID)
 					}
+					p.publishExecutionCompleted(context.Background(), projectTask, dbExecution, completedAt, execution.Error)
+					p.recordSpend(context.Background(), projectTask, dbExecution, aiExecutor, execution)
+					p.retryFailedExecution(context.Background(), dbExecution)
 
 					// Create failure log entry
 					// failureLog := &entity.ExecutionLog{
@@ -516,15 +816,25 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 				} else {
 					p.logger.Info("AI execution completed successfully", "task_id", payload.TaskID, "execution_id", execution.ID)
 
+					if execution.Result != nil {
+						if question, sessionID := aiExecutor.ParseOutputToQuestion(execution.Result.Output); question != "" {
+							p.pauseForQuestion(context.Background(), projectTask, dbExecution, aiExecutor, execution, question, sessionID)
+							return
+						}
+					}
+
 					// Update execution status to COMPLETED
 					err := p.executionRepo.MarkCompleted(context.Background(), dbExecution.ID, completedAt, nil)
 					if err != nil {
 						p.logger.Error("Failed to mark execution as completed", "error", err, "execution_id", dbExecution.ID)
 					}
-					// Execute PR creation workflow
-					p.executePRCreationWorkflow(context.Background(), projectTask, plan, dbExecution)
+					p.publishExecutionCompleted(context.Background(), projectTask, dbExecution, completedAt, "")
+					p.recordSpend(context.Background(), projectTask, dbExecution, aiExecutor, execution)
 
-					_ = p.updateTaskStatus(context.Background(), payload.TaskID, entity.TaskStatusCODEREVIEWING)
+					if payload.ChangeRequest != "" {
+						p.commitChangeRequest(context.Background(), projectTask, payload.ChangeRequest)
+					}
+					p.finishImplementation(context.WithoutCancel(ctx), project, projectTask, plan, dbExecution, aiExecutor, fallbackStatus)
 
 					// // Create completion log entry
 					// completionLog := &entity.ExecutionLog{
@@ -556,10 +866,13 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 				// assign execution id to each log
 				for _, log := range logs {
 					log.ExecutionID = dbExecution.ID
+					log.TraceID = traceID
 				}
 				err := p.executionLogRepo.BatchInsertOrUpdate(context.Background(), logs)
 				if err != nil {
 					p.logger.Error("Failed to insert or update logs", "error", err, "execution_id", dbExecution.ID)
+				} else {
+					p.publishExecutionLogs(projectTask, dbExecution.ID, logs)
 				}
 			case stderr := <-stderrChannel:
 				p.logger.Error("AI execution stderr", "task_id", payload.TaskID, "execution_id", execution.ID, "stderr", stderr)
@@ -595,6 +908,48 @@ func (p *Processor) ProcessTaskImplementation(ctx context.Context, task *asynq.T
 	return nil
 }
 
+// finishImplementation verifies a completed implementation, opens its pull
+// request and moves the task to code review. When verification fails the
+// task goes back to fallbackStatus with the changes kept in the worktree.
+func (p *Processor) finishImplementation(ctx context.Context, project *entity.Project, projectTask *entity.Task, plan *entity.Plan, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli, fallbackStatus entity.TaskStatus) {
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ExecutionID: dbExecution.ID})
+
+	// Run the project's verification pipeline before anything is pushed
+	pipeline := p.withWorkflowPipeline(ctx, projectTask, p.verificationPipeline(ctx, project))
+	verificationRuns, blockedBy := p.verifyImplementation(ctx, project, pipeline, projectTask, dbExecution, aiExecutor)
+	// Give the AI a chance to fix a failing build or test run
+	verificationRuns, blockedBy = p.autoFix(ctx, project, pipeline, projectTask, dbExecution, aiExecutor, verificationRuns, blockedBy)
+	if blockedBy != nil {
+		p.logger.Warn("Verification failed, pull request creation blocked", "task_id", projectTask.ID, "step", blockedBy.Step, "exit_code", blockedBy.ExitCode)
+		_ = p.updateTaskStatus(ctx, projectTask.ID, fallbackStatus)
+		_ = p.taskUsecase.AppendErrorLog(ctx, projectTask.ID,
+			blockedReason(blockedBy)+fixAttemptsNote(dbExecution)+"; the changes were kept in the worktree and no pull request was created")
+		return
+	}
+	dbExecution.VerificationRuns = verificationRuns
+
+	// Record how the changes moved the test coverage
+	p.measureCoverage(ctx, project, projectTask, dbExecution)
+
+	// Execute PR creation workflow, or export the changes when the project
+	// has no remote git provider
+	var blocked bool
+	if project.ExportPatches {
+		blocked = p.executePatchExportWorkflow(ctx, projectTask, dbExecution)
+	} else {
+		blocked = p.executePRCreationWorkflow(ctx, projectTask, plan, dbExecution)
+	}
+	if blocked {
+		_ = p.updateTaskStatus(ctx, projectTask.ID, fallbackStatus)
+		return
+	}
+
+	_ = p.updateTaskStatus(ctx, projectTask.ID, entity.TaskStatusCODEREVIEWING)
+
+	// Let reviewers click through the changes
+	p.startPreview(ctx, project, projectTask)
+}
+
 // updateTaskStatus updates the task status and broadcasts WebSocket notification
 func (p *Processor) updateTaskStatus(ctx context.Context, taskID uuid.UUID, status entity.TaskStatus) error {
 	p.logger.Info("Updating task status", "task_id", taskID, "status", status)
@@ -675,13 +1030,33 @@ func (p *Processor) updateTaskStatus(ctx context.Context, taskID uuid.UUID, stat
 
 		p.logger.Info("Sent WebSocket notifications for status change",
 			"task_id", taskID, "old_status", oldStatus, "new_status", status)
+
+		if task.EpicID != nil && p.epicUsecase != nil {
+			p.notifyEpicProgress(ctx, *task.EpicID, task.ProjectID)
+		}
 	}
 
 	return nil
 }
 
+// notifyEpicProgress sends the epic's new rollup after one of its tasks
+// changed status
+func (p *Processor) notifyEpicProgress(ctx context.Context, epicID, projectID uuid.UUID) {
+	progress, err := p.epicUsecase.GetProgress(ctx, epicID)
+	if err != nil {
+		p.logger.Warn("Failed to get epic progress", "epic_id", epicID, "error", err)
+		return
+	}
+	if err := p.wsService.NotifyEpicProgressUpdated(epicID, projectID, progress); err != nil {
+		p.logger.Warn("Failed to send epic progress notification", "epic_id", epicID, "error", err)
+	}
+}
+
 // createWorktree creates a git worktree for the task
-func (p *Processor) createWorktree(ctx context.Context, project *entity.Project, task *entity.Task, useRemoteBranch bool) (*entity.Worktree, error) {
+func (p *Processor) createWorktree(ctx context.Context, project *entity.Project, task *entity.Task, useRemoteBranch bool) (worktree *entity.Worktree, err error) {
+	ctx, span := tracing.Start(ctx, "worktree.create", trace.WithAttributes(attribute.String("task.id", task.ID.String())))
+	defer func() { tracing.End(span, err) }()
+
 	if project.WorktreeBasePath == "" {
 		return nil, fmt.Errorf("project has no worktree base path configured")
 	}
@@ -698,7 +1073,7 @@ func (p *Processor) createWorktree(ctx context.Context, project *entity.Project,
 	}
 
 	// Create worktree from the task's base branch (set during StartPlanning / Create Worktree)
-	worktree, err := p.worktreeUsecase.CreateWorktreeForTask(ctx, usecase.CreateWorktreeRequest{
+	worktree, err = p.worktreeUsecase.CreateWorktreeForTask(ctx, usecase.CreateWorktreeRequest{
 		TaskID:          task.ID,
 		ProjectID:       project.ID,
 		TaskTitle:       task.Title,
@@ -732,19 +1107,47 @@ func (p *Processor) updateTaskWithGitInfo(ctx context.Context, taskID uuid.UUID,
 	return err
 }
 
-// cleanupWorktree removes the worktree directory
-func (p *Processor) cleanupWorktree(ctx context.Context, worktreePath string) error {
-	if worktreePath == "" {
+// cleanupWorktree removes a worktree created for a failed attempt: the git
+// worktree, its directory, its branch and its record, so a retry starts over
+func (p *Processor) cleanupWorktree(ctx context.Context, project *entity.Project, worktree *entity.Worktree) error {
+	if worktree.WorktreePath == "" {
 		p.logger.Warn("Empty worktree path, skipping cleanup")
 		return nil
 	}
 
-	p.logger.Info("Cleaning up worktree", "path", worktreePath)
+	p.logger.Info("Cleaning up worktree", "path", worktree.WorktreePath, "branch_name", worktree.BranchName)
+
+	// Unregister the worktree from the project repository; the directory is
+	// removed below either way
+	if _, err := os.Stat(worktree.WorktreePath); err == nil {
+		if err := p.gitManager.DeleteWorktree(ctx, &git.DeleteWorktreeRequest{
+			WorkingDir:   project.WorktreeBasePath,
+			WorktreePath: worktree.WorktreePath,
+		}); err != nil {
+			p.logger.Warn("Failed to delete git worktree", "path", worktree.WorktreePath, "error", err)
+		}
+	}
+	if err := os.RemoveAll(worktree.WorktreePath); err != nil {
+		return fmt.Errorf("failed to remove worktree directory: %w", err)
+	}
+
+	// The branch has nothing worth keeping yet
+	if worktree.BranchName != "" {
+		if err := p.gitManager.DeleteBranch(ctx, project.WorktreeBasePath, worktree.BranchName, true); err != nil {
+			p.logger.Warn("Failed to delete worktree branch", "branch_name", worktree.BranchName, "error", err)
+		}
+	}
+
+	if err := p.worktreeUsecase.CleanupWorktreeForTask(ctx, usecase.CleanupWorktreeRequest{
+		TaskID:     worktree.TaskID,
+		ProjectID:  worktree.ProjectID,
+		BranchName: worktree.BranchName,
+		Force:      true,
+	}); err != nil {
+		return fmt.Errorf("failed to remove worktree record: %w", err)
+	}
 
-	// This would use git worktree remove command
-	// For now, just log the cleanup attempt
-	// TODO: Implement actual worktree cleanup using git commands
-	p.logger.Warn("Worktree cleanup not implemented yet", "path", worktreePath)
+	p.logger.Info("Worktree cleaned up", "path", worktree.WorktreePath, "task_id", worktree.TaskID)
 	return nil
 }
 
@@ -777,6 +1180,12 @@ func (p *Processor) savePlanAndUpdateStatus(ctx context.Context, taskID uuid.UUI
 
 	p.logger.Info("Plan status updated to REVIEWING", "plan_id", plan.ID)
 
+	// Flag sensitive plans before anyone can approve them
+	if err := p.flagHighRiskPlan(ctx, taskID, planContent); err != nil {
+		p.logger.Error("Failed to check plan for high-risk paths", "task_id", taskID, "error", err)
+		return err
+	}
+
 	// Update task status to PLAN_REVIEWING with WebSocket broadcast
 	err = p.updateTaskStatus(ctx, taskID, entity.TaskStatusPLANREVIEWING)
 	if err != nil {
@@ -785,17 +1194,37 @@ func (p *Processor) savePlanAndUpdateStatus(ctx context.Context, taskID uuid.UUI
 	}
 
 	p.logger.Info("Task status updated to PLAN_REVIEWING", "task_id", taskID)
+
+	if task, err := p.taskUsecase.GetByID(ctx, taskID); err != nil {
+		p.logger.Warn("Failed to get task for plan_ready webhooks", "task_id", taskID, "error", err)
+	} else {
+		p.publishWebhook(ctx, task, entity.WebhookEventPlanReady, usecase.WebhookPlanReadyData{
+			TaskID:    task.ID,
+			TaskTitle: task.Title,
+			PlanID:    plan.ID,
+			Content:   plan.Content,
+		})
+		if p.githubIssueUsecase != nil {
+			if err := p.githubIssueUsecase.CommentPlan(ctx, task, plan); err != nil {
+				p.logger.Warn("Failed to post plan to GitHub issue", "task_id", taskID, "error", err)
+			}
+		}
+		p.notify(ctx, task, func(project *entity.Project) error {
+			return p.notificationUsecase.SendPlanReadyNotification(ctx, task, project, plan)
+		})
+	}
 	return nil
 }
 
-// executePRCreationWorkflow handles the automated PR creation workflow after successful AI implementation
-func (p *Processor) executePRCreationWorkflow(ctx context.Context, projectTask *entity.Task, plan *entity.Plan, dbExecution *entity.Execution) {
+// executePRCreationWorkflow handles the automated PR creation workflow after successful AI implementation.
+// It returns whether the push was blocked because the changes add secrets.
+func (p *Processor) executePRCreationWorkflow(ctx context.Context, projectTask *entity.Task, plan *entity.Plan, dbExecution *entity.Execution) bool {
 	p.logger.Info("Starting PR creation workflow", "task_id", projectTask.ID)
 
 	// Step 1: Check if task has a worktree path
 	if projectTask.WorktreePath == nil {
 		p.logger.Error("Task has no worktree path, cannot commit and push changes", "task_id", projectTask.ID)
-		return
+		return false
 	}
 
 	// Step 2: Check if there are pending changes in the worktree
@@ -805,41 +1234,64 @@ func (p *Processor) executePRCreationWorkflow(ctx context.Context, projectTask *
 		// Continue without failing the entire workflow
 	}
 
-	// Step 3: Commit and push changes if any exist
-	if hasPendingChanges {
-		commitMessage := fmt.Sprintf("Implement task: %s\n\nTask ID: %s\nAI Implementation completed via Auto-Devs\n\n- %s",
-			projectTask.Title,
-			projectTask.ID.String(),
-			projectTask.Description)
+	// Step 3: Make sure the AI did not add credentials or keys. The changes
+	// stay committed in the worktree when it did, or when they could not be
+	// scanned.
+	secrets, err := p.scanForSecrets(ctx, projectTask, dbExecution)
+	if err != nil {
+		p.logger.Error("Failed to scan changes for secrets, push blocked", "error", err, "task_id", projectTask.ID)
+		_ = p.taskUsecase.AppendErrorLog(ctx, projectTask.ID, secretScanFailedReason(err))
+		return true
+	}
+	if len(secrets) > 0 {
+		p.logger.Warn("Secrets found in the changes, push blocked", "task_id", projectTask.ID, "findings", len(secrets))
+		_ = p.taskUsecase.AppendErrorLog(ctx, projectTask.ID, secretsFoundReason(secrets))
+		return true
+	}
 
-		err = p.gitManager.CommitAndPush(ctx, *projectTask.WorktreePath, commitMessage, "origin", *projectTask.BranchName)
-		if err != nil {
-			p.logger.Error("Failed to commit and push changes", "error", err, "task_id", projectTask.ID)
-			// Don't fail the workflow, but log the error
-			return
-		} else {
-			p.logger.Info("Successfully committed and pushed changes", "task_id", projectTask.ID, "branch", *projectTask.BranchName)
-		}
-	} else {
+	// Step 4: Commit pending changes and push. Verification may already have
+	// committed the changes locally, so the branch is pushed either way.
+	if !hasPendingChanges {
 		p.logger.Info("No pending changes to commit", "task_id", projectTask.ID)
 	}
+	err = p.gitManager.CommitAndPush(ctx, *projectTask.WorktreePath, implementationCommitMessage(projectTask), "origin", *projectTask.BranchName)
+	if err != nil {
+		p.logger.Error("Failed to commit and push changes", "error", err, "task_id", projectTask.ID)
+		// Don't fail the workflow, but log the error
+		return false
+	}
+	p.logger.Info("Successfully committed and pushed changes", "task_id", projectTask.ID, "branch", *projectTask.BranchName)
 
-	// Step 4: Create PR using the existing PRCreator service
+	// Step 5: Create PR using the existing PRCreator service
 	if p.prCreator != nil && projectTask.BranchName != nil {
 		project, err := p.projectUsecase.GetByID(ctx, projectTask.ProjectID)
 		if err != nil {
 			p.logger.Error("Failed to get project", "error", err, "task_id", projectTask.ID)
-			return
+			return false
 		}
 		projectTask.Project = project
+
+		// A later push to a task that already has an open PR only refreshes
+		// its quality gate summary
+		existing, err := p.prRepo.GetByTaskID(ctx, projectTask.ID)
+		if err != nil {
+			p.logger.Error("Failed to get existing PR", "error", err, "task_id", projectTask.ID)
+		}
+		if existing != nil && existing.Status == entity.PullRequestStatusOpen {
+			p.logger.Info("Task already has an open PR, updating it", "pr_number", existing.GitHubPRNumber, "task_id", projectTask.ID)
+			p.postQualityGateComment(ctx, projectTask, existing, dbExecution)
+			p.sendPRNotification(ctx, projectTask.ProjectID, existing, "pr_updated")
+			return false
+		}
+
 		pr, err := p.prCreator.CreatePRFromImplementation(ctx, *projectTask, *dbExecution, plan)
 		if err != nil {
 			p.logger.Error("Failed to create PR", "error", err, "task_id", projectTask.ID)
 			// Don't fail the workflow, log and continue
-			return
+			return false
 		}
 
-		// Step 5: Save PR to database
+		// Step 6: Save PR to database
 		if err := p.prRepo.Create(ctx, pr); err != nil {
 			p.logger.Error("Failed to save PR to database", "error", err, "pr_id", pr.ID, "task_id", projectTask.ID)
 		} else {
@@ -848,8 +1300,21 @@ func (p *Processor) executePRCreationWorkflow(ctx context.Context, projectTask *
 				"task_id", projectTask.ID,
 				"pr_id", pr.ID)
 
-			// Step 6: Send WebSocket notification about PR creation
+			// Step 7: Send WebSocket notification about PR creation
 			p.sendPRNotification(ctx, projectTask.ProjectID, pr, "pr_created")
+			if p.githubIssueUsecase != nil {
+				if err := p.githubIssueUsecase.CommentPullRequest(ctx, projectTask, pr); err != nil {
+					p.logger.Warn("Failed to post PR to GitHub issue", "task_id", projectTask.ID, "error", err)
+				}
+			}
+
+			// Step 8: Sum up the verification results in a PR comment
+			p.postQualityGateComment(ctx, projectTask, pr, dbExecution)
+
+			// Step 9: Post the AI review findings on the PR
+			if project.PostReviewComments {
+				p.postReviewComments(ctx, projectTask, pr, dbExecution.ReviewComments)
+			}
 		}
 	} else {
 		p.logger.Warn("PR creation skipped - missing required services or branch name",
@@ -857,6 +1322,41 @@ func (p *Processor) executePRCreationWorkflow(ctx context.Context, projectTask *
 			"has_pr_creator", p.prCreator != nil,
 			"has_branch_name", projectTask.BranchName != nil)
 	}
+	return false
+}
+
+// postQualityGateComment creates or refreshes the PR's quality gate summary
+// and saves the comment's ID on the PR
+func (p *Processor) postQualityGateComment(ctx context.Context, task *entity.Task, pr *entity.PullRequest, execution *entity.Execution) {
+	hadComment := pr.QualityGateCommentID != nil
+	if err := p.prCreator.PostQualityGateComment(ctx, *task, pr, *execution); err != nil {
+		p.logger.Error("Failed to post quality gate comment", "error", err, "task_id", task.ID, "pr_number", pr.GitHubPRNumber)
+		return
+	}
+	if hadComment {
+		return
+	}
+	if err := p.prRepo.Update(ctx, pr); err != nil {
+		p.logger.Error("Failed to save quality gate comment ID", "error", err, "task_id", task.ID, "pr_id", pr.ID)
+	}
+}
+
+// implementationCommitMessage is the message of the commit holding the AI's
+// changes for task
+func implementationCommitMessage(task *entity.Task) string {
+	return i18n.T(taskLocale(task), "commit.implementation",
+		task.Title,
+		task.ID.String(),
+		task.Description)
+}
+
+// taskLocale is the locale of the texts generated for task, its project's
+// or the default when the project is not loaded
+func taskLocale(task *entity.Task) i18n.Locale {
+	if task.Project == nil {
+		return i18n.DefaultLocale
+	}
+	return i18n.Parse(task.Project.Locale)
 }
 
 // sendPRNotification sends WebSocket notification about PR events
@@ -998,10 +1498,15 @@ func (p *Processor) cleanupTaskWorktree(ctx context.Context, task *entity.Task)
 		p.logger.Info("Successfully removed git worktree", "task_id", task.ID)
 	}
 
-	// Step 2: Delete branch if it exists
+	// Step 2: Delete branch if it exists and nothing needs it
 	if task.BranchName != nil && *task.BranchName != "" {
 		branchName := *task.BranchName
-		if err := p.gitManager.DeleteBranch(ctx, project.WorktreeBasePath, branchName, true); err != nil {
+		if reason := p.branchKeepReason(ctx, project, task, branchName); reason != "" {
+			p.logger.Info("Keeping branch",
+				"task_id", task.ID,
+				"branch_name", branchName,
+				"reason", reason)
+		} else if err := p.gitManager.DeleteBranch(ctx, project.WorktreeBasePath, branchName, true); err != nil {
 			p.logger.Warn("Failed to delete branch, continuing with cleanup",
 				"task_id", task.ID,
 				"branch_name", branchName,
@@ -1071,6 +1576,10 @@ func (p *Processor) removeWorktreeFolder(worktreePath string) error {
 	return nil
 }
 
+// webhookSourceTTL is how long after its last PR webhook a repository is
+// left to its webhooks instead of being polled
+const webhookSourceTTL = 24 * time.Hour
+
 // ProcessPRStatusSync processes PR status sync jobs
 func (p *Processor) ProcessPRStatusSync(ctx context.Context, task *asynq.Task) error {
 	p.logger.Info("Processing PR status sync job")
@@ -1089,8 +1598,14 @@ func (p *Processor) ProcessPRStatusSync(ctx context.Context, task *asynq.Task) e
 
 	p.logger.Info("Found open PRs to check", "count", len(openPRs))
 
+	// Repositories sending PR webhooks are synced as their events arrive
+	hooked := p.webhookSourceRepositories(ctx)
+
 	// Process each open PR
 	for _, pr := range openPRs {
+		if hooked[pr.Repository] {
+			continue
+		}
 		if err := p.processSinglePR(ctx, pr); err != nil {
 			p.logger.Error("Failed to process PR",
 				"pr_id", pr.ID,
@@ -1105,6 +1620,45 @@ func (p *Processor) ProcessPRStatusSync(ctx context.Context, task *asynq.Task) e
 	return nil
 }
 
+// ProcessPRSync processes jobs syncing a single PR a webhook reported a
+// change of
+func (p *Processor) ProcessPRSync(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParsePRSyncPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse PR sync payload: %w", err)
+	}
+
+	pr, err := p.prRepo.GetByID(ctx, payload.PullRequestID)
+	if err != nil {
+		return fmt.Errorf("failed to get PR: %w", err)
+	}
+	if pr.Status != entity.PullRequestStatusOpen {
+		p.logger.Debug("Skipping sync of PR no longer open", "pr_id", pr.ID, "status", pr.Status)
+		return nil
+	}
+
+	return p.processSinglePR(ctx, pr)
+}
+
+// webhookSourceRepositories returns the repositories which sent a PR webhook
+// within webhookSourceTTL, which the PR status sync leaves alone. On failure
+// every repository is polled.
+func (p *Processor) webhookSourceRepositories(ctx context.Context) map[string]bool {
+	if p.webhookDeliveryRepo == nil {
+		return nil
+	}
+	repos, err := p.webhookDeliveryRepo.ListSourcesSince(ctx, entity.WebhookProviderGitHub, time.Now().Add(-webhookSourceTTL))
+	if err != nil {
+		p.logger.Warn("Failed to list webhook sources, polling every repository", "error", err)
+		return nil
+	}
+	hooked := make(map[string]bool, len(repos))
+	for _, repo := range repos {
+		hooked[repo] = true
+	}
+	return hooked
+}
+
 // processSinglePR checks and updates the status of a single PR
 func (p *Processor) processSinglePR(ctx context.Context, pr *entity.PullRequest) error {
 	p.logger.Debug("Checking PR status",
@@ -1113,10 +1667,14 @@ func (p *Processor) processSinglePR(ctx context.Context, pr *entity.PullRequest)
 		"repository", pr.Repository,
 		"current_status", pr.Status)
 
-	// Get current PR status from GitHub
-	updatedPR, err := p.githubService.GetPullRequest(ctx, pr.Repository, pr.GitHubPRNumber)
+	// Get current PR status from the project's git provider, with its token
+	task, err := p.taskUsecase.GetByID(ctx, pr.TaskID)
+	if err != nil {
+		return fmt.Errorf("failed to get PR task: %w", err)
+	}
+	updatedPR, err := p.githubService.GetPullRequest(github.WithProjectID(ctx, task.ProjectID), pr.Repository, pr.GitHubPRNumber)
 	if err != nil {
-		return fmt.Errorf("failed to get PR from GitHub: %w", err)
+		return fmt.Errorf("failed to get PR from git provider: %w", err)
 	}
 
 	// Check if PR status has changed
@@ -1140,6 +1698,19 @@ func (p *Processor) processSinglePR(ctx context.Context, pr *entity.PullRequest)
 
 		// If PR was merged, automatically mark associated task as DONE
 		if updatedPR.Status == entity.PullRequestStatusMerged {
+			p.publishWebhook(ctx, task, entity.WebhookEventPRMerged, usecase.WebhookPRMergedData{
+				TaskID:         task.ID,
+				TaskTitle:      task.Title,
+				Number:         pr.GitHubPRNumber,
+				Repository:     pr.Repository,
+				URL:            pr.GitHubURL,
+				MergeCommitSHA: pr.MergeCommitSHA,
+				MergedBy:       pr.MergedBy,
+				MergedAt:       pr.MergedAt,
+			})
+			p.notify(ctx, task, func(project *entity.Project) error {
+				return p.notificationUsecase.SendPRMergedNotification(ctx, task, project, pr)
+			})
 			if err := p.autoCompleteTask(ctx, pr.TaskID); err != nil {
 				p.logger.Error("Failed to auto-complete task",
 					"task_id", pr.TaskID,
@@ -1156,11 +1727,27 @@ func (p *Processor) processSinglePR(ctx context.Context, pr *entity.PullRequest)
 
 		// Send WebSocket notification about PR status change
 		p.sendPRStatusChangeNotification(ctx, pr, string(pr.Status), string(updatedPR.Status))
+	} else if pr.IsDraft != updatedPR.IsDraft || !equalBoolPtr(pr.Mergeable, updatedPR.Mergeable) || !equalStringPtr(pr.MergeableState, updatedPR.MergeableState) {
+		// A push or finished check suite changes whether the PR can be merged
+		pr.IsDraft = updatedPR.IsDraft
+		pr.Mergeable = updatedPR.Mergeable
+		pr.MergeableState = updatedPR.MergeableState
+		if err := p.prRepo.Update(ctx, pr); err != nil {
+			return fmt.Errorf("failed to update PR mergeability in database: %w", err)
+		}
 	}
 
 	return nil
 }
 
+func equalBoolPtr(a, b *bool) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalStringPtr(a, b *string) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
 // autoCompleteTask automatically marks a task as DONE when its PR is merged
 func (p *Processor) autoCompleteTask(ctx context.Context, taskID uuid.UUID) error {
 	p.logger.Info("Auto-completing task", "task_id", taskID)
@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanupWorktree(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	worktreeUsecase := usecase.NewWorktreeUsecaseMock(t)
+	processor := &Processor{gitManager: gitManager, worktreeUsecase: worktreeUsecase, logger: slog.Default()}
+
+	repo := initRepo(t)
+	project := &entity.Project{WorktreeBasePath: repo}
+	worktree := &entity.Worktree{
+		TaskID:       uuid.New(),
+		ProjectID:    uuid.New(),
+		BranchName:   "task-123-add-login",
+		WorktreePath: filepath.Join(t.TempDir(), "task-123"),
+	}
+	out, err := exec.Command("git", "-C", repo, "worktree", "add", "-q", "-b", worktree.BranchName, worktree.WorktreePath).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	worktreeUsecase.EXPECT().CleanupWorktreeForTask(mock.Anything, usecase.CleanupWorktreeRequest{
+		TaskID:     worktree.TaskID,
+		ProjectID:  worktree.ProjectID,
+		BranchName: worktree.BranchName,
+		Force:      true,
+	}).Return(nil).Once()
+
+	require.NoError(t, processor.cleanupWorktree(context.Background(), project, worktree))
+	assert.NoDirExists(t, worktree.WorktreePath)
+	out, err = exec.Command("git", "-C", repo, "worktree", "list").CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.NotContains(t, string(out), worktree.WorktreePath)
+	out, err = exec.Command("git", "-C", repo, "branch", "--list", worktree.BranchName).CombinedOutput()
+	require.NoError(t, err, string(out))
+	assert.Empty(t, string(out), "the branch is deleted")
+}
+
+func TestCleanupWorktree_AlreadyRemoved(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	worktreeUsecase := usecase.NewWorktreeUsecaseMock(t)
+	processor := &Processor{gitManager: gitManager, worktreeUsecase: worktreeUsecase, logger: slog.Default()}
+
+	worktree := &entity.Worktree{
+		TaskID:       uuid.New(),
+		ProjectID:    uuid.New(),
+		BranchName:   "task-456-missing",
+		WorktreePath: filepath.Join(t.TempDir(), "task-456"),
+	}
+	worktreeUsecase.EXPECT().CleanupWorktreeForTask(mock.Anything, mock.Anything).Return(nil).Once()
+
+	assert.NoError(t, processor.cleanupWorktree(context.Background(), &entity.Project{WorktreeBasePath: initRepo(t)}, worktree),
+		"a half-created worktree is still cleaned up")
+}
+
+func TestProcessWorktreeValidate(t *testing.T) {
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	worktreeUsecase := usecase.NewWorktreeUsecaseMock(t)
+	processor := &Processor{taskUsecase: taskUsecase, worktreeUsecase: worktreeUsecase, logger: slog.Default()}
+
+	path := "/worktrees/project-1/task-1"
+	broken := &entity.Task{ID: uuid.New(), WorktreePath: &path}
+	healthy := &entity.Task{ID: uuid.New(), WorktreePath: &path}
+	taskUsecase.EXPECT().GetTasksWithWorktree(mock.Anything).Return([]*entity.Task{broken, healthy}, nil).Once()
+	worktreeUsecase.EXPECT().RepairTaskWorktree(mock.Anything, broken).Return(usecase.WorktreeRepairNone, assert.AnError).Once()
+	worktreeUsecase.EXPECT().RepairTaskWorktree(mock.Anything, healthy).Return(usecase.WorktreeRepairNone, nil).Once()
+
+	job, err := NewWorktreeValidateJob()
+	require.NoError(t, err)
+	assert.NoError(t, processor.ProcessWorktreeValidate(context.Background(), job), "one task failing does not fail the job")
+}
+
+func TestImplementationCommitMessage_UsesProjectLocale(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), Title: "Add login", Description: "Login form"}
+	assert.Contains(t, implementationCommitMessage(task), "Implement task: Add login\n", "tasks without a loaded project use English")
+
+	task.Project = &entity.Project{Locale: "vi"}
+	assert.Contains(t, implementationCommitMessage(task), "Triển khai task: Add login\n")
+}
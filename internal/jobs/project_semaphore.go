@@ -0,0 +1,242 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+// ProjectSemaphore bounds how many planning and implementation executions
+// of a project run at once across the workers
+type ProjectSemaphore interface {
+	// Acquire takes one of the project's limit slots for the task and
+	// returns the function releasing it. When all are taken, release is nil
+	// and position is the task's place in the project's queue, 1 being
+	// next; the task keeps its place while it asks again.
+	Acquire(ctx context.Context, projectID, taskID uuid.UUID, limit int) (release func(), position int, err error)
+}
+
+// JobRequeuer enqueues again the jobs waiting for a slot of their project
+type JobRequeuer interface {
+	EnqueueTaskPlanning(payload *TaskPlanningPayload, delay time.Duration) (*asynq.TaskInfo, error)
+	EnqueueTaskImplementation(payload *TaskImplementationPayload, delay time.Duration) (*asynq.TaskInfo, error)
+	EnqueueTaskComparison(payload *TaskComparisonPayload, delay time.Duration) (*asynq.TaskInfo, error)
+}
+
+const (
+	// projectSlotTTL is how long a slot outlives a worker that stopped
+	// renewing it, having crashed
+	projectSlotTTL = time.Minute
+	// projectSlotRetryInterval is how long a job waiting for a slot is
+	// requeued for
+	projectSlotRetryInterval = 15 * time.Second
+	// projectQueueTTL is how long a waiting task keeps its place without
+	// asking again, its job having been deleted
+	projectQueueTTL = 5 * time.Minute
+)
+
+// acquireProjectSlotScript takes a slot for ARGV[4] unless the project's
+// ARGV[3] slots are taken or tasks queued before it get the free ones. It
+// returns 0 when it took one, the task's position in the queue otherwise.
+// KEYS are the slot holders by expiry, the queue by arrival and the queue
+// by when each task last asked.
+var acquireProjectSlotScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local member = ARGV[4]
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now)
+for _, stale in ipairs(redis.call("ZRANGEBYSCORE", KEYS[3], "-inf", now - tonumber(ARGV[5]))) do
+	redis.call("ZREM", KEYS[2], stale)
+	redis.call("ZREM", KEYS[3], stale)
+end
+if not redis.call("ZSCORE", KEYS[1], member) then
+	redis.call("ZADD", KEYS[2], "NX", now, member)
+	redis.call("ZADD", KEYS[3], now, member)
+	local free = tonumber(ARGV[3]) - redis.call("ZCARD", KEYS[1])
+	if free < 0 then
+		free = 0
+	end
+	local rank = redis.call("ZRANK", KEYS[2], member)
+	if rank >= free then
+		return rank - free + 1
+	end
+	redis.call("ZREM", KEYS[2], member)
+	redis.call("ZREM", KEYS[3], member)
+end
+redis.call("ZADD", KEYS[1], now + tonumber(ARGV[2]), member)
+return 0`)
+
+// renewProjectSlotScript extends a slot only if it is still held
+var renewProjectSlotScript = redis.NewScript(`
+if redis.call("ZSCORE", KEYS[1], ARGV[1]) then
+	return redis.call("ZADD", KEYS[1], "XX", "CH", ARGV[2], ARGV[1])
+end
+return -1`)
+
+// RedisProjectSemaphore keeps the slots of projects in Redis, shared by
+// every worker. A slot is renewed while held and expires projectSlotTTL
+// after its worker stops.
+type RedisProjectSemaphore struct {
+	client *redis.Client
+	logger *slog.Logger
+}
+
+// NewRedisProjectSemaphore creates a project semaphore on the Redis the job
+// queues use
+func NewRedisProjectSemaphore(redisAddr, redisPassword string, redisDB int) *RedisProjectSemaphore {
+	return &RedisProjectSemaphore{
+		client: redis.NewClient(&redis.Options{
+			Addr:     redisAddr,
+			Password: redisPassword,
+			DB:       redisDB,
+		}),
+		logger: slog.Default().With("component", "project-semaphore"),
+	}
+}
+
+// Close closes the Redis client
+func (s *RedisProjectSemaphore) Close() error {
+	return s.client.Close()
+}
+
+// Acquire takes one of the project's slots for the task, or returns its
+// position in the project's queue
+func (s *RedisProjectSemaphore) Acquire(ctx context.Context, projectID, taskID uuid.UUID, limit int) (func(), int, error) {
+	prefix := "auto-devs:project-slots:" + projectID.String()
+	keys := []string{prefix, prefix + ":queue", prefix + ":queue-seen"}
+	member := taskID.String()
+
+	position, err := acquireProjectSlotScript.Run(ctx, s.client, keys,
+		time.Now().UnixMilli(), projectSlotTTL.Milliseconds(), limit, member, projectQueueTTL.Milliseconds()).Int()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to take project execution slot: %w", err)
+	}
+	if position > 0 {
+		return nil, position, nil
+	}
+
+	stop := make(chan struct{})
+	go s.renew(keys[0], member, stop)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			if err := s.client.ZRem(context.Background(), keys[0], member).Err(); err != nil {
+				s.logger.Error("Failed to release project execution slot", "project_id", projectID, "task_id", taskID, "error", err)
+			}
+		})
+	}, 0, nil
+}
+
+// renew extends the slot every third of its TTL until stop is closed
+func (s *RedisProjectSemaphore) renew(key, member string, stop <-chan struct{}) {
+	ticker := time.NewTicker(projectSlotTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			expiresAt := time.Now().Add(projectSlotTTL).UnixMilli()
+			renewed, err := renewProjectSlotScript.Run(context.Background(), s.client, []string{key}, member, expiresAt).Int()
+			if err != nil {
+				s.logger.Error("Failed to renew project execution slot", "key", key, "task_id", member, "error", err)
+			} else if renewed < 0 {
+				s.logger.Warn("Project execution slot expired while held", "key", key, "task_id", member)
+				return
+			}
+		}
+	}
+}
+
+// SetProjectSemaphore has the processor hold the tasks of projects with a
+// concurrency limit to it, requeueing the jobs of the tasks waiting for a
+// slot with requeuer. Without a semaphore, projects are not limited.
+func (p *Processor) SetProjectSemaphore(semaphore ProjectSemaphore, requeuer JobRequeuer) {
+	p.projectSemaphore = semaphore
+	p.jobRequeuer = requeuer
+}
+
+// acquireProjectSlot takes one of the execution slots of the task's project,
+// returning the function releasing it. When the project runs as many
+// executions as it allows, the job is requeued with requeue, the task told
+// its position in the project's queue, and release is nil.
+func (p *Processor) acquireProjectSlot(ctx context.Context, task *entity.Task, requeue func(delay time.Duration) error) (func(), error) {
+	if p.projectSemaphore == nil {
+		return func() {}, nil
+	}
+	project, err := p.projectUsecase.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if project.MaxConcurrentExecutions <= 0 {
+		return func() {}, nil
+	}
+
+	release, position, err := p.projectSemaphore.Acquire(ctx, project.ID, task.ID, project.MaxConcurrentExecutions)
+	if err != nil {
+		return nil, err
+	}
+	if release != nil {
+		if task.QueuePosition != nil {
+			p.updateQueuePosition(ctx, task, nil)
+		}
+		return release, nil
+	}
+
+	if err := requeue(projectSlotRetryInterval); err != nil {
+		return nil, fmt.Errorf("failed to requeue job waiting for a project execution slot: %w", err)
+	}
+	p.logger.Info("Project runs as many executions as it allows, job requeued",
+		"project_id", project.ID, "task_id", task.ID, "max_concurrent_executions", project.MaxConcurrentExecutions, "queue_position", position)
+	if task.QueuePosition == nil || *task.QueuePosition != position {
+		p.updateQueuePosition(ctx, task, &position)
+	}
+	return nil, nil
+}
+
+// updateQueuePosition saves the task's position in its project's queue and
+// tells the project's clients about it
+func (p *Processor) updateQueuePosition(ctx context.Context, task *entity.Task, position *int) {
+	oldPosition := task.QueuePosition
+	updated, err := p.taskUsecase.UpdateQueuePosition(ctx, task.ID, position)
+	if err != nil {
+		p.logger.Error("Failed to save queue position", "error", err, "task_id", task.ID)
+		return
+	}
+
+	changes := map[string]interface{}{
+		"queue_position": map[string]interface{}{
+			"old": oldPosition,
+			"new": position,
+		},
+	}
+	taskResponse := map[string]interface{}{
+		"id":             updated.ID.String(),
+		"project_id":     updated.ProjectID.String(),
+		"title":          updated.Title,
+		"status":         string(updated.Status),
+		"queue_position": updated.QueuePosition,
+		"updated_at":     updated.UpdatedAt,
+	}
+
+	if p.redisBroker != nil {
+		if err := p.redisBroker.PublishTaskUpdated(updated.ID, updated.ProjectID, changes, taskResponse); err != nil {
+			p.logger.Warn("Failed to publish queue position via Redis broker", "task_id", task.ID, "error", err)
+		} else {
+			return
+		}
+	}
+	if p.wsService != nil {
+		if err := p.wsService.NotifyTaskUpdated(updated.ID, updated.ProjectID, changes, taskResponse); err != nil {
+			p.logger.Warn("Failed to notify queue position via WebSocket", "task_id", task.ID, "error", err)
+		}
+	}
+}
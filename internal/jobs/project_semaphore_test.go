@@ -0,0 +1,218 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProjectSemaphore hands out limit slots per project and queues the
+// other tasks in the order they asked
+type fakeProjectSemaphore struct {
+	holders map[uuid.UUID][]uuid.UUID
+	queue   map[uuid.UUID][]uuid.UUID
+}
+
+func newFakeProjectSemaphore() *fakeProjectSemaphore {
+	return &fakeProjectSemaphore{holders: map[uuid.UUID][]uuid.UUID{}, queue: map[uuid.UUID][]uuid.UUID{}}
+}
+
+func (s *fakeProjectSemaphore) Acquire(ctx context.Context, projectID, taskID uuid.UUID, limit int) (func(), int, error) {
+	if len(s.holders[projectID]) < limit {
+		s.holders[projectID] = append(s.holders[projectID], taskID)
+		return func() {
+			holders := s.holders[projectID][:0]
+			for _, holder := range s.holders[projectID] {
+				if holder != taskID {
+					holders = append(holders, holder)
+				}
+			}
+			s.holders[projectID] = holders
+		}, 0, nil
+	}
+	for i, queued := range s.queue[projectID] {
+		if queued == taskID {
+			return nil, i + 1, nil
+		}
+	}
+	s.queue[projectID] = append(s.queue[projectID], taskID)
+	return nil, len(s.queue[projectID]), nil
+}
+
+// fakeJobRequeuer records the delays of the jobs requeued
+type fakeJobRequeuer struct {
+	planning       []time.Duration
+	implementation []time.Duration
+	comparison     []time.Duration
+}
+
+func (r *fakeJobRequeuer) EnqueueTaskPlanning(payload *TaskPlanningPayload, delay time.Duration) (*asynq.TaskInfo, error) {
+	r.planning = append(r.planning, delay)
+	return &asynq.TaskInfo{ID: uuid.NewString()}, nil
+}
+
+func (r *fakeJobRequeuer) EnqueueTaskImplementation(payload *TaskImplementationPayload, delay time.Duration) (*asynq.TaskInfo, error) {
+	r.implementation = append(r.implementation, delay)
+	return &asynq.TaskInfo{ID: uuid.NewString()}, nil
+}
+
+func (r *fakeJobRequeuer) EnqueueTaskComparison(payload *TaskComparisonPayload, delay time.Duration) (*asynq.TaskInfo, error) {
+	r.comparison = append(r.comparison, delay)
+	return &asynq.TaskInfo{ID: uuid.NewString()}, nil
+}
+
+func TestProcessTaskPlanning_RequeuesWhenProjectIsAtItsLimit(t *testing.T) {
+	ctx := context.Background()
+	project := &entity.Project{ID: uuid.New(), MaxConcurrentExecutions: 1}
+	task := &entity.Task{ID: uuid.New(), ProjectID: project.ID, Status: entity.TaskStatusTODO}
+	position := 1
+
+	semaphore := newFakeProjectSemaphore()
+	release, _, err := semaphore.Acquire(ctx, project.ID, uuid.New(), 1)
+	require.NoError(t, err)
+	require.NotNil(t, release)
+
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+	taskUsecase.EXPECT().UpdateQueuePosition(mock.Anything, task.ID, &position).Return(&entity.Task{ID: task.ID, ProjectID: project.ID, QueuePosition: &position}, nil).Once()
+	projectUsecase := usecase.NewProjectUsecaseMock(t)
+	projectUsecase.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil).Once()
+
+	requeuer := &fakeJobRequeuer{}
+	processor := &Processor{taskUsecase: taskUsecase, projectUsecase: projectUsecase, logger: slog.Default()}
+	processor.SetProjectSemaphore(semaphore, requeuer)
+
+	jobTask, err := NewTaskPlanningTask(TaskPlanningPayload{TaskID: task.ID, ProjectID: project.ID})
+	require.NoError(t, err)
+	require.NoError(t, processor.ProcessTaskPlanning(ctx, jobTask))
+
+	assert.Equal(t, []time.Duration{projectSlotRetryInterval}, requeuer.planning)
+}
+
+func TestProcessTaskComparisonAndDryRun_RequeueWhenProjectIsAtItsLimit(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*Processor, *fakeJobRequeuer, *entity.Task) {
+		project := &entity.Project{ID: uuid.New(), MaxConcurrentExecutions: 1}
+		task := &entity.Task{ID: uuid.New(), ProjectID: project.ID, Status: entity.TaskStatusTODO}
+		position := 1
+
+		semaphore := newFakeProjectSemaphore()
+		_, _, err := semaphore.Acquire(ctx, project.ID, uuid.New(), 1)
+		require.NoError(t, err)
+
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+		taskUsecase.EXPECT().UpdateQueuePosition(mock.Anything, task.ID, &position).Return(&entity.Task{ID: task.ID, ProjectID: project.ID, QueuePosition: &position}, nil).Once()
+		projectUsecase := usecase.NewProjectUsecaseMock(t)
+		projectUsecase.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil).Once()
+
+		requeuer := &fakeJobRequeuer{}
+		processor := &Processor{taskUsecase: taskUsecase, projectUsecase: projectUsecase, logger: slog.Default()}
+		processor.SetProjectSemaphore(semaphore, requeuer)
+		return processor, requeuer, task
+	}
+
+	t.Run("comparison", func(t *testing.T) {
+		processor, requeuer, task := setup(t)
+
+		jobTask, err := NewTaskComparisonTask(TaskComparisonPayload{TaskID: task.ID, ProjectID: task.ProjectID, AITypes: []string{"fake-code", "fake-code"}})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessTaskComparison(ctx, jobTask))
+
+		assert.Equal(t, []time.Duration{projectSlotRetryInterval}, requeuer.comparison)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		processor, requeuer, task := setup(t)
+
+		jobTask, err := NewTaskImplementationTask(TaskImplementationPayload{TaskID: task.ID, ProjectID: task.ProjectID, AIType: "fake-code", DryRun: true})
+		require.NoError(t, err)
+		require.NoError(t, processor.ProcessTaskImplementation(ctx, jobTask))
+
+		assert.Equal(t, []time.Duration{projectSlotRetryInterval}, requeuer.implementation)
+	})
+}
+
+func TestAcquireProjectSlot(t *testing.T) {
+	ctx := context.Background()
+	requeueNotExpected := func(t *testing.T) func(time.Duration) error {
+		return func(time.Duration) error {
+			t.Fatal("job requeued")
+			return nil
+		}
+	}
+
+	t.Run("takes a slot and clears the queue position", func(t *testing.T) {
+		project := &entity.Project{ID: uuid.New(), MaxConcurrentExecutions: 2}
+		position := 1
+		task := &entity.Task{ID: uuid.New(), ProjectID: project.ID, QueuePosition: &position}
+
+		taskUsecase := usecase.NewTaskUsecaseMock(t)
+		taskUsecase.EXPECT().UpdateQueuePosition(mock.Anything, task.ID, (*int)(nil)).Return(&entity.Task{ID: task.ID, ProjectID: project.ID}, nil).Once()
+		projectUsecase := usecase.NewProjectUsecaseMock(t)
+		projectUsecase.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil).Once()
+
+		semaphore := newFakeProjectSemaphore()
+		processor := &Processor{taskUsecase: taskUsecase, projectUsecase: projectUsecase, logger: slog.Default()}
+		processor.SetProjectSemaphore(semaphore, &fakeJobRequeuer{})
+
+		release, err := processor.acquireProjectSlot(ctx, task, requeueNotExpected(t))
+		require.NoError(t, err)
+		require.NotNil(t, release)
+		assert.Equal(t, []uuid.UUID{task.ID}, semaphore.holders[project.ID])
+
+		release()
+		assert.Empty(t, semaphore.holders[project.ID])
+	})
+
+	t.Run("keeps the position a queued task already has", func(t *testing.T) {
+		project := &entity.Project{ID: uuid.New(), MaxConcurrentExecutions: 1}
+		position := 1
+		task := &entity.Task{ID: uuid.New(), ProjectID: project.ID, QueuePosition: &position}
+
+		projectUsecase := usecase.NewProjectUsecaseMock(t)
+		projectUsecase.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil).Once()
+
+		semaphore := newFakeProjectSemaphore()
+		_, _, err := semaphore.Acquire(ctx, project.ID, uuid.New(), 1)
+		require.NoError(t, err)
+		processor := &Processor{taskUsecase: usecase.NewTaskUsecaseMock(t), projectUsecase: projectUsecase, logger: slog.Default()}
+		processor.SetProjectSemaphore(semaphore, &fakeJobRequeuer{})
+
+		requeued := 0
+		release, err := processor.acquireProjectSlot(ctx, task, func(time.Duration) error {
+			requeued++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Nil(t, release)
+		assert.Equal(t, 1, requeued)
+	})
+
+	t.Run("does not limit projects without a limit", func(t *testing.T) {
+		project := &entity.Project{ID: uuid.New()}
+		task := &entity.Task{ID: uuid.New(), ProjectID: project.ID}
+
+		projectUsecase := usecase.NewProjectUsecaseMock(t)
+		projectUsecase.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil).Once()
+
+		semaphore := newFakeProjectSemaphore()
+		processor := &Processor{projectUsecase: projectUsecase, logger: slog.Default()}
+		processor.SetProjectSemaphore(semaphore, &fakeJobRequeuer{})
+
+		release, err := processor.acquireProjectSlot(ctx, task, requeueNotExpected(t))
+		require.NoError(t, err)
+		require.NotNil(t, release)
+		assert.Empty(t, semaphore.holders[project.ID])
+	})
+}
@@ -0,0 +1,85 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+)
+
+// resumeFallbackStatus is where a task goes back to when the resumed run of
+// its implementation fails. The task has been IMPLEMENTING since the run
+// started, so its status no longer tells: one with a pull request was
+// following up a change request, one with an approved plan came from plan
+// review.
+func (p *Processor) resumeFallbackStatus(ctx context.Context, task *entity.Task) entity.TaskStatus {
+	if task.PullRequest != nil && *task.PullRequest != "" {
+		return entity.TaskStatusCODEREVIEWING
+	}
+	if plan, err := p.planRepo.GetByTaskID(ctx, task.ID); err == nil && plan != nil && plan.Status == entity.PlanStatusAPPROVED {
+		return entity.TaskStatusPLANREVIEWING
+	}
+	return entity.TaskStatusTODO
+}
+
+// startResumedExecution resumes the AI session of an answered execution with
+// the user's answer, and returns the execution, now run by this worker
+func (p *Processor) startResumedExecution(ctx context.Context, payload *TaskImplementationPayload, task *entity.Task, aiExecutor ai.AiCodingCli) (*ai.Execution, map[string]string, *entity.Execution, error) {
+	dbExecution, err := p.executionRepo.GetByID(ctx, *payload.ResumeExecutionID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get execution: %w", err)
+	}
+	if dbExecution.TaskID != task.ID || dbExecution.Status != entity.ExecutionStatusPending || dbExecution.SessionID == "" {
+		return nil, nil, nil, fmt.Errorf("execution %s cannot be resumed, status: %s", dbExecution.ID, dbExecution.Status)
+	}
+
+	execution, injectEnvVars, err := p.executionService.StartResumeExecution(task, aiExecutor, dbExecution.SessionID, payload.Answer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	dbExecution.Status = entity.ExecutionStatus(execution.Status)
+	dbExecution.Question = ""
+	dbExecution.Worker = p.worker
+	if err := p.executionRepo.Update(ctx, dbExecution); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to update execution: %w", err)
+	}
+	return execution, injectEnvVars, dbExecution, nil
+}
+
+// pauseForQuestion stops the execution on the question the AI ended its run
+// with, keeping what the run used, and tells the project's clients. The task
+// stays IMPLEMENTING until the answer resumes the execution.
+func (p *Processor) pauseForQuestion(ctx context.Context, task *entity.Task, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli, execution *ai.Execution, question, sessionID string) {
+	p.logger.Info("AI execution asked a question", "task_id", task.ID, "execution_id", dbExecution.ID)
+
+	if err := p.executionRepo.MarkWaitingInput(ctx, dbExecution.ID, question, sessionID); err != nil {
+		p.logger.Error("Failed to mark execution as waiting for input", "error", err, "execution_id", dbExecution.ID)
+		return
+	}
+	// The spend is only recorded once the execution completes; until then
+	// the usage is kept on it so the resumed run adds to it
+	if usage := aiExecutor.ParseOutputToUsage(execution.Result.Output); usage != nil {
+		if err := p.executionRepo.UpdateUsage(ctx, dbExecution.ID, accumulatedUsage(dbExecution, *usage)); err != nil {
+			p.logger.Error("Failed to record execution usage", "error", err, "execution_id", dbExecution.ID)
+		}
+	}
+
+	if p.wsService == nil {
+		return
+	}
+	if err := p.wsService.NotifyExecutionWaitingInput(dbExecution.ID, task.ID, task.ProjectID, question); err != nil {
+		p.logger.Warn("Failed to send execution question", "error", err, "execution_id", dbExecution.ID)
+	}
+}
+
+// accumulatedUsage adds usage to what the earlier runs of a resumed
+// execution used
+func accumulatedUsage(dbExecution *entity.Execution, usage entity.ExecutionUsage) entity.ExecutionUsage {
+	return entity.ExecutionUsage{
+		InputTokens:  dbExecution.InputTokens + usage.InputTokens,
+		OutputTokens: dbExecution.OutputTokens + usage.OutputTokens,
+		CostUSD:      dbExecution.CostUSD + usage.CostUSD,
+	}
+}
@@ -0,0 +1,129 @@
+package jobs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Queue name constants
+const (
+	QueueCritical       = "critical"
+	QueuePlanning       = "planning"
+	QueueImplementation = "implementation"
+	QueueMonitoring     = "monitoring"
+	QueueCleanup        = "cleanup"
+	QueueDefault        = "default"
+)
+
+// DefaultQueues are the queues a worker serves when none are configured,
+// with their priorities
+var DefaultQueues = map[string]int{
+	QueueCritical:       6, // Worktree creation blocks the user workflow
+	QueuePlanning:       4,
+	QueueImplementation: 4,
+	QueueMonitoring:     2,
+	QueueCleanup:        1,
+	QueueDefault:        1,
+}
+
+// taskQueues routes each job type to the queue it is enqueued on, so a
+// worker only handles the job types of the queues it serves
+var taskQueues = map[string]string{
+	TypeTaskPlanning:       QueuePlanning,
+	TypeTaskImplementation: QueueImplementation,
+	TypeTaskComparison:     QueueImplementation,
+	TypeComparisonSelect:   QueueImplementation,
+	TypePRStatusSync:       QueueMonitoring,
+	TypePRSync:             QueueMonitoring,
+	TypeWorktreeCleanup:    QueueCleanup,
+	TypeWorktreeCreate:     QueueCritical,
+	TypeWorktreeValidate:   QueueCleanup,
+	TypeKanbanNotify:       QueueDefault,
+	TypeJiraSync:           QueueDefault,
+	TypeSoftDeletePurge:    QueueCleanup,
+	TypeVelocityRollup:     QueueCleanup,
+	TypePreviewStop:        QueueDefault,
+	TypeReleaseNotes:       QueueDefault,
+	TypeDailyStandup:       QueueDefault,
+	TypeEmailDigest:        QueueDefault,
+	TypeWebhookDelivery:    QueueDefault,
+	// Cancellations of executions some worker runs go on its WorkerQueue
+	TypeExecutionCancel: QueueCritical,
+}
+
+// workerQueuePrefix starts the name of the queue only one worker serves
+const workerQueuePrefix = "worker:"
+
+// WorkerQueue returns the queue only worker, as returned by WorkerID,
+// serves. Jobs acting on the executions a worker runs, which live in its
+// process, are enqueued on it.
+func WorkerQueue(worker string) string {
+	return workerQueuePrefix + worker
+}
+
+// QueueFor returns the queue a job type is enqueued on
+func QueueFor(taskType string) string {
+	if queue, ok := taskQueues[taskType]; ok {
+		return queue
+	}
+	return QueueDefault
+}
+
+// ParseQueues parses a comma-separated list of queues to serve, each
+// optionally followed by its priority, such as "implementation:4,default".
+// Queues without a priority keep their default one. An empty list serves
+// DefaultQueues.
+func ParseQueues(spec string) (map[string]int, error) {
+	if strings.TrimSpace(spec) == "" {
+		queues := make(map[string]int, len(DefaultQueues))
+		for name, priority := range DefaultQueues {
+			queues[name] = priority
+		}
+		return queues, nil
+	}
+
+	queues := make(map[string]int)
+	for _, part := range strings.Split(spec, ",") {
+		name, value, hasPriority := strings.Cut(strings.TrimSpace(part), ":")
+		name = strings.TrimSpace(name)
+		priority, known := DefaultQueues[name]
+		if !known {
+			return nil, fmt.Errorf("unknown queue %q, expected one of %s", name, strings.Join(queueNames(), ", "))
+		}
+		if hasPriority {
+			parsed, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || parsed < 1 {
+				return nil, fmt.Errorf("invalid priority %q for queue %s: must be a positive integer", value, name)
+			}
+			priority = parsed
+		}
+		if _, duplicate := queues[name]; duplicate {
+			return nil, fmt.Errorf("queue %s is listed more than once", name)
+		}
+		queues[name] = priority
+	}
+	return queues, nil
+}
+
+// servedTaskTypes returns the job types enqueued on the given queues
+func servedTaskTypes(queues map[string]int) []string {
+	var types []string
+	for taskType, queue := range taskQueues {
+		if _, ok := queues[queue]; ok {
+			types = append(types, taskType)
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+func queueNames() []string {
+	names := make([]string, 0, len(DefaultQueues))
+	for name := range DefaultQueues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueues(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    map[string]int
+		wantErr string
+	}{
+		{spec: "", want: DefaultQueues},
+		{spec: "implementation", want: map[string]int{QueueImplementation: 4}},
+		{spec: "planning:2, default", want: map[string]int{QueuePlanning: 2, QueueDefault: 1}},
+		{spec: "implementation,builds", wantErr: `unknown queue "builds"`},
+		{spec: "planning:0", wantErr: "invalid priority"},
+		{spec: "planning:high", wantErr: "invalid priority"},
+		{spec: "cleanup,cleanup:2", wantErr: "listed more than once"},
+	}
+	for _, tt := range tests {
+		queues, err := ParseQueues(tt.spec)
+		if tt.wantErr != "" {
+			assert.ErrorContains(t, err, tt.wantErr, tt.spec)
+			continue
+		}
+		require.NoError(t, err, tt.spec)
+		assert.Equal(t, tt.want, queues, tt.spec)
+	}
+}
+
+func TestParseQueues_DoesNotShareDefaults(t *testing.T) {
+	queues, err := ParseQueues("")
+	require.NoError(t, err)
+	queues[QueueDefault] = 10
+
+	assert.Equal(t, 1, DefaultQueues[QueueDefault])
+}
+
+func TestServer_RegisterHandlers_OnlyServedQueues(t *testing.T) {
+	server := NewServer("localhost:0", "", 0, &Processor{}, ServerOptions{
+		Queues: map[string]int{QueueImplementation: 1},
+	})
+	server.RegisterHandlers()
+
+	for taskType, queue := range taskQueues {
+		_, pattern := server.mux.Handler(asynq.NewTask(taskType, nil))
+		if queue == QueueImplementation {
+			assert.Equal(t, taskType, pattern, "%s should be handled", taskType)
+		} else {
+			assert.Empty(t, pattern, "%s should not be handled", taskType)
+		}
+	}
+}
+
+func TestServer_RegisterHandlers_AllQueuesByDefault(t *testing.T) {
+	server := NewServer("localhost:0", "", 0, &Processor{}, ServerOptions{})
+	server.RegisterHandlers()
+
+	for taskType := range taskQueues {
+		_, pattern := server.mux.Handler(asynq.NewTask(taskType, nil))
+		assert.Equal(t, taskType, pattern)
+	}
+}
+
+func TestServer_RegisterHandlers_WorkerQueue(t *testing.T) {
+	server := NewServer("localhost:0", "", 0, &Processor{}, ServerOptions{
+		Queues: map[string]int{QueueImplementation: 1},
+		Worker: "impl-1@host-a",
+	})
+	server.RegisterHandlers()
+
+	assert.Equal(t, DefaultQueues[QueueCritical], server.queues[WorkerQueue("impl-1@host-a")])
+	_, pattern := server.mux.Handler(asynq.NewTask(TypeExecutionCancel, nil))
+	assert.Equal(t, TypeExecutionCancel, pattern, "cancellations routed to the worker should be handled")
+}
@@ -126,6 +126,32 @@ func (c *RedisBrokerClient) PublishStatusChanged(entityID, projectID uuid.UUID,
 	return c.PublishMessage(message)
 }
 
+// PublishExecutionLog publishes log lines an execution of a task printed
+func (c *RedisBrokerClient) PublishExecutionLog(executionID, taskID, projectID uuid.UUID, logs interface{}) error {
+	data := map[string]interface{}{
+		"execution_id": executionID.String(),
+		"task_id":      taskID.String(),
+		"project_id":   projectID.String(),
+		"logs":         logs,
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal execution log data: %w", err)
+	}
+
+	message := &BrokerMessage{
+		Type:      "execution_log",
+		Data:      dataBytes,
+		ProjectID: &projectID,
+		Timestamp: time.Now(),
+		MessageID: uuid.New().String(),
+		Source:    "worker",
+	}
+
+	return c.PublishMessage(message)
+}
+
 // TestConnection tests the Redis connection
 func (c *RedisBrokerClient) TestConnection() error {
 	return c.client.Ping(c.ctx).Err()
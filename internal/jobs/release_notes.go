@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+)
+
+// releaseNotesTimeout bounds a release notes execution
+const releaseNotesTimeout = 15 * time.Minute
+
+// ProcessReleaseNotes has the release notes' AI executor write them from the
+// changes they cover. A failed generation is recorded on the notes and not
+// retried; the user asks for new notes instead.
+func (p *Processor) ProcessReleaseNotes(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseReleaseNotesPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse release notes payload: %w", err)
+	}
+
+	p.logger.Info("Processing release notes job", "release_notes_id", payload.ReleaseNotesID)
+
+	notes, err := p.releaseNotesUsecase.MarkGenerating(ctx, payload.ReleaseNotesID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrReleaseNotesNotFound) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to start release notes: %w", err)
+	}
+
+	content, err := p.generateReleaseNotes(ctx, notes)
+	if err != nil {
+		p.logger.Error("Failed to generate release notes", "error", err, "release_notes_id", notes.ID)
+		if failErr := p.releaseNotesUsecase.Fail(ctx, notes.ID, err.Error()); failErr != nil {
+			p.logger.Error("Failed to record release notes failure", "error", failErr, "release_notes_id", notes.ID)
+		}
+		return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+	}
+
+	if err := p.releaseNotesUsecase.Complete(ctx, notes.ID, content); err != nil {
+		return fmt.Errorf("failed to save release notes: %w", err)
+	}
+
+	p.logger.Info("Completed release notes job", "release_notes_id", notes.ID, "changes", notes.Changes)
+	return nil
+}
+
+// generateReleaseNotes runs the AI execution writing the notes and returns
+// them in Markdown
+func (p *Processor) generateReleaseNotes(ctx context.Context, notes *entity.ReleaseNotes) (string, error) {
+	changes, err := p.releaseNotesUsecase.CollectChanges(ctx, notes)
+	if err != nil {
+		return "", fmt.Errorf("failed to collect changes: %w", err)
+	}
+	if len(changes) == 0 {
+		return "", fmt.Errorf("no changes left in the date range")
+	}
+
+	aiExecutor, err := p.getAiExecutor(notes.AIType)
+	if err != nil {
+		return "", err
+	}
+	execution, injectEnvVars, err := p.executionService.StartReleaseNotesExecution(notes, aiExecutor, changes)
+	if err != nil {
+		return "", fmt.Errorf("failed to start release notes execution: %w", err)
+	}
+	output, err := p.runToCompletion(ctx, nil, execution, injectEnvVars, releaseNotesTimeout)
+	if err != nil {
+		return "", fmt.Errorf("release notes execution failed: %w", err)
+	}
+	return aiExecutor.ParseOutputToReleaseNotes(output)
+}
@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessReleaseNotes(t *testing.T) {
+	notes := &entity.ReleaseNotes{ID: uuid.New(), ProjectID: uuid.New(), AIType: "gpt-nope", Status: entity.ReleaseNotesStatusGenerating}
+	task, err := NewReleaseNotesTask(ReleaseNotesPayload{ReleaseNotesID: notes.ID})
+	require.NoError(t, err)
+	assert.Equal(t, TypeReleaseNotes, task.Type())
+
+	t.Run("failed generation", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		p := &Processor{releaseNotesUsecase: releaseNotesUsecase, logger: slog.Default()}
+		releaseNotesUsecase.EXPECT().MarkGenerating(mock.Anything, notes.ID).Return(notes, nil)
+		releaseNotesUsecase.EXPECT().CollectChanges(mock.Anything, notes).Return([]entity.ReleaseChange{{Title: "Add login page"}}, nil)
+		releaseNotesUsecase.EXPECT().Fail(mock.Anything, notes.ID, "invalid execution type: gpt-nope").Return(nil).Once()
+
+		err := p.ProcessReleaseNotes(context.Background(), task)
+		assert.True(t, errors.Is(err, asynq.SkipRetry), "a failed generation is not retried")
+	})
+
+	t.Run("deleted notes", func(t *testing.T) {
+		releaseNotesUsecase := usecase.NewReleaseNotesUsecaseMock(t)
+		p := &Processor{releaseNotesUsecase: releaseNotesUsecase, logger: slog.Default()}
+		releaseNotesUsecase.EXPECT().MarkGenerating(mock.Anything, notes.ID).Return(nil, usecase.ErrReleaseNotesNotFound)
+
+		err := p.ProcessReleaseNotes(context.Background(), task)
+		assert.True(t, errors.Is(err, asynq.SkipRetry))
+	})
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// withPlanFeedbackContext returns a copy of task whose description carries
+// the rejected plan and the reviewer's feedback on it, for the executor to
+// write a plan addressing the feedback. The plan is left out when it cannot
+// be read.
+func (p *Processor) withPlanFeedbackContext(ctx context.Context, task *entity.Task, feedback string) *entity.Task {
+	var description strings.Builder
+	description.WriteString(strings.TrimRight(task.Description, "\n"))
+
+	plan, err := p.planRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		p.logger.Warn("Failed to get the rejected plan for re-planning", "task_id", task.ID, "error", err)
+	} else if strings.TrimSpace(plan.Content) != "" {
+		description.WriteString("\n\nA previous plan for this task was rejected in review:\n\n")
+		description.WriteString(strings.TrimRight(plan.Content, "\n"))
+	}
+	description.WriteString("\n\nThe reviewer rejected the plan with this feedback. Write a new plan addressing it:\n\n")
+	description.WriteString(feedback)
+
+	withFeedback := *task
+	withFeedback.Description = description.String()
+	return &withFeedback
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWithPlanFeedbackContext(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), Title: "Add login", Description: "Let users sign in\n"}
+
+	t.Run("quotes the rejected plan and the feedback", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		processor := &Processor{planRepo: planRepo, logger: slog.Default()}
+		planRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(&entity.Plan{TaskID: task.ID, Content: "1. Add a password form\n"}, nil)
+
+		replanned := processor.withPlanFeedbackContext(context.Background(), task, "Use the SSO provider instead")
+		assert.Equal(t, "Let users sign in\n", task.Description, "the task itself is left as is")
+		assert.Equal(t, "Let users sign in\n\n"+
+			"A previous plan for this task was rejected in review:\n\n1. Add a password form\n\n"+
+			"The reviewer rejected the plan with this feedback. Write a new plan addressing it:\n\nUse the SSO provider instead",
+			replanned.Description)
+	})
+
+	t.Run("keeps the feedback when the plan cannot be read", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		processor := &Processor{planRepo: planRepo, logger: slog.Default()}
+		planRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(nil, errors.New("plan not found"))
+
+		replanned := processor.withPlanFeedbackContext(context.Background(), task, "Use the SSO provider instead")
+		assert.NotContains(t, replanned.Description, "previous plan")
+		assert.Contains(t, replanned.Description, "Use the SSO provider instead")
+	})
+}
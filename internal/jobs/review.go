@@ -0,0 +1,179 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	aiexecutors "github.com/auto-devs/auto-devs/internal/ai-executors"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxReviewDiffBytes bounds the diff handed to the reviewer, which can
+	// still read the rest of the changes in the worktree
+	maxReviewDiffBytes = 200 * 1024
+	// reviewTimeout bounds a review execution
+	reviewTimeout = 15 * time.Minute
+)
+
+// reviewImplementation runs a read-only AI execution critiquing the task's
+// changes against its plan and the project's conventions, and saves the
+// findings. It returns nil when the review could not be made, so the pull
+// request is opened without one.
+func (p *Processor) reviewImplementation(ctx context.Context, task *entity.Task, aiExecutor ai.AiCodingCli, execution *entity.Execution) []entity.ReviewComment {
+	diff, err := p.reviewDiff(ctx, task)
+	if err != nil {
+		p.logger.Error("Failed to get the diff to review", "error", err, "task_id", task.ID)
+		return nil
+	}
+	if strings.TrimSpace(diff) == "" {
+		p.logger.Info("No changes to review", "task_id", task.ID)
+		return nil
+	}
+
+	p.logger.Info("Running AI review", "task_id", task.ID, "diff_bytes", len(diff))
+	output, err := p.runReview(ctx, task, execution, aiExecutor, diff)
+	if err != nil {
+		p.logger.Error("AI review failed", "error", err, "task_id", task.ID)
+		return nil
+	}
+	review, err := aiExecutor.ParseOutputToReview(output)
+	if err != nil {
+		p.logger.Error("Failed to read the AI review", "error", err, "task_id", task.ID)
+		return nil
+	}
+	comments, err := aiexecutors.ParseReviewComments(review)
+	if err != nil {
+		p.logger.Error("Failed to parse the AI review findings", "error", err, "task_id", task.ID)
+		return nil
+	}
+
+	p.saveReviewComments(ctx, task, execution, comments)
+	p.logger.Info("AI review finished", "task_id", task.ID, "findings", len(comments))
+	return comments
+}
+
+// reviewDiff returns the task's changes against its base branch, cut short
+// for the review prompt
+func (p *Processor) reviewDiff(ctx context.Context, task *entity.Task) (string, error) {
+	diff, err := p.taskDiff(ctx, task)
+	if err != nil {
+		return "", err
+	}
+
+	if len(diff) > maxReviewDiffBytes {
+		diff = diff[:maxReviewDiffBytes] + "\n[diff truncated, read the changed files in the worktree for the rest]\n"
+	}
+	return diff, nil
+}
+
+// taskDiff returns the task's changes against its base branch. The
+// implementation is committed first so new files are part of the diff.
+func (p *Processor) taskDiff(ctx context.Context, task *entity.Task) (string, error) {
+	if task.WorktreePath == nil || task.BaseBranchName == nil || p.gitManager == nil {
+		return "", fmt.Errorf("task has no worktree or base branch")
+	}
+	worktreePath := *task.WorktreePath
+
+	if _, err := p.gitManager.CommitAll(ctx, worktreePath, implementationCommitMessage(task)); err != nil {
+		return "", fmt.Errorf("failed to commit implementation: %w", err)
+	}
+
+	diff, err := p.gitManager.GetDiff(ctx, worktreePath, *task.BaseBranchName, "HEAD")
+	if err != nil {
+		// Worktrees created from the remote branch may not have it locally
+		return p.gitManager.GetDiff(ctx, worktreePath, "origin/"+*task.BaseBranchName, "HEAD")
+	}
+	return diff, nil
+}
+
+// runReview runs the review execution to completion and returns its output
+func (p *Processor) runReview(ctx context.Context, task *entity.Task, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli, diff string) (string, error) {
+	execution, injectEnvVars, err := p.executionService.StartReviewExecution(task, aiExecutor, diff)
+	if err != nil {
+		return "", fmt.Errorf("failed to start review execution: %w", err)
+	}
+	return p.runToCompletion(ctx, dbExecution, execution, injectEnvVars, reviewTimeout)
+}
+
+// runToCompletion runs an AI execution that is not streamed to the task's
+// logs, waits for it for at most timeout and returns its output. A file
+// access violation is logged on dbExecution, the execution it is part of,
+// when there is one.
+func (p *Processor) runToCompletion(ctx context.Context, dbExecution *entity.Execution, execution *ai.Execution, injectEnvVars map[string]string, timeout time.Duration) (string, error) {
+	// The output is read from the result once the execution is done, the
+	// channels only need draining
+	release, err := p.acquireExecutionSlot(ctx)
+	if err != nil {
+		_ = p.executionService.CancelExecution(execution.ID)
+		return "", err
+	}
+	defer release()
+
+	stdoutChannel := make(chan string)
+	stderrChannel := make(chan string)
+	execution.RegisterStdoutChannel(stdoutChannel)
+	execution.RegisterStderrChannel(stderrChannel)
+
+	p.executionService.RunExecution(execution, injectEnvVars)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-execution.GetContextDoneChannel():
+			if execution.Error != "" {
+				p.recordFileAccessViolation(ctx, dbExecution, execution)
+				return "", fmt.Errorf("%s", execution.Error)
+			}
+			if execution.Result == nil {
+				return "", fmt.Errorf("execution was cancelled")
+			}
+			return execution.Result.Output, nil
+		case <-stdoutChannel:
+		case <-stderrChannel:
+		case <-timer.C:
+			_ = p.executionService.CancelExecution(execution.ID)
+			return "", fmt.Errorf("execution timed out after %v", timeout)
+		case <-ctx.Done():
+			_ = p.executionService.CancelExecution(execution.ID)
+			return "", ctx.Err()
+		}
+	}
+}
+
+func (p *Processor) saveReviewComments(ctx context.Context, task *entity.Task, execution *entity.Execution, comments []entity.ReviewComment) {
+	records := make([]*entity.ReviewComment, len(comments))
+	for i := range comments {
+		comments[i].ExecutionID = execution.ID
+		comments[i].TaskID = task.ID
+		records[i] = &comments[i]
+	}
+	if err := p.reviewCommentRepo.BatchCreate(ctx, records); err != nil {
+		// The findings still go in the pull request
+		p.logger.Error("Failed to save review comments", "error", err, "task_id", task.ID)
+	}
+}
+
+// postReviewComments adds the AI review findings to the pull request
+func (p *Processor) postReviewComments(ctx context.Context, task *entity.Task, pr *entity.PullRequest, comments []entity.ReviewComment) {
+	if len(comments) == 0 {
+		return
+	}
+	if err := p.prCreator.PostReviewComments(ctx, *task, pr, comments); err != nil {
+		p.logger.Error("Failed to post review comments", "error", err, "task_id", task.ID, "pr_number", pr.GitHubPRNumber)
+		return
+	}
+
+	ids := make([]uuid.UUID, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.ID
+	}
+	if err := p.reviewCommentRepo.MarkPosted(ctx, ids); err != nil {
+		p.logger.Error("Failed to mark review comments as posted", "error", err, "task_id", task.ID)
+	}
+}
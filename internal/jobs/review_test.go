@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewDiff(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	base := strings.TrimSpace(string(out))
+	out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", "task/add-main").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree, BaseBranchName: &base}
+	processor := &Processor{gitManager: gitManager, logger: slog.Default()}
+
+	diff, err := processor.reviewDiff(context.Background(), task)
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+++ b/main.go", "new files are part of the reviewed diff")
+	assert.Contains(t, diff, "+package main")
+	assert.Equal(t, []string{"Implement task: Add main", "initial"}, gitLog(t, worktree))
+
+	missing := "missing"
+	task.BaseBranchName = &missing
+	_, err = processor.reviewDiff(context.Background(), task)
+	assert.Error(t, err)
+}
+
+func TestSaveReviewComments(t *testing.T) {
+	repo := repository.NewReviewCommentRepositoryMock(t)
+	processor := &Processor{reviewCommentRepo: repo, logger: slog.Default()}
+	task := &entity.Task{ID: uuid.New()}
+	execution := &entity.Execution{ID: uuid.New()}
+	comments := []entity.ReviewComment{
+		{Severity: entity.ReviewSeverityWarning, Body: "Unused import"},
+		{Severity: entity.ReviewSeverityInfo, Body: "Consider a follow-up"},
+	}
+
+	repo.EXPECT().BatchCreate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, saved []*entity.ReviewComment) error {
+		require.Len(t, saved, 2)
+		for i, comment := range saved {
+			assert.Same(t, &comments[i], comment)
+			comment.ID = uuid.New()
+		}
+		return nil
+	}).Once()
+
+	processor.saveReviewComments(context.Background(), task, execution, comments)
+	for _, comment := range comments {
+		assert.Equal(t, execution.ID, comment.ExecutionID)
+		assert.Equal(t, task.ID, comment.TaskID)
+		assert.NotEqual(t, uuid.Nil, comment.ID, "saved IDs are kept so the comments can be marked as posted")
+	}
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+)
+
+// scheduledPageSize is how many scheduled jobs are read from Redis at a time
+const scheduledPageSize = 100
+
+// scheduledJobQueues are the queues planning and implementation jobs are
+// scheduled in, with the kind of job each holds
+var scheduledJobQueues = map[string]string{
+	QueuePlanning:       usecase.ScheduledJobPlanning,
+	QueueImplementation: usecase.ScheduledJobImplementation,
+}
+
+// ScheduledJobs returns the task's planning and implementation jobs waiting
+// for their start time
+func (c *Client) ScheduledJobs(taskID uuid.UUID) ([]usecase.ScheduledJob, error) {
+	// A queue exists once a job was enqueued in it
+	queues, err := c.inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	jobs := []usecase.ScheduledJob{}
+	for queue, kind := range scheduledJobQueues {
+		if !slices.Contains(queues, queue) {
+			continue
+		}
+		for page := 1; ; page++ {
+			infos, err := c.inspector.ListScheduledTasks(queue, asynq.PageSize(scheduledPageSize), asynq.Page(page))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list scheduled jobs of queue %s: %w", queue, err)
+			}
+			for _, info := range infos {
+				if job, ok := scheduledJob(info, kind); ok && job.TaskID == taskID {
+					jobs = append(jobs, job)
+				}
+			}
+			if len(infos) < scheduledPageSize {
+				break
+			}
+		}
+	}
+	return jobs, nil
+}
+
+// CancelScheduledJob deletes one of the task's scheduled jobs
+func (c *Client) CancelScheduledJob(taskID uuid.UUID, jobID string) error {
+	for queue, kind := range scheduledJobQueues {
+		info, err := c.inspector.GetTaskInfo(queue, jobID)
+		if errors.Is(err, asynq.ErrQueueNotFound) || errors.Is(err, asynq.ErrTaskNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get job %s: %w", jobID, err)
+		}
+		// Jobs of other tasks, and jobs already running, are not the
+		// task's to cancel
+		if job, ok := scheduledJob(info, kind); !ok || job.TaskID != taskID || info.State != asynq.TaskStateScheduled {
+			break
+		}
+		if err := c.inspector.DeleteTask(queue, jobID); err != nil {
+			if errors.Is(err, asynq.ErrTaskNotFound) {
+				break
+			}
+			return fmt.Errorf("failed to delete job %s: %w", jobID, err)
+		}
+		return nil
+	}
+	return usecase.ErrScheduledJobNotFound
+}
+
+// scheduledJob reads a planning or implementation job of kind, reporting
+// false for other jobs
+func scheduledJob(info *asynq.TaskInfo, kind string) (usecase.ScheduledJob, bool) {
+	if info.Type != TypeTaskPlanning && info.Type != TypeTaskImplementation {
+		return usecase.ScheduledJob{}, false
+	}
+	var payload struct {
+		TaskID uuid.UUID `json:"task_id"`
+		AIType string    `json:"ai_type"`
+	}
+	if err := json.Unmarshal(info.Payload, &payload); err != nil {
+		return usecase.ScheduledJob{}, false
+	}
+	return usecase.ScheduledJob{
+		ID:          info.ID,
+		TaskID:      payload.TaskID,
+		Kind:        kind,
+		AIType:      payload.AIType,
+		ScheduledAt: info.NextProcessAt,
+	}, true
+}
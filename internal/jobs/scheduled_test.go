@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledJobs_SkippedWhenTaskMovedOn(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	// Planned by hand in the meantime; nothing is updated
+	taskUsecase.EXPECT().GetByID(ctx, taskID).Return(&entity.Task{ID: taskID, Status: entity.TaskStatusPLANREVIEWING}, nil).Once()
+	processor := &Processor{taskUsecase: taskUsecase, logger: slog.Default()}
+
+	planning, err := NewTaskPlanningTask(TaskPlanningPayload{TaskID: taskID, AIType: "claude-code", Scheduled: true})
+	require.NoError(t, err)
+	assert.NoError(t, processor.ProcessTaskPlanning(ctx, planning))
+
+	// Then approved by hand as well
+	taskUsecase.EXPECT().GetByID(ctx, taskID).Return(&entity.Task{ID: taskID, Status: entity.TaskStatusIMPLEMENTING}, nil).Once()
+	implementation, err := NewTaskImplementationTask(TaskImplementationPayload{TaskID: taskID, AIType: "claude-code", Scheduled: true})
+	require.NoError(t, err)
+	assert.NoError(t, processor.ProcessTaskImplementation(ctx, implementation))
+}
+
+func TestScheduledJob(t *testing.T) {
+	taskID := uuid.New()
+	task, err := NewTaskImplementationTask(TaskImplementationPayload{TaskID: taskID, AIType: "deep-seek", Scheduled: true})
+	require.NoError(t, err)
+
+	job, ok := scheduledJob(&asynq.TaskInfo{ID: "job-1", Type: task.Type(), Payload: task.Payload()}, usecase.ScheduledJobImplementation)
+	require.True(t, ok)
+	assert.Equal(t, usecase.ScheduledJob{ID: "job-1", TaskID: taskID, Kind: usecase.ScheduledJobImplementation, AIType: "deep-seek"}, job)
+
+	_, ok = scheduledJob(&asynq.TaskInfo{ID: "job-2", Type: TypePRStatusSync}, usecase.ScheduledJobImplementation)
+	assert.False(t, ok, "other jobs are not scheduled runs of a task")
+}
@@ -1,32 +1,33 @@
 package jobs
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 
+	"github.com/auto-devs/auto-devs/config"
 	"github.com/hibiken/asynq"
 )
 
 // Scheduler wraps asynq.Scheduler for periodic job scheduling
 type Scheduler struct {
-	scheduler *asynq.Scheduler
-	logger    *slog.Logger
+	redisOpt      asynq.RedisClientOpt
+	scheduler     *asynq.Scheduler
+	purgeConfig   *config.PurgeConfig
+	standupConfig *config.StandupConfig
+	emailConfig   *config.EmailConfig
+	logger        *slog.Logger
 }
 
 // NewScheduler creates a new job scheduler
 func NewScheduler(redisAddr, redisPassword string, redisDB int) *Scheduler {
-	redisOpt := asynq.RedisClientOpt{
-		Addr:     redisAddr,
-		Password: redisPassword,
-		DB:       redisDB,
-	}
-
-	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
-		LogLevel: asynq.InfoLevel,
-	})
-
 	return &Scheduler{
-		scheduler: scheduler,
-		logger:    slog.Default().With("component", "job-scheduler"),
+		redisOpt: asynq.RedisClientOpt{
+			Addr:     redisAddr,
+			Password: redisPassword,
+			DB:       redisDB,
+		},
+		logger: slog.Default().With("component", "job-scheduler"),
 	}
 }
 
@@ -42,7 +43,7 @@ func (s *Scheduler) RegisterPeriodicTasks() error {
 	}
 
 	// Register PR status sync to run every 30 seconds in monitoring queue
-	_, err = s.scheduler.Register("@every 30s", prStatusSyncJob, asynq.Queue("monitoring"))
+	_, err = s.scheduler.Register("@every 30s", prStatusSyncJob, asynq.Queue(QueueMonitoring))
 	if err != nil {
 		s.logger.Error("Failed to register PR status sync job", "error", err)
 		return err
@@ -58,27 +59,186 @@ func (s *Scheduler) RegisterPeriodicTasks() error {
 	}
 
 	// Register worktree cleanup to run every 30 minutes in cleanup queue
-	_, err = s.scheduler.Register("@every 30m", worktreeCleanupJob, asynq.Queue("cleanup"))
+	_, err = s.scheduler.Register("@every 30m", worktreeCleanupJob, asynq.Queue(QueueCleanup))
 	if err != nil {
 		s.logger.Error("Failed to register worktree cleanup job", "error", err)
 		return err
 	}
 
 	s.logger.Info("Worktree cleanup job registered to run every 30 minutes")
+
+	// Create worktree validation job
+	worktreeValidateJob, err := NewWorktreeValidateJob()
+	if err != nil {
+		s.logger.Error("Failed to create worktree validate job", "error", err)
+		return err
+	}
+
+	// Register worktree validation to run every 15 minutes in cleanup queue
+	_, err = s.scheduler.Register("@every 15m", worktreeValidateJob, asynq.Queue(QueueCleanup))
+	if err != nil {
+		s.logger.Error("Failed to register worktree validate job", "error", err)
+		return err
+	}
+
+	s.logger.Info("Worktree validate job registered to run every 15 minutes")
+
+	// Recompute the current and previous week, so late changes to last
+	// week still land in its rollup
+	velocityRollupJob, err := NewVelocityRollupJob(2)
+	if err != nil {
+		s.logger.Error("Failed to create velocity rollup job", "error", err)
+		return err
+	}
+
+	_, err = s.scheduler.Register("@every 1h", velocityRollupJob, asynq.Queue(QueueCleanup))
+	if err != nil {
+		s.logger.Error("Failed to register velocity rollup job", "error", err)
+		return err
+	}
+
+	s.logger.Info("Velocity rollup job registered to run every hour")
+
+	if s.purgeConfig != nil && s.purgeConfig.Enabled {
+		purgeJob, err := NewSoftDeletePurgeJob(s.purgeConfig.RetentionDays, s.purgeConfig.DryRun)
+		if err != nil {
+			s.logger.Error("Failed to create soft delete purge job", "error", err)
+			return err
+		}
+
+		_, err = s.scheduler.Register("@every "+s.purgeConfig.Interval, purgeJob,
+			asynq.Queue(QueueCleanup),
+			// Keep the completed task so its per-table report stays readable
+			asynq.Retention(purgeReportRetention))
+		if err != nil {
+			s.logger.Error("Failed to register soft delete purge job", "error", err)
+			return err
+		}
+
+		s.logger.Info("Soft delete purge job registered",
+			"interval", s.purgeConfig.Interval,
+			"retention_days", s.purgeConfig.RetentionDays,
+			"dry_run", s.purgeConfig.DryRun)
+	}
+
+	if s.standupConfig != nil && s.standupConfig.Enabled {
+		standupJob, err := NewDailyStandupJob(s.standupConfig.AIType)
+		if err != nil {
+			s.logger.Error("Failed to create daily standup job", "error", err)
+			return err
+		}
+
+		_, err = s.scheduler.Register(s.standupConfig.Schedule, standupJob, asynq.Queue(QueueDefault))
+		if err != nil {
+			s.logger.Error("Failed to register daily standup job", "error", err)
+			return err
+		}
+
+		s.logger.Info("Daily standup job registered",
+			"schedule", s.standupConfig.Schedule,
+			"ai_type", s.standupConfig.AIType)
+	}
+
+	if s.emailConfig != nil && s.emailConfig.DigestEnabled {
+		emailDigestJob, err := NewEmailDigestJob()
+		if err != nil {
+			s.logger.Error("Failed to create email digest job", "error", err)
+			return err
+		}
+
+		_, err = s.scheduler.Register(s.emailConfig.DigestSchedule, emailDigestJob, asynq.Queue(QueueDefault))
+		if err != nil {
+			s.logger.Error("Failed to register email digest job", "error", err)
+			return err
+		}
+
+		s.logger.Info("Email digest job registered", "schedule", s.emailConfig.DigestSchedule)
+	}
+
+	return nil
+}
+
+// EnableSoftDeletePurge schedules the soft-delete purge job with the given
+// configuration. It must be called before Run.
+func (s *Scheduler) EnableSoftDeletePurge(cfg *config.PurgeConfig) error {
+	if cfg.RetentionDays <= 0 {
+		return fmt.Errorf("purge retention days must be positive, got %d", cfg.RetentionDays)
+	}
+	s.purgeConfig = cfg
+	return nil
+}
+
+// EnableDailyStandup schedules the daily standup job with the given
+// configuration. It must be called before Run.
+func (s *Scheduler) EnableDailyStandup(cfg *config.StandupConfig) error {
+	if cfg.Schedule == "" {
+		return fmt.Errorf("standup schedule is required")
+	}
+	if cfg.AIType == "" {
+		return fmt.Errorf("standup AI type is required")
+	}
+	s.standupConfig = cfg
+	return nil
+}
+
+// EnableEmailDigest schedules the daily email digest job with the given
+// configuration. It must be called before Run.
+func (s *Scheduler) EnableEmailDigest(cfg *config.EmailConfig) error {
+	if cfg.SMTPHost == "" {
+		return fmt.Errorf("SMTP host is required to send email digests")
+	}
+	if cfg.DigestSchedule == "" {
+		return fmt.Errorf("email digest schedule is required")
+	}
+	s.emailConfig = cfg
 	return nil
 }
 
-// Start starts the scheduler
-func (s *Scheduler) Start() error {
+// Run schedules the periodic jobs while this worker leads, until ctx is
+// done. When the lead is lost, it stops scheduling and campaigns again, so
+// a single worker schedules them at any time.
+func (s *Scheduler) Run(ctx context.Context, leadership Leadership) error {
+	for {
+		lost, resign, err := leadership.Campaign(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to campaign for scheduler leadership: %w", err)
+		}
+
+		if err := s.start(); err != nil {
+			resign()
+			return err
+		}
+		select {
+		case <-ctx.Done():
+		case <-lost:
+			s.logger.Warn("Lost scheduler leadership, stopping the periodic jobs")
+		}
+		s.stop()
+		resign()
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// start starts scheduling the periodic jobs. An asynq scheduler cannot be
+// started again once stopped, so each term has its own.
+func (s *Scheduler) start() error {
 	s.logger.Info("Starting job scheduler")
+	s.scheduler = asynq.NewScheduler(s.redisOpt, &asynq.SchedulerOpts{
+		LogLevel: asynq.InfoLevel,
+	})
 	if err := s.RegisterPeriodicTasks(); err != nil {
 		return err
 	}
-	return s.scheduler.Run()
+	return s.scheduler.Start()
 }
 
-// Stop gracefully stops the scheduler
-func (s *Scheduler) Stop() {
+// stop gracefully stops scheduling the periodic jobs
+func (s *Scheduler) stop() {
 	s.logger.Info("Stopping job scheduler")
 	s.scheduler.Shutdown()
-}
\ No newline at end of file
+}
@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLeadership grants the lead for each term sent on terms, the term
+// being lost when its channel is closed
+type fakeLeadership struct {
+	terms    chan chan struct{}
+	resigned atomic.Int32
+}
+
+func (f *fakeLeadership) Campaign(ctx context.Context) (<-chan struct{}, func(), error) {
+	select {
+	case lost := <-f.terms:
+		return lost, func() { f.resigned.Add(1) }, nil
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	}
+}
+
+func TestSchedulerRun_CampaignsAgainAfterLosingTheLead(t *testing.T) {
+	// Nothing listens there; the scheduler only logs its failures to reach
+	// Redis
+	scheduler := NewScheduler("127.0.0.1:1", "", 0)
+	leadership := &fakeLeadership{terms: make(chan chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- scheduler.Run(ctx, leadership) }()
+
+	firstTerm := make(chan struct{})
+	leadership.terms <- firstTerm
+	close(firstTerm)
+	// Only accepted once the scheduler campaigns again
+	leadership.terms <- make(chan struct{})
+	assert.Equal(t, int32(1), leadership.resigned.Load(), "the lost term is resigned")
+
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("scheduler did not stop with its context")
+	}
+	assert.Equal(t, int32(2), leadership.resigned.Load(), "the lead is given up on shutdown")
+}
+
+func TestSchedulerRun_StopsWhileFollowing(t *testing.T) {
+	scheduler := NewScheduler("127.0.0.1:1", "", 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := scheduler.Run(ctx, &fakeLeadership{terms: make(chan chan struct{})})
+
+	assert.NoError(t, err)
+}
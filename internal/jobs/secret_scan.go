@@ -0,0 +1,54 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/secretscan"
+)
+
+// maxReportedSecrets bounds the findings listed in the task's error log; all
+// of them are saved with the execution
+const maxReportedSecrets = 5
+
+// scanForSecrets looks for credentials and keys in the lines the task's
+// changes add and saves what it finds with the execution's security
+// findings. An error means the diff could not be read.
+func (p *Processor) scanForSecrets(ctx context.Context, task *entity.Task, execution *entity.Execution) ([]entity.SecurityFinding, error) {
+	diff, err := p.taskDiff(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := secretscan.ScanDiff(diff)
+	if len(findings) == 0 {
+		return nil, nil
+	}
+	start := len(execution.SecurityFindings)
+	execution.SecurityFindings = append(execution.SecurityFindings, findings...)
+	p.saveSecurityFindings(ctx, task, execution, execution.SecurityFindings[start:])
+	return findings, nil
+}
+
+// secretScanFailedReason explains in the task's error log why its changes
+// were not pushed when they could not be scanned
+func secretScanFailedReason(err error) string {
+	return fmt.Sprintf("Secret scan failed: %s. Nothing was pushed and no pull request was created", err.Error())
+}
+
+// secretsFoundReason explains in the task's error log why its changes were
+// not pushed
+func secretsFoundReason(findings []entity.SecurityFinding) string {
+	listed := make([]string, 0, maxReportedSecrets)
+	for i, finding := range findings {
+		if i == maxReportedSecrets {
+			listed = append(listed, fmt.Sprintf("and %d more", len(findings)-maxReportedSecrets))
+			break
+		}
+		listed = append(listed, fmt.Sprintf("%s in %s:%d", finding.RuleID, finding.FilePath, finding.Line))
+	}
+	return fmt.Sprintf("Secret scan found %d possible secret(s) added by the changes (%s); remove them, or mark false positives with a gitleaks:allow comment. Nothing was pushed and no pull request was created",
+		len(findings), strings.Join(listed, ", "))
+}
@@ -0,0 +1,97 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutePRCreationWorkflow_SecretsBlockPush(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+	require.NoError(t, err, string(out))
+	base := strings.TrimSpace(string(out))
+	branch := "task/add-config"
+	out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", branch).CombinedOutput()
+	require.NoError(t, err, string(out))
+	// Built at runtime so this file does not trip secret scanners itself
+	awsKey := "AKIA" + "Z7QW2E4R6T3Y5U7I"
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "config.go"), []byte(fmt.Sprintf("package main\n\nconst accessKey = %q\n", awsKey)), 0o644))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add config", WorktreePath: &worktree, BaseBranchName: &base, BranchName: &branch}
+	execution := &entity.Execution{ID: uuid.New()}
+
+	findingRepo := repository.NewSecurityFindingRepositoryMock(t)
+	var saved []*entity.SecurityFinding
+	findingRepo.EXPECT().BatchCreate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, findings []*entity.SecurityFinding) error {
+		saved = findings
+		return nil
+	})
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().AppendErrorLog(mock.Anything, task.ID, mock.MatchedBy(func(message string) bool {
+		return strings.Contains(message, "aws-access-key-id in config.go:3") && !strings.Contains(message, awsKey)
+	})).Return(nil)
+	processor := &Processor{gitManager: gitManager, securityFindingRepo: findingRepo, taskUsecase: taskUsecase, logger: slog.Default()}
+
+	blocked := processor.executePRCreationWorkflow(context.Background(), task, nil, execution)
+
+	assert.True(t, blocked)
+	require.Len(t, saved, 1)
+	assert.Equal(t, entity.SecurityScannerSecrets, saved[0].Scanner)
+	assert.Equal(t, execution.ID, saved[0].ExecutionID)
+	assert.Equal(t, task.ID, saved[0].TaskID)
+	assert.Len(t, execution.SecurityFindings, 1)
+	assert.Equal(t, []string{"Implement task: Add config", "initial"}, gitLog(t, worktree), "the changes stay committed in the worktree")
+}
+
+func TestExecutePRCreationWorkflow_ScanFailureBlocksPush(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktree := initRepo(t)
+	base, branch := "no-such-branch", "task/add-config"
+	out, err := exec.Command("git", "-C", worktree, "checkout", "-q", "-b", branch).CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "config.go"), []byte("package main\n"), 0o644))
+
+	task := &entity.Task{ID: uuid.New(), Title: "Add config", WorktreePath: &worktree, BaseBranchName: &base, BranchName: &branch}
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().AppendErrorLog(mock.Anything, task.ID, mock.MatchedBy(func(message string) bool {
+		return strings.HasPrefix(message, "Secret scan failed: ")
+	})).Return(nil).Once()
+	processor := &Processor{gitManager: gitManager, taskUsecase: taskUsecase, logger: slog.Default()}
+
+	blocked := processor.executePRCreationWorkflow(context.Background(), task, nil, &entity.Execution{ID: uuid.New()})
+
+	assert.True(t, blocked)
+}
+
+func TestSecretsFoundReason(t *testing.T) {
+	findings := make([]entity.SecurityFinding, 7)
+	for i := range findings {
+		findings[i] = entity.SecurityFinding{RuleID: "github-pat", FilePath: "deploy.yaml", Line: i + 1}
+	}
+
+	reason := secretsFoundReason(findings)
+
+	assert.True(t, strings.HasPrefix(reason, "Secret scan found 7 possible secret(s) added by the changes (github-pat in deploy.yaml:1, "))
+	assert.Contains(t, reason, "github-pat in deploy.yaml:5, and 2 more)")
+	assert.NotContains(t, reason, "deploy.yaml:6")
+}
@@ -0,0 +1,112 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/securityscan"
+)
+
+// runSecurityScan runs the project's security scanner over the files the task
+// changed into run and saves the findings in them. The run is skipped when
+// there is nothing to scan. It returns whether the run blocks the pull
+// request: only high-severity findings do, a scanner that fails to run is
+// reported but not held against the changes.
+func (p *Processor) runSecurityScan(ctx context.Context, project *entity.Project, task *entity.Task, execution *entity.Execution, run *entity.VerificationRun) bool {
+	skip := func(reason string) bool {
+		run.Status = entity.VerificationRunStatusSkipped
+		run.Output = "[auto-devs] " + reason
+		return false
+	}
+
+	files, err := p.changedFiles(ctx, task)
+	if err != nil {
+		p.logger.Error("Failed to list changed files, skipping security scan", "error", err, "task_id", task.ID)
+		return skip(fmt.Sprintf("failed to list the changed files: %v", err))
+	}
+
+	reportDir, err := os.MkdirTemp("", "auto-devs-security-scan-")
+	if err != nil {
+		p.logger.Error("Failed to create security scan report directory", "error", err, "task_id", task.ID)
+		return skip(fmt.Sprintf("failed to create the report directory: %v", err))
+	}
+	defer os.RemoveAll(reportDir)
+	reportPath := filepath.Join(reportDir, "report.json")
+
+	command, err := securityscan.Command(project.SecurityScanner, files, reportPath)
+	if err != nil {
+		p.logger.Error("Failed to build security scan command", "error", err, "task_id", task.ID)
+		return skip(err.Error())
+	}
+	if command == "" {
+		p.logger.Info("No changed files for the security scanner", "task_id", task.ID, "scanner", project.SecurityScanner)
+		return skip(fmt.Sprintf("no changed files for %s to scan", project.SecurityScanner))
+	}
+
+	run.Command = command
+	p.runVerificationStep(ctx, run, task)
+	if !run.Passed {
+		return false
+	}
+
+	report, err := os.ReadFile(reportPath)
+	if err == nil {
+		execution.SecurityFindings, err = securityscan.ParseReport(project.SecurityScanner, report, *task.WorktreePath, files)
+	}
+	if err != nil {
+		run.Passed = false
+		run.Output += fmt.Sprintf("\n[auto-devs] failed to read the %s report: %v", project.SecurityScanner, err)
+		return false
+	}
+
+	high := 0
+	for _, finding := range execution.SecurityFindings {
+		if finding.Severity == entity.SecuritySeverityHigh {
+			high++
+		}
+	}
+	run.Output += fmt.Sprintf("\n[auto-devs] %d finding(s) in the changed files, %d high severity", len(execution.SecurityFindings), high)
+	p.saveSecurityFindings(ctx, task, execution, execution.SecurityFindings)
+
+	blocked := securityscan.HasHighSeverity(execution.SecurityFindings)
+	run.Passed = !blocked
+	return blocked
+}
+
+// changedFiles returns the files the task added or modified against its base
+// branch. The implementation is committed first so new files are included.
+func (p *Processor) changedFiles(ctx context.Context, task *entity.Task) ([]string, error) {
+	if task.BaseBranchName == nil || p.gitManager == nil {
+		return nil, fmt.Errorf("task has no base branch")
+	}
+	worktreePath := *task.WorktreePath
+
+	if _, err := p.gitManager.CommitAll(ctx, worktreePath, implementationCommitMessage(task)); err != nil {
+		return nil, fmt.Errorf("failed to commit implementation: %w", err)
+	}
+
+	files, err := p.gitManager.GetChangedFiles(ctx, worktreePath, *task.BaseBranchName, "HEAD")
+	if err != nil {
+		// Worktrees created from the remote branch may not have it locally
+		return p.gitManager.GetChangedFiles(ctx, worktreePath, "origin/"+*task.BaseBranchName, "HEAD")
+	}
+	return files, nil
+}
+
+// saveSecurityFindings saves findings, a part of the execution's, with the
+// execution and task they belong to
+func (p *Processor) saveSecurityFindings(ctx context.Context, task *entity.Task, execution *entity.Execution, findings []entity.SecurityFinding) {
+	records := make([]*entity.SecurityFinding, len(findings))
+	for i := range findings {
+		findings[i].ExecutionID = execution.ID
+		findings[i].TaskID = task.ID
+		records[i] = &findings[i]
+	}
+	if err := p.securityFindingRepo.BatchCreate(ctx, records); err != nil {
+		// The findings still decide what happens to the PR
+		p.logger.Error("Failed to save security findings", "error", err, "task_id", task.ID)
+	}
+}
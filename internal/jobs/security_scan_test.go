@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGosec puts a gosec on PATH that writes report to the file given with
+// -out
+func fakeGosec(t *testing.T, report string) {
+	t.Helper()
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "report.json"), []byte(report), 0o644))
+	script := "#!/bin/bash\nfor arg in \"$@\"; do case $arg in -out=*) cp " + filepath.Join(bin, "report.json") + " \"${arg#-out=}\";; esac; done\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "gosec"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestVerifyImplementation_SecurityScan(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	newTask := func(t *testing.T) *entity.Task {
+		worktree := initRepo(t)
+		out, err := exec.Command("git", "-C", worktree, "rev-parse", "--abbrev-ref", "HEAD").CombinedOutput()
+		require.NoError(t, err, string(out))
+		baseBranch := strings.TrimSpace(string(out))
+		out, err = exec.Command("git", "-C", worktree, "checkout", "-q", "-b", "task-branch").CombinedOutput()
+		require.NoError(t, err, string(out))
+		return &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree, BaseBranchName: &baseBranch}
+	}
+	newProcessor := func(t *testing.T) (*Processor, *[]*entity.SecurityFinding) {
+		processor, _ := newVerificationProcessor(t)
+		processor.gitManager = gitManager
+		findingRepo := repository.NewSecurityFindingRepositoryMock(t)
+		var saved []*entity.SecurityFinding
+		findingRepo.EXPECT().BatchCreate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, findings []*entity.SecurityFinding) error {
+			saved = findings
+			return nil
+		}).Maybe()
+		processor.securityFindingRepo = findingRepo
+		return processor, &saved
+	}
+	project := &entity.Project{SecurityScanner: entity.SecurityScannerGosec}
+
+	t.Run("high severity blocks", func(t *testing.T) {
+		task := newTask(t)
+		fakeGosec(t, `{"Issues": [
+			{"severity": "HIGH", "rule_id": "G101", "details": "Potential hardcoded credentials", "file": "`+filepath.Join(*task.WorktreePath, "main.go")+`", "line": "1"},
+			{"severity": "HIGH", "rule_id": "G401", "details": "Weak crypto", "file": "`+filepath.Join(*task.WorktreePath, "vendored.go")+`", "line": "3"}
+		]}`)
+		processor, saved := newProcessor(t)
+		execution := &entity.Execution{ID: uuid.New()}
+
+		runs, blockedBy := verify(processor, project, task, execution)
+		require.Len(t, runs, 1)
+		require.NotNil(t, blockedBy)
+		assert.Equal(t, entity.VerificationStepSecurityScan, blockedBy.Step)
+		assert.False(t, blockedBy.Passed)
+		assert.Contains(t, blockedBy.Output, "1 finding(s) in the changed files, 1 high severity")
+		assert.Equal(t, "Security Scan found high-severity issues in the changed files (`"+blockedBy.Command+"`)", blockedReason(blockedBy))
+
+		require.Len(t, execution.SecurityFindings, 1, "only findings in changed files are kept")
+		require.Len(t, *saved, 1)
+		assert.Equal(t, "main.go", (*saved)[0].FilePath)
+		assert.Equal(t, execution.ID, (*saved)[0].ExecutionID)
+		assert.Equal(t, task.ID, (*saved)[0].TaskID)
+	})
+
+	t.Run("lower severities pass", func(t *testing.T) {
+		task := newTask(t)
+		fakeGosec(t, `{"Issues": [{"severity": "MEDIUM", "rule_id": "G304", "details": "File inclusion", "file": "`+filepath.Join(*task.WorktreePath, "main.go")+`", "line": "1"}]}`)
+		processor, _ := newProcessor(t)
+		execution := &entity.Execution{ID: uuid.New()}
+
+		runs, blockedBy := verify(processor, project, task, execution)
+		assert.Nil(t, blockedBy)
+		require.Len(t, runs, 1)
+		assert.True(t, runs[0].Passed)
+		assert.Len(t, execution.SecurityFindings, 1)
+	})
+
+	t.Run("scanner failures do not block", func(t *testing.T) {
+		task := newTask(t)
+		fakeGosec(t, "not json")
+		processor, _ := newProcessor(t)
+
+		runs, blockedBy := verify(processor, project, task, &entity.Execution{ID: uuid.New()})
+		assert.Nil(t, blockedBy)
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].Passed)
+		assert.Contains(t, runs[0].Output, "failed to read the gosec report")
+	})
+}
@@ -3,8 +3,12 @@ package jobs
 import (
 	"context"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/auto-devs/auto-devs/internal/repository"
 	"github.com/hibiken/asynq"
 )
 
@@ -13,11 +17,45 @@ type Server struct {
 	server    *asynq.Server
 	mux       *asynq.ServeMux
 	processor *Processor
+	queues    map[string]int
+	metrics   repository.JobMetricRepository
 	logger    *slog.Logger
 }
 
+// ServerOptions configures which queues a job server serves and how many
+// jobs it runs at once
+type ServerOptions struct {
+	// Queues maps the queues to serve to their priorities; empty serves
+	// DefaultQueues
+	Queues map[string]int
+	// Concurrency is the number of jobs run at once; zero runs
+	// DefaultConcurrency
+	Concurrency int
+	// Metrics keeps the daily rollups of the jobs processed; nil keeps none
+	Metrics repository.JobMetricRepository
+	// Worker, as returned by WorkerID, makes the server serve its
+	// WorkerQueue too, with the priority of the critical queue
+	Worker string
+}
+
+// DefaultConcurrency is the number of jobs a worker runs at once by default
+const DefaultConcurrency = 4
+
 // NewServer creates a new job server
-func NewServer(redisAddr, redisPassword string, redisDB int, processor *Processor) *Server {
+func NewServer(redisAddr, redisPassword string, redisDB int, processor *Processor, opts ServerOptions) *Server {
+	queues := make(map[string]int, len(opts.Queues)+1)
+	maps.Copy(queues, opts.Queues)
+	if len(queues) == 0 {
+		maps.Copy(queues, DefaultQueues)
+	}
+	if opts.Worker != "" {
+		queues[WorkerQueue(opts.Worker)] = DefaultQueues[QueueCritical]
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
 	redisOpt := asynq.RedisClientOpt{
 		Addr:     redisAddr,
 		Password: redisPassword,
@@ -27,16 +65,9 @@ func NewServer(redisAddr, redisPassword string, redisDB int, processor *Processo
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
-			Queues: map[string]int{
-				"critical":       6, // High priority queue
-				"planning":       4, // Planning jobs queue
-				"implementation": 4, // Implementing jobs queue
-				"monitoring":     2, // Monitoring jobs queue
-				"cleanup":        1, // Cleanup jobs queue
-				"default":        1, // Default queue
-			},
+			Queues: queues,
 			// Concurrency settings
-			Concurrency: 4,
+			Concurrency: concurrency,
 			// Retry settings
 			RetryDelayFunc: func(n int, err error, task *asynq.Task) time.Duration {
 				// Exponential backoff: 1s, 2s, 4s, 8s, 16s, 30s (max)
@@ -68,24 +99,65 @@ func NewServer(redisAddr, redisPassword string, redisDB int, processor *Processo
 		server:    server,
 		mux:       mux,
 		processor: processor,
+		queues:    queues,
+		metrics:   opts.Metrics,
 		logger:    slog.Default().With("component", "job-server"),
 	}
 }
 
-// RegisterHandlers registers job handlers
+// RegisterHandlers registers the handlers of the job types enqueued on the
+// queues the server serves
 func (s *Server) RegisterHandlers() {
-	s.mux.HandleFunc(TypeTaskPlanning, s.processor.ProcessTaskPlanning)
-	s.mux.HandleFunc(TypeTaskImplementation, s.processor.ProcessTaskImplementation)
-	s.mux.HandleFunc(TypePRStatusSync, s.processor.ProcessPRStatusSync)
-	s.mux.HandleFunc(TypeWorktreeCleanup, s.processor.ProcessWorktreeCleanup)
-	s.mux.HandleFunc(TypeWorktreeCreate, s.processor.ProcessWorktreeCreate)
-	s.mux.HandleFunc(TypeKanbanNotify, s.processor.ProcessKanbanNotify)
+	handlers := map[string]asynq.HandlerFunc{
+		TypeTaskPlanning:       s.processor.ProcessTaskPlanning,
+		TypeTaskImplementation: s.processor.ProcessTaskImplementation,
+		TypeTaskComparison:     s.processor.ProcessTaskComparison,
+		TypeComparisonSelect:   s.processor.ProcessComparisonSelect,
+		TypePRStatusSync:       s.processor.ProcessPRStatusSync,
+		TypeWorktreeCleanup:    s.processor.ProcessWorktreeCleanup,
+		TypeWorktreeCreate:     s.processor.ProcessWorktreeCreate,
+		TypeWorktreeValidate:   s.processor.ProcessWorktreeValidate,
+		TypeKanbanNotify:       s.processor.ProcessKanbanNotify,
+		TypeJiraSync:           s.processor.ProcessJiraSync,
+		TypeSoftDeletePurge:    s.processor.ProcessSoftDeletePurge,
+		TypeVelocityRollup:     s.processor.ProcessVelocityRollup,
+		TypePreviewStop:        s.processor.ProcessPreviewStop,
+		TypeReleaseNotes:       s.processor.ProcessReleaseNotes,
+		TypeDailyStandup:       s.processor.ProcessDailyStandup,
+		TypeEmailDigest:        s.processor.ProcessEmailDigest,
+		TypeExecutionCancel:    s.processor.ProcessExecutionCancel,
+		TypeWebhookDelivery:    s.processor.ProcessWebhookDelivery,
+		TypePRSync:             s.processor.ProcessPRSync,
+	}
+
+	s.mux.Use(traceJobs)
+	if s.metrics != nil {
+		s.mux.Use(recordJobMetrics(s.metrics, s.logger, time.Now))
+	}
+	s.mux.Use(gitOperationScope)
+	taskTypes := servedTaskTypes(s.queues)
+	if s.servesWorkerQueue() && !slices.Contains(taskTypes, TypeExecutionCancel) {
+		taskTypes = append(taskTypes, TypeExecutionCancel)
+	}
+	for _, taskType := range taskTypes {
+		s.mux.HandleFunc(taskType, handlers[taskType])
+	}
+}
+
+// servesWorkerQueue reports whether the server serves a worker's own queue
+func (s *Server) servesWorkerQueue() bool {
+	for queue := range s.queues {
+		if strings.HasPrefix(queue, workerQueuePrefix) {
+			return true
+		}
+	}
+	return false
 }
 
 // Start starts the job server
 func (s *Server) Start() error {
 	s.RegisterHandlers()
-	s.logger.Info("Starting job server")
+	s.logger.Info("Starting job server", "queues", s.queues)
 	return s.server.Run(s.mux)
 }
 
@@ -0,0 +1,19 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// snapshotWorktree saves the task worktree before a run that may overwrite
+// it, under the given snapshot ID, so the run can be reverted. Failing to
+// take the snapshot does not stop the run.
+func (p *Processor) snapshotWorktree(ctx context.Context, task *entity.Task, snapshotID, message string) {
+	if p.gitManager == nil || task.WorktreePath == nil || *task.WorktreePath == "" {
+		return
+	}
+	if _, err := p.gitManager.CreateSnapshot(ctx, *task.WorktreePath, task.ID.String(), snapshotID, message); err != nil {
+		p.logger.Warn("Failed to snapshot worktree", "error", err, "task_id", task.ID, "snapshot_id", snapshotID)
+	}
+}
@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// purgeReportRetention is how long a completed purge task, and the report
+// stored as its result, is kept in the queue
+const purgeReportRetention = 7 * 24 * time.Hour
+
+// ProcessSoftDeletePurge hard-deletes soft-deleted rows whose deleted_at is
+// older than the retention window carried in the payload. In dry-run mode the
+// rows are only counted. Either way the per-table report is logged and written
+// as the task result so it can be inspected from the queue afterwards.
+func (p *Processor) ProcessSoftDeletePurge(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseSoftDeletePurgePayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse soft delete purge payload: %w", err)
+	}
+
+	if payload.RetentionDays <= 0 {
+		// A zero retention would purge everything soft-deleted so far; never
+		// retrying makes a bad payload fail loudly once instead of repeatedly.
+		return fmt.Errorf("invalid retention days %d: %w", payload.RetentionDays, asynq.SkipRetry)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -payload.RetentionDays)
+	p.logger.Info("Processing soft delete purge job",
+		"retention_days", payload.RetentionDays,
+		"cutoff", cutoff,
+		"dry_run", payload.DryRun)
+
+	report, err := p.purgeRepo.PurgeSoftDeleted(ctx, cutoff, payload.DryRun)
+	if err != nil {
+		p.logger.Error("Failed to purge soft-deleted records", "error", err)
+		return fmt.Errorf("failed to purge soft-deleted records: %w", err)
+	}
+
+	for _, t := range report.Tables {
+		p.logger.Info("Soft delete purge table result",
+			"table", t.Table,
+			"rows", t.Rows,
+			"dry_run", report.DryRun)
+	}
+
+	p.logger.Info("Completed soft delete purge job",
+		"total_rows", report.TotalRows(),
+		"dry_run", report.DryRun)
+
+	if writer := task.ResultWriter(); writer != nil {
+		result, err := json.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("failed to marshal soft delete purge report: %w", err)
+		}
+		if _, err := writer.Write(result); err != nil {
+			// The purge has already committed; losing the report is not worth a retry.
+			p.logger.Warn("Failed to write soft delete purge report", "error", err)
+		}
+	}
+
+	return nil
+}
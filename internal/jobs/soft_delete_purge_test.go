@@ -0,0 +1,84 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPurgeTestProcessor(purgeRepo repository.PurgeRepository) *Processor {
+	return &Processor{
+		purgeRepo: purgeRepo,
+		logger:    slog.Default().With("component", "job-processor-test"),
+	}
+}
+
+func TestSoftDeletePurgePayload_RoundTrip(t *testing.T) {
+	task, err := NewSoftDeletePurgeJob(14, true)
+	require.NoError(t, err)
+	assert.Equal(t, TypeSoftDeletePurge, task.Type())
+
+	payload, err := ParseSoftDeletePurgePayload(task)
+	require.NoError(t, err)
+	assert.Equal(t, 14, payload.RetentionDays)
+	assert.True(t, payload.DryRun)
+}
+
+func TestProcessSoftDeletePurge_UsesRetentionCutoff(t *testing.T) {
+	purgeRepo := repository.NewPurgeRepositoryMock(t)
+	purgeRepo.EXPECT().
+		PurgeSoftDeleted(mock.Anything, mock.MatchedBy(func(cutoff time.Time) bool {
+			expected := time.Now().AddDate(0, 0, -30)
+			return cutoff.Sub(expected).Abs() < time.Minute
+		}), true).
+		Return(&repository.PurgeReport{
+			DryRun: true,
+			Tables: []repository.PurgeTableResult{{Table: "tasks", Rows: 3}},
+		}, nil)
+
+	task, err := NewSoftDeletePurgeJob(30, true)
+	require.NoError(t, err)
+
+	err = newPurgeTestProcessor(purgeRepo).ProcessSoftDeletePurge(context.Background(), task)
+	assert.NoError(t, err)
+}
+
+func TestProcessSoftDeletePurge_RejectsNonPositiveRetention(t *testing.T) {
+	purgeRepo := repository.NewPurgeRepositoryMock(t)
+
+	task, err := NewSoftDeletePurgeJob(0, false)
+	require.NoError(t, err)
+
+	err = newPurgeTestProcessor(purgeRepo).ProcessSoftDeletePurge(context.Background(), task)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, asynq.SkipRetry))
+}
+
+func TestProcessSoftDeletePurge_RepositoryError(t *testing.T) {
+	purgeRepo := repository.NewPurgeRepositoryMock(t)
+	purgeRepo.EXPECT().
+		PurgeSoftDeleted(mock.Anything, mock.Anything, false).
+		Return(nil, errors.New("db down"))
+
+	task, err := NewSoftDeletePurgeJob(7, false)
+	require.NoError(t, err)
+
+	err = newPurgeTestProcessor(purgeRepo).ProcessSoftDeletePurge(context.Background(), task)
+	assert.ErrorContains(t, err, "db down")
+}
+
+func TestPurgeReport_TotalRows(t *testing.T) {
+	report := &repository.PurgeReport{Tables: []repository.PurgeTableResult{
+		{Table: "tasks", Rows: 2},
+		{Table: "plans", Rows: 5},
+	}}
+	assert.Equal(t, int64(7), report.TotalRows())
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+)
+
+// recordSpend stores the tokens and cost a finished AI run reported on its
+// execution and tells the project's clients about any budget threshold the
+// cost reached
+func (p *Processor) recordSpend(ctx context.Context, task *entity.Task, dbExecution *entity.Execution, aiExecutor ai.AiCodingCli, execution *ai.Execution) {
+	if execution.Result == nil {
+		return
+	}
+	usage := aiExecutor.ParseOutputToUsage(execution.Result.Output)
+	if usage == nil {
+		return
+	}
+
+	alerts, err := p.projectUsecase.RecordExecutionUsage(ctx, task.ProjectID, dbExecution, accumulatedUsage(dbExecution, *usage))
+	if err != nil {
+		p.logger.Error("Failed to record execution usage", "error", err, "execution_id", dbExecution.ID)
+		return
+	}
+	for _, alert := range alerts {
+		p.logger.Warn("Project AI spend reached a budget threshold",
+			"project_id", alert.ProjectID,
+			"threshold", alert.Threshold,
+			"spent_usd", alert.SpentUSD,
+			"budget_usd", alert.BudgetUSD)
+		if p.wsService == nil {
+			continue
+		}
+		if err := p.wsService.SendProjectMessage(alert.ProjectID, websocket.BudgetThresholdReached, alert); err != nil {
+			p.logger.Error("Failed to send budget alert", "error", err, "project_id", alert.ProjectID)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/hibiken/asynq"
+)
+
+// standupTimeout bounds a daily standup execution
+const standupTimeout = 10 * time.Minute
+
+// ProcessDailyStandup has the planning service summarize the previous day,
+// in UTC, of every project and sends the summaries. A project whose summary
+// fails is logged and skipped; the job is not retried, so no project gets
+// its summary twice.
+func (p *Processor) ProcessDailyStandup(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseDailyStandupPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse daily standup payload: %w", err)
+	}
+
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	from := to.AddDate(0, 0, -1)
+	p.logger.Info("Processing daily standup job", "day", from.Format("2006-01-02"), "ai_type", payload.AIType)
+
+	aiExecutor, err := p.getAiExecutor(payload.AIType)
+	if err != nil {
+		return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+	}
+
+	reports, err := p.taskUsecase.CollectStandupReports(ctx, from, to)
+	if err != nil {
+		if len(reports) == 0 {
+			return fmt.Errorf("failed to collect standup reports: %w", err)
+		}
+		p.logger.Warn("Failed to collect some standup reports", "error", err)
+	}
+
+	sent := 0
+	for _, report := range reports {
+		summary, err := p.generateStandupSummary(ctx, aiExecutor, report)
+		if err != nil {
+			p.logger.Error("Failed to generate standup summary", "error", err, "project_id", report.ProjectID)
+			continue
+		}
+		if err := p.taskUsecase.SendStandup(ctx, report, summary); err != nil {
+			p.logger.Error("Failed to send standup summary", "error", err, "project_id", report.ProjectID)
+			continue
+		}
+		if p.wsService != nil {
+			if err := p.wsService.NotifyDailyStandup(report.ProjectID, from, summary); err != nil {
+				p.logger.Warn("Failed to broadcast standup summary", "error", err, "project_id", report.ProjectID)
+			}
+		}
+		sent++
+	}
+
+	p.logger.Info("Completed daily standup job", "projects", len(reports), "sent", sent)
+	return nil
+}
+
+// generateStandupSummary runs the AI execution writing the summary of report
+// and returns it in Markdown
+func (p *Processor) generateStandupSummary(ctx context.Context, aiExecutor ai.AiCodingCli, report *entity.StandupReport) (string, error) {
+	execution, injectEnvVars, err := p.planningService.StartStandupSummary(report, aiExecutor)
+	if err != nil {
+		return "", fmt.Errorf("failed to start standup execution: %w", err)
+	}
+	output, err := p.runToCompletion(ctx, nil, execution, injectEnvVars, standupTimeout)
+	if err != nil {
+		return "", fmt.Errorf("standup execution failed: %w", err)
+	}
+	return aiExecutor.ParseOutputToStandup(output)
+}
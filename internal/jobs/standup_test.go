@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessDailyStandup_UnknownExecutor(t *testing.T) {
+	task, err := NewDailyStandupJob("gpt-nope")
+	require.NoError(t, err)
+	assert.Equal(t, TypeDailyStandup, task.Type())
+	assert.Equal(t, QueueDefault, QueueFor(task.Type()))
+
+	p := &Processor{logger: slog.Default()}
+	err = p.ProcessDailyStandup(context.Background(), task)
+	assert.True(t, errors.Is(err, asynq.SkipRetry), "a misconfigured executor is not retried")
+}
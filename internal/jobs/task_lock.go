@@ -0,0 +1,143 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// TaskLocker keeps two workers from planning or implementing the same task
+// at once, which would create duplicate worktrees and push conflicting
+// changes
+type TaskLocker interface {
+	// Lock takes the task's lock, waiting for it while ctx allows, and
+	// returns the function releasing it
+	Lock(ctx context.Context, taskID uuid.UUID) (func(), error)
+}
+
+const (
+	// taskLockTTL is how long a lock outlives a worker that stopped renewing
+	// it, having crashed
+	taskLockTTL = time.Minute
+	// taskLockRetryInterval is how often a taken lock is tried again
+	taskLockRetryInterval = 2 * time.Second
+)
+
+// ErrTaskLocked is returned when the lock of a task could not be taken in
+// time, another worker holding it
+var ErrTaskLocked = errors.New("task is being processed by another worker")
+
+// releaseLockScript deletes a lock or lease only if it is still the holder's
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// renewLockScript extends a lock or lease only if it is still the holder's
+var renewLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// RedisTaskLocker locks tasks in Redis, shared by every worker. A lock is
+// renewed while held and expires taskLockTTL after its worker stops.
+type RedisTaskLocker struct {
+	client *redis.Client
+	logger *slog.Logger
+}
+
+// NewRedisTaskLocker creates a task locker on the Redis the job queues use
+func NewRedisTaskLocker(redisAddr, redisPassword string, redisDB int) *RedisTaskLocker {
+	return &RedisTaskLocker{
+		client: redis.NewClient(&redis.Options{
+			Addr:     redisAddr,
+			Password: redisPassword,
+			DB:       redisDB,
+		}),
+		logger: slog.Default().With("component", "task-locker"),
+	}
+}
+
+// Close closes the Redis client
+func (l *RedisTaskLocker) Close() error {
+	return l.client.Close()
+}
+
+// Lock takes the task's lock, waiting for it while ctx allows
+func (l *RedisTaskLocker) Lock(ctx context.Context, taskID uuid.UUID) (func(), error) {
+	key := "auto-devs:task-lock:" + taskID.String()
+	token := uuid.NewString()
+
+	for waited := false; ; waited = true {
+		ok, err := l.client.SetNX(ctx, key, token, taskLockTTL).Result()
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("failed to take task lock: %w", err)
+		}
+		if ok {
+			break
+		}
+		if !waited {
+			l.logger.Info("Waiting for the task lock held by another worker", "task_id", taskID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %w", ErrTaskLocked, ctx.Err())
+		case <-time.After(taskLockRetryInterval):
+		}
+	}
+
+	stop := make(chan struct{})
+	go l.renew(key, token, stop)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(stop)
+			if err := releaseLockScript.Run(context.Background(), l.client, []string{key}, token).Err(); err != nil {
+				l.logger.Error("Failed to release task lock", "task_id", taskID, "error", err)
+			}
+		})
+	}, nil
+}
+
+// renew extends the lock every third of its TTL until stop is closed
+func (l *RedisTaskLocker) renew(key, token string, stop <-chan struct{}) {
+	ticker := time.NewTicker(taskLockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			renewed, err := renewLockScript.Run(context.Background(), l.client, []string{key}, token, taskLockTTL.Milliseconds()).Int()
+			if err != nil {
+				l.logger.Error("Failed to renew task lock", "key", key, "error", err)
+			} else if renewed == 0 {
+				l.logger.Warn("Task lock expired while held", "key", key)
+				return
+			}
+		}
+	}
+}
+
+// SetTaskLocker sets the locker keeping other workers off the tasks the
+// processor plans or implements. Without one, tasks are not locked.
+func (p *Processor) SetTaskLocker(locker TaskLocker) {
+	p.taskLocker = locker
+}
+
+// lockTask takes the task's lock when the processor has a locker
+func (p *Processor) lockTask(ctx context.Context, taskID uuid.UUID) (func(), error) {
+	if p.taskLocker == nil {
+		return func() {}, nil
+	}
+	return p.taskLocker.Lock(ctx, taskID)
+}
@@ -0,0 +1,66 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTaskLocker struct {
+	err      error
+	locked   []uuid.UUID
+	unlocked int
+}
+
+func (f *fakeTaskLocker) Lock(_ context.Context, taskID uuid.UUID) (func(), error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.locked = append(f.locked, taskID)
+	return func() { f.unlocked++ }, nil
+}
+
+func TestProcessTaskPlanning_TaskLockedByAnotherWorker(t *testing.T) {
+	taskID := uuid.New()
+	locker := &fakeTaskLocker{err: ErrTaskLocked}
+	// Nothing is read or changed while another worker holds the task
+	processor := &Processor{taskUsecase: usecase.NewTaskUsecaseMock(t), taskLocker: locker, logger: slog.Default()}
+
+	job, err := NewTaskPlanningJob(taskID, "feature/login", uuid.New(), "claude-code", false, false)
+	require.NoError(t, err)
+	err = processor.ProcessTaskPlanning(context.Background(), job)
+
+	assert.ErrorIs(t, err, ErrTaskLocked)
+}
+
+func TestProcessTaskImplementation_ReleasesTaskLockOnFailure(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	taskUsecase.EXPECT().GetByID(ctx, taskID).Return(nil, errors.New("connection refused")).Once()
+	locker := &fakeTaskLocker{}
+	processor := &Processor{taskUsecase: taskUsecase, taskLocker: locker, logger: slog.Default()}
+
+	job, err := NewTaskImplementationJob(taskID, uuid.New(), "claude-code", false)
+	require.NoError(t, err)
+	err = processor.ProcessTaskImplementation(ctx, job)
+
+	require.Error(t, err)
+	assert.Equal(t, []uuid.UUID{taskID}, locker.locked)
+	assert.Equal(t, 1, locker.unlocked, "the lock is released when no execution was started")
+}
+
+func TestLockTask_WithoutLocker(t *testing.T) {
+	processor := &Processor{logger: slog.Default()}
+
+	unlock, err := processor.lockTask(context.Background(), uuid.New())
+
+	require.NoError(t, err)
+	unlock()
+}
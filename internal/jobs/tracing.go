@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceContextPayload is the trace context any job payload may carry
+type traceContextPayload struct {
+	TraceContext map[string]string `json:"trace_context"`
+}
+
+// traceJobs runs every job in a span, continuing the trace of the request
+// that enqueued it when its payload carries one
+func traceJobs(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		var payload traceContextPayload
+		_ = json.Unmarshal(task.Payload(), &payload)
+		jobID, _ := asynq.GetTaskID(ctx)
+		queue, _ := asynq.GetQueueName(ctx)
+
+		ctx, span := tracing.Start(tracing.Extract(ctx, payload.TraceContext), "job "+task.Type(),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(
+				attribute.String("job.type", task.Type()),
+				attribute.String("job.id", jobID),
+				attribute.String("job.queue", queue),
+			))
+		err := next.ProcessTask(ctx, task)
+		tracing.End(span, err)
+		return err
+	})
+}
+
+// startEnqueueSpan starts the span of enqueueing a job in the trace of
+// traceContext, and returns the trace context the job goes on with. Jobs
+// enqueued outside a trace are not traced.
+func startEnqueueSpan(traceContext map[string]string, jobType, queue string) (map[string]string, trace.Span) {
+	if len(traceContext) == 0 {
+		return nil, trace.SpanFromContext(context.Background())
+	}
+
+	ctx, span := tracing.Start(tracing.Extract(context.Background(), traceContext), "enqueue "+jobType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("job.type", jobType),
+			attribute.String("job.queue", queue),
+		))
+	return tracing.Inject(ctx), span
+}
+
+// startExecutionSpan starts the span of an AI execution of the task, which
+// lasts until the execution is finished with and is ended by endExecutionSpan
+func startExecutionSpan(ctx context.Context, taskID uuid.UUID, aiType string, phase entity.ExecutionPhase) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "ai.execution",
+		trace.WithAttributes(
+			attribute.String("task.id", taskID.String()),
+			attribute.String("ai.type", aiType),
+			attribute.String("execution.phase", string(phase)),
+		))
+}
+
+// endExecutionSpan ends the span of an execution that ran, failed with its
+// error if it did
+func endExecutionSpan(span trace.Span, execution *ai.Execution) {
+	span.SetAttributes(attribute.Bool("execution.cancelled", execution.IsCancelled()))
+	tracing.Fail(span, execution.Error)
+	span.End()
+}
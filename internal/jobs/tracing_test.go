@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceJobs(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	requestCtx, request := tracing.Start(context.Background(), "POST /api/v1/tasks/:id/start-planning")
+	traceContext, enqueue := startEnqueueSpan(tracing.Inject(requestCtx), TypeTaskPlanning, QueuePlanning)
+	enqueue.End()
+	request.End()
+	task, err := NewTaskPlanningTask(TaskPlanningPayload{TaskID: uuid.New(), TraceContext: traceContext})
+	require.NoError(t, err)
+
+	var jobTraceID string
+	handler := traceJobs(asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		jobTraceID = tracing.TraceID(ctx)
+		return errors.New("no worktree base path")
+	}))
+	assert.Error(t, handler.ProcessTask(context.Background(), task))
+
+	assert.Equal(t, tracing.TraceID(requestCtx), jobTraceID, "the job goes on in the request's trace")
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	job := spans[2]
+	assert.Equal(t, "job "+TypeTaskPlanning, job.Name())
+	assert.Equal(t, trace.SpanKindConsumer, job.SpanKind())
+	assert.Equal(t, enqueue.SpanContext().SpanID(), job.Parent().SpanID())
+	assert.Equal(t, codes.Error, job.Status().Code)
+
+	traceContext, span := startEnqueueSpan(nil, TypeTaskPlanning, QueuePlanning)
+	span.End()
+	assert.Nil(t, traceContext)
+	assert.Len(t, recorder.Ended(), 3, "jobs enqueued outside a trace are not traced")
+}
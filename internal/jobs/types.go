@@ -13,10 +13,23 @@ import (
 const (
 	TypeTaskPlanning       = "task:planning"
 	TypeTaskImplementation = "task:implementation"
+	TypeTaskComparison     = "task:comparison"
+	TypeComparisonSelect   = "task:comparison_select"
 	TypePRStatusSync       = "pr:status_sync"
+	TypePRSync             = "pr:sync"
 	TypeWorktreeCleanup    = "worktree:cleanup"
 	TypeWorktreeCreate     = "worktree:create"
+	TypeWorktreeValidate   = "worktree:validate"
 	TypeKanbanNotify       = "kanban:notify"
+	TypeJiraSync           = "jira:sync"
+	TypeSoftDeletePurge    = "maintenance:soft_delete_purge"
+	TypeVelocityRollup     = "analytics:velocity_rollup"
+	TypePreviewStop        = "preview:stop"
+	TypeReleaseNotes       = "release_notes:generate"
+	TypeDailyStandup       = "report:daily_standup"
+	TypeEmailDigest        = "report:email_digest"
+	TypeExecutionCancel    = "execution:cancel"
+	TypeWebhookDelivery    = "webhook:deliver"
 )
 
 // TaskPlanningPayload represents the payload for task planning jobs
@@ -27,6 +40,18 @@ type TaskPlanningPayload struct {
 	AIType          string    `json:"ai_type"`
 	AutoImplement   bool      `json:"auto_implement"`
 	UseRemoteBranch bool      `json:"use_remote_branch"`
+	// Scheduled jobs were delayed until a start time, and are skipped when
+	// the task has moved on by then
+	Scheduled bool `json:"scheduled,omitempty"`
+	// Feedback is the reviewer's reason for rejecting the previous plan,
+	// which the new plan addresses
+	Feedback string `json:"feedback,omitempty"`
+	// RetryOfID is the failed execution the job retries as the given
+	// Attempt, and the job is skipped when the task has moved on by then
+	RetryOfID *uuid.UUID `json:"retry_of_id,omitempty"`
+	Attempt   int        `json:"attempt,omitempty"`
+	// TraceContext continues the trace of the request enqueueing the job
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // TaskImplementationPayload represents the payload for task implementation jobs
@@ -35,6 +60,39 @@ type TaskImplementationPayload struct {
 	ProjectID       uuid.UUID `json:"project_id"`
 	AIType          string    `json:"ai_type"`
 	UseRemoteBranch bool      `json:"use_remote_branch"`
+	// ChangeRequest is the reviewer feedback a follow-up implementation of
+	// a task in code review addresses, empty for a first implementation
+	ChangeRequest string `json:"change_request,omitempty"`
+	// ResumeExecutionID is the execution waiting for input that the job
+	// resumes with Answer, the user's answer to its question
+	ResumeExecutionID *uuid.UUID `json:"resume_execution_id,omitempty"`
+	Answer            string     `json:"answer,omitempty"`
+	Scheduled         bool       `json:"scheduled,omitempty"`
+	// DryRun runs the executor in a throwaway worktree and attaches the diff
+	// it produced to the task; nothing is committed or pushed and the task
+	// keeps its status
+	DryRun bool `json:"dry_run,omitempty"`
+	// RetryOfID is the failed execution the job retries as the given
+	// Attempt, and the job is skipped when the task has moved on by then
+	RetryOfID *uuid.UUID `json:"retry_of_id,omitempty"`
+	Attempt   int        `json:"attempt,omitempty"`
+	// TraceContext continues the trace of the request enqueueing the job
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// TaskComparisonPayload represents the payload for executor comparison jobs
+type TaskComparisonPayload struct {
+	TaskID    uuid.UUID `json:"task_id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	AITypes   []string  `json:"ai_types"`
+}
+
+// ComparisonSelectPayload represents the payload for jobs continuing the
+// winning variant of an executor comparison
+type ComparisonSelectPayload struct {
+	TaskID      uuid.UUID `json:"task_id"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	ExecutionID uuid.UUID `json:"execution_id"`
 }
 
 // PRStatusSyncPayload represents the payload for PR status sync jobs
@@ -42,11 +100,22 @@ type PRStatusSyncPayload struct {
 	// Empty payload since this job checks all open PRs
 }
 
+// PRSyncPayload represents the payload for jobs syncing a single PR, which
+// a repository host webhook reported a change of
+type PRSyncPayload struct {
+	PullRequestID uuid.UUID `json:"pull_request_id"`
+}
+
 // WorktreeCleanupPayload represents the payload for worktree cleanup jobs
 type WorktreeCleanupPayload struct {
 	// Empty payload since this job processes all eligible tasks
 }
 
+// WorktreeValidatePayload represents the payload for worktree validation jobs
+type WorktreeValidatePayload struct {
+	// Empty payload since this job checks every task with a worktree
+}
+
 // KanbanNotifyPayload represents the payload for Hermes kanban callback jobs
 type KanbanNotifyPayload struct {
 	TaskID       uuid.UUID         `json:"task_id"`
@@ -55,6 +124,55 @@ type KanbanNotifyPayload struct {
 	NewStatus    entity.TaskStatus `json:"new_status"`
 }
 
+// JiraSyncPayload represents the payload for Jira status sync jobs
+type JiraSyncPayload struct {
+	TaskID    uuid.UUID         `json:"task_id"`
+	NewStatus entity.TaskStatus `json:"new_status"`
+}
+
+// PreviewStopPayload represents the payload for preview teardown jobs
+type PreviewStopPayload struct {
+	TaskID uuid.UUID `json:"task_id"`
+}
+
+// ExecutionCancelPayload represents the payload for execution cancellation
+// jobs
+type ExecutionCancelPayload struct {
+	ExecutionID uuid.UUID `json:"execution_id"`
+}
+
+// WebhookDeliveryPayload represents the payload for jobs posting a delivery
+// of a project webhook
+type WebhookDeliveryPayload struct {
+	DeliveryID uuid.UUID `json:"delivery_id"`
+}
+
+// ReleaseNotesPayload represents the payload for release notes generation jobs
+type ReleaseNotesPayload struct {
+	ReleaseNotesID uuid.UUID `json:"release_notes_id"`
+}
+
+// SoftDeletePurgePayload represents the payload for soft-delete purge jobs
+type SoftDeletePurgePayload struct {
+	RetentionDays int  `json:"retention_days"`
+	DryRun        bool `json:"dry_run"`
+}
+
+// VelocityRollupPayload represents the payload for velocity rollup jobs
+type VelocityRollupPayload struct {
+	// Weeks is how many weeks, up to the current one, are recomputed
+	Weeks int `json:"weeks"`
+}
+
+// DailyStandupPayload represents the payload for daily standup jobs
+type DailyStandupPayload struct {
+	// AIType is the executor writing the summaries
+	AIType string `json:"ai_type"`
+}
+
+// EmailDigestPayload represents the payload for email digest jobs
+type EmailDigestPayload struct{}
+
 // WorktreeCreatePayload represents the payload for worktree creation jobs
 type WorktreeCreatePayload struct {
 	WorktreeID      uuid.UUID `json:"worktree_id"`
@@ -66,16 +184,19 @@ type WorktreeCreatePayload struct {
 
 // NewTaskPlanningJob creates a new task planning job
 func NewTaskPlanningJob(taskID uuid.UUID, branchName string, projectID uuid.UUID, aiType string, autoImplement, useRemoteBranch bool) (*asynq.Task, error) {
-	payload := TaskPlanningPayload{
+	return NewTaskPlanningTask(TaskPlanningPayload{
 		TaskID:          taskID,
 		BranchName:      branchName,
 		ProjectID:       projectID,
 		AIType:          aiType,
 		AutoImplement:   autoImplement,
 		UseRemoteBranch: useRemoteBranch,
-	}
+	})
+}
 
-	data, err := json.Marshal(payload)
+// NewTaskPlanningTask creates a new task planning job from its whole payload
+func NewTaskPlanningTask(p TaskPlanningPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal task planning payload: %w", err)
 	}
@@ -94,14 +215,18 @@ func ParseTaskPlanningPayload(task *asynq.Task) (*TaskPlanningPayload, error) {
 
 // NewTaskImplementationJob creates a new task implementation job
 func NewTaskImplementationJob(taskID uuid.UUID, projectID uuid.UUID, aiType string, useRemoteBranch bool) (*asynq.Task, error) {
-	payload := TaskImplementationPayload{
+	return NewTaskImplementationTask(TaskImplementationPayload{
 		TaskID:          taskID,
 		ProjectID:       projectID,
 		AIType:          aiType,
 		UseRemoteBranch: useRemoteBranch,
-	}
+	})
+}
 
-	data, err := json.Marshal(payload)
+// NewTaskImplementationTask creates a new task implementation job from its
+// whole payload, with the change request or answer it carries
+func NewTaskImplementationTask(p TaskImplementationPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal task implementation payload: %w", err)
 	}
@@ -118,6 +243,44 @@ func ParseTaskImplementationPayload(task *asynq.Task) (*TaskImplementationPayloa
 	return &payload, nil
 }
 
+// NewTaskComparisonTask creates a new executor comparison job
+func NewTaskComparisonTask(p TaskComparisonPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task comparison payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeTaskComparison, data), nil
+}
+
+// ParseTaskComparisonPayload parses the executor comparison payload from asynq task
+func ParseTaskComparisonPayload(task *asynq.Task) (*TaskComparisonPayload, error) {
+	var payload TaskComparisonPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task comparison payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewComparisonSelectTask creates a new comparison select job
+func NewComparisonSelectTask(p ComparisonSelectPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal comparison select payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeComparisonSelect, data), nil
+}
+
+// ParseComparisonSelectPayload parses the comparison select payload from asynq task
+func ParseComparisonSelectPayload(task *asynq.Task) (*ComparisonSelectPayload, error) {
+	var payload ComparisonSelectPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal comparison select payload: %w", err)
+	}
+	return &payload, nil
+}
+
 // NewPRStatusSyncJob creates a new PR status sync job
 func NewPRStatusSyncJob() (*asynq.Task, error) {
 	payload := PRStatusSyncPayload{}
@@ -160,6 +323,27 @@ func ParseWorktreeCleanupPayload(task *asynq.Task) (*WorktreeCleanupPayload, err
 	return &payload, nil
 }
 
+// NewWorktreeValidateJob creates a new worktree validation job
+func NewWorktreeValidateJob() (*asynq.Task, error) {
+	payload := WorktreeValidatePayload{}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal worktree validate payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeWorktreeValidate, data), nil
+}
+
+// ParseWorktreeValidatePayload parses the worktree validation payload from asynq task
+func ParseWorktreeValidatePayload(task *asynq.Task) (*WorktreeValidatePayload, error) {
+	var payload WorktreeValidatePayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal worktree validate payload: %w", err)
+	}
+	return &payload, nil
+}
+
 // NewKanbanNotifyTask creates a new kanban notify job
 func NewKanbanNotifyTask(p KanbanNotifyPayload) (*asynq.Task, error) {
 	data, err := json.Marshal(p)
@@ -179,6 +363,121 @@ func ParseKanbanNotifyPayload(task *asynq.Task) (*KanbanNotifyPayload, error) {
 	return &payload, nil
 }
 
+// NewPRSyncTask creates a new PR sync job
+func NewPRSyncTask(p PRSyncPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PR sync payload: %w", err)
+	}
+
+	return asynq.NewTask(TypePRSync, data), nil
+}
+
+// ParsePRSyncPayload parses the PR sync payload from asynq task
+func ParsePRSyncPayload(task *asynq.Task) (*PRSyncPayload, error) {
+	var payload PRSyncPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PR sync payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewWebhookDeliveryTask creates a new webhook delivery job
+func NewWebhookDeliveryTask(p WebhookDeliveryPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook delivery payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeWebhookDelivery, data), nil
+}
+
+// ParseWebhookDeliveryPayload parses the webhook delivery payload from asynq task
+func ParseWebhookDeliveryPayload(task *asynq.Task) (*WebhookDeliveryPayload, error) {
+	var payload WebhookDeliveryPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal webhook delivery payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewJiraSyncTask creates a new jira sync job
+func NewJiraSyncTask(p JiraSyncPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal jira sync payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeJiraSync, data), nil
+}
+
+// ParseJiraSyncPayload parses the jira sync payload from asynq task
+func ParseJiraSyncPayload(task *asynq.Task) (*JiraSyncPayload, error) {
+	var payload JiraSyncPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal jira sync payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewPreviewStopTask creates a new preview stop job
+func NewPreviewStopTask(p PreviewStopPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal preview stop payload: %w", err)
+	}
+
+	return asynq.NewTask(TypePreviewStop, data), nil
+}
+
+// ParsePreviewStopPayload parses the preview stop payload from asynq task
+func ParsePreviewStopPayload(task *asynq.Task) (*PreviewStopPayload, error) {
+	var payload PreviewStopPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal preview stop payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewExecutionCancelTask creates a new execution cancellation job
+func NewExecutionCancelTask(p ExecutionCancelPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal execution cancel payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeExecutionCancel, data), nil
+}
+
+// ParseExecutionCancelPayload parses the execution cancel payload from asynq
+// task
+func ParseExecutionCancelPayload(task *asynq.Task) (*ExecutionCancelPayload, error) {
+	var payload ExecutionCancelPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal execution cancel payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewReleaseNotesTask creates a new release notes generation job
+func NewReleaseNotesTask(p ReleaseNotesPayload) (*asynq.Task, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal release notes payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeReleaseNotes, data), nil
+}
+
+// ParseReleaseNotesPayload parses the release notes payload from asynq task
+func ParseReleaseNotesPayload(task *asynq.Task) (*ReleaseNotesPayload, error) {
+	var payload ReleaseNotesPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal release notes payload: %w", err)
+	}
+	return &payload, nil
+}
+
 // NewWorktreeCreateJob creates a new worktree creation job
 func NewWorktreeCreateJob(worktreeID, taskID, projectID uuid.UUID, baseBranchName string, useRemoteBranch bool) (*asynq.Task, error) {
 	payload := WorktreeCreatePayload{
@@ -205,3 +504,81 @@ func ParseWorktreeCreatePayload(task *asynq.Task) (*WorktreeCreatePayload, error
 	}
 	return &payload, nil
 }
+
+// NewSoftDeletePurgeJob creates a new soft-delete purge job
+func NewSoftDeletePurgeJob(retentionDays int, dryRun bool) (*asynq.Task, error) {
+	payload := SoftDeletePurgePayload{
+		RetentionDays: retentionDays,
+		DryRun:        dryRun,
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal soft delete purge payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeSoftDeletePurge, data), nil
+}
+
+// ParseSoftDeletePurgePayload parses the soft-delete purge payload from asynq task
+func ParseSoftDeletePurgePayload(task *asynq.Task) (*SoftDeletePurgePayload, error) {
+	var payload SoftDeletePurgePayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal soft delete purge payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewVelocityRollupJob creates a new velocity rollup job
+func NewVelocityRollupJob(weeks int) (*asynq.Task, error) {
+	payload := VelocityRollupPayload{Weeks: weeks}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal velocity rollup payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeVelocityRollup, data), nil
+}
+
+// ParseVelocityRollupPayload parses the velocity rollup payload from asynq task
+func ParseVelocityRollupPayload(task *asynq.Task) (*VelocityRollupPayload, error) {
+	var payload VelocityRollupPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal velocity rollup payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewDailyStandupJob creates a new daily standup job
+func NewDailyStandupJob(aiType string) (*asynq.Task, error) {
+	payload := DailyStandupPayload{AIType: aiType}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal daily standup payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeDailyStandup, data), nil
+}
+
+// ParseDailyStandupPayload parses the daily standup payload from asynq task
+func ParseDailyStandupPayload(task *asynq.Task) (*DailyStandupPayload, error) {
+	var payload DailyStandupPayload
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal daily standup payload: %w", err)
+	}
+	return &payload, nil
+}
+
+// NewEmailDigestJob creates a new email digest job
+func NewEmailDigestJob() (*asynq.Task, error) {
+	payload := EmailDigestPayload{}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal email digest payload: %w", err)
+	}
+
+	return asynq.NewTask(TypeEmailDigest, data), nil
+}
@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+)
+
+// ProcessVelocityRollup recomputes the weekly velocity of every project over
+// the number of weeks carried in the payload. Rollups are overwritten, so a
+// retry only redoes the work.
+func (p *Processor) ProcessVelocityRollup(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseVelocityRollupPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse velocity rollup payload: %w", err)
+	}
+
+	p.logger.Info("Processing velocity rollup job", "weeks", payload.Weeks)
+
+	if err := p.taskUsecase.RollupVelocity(ctx, payload.Weeks); err != nil {
+		if errors.Is(err, usecase.ErrVelocityWeeksInvalid) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		p.logger.Error("Failed to roll up velocity", "error", err)
+		return fmt.Errorf("failed to roll up velocity: %w", err)
+	}
+
+	p.logger.Info("Completed velocity rollup job", "weeks", payload.Weeks)
+	return nil
+}
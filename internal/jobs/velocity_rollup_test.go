@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessVelocityRollup(t *testing.T) {
+	taskUsecase := usecase.NewTaskUsecaseMock(t)
+	p := &Processor{taskUsecase: taskUsecase, logger: slog.Default()}
+
+	task, err := NewVelocityRollupJob(2)
+	require.NoError(t, err)
+	assert.Equal(t, TypeVelocityRollup, task.Type())
+
+	taskUsecase.EXPECT().RollupVelocity(mock.Anything, 2).Return(nil).Once()
+	assert.NoError(t, p.ProcessVelocityRollup(context.Background(), task))
+
+	taskUsecase.EXPECT().RollupVelocity(mock.Anything, 2).Return(usecase.ErrVelocityWeeksInvalid).Once()
+	err = p.ProcessVelocityRollup(context.Background(), task)
+	assert.True(t, errors.Is(err, asynq.SkipRetry), "an invalid payload is not retried")
+}
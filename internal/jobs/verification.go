@@ -0,0 +1,236 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/auto-devs/auto-devs/internal/websocket"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
+)
+
+// lintFixesCommitMessage is the message of the commit holding the changes
+// made by the project's lint command
+func lintFixesCommitMessage(project *entity.Project) string {
+	return i18n.T(i18n.Parse(project.Locale), "commit.lint_fixes")
+}
+
+// verificationPipeline returns the project's verification pipeline, falling
+// back to the one its settings describe when it cannot be loaded
+func (p *Processor) verificationPipeline(ctx context.Context, project *entity.Project) *entity.VerificationPipeline {
+	pipeline, err := p.projectUsecase.GetVerificationPipeline(ctx, project.ID)
+	if err != nil {
+		p.logger.Error("Failed to load verification pipeline, using the default", "error", err, "project_id", project.ID)
+		return entity.DefaultVerificationPipeline(project)
+	}
+	return pipeline
+}
+
+// verifyImplementation runs the steps of pipeline in the task worktree after
+// a successful implementation. A run is created pending for every step up
+// front, then the steps run in order, each reporting its progress over
+// WebSocket. Once a step blocks the pull request the remaining ones are
+// skipped. It returns the runs, and the run that blocks the pull request if
+// there is one.
+func (p *Processor) verifyImplementation(ctx context.Context, project *entity.Project, pipeline *entity.VerificationPipeline, task *entity.Task, execution *entity.Execution, aiExecutor ai.AiCodingCli) ([]entity.VerificationRun, *entity.VerificationRun) {
+	if task.WorktreePath == nil || p.verificationRunner == nil {
+		return nil, nil
+	}
+
+	runs := make([]entity.VerificationRun, len(pipeline.Steps))
+	for i, step := range pipeline.Steps {
+		runs[i] = entity.VerificationRun{
+			ExecutionID: execution.ID,
+			TaskID:      task.ID,
+			Step:        step.Step,
+			Position:    i,
+			Attempt:     execution.FixAttempts,
+			Status:      entity.VerificationRunStatusPending,
+			Command:     step.CommandFor(project),
+		}
+		p.saveVerificationRun(ctx, &runs[i])
+		p.notifyVerificationProgress(task, &runs[i])
+	}
+
+	for i, step := range pipeline.Steps {
+		run := &runs[i]
+		run.Status = entity.VerificationRunStatusRunning
+		run.StartedAt = time.Now()
+		p.updateVerificationRun(ctx, task, run)
+
+		blocked := p.runPipelineStep(ctx, project, task, execution, aiExecutor, step, run)
+		if run.Status == entity.VerificationRunStatusRunning {
+			run.Status = entity.VerificationRunStatusFailed
+			if run.Passed {
+				run.Status = entity.VerificationRunStatusPassed
+			}
+		}
+		if run.CompletedAt.IsZero() {
+			run.CompletedAt = time.Now()
+		}
+		p.updateVerificationRun(ctx, task, run)
+
+		if blocked {
+			for j := i + 1; j < len(runs); j++ {
+				runs[j].Status = entity.VerificationRunStatusSkipped
+				p.updateVerificationRun(ctx, task, &runs[j])
+			}
+			return runs, run
+		}
+	}
+
+	return runs, nil
+}
+
+// runPipelineStep runs one step of the verification pipeline into run and
+// reports whether it blocks the pull request. Setup and build failures
+// always do, since nothing after them can be trusted; test failures do
+// unless the project only flags them; the security scan does on
+// high-severity findings and the test changes check when no test changed;
+// lint and review never do.
+func (p *Processor) runPipelineStep(ctx context.Context, project *entity.Project, task *entity.Task, execution *entity.Execution, aiExecutor ai.AiCodingCli, step entity.VerificationPipelineStep, run *entity.VerificationRun) bool {
+	switch step.Step {
+	case entity.VerificationStepSetup, entity.VerificationStepBuild:
+		p.runVerificationStep(ctx, run, task)
+		return !run.Passed
+	case entity.VerificationStepLint:
+		p.runLint(ctx, project, task, run)
+		return false
+	case entity.VerificationStepTest:
+		p.runVerificationStep(ctx, run, task)
+		return testsBlockPR(project, run)
+	case entity.VerificationStepSecurityScan:
+		return p.runSecurityScan(ctx, project, task, execution, run)
+	case entity.VerificationStepReview:
+		p.runReviewStep(ctx, task, execution, aiExecutor, run)
+		return false
+	case entity.VerificationStepTestChanges:
+		return p.runTestChangesCheck(ctx, task, run)
+	default:
+		run.Status = entity.VerificationRunStatusSkipped
+		run.Output = fmt.Sprintf("[auto-devs] unknown verification step %q", step.Step)
+		return false
+	}
+}
+
+// blockedReason explains in the task's error log why a verification run kept
+// the pull request from being opened
+func blockedReason(run *entity.VerificationRun) string {
+	if run.Step == entity.VerificationStepSecurityScan && run.ExitCode == 0 {
+		return fmt.Sprintf("%s found high-severity issues in the changed files (`%s`)", run.Step.GetDisplayName(), run.Command)
+	}
+	if run.Step == entity.VerificationStepTestChanges {
+		return fmt.Sprintf("%s failed after implementation: the task's workflow requires the changes to add or update a test", run.Step.GetDisplayName())
+	}
+	return fmt.Sprintf("%s failed after implementation (`%s` exited with code %d)", run.Step.GetDisplayName(), run.Command, run.ExitCode)
+}
+
+// runLint runs the project's lint command. The implementation is committed
+// first so the command's own changes can be told apart: they are committed
+// separately when the project auto-commits lint fixes, and discarded
+// otherwise.
+func (p *Processor) runLint(ctx context.Context, project *entity.Project, task *entity.Task, run *entity.VerificationRun) {
+	worktreePath := *task.WorktreePath
+
+	separated := false
+	if p.gitManager != nil {
+		if _, err := p.gitManager.CommitAll(ctx, worktreePath, implementationCommitMessage(task)); err != nil {
+			p.logger.Error("Failed to commit implementation before linting, lint changes will be left in place", "error", err, "task_id", task.ID)
+		} else {
+			separated = true
+		}
+	}
+
+	p.runVerificationStep(ctx, run, task)
+
+	if separated {
+		if project.AutoCommitLintFixes {
+			committed, err := p.gitManager.CommitAll(ctx, worktreePath, lintFixesCommitMessage(project))
+			if err != nil {
+				p.logger.Error("Failed to commit lint fixes", "error", err, "task_id", task.ID)
+			}
+			run.FixesCommitted = committed
+		} else if err := p.gitManager.DiscardChanges(ctx, worktreePath); err != nil {
+			p.logger.Error("Failed to discard lint changes", "error", err, "task_id", task.ID)
+		}
+	}
+}
+
+// runVerificationStep runs the run's command in the task worktree and
+// records the outcome on it
+func (p *Processor) runVerificationStep(ctx context.Context, run *entity.VerificationRun, task *entity.Task) {
+	p.logger.Info("Running verification step", "task_id", task.ID, "step", run.Step, "command", run.Command)
+
+	result := p.verificationRunner.Run(ctx, *task.WorktreePath, run.Command)
+	run.Command = result.Command
+	run.Passed = result.Passed
+	run.ExitCode = result.ExitCode
+	run.Output = result.Output
+	run.StartedAt = result.StartedAt
+	run.CompletedAt = result.CompletedAt
+
+	p.logger.Info("Verification step finished",
+		"task_id", task.ID,
+		"step", run.Step,
+		"passed", run.Passed,
+		"exit_code", run.ExitCode,
+		"duration", run.GetDuration())
+}
+
+// runReviewStep has the AI review the changes. The review never blocks the
+// pull request; its findings go in the description and, when the project
+// wants them, on the diff.
+func (p *Processor) runReviewStep(ctx context.Context, task *entity.Task, execution *entity.Execution, aiExecutor ai.AiCodingCli, run *entity.VerificationRun) {
+	if aiExecutor == nil {
+		run.Status = entity.VerificationRunStatusSkipped
+		run.Output = "[auto-devs] no AI executor to review the changes"
+		return
+	}
+
+	execution.ReviewComments = p.reviewImplementation(ctx, task, aiExecutor, execution)
+	if execution.ReviewComments == nil {
+		run.Status = entity.VerificationRunStatusSkipped
+		run.Output = "[auto-devs] no review was made, see the logs"
+		return
+	}
+	run.Passed = true
+	run.Output = fmt.Sprintf("[auto-devs] %d finding(s)", len(execution.ReviewComments))
+}
+
+func (p *Processor) saveVerificationRun(ctx context.Context, run *entity.VerificationRun) {
+	if err := p.verificationRunRepo.Create(ctx, run); err != nil {
+		// The outcome still decides what happens to the PR
+		p.logger.Error("Failed to save verification run", "error", err, "task_id", run.TaskID, "step", run.Step)
+	}
+}
+
+// updateVerificationRun saves the run's progress and reports it to the
+// project's WebSocket clients
+func (p *Processor) updateVerificationRun(ctx context.Context, task *entity.Task, run *entity.VerificationRun) {
+	if err := p.verificationRunRepo.Update(ctx, run); err != nil {
+		p.logger.Error("Failed to update verification run", "error", err, "task_id", run.TaskID, "step", run.Step)
+	}
+	p.notifyVerificationProgress(task, run)
+}
+
+func (p *Processor) notifyVerificationProgress(task *entity.Task, run *entity.VerificationRun) {
+	if p.wsService == nil {
+		return
+	}
+	data := map[string]interface{}{
+		"task_id":      task.ID,
+		"execution_id": run.ExecutionID,
+		"run":          run,
+	}
+	if err := p.wsService.SendProjectMessage(task.ProjectID, websocket.VerificationProgress, data); err != nil {
+		p.logger.Error("Failed to send verification progress", "error", err, "task_id", task.ID, "step", run.Step)
+	}
+}
+
+// testsBlockPR reports whether a test run keeps the implementation from being
+// pushed and opened as a pull request
+func testsBlockPR(project *entity.Project, run *entity.VerificationRun) bool {
+	return run != nil && !run.Passed && project.TestFailurePolicy != entity.TestFailurePolicyFlag
+}
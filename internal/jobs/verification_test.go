@@ -0,0 +1,206 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/service/verification"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newVerificationProcessor(t *testing.T) (*Processor, *[]*entity.VerificationRun) {
+	repo := repository.NewVerificationRunRepositoryMock(t)
+	var saved []*entity.VerificationRun
+	repo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, run *entity.VerificationRun) error {
+		saved = append(saved, run)
+		return nil
+	}).Maybe()
+	repo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return &Processor{
+		verificationRunRepo: repo,
+		verificationRunner:  verification.NewRunner(time.Minute),
+		logger:              slog.Default(),
+	}, &saved
+}
+
+// verify runs the verification pipeline the project's settings describe
+func verify(processor *Processor, project *entity.Project, task *entity.Task, execution *entity.Execution) ([]entity.VerificationRun, *entity.VerificationRun) {
+	return processor.verifyImplementation(context.Background(), project, entity.DefaultVerificationPipeline(project), task, execution, nil)
+}
+
+func TestVerifyImplementation_Tests(t *testing.T) {
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	execution := &entity.Execution{ID: uuid.New()}
+	processor, saved := newVerificationProcessor(t)
+
+	project := &entity.Project{TestCommand: "echo 1 failing; exit 1"}
+	runs, blockedBy := verify(processor, project, task, execution)
+	require.Len(t, runs, 1)
+	require.NotNil(t, blockedBy, "failing tests block the PR by default")
+	require.Len(t, *saved, 1)
+	assert.Same(t, (*saved)[0], blockedBy)
+	assert.Equal(t, entity.VerificationStepTest, blockedBy.Step)
+	assert.Equal(t, execution.ID, blockedBy.ExecutionID)
+	assert.Equal(t, task.ID, blockedBy.TaskID)
+	assert.Equal(t, 1, blockedBy.ExitCode)
+	assert.Equal(t, "1 failing\n", blockedBy.Output)
+
+	project.TestFailurePolicy = entity.TestFailurePolicyFlag
+	runs, blockedBy = verify(processor, project, task, execution)
+	assert.Len(t, runs, 1)
+	assert.Nil(t, blockedBy)
+
+	runs, blockedBy = verify(processor, &entity.Project{}, task, execution)
+	assert.Empty(t, runs, "projects without commands are not verified")
+	assert.Nil(t, blockedBy)
+}
+
+func TestVerifyImplementation_Build(t *testing.T) {
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	processor, saved := newVerificationProcessor(t)
+
+	project := &entity.Project{
+		BuildCommand:      "echo 'undefined: Foo'; exit 2",
+		TestCommand:       "echo ok",
+		TestFailurePolicy: entity.TestFailurePolicyFlag,
+	}
+	runs, blockedBy := verify(processor, project, task, &entity.Execution{ID: uuid.New()})
+	require.NotNil(t, blockedBy, "a failing build blocks the PR whatever the test failure policy")
+	require.Len(t, *saved, 2, "runs are created for every step up front")
+	assert.Equal(t, entity.VerificationStepBuild, blockedBy.Step)
+	assert.Equal(t, entity.VerificationRunStatusFailed, blockedBy.Status)
+	assert.Equal(t, 2, blockedBy.ExitCode)
+	require.Len(t, runs, 2)
+	assert.Equal(t, entity.VerificationRunStatusSkipped, runs[1].Status, "tests do not run when the build fails")
+	assert.Empty(t, runs[1].Output)
+
+	project.BuildCommand = "echo built"
+	runs, blockedBy = verify(processor, project, task, &entity.Execution{ID: uuid.New()})
+	assert.Nil(t, blockedBy)
+	require.Len(t, runs, 2)
+	assert.Equal(t, entity.VerificationStepBuild, runs[0].Step)
+	assert.Equal(t, entity.VerificationStepTest, runs[1].Step)
+	assert.Equal(t, 1, runs[1].Position)
+	assert.Equal(t, entity.VerificationRunStatusPassed, runs[1].Status)
+}
+
+func TestVerifyImplementation_Pipeline(t *testing.T) {
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	processor, _ := newVerificationProcessor(t)
+	project := &entity.Project{TestCommand: "test -f deps.txt", AIReviewEnabled: true}
+
+	pipeline := &entity.VerificationPipeline{Steps: []entity.VerificationPipelineStep{
+		{Step: entity.VerificationStepSetup, Command: "touch deps.txt"},
+		{Step: entity.VerificationStepTest},
+		{Step: entity.VerificationStepBuild, Command: "echo built"},
+		{Step: entity.VerificationStepReview},
+	}}
+	runs, blockedBy := processor.verifyImplementation(context.Background(), project, pipeline, task, &entity.Execution{ID: uuid.New()}, nil)
+	assert.Nil(t, blockedBy)
+	require.Len(t, runs, 4)
+	for i, step := range pipeline.Steps {
+		assert.Equal(t, step.Step, runs[i].Step, "steps run in the pipeline's order")
+		assert.Equal(t, i, runs[i].Position)
+	}
+	assert.Equal(t, entity.VerificationRunStatusPassed, runs[1].Status, "the setup step ran before the tests")
+	assert.Equal(t, "test -f deps.txt", runs[1].Command, "steps without a command use the project's")
+	assert.Equal(t, "echo built", runs[2].Command)
+	assert.Equal(t, entity.VerificationRunStatusSkipped, runs[3].Status, "no AI executor to review with")
+
+	pipeline.Steps[0].Command = "exit 3"
+	runs, blockedBy = processor.verifyImplementation(context.Background(), project, pipeline, task, &entity.Execution{ID: uuid.New()}, nil)
+	require.NotNil(t, blockedBy, "a failing setup step blocks the PR")
+	assert.Equal(t, entity.VerificationStepSetup, blockedBy.Step)
+	assert.Equal(t, "Setup failed after implementation (`exit 3` exited with code 3)", blockedReason(blockedBy))
+	for _, run := range runs[1:] {
+		assert.Equal(t, entity.VerificationRunStatusSkipped, run.Status)
+	}
+}
+
+// initRepo creates a git repository with one commit and an uncommitted
+// change standing in for the AI's implementation
+func initRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "dev@example.com"},
+		{"config", "user.name", "Dev"},
+		{"commit", "-q", "--allow-empty", "-m", "initial"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+	return dir
+}
+
+func gitLog(t *testing.T, dir string) []string {
+	out, err := exec.Command("git", "-C", dir, "log", "--format=%s").CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.Split(strings.TrimSpace(string(out)), "\n")
+}
+
+func TestVerifyImplementation_Lint(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	lintCommand := "echo formatted >> main.go && touch lint.log"
+
+	t.Run("auto-committed fixes", func(t *testing.T) {
+		worktree := initRepo(t)
+		task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree}
+		processor, saved := newVerificationProcessor(t)
+		processor.gitManager = gitManager
+
+		project := &entity.Project{LintCommand: lintCommand, AutoCommitLintFixes: true}
+		runs, blockedBy := verify(processor, project, task, &entity.Execution{ID: uuid.New()})
+		assert.Nil(t, blockedBy)
+		require.Len(t, runs, 1)
+		require.Len(t, *saved, 1)
+		assert.Equal(t, entity.VerificationStepLint, runs[0].Step)
+		assert.True(t, runs[0].Passed)
+		assert.True(t, runs[0].FixesCommitted)
+
+		assert.Equal(t, []string{"Apply lint and format fixes", "Implement task: Add main", "initial"}, gitLog(t, worktree))
+		pending, err := gitManager.HasPendingChanges(context.Background(), worktree)
+		require.NoError(t, err)
+		assert.False(t, pending)
+	})
+
+	t.Run("discarded fixes", func(t *testing.T) {
+		worktree := initRepo(t)
+		task := &entity.Task{ID: uuid.New(), Title: "Add main", WorktreePath: &worktree}
+		processor, _ := newVerificationProcessor(t)
+		processor.gitManager = gitManager
+
+		runs, _ := verify(processor, &entity.Project{LintCommand: lintCommand}, task, &entity.Execution{ID: uuid.New()})
+		require.Len(t, runs, 1)
+		assert.False(t, runs[0].FixesCommitted)
+
+		assert.Equal(t, []string{"Implement task: Add main", "initial"}, gitLog(t, worktree))
+		content, err := os.ReadFile(filepath.Join(worktree, "main.go"))
+		require.NoError(t, err)
+		assert.Equal(t, "package main\n", string(content), "the implementation is kept, the lint changes are not")
+		assert.NoFileExists(t, filepath.Join(worktree, "lint.log"))
+	})
+}
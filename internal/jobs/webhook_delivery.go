@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+)
+
+// WebhookDeliveryMaxRetry is how many times a failed webhook delivery is
+// retried, with exponential backoff, before it is recorded as failed
+const WebhookDeliveryMaxRetry = 8
+
+// ProcessWebhookDelivery posts a delivery of a project webhook. A failed
+// delivery is retried until its last attempt, which records it as failed.
+func (p *Processor) ProcessWebhookDelivery(ctx context.Context, task *asynq.Task) error {
+	payload, err := ParseWebhookDeliveryPayload(task)
+	if err != nil {
+		return fmt.Errorf("failed to parse webhook delivery payload: %w", err)
+	}
+
+	if p.webhookUsecase == nil {
+		p.logger.Warn("Webhook usecase not configured, skipping delivery job", "delivery_id", payload.DeliveryID)
+		return nil
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	lastAttempt := !ok || retried >= maxRetry
+	if err := p.webhookUsecase.Deliver(ctx, payload.DeliveryID, lastAttempt); err != nil {
+		if errors.Is(err, usecase.ErrWebhookDeliveryNotFound) {
+			return fmt.Errorf("%w: %w", err, asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to deliver webhook %s: %w", payload.DeliveryID, err)
+	}
+
+	return nil
+}
+
+// publishWebhook posts event to the webhooks of the task's project
+func (p *Processor) publishWebhook(ctx context.Context, task *entity.Task, event entity.WebhookEvent, data interface{}) {
+	if p.webhookUsecase == nil || task == nil {
+		return
+	}
+	p.webhookUsecase.Publish(ctx, task.ProjectID, event, data)
+}
+
+// publishExecutionCompleted posts an execution of the task that finished,
+// failed with errorMessage unless it is empty, to the project webhooks, and
+// notifies the project of failed ones
+func (p *Processor) publishExecutionCompleted(ctx context.Context, task *entity.Task, execution *entity.Execution, completedAt time.Time, errorMessage string) {
+	if task == nil {
+		return
+	}
+	status := entity.ExecutionStatusCompleted
+	if errorMessage != "" {
+		status = entity.ExecutionStatusFailed
+	}
+	p.publishWebhook(ctx, task, entity.WebhookEventExecutionCompleted, usecase.WebhookExecutionCompletedData{
+		ExecutionID: execution.ID,
+		TaskID:      task.ID,
+		TaskTitle:   task.Title,
+		Phase:       execution.Phase,
+		AIType:      execution.AIType,
+		Status:      status,
+		Error:       errorMessage,
+		CompletedAt: completedAt,
+	})
+	if status == entity.ExecutionStatusFailed {
+		p.notify(ctx, task, func(project *entity.Project) error {
+			return p.notificationUsecase.SendExecutionFailedNotification(ctx, task, project, execution, errorMessage)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessWebhookDelivery(t *testing.T) {
+	deliveryID := uuid.New()
+	job, err := NewWebhookDeliveryTask(WebhookDeliveryPayload{DeliveryID: deliveryID})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		deliverErr error
+		wantErr    bool
+		skipRetry  bool
+	}{
+		{name: "delivered"},
+		{name: "receiver failure is retried", deliverErr: errors.New("webhook answered 502"), wantErr: true},
+		{name: "deleted delivery is not retried", deliverErr: usecase.ErrWebhookDeliveryNotFound, wantErr: true, skipRetry: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookUsecase := usecase.NewProjectWebhookUsecaseMock(t)
+			// Outside a worker the job has no retry count, so every run is
+			// its last attempt
+			webhookUsecase.EXPECT().Deliver(context.Background(), deliveryID, true).Return(tt.deliverErr).Once()
+			processor := &Processor{webhookUsecase: webhookUsecase, logger: slog.Default()}
+
+			err := processor.ProcessWebhookDelivery(context.Background(), job)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.skipRetry, errors.Is(err, asynq.SkipRetry))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// workflowPreset returns the project's preset of the task's workflow type,
+// or nil when the task has none or the preset cannot be loaded
+func (p *Processor) workflowPreset(ctx context.Context, task *entity.Task) *entity.WorkflowPreset {
+	if task.WorkflowType == "" {
+		return nil
+	}
+	preset, err := p.projectUsecase.GetWorkflowPreset(ctx, task.ProjectID, task.WorkflowType)
+	if err != nil {
+		p.logger.Warn("Failed to load workflow preset, using the default", "error", err, "task_id", task.ID, "workflow", task.WorkflowType)
+		return entity.DefaultWorkflowPreset(task.ProjectID, task.WorkflowType)
+	}
+	return preset
+}
+
+// withWorkflowContext returns a copy of task whose description carries the
+// instructions its workflow preset adds to the planning or implementation
+// prompt, or task itself when there are none
+func (p *Processor) withWorkflowContext(ctx context.Context, task *entity.Task, planning bool) *entity.Task {
+	preset := p.workflowPreset(ctx, task)
+	if preset == nil || preset.Instructions(planning) == "" {
+		return task
+	}
+
+	withWorkflow := *task
+	withWorkflow.Description = fmt.Sprintf("%s\n\nWorkflow (%s): %s", strings.TrimRight(task.Description, "\n"), task.WorkflowType, preset.Instructions(planning))
+	return &withWorkflow
+}
+
+// withWorkflowPipeline returns pipeline with a test changes step added last
+// when the task's workflow preset requires test changes and the pipeline
+// does not check them already
+func (p *Processor) withWorkflowPipeline(ctx context.Context, task *entity.Task, pipeline *entity.VerificationPipeline) *entity.VerificationPipeline {
+	preset := p.workflowPreset(ctx, task)
+	if preset == nil || !preset.RequireTestChanges {
+		return pipeline
+	}
+	for _, step := range pipeline.Steps {
+		if step.Step == entity.VerificationStepTestChanges {
+			return pipeline
+		}
+	}
+
+	withStep := *pipeline
+	withStep.Steps = append(append([]entity.VerificationPipelineStep(nil), pipeline.Steps...),
+		entity.VerificationPipelineStep{Step: entity.VerificationStepTestChanges})
+	return &withStep
+}
+
+// runTestChangesCheck checks that the task's changes add or modify a test
+// file, and reports whether the pull request is blocked because they do not
+func (p *Processor) runTestChangesCheck(ctx context.Context, task *entity.Task, run *entity.VerificationRun) bool {
+	files, err := p.changedFiles(ctx, task)
+	if err != nil {
+		p.logger.Error("Failed to list changed files, skipping test changes check", "error", err, "task_id", task.ID)
+		run.Status = entity.VerificationRunStatusSkipped
+		run.Output = fmt.Sprintf("[auto-devs] failed to list the changed files: %v", err)
+		return false
+	}
+
+	var tests []string
+	for _, file := range files {
+		if isTestFile(file) {
+			tests = append(tests, file)
+		}
+	}
+	if len(tests) == 0 {
+		run.Output = fmt.Sprintf("[auto-devs] none of the %d changed files is a test file", len(files))
+		return true
+	}
+	run.Passed = true
+	run.Output = "[auto-devs] changed test files:\n" + strings.Join(tests, "\n")
+	return false
+}
+
+// isTestFile reports whether file, a path relative to the repository, looks
+// like a test by the usual conventions: a file under a test directory, or
+// named like foo_test.go, foo.test.ts, foo.spec.js, test_foo.py or
+// FooTest.java
+func isTestFile(file string) bool {
+	for _, dir := range strings.Split(path.Dir(file), "/") {
+		switch strings.ToLower(dir) {
+		case "test", "tests", "__tests__", "spec":
+			return true
+		}
+	}
+
+	name := path.Base(file)
+	stem := strings.TrimSuffix(name, path.Ext(name))
+	for _, suffix := range []string{"_test", ".test", ".spec", "_spec", "Test", "Tests"} {
+		if strings.HasSuffix(stem, suffix) && stem != suffix {
+			return true
+		}
+	}
+	return strings.HasPrefix(stem, "test_")
+}
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorkflowContext(t *testing.T) {
+	projectUsecase := usecase.NewProjectUsecaseMock(t)
+	processor := &Processor{projectUsecase: projectUsecase, logger: slog.Default()}
+	ctx := context.Background()
+
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Description: "Fix rounding\n"}
+	assert.Same(t, task, processor.withWorkflowContext(ctx, task, false), "tasks without a workflow type are left as is")
+
+	task.WorkflowType = entity.WorkflowTypeBugfix
+	projectUsecase.EXPECT().GetWorkflowPreset(mock.Anything, task.ProjectID, entity.WorkflowTypeBugfix).
+		Return(&entity.WorkflowPreset{ID: uuid.New(), Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "Reproduce it first.", RequireTestChanges: true}, nil)
+
+	withWorkflow := processor.withWorkflowContext(ctx, task, false)
+	assert.Equal(t, "Fix rounding\n\nWorkflow (bugfix): Reproduce it first.", withWorkflow.Description)
+	assert.Equal(t, "Fix rounding\n", task.Description, "the task itself is left as is")
+	assert.Same(t, task, processor.withWorkflowContext(ctx, task, true), "the preset adds nothing to planning")
+
+	pipeline := &entity.VerificationPipeline{Steps: []entity.VerificationPipelineStep{{Step: entity.VerificationStepTest}}}
+	withStep := processor.withWorkflowPipeline(ctx, task, pipeline)
+	assert.Equal(t, []entity.VerificationPipelineStep{{Step: entity.VerificationStepTest}, {Step: entity.VerificationStepTestChanges}}, withStep.Steps)
+	assert.Len(t, pipeline.Steps, 1, "the project's pipeline is left as is")
+	assert.Same(t, withStep, processor.withWorkflowPipeline(ctx, task, withStep), "the step is not added twice")
+}
+
+func TestIsTestFile(t *testing.T) {
+	for _, file := range []string{
+		"internal/jobs/workflow_test.go",
+		"src/components/Button.test.tsx",
+		"src/api/client.spec.ts",
+		"tests/test_rounding.py",
+		"app/test_rounding.py",
+		"src/test/java/com/acme/InvoiceTest.java",
+		"frontend/src/__tests__/App.tsx",
+		"spec/models/invoice_spec.rb",
+	} {
+		assert.True(t, isTestFile(file), file)
+	}
+	for _, file := range []string{"internal/jobs/workflow.go", "src/testing.ts", "README.md", "Test.java", "contest/main.go"} {
+		assert.False(t, isTestFile(file), file)
+	}
+}
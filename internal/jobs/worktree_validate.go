@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/usecase"
+	"github.com/hibiken/asynq"
+)
+
+// ProcessWorktreeValidate checks that every task's worktree path is a usable
+// git worktree, repairing the ones that are not before an implementation
+// fails on them. Tasks are repaired independently, so one failure does not
+// fail the job.
+func (p *Processor) ProcessWorktreeValidate(ctx context.Context, task *asynq.Task) error {
+	if _, err := ParseWorktreeValidatePayload(task); err != nil {
+		return fmt.Errorf("failed to parse worktree validate payload: %w", err)
+	}
+
+	tasks, err := p.taskUsecase.GetTasksWithWorktree(ctx)
+	if err != nil {
+		p.logger.Error("Failed to get tasks with worktree", "error", err)
+		return fmt.Errorf("failed to get tasks with worktree: %w", err)
+	}
+
+	counts := make(map[usecase.WorktreeRepairAction]int)
+	failed := 0
+	for _, t := range tasks {
+		action, err := p.worktreeUsecase.RepairTaskWorktree(ctx, t)
+		if err != nil {
+			p.logger.Error("Failed to repair task worktree",
+				"task_id", t.ID,
+				"worktree_path", *t.WorktreePath,
+				"error", err)
+			failed++
+			continue
+		}
+		if action != usecase.WorktreeRepairNone {
+			p.logger.Info("Repaired task worktree", "task_id", t.ID, "action", action)
+		}
+		counts[action]++
+	}
+
+	p.logger.Info("Completed worktree validate job",
+		"total_tasks", len(tasks),
+		"reconciled", counts[usecase.WorktreeRepairReconciled],
+		"recreated", counts[usecase.WorktreeRepairRecreated],
+		"cleared", counts[usecase.WorktreeRepairCleared],
+		"failed", failed)
+
+	return nil
+}
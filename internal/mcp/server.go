@@ -0,0 +1,214 @@
+// Package mcp implements a Model Context Protocol server over stdio, exposing
+// auto-devs projects, tasks and plans as tools to external AI assistants.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+)
+
+const serverName = "auto-devs"
+
+// supportedProtocolVersions lists the MCP revisions this server speaks,
+// newest first.
+var supportedProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// JSON-RPC 2.0 error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+// Backend is the auto-devs API the tools are served from.
+type Backend interface {
+	ListProjects(ctx context.Context, archived bool) (*dto.ProjectListResponse, error)
+	ListTasks(ctx context.Context, projectID uuid.UUID, includeDone bool) (*dto.TaskListResponse, error)
+	GetTask(ctx context.Context, taskID uuid.UUID) (*dto.TaskResponse, error)
+	ListPlans(ctx context.Context, taskID uuid.UUID) (*dto.TaskPlansResponse, error)
+	CreateTask(ctx context.Context, req dto.TaskCreateRequest) (*dto.TaskResponse, error)
+}
+
+// Server answers MCP requests read as newline-delimited JSON-RPC messages.
+type Server struct {
+	version string
+	tools   []tool
+	logger  *slog.Logger
+}
+
+func NewServer(backend Backend, version string, logger *slog.Logger) *Server {
+	return &Server{
+		version: version,
+		tools:   newTools(backend),
+		logger:  logger,
+	}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// Serve handles requests from r until it is exhausted or ctx is cancelled.
+// Stdout belongs to the protocol, so the server only logs to its logger.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	// Read in the background so cancellation is not stuck behind a blocking
+	// read of stdin
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(line) > 0 {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					readErr <- err
+				}
+				return
+			}
+		}
+	}()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case line, ok := <-lines:
+			if !ok {
+				select {
+				case err := <-readErr:
+					return fmt.Errorf("failed to read request: %w", err)
+				default:
+					return nil
+				}
+			}
+			if resp := s.handleMessage(ctx, line); resp != nil {
+				if err := encoder.Encode(resp); err != nil {
+					return fmt.Errorf("failed to write response: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// handleMessage answers one message; notifications get no response.
+func (s *Server) handleMessage(ctx context.Context, line []byte) *response {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return &response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "Parse error"}}
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return &response{JSONRPC: "2.0", ID: idOrNull(req.ID), Error: &rpcError{Code: codeInvalidRequest, Message: "Invalid request"}}
+	}
+
+	result, err := s.dispatch(ctx, req)
+	if req.ID == nil {
+		return nil
+	}
+
+	resp := &response{JSONRPC: "2.0", ID: req.ID, Result: result}
+	if err != nil {
+		var rpcErr *rpcError
+		if !errors.As(err, &rpcErr) {
+			s.logger.Error("MCP request failed", "method", req.Method, "error", err)
+			rpcErr = &rpcError{Code: codeInternalError, Message: err.Error()}
+		}
+		resp.Result = nil
+		resp.Error = rpcErr
+	}
+	return resp
+}
+
+func (s *Server) dispatch(ctx context.Context, req request) (any, error) {
+	switch req.Method {
+	case "initialize":
+		return s.initialize(req.Params)
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return s.listTools(), nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	case "notifications/initialized", "notifications/cancelled":
+		return nil, nil
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: "Method not found: " + req.Method}
+}
+
+func (s *Server) initialize(params json.RawMessage) (any, error) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "Invalid initialize params"}
+	}
+
+	// Echo the client's revision when supported, otherwise offer our newest
+	version := supportedProtocolVersions[0]
+	if slices.Contains(supportedProtocolVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools": map[string]any{"listChanged": false},
+		},
+		"serverInfo": map[string]any{
+			"name":    serverName,
+			"version": s.version,
+		},
+		"instructions": "Tools for the auto-devs task board: list projects and tasks, read task plans, and create tasks.",
+	}, nil
+}
+
+func idOrNull(id json.RawMessage) json.RawMessage {
+	if id == nil {
+		return json.RawMessage("null")
+	}
+	return id
+}
@@ -0,0 +1,156 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackend struct {
+	tasks   map[uuid.UUID][]dto.TaskResponse
+	created []dto.TaskCreateRequest
+}
+
+func (f *fakeBackend) ListProjects(ctx context.Context, archived bool) (*dto.ProjectListResponse, error) {
+	return &dto.ProjectListResponse{}, nil
+}
+
+func (f *fakeBackend) ListTasks(ctx context.Context, projectID uuid.UUID, includeDone bool) (*dto.TaskListResponse, error) {
+	tasks := f.tasks[projectID]
+	return &dto.TaskListResponse{Items: tasks, ListMeta: dto.NewListMeta(len(tasks), 1, 0)}, nil
+}
+
+func (f *fakeBackend) GetTask(ctx context.Context, taskID uuid.UUID) (*dto.TaskResponse, error) {
+	return nil, errors.New("Task not found (TASK_NOT_FOUND, HTTP 404)")
+}
+
+func (f *fakeBackend) ListPlans(ctx context.Context, taskID uuid.UUID) (*dto.TaskPlansResponse, error) {
+	return &dto.TaskPlansResponse{}, nil
+}
+
+func (f *fakeBackend) CreateTask(ctx context.Context, req dto.TaskCreateRequest) (*dto.TaskResponse, error) {
+	f.created = append(f.created, req)
+	return &dto.TaskResponse{ID: uuid.New(), ProjectID: req.ProjectID, Title: req.Title, Status: entity.TaskStatusTODO}, nil
+}
+
+// serve sends the given messages to a server and returns its responses.
+func serve(t *testing.T, backend Backend, messages ...string) []map[string]any {
+	t.Helper()
+	server := NewServer(backend, "test", slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out))
+
+	var responses []map[string]any
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var resp map[string]any
+		require.NoError(t, decoder.Decode(&resp))
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func TestServer_Handshake(t *testing.T) {
+	responses := serve(t, &fakeBackend{},
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"ping"}`,
+	)
+
+	require.Len(t, responses, 3, "notifications get no response")
+
+	result := responses[0]["result"].(map[string]any)
+	assert.Equal(t, "2024-11-05", result["protocolVersion"])
+	assert.Equal(t, serverName, result["serverInfo"].(map[string]any)["name"])
+
+	var names []string
+	for _, tool := range responses[1]["result"].(map[string]any)["tools"].([]any) {
+		names = append(names, tool.(map[string]any)["name"].(string))
+	}
+	assert.Equal(t, []string{"list_projects", "list_tasks", "get_task", "get_plans", "create_task"}, names)
+
+	assert.Equal(t, float64(3), responses[2]["id"])
+	assert.Equal(t, map[string]any{}, responses[2]["result"])
+}
+
+func TestServer_UnsupportedProtocolVersion(t *testing.T) {
+	responses := serve(t, &fakeBackend{}, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"1999-01-01"}}`)
+
+	assert.Equal(t, supportedProtocolVersions[0], responses[0]["result"].(map[string]any)["protocolVersion"])
+}
+
+func TestServer_CallTool(t *testing.T) {
+	projectID := uuid.New()
+	backend := &fakeBackend{tasks: map[uuid.UUID][]dto.TaskResponse{
+		projectID: {{ID: uuid.New(), ProjectID: projectID, Title: "Add login", Status: entity.TaskStatusPLANREVIEWING}},
+	}}
+
+	callTool := func(id int, name string, args map[string]any) string {
+		params, _ := json.Marshal(map[string]any{"name": name, "arguments": args})
+		msg, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "method": "tools/call", "params": json.RawMessage(params)})
+		return string(msg)
+	}
+
+	responses := serve(t, backend,
+		callTool(1, "list_tasks", map[string]any{"project_id": projectID}),
+		callTool(2, "create_task", map[string]any{"project_id": projectID, "title": "Write docs"}),
+		callTool(3, "get_task", map[string]any{"task_id": uuid.New()}),
+		callTool(4, "create_task", map[string]any{"title": "No project"}),
+		callTool(5, "delete_everything", nil),
+	)
+	require.Len(t, responses, 5)
+
+	text := func(resp map[string]any) string {
+		content := resp["result"].(map[string]any)["content"].([]any)
+		return content[0].(map[string]any)["text"].(string)
+	}
+	isError := func(resp map[string]any) bool {
+		return resp["result"].(map[string]any)["isError"] == true
+	}
+
+	assert.False(t, isError(responses[0]))
+	assert.Contains(t, text(responses[0]), "Add login")
+	assert.Contains(t, text(responses[0]), "PLAN_REVIEWING")
+
+	assert.False(t, isError(responses[1]))
+	require.Len(t, backend.created, 1)
+	assert.Equal(t, dto.TaskCreateRequest{ProjectID: projectID, Title: "Write docs"}, backend.created[0])
+
+	assert.True(t, isError(responses[2]))
+	assert.Contains(t, text(responses[2]), "TASK_NOT_FOUND")
+
+	assert.True(t, isError(responses[3]))
+	assert.Equal(t, "project_id is required", text(responses[3]))
+
+	assert.Equal(t, float64(codeInvalidParams), responses[4]["error"].(map[string]any)["code"])
+}
+
+func TestServer_InvalidMessages(t *testing.T) {
+	responses := serve(t, &fakeBackend{},
+		`not json`,
+		`{"jsonrpc":"1.0","id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","method":"unknown/notification"}`,
+	)
+	require.Len(t, responses, 3)
+
+	codes := make([]float64, len(responses))
+	for i, resp := range responses {
+		codes[i] = resp["error"].(map[string]any)["code"].(float64)
+	}
+	assert.Equal(t, []float64{codeParseError, codeInvalidRequest, codeMethodNotFound}, codes)
+	assert.Nil(t, responses[0]["id"])
+}
@@ -0,0 +1,203 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/handler/dto"
+	"github.com/google/uuid"
+)
+
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+
+	call func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+type toolContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolResult struct {
+	Content []toolContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+func newTools(backend Backend) []tool {
+	return []tool{
+		{
+			Name:        "list_projects",
+			Description: "List auto-devs projects with their IDs and repositories.",
+			InputSchema: objectSchema(map[string]any{
+				"archived": boolProperty("List archived projects instead of active ones"),
+			}),
+			call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var args struct {
+					Archived bool `json:"archived"`
+				}
+				if err := decodeArgs(raw, &args); err != nil {
+					return nil, err
+				}
+				return backend.ListProjects(ctx, args.Archived)
+			},
+		},
+		{
+			Name:        "list_tasks",
+			Description: "List the tasks of a project with their status (TODO, PLANNING, PLAN_REVIEWING, IMPLEMENTING, CODE_REVIEWING, DONE, CANCELLED).",
+			InputSchema: objectSchema(map[string]any{
+				"project_id":   stringProperty("Project ID"),
+				"include_done": boolProperty("Include DONE tasks"),
+			}, "project_id"),
+			call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var args struct {
+					ProjectID   uuid.UUID `json:"project_id"`
+					IncludeDone bool      `json:"include_done"`
+				}
+				if err := decodeArgs(raw, &args); err != nil {
+					return nil, err
+				}
+				if err := requireID("project_id", args.ProjectID); err != nil {
+					return nil, err
+				}
+				return backend.ListTasks(ctx, args.ProjectID, args.IncludeDone)
+			},
+		},
+		{
+			Name:        "get_task",
+			Description: "Get a task by ID, including its status, branch and pull request.",
+			InputSchema: objectSchema(map[string]any{
+				"task_id": stringProperty("Task ID"),
+			}, "task_id"),
+			call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var args struct {
+					TaskID uuid.UUID `json:"task_id"`
+				}
+				if err := decodeArgs(raw, &args); err != nil {
+					return nil, err
+				}
+				if err := requireID("task_id", args.TaskID); err != nil {
+					return nil, err
+				}
+				return backend.GetTask(ctx, args.TaskID)
+			},
+		},
+		{
+			Name:        "get_plans",
+			Description: "Read the implementation plans written for a task, newest first.",
+			InputSchema: objectSchema(map[string]any{
+				"task_id": stringProperty("Task ID"),
+			}, "task_id"),
+			call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var args struct {
+					TaskID uuid.UUID `json:"task_id"`
+				}
+				if err := decodeArgs(raw, &args); err != nil {
+					return nil, err
+				}
+				if err := requireID("task_id", args.TaskID); err != nil {
+					return nil, err
+				}
+				return backend.ListPlans(ctx, args.TaskID)
+			},
+		},
+		{
+			Name:        "create_task",
+			Description: "Create a TODO task in a project.",
+			InputSchema: objectSchema(map[string]any{
+				"project_id":  stringProperty("Project ID"),
+				"title":       stringProperty("Task title"),
+				"description": stringProperty("Task description, in markdown"),
+			}, "project_id", "title"),
+			call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				var args dto.TaskCreateRequest
+				if err := decodeArgs(raw, &args); err != nil {
+					return nil, err
+				}
+				if err := requireID("project_id", args.ProjectID); err != nil {
+					return nil, err
+				}
+				if args.Title == "" {
+					return nil, fmt.Errorf("title is required")
+				}
+				return backend.CreateTask(ctx, dto.TaskCreateRequest{
+					ProjectID:   args.ProjectID,
+					Title:       args.Title,
+					Description: args.Description,
+				})
+			},
+		},
+	}
+}
+
+func (s *Server) listTools() any {
+	return map[string]any{"tools": s.tools}
+}
+
+// callTool runs a tool. Failures of the tool itself are reported in the
+// result (isError) so the model can see them, as the MCP spec asks.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, error) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "Invalid tools/call params"}
+	}
+
+	for _, t := range s.tools {
+		if t.Name != p.Name {
+			continue
+		}
+
+		result, err := t.call(ctx, p.Arguments)
+		if err != nil {
+			return toolResult{Content: []toolContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+		}
+		text, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return toolResult{Content: []toolContent{{Type: "text", Text: string(text)}}}, nil
+	}
+	return nil, &rpcError{Code: codeInvalidParams, Message: "Unknown tool: " + p.Name}
+}
+
+func decodeArgs(raw json.RawMessage, args any) error {
+	if len(raw) == 0 {
+		raw = json.RawMessage("{}")
+	}
+	if err := json.Unmarshal(raw, args); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+func requireID(name string, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return fmt.Errorf("%s is required", name)
+	}
+	return nil
+}
+
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func stringProperty(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+func boolProperty(description string) map[string]any {
+	return map[string]any{"type": "boolean", "description": description}
+}
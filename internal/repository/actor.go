@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type actorKey struct{}
+
+type userIDKey struct{}
+
+// WithActor returns a context recording the changes made with it as done by
+// the named user, in the changed_by and created_by fields.
+func WithActor(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, actorKey{}, username)
+}
+
+// ActorFromContext returns the user the context's changes are done by, or
+// "" for anonymous requests and background jobs.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// WithUserID returns a context of a request authenticated as the user
+// account, whose project roles then apply to it.
+func WithUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext returns the user account the context's request
+// authenticated as, if any. Static API keys and background jobs have none.
+func UserIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+type anonymousKey struct{}
+
+// WithAnonymous returns a context of a request made without credentials
+func WithAnonymous(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anonymousKey{}, true)
+}
+
+// IsAnonymous reports whether the context's request was made without
+// credentials. Background jobs are not anonymous.
+func IsAnonymous(ctx context.Context) bool {
+	anonymous, _ := ctx.Value(anonymousKey{}).(bool)
+	return anonymous
+}
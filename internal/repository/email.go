@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type EmailTemplateRepository interface {
+	// ListByProjectID returns the project's templates, by type
+	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.EmailTemplate, error)
+	// Get returns the project's template of the type, or nil when the
+	// project uses the default one
+	Get(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) (*entity.EmailTemplate, error)
+	// Save creates the template or replaces the one of the same project and
+	// type
+	Save(ctx context.Context, template *entity.EmailTemplate) error
+	Delete(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) error
+}
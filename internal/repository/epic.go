@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type EpicRepository interface {
+	Create(ctx context.Context, epic *entity.Epic) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Epic, error)
+	// ListByProjectID returns the project's epics, those due first and
+	// then the newest
+	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Epic, error)
+	Update(ctx context.Context, epic *entity.Epic) error
+	// Delete deletes the epic, leaving its tasks ungrouped
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetProgress rolls up the tasks of each epic with a single query.
+	// Every epic given has an entry, even without tasks.
+	GetProgress(ctx context.Context, epicIDs []uuid.UUID) (map[uuid.UUID]*entity.EpicProgress, error)
+}
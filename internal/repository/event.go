@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+type EventRepository interface {
+	Create(ctx context.Context, event *entity.Event) error
+	// List returns the events matching filter, newest first
+	List(ctx context.Context, filter entity.EventFilter) ([]*entity.Event, error)
+}
@@ -14,6 +14,15 @@ type ExecutionRepository interface {
 	Create(ctx context.Context, execution *entity.Execution) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Execution, error)
 	GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error)
+	GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.Execution, error)
+	// GetLatestComparison returns the executions of the task's most recent
+	// executor comparison, ordered by variant
+	GetLatestComparison(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error)
+	// GetRetries returns the executions retrying the execution, oldest first
+	GetRetries(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error)
+	// GetAttempts returns the attempt chain the execution belongs to, from
+	// its first attempt to its latest retry
+	GetAttempts(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error)
 	Update(ctx context.Context, execution *entity.Execution) error
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -23,11 +32,28 @@ type ExecutionRepository interface {
 	UpdateError(ctx context.Context, id uuid.UUID, error string) error
 	MarkCompleted(ctx context.Context, id uuid.UUID, completedAt time.Time, result *entity.ExecutionResult) error
 	MarkFailed(ctx context.Context, id uuid.UUID, completedAt time.Time, error string) error
+	UpdateCoverage(ctx context.Context, id uuid.UUID, coverage, baseCoverage *float64) error
+	UpdateFixAttempts(ctx context.Context, id uuid.UUID, fixAttempts int) error
+	UpdateUsage(ctx context.Context, id uuid.UUID, usage entity.ExecutionUsage) error
+	// MarkWaitingInput stops the execution on the question the AI asked in
+	// session sessionID
+	MarkWaitingInput(ctx context.Context, id uuid.UUID, question, sessionID string) error
+	// MarkAnswered moves the execution waiting for input back to pending,
+	// reported alive at at, and reports whether it was waiting
+	MarkAnswered(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)
+	// MarkCancelled stops the execution, if it has not finished, at
+	// completedAt and reports whether it had not
+	MarkCancelled(ctx context.Context, id uuid.UUID, completedAt time.Time) (bool, error)
 
 	// Filtering and search
 	GetByStatus(ctx context.Context, status entity.ExecutionStatus) ([]*entity.Execution, error)
 	GetByStatuses(ctx context.Context, statuses []entity.ExecutionStatus) ([]*entity.Execution, error)
 	GetActive(ctx context.Context) ([]*entity.Execution, error)
+	// Heartbeat reports the active executions run by worker alive at at
+	Heartbeat(ctx context.Context, worker string, at time.Time) error
+	// GetOrphaned returns the active executions run by worker, when set, or
+	// last reported alive before staleBefore, among those a worker runs
+	GetOrphaned(ctx context.Context, worker string, staleBefore time.Time) ([]*entity.Execution, error)
 	GetCompleted(ctx context.Context, limit int) ([]*entity.Execution, error)
 	GetByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*entity.Execution, error)
 
@@ -36,6 +62,9 @@ type ExecutionRepository interface {
 	GetWithLogs(ctx context.Context, id uuid.UUID, logLimit int) (*entity.Execution, error)
 	GetExecutionStats(ctx context.Context, taskID *uuid.UUID) (*ExecutionStats, error)
 	GetRecentExecutions(ctx context.Context, limit int) ([]*entity.Execution, error)
+	// GetSpendByAIType sums the usage of the project's executions completed
+	// in [from, to), per executor
+	GetSpendByAIType(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]*ExecutorSpend, error)
 
 	// Bulk operations
 	BulkUpdateStatus(ctx context.Context, ids []uuid.UUID, status entity.ExecutionStatus) error
@@ -58,6 +87,15 @@ type ExecutionStats struct {
 	RecentActivity      []*entity.Execution              `json:"recent_activity"`
 }
 
+// ExecutorSpend is the usage of the executions run by one executor
+type ExecutorSpend struct {
+	AIType       string  `json:"ai_type"`
+	Executions   int64   `json:"executions"`
+	InputTokens  int64   `json:"input_tokens"`
+	OutputTokens int64   `json:"output_tokens"`
+	CostUSD      float64 `json:"cost_usd"`
+}
+
 // ExecutionFilters represents filtering options for executions
 type ExecutionFilters struct {
 	TaskID        *uuid.UUID
@@ -336,6 +336,63 @@ func (_c *ExecutionRepositoryMock_GetActive_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetAttempts provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetAttempts(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttempts")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttempts'
+type ExecutionRepositoryMock_GetAttempts_Call struct {
+	*mock.Call
+}
+
+// GetAttempts is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *ExecutionRepositoryMock_Expecter) GetAttempts(ctx interface{}, id interface{}) *ExecutionRepositoryMock_GetAttempts_Call {
+	return &ExecutionRepositoryMock_GetAttempts_Call{Call: _e.mock.On("GetAttempts", ctx, id)}
+}
+
+func (_c *ExecutionRepositoryMock_GetAttempts_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ExecutionRepositoryMock_GetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetAttempts_Call) Return(executions []*entity.Execution, err error) *ExecutionRepositoryMock_GetAttempts_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetAttempts_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error)) *ExecutionRepositoryMock_GetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByDateRange provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) GetByDateRange(ctx context.Context, startDate time.Time, endDate time.Time) ([]*entity.Execution, error) {
 	ret := _mock.Called(ctx, startDate, endDate)
@@ -622,6 +679,63 @@ func (_c *ExecutionRepositoryMock_GetByTaskID_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// GetByTaskIDs provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskIDs")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskIDs'
+type ExecutionRepositoryMock_GetByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// GetByTaskIDs is a helper method to define mock.On call
+//   - ctx
+//   - taskIDs
+func (_e *ExecutionRepositoryMock_Expecter) GetByTaskIDs(ctx interface{}, taskIDs interface{}) *ExecutionRepositoryMock_GetByTaskIDs_Call {
+	return &ExecutionRepositoryMock_GetByTaskIDs_Call{Call: _e.mock.On("GetByTaskIDs", ctx, taskIDs)}
+}
+
+func (_c *ExecutionRepositoryMock_GetByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []uuid.UUID)) *ExecutionRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetByTaskIDs_Call) Return(executions []*entity.Execution, err error) *ExecutionRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.Execution, error)) *ExecutionRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompleted provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) GetCompleted(ctx context.Context, limit int) ([]*entity.Execution, error) {
 	ret := _mock.Called(ctx, limit)
@@ -736,6 +850,121 @@ func (_c *ExecutionRepositoryMock_GetExecutionStats_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetLatestComparison provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetLatestComparison(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestComparison")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetLatestComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestComparison'
+type ExecutionRepositoryMock_GetLatestComparison_Call struct {
+	*mock.Call
+}
+
+// GetLatestComparison is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *ExecutionRepositoryMock_Expecter) GetLatestComparison(ctx interface{}, taskID interface{}) *ExecutionRepositoryMock_GetLatestComparison_Call {
+	return &ExecutionRepositoryMock_GetLatestComparison_Call{Call: _e.mock.On("GetLatestComparison", ctx, taskID)}
+}
+
+func (_c *ExecutionRepositoryMock_GetLatestComparison_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *ExecutionRepositoryMock_GetLatestComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetLatestComparison_Call) Return(executions []*entity.Execution, err error) *ExecutionRepositoryMock_GetLatestComparison_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetLatestComparison_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error)) *ExecutionRepositoryMock_GetLatestComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrphaned provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetOrphaned(ctx context.Context, worker string, staleBefore time.Time) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, worker, staleBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphaned")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, worker, staleBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, worker, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, worker, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetOrphaned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrphaned'
+type ExecutionRepositoryMock_GetOrphaned_Call struct {
+	*mock.Call
+}
+
+// GetOrphaned is a helper method to define mock.On call
+//   - ctx
+//   - worker
+//   - staleBefore
+func (_e *ExecutionRepositoryMock_Expecter) GetOrphaned(ctx interface{}, worker interface{}, staleBefore interface{}) *ExecutionRepositoryMock_GetOrphaned_Call {
+	return &ExecutionRepositoryMock_GetOrphaned_Call{Call: _e.mock.On("GetOrphaned", ctx, worker, staleBefore)}
+}
+
+func (_c *ExecutionRepositoryMock_GetOrphaned_Call) Run(run func(ctx context.Context, worker string, staleBefore time.Time)) *ExecutionRepositoryMock_GetOrphaned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetOrphaned_Call) Return(r0 []*entity.Execution, r1 error) *ExecutionRepositoryMock_GetOrphaned_Call {
+	_c.Call.Return(r0, r1)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetOrphaned_Call) RunAndReturn(run func(ctx context.Context, worker string, staleBefore time.Time) ([]*entity.Execution, error)) *ExecutionRepositoryMock_GetOrphaned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRecentExecutions provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) GetRecentExecutions(ctx context.Context, limit int) ([]*entity.Execution, error) {
 	ret := _mock.Called(ctx, limit)
@@ -793,6 +1022,122 @@ func (_c *ExecutionRepositoryMock_GetRecentExecutions_Call) RunAndReturn(run fun
 	return _c
 }
 
+// GetRetries provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetRetries(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRetries")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetRetries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRetries'
+type ExecutionRepositoryMock_GetRetries_Call struct {
+	*mock.Call
+}
+
+// GetRetries is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *ExecutionRepositoryMock_Expecter) GetRetries(ctx interface{}, id interface{}) *ExecutionRepositoryMock_GetRetries_Call {
+	return &ExecutionRepositoryMock_GetRetries_Call{Call: _e.mock.On("GetRetries", ctx, id)}
+}
+
+func (_c *ExecutionRepositoryMock_GetRetries_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ExecutionRepositoryMock_GetRetries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetRetries_Call) Return(executions []*entity.Execution, err error) *ExecutionRepositoryMock_GetRetries_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetRetries_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error)) *ExecutionRepositoryMock_GetRetries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpendByAIType provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) GetSpendByAIType(ctx context.Context, projectID uuid.UUID, from time.Time, to time.Time) ([]*ExecutorSpend, error) {
+	ret := _mock.Called(ctx, projectID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpendByAIType")
+	}
+
+	var r0 []*ExecutorSpend
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) ([]*ExecutorSpend, error)); ok {
+		return returnFunc(ctx, projectID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) []*ExecutorSpend); ok {
+		r0 = returnFunc(ctx, projectID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ExecutorSpend)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, projectID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_GetSpendByAIType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpendByAIType'
+type ExecutionRepositoryMock_GetSpendByAIType_Call struct {
+	*mock.Call
+}
+
+// GetSpendByAIType is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - from
+//   - to
+func (_e *ExecutionRepositoryMock_Expecter) GetSpendByAIType(ctx interface{}, projectID interface{}, from interface{}, to interface{}) *ExecutionRepositoryMock_GetSpendByAIType_Call {
+	return &ExecutionRepositoryMock_GetSpendByAIType_Call{Call: _e.mock.On("GetSpendByAIType", ctx, projectID, from, to)}
+}
+
+func (_c *ExecutionRepositoryMock_GetSpendByAIType_Call) Run(run func(ctx context.Context, projectID uuid.UUID, from time.Time, to time.Time)) *ExecutionRepositoryMock_GetSpendByAIType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetSpendByAIType_Call) Return(executorSpends []*ExecutorSpend, err error) *ExecutionRepositoryMock_GetSpendByAIType_Call {
+	_c.Call.Return(executorSpends, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_GetSpendByAIType_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, from time.Time, to time.Time) ([]*ExecutorSpend, error)) *ExecutionRepositoryMock_GetSpendByAIType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithLogs provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) GetWithLogs(ctx context.Context, id uuid.UUID, logLimit int) (*entity.Execution, error) {
 	ret := _mock.Called(ctx, id, logLimit)
@@ -908,6 +1253,165 @@ func (_c *ExecutionRepositoryMock_GetWithProcesses_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// Heartbeat provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) Heartbeat(ctx context.Context, worker string, at time.Time) error {
+	ret := _mock.Called(ctx, worker, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heartbeat")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, worker, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ExecutionRepositoryMock_Heartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Heartbeat'
+type ExecutionRepositoryMock_Heartbeat_Call struct {
+	*mock.Call
+}
+
+// Heartbeat is a helper method to define mock.On call
+//   - ctx
+//   - worker
+//   - at
+func (_e *ExecutionRepositoryMock_Expecter) Heartbeat(ctx interface{}, worker interface{}, at interface{}) *ExecutionRepositoryMock_Heartbeat_Call {
+	return &ExecutionRepositoryMock_Heartbeat_Call{Call: _e.mock.On("Heartbeat", ctx, worker, at)}
+}
+
+func (_c *ExecutionRepositoryMock_Heartbeat_Call) Run(run func(ctx context.Context, worker string, at time.Time)) *ExecutionRepositoryMock_Heartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_Heartbeat_Call) Return(r0 error) *ExecutionRepositoryMock_Heartbeat_Call {
+	_c.Call.Return(r0)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_Heartbeat_Call) RunAndReturn(run func(ctx context.Context, worker string, at time.Time) error) *ExecutionRepositoryMock_Heartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAnswered provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) MarkAnswered(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	ret := _mock.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAnswered")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, id, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, id, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_MarkAnswered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAnswered'
+type ExecutionRepositoryMock_MarkAnswered_Call struct {
+	*mock.Call
+}
+
+// MarkAnswered is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - at
+func (_e *ExecutionRepositoryMock_Expecter) MarkAnswered(ctx interface{}, id interface{}, at interface{}) *ExecutionRepositoryMock_MarkAnswered_Call {
+	return &ExecutionRepositoryMock_MarkAnswered_Call{Call: _e.mock.On("MarkAnswered", ctx, id, at)}
+}
+
+func (_c *ExecutionRepositoryMock_MarkAnswered_Call) Run(run func(ctx context.Context, id uuid.UUID, at time.Time)) *ExecutionRepositoryMock_MarkAnswered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkAnswered_Call) Return(b bool, err error) *ExecutionRepositoryMock_MarkAnswered_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkAnswered_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, at time.Time) (bool, error)) *ExecutionRepositoryMock_MarkAnswered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCancelled provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) MarkCancelled(ctx context.Context, id uuid.UUID, completedAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, id, completedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCancelled")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, id, completedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) bool); ok {
+		r0 = returnFunc(ctx, id, completedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, completedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionRepositoryMock_MarkCancelled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCancelled'
+type ExecutionRepositoryMock_MarkCancelled_Call struct {
+	*mock.Call
+}
+
+// MarkCancelled is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - completedAt
+func (_e *ExecutionRepositoryMock_Expecter) MarkCancelled(ctx interface{}, id interface{}, completedAt interface{}) *ExecutionRepositoryMock_MarkCancelled_Call {
+	return &ExecutionRepositoryMock_MarkCancelled_Call{Call: _e.mock.On("MarkCancelled", ctx, id, completedAt)}
+}
+
+func (_c *ExecutionRepositoryMock_MarkCancelled_Call) Run(run func(ctx context.Context, id uuid.UUID, completedAt time.Time)) *ExecutionRepositoryMock_MarkCancelled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkCancelled_Call) Return(r0 bool, r1 error) *ExecutionRepositoryMock_MarkCancelled_Call {
+	_c.Call.Return(r0, r1)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkCancelled_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, completedAt time.Time) (bool, error)) *ExecutionRepositoryMock_MarkCancelled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkCompleted provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) MarkCompleted(ctx context.Context, id uuid.UUID, completedAt time.Time, result *entity.ExecutionResult) error {
 	ret := _mock.Called(ctx, id, completedAt, result)
@@ -1004,6 +1508,54 @@ func (_c *ExecutionRepositoryMock_MarkFailed_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// MarkWaitingInput provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) MarkWaitingInput(ctx context.Context, id uuid.UUID, question string, sessionID string) error {
+	ret := _mock.Called(ctx, id, question, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkWaitingInput")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, id, question, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ExecutionRepositoryMock_MarkWaitingInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWaitingInput'
+type ExecutionRepositoryMock_MarkWaitingInput_Call struct {
+	*mock.Call
+}
+
+// MarkWaitingInput is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - question
+//   - sessionID
+func (_e *ExecutionRepositoryMock_Expecter) MarkWaitingInput(ctx interface{}, id interface{}, question interface{}, sessionID interface{}) *ExecutionRepositoryMock_MarkWaitingInput_Call {
+	return &ExecutionRepositoryMock_MarkWaitingInput_Call{Call: _e.mock.On("MarkWaitingInput", ctx, id, question, sessionID)}
+}
+
+func (_c *ExecutionRepositoryMock_MarkWaitingInput_Call) Run(run func(ctx context.Context, id uuid.UUID, question string, sessionID string)) *ExecutionRepositoryMock_MarkWaitingInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkWaitingInput_Call) Return(err error) *ExecutionRepositoryMock_MarkWaitingInput_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_MarkWaitingInput_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, question string, sessionID string) error) *ExecutionRepositoryMock_MarkWaitingInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) Update(ctx context.Context, execution *entity.Execution) error {
 	ret := _mock.Called(ctx, execution)
@@ -1050,6 +1602,101 @@ func (_c *ExecutionRepositoryMock_Update_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// UpdateCoverage provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) UpdateCoverage(ctx context.Context, id uuid.UUID, coverage *float64, baseCoverage *float64) error {
+	ret := _mock.Called(ctx, id, coverage, baseCoverage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCoverage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *float64, *float64) error); ok {
+		r0 = returnFunc(ctx, id, coverage, baseCoverage)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ExecutionRepositoryMock_UpdateCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCoverage'
+type ExecutionRepositoryMock_UpdateCoverage_Call struct {
+	*mock.Call
+}
+
+// UpdateCoverage is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - coverage
+//   - baseCoverage
+func (_e *ExecutionRepositoryMock_Expecter) UpdateCoverage(ctx interface{}, id interface{}, coverage interface{}, baseCoverage interface{}) *ExecutionRepositoryMock_UpdateCoverage_Call {
+	return &ExecutionRepositoryMock_UpdateCoverage_Call{Call: _e.mock.On("UpdateCoverage", ctx, id, coverage, baseCoverage)}
+}
+
+func (_c *ExecutionRepositoryMock_UpdateCoverage_Call) Run(run func(ctx context.Context, id uuid.UUID, coverage *float64, baseCoverage *float64)) *ExecutionRepositoryMock_UpdateCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*float64), args[3].(*float64))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateCoverage_Call) Return(err error) *ExecutionRepositoryMock_UpdateCoverage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateCoverage_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, coverage *float64, baseCoverage *float64) error) *ExecutionRepositoryMock_UpdateCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFixAttempts provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) UpdateFixAttempts(ctx context.Context, id uuid.UUID, fixAttempts int) error {
+	ret := _mock.Called(ctx, id, fixAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFixAttempts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) error); ok {
+		r0 = returnFunc(ctx, id, fixAttempts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ExecutionRepositoryMock_UpdateFixAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFixAttempts'
+type ExecutionRepositoryMock_UpdateFixAttempts_Call struct {
+	*mock.Call
+}
+
+// UpdateFixAttempts is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - fixAttempts
+func (_e *ExecutionRepositoryMock_Expecter) UpdateFixAttempts(ctx interface{}, id interface{}, fixAttempts interface{}) *ExecutionRepositoryMock_UpdateFixAttempts_Call {
+	return &ExecutionRepositoryMock_UpdateFixAttempts_Call{Call: _e.mock.On("UpdateFixAttempts", ctx, id, fixAttempts)}
+}
+
+func (_c *ExecutionRepositoryMock_UpdateFixAttempts_Call) Run(run func(ctx context.Context, id uuid.UUID, fixAttempts int)) *ExecutionRepositoryMock_UpdateFixAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateFixAttempts_Call) Return(err error) *ExecutionRepositoryMock_UpdateFixAttempts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateFixAttempts_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, fixAttempts int) error) *ExecutionRepositoryMock_UpdateFixAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateError provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) UpdateError(ctx context.Context, id uuid.UUID, error1 string) error {
 	ret := _mock.Called(ctx, id, error1)
@@ -1191,6 +1838,53 @@ func (_c *ExecutionRepositoryMock_UpdateStatus_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// UpdateUsage provides a mock function for the type ExecutionRepositoryMock
+func (_mock *ExecutionRepositoryMock) UpdateUsage(ctx context.Context, id uuid.UUID, usage entity.ExecutionUsage) error {
+	ret := _mock.Called(ctx, id, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.ExecutionUsage) error); ok {
+		r0 = returnFunc(ctx, id, usage)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ExecutionRepositoryMock_UpdateUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsage'
+type ExecutionRepositoryMock_UpdateUsage_Call struct {
+	*mock.Call
+}
+
+// UpdateUsage is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - usage
+func (_e *ExecutionRepositoryMock_Expecter) UpdateUsage(ctx interface{}, id interface{}, usage interface{}) *ExecutionRepositoryMock_UpdateUsage_Call {
+	return &ExecutionRepositoryMock_UpdateUsage_Call{Call: _e.mock.On("UpdateUsage", ctx, id, usage)}
+}
+
+func (_c *ExecutionRepositoryMock_UpdateUsage_Call) Run(run func(ctx context.Context, id uuid.UUID, usage entity.ExecutionUsage)) *ExecutionRepositoryMock_UpdateUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.ExecutionUsage))
+	})
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateUsage_Call) Return(err error) *ExecutionRepositoryMock_UpdateUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ExecutionRepositoryMock_UpdateUsage_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, usage entity.ExecutionUsage) error) *ExecutionRepositoryMock_UpdateUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateExecutionExists provides a mock function for the type ExecutionRepositoryMock
 func (_mock *ExecutionRepositoryMock) ValidateExecutionExists(ctx context.Context, id uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, id)
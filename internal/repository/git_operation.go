@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type GitOperationRepository interface {
+	Create(ctx context.Context, operation *entity.GitOperation) error
+	// ListByTaskID returns the git operations done for the task, oldest
+	// first
+	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error)
+}
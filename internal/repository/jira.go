@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type JiraIntegrationRepository interface {
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) (*entity.JiraIntegration, error)
+	// Save creates or updates the project's integration
+	Save(ctx context.Context, integration *entity.JiraIntegration) error
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+type JobMetricRepository interface {
+	// Record adds a run of a job of jobType, finished on day, to the day's
+	// rollup of the type
+	Record(ctx context.Context, day time.Time, jobType string, duration time.Duration, failed bool) error
+	// List returns the rollups of the days in [from, to), of jobType only
+	// when set, oldest first
+	List(ctx context.Context, from, to time.Time, jobType string) ([]*entity.JobMetric, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *entity.Organization) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Organization, error)
+	GetAll(ctx context.Context) ([]*entity.Organization, error)
+	Update(ctx context.Context, org *entity.Organization) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Count returns how many organizations there are
+	Count(ctx context.Context) (int64, error)
+	// AdoptUnassigned moves the projects and users of no organization into
+	// the organization
+	AdoptUnassigned(ctx context.Context, id uuid.UUID) error
+	// AddUser moves a user of no organization into the organization. It
+	// returns false when no such user exists.
+	AddUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error)
+	// AddUserByUsername is AddUser for the user with the username
+	AddUserByUsername(ctx context.Context, id uuid.UUID, username string) (bool, error)
+}
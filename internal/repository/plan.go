@@ -12,6 +12,8 @@ type PlanRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, plan *entity.Plan) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Plan, error)
+	// GetByTaskID returns the task's plan, the latest one when the task
+	// was planned again
 	GetByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.Plan, error)
 	Update(ctx context.Context, plan *entity.Plan) error
 	Delete(ctx context.Context, id uuid.UUID) error
@@ -19,6 +21,8 @@ type PlanRepository interface {
 	// Status-based queries
 	ListByStatus(ctx context.Context, status entity.PlanStatus) ([]*entity.Plan, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.PlanStatus) error
+	// Reject marks the plan REJECTED with the reviewer's feedback
+	Reject(ctx context.Context, id uuid.UUID, feedback string) error
 
 	// Advanced queries
 	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Plan, error)
@@ -35,6 +39,9 @@ type PlanRepository interface {
 	GetVersion(ctx context.Context, planID uuid.UUID, version int) (*entity.PlanVersion, error)
 	RestoreVersion(ctx context.Context, planID uuid.UUID, version int) error
 	CompareVersions(ctx context.Context, planID uuid.UUID, fromVersion, toVersion int) (*entity.PlanVersionComparison, error)
+	// Revise replaces the plan's content and records it as the plan's next
+	// version, created by editedBy
+	Revise(ctx context.Context, planID uuid.UUID, content string, editedBy string) (*entity.PlanVersion, error)
 
 	// Bulk operations
 	BulkUpdateStatus(ctx context.Context, planIDs []uuid.UUID, status entity.PlanStatus) error
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type PlanApprovalRepository interface {
+	// Create records an approval. An approver approving the same plan again
+	// is not recorded twice.
+	Create(ctx context.Context, approval *entity.PlanApproval) error
+	// ListByPlanID returns the approvals of a plan, oldest first
+	ListByPlanID(ctx context.Context, planID uuid.UUID) ([]*entity.PlanApproval, error)
+}
@@ -969,6 +969,53 @@ func (_c *PlanRepositoryMock_ListByTaskIDs_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// Reject provides a mock function for the type PlanRepositoryMock
+func (_mock *PlanRepositoryMock) Reject(ctx context.Context, id uuid.UUID, feedback string) error {
+	ret := _mock.Called(ctx, id, feedback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reject")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, feedback)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// PlanRepositoryMock_Reject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reject'
+type PlanRepositoryMock_Reject_Call struct {
+	*mock.Call
+}
+
+// Reject is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - feedback
+func (_e *PlanRepositoryMock_Expecter) Reject(ctx interface{}, id interface{}, feedback interface{}) *PlanRepositoryMock_Reject_Call {
+	return &PlanRepositoryMock_Reject_Call{Call: _e.mock.On("Reject", ctx, id, feedback)}
+}
+
+func (_c *PlanRepositoryMock_Reject_Call) Run(run func(ctx context.Context, id uuid.UUID, feedback string)) *PlanRepositoryMock_Reject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PlanRepositoryMock_Reject_Call) Return(r0 error) *PlanRepositoryMock_Reject_Call {
+	_c.Call.Return(r0)
+	return _c
+}
+
+func (_c *PlanRepositoryMock_Reject_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, feedback string) error) *PlanRepositoryMock_Reject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreVersion provides a mock function for the type PlanRepositoryMock
 func (_mock *PlanRepositoryMock) RestoreVersion(ctx context.Context, planID uuid.UUID, version int) error {
 	ret := _mock.Called(ctx, planID, version)
@@ -1016,6 +1063,65 @@ func (_c *PlanRepositoryMock_RestoreVersion_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// Revise provides a mock function for the type PlanRepositoryMock
+func (_mock *PlanRepositoryMock) Revise(ctx context.Context, planID uuid.UUID, content string, editedBy string) (*entity.PlanVersion, error) {
+	ret := _mock.Called(ctx, planID, content, editedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revise")
+	}
+
+	var r0 *entity.PlanVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*entity.PlanVersion, error)); ok {
+		return returnFunc(ctx, planID, content, editedBy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *entity.PlanVersion); ok {
+		r0 = returnFunc(ctx, planID, content, editedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.PlanVersion)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, planID, content, editedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PlanRepositoryMock_Revise_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revise'
+type PlanRepositoryMock_Revise_Call struct {
+	*mock.Call
+}
+
+// Revise is a helper method to define mock.On call
+//   - ctx
+//   - planID
+//   - content
+//   - editedBy
+func (_e *PlanRepositoryMock_Expecter) Revise(ctx interface{}, planID interface{}, content interface{}, editedBy interface{}) *PlanRepositoryMock_Revise_Call {
+	return &PlanRepositoryMock_Revise_Call{Call: _e.mock.On("Revise", ctx, planID, content, editedBy)}
+}
+
+func (_c *PlanRepositoryMock_Revise_Call) Run(run func(ctx context.Context, planID uuid.UUID, content string, editedBy string)) *PlanRepositoryMock_Revise_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PlanRepositoryMock_Revise_Call) Return(_a0 *entity.PlanVersion, _a1 error) *PlanRepositoryMock_Revise_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlanRepositoryMock_Revise_Call) RunAndReturn(run func(ctx context.Context, planID uuid.UUID, content string, editedBy string) (*entity.PlanVersion, error)) *PlanRepositoryMock_Revise_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchByContent provides a mock function for the type PlanRepositoryMock
 func (_mock *PlanRepositoryMock) SearchByContent(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.Plan, error) {
 	ret := _mock.Called(ctx, query, projectID)
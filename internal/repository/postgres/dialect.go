@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"gorm.io/gorm"
+)
+
+// The repositories are written against Postgres. These helpers provide the
+// SQLite fallbacks for the few Postgres-only constructs they use.
+
+// ilike returns a case-insensitive LIKE condition on column. SQLite has no
+// ILIKE, but its LIKE is already case-insensitive for ASCII.
+func ilike(db *gorm.DB, column string) string {
+	if database.IsSQLite(db) {
+		return column + " LIKE ?"
+	}
+	return column + " ILIKE ?"
+}
+
+// jsonArrayContains returns a condition matching rows whose JSON array column
+// contains the string bound to the placeholder. Postgres expects the value as
+// a JSON array literal (e.g. `["tag"]`), SQLite as the plain string.
+func jsonArrayContains(db *gorm.DB, column string) string {
+	if database.IsSQLite(db) {
+		return "EXISTS (SELECT 1 FROM json_each(" + column + ") WHERE json_each.value = ?)"
+	}
+	return column + " @> ?"
+}
+
+// jsonArrayElement returns the value to bind to a jsonArrayContains
+// condition for the string value
+func jsonArrayElement(db *gorm.DB, value string) interface{} {
+	if database.IsSQLite(db) {
+		return value
+	}
+	return fmt.Sprintf(`["%s"]`, value)
+}
+
+// hoursBetween returns an expression for the hours elapsed from the from
+// timestamp column to the to one. SQLite has no interval arithmetic, so the
+// difference is taken between Julian day numbers.
+func hoursBetween(db *gorm.DB, from, to string) string {
+	if database.IsSQLite(db) {
+		return "(julianday(" + to + ") - julianday(" + from + ")) * 24"
+	}
+	return "EXTRACT(EPOCH FROM (" + to + " - " + from + ")) / 3600"
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSQLiteDB(t *testing.T) *database.GormDB {
+	cfg := &config.Config{Database: config.DatabaseConfig{
+		Driver:     database.DriverSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "autodevs.db"),
+	}}
+
+	db, err := database.NewGormDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, database.RunSQLiteMigrations(db))
+
+	return db
+}
+
+func TestSQLiteFallbacks(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	project := &entity.Project{Name: "Billing Service", Description: "Handles invoices"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix invoice rounding", Tags: []string{"bug", "billing"}}
+	require.NoError(t, taskRepo.Create(ctx, task))
+	other := &entity.Task{ProjectID: project.ID, Title: "Write docs", Tags: []string{"docs"}}
+	require.NoError(t, taskRepo.Create(ctx, other))
+
+	t.Run("project search is case-insensitive", func(t *testing.T) {
+		projects, total, err := projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{Search: "billing"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, projects, 1)
+		assert.Equal(t, project.ID, projects[0].ID)
+	})
+
+	t.Run("task search falls back to substring matching", func(t *testing.T) {
+		results, err := taskRepo.SearchTasks(ctx, "invoice", &project.ID)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, task.ID, results[0].Task.ID)
+	})
+
+	t.Run("tag filter uses json_each", func(t *testing.T) {
+		tasks, err := taskRepo.GetTasksByTags(ctx, []string{"billing"})
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, task.ID, tasks[0].ID)
+	})
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type emailTemplateRepository struct {
+	db *database.GormDB
+}
+
+// NewEmailTemplateRepository creates a new PostgreSQL email template repository
+func NewEmailTemplateRepository(db *database.GormDB) repository.EmailTemplateRepository {
+	return &emailTemplateRepository{db: db}
+}
+
+// ListByProjectID retrieves the project's templates, by type
+func (r *emailTemplateRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.EmailTemplate, error) {
+	var templates []*entity.EmailTemplate
+
+	result := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("type ASC").
+		Find(&templates)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list email templates: %w", result.Error)
+	}
+
+	return templates, nil
+}
+
+// Get retrieves a project's template of a type, returning nil when there is
+// none
+func (r *emailTemplateRepository) Get(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) (*entity.EmailTemplate, error) {
+	var template entity.EmailTemplate
+
+	result := r.db.WithContext(ctx).First(&template, "project_id = ? AND type = ?", projectID, notificationType)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get email template: %w", result.Error)
+	}
+
+	return &template, nil
+}
+
+// Save creates a template or replaces the existing one of its type
+func (r *emailTemplateRepository) Save(ctx context.Context, template *entity.EmailTemplate) error {
+	if template.ID == uuid.Nil {
+		template.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"subject", "body", "updated_by", "updated_at"}),
+	}).Create(template)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save email template: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete removes a project's template of a type
+func (r *emailTemplateRepository) Delete(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) error {
+	result := r.db.WithContext(ctx).Delete(&entity.EmailTemplate{}, "project_id = ? AND type = ?", projectID, notificationType)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete email template: %w", result.Error)
+	}
+
+	return nil
+}
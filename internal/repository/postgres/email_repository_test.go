@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailTemplateRepository(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewEmailTemplateRepository(db)
+	projectID := uuid.New()
+	admin := "admin"
+
+	require.NoError(t, repo.Save(ctx, &entity.EmailTemplate{ProjectID: projectID, Type: entity.NotificationTypePlanReady, Subject: "Review {{.TaskKey}}", Body: "{{.Plan}}"}))
+	// Saving a type again replaces its template
+	require.NoError(t, repo.Save(ctx, &entity.EmailTemplate{ProjectID: projectID, Type: entity.NotificationTypePlanReady, Subject: "Plan for {{.TaskKey}}", Body: "{{.Plan}}", UpdatedBy: &admin}))
+	require.NoError(t, repo.Save(ctx, &entity.EmailTemplate{ProjectID: projectID, Type: entity.NotificationTypeDailyDigest, Subject: "Digest", Body: "{{len .Movements}} moves"}))
+
+	templates, err := repo.ListByProjectID(ctx, projectID)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, entity.NotificationTypeDailyDigest, templates[0].Type)
+	assert.Equal(t, "Plan for {{.TaskKey}}", templates[1].Subject)
+	assert.Equal(t, &admin, templates[1].UpdatedBy)
+
+	template, err := repo.Get(ctx, projectID, entity.NotificationTypeExecutionFailed)
+	require.NoError(t, err)
+	assert.Nil(t, template, "types without a template use the default one")
+
+	require.NoError(t, repo.Delete(ctx, projectID, entity.NotificationTypePlanReady))
+	template, err = repo.Get(ctx, projectID, entity.NotificationTypePlanReady)
+	require.NoError(t, err)
+	assert.Nil(t, template)
+}
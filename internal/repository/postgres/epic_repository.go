@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type epicRepository struct {
+	db *database.GormDB
+}
+
+// NewEpicRepository creates a new PostgreSQL epic repository
+func NewEpicRepository(db *database.GormDB) repository.EpicRepository {
+	return &epicRepository{db: db}
+}
+
+// Create creates a new epic
+func (r *epicRepository) Create(ctx context.Context, epic *entity.Epic) error {
+	if epic.ID == uuid.Nil {
+		epic.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(epic)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create epic: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an epic by ID
+func (r *epicRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Epic, error) {
+	var epic entity.Epic
+
+	result := r.db.WithContext(ctx).First(&epic, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("epic not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get epic: %w", result.Error)
+	}
+
+	return &epic, nil
+}
+
+// ListByProjectID retrieves the project's epics, those due first and then
+// the newest
+func (r *epicRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Epic, error) {
+	var epics []*entity.Epic
+
+	result := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("CASE WHEN due_date IS NULL THEN 1 ELSE 0 END").
+		Order("due_date ASC").
+		Order("created_at DESC").
+		Find(&epics)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list epics: %w", result.Error)
+	}
+
+	return epics, nil
+}
+
+// Update saves the epic
+func (r *epicRepository) Update(ctx context.Context, epic *entity.Epic) error {
+	result := r.db.WithContext(ctx).Save(epic)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update epic: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete deletes the epic and ungroups its tasks. The foreign key does the
+// latter on Postgres too, but not on SQLite.
+func (r *epicRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Task{}).Where("epic_id = ?", id).Update("epic_id", nil).Error; err != nil {
+			return fmt.Errorf("failed to ungroup epic tasks: %w", err)
+		}
+
+		result := tx.Delete(&entity.Epic{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete epic: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("epic not found with id %s", id)
+		}
+		return nil
+	})
+}
+
+// GetProgress rolls up the tasks of each epic, templates left out
+func (r *epicRepository) GetProgress(ctx context.Context, epicIDs []uuid.UUID) (map[uuid.UUID]*entity.EpicProgress, error) {
+	progress := make(map[uuid.UUID]*entity.EpicProgress, len(epicIDs))
+	for _, id := range epicIDs {
+		progress[id] = &entity.EpicProgress{EpicID: id}
+	}
+	if len(epicIDs) == 0 {
+		return progress, nil
+	}
+
+	var rows []entity.EpicProgress
+	result := r.db.WithContext(ctx).
+		Model(&entity.Task{}).
+		Select("epic_id, COUNT(*) AS total_tasks, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS done_tasks, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS cancelled_tasks, "+
+			"COALESCE(SUM(estimated_hours), 0) AS estimated_hours, "+
+			"COALESCE(SUM(actual_hours), 0) AS actual_hours",
+			entity.TaskStatusDONE, entity.TaskStatusCANCELLED).
+		Where("epic_id IN ? AND is_template = ?", epicIDs, false).
+		Group("epic_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get epic progress: %w", result.Error)
+	}
+
+	for i := range rows {
+		row := rows[i]
+		progress[row.EpicID] = &row
+	}
+	return progress, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpicRepository_GetProgress(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	epicRepo := NewEpicRepository(db)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	epic := &entity.Epic{ProjectID: project.ID, Title: "Invoicing v2"}
+	empty := &entity.Epic{ProjectID: project.ID, Title: "Refunds"}
+	require.NoError(t, epicRepo.Create(ctx, epic))
+	require.NoError(t, epicRepo.Create(ctx, empty))
+
+	hours := func(h float64) *float64 { return &h }
+	create := func(title string, status entity.TaskStatus, estimated, actual *float64) *entity.Task {
+		task := &entity.Task{ProjectID: project.ID, Title: title, Status: status, EpicID: &epic.ID, EstimatedHours: estimated, ActualHours: actual}
+		require.NoError(t, taskRepo.Create(ctx, task))
+		return task
+	}
+	create("Draft invoices", entity.TaskStatusDONE, hours(4), hours(5.5))
+	create("Send invoices", entity.TaskStatusIMPLEMENTING, hours(6), nil)
+	create("Invoice PDFs", entity.TaskStatusCANCELLED, nil, nil)
+	deleted := create("Invoice emails", entity.TaskStatusDONE, hours(2), hours(2))
+	require.NoError(t, taskRepo.Delete(ctx, deleted.ID))
+	require.NoError(t, taskRepo.Create(ctx, &entity.Task{ProjectID: project.ID, Title: "Ungrouped", Status: entity.TaskStatusDONE}))
+
+	progress, err := epicRepo.GetProgress(ctx, []uuid.UUID{epic.ID, empty.ID})
+	require.NoError(t, err)
+	require.Len(t, progress, 2)
+	assert.Equal(t, &entity.EpicProgress{
+		EpicID:         epic.ID,
+		TotalTasks:     3,
+		DoneTasks:      1,
+		CancelledTasks: 1,
+		EstimatedHours: 10,
+		ActualHours:    5.5,
+	}, progress[epic.ID])
+	assert.Equal(t, &entity.EpicProgress{EpicID: empty.ID}, progress[empty.ID], "epics without tasks have an entry")
+}
+
+func TestEpicRepository_ListAndDelete(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	epicRepo := NewEpicRepository(db)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	due := time.Now().UTC().Add(48 * time.Hour)
+	undated := &entity.Epic{ProjectID: project.ID, Title: "Someday"}
+	dated := &entity.Epic{ProjectID: project.ID, Title: "Q3 launch", DueDate: &due}
+	require.NoError(t, epicRepo.Create(ctx, undated))
+	require.NoError(t, epicRepo.Create(ctx, dated))
+
+	epics, err := epicRepo.ListByProjectID(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, epics, 2)
+	assert.Equal(t, dated.ID, epics[0].ID, "epics with a due date come first")
+
+	task := &entity.Task{ProjectID: project.ID, Title: "Launch page", EpicID: &dated.ID}
+	require.NoError(t, taskRepo.Create(ctx, task))
+	require.NoError(t, epicRepo.Delete(ctx, dated.ID))
+
+	_, err = epicRepo.GetByID(ctx, dated.ID)
+	assert.ErrorContains(t, err, "epic not found")
+	task, err = taskRepo.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, task.EpicID, "the tasks of a deleted epic are ungrouped")
+
+	assert.ErrorContains(t, epicRepo.Delete(ctx, dated.ID), "epic not found")
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+)
+
+type eventRepository struct {
+	db *database.GormDB
+}
+
+// NewEventRepository creates a new PostgreSQL event feed repository
+func NewEventRepository(db *database.GormDB) repository.EventRepository {
+	return &eventRepository{db: db}
+}
+
+// Create appends an event to the feed
+func (r *eventRepository) Create(ctx context.Context, event *entity.Event) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+	return nil
+}
+
+// List returns the events matching filter, newest first
+func (r *eventRepository) List(ctx context.Context, filter entity.EventFilter) ([]*entity.Event, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Event{})
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", filter.Types)
+	}
+	if filter.AfterID > 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var events []*entity.Event
+	if err := query.Order("id DESC").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	return events, nil
+}
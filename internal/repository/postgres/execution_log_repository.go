@@ -190,6 +190,7 @@ func (r *executionLogRepository) insertOrUpdateLog(ctx context.Context, log *ent
         "is_error":       log.IsError,
         "duration_ms":    log.DurationMs,
         "num_turns":      log.NumTurns,
+        "encrypted":      log.Encrypted,
     }
 
 		if err := r.db.WithContext(ctx).Model(&existingLog).Updates(updateData).Error; err != nil {
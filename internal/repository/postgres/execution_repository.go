@@ -47,11 +47,17 @@ func (r *executionRepository) Create(ctx context.Context, execution *entity.Exec
 	return nil
 }
 
+// scoped returns a query restricted to the executions of the organization
+// carried by ctx
+func (r *executionRepository) scoped(ctx context.Context) *gorm.DB {
+	return scopeByTask(ctx, r.db.WithContext(ctx), "executions.task_id")
+}
+
 // GetByID retrieves an execution by ID
 func (r *executionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Execution, error) {
 	var execution entity.Execution
 
-	result := r.db.WithContext(ctx).First(&execution, "id = ?", id)
+	result := r.scoped(ctx).First(&execution, "id = ?", id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("execution not found with id %s", id)
@@ -66,7 +72,7 @@ func (r *executionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entit
 func (r *executionRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error) {
 	var executions []entity.Execution
 
-	result := r.db.WithContext(ctx).Where("task_id = ?", taskID).Order("started_at DESC").Find(&executions)
+	result := r.scoped(ctx).Where("task_id = ?", taskID).Order("started_at DESC").Find(&executions)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get executions by task: %w", result.Error)
 	}
@@ -80,6 +86,107 @@ func (r *executionRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID)
 	return executionPtrs, nil
 }
 
+// GetRetries retrieves the executions retrying an execution
+func (r *executionRepository) GetRetries(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error) {
+	var executions []entity.Execution
+
+	result := r.scoped(ctx).Where("retry_of_id = ?", id).Order("created_at ASC").Find(&executions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get execution retries: %w", result.Error)
+	}
+
+	// Convert to slice of pointers
+	executionPtrs := make([]*entity.Execution, len(executions))
+	for i := range executions {
+		executionPtrs[i] = &executions[i]
+	}
+
+	return executionPtrs, nil
+}
+
+// GetAttempts retrieves the attempt chain of an execution by following its
+// retry links back to the first attempt, then forward to the latest retry
+func (r *executionRepository) GetAttempts(ctx context.Context, id uuid.UUID) ([]*entity.Execution, error) {
+	execution, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[uuid.UUID]bool{execution.ID: true}
+	attempts := []*entity.Execution{execution}
+	for first := execution; first.RetryOfID != nil && !seen[*first.RetryOfID]; {
+		previous, err := r.GetByID(ctx, *first.RetryOfID)
+		if err != nil {
+			return nil, err
+		}
+		seen[previous.ID] = true
+		attempts = append([]*entity.Execution{previous}, attempts...)
+		first = previous
+	}
+	for last := execution; ; {
+		retries, err := r.GetRetries(ctx, last.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(retries) == 0 || seen[retries[0].ID] {
+			break
+		}
+		last = retries[0]
+		seen[last.ID] = true
+		attempts = append(attempts, last)
+	}
+
+	return attempts, nil
+}
+
+// GetByTaskIDs retrieves the executions of several tasks in one query
+func (r *executionRepository) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.Execution, error) {
+	var executions []entity.Execution
+
+	result := r.scoped(ctx).Where("task_id IN ?", taskIDs).Order("started_at DESC").Find(&executions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get executions by tasks: %w", result.Error)
+	}
+
+	// Convert to slice of pointers
+	executionPtrs := make([]*entity.Execution, len(executions))
+	for i := range executions {
+		executionPtrs[i] = &executions[i]
+	}
+
+	return executionPtrs, nil
+}
+
+// GetLatestComparison retrieves the executions of the most recent executor
+// comparison of a task
+func (r *executionRepository) GetLatestComparison(ctx context.Context, taskID uuid.UUID) ([]*entity.Execution, error) {
+	var latest entity.Execution
+	result := r.scoped(ctx).
+		Where("task_id = ? AND comparison_id IS NOT NULL", taskID).
+		Order("started_at DESC").
+		First(&latest)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return []*entity.Execution{}, nil
+		}
+		return nil, fmt.Errorf("failed to get latest comparison: %w", result.Error)
+	}
+
+	var executions []entity.Execution
+	result = r.scoped(ctx).Where("comparison_id = ?", latest.ComparisonID).Order("variant ASC").Find(&executions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get comparison executions: %w", result.Error)
+	}
+
+	// Convert to slice of pointers
+	executionPtrs := make([]*entity.Execution, len(executions))
+	for i := range executions {
+		executionPtrs[i] = &executions[i]
+	}
+
+	return executionPtrs, nil
+}
+
 // Update updates an existing execution
 func (r *executionRepository) Update(ctx context.Context, execution *entity.Execution) error {
 	// First check if execution exists
@@ -209,6 +316,113 @@ func (r *executionRepository) MarkFailed(ctx context.Context, id uuid.UUID, comp
 	return nil
 }
 
+// UpdateCoverage records the test coverage measured after an execution and
+// on its base branch
+func (r *executionRepository) UpdateCoverage(ctx context.Context, id uuid.UUID, coverage, baseCoverage *float64) error {
+	updates := map[string]interface{}{
+		"coverage":      coverage,
+		"base_coverage": baseCoverage,
+	}
+
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).Where("id = ?", id).Updates(updates)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update execution coverage: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("execution not found with id %s", id)
+	}
+
+	return nil
+}
+
+// UpdateUsage records the tokens and cost an execution's AI run reported
+func (r *executionRepository) UpdateUsage(ctx context.Context, id uuid.UUID, usage entity.ExecutionUsage) error {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"input_tokens":  usage.InputTokens,
+		"output_tokens": usage.OutputTokens,
+		"cost_usd":      usage.CostUSD,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update execution usage: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("execution not found with id %s", id)
+	}
+
+	return nil
+}
+
+// MarkWaitingInput stops an execution on the question the AI asked in
+// session sessionID
+func (r *executionRepository) MarkWaitingInput(ctx context.Context, id uuid.UUID, question, sessionID string) error {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     entity.ExecutionStatusWaitingInput,
+		"question":   question,
+		"session_id": sessionID,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark execution as waiting for input: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("execution not found with id %s", id)
+	}
+
+	return nil
+}
+
+// MarkAnswered moves an execution waiting for input back to pending. The
+// status is checked in the update itself, so an answer is only taken once;
+// the heartbeat keeps the execution from counting as orphaned until a
+// worker resumes it.
+func (r *executionRepository) MarkAnswered(ctx context.Context, id uuid.UUID, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).
+		Where("id = ? AND status = ?", id, entity.ExecutionStatusWaitingInput).
+		Updates(map[string]interface{}{
+			"status":       entity.ExecutionStatusPending,
+			"heartbeat_at": at,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark execution as answered: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkCancelled cancels the execution unless it has already finished
+func (r *executionRepository) MarkCancelled(ctx context.Context, id uuid.UUID, completedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).
+		Where("id = ? AND status IN ?", id, []entity.ExecutionStatus{
+			entity.ExecutionStatusPending,
+			entity.ExecutionStatusRunning,
+			entity.ExecutionStatusPaused,
+			entity.ExecutionStatusWaitingInput,
+		}).
+		Updates(map[string]interface{}{
+			"status":       entity.ExecutionStatusCancelled,
+			"completed_at": completedAt,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark execution as cancelled: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UpdateFixAttempts records how many auto-fix attempts followed an execution
+func (r *executionRepository) UpdateFixAttempts(ctx context.Context, id uuid.UUID, fixAttempts int) error {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).Where("id = ?", id).Update("fix_attempts", fixAttempts)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update execution fix attempts: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("execution not found with id %s", id)
+	}
+
+	return nil
+}
+
 // GetByStatus retrieves executions by status
 func (r *executionRepository) GetByStatus(ctx context.Context, status entity.ExecutionStatus) ([]*entity.Execution, error) {
 	var executions []entity.Execution
@@ -247,12 +461,50 @@ func (r *executionRepository) GetByStatuses(ctx context.Context, statuses []enti
 
 // GetActive retrieves all active executions
 func (r *executionRepository) GetActive(ctx context.Context) ([]*entity.Execution, error) {
-	activeStatuses := []entity.ExecutionStatus{
-		entity.ExecutionStatusPending,
-		entity.ExecutionStatusRunning,
-		entity.ExecutionStatusPaused,
+	return r.GetByStatuses(ctx, activeExecutionStatuses)
+}
+
+// activeExecutionStatuses are the statuses of executions not finished yet
+var activeExecutionStatuses = []entity.ExecutionStatus{
+	entity.ExecutionStatusPending,
+	entity.ExecutionStatusRunning,
+	entity.ExecutionStatusPaused,
+}
+
+// Heartbeat reports the active executions run by worker alive at at
+func (r *executionRepository) Heartbeat(ctx context.Context, worker string, at time.Time) error {
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).
+		Where("worker = ? AND status IN ?", worker, activeExecutionStatuses).
+		Update("heartbeat_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record execution heartbeat: %w", result.Error)
+	}
+	return nil
+}
+
+// GetOrphaned returns the active executions run by worker, when set, or last
+// reported alive before staleBefore. Executions never reported alive count
+// from when they started. Only executions a worker runs are reported alive,
+// so the others, such as those created and updated through the API, are
+// never orphaned.
+func (r *executionRepository) GetOrphaned(ctx context.Context, worker string, staleBefore time.Time) ([]*entity.Execution, error) {
+	var executions []*entity.Execution
+
+	stale := r.db.Where("COALESCE(heartbeat_at, started_at) < ?", staleBefore)
+	if worker != "" {
+		stale = stale.Or("worker = ?", worker)
+	}
+	result := r.db.WithContext(ctx).
+		Where("status IN ?", activeExecutionStatuses).
+		Where("worker <> ''").
+		Where(stale).
+		Order("started_at").
+		Find(&executions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get orphaned executions: %w", result.Error)
 	}
-	return r.GetByStatuses(ctx, activeStatuses)
+
+	return executions, nil
 }
 
 // GetCompleted retrieves completed executions with limit
@@ -305,7 +557,7 @@ func (r *executionRepository) GetByDateRange(ctx context.Context, startDate, end
 func (r *executionRepository) GetWithProcesses(ctx context.Context, id uuid.UUID) (*entity.Execution, error) {
 	var execution entity.Execution
 
-	result := r.db.WithContext(ctx).Preload("Processes").First(&execution, "id = ?", id)
+	result := r.scoped(ctx).Preload("Processes").First(&execution, "id = ?", id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("execution not found with id %s", id)
@@ -320,7 +572,7 @@ func (r *executionRepository) GetWithProcesses(ctx context.Context, id uuid.UUID
 func (r *executionRepository) GetWithLogs(ctx context.Context, id uuid.UUID, logLimit int) (*entity.Execution, error) {
 	var execution entity.Execution
 
-	query := r.db.WithContext(ctx)
+	query := r.scoped(ctx)
 	if logLimit > 0 {
 		query = query.Preload("Logs", func(db *gorm.DB) *gorm.DB {
 			return db.Order("timestamp DESC").Limit(logLimit)
@@ -433,6 +685,27 @@ func (r *executionRepository) GetRecentExecutions(ctx context.Context, limit int
 	return executionPtrs, nil
 }
 
+// GetSpendByAIType sums the usage of the project's executions completed in
+// [from, to), per executor
+func (r *executionRepository) GetSpendByAIType(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]*repository.ExecutorSpend, error) {
+	var spend []*repository.ExecutorSpend
+	result := r.db.WithContext(ctx).Model(&entity.Execution{}).
+		Select("executions.ai_type AS ai_type, COUNT(*) AS executions, "+
+			"SUM(executions.input_tokens) AS input_tokens, SUM(executions.output_tokens) AS output_tokens, "+
+			"SUM(executions.cost_usd) AS cost_usd").
+		Joins("JOIN tasks ON tasks.id = executions.task_id").
+		Where("tasks.project_id = ?", projectID).
+		Where("executions.completed_at >= ? AND executions.completed_at < ?", from, to).
+		Group("executions.ai_type").
+		Order("cost_usd DESC").
+		Scan(&spend)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get execution spend: %w", result.Error)
+	}
+
+	return spend, nil
+}
+
 // BulkUpdateStatus updates status for multiple executions
 func (r *executionRepository) BulkUpdateStatus(ctx context.Context, ids []uuid.UUID, status entity.ExecutionStatus) error {
 	result := r.db.WithContext(ctx).Model(&entity.Execution{}).Where("id IN ?", ids).Update("status", status)
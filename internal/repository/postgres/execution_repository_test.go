@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRepository_Orphaned(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+
+	repo := NewExecutionRepository(db)
+	now := time.Now().UTC()
+	long := now.Add(-time.Hour)
+	create := func(worker string, status entity.ExecutionStatus, heartbeatAt *time.Time) *entity.Execution {
+		execution := &entity.Execution{TaskID: task.ID, Worker: worker, Status: status, StartedAt: long, HeartbeatAt: heartbeatAt}
+		require.NoError(t, repo.Create(ctx, execution))
+		return execution
+	}
+	alive := create("impl-1@host-a", entity.ExecutionStatusRunning, &long)
+	crashed := create("impl-2@host-b", entity.ExecutionStatusRunning, &long)
+	restarted := create("general@host-a", entity.ExecutionStatusPending, &now)
+	// Executions created through the API have no worker reporting them
+	create("", entity.ExecutionStatusRunning, nil)
+	create("impl-2@host-b", entity.ExecutionStatusFailed, &long)
+
+	require.NoError(t, repo.Heartbeat(ctx, "impl-1@host-a", now))
+
+	orphaned, err := repo.GetOrphaned(ctx, "", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{crashed.ID.String()}, executionIDs(orphaned),
+		"executions reported alive recently, finished ones and those without a worker are not orphaned")
+
+	orphaned, err = repo.GetOrphaned(ctx, "general@host-a", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{crashed.ID.String(), restarted.ID.String()}, executionIDs(orphaned),
+		"a restarted worker's executions are orphaned however recent their heartbeat")
+
+	reloaded, err := repo.GetByID(ctx, alive.ID)
+	require.NoError(t, err)
+	require.NotNil(t, reloaded.HeartbeatAt)
+	assert.WithinDuration(t, now, *reloaded.HeartbeatAt, time.Second)
+}
+
+func executionIDs(executions []*entity.Execution) []string {
+	ids := make([]string, len(executions))
+	for i, execution := range executions {
+		ids[i] = execution.ID.String()
+	}
+	return ids
+}
+
+func TestExecutionRepository_WaitingInput(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+
+	repo := NewExecutionRepository(db)
+	long := time.Now().UTC().Add(-time.Hour)
+	execution := &entity.Execution{TaskID: task.ID, Worker: "impl-1@host-a", Status: entity.ExecutionStatusRunning, StartedAt: long, HeartbeatAt: &long}
+	require.NoError(t, repo.Create(ctx, execution))
+
+	answered, err := repo.MarkAnswered(ctx, execution.ID, time.Now().UTC())
+	require.NoError(t, err)
+	assert.False(t, answered, "a running execution has no question to answer")
+
+	require.NoError(t, repo.MarkWaitingInput(ctx, execution.ID, "Keep the old endpoint?", "session-1"))
+	orphaned, err := repo.GetOrphaned(ctx, "impl-1@host-a", time.Now().UTC())
+	require.NoError(t, err)
+	assert.Empty(t, orphaned, "an execution waiting for input is not orphaned")
+
+	now := time.Now().UTC()
+	answered, err = repo.MarkAnswered(ctx, execution.ID, now)
+	require.NoError(t, err)
+	assert.True(t, answered)
+	answered, err = repo.MarkAnswered(ctx, execution.ID, now)
+	require.NoError(t, err)
+	assert.False(t, answered, "an answer is only taken once")
+
+	reloaded, err := repo.GetByID(ctx, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ExecutionStatusPending, reloaded.Status)
+	assert.Equal(t, "Keep the old endpoint?", reloaded.Question)
+	assert.Equal(t, "session-1", reloaded.SessionID)
+	require.NotNil(t, reloaded.HeartbeatAt)
+	assert.WithinDuration(t, now, *reloaded.HeartbeatAt, time.Second)
+}
+
+func TestExecutionRepository_GetAttempts(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+
+	repo := NewExecutionRepository(db)
+	var previous *entity.Execution
+	var chain []*entity.Execution
+	for attempt := 1; attempt <= 3; attempt++ {
+		execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusFailed, StartedAt: time.Now().UTC(),
+			Phase: entity.ExecutionPhaseImplementation, Attempt: attempt}
+		if previous != nil {
+			execution.RetryOfID = &previous.ID
+		}
+		require.NoError(t, repo.Create(ctx, execution))
+		chain = append(chain, execution)
+		previous = execution
+	}
+	unrelated := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusFailed, StartedAt: time.Now().UTC()}
+	require.NoError(t, repo.Create(ctx, unrelated))
+
+	for _, execution := range chain {
+		attempts, err := repo.GetAttempts(ctx, execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, executionIDs(chain), executionIDs(attempts), "every attempt of the chain returns the whole chain, in order")
+	}
+
+	retries, err := repo.GetRetries(ctx, chain[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{chain[1].ID.String()}, executionIDs(retries))
+
+	attempts, err := repo.GetAttempts(ctx, unrelated.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{unrelated.ID.String()}, executionIDs(attempts))
+}
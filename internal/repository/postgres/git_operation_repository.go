@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+)
+
+type gitOperationRepository struct {
+	db *database.GormDB
+}
+
+// NewGitOperationRepository creates a new PostgreSQL git operation repository
+func NewGitOperationRepository(db *database.GormDB) repository.GitOperationRepository {
+	return &gitOperationRepository{db: db}
+}
+
+// Create saves the audit record of a git operation
+func (r *gitOperationRepository) Create(ctx context.Context, operation *entity.GitOperation) error {
+	if operation.ID == uuid.Nil {
+		operation.ID = uuid.New()
+	}
+
+	if err := r.db.WithContext(ctx).Create(operation).Error; err != nil {
+		return fmt.Errorf("failed to create git operation: %w", err)
+	}
+
+	return nil
+}
+
+// ListByTaskID retrieves the git operations done for a task, oldest first
+func (r *gitOperationRepository) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error) {
+	var operations []*entity.GitOperation
+
+	result := r.db.WithContext(ctx).
+		Where("task_id = ?", taskID).
+		Order("created_at ASC").
+		Find(&operations)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list git operations: %w", result.Error)
+	}
+
+	return operations, nil
+}
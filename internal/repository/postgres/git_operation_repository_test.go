@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitOperationRepository_ListByTaskID(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewGitOperationRepository(db)
+
+	taskID, otherTaskID := uuid.New(), uuid.New()
+	operations := []*entity.GitOperation{
+		{TaskID: &taskID, Operation: entity.GitOperationWorktreeCreate, Actor: "job:worktree:create", WorkingDir: "/repo", Target: "/worktrees/task", Succeeded: true},
+		{TaskID: &otherTaskID, Operation: entity.GitOperationCommit, Actor: "system", WorkingDir: "/worktrees/other", Succeeded: true},
+		{TaskID: &taskID, Operation: entity.GitOperationPush, Actor: "job:task:implementation", WorkingDir: "/worktrees/task", Target: "origin/task-branch", Error: "rejected"},
+	}
+	for _, operation := range operations {
+		require.NoError(t, repo.Create(ctx, operation))
+	}
+
+	listed, err := repo.ListByTaskID(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, entity.GitOperationWorktreeCreate, listed[0].Operation)
+	assert.Equal(t, entity.GitOperationPush, listed[1].Operation)
+	assert.False(t, listed[1].Succeeded)
+	assert.Equal(t, "rejected", listed[1].Error)
+}
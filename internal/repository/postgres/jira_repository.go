@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type jiraIntegrationRepository struct {
+	db *database.GormDB
+}
+
+// NewJiraIntegrationRepository creates a new PostgreSQL Jira integration repository
+func NewJiraIntegrationRepository(db *database.GormDB) repository.JiraIntegrationRepository {
+	return &jiraIntegrationRepository{db: db}
+}
+
+// scoped returns a query restricted to the integrations of the
+// organization carried by ctx
+func (r *jiraIntegrationRepository) scoped(ctx context.Context) *gorm.DB {
+	return scopeByProject(ctx, r.db.WithContext(ctx), "jira_integrations.project_id")
+}
+
+// GetByProjectID retrieves a project's integration, returning nil when the
+// project has none
+func (r *jiraIntegrationRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (*entity.JiraIntegration, error) {
+	var integration entity.JiraIntegration
+
+	result := r.scoped(ctx).First(&integration, "project_id = ?", projectID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get jira integration: %w", result.Error)
+	}
+
+	return &integration, nil
+}
+
+// Save creates or updates an integration
+func (r *jiraIntegrationRepository) Save(ctx context.Context, integration *entity.JiraIntegration) error {
+	if integration.ID == uuid.Nil {
+		integration.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Save(integration)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save jira integration: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete removes a project's integration
+func (r *jiraIntegrationRepository) Delete(ctx context.Context, projectID uuid.UUID) error {
+	result := r.scoped(ctx).Delete(&entity.JiraIntegration{}, "project_id = ?", projectID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete jira integration: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("jira integration not found for project %s", projectID)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobMetricRepository struct {
+	db *database.GormDB
+}
+
+// NewJobMetricRepository creates a new PostgreSQL job metric repository
+func NewJobMetricRepository(db *database.GormDB) repository.JobMetricRepository {
+	return &jobMetricRepository{db: db}
+}
+
+// Record inserts the day's rollup of the job type or adds the run to it, in
+// one statement so concurrent workers do not lose runs
+func (r *jobMetricRepository) Record(ctx context.Context, day time.Time, jobType string, duration time.Duration, failed bool) error {
+	metric := &entity.JobMetric{
+		ID:              uuid.New(),
+		Day:             day,
+		JobType:         jobType,
+		Processed:       1,
+		TotalDurationMs: duration.Milliseconds(),
+		MaxDurationMs:   duration.Milliseconds(),
+	}
+	if failed {
+		metric.Failed = 1
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "day"}, {Name: "job_type"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"processed":         gorm.Expr("job_metrics.processed + excluded.processed"),
+			"failed":            gorm.Expr("job_metrics.failed + excluded.failed"),
+			"total_duration_ms": gorm.Expr("job_metrics.total_duration_ms + excluded.total_duration_ms"),
+			"max_duration_ms":   gorm.Expr("CASE WHEN excluded.max_duration_ms > job_metrics.max_duration_ms THEN excluded.max_duration_ms ELSE job_metrics.max_duration_ms END"),
+			"updated_at":        gorm.Expr("excluded.updated_at"),
+		}),
+	}).Create(metric)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record job metric: %w", result.Error)
+	}
+
+	return nil
+}
+
+// List retrieves the rollups of the days in [from, to), of jobType only
+// when set, oldest first
+func (r *jobMetricRepository) List(ctx context.Context, from, to time.Time, jobType string) ([]*entity.JobMetric, error) {
+	var metrics []*entity.JobMetric
+
+	query := r.db.WithContext(ctx).Where("day >= ? AND day < ?", from, to)
+	if jobType != "" {
+		query = query.Where("job_type = ?", jobType)
+	}
+	result := query.Order("day ASC, job_type ASC").Find(&metrics)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list job metrics: %w", result.Error)
+	}
+
+	return metrics, nil
+}
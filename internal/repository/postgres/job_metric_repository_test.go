@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobMetricRepository_Record(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	repo := NewJobMetricRepository(db)
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Record(ctx, day, "task:planning", 2*time.Second, false))
+	require.NoError(t, repo.Record(ctx, day, "task:planning", 5*time.Second, true))
+	require.NoError(t, repo.Record(ctx, day, "task:planning", time.Second, false))
+	require.NoError(t, repo.Record(ctx, day, "pr:status_sync", 300*time.Millisecond, false))
+	require.NoError(t, repo.Record(ctx, day.AddDate(0, 0, -1), "task:planning", time.Second, false))
+
+	metrics, err := repo.List(ctx, day, day.AddDate(0, 0, 1), "")
+	require.NoError(t, err)
+	require.Len(t, metrics, 2, "runs of a type on the same day share a rollup")
+	assert.Equal(t, "pr:status_sync", metrics[0].JobType)
+	planning := metrics[1]
+	assert.Equal(t, int64(3), planning.Processed)
+	assert.Equal(t, int64(1), planning.Failed)
+	assert.Equal(t, int64(8000), planning.TotalDurationMs)
+	assert.Equal(t, int64(5000), planning.MaxDurationMs)
+
+	metrics, err = repo.List(ctx, day.AddDate(0, 0, -1), day.AddDate(0, 0, 1), "task:planning")
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+	assert.True(t, metrics[0].Day.Equal(day.AddDate(0, 0, -1)), "oldest first")
+}
@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type organizationRepository struct {
+	db *database.GormDB
+}
+
+// NewOrganizationRepository creates a new PostgreSQL organization repository
+func NewOrganizationRepository(db *database.GormDB) repository.OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+// Create creates a new organization
+func (r *organizationRepository) Create(ctx context.Context, org *entity.Organization) error {
+	if org.ID == uuid.Nil {
+		org.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(org)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create organization: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an organization by ID
+func (r *organizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	var org entity.Organization
+
+	result := r.db.WithContext(ctx).First(&org, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("organization not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", result.Error)
+	}
+
+	return &org, nil
+}
+
+// GetBySlug retrieves an organization by its slug
+func (r *organizationRepository) GetBySlug(ctx context.Context, slug string) (*entity.Organization, error) {
+	var org entity.Organization
+
+	result := r.db.WithContext(ctx).First(&org, "slug = ?", slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("organization not found with slug %s", slug)
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", result.Error)
+	}
+
+	return &org, nil
+}
+
+// GetAll retrieves all organizations ordered by name
+func (r *organizationRepository) GetAll(ctx context.Context) ([]*entity.Organization, error) {
+	var orgs []*entity.Organization
+
+	result := r.db.WithContext(ctx).Order("name ASC").Find(&orgs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get organizations: %w", result.Error)
+	}
+
+	return orgs, nil
+}
+
+// Update updates an existing organization
+func (r *organizationRepository) Update(ctx context.Context, org *entity.Organization) error {
+	result := r.db.WithContext(ctx).Save(org)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update organization: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete deletes an organization by ID (soft delete)
+func (r *organizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Organization{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete organization: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("organization not found with id %s", id)
+	}
+
+	return nil
+}
+
+// Count returns how many organizations there are
+func (r *organizationRepository) Count(ctx context.Context) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entity.Organization{}).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count organizations: %w", err)
+	}
+	return count, nil
+}
+
+// AdoptUnassigned moves the projects and users of no organization into the
+// organization
+func (r *organizationRepository) AdoptUnassigned(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Project{}).Where("organization_id IS NULL").Update("organization_id", id).Error; err != nil {
+			return fmt.Errorf("failed to adopt projects: %w", err)
+		}
+		if err := tx.Model(&entity.User{}).Where("organization_id IS NULL").Update("organization_id", id).Error; err != nil {
+			return fmt.Errorf("failed to adopt users: %w", err)
+		}
+		return nil
+	})
+}
+
+// AddUser moves a user of no organization into the organization
+func (r *organizationRepository) AddUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	return r.addUser(ctx, id, "id = ?", userID)
+}
+
+// AddUserByUsername moves the user of no organization with the username
+// into the organization
+func (r *organizationRepository) AddUserByUsername(ctx context.Context, id uuid.UUID, username string) (bool, error) {
+	return r.addUser(ctx, id, "username = ?", username)
+}
+
+func (r *organizationRepository) addUser(ctx context.Context, id uuid.UUID, condition string, value interface{}) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entity.User{}).
+		Where(condition, value).
+		Where("organization_id IS NULL").
+		Update("organization_id", id)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to add user to organization: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type planApprovalRepository struct {
+	db *database.GormDB
+}
+
+// NewPlanApprovalRepository creates a new PostgreSQL plan approval repository
+func NewPlanApprovalRepository(db *database.GormDB) repository.PlanApprovalRepository {
+	return &planApprovalRepository{db: db}
+}
+
+// Create inserts the approval unless the approver already approved the plan
+func (r *planApprovalRepository) Create(ctx context.Context, approval *entity.PlanApproval) error {
+	if approval.ID == uuid.Nil {
+		approval.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "plan_id"}, {Name: "approver"}},
+		DoNothing: true,
+	}).Create(approval)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create plan approval: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ListByPlanID retrieves the approvals of a plan, oldest first
+func (r *planApprovalRepository) ListByPlanID(ctx context.Context, planID uuid.UUID) ([]*entity.PlanApproval, error) {
+	var approvals []*entity.PlanApproval
+	result := r.db.WithContext(ctx).
+		Where("plan_id = ?", planID).
+		Order("created_at ASC").
+		Find(&approvals)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list plan approvals: %w", result.Error)
+	}
+
+	return approvals, nil
+}
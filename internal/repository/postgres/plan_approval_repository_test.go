@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanApprovalRepository_CreateList(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewPlanApprovalRepository(db)
+	taskID, planID := uuid.New(), uuid.New()
+
+	approve := func(planID uuid.UUID, approver string) {
+		t.Helper()
+		require.NoError(t, repo.Create(ctx, &entity.PlanApproval{TaskID: taskID, PlanID: planID, Approver: approver, Method: entity.PlanApprovalAPIKey}))
+	}
+	approve(planID, "alice")
+	approve(planID, "alice")
+	approve(planID, "bob")
+	approve(uuid.New(), "carol")
+
+	approvals, err := repo.ListByPlanID(ctx, planID)
+	require.NoError(t, err)
+	require.Len(t, approvals, 2, "an approver is recorded once per plan")
+	assert.Equal(t, "alice", approvals[0].Approver)
+	assert.Equal(t, "bob", approvals[1].Approver)
+}
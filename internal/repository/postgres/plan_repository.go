@@ -42,11 +42,17 @@ func (r *planRepository) Create(ctx context.Context, plan *entity.Plan) error {
 	return nil
 }
 
+// scoped returns a query restricted to the plans of the organization
+// carried by ctx
+func (r *planRepository) scoped(ctx context.Context) *gorm.DB {
+	return scopeByTask(ctx, r.db.WithContext(ctx), "plans.task_id")
+}
+
 // GetByID retrieves a plan by ID
 func (r *planRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Plan, error) {
 	var plan entity.Plan
 
-	result := r.db.WithContext(ctx).Preload("Task").First(&plan, "id = ?", id)
+	result := r.scoped(ctx).Preload("Task").First(&plan, "id = ?", id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("plan not found with id %s", id)
@@ -61,7 +67,7 @@ func (r *planRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Pla
 func (r *planRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.Plan, error) {
 	var plan entity.Plan
 
-	result := r.db.WithContext(ctx).Preload("Task").Where("task_id = ?", taskID).First(&plan)
+	result := r.scoped(ctx).Preload("Task").Where("task_id = ?", taskID).Order("created_at DESC").First(&plan)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("plan not found for task %s", taskID)
@@ -139,11 +145,28 @@ func (r *planRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status
 	return nil
 }
 
+// Reject marks a plan REJECTED and keeps the reviewer's feedback
+func (r *planRepository) Reject(ctx context.Context, id uuid.UUID, feedback string) error {
+	result := r.db.WithContext(ctx).Model(&entity.Plan{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":   entity.PlanStatusREJECTED,
+		"feedback": feedback,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to reject plan: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("plan not found with id %s", id)
+	}
+
+	return nil
+}
+
 // ListByProjectID retrieves all plans for a specific project
 func (r *planRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Plan, error) {
 	var plans []entity.Plan
 
-	result := r.db.WithContext(ctx).
+	result := r.scoped(ctx).
 		Preload("Task").
 		Joins("JOIN tasks ON plans.task_id = tasks.id").
 		Where("tasks.project_id = ?", projectID).
@@ -167,7 +190,7 @@ func (r *planRepository) ListByProjectID(ctx context.Context, projectID uuid.UUI
 func (r *planRepository) ListByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.Plan, error) {
 	var plans []entity.Plan
 
-	result := r.db.WithContext(ctx).Preload("Task").Where("task_id IN ?", taskIDs).Order("created_at DESC").Find(&plans)
+	result := r.scoped(ctx).Preload("Task").Where("task_id IN ?", taskIDs).Order("created_at DESC").Find(&plans)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get plans by task IDs: %w", result.Error)
 	}
@@ -185,7 +208,7 @@ func (r *planRepository) ListByTaskIDs(ctx context.Context, taskIDs []uuid.UUID)
 func (r *planRepository) GetLatestByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.Plan, error) {
 	var plan entity.Plan
 
-	result := r.db.WithContext(ctx).
+	result := r.scoped(ctx).
 		Preload("Task").
 		Where("task_id = ?", taskID).
 		Order("created_at DESC").
@@ -219,8 +242,14 @@ func (r *planRepository) UpdateContent(ctx context.Context, id uuid.UUID, conten
 func (r *planRepository) SearchByContent(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.Plan, error) {
 	searchQuery := r.db.WithContext(ctx).
 		Model(&entity.Plan{}).
-		Preload("Task").
-		Where("to_tsvector('english', content) @@ plainto_tsquery('english', ?)", query)
+		Preload("Task")
+
+	if database.IsSQLite(r.db.DB) {
+		// No full-text search on SQLite; fall back to substring matching
+		searchQuery = searchQuery.Where("content LIKE ?", "%"+query+"%")
+	} else {
+		searchQuery = searchQuery.Where("to_tsvector('english', content) @@ plainto_tsquery('english', ?)", query)
+	}
 
 	if projectID != nil {
 		searchQuery = searchQuery.
@@ -342,6 +371,47 @@ func (r *planRepository) RestoreVersion(ctx context.Context, planID uuid.UUID, v
 	})
 }
 
+// Revise replaces the content of a plan and records it as its next version
+func (r *planRepository) Revise(ctx context.Context, planID uuid.UUID, content string, editedBy string) (*entity.PlanVersion, error) {
+	var version *entity.PlanVersion
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Plan{}).Where("id = ?", planID).Update("content", content)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update plan content: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("plan not found with id %s", planID)
+		}
+
+		// The first version holds the generated content, recorded when the
+		// plan was created
+		var maxVersion int
+		if err := tx.Model(&entity.PlanVersion{}).
+			Where("plan_id = ?", planID).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&maxVersion).Error; err != nil {
+			return fmt.Errorf("failed to get max version: %w", err)
+		}
+
+		version = &entity.PlanVersion{
+			ID:        uuid.New(),
+			PlanID:    planID,
+			Version:   maxVersion + 1,
+			Content:   content,
+			CreatedBy: editedBy,
+		}
+		if err := tx.Create(version).Error; err != nil {
+			return fmt.Errorf("failed to create plan version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
 // CompareVersions compares two versions of a plan
 func (r *planRepository) CompareVersions(ctx context.Context, planID uuid.UUID, fromVersion, toVersion int) (*entity.PlanVersionComparison, error) {
 	// Get both versions
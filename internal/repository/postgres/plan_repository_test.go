@@ -248,6 +248,81 @@ func TestPlanRepository_CreateVersion(t *testing.T) {
 	assert.Equal(t, "test-user", version.CreatedBy)
 }
 
+func TestPlanRepository_Revise(t *testing.T) {
+	db := SetupTestDB(t)
+	defer TeardownTestDB()
+
+	repo := NewPlanRepository(db)
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	project := CreateTestProject(t, projectRepo, ctx)
+	task := CreateTestTask(t, taskRepo, project.ID, ctx)
+	plan := &entity.Plan{
+		TaskID:  task.ID,
+		Status:  entity.PlanStatusREVIEWING,
+		Content: "# Initial Plan",
+	}
+	require.NoError(t, repo.Create(ctx, plan))
+
+	version, err := repo.Revise(ctx, plan.ID, "# Edited Plan", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 2, version.Version)
+	assert.Equal(t, "alice", version.CreatedBy)
+
+	revised, err := repo.GetByID(ctx, plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "# Edited Plan", revised.Content)
+
+	versions, err := repo.GetVersions(ctx, plan.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "# Initial Plan", versions[0].Content)
+
+	_, err = repo.Revise(ctx, uuid.New(), "# Edited Plan", "alice")
+	assert.Error(t, err)
+}
+
+func TestPlanRepository_Reject(t *testing.T) {
+	db := SetupTestDB(t)
+	defer TeardownTestDB()
+
+	repo := NewPlanRepository(db)
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	project := CreateTestProject(t, projectRepo, ctx)
+	task := CreateTestTask(t, taskRepo, project.ID, ctx)
+	plan := &entity.Plan{
+		TaskID:  task.ID,
+		Status:  entity.PlanStatusREVIEWING,
+		Content: "# Initial Plan",
+	}
+	require.NoError(t, repo.Create(ctx, plan))
+
+	require.NoError(t, repo.Reject(ctx, plan.ID, "Reuse the session store"))
+	rejected, err := repo.GetByID(ctx, plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PlanStatusREJECTED, rejected.Status)
+	require.NotNil(t, rejected.Feedback)
+	assert.Equal(t, "Reuse the session store", *rejected.Feedback)
+
+	// The task is planned again next to the rejected plan
+	replanned := &entity.Plan{
+		TaskID:  task.ID,
+		Status:  entity.PlanStatusREVIEWING,
+		Content: "# Second Plan",
+	}
+	require.NoError(t, repo.Create(ctx, replanned))
+	latest, err := repo.GetByTaskID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, replanned.ID, latest.ID)
+
+	assert.Error(t, repo.Reject(ctx, uuid.New(), "Reuse the session store"))
+}
+
 func TestPlanRepository_GetVersions(t *testing.T) {
 	db := SetupTestDB(t)
 	defer TeardownTestDB()
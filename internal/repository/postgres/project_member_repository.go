@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type projectMemberRepository struct {
+	db *database.GormDB
+}
+
+// NewProjectMemberRepository creates a new PostgreSQL project member repository
+func NewProjectMemberRepository(db *database.GormDB) repository.ProjectMemberRepository {
+	return &projectMemberRepository{db: db}
+}
+
+// Save adds the member to the project, or changes their role
+func (r *projectMemberRepository) Save(ctx context.Context, member *entity.ProjectMember) error {
+	result := r.db.WithContext(ctx).
+		Omit("User").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+		}).
+		Create(member)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save project member: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Get retrieves the user's membership of the project, nil when there is none
+func (r *projectMemberRepository) Get(ctx context.Context, projectID, userID uuid.UUID) (*entity.ProjectMember, error) {
+	var member entity.ProjectMember
+
+	result := r.db.WithContext(ctx).First(&member, "project_id = ? AND user_id = ?", projectID, userID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get project member: %w", result.Error)
+	}
+
+	return &member, nil
+}
+
+// ListByProject retrieves the project's members with their user
+func (r *projectMemberRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectMember, error) {
+	var members []*entity.ProjectMember
+
+	result := r.db.WithContext(ctx).
+		Preload("User").
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&members)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list project members: %w", result.Error)
+	}
+
+	return members, nil
+}
+
+// CountByProject counts the project's members, only those with role unless
+// it is ""
+func (r *projectMemberRepository) CountByProject(ctx context.Context, projectID uuid.UUID, role entity.ProjectRole) (int64, error) {
+	var count int64
+
+	query := r.db.WithContext(ctx).Model(&entity.ProjectMember{}).Where("project_id = ?", projectID)
+	if role != "" {
+		query = query.Where("role = ?", role)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count project members: %w", err)
+	}
+
+	return count, nil
+}
+
+// Delete removes the user from the project's members
+func (r *projectMemberRepository) Delete(ctx context.Context, projectID, userID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entity.ProjectMember{}, "project_id = ? AND user_id = ?", projectID, userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete project member: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("project member not found with user id %s", userID)
+	}
+
+	return nil
+}
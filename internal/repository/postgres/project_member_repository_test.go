@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectMemberRepository(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewProjectMemberRepository(db)
+	projectRepo := NewProjectRepository(db)
+	userRepo := NewUserRepository(db)
+
+	restricted := &entity.Project{Name: "Restricted"}
+	open := &entity.Project{Name: "Open"}
+	require.NoError(t, projectRepo.Create(ctx, restricted))
+	require.NoError(t, projectRepo.Create(ctx, open))
+	alice := &entity.User{Username: "alice", PasswordHash: "hash"}
+	bob := &entity.User{Username: "bob", PasswordHash: "hash"}
+	require.NoError(t, userRepo.Create(ctx, alice))
+	require.NoError(t, userRepo.Create(ctx, bob))
+
+	require.NoError(t, repo.Save(ctx, &entity.ProjectMember{ProjectID: restricted.ID, UserID: alice.ID, Role: entity.ProjectRoleViewer}))
+	require.NoError(t, repo.Save(ctx, &entity.ProjectMember{ProjectID: restricted.ID, UserID: alice.ID, Role: entity.ProjectRoleOwner}), "saving again changes the role")
+
+	member, err := repo.Get(ctx, restricted.ID, alice.ID)
+	require.NoError(t, err)
+	require.NotNil(t, member)
+	assert.Equal(t, entity.ProjectRoleOwner, member.Role)
+	member, err = repo.Get(ctx, restricted.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Nil(t, member)
+
+	members, err := repo.ListByProject(ctx, restricted.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.NotNil(t, members[0].User)
+	assert.Equal(t, "alice", members[0].User.Username)
+
+	count, err := repo.CountByProject(ctx, restricted.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	count, err = repo.CountByProject(ctx, restricted.ID, entity.ProjectRoleViewer)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	visibleTo := func(userID uuid.UUID) []string {
+		projects, _, err := projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{SortBy: "name", SortOrder: "asc", MemberID: &userID})
+		require.NoError(t, err)
+		names := make([]string, len(projects))
+		for i, project := range projects {
+			names[i] = project.Name
+		}
+		return names
+	}
+	assert.Equal(t, []string{"Restricted"}, visibleTo(alice.ID))
+	assert.Empty(t, visibleTo(bob.ID), "projects are listed only to their members")
+
+	assert.Error(t, repo.Delete(ctx, restricted.ID, bob.ID))
+	require.NoError(t, repo.Delete(ctx, restricted.ID, alice.ID))
+	assert.Empty(t, visibleTo(alice.ID))
+}
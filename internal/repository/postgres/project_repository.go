@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
@@ -22,6 +23,16 @@ func NewProjectRepository(db *database.GormDB) repository.ProjectRepository {
 	return &projectRepository{db: db}
 }
 
+// scoped returns a query restricted to the organization carried by ctx.
+// Requests without an organization see every project.
+func (r *projectRepository) scoped(ctx context.Context) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok {
+		query = query.Where("projects.organization_id = ?", orgID)
+	}
+	return query
+}
+
 // Create creates a new project
 func (r *projectRepository) Create(ctx context.Context, project *entity.Project) error {
 	// Generate UUID if not provided
@@ -29,6 +40,11 @@ func (r *projectRepository) Create(ctx context.Context, project *entity.Project)
 		project.ID = uuid.New()
 	}
 
+	// New projects belong to the organization the request is scoped to
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok && project.OrganizationID == nil {
+		project.OrganizationID = &orgID
+	}
+
 	result := r.db.WithContext(ctx).Create(project)
 	if result.Error != nil {
 		return fmt.Errorf("failed to create project: %w", result.Error)
@@ -41,7 +57,7 @@ func (r *projectRepository) Create(ctx context.Context, project *entity.Project)
 func (r *projectRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Project, error) {
 	var project entity.Project
 
-	result := r.db.WithContext(ctx).First(&project, "id = ?", id)
+	result := r.scoped(ctx).First(&project, "id = ?", id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("project not found with id %s", id)
@@ -52,13 +68,35 @@ func (r *projectRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 	return &project, nil
 }
 
+// GetByIDs retrieves several projects in one query; unknown IDs are skipped
+func (r *projectRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Project, error) {
+	var projects []*entity.Project
+
+	result := r.scoped(ctx).Where("id IN ?", ids).Find(&projects)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", result.Error)
+	}
+
+	return projects, nil
+}
 
+// GetByRepositoryURLs retrieves the projects whose repository URL is one of urls
+func (r *projectRepository) GetByRepositoryURLs(ctx context.Context, urls []string) ([]*entity.Project, error) {
+	var projects []*entity.Project
+
+	result := r.scoped(ctx).Where("repository_url IN ?", urls).Find(&projects)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get projects by repository URL: %w", result.Error)
+	}
+
+	return projects, nil
+}
 
 // Update updates an existing project
 func (r *projectRepository) Update(ctx context.Context, project *entity.Project) error {
 	// First check if project exists
 	var existingProject entity.Project
-	result := r.db.WithContext(ctx).First(&existingProject, "id = ?", project.ID)
+	result := r.scoped(ctx).First(&existingProject, "id = ?", project.ID)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return fmt.Errorf("project not found with id %s", project.ID)
@@ -77,7 +115,7 @@ func (r *projectRepository) Update(ctx context.Context, project *entity.Project)
 
 // Delete deletes a project by ID (soft delete)
 func (r *projectRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&entity.Project{}, "id = ?", id)
+	result := r.scoped(ctx).Delete(&entity.Project{}, "id = ?", id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete project: %w", result.Error)
 	}
@@ -96,7 +134,7 @@ func (r *projectRepository) GetAllWithParams(ctx context.Context, params reposit
 	var projects []entity.Project
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Project{})
+	query := r.scoped(ctx).Model(&entity.Project{})
 
 	// Apply archived filter
 	if params.Archived != nil {
@@ -107,10 +145,14 @@ func (r *projectRepository) GetAllWithParams(ctx context.Context, params reposit
 		}
 	}
 
+	if params.MemberID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM project_members WHERE project_members.project_id = projects.id AND project_members.user_id = ?)", *params.MemberID)
+	}
+
 	// Apply search filter
 	if params.Search != "" {
 		searchPattern := "%" + params.Search + "%"
-		query = query.Where("name ILIKE ? OR description ILIKE ?", searchPattern, searchPattern)
+		query = query.Where(ilike(r.db.DB, "name")+" OR "+ilike(r.db.DB, "description"), searchPattern, searchPattern)
 	}
 
 	// Get total count
@@ -255,9 +297,216 @@ func (r *projectRepository) GetLastActivityAt(ctx context.Context, projectID uui
 	return &lastActivity.Time, nil
 }
 
+// projectActivityQuery unions what happened to the project's tasks into
+// rows of the same shape, leaving the columns an entry type has no use for
+// NULL
+const projectActivityQuery = `
+	SELECT 'task_created' AS type, t.id AS task_id, t.title AS task_title, t.created_at AS occurred_at,
+		NULL AS actor, NULL AS from_status, NULL AS to_status, NULL AS reason, NULL AS plan_id,
+		NULL AS execution_id, NULL AS execution_status, NULL AS ai_type, NULL AS pr_number, NULL AS pr_url
+	FROM tasks t
+	WHERE t.project_id = @project AND t.deleted_at IS NULL
+	UNION ALL
+	SELECT 'status_changed', t.id, t.title, h.created_at,
+		h.changed_by, h.from_status, h.to_status, h.reason, NULL,
+		NULL, NULL, NULL, NULL, NULL
+	FROM task_status_histories h
+	JOIN tasks t ON t.id = h.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND h.deleted_at IS NULL
+	UNION ALL
+	SELECT 'plan_approved', t.id, t.title, a.created_at,
+		a.approver, NULL, NULL, NULL, a.plan_id,
+		NULL, NULL, NULL, NULL, NULL
+	FROM plan_approvals a
+	JOIN tasks t ON t.id = a.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL
+	UNION ALL
+	SELECT 'execution_started', t.id, t.title, e.started_at,
+		NULL, NULL, NULL, NULL, NULL,
+		e.id, e.status, e.ai_type, NULL, NULL
+	FROM executions e
+	JOIN tasks t ON t.id = e.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND e.deleted_at IS NULL
+	UNION ALL
+	SELECT 'execution_finished', t.id, t.title, e.completed_at,
+		NULL, NULL, NULL, NULL, NULL,
+		e.id, e.status, e.ai_type, NULL, NULL
+	FROM executions e
+	JOIN tasks t ON t.id = e.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND e.deleted_at IS NULL AND e.completed_at IS NOT NULL
+	UNION ALL
+	SELECT 'pr_opened', t.id, t.title, p.created_at,
+		p.created_by, NULL, NULL, NULL, NULL,
+		NULL, NULL, NULL, p.github_pr_number, p.github_url
+	FROM pull_requests p
+	JOIN tasks t ON t.id = p.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND p.deleted_at IS NULL
+	UNION ALL
+	SELECT 'pr_merged', t.id, t.title, p.merged_at,
+		p.merged_by, NULL, NULL, NULL, NULL,
+		NULL, NULL, NULL, p.github_pr_number, p.github_url
+	FROM pull_requests p
+	JOIN tasks t ON t.id = p.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND p.deleted_at IS NULL AND p.merged_at IS NOT NULL
+	UNION ALL
+	SELECT 'pr_closed', t.id, t.title, p.closed_at,
+		NULL, NULL, NULL, NULL, NULL,
+		NULL, NULL, NULL, p.github_pr_number, p.github_url
+	FROM pull_requests p
+	JOIN tasks t ON t.id = p.task_id
+	WHERE t.project_id = @project AND t.deleted_at IS NULL AND p.deleted_at IS NULL AND p.status = 'CLOSED' AND p.closed_at IS NOT NULL
+`
+
+// GetActivity reads the project's activity feed, most recent first
+func (r *projectRepository) GetActivity(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]*entity.ProjectActivity, int, error) {
+	project := sql.Named("project", projectID)
+
+	var total int64
+	if err := r.db.WithContext(ctx).Raw(`SELECT COUNT(*) FROM (`+projectActivityQuery+`) activity`, project).Scan(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count project activity: %w", err)
+	}
+
+	query := `SELECT * FROM (` + projectActivityQuery + `) activity ORDER BY occurred_at DESC, type, task_id`
+	args := []interface{}{project}
+	if limit > 0 {
+		query += ` LIMIT @limit OFFSET @offset`
+		args = append(args, sql.Named("limit", limit), sql.Named("offset", offset))
+	}
+
+	var rows []struct {
+		Type            entity.ProjectActivityType
+		TaskID          uuid.UUID
+		TaskTitle       string
+		OccurredAt      time.Time
+		Actor           *string
+		FromStatus      *entity.TaskStatus
+		ToStatus        *entity.TaskStatus
+		Reason          *string
+		PlanID          *uuid.UUID
+		ExecutionID     *uuid.UUID
+		ExecutionStatus *entity.ExecutionStatus
+		AIType          *string `gorm:"column:ai_type"`
+		PRNumber        *int    `gorm:"column:pr_number"`
+		PRURL           *string `gorm:"column:pr_url"`
+	}
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to get project activity: %w", err)
+	}
+
+	activity := make([]*entity.ProjectActivity, len(rows))
+	for i, row := range rows {
+		activity[i] = &entity.ProjectActivity{
+			Type:              row.Type,
+			TaskID:            row.TaskID,
+			TaskTitle:         row.TaskTitle,
+			OccurredAt:        row.OccurredAt,
+			Actor:             row.Actor,
+			FromStatus:        row.FromStatus,
+			ToStatus:          row.ToStatus,
+			Reason:            row.Reason,
+			PlanID:            row.PlanID,
+			ExecutionID:       row.ExecutionID,
+			ExecutionStatus:   row.ExecutionStatus,
+			AIType:            row.AIType,
+			PullRequestNumber: row.PRNumber,
+			PullRequestURL:    row.PRURL,
+		}
+	}
+	return activity, int(total), nil
+}
+
+// searchQueries find each type of quick search result in rows of the same
+// shape. @tenant is replaced by the condition on the project's organization.
+var searchQueries = []string{`
+	SELECT 'project' AS type, p.id, p.id AS project_id, p.name AS project_name, NULL AS task_id, NULL AS task_number,
+		p.name AS title, COALESCE(p.description, '') AS text, '' AS status, NULL AS pr_number, p.updated_at
+	FROM projects p
+	WHERE p.deleted_at IS NULL @tenant AND (LOWER(p.name) LIKE @pattern ESCAPE '\' OR LOWER(COALESCE(p.description, '')) LIKE @pattern ESCAPE '\')`, `
+	SELECT 'task' AS type, t.id, p.id AS project_id, p.name AS project_name, t.id AS task_id, t.number AS task_number,
+		t.title, COALESCE(t.description, '') AS text, t.status, NULL AS pr_number, t.updated_at
+	FROM tasks t
+	JOIN projects p ON p.id = t.project_id
+	WHERE t.deleted_at IS NULL AND NOT t.is_archived AND p.deleted_at IS NULL @tenant
+		AND (LOWER(t.title) LIKE @pattern ESCAPE '\' OR LOWER(COALESCE(t.description, '')) LIKE @pattern ESCAPE '\' OR t.number = @number)`, `
+	SELECT 'plan' AS type, pl.id, p.id AS project_id, p.name AS project_name, t.id AS task_id, t.number AS task_number,
+		t.title, pl.content AS text, pl.status, NULL AS pr_number, pl.updated_at
+	FROM plans pl
+	JOIN tasks t ON t.id = pl.task_id
+	JOIN projects p ON p.id = t.project_id
+	WHERE pl.deleted_at IS NULL AND t.deleted_at IS NULL AND NOT t.is_archived AND p.deleted_at IS NULL @tenant
+		AND (LOWER(pl.content) LIKE @pattern ESCAPE '\' OR t.number = @number)`, `
+	SELECT 'pull_request' AS type, pr.id, p.id AS project_id, p.name AS project_name, t.id AS task_id, t.number AS task_number,
+		pr.title, COALESCE(pr.body, '') AS text, pr.status, pr.github_pr_number AS pr_number, pr.updated_at
+	FROM pull_requests pr
+	JOIN tasks t ON t.id = pr.task_id
+	JOIN projects p ON p.id = t.project_id
+	WHERE pr.deleted_at IS NULL AND t.deleted_at IS NULL AND NOT t.is_archived AND p.deleted_at IS NULL @tenant
+		AND (LOWER(pr.title) LIKE @pattern ESCAPE '\' OR LOWER(COALESCE(pr.body, '')) LIKE @pattern ESCAPE '\' OR t.number = @number)`,
+}
+
+// likeEscaper escapes the LIKE wildcards of a search query, for patterns
+// declared with ESCAPE '\'
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search finds the results of each type with its own query, so that one
+// type cannot crowd the others out of the limit
+func (r *projectRepository) Search(ctx context.Context, params repository.SearchParams) ([]*entity.SearchResult, error) {
+	tenant := ""
+	args := []interface{}{
+		sql.Named("pattern", "%"+likeEscaper.Replace(strings.ToLower(params.Query))+"%"),
+		// Task numbers start at 1, so -1 matches no task
+		sql.Named("number", int64(-1)),
+		sql.Named("limit", params.Limit),
+	}
+	if params.TaskNumber != nil {
+		args[1] = sql.Named("number", *params.TaskNumber)
+	}
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok {
+		tenant = "AND p.organization_id = @org"
+		args = append(args, sql.Named("org", orgID))
+	}
+
+	var results []*entity.SearchResult
+	for _, query := range searchQueries {
+		query = `SELECT * FROM (` + strings.Replace(query, "@tenant", tenant, 1) + `) results ORDER BY updated_at DESC LIMIT @limit`
+		var rows []struct {
+			Type        entity.SearchResultType
+			ID          uuid.UUID
+			ProjectID   uuid.UUID
+			ProjectName string
+			TaskID      *uuid.UUID
+			TaskNumber  *int64
+			Title       string
+			Text        string
+			Status      string
+			PRNumber    *int `gorm:"column:pr_number"`
+			UpdatedAt   time.Time
+		}
+		if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+			return nil, fmt.Errorf("failed to search: %w", err)
+		}
+		for _, row := range rows {
+			results = append(results, &entity.SearchResult{
+				Type:              row.Type,
+				ID:                row.ID,
+				ProjectID:         row.ProjectID,
+				ProjectName:       row.ProjectName,
+				TaskID:            row.TaskID,
+				TaskNumber:        row.TaskNumber,
+				Title:             row.Title,
+				Text:              row.Text,
+				Status:            row.Status,
+				PullRequestNumber: row.PRNumber,
+				UpdatedAt:         row.UpdatedAt,
+			})
+		}
+	}
+	return results, nil
+}
+
 // Archive soft deletes a project (sets deleted_at)
 func (r *projectRepository) Archive(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&entity.Project{}, "id = ?", id)
+	result := r.scoped(ctx).Delete(&entity.Project{}, "id = ?", id)
 	if result.Error != nil {
 		return fmt.Errorf("failed to archive project: %w", result.Error)
 	}
@@ -271,7 +520,7 @@ func (r *projectRepository) Archive(ctx context.Context, id uuid.UUID) error {
 
 // Restore undeletes a project (clears deleted_at)
 func (r *projectRepository) Restore(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Unscoped().Model(&entity.Project{}).
+	result := r.scoped(ctx).Unscoped().Model(&entity.Project{}).
 		Where("id = ? AND deleted_at IS NOT NULL", id).
 		Update("deleted_at", nil)
 
@@ -290,7 +539,7 @@ func (r *projectRepository) Restore(ctx context.Context, id uuid.UUID) error {
 func (r *projectRepository) CheckNameExists(ctx context.Context, name string, excludeID *uuid.UUID) (bool, error) {
 	var count int64
 
-	query := r.db.WithContext(ctx).Model(&entity.Project{}).Where("name = ?", name)
+	query := r.scoped(ctx).Model(&entity.Project{}).Where("name = ?", name)
 
 	if excludeID != nil {
 		query = query.Where("id != ?", *excludeID)
@@ -342,3 +591,99 @@ func (r *projectRepository) UpdateSettings(ctx context.Context, settings *entity
 
 	return nil
 }
+
+// GetVerificationPipeline retrieves the project's saved verification pipeline
+func (r *projectRepository) GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	var pipeline entity.VerificationPipeline
+
+	result := r.db.WithContext(ctx).First(&pipeline, "project_id = ?", projectID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get verification pipeline: %w", result.Error)
+	}
+
+	return &pipeline, nil
+}
+
+// SaveVerificationPipeline creates or replaces the project's verification
+// pipeline
+func (r *projectRepository) SaveVerificationPipeline(ctx context.Context, pipeline *entity.VerificationPipeline) error {
+	existing, err := r.GetVerificationPipeline(ctx, pipeline.ProjectID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		pipeline.ID = existing.ID
+		pipeline.CreatedAt = existing.CreatedAt
+	} else if pipeline.ID == uuid.Nil {
+		pipeline.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Save(pipeline)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save verification pipeline: %w", result.Error)
+	}
+
+	return nil
+}
+
+// DeleteVerificationPipeline removes the project's verification pipeline, so
+// the default one applies again
+func (r *projectRepository) DeleteVerificationPipeline(ctx context.Context, projectID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("project_id = ?", projectID).Delete(&entity.VerificationPipeline{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete verification pipeline: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetWorkflowPresets retrieves the project's saved workflow presets
+func (r *projectRepository) GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error) {
+	var presets []*entity.WorkflowPreset
+
+	result := r.db.WithContext(ctx).Where("project_id = ?", projectID).Order("workflow").Find(&presets)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get workflow presets: %w", result.Error)
+	}
+
+	return presets, nil
+}
+
+// SaveWorkflowPreset creates or replaces the project's preset of the
+// preset's workflow type
+func (r *projectRepository) SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) error {
+	var existing entity.WorkflowPreset
+	result := r.db.WithContext(ctx).First(&existing, "project_id = ? AND workflow = ?", preset.ProjectID, preset.Workflow)
+	switch {
+	case result.Error == nil:
+		preset.ID = existing.ID
+		preset.CreatedAt = existing.CreatedAt
+	case result.Error == gorm.ErrRecordNotFound:
+		if preset.ID == uuid.Nil {
+			preset.ID = uuid.New()
+		}
+	default:
+		return fmt.Errorf("failed to get workflow preset: %w", result.Error)
+	}
+
+	result = r.db.WithContext(ctx).Save(preset)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save workflow preset: %w", result.Error)
+	}
+
+	return nil
+}
+
+// DeleteWorkflowPreset removes the project's preset of workflow, so the
+// default one applies again
+func (r *projectRepository) DeleteWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) error {
+	result := r.db.WithContext(ctx).Where("project_id = ? AND workflow = ?", projectID, workflow).Delete(&entity.WorkflowPreset{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete workflow preset: %w", result.Error)
+	}
+
+	return nil
+}
@@ -462,3 +462,206 @@ func TestProjectRepository_GetLastActivityAt(t *testing.T) {
 		assert.True(t, lastActivity.After(project.UpdatedAt))
 	})
 }
+
+func TestProjectRepository_VerificationPipeline(t *testing.T) {
+	db := SetupTestDB(t)
+	defer TeardownTestDB()
+
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "Pipeline Project", WorktreeBasePath: "/tmp/pipeline"}
+	require.NoError(t, repo.Create(ctx, project))
+
+	pipeline, err := repo.GetVerificationPipeline(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Nil(t, pipeline, "projects start without a saved pipeline")
+
+	require.NoError(t, repo.SaveVerificationPipeline(ctx, &entity.VerificationPipeline{
+		ProjectID: project.ID,
+		Steps: []entity.VerificationPipelineStep{
+			{Step: entity.VerificationStepSetup, Command: "npm ci"},
+			{Step: entity.VerificationStepTest},
+		},
+	}))
+	require.NoError(t, repo.SaveVerificationPipeline(ctx, &entity.VerificationPipeline{
+		ProjectID: project.ID,
+		Steps:     []entity.VerificationPipelineStep{{Step: entity.VerificationStepBuild, Command: "make"}},
+	}))
+
+	pipeline, err = repo.GetVerificationPipeline(ctx, project.ID)
+	require.NoError(t, err)
+	require.NotNil(t, pipeline)
+	assert.Equal(t, []entity.VerificationPipelineStep{{Step: entity.VerificationStepBuild, Command: "make"}}, pipeline.Steps,
+		"saving again replaces the pipeline")
+
+	require.NoError(t, repo.DeleteVerificationPipeline(ctx, project.ID))
+	pipeline, err = repo.GetVerificationPipeline(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Nil(t, pipeline)
+}
+
+func TestProjectRepository_WorkflowPresets(t *testing.T) {
+	db := setupSQLiteDB(t)
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "Preset Project"}
+	require.NoError(t, repo.Create(ctx, project))
+
+	presets, err := repo.GetWorkflowPresets(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, presets, "projects start without saved presets")
+
+	require.NoError(t, repo.SaveWorkflowPreset(ctx, &entity.WorkflowPreset{
+		ProjectID: project.ID, Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "Reproduce it first",
+	}))
+	require.NoError(t, repo.SaveWorkflowPreset(ctx, &entity.WorkflowPreset{
+		ProjectID: project.ID, Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "Add a regression test", RequireTestChanges: true,
+	}))
+	require.NoError(t, repo.SaveWorkflowPreset(ctx, &entity.WorkflowPreset{
+		ProjectID: project.ID, Workflow: entity.WorkflowTypeRefactor, PlanningInstructions: "Keep the API",
+	}))
+
+	presets, err = repo.GetWorkflowPresets(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, presets, 2, "saving again replaces the preset of the same workflow")
+	assert.Equal(t, entity.WorkflowTypeBugfix, presets[0].Workflow)
+	assert.Equal(t, "Add a regression test", presets[0].ImplementationInstructions)
+	assert.True(t, presets[0].RequireTestChanges)
+
+	require.NoError(t, repo.DeleteWorkflowPreset(ctx, project.ID, entity.WorkflowTypeBugfix))
+	presets, err = repo.GetWorkflowPresets(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, presets, 1)
+	assert.Equal(t, entity.WorkflowTypeRefactor, presets[0].Workflow)
+}
+
+func TestProjectRepository_GetActivity(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	other := &entity.Project{Name: "Docs"}
+	require.NoError(t, projectRepo.Create(ctx, other))
+
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix invoice rounding", CreatedAt: at(0)}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+	require.NoError(t, NewTaskRepository(db).Create(ctx, &entity.Task{ProjectID: other.ID, Title: "Elsewhere", CreatedAt: at(1)}))
+
+	alice, todo, planning := "alice", entity.TaskStatusTODO, entity.TaskStatusPLANNING
+	require.NoError(t, db.Create(&entity.TaskStatusHistory{TaskID: task.ID, FromStatus: &todo, ToStatus: planning, ChangedBy: &alice, CreatedAt: at(2)}).Error)
+	planID := uuid.New()
+	require.NoError(t, db.Create(&entity.PlanApproval{TaskID: task.ID, PlanID: planID, Approver: "bob", Method: entity.PlanApprovalTOTP, CreatedAt: at(3)}).Error)
+	finished := at(5)
+	execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusCompleted, AIType: "claude-code", StartedAt: at(4), CompletedAt: &finished}
+	require.NoError(t, NewExecutionRepository(db).Create(ctx, execution))
+	merged := at(7)
+	require.NoError(t, NewPullRequestRepository(db).Create(ctx, &entity.PullRequest{
+		TaskID: task.ID, GitHubPRNumber: 42, Repository: "acme/billing", Title: "Fix rounding", Status: entity.PullRequestStatusMerged,
+		HeadBranch: "task/fix", BaseBranch: "main", GitHubURL: "https://github.com/acme/billing/pull/42",
+		MergedAt: &merged, MergedBy: &alice, CreatedAt: at(6),
+	}))
+
+	activity, total, err := projectRepo.GetActivity(ctx, project.ID, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 7, total)
+	require.Len(t, activity, 7)
+	types := make([]entity.ProjectActivityType, len(activity))
+	for i, entry := range activity {
+		types[i] = entry.Type
+		assert.Equal(t, task.ID, entry.TaskID)
+		assert.Equal(t, "Fix invoice rounding", entry.TaskTitle)
+	}
+	assert.Equal(t, []entity.ProjectActivityType{
+		entity.ProjectActivityPRMerged,
+		entity.ProjectActivityPROpened,
+		entity.ProjectActivityExecutionFinished,
+		entity.ProjectActivityExecutionStarted,
+		entity.ProjectActivityPlanApproved,
+		entity.ProjectActivityStatusChanged,
+		entity.ProjectActivityTaskCreated,
+	}, types)
+
+	assert.True(t, merged.Equal(activity[0].OccurredAt))
+	require.NotNil(t, activity[0].PullRequestNumber)
+	assert.Equal(t, 42, *activity[0].PullRequestNumber)
+	assert.Equal(t, &alice, activity[0].Actor)
+	require.NotNil(t, activity[2].ExecutionStatus)
+	assert.Equal(t, entity.ExecutionStatusCompleted, *activity[2].ExecutionStatus)
+	assert.Equal(t, &execution.ID, activity[2].ExecutionID)
+	assert.Equal(t, &planID, activity[4].PlanID)
+	require.NotNil(t, activity[5].ToStatus)
+	assert.Equal(t, planning, *activity[5].ToStatus)
+	assert.Nil(t, activity[6].Actor)
+
+	page, total, err := projectRepo.GetActivity(ctx, project.ID, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 7, total)
+	require.Len(t, page, 2)
+	assert.Equal(t, entity.ProjectActivityExecutionFinished, page[0].Type)
+	assert.Equal(t, entity.ProjectActivityExecutionStarted, page[1].Type)
+}
+
+func TestProjectRepository_Search(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	project := &entity.Project{Name: "Billing Service", Description: "Invoices and payments"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	archivedProject := &entity.Project{Name: "Old invoices"}
+	require.NoError(t, projectRepo.Create(ctx, archivedProject))
+	require.NoError(t, projectRepo.Archive(ctx, archivedProject.ID))
+
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix invoice rounding", Number: 7}
+	require.NoError(t, taskRepo.Create(ctx, task))
+	require.NoError(t, taskRepo.Create(ctx, &entity.Task{ProjectID: project.ID, Title: "Archived invoice task", Number: 8, IsArchived: true}))
+	require.NoError(t, taskRepo.Create(ctx, &entity.Task{ProjectID: project.ID, Title: "100% coverage", Number: 9}))
+	require.NoError(t, NewPlanRepository(db).Create(ctx, &entity.Plan{TaskID: task.ID, Status: entity.PlanStatusDRAFT, Content: "Round each invoice line"}))
+	require.NoError(t, NewPullRequestRepository(db).Create(ctx, &entity.PullRequest{
+		TaskID: task.ID, GitHubPRNumber: 42, Repository: "acme/billing", Title: "Fix rounding", Body: "Rounds invoice totals",
+		Status: entity.PullRequestStatusOpen, HeadBranch: "task/fix", BaseBranch: "main",
+	}))
+
+	results, err := projectRepo.Search(ctx, repository.SearchParams{Query: "INVOICE", Limit: 10})
+	require.NoError(t, err)
+	found := map[entity.SearchResultType][]string{}
+	for _, result := range results {
+		found[result.Type] = append(found[result.Type], result.Title)
+		assert.Equal(t, project.ID, result.ProjectID)
+		assert.Equal(t, "Billing Service", result.ProjectName)
+	}
+	assert.Equal(t, map[entity.SearchResultType][]string{
+		entity.SearchResultProject:     {"Billing Service"},
+		entity.SearchResultTask:        {"Fix invoice rounding"},
+		entity.SearchResultPlan:        {"Fix invoice rounding"},
+		entity.SearchResultPullRequest: {"Fix rounding"},
+	}, found, "archived projects and tasks are left out")
+
+	results, err = projectRepo.Search(ctx, repository.SearchParams{Query: "%", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results, 1, "wildcards are matched literally")
+	assert.Equal(t, "100% coverage", results[0].Title)
+
+	number := int64(7)
+	results, err = projectRepo.Search(ctx, repository.SearchParams{Query: "AD-7", TaskNumber: &number, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, results, 3, "the task, its plan and its pull request")
+	for _, result := range results {
+		assert.Equal(t, &task.ID, result.TaskID)
+		assert.Equal(t, &number, result.TaskNumber)
+	}
+	require.NotNil(t, results[2].PullRequestNumber)
+	assert.Equal(t, 42, *results[2].PullRequestNumber)
+
+	orgID := uuid.New()
+	results, err = projectRepo.Search(repository.WithOrganizationID(ctx, orgID), repository.SearchParams{Query: "invoice", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, results, "projects of other organizations are not searched")
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type projectWebhookRepository struct {
+	db *database.GormDB
+}
+
+// NewProjectWebhookRepository creates a new PostgreSQL project webhook repository
+func NewProjectWebhookRepository(db *database.GormDB) repository.ProjectWebhookRepository {
+	return &projectWebhookRepository{db: db}
+}
+
+// Create creates a new webhook
+func (r *projectWebhookRepository) Create(ctx context.Context, webhook *entity.ProjectWebhook) error {
+	if webhook.ID == uuid.Nil {
+		webhook.ID = uuid.New()
+	}
+
+	if err := r.db.WithContext(ctx).Create(webhook).Error; err != nil {
+		return fmt.Errorf("failed to create project webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a webhook by ID, nil when there is none
+func (r *projectWebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhook, error) {
+	var webhook entity.ProjectWebhook
+
+	result := r.db.WithContext(ctx).First(&webhook, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get project webhook: %w", result.Error)
+	}
+
+	return &webhook, nil
+}
+
+// ListByProject retrieves the project's webhooks
+func (r *projectWebhookRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectWebhook, error) {
+	var webhooks []*entity.ProjectWebhook
+
+	result := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&webhooks)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list project webhooks: %w", result.Error)
+	}
+
+	return webhooks, nil
+}
+
+// Update saves the webhook's URL, events and whether it is active
+func (r *projectWebhookRepository) Update(ctx context.Context, webhook *entity.ProjectWebhook) error {
+	if err := r.db.WithContext(ctx).Save(webhook).Error; err != nil {
+		return fmt.Errorf("failed to update project webhook: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the webhook and its deliveries
+func (r *projectWebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id = ?", id).Delete(&entity.ProjectWebhookDelivery{}).Error; err != nil {
+			return fmt.Errorf("failed to delete project webhook deliveries: %w", err)
+		}
+		result := tx.Delete(&entity.ProjectWebhook{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete project webhook: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("project webhook not found with id %s", id)
+		}
+		return nil
+	})
+}
+
+// CreateDelivery records a delivery of a webhook
+func (r *projectWebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+	if delivery.ID == uuid.Nil {
+		delivery.ID = uuid.New()
+	}
+
+	if err := r.db.WithContext(ctx).Omit("Webhook").Create(delivery).Error; err != nil {
+		return fmt.Errorf("failed to create project webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetDelivery retrieves a delivery with its webhook, nil when there is none
+func (r *projectWebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhookDelivery, error) {
+	var delivery entity.ProjectWebhookDelivery
+
+	result := r.db.WithContext(ctx).Preload("Webhook").First(&delivery, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get project webhook delivery: %w", result.Error)
+	}
+
+	return &delivery, nil
+}
+
+// UpdateDelivery saves the outcome of a delivery's last attempt
+func (r *projectWebhookRepository) UpdateDelivery(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Omit("Webhook").Save(delivery).Error; err != nil {
+		return fmt.Errorf("failed to update project webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeliveries retrieves the webhook's latest deliveries
+func (r *projectWebhookRepository) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*entity.ProjectWebhookDelivery, error) {
+	var deliveries []*entity.ProjectWebhookDelivery
+
+	result := r.db.WithContext(ctx).
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list project webhook deliveries: %w", result.Error)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectWebhookRepository(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewProjectWebhookRepository(db)
+	projectRepo := NewProjectRepository(db)
+
+	project := &entity.Project{Name: "Hooked"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+
+	webhook := &entity.ProjectWebhook{
+		ProjectID: project.ID,
+		URL:       "https://ci.example.com/hooks/auto-devs",
+		Events:    []entity.WebhookEvent{entity.WebhookEventPlanReady, entity.WebhookEventPRMerged},
+		Active:    true,
+	}
+	require.NoError(t, repo.Create(ctx, webhook))
+	require.NoError(t, repo.Create(ctx, &entity.ProjectWebhook{ProjectID: project.ID, URL: "https://chat.example.com/hook", Active: true}))
+
+	found, err := repo.GetByID(ctx, webhook.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, []entity.WebhookEvent{entity.WebhookEventPlanReady, entity.WebhookEventPRMerged}, found.Events)
+
+	found.Active = false
+	found.Events = nil
+	require.NoError(t, repo.Update(ctx, found))
+	webhooks, err := repo.ListByProject(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, webhook.ID, webhooks[0].ID)
+	assert.False(t, webhooks[0].Active)
+	assert.Empty(t, webhooks[0].Events)
+
+	first := &entity.ProjectWebhookDelivery{WebhookID: webhook.ID, Event: entity.WebhookEventPlanReady, Payload: `{"event":"plan_ready"}`, Status: entity.WebhookDeliveryPending}
+	require.NoError(t, repo.CreateDelivery(ctx, first))
+	first.Status = entity.WebhookDeliveryFailed
+	first.Attempts = 3
+	first.Error = "connection refused"
+	require.NoError(t, repo.UpdateDelivery(ctx, first))
+	second := &entity.ProjectWebhookDelivery{WebhookID: webhook.ID, Event: entity.WebhookEventPlanReady, Payload: first.Payload, Status: entity.WebhookDeliveryPending, RedeliveryOf: &first.ID}
+	second.CreatedAt = first.CreatedAt.Add(1)
+	require.NoError(t, repo.CreateDelivery(ctx, second))
+
+	delivery, err := repo.GetDelivery(ctx, first.ID)
+	require.NoError(t, err)
+	require.NotNil(t, delivery)
+	require.NotNil(t, delivery.Webhook)
+	assert.Equal(t, project.ID, delivery.Webhook.ProjectID)
+	assert.Equal(t, entity.WebhookDeliveryFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+
+	deliveries, err := repo.ListDeliveries(ctx, webhook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, second.ID, deliveries[0].ID, "the latest delivery comes first")
+	deliveries, err = repo.ListDeliveries(ctx, webhook.ID, 1)
+	require.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	require.NoError(t, repo.Delete(ctx, webhook.ID))
+	delivery, err = repo.GetDelivery(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Nil(t, delivery, "the deliveries go with their webhook")
+	found, err = repo.GetByID(ctx, webhook.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	assert.Error(t, repo.Delete(ctx, webhook.ID))
+}
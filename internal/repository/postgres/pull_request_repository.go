@@ -100,6 +100,17 @@ func (r *pullRequestRepository) GetByTaskID(ctx context.Context, taskID uuid.UUI
 	return &pr, nil
 }
 
+// GetByTaskIDs retrieves the pull requests of several tasks in one query
+func (r *pullRequestRepository) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.PullRequest, error) {
+	var prs []*entity.PullRequest
+	result := r.db.WithContext(ctx).Where("task_id IN ?", taskIDs).Order("created_at DESC").Find(&prs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get pull requests by task IDs: %w", result.Error)
+	}
+
+	return prs, nil
+}
+
 // GetByGitHubPRNumber retrieves a pull request by GitHub PR number and repository
 func (r *pullRequestRepository) GetByGitHubPRNumber(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error) {
 	var pr entity.PullRequest
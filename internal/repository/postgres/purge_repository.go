@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"gorm.io/gorm"
+)
+
+// softDeletedBefore matches the rows soft-deleted before the cutoff bound to
+// its placeholder
+const softDeletedBefore = "deleted_at IS NOT NULL AND deleted_at < ?"
+
+// purgeStep removes the rows of table matching where, which binds the cutoff
+type purgeStep struct {
+	table string
+	where string
+}
+
+// purgeSteps are the tables handled by the purge job, children before their
+// parents. Execution logs have no deleted_at of their own and go with their
+// execution, so they are deleted explicitly and counted in the report. The
+// other rows referencing a purged execution or task (processes, verification
+// runs, review comments, findings, and the rows of tasks whose executions
+// were not soft-deleted) are removed by the ON DELETE CASCADE of their
+// foreign keys and are not counted.
+var purgeSteps = []purgeStep{
+	{"task_comments", softDeletedBefore},
+	{"task_audit_logs", softDeletedBefore},
+	{"plan_versions", softDeletedBefore},
+	{"plans", softDeletedBefore},
+	{"execution_logs", "execution_id IN (SELECT id FROM executions WHERE " + softDeletedBefore + ")"},
+	{"executions", softDeletedBefore},
+	{"tasks", softDeletedBefore},
+}
+
+type purgeRepository struct {
+	db *database.GormDB
+}
+
+func NewPurgeRepository(db *database.GormDB) repository.PurgeRepository {
+	return &purgeRepository{db: db}
+}
+
+func (r *purgeRepository) PurgeSoftDeleted(ctx context.Context, cutoff time.Time, dryRun bool) (*repository.PurgeReport, error) {
+	report := &repository.PurgeReport{
+		Cutoff: cutoff,
+		DryRun: dryRun,
+		Tables: make([]repository.PurgeTableResult, 0, len(purgeSteps)),
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, step := range purgeSteps {
+			var rows int64
+			if dryRun {
+				if err := tx.Table(step.table).Where(step.where, cutoff).Count(&rows).Error; err != nil {
+					return fmt.Errorf("failed to count purgeable rows in %s: %w", step.table, err)
+				}
+			} else {
+				result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", step.table, step.where), cutoff)
+				if result.Error != nil {
+					return fmt.Errorf("failed to purge rows from %s: %w", step.table, result.Error)
+				}
+				rows = result.RowsAffected
+			}
+			report.Tables = append(report.Tables, repository.PurgeTableResult{Table: step.table, Rows: rows})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
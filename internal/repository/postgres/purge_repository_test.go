@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeRepository_PurgeSoftDeleted(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "Purge"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	taskRepo := NewTaskRepository(db)
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+	old := cutoff.Add(-time.Hour)
+	recent := cutoff.Add(time.Hour)
+
+	newTask := func(deletedAt *time.Time) *entity.Task {
+		task := &entity.Task{ProjectID: project.ID, Title: "Task"}
+		require.NoError(t, taskRepo.Create(ctx, task))
+		if deletedAt != nil {
+			require.NoError(t, db.Model(task).UpdateColumn("deleted_at", *deletedAt).Error)
+		}
+		return task
+	}
+	newExecution := func(task *entity.Task, deletedAt *time.Time, logs int) *entity.Execution {
+		execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+		require.NoError(t, db.Create(execution).Error)
+		if logs > 0 {
+			require.NoError(t, db.Create(CreateTestExecutionLogs(execution.ID, logs)).Error)
+		}
+		if deletedAt != nil {
+			require.NoError(t, db.Model(execution).UpdateColumn("deleted_at", *deletedAt).Error)
+		}
+		return execution
+	}
+
+	live := newTask(nil)
+	oldExecution := newExecution(live, &old, 3)
+	recentExecution := newExecution(live, &recent, 2)
+	liveExecution := newExecution(live, nil, 1)
+	oldTask := newTask(&old)
+	recentTask := newTask(&recent)
+
+	count := func(table string) int64 {
+		var n int64
+		require.NoError(t, db.Table(table).Count(&n).Error)
+		return n
+	}
+	rowsByTable := func(report *repository.PurgeReport) map[string]int64 {
+		rows := make(map[string]int64, len(report.Tables))
+		for _, result := range report.Tables {
+			rows[result.Table] = result.Rows
+		}
+		return rows
+	}
+
+	t.Run("dry run counts every table without deleting", func(t *testing.T) {
+		report, err := NewPurgeRepository(db).PurgeSoftDeleted(ctx, cutoff, true)
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		rows := rowsByTable(report)
+		assert.Equal(t, int64(3), rows["execution_logs"])
+		assert.Equal(t, int64(1), rows["executions"])
+		assert.Equal(t, int64(1), rows["tasks"])
+		assert.Equal(t, int64(5), report.TotalRows())
+
+		assert.Equal(t, int64(6), count("execution_logs"))
+		assert.Equal(t, int64(3), count("executions"))
+		assert.Equal(t, int64(3), count("tasks"))
+	})
+
+	t.Run("purge removes rows soft-deleted before the cutoff", func(t *testing.T) {
+		report, err := NewPurgeRepository(db).PurgeSoftDeleted(ctx, cutoff, false)
+		require.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		rows := rowsByTable(report)
+		assert.Equal(t, int64(3), rows["execution_logs"])
+		assert.Equal(t, int64(1), rows["executions"])
+		assert.Equal(t, int64(1), rows["tasks"])
+
+		var logs int64
+		require.NoError(t, db.Table("execution_logs").Where("execution_id = ?", oldExecution.ID).Count(&logs).Error)
+		assert.Zero(t, logs)
+		assert.Equal(t, int64(3), count("execution_logs"))
+
+		var executions []string
+		require.NoError(t, db.Table("executions").Pluck("id", &executions).Error)
+		assert.ElementsMatch(t, []string{recentExecution.ID.String(), liveExecution.ID.String()}, executions)
+
+		var tasks []string
+		require.NoError(t, db.Table("tasks").Pluck("id", &tasks).Error)
+		assert.ElementsMatch(t, []string{live.ID.String(), recentTask.ID.String()}, tasks)
+		assert.NotContains(t, tasks, oldTask.ID.String())
+	})
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type releaseNotesRepository struct {
+	db *database.GormDB
+}
+
+// NewReleaseNotesRepository creates a new PostgreSQL release notes repository
+func NewReleaseNotesRepository(db *database.GormDB) repository.ReleaseNotesRepository {
+	return &releaseNotesRepository{db: db}
+}
+
+// scoped returns a query restricted to the release notes of the
+// organization carried by ctx
+func (r *releaseNotesRepository) scoped(ctx context.Context) *gorm.DB {
+	return scopeByProject(ctx, r.db.WithContext(ctx), "release_notes.project_id")
+}
+
+// Create creates new release notes
+func (r *releaseNotesRepository) Create(ctx context.Context, notes *entity.ReleaseNotes) error {
+	if notes.ID == uuid.Nil {
+		notes.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(notes)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create release notes: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID retrieves release notes by ID
+func (r *releaseNotesRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error) {
+	var notes entity.ReleaseNotes
+
+	result := r.scoped(ctx).First(&notes, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("release notes not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get release notes: %w", result.Error)
+	}
+
+	return &notes, nil
+}
+
+// ListByProjectID retrieves the project's release notes, newest first
+func (r *releaseNotesRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.ReleaseNotes, error) {
+	var notes []*entity.ReleaseNotes
+
+	result := r.scoped(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Find(&notes)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list release notes: %w", result.Error)
+	}
+
+	return notes, nil
+}
+
+// Update saves the release notes
+func (r *releaseNotesRepository) Update(ctx context.Context, notes *entity.ReleaseNotes) error {
+	result := r.db.WithContext(ctx).Save(notes)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update release notes: %w", result.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+)
+
+type reviewCommentRepository struct {
+	db *database.GormDB
+}
+
+// NewReviewCommentRepository creates a new PostgreSQL review comment repository
+func NewReviewCommentRepository(db *database.GormDB) repository.ReviewCommentRepository {
+	return &reviewCommentRepository{db: db}
+}
+
+// BatchCreate saves the findings of a review
+func (r *reviewCommentRepository) BatchCreate(ctx context.Context, comments []*entity.ReviewComment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	for _, comment := range comments {
+		if comment.ID == uuid.Nil {
+			comment.ID = uuid.New()
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Create(comments).Error; err != nil {
+		return fmt.Errorf("failed to create review comments: %w", err)
+	}
+
+	return nil
+}
+
+// ListByExecutionID retrieves an execution's review comments by file and
+// line, findings about the whole change first
+func (r *reviewCommentRepository) ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error) {
+	var comments []*entity.ReviewComment
+
+	result := r.db.WithContext(ctx).
+		Where("execution_id = ?", executionID).
+		Order("file_path ASC, line ASC").
+		Find(&comments)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list review comments: %w", result.Error)
+	}
+
+	return comments, nil
+}
+
+// MarkPosted records that the comments were added to the pull request
+func (r *reviewCommentRepository) MarkPosted(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&entity.ReviewComment{}).
+		Where("id IN ?", ids).
+		Update("posted", true)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark review comments as posted: %w", result.Error)
+	}
+
+	return nil
+}
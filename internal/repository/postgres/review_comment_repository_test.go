@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewCommentRepository_ListByExecutionID(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+	execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+	require.NoError(t, NewExecutionRepository(db).Create(ctx, execution))
+
+	repo := NewReviewCommentRepository(db)
+	line12, line3 := 12, 3
+	comments := []*entity.ReviewComment{
+		{ExecutionID: execution.ID, TaskID: task.ID, FilePath: "auth/login.go", Line: &line12, Severity: entity.ReviewSeverityError, Body: "Password is logged"},
+		{ExecutionID: execution.ID, TaskID: task.ID, Severity: entity.ReviewSeverityInfo, Body: "Consider a follow-up for rate limiting"},
+		{ExecutionID: execution.ID, TaskID: task.ID, FilePath: "auth/login.go", Line: &line3, Severity: entity.ReviewSeverityWarning, Body: "Unused import"},
+	}
+	require.NoError(t, repo.BatchCreate(ctx, comments))
+	require.NoError(t, repo.MarkPosted(ctx, []uuid.UUID{comments[0].ID}))
+
+	listed, err := repo.ListByExecutionID(ctx, execution.ID)
+	require.NoError(t, err)
+	require.Len(t, listed, 3)
+	assert.Empty(t, listed[0].FilePath, "findings about the whole change come first")
+	assert.Equal(t, 3, *listed[1].Line)
+	assert.Equal(t, "Password is logged", listed[2].Body)
+	assert.True(t, listed[2].Posted)
+	assert.False(t, listed[1].Posted)
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type secretRepository struct {
+	db *database.GormDB
+}
+
+// NewSecretRepository creates a new PostgreSQL project secret repository
+func NewSecretRepository(db *database.GormDB) repository.SecretRepository {
+	return &secretRepository{db: db}
+}
+
+// Upsert creates a secret or replaces the ciphertext of an existing one
+func (r *secretRepository) Upsert(ctx context.Context, secret *entity.ProjectSecret) error {
+	if secret.ID == uuid.Nil {
+		secret.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ciphertext", "updated_at"}),
+	}).Create(secret)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save secret: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Get retrieves a project's secret by name, returning nil when it does not exist
+func (r *secretRepository) Get(ctx context.Context, projectID uuid.UUID, name string) (*entity.ProjectSecret, error) {
+	var secret entity.ProjectSecret
+
+	result := r.db.WithContext(ctx).First(&secret, "project_id = ? AND name = ?", projectID, name)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get secret: %w", result.Error)
+	}
+
+	return &secret, nil
+}
+
+// Delete removes a project's secret by name
+func (r *secretRepository) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	result := r.db.WithContext(ctx).Delete(&entity.ProjectSecret{}, "project_id = ? AND name = ?", projectID, name)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete secret: %w", result.Error)
+	}
+
+	return nil
+}
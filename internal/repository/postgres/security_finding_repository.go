@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+)
+
+type securityFindingRepository struct {
+	db *database.GormDB
+}
+
+// NewSecurityFindingRepository creates a new PostgreSQL security finding repository
+func NewSecurityFindingRepository(db *database.GormDB) repository.SecurityFindingRepository {
+	return &securityFindingRepository{db: db}
+}
+
+// BatchCreate saves the findings of a security scan
+func (r *securityFindingRepository) BatchCreate(ctx context.Context, findings []*entity.SecurityFinding) error {
+	if len(findings) == 0 {
+		return nil
+	}
+
+	for _, finding := range findings {
+		if finding.ID == uuid.Nil {
+			finding.ID = uuid.New()
+		}
+	}
+
+	if err := r.db.WithContext(ctx).Create(findings).Error; err != nil {
+		return fmt.Errorf("failed to create security findings: %w", err)
+	}
+
+	return nil
+}
+
+// ListByExecutionID retrieves an execution's security findings by file and
+// line
+func (r *securityFindingRepository) ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error) {
+	var findings []*entity.SecurityFinding
+
+	result := r.db.WithContext(ctx).
+		Where("execution_id = ?", executionID).
+		Order("file_path ASC, line ASC").
+		Find(&findings)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list security findings: %w", result.Error)
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecurityFindingRepository_ListByExecutionID(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+	execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+	require.NoError(t, NewExecutionRepository(db).Create(ctx, execution))
+
+	repo := NewSecurityFindingRepository(db)
+	findings := []*entity.SecurityFinding{
+		{ExecutionID: execution.ID, TaskID: task.ID, Scanner: entity.SecurityScannerGosec, RuleID: "G304", FilePath: "auth/token.go", Line: 30, Severity: entity.SecuritySeverityMedium, Message: "Potential file inclusion via variable"},
+		{ExecutionID: execution.ID, TaskID: task.ID, Scanner: entity.SecurityScannerGosec, RuleID: "G101", FilePath: "auth/login.go", Line: 12, Severity: entity.SecuritySeverityHigh, Message: "Potential hardcoded credentials"},
+	}
+	require.NoError(t, repo.BatchCreate(ctx, findings))
+
+	listed, err := repo.ListByExecutionID(ctx, execution.ID)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "G101", listed[0].RuleID)
+	assert.Equal(t, "auth/token.go", listed[1].FilePath)
+}
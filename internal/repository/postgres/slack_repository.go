@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type slackIntegrationRepository struct {
+	db *database.GormDB
+}
+
+// NewSlackIntegrationRepository creates a new PostgreSQL Slack integration repository
+func NewSlackIntegrationRepository(db *database.GormDB) repository.SlackIntegrationRepository {
+	return &slackIntegrationRepository{db: db}
+}
+
+// GetByProjectID retrieves a project's integration, returning nil when the
+// project has none
+func (r *slackIntegrationRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (*entity.SlackIntegration, error) {
+	var integration entity.SlackIntegration
+
+	result := r.db.WithContext(ctx).First(&integration, "project_id = ?", projectID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get slack integration: %w", result.Error)
+	}
+
+	return &integration, nil
+}
+
+// Save creates or updates an integration
+func (r *slackIntegrationRepository) Save(ctx context.Context, integration *entity.SlackIntegration) error {
+	if integration.ID == uuid.Nil {
+		integration.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Save(integration)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save slack integration: %w", result.Error)
+	}
+
+	return nil
+}
+
+// Delete removes a project's integration
+func (r *slackIntegrationRepository) Delete(ctx context.Context, projectID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entity.SlackIntegration{}, "project_id = ?", projectID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete slack integration: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("slack integration not found for project %s", projectID)
+	}
+
+	return nil
+}
+
+type notificationPreferenceRepository struct {
+	db *database.GormDB
+}
+
+// NewNotificationPreferenceRepository creates a new PostgreSQL notification preference repository
+func NewNotificationPreferenceRepository(db *database.GormDB) repository.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+// ListByProjectID retrieves the project's preferences for a channel, by type
+func (r *notificationPreferenceRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID, channel entity.NotificationChannel) ([]*entity.NotificationPreference, error) {
+	var preferences []*entity.NotificationPreference
+
+	result := r.db.WithContext(ctx).
+		Where("project_id = ? AND channel = ?", projectID, channel).
+		Order("type ASC").
+		Find(&preferences)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list notification preferences: %w", result.Error)
+	}
+
+	return preferences, nil
+}
+
+// Save creates a preference or replaces the existing one of its type
+func (r *notificationPreferenceRepository) Save(ctx context.Context, preference *entity.NotificationPreference) error {
+	if preference.ID == uuid.Nil {
+		preference.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "channel"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"muted", "updated_by", "updated_at"}),
+	}).Create(preference)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save notification preference: %w", result.Error)
+	}
+
+	return nil
+}
+
+// IsMuted reports whether a preference mutes the type on the channel
+func (r *notificationPreferenceRepository) IsMuted(ctx context.Context, projectID uuid.UUID, channel entity.NotificationChannel, notificationType entity.NotificationType) (bool, error) {
+	var count int64
+
+	result := r.db.WithContext(ctx).Model(&entity.NotificationPreference{}).
+		Where("project_id = ? AND channel = ? AND type = ? AND muted = ?", projectID, channel, notificationType, true).
+		Count(&count)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to check notification preference: %w", result.Error)
+	}
+
+	return count > 0, nil
+}
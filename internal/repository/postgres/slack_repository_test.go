@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferenceRepository(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewNotificationPreferenceRepository(db)
+	projectID := uuid.New()
+	admin := "admin"
+
+	require.NoError(t, repo.Save(ctx, &entity.NotificationPreference{ProjectID: projectID, Channel: entity.NotificationChannelSlack, Type: entity.NotificationTypeTaskCreated, Muted: true}))
+	require.NoError(t, repo.Save(ctx, &entity.NotificationPreference{ProjectID: projectID, Channel: entity.NotificationChannelSlack, Type: entity.NotificationTypePRMerged, Muted: true}))
+	// Saving a type again replaces its preference
+	require.NoError(t, repo.Save(ctx, &entity.NotificationPreference{ProjectID: projectID, Channel: entity.NotificationChannelSlack, Type: entity.NotificationTypePRMerged, Muted: false, UpdatedBy: &admin}))
+
+	preferences, err := repo.ListByProjectID(ctx, projectID, entity.NotificationChannelSlack)
+	require.NoError(t, err)
+	require.Len(t, preferences, 2)
+	assert.Equal(t, entity.NotificationTypePRMerged, preferences[0].Type)
+	assert.False(t, preferences[0].Muted)
+	assert.Equal(t, &admin, preferences[0].UpdatedBy)
+
+	muted, err := repo.IsMuted(ctx, projectID, entity.NotificationChannelSlack, entity.NotificationTypeTaskCreated)
+	require.NoError(t, err)
+	assert.True(t, muted)
+	muted, err = repo.IsMuted(ctx, projectID, entity.NotificationChannelSlack, entity.NotificationTypePRMerged)
+	require.NoError(t, err)
+	assert.False(t, muted)
+	muted, err = repo.IsMuted(ctx, uuid.New(), entity.NotificationChannelSlack, entity.NotificationTypeTaskCreated)
+	require.NoError(t, err)
+	assert.False(t, muted, "preferences are per project")
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type taskAttachmentRepository struct {
+	db *database.GormDB
+}
+
+// NewTaskAttachmentRepository creates a new PostgreSQL task attachment repository
+func NewTaskAttachmentRepository(db *database.GormDB) repository.TaskAttachmentRepository {
+	return &taskAttachmentRepository{db: db}
+}
+
+// Create creates a new task attachment
+func (r *taskAttachmentRepository) Create(ctx context.Context, attachment *entity.TaskAttachment) error {
+	if attachment.ID == uuid.Nil {
+		attachment.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(attachment)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create task attachment: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a task attachment by ID
+func (r *taskAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.TaskAttachment, error) {
+	var attachment entity.TaskAttachment
+
+	result := r.db.WithContext(ctx).First(&attachment, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("task attachment not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get task attachment: %w", result.Error)
+	}
+
+	return &attachment, nil
+}
+
+// ListByTaskID retrieves the attachments of a task, oldest first
+func (r *taskAttachmentRepository) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskAttachment, error) {
+	var attachments []*entity.TaskAttachment
+
+	result := r.db.WithContext(ctx).
+		Where("task_id = ?", taskID).
+		Order("created_at ASC").
+		Find(&attachments)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list task attachments: %w", result.Error)
+	}
+
+	return attachments, nil
+}
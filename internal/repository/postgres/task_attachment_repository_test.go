@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAttachmentRepository_CreateGetList(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewTaskAttachmentRepository(db)
+	taskID := uuid.New()
+
+	attach := func(taskID uuid.UUID, filename string) *entity.TaskAttachment {
+		t.Helper()
+		attachment := &entity.TaskAttachment{TaskID: taskID, Filename: filename, FilePath: "tasks/" + filename, FileSize: 5, MimeType: "text/plain", UploadedBy: "alice"}
+		require.NoError(t, repo.Create(ctx, attachment))
+		return attachment
+	}
+	first := attach(taskID, "first.txt")
+	attach(taskID, "second.txt")
+	attach(uuid.New(), "other.txt")
+
+	got, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "first.txt", got.Filename)
+
+	_, err = repo.GetByID(ctx, uuid.New())
+	assert.Error(t, err)
+
+	attachments, err := repo.ListByTaskID(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "first.txt", attachments[0].Filename)
+	assert.Equal(t, "second.txt", attachments[1].Filename)
+}
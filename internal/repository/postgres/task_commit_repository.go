@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type taskCommitRepository struct {
+	db *database.GormDB
+}
+
+// NewTaskCommitRepository creates a new PostgreSQL task commit repository
+func NewTaskCommitRepository(db *database.GormDB) repository.TaskCommitRepository {
+	return &taskCommitRepository{db: db}
+}
+
+// Link inserts the commit unless the task already has a commit with its SHA
+func (r *taskCommitRepository) Link(ctx context.Context, commit *entity.TaskCommit) (bool, error) {
+	if commit.ID == uuid.Nil {
+		commit.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "task_id"}, {Name: "sha"}},
+		DoNothing: true,
+	}).Create(commit)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to link commit: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListByTaskID retrieves a task's commits, most recent first
+func (r *taskCommitRepository) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskCommit, error) {
+	var commits []*entity.TaskCommit
+
+	result := r.db.WithContext(ctx).
+		Where("task_id = ?", taskID).
+		Order("committed_at DESC, created_at DESC").
+		Find(&commits)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list task commits: %w", result.Error)
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskCommitRepository_Link(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App", RepositoryURL: "https://github.com/acme/app"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+
+	projects, err := NewProjectRepository(db).GetByRepositoryURLs(ctx, []string{"https://github.com/acme/app.git", "https://github.com/acme/app"})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+
+	repo := NewTaskCommitRepository(db)
+	linked, err := repo.Link(ctx, &entity.TaskCommit{TaskID: task.ID, SHA: "abc", Message: "AD-1 fix"})
+	require.NoError(t, err)
+	assert.True(t, linked)
+
+	linked, err = repo.Link(ctx, &entity.TaskCommit{TaskID: task.ID, SHA: "abc", Message: "AD-1 fix"})
+	require.NoError(t, err)
+	assert.False(t, linked, "a redelivered commit is linked once")
+
+	commits, err := repo.ListByTaskID(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "abc", commits[0].SHA)
+}
@@ -23,6 +23,12 @@ func NewTaskRepository(db *database.GormDB) repository.TaskRepository {
 	return &taskRepository{db: db}
 }
 
+// scoped returns a query restricted to the tasks of the organization
+// carried by ctx
+func (r *taskRepository) scoped(ctx context.Context) *gorm.DB {
+	return scopeByProject(ctx, r.db.WithContext(ctx), "tasks.project_id")
+}
+
 // Create creates a new task
 func (r *taskRepository) Create(ctx context.Context, task *entity.Task) error {
 	// Generate UUID if not provided
@@ -47,7 +53,7 @@ func (r *taskRepository) Create(ctx context.Context, task *entity.Task) error {
 func (r *taskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
 	var task entity.Task
 
-	result := r.db.WithContext(ctx).First(&task, "id = ?", id)
+	result := r.scoped(ctx).First(&task, "id = ?", id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("task not found with id %s", id)
@@ -58,11 +64,46 @@ func (r *taskRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tas
 	return &task, nil
 }
 
+// GetDetail preloads the latest plan, execution and pull request of the
+// task and all its comments, then counts the logs of that execution
+func (r *taskRepository) GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error) {
+	latest := func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at DESC").Limit(1)
+	}
+
+	var task entity.Task
+	result := r.scoped(ctx).
+		Preload("Plans", latest).
+		Preload("Executions", latest).
+		Preload("PullRequests", latest).
+		Preload("Comments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		First(&task, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("task not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get task detail: %w", result.Error)
+	}
+
+	detail := &entity.TaskDetail{Task: &task}
+	if execution := detail.LatestExecution(); execution != nil {
+		result = r.db.WithContext(ctx).Model(&entity.ExecutionLog{}).
+			Where("execution_id = ?", execution.ID).
+			Count(&detail.LatestExecutionLogCount)
+		if result.Error != nil {
+			return nil, fmt.Errorf("failed to count execution logs: %w", result.Error)
+		}
+	}
+	return detail, nil
+}
+
 // GetByProjectID retrieves all tasks for a specific project
 func (r *taskRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Task, error) {
 	var tasks []entity.Task
 
-	result := r.db.WithContext(ctx).Preload("Plans").Where("project_id = ?", projectID).Order("created_at DESC").Find(&tasks)
+	result := r.scoped(ctx).Preload("Plans").Where("project_id = ?", projectID).Order("created_at DESC").Find(&tasks)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get tasks by project: %w", result.Error)
 	}
@@ -76,6 +117,70 @@ func (r *taskRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID
 	return taskPtrs, nil
 }
 
+// GetByIDs retrieves the tasks with the given IDs in one query
+func (r *taskRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Task, error) {
+	var tasks []entity.Task
+
+	result := r.scoped(ctx).Where("id IN ?", ids).Find(&tasks)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get tasks by IDs: %w", result.Error)
+	}
+
+	// Convert to slice of pointers
+	taskPtrs := make([]*entity.Task, len(tasks))
+	for i := range tasks {
+		taskPtrs[i] = &tasks[i]
+	}
+
+	return taskPtrs, nil
+}
+
+// GetByProjectIDs retrieves the tasks of several projects in one query
+func (r *taskRepository) GetByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*entity.Task, error) {
+	var tasks []entity.Task
+
+	result := r.scoped(ctx).Where("project_id IN ?", projectIDs).Order("created_at DESC").Find(&tasks)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get tasks by projects: %w", result.Error)
+	}
+
+	// Convert to slice of pointers
+	taskPtrs := make([]*entity.Task, len(tasks))
+	for i := range tasks {
+		taskPtrs[i] = &tasks[i]
+	}
+
+	return taskPtrs, nil
+}
+
+// GetBoardTasks loads the card fields of the project's unarchived tasks,
+// templates left out, in board order, only those of the epic when given
+func (r *taskRepository) GetBoardTasks(ctx context.Context, projectID uuid.UUID, epicID *uuid.UUID) ([]*entity.Task, error) {
+	priorityRank := "CASE priority"
+	for _, priority := range entity.GetAllTaskPriorities() {
+		priorityRank += fmt.Sprintf(" WHEN '%s' THEN %d", priority, priority.Rank())
+	}
+	priorityRank += fmt.Sprintf(" ELSE %d END", entity.TaskPriority("").Rank())
+
+	query := r.scoped(ctx).
+		Select("id, project_id, title, status, priority, tags, git_status, branch_name, pull_request, parent_task_id, epic_id, created_at, updated_at").
+		Where("project_id = ? AND is_archived = ? AND is_template = ?", projectID, false, false)
+	if epicID != nil {
+		query = query.Where("epic_id = ?", *epicID)
+	}
+
+	var tasks []*entity.Task
+	result := query.
+		Order(priorityRank).
+		Order("updated_at DESC").
+		Find(&tasks)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get board tasks: %w", result.Error)
+	}
+
+	return tasks, nil
+}
+
 // Update updates an existing task
 func (r *taskRepository) Update(ctx context.Context, task *entity.Task) error {
 	// First check if task exists
@@ -111,18 +216,41 @@ func (r *taskRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// UpdateStatus updates the status of a task
+// UpdateStatus updates the status of a task and records the change in its
+// status history
 func (r *taskRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TaskStatus) error {
-	result := r.db.WithContext(ctx).Model(&entity.Task{}).Where("id = ?", id).Update("status", status)
-	if result.Error != nil {
-		return fmt.Errorf("failed to update task status: %w", result.Error)
-	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current entity.Task
+		if err := tx.Select("status").Where("id = ?", id).Limit(1).Find(&current).Error; err != nil {
+			return fmt.Errorf("failed to get current task status: %w", err)
+		}
 
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("task not found with id %s", id)
-	}
+		result := tx.Model(&entity.Task{}).Where("id = ?", id).Update("status", status)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update task status: %w", result.Error)
+		}
 
-	return nil
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("task not found with id %s", id)
+		}
+
+		if current.Status == status {
+			return nil
+		}
+		history := &entity.TaskStatusHistory{
+			TaskID:     id,
+			FromStatus: &current.Status,
+			ToStatus:   status,
+		}
+		if actor := repository.ActorFromContext(ctx); actor != "" {
+			history.ChangedBy = &actor
+		}
+		if err := tx.Create(history).Error; err != nil {
+			return fmt.Errorf("failed to create status history: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // GetByStatus retrieves all tasks with a specific status
@@ -261,6 +389,25 @@ func (r *taskRepository) GetStatusHistory(ctx context.Context, taskID uuid.UUID)
 	return historyPtrs, nil
 }
 
+// GetStatusHistoriesByProjectID retrieves the status history of every task
+// of a project, grouped by task and oldest first
+func (r *taskRepository) GetStatusHistoriesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.TaskStatusHistory, error) {
+	var history []entity.TaskStatusHistory
+
+	taskIDs := r.db.WithContext(ctx).Model(&entity.Task{}).Select("id").Where("project_id = ?", projectID)
+	result := r.db.WithContext(ctx).Where("task_id IN (?)", taskIDs).Order("task_id, created_at ASC").Find(&history)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get project status history: %w", result.Error)
+	}
+
+	historyPtrs := make([]*entity.TaskStatusHistory, len(history))
+	for i := range history {
+		historyPtrs[i] = &history[i]
+	}
+
+	return historyPtrs, nil
+}
+
 // GetStatusAnalytics generates status analytics for a project
 func (r *taskRepository) GetStatusAnalytics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatusAnalytics, error) {
 	analytics := &entity.TaskStatusAnalytics{
@@ -297,8 +444,40 @@ func (r *taskRepository) GetStatusAnalytics(ctx context.Context, projectID uuid.
 		analytics.CompletionRate = float64(analytics.CompletedTasks) / float64(analytics.TotalTasks) * 100
 	}
 
-	// Get average time in status
+	// Get average time in status. Each transition ends a stay in its
+	// from_status, which began at the task's previous transition or, for the
+	// first one, at the task's creation. Stays still ongoing are left out.
 	analytics.AverageTimeInStatus = make(map[entity.TaskStatus]float64)
+	var stays []struct {
+		Status entity.TaskStatus
+		Hours  float64
+	}
+
+	stayQuery := `
+		SELECT
+			from_status AS status,
+			AVG(` + hoursBetween(r.db.DB, "entered_at", "left_at") + `) AS hours
+		FROM (
+			SELECT
+				h.from_status,
+				h.created_at AS left_at,
+				COALESCE(LAG(h.created_at) OVER (PARTITION BY h.task_id ORDER BY h.created_at), t.created_at) AS entered_at
+			FROM task_status_histories h
+			JOIN tasks t ON t.id = h.task_id
+			WHERE t.project_id = ? AND t.deleted_at IS NULL
+			AND h.deleted_at IS NULL
+		) stays
+		WHERE from_status IS NOT NULL
+		GROUP BY from_status
+	`
+
+	if err := r.db.WithContext(ctx).Raw(stayQuery, projectID).Scan(&stays).Error; err != nil {
+		return nil, fmt.Errorf("failed to get average time in status: %w", err)
+	}
+
+	for _, stay := range stays {
+		analytics.AverageTimeInStatus[stay.Status] = stay.Hours
+	}
 
 	// Get transition counts
 	analytics.TransitionCount = make(map[string]int)
@@ -337,7 +516,7 @@ func (r *taskRepository) GetStatusAnalytics(ctx context.Context, projectID uuid.
 
 // GetTasksWithFilters retrieves tasks with various filtering options
 func (r *taskRepository) GetTasksWithFilters(ctx context.Context, filters entity.TaskFilters) ([]*entity.Task, error) {
-	query := r.db.WithContext(ctx).Model(&entity.Task{})
+	query := r.scoped(ctx).Model(&entity.Task{})
 
 	// Apply filters
 	if filters.ProjectID != nil {
@@ -408,16 +587,24 @@ func (r *taskRepository) GetTasksWithFilters(ctx context.Context, filters entity
 
 // SearchTasks performs full-text search on tasks
 func (r *taskRepository) SearchTasks(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.TaskSearchResult, error) {
-	searchQuery := r.db.WithContext(ctx).Model(&entity.Task{}).
-		Select("*, ts_rank(to_tsvector('english', title || ' ' || COALESCE(description, '')), plainto_tsquery('english', ?)) as rank", query).
-		Where("to_tsvector('english', title || ' ' || COALESCE(description, '')) @@ plainto_tsquery('english', ?)", query)
+	var searchQuery *gorm.DB
+	if database.IsSQLite(r.db.DB) {
+		// No full-text search on SQLite; fall back to substring matching
+		pattern := "%" + query + "%"
+		searchQuery = r.db.WithContext(ctx).Model(&entity.Task{}).
+			Where("title LIKE ? OR COALESCE(description, '') LIKE ?", pattern, pattern).
+			Order("created_at DESC")
+	} else {
+		searchQuery = r.db.WithContext(ctx).Model(&entity.Task{}).
+			Select("*, ts_rank(to_tsvector('english', title || ' ' || COALESCE(description, '')), plainto_tsquery('english', ?)) as rank", query).
+			Where("to_tsvector('english', title || ' ' || COALESCE(description, '')) @@ plainto_tsquery('english', ?)", query).
+			Order("rank DESC")
+	}
 
 	if projectID != nil {
 		searchQuery = searchQuery.Where("project_id = ?", *projectID)
 	}
 
-	searchQuery = searchQuery.Order("rank DESC")
-
 	var tasks []entity.Task
 	if err := searchQuery.Find(&tasks).Error; err != nil {
 		return nil, fmt.Errorf("failed to search tasks: %w", err)
@@ -456,12 +643,12 @@ func (r *taskRepository) GetTasksByPriority(ctx context.Context, priority entity
 func (r *taskRepository) GetTasksByTags(ctx context.Context, tags []string) ([]*entity.Task, error) {
 	var tasks []entity.Task
 
-	// Using JSONB containment operator
+	// Using JSONB containment operator (json_each on SQLite)
 	tagConditions := make([]string, len(tags))
 	args := make([]interface{}, len(tags))
 	for i, tag := range tags {
-		tagConditions[i] = "tags @> ?"
-		args[i] = fmt.Sprintf(`["%s"]`, tag)
+		tagConditions[i] = jsonArrayContains(r.db.DB, "tags")
+		args[i] = jsonArrayElement(r.db.DB, tag)
 	}
 
 	query := r.db.WithContext(ctx).Where(strings.Join(tagConditions, " OR "), args...)
@@ -521,7 +708,7 @@ func (r *taskRepository) GetTasksWithSubtasks(ctx context.Context, projectID uui
 func (r *taskRepository) GetSubtasks(ctx context.Context, parentTaskID uuid.UUID) ([]*entity.Task, error) {
 	var tasks []entity.Task
 
-	result := r.db.WithContext(ctx).Where("parent_task_id = ?", parentTaskID).Order("created_at ASC").Find(&tasks)
+	result := r.scoped(ctx).Where("parent_task_id = ?", parentTaskID).Order("created_at ASC").Find(&tasks)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get subtasks: %w", result.Error)
 	}
@@ -590,6 +777,16 @@ func (r *taskRepository) BulkUnarchive(ctx context.Context, taskIDs []uuid.UUID)
 	return nil
 }
 
+// BulkRestore restores multiple deleted tasks
+func (r *taskRepository) BulkRestore(ctx context.Context, taskIDs []uuid.UUID) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&entity.Task{}).Where("id IN ?", taskIDs).Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to bulk restore tasks: %w", result.Error)
+	}
+
+	return nil
+}
+
 // BulkUpdatePriority updates priority for multiple tasks
 func (r *taskRepository) BulkUpdatePriority(ctx context.Context, taskIDs []uuid.UUID, priority entity.TaskPriority) error {
 	result := r.db.WithContext(ctx).Model(&entity.Task{}).Where("id IN ?", taskIDs).Update("priority", priority)
@@ -860,7 +1057,7 @@ func (r *taskRepository) AddComment(ctx context.Context, comment *entity.TaskCom
 func (r *taskRepository) GetComments(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskComment, error) {
 	var comments []entity.TaskComment
 
-	result := r.db.WithContext(ctx).Where("task_id = ?", taskID).Order("created_at ASC").Find(&comments)
+	result := scopeByTask(ctx, r.db.WithContext(ctx), "task_id").Where("task_id = ?", taskID).Order("created_at ASC").Find(&comments)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get comments: %w", result.Error)
 	}
@@ -873,11 +1070,26 @@ func (r *taskRepository) GetComments(ctx context.Context, taskID uuid.UUID) ([]*
 	return commentPtrs, nil
 }
 
+// GetCommentByID retrieves a comment by ID
+func (r *taskRepository) GetCommentByID(ctx context.Context, commentID uuid.UUID) (*entity.TaskComment, error) {
+	var comment entity.TaskComment
+
+	result := scopeByTask(ctx, r.db.WithContext(ctx), "task_id").First(&comment, "id = ?", commentID)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("comment not found with id %s", commentID)
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", result.Error)
+	}
+
+	return &comment, nil
+}
+
 // GetPlansByTaskID retrieves all plans for a task, sorted by created_at descending
 func (r *taskRepository) GetPlansByTaskID(ctx context.Context, taskID uuid.UUID) ([]entity.Plan, error) {
 	var plans []entity.Plan
 
-	result := r.db.WithContext(ctx).Where("task_id = ?", taskID).Order("created_at DESC").Find(&plans)
+	result := scopeByTask(ctx, r.db.WithContext(ctx), "task_id").Where("task_id = ?", taskID).Order("created_at DESC").Find(&plans)
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get plans: %w", result.Error)
 	}
@@ -885,19 +1097,24 @@ func (r *taskRepository) GetPlansByTaskID(ctx context.Context, taskID uuid.UUID)
 	return plans, nil
 }
 
-// UpdateComment updates a comment
+// UpdateComment updates the text of a comment
 func (r *taskRepository) UpdateComment(ctx context.Context, comment *entity.TaskComment) error {
-	result := r.db.WithContext(ctx).Save(comment)
+	result := scopeByTask(ctx, r.db.WithContext(ctx), "task_id").
+		Model(comment).
+		Updates(map[string]interface{}{"comment": comment.Comment, "updated_at": comment.UpdatedAt})
 	if result.Error != nil {
 		return fmt.Errorf("failed to update comment: %w", result.Error)
 	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("comment not found with id %s", comment.ID)
+	}
 
 	return nil
 }
 
 // DeleteComment deletes a comment
 func (r *taskRepository) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&entity.TaskComment{}, "id = ?", commentID)
+	result := scopeByTask(ctx, r.db.WithContext(ctx), "task_id").Delete(&entity.TaskComment{}, "id = ?", commentID)
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete comment: %w", result.Error)
 	}
@@ -969,6 +1186,19 @@ func (r *taskRepository) GetTasksEligibleForWorktreeCleanup(ctx context.Context,
 	return tasks, nil
 }
 
+// GetTasksWithWorktree finds tasks that have a worktree path
+func (r *taskRepository) GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error) {
+	var tasks []*entity.Task
+
+	if err := r.db.WithContext(ctx).
+		Where("worktree_path IS NOT NULL AND worktree_path != ''").
+		Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("failed to get tasks with worktree: %w", err)
+	}
+
+	return tasks, nil
+}
+
 // AppendErrorLog appends an error message to the task's error_logs column, keeping at most 1000 entries.
 func (r *taskRepository) AppendErrorLog(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
 	var raw struct {
@@ -1010,3 +1240,25 @@ func (r *taskRepository) AppendErrorLog(ctx context.Context, taskID uuid.UUID, e
 
 	return nil
 }
+
+// IncrementPlanningIterations counts one more planning run of a task
+func (r *taskRepository) IncrementPlanningIterations(ctx context.Context, taskID uuid.UUID) (int, error) {
+	var iterations int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.Task{}).Where("id = ?", taskID).
+			UpdateColumn("planning_iterations", gorm.Expr("planning_iterations + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("failed to increment planning iterations: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("task not found with id %s", taskID)
+		}
+
+		return tx.Model(&entity.Task{}).Select("planning_iterations").Where("id = ?", taskID).Scan(&iterations).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return iterations, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/google/uuid"
@@ -128,6 +129,124 @@ func TestTaskRepository_GetByID_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "task not found")
 }
 
+func TestTaskRepository_GetDetail(t *testing.T) {
+	db := SetupTestDB(t)
+	defer TeardownTestDB()
+
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	project := CreateTestProject(t, projectRepo, ctx)
+	task := &entity.Task{ProjectID: project.ID, Title: "Test Task", Status: entity.TaskStatusIMPLEMENTING}
+	require.NoError(t, taskRepo.Create(ctx, task))
+
+	detail, err := taskRepo.GetDetail(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, detail.Task.ID)
+	assert.Nil(t, detail.LatestPlan())
+	assert.Nil(t, detail.LatestExecution())
+	assert.Nil(t, detail.PullRequest())
+	assert.Empty(t, detail.Task.Comments)
+
+	earlier := time.Now().Add(-time.Hour)
+	records := []any{
+		&entity.Plan{ID: uuid.New(), TaskID: task.ID, Content: "old plan", Status: entity.PlanStatusREJECTED, CreatedAt: earlier},
+		&entity.Plan{ID: uuid.New(), TaskID: task.ID, Content: "new plan", Status: entity.PlanStatusAPPROVED},
+		&entity.Execution{ID: uuid.New(), TaskID: task.ID, Status: entity.ExecutionStatusFailed, StartedAt: earlier, CreatedAt: earlier},
+		&entity.TaskComment{ID: uuid.New(), TaskID: task.ID, Comment: "second", CreatedBy: "bob"},
+		&entity.TaskComment{ID: uuid.New(), TaskID: task.ID, Comment: "first", CreatedBy: "alice", CreatedAt: earlier},
+	}
+	latestExecution := &entity.Execution{ID: uuid.New(), TaskID: task.ID, Status: entity.ExecutionStatusRunning, StartedAt: time.Now()}
+	records = append(records, latestExecution)
+	for _, log := range CreateTestExecutionLogs(latestExecution.ID, 2) {
+		records = append(records, log)
+	}
+	for _, record := range records {
+		require.NoError(t, db.WithContext(ctx).Create(record).Error)
+	}
+
+	detail, err = taskRepo.GetDetail(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, detail.LatestPlan())
+	assert.Equal(t, "new plan", detail.LatestPlan().Content)
+	require.NotNil(t, detail.LatestExecution())
+	assert.Equal(t, latestExecution.ID, detail.LatestExecution().ID)
+	assert.Equal(t, int64(2), detail.LatestExecutionLogCount)
+	require.Len(t, detail.Task.Comments, 2)
+	assert.Equal(t, "first", detail.Task.Comments[0].Comment)
+
+	_, err = taskRepo.GetDetail(ctx, uuid.New())
+	assert.ErrorContains(t, err, "task not found")
+}
+
+func TestTaskRepository_GetBoardTasks(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	create := func(title string, priority entity.TaskPriority, updatedAt time.Time) *entity.Task {
+		task := &entity.Task{ProjectID: project.ID, Title: title, Priority: priority, Tags: []string{"billing"}}
+		require.NoError(t, taskRepo.Create(ctx, task))
+		require.NoError(t, db.Model(task).UpdateColumn("updated_at", updatedAt).Error)
+		return task
+	}
+	now := time.Now().UTC()
+	low := create("Low", entity.TaskPriorityLow, now)
+	oldUrgent := create("Old urgent", entity.TaskPriorityUrgent, now.Add(-time.Hour))
+	newUrgent := create("New urgent", entity.TaskPriorityUrgent, now)
+	medium := create("Medium", entity.TaskPriorityMedium, now)
+	archived := create("Archived", entity.TaskPriorityUrgent, now)
+	require.NoError(t, db.Model(archived).UpdateColumn("is_archived", true).Error)
+
+	tasks, err := taskRepo.GetBoardTasks(ctx, project.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	ids := []uuid.UUID{tasks[0].ID, tasks[1].ID, tasks[2].ID, tasks[3].ID}
+	assert.Equal(t, []uuid.UUID{newUrgent.ID, oldUrgent.ID, medium.ID, low.ID}, ids)
+	assert.Equal(t, "New urgent", tasks[0].Title)
+	assert.Equal(t, []string{"billing"}, tasks[0].Tags)
+	assert.Empty(t, tasks[0].Description, "only card fields are loaded")
+
+	epic := &entity.Epic{ProjectID: project.ID, Title: "Invoicing v2"}
+	require.NoError(t, NewEpicRepository(db).Create(ctx, epic))
+	require.NoError(t, db.Model(medium).UpdateColumn("epic_id", epic.ID).Error)
+
+	tasks, err = taskRepo.GetBoardTasks(ctx, project.ID, &epic.ID)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, medium.ID, tasks[0].ID)
+	assert.Equal(t, &epic.ID, tasks[0].EpicID)
+}
+
+func TestTaskRepository_BulkRestore(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	first := &entity.Task{ProjectID: project.ID, Title: "First"}
+	second := &entity.Task{ProjectID: project.ID, Title: "Second"}
+	require.NoError(t, taskRepo.Create(ctx, first))
+	require.NoError(t, taskRepo.Create(ctx, second))
+	ids := []uuid.UUID{first.ID, second.ID}
+
+	require.NoError(t, taskRepo.BulkDelete(ctx, ids))
+	tasks, err := taskRepo.GetByIDs(ctx, ids)
+	require.NoError(t, err)
+	assert.Empty(t, tasks, "deleted tasks are not found")
+
+	require.NoError(t, taskRepo.BulkRestore(ctx, ids))
+	tasks, err = taskRepo.GetByIDs(ctx, ids)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
 func TestTaskRepository_GetByProjectID(t *testing.T) {
 	db := SetupTestDB(t)
 	defer TeardownTestDB()
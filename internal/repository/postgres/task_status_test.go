@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/google/uuid"
@@ -360,6 +361,48 @@ func TestTaskRepository_GetStatusAnalytics(t *testing.T) {
 	assert.Equal(t, 1, statusCounts[entity.TaskStatusIMPLEMENTING])
 }
 
+func TestTaskRepository_GetStatusAnalytics_AverageTimeInStatus(t *testing.T) {
+	db := SetupTestDB(t)
+	defer TeardownTestDB()
+
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	ctx := context.Background()
+
+	project := CreateTestProject(t, projectRepo, ctx)
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	// Each task starts in TODO, then moves through the given statuses at the
+	// given hours after its creation
+	createTask := func(title string, transitions []entity.TaskStatus, hours []int) {
+		task := &entity.Task{ProjectID: project.ID, Title: title, Status: transitions[len(transitions)-1]}
+		require.NoError(t, taskRepo.Create(ctx, task))
+		require.NoError(t, db.WithContext(ctx).Model(task).UpdateColumn("created_at", start).Error)
+
+		from := entity.TaskStatusTODO
+		for i, to := range transitions {
+			fromStatus := from
+			require.NoError(t, db.WithContext(ctx).Create(&entity.TaskStatusHistory{
+				TaskID:     task.ID,
+				FromStatus: &fromStatus,
+				ToStatus:   to,
+				CreatedAt:  start.Add(time.Duration(hours[i]) * time.Hour),
+			}).Error)
+			from = to
+		}
+	}
+	createTask("Login", []entity.TaskStatus{entity.TaskStatusPLANNING, entity.TaskStatusIMPLEMENTING, entity.TaskStatusDONE}, []int{2, 3, 7})
+	createTask("Search", []entity.TaskStatus{entity.TaskStatusPLANNING, entity.TaskStatusIMPLEMENTING}, []int{4, 9})
+
+	analytics, err := taskRepo.GetStatusAnalytics(ctx, project.ID)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 3.0, analytics.AverageTimeInStatus[entity.TaskStatusTODO], 1e-6)
+	assert.InDelta(t, 3.0, analytics.AverageTimeInStatus[entity.TaskStatusPLANNING], 1e-6)
+	assert.InDelta(t, 4.0, analytics.AverageTimeInStatus[entity.TaskStatusIMPLEMENTING], 1e-6, "ongoing stays are left out")
+	assert.NotContains(t, analytics.AverageTimeInStatus, entity.TaskStatusDONE)
+}
+
 func TestTaskRepository_GetTasksWithFilters(t *testing.T) {
 	db := SetupTestDB(t)
 	defer TeardownTestDB()
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"gorm.io/gorm"
+)
+
+// scopeByProject restricts query to the rows whose project, referenced by
+// projectColumn, belongs to the organization carried by ctx. Requests
+// without an organization see every row.
+func scopeByProject(ctx context.Context, query *gorm.DB, projectColumn string) *gorm.DB {
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok {
+		query = query.Where(projectColumn+" IN (SELECT id FROM projects WHERE organization_id = ?)", orgID)
+	}
+	return query
+}
+
+// scopeByTask is scopeByProject for rows reaching their project through the
+// task referenced by taskColumn
+func scopeByTask(ctx context.Context, query *gorm.DB, taskColumn string) *gorm.DB {
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok {
+		query = query.Where(taskColumn+" IN (SELECT tasks.id FROM tasks JOIN projects ON projects.id = tasks.project_id WHERE projects.organization_id = ?)", orgID)
+	}
+	return query
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantScoping_TasksPlansExecutions(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	orgRepo := NewOrganizationRepository(db)
+	projectRepo := NewProjectRepository(db)
+	taskRepo := NewTaskRepository(db)
+	planRepo := NewPlanRepository(db)
+	executionRepo := NewExecutionRepository(db)
+
+	ours := &entity.Organization{Name: "Ours", Slug: "ours"}
+	theirs := &entity.Organization{Name: "Theirs", Slug: "theirs"}
+	require.NoError(t, orgRepo.Create(ctx, ours))
+	require.NoError(t, orgRepo.Create(ctx, theirs))
+	ourCtx := repository.WithOrganizationID(ctx, ours.ID)
+	theirCtx := repository.WithOrganizationID(ctx, theirs.ID)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(theirCtx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix invoice rounding"}
+	require.NoError(t, taskRepo.Create(ctx, task))
+	plan := &entity.Plan{TaskID: task.ID, Content: "Round half up"}
+	require.NoError(t, planRepo.Create(ctx, plan))
+	execution := &entity.Execution{TaskID: task.ID}
+	require.NoError(t, executionRepo.Create(ctx, execution))
+	comment := &entity.TaskComment{TaskID: task.ID, Comment: "Looks good", CreatedBy: "alice"}
+	require.NoError(t, taskRepo.AddComment(ctx, comment))
+
+	t.Run("the organization's own rows are found", func(t *testing.T) {
+		_, err := taskRepo.GetByID(theirCtx, task.ID)
+		assert.NoError(t, err)
+		_, err = planRepo.GetByID(theirCtx, plan.ID)
+		assert.NoError(t, err)
+		_, err = executionRepo.GetByID(theirCtx, execution.ID)
+		assert.NoError(t, err)
+		comments, err := taskRepo.GetComments(theirCtx, task.ID)
+		require.NoError(t, err)
+		assert.Len(t, comments, 1)
+	})
+
+	t.Run("another organization's rows are not", func(t *testing.T) {
+		_, err := taskRepo.GetByID(ourCtx, task.ID)
+		assert.Error(t, err)
+		tasks, err := taskRepo.GetByProjectID(ourCtx, project.ID)
+		require.NoError(t, err)
+		assert.Empty(t, tasks)
+
+		_, err = planRepo.GetByID(ourCtx, plan.ID)
+		assert.Error(t, err)
+		_, err = planRepo.GetByTaskID(ourCtx, task.ID)
+		assert.Error(t, err)
+
+		_, err = executionRepo.GetByID(ourCtx, execution.ID)
+		assert.Error(t, err)
+		executions, err := executionRepo.GetByTaskID(ourCtx, task.ID)
+		require.NoError(t, err)
+		assert.Empty(t, executions)
+
+		comments, err := taskRepo.GetComments(ourCtx, task.ID)
+		require.NoError(t, err)
+		assert.Empty(t, comments)
+		comment.Comment = "Hijacked"
+		assert.Error(t, taskRepo.UpdateComment(ourCtx, comment))
+		require.NoError(t, taskRepo.DeleteComment(ourCtx, comment.ID))
+
+		comments, err = taskRepo.GetComments(theirCtx, task.ID)
+		require.NoError(t, err)
+		require.Len(t, comments, 1, "the comment was not deleted")
+		assert.Equal(t, "Looks good", comments[0].Comment)
+	})
+
+	t.Run("unscoped requests see every row", func(t *testing.T) {
+		_, err := executionRepo.GetByID(ctx, execution.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestTenantScoping_JiraIntegrations(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	orgRepo := NewOrganizationRepository(db)
+	projectRepo := NewProjectRepository(db)
+	jiraRepo := NewJiraIntegrationRepository(db)
+
+	ours := &entity.Organization{Name: "Ours", Slug: "ours"}
+	theirs := &entity.Organization{Name: "Theirs", Slug: "theirs"}
+	require.NoError(t, orgRepo.Create(ctx, ours))
+	require.NoError(t, orgRepo.Create(ctx, theirs))
+	ourCtx := repository.WithOrganizationID(ctx, ours.ID)
+	theirCtx := repository.WithOrganizationID(ctx, theirs.ID)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(theirCtx, project))
+	integration := &entity.JiraIntegration{ProjectID: project.ID, BaseURL: "https://acme.atlassian.net", Email: "bot@acme.com", JQL: "project = BILL"}
+	require.NoError(t, jiraRepo.Save(theirCtx, integration))
+
+	found, err := jiraRepo.GetByProjectID(ourCtx, project.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found, "another organization's integration is not found")
+	assert.Error(t, jiraRepo.Delete(ourCtx, project.ID))
+
+	found, err = jiraRepo.GetByProjectID(theirCtx, project.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found, "the integration was not deleted")
+	assert.Equal(t, integration.ID, found.ID)
+}
+
+func TestTenantScoping_ReleaseNotes(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	orgRepo := NewOrganizationRepository(db)
+	projectRepo := NewProjectRepository(db)
+	releaseNotesRepo := NewReleaseNotesRepository(db)
+
+	ours := &entity.Organization{Name: "Ours", Slug: "ours"}
+	theirs := &entity.Organization{Name: "Theirs", Slug: "theirs"}
+	require.NoError(t, orgRepo.Create(ctx, ours))
+	require.NoError(t, orgRepo.Create(ctx, theirs))
+	ourCtx := repository.WithOrganizationID(ctx, ours.ID)
+	theirCtx := repository.WithOrganizationID(ctx, theirs.ID)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(theirCtx, project))
+	notes := &entity.ReleaseNotes{ProjectID: project.ID, Version: "1.2.0", Source: entity.ReleaseNotesSourceTasks, Status: entity.ReleaseNotesStatusPending}
+	require.NoError(t, releaseNotesRepo.Create(theirCtx, notes))
+
+	_, err := releaseNotesRepo.GetByID(theirCtx, notes.ID)
+	assert.NoError(t, err)
+	_, err = releaseNotesRepo.GetByID(ourCtx, notes.ID)
+	assert.Error(t, err)
+	listed, err := releaseNotesRepo.ListByProjectID(ourCtx, project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+func TestOrganizationRepository_Members(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	orgRepo := NewOrganizationRepository(db)
+	projectRepo := NewProjectRepository(db)
+	userRepo := NewUserRepository(db)
+
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, projectRepo.Create(ctx, project))
+	alice := &entity.User{Username: "alice", PasswordHash: "hash"}
+	require.NoError(t, userRepo.Create(ctx, alice))
+
+	org := &entity.Organization{Name: "Ours", Slug: "ours"}
+	require.NoError(t, orgRepo.Create(ctx, org))
+	count, err := orgRepo.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, orgRepo.AdoptUnassigned(ctx, org.ID))
+	project, err = projectRepo.GetByID(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &org.ID, project.OrganizationID)
+	alice, err = userRepo.GetByID(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &org.ID, alice.OrganizationID)
+
+	bob := &entity.User{Username: "bob", PasswordHash: "hash"}
+	require.NoError(t, userRepo.Create(ctx, bob))
+	added, err := orgRepo.AddUserByUsername(ctx, org.ID, "bob")
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	other := &entity.Organization{Name: "Theirs", Slug: "theirs"}
+	require.NoError(t, orgRepo.Create(ctx, other))
+	added, err = orgRepo.AddUser(ctx, other.ID, alice.ID)
+	require.NoError(t, err)
+	assert.False(t, added, "users of an organization are not moved to another")
+	added, err = orgRepo.AddUserByUsername(ctx, other.ID, "nobody")
+	require.NoError(t, err)
+	assert.False(t, added)
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"gorm.io/gorm"
+)
+
+type undoOperationRepository struct {
+	db *database.GormDB
+}
+
+// NewUndoOperationRepository creates a new PostgreSQL undo operation repository
+func NewUndoOperationRepository(db *database.GormDB) repository.UndoOperationRepository {
+	return &undoOperationRepository{db: db}
+}
+
+// Create records an undoable operation
+func (r *undoOperationRepository) Create(ctx context.Context, operation *entity.UndoOperation) error {
+	result := r.db.WithContext(ctx).Create(operation)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create undo operation: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByToken retrieves the operation an undo token was issued for
+func (r *undoOperationRepository) GetByToken(ctx context.Context, token string) (*entity.UndoOperation, error) {
+	var operation entity.UndoOperation
+
+	result := r.db.WithContext(ctx).First(&operation, "token = ?", token)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("undo operation not found")
+		}
+		return nil, fmt.Errorf("failed to get undo operation: %w", result.Error)
+	}
+
+	return &operation, nil
+}
+
+// MarkUndone sets undone_at unless it is already set
+func (r *undoOperationRepository) MarkUndone(ctx context.Context, token string, undoneAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entity.UndoOperation{}).
+		Where("token = ? AND undone_at IS NULL", token).
+		Update("undone_at", undoneAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark undo operation undone: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteExpiredBefore deletes the operations that expired before the given time
+func (r *undoOperationRepository) DeleteExpiredBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&entity.UndoOperation{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete undo operations: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoOperationRepository(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewUndoOperationRepository(db)
+	project := &entity.Project{Name: "Billing Service"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	now := time.Now().UTC()
+
+	taskIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	require.NoError(t, repo.Create(ctx, &entity.UndoOperation{
+		Token:     "live",
+		ProjectID: project.ID,
+		Operation: entity.UndoOperationBulkDelete,
+		TaskIDs:   taskIDs,
+		ExpiresAt: now.Add(time.Minute),
+	}))
+	require.NoError(t, repo.Create(ctx, &entity.UndoOperation{
+		Token:     "expired",
+		ProjectID: project.ID,
+		Operation: entity.UndoOperationBulkArchive,
+		ExpiresAt: now.Add(-time.Minute),
+	}))
+
+	operation, err := repo.GetByToken(ctx, "live")
+	require.NoError(t, err)
+	assert.Equal(t, entity.UndoOperationBulkDelete, operation.Operation)
+	assert.Equal(t, taskIDs, operation.TaskIDs)
+	assert.Nil(t, operation.UndoneAt)
+
+	_, err = repo.GetByToken(ctx, "unknown")
+	assert.Error(t, err)
+
+	undone, err := repo.MarkUndone(ctx, "live", now)
+	require.NoError(t, err)
+	assert.True(t, undone)
+	undone, err = repo.MarkUndone(ctx, "live", now)
+	require.NoError(t, err)
+	assert.False(t, undone, "an operation is undone once")
+
+	deleted, err := repo.DeleteExpiredBefore(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+	_, err = repo.GetByToken(ctx, "expired")
+	assert.Error(t, err)
+}
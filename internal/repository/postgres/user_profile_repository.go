@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"gorm.io/gorm/clause"
+)
+
+type userProfileRepository struct {
+	db *database.GormDB
+}
+
+// NewUserProfileRepository creates a new PostgreSQL user profile repository
+func NewUserProfileRepository(db *database.GormDB) repository.UserProfileRepository {
+	return &userProfileRepository{db: db}
+}
+
+// ListByUsernames retrieves the saved profiles of the users
+func (r *userProfileRepository) ListByUsernames(ctx context.Context, usernames []string) ([]*entity.UserProfile, error) {
+	var profiles []*entity.UserProfile
+	if len(usernames) == 0 {
+		return profiles, nil
+	}
+
+	result := r.db.WithContext(ctx).
+		Where("username IN ?", usernames).
+		Order("username ASC").
+		Find(&profiles)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list user profiles: %w", result.Error)
+	}
+
+	return profiles, nil
+}
+
+// Save inserts the profile, or updates the saved one keeping its creation
+// time
+func (r *userProfileRepository) Save(ctx context.Context, profile *entity.UserProfile) error {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "username"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"display_name", "timezone", "avatar_path", "avatar_mime_type", "avatar_size", "avatar_updated_at", "updated_at",
+		}),
+	}).Create(profile)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save user profile: %w", result.Error)
+	}
+
+	return nil
+}
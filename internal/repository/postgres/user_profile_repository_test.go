@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserProfileRepository_Save(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewUserProfileRepository(db)
+
+	alice := entity.NewUserProfile("alice")
+	alice.DisplayName = "Alice Nguyen"
+	require.NoError(t, repo.Save(ctx, alice))
+	require.NoError(t, repo.Save(ctx, &entity.UserProfile{Username: "bob", Timezone: "Europe/Paris"}))
+
+	avatarPath := "avatars/alice"
+	now := time.Now().UTC().Truncate(time.Second)
+	alice.Timezone = "Asia/Ho_Chi_Minh"
+	alice.AvatarPath = &avatarPath
+	alice.AvatarMimeType = "image/png"
+	alice.AvatarUpdatedAt = &now
+	require.NoError(t, repo.Save(ctx, alice), "saving again replaces the profile")
+
+	profiles, err := repo.ListByUsernames(ctx, []string{"alice", "bob", "carol"})
+	require.NoError(t, err)
+	require.Len(t, profiles, 2, "users without a profile are left out")
+	assert.Equal(t, "Alice Nguyen", profiles[0].DisplayName)
+	assert.Equal(t, "Asia/Ho_Chi_Minh", profiles[0].Timezone)
+	assert.Equal(t, &avatarPath, profiles[0].AvatarPath)
+	require.NotNil(t, profiles[0].AvatarUpdatedAt)
+	assert.True(t, now.Equal(*profiles[0].AvatarUpdatedAt))
+	assert.Equal(t, "bob", profiles[1].Username)
+
+	profiles, err = repo.ListByUsernames(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+}
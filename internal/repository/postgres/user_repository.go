@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type userRepository struct {
+	db *database.GormDB
+}
+
+// NewUserRepository creates a new PostgreSQL user repository
+func NewUserRepository(db *database.GormDB) repository.UserRepository {
+	return &userRepository{db: db}
+}
+
+// Create creates a new user
+func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(user)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create user: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a user by ID
+func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	var user entity.User
+
+	result := r.db.WithContext(ctx).First(&user, "id = ?", id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user not found with id %s", id)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", result.Error)
+	}
+
+	return &user, nil
+}
+
+// GetByUsername retrieves a user by username, nil when there is none
+func (r *userRepository) GetByUsername(ctx context.Context, username string) (*entity.User, error) {
+	var user entity.User
+
+	result := r.db.WithContext(ctx).First(&user, "username = ?", username)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user: %w", result.Error)
+	}
+
+	return &user, nil
+}
+
+// CreateAPIKey creates a new API key of a user
+func (r *userRepository) CreateAPIKey(ctx context.Context, key *entity.UserAPIKey) error {
+	if key.ID == uuid.Nil {
+		key.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Create(key)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create API key: %w", result.Error)
+	}
+
+	return nil
+}
+
+// GetAPIKeyByHash retrieves an API key by the hash of the key, nil when
+// there is none
+func (r *userRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.UserAPIKey, error) {
+	var key entity.UserAPIKey
+
+	result := r.db.WithContext(ctx).First(&key, "key_hash = ?", keyHash)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get API key: %w", result.Error)
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys retrieves the user's API keys, the newest first
+func (r *userRepository) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.UserAPIKey, error) {
+	var keys []*entity.UserAPIKey
+
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&keys)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", result.Error)
+	}
+
+	return keys, nil
+}
+
+// DeleteAPIKey deletes one of the user's API keys
+func (r *userRepository) DeleteAPIKey(ctx context.Context, userID, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&entity.UserAPIKey{}, "id = ? AND user_id = ?", id, userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete API key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("API key not found with id %s", id)
+	}
+
+	return nil
+}
+
+// TouchAPIKey records when the API key was last used
+func (r *userRepository) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&entity.UserAPIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to record API key use: %w", result.Error)
+	}
+
+	return nil
+}
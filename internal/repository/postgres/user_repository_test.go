@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository_APIKeys(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewUserRepository(db)
+
+	alice := &entity.User{Username: "alice", PasswordHash: "hash"}
+	require.NoError(t, repo.Create(ctx, alice))
+	found, err := repo.GetByUsername(ctx, "alice")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, alice.ID, found.ID)
+	found, err = repo.GetByUsername(ctx, "bob")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	_, err = repo.GetByID(ctx, uuid.New())
+	assert.Error(t, err)
+
+	older := &entity.UserAPIKey{UserID: alice.ID, Name: "laptop", Prefix: "adk_11111111", KeyHash: "hash-1", CreatedAt: time.Now().Add(-time.Hour)}
+	newer := &entity.UserAPIKey{UserID: alice.ID, Name: "CI", Prefix: "adk_22222222", KeyHash: "hash-2"}
+	require.NoError(t, repo.CreateAPIKey(ctx, older))
+	require.NoError(t, repo.CreateAPIKey(ctx, newer))
+
+	keys, err := repo.ListAPIKeys(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, newer.ID, keys[0].ID, "newest first")
+
+	usedAt := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.TouchAPIKey(ctx, older.ID, usedAt))
+	key, err := repo.GetAPIKeyByHash(ctx, "hash-1")
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	require.NotNil(t, key.LastUsedAt)
+	assert.True(t, usedAt.Equal(*key.LastUsedAt))
+
+	assert.Error(t, repo.DeleteAPIKey(ctx, uuid.New(), older.ID), "other users' keys are not deleted")
+	require.NoError(t, repo.DeleteAPIKey(ctx, alice.ID, older.ID))
+	key, err = repo.GetAPIKeyByHash(ctx, "hash-1")
+	require.NoError(t, err)
+	assert.Nil(t, key)
+}
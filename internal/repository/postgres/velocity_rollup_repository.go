@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type velocityRollupRepository struct {
+	db *database.GormDB
+}
+
+// NewVelocityRollupRepository creates a new PostgreSQL velocity rollup repository
+func NewVelocityRollupRepository(db *database.GormDB) repository.VelocityRollupRepository {
+	return &velocityRollupRepository{db: db}
+}
+
+// Upsert inserts the rollup or overwrites the counts of the existing rollup
+// of its project and week
+func (r *velocityRollupRepository) Upsert(ctx context.Context, rollup *entity.VelocityRollup) error {
+	if rollup.ID == uuid.Nil {
+		rollup.ID = uuid.New()
+	}
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "week_start"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tasks_completed", "pull_requests_merged", "executions", "updated_at"}),
+	}).Create(rollup)
+	if result.Error != nil {
+		return fmt.Errorf("failed to upsert velocity rollup: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ListByProjectID retrieves the project's rollups of the weeks starting in
+// [from, to), oldest first
+func (r *velocityRollupRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]*entity.VelocityRollup, error) {
+	var rollups []*entity.VelocityRollup
+
+	result := r.db.WithContext(ctx).
+		Where("project_id = ? AND week_start >= ? AND week_start < ?", projectID, from, to).
+		Order("week_start ASC").
+		Find(&rollups)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list velocity rollups: %w", result.Error)
+	}
+
+	return rollups, nil
+}
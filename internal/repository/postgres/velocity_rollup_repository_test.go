@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVelocityRollupRepository_Upsert(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+
+	repo := NewVelocityRollupRepository(db)
+	week := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Upsert(ctx, &entity.VelocityRollup{ProjectID: project.ID, WeekStart: week, TasksCompleted: 2}))
+	require.NoError(t, repo.Upsert(ctx, &entity.VelocityRollup{ProjectID: project.ID, WeekStart: week, TasksCompleted: 5, PullRequestsMerged: 4, Executions: 7}))
+	require.NoError(t, repo.Upsert(ctx, &entity.VelocityRollup{ProjectID: project.ID, WeekStart: week.AddDate(0, 0, -7), TasksCompleted: 1}))
+
+	rollups, err := repo.ListByProjectID(ctx, project.ID, week.AddDate(0, 0, -7), week.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	require.Len(t, rollups, 2, "a recomputed week replaces its rollup")
+	assert.True(t, rollups[0].WeekStart.Equal(week.AddDate(0, 0, -7)))
+	assert.Equal(t, 5, rollups[1].TasksCompleted)
+	assert.Equal(t, 4, rollups[1].PullRequestsMerged)
+	assert.Equal(t, 7, rollups[1].Executions)
+
+	rollups, err = repo.ListByProjectID(ctx, project.ID, week, week.AddDate(0, 0, 7))
+	require.NoError(t, err)
+	assert.Len(t, rollups, 1)
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"github.com/google/uuid"
+)
+
+type verificationRunRepository struct {
+	db *database.GormDB
+}
+
+// NewVerificationRunRepository creates a new PostgreSQL verification run repository
+func NewVerificationRunRepository(db *database.GormDB) repository.VerificationRunRepository {
+	return &verificationRunRepository{db: db}
+}
+
+// Create saves a verification run
+func (r *verificationRunRepository) Create(ctx context.Context, run *entity.VerificationRun) error {
+	if run.ID == uuid.Nil {
+		run.ID = uuid.New()
+	}
+
+	if err := r.db.WithContext(ctx).Create(run).Error; err != nil {
+		return fmt.Errorf("failed to create verification run: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the progress of a verification run
+func (r *verificationRunRepository) Update(ctx context.Context, run *entity.VerificationRun) error {
+	result := r.db.WithContext(ctx).Save(run)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update verification run: %w", result.Error)
+	}
+
+	return nil
+}
+
+// ListByExecutionID retrieves an execution's verification runs, attempt by
+// attempt in pipeline order
+func (r *verificationRunRepository) ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error) {
+	var runs []*entity.VerificationRun
+
+	result := r.db.WithContext(ctx).
+		Where("execution_id = ?", executionID).
+		Order("attempt ASC, position ASC, created_at ASC").
+		Find(&runs)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list verification runs: %w", result.Error)
+	}
+
+	return runs, nil
+}
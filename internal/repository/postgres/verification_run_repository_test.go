@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerificationRunRepository_ListByExecutionID(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+
+	project := &entity.Project{Name: "App"}
+	require.NoError(t, NewProjectRepository(db).Create(ctx, project))
+	task := &entity.Task{ProjectID: project.ID, Title: "Fix login"}
+	require.NoError(t, NewTaskRepository(db).Create(ctx, task))
+	execution := &entity.Execution{TaskID: task.ID, Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+	require.NoError(t, NewExecutionRepository(db).Create(ctx, execution))
+
+	repo := NewVerificationRunRepository(db)
+	started := time.Now().Add(-time.Minute)
+	testRun := &entity.VerificationRun{
+		ExecutionID: execution.ID, TaskID: task.ID, Step: entity.VerificationStepTest, Position: 1, Status: entity.VerificationRunStatusPending, Command: "make test",
+	}
+	require.NoError(t, repo.Create(ctx, testRun))
+	require.NoError(t, repo.Create(ctx, &entity.VerificationRun{
+		ExecutionID: execution.ID, TaskID: task.ID, Step: entity.VerificationStepLint, Position: 0, Status: entity.VerificationRunStatusFailed, Command: "make fmt",
+		ExitCode: 2, Passed: false, Output: "FAIL", FixesCommitted: true, StartedAt: started, CompletedAt: started.Add(10 * time.Second),
+	}))
+
+	require.NoError(t, repo.Create(ctx, &entity.VerificationRun{
+		ExecutionID: execution.ID, TaskID: task.ID, Step: entity.VerificationStepLint, Attempt: 1, Status: entity.VerificationRunStatusPassed, Command: "make fmt",
+		Passed: true, StartedAt: time.Now(), CompletedAt: time.Now(),
+	}))
+
+	testRun.Status = entity.VerificationRunStatusPassed
+	testRun.Passed = true
+	testRun.Output = "ok"
+	testRun.StartedAt = started.Add(30 * time.Second)
+	testRun.CompletedAt = time.Now()
+	require.NoError(t, repo.Update(ctx, testRun))
+
+	runs, err := repo.ListByExecutionID(ctx, execution.ID)
+	require.NoError(t, err)
+	require.Len(t, runs, 3)
+	assert.Equal(t, entity.VerificationStepLint, runs[0].Step, "runs are listed in pipeline order")
+	assert.Equal(t, 2, runs[0].ExitCode)
+	assert.True(t, runs[0].FixesCommitted)
+	assert.True(t, runs[1].Passed)
+	assert.Equal(t, entity.VerificationRunStatusPassed, runs[1].Status)
+	assert.Equal(t, "ok", runs[1].Output)
+	assert.Equal(t, 1, runs[2].Attempt, "runs after an auto-fix attempt come last")
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/pkg/database"
+	"gorm.io/gorm/clause"
+)
+
+type webhookDeliveryRepository struct {
+	db *database.GormDB
+}
+
+// NewWebhookDeliveryRepository creates a new PostgreSQL webhook delivery repository
+func NewWebhookDeliveryRepository(db *database.GormDB) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{db: db}
+}
+
+// Claim inserts the delivery unless it is already recorded
+func (r *webhookDeliveryRepository) Claim(ctx context.Context, provider, deliveryID string, receivedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&entity.WebhookDelivery{
+		Provider:   provider,
+		DeliveryID: deliveryID,
+		ReceivedAt: receivedAt,
+	})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim webhook delivery: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Release deletes the delivery
+func (r *webhookDeliveryRepository) Release(ctx context.Context, provider, deliveryID string) error {
+	result := r.db.WithContext(ctx).
+		Where("provider = ? AND delivery_id = ?", provider, deliveryID).
+		Delete(&entity.WebhookDelivery{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to release webhook delivery: %w", result.Error)
+	}
+
+	return nil
+}
+
+// DeleteReceivedBefore deletes the deliveries received before the given time
+func (r *webhookDeliveryRepository) DeleteReceivedBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("received_at < ?", before).
+		Delete(&entity.WebhookDelivery{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete webhook deliveries: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// TouchSource upserts the repository with the time of its latest event
+func (r *webhookDeliveryRepository) TouchSource(ctx context.Context, provider, repository string, at time.Time) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "provider"}, {Name: "repository"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_event_at"}),
+	}).Create(&entity.WebhookSource{
+		Provider:    provider,
+		Repository:  repository,
+		LastEventAt: at,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record webhook source: %w", err)
+	}
+
+	return nil
+}
+
+// ListSourcesSince lists the repositories with an event at or after since
+func (r *webhookDeliveryRepository) ListSourcesSince(ctx context.Context, provider string, since time.Time) ([]string, error) {
+	var repositories []string
+	err := r.db.WithContext(ctx).Model(&entity.WebhookSource{}).
+		Where("provider = ? AND last_event_at >= ?", provider, since).
+		Order("repository").
+		Pluck("repository", &repositories).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook sources: %w", err)
+	}
+
+	return repositories, nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDeliveryRepository_Claim(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewWebhookDeliveryRepository(db)
+	now := time.Now()
+
+	claimed, err := repo.Claim(ctx, "github", "delivery-1", now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.Claim(ctx, "github", "delivery-1", now)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a replay is not claimed")
+
+	claimed, err = repo.Claim(ctx, "gitlab", "delivery-1", now)
+	require.NoError(t, err)
+	assert.True(t, claimed, "delivery IDs are per provider")
+
+	require.NoError(t, repo.Release(ctx, "gitlab", "delivery-1"))
+	claimed, err = repo.Claim(ctx, "gitlab", "delivery-1", now)
+	require.NoError(t, err)
+	assert.True(t, claimed, "a released delivery can be delivered again")
+
+	deleted, err := repo.DeleteReceivedBefore(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestWebhookDeliveryRepository_Sources(t *testing.T) {
+	db := setupSQLiteDB(t)
+	ctx := context.Background()
+	repo := NewWebhookDeliveryRepository(db)
+	now := time.Now()
+
+	require.NoError(t, repo.TouchSource(ctx, "github", "acme/app", now.Add(-48*time.Hour)))
+	require.NoError(t, repo.TouchSource(ctx, "github", "acme/api", now.Add(-48*time.Hour)))
+	require.NoError(t, repo.TouchSource(ctx, "github", "acme/app", now), "a later event refreshes the source")
+	require.NoError(t, repo.TouchSource(ctx, "gitlab", "acme/web", now))
+
+	repositories, err := repo.ListSourcesSince(ctx, "github", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme/app"}, repositories)
+}
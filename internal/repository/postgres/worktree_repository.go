@@ -96,6 +96,23 @@ func (r *worktreeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Delete(&entity.Worktree{}, id).Error
 }
 
+// RestoreByTaskID undeletes the task's worktree record (clears deleted_at)
+func (r *worktreeRepository) RestoreByTaskID(ctx context.Context, taskID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&entity.Worktree{}).
+		Where("task_id = ? AND deleted_at IS NOT NULL", taskID).
+		Update("deleted_at", nil)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore worktree: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("deleted worktree not found for task %s", taskID)
+	}
+
+	return nil
+}
+
 // UpdateStatus updates the status of a worktree
 func (r *worktreeRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.WorktreeStatus) error {
 	// First check if the record exists
@@ -154,7 +171,7 @@ func (r *worktreeRepository) GetWorktreesWithFilters(ctx context.Context, filter
 	}
 
 	if filters.BranchName != nil {
-		query = query.Where("branch_name ILIKE ?", "%"+*filters.BranchName+"%")
+		query = query.Where(ilike(r.db, "branch_name"), "%"+*filters.BranchName+"%")
 	}
 
 	if filters.CreatedAfter != nil {
@@ -18,18 +18,49 @@ type ActiveTaskCounts struct {
 type ProjectRepository interface {
 	Create(ctx context.Context, project *entity.Project) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Project, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Project, error)
+	// GetByRepositoryURLs returns the projects whose repository URL is one of urls
+	GetByRepositoryURLs(ctx context.Context, urls []string) ([]*entity.Project, error)
 	GetAllWithParams(ctx context.Context, params GetProjectsParams) ([]*entity.Project, int, error)
 	Update(ctx context.Context, project *entity.Project) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetTaskStatistics(ctx context.Context, projectID uuid.UUID) (map[entity.TaskStatus]int, error)
 	GetLastActivityAt(ctx context.Context, projectID uuid.UUID) (*time.Time, error)
 	GetActiveTaskCountsBatch(ctx context.Context, projectIDs []uuid.UUID) (map[uuid.UUID]ActiveTaskCounts, error)
+	// GetActivity returns a page of what happened to the project's tasks,
+	// most recent first, and how many entries there are in all. A limit of 0
+	// returns every entry.
+	GetActivity(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]*entity.ProjectActivity, int, error)
+	// Search finds the projects, tasks, plans and pull requests matching
+	// params, at most params.Limit of each type, most recently updated
+	// first. Archived projects and tasks are left out.
+	Search(ctx context.Context, params SearchParams) ([]*entity.SearchResult, error)
 	Archive(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) error
 	CheckNameExists(ctx context.Context, name string, excludeID *uuid.UUID) (bool, error)
 	GetSettings(ctx context.Context, projectID uuid.UUID) (*entity.ProjectSettings, error)
 	CreateSettings(ctx context.Context, settings *entity.ProjectSettings) error
 	UpdateSettings(ctx context.Context, settings *entity.ProjectSettings) error
+	// GetVerificationPipeline returns the project's saved verification
+	// pipeline, or nil when it has none
+	GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)
+	SaveVerificationPipeline(ctx context.Context, pipeline *entity.VerificationPipeline) error
+	DeleteVerificationPipeline(ctx context.Context, projectID uuid.UUID) error
+	// GetWorkflowPresets returns the project's saved workflow presets
+	GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error)
+	SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) error
+	DeleteWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) error
+}
+
+// SearchParams describes a quick search
+type SearchParams struct {
+	// Query is matched case-insensitively against titles, descriptions,
+	// plan contents and pull request bodies
+	Query string
+	// TaskNumber, when set, also matches the task with that number, its
+	// plans and its pull requests
+	TaskNumber *int64
+	Limit      int
 }
 
 type GetProjectsParams struct {
@@ -39,4 +70,7 @@ type GetProjectsParams struct {
 	Page      int
 	PageSize  int
 	Archived  *bool
+	// MemberID, when set, leaves out the projects the user is not a member
+	// of
+	MemberID *uuid.UUID
 }
\ No newline at end of file
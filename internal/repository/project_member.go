@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type ProjectMemberRepository interface {
+	// Save adds the member to the project, or changes their role
+	Save(ctx context.Context, member *entity.ProjectMember) error
+	// Get returns the user's membership of the project, or nil when they
+	// are not a member
+	Get(ctx context.Context, projectID, userID uuid.UUID) (*entity.ProjectMember, error)
+	// ListByProject returns the project's members with their user, the
+	// earliest added first
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectMember, error)
+	// CountByProject returns how many members the project has with role,
+	// or with any role when role is ""
+	CountByProject(ctx context.Context, projectID uuid.UUID, role entity.ProjectRole) (int64, error)
+	Delete(ctx context.Context, projectID, userID uuid.UUID) error
+}
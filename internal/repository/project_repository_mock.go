@@ -280,6 +280,99 @@ func (_c *ProjectRepositoryMock_Delete_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// DeleteVerificationPipeline provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) DeleteVerificationPipeline(ctx context.Context, projectID uuid.UUID) error {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVerificationPipeline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectRepositoryMock_DeleteVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteVerificationPipeline'
+type ProjectRepositoryMock_DeleteVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// DeleteVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectRepositoryMock_Expecter) DeleteVerificationPipeline(ctx interface{}, projectID interface{}) *ProjectRepositoryMock_DeleteVerificationPipeline_Call {
+	return &ProjectRepositoryMock_DeleteVerificationPipeline_Call{Call: _e.mock.On("DeleteVerificationPipeline", ctx, projectID)}
+}
+
+func (_c *ProjectRepositoryMock_DeleteVerificationPipeline_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectRepositoryMock_DeleteVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_DeleteVerificationPipeline_Call) Return(err error) *ProjectRepositoryMock_DeleteVerificationPipeline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_DeleteVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) error) *ProjectRepositoryMock_DeleteVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkflowPreset provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) DeleteWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) error {
+	ret := _mock.Called(ctx, projectID, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkflowPreset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WorkflowType) error); ok {
+		r0 = returnFunc(ctx, projectID, workflow)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectRepositoryMock_DeleteWorkflowPreset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkflowPreset'
+type ProjectRepositoryMock_DeleteWorkflowPreset_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkflowPreset is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - workflow
+func (_e *ProjectRepositoryMock_Expecter) DeleteWorkflowPreset(ctx interface{}, projectID interface{}, workflow interface{}) *ProjectRepositoryMock_DeleteWorkflowPreset_Call {
+	return &ProjectRepositoryMock_DeleteWorkflowPreset_Call{Call: _e.mock.On("DeleteWorkflowPreset", ctx, projectID, workflow)}
+}
+
+func (_c *ProjectRepositoryMock_DeleteWorkflowPreset_Call) Run(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType)) *ProjectRepositoryMock_DeleteWorkflowPreset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.WorkflowType))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_DeleteWorkflowPreset_Call) Return(err error) *ProjectRepositoryMock_DeleteWorkflowPreset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_DeleteWorkflowPreset_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) error) *ProjectRepositoryMock_DeleteWorkflowPreset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetActiveTaskCountsBatch provides a mock function for the type ProjectRepositoryMock
 func (_mock *ProjectRepositoryMock) GetActiveTaskCountsBatch(ctx context.Context, projectIDs []uuid.UUID) (map[uuid.UUID]ActiveTaskCounts, error) {
 	ret := _mock.Called(ctx, projectIDs)
@@ -337,6 +430,71 @@ func (_c *ProjectRepositoryMock_GetActiveTaskCountsBatch_Call) RunAndReturn(run
 	return _c
 }
 
+// GetActivity provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) GetActivity(ctx context.Context, projectID uuid.UUID, limit int, offset int) ([]*entity.ProjectActivity, int, error) {
+	ret := _mock.Called(ctx, projectID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivity")
+	}
+
+	var r0 []*entity.ProjectActivity
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*entity.ProjectActivity, int, error)); ok {
+		return returnFunc(ctx, projectID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*entity.ProjectActivity); ok {
+		r0 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ProjectActivity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ProjectRepositoryMock_GetActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivity'
+type ProjectRepositoryMock_GetActivity_Call struct {
+	*mock.Call
+}
+
+// GetActivity is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - limit
+//   - offset
+func (_e *ProjectRepositoryMock_Expecter) GetActivity(ctx interface{}, projectID interface{}, limit interface{}, offset interface{}) *ProjectRepositoryMock_GetActivity_Call {
+	return &ProjectRepositoryMock_GetActivity_Call{Call: _e.mock.On("GetActivity", ctx, projectID, limit, offset)}
+}
+
+func (_c *ProjectRepositoryMock_GetActivity_Call) Run(run func(ctx context.Context, projectID uuid.UUID, limit int, offset int)) *ProjectRepositoryMock_GetActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetActivity_Call) Return(projectActivitys []*entity.ProjectActivity, n int, err error) *ProjectRepositoryMock_GetActivity_Call {
+	_c.Call.Return(projectActivitys, n, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetActivity_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, limit int, offset int) ([]*entity.ProjectActivity, int, error)) *ProjectRepositoryMock_GetActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllWithParams provides a mock function for the type ProjectRepositoryMock
 func (_mock *ProjectRepositoryMock) GetAllWithParams(ctx context.Context, params GetProjectsParams) ([]*entity.Project, int, error) {
 	ret := _mock.Called(ctx, params)
@@ -457,6 +615,120 @@ func (_c *ProjectRepositoryMock_GetByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetByIDs provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Project, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*entity.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.Project, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.Project); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectRepositoryMock_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type ProjectRepositoryMock_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *ProjectRepositoryMock_Expecter) GetByIDs(ctx interface{}, ids interface{}) *ProjectRepositoryMock_GetByIDs_Call {
+	return &ProjectRepositoryMock_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *ProjectRepositoryMock_GetByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *ProjectRepositoryMock_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetByIDs_Call) Return(projects []*entity.Project, err error) *ProjectRepositoryMock_GetByIDs_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]*entity.Project, error)) *ProjectRepositoryMock_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByRepositoryURLs provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) GetByRepositoryURLs(ctx context.Context, urls []string) ([]*entity.Project, error) {
+	ret := _mock.Called(ctx, urls)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByRepositoryURLs")
+	}
+
+	var r0 []*entity.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]*entity.Project, error)); ok {
+		return returnFunc(ctx, urls)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []*entity.Project); ok {
+		r0 = returnFunc(ctx, urls)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, urls)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectRepositoryMock_GetByRepositoryURLs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByRepositoryURLs'
+type ProjectRepositoryMock_GetByRepositoryURLs_Call struct {
+	*mock.Call
+}
+
+// GetByRepositoryURLs is a helper method to define mock.On call
+//   - ctx
+//   - urls
+func (_e *ProjectRepositoryMock_Expecter) GetByRepositoryURLs(ctx interface{}, urls interface{}) *ProjectRepositoryMock_GetByRepositoryURLs_Call {
+	return &ProjectRepositoryMock_GetByRepositoryURLs_Call{Call: _e.mock.On("GetByRepositoryURLs", ctx, urls)}
+}
+
+func (_c *ProjectRepositoryMock_GetByRepositoryURLs_Call) Run(run func(ctx context.Context, urls []string)) *ProjectRepositoryMock_GetByRepositoryURLs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetByRepositoryURLs_Call) Return(projects []*entity.Project, err error) *ProjectRepositoryMock_GetByRepositoryURLs_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetByRepositoryURLs_Call) RunAndReturn(run func(ctx context.Context, urls []string) ([]*entity.Project, error)) *ProjectRepositoryMock_GetByRepositoryURLs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLastActivityAt provides a mock function for the type ProjectRepositoryMock
 func (_mock *ProjectRepositoryMock) GetLastActivityAt(ctx context.Context, projectID uuid.UUID) (*time.Time, error) {
 	ret := _mock.Called(ctx, projectID)
@@ -628,6 +900,120 @@ func (_c *ProjectRepositoryMock_GetTaskStatistics_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetVerificationPipeline provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationPipeline")
+	}
+
+	var r0 *entity.VerificationPipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.VerificationPipeline, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.VerificationPipeline); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.VerificationPipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectRepositoryMock_GetVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationPipeline'
+type ProjectRepositoryMock_GetVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// GetVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectRepositoryMock_Expecter) GetVerificationPipeline(ctx interface{}, projectID interface{}) *ProjectRepositoryMock_GetVerificationPipeline_Call {
+	return &ProjectRepositoryMock_GetVerificationPipeline_Call{Call: _e.mock.On("GetVerificationPipeline", ctx, projectID)}
+}
+
+func (_c *ProjectRepositoryMock_GetVerificationPipeline_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectRepositoryMock_GetVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetVerificationPipeline_Call) Return(verificationPipeline *entity.VerificationPipeline, err error) *ProjectRepositoryMock_GetVerificationPipeline_Call {
+	_c.Call.Return(verificationPipeline, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)) *ProjectRepositoryMock_GetVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkflowPresets provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowPresets")
+	}
+
+	var r0 []*entity.WorkflowPreset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.WorkflowPreset, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.WorkflowPreset); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.WorkflowPreset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectRepositoryMock_GetWorkflowPresets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowPresets'
+type ProjectRepositoryMock_GetWorkflowPresets_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowPresets is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectRepositoryMock_Expecter) GetWorkflowPresets(ctx interface{}, projectID interface{}) *ProjectRepositoryMock_GetWorkflowPresets_Call {
+	return &ProjectRepositoryMock_GetWorkflowPresets_Call{Call: _e.mock.On("GetWorkflowPresets", ctx, projectID)}
+}
+
+func (_c *ProjectRepositoryMock_GetWorkflowPresets_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectRepositoryMock_GetWorkflowPresets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetWorkflowPresets_Call) Return(presets []*entity.WorkflowPreset, err error) *ProjectRepositoryMock_GetWorkflowPresets_Call {
+	_c.Call.Return(presets, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_GetWorkflowPresets_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error)) *ProjectRepositoryMock_GetWorkflowPresets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Restore provides a mock function for the type ProjectRepositoryMock
 func (_mock *ProjectRepositoryMock) Restore(ctx context.Context, id uuid.UUID) error {
 	ret := _mock.Called(ctx, id)
@@ -674,6 +1060,155 @@ func (_c *ProjectRepositoryMock_Restore_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// SaveVerificationPipeline provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) SaveVerificationPipeline(ctx context.Context, pipeline *entity.VerificationPipeline) error {
+	ret := _mock.Called(ctx, pipeline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVerificationPipeline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.VerificationPipeline) error); ok {
+		r0 = returnFunc(ctx, pipeline)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectRepositoryMock_SaveVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveVerificationPipeline'
+type ProjectRepositoryMock_SaveVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// SaveVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - pipeline
+func (_e *ProjectRepositoryMock_Expecter) SaveVerificationPipeline(ctx interface{}, pipeline interface{}) *ProjectRepositoryMock_SaveVerificationPipeline_Call {
+	return &ProjectRepositoryMock_SaveVerificationPipeline_Call{Call: _e.mock.On("SaveVerificationPipeline", ctx, pipeline)}
+}
+
+func (_c *ProjectRepositoryMock_SaveVerificationPipeline_Call) Run(run func(ctx context.Context, pipeline *entity.VerificationPipeline)) *ProjectRepositoryMock_SaveVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.VerificationPipeline))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_SaveVerificationPipeline_Call) Return(err error) *ProjectRepositoryMock_SaveVerificationPipeline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_SaveVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, pipeline *entity.VerificationPipeline) error) *ProjectRepositoryMock_SaveVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWorkflowPreset provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) error {
+	ret := _mock.Called(ctx, preset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWorkflowPreset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.WorkflowPreset) error); ok {
+		r0 = returnFunc(ctx, preset)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectRepositoryMock_SaveWorkflowPreset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWorkflowPreset'
+type ProjectRepositoryMock_SaveWorkflowPreset_Call struct {
+	*mock.Call
+}
+
+// SaveWorkflowPreset is a helper method to define mock.On call
+//   - ctx
+//   - preset
+func (_e *ProjectRepositoryMock_Expecter) SaveWorkflowPreset(ctx interface{}, preset interface{}) *ProjectRepositoryMock_SaveWorkflowPreset_Call {
+	return &ProjectRepositoryMock_SaveWorkflowPreset_Call{Call: _e.mock.On("SaveWorkflowPreset", ctx, preset)}
+}
+
+func (_c *ProjectRepositoryMock_SaveWorkflowPreset_Call) Run(run func(ctx context.Context, preset *entity.WorkflowPreset)) *ProjectRepositoryMock_SaveWorkflowPreset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WorkflowPreset))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_SaveWorkflowPreset_Call) Return(err error) *ProjectRepositoryMock_SaveWorkflowPreset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_SaveWorkflowPreset_Call) RunAndReturn(run func(ctx context.Context, preset *entity.WorkflowPreset) error) *ProjectRepositoryMock_SaveWorkflowPreset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type ProjectRepositoryMock
+func (_mock *ProjectRepositoryMock) Search(ctx context.Context, params SearchParams) ([]*entity.SearchResult, error) {
+	ret := _mock.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*entity.SearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchParams) ([]*entity.SearchResult, error)); ok {
+		return returnFunc(ctx, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, SearchParams) []*entity.SearchResult); ok {
+		r0 = returnFunc(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, SearchParams) error); ok {
+		r1 = returnFunc(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectRepositoryMock_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type ProjectRepositoryMock_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx
+//   - params
+func (_e *ProjectRepositoryMock_Expecter) Search(ctx interface{}, params interface{}) *ProjectRepositoryMock_Search_Call {
+	return &ProjectRepositoryMock_Search_Call{Call: _e.mock.On("Search", ctx, params)}
+}
+
+func (_c *ProjectRepositoryMock_Search_Call) Run(run func(ctx context.Context, params SearchParams)) *ProjectRepositoryMock_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SearchParams))
+	})
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_Search_Call) Return(searchResults []*entity.SearchResult, err error) *ProjectRepositoryMock_Search_Call {
+	_c.Call.Return(searchResults, err)
+	return _c
+}
+
+func (_c *ProjectRepositoryMock_Search_Call) RunAndReturn(run func(ctx context.Context, params SearchParams) ([]*entity.SearchResult, error)) *ProjectRepositoryMock_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type ProjectRepositoryMock
 func (_mock *ProjectRepositoryMock) Update(ctx context.Context, project *entity.Project) error {
 	ret := _mock.Called(ctx, project)
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type ProjectWebhookRepository interface {
+	Create(ctx context.Context, webhook *entity.ProjectWebhook) error
+	// GetByID returns the webhook, or nil when there is none
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhook, error)
+	// ListByProject returns the project's webhooks, the earliest created
+	// first
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectWebhook, error)
+	Update(ctx context.Context, webhook *entity.ProjectWebhook) error
+	// Delete removes the webhook and its deliveries
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	CreateDelivery(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error
+	// GetDelivery returns the delivery with its webhook, or nil when there
+	// is none
+	GetDelivery(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error
+	// ListDeliveries returns up to limit of the webhook's deliveries, the
+	// latest first
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*entity.ProjectWebhookDelivery, error)
+}
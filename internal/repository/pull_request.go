@@ -17,6 +17,7 @@ type PullRequestRepository interface {
 	
 	// Query operations
 	GetByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.PullRequest, error)
+	GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.PullRequest, error)
 	GetByGitHubPRNumber(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error)
 	GetByRepository(ctx context.Context, repo string) ([]*entity.PullRequest, error)
 	GetByStatus(ctx context.Context, status entity.PullRequestStatus) ([]*entity.PullRequest, error)
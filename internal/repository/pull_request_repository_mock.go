@@ -473,6 +473,63 @@ func (_c *PullRequestRepositoryMock_GetByTaskID_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetByTaskIDs provides a mock function for the type PullRequestRepositoryMock
+func (_mock *PullRequestRepositoryMock) GetByTaskIDs(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.PullRequest, error) {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskIDs")
+	}
+
+	var r0 []*entity.PullRequest
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.PullRequest, error)); ok {
+		return returnFunc(ctx, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.PullRequest); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.PullRequest)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// PullRequestRepositoryMock_GetByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskIDs'
+type PullRequestRepositoryMock_GetByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// GetByTaskIDs is a helper method to define mock.On call
+//   - ctx
+//   - taskIDs
+func (_e *PullRequestRepositoryMock_Expecter) GetByTaskIDs(ctx interface{}, taskIDs interface{}) *PullRequestRepositoryMock_GetByTaskIDs_Call {
+	return &PullRequestRepositoryMock_GetByTaskIDs_Call{Call: _e.mock.On("GetByTaskIDs", ctx, taskIDs)}
+}
+
+func (_c *PullRequestRepositoryMock_GetByTaskIDs_Call) Run(run func(ctx context.Context, taskIDs []uuid.UUID)) *PullRequestRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PullRequestRepositoryMock_GetByTaskIDs_Call) Return(pullRequests []*entity.PullRequest, err error) *PullRequestRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Return(pullRequests, err)
+	return _c
+}
+
+func (_c *PullRequestRepositoryMock_GetByTaskIDs_Call) RunAndReturn(run func(ctx context.Context, taskIDs []uuid.UUID) ([]*entity.PullRequest, error)) *PullRequestRepositoryMock_GetByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOpenPRs provides a mock function for the type PullRequestRepositoryMock
 func (_mock *PullRequestRepositoryMock) GetOpenPRs(ctx context.Context) ([]*entity.PullRequest, error) {
 	ret := _mock.Called(ctx)
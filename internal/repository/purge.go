@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeTableResult holds the number of rows purged (or purgeable, in dry-run
+// mode) from a single table.
+type PurgeTableResult struct {
+	Table string `json:"table"`
+	Rows  int64  `json:"rows"`
+}
+
+// PurgeReport summarizes a soft-delete purge run
+type PurgeReport struct {
+	Cutoff time.Time          `json:"cutoff"`
+	DryRun bool               `json:"dry_run"`
+	Tables []PurgeTableResult `json:"tables"`
+}
+
+// TotalRows returns the number of rows affected across all tables
+func (r *PurgeReport) TotalRows() int64 {
+	var total int64
+	for _, t := range r.Tables {
+		total += t.Rows
+	}
+	return total
+}
+
+type PurgeRepository interface {
+	// PurgeSoftDeleted hard-deletes rows whose deleted_at is older than cutoff.
+	// When dryRun is true, rows are only counted.
+	PurgeSoftDeleted(ctx context.Context, cutoff time.Time, dryRun bool) (*PurgeReport, error)
+}
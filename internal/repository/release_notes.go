@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type ReleaseNotesRepository interface {
+	Create(ctx context.Context, notes *entity.ReleaseNotes) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error)
+	// ListByProjectID returns the project's release notes, newest first
+	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.ReleaseNotes, error)
+	Update(ctx context.Context, notes *entity.ReleaseNotes) error
+}
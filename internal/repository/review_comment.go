@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type ReviewCommentRepository interface {
+	BatchCreate(ctx context.Context, comments []*entity.ReviewComment) error
+	// ListByExecutionID returns the execution's review comments by file and
+	// line, findings about the whole change first
+	ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error)
+	MarkPosted(ctx context.Context, ids []uuid.UUID) error
+}
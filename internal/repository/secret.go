@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type SecretRepository interface {
+	// Upsert creates the secret or replaces the ciphertext of the existing
+	// secret with the same project and name
+	Upsert(ctx context.Context, secret *entity.ProjectSecret) error
+	Get(ctx context.Context, projectID uuid.UUID, name string) (*entity.ProjectSecret, error)
+	Delete(ctx context.Context, projectID uuid.UUID, name string) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type SecurityFindingRepository interface {
+	BatchCreate(ctx context.Context, findings []*entity.SecurityFinding) error
+	// ListByExecutionID returns the execution's security findings by file and
+	// line
+	ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type SlackIntegrationRepository interface {
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) (*entity.SlackIntegration, error)
+	// Save creates or updates the project's integration
+	Save(ctx context.Context, integration *entity.SlackIntegration) error
+	Delete(ctx context.Context, projectID uuid.UUID) error
+}
+
+type NotificationPreferenceRepository interface {
+	// ListByProjectID returns the project's preferences for the channel
+	ListByProjectID(ctx context.Context, projectID uuid.UUID, channel entity.NotificationChannel) ([]*entity.NotificationPreference, error)
+	// Save creates the preference or replaces the one for the same project,
+	// channel and type
+	Save(ctx context.Context, preference *entity.NotificationPreference) error
+	// IsMuted reports whether the type of notification is muted on the
+	// project's channel
+	IsMuted(ctx context.Context, projectID uuid.UUID, channel entity.NotificationChannel, notificationType entity.NotificationType) (bool, error)
+}
@@ -12,7 +12,17 @@ type TaskRepository interface {
 	// Basic CRUD operations
 	Create(ctx context.Context, task *entity.Task) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Task, error)
+	// GetByIDs returns the tasks with the given IDs; IDs with no task are
+	// left out
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Task, error)
+	// GetDetail reads the task with what its detail view shows in one call
+	GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error)
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Task, error)
+	GetByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*entity.Task, error)
+	// GetBoardTasks returns the project's unarchived tasks other than
+	// templates with only what a board card shows, by priority rank and then
+	// most recently updated first. A non-nil epicID keeps the epic's tasks.
+	GetBoardTasks(ctx context.Context, projectID uuid.UUID, epicID *uuid.UUID) ([]*entity.Task, error)
 	Update(ctx context.Context, task *entity.Task) error
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -40,6 +50,8 @@ type TaskRepository interface {
 	BulkDelete(ctx context.Context, taskIDs []uuid.UUID) error
 	BulkArchive(ctx context.Context, taskIDs []uuid.UUID) error
 	BulkUnarchive(ctx context.Context, taskIDs []uuid.UUID) error
+	// BulkRestore brings back deleted tasks
+	BulkRestore(ctx context.Context, taskIDs []uuid.UUID) error
 	BulkUpdatePriority(ctx context.Context, taskIDs []uuid.UUID, priority entity.TaskPriority) error
 	BulkAssign(ctx context.Context, taskIDs []uuid.UUID, assignedTo string) error
 
@@ -56,6 +68,9 @@ type TaskRepository interface {
 
 	// Statistics and analytics
 	GetStatusHistory(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskStatusHistory, error)
+	// GetStatusHistoriesByProjectID returns the status history of every task
+	// of a project, grouped by task and oldest first
+	GetStatusHistoriesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.TaskStatusHistory, error)
 	GetStatusAnalytics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatusAnalytics, error)
 	GetTaskStatistics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatistics, error)
 
@@ -68,6 +83,7 @@ type TaskRepository interface {
 	// Comments
 	AddComment(ctx context.Context, comment *entity.TaskComment) error
 	GetComments(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskComment, error)
+	GetCommentByID(ctx context.Context, commentID uuid.UUID) (*entity.TaskComment, error)
 
 	// Plan operations
 	GetPlansByTaskID(ctx context.Context, taskID uuid.UUID) ([]entity.Plan, error)
@@ -86,9 +102,15 @@ type TaskRepository interface {
 
 	// Worktree cleanup
 	GetTasksEligibleForWorktreeCleanup(ctx context.Context, cutoffTime time.Time) ([]*entity.Task, error)
+	// GetTasksWithWorktree returns the tasks that have a worktree path
+	GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error)
 
 	// Error logs
 	AppendErrorLog(ctx context.Context, taskID uuid.UUID, errorMsg string) error
+
+	// IncrementPlanningIterations counts one more planning run of the task,
+	// returning the new count
+	IncrementPlanningIterations(ctx context.Context, taskID uuid.UUID) (int, error)
 }
 
 // TaskFilters represents filtering options for tasks (moved to entity package)
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type TaskAttachmentRepository interface {
+	Create(ctx context.Context, attachment *entity.TaskAttachment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.TaskAttachment, error)
+	// ListByTaskID returns the attachments of a task, oldest first
+	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskAttachment, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type TaskCommitRepository interface {
+	// Link attaches a commit to a task. It reports false when the commit was
+	// already linked to the task.
+	Link(ctx context.Context, commit *entity.TaskCommit) (bool, error)
+	// ListByTaskID returns the task's commits, most recent first
+	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskCommit, error)
+}
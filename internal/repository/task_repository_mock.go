@@ -320,6 +320,52 @@ func (_c *TaskRepositoryMock_BulkDelete_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// BulkRestore provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) BulkRestore(ctx context.Context, taskIDs []uuid.UUID) error {
+	ret := _mock.Called(ctx, taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkRestore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, taskIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskRepositoryMock_BulkRestore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkRestore'
+type TaskRepositoryMock_BulkRestore_Call struct {
+	*mock.Call
+}
+
+// BulkRestore is a helper method to define mock.On call
+//   - ctx
+//   - taskIDs
+func (_e *TaskRepositoryMock_Expecter) BulkRestore(ctx interface{}, taskIDs interface{}) *TaskRepositoryMock_BulkRestore_Call {
+	return &TaskRepositoryMock_BulkRestore_Call{Call: _e.mock.On("BulkRestore", ctx, taskIDs)}
+}
+
+func (_c *TaskRepositoryMock_BulkRestore_Call) Run(run func(ctx context.Context, taskIDs []uuid.UUID)) *TaskRepositoryMock_BulkRestore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_BulkRestore_Call) Return(err error) *TaskRepositoryMock_BulkRestore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_BulkRestore_Call) RunAndReturn(run func(ctx context.Context, taskIDs []uuid.UUID) error) *TaskRepositoryMock_BulkRestore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BulkUnarchive provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) BulkUnarchive(ctx context.Context, taskIDs []uuid.UUID) error {
 	ret := _mock.Called(ctx, taskIDs)
@@ -980,6 +1026,64 @@ func (_c *TaskRepositoryMock_GetAuditLogs_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetBoardTasks provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetBoardTasks(ctx context.Context, projectID uuid.UUID, epicID *uuid.UUID) ([]*entity.Task, error) {
+	ret := _mock.Called(ctx, projectID, epicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoardTasks")
+	}
+
+	var r0 []*entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) ([]*entity.Task, error)); ok {
+		return returnFunc(ctx, projectID, epicID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) []*entity.Task); ok {
+		r0 = returnFunc(ctx, projectID, epicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID, epicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetBoardTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoardTasks'
+type TaskRepositoryMock_GetBoardTasks_Call struct {
+	*mock.Call
+}
+
+// GetBoardTasks is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - epicID
+func (_e *TaskRepositoryMock_Expecter) GetBoardTasks(ctx interface{}, projectID interface{}, epicID interface{}) *TaskRepositoryMock_GetBoardTasks_Call {
+	return &TaskRepositoryMock_GetBoardTasks_Call{Call: _e.mock.On("GetBoardTasks", ctx, projectID, epicID)}
+}
+
+func (_c *TaskRepositoryMock_GetBoardTasks_Call) Run(run func(ctx context.Context, projectID uuid.UUID, epicID *uuid.UUID)) *TaskRepositoryMock_GetBoardTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetBoardTasks_Call) Return(tasks []*entity.Task, err error) *TaskRepositoryMock_GetBoardTasks_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetBoardTasks_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, epicID *uuid.UUID) ([]*entity.Task, error)) *TaskRepositoryMock_GetBoardTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
 	ret := _mock.Called(ctx, id)
@@ -1037,6 +1141,63 @@ func (_c *TaskRepositoryMock_GetByID_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// GetByIDs provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Task, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.Task, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.Task); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type TaskRepositoryMock_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *TaskRepositoryMock_Expecter) GetByIDs(ctx interface{}, ids interface{}) *TaskRepositoryMock_GetByIDs_Call {
+	return &TaskRepositoryMock_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *TaskRepositoryMock_GetByIDs_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *TaskRepositoryMock_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetByIDs_Call) Return(tasks []*entity.Task, err error) *TaskRepositoryMock_GetByIDs_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []uuid.UUID) ([]*entity.Task, error)) *TaskRepositoryMock_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByProjectID provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Task, error) {
 	ret := _mock.Called(ctx, projectID)
@@ -1094,6 +1255,63 @@ func (_c *TaskRepositoryMock_GetByProjectID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// GetByProjectIDs provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetByProjectIDs(ctx context.Context, projectIDs []uuid.UUID) ([]*entity.Task, error) {
+	ret := _mock.Called(ctx, projectIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByProjectIDs")
+	}
+
+	var r0 []*entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]*entity.Task, error)); ok {
+		return returnFunc(ctx, projectIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []*entity.Task); ok {
+		r0 = returnFunc(ctx, projectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetByProjectIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByProjectIDs'
+type TaskRepositoryMock_GetByProjectIDs_Call struct {
+	*mock.Call
+}
+
+// GetByProjectIDs is a helper method to define mock.On call
+//   - ctx
+//   - projectIDs
+func (_e *TaskRepositoryMock_Expecter) GetByProjectIDs(ctx interface{}, projectIDs interface{}) *TaskRepositoryMock_GetByProjectIDs_Call {
+	return &TaskRepositoryMock_GetByProjectIDs_Call{Call: _e.mock.On("GetByProjectIDs", ctx, projectIDs)}
+}
+
+func (_c *TaskRepositoryMock_GetByProjectIDs_Call) Run(run func(ctx context.Context, projectIDs []uuid.UUID)) *TaskRepositoryMock_GetByProjectIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetByProjectIDs_Call) Return(tasks []*entity.Task, err error) *TaskRepositoryMock_GetByProjectIDs_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetByProjectIDs_Call) RunAndReturn(run func(ctx context.Context, projectIDs []uuid.UUID) ([]*entity.Task, error)) *TaskRepositoryMock_GetByProjectIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByStatus provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetByStatus(ctx context.Context, status entity.TaskStatus) ([]*entity.Task, error) {
 	ret := _mock.Called(ctx, status)
@@ -1208,6 +1426,63 @@ func (_c *TaskRepositoryMock_GetByStatuses_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetCommentByID provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetCommentByID(ctx context.Context, commentID uuid.UUID) (*entity.TaskComment, error) {
+	ret := _mock.Called(ctx, commentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCommentByID")
+	}
+
+	var r0 *entity.TaskComment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.TaskComment, error)); ok {
+		return returnFunc(ctx, commentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.TaskComment); ok {
+		r0 = returnFunc(ctx, commentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TaskComment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, commentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetCommentByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCommentByID'
+type TaskRepositoryMock_GetCommentByID_Call struct {
+	*mock.Call
+}
+
+// GetCommentByID is a helper method to define mock.On call
+//   - ctx
+//   - commentID
+func (_e *TaskRepositoryMock_Expecter) GetCommentByID(ctx interface{}, commentID interface{}) *TaskRepositoryMock_GetCommentByID_Call {
+	return &TaskRepositoryMock_GetCommentByID_Call{Call: _e.mock.On("GetCommentByID", ctx, commentID)}
+}
+
+func (_c *TaskRepositoryMock_GetCommentByID_Call) Run(run func(ctx context.Context, commentID uuid.UUID)) *TaskRepositoryMock_GetCommentByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetCommentByID_Call) Return(_a0 *entity.TaskComment, _a1 error) *TaskRepositoryMock_GetCommentByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetCommentByID_Call) RunAndReturn(run func(ctx context.Context, commentID uuid.UUID) (*entity.TaskComment, error)) *TaskRepositoryMock_GetCommentByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComments provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetComments(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskComment, error) {
 	ret := _mock.Called(ctx, taskID)
@@ -1379,6 +1654,63 @@ func (_c *TaskRepositoryMock_GetDependents_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetDetail provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetail")
+	}
+
+	var r0 *entity.TaskDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.TaskDetail, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.TaskDetail); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TaskDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetail'
+type TaskRepositoryMock_GetDetail_Call struct {
+	*mock.Call
+}
+
+// GetDetail is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *TaskRepositoryMock_Expecter) GetDetail(ctx interface{}, id interface{}) *TaskRepositoryMock_GetDetail_Call {
+	return &TaskRepositoryMock_GetDetail_Call{Call: _e.mock.On("GetDetail", ctx, id)}
+}
+
+func (_c *TaskRepositoryMock_GetDetail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *TaskRepositoryMock_GetDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetDetail_Call) Return(taskDetail *entity.TaskDetail, err error) *TaskRepositoryMock_GetDetail_Call {
+	_c.Call.Return(taskDetail, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetDetail_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error)) *TaskRepositoryMock_GetDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetParentTask provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetParentTask(ctx context.Context, taskID uuid.UUID) (*entity.Task, error) {
 	ret := _mock.Called(ctx, taskID)
@@ -1550,6 +1882,63 @@ func (_c *TaskRepositoryMock_GetStatusAnalytics_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetStatusHistoriesByProjectID provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetStatusHistoriesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.TaskStatusHistory, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusHistoriesByProjectID")
+	}
+
+	var r0 []*entity.TaskStatusHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.TaskStatusHistory, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.TaskStatusHistory); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TaskStatusHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetStatusHistoriesByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatusHistoriesByProjectID'
+type TaskRepositoryMock_GetStatusHistoriesByProjectID_Call struct {
+	*mock.Call
+}
+
+// GetStatusHistoriesByProjectID is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *TaskRepositoryMock_Expecter) GetStatusHistoriesByProjectID(ctx interface{}, projectID interface{}) *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call {
+	return &TaskRepositoryMock_GetStatusHistoriesByProjectID_Call{Call: _e.mock.On("GetStatusHistoriesByProjectID", ctx, projectID)}
+}
+
+func (_c *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call) Return(taskStatusHistorys []*entity.TaskStatusHistory, err error) *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call {
+	_c.Call.Return(taskStatusHistorys, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]*entity.TaskStatusHistory, error)) *TaskRepositoryMock_GetStatusHistoriesByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatusHistory provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetStatusHistory(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskStatusHistory, error) {
 	ret := _mock.Called(ctx, taskID)
@@ -2006,6 +2395,62 @@ func (_c *TaskRepositoryMock_GetTasksWithSubtasks_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetTasksWithWorktree provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksWithWorktree")
+	}
+
+	var r0 []*entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*entity.Task, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*entity.Task); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_GetTasksWithWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksWithWorktree'
+type TaskRepositoryMock_GetTasksWithWorktree_Call struct {
+	*mock.Call
+}
+
+// GetTasksWithWorktree is a helper method to define mock.On call
+//   - ctx
+func (_e *TaskRepositoryMock_Expecter) GetTasksWithWorktree(ctx interface{}) *TaskRepositoryMock_GetTasksWithWorktree_Call {
+	return &TaskRepositoryMock_GetTasksWithWorktree_Call{Call: _e.mock.On("GetTasksWithWorktree", ctx)}
+}
+
+func (_c *TaskRepositoryMock_GetTasksWithWorktree_Call) Run(run func(ctx context.Context)) *TaskRepositoryMock_GetTasksWithWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetTasksWithWorktree_Call) Return(tasks []*entity.Task, err error) *TaskRepositoryMock_GetTasksWithWorktree_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_GetTasksWithWorktree_Call) RunAndReturn(run func(ctx context.Context) ([]*entity.Task, error)) *TaskRepositoryMock_GetTasksWithWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTemplateByID provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) GetTemplateByID(ctx context.Context, id uuid.UUID) (*entity.TaskTemplate, error) {
 	ret := _mock.Called(ctx, id)
@@ -2121,6 +2566,61 @@ func (_c *TaskRepositoryMock_GetTemplates_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// IncrementPlanningIterations provides a mock function for the type TaskRepositoryMock
+func (_mock *TaskRepositoryMock) IncrementPlanningIterations(ctx context.Context, taskID uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementPlanningIterations")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskRepositoryMock_IncrementPlanningIterations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementPlanningIterations'
+type TaskRepositoryMock_IncrementPlanningIterations_Call struct {
+	*mock.Call
+}
+
+// IncrementPlanningIterations is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskRepositoryMock_Expecter) IncrementPlanningIterations(ctx interface{}, taskID interface{}) *TaskRepositoryMock_IncrementPlanningIterations_Call {
+	return &TaskRepositoryMock_IncrementPlanningIterations_Call{Call: _e.mock.On("IncrementPlanningIterations", ctx, taskID)}
+}
+
+func (_c *TaskRepositoryMock_IncrementPlanningIterations_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskRepositoryMock_IncrementPlanningIterations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskRepositoryMock_IncrementPlanningIterations_Call) Return(r0 int, r1 error) *TaskRepositoryMock_IncrementPlanningIterations_Call {
+	_c.Call.Return(r0, r1)
+	return _c
+}
+
+func (_c *TaskRepositoryMock_IncrementPlanningIterations_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (int, error)) *TaskRepositoryMock_IncrementPlanningIterations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveDependency provides a mock function for the type TaskRepositoryMock
 func (_mock *TaskRepositoryMock) RemoveDependency(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID) error {
 	ret := _mock.Called(ctx, taskID, dependsOnTaskID)
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type organizationIDKey struct{}
+
+// WithOrganizationID returns a context that scopes tenant-aware repository
+// queries to the given organization.
+func WithOrganizationID(ctx context.Context, orgID uuid.UUID) context.Context {
+	return context.WithValue(ctx, organizationIDKey{}, orgID)
+}
+
+// OrganizationIDFromContext returns the organization the context is scoped to.
+// Contexts without an organization are unscoped (single-tenant behaviour).
+func OrganizationIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	orgID, ok := ctx.Value(organizationIDKey{}).(uuid.UUID)
+	return orgID, ok && orgID != uuid.Nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+type UndoOperationRepository interface {
+	Create(ctx context.Context, operation *entity.UndoOperation) error
+	GetByToken(ctx context.Context, token string) (*entity.UndoOperation, error)
+	// MarkUndone records that the operation was undone. It reports false
+	// when the operation was undone before.
+	MarkUndone(ctx context.Context, token string, undoneAt time.Time) (bool, error)
+	// DeleteExpiredBefore removes the operations that expired before the
+	// given time and returns how many were removed
+	DeleteExpiredBefore(ctx context.Context, before time.Time) (int64, error)
+}
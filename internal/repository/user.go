@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type UserRepository interface {
+	Create(ctx context.Context, user *entity.User) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// GetByUsername returns the user with the username, or nil when there
+	// is none
+	GetByUsername(ctx context.Context, username string) (*entity.User, error)
+
+	CreateAPIKey(ctx context.Context, key *entity.UserAPIKey) error
+	// GetAPIKeyByHash returns the API key with the hash, or nil when there
+	// is none
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*entity.UserAPIKey, error)
+	// ListAPIKeys returns the user's API keys, the newest first
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.UserAPIKey, error)
+	// DeleteAPIKey deletes one of the user's API keys
+	DeleteAPIKey(ctx context.Context, userID, id uuid.UUID) error
+	// TouchAPIKey records when the API key was last used
+	TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+type UserProfileRepository interface {
+	// ListByUsernames returns the saved profiles of the users; users without
+	// one are left out
+	ListByUsernames(ctx context.Context, usernames []string) ([]*entity.UserProfile, error)
+	// Save creates the profile or replaces the saved one
+	Save(ctx context.Context, profile *entity.UserProfile) error
+}
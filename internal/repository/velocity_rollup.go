@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type VelocityRollupRepository interface {
+	// Upsert stores the rollup, replacing the project's rollup for the same
+	// week
+	Upsert(ctx context.Context, rollup *entity.VelocityRollup) error
+	// ListByProjectID returns the project's rollups of the weeks starting in
+	// [from, to), oldest first
+	ListByProjectID(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]*entity.VelocityRollup, error)
+}
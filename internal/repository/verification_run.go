@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+type VerificationRunRepository interface {
+	Create(ctx context.Context, run *entity.VerificationRun) error
+	Update(ctx context.Context, run *entity.VerificationRun) error
+	// ListByExecutionID returns the execution's verification runs, those of
+	// each auto-fix attempt after the previous ones, in pipeline order
+	ListByExecutionID(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type WebhookDeliveryRepository interface {
+	// Claim records a delivery as processed. It reports false when the
+	// delivery was claimed before.
+	Claim(ctx context.Context, provider, deliveryID string, receivedAt time.Time) (bool, error)
+	// Release forgets a claimed delivery, so it can be delivered again
+	Release(ctx context.Context, provider, deliveryID string) error
+	// DeleteReceivedBefore removes the deliveries received before the given
+	// time and returns how many were removed
+	DeleteReceivedBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// TouchSource records that the repository sent a pull request event at
+	// the given time
+	TouchSource(ctx context.Context, provider, repository string, at time.Time) error
+	// ListSourcesSince returns the repositories of the provider that sent a
+	// pull request event since the given time
+	ListSourcesSince(ctx context.Context, provider string, since time.Time) ([]string, error)
+}
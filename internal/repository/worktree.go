@@ -15,6 +15,8 @@ type WorktreeRepository interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Worktree, error)
 	Update(ctx context.Context, worktree *entity.Worktree) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// RestoreByTaskID undeletes the task's soft-deleted worktree record
+	RestoreByTaskID(ctx context.Context, taskID uuid.UUID) error
 
 	// Status management
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.WorktreeStatus) error
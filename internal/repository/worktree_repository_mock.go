@@ -1202,6 +1202,52 @@ func (_c *WorktreeRepositoryMock_GetWorktreesWithFilters_Call) RunAndReturn(run
 	return _c
 }
 
+// RestoreByTaskID provides a mock function for the type WorktreeRepositoryMock
+func (_mock *WorktreeRepositoryMock) RestoreByTaskID(ctx context.Context, taskID uuid.UUID) error {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreByTaskID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WorktreeRepositoryMock_RestoreByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreByTaskID'
+type WorktreeRepositoryMock_RestoreByTaskID_Call struct {
+	*mock.Call
+}
+
+// RestoreByTaskID is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *WorktreeRepositoryMock_Expecter) RestoreByTaskID(ctx interface{}, taskID interface{}) *WorktreeRepositoryMock_RestoreByTaskID_Call {
+	return &WorktreeRepositoryMock_RestoreByTaskID_Call{Call: _e.mock.On("RestoreByTaskID", ctx, taskID)}
+}
+
+func (_c *WorktreeRepositoryMock_RestoreByTaskID_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *WorktreeRepositoryMock_RestoreByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WorktreeRepositoryMock_RestoreByTaskID_Call) Return(err error) *WorktreeRepositoryMock_RestoreByTaskID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WorktreeRepositoryMock_RestoreByTaskID_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) error) *WorktreeRepositoryMock_RestoreByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type WorktreeRepositoryMock
 func (_mock *WorktreeRepositoryMock) Update(ctx context.Context, worktree *entity.Worktree) error {
 	ret := _mock.Called(ctx, worktree)
@@ -0,0 +1,199 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// minReconnectDelay and maxReconnectDelay bound the wait before the
+	// agent connects again after losing the worker
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Agent runs on a runner machine, executing the processes a worker sends it
+type Agent struct {
+	name     string
+	token    string
+	capacity int
+	logger   *slog.Logger
+
+	mu        sync.Mutex
+	processes map[string]context.CancelFunc
+}
+
+// NewAgent creates an agent registering as name and running up to capacity
+// processes at once
+func NewAgent(name, token string, capacity int) *Agent {
+	return &Agent{
+		name:      name,
+		token:     token,
+		capacity:  max(capacity, 1),
+		logger:    slog.Default().With("component", "runner-agent", "runner", name),
+		processes: make(map[string]context.CancelFunc),
+	}
+}
+
+// Run serves the worker at cc until ctx is done, connecting again whenever
+// the connection is lost. It only returns early when the worker rejects
+// the agent.
+func (a *Agent) Run(ctx context.Context, cc grpc.ClientConnInterface) error {
+	delay := minReconnectDelay
+	for {
+		registered, err := a.serve(ctx, cc)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if code := status.Code(err); code == codes.Unauthenticated || code == codes.InvalidArgument {
+			return err
+		}
+		if registered {
+			delay = minReconnectDelay
+		}
+		a.logger.Warn("Lost connection to worker, reconnecting", "error", err, "delay", delay)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// serve registers with the worker and runs what it sends until the
+// connection ends, killing the processes still running then
+func (a *Agent) serve(ctx context.Context, cc grpc.ClientConnInterface) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := Connect(ctx, cc)
+	if err != nil {
+		return false, err
+	}
+	var sendMu sync.Mutex
+	send := func(msg *RunnerMessage) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(msg)
+	}
+
+	if err := send(&RunnerMessage{Register: &Register{Name: a.name, Token: a.token, Capacity: a.capacity}}); err != nil {
+		return false, err
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return false, err
+	}
+	if msg.Registered == nil {
+		return false, errors.New("worker did not accept the registration")
+	}
+	a.logger.Info("Registered with worker", "runner_id", msg.Registered.RunnerID, "capacity", a.capacity)
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return true, err
+		}
+		switch {
+		case msg.Start != nil:
+			// Registered before running, a stop right behind the start finds
+			// the process
+			processCtx, cancel := context.WithCancel(ctx)
+			a.mu.Lock()
+			a.processes[msg.Start.ProcessID] = cancel
+			a.mu.Unlock()
+			go a.runProcess(processCtx, msg.Start, send)
+		case msg.Stop != nil:
+			a.stopProcess(msg.Stop.ProcessID)
+		}
+	}
+}
+
+// runProcess runs the process as the worker's ProcessManager would,
+// streaming its output and exit to the worker
+func (a *Agent) runProcess(ctx context.Context, start *StartProcess, send func(*RunnerMessage) error) {
+	defer a.stopProcess(start.ProcessID)
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", start.Command)
+	cmd.Stdin = strings.NewReader(start.Input)
+	cmd.Dir = start.WorkDir
+	// Stopping kills the whole process group, the AI CLI's children included
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	// Don't wait on pipes held open by processes the command left behind
+	cmd.WaitDelay = 10 * time.Second
+	cmd.Env = os.Environ()
+	for key, value := range start.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
+	}
+	cmd.Stdout = &outputWriter{processID: start.ProcessID, send: send}
+	cmd.Stderr = &outputWriter{processID: start.ProcessID, send: send, stderr: true}
+
+	a.logger.Info("Running process", "process_id", start.ProcessID, "work_dir", start.WorkDir)
+	exit := &ProcessExit{ProcessID: start.ProcessID}
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			exit.ExitCode = exitErr.ExitCode()
+		} else {
+			exit.ExitCode = -1
+		}
+		if ctx.Err() != nil {
+			exit.Error = "process was stopped"
+		} else if exit.ExitCode == -1 {
+			exit.Error = err.Error()
+		}
+	}
+	a.logger.Info("Process exited", "process_id", start.ProcessID, "exit_code", exit.ExitCode)
+
+	if err := send(&RunnerMessage{Exit: exit}); err != nil {
+		a.logger.Error("Failed to report process exit", "process_id", start.ProcessID, "error", err)
+	}
+}
+
+// stopProcess kills a running process
+func (a *Agent) stopProcess(processID string) {
+	a.mu.Lock()
+	cancel := a.processes[processID]
+	delete(a.processes, processID)
+	a.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// outputWriter streams what a process writes to the worker
+type outputWriter struct {
+	processID string
+	send      func(*RunnerMessage) error
+	stderr    bool
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	chunk := append([]byte(nil), p...)
+	output := &ProcessOutput{ProcessID: w.processID}
+	if w.stderr {
+		output.Stderr = chunk
+	} else {
+		output.Stdout = chunk
+	}
+	if err := w.send(&RunnerMessage{Output: output}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
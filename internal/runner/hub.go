@@ -0,0 +1,177 @@
+package runner
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Hub is the worker's side of the runners: it serves their connections and
+// hands them the processes the worker's ProcessManager spawns
+type Hub struct {
+	token  string
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	runners map[string]*runnerConn
+}
+
+// runnerConn is a connected runner
+type runnerConn struct {
+	id       string
+	name     string
+	capacity int
+	stream   ConnectServer
+	sendMu   sync.Mutex
+	// processes are the processes the runner runs, guarded by the hub's mu
+	processes map[string]ai.RemoteProcessReport
+}
+
+// send sends msg to the runner, gRPC streams not allowing concurrent sends
+func (c *runnerConn) send(msg *ServerMessage) error {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	return c.stream.Send(msg)
+}
+
+// NewHub creates a hub accepting the runners presenting token. An empty
+// token accepts any runner.
+func NewHub(token string) *Hub {
+	return &Hub{
+		token:   token,
+		logger:  slog.Default().With("component", "runner-hub"),
+		runners: make(map[string]*runnerConn),
+	}
+}
+
+// Connect serves a runner until it disconnects, the processes it was
+// running being reported as failed then
+func (h *Hub) Connect(stream ConnectServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if msg.Register == nil {
+		return status.Error(codes.InvalidArgument, "runner must register first")
+	}
+	if h.token != "" && subtle.ConstantTimeCompare([]byte(msg.Register.Token), []byte(h.token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid runner token")
+	}
+
+	conn := &runnerConn{
+		id:        uuid.NewString(),
+		name:      msg.Register.Name,
+		capacity:  max(msg.Register.Capacity, 1),
+		stream:    stream,
+		processes: make(map[string]ai.RemoteProcessReport),
+	}
+	if err := conn.send(&ServerMessage{Registered: &Registered{RunnerID: conn.id}}); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.runners[conn.id] = conn
+	h.mu.Unlock()
+	h.logger.Info("Runner connected", "runner", conn.name, "runner_id", conn.id, "capacity", conn.capacity)
+
+	err = h.serve(conn)
+
+	h.mu.Lock()
+	delete(h.runners, conn.id)
+	lost := conn.processes
+	conn.processes = nil
+	h.mu.Unlock()
+
+	h.logger.Info("Runner disconnected", "runner", conn.name, "runner_id", conn.id, "processes", len(lost), "error", err)
+	for _, report := range lost {
+		report.Exited(-1, fmt.Errorf("runner %s disconnected", conn.name))
+	}
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+// serve dispatches what the runner reports until its stream ends
+func (h *Hub) serve(conn *runnerConn) error {
+	for {
+		msg, err := conn.stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch {
+		case msg.Output != nil:
+			h.mu.Lock()
+			report := conn.processes[msg.Output.ProcessID]
+			h.mu.Unlock()
+			if report != nil {
+				report.Output(msg.Output.Stdout, msg.Output.Stderr)
+			}
+		case msg.Exit != nil:
+			h.mu.Lock()
+			report := conn.processes[msg.Exit.ProcessID]
+			delete(conn.processes, msg.Exit.ProcessID)
+			h.mu.Unlock()
+			if report != nil {
+				var exitErr error
+				if msg.Exit.Error != "" {
+					exitErr = errors.New(msg.Exit.Error)
+				}
+				report.Exited(msg.Exit.ExitCode, exitErr)
+			}
+		}
+	}
+}
+
+// Start runs the process on the connected runner with the most free
+// capacity
+func (h *Hub) Start(spec ai.RemoteProcessSpec, report ai.RemoteProcessReport) (func() error, error) {
+	h.mu.Lock()
+	var conn *runnerConn
+	for _, candidate := range h.runners {
+		free := candidate.capacity - len(candidate.processes)
+		if free > 0 && (conn == nil || free > conn.capacity-len(conn.processes)) {
+			conn = candidate
+		}
+	}
+	if conn == nil {
+		h.mu.Unlock()
+		return nil, ai.ErrNoRemoteRunner
+	}
+	conn.processes[spec.ID] = report
+	h.mu.Unlock()
+
+	err := conn.send(&ServerMessage{Start: &StartProcess{
+		ProcessID: spec.ID,
+		Command:   spec.Command,
+		WorkDir:   spec.WorkDir,
+		Input:     spec.Input,
+		Env:       spec.Env,
+	}})
+	if err != nil {
+		h.mu.Lock()
+		delete(conn.processes, spec.ID)
+		h.mu.Unlock()
+		return nil, fmt.Errorf("failed to start process on runner %s: %w", conn.name, err)
+	}
+	h.logger.Info("Process started on runner", "process_id", spec.ID, "runner", conn.name)
+
+	return func() error {
+		return conn.send(&ServerMessage{Stop: &StopProcess{ProcessID: spec.ID}})
+	}, nil
+}
+
+// RunnerCount returns how many runners are connected
+func (h *Hub) RunnerCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.runners)
+}
@@ -0,0 +1,59 @@
+package runner
+
+// RunnerMessage is sent by a runner; exactly one field is set. Register is
+// the first message of a connection.
+type RunnerMessage struct {
+	Register *Register      `json:"register,omitempty"`
+	Output   *ProcessOutput `json:"output,omitempty"`
+	Exit     *ProcessExit   `json:"exit,omitempty"`
+}
+
+// ServerMessage is sent to a runner; exactly one field is set
+type ServerMessage struct {
+	Registered *Registered   `json:"registered,omitempty"`
+	Start      *StartProcess `json:"start,omitempty"`
+	Stop       *StopProcess  `json:"stop,omitempty"`
+}
+
+// Register introduces a runner to the worker
+type Register struct {
+	Name string `json:"name"`
+	// Token must match the worker's RUNNER_TOKEN
+	Token string `json:"token"`
+	// Capacity is how many processes the runner runs at once
+	Capacity int `json:"capacity"`
+}
+
+// Registered accepts a runner
+type Registered struct {
+	RunnerID string `json:"runner_id"`
+}
+
+// StartProcess has the runner run a command in a shell
+type StartProcess struct {
+	ProcessID string            `json:"process_id"`
+	Command   string            `json:"command"`
+	WorkDir   string            `json:"work_dir"`
+	Input     string            `json:"input"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// StopProcess has the runner kill a process
+type StopProcess struct {
+	ProcessID string `json:"process_id"`
+}
+
+// ProcessOutput is what a process wrote since the last output
+type ProcessOutput struct {
+	ProcessID string `json:"process_id"`
+	Stdout    []byte `json:"stdout,omitempty"`
+	Stderr    []byte `json:"stderr,omitempty"`
+}
+
+// ProcessExit reports a process ended. Error is set when it could not be
+// started or was killed.
+type ProcessExit struct {
+	ProcessID string `json:"process_id"`
+	ExitCode  int    `json:"exit_code"`
+	Error     string `json:"error,omitempty"`
+}
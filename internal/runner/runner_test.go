@@ -0,0 +1,146 @@
+package runner
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/service/ai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type recordedReport struct {
+	mu       sync.Mutex
+	stdout   []byte
+	stderr   []byte
+	exitCode int
+	err      error
+	exited   chan struct{}
+}
+
+func newRecordedReport() *recordedReport {
+	return &recordedReport{exited: make(chan struct{})}
+}
+
+func (r *recordedReport) Output(stdout, stderr []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stdout = append(r.stdout, stdout...)
+	r.stderr = append(r.stderr, stderr...)
+}
+
+func (r *recordedReport) Exited(exitCode int, err error) {
+	r.mu.Lock()
+	r.exitCode = exitCode
+	r.err = err
+	r.mu.Unlock()
+	close(r.exited)
+}
+
+func (r *recordedReport) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-r.exited:
+	case <-time.After(5 * time.Second):
+		t.Fatal("process did not exit")
+	}
+}
+
+// startHub serves hub over an in-memory listener and connects an agent to it
+func startHub(t *testing.T, hub *Hub, agent *Agent) <-chan error {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterRunnerServer(server, hub)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	done := make(chan error, 1)
+	go func() { done <- agent.Run(ctx, conn) }()
+	return done
+}
+
+func waitForRunners(t *testing.T, hub *Hub, count int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return hub.RunnerCount() == count }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestHub_RunsProcessOnAgent(t *testing.T) {
+	hub := NewHub("secret")
+	startHub(t, hub, NewAgent("runner-1", "secret", 2))
+	waitForRunners(t, hub, 1)
+
+	report := newRecordedReport()
+	_, err := hub.Start(ai.RemoteProcessSpec{
+		ID:      "process-1",
+		Command: `cat; echo "$GREETING" >&2; exit 3`,
+		WorkDir: t.TempDir(),
+		Input:   "plan the task",
+		Env:     map[string]string{"GREETING": "hello"},
+	}, report)
+	require.NoError(t, err)
+	report.wait(t)
+
+	assert.Equal(t, "plan the task", string(report.stdout))
+	assert.Equal(t, "hello\n", string(report.stderr))
+	assert.Equal(t, 3, report.exitCode)
+	assert.NoError(t, report.err)
+}
+
+func TestHub_StopsProcess(t *testing.T) {
+	hub := NewHub("")
+	startHub(t, hub, NewAgent("runner-1", "", 1))
+	waitForRunners(t, hub, 1)
+
+	report := newRecordedReport()
+	stop, err := hub.Start(ai.RemoteProcessSpec{ID: "process-1", Command: "sleep 30", WorkDir: t.TempDir()}, report)
+	require.NoError(t, err)
+	require.NoError(t, stop())
+	report.wait(t)
+
+	assert.EqualError(t, report.err, "process was stopped")
+}
+
+func TestHub_NoRunnerWithFreeCapacity(t *testing.T) {
+	hub := NewHub("")
+	_, err := hub.Start(ai.RemoteProcessSpec{ID: "process-1", Command: "true"}, newRecordedReport())
+	assert.ErrorIs(t, err, ai.ErrNoRemoteRunner)
+
+	startHub(t, hub, NewAgent("runner-1", "", 1))
+	waitForRunners(t, hub, 1)
+	_, err = hub.Start(ai.RemoteProcessSpec{ID: "process-1", Command: "sleep 30", WorkDir: t.TempDir()}, newRecordedReport())
+	require.NoError(t, err)
+
+	_, err = hub.Start(ai.RemoteProcessSpec{ID: "process-2", Command: "true", WorkDir: t.TempDir()}, newRecordedReport())
+	assert.ErrorIs(t, err, ai.ErrNoRemoteRunner, "the only runner is full")
+}
+
+func TestHub_RejectsInvalidToken(t *testing.T) {
+	hub := NewHub("secret")
+	done := startHub(t, hub, NewAgent("runner-1", "wrong", 1))
+
+	select {
+	case err := <-done:
+		assert.ErrorContains(t, err, "invalid runner token")
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent kept running with an invalid token")
+	}
+	assert.Equal(t, 0, hub.RunnerCount())
+}
@@ -0,0 +1,126 @@
+// Package runner lets AI CLI processes run on other machines than the
+// worker's. A runner agent dials the worker's gRPC endpoint, registers, and
+// is sent the processes to run, streaming their output and exit back on the
+// same connection. Runners only dial out, so they can sit behind NAT.
+//
+// Messages are plain Go structs carried with a JSON codec, so the service
+// needs no generated protobuf code.
+package runner
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+// serviceName is the gRPC service runners connect to
+const serviceName = "autodevs.runner.v1.Runner"
+
+// codecName is the content subtype of the runner messages
+const codecName = "json"
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec marshals the runner messages as JSON
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return codecName
+}
+
+// RunnerServer is the worker side of the runner service
+type RunnerServer interface {
+	// Connect serves a runner for as long as it stays connected
+	Connect(stream ConnectServer) error
+}
+
+// ConnectServer is the worker's end of a runner connection
+type ConnectServer interface {
+	Send(*ServerMessage) error
+	Recv() (*RunnerMessage, error)
+	grpc.ServerStream
+}
+
+// ConnectClient is the runner's end of its connection
+type ConnectClient interface {
+	Send(*RunnerMessage) error
+	Recv() (*ServerMessage, error)
+	grpc.ClientStream
+}
+
+// ServiceDesc describes the runner service to gRPC
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*RunnerServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       connectHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "runner",
+}
+
+// RegisterRunnerServer registers srv with s
+func RegisterRunnerServer(s grpc.ServiceRegistrar, srv RunnerServer) {
+	s.RegisterService(&ServiceDesc, srv)
+}
+
+func connectHandler(srv any, stream grpc.ServerStream) error {
+	return srv.(RunnerServer).Connect(&connectServer{stream})
+}
+
+type connectServer struct {
+	grpc.ServerStream
+}
+
+func (s *connectServer) Send(m *ServerMessage) error {
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *connectServer) Recv() (*RunnerMessage, error) {
+	m := new(RunnerMessage)
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Connect opens a runner connection to the worker at cc
+func Connect(ctx context.Context, cc grpc.ClientConnInterface) (ConnectClient, error) {
+	stream, err := cc.NewStream(ctx, &ServiceDesc.Streams[0], "/"+serviceName+"/Connect", grpc.CallContentSubtype(codecName))
+	if err != nil {
+		return nil, err
+	}
+	return &connectClient{stream}, nil
+}
+
+type connectClient struct {
+	grpc.ClientStream
+}
+
+func (c *connectClient) Send(m *RunnerMessage) error {
+	return c.ClientStream.SendMsg(m)
+}
+
+func (c *connectClient) Recv() (*ServerMessage, error) {
+	m := new(ServerMessage)
+	if err := c.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
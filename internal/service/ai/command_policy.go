@@ -0,0 +1,37 @@
+package ai
+
+import (
+	"errors"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// ErrCommandPolicyUnsupported is returned when starting an execution for a
+// project with a command policy on an AI CLI that cannot enforce it
+var ErrCommandPolicyUnsupported = errors.New("the AI executor cannot enforce the project's command policy")
+
+// CommandPolicyEnforcer is implemented by the AI CLIs able to restrict the
+// shell commands their agent runs
+type CommandPolicyEnforcer interface {
+	// ApplyCommandPolicy returns command changed so that the agent only runs
+	// the shell commands policy lets it
+	ApplyCommandPolicy(command string, policy entity.CommandPolicy) string
+}
+
+// applyCommandPolicy restricts command to the command policy of the task's
+// project. Executions of a project with a policy fail rather than run
+// unrestricted on a CLI that cannot enforce it.
+func applyCommandPolicy(task *entity.Task, cli AiCodingCli, command string) (string, error) {
+	if task.Project == nil {
+		return command, nil
+	}
+	policy := task.Project.CommandPolicy()
+	if policy.IsEmpty() {
+		return command, nil
+	}
+	enforcer, ok := cli.(CommandPolicyEnforcer)
+	if !ok {
+		return "", ErrCommandPolicyUnsupported
+	}
+	return enforcer.ApplyCommandPolicy(command, policy), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -39,6 +40,9 @@ type Execution struct {
 	Command     string           `json:"command"`
 	Input       string           `json:"input"`
 	WorkingDir  string           `json:"working_dir"`
+	// Violation is the file operation outside the worktree the execution
+	// was stopped for
+	Violation *FileAccessViolation `json:"violation,omitempty"`
 
 	// Internal fields
 	processID     string
@@ -47,6 +51,9 @@ type Execution struct {
 	mu            sync.RWMutex
 	stdoutChannel chan string
 	stderrChannel chan string
+	// cli parses the output audited for file operations; unset for
+	// executions not run in a worktree
+	cli AiCodingCli
 }
 
 // ExecutionResult represents the result of an execution
@@ -71,6 +78,7 @@ type ExecutionUpdate struct {
 type ExecutionService struct {
 	cliManager     *CLIManager
 	processManager *ProcessManager
+	isolation      *FileIsolation
 	executions     map[string]*Execution
 	mu             sync.RWMutex
 
@@ -83,10 +91,17 @@ func NewExecutionService(cliManager *CLIManager, processManager *ProcessManager)
 	return &ExecutionService{
 		cliManager:     cliManager,
 		processManager: processManager,
+		isolation:      &FileIsolation{},
 		executions:     make(map[string]*Execution),
 	}
 }
 
+// SetFileIsolation sets how executions are kept to their working directory.
+// Without it, their file operations are only audited against it.
+func (es *ExecutionService) SetFileIsolation(isolation *FileIsolation) {
+	es.isolation = isolation
+}
+
 // SetUpdateCallback sets the callback for real-time updates
 func (es *ExecutionService) SetUpdateCallback(callback func(update ExecutionUpdate)) {
 	es.onUpdate = callback
@@ -97,11 +112,35 @@ type AiCodingCli interface {
 	GetImplementationCommand(context.Context, *entity.Task) (string, string, map[string]string, error)
 	ParseOutputToLogs(output string) []*entity.ExecutionLog
 	ParseOutputToPlan(output string) (string, error)
+	// GetReviewCommand returns a read-only run critiquing diff against the
+	// task and its plan; ParseOutputToReview extracts its final answer
+	GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error)
+	ParseOutputToReview(output string) (string, error)
+	// ParseOutputToUsage returns the tokens and cost a run reported, or nil
+	// when its output does not report them
+	ParseOutputToUsage(output string) *entity.ExecutionUsage
+	// GetFixCommand returns a run fixing the changes in the task's worktree
+	// so the failing verification run passes
+	GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error)
+	// GetReleaseNotesCommand returns a read-only run writing the release
+	// notes of changes; ParseOutputToReleaseNotes extracts them
+	GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error)
+	ParseOutputToReleaseNotes(output string) (string, error)
+	// GetStandupCommand returns a read-only run writing the daily standup
+	// summary of report; ParseOutputToStandup extracts it
+	GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error)
+	ParseOutputToStandup(output string) (string, error)
+	// ParseOutputToQuestion returns the question an implementation run ended
+	// on and the session to resume, both empty when it asked none;
+	// GetResumeCommand resumes the session with the user's answer
+	ParseOutputToQuestion(output string) (string, string)
+	GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error)
 }
 
-// StartExecution starts a new AI execution
+// StartExecution starts a new AI execution. Its prompt, like those of the
+// review and fix executions, gets the prompt template of the project's
+// settings.
 func (es *ExecutionService) StartExecution(task *entity.Task, cli AiCodingCli, isForPlanning bool) (*Execution, map[string]string, error) {
-	executionID := uuid.New().String()
 	ctx, cancel := context.WithCancel(context.Background())
 
 	var command, input string
@@ -113,18 +152,129 @@ func (es *ExecutionService) StartExecution(task *entity.Task, cli AiCodingCli, i
 		command, input, injectEnvVars, err = cli.GetImplementationCommand(ctx, task)
 	}
 	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	execution, err := es.newExecution(ctx, cancel, task, cli, command, applyPromptTemplate(task, input))
+	if err != nil {
+		return nil, nil, err
+	}
+	return execution, injectEnvVars, nil
+}
+
+// StartReviewExecution starts an AI execution reviewing diff, the changes
+// made in the task's worktree
+func (es *ExecutionService) StartReviewExecution(task *entity.Task, cli AiCodingCli, diff string) (*Execution, map[string]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	command, input, injectEnvVars, err := cli.GetReviewCommand(ctx, task, diff)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	execution, err := es.newExecution(ctx, cancel, task, cli, command, applyPromptTemplate(task, input))
+	if err != nil {
+		return nil, nil, err
+	}
+	return execution, injectEnvVars, nil
+}
+
+// StartFixExecution starts an AI execution fixing the changes made in the
+// task's worktree after run, a verification step, failed
+func (es *ExecutionService) StartFixExecution(task *entity.Task, cli AiCodingCli, run *entity.VerificationRun) (*Execution, map[string]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	command, input, injectEnvVars, err := cli.GetFixCommand(ctx, task, run)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	execution, err := es.newExecution(ctx, cancel, task, cli, command, applyPromptTemplate(task, input))
+	if err != nil {
+		return nil, nil, err
+	}
+	return execution, injectEnvVars, nil
+}
+
+// StartResumeExecution starts an AI execution resuming session sessionID,
+// in which an implementation of the task asked the user a question, with
+// the user's answer
+func (es *ExecutionService) StartResumeExecution(task *entity.Task, cli AiCodingCli, sessionID, answer string) (*Execution, map[string]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	command, input, injectEnvVars, err := cli.GetResumeCommand(ctx, task, sessionID, answer)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	// The session already holds the project's prompt template
+
+	execution, err := es.newExecution(ctx, cancel, task, cli, command, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return execution, injectEnvVars, nil
+}
+
+// StartReleaseNotesExecution starts an AI execution writing the release
+// notes of changes. It is not tied to a task, so it runs in a scratch
+// directory rather than a worktree.
+func (es *ExecutionService) StartReleaseNotesExecution(notes *entity.ReleaseNotes, cli AiCodingCli, changes []entity.ReleaseChange) (*Execution, map[string]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	command, input, injectEnvVars, err := cli.GetReleaseNotesCommand(ctx, notes, changes)
+	if err != nil {
+		cancel()
 		return nil, nil, err
 	}
 
+	return es.registerExecution(ctx, cancel, "", os.TempDir(), command, input), injectEnvVars, nil
+}
+
+// StartStandupExecution starts an AI execution writing the daily standup
+// summary of report. Like release notes, it runs in a scratch directory.
+func (es *ExecutionService) StartStandupExecution(report *entity.StandupReport, cli AiCodingCli) (*Execution, map[string]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	command, input, injectEnvVars, err := cli.GetStandupCommand(ctx, report)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return es.registerExecution(ctx, cancel, "", os.TempDir(), command, input), injectEnvVars, nil
+}
+
+// newExecution registers a pending execution running command in the task's
+// worktree, restricted to the command policy of the task's project when it
+// is loaded
+func (es *ExecutionService) newExecution(ctx context.Context, cancel context.CancelFunc, task *entity.Task, cli AiCodingCli, command, input string) (*Execution, error) {
 	if task.WorktreePath == nil {
-		return nil, nil, fmt.Errorf("worktree path is not set")
+		cancel()
+		return nil, fmt.Errorf("worktree path is not set")
+	}
+	command, err := applyCommandPolicy(task, cli, command)
+	if err != nil {
+		cancel()
+		return nil, err
 	}
 
-	workingDir := *task.WorktreePath
+	execution := es.registerExecution(ctx, cancel, task.ID.String(), *task.WorktreePath, command, input)
+	execution.cli = cli
+	return execution, nil
+}
 
+// registerExecution registers a pending execution running command in
+// workingDir, in the sandbox when it is on
+func (es *ExecutionService) registerExecution(ctx context.Context, cancel context.CancelFunc, taskID, workingDir, command, input string) *Execution {
+	command = es.isolation.wrap(command, workingDir)
 	execution := &Execution{
-		ID:         executionID,
-		TaskID:     task.ID.String(),
+		ID:         uuid.New().String(),
+		TaskID:     taskID,
 		Status:     ExecutionStatusPending,
 		StartedAt:  time.Now(),
 		Progress:   0.0,
@@ -137,10 +287,10 @@ func (es *ExecutionService) StartExecution(task *entity.Task, cli AiCodingCli, i
 	}
 
 	es.mu.Lock()
-	es.executions[executionID] = execution
+	es.executions[execution.ID] = execution
 	es.mu.Unlock()
 
-	return execution, injectEnvVars, nil
+	return execution
 }
 
 func (es *ExecutionService) RunExecution(execution *Execution, injectEnvVars map[string]string) (*Execution, error) {
@@ -167,6 +317,13 @@ func (exe *Execution) GetContextDoneChannel() <-chan struct{} {
 	return exe.ctx.Done()
 }
 
+// IsCancelled reports whether the execution was cancelled
+func (exe *Execution) IsCancelled() bool {
+	exe.mu.RLock()
+	defer exe.mu.RUnlock()
+	return exe.Status == ExecutionStatusCancelled
+}
+
 // GetExecution retrieves an execution by ID
 func (es *ExecutionService) GetExecution(executionID string) (*Execution, error) {
 	es.mu.RLock()
@@ -280,6 +437,8 @@ func (es *ExecutionService) runExecution(execution *Execution, injectEnvVars map
 	process, err := es.processManager.SpawnProcess(command, execution.WorkingDir, execution.Input, injectEnvVars)
 	if err != nil {
 		es.handleExecutionError(execution, fmt.Sprintf("Failed to start process: %v", err))
+		// Nothing else will end the execution, waiters watch its context
+		execution.cancel()
 		return
 	}
 
@@ -342,6 +501,7 @@ func (es *ExecutionService) monitorProcessOutput(execution *Execution, process *
 			stdout, stderr := process.GetOutput()
 			if len(stdout) > 0 {
 				output := string(stdout)
+				es.auditFileAccess(execution, process, output)
 				// es.addLog(execution, output)
 
 				// // Update progress based on output patterns
@@ -361,6 +521,25 @@ func (es *ExecutionService) monitorProcessOutput(execution *Execution, process *
 	}
 }
 
+// auditFileAccess kills the execution's process when a tool call in output
+// touched a path outside its worktree. The execution then fails with the
+// violation.
+func (es *ExecutionService) auditFileAccess(execution *Execution, process *Process, output string) {
+	execution.mu.Lock()
+	defer execution.mu.Unlock()
+	if execution.cli == nil || execution.Violation != nil {
+		return
+	}
+
+	violation := es.isolation.violation(execution.WorkingDir, execution.cli.ParseOutputToLogs(output))
+	if violation == nil {
+		return
+	}
+	log.Println("Stopping execution for file access outside the worktree", execution.ID, violation)
+	execution.Violation = violation
+	_ = es.processManager.KillProcess(process)
+}
+
 // estimateProgress estimates progress based on output patterns
 func (es *ExecutionService) estimateProgress(output string) float64 {
 	// Convert to lowercase for case-insensitive matching
@@ -388,9 +567,20 @@ func (es *ExecutionService) handleExecutionCompletion(execution *Execution, proc
 		execution.mu.Unlock()
 	}()
 
+	// A cancelled execution keeps its status, whatever its killed process
+	// exited with
+	if execution.Status == ExecutionStatusCancelled {
+		return
+	}
+
 	now := time.Now()
 	execution.CompletedAt = &now
 
+	if execution.Violation != nil {
+		es.handleExecutionError(execution, fmt.Sprintf("Stopped by the filesystem guard: %s", execution.Violation))
+		return
+	}
+
 	// Get process output
 	stdout, stderr := process.GetOutput()
 
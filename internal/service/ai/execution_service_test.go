@@ -69,6 +69,46 @@ func (f *FakeAiCodingCli) ParseOutputToPlan(output string) (string, error) {
 	return "test plan", nil
 }
 
+func (f *FakeAiCodingCli) GetReviewCommand(ctx context.Context, task *entity.Task, diff string) (string, string, map[string]string, error) {
+	return "cat", diff, nil, nil
+}
+
+func (f *FakeAiCodingCli) ParseOutputToReview(output string) (string, error) {
+	return output, nil
+}
+
+func (f *FakeAiCodingCli) ParseOutputToUsage(output string) *entity.ExecutionUsage {
+	return nil
+}
+
+func (f *FakeAiCodingCli) GetFixCommand(ctx context.Context, task *entity.Task, run *entity.VerificationRun) (string, string, map[string]string, error) {
+	return f.GetImplementationCommand(ctx, task)
+}
+
+func (f *FakeAiCodingCli) GetReleaseNotesCommand(ctx context.Context, notes *entity.ReleaseNotes, changes []entity.ReleaseChange) (string, string, map[string]string, error) {
+	return "cat", changes[0].Title, nil, nil
+}
+
+func (f *FakeAiCodingCli) ParseOutputToReleaseNotes(output string) (string, error) {
+	return output, nil
+}
+
+func (f *FakeAiCodingCli) GetStandupCommand(ctx context.Context, report *entity.StandupReport) (string, string, map[string]string, error) {
+	return "cat", report.ProjectName, nil, nil
+}
+
+func (f *FakeAiCodingCli) ParseOutputToStandup(output string) (string, error) {
+	return output, nil
+}
+
+func (f *FakeAiCodingCli) ParseOutputToQuestion(output string) (string, string) {
+	return "", ""
+}
+
+func (f *FakeAiCodingCli) GetResumeCommand(ctx context.Context, task *entity.Task, sessionID, answer string) (string, string, map[string]string, error) {
+	return "cat", answer, nil, nil
+}
+
 func NewFakeAiCodingCli() AiCodingCli {
 	return &FakeAiCodingCli{}
 }
@@ -106,6 +146,92 @@ func TestExecutionService_StartExecution(t *testing.T) {
 	assert.Equal(t, execution.ID, retrieved.ID)
 }
 
+type fakePolicyCli struct {
+	FakeAiCodingCli
+}
+
+func (f *fakePolicyCli) ApplyCommandPolicy(command string, policy entity.CommandPolicy) string {
+	return command + " --deny " + strings.Join(policy.Denied, ",")
+}
+
+func TestExecutionService_CommandPolicy(t *testing.T) {
+	cliManager, err := NewCLIManager(DefaultCLIConfig())
+	require.NoError(t, err)
+	es := NewExecutionService(cliManager, NewProcessManager())
+
+	worktreePath := "testdata/worktree"
+	task := &entity.Task{
+		ID:           uuid.New(),
+		WorktreePath: &worktreePath,
+		Project:      &entity.Project{DeniedCommands: "npm publish\ncurl"},
+	}
+
+	execution, _, err := es.StartReviewExecution(task, &fakePolicyCli{}, "diff")
+	require.NoError(t, err)
+	assert.Equal(t, "cat --deny npm publish,curl", execution.Command)
+
+	_, _, err = es.StartReviewExecution(task, NewFakeAiCodingCli(), "diff")
+	assert.ErrorIs(t, err, ErrCommandPolicyUnsupported)
+
+	task.Project = &entity.Project{}
+	execution, _, err = es.StartReviewExecution(task, NewFakeAiCodingCli(), "diff")
+	require.NoError(t, err)
+	assert.Equal(t, "cat", execution.Command)
+}
+
+func TestExecutionService_PromptTemplate(t *testing.T) {
+	cliManager, err := NewCLIManager(DefaultCLIConfig())
+	require.NoError(t, err)
+	es := NewExecutionService(cliManager, NewProcessManager())
+
+	worktreePath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreePath, "docs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "docs", "ARCHITECTURE.md"), []byte("Handlers call usecases.\n"), 0o644))
+	task := &entity.Task{
+		ID:           uuid.New(),
+		WorktreePath: &worktreePath,
+		Project: &entity.Project{Settings: &entity.ProjectSettings{
+			PromptPrefix: "You work on the billing service.",
+			PromptSuffix: "Never edit generated files.",
+			ContextFiles: "docs/ARCHITECTURE.md\nmissing.md\n../outside.md",
+		}},
+	}
+
+	execution, _, err := es.StartReviewExecution(task, NewFakeAiCodingCli(), "diff")
+	require.NoError(t, err)
+	assert.Equal(t, "You work on the billing service.\n\n"+
+		"Context file docs/ARCHITECTURE.md of the project, to follow in this task:\n\n```\nHandlers call usecases.\n```\n\n"+
+		"diff\n\nNever edit generated files.", execution.Input, "unreadable context files are left out")
+
+	execution, _, err = es.StartResumeExecution(task, NewFakeAiCodingCli(), "session", "yes")
+	require.NoError(t, err)
+	assert.NotContains(t, execution.Input, "billing service", "resumed sessions already hold the template")
+
+	task.Project.Settings = &entity.ProjectSettings{}
+	execution, _, err = es.StartReviewExecution(task, NewFakeAiCodingCli(), "diff")
+	require.NoError(t, err)
+	assert.Equal(t, "diff", execution.Input)
+}
+
+func TestExecutionService_StartReleaseNotesExecution(t *testing.T) {
+	cliManager, err := NewCLIManager(DefaultCLIConfig())
+	require.NoError(t, err)
+	es := NewExecutionService(cliManager, NewProcessManager())
+
+	notes := &entity.ReleaseNotes{ID: uuid.New(), ProjectID: uuid.New()}
+	changes := []entity.ReleaseChange{{TaskID: uuid.New(), Title: "Add login page"}}
+
+	execution, _, err := es.StartReleaseNotesExecution(notes, NewFakeAiCodingCli(), changes)
+	require.NoError(t, err)
+	assert.Empty(t, execution.TaskID)
+	assert.Equal(t, os.TempDir(), execution.WorkingDir)
+	assert.Equal(t, "Add login page", execution.Input)
+
+	retrieved, err := es.GetExecution(execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, execution, retrieved)
+}
+
 func TestExecutionService_GetExecution(t *testing.T) {
 	cliManager, err := NewCLIManager(DefaultCLIConfig())
 	require.NoError(t, err)
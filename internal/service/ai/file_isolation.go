@@ -0,0 +1,195 @@
+package ai
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// FileAccessViolation is a file operation an execution attempted outside its
+// worktree
+type FileAccessViolation struct {
+	Tool string `json:"tool"`
+	Path string `json:"path"`
+}
+
+func (v *FileAccessViolation) Error() string {
+	return fmt.Sprintf("%s tried to access %s outside the worktree", v.Tool, v.Path)
+}
+
+// filePathInputs are the tool call inputs holding the file or directory the
+// call reads or writes
+var filePathInputs = []string{"file_path", "path", "notebook_path"}
+
+// FileIsolation keeps executions to their working directory. The paths
+// their tool calls touch are audited, and with the sandbox on the commands
+// run under bubblewrap with the filesystem read-only outside the working
+// directory, the parts of the git directory a worktree commits to and the
+// state paths. Without the sandbox, what shell commands do is not audited.
+type FileIsolation struct {
+	sandbox    bool
+	statePaths []string
+}
+
+// NewFileIsolation creates the file isolation of cfg. The sandbox needs
+// bwrap on the PATH.
+func NewFileIsolation(cfg *config.ExecutionConfig) (*FileIsolation, error) {
+	if cfg.Sandbox {
+		if _, err := exec.LookPath("bwrap"); err != nil {
+			return nil, fmt.Errorf("execution sandbox needs bubblewrap: %w", err)
+		}
+	} else {
+		slog.Warn("Execution sandbox is off: only the paths of tool calls are audited, not what shell commands do. Set EXECUTION_SANDBOX=true to confine them.")
+	}
+
+	home, _ := os.UserHomeDir()
+	statePaths := make([]string, 0, len(cfg.StatePaths))
+	for _, path := range cfg.StatePaths {
+		if rest, ok := strings.CutPrefix(path, "~"); ok {
+			if home == "" {
+				continue
+			}
+			path = filepath.Join(home, rest)
+		}
+		statePaths = append(statePaths, filepath.Clean(path))
+	}
+	return &FileIsolation{sandbox: cfg.Sandbox, statePaths: statePaths}, nil
+}
+
+// wrap returns command run in the sandbox when it is on
+func (fi *FileIsolation) wrap(command, workingDir string) string {
+	if !fi.sandbox {
+		return command
+	}
+
+	args := []string{"bwrap", "--die-with-parent",
+		"--ro-bind", "/", "/",
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+		"--bind", workingDir, workingDir,
+	}
+	// Committing writes objects, refs and their logs to the repository's
+	// git directory, and the worktree's own state to its directory there.
+	// Hooks and config stay read-only, as git on the host would run what
+	// they name, and so does the .git file pointing the worktree at them.
+	var writable, readOnly []string
+	gitDir, commonDir := gitDirs(workingDir)
+	if commonDir != "" {
+		writable = append(writable,
+			filepath.Join(commonDir, "objects"),
+			filepath.Join(commonDir, "refs"),
+			filepath.Join(commonDir, "logs"),
+			gitDir,
+		)
+		readOnly = append(readOnly, filepath.Join(workingDir, ".git"), filepath.Join(gitDir, "config.worktree"))
+	} else {
+		// The git directory of a repository checked out in place is
+		// inside the working directory
+		commonDir = filepath.Join(workingDir, ".git")
+	}
+	readOnly = append(readOnly, filepath.Join(commonDir, "hooks"), filepath.Join(commonDir, "config"))
+
+	for _, path := range append(writable, fi.statePaths...) {
+		args = append(args, "--bind-try", path, path)
+	}
+	for _, path := range readOnly {
+		args = append(args, "--ro-bind-try", path, path)
+	}
+	args = append(args, "--chdir", workingDir, "sh", "-c", command)
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// violation returns the first tool call in logs touching a path outside
+// root and the state paths, or nil
+func (fi *FileIsolation) violation(root string, logs []*entity.ExecutionLog) *FileAccessViolation {
+	allowed := append([]string{resolvePath(root)}, fi.statePaths...)
+	for _, log := range logs {
+		content, _ := log.ParsedContent["content"].([]interface{})
+		for _, item := range content {
+			call, _ := item.(map[string]interface{})
+			if call["type"] != "tool_use" {
+				continue
+			}
+			input, _ := call["input"].(map[string]interface{})
+			for _, key := range filePathInputs {
+				path, _ := input[key].(string)
+				if path == "" {
+					continue
+				}
+				if !filepath.IsAbs(path) {
+					path = filepath.Join(root, path)
+				}
+				if !withinAny(resolvePath(path), allowed) {
+					tool, _ := call["name"].(string)
+					return &FileAccessViolation{Tool: tool, Path: path}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func withinAny(path string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, path)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePath resolves the symlinks in the part of path that exists, so a
+// link inside the worktree cannot point a tool call outside of it
+func resolvePath(path string) string {
+	path = filepath.Clean(path)
+	rest := ""
+	for dir := path; ; dir = filepath.Dir(dir) {
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		if dir == filepath.Dir(dir) {
+			return path
+		}
+		rest = filepath.Join(filepath.Base(dir), rest)
+	}
+}
+
+// gitDirs returns the git directory of a worktree, and that of the
+// repository it was added from, which git writes to when committing in the
+// worktree. Both are "" when workingDir is not such a worktree.
+func gitDirs(workingDir string) (gitDir, commonDir string) {
+	data, err := os.ReadFile(filepath.Join(workingDir, ".git"))
+	if err != nil {
+		return "", ""
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return "", ""
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(workingDir, gitDir)
+	}
+	gitDir = filepath.Clean(gitDir)
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		return gitDir, filepath.Clean(commonDir)
+	}
+	return gitDir, gitDir
+}
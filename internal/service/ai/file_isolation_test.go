@@ -0,0 +1,144 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// toolCall is a log line of a tool call, as the AI CLIs parse them
+func toolCall(name string, input map[string]interface{}) *entity.ExecutionLog {
+	return &entity.ExecutionLog{ParsedContent: entity.JSONB{"content": []interface{}{
+		map[string]interface{}{"type": "tool_use", "name": name, "input": input},
+	}}}
+}
+
+func TestFileIsolation_Violation(t *testing.T) {
+	root := t.TempDir()
+	state := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "link")))
+	isolation := &FileIsolation{statePaths: []string{state}}
+
+	tests := []struct {
+		name string
+		log  *entity.ExecutionLog
+		want *FileAccessViolation
+	}{
+		{"relative path", toolCall("Edit", map[string]interface{}{"file_path": "cmd/main.go"}), nil},
+		{"absolute path inside", toolCall("Write", map[string]interface{}{"file_path": filepath.Join(root, "new/file.go")}), nil},
+		{"state path", toolCall("Write", map[string]interface{}{"file_path": filepath.Join(state, "plans/plan.md")}), nil},
+		{"no path", toolCall("Bash", map[string]interface{}{"command": "ls /"}), nil},
+		{"absolute path outside", toolCall("Read", map[string]interface{}{"file_path": "/etc/passwd"}),
+			&FileAccessViolation{Tool: "Read", Path: "/etc/passwd"}},
+		{"parent directory", toolCall("Grep", map[string]interface{}{"path": "../other"}),
+			&FileAccessViolation{Tool: "Grep", Path: filepath.Join(filepath.Dir(root), "other")}},
+		{"symlink out", toolCall("Write", map[string]interface{}{"file_path": "link/key"}),
+			&FileAccessViolation{Tool: "Write", Path: filepath.Join(root, "link/key")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isolation.violation(root, []*entity.ExecutionLog{{Message: "text"}, tt.log}))
+		})
+	}
+}
+
+func TestNewFileIsolation(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	isolation, err := NewFileIsolation(&config.ExecutionConfig{StatePaths: []string{"~/.claude", "/opt/cache/"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(home, ".claude"), "/opt/cache"}, isolation.statePaths)
+	assert.Equal(t, "claude -p", isolation.wrap("claude -p", "/worktrees/task"), "commands run as they are without the sandbox")
+}
+
+func TestFileIsolation_Wrap(t *testing.T) {
+	repo := t.TempDir()
+	worktree := t.TempDir()
+	gitDir := filepath.Join(repo, ".git", "worktrees", "task")
+	require.NoError(t, os.MkdirAll(gitDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "commondir"), []byte("../..\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+gitDir+"\n"), 0o644))
+
+	isolation := &FileIsolation{sandbox: true, statePaths: []string{"/home/dev/.claude"}}
+	command := isolation.wrap("echo 'done'", worktree)
+
+	assert.True(t, strings.HasPrefix(command, "'bwrap' '--die-with-parent' '--ro-bind' '/' '/'"))
+	assert.Contains(t, command, "'--bind' '"+worktree+"' '"+worktree+"'")
+	commonDir := filepath.Join(repo, ".git")
+	for _, writable := range []string{"objects", "refs", "logs", "worktrees/task"} {
+		path := filepath.Join(commonDir, writable)
+		assert.Contains(t, command, "'--bind-try' '"+path+"' '"+path+"'")
+	}
+	assert.NotContains(t, command, "'--bind-try' '"+commonDir+"' ", "the repository's hooks and config are not writable")
+	for _, readOnly := range []string{"hooks", "config", "worktrees/task/config.worktree"} {
+		path := filepath.Join(commonDir, readOnly)
+		assert.Contains(t, command, "'--ro-bind-try' '"+path+"' '"+path+"'")
+	}
+	assert.Contains(t, command, "'--ro-bind-try' '"+filepath.Join(worktree, ".git")+"' '"+filepath.Join(worktree, ".git")+"'")
+	assert.Contains(t, command, "'--bind-try' '/home/dev/.claude' '/home/dev/.claude'")
+	assert.True(t, strings.HasSuffix(command, "'--chdir' '"+worktree+"' 'sh' '-c' 'echo '\\''done'\\'''"))
+}
+
+// streamJSONCli parses output lines as JSON, as the AI CLIs streaming
+// stream-json do
+type streamJSONCli struct {
+	FakeAiCodingCli
+}
+
+func (f *streamJSONCli) GetImplementationCommand(ctx context.Context, task *entity.Task) (string, string, map[string]string, error) {
+	line, err := json.Marshal(map[string]interface{}{"type": "assistant", "message": map[string]interface{}{"content": []interface{}{
+		map[string]interface{}{"type": "tool_use", "name": "Write", "input": map[string]interface{}{"file_path": "/etc/cron.d/job"}},
+	}}})
+	return "cat; sleep 10", string(line) + "\n", nil, err
+}
+
+func (f *streamJSONCli) ParseOutputToLogs(output string) []*entity.ExecutionLog {
+	var logs []*entity.ExecutionLog
+	for _, line := range strings.Split(output, "\n") {
+		var generic map[string]interface{}
+		if json.Unmarshal([]byte(line), &generic) != nil {
+			continue
+		}
+		message, _ := generic["message"].(map[string]interface{})
+		logs = append(logs, &entity.ExecutionLog{ParsedContent: entity.JSONB{"content": message["content"]}})
+	}
+	return logs
+}
+
+func TestExecutionService_StopsFileAccessOutsideWorktree(t *testing.T) {
+	cliManager, err := NewCLIManager(DefaultCLIConfig())
+	require.NoError(t, err)
+	es := NewExecutionService(cliManager, NewProcessManager())
+
+	worktree := t.TempDir()
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	execution, _, err := es.StartExecution(task, &streamJSONCli{}, false)
+	require.NoError(t, err)
+
+	stdout := make(chan string, 100)
+	execution.RegisterStdoutChannel(stdout)
+	execution.RegisterStderrChannel(make(chan string, 100))
+	_, err = es.RunExecution(execution, nil)
+	require.NoError(t, err)
+
+	select {
+	case <-execution.GetContextDoneChannel():
+	case <-time.After(5 * time.Second):
+		t.Fatal("execution was not stopped")
+	}
+	assert.Equal(t, &FileAccessViolation{Tool: "Write", Path: "/etc/cron.d/job"}, execution.Violation)
+	assert.Equal(t, ExecutionStatusFailed, execution.Status)
+	assert.Contains(t, execution.Error, "Stopped by the filesystem guard: Write tried to access /etc/cron.d/job outside the worktree")
+}
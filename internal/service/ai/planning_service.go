@@ -56,6 +56,15 @@ func (ps *PlanningService) GeneratePlan(task entity.Task) (*Plan, error) {
 	return plan, nil
 }
 
+// StartStandupSummary starts the AI execution writing a project's daily
+// standup summary from report, to be parsed with cli.ParseOutputToStandup
+func (ps *PlanningService) StartStandupSummary(report *entity.StandupReport, cli AiCodingCli) (*Execution, map[string]string, error) {
+	if report.IsEmpty() {
+		return nil, nil, fmt.Errorf("nothing to summarize for project %s", report.ProjectID)
+	}
+	return ps.executionService.StartStandupExecution(report, cli)
+}
+
 // generatePlanningPrompt creates a structured prompt for AI planning phase
 func (ps *PlanningService) generatePlanningPrompt(task entity.Task) (string, error) {
 	var promptBuilder strings.Builder
@@ -113,7 +122,7 @@ func (ps *PlanningService) generatePlanningPrompt(task entity.Task) (string, err
 	promptBuilder.WriteString("This is a Go-based web application with Clean Architecture pattern.\n")
 	promptBuilder.WriteString("The codebase uses Gin framework, GORM for database, and follows standard Go practices.\n")
 	
-	return promptBuilder.String(), nil
+	return applyPromptTemplate(&task, promptBuilder.String()), nil
 }
 
 // generateInitialPlanSteps creates basic plan steps before AI enhancement
@@ -223,4 +223,20 @@ func TestPlanningService_GenerateInitialPlanSteps(t *testing.T) {
 	for i, step := range steps {
 		assert.Equal(t, expectedActions[i], step.Action)
 	}
-}
\ No newline at end of file
+}
+
+func TestPlanningService_StartStandupSummary(t *testing.T) {
+	cliManager, err := NewCLIManager(DefaultCLIConfig())
+	require.NoError(t, err)
+	executionService := NewExecutionService(cliManager, NewProcessManager())
+	planningService := NewPlanningService(executionService, cliManager)
+
+	report := &entity.StandupReport{ProjectID: uuid.New(), ProjectName: "Shop", Executions: 3}
+	execution, _, err := planningService.StartStandupSummary(report, NewFakeAiCodingCli())
+	require.NoError(t, err)
+	assert.Empty(t, execution.TaskID)
+	assert.Equal(t, "Shop", execution.Input)
+
+	_, _, err = planningService.StartStandupSummary(&entity.StandupReport{ProjectID: uuid.New()}, NewFakeAiCodingCli())
+	assert.Error(t, err, "an empty report is not summarized")
+}
@@ -2,6 +2,7 @@ package ai
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -15,20 +16,23 @@ import (
 
 // Process represents an AI execution process
 type Process struct {
-	ID          string
-	Command     string
-	WorkDir     string
-	PID         int
-	Status      ProcessStatus
-	StartTime   time.Time
-	EndTime     *time.Time
-	ExitCode    *int
-	Stdout      []byte
-	Stderr      []byte
-	Error       error
-	ctx         context.Context
-	cancel      context.CancelFunc
-	cmd         *exec.Cmd
+	ID        string
+	Command   string
+	WorkDir   string
+	PID       int
+	Status    ProcessStatus
+	StartTime time.Time
+	EndTime   *time.Time
+	ExitCode  *int
+	Stdout    []byte
+	Stderr    []byte
+	Error     error
+	ctx       context.Context
+	cancel    context.CancelFunc
+	cmd       *exec.Cmd
+	// stopRemote stops the process when a remote runner runs it, cmd is
+	// unset then
+	stopRemote  func() error
 	mu          sync.RWMutex
 	resourceMu  sync.RWMutex
 	CPUUsage    float64
@@ -50,6 +54,10 @@ const (
 type ProcessManager struct {
 	processes map[string]*Process
 	mu        sync.RWMutex
+	// remote runs the processes on other machines when set, see
+	// SetRemoteRunner
+	remote        RemoteRunner
+	localFallback bool
 }
 
 // NewProcessManager creates a new ProcessManager instance
@@ -61,6 +69,14 @@ func NewProcessManager() *ProcessManager {
 
 // SpawnProcess creates and starts a new AI execution process
 func (pm *ProcessManager) SpawnProcess(command string, workDir string, input string, injectEnvVars map[string]string) (*Process, error) {
+	if pm.remote != nil {
+		process, err := pm.spawnRemoteProcess(command, workDir, input, injectEnvVars)
+		if !errors.Is(err, ErrNoRemoteRunner) || !pm.localFallback {
+			return process, err
+		}
+		log.Println("No remote runner available, running the process locally")
+	}
+
 	log.Println("Spawning process", command, workDir, input)
 	// Generate unique process ID
 	processID := generateProcessID()
@@ -185,7 +201,11 @@ func (pm *ProcessManager) TerminateProcess(process *Process) error {
 	}
 
 	// Send SIGTERM signal
-	if process.cmd.Process != nil {
+	if process.stopRemote != nil {
+		if err := process.stopRemote(); err != nil {
+			return fmt.Errorf("failed to stop remote process %s: %w", process.ID, err)
+		}
+	} else if process.cmd.Process != nil {
 		if err := process.cmd.Process.Signal(syscall.SIGTERM); err != nil {
 			return fmt.Errorf("failed to send SIGTERM to process %s: %w", process.ID, err)
 		}
@@ -207,7 +227,11 @@ func (pm *ProcessManager) KillProcess(process *Process) error {
 	}
 
 	// Send SIGKILL signal
-	if process.cmd.Process != nil {
+	if process.stopRemote != nil {
+		if err := process.stopRemote(); err != nil {
+			return fmt.Errorf("failed to stop remote process %s: %w", process.ID, err)
+		}
+	} else if process.cmd.Process != nil {
 		if err := process.cmd.Process.Signal(syscall.SIGKILL); err != nil {
 			return fmt.Errorf("failed to send SIGKILL to process %s: %w", process.ID, err)
 		}
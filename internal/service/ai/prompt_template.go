@@ -0,0 +1,65 @@
+package ai
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// maxContextFileBytes bounds each context file quoted in a prompt
+const maxContextFileBytes = 32 * 1024
+
+// applyPromptTemplate returns prompt with the prompt template of the task's
+// project settings applied: the prefix first, then the context files read
+// from the task's worktree, the prompt and the suffix. Context files that
+// cannot be read are left out.
+func applyPromptTemplate(task *entity.Task, prompt string) string {
+	if task.Project == nil || task.Project.Settings == nil || !task.Project.Settings.HasPromptTemplate() {
+		return prompt
+	}
+	settings := task.Project.Settings
+
+	var templated strings.Builder
+	if prefix := strings.TrimSpace(settings.PromptPrefix); prefix != "" {
+		templated.WriteString(prefix)
+		templated.WriteString("\n\n")
+	}
+	if task.WorktreePath != nil {
+		for _, file := range settings.ContextFileList() {
+			content, err := readContextFile(*task.WorktreePath, file)
+			if err != nil {
+				log.Printf("Skipping context file %s of task %s: %v", file, task.ID, err)
+				continue
+			}
+			templated.WriteString(fmt.Sprintf("Context file %s of the project, to follow in this task:\n\n```\n%s\n```\n\n", file, content))
+		}
+	}
+	templated.WriteString(prompt)
+	if suffix := strings.TrimSpace(settings.PromptSuffix); suffix != "" {
+		templated.WriteString("\n\n")
+		templated.WriteString(suffix)
+	}
+	return templated.String()
+}
+
+// readContextFile returns the content of file, a path relative to
+// worktreePath, cut to maxContextFileBytes
+func readContextFile(worktreePath, file string) (string, error) {
+	path := filepath.Join(worktreePath, filepath.FromSlash(file))
+	if rel, err := filepath.Rel(worktreePath, path); err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("path is outside the worktree")
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	text := strings.TrimRight(string(content), "\n")
+	if len(text) > maxContextFileBytes {
+		text = text[:maxContextFileBytes] + "\n[truncated]"
+	}
+	return text, nil
+}
@@ -0,0 +1,142 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// ErrNoRemoteRunner is returned by a RemoteRunner when no runner can take
+// another process
+var ErrNoRemoteRunner = errors.New("no remote runner available")
+
+// RemoteProcessSpec is a process to run on a remote runner
+type RemoteProcessSpec struct {
+	ID      string
+	Command string
+	WorkDir string
+	Input   string
+	Env     map[string]string
+}
+
+// RemoteProcessReport receives what a remote runner reports of the process
+// it runs
+type RemoteProcessReport interface {
+	Output(stdout, stderr []byte)
+	// Exited is reported once, when the process ends or the runner is lost
+	Exited(exitCode int, err error)
+}
+
+// RemoteRunner runs the AI CLI processes on other machines than the
+// worker's, which must see the worktrees at the same paths
+type RemoteRunner interface {
+	// Start starts the process on a runner, reporting its output and exit
+	// to report, and returns the function stopping it
+	Start(spec RemoteProcessSpec, report RemoteProcessReport) (stop func() error, err error)
+}
+
+// SetRemoteRunner has processes run by runner. When localFallback is set,
+// a process no runner can take runs locally instead of failing.
+func (pm *ProcessManager) SetRemoteRunner(runner RemoteRunner, localFallback bool) {
+	pm.remote = runner
+	pm.localFallback = localFallback
+}
+
+// spawnRemoteProcess starts the process on a remote runner
+func (pm *ProcessManager) spawnRemoteProcess(command, workDir, input string, injectEnvVars map[string]string) (*Process, error) {
+	processID := generateProcessID()
+	ctx, cancel := context.WithCancel(context.Background())
+	process := &Process{
+		ID:        processID,
+		Command:   command,
+		WorkDir:   workDir,
+		Status:    ProcessStatusRunning,
+		StartTime: time.Now(),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	env := map[string]string{
+		"AI_PROCESS_ID": processID,
+		"AI_WORK_DIR":   workDir,
+	}
+	for key, value := range injectEnvVars {
+		env[key] = value
+	}
+
+	// Registered first, the process can be found as soon as it reports
+	pm.mu.Lock()
+	pm.processes[processID] = process
+	pm.mu.Unlock()
+
+	stop, err := pm.remote.Start(RemoteProcessSpec{
+		ID:      processID,
+		Command: command,
+		WorkDir: workDir,
+		Input:   input,
+		Env:     env,
+	}, &remoteProcessReport{pm: pm, process: process})
+	if err != nil {
+		pm.mu.Lock()
+		delete(pm.processes, processID)
+		pm.mu.Unlock()
+		cancel()
+		process.Status = ProcessStatusError
+		process.Error = err
+		return process, err
+	}
+
+	process.mu.Lock()
+	process.stopRemote = stop
+	process.mu.Unlock()
+	log.Println("Spawned remote process", processID, command, workDir)
+	return process, nil
+}
+
+// remoteProcessReport feeds what the runner reports into the process, as
+// collectOutput and MonitorProcess do for local ones
+type remoteProcessReport struct {
+	pm      *ProcessManager
+	process *Process
+}
+
+func (r *remoteProcessReport) Output(stdout, stderr []byte) {
+	r.process.mu.Lock()
+	defer r.process.mu.Unlock()
+	r.process.Stdout = append(r.process.Stdout, stdout...)
+	r.process.Stderr = append(r.process.Stderr, stderr...)
+}
+
+func (r *remoteProcessReport) Exited(exitCode int, err error) {
+	// Like local processes, the status changes a little later so the last
+	// output is picked up by the execution first
+	go func() {
+		time.Sleep(2 * time.Second)
+		r.finish(exitCode, err)
+	}()
+}
+
+func (r *remoteProcessReport) finish(exitCode int, err error) {
+	process := r.process
+	process.mu.Lock()
+	if process.Status == ProcessStatusRunning {
+		if err != nil || exitCode != 0 {
+			process.Status = ProcessStatusError
+			process.Error = err
+		} else {
+			process.Status = ProcessStatusStopped
+		}
+	}
+	if process.EndTime == nil {
+		now := time.Now()
+		process.EndTime = &now
+	}
+	process.ExitCode = &exitCode
+	process.mu.Unlock()
+	process.cancel()
+
+	r.pm.mu.Lock()
+	delete(r.pm.processes, process.ID)
+	r.pm.mu.Unlock()
+}
@@ -0,0 +1,110 @@
+package ai
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeRemoteRunner records the processes it is given and lets the test
+// report for them
+type fakeRemoteRunner struct {
+	err     error
+	specs   []RemoteProcessSpec
+	reports []RemoteProcessReport
+	stopped int
+}
+
+func (f *fakeRemoteRunner) Start(spec RemoteProcessSpec, report RemoteProcessReport) (func() error, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.specs = append(f.specs, spec)
+	f.reports = append(f.reports, report)
+	return func() error {
+		f.stopped++
+		return nil
+	}, nil
+}
+
+func TestProcessManager_SpawnRemoteProcess(t *testing.T) {
+	pm := NewProcessManager()
+	runner := &fakeRemoteRunner{}
+	pm.SetRemoteRunner(runner, false)
+
+	process, err := pm.SpawnProcess("claude -p", "/worktrees/task-1", "plan it", map[string]string{"TASK_ID": "task-1"})
+	if err != nil {
+		t.Fatalf("Failed to spawn process: %v", err)
+	}
+	if len(runner.specs) != 1 {
+		t.Fatalf("Expected the process to be sent to the runner, got %d", len(runner.specs))
+	}
+	spec := runner.specs[0]
+	if spec.ID != process.ID || spec.Input != "plan it" || spec.WorkDir != "/worktrees/task-1" {
+		t.Errorf("Unexpected spec %+v", spec)
+	}
+	if spec.Env["TASK_ID"] != "task-1" || spec.Env["AI_PROCESS_ID"] != process.ID {
+		t.Errorf("Expected the injected and process environment, got %v", spec.Env)
+	}
+	if _, exists := pm.GetProcess(process.ID); !exists {
+		t.Error("Remote process should be tracked by the manager")
+	}
+
+	report := runner.reports[0]
+	report.Output([]byte("planning\n"), nil)
+	report.Exited(0, nil)
+
+	stdout, _ := process.GetOutput()
+	if string(stdout) != "planning\n" {
+		t.Errorf("Expected the reported output, got %q", stdout)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for process.IsRunning() && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if process.GetStatus() != ProcessStatusStopped {
+		t.Errorf("Expected status %s, got %s", ProcessStatusStopped, process.GetStatus())
+	}
+	if _, exists := pm.GetProcess(process.ID); exists {
+		t.Error("Exited remote process should be removed from the manager")
+	}
+}
+
+func TestProcessManager_KillRemoteProcess(t *testing.T) {
+	pm := NewProcessManager()
+	runner := &fakeRemoteRunner{}
+	pm.SetRemoteRunner(runner, false)
+
+	process, err := pm.SpawnProcess("sleep 30", t.TempDir(), "", nil)
+	if err != nil {
+		t.Fatalf("Failed to spawn process: %v", err)
+	}
+	if err := pm.KillProcess(process); err != nil {
+		t.Fatalf("Failed to kill process: %v", err)
+	}
+
+	if runner.stopped != 1 {
+		t.Errorf("Expected the runner to be asked to stop the process, got %d", runner.stopped)
+	}
+	if process.GetStatus() != ProcessStatusKilled {
+		t.Errorf("Expected status %s, got %s", ProcessStatusKilled, process.GetStatus())
+	}
+}
+
+func TestProcessManager_RemoteRunnerFallback(t *testing.T) {
+	pm := NewProcessManager()
+	pm.SetRemoteRunner(&fakeRemoteRunner{err: ErrNoRemoteRunner}, false)
+
+	if _, err := pm.SpawnProcess("echo hi", t.TempDir(), "", nil); !errors.Is(err, ErrNoRemoteRunner) {
+		t.Fatalf("Expected ErrNoRemoteRunner without local fallback, got %v", err)
+	}
+
+	pm.SetRemoteRunner(&fakeRemoteRunner{err: ErrNoRemoteRunner}, true)
+	process, err := pm.SpawnProcess("echo hi", t.TempDir(), "", nil)
+	if err != nil {
+		t.Fatalf("Expected the process to run locally, got %v", err)
+	}
+	if process.PID == 0 {
+		t.Error("Local process should have a PID")
+	}
+}
@@ -0,0 +1,41 @@
+package email
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	data, err := buildMessage("auto-devs <bot@acme.com>", Message{
+		To:      []string{"alice@acme.com", "bob@acme.com"},
+		Subject: "Plan ready: Đăng nhập",
+		Body:    "Line 1\nLine 2",
+	}, date)
+	require.NoError(t, err)
+
+	assert.Equal(t, "From: auto-devs <bot@acme.com>\r\n"+
+		"To: alice@acme.com, bob@acme.com\r\n"+
+		"Subject: =?utf-8?q?Plan_ready:_=C4=90=C4=83ng_nh=E1=BA=ADp?=\r\n"+
+		"Date: Mon, 15 Jan 2024 10:30:00 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"Content-Transfer-Encoding: 8bit\r\n"+
+		"\r\n"+
+		"Line 1\r\nLine 2\r\n", string(data))
+}
+
+func TestBuildMessage_RejectsHeaderInjection(t *testing.T) {
+	_, err := buildMessage("bot@acme.com", Message{To: []string{"alice@acme.com"}, Subject: "Hi\r\nBcc: eve@acme.com"}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestSend_RequiresRecipients(t *testing.T) {
+	err := NewSMTPSender(SMTPConfig{Host: "localhost", Port: 25, From: "bot@acme.com"}).Send(context.Background(), Message{Subject: "Hi"})
+	assert.ErrorContains(t, err, "no recipients")
+}
@@ -0,0 +1,248 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+)
+
+// File statuses of a FileDiff
+const (
+	FileStatusAdded    = "added"
+	FileStatusDeleted  = "deleted"
+	FileStatusModified = "modified"
+	FileStatusRenamed  = "renamed"
+)
+
+// Line types of a DiffLine
+const (
+	DiffLineContext   = "context"
+	DiffLineAdded     = "added"
+	DiffLineDeleted   = "deleted"
+	DiffLineNoNewline = "no_newline"
+)
+
+// FileDiff is the change to one file in a unified diff
+type FileDiff struct {
+	// OldPath is empty for an added file
+	OldPath string
+	// NewPath is empty for a deleted file
+	NewPath   string
+	Status    string
+	Binary    bool
+	Additions int
+	Deletions int
+	Hunks     []DiffHunk
+}
+
+// DiffHunk is a run of changed lines of a file, with their context
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	// Header is the text after the line ranges, often the enclosing function
+	Header string
+	Lines  []DiffLine
+}
+
+// DiffLine is a line of a hunk. OldLine is 0 for an added line and NewLine
+// is 0 for a deleted one.
+type DiffLine struct {
+	Type    string
+	Content string
+	OldLine int
+	NewLine int
+}
+
+// ParseUnifiedDiff splits the output of git diff, or a pull request's diff
+// from GitHub, into its files. Lines it does not recognise are skipped.
+func ParseUnifiedDiff(diff string) []FileDiff {
+	var files []FileDiff
+	var file *FileDiff
+	var hunk *DiffHunk
+	oldLine, newLine := 0, 0
+
+	flush := func() {
+		if file == nil {
+			return
+		}
+		if hunk != nil {
+			file.Hunks = append(file.Hunks, *hunk)
+			hunk = nil
+		}
+		if file.Status == "" {
+			file.Status = FileStatusModified
+			if file.OldPath != file.NewPath {
+				file.Status = FileStatusRenamed
+			}
+		}
+		files = append(files, *file)
+		file = nil
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			flush()
+			oldPath, newPath := parseDiffGitPaths(strings.TrimPrefix(line, "diff --git "))
+			file = &FileDiff{OldPath: oldPath, NewPath: newPath}
+			continue
+		}
+		if file == nil {
+			continue
+		}
+
+		if hunk != nil {
+			if diffLine, ok := parseHunkLine(line, &oldLine, &newLine); ok {
+				switch diffLine.Type {
+				case DiffLineAdded:
+					file.Additions++
+				case DiffLineDeleted:
+					file.Deletions++
+				}
+				hunk.Lines = append(hunk.Lines, diffLine)
+				continue
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@@ "):
+			if hunk != nil {
+				file.Hunks = append(file.Hunks, *hunk)
+			}
+			hunk = parseHunkHeader(line)
+			if hunk != nil {
+				oldLine, newLine = hunk.OldStart, hunk.NewStart
+			}
+		case strings.HasPrefix(line, "new file mode"):
+			file.Status = FileStatusAdded
+		case strings.HasPrefix(line, "deleted file mode"):
+			file.Status = FileStatusDeleted
+		case strings.HasPrefix(line, "rename from "):
+			file.OldPath = unquoteDiffPath(strings.TrimPrefix(line, "rename from "))
+			file.Status = FileStatusRenamed
+		case strings.HasPrefix(line, "rename to "):
+			file.NewPath = unquoteDiffPath(strings.TrimPrefix(line, "rename to "))
+			file.Status = FileStatusRenamed
+		case strings.HasPrefix(line, "--- "):
+			if path := parseDiffFilePath(strings.TrimPrefix(line, "--- "), "a/"); path != "" {
+				file.OldPath = path
+			}
+		case strings.HasPrefix(line, "+++ "):
+			if path := parseDiffFilePath(strings.TrimPrefix(line, "+++ "), "b/"); path != "" {
+				file.NewPath = path
+			}
+		case strings.HasPrefix(line, "Binary files ") || line == "GIT binary patch":
+			file.Binary = true
+		}
+	}
+	flush()
+
+	for i := range files {
+		switch files[i].Status {
+		case FileStatusAdded:
+			files[i].OldPath = ""
+		case FileStatusDeleted:
+			files[i].NewPath = ""
+		}
+	}
+	return files
+}
+
+// parseHunkLine reads a line of a hunk's body, advancing the line numbers
+func parseHunkLine(line string, oldLine, newLine *int) (DiffLine, bool) {
+	if line == "" {
+		// Some tools strip the space of empty context lines
+		line = " "
+	}
+	switch line[0] {
+	case ' ':
+		diffLine := DiffLine{Type: DiffLineContext, Content: line[1:], OldLine: *oldLine, NewLine: *newLine}
+		*oldLine++
+		*newLine++
+		return diffLine, true
+	case '+':
+		diffLine := DiffLine{Type: DiffLineAdded, Content: line[1:], NewLine: *newLine}
+		*newLine++
+		return diffLine, true
+	case '-':
+		diffLine := DiffLine{Type: DiffLineDeleted, Content: line[1:], OldLine: *oldLine}
+		*oldLine++
+		return diffLine, true
+	case '\\':
+		return DiffLine{Type: DiffLineNoNewline, Content: strings.TrimPrefix(line, `\ `)}, true
+	}
+	return DiffLine{}, false
+}
+
+// parseHunkHeader reads a line such as "@@ -1,4 +1,5 @@ func main()"
+func parseHunkHeader(line string) *DiffHunk {
+	rest := strings.TrimPrefix(line, "@@ ")
+	end := strings.Index(rest, " @@")
+	if end < 0 {
+		return nil
+	}
+	ranges := strings.Fields(rest[:end])
+	if len(ranges) != 2 || !strings.HasPrefix(ranges[0], "-") || !strings.HasPrefix(ranges[1], "+") {
+		return nil
+	}
+
+	hunk := &DiffHunk{Header: strings.TrimSpace(rest[end+len(" @@"):])}
+	var ok bool
+	if hunk.OldStart, hunk.OldLines, ok = parseHunkRange(ranges[0][1:]); !ok {
+		return nil
+	}
+	if hunk.NewStart, hunk.NewLines, ok = parseHunkRange(ranges[1][1:]); !ok {
+		return nil
+	}
+	return hunk
+}
+
+// parseHunkRange reads "start,count", where count defaults to 1
+func parseHunkRange(text string) (int, int, bool) {
+	startText, countText, hasCount := strings.Cut(text, ",")
+	start, err := strconv.Atoi(startText)
+	if err != nil {
+		return 0, 0, false
+	}
+	if !hasCount {
+		return start, 1, true
+	}
+	count, err := strconv.Atoi(countText)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, count, true
+}
+
+// parseDiffGitPaths reads the paths of a "diff --git a/old b/new" line. They
+// are ambiguous when they contain spaces; the ---, +++ and rename lines that
+// follow take precedence.
+func parseDiffGitPaths(paths string) (string, string) {
+	if oldPath, newPath, ok := strings.Cut(paths, " b/"); ok && strings.HasPrefix(oldPath, "a/") {
+		return unquoteDiffPath(strings.TrimPrefix(oldPath, "a/")), unquoteDiffPath(newPath)
+	}
+	return "", ""
+}
+
+// parseDiffFilePath reads the path of a ---/+++ line, returning "" for
+// /dev/null
+func parseDiffFilePath(path, prefix string) string {
+	// A tab may separate a timestamp from the path
+	path, _, _ = strings.Cut(path, "\t")
+	path = unquoteDiffPath(path)
+	if path == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(path, prefix)
+}
+
+// unquoteDiffPath undoes the C-style quoting git applies to unusual paths
+func unquoteDiffPath(path string) string {
+	if len(path) < 2 || path[0] != '"' || path[len(path)-1] != '"' {
+		return path
+	}
+	if unquoted, err := strconv.Unquote(path); err == nil {
+		return unquoted
+	}
+	return path
+}
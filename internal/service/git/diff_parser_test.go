@@ -0,0 +1,116 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleDiff = `diff --git a/main.go b/main.go
+index 3b18e51..a1b2c3d 100644
+--- a/main.go
++++ b/main.go
+@@ -1,4 +1,5 @@ package main
+ import "fmt"
+-func main() {
++func main() {
++	fmt.Println("hi")
+ }
+@@ -10 +11,0 @@ func helper() {
+-	return
+diff --git a/docs/new.md b/docs/new.md
+new file mode 100644
+index 0000000..e69de29
+--- /dev/null
++++ b/docs/new.md
+@@ -0,0 +1 @@
++# New
+\ No newline at end of file
+diff --git a/old.txt b/old.txt
+deleted file mode 100644
+index e69de29..0000000
+--- a/old.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+diff --git a/a dir/before.go b/a dir/after.go
+similarity index 100%
+rename from a dir/before.go
+rename to a dir/after.go
+diff --git a/logo.png b/logo.png
+index 1234567..89abcde 100644
+Binary files a/logo.png and b/logo.png differ
+`
+
+func TestParseUnifiedDiff(t *testing.T) {
+	files := ParseUnifiedDiff(sampleDiff)
+	require.Len(t, files, 5)
+
+	modified := files[0]
+	assert.Equal(t, "main.go", modified.OldPath)
+	assert.Equal(t, "main.go", modified.NewPath)
+	assert.Equal(t, FileStatusModified, modified.Status)
+	assert.Equal(t, 2, modified.Additions)
+	assert.Equal(t, 2, modified.Deletions)
+	require.Len(t, modified.Hunks, 2)
+	first := modified.Hunks[0]
+	assert.Equal(t, DiffHunk{OldStart: 1, OldLines: 4, NewStart: 1, NewLines: 5, Header: "package main"}, DiffHunk{
+		OldStart: first.OldStart, OldLines: first.OldLines, NewStart: first.NewStart, NewLines: first.NewLines, Header: first.Header,
+	})
+	assert.Equal(t, []DiffLine{
+		{Type: DiffLineContext, Content: `import "fmt"`, OldLine: 1, NewLine: 1},
+		{Type: DiffLineDeleted, Content: "func main() {", OldLine: 2},
+		{Type: DiffLineAdded, Content: "func main() {", NewLine: 2},
+		{Type: DiffLineAdded, Content: "\tfmt.Println(\"hi\")", NewLine: 3},
+		{Type: DiffLineContext, Content: "}", OldLine: 3, NewLine: 4},
+	}, first.Lines)
+	second := modified.Hunks[1]
+	assert.Equal(t, 10, second.OldStart)
+	assert.Equal(t, 1, second.OldLines)
+	assert.Equal(t, 0, second.NewLines)
+	assert.Equal(t, []DiffLine{{Type: DiffLineDeleted, Content: "\treturn", OldLine: 10}}, second.Lines)
+
+	added := files[1]
+	assert.Equal(t, "", added.OldPath)
+	assert.Equal(t, "docs/new.md", added.NewPath)
+	assert.Equal(t, FileStatusAdded, added.Status)
+	assert.Equal(t, 1, added.Additions)
+	require.Len(t, added.Hunks, 1)
+	assert.Equal(t, []DiffLine{
+		{Type: DiffLineAdded, Content: "# New", NewLine: 1},
+		{Type: DiffLineNoNewline, Content: "No newline at end of file"},
+	}, added.Hunks[0].Lines)
+
+	deleted := files[2]
+	assert.Equal(t, "old.txt", deleted.OldPath)
+	assert.Equal(t, "", deleted.NewPath)
+	assert.Equal(t, FileStatusDeleted, deleted.Status)
+	assert.Equal(t, 1, deleted.Deletions)
+
+	renamed := files[3]
+	assert.Equal(t, "a dir/before.go", renamed.OldPath)
+	assert.Equal(t, "a dir/after.go", renamed.NewPath)
+	assert.Equal(t, FileStatusRenamed, renamed.Status)
+	assert.Empty(t, renamed.Hunks)
+
+	binary := files[4]
+	assert.Equal(t, "logo.png", binary.NewPath)
+	assert.True(t, binary.Binary)
+	assert.Equal(t, FileStatusModified, binary.Status)
+}
+
+func TestParseUnifiedDiff_Empty(t *testing.T) {
+	assert.Empty(t, ParseUnifiedDiff(""))
+	assert.Empty(t, ParseUnifiedDiff("No code changes"))
+}
+
+func TestParseUnifiedDiff_QuotedPaths(t *testing.T) {
+	files := ParseUnifiedDiff("diff --git \"a/caf\\303\\251.txt\" \"b/caf\\303\\251.txt\"\n" +
+		"--- \"a/caf\\303\\251.txt\"\n+++ \"b/caf\\303\\251.txt\"\n@@ -1 +1 @@\n-a\n+b\n")
+
+	require.Len(t, files, 1)
+	assert.Equal(t, "café.txt", files[0].OldPath)
+	assert.Equal(t, "café.txt", files[0].NewPath)
+	assert.Equal(t, FileStatusModified, files[0].Status)
+}
@@ -0,0 +1,52 @@
+package git
+
+import (
+	"context"
+	"fmt"
+)
+
+// FormatPatch returns the commits on toRef since it diverged from fromRef as
+// a mailbox patch series, which git am applies. It is empty when there are
+// no such commits.
+func (m *GitManager) FormatPatch(ctx context.Context, workingDir, fromRef, toRef string) (string, error) {
+	patch, err := m.commands.run(ctx, m.getWorkingDir(workingDir), "format-patch", "format-patch", "--stdout", fromRef+".."+toRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to format patch: %w", err)
+	}
+	if patch == "" {
+		return "", nil
+	}
+	return patch + "\n", nil
+}
+
+// CreateBundle writes the commits on branch since it diverged from fromRef to
+// a bundle file at path, which git fetch and git clone read like a remote.
+// The bundle needs fromRef in the repository it is fetched into.
+func (m *GitManager) CreateBundle(ctx context.Context, workingDir, path, fromRef, branch string) error {
+	if _, err := m.commands.run(ctx, m.getWorkingDir(workingDir), "bundle", "bundle", "create", path, branch, "^"+fromRef); err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	m.logger.Info("Created bundle", "working_dir", workingDir, "path", path, "branch", branch, "from", fromRef)
+	return nil
+}
+
+// DiffWorktree returns the diff between fromRef and the files in the
+// worktree at workingDir, whether committed or not, untracked files
+// included. Only the index is touched, and it is unstaged again.
+func (m *GitManager) DiffWorktree(ctx context.Context, workingDir, fromRef string) (string, error) {
+	dir := m.getWorkingDir(workingDir)
+	if _, err := m.commands.run(ctx, dir, "diff-worktree", "add", "-A"); err != nil {
+		return "", fmt.Errorf("failed to stage worktree: %w", err)
+	}
+	diff, err := m.commands.run(ctx, dir, "diff-worktree", "diff", "--cached", fromRef)
+	if _, resetErr := m.commands.run(ctx, dir, "diff-worktree", "reset", "-q"); resetErr != nil {
+		m.logger.Warn("Failed to unstage worktree after diff", "working_dir", workingDir, "error", resetErr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to diff worktree: %w", err)
+	}
+	if diff == "" {
+		return "", nil
+	}
+	return diff + "\n", nil
+}
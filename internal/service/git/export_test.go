@@ -0,0 +1,82 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_PatchAndBundle(t *testing.T) {
+	manager, err := NewGitManager(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	gitOutput(t, dir, "init", "-q", "-b", "main")
+	gitOutput(t, dir, "config", "user.email", "test@example.com")
+	gitOutput(t, dir, "config", "user.name", "Test User")
+	gitOutput(t, dir, "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	gitOutput(t, dir, "checkout", "-q", "-b", "task/login")
+
+	patch, err := manager.FormatPatch(ctx, dir, "main", "HEAD")
+	require.NoError(t, err)
+	assert.Empty(t, patch, "no commits since main")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.go"), []byte("package main\n"), 0o644))
+	gitOutput(t, dir, "add", ".")
+	gitOutput(t, dir, "commit", "-q", "-m", "Add login")
+
+	patch, err = manager.FormatPatch(ctx, dir, "main", "HEAD")
+	require.NoError(t, err)
+	assert.Contains(t, patch, "Subject: [PATCH] Add login")
+	assert.Contains(t, patch, "+package main")
+
+	// The patch applies on the base branch
+	patchPath := filepath.Join(t.TempDir(), "login.patch")
+	require.NoError(t, os.WriteFile(patchPath, []byte(patch), 0o644))
+	gitOutput(t, dir, "checkout", "-q", "-b", "applied", "main")
+	gitOutput(t, dir, "am", "-q", patchPath)
+	assert.Equal(t, "Add login", gitOutput(t, dir, "log", "-1", "--format=%s"))
+
+	bundlePath := filepath.Join(t.TempDir(), "login.bundle")
+	require.NoError(t, manager.CreateBundle(ctx, dir, bundlePath, "main", "task/login"))
+	gitOutput(t, dir, "bundle", "verify", "-q", bundlePath)
+	assert.Contains(t, gitOutput(t, dir, "bundle", "list-heads", bundlePath), "refs/heads/task/login")
+}
+
+func TestDiffWorktree(t *testing.T) {
+	manager, err := NewGitManager(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	gitOutput(t, dir, "init", "-q", "-b", "main")
+	gitOutput(t, dir, "config", "user.email", "test@example.com")
+	gitOutput(t, dir, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+	gitOutput(t, dir, "add", ".")
+	gitOutput(t, dir, "commit", "-q", "-m", "Initial commit")
+	gitOutput(t, dir, "checkout", "-q", "-b", "task/login")
+
+	diff, err := manager.DiffWorktree(ctx, dir, "main")
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// A committed change, a modified file and an untracked one
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.go"), []byte("package main\n\nfunc login() {}\n"), 0o644))
+	gitOutput(t, dir, "add", ".")
+	gitOutput(t, dir, "commit", "-q", "-m", "Add login")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logout.go"), []byte("package main\n\nfunc logout() {}\n"), 0o644))
+
+	diff, err = manager.DiffWorktree(ctx, dir, "main")
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+func login() {}")
+	assert.Contains(t, diff, "+func main() {}")
+	assert.Contains(t, diff, "+func logout() {}")
+	assert.Contains(t, gitOutput(t, dir, "status", "--porcelain"), "?? logout.go", "the index is left as it was")
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -24,6 +25,9 @@ type CommandResult struct {
 	Command  string
 }
 
+// hostGitConfig is passed to every git command the server runs
+var hostGitConfig = []string{"-c", "core.hooksPath=/dev/null", "-c", "core.fsmonitor=false"}
+
 // DefaultCommandExecutor implements CommandExecutor using os/exec
 type DefaultCommandExecutor struct {
 	gitPath        string
@@ -54,8 +58,10 @@ func (e *DefaultCommandExecutor) ExecuteWithTimeout(ctx context.Context, working
 	cmdCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// Build the full command
-	cmd := exec.CommandContext(cmdCtx, e.gitPath, args...)
+	// Build the full command. Hooks and the filesystem monitor are off, as
+	// they run what the repository's config names, which AI executions may
+	// have written.
+	cmd := exec.CommandContext(cmdCtx, e.gitPath, append(hostGitConfig, args...)...)
 	cmd.Dir = workingDir
 
 	// Set environment variables for Git
@@ -267,6 +273,26 @@ func (g *GitCommands) ListBranches(ctx context.Context, workingDir string, optio
 	return branches, nil
 }
 
+// LocalBranchExists reports whether a local branch exists
+// run command git show-ref --verify --quiet refs/heads/<branch-name>
+func (g *GitCommands) LocalBranchExists(ctx context.Context, workingDir, branchName string) (bool, error) {
+	args := []string{"show-ref", "--verify", "--quiet", "refs/heads/" + branchName}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return false, WrapWithOperation("local-branch-exists", err)
+	}
+
+	// A missing ref exits with 1
+	switch result.ExitCode {
+	case 0:
+		return true, nil
+	case 1:
+		return false, nil
+	default:
+		return false, NewGitError("local-branch-exists", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+}
+
 // CreateBranch creates a new branch
 func (g *GitCommands) CreateBranch(ctx context.Context, workingDir, branchName, startPoint string) error {
 	args := []string{"branch", branchName}
@@ -306,6 +332,22 @@ func (g *GitCommands) Checkout(ctx context.Context, workingDir, target string, c
 	return nil
 }
 
+// CheckoutNewBranch creates branchName at startPoint and switches to it
+// run command git checkout -b <branch-name> <start-point>
+func (g *GitCommands) CheckoutNewBranch(ctx context.Context, workingDir, branchName, startPoint string) error {
+	args := []string{"checkout", "-b", branchName, startPoint}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return WrapWithOperation("checkout", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("checkout", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
 // IsRepository checks if a directory is a Git repository
 func (g *GitCommands) IsRepository(ctx context.Context, path string) (bool, error) {
 	absPath, err := filepath.Abs(path)
@@ -335,6 +377,25 @@ func (g *GitCommands) GetRemoteURL(ctx context.Context, workingDir, remoteName s
 	return strings.TrimSpace(result.Stdout), nil
 }
 
+// RemoteDefaultBranch returns the branch the remote's HEAD points to, as
+// last fetched, or "" when it is not known
+func (g *GitCommands) RemoteDefaultBranch(ctx context.Context, workingDir, remoteName string) (string, error) {
+	result, err := g.executor.Execute(ctx, workingDir, "symbolic-ref", "--quiet", "--short", "refs/remotes/"+remoteName+"/HEAD")
+	if err != nil {
+		return "", WrapWithOperation("remote-default-branch", err)
+	}
+
+	// A missing or non-symbolic ref exits with 1
+	switch result.ExitCode {
+	case 0:
+		return strings.TrimPrefix(strings.TrimSpace(result.Stdout), remoteName+"/"), nil
+	case 1:
+		return "", nil
+	default:
+		return "", NewGitError("remote-default-branch", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+}
+
 // GetCommitInfo returns information about a commit
 func (g *GitCommands) GetCommitInfo(ctx context.Context, workingDir, commitish string) (*CommitInfo, error) {
 	// Format: hash|author|date|subject
@@ -431,6 +492,71 @@ func (g *GitCommands) CreateWorktree(ctx context.Context, workingDir, baseBranch
 	return nil
 }
 
+// CreateDetachedWorktree checks ref out in a new worktree without creating a
+// branch
+// run command git worktree add --detach <worktree-path> <ref>
+func (g *GitCommands) CreateDetachedWorktree(ctx context.Context, workingDir, ref, worktreePath string) error {
+	args := []string{"worktree", "add", "--detach", worktreePath, ref}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return WrapWithOperation("create-detached-worktree", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("create-detached-worktree", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
+// AddWorktree checks an existing branch out in a new worktree
+// run command git worktree add <worktree-path> <branch-name>
+func (g *GitCommands) AddWorktree(ctx context.Context, workingDir, branchName, worktreePath string) error {
+	args := []string{"worktree", "add", worktreePath, branchName}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return WrapWithOperation("add-worktree", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("add-worktree", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
+// PruneWorktrees removes the records of worktrees whose directory is gone
+// run command git worktree prune
+func (g *GitCommands) PruneWorktrees(ctx context.Context, workingDir string) error {
+	args := []string{"worktree", "prune"}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return WrapWithOperation("prune-worktrees", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("prune-worktrees", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
+// MoveWorktree moves a worktree to a new path, which must not exist
+// run command git worktree move <worktree-path> <new-path>
+func (g *GitCommands) MoveWorktree(ctx context.Context, workingDir, worktreePath, newPath string) error {
+	args := []string{"worktree", "move", worktreePath, newPath}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return WrapWithOperation("move-worktree", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("move-worktree", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
 // DeleteWorktree deletes a worktree
 // run command git worktree remove --force <worktree-path>
 func (g *GitCommands) DeleteWorktree(ctx context.Context, workingDir, worktreePath string) error {
@@ -475,6 +601,29 @@ func (g *GitCommands) Commit(ctx context.Context, workingDir, message string) er
 	return nil
 }
 
+// DiscardChanges drops all uncommitted changes, untracked files included
+func (g *GitCommands) DiscardChanges(ctx context.Context, workingDir string) error {
+	result, err := g.executor.Execute(ctx, workingDir, "reset", "--hard", "HEAD")
+	if err != nil {
+		return WrapWithOperation("discard-changes", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("discard-changes", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	result, err = g.executor.Execute(ctx, workingDir, "clean", "-fd")
+	if err != nil {
+		return WrapWithOperation("discard-changes", err)
+	}
+
+	if result.ExitCode != 0 {
+		return NewGitError("discard-changes", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return nil
+}
+
 // Push pushes commits to remote repository
 func (g *GitCommands) Push(ctx context.Context, workingDir, remote, branch string) error {
 	args := []string{"push"}
@@ -549,3 +698,53 @@ func (g *GitCommands) GetDiff(ctx context.Context, workingDir, fromRef, toRef st
 
 	return result.Stdout, nil
 }
+
+// GetChangedFiles returns the files added or modified between the merge base
+// of two refs and toRef; deleted files are left out
+// run command git diff --name-only --diff-filter=d <from-ref>...<to-ref>
+func (g *GitCommands) GetChangedFiles(ctx context.Context, workingDir, fromRef, toRef string) ([]string, error) {
+	args := []string{"diff", "--name-only", "--diff-filter=d", fmt.Sprintf("%s...%s", fromRef, toRef)}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return nil, WrapWithOperation("get-changed-files", err)
+	}
+
+	if result.ExitCode != 0 {
+		return nil, NewGitError("get-changed-files", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	var files []string
+	for _, line := range strings.Split(result.Stdout, "\n") {
+		if file := strings.TrimSpace(line); file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// GetAheadBehind counts the commits on ref that are not on baseRef (ahead)
+// and the commits on baseRef that are not on ref (behind)
+// run command git rev-list --left-right --count <base-ref>...<ref>
+func (g *GitCommands) GetAheadBehind(ctx context.Context, workingDir, baseRef, ref string) (ahead, behind int, err error) {
+	args := []string{"rev-list", "--left-right", "--count", fmt.Sprintf("%s...%s", baseRef, ref)}
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return 0, 0, WrapWithOperation("get-ahead-behind", err)
+	}
+
+	if result.ExitCode != 0 {
+		return 0, 0, NewGitError("get-ahead-behind", result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	counts := strings.Fields(result.Stdout)
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected git rev-list output: %q", result.Stdout)
+	}
+	if behind, err = strconv.Atoi(counts[0]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected git rev-list output: %q", result.Stdout)
+	}
+	if ahead, err = strconv.Atoi(counts[1]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected git rev-list output: %q", result.Stdout)
+	}
+	return ahead, behind, nil
+}
@@ -3,12 +3,15 @@ package git
 import (
 	"context"
 	"errors"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockCommandExecutor is a mock implementation of CommandExecutor
@@ -58,6 +61,29 @@ func TestDefaultCommandExecutor_Execute(t *testing.T) {
 	assert.Equal(t, "git --version", result.Command)
 }
 
+func TestDefaultCommandExecutor_IgnoresRepositoryHooks(t *testing.T) {
+	executor, err := NewDefaultCommandExecutor()
+	if err == ErrGitNotInstalled {
+		t.Skip("Git not installed, skipping test")
+	}
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	gitOutput(t, dir, "init", "-q", "-b", "main")
+	gitOutput(t, dir, "config", "user.email", "test@example.com")
+	gitOutput(t, dir, "config", "user.name", "Test User")
+	// A hook an AI execution could have planted
+	marker := filepath.Join(t.TempDir(), "hook-ran")
+	hook := "#!/bin/sh\ntouch " + marker + "\nexit 1\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "hooks", "pre-commit"), []byte(hook), 0o755))
+
+	result, err := executor.Execute(context.Background(), dir, "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.ExitCode, result.Stderr)
+	assert.NoFileExists(t, marker)
+	assert.Equal(t, "git commit -q --allow-empty -m Initial commit", result.Command)
+}
+
 func TestGitCommands_Version(t *testing.T) {
 	mockExecutor := new(MockCommandExecutor)
 	commands := NewGitCommands(mockExecutor)
@@ -476,3 +502,20 @@ func TestGitCommands_IsRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestGitCommands_DiscardChanges(t *testing.T) {
+	mockExecutor := new(MockCommandExecutor)
+	commands := NewGitCommands(mockExecutor)
+
+	mockExecutor.On("Execute", mock.Anything, "/tmp/repo", []string{"reset", "--hard", "HEAD"}).
+		Return(&CommandResult{ExitCode: 0}, nil).Once()
+	mockExecutor.On("Execute", mock.Anything, "/tmp/repo", []string{"clean", "-fd"}).
+		Return(&CommandResult{ExitCode: 0}, nil).Once()
+	assert.NoError(t, commands.DiscardChanges(context.Background(), "/tmp/repo"))
+	mockExecutor.AssertExpectations(t)
+
+	mockExecutor.On("Execute", mock.Anything, "/tmp/repo", []string{"reset", "--hard", "HEAD"}).
+		Return(&CommandResult{ExitCode: 128, Stderr: "fatal: not a git repository"}, nil).Once()
+	assert.Error(t, commands.DiscardChanges(context.Background(), "/tmp/repo"), "a failed reset stops before clean")
+	mockExecutor.AssertExpectations(t)
+}
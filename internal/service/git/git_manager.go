@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
 )
 
 // GitManager provides high-level Git operations and management
@@ -23,6 +28,9 @@ type ManagerConfig struct {
 	WorkingDir     string
 	EnableLogging  bool
 	LogLevel       slog.Level
+	// Recorder, when set, keeps an audit record of every worktree
+	// creation and deletion, commit, push and branch deletion
+	Recorder OperationRecorder
 }
 
 // NewGitManager creates a new GitManager instance
@@ -260,7 +268,11 @@ type CreateWorktreeRequest struct {
 	UseRemoteBranch    bool
 }
 
-func (m *GitManager) CreateWorktree(ctx context.Context, request *CreateWorktreeRequest) error {
+func (m *GitManager) CreateWorktree(ctx context.Context, request *CreateWorktreeRequest) (err error) {
+	defer func(start time.Time) {
+		m.recordOperation(ctx, entity.GitOperationWorktreeCreate, request.BaseWorkingDir, request.WorktreeWorkingDir, "", start, err)
+	}(time.Now())
+
 	baseBranchRef := request.BaseBranchName
 	if request.UseRemoteBranch {
 		err := m.executeWithRetry(ctx, func() error {
@@ -273,7 +285,7 @@ func (m *GitManager) CreateWorktree(ctx context.Context, request *CreateWorktree
 	}
 
 	// run command git worktree add -b <worktree-branch-name> <worktree-path> <base-branch-name>
-	err := m.executeWithRetry(ctx, func() error {
+	err = m.executeWithRetry(ctx, func() error {
 		return m.commands.CreateWorktree(
 			ctx,
 			request.BaseWorkingDir,
@@ -288,6 +300,108 @@ func (m *GitManager) CreateWorktree(ctx context.Context, request *CreateWorktree
 	return nil
 }
 
+// CreateDetachedWorktree checks ref out at worktreePath without creating a
+// branch, for throwaway worktrees of existing commits
+func (m *GitManager) CreateDetachedWorktree(ctx context.Context, workingDir, ref, worktreePath string) error {
+	start := time.Now()
+	err := m.executeWithRetry(ctx, func() error {
+		return m.commands.CreateDetachedWorktree(ctx, workingDir, ref, worktreePath)
+	})
+	m.recordOperation(ctx, entity.GitOperationWorktreeCreate, workingDir, worktreePath, "", start, err)
+	if err != nil {
+		return fmt.Errorf("failed to create detached worktree: %w", err)
+	}
+	return nil
+}
+
+// AddWorktree checks the existing branchName out at worktreePath
+func (m *GitManager) AddWorktree(ctx context.Context, workingDir, branchName, worktreePath string) error {
+	// run command git worktree add <worktree-path> <branch-name>
+	start := time.Now()
+	err := m.executeWithRetry(ctx, func() error {
+		return m.commands.AddWorktree(ctx, workingDir, branchName, worktreePath)
+	})
+	m.recordOperation(ctx, entity.GitOperationWorktreeCreate, workingDir, worktreePath, "", start, err)
+	if err != nil {
+		return fmt.Errorf("failed to add worktree: %w", err)
+	}
+	return nil
+}
+
+// PruneWorktrees forgets worktrees of the repository at workingDir whose
+// directory was removed, so their branches can be checked out again
+func (m *GitManager) PruneWorktrees(ctx context.Context, workingDir string) error {
+	if err := m.commands.PruneWorktrees(ctx, workingDir); err != nil {
+		return fmt.Errorf("failed to prune worktrees: %w", err)
+	}
+	return nil
+}
+
+// BranchExists reports whether the local branch branchName exists in the
+// repository at workingDir
+func (m *GitManager) BranchExists(ctx context.Context, workingDir, branchName string) (bool, error) {
+	exists, err := m.commands.LocalBranchExists(ctx, workingDir, branchName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check branch %s: %w", branchName, err)
+	}
+	return exists, nil
+}
+
+// RemoteDefaultBranch returns the default branch of the repository's remote
+// as last fetched, or "" when it is not known
+func (m *GitManager) RemoteDefaultBranch(ctx context.Context, workingDir, remote string) (string, error) {
+	branch, err := m.commands.RemoteDefaultBranch(ctx, workingDir, remote)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the default branch of %s: %w", remote, err)
+	}
+	return branch, nil
+}
+
+// IsWorktree reports whether path is the root of a checkout git can still
+// use. A worktree whose administrative files were pruned is not.
+func (m *GitManager) IsWorktree(ctx context.Context, path string) bool {
+	if _, err := os.Stat(filepath.Join(path, ".git")); err != nil {
+		return false
+	}
+	ok, err := m.commands.IsRepository(ctx, path)
+	return err == nil && ok
+}
+
+// MoveWorktree moves the worktree at worktreePath to newPath
+func (m *GitManager) MoveWorktree(ctx context.Context, workingDir, worktreePath, newPath string) error {
+	// run command git worktree move <worktree-path> <new-path>
+	err := m.executeWithRetry(ctx, func() error {
+		return m.commands.MoveWorktree(ctx, workingDir, worktreePath, newPath)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to move worktree: %w", err)
+	}
+	return nil
+}
+
+// Fetch fetches updates from remote
+func (m *GitManager) Fetch(ctx context.Context, workingDir, remote string) error {
+	err := m.executeWithRetry(ctx, func() error {
+		return m.commands.Fetch(ctx, workingDir, remote)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", remote, err)
+	}
+	return nil
+}
+
+// CheckoutNewBranch creates branchName at startPoint in the worktree at
+// workingDir and switches to it
+func (m *GitManager) CheckoutNewBranch(ctx context.Context, workingDir, branchName, startPoint string) error {
+	err := m.executeWithRetry(ctx, func() error {
+		return m.commands.CheckoutNewBranch(ctx, workingDir, branchName, startPoint)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check out new branch: %w", err)
+	}
+	return nil
+}
+
 // DeleteWorktree deletes a worktree
 type DeleteWorktreeRequest struct {
 	WorkingDir   string
@@ -296,9 +410,11 @@ type DeleteWorktreeRequest struct {
 
 func (m *GitManager) DeleteWorktree(ctx context.Context, request *DeleteWorktreeRequest) error {
 	// run command git worktree remove --force <worktree-path>
+	start := time.Now()
 	err := m.executeWithRetry(ctx, func() error {
 		return m.commands.DeleteWorktree(ctx, request.WorkingDir, request.WorktreePath)
 	})
+	m.recordOperation(ctx, entity.GitOperationWorktreeDelete, request.WorkingDir, request.WorktreePath, "", start, err)
 	if err != nil {
 		return fmt.Errorf("failed to delete worktree: %w", err)
 	}
@@ -324,12 +440,15 @@ func (m *GitManager) CommitAndPush(ctx context.Context, workingDir, commitMessag
 	if !hasPendingChanges {
 		m.logger.Info("No pending changes to commit, skipping commit step")
 	} else {
+		start := time.Now()
+
 		// Stage all changes
 		err = m.executeWithRetry(ctx, func() error {
 			return m.commands.AddAllChanges(ctx, workingDir)
 		})
 		if err != nil {
 			m.logger.Error("Failed to add changes", "error", err)
+			m.recordOperation(ctx, entity.GitOperationCommit, workingDir, "", "", start, err)
 			return fmt.Errorf("failed to stage changes: %w", err)
 		}
 
@@ -339,14 +458,18 @@ func (m *GitManager) CommitAndPush(ctx context.Context, workingDir, commitMessag
 		})
 		if err != nil {
 			m.logger.Error("Failed to commit changes", "error", err)
+			m.recordOperation(ctx, entity.GitOperationCommit, workingDir, "", "", start, err)
 			return fmt.Errorf("failed to commit changes: %w", err)
 		}
+		m.recordOperation(ctx, entity.GitOperationCommit, workingDir, "", m.headCommit(ctx, workingDir), start, nil)
 	}
 
 	// Push changes with upstream tracking (always runs)
+	start := time.Now()
 	err = m.executeWithRetry(ctx, func() error {
 		return m.commands.PushWithUpstream(ctx, workingDir, remote, branch)
 	})
+	m.recordOperation(ctx, entity.GitOperationPush, workingDir, remote+"/"+branch, m.headCommit(ctx, workingDir), start, err)
 	if err != nil {
 		m.logger.Error("Failed to push changes", "error", err)
 		return fmt.Errorf("failed to push changes: %w", err)
@@ -360,6 +483,58 @@ func (m *GitManager) CommitAndPush(ctx context.Context, workingDir, commitMessag
 	return nil
 }
 
+// CommitAll stages and commits all pending changes without pushing. It
+// reports false when there was nothing to commit.
+func (m *GitManager) CommitAll(ctx context.Context, workingDir, commitMessage string) (bool, error) {
+	workingDir = m.getWorkingDir(workingDir)
+
+	hasPendingChanges, err := m.commands.GetPendingChanges(ctx, workingDir)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pending changes: %w", err)
+	}
+	if !hasPendingChanges {
+		return false, nil
+	}
+
+	start := time.Now()
+	defer func() {
+		commitSHA := ""
+		if err == nil {
+			commitSHA = m.headCommit(ctx, workingDir)
+		}
+		m.recordOperation(ctx, entity.GitOperationCommit, workingDir, "", commitSHA, start, err)
+	}()
+
+	err = m.executeWithRetry(ctx, func() error {
+		return m.commands.AddAllChanges(ctx, workingDir)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to stage changes: %w", err)
+	}
+
+	err = m.executeWithRetry(ctx, func() error {
+		return m.commands.Commit(ctx, workingDir, commitMessage)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to commit changes: %w", err)
+	}
+
+	m.logger.Info("Committed pending changes", "working_dir", workingDir)
+	return true, nil
+}
+
+// DiscardChanges drops all uncommitted changes in the working directory
+func (m *GitManager) DiscardChanges(ctx context.Context, workingDir string) error {
+	workingDir = m.getWorkingDir(workingDir)
+
+	if err := m.commands.DiscardChanges(ctx, workingDir); err != nil {
+		return fmt.Errorf("failed to discard changes: %w", err)
+	}
+
+	m.logger.Info("Discarded pending changes", "working_dir", workingDir)
+	return nil
+}
+
 // HasPendingChanges checks if there are uncommitted changes in the working directory
 func (m *GitManager) HasPendingChanges(ctx context.Context, workingDir string) (bool, error) {
 	workingDir = m.getWorkingDir(workingDir)
@@ -395,7 +570,10 @@ func (m *GitManager) SwitchToBranch(ctx context.Context, workingDir, branchName
 
 // DeleteBranch deletes a branch with proper cleanup
 func (m *GitManager) DeleteBranch(ctx context.Context, workingDir, branchName string, force bool) error {
-	return m.branchManager.DeleteBranch(ctx, workingDir, branchName, force)
+	start := time.Now()
+	err := m.branchManager.DeleteBranch(ctx, workingDir, branchName, force)
+	m.recordOperation(ctx, entity.GitOperationBranchDelete, workingDir, branchName, "", start, err)
+	return err
 }
 
 // CheckBranchConflict checks for potential branch naming conflicts
@@ -447,6 +625,62 @@ func (m *GitManager) GetDiff(ctx context.Context, workingDir, fromRef, toRef str
 	return m.commands.GetDiff(ctx, workingDir, fromRef, toRef)
 }
 
+// GetChangedFiles returns the files added or modified on toRef since it
+// diverged from fromRef
+func (m *GitManager) GetChangedFiles(ctx context.Context, workingDir, fromRef, toRef string) ([]string, error) {
+	return m.commands.GetChangedFiles(ctx, workingDir, fromRef, toRef)
+}
+
+// WorktreeStatus is the state of a worktree compared to its base branch
+type WorktreeStatus struct {
+	Branch string
+	// DirtyFiles counts modified, staged and untracked files
+	DirtyFiles int
+	// Ahead and Behind count commits relative to the base branch; they are
+	// nil when the base branch cannot be found
+	Ahead      *int
+	Behind     *int
+	LastCommit *CommitInfo
+}
+
+// GetWorktreeStatus returns the branch, uncommitted changes, position
+// relative to baseRef and last commit of the worktree at workingDir
+func (m *GitManager) GetWorktreeStatus(ctx context.Context, workingDir, baseRef string) (*WorktreeStatus, error) {
+	branch, err := m.commands.CurrentBranch(ctx, workingDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	output, err := m.commands.Status(ctx, workingDir, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+	status := &WorktreeStatus{Branch: branch}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) != "" {
+			status.DirtyFiles++
+		}
+	}
+
+	if baseRef != "" {
+		ahead, behind, err := m.commands.GetAheadBehind(ctx, workingDir, baseRef, "HEAD")
+		if err != nil {
+			m.logger.Warn("Failed to compare worktree with base branch", "working_dir", workingDir, "base_ref", baseRef, "error", err)
+		} else {
+			status.Ahead = &ahead
+			status.Behind = &behind
+		}
+	}
+
+	if commit, err := m.commands.GetCommitInfo(ctx, workingDir, "HEAD"); err != nil {
+		m.logger.Warn("Failed to get last commit", "working_dir", workingDir, "error", err)
+	} else {
+		status.LastCommit = commit
+	}
+
+	return status, nil
+}
+
 // Helper methods
 
 // executeWithRetry executes a function with retry logic
@@ -0,0 +1,112 @@
+package git
+
+import (
+	"context"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// defaultActor is recorded for operations done outside of any job or request
+const defaultActor = "system"
+
+// OperationRecorder stores the audit records of the git operations the
+// manager does
+type OperationRecorder interface {
+	Create(ctx context.Context, operation *entity.GitOperation) error
+}
+
+// OperationScope is what git operations are done for, recorded with them
+type OperationScope struct {
+	ProjectID   uuid.UUID
+	TaskID      uuid.UUID
+	ExecutionID uuid.UUID
+	// Actor is who asked for the operations, see entity.GitOperation
+	Actor string
+}
+
+type operationScopeKey struct{}
+
+// WithOperationScope scopes the git operations done with ctx. Fields left
+// unset keep the value of the scope ctx already has.
+func WithOperationScope(ctx context.Context, scope OperationScope) context.Context {
+	current := OperationScopeFromContext(ctx)
+	if scope.ProjectID != uuid.Nil {
+		current.ProjectID = scope.ProjectID
+	}
+	if scope.TaskID != uuid.Nil {
+		current.TaskID = scope.TaskID
+	}
+	if scope.ExecutionID != uuid.Nil {
+		current.ExecutionID = scope.ExecutionID
+	}
+	if scope.Actor != "" {
+		current.Actor = scope.Actor
+	}
+	return context.WithValue(ctx, operationScopeKey{}, current)
+}
+
+// OperationScopeFromContext returns the scope of the git operations done
+// with ctx
+func OperationScopeFromContext(ctx context.Context) OperationScope {
+	scope, _ := ctx.Value(operationScopeKey{}).(OperationScope)
+	return scope
+}
+
+// recordOperation stores the audit record of an operation that started at
+// start and ended with err. Failing to store it is logged, not returned, so
+// auditing never stops the operation's caller.
+func (m *GitManager) recordOperation(ctx context.Context, operation entity.GitOperationType, workingDir, target, commitSHA string, start time.Time, err error) {
+	if m.config.Recorder == nil {
+		return
+	}
+
+	scope := OperationScopeFromContext(ctx)
+	record := &entity.GitOperation{
+		ProjectID:   optionalID(scope.ProjectID),
+		TaskID:      optionalID(scope.TaskID),
+		ExecutionID: optionalID(scope.ExecutionID),
+		Operation:   operation,
+		Actor:       scope.Actor,
+		WorkingDir:  workingDir,
+		Target:      target,
+		CommitSHA:   commitSHA,
+		Succeeded:   err == nil,
+		DurationMs:  time.Since(start).Milliseconds(),
+	}
+	if record.Actor == "" {
+		record.Actor = defaultActor
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	// A cancelled operation is still recorded
+	if recordErr := m.config.Recorder.Create(context.WithoutCancel(ctx), record); recordErr != nil {
+		m.logger.Error("Failed to record git operation",
+			"operation", operation,
+			"working_dir", workingDir,
+			"error", recordErr)
+	}
+}
+
+// headCommit returns the commit HEAD points to in workingDir for the audit
+// record, or "" when it cannot be read or nothing is recorded
+func (m *GitManager) headCommit(ctx context.Context, workingDir string) string {
+	if m.config.Recorder == nil {
+		return ""
+	}
+	info, err := m.commands.GetCommitInfo(ctx, workingDir, "HEAD")
+	if err != nil {
+		return ""
+	}
+	return info.Hash
+}
+
+func optionalID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
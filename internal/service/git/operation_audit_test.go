@@ -0,0 +1,79 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type operationLog []*entity.GitOperation
+
+func (l *operationLog) Create(ctx context.Context, operation *entity.GitOperation) error {
+	*l = append(*l, operation)
+	return nil
+}
+
+func TestWithOperationScope_KeepsUnsetFields(t *testing.T) {
+	projectID, taskID, executionID := uuid.New(), uuid.New(), uuid.New()
+
+	ctx := WithOperationScope(context.Background(), OperationScope{Actor: "job:task:implementation", TaskID: taskID})
+	ctx = WithOperationScope(ctx, OperationScope{ProjectID: projectID, ExecutionID: executionID})
+
+	assert.Equal(t, OperationScope{
+		ProjectID:   projectID,
+		TaskID:      taskID,
+		ExecutionID: executionID,
+		Actor:       "job:task:implementation",
+	}, OperationScopeFromContext(ctx))
+	assert.Equal(t, OperationScope{}, OperationScopeFromContext(context.Background()))
+}
+
+func TestGitManager_RecordsOperations(t *testing.T) {
+	var log operationLog
+	manager, err := NewGitManager(&ManagerConfig{MaxRetries: 1, EnableLogging: true, Recorder: &log})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	gitOutput(t, dir, "init", "-q", "-b", "main")
+	gitOutput(t, dir, "config", "user.email", "test@example.com")
+	gitOutput(t, dir, "config", "user.name", "Test User")
+
+	taskID := uuid.New()
+	ctx := WithOperationScope(context.Background(), OperationScope{TaskID: taskID, Actor: "api"})
+
+	committed, err := manager.CommitAll(ctx, dir, "Nothing to commit")
+	require.NoError(t, err)
+	assert.False(t, committed)
+	assert.Empty(t, log, "nothing was done, so nothing is recorded")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+	committed, err = manager.CommitAll(ctx, dir, "Add main")
+	require.NoError(t, err)
+	assert.True(t, committed)
+
+	assert.Error(t, manager.DeleteBranch(context.Background(), dir, "missing", false))
+
+	require.Len(t, log, 2)
+	commit := log[0]
+	assert.Equal(t, entity.GitOperationCommit, commit.Operation)
+	assert.Equal(t, &taskID, commit.TaskID)
+	assert.Nil(t, commit.ProjectID)
+	assert.Equal(t, "api", commit.Actor)
+	assert.Equal(t, dir, commit.WorkingDir)
+	assert.Equal(t, gitOutput(t, dir, "rev-parse", "HEAD"), commit.CommitSHA)
+	assert.True(t, commit.Succeeded)
+
+	deleteBranch := log[1]
+	assert.Equal(t, entity.GitOperationBranchDelete, deleteBranch.Operation)
+	assert.Nil(t, deleteBranch.TaskID)
+	assert.Equal(t, defaultActor, deleteBranch.Actor)
+	assert.Equal(t, "missing", deleteBranch.Target)
+	assert.False(t, deleteBranch.Succeeded)
+	assert.NotEmpty(t, deleteBranch.Error)
+}
@@ -0,0 +1,134 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// snapshotRefPrefix holds the snapshot refs. Refs are shared by every
+// worktree of a repository, so each snapshot lives under a namespace, such as
+// the task ID.
+const snapshotRefPrefix = "refs/auto-devs/snapshots/"
+
+// ErrSnapshotNotFound is returned when restoring a snapshot that does not exist
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Snapshot is a saved state of a worktree: its commit plus every uncommitted
+// and untracked file that is not ignored
+type Snapshot struct {
+	ID   string
+	Hash string
+	// Base is the commit the worktree was on when the snapshot was taken
+	Base      string
+	Message   string
+	CreatedAt time.Time
+}
+
+func snapshotRef(namespace, id string) string {
+	return snapshotRefPrefix + namespace + "/" + id
+}
+
+// CreateSnapshot saves the worktree at workingDir as snapshot id of
+// namespace, replacing any snapshot of the same id. The files in the
+// worktree are left as they are; only the index is refreshed.
+func (m *GitManager) CreateSnapshot(ctx context.Context, workingDir, namespace, id, message string) (*Snapshot, error) {
+	base, err := m.commands.run(ctx, workingDir, "create-snapshot", "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	if _, err := m.commands.run(ctx, workingDir, "create-snapshot", "add", "-A"); err != nil {
+		return nil, fmt.Errorf("failed to stage worktree: %w", err)
+	}
+	tree, err := m.commands.run(ctx, workingDir, "create-snapshot", "write-tree")
+	// Unstage again, whether or not the tree was written
+	if _, resetErr := m.commands.run(ctx, workingDir, "create-snapshot", "reset", "-q"); resetErr != nil {
+		m.logger.Warn("Failed to unstage worktree after snapshot", "working_dir", workingDir, "error", resetErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write worktree tree: %w", err)
+	}
+
+	hash, err := m.commands.run(ctx, workingDir, "create-snapshot", "commit-tree", tree, "-p", base, "-m", message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit snapshot: %w", err)
+	}
+	if _, err := m.commands.run(ctx, workingDir, "create-snapshot", "update-ref", snapshotRef(namespace, id), hash); err != nil {
+		return nil, fmt.Errorf("failed to save snapshot ref: %w", err)
+	}
+
+	m.logger.Info("Created worktree snapshot", "working_dir", workingDir, "namespace", namespace, "id", id, "hash", hash)
+	return &Snapshot{ID: id, Hash: hash, Base: base, Message: message, CreatedAt: time.Now()}, nil
+}
+
+// ListSnapshots returns the snapshots of namespace, newest first
+func (m *GitManager) ListSnapshots(ctx context.Context, workingDir, namespace string) ([]Snapshot, error) {
+	prefix := snapshotRef(namespace, "")
+	out, err := m.commands.run(ctx, workingDir, "list-snapshots", "for-each-ref", "--sort=-creatordate",
+		"--format=%(refname)%09%(objectname)%09%(parent)%09%(creatordate:iso-strict)%09%(contents:subject)", prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []Snapshot{}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, "\t", 5)
+		if len(fields) < 5 {
+			continue
+		}
+		createdAt, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot date: %w", err)
+		}
+		snapshots = append(snapshots, Snapshot{
+			ID:        strings.TrimPrefix(fields[0], prefix),
+			Hash:      fields[1],
+			Base:      fields[2],
+			CreatedAt: createdAt,
+			Message:   fields[4],
+		})
+	}
+	return snapshots, nil
+}
+
+// RestoreSnapshot puts the worktree at workingDir back to snapshot id of
+// namespace. Commits made since the snapshot are dropped from the branch and
+// uncommitted changes are discarded; the snapshot's uncommitted files come
+// back uncommitted.
+func (m *GitManager) RestoreSnapshot(ctx context.Context, workingDir, namespace, id string) error {
+	hash, err := m.commands.run(ctx, workingDir, "restore-snapshot", "rev-parse", "--verify", "--quiet", snapshotRef(namespace, id)+"^{commit}")
+	if err != nil {
+		return ErrSnapshotNotFound
+	}
+
+	for _, args := range [][]string{
+		{"reset", "--hard", hash + "^"},
+		{"clean", "-fd"},
+		{"read-tree", "-u", "--reset", hash},
+		{"reset", "-q"},
+	} {
+		if _, err := m.commands.run(ctx, workingDir, "restore-snapshot", args...); err != nil {
+			return fmt.Errorf("failed to restore snapshot: %w", err)
+		}
+	}
+
+	m.logger.Info("Restored worktree snapshot", "working_dir", workingDir, "namespace", namespace, "id", id, "hash", hash)
+	return nil
+}
+
+// run runs a git command and returns its trimmed output
+func (g *GitCommands) run(ctx context.Context, workingDir, operation string, args ...string) (string, error) {
+	result, err := g.executor.Execute(ctx, workingDir, args...)
+	if err != nil {
+		return "", WrapWithOperation(operation, err)
+	}
+
+	if result.ExitCode != 0 {
+		return "", NewGitError(operation, result.ExitCode, result.Command, result.Stdout, result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
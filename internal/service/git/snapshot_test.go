@@ -0,0 +1,76 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestSnapshot_CreateAndRestore(t *testing.T) {
+	manager, err := NewGitManager(nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	gitOutput(t, dir, "init", "-q", "-b", "main")
+	gitOutput(t, dir, "config", "user.email", "test@example.com")
+	gitOutput(t, dir, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "old.go"), []byte("package main\n"), 0o644))
+	gitOutput(t, dir, "add", ".")
+	gitOutput(t, dir, "commit", "-q", "-m", "Initial commit")
+	base := gitOutput(t, dir, "rev-parse", "HEAD")
+
+	// The previous attempt left a modified, a deleted and an untracked file
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+	require.NoError(t, os.Remove(filepath.Join(dir, "old.go")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.go"), []byte("package main\n"), 0o644))
+
+	snapshot, err := manager.CreateSnapshot(ctx, dir, "task-1", "exec-1", "Before execution exec-1")
+	require.NoError(t, err)
+	assert.Equal(t, base, snapshot.Base)
+	assert.Equal(t, base, gitOutput(t, dir, "rev-parse", "HEAD"), "the branch does not move")
+	assert.Equal(t, "M main.go\n D old.go\n?? login.go", gitOutput(t, dir, "status", "--porcelain"), "the files are left uncommitted")
+
+	// A bad re-run commits over the attempt and leaves more files behind
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.go"), []byte("broken"), 0o644))
+	gitOutput(t, dir, "add", "-A")
+	gitOutput(t, dir, "commit", "-q", "-m", "Bad re-run")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "junk.go"), []byte("junk"), 0o644))
+
+	require.NoError(t, manager.RestoreSnapshot(ctx, dir, "task-1", "exec-1"))
+	assert.Equal(t, base, gitOutput(t, dir, "rev-parse", "HEAD"))
+	content, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package main\n\nfunc main() {}\n", string(content))
+	assert.FileExists(t, filepath.Join(dir, "login.go"))
+	assert.NoFileExists(t, filepath.Join(dir, "old.go"))
+	assert.NoFileExists(t, filepath.Join(dir, "junk.go"))
+	assert.Equal(t, "M main.go\n D old.go\n?? login.go", gitOutput(t, dir, "status", "--porcelain"))
+
+	snapshots, err := manager.ListSnapshots(ctx, dir, "task-1")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "exec-1", snapshots[0].ID)
+	assert.Equal(t, snapshot.Hash, snapshots[0].Hash)
+	assert.Equal(t, base, snapshots[0].Base)
+	assert.Equal(t, "Before execution exec-1", snapshots[0].Message)
+
+	other, err := manager.ListSnapshots(ctx, dir, "task-2")
+	require.NoError(t, err)
+	assert.Empty(t, other)
+
+	assert.ErrorIs(t, manager.RestoreSnapshot(ctx, dir, "task-1", "missing"), ErrSnapshotNotFound)
+}
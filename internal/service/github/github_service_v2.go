@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -179,6 +180,119 @@ func (gs *GitHubServiceV2) UpdatePullRequest(ctx context.Context, repo string, p
 	return nil
 }
 
+// CreateReview adds a COMMENT review to a pull request on GitHub
+func (gs *GitHubServiceV2) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error {
+	if err := gs.validateRepository(repo); err != nil {
+		return fmt.Errorf("invalid repository: %w", err)
+	}
+
+	if prNumber <= 0 {
+		return fmt.Errorf("invalid pull request number: %d", prNumber)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	reviewRequest := &github.PullRequestReviewRequest{
+		Body:  &body,
+		Event: github.String("COMMENT"),
+	}
+	for _, comment := range comments {
+		reviewRequest.Comments = append(reviewRequest.Comments, &github.DraftReviewComment{
+			Path: github.String(comment.Path),
+			Line: github.Int(comment.Line),
+			Side: github.String("RIGHT"),
+			Body: github.String(comment.Body),
+		})
+	}
+
+	_, resp, err := gs.client.PullRequests.CreateReview(ctx, owner, name, prNumber, reviewRequest)
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return fmt.Errorf("failed to create pull request review: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return nil
+}
+
+// CreateIssueComment adds a comment to a pull request's conversation on GitHub
+func (gs *GitHubServiceV2) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	if err := gs.validateRepository(repo); err != nil {
+		return 0, fmt.Errorf("invalid repository: %w", err)
+	}
+
+	if prNumber <= 0 {
+		return 0, fmt.Errorf("invalid pull request number: %d", prNumber)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return 0, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	comment, resp, err := gs.client.Issues.CreateComment(ctx, owner, name, prNumber, &github.IssueComment{Body: &body})
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return 0, fmt.Errorf("failed to create pull request comment: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return comment.GetID(), nil
+}
+
+// UpdateIssueComment replaces the body of a pull request comment on GitHub.
+// Comment IDs are unique in a repository, so prNumber is not needed.
+func (gs *GitHubServiceV2) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	if err := gs.validateRepository(repo); err != nil {
+		return fmt.Errorf("invalid repository: %w", err)
+	}
+
+	if commentID <= 0 {
+		return fmt.Errorf("invalid comment ID: %d", commentID)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	_, resp, err := gs.client.Issues.EditComment(ctx, owner, name, commentID, &github.IssueComment{Body: &body})
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return fmt.Errorf("failed to update pull request comment: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return nil
+}
+
 // MergePullRequest merges a pull request on GitHub
 func (gs *GitHubServiceV2) MergePullRequest(ctx context.Context, repo string, prNumber int, mergeMethod string) error {
 	if err := gs.validateRepository(repo); err != nil {
@@ -224,6 +338,132 @@ func (gs *GitHubServiceV2) MergePullRequest(ctx context.Context, repo string, pr
 	return nil
 }
 
+// GetDefaultBranch returns the default branch of a repository on GitHub
+func (gs *GitHubServiceV2) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	if err := gs.validateRepository(repo); err != nil {
+		return "", fmt.Errorf("invalid repository: %w", err)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return "", fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	repository, resp, err := gs.client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return "", fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return repository.GetDefaultBranch(), nil
+}
+
+// IsBranchProtected reports whether a branch has protection rules on
+// GitHub. A branch that does not exist on GitHub is not protected.
+func (gs *GitHubServiceV2) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	if err := gs.validateRepository(repo); err != nil {
+		return false, fmt.Errorf("invalid repository: %w", err)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return false, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	ghBranch, resp, err := gs.client.Repositories.GetBranch(ctx, owner, name, branch, 1)
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+			if resp.StatusCode == http.StatusNotFound {
+				return false, nil
+			}
+		}
+		return false, fmt.Errorf("failed to get branch: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return ghBranch.GetProtected(), nil
+}
+
+// HasOpenPullRequest reports whether a pull request from a branch of the
+// repository is open on GitHub
+func (gs *GitHubServiceV2) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	if err := gs.validateRepository(repo); err != nil {
+		return false, fmt.Errorf("invalid repository: %w", err)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return false, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	prs, resp, err := gs.client.PullRequests.List(ctx, owner, name, &github.PullRequestListOptions{
+		State:       "open",
+		Head:        owner + ":" + branch,
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return false, fmt.Errorf("failed to list pull requests: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return len(prs) > 0, nil
+}
+
+// GetPullRequestDiff returns the changes of a pull request as a unified
+// diff, as GitHub renders them
+func (gs *GitHubServiceV2) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	if err := gs.validateRepository(repo); err != nil {
+		return "", fmt.Errorf("invalid repository: %w", err)
+	}
+
+	// Wait for rate limit
+	if err := gs.rateLimiter.Wait(ctx); err != nil {
+		return "", fmt.Errorf("rate limit error: %w", err)
+	}
+
+	// Parse repository owner and name
+	owner, name := gs.parseRepository(repo)
+
+	diff, resp, err := gs.client.PullRequests.GetRaw(ctx, owner, name, prNumber, github.RawOptions{Type: github.Diff})
+	if err != nil {
+		// Update rate limiter from response
+		if resp != nil {
+			gs.rateLimiter.UpdateFromGitHubResponse(resp)
+		}
+		return "", fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+
+	// Update rate limiter
+	gs.rateLimiter.UpdateFromGitHubResponse(resp)
+
+	return diff, nil
+}
+
 // ValidateToken validates the GitHub token by making a test API call
 func (gs *GitHubServiceV2) ValidateToken(ctx context.Context) error {
 	// Wait for rate limit
@@ -4,19 +4,22 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
 	"github.com/google/uuid"
 )
 
-// GitHubServiceInterface defines the interface for GitHub operations needed by PRCreator and PRMonitor
-type GitHubServiceInterface interface {
-	CreatePullRequest(ctx context.Context, repo, base, head, title, body string) (*entity.PullRequest, error)
-	UpdatePullRequest(ctx context.Context, repo string, prNumber int, updates map[string]interface{}) error
-	GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error)
-}
+// GitHubServiceInterface defines the git provider operations needed by
+// PRCreator and PRMonitor, on GitHub or GitLab
+type GitHubServiceInterface = vcs.Service
+
+// InlineReviewComment is a review comment on a line of a pull request's diff
+type InlineReviewComment = vcs.InlineReviewComment
 
 // PRCreator handles automatic pull request creation from completed implementations
 type PRCreator struct {
@@ -60,7 +63,7 @@ func (prc *PRCreator) CreatePRFromImplementation(ctx context.Context, task entit
 
 	// Create the pull request via GitHub API
 	githubPR, err := prc.githubService.CreatePullRequest(
-		ctx,
+		WithProjectID(ctx, task.ProjectID),
 		repository,
 		*task.BaseBranchName, // base branch - should be get from tas
 		*task.BranchName,     // head branch
@@ -103,85 +106,420 @@ func (prc *PRCreator) GeneratePRTitle(task entity.Task) (string, error) {
 	return fmt.Sprintf("%s %s (%s)", typePrefix, title, task.ID.String()[:8]), nil
 }
 
-// GeneratePRDescription creates a comprehensive description for the pull request
+// GeneratePRDescription creates a comprehensive description for the pull
+// request, in the language of the task's project when it is loaded
 func (prc *PRCreator) GeneratePRDescription(task entity.Task, plan *entity.Plan, execution entity.Execution) (string, error) {
 	var description strings.Builder
+	locale := taskLocale(task)
+	writeLine := func(key string, args ...interface{}) {
+		description.WriteString(i18n.T(locale, key, args...) + "\n")
+	}
 
 	// Add task information
-	description.WriteString("## Task Information\n\n")
-	description.WriteString(fmt.Sprintf("**Task ID:** %s\n", task.ID.String()))
-	description.WriteString(fmt.Sprintf("**Title:** %s\n", task.Title))
+	writeLine("pr.task_information")
+	description.WriteString("\n")
+	writeLine("pr.task_id", task.ID.String())
+	writeLine("pr.title", task.Title)
 
 	if task.Description != "" {
-		description.WriteString(fmt.Sprintf("**Description:**\n%s\n\n", task.Description))
+		writeLine("pr.description")
+		description.WriteString(task.Description + "\n\n")
 	}
 
-	description.WriteString(fmt.Sprintf("**Priority:** %s\n", task.Priority.GetDisplayName()))
-	description.WriteString(fmt.Sprintf("**Status:** %s\n\n", task.Status.GetDisplayName()))
+	writeLine("pr.priority", i18n.DisplayName(locale, "task.priority."+string(task.Priority), task.Priority.GetDisplayName()))
+	writeLine("pr.status", i18n.DisplayName(locale, "task.status."+string(task.Status), task.Status.GetDisplayName()))
+	description.WriteString("\n")
 
 	// Add task link
 	if prc.baseURL != "" {
 		taskURL := fmt.Sprintf("%s/projects/%s/tasks/%s", prc.baseURL, task.ProjectID.String(), task.ID.String())
-		description.WriteString(fmt.Sprintf("**Task URL:** %s\n\n", taskURL))
+		writeLine("pr.task_url", taskURL)
+		description.WriteString("\n")
 	}
 
 	// Add plan reference if available
 	if plan != nil {
-		description.WriteString("## Implementation Plan\n\n")
-		description.WriteString(fmt.Sprintf("**Plan Status:** %s\n", plan.Status.GetDisplayName()))
-		description.WriteString(fmt.Sprintf("**Plan ID:** %s\n\n", plan.ID.String()))
+		writeLine("pr.implementation_plan")
+		description.WriteString("\n")
+		writeLine("pr.plan_status", i18n.DisplayName(locale, "plan.status."+string(plan.Status), plan.Status.GetDisplayName()))
+		writeLine("pr.plan_id", plan.ID.String())
+		description.WriteString("\n")
 
 		// Add truncated plan content for context
 		planContent := plan.Content
 		if len(planContent) > 500 {
-			planContent = planContent[:500] + "...\n\n[See full plan in task details]"
+			planContent = planContent[:500] + "...\n\n" + i18n.T(locale, "pr.plan_truncated")
 		}
-		description.WriteString(fmt.Sprintf("**Plan Summary:**\n```\n%s\n```\n\n", planContent))
+		writeLine("pr.plan_summary")
+		description.WriteString(fmt.Sprintf("```\n%s\n```\n\n", planContent))
 	}
 
 	// Add implementation summary
-	description.WriteString("## Implementation Summary\n\n")
-	description.WriteString(fmt.Sprintf("**Execution ID:** %s\n", execution.ID.String()))
-	description.WriteString(fmt.Sprintf("**Execution Status:** %s\n", execution.Status))
-	description.WriteString(fmt.Sprintf("**Started At:** %s\n", execution.StartedAt.Format(time.RFC3339)))
+	writeLine("pr.implementation_summary")
+	description.WriteString("\n")
+	writeLine("pr.execution_id", execution.ID.String())
+	writeLine("pr.execution_status", execution.Status)
+	writeLine("pr.started_at", execution.StartedAt.Format(time.RFC3339))
 
 	if execution.CompletedAt != nil {
-		description.WriteString(fmt.Sprintf("**Completed At:** %s\n", execution.CompletedAt.Format(time.RFC3339)))
+		writeLine("pr.completed_at", execution.CompletedAt.Format(time.RFC3339))
 		duration := execution.GetDuration()
-		description.WriteString(fmt.Sprintf("**Duration:** %v\n", duration.Round(time.Second)))
+		writeLine("pr.duration", duration.Round(time.Second))
 	}
 
 	if execution.Result != nil {
-		description.WriteString(fmt.Sprintf("**Implementation Result:**\n```json\n%s\n```\n\n", *execution.Result))
+		writeLine("pr.implementation_result")
+		description.WriteString(fmt.Sprintf("```json\n%s\n```\n\n", *execution.Result))
+	}
+
+	// Add the results of the project's verification pipeline; the AI review
+	// has its own section below
+	if execution.FixAttempts > 0 {
+		writeLine("pr.fix_attempts", execution.FixAttempts)
+		description.WriteString("\n")
+	}
+	for _, run := range execution.VerificationRuns {
+		if run.Step == entity.VerificationStepReview {
+			continue
+		}
+		writeVerificationRun(&description, locale, run)
+		if run.Step == entity.VerificationStepSecurityScan {
+			writeSecurityFindings(&description, locale, execution.SecurityFindings)
+		}
+	}
+
+	// Add how the changes moved the test coverage
+	if execution.Coverage != nil {
+		writeCoverage(&description, locale, &execution)
+	}
+
+	// Add the findings of the AI review of the diff
+	if execution.ReviewComments != nil {
+		writeReviewSummary(&description, locale, execution.ReviewComments)
 	}
 
 	// Add testing instructions
-	description.WriteString("## Testing Instructions\n\n")
-	description.WriteString("1. Check out this branch locally\n")
-	description.WriteString("2. Run the application and verify the implemented functionality\n")
-	description.WriteString("3. Run tests to ensure no regressions:\n")
+	writeLine("pr.testing_instructions")
+	description.WriteString("\n")
+	writeLine("pr.testing_checkout")
+	writeLine("pr.testing_run")
+	writeLine("pr.testing_tests")
 	description.WriteString("   ```bash\n")
 	description.WriteString("   make test\n")
 	description.WriteString("   ```\n")
-	description.WriteString("4. Verify the changes meet the requirements outlined in the task description\n\n")
+	writeLine("pr.testing_requirements")
+	description.WriteString("\n")
 
 	// Add checklist
-	description.WriteString("## Review Checklist\n\n")
-	description.WriteString("- [ ] Code follows project conventions and style guidelines\n")
-	description.WriteString("- [ ] All tests pass\n")
-	description.WriteString("- [ ] No breaking changes introduced\n")
-	description.WriteString("- [ ] Documentation updated if needed\n")
-	description.WriteString("- [ ] Security considerations addressed\n")
-	description.WriteString("- [ ] Performance impact assessed\n\n")
+	writeLine("pr.review_checklist")
+	description.WriteString("\n")
+	for _, key := range []string{"pr.checklist_conventions", "pr.checklist_tests", "pr.checklist_breaking", "pr.checklist_docs", "pr.checklist_security", "pr.checklist_performance"} {
+		writeLine(key)
+	}
+	description.WriteString("\n")
 
 	// Add metadata
 	description.WriteString("---\n")
-	description.WriteString("*This pull request was automatically generated by Auto-Devs AI system*\n")
+	writeLine("pr.footer")
 
 	// Sanitize the description before returning
 	return prc.SanitizeForGitHub(description.String()), nil
 }
 
+// taskLocale is the locale of the texts generated for task, its project's
+// or the default when the project is not loaded
+func taskLocale(task entity.Task) i18n.Locale {
+	if task.Project == nil {
+		return i18n.DefaultLocale
+	}
+	return i18n.Parse(task.Project.Locale)
+}
+
+// maxVerificationOutputInPR is how much of a failing verification run's
+// output is quoted
+const maxVerificationOutputInPR = 3000
+
+// writeVerificationRun adds a results section for a verification step,
+// quoting the end of the output when the step failed and giving the reason
+// when it was skipped
+func writeVerificationRun(description *strings.Builder, locale i18n.Locale, run entity.VerificationRun) {
+	stepName := i18n.DisplayName(locale, "verification.step."+string(run.Step), run.Step.GetDisplayName())
+	description.WriteString(i18n.T(locale, "pr.step_results", stepName) + "\n\n")
+	if run.FixesCommitted {
+		description.WriteString(i18n.T(locale, "pr.fixes_committed") + "\n\n")
+	}
+	if run.Status == entity.VerificationRunStatusSkipped {
+		description.WriteString(i18n.T(locale, "pr.step_skipped"))
+		if reason := strings.TrimSpace(strings.TrimPrefix(run.Output, "[auto-devs]")); reason != "" {
+			description.WriteString(": " + reason)
+		}
+		description.WriteString("\n\n")
+		return
+	}
+	duration := run.GetDuration().Round(time.Second)
+	if run.Passed {
+		description.WriteString(i18n.T(locale, "pr.step_passed", run.Command, duration) + "\n\n")
+		return
+	}
+
+	description.WriteString(i18n.T(locale, "pr.step_failed", run.Command, run.ExitCode, duration) + "\n\n")
+	output := run.Output
+	if len(output) > maxVerificationOutputInPR {
+		output = "..." + output[len(output)-maxVerificationOutputInPR:]
+	}
+	description.WriteString("<details><summary>" + i18n.T(locale, "pr.output") + "</summary>\n\n```\n")
+	description.WriteString(strings.TrimSpace(output))
+	description.WriteString("\n```\n\n</details>\n\n")
+}
+
+// maxSecurityFindingsInPR is how many security findings are listed in the
+// description; all of them are kept on the execution
+const maxSecurityFindingsInPR = 20
+
+// writeSecurityFindings lists the security scanner's findings in the changed
+// files under its results
+func writeSecurityFindings(description *strings.Builder, locale i18n.Locale, findings []entity.SecurityFinding) {
+	if len(findings) == 0 {
+		return
+	}
+
+	description.WriteString(i18n.T(locale, "pr.security_findings", len(findings)) + "\n\n")
+	for i, finding := range findings {
+		if i == maxSecurityFindingsInPR {
+			description.WriteString(i18n.T(locale, "pr.more", len(findings)-maxSecurityFindingsInPR) + "\n")
+			break
+		}
+		description.WriteString(fmt.Sprintf("- **%s** `%s:%d` %s: %s\n", finding.Severity, finding.FilePath, finding.Line, finding.RuleID, finding.Message))
+	}
+	description.WriteString("\n")
+}
+
+// writeCoverage adds the coverage section, with the delta against the base
+// branch when it was measured
+func writeCoverage(description *strings.Builder, locale i18n.Locale, execution *entity.Execution) {
+	description.WriteString(i18n.T(locale, "pr.coverage") + "\n\n")
+	delta := execution.GetCoverageDelta()
+	if delta == nil {
+		description.WriteString(i18n.T(locale, "pr.coverage_unmeasured", *execution.Coverage) + "\n\n")
+		return
+	}
+
+	icon := "➖"
+	switch {
+	case *delta >= 0.05:
+		icon = "📈"
+	case *delta <= -0.05:
+		icon = "📉"
+	}
+	description.WriteString(i18n.T(locale, "pr.coverage_delta", icon, *execution.Coverage, *delta, *execution.BaseCoverage) + "\n\n")
+}
+
+// maxReviewCommentsInPR is how many AI review findings are listed in the
+// description; all of them are kept on the execution
+const maxReviewCommentsInPR = 20
+
+// writeReviewSummary adds the AI review section, most severe findings first
+func writeReviewSummary(description *strings.Builder, locale i18n.Locale, comments []entity.ReviewComment) {
+	description.WriteString(i18n.T(locale, "pr.ai_review") + "\n\n")
+	if len(comments) == 0 {
+		description.WriteString(i18n.T(locale, "pr.ai_review_clean") + "\n\n")
+		return
+	}
+
+	counts := map[entity.ReviewSeverity]int{}
+	for _, comment := range comments {
+		counts[comment.Severity]++
+	}
+	description.WriteString(i18n.T(locale, "pr.ai_review_findings",
+		len(comments), counts[entity.ReviewSeverityError], counts[entity.ReviewSeverityWarning], counts[entity.ReviewSeverityInfo]) + "\n\n")
+
+	sorted := make([]entity.ReviewComment, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return reviewSeverityRank[sorted[i].Severity] < reviewSeverityRank[sorted[j].Severity]
+	})
+	for i, comment := range sorted {
+		if i == maxReviewCommentsInPR {
+			description.WriteString(i18n.T(locale, "pr.more", len(sorted)-maxReviewCommentsInPR) + "\n")
+			break
+		}
+		description.WriteString(fmt.Sprintf("- **%s**", comment.Severity))
+		if location := reviewCommentLocation(comment); location != "" {
+			description.WriteString(fmt.Sprintf(" `%s`", location))
+		}
+		description.WriteString(fmt.Sprintf(": %s\n", comment.Body))
+	}
+	description.WriteString("\n")
+}
+
+var reviewSeverityRank = map[entity.ReviewSeverity]int{
+	entity.ReviewSeverityError:   0,
+	entity.ReviewSeverityWarning: 1,
+	entity.ReviewSeverityInfo:    2,
+}
+
+func reviewCommentLocation(comment entity.ReviewComment) string {
+	if comment.FilePath == "" || comment.Line == nil {
+		return comment.FilePath
+	}
+	return fmt.Sprintf("%s:%d", comment.FilePath, *comment.Line)
+}
+
+// PostReviewComments adds the AI review findings to the pull request as a
+// review. Findings on a line become inline comments; if GitHub rejects those,
+// typically because a line is outside the diff, every finding goes in the
+// review body instead.
+func (prc *PRCreator) PostReviewComments(ctx context.Context, task entity.Task, pr *entity.PullRequest, comments []entity.ReviewComment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+	repository := prc.getRepositoryFromTask(task)
+	if repository == "" {
+		return fmt.Errorf("unable to determine repository from task")
+	}
+
+	ctx = WithProjectID(ctx, task.ProjectID)
+	var inline []InlineReviewComment
+	var general []entity.ReviewComment
+	for _, comment := range comments {
+		if comment.FilePath != "" && comment.Line != nil {
+			inline = append(inline, InlineReviewComment{
+				Path: comment.FilePath,
+				Line: *comment.Line,
+				Body: fmt.Sprintf("**%s**: %s", comment.Severity, comment.Body),
+			})
+			continue
+		}
+		general = append(general, comment)
+	}
+
+	err := prc.githubService.CreateReview(ctx, repository, pr.GitHubPRNumber, reviewBody(general), inline)
+	if err == nil || len(inline) == 0 {
+		return err
+	}
+	log.Printf("Inline review comments rejected, posting them in the review body: %v", err)
+	return prc.githubService.CreateReview(ctx, repository, pr.GitHubPRNumber, reviewBody(comments), nil)
+}
+
+// qualityGateMarker identifies the quality gate summary comment in the pull
+// request's conversation
+const qualityGateMarker = "<!-- auto-devs:quality-gate -->"
+
+// PostQualityGateComment sums up the execution's verification results in a
+// comment on the pull request. The first push creates the comment and
+// records its ID on pr; later pushes edit it in place.
+func (prc *PRCreator) PostQualityGateComment(ctx context.Context, task entity.Task, pr *entity.PullRequest, execution entity.Execution) error {
+	if pr == nil {
+		return fmt.Errorf("pull request cannot be nil")
+	}
+	repository := pr.Repository
+	if repository == "" {
+		repository = prc.getRepositoryFromTask(task)
+	}
+	if repository == "" {
+		return fmt.Errorf("unable to determine repository from task")
+	}
+
+	ctx = WithProjectID(ctx, task.ProjectID)
+	body := prc.SanitizeForGitHub(GenerateQualityGateSummary(taskLocale(task), execution))
+	if pr.QualityGateCommentID != nil {
+		if err := prc.githubService.UpdateIssueComment(ctx, repository, pr.GitHubPRNumber, *pr.QualityGateCommentID, body); err != nil {
+			return fmt.Errorf("failed to update quality gate comment: %w", err)
+		}
+		return nil
+	}
+
+	commentID, err := prc.githubService.CreateIssueComment(ctx, repository, pr.GitHubPRNumber, body)
+	if err != nil {
+		return fmt.Errorf("failed to create quality gate comment: %w", err)
+	}
+	pr.QualityGateCommentID = &commentID
+	return nil
+}
+
+// GenerateQualityGateSummary aggregates the verification steps, security
+// findings, coverage delta and AI review of an execution into one report in
+// locale
+func GenerateQualityGateSummary(locale i18n.Locale, execution entity.Execution) string {
+	var summary strings.Builder
+	summary.WriteString(qualityGateMarker + "\n")
+	summary.WriteString(i18n.T(locale, "pr.quality_gate") + "\n\n")
+
+	var failed []string
+	for _, run := range execution.VerificationRuns {
+		if run.Status != entity.VerificationRunStatusSkipped && !run.Passed {
+			failed = append(failed, i18n.DisplayName(locale, "verification.step."+string(run.Step), run.Step.GetDisplayName()))
+		}
+	}
+	switch {
+	case len(execution.VerificationRuns) == 0:
+		summary.WriteString(i18n.T(locale, "pr.quality_gate_none") + "\n\n")
+	case len(failed) == 0:
+		summary.WriteString(i18n.T(locale, "pr.quality_gate_passed") + "\n\n")
+	default:
+		summary.WriteString(i18n.T(locale, "pr.quality_gate_failed", strings.Join(failed, ", ")) + "\n\n")
+	}
+	summary.WriteString(i18n.T(locale, "pr.quality_gate_execution", execution.ID.String(), time.Now().UTC().Format(time.RFC3339)) + "\n\n")
+	if execution.FixAttempts > 0 {
+		summary.WriteString(i18n.T(locale, "pr.quality_gate_fix_attempts", execution.FixAttempts) + "\n\n")
+	}
+
+	for _, run := range execution.VerificationRuns {
+		if run.Step == entity.VerificationStepReview {
+			continue
+		}
+		writeVerificationRun(&summary, locale, run)
+		if run.Step == entity.VerificationStepSecurityScan {
+			writeSecurityFindings(&summary, locale, execution.SecurityFindings)
+		}
+	}
+	if execution.Coverage != nil {
+		writeCoverage(&summary, locale, &execution)
+	}
+	if execution.ReviewComments != nil {
+		writeReviewSummary(&summary, locale, execution.ReviewComments)
+	}
+
+	summary.WriteString("---\n")
+	summary.WriteString(i18n.T(locale, "pr.quality_gate_footer") + "\n")
+	return summary.String()
+}
+
+// reviewBody lists the findings that are not posted inline
+func reviewBody(comments []entity.ReviewComment) string {
+	var body strings.Builder
+	body.WriteString("🤖 **Auto-Devs AI review**\n")
+	for _, comment := range comments {
+		body.WriteString(fmt.Sprintf("\n- **%s**", comment.Severity))
+		if location := reviewCommentLocation(comment); location != "" {
+			body.WriteString(fmt.Sprintf(" `%s`", location))
+		}
+		body.WriteString(fmt.Sprintf(": %s", comment.Body))
+	}
+	return body.String()
+}
+
+// GetPullRequestDiff returns the changes of the task's pull request as a
+// unified diff
+func (prc *PRCreator) GetPullRequestDiff(ctx context.Context, task entity.Task, pr *entity.PullRequest) (string, error) {
+	if pr == nil {
+		return "", fmt.Errorf("pull request cannot be nil")
+	}
+	repository := pr.Repository
+	if repository == "" {
+		repository = prc.getRepositoryFromTask(task)
+	}
+	if repository == "" {
+		return "", fmt.Errorf("unable to determine repository from task")
+	}
+
+	diff, err := prc.githubService.GetPullRequestDiff(WithProjectID(ctx, task.ProjectID), repository, pr.GitHubPRNumber)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pull request diff: %w", err)
+	}
+	return diff, nil
+}
+
 // AddTaskLinks creates bidirectional links between the PR and the task
 func (prc *PRCreator) AddTaskLinks(ctx context.Context, pr *entity.PullRequest, task entity.Task) error {
 	if pr == nil {
@@ -198,7 +536,7 @@ func (prc *PRCreator) AddTaskLinks(ctx context.Context, pr *entity.PullRequest,
 			"body": updatedBody,
 		}
 
-		err := prc.githubService.UpdatePullRequest(ctx, pr.Repository, pr.GitHubPRNumber, updates)
+		err := prc.githubService.UpdatePullRequest(WithProjectID(ctx, task.ProjectID), pr.Repository, pr.GitHubPRNumber, updates)
 		if err != nil {
 			return fmt.Errorf("failed to update PR with task link: %w", err)
 		}
@@ -243,41 +581,13 @@ func (prc *PRCreator) determineTypePrefix(task entity.Task) string {
 	return "[feat]"
 }
 
-// getRepositoryFromTask extracts the repository information from a task
-// Expected format: "https://github.com/owner/repo" -> "owner/repo"
+// getRepositoryFromTask returns the path of the task's project repository,
+// owner/repo on GitHub and the namespace path on GitLab
 func (prc *PRCreator) getRepositoryFromTask(task entity.Task) string {
-	if task.Project.RepositoryURL == "" {
+	if task.Project == nil || task.Project.RepositoryURL == "" {
 		return ""
 	}
-
-	// Parse GitHub URL to extract owner/repo format
-	repoURL := task.Project.RepositoryURL
-	log.Println("repoURL", repoURL)
-
-	// Remove common prefixes
-	prefixes := []string{
-		"https://github.com/",
-		"http://github.com/",
-		"git@github.com:",
-	}
-
-	for _, prefix := range prefixes {
-		if strings.HasPrefix(repoURL, prefix) {
-			repoURL = strings.TrimPrefix(repoURL, prefix)
-			break
-		}
-	}
-
-	// Remove .git suffix if present
-	repoURL = strings.TrimSuffix(repoURL, ".git")
-
-	// Validate format (should be owner/repo)
-	parts := strings.Split(repoURL, "/")
-	if len(parts) >= 2 && parts[0] != "" && parts[1] != "" {
-		return fmt.Sprintf("%s/%s", parts[0], parts[1])
-	}
-
-	return ""
+	return vcs.RepositoryFor(task.Project)
 }
 
 // PRCreationError represents errors that occur during PR creation
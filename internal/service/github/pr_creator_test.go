@@ -2,10 +2,12 @@ package github
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +39,41 @@ func (m *MockGitHubService) GetPullRequest(ctx context.Context, repo string, prN
 	return args.Get(0).(*entity.PullRequest), args.Error(1)
 }
 
+func (m *MockGitHubService) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error {
+	args := m.Called(ctx, repo, prNumber, body, comments)
+	return args.Error(0)
+}
+
+func (m *MockGitHubService) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	args := m.Called(ctx, repo, prNumber, body)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockGitHubService) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	args := m.Called(ctx, repo, prNumber, commentID, body)
+	return args.Error(0)
+}
+
+func (m *MockGitHubService) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	args := m.Called(ctx, repo)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockGitHubService) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	args := m.Called(ctx, repo, branch)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGitHubService) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	args := m.Called(ctx, repo, branch)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGitHubService) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	args := m.Called(ctx, repo, prNumber)
+	return args.String(0), args.Error(1)
+}
+
 func TestNewPRCreator(t *testing.T) {
 	mockGitHub := &MockGitHubService{}
 	baseURL := "https://auto-devs.example.com"
@@ -169,6 +206,225 @@ func TestPRCreator_GeneratePRDescription(t *testing.T) {
 	assert.Contains(t, description, taskID.String())
 	assert.Contains(t, description, planID.String())
 	assert.Contains(t, description, executionID.String())
+	assert.NotContains(t, description, "## Test Results")
+}
+
+func TestPRCreator_GeneratePRDescriptionInProjectLocale(t *testing.T) {
+	creator := NewPRCreator(nil, "")
+	task := entity.Task{
+		ID:       uuid.New(),
+		Title:    "Test task",
+		Priority: entity.TaskPriorityHigh,
+		Status:   entity.TaskStatusIMPLEMENTING,
+		Project:  &entity.Project{Locale: "vi"},
+	}
+
+	description, err := creator.GeneratePRDescription(task, nil, entity.Execution{ID: uuid.New(), StartedAt: time.Now()})
+
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## Thông tin task")
+	assert.Contains(t, description, "**Độ ưu tiên:** Cao")
+	assert.Contains(t, description, "**Trạng thái:** Đang triển khai")
+	assert.Contains(t, description, "## Hướng dẫn kiểm thử")
+	assert.NotContains(t, description, "## Task Information")
+}
+
+func TestPRCreator_GeneratePRDescriptionWithVerificationRuns(t *testing.T) {
+	creator := NewPRCreator(nil, "")
+	task := entity.Task{ID: uuid.New(), Title: "Test task", Priority: entity.TaskPriorityHigh, Status: entity.TaskStatusIMPLEMENTING}
+	started := time.Now().Add(-time.Minute)
+	execution := entity.Execution{
+		ID:        uuid.New(),
+		Status:    entity.ExecutionStatusCompleted,
+		StartedAt: started,
+		VerificationRuns: []entity.VerificationRun{
+			{
+				Step:           entity.VerificationStepLint,
+				Command:        "make fmt",
+				Passed:         true,
+				FixesCommitted: true,
+				StartedAt:      started,
+				CompletedAt:    started.Add(3 * time.Second),
+			},
+			{
+				Step:        entity.VerificationStepTest,
+				Command:     "make test",
+				ExitCode:    2,
+				Output:      "--- FAIL: TestLogin\nFAIL\n",
+				StartedAt:   started,
+				CompletedAt: started.Add(12 * time.Second),
+			},
+		},
+	}
+
+	description, err := creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## Lint Results\n\nFixes made by the command were committed separately.\n\n✅ `make fmt` passed in 3s")
+	assert.Contains(t, description, "## Test Results")
+	assert.Contains(t, description, "**`make test` failed** (exit code 2) after 12s")
+	assert.Contains(t, description, "--- FAIL: TestLogin\nFAIL\n```")
+
+	execution.VerificationRuns[1].Passed = true
+	execution.VerificationRuns[1].ExitCode = 0
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "✅ `make test` passed in 12s")
+	assert.NotContains(t, description, "<summary>Output</summary>")
+
+	execution.VerificationRuns = append(execution.VerificationRuns,
+		entity.VerificationRun{Step: entity.VerificationStepSecurityScan, Status: entity.VerificationRunStatusSkipped, Output: "[auto-devs] no changed files for gosec to scan"},
+		entity.VerificationRun{Step: entity.VerificationStepReview, Status: entity.VerificationRunStatusSkipped})
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## Security Scan Results\n\n⏭️ Skipped: no changed files for gosec to scan\n\n")
+	assert.NotContains(t, description, "## Review Results", "the AI review has its own section")
+}
+
+func TestPRCreator_GeneratePRDescriptionWithSecurityFindings(t *testing.T) {
+	creator := NewPRCreator(nil, "")
+	task := entity.Task{ID: uuid.New(), Title: "Test task", Priority: entity.TaskPriorityHigh, Status: entity.TaskStatusIMPLEMENTING}
+	started := time.Now()
+	execution := entity.Execution{
+		ID:        uuid.New(),
+		Status:    entity.ExecutionStatusCompleted,
+		StartedAt: started,
+		VerificationRuns: []entity.VerificationRun{
+			{Step: entity.VerificationStepSecurityScan, Command: "gosec ./auth", Passed: true, StartedAt: started, CompletedAt: started.Add(2 * time.Second)},
+		},
+		SecurityFindings: []entity.SecurityFinding{
+			{RuleID: "G304", FilePath: "auth/token.go", Line: 30, Severity: entity.SecuritySeverityMedium, Message: "Potential file inclusion via variable"},
+		},
+	}
+
+	description, err := creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## Security Scan Results\n\n✅ `gosec ./auth` passed in 2s\n\n"+
+		"1 finding(s) in the changed files:\n\n"+
+		"- **medium** `auth/token.go:30` G304: Potential file inclusion via variable\n")
+}
+
+func TestPRCreator_GeneratePRDescriptionWithReviewComments(t *testing.T) {
+	creator := NewPRCreator(nil, "")
+	task := entity.Task{ID: uuid.New(), Title: "Test task", Priority: entity.TaskPriorityHigh, Status: entity.TaskStatusIMPLEMENTING}
+	line := 12
+	execution := entity.Execution{ID: uuid.New(), Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+
+	description, err := creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.NotContains(t, description, "## AI Review", "no section when the review did not run")
+
+	execution.ReviewComments = []entity.ReviewComment{}
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## AI Review\n\n✅ The AI review found no issues.")
+
+	execution.ReviewComments = []entity.ReviewComment{
+		{Severity: entity.ReviewSeverityInfo, Body: "Consider a follow-up"},
+		{FilePath: "auth/login.go", Line: &line, Severity: entity.ReviewSeverityError, Body: "Password is logged"},
+	}
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "2 finding(s): 1 error, 0 warning, 1 info.\n\n"+
+		"- **error** `auth/login.go:12`: Password is logged\n"+
+		"- **info**: Consider a follow-up\n")
+}
+
+func TestPRCreator_GeneratePRDescriptionWithCoverage(t *testing.T) {
+	creator := NewPRCreator(nil, "")
+	task := entity.Task{ID: uuid.New(), Title: "Test task", Priority: entity.TaskPriorityHigh, Status: entity.TaskStatusIMPLEMENTING}
+	execution := entity.Execution{ID: uuid.New(), Status: entity.ExecutionStatusCompleted, StartedAt: time.Now()}
+
+	description, err := creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.NotContains(t, description, "## Coverage")
+
+	coverage, baseCoverage := 71.25, 73.5
+	execution.Coverage = &coverage
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "## Coverage\n\nTotal coverage: **71.2%** (base branch not measured)")
+
+	execution.BaseCoverage = &baseCoverage
+	description, err = creator.GeneratePRDescription(task, nil, execution)
+	assert.NoError(t, err)
+	assert.Contains(t, description, "📉 Total coverage: **71.2%** (-2.2 points, base branch 73.5%)")
+}
+
+func TestPRCreator_PostReviewComments(t *testing.T) {
+	mockGitHub := &MockGitHubService{}
+	creator := NewPRCreator(mockGitHub, "")
+	task := entity.Task{Project: &entity.Project{RepositoryURL: "https://github.com/owner/repo"}}
+	pr := &entity.PullRequest{GitHubPRNumber: 7}
+	line := 12
+	comments := []entity.ReviewComment{
+		{FilePath: "auth/login.go", Line: &line, Severity: entity.ReviewSeverityError, Body: "Password is logged"},
+		{Severity: entity.ReviewSeverityInfo, Body: "Consider a follow-up"},
+	}
+
+	inline := []InlineReviewComment{{Path: "auth/login.go", Line: 12, Body: "**error**: Password is logged"}}
+	mockGitHub.On("CreateReview", mock.Anything, "owner/repo", 7, "🤖 **Auto-Devs AI review**\n\n- **info**: Consider a follow-up", inline).
+		Return(assert.AnError).Once()
+	mockGitHub.On("CreateReview", mock.Anything, "owner/repo", 7,
+		"🤖 **Auto-Devs AI review**\n\n- **error** `auth/login.go:12`: Password is logged\n- **info**: Consider a follow-up",
+		[]InlineReviewComment(nil)).Return(nil).Once()
+
+	err := creator.PostReviewComments(context.Background(), task, pr, comments)
+	assert.NoError(t, err, "rejected inline comments fall back to the review body")
+	mockGitHub.AssertExpectations(t)
+}
+
+func TestGenerateQualityGateSummary(t *testing.T) {
+	started := time.Now().Add(-time.Minute)
+	coverage, baseCoverage := 81.5, 80.0
+	line := 4
+	execution := entity.Execution{
+		ID: uuid.New(),
+		VerificationRuns: []entity.VerificationRun{
+			{Step: entity.VerificationStepLint, Command: "make lint", Passed: true, StartedAt: started, CompletedAt: started.Add(2 * time.Second)},
+			{Step: entity.VerificationStepTest, Command: "make test", ExitCode: 1, Output: "--- FAIL: TestLogin", StartedAt: started, CompletedAt: started.Add(5 * time.Second)},
+			{Step: entity.VerificationStepSecurityScan, Command: "gosec", Passed: true, StartedAt: started, CompletedAt: started.Add(time.Second)},
+		},
+		SecurityFindings: []entity.SecurityFinding{{Severity: "MEDIUM", FilePath: "db.go", Line: 9, RuleID: "G202", Message: "SQL string concatenation"}},
+		Coverage:         &coverage,
+		BaseCoverage:     &baseCoverage,
+		ReviewComments:   []entity.ReviewComment{{FilePath: "db.go", Line: &line, Severity: entity.ReviewSeverityWarning, Body: "Close the rows"}},
+	}
+
+	summary := GenerateQualityGateSummary(i18n.LocaleEnglish, execution)
+	assert.True(t, strings.HasPrefix(summary, qualityGateMarker))
+	assert.Contains(t, summary, "❌ **Failed**: Test.")
+	assert.Contains(t, summary, "✅ `make lint` passed in 2s")
+	assert.Contains(t, summary, "**`make test` failed** (exit code 1)")
+	assert.Contains(t, summary, "- **MEDIUM** `db.go:9` G202: SQL string concatenation")
+	assert.Contains(t, summary, "📈 Total coverage: **81.5%** (+1.5 points, base branch 80.0%)")
+	assert.Contains(t, summary, "- **warning** `db.go:4`: Close the rows")
+
+	execution.VerificationRuns[1].Passed = true
+	assert.Contains(t, GenerateQualityGateSummary(i18n.LocaleEnglish, execution), "✅ **Passed**: every verification step succeeded.")
+	assert.Contains(t, GenerateQualityGateSummary(i18n.LocaleEnglish, entity.Execution{}), "No verification steps ran for this push.")
+}
+
+func TestPRCreator_PostQualityGateComment(t *testing.T) {
+	mockGitHub := &MockGitHubService{}
+	creator := NewPRCreator(mockGitHub, "")
+	task := entity.Task{Project: &entity.Project{RepositoryURL: "https://github.com/owner/repo"}}
+	pr := &entity.PullRequest{GitHubPRNumber: 7}
+	execution := entity.Execution{ID: uuid.New()}
+
+	// The first push creates the comment and records its ID
+	mockGitHub.On("CreateIssueComment", mock.Anything, "owner/repo", 7, mock.AnythingOfType("string")).Return(int64(42), nil).Once()
+	assert.NoError(t, creator.PostQualityGateComment(context.Background(), task, pr, execution))
+	if assert.NotNil(t, pr.QualityGateCommentID) {
+		assert.Equal(t, int64(42), *pr.QualityGateCommentID)
+	}
+
+	// Later pushes edit it in place
+	mockGitHub.On("UpdateIssueComment", mock.Anything, "owner/repo", 7, int64(42), mock.AnythingOfType("string")).Return(nil).Once()
+	assert.NoError(t, creator.PostQualityGateComment(context.Background(), task, pr, execution))
+
+	mockGitHub.On("UpdateIssueComment", mock.Anything, "owner/repo", 7, int64(42), mock.AnythingOfType("string")).Return(assert.AnError).Once()
+	assert.ErrorIs(t, creator.PostQualityGateComment(context.Background(), task, pr, execution), assert.AnError)
+	mockGitHub.AssertExpectations(t)
 }
 
 func TestPRCreator_ValidateTaskForPRCreation(t *testing.T) {
@@ -288,6 +544,11 @@ func TestPRCreator_getRepositoryFromTask(t *testing.T) {
 			repoURL:  "http://github.com/owner/repo",
 			expected: "owner/repo",
 		},
+		{
+			name:     "GitLab URL with subgroup",
+			repoURL:  "https://gitlab.com/group/subgroup/repo.git",
+			expected: "group/subgroup/repo",
+		},
 		{
 			name:     "Invalid URL",
 			repoURL:  "invalid-url",
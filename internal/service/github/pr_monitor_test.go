@@ -114,6 +114,41 @@ func (m *MockGitHubServiceForPR) GetPullRequest(ctx context.Context, repo string
 	return args.Get(0).(*entity.PullRequest), args.Error(1)
 }
 
+func (m *MockGitHubServiceForPR) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error {
+	args := m.Called(ctx, repo, prNumber, body, comments)
+	return args.Error(0)
+}
+
+func (m *MockGitHubServiceForPR) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	args := m.Called(ctx, repo, prNumber, body)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockGitHubServiceForPR) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	args := m.Called(ctx, repo, prNumber, commentID, body)
+	return args.Error(0)
+}
+
+func (m *MockGitHubServiceForPR) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	args := m.Called(ctx, repo)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockGitHubServiceForPR) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	args := m.Called(ctx, repo, branch)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGitHubServiceForPR) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	args := m.Called(ctx, repo, branch)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGitHubServiceForPR) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	args := m.Called(ctx, repo, prNumber)
+	return args.String(0), args.Error(1)
+}
+
 type MockWebSocketService struct {
 	mock.Mock
 }
@@ -0,0 +1,173 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+	"github.com/google/uuid"
+)
+
+// WithProjectID scopes ctx to a project, so GitHub calls made with it use
+// the project's token
+func WithProjectID(ctx context.Context, projectID uuid.UUID) context.Context {
+	return vcs.WithProjectID(ctx, projectID)
+}
+
+// ProjectIDFromContext returns the project ctx is scoped to, if any
+func ProjectIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	return vcs.ProjectIDFromContext(ctx)
+}
+
+// projectClient is the client built for a project's token
+type projectClient struct {
+	token   string
+	service *GitHubServiceV2
+}
+
+// ProjectGitHubService makes each call with the GitHub token of the project
+// its context is scoped to, read from the project's secrets. Calls outside a
+// project, or for a project without a token, use the global token.
+type ProjectGitHubService struct {
+	config      GitHubConfig
+	secretStore secrets.Store
+	global      *GitHubServiceV2
+
+	mu      sync.Mutex
+	clients map[uuid.UUID]projectClient
+}
+
+// NewProjectGitHubService creates a GitHub service resolving tokens per
+// project, with config's token as the global one
+func NewProjectGitHubService(config *GitHubConfig, secretStore secrets.Store) *ProjectGitHubService {
+	global := NewGitHubServiceV2(config)
+	return &ProjectGitHubService{
+		config:      *global.config,
+		secretStore: secretStore,
+		global:      global,
+		clients:     make(map[uuid.UUID]projectClient),
+	}
+}
+
+// serviceFor returns the client for the project ctx is scoped to. A secret
+// that cannot be read is an error rather than a reason to fall back to the
+// global token.
+func (s *ProjectGitHubService) serviceFor(ctx context.Context) (*GitHubServiceV2, error) {
+	projectID, ok := ProjectIDFromContext(ctx)
+	if !ok || s.secretStore == nil {
+		return s.global, nil
+	}
+
+	token, err := s.secretStore.Get(ctx, projectID, entity.GitHubTokenSecret)
+	if errors.Is(err, secrets.ErrNotFound) || errors.Is(err, secrets.ErrNoEncryptionKey) {
+		return s.global, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the project's GitHub token: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if client, ok := s.clients[projectID]; ok && client.token == token {
+		return client.service, nil
+	}
+	config := s.config
+	config.Token = token
+	service := NewGitHubServiceV2(&config)
+	s.clients[projectID] = projectClient{token: token, service: service}
+	return service, nil
+}
+
+// CreatePullRequest creates a new pull request on GitHub
+func (s *ProjectGitHubService) CreatePullRequest(ctx context.Context, repo, base, head, title, body string) (*entity.PullRequest, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.CreatePullRequest(ctx, repo, base, head, title, body)
+}
+
+// UpdatePullRequest updates an existing pull request
+func (s *ProjectGitHubService) UpdatePullRequest(ctx context.Context, repo string, prNumber int, updates map[string]interface{}) error {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.UpdatePullRequest(ctx, repo, prNumber, updates)
+}
+
+// GetPullRequest retrieves a pull request by number
+func (s *ProjectGitHubService) GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetPullRequest(ctx, repo, prNumber)
+}
+
+// CreateReview adds a review that only comments on the pull request
+func (s *ProjectGitHubService) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.CreateReview(ctx, repo, prNumber, body, comments)
+}
+
+// CreateIssueComment adds a comment to the pull request's conversation
+func (s *ProjectGitHubService) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return service.CreateIssueComment(ctx, repo, prNumber, body)
+}
+
+// UpdateIssueComment replaces the body of a comment
+func (s *ProjectGitHubService) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.UpdateIssueComment(ctx, repo, prNumber, commentID, body)
+}
+
+// GetDefaultBranch returns the repository's default branch
+func (s *ProjectGitHubService) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GetDefaultBranch(ctx, repo)
+}
+
+// IsBranchProtected reports whether the branch has protection rules
+func (s *ProjectGitHubService) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return false, err
+	}
+	return service.IsBranchProtected(ctx, repo, branch)
+}
+
+// HasOpenPullRequest reports whether a pull request from the branch is open
+func (s *ProjectGitHubService) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return false, err
+	}
+	return service.HasOpenPullRequest(ctx, repo, branch)
+}
+
+// GetPullRequestDiff returns the pull request's changes as a unified diff
+func (s *ProjectGitHubService) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	service, err := s.serviceFor(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GetPullRequestDiff(ctx, repo, prNumber)
+}
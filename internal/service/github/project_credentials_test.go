@@ -0,0 +1,68 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenStore map[uuid.UUID]string
+
+func (s tokenStore) Put(ctx context.Context, projectID uuid.UUID, name, value string) error {
+	s[projectID] = value
+	return nil
+}
+
+func (s tokenStore) Get(ctx context.Context, projectID uuid.UUID, name string) (string, error) {
+	token, ok := s[projectID]
+	if !ok || name != entity.GitHubTokenSecret {
+		return "", secrets.ErrNotFound
+	}
+	return token, nil
+}
+
+func (s tokenStore) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	delete(s, projectID)
+	return nil
+}
+
+func TestProjectGitHubService_ResolvesTokenPerProject(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		_, _ = w.Write([]byte(`{"number": 7, "state": "open", "user": {"login": "octocat"}}`))
+	}))
+	defer server.Close()
+
+	store := tokenStore{}
+	service := NewProjectGitHubService(&GitHubConfig{Token: "global-token", BaseURL: server.URL}, store)
+	withToken, withoutToken := uuid.New(), uuid.New()
+	store[withToken] = "project-token"
+
+	getPR := func(ctx context.Context) string {
+		t.Helper()
+		_, err := service.GetPullRequest(ctx, "owner/repo", 7)
+		require.NoError(t, err)
+		return authorization
+	}
+
+	assert.Equal(t, "Bearer global-token", getPR(context.Background()))
+	assert.Equal(t, "Bearer global-token", getPR(WithProjectID(context.Background(), withoutToken)))
+	assert.Equal(t, "Bearer project-token", getPR(WithProjectID(context.Background(), withToken)))
+
+	store[withToken] = "rotated-token"
+	assert.Equal(t, "Bearer rotated-token", getPR(WithProjectID(context.Background(), withToken)))
+
+	delete(store, withToken)
+	assert.Equal(t, "Bearer global-token", getPR(WithProjectID(context.Background(), withToken)))
+}
@@ -0,0 +1,184 @@
+// Package githubissues is a minimal GitHub REST client that reads the issues
+// of a repository and comments on them.
+package githubissues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	issuesPageSize = 100
+)
+
+// ErrNotFound is returned when the repository or issue does not exist, or the
+// token cannot see it.
+var ErrNotFound = errors.New("github repository or issue not found")
+
+// Issue is a GitHub issue
+type Issue struct {
+	Number int
+	Title  string
+	Body   string
+	URL    string
+	// State is open or closed
+	State     string
+	Labels    []string
+	Assignees []string
+}
+
+// ListFilter selects the issues of a repository
+type ListFilter struct {
+	// State is open, closed or all; empty means open
+	State string
+	// Labels are required on every issue listed
+	Labels []string
+}
+
+// Client reads and comments on GitHub issues. Repositories are given by
+// their full name, owner/name.
+type Client interface {
+	// ListIssues returns the repository's issues matching filter. Pull
+	// requests, which GitHub lists as issues too, are left out.
+	ListIssues(ctx context.Context, repo string, filter ListFilter) ([]Issue, error)
+	// CreateComment adds a comment to the issue
+	CreateComment(ctx context.Context, repo string, number int, body string) error
+}
+
+type httpClient struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client for the GitHub REST API base URL authenticating
+// with token.
+func NewClient(apiBaseURL, token string) Client {
+	return &httpClient{
+		baseURL: strings.TrimRight(apiBaseURL, "/"),
+		token:   token,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+type apiIssue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+	State   string `json:"state"`
+	Labels  []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Assignees []struct {
+		Login string `json:"login"`
+	} `json:"assignees"`
+	// PullRequest is set on pull requests only
+	PullRequest *json.RawMessage `json:"pull_request"`
+}
+
+func (c *httpClient) ListIssues(ctx context.Context, repo string, filter ListFilter) ([]Issue, error) {
+	state := filter.State
+	if state == "" {
+		state = "open"
+	}
+	query := url.Values{
+		"state":    {state},
+		"per_page": {strconv.Itoa(issuesPageSize)},
+	}
+	if len(filter.Labels) > 0 {
+		query.Set("labels", strings.Join(filter.Labels, ","))
+	}
+
+	var issues []Issue
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		var raw []apiIssue
+		if err := c.do(ctx, http.MethodGet, "/repos/"+repo+"/issues?"+query.Encode(), nil, &raw); err != nil {
+			return nil, err
+		}
+		for _, issue := range raw {
+			if issue.PullRequest == nil {
+				issues = append(issues, toIssue(issue))
+			}
+		}
+		if len(raw) < issuesPageSize {
+			return issues, nil
+		}
+	}
+}
+
+func (c *httpClient) CreateComment(ctx context.Context, repo string, number int, body string) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/issues/%d/comments", repo, number), map[string]string{"body": body}, nil)
+}
+
+func toIssue(raw apiIssue) Issue {
+	issue := Issue{
+		Number: raw.Number,
+		Title:  raw.Title,
+		Body:   strings.TrimSpace(raw.Body),
+		URL:    raw.HTMLURL,
+		State:  raw.State,
+	}
+	for _, label := range raw.Labels {
+		issue.Labels = append(issue.Labels, label.Name)
+	}
+	for _, assignee := range raw.Assignees {
+		issue.Assignees = append(issue.Assignees, assignee.Login)
+	}
+	return issue
+}
+
+func (c *httpClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to marshal github request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create github request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("github request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotFound, path)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("github API returned %d: %s", resp.StatusCode, string(respBody))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode github response: %w", err)
+	}
+	return nil
+}
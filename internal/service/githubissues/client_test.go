@@ -0,0 +1,71 @@
+package githubissues
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIssues_PaginatesAndSkipsPullRequests(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/acme/app/issues", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "open", r.URL.Query().Get("state"))
+		assert.Equal(t, "bug,ready", r.URL.Query().Get("labels"))
+		pages = append(pages, r.URL.Query().Get("page"))
+
+		if r.URL.Query().Get("page") == "1" {
+			// A full page: the issue and 99 pull requests
+			items := []string{`{"number":1,"title":"Crash on login","body":" Stack trace ","html_url":"https://github.com/acme/app/issues/1","state":"open",
+				"labels":[{"name":"bug"},{"name":"ready"}],"assignees":[{"login":"ada"}]}`}
+			for i := 0; i < issuesPageSize-1; i++ {
+				items = append(items, fmt.Sprintf(`{"number":%d,"title":"PR","pull_request":{}}`, 100+i))
+			}
+			_, _ = w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"number":2,"title":"Slow search","state":"open"}]`))
+	}))
+	defer server.Close()
+
+	issues, err := NewClient(server.URL, "token").ListIssues(context.Background(), "acme/app", ListFilter{Labels: []string{"bug", "ready"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.Equal(t, []Issue{
+		{Number: 1, Title: "Crash on login", Body: "Stack trace", URL: "https://github.com/acme/app/issues/1", State: "open", Labels: []string{"bug", "ready"}, Assignees: []string{"ada"}},
+		{Number: 2, Title: "Slow search", State: "open"},
+	}, issues)
+}
+
+func TestListIssues_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "token").ListIssues(context.Background(), "acme/gone", ListFilter{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreateComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repos/acme/app/issues/1/comments", r.URL.Path)
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Tracked as AD-7", body["body"])
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewClient(server.URL, "token").CreateComment(context.Background(), "acme/app", 1, "Tracked as AD-7"))
+}
@@ -0,0 +1,270 @@
+// Package githubprojects is a minimal GitHub GraphQL client that reads the
+// items of a Projects (v2) board.
+package githubprojects
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	itemsPageSize  = 100
+)
+
+// ErrBoardNotFound is returned when the owner has no project with the given
+// number, or the token cannot see it.
+var ErrBoardNotFound = errors.New("github project not found")
+
+// Board is a GitHub project with its items
+type Board struct {
+	Title string
+	// Columns are the options of the status field, in board order
+	Columns []string
+	Items   []Item
+}
+
+// Item is a card on the board: an issue, pull request or draft issue
+type Item struct {
+	ID string
+	// Column is the item's status field value; empty when unset
+	Column string
+	Title  string
+	Body   string
+	// URL is empty for draft issues
+	URL string
+	// State is OPEN, CLOSED or MERGED; empty for draft issues
+	State     string
+	Assignees []string
+	Labels    []string
+}
+
+// Client reads GitHub Projects.
+type Client interface {
+	// GetBoard returns the owner's project with the given number and every
+	// non-archived item, grouped into columns by statusField.
+	GetBoard(ctx context.Context, owner string, number int, statusField string) (*Board, error)
+}
+
+type httpClient struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client for the GitHub REST API base URL (as configured
+// for the rest of the GitHub integration) authenticating with token.
+func NewClient(apiBaseURL, token string) Client {
+	return &httpClient{
+		endpoint: graphQLEndpoint(apiBaseURL),
+		token:    token,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// graphQLEndpoint derives the GraphQL endpoint from a REST base URL:
+// api.github.com/graphql on github.com, <host>/api/graphql on Enterprise
+// Server whose REST API lives under /api/v3.
+func graphQLEndpoint(apiBaseURL string) string {
+	base := strings.TrimRight(apiBaseURL, "/")
+	if strings.HasSuffix(base, "/api/v3") {
+		return strings.TrimSuffix(base, "/v3") + "/graphql"
+	}
+	return base + "/graphql"
+}
+
+const boardQuery = `query($owner: String!, $number: Int!, $statusField: String!, $pageSize: Int!, $cursor: String) {
+  repositoryOwner(login: $owner) {
+    ... on ProjectV2Owner {
+      projectV2(number: $number) {
+        title
+        field(name: $statusField) {
+          ... on ProjectV2SingleSelectField { options { name } }
+        }
+        items(first: $pageSize, after: $cursor) {
+          pageInfo { hasNextPage endCursor }
+          nodes {
+            id
+            isArchived
+            fieldValueByName(name: $statusField) {
+              ... on ProjectV2ItemFieldSingleSelectValue { name }
+            }
+            content {
+              ... on DraftIssue { title body assignees(first: 10) { nodes { login } } }
+              ... on Issue { title body url state labels(first: 20) { nodes { name } } assignees(first: 10) { nodes { login } } }
+              ... on PullRequest { title body url state labels(first: 20) { nodes { name } } assignees(first: 10) { nodes { login } } }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type nodes[T any] struct {
+	Nodes []T `json:"nodes"`
+}
+
+type apiItem struct {
+	ID               string `json:"id"`
+	IsArchived       bool   `json:"isArchived"`
+	FieldValueByName *struct {
+		Name string `json:"name"`
+	} `json:"fieldValueByName"`
+	Content *struct {
+		Title  string `json:"title"`
+		Body   string `json:"body"`
+		URL    string `json:"url"`
+		State  string `json:"state"`
+		Labels nodes[struct {
+			Name string `json:"name"`
+		}] `json:"labels"`
+		Assignees nodes[struct {
+			Login string `json:"login"`
+		}] `json:"assignees"`
+	} `json:"content"`
+}
+
+type boardResponse struct {
+	RepositoryOwner *struct {
+		ProjectV2 *struct {
+			Title string `json:"title"`
+			Field *struct {
+				Options []struct {
+					Name string `json:"name"`
+				} `json:"options"`
+			} `json:"field"`
+			Items struct {
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+				Nodes []apiItem `json:"nodes"`
+			} `json:"items"`
+		} `json:"projectV2"`
+	} `json:"repositoryOwner"`
+}
+
+func (c *httpClient) GetBoard(ctx context.Context, owner string, number int, statusField string) (*Board, error) {
+	var board *Board
+	var cursor *string
+	for {
+		variables := map[string]any{
+			"owner":       owner,
+			"number":      number,
+			"statusField": statusField,
+			"pageSize":    itemsPageSize,
+			"cursor":      cursor,
+		}
+		var data boardResponse
+		if err := c.query(ctx, boardQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.RepositoryOwner == nil || data.RepositoryOwner.ProjectV2 == nil {
+			return nil, fmt.Errorf("%w: %s #%d", ErrBoardNotFound, owner, number)
+		}
+		project := data.RepositoryOwner.ProjectV2
+
+		if board == nil {
+			board = &Board{Title: project.Title}
+			if project.Field != nil {
+				for _, option := range project.Field.Options {
+					board.Columns = append(board.Columns, option.Name)
+				}
+			}
+		}
+		for _, raw := range project.Items.Nodes {
+			if item, ok := toItem(raw); ok {
+				board.Items = append(board.Items, item)
+			}
+		}
+
+		if !project.Items.PageInfo.HasNextPage {
+			return board, nil
+		}
+		endCursor := project.Items.PageInfo.EndCursor
+		cursor = &endCursor
+	}
+}
+
+// toItem converts an API item, skipping archived items and those whose
+// content is hidden from the token (e.g. issues in private repositories)
+func toItem(raw apiItem) (Item, bool) {
+	if raw.IsArchived || raw.Content == nil || raw.Content.Title == "" {
+		return Item{}, false
+	}
+
+	item := Item{
+		ID:    raw.ID,
+		Title: raw.Content.Title,
+		Body:  strings.TrimSpace(raw.Content.Body),
+		URL:   raw.Content.URL,
+		State: raw.Content.State,
+	}
+	if raw.FieldValueByName != nil {
+		item.Column = raw.FieldValueByName.Name
+	}
+	for _, label := range raw.Content.Labels.Nodes {
+		item.Labels = append(item.Labels, label.Name)
+	}
+	for _, assignee := range raw.Content.Assignees.Nodes {
+		item.Assignees = append(item.Assignees, assignee.Login)
+	}
+	return item, true
+}
+
+func (c *httpClient) query(ctx context.Context, query string, variables map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		return fmt.Errorf("failed to marshal github query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create github request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("github request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("github GraphQL API returned %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode github response: %w", err)
+	}
+	if len(envelope.Errors) > 0 {
+		if envelope.Errors[0].Type == "NOT_FOUND" {
+			return fmt.Errorf("%w: %s", ErrBoardNotFound, envelope.Errors[0].Message)
+		}
+		return fmt.Errorf("github GraphQL error: %s", envelope.Errors[0].Message)
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("failed to decode github response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package githubprojects
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBoard_Paginates(t *testing.T) {
+	var cursors []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/graphql", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "acme", body.Variables["owner"])
+		assert.Equal(t, float64(3), body.Variables["number"])
+		assert.Equal(t, "Stage", body.Variables["statusField"])
+		cursors = append(cursors, body.Variables["cursor"])
+
+		if body.Variables["cursor"] == nil {
+			_, _ = w.Write([]byte(`{"data":{"repositoryOwner":{"projectV2":{
+				"title":"Roadmap",
+				"field":{"options":[{"name":"Backlog"},{"name":"Shipped"}]},
+				"items":{"pageInfo":{"hasNextPage":true,"endCursor":"c1"},"nodes":[
+					{"id":"I1","fieldValueByName":{"name":"Backlog"},"content":{"title":"Add login","body":" Use JWT ","url":"https://github.com/acme/app/issues/1","state":"OPEN",
+						"labels":{"nodes":[{"name":"auth"}]},"assignees":{"nodes":[{"login":"ada"}]}}},
+					{"id":"I2","isArchived":true,"content":{"title":"Old"}},
+					{"id":"I3","content":{}}
+				]}}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"repositoryOwner":{"projectV2":{"title":"Roadmap",
+			"items":{"pageInfo":{"hasNextPage":false},"nodes":[{"id":"I4","content":{"title":"Draft idea","body":""}}]}}}}}`))
+	}))
+	defer server.Close()
+
+	board, err := NewClient(server.URL, "token").GetBoard(context.Background(), "acme", 3, "Stage")
+	require.NoError(t, err)
+
+	assert.Equal(t, []any{nil, "c1"}, cursors)
+	assert.Equal(t, "Roadmap", board.Title)
+	assert.Equal(t, []string{"Backlog", "Shipped"}, board.Columns)
+	assert.Equal(t, []Item{
+		{ID: "I1", Column: "Backlog", Title: "Add login", Body: "Use JWT", URL: "https://github.com/acme/app/issues/1", State: "OPEN", Assignees: []string{"ada"}, Labels: []string{"auth"}},
+		{ID: "I4", Title: "Draft idea"},
+	}, board.Items)
+}
+
+func TestGetBoard_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"repositoryOwner":null},"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a RepositoryOwner with the login of 'nobody'."}]}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "token").GetBoard(context.Background(), "nobody", 1, "Status")
+	assert.ErrorIs(t, err, ErrBoardNotFound)
+}
+
+func TestGraphQLEndpoint(t *testing.T) {
+	assert.Equal(t, "https://api.github.com/graphql", graphQLEndpoint("https://api.github.com/"))
+	assert.Equal(t, "https://ghe.acme.com/api/graphql", graphQLEndpoint("https://ghe.acme.com/api/v3"))
+}
@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// adfNode is a node of the Atlassian Document Format that v3 of the REST API
+// uses for rich-text fields.
+type adfNode struct {
+	Type    string    `json:"type"`
+	Text    string    `json:"text"`
+	Content []adfNode `json:"content"`
+}
+
+// descriptionText flattens an ADF description into plain text, keeping
+// paragraph breaks and list bullets. Formatting marks are dropped.
+func descriptionText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	// Fields set through the v2 API can still come back as a plain string
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return strings.TrimSpace(text)
+	}
+
+	var doc adfNode
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return ""
+	}
+	var b strings.Builder
+	writeADF(&b, doc, "")
+	return strings.TrimSpace(b.String())
+}
+
+func writeADF(b *strings.Builder, node adfNode, indent string) {
+	switch node.Type {
+	case "text":
+		b.WriteString(node.Text)
+		return
+	case "hardBreak":
+		b.WriteString("\n")
+		return
+	case "listItem":
+		b.WriteString(indent + "- ")
+		for _, child := range node.Content {
+			writeADF(b, child, indent+"  ")
+		}
+		return
+	}
+
+	for _, child := range node.Content {
+		writeADF(b, child, indent)
+	}
+
+	switch node.Type {
+	case "paragraph", "heading", "codeBlock", "blockquote", "rule":
+		b.WriteString("\n")
+		if indent == "" {
+			b.WriteString("\n")
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Package jira is a minimal Jira Cloud REST client covering issue search and
+// workflow transitions.
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	searchPageSize = 100
+	dueDateLayout  = "2006-01-02"
+)
+
+// ErrNoTransition is returned when the issue's workflow has no transition
+// into the requested status from its current one.
+var ErrNoTransition = errors.New("no jira transition to the requested status")
+
+// Issue is the subset of a Jira issue that is imported into a task
+type Issue struct {
+	Key         string
+	Summary     string
+	Description string
+	Status      string
+	// StatusCategory is "new", "indeterminate" or "done"
+	StatusCategory string
+	Priority       string
+	Assignee       string
+	Labels         []string
+	DueDate        *time.Time
+}
+
+// Client talks to a Jira Cloud site.
+type Client interface {
+	// SearchIssues returns every issue matching jql.
+	SearchIssues(ctx context.Context, jql string) ([]Issue, error)
+	// TransitionIssue moves the issue into the named status. It is a no-op
+	// when the issue is already there.
+	TransitionIssue(ctx context.Context, key, status string) error
+}
+
+type httpClient struct {
+	baseURL    string
+	email      string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client authenticating with an Atlassian account email
+// and API token.
+func NewClient(baseURL, email, token string) Client {
+	return &httpClient{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		email:   email,
+		token:   token,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+type issueFields struct {
+	Summary     string          `json:"summary"`
+	Description json.RawMessage `json:"description"`
+	Status      struct {
+		Name           string `json:"name"`
+		StatusCategory struct {
+			Key string `json:"key"`
+		} `json:"statusCategory"`
+	} `json:"status"`
+	Priority *struct {
+		Name string `json:"name"`
+	} `json:"priority"`
+	Assignee *struct {
+		DisplayName  string `json:"displayName"`
+		EmailAddress string `json:"emailAddress"`
+	} `json:"assignee"`
+	Labels  []string `json:"labels"`
+	DueDate string   `json:"duedate"`
+}
+
+type apiIssue struct {
+	Key    string      `json:"key"`
+	Fields issueFields `json:"fields"`
+}
+
+var searchFields = []string{"summary", "description", "status", "priority", "assignee", "labels", "duedate"}
+
+func (c *httpClient) SearchIssues(ctx context.Context, jql string) ([]Issue, error) {
+	var issues []Issue
+	nextPageToken := ""
+	for {
+		body := map[string]any{
+			"jql":        jql,
+			"fields":     searchFields,
+			"maxResults": searchPageSize,
+		}
+		if nextPageToken != "" {
+			body["nextPageToken"] = nextPageToken
+		}
+
+		var page struct {
+			Issues        []apiIssue `json:"issues"`
+			NextPageToken string     `json:"nextPageToken"`
+			IsLast        bool       `json:"isLast"`
+		}
+		if err := c.doJSON(ctx, http.MethodPost, "/rest/api/3/search/jql", body, &page); err != nil {
+			return nil, err
+		}
+
+		for _, raw := range page.Issues {
+			issues = append(issues, toIssue(raw))
+		}
+
+		if page.IsLast || page.NextPageToken == "" {
+			return issues, nil
+		}
+		nextPageToken = page.NextPageToken
+	}
+}
+
+func (c *httpClient) TransitionIssue(ctx context.Context, key, status string) error {
+	issuePath := "/rest/api/3/issue/" + url.PathEscape(key)
+
+	var issue apiIssue
+	if err := c.doJSON(ctx, http.MethodGet, issuePath+"?fields=status", nil, &issue); err != nil {
+		return err
+	}
+	if strings.EqualFold(issue.Fields.Status.Name, status) {
+		return nil
+	}
+
+	var available struct {
+		Transitions []struct {
+			ID string `json:"id"`
+			To struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, issuePath+"/transitions", nil, &available); err != nil {
+		return err
+	}
+
+	for _, t := range available.Transitions {
+		if strings.EqualFold(t.To.Name, status) {
+			body := map[string]any{"transition": map[string]string{"id": t.ID}}
+			return c.doJSON(ctx, http.MethodPost, issuePath+"/transitions", body, nil)
+		}
+	}
+	return fmt.Errorf("%w: %s from %q to %q", ErrNoTransition, key, issue.Fields.Status.Name, status)
+}
+
+func (c *httpClient) doJSON(ctx context.Context, method, path string, payload, out any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal jira payload: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	endpoint := c.baseURL + path
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to create jira request: %w", err)
+	}
+	req.SetBasicAuth(c.email, c.token)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("jira request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("jira API %s %s returned %d: %s", method, endpoint, resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode jira response: %w", err)
+	}
+	return nil
+}
+
+func toIssue(raw apiIssue) Issue {
+	f := raw.Fields
+	issue := Issue{
+		Key:            raw.Key,
+		Summary:        f.Summary,
+		Description:    descriptionText(f.Description),
+		Status:         f.Status.Name,
+		StatusCategory: f.Status.StatusCategory.Key,
+		Labels:         f.Labels,
+	}
+	if f.Priority != nil {
+		issue.Priority = f.Priority.Name
+	}
+	if f.Assignee != nil {
+		issue.Assignee = f.Assignee.EmailAddress
+		if issue.Assignee == "" {
+			issue.Assignee = f.Assignee.DisplayName
+		}
+	}
+	if due, err := time.Parse(dueDateLayout, f.DueDate); err == nil {
+		issue.DueDate = &due
+	}
+	return issue
+}
@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchIssues_Paginates(t *testing.T) {
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "bot@acme.com", user)
+		assert.Equal(t, "token", pass)
+
+		var body struct {
+			JQL           string `json:"jql"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "project = PROJ", body.JQL)
+		tokens = append(tokens, body.NextPageToken)
+
+		if body.NextPageToken == "" {
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1","fields":{
+				"summary":"Add login",
+				"description":{"type":"doc","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"Use "},{"type":"text","text":"JWT","marks":[{"type":"strong"}]}]},
+					{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"refresh tokens"}]}]}]}
+				]},
+				"status":{"name":"To Do","statusCategory":{"key":"new"}},
+				"priority":{"name":"High"},
+				"assignee":{"displayName":"Ada","emailAddress":"ada@acme.com"},
+				"labels":["auth"],
+				"duedate":"2024-02-01"
+			}}],"nextPageToken":"page-2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-2","fields":{"summary":"Ship it","status":{"name":"Done","statusCategory":{"key":"done"}}}}],"isLast":true}`))
+	}))
+	defer server.Close()
+
+	issues, err := NewClient(server.URL+"/", "bot@acme.com", "token").SearchIssues(context.Background(), "project = PROJ")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "page-2"}, tokens)
+	require.Len(t, issues, 2)
+
+	first := issues[0]
+	assert.Equal(t, "PROJ-1", first.Key)
+	assert.Equal(t, "Use JWT\n\n- refresh tokens", first.Description)
+	assert.Equal(t, "To Do", first.Status)
+	assert.Equal(t, "High", first.Priority)
+	assert.Equal(t, "ada@acme.com", first.Assignee)
+	assert.Equal(t, []string{"auth"}, first.Labels)
+	require.NotNil(t, first.DueDate)
+	assert.Equal(t, "2024-02-01", first.DueDate.Format(dueDateLayout))
+
+	assert.Equal(t, "done", issues[1].StatusCategory)
+	assert.Empty(t, issues[1].Description)
+	assert.Nil(t, issues[1].DueDate)
+}
+
+func TestTransitionIssue(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-1":
+			_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{"status":{"name":"To Do"}}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-1/transitions":
+			_, _ = w.Write([]byte(`{"transitions":[{"id":"11","to":{"name":"In Progress"}},{"id":"31","to":{"name":"Done"}}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/PROJ-1/transitions":
+			var body struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			posted = append(posted, body.Transition.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "bot@acme.com", "token")
+
+	require.NoError(t, client.TransitionIssue(context.Background(), "PROJ-1", "in progress"))
+	assert.Equal(t, []string{"11"}, posted)
+
+	// Already there: nothing to post
+	require.NoError(t, client.TransitionIssue(context.Background(), "PROJ-1", "To Do"))
+	assert.Len(t, posted, 1)
+
+	err := client.TransitionIssue(context.Background(), "PROJ-1", "Blocked")
+	assert.ErrorIs(t, err, ErrNoTransition)
+}
@@ -0,0 +1,289 @@
+// Package preview runs a preview environment of a task worktree, such as
+// its docker compose stack, on a port of its own so reviewers can try the
+// change.
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/google/uuid"
+)
+
+const (
+	// stopTimeout is how long a preview has to exit after SIGTERM before it
+	// is killed
+	stopTimeout = 30 * time.Second
+	// maxOutputBytes is how much of a preview's output is kept to explain
+	// why it failed to start
+	maxOutputBytes = 8 * 1024
+)
+
+var (
+	// ErrNoCommand is returned when the project sets no preview command and
+	// the worktree has no compose file to fall back to
+	ErrNoCommand = errors.New("no preview command and no docker compose file in the worktree")
+	// ErrNoFreePort is returned when every port of the range is taken
+	ErrNoFreePort = errors.New("no free port for the preview")
+)
+
+// composeFiles are the file names docker compose looks for by default
+var composeFiles = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
+
+// Preview is a preview environment running for a task
+type Preview struct {
+	TaskID    uuid.UUID
+	Port      int
+	URL       string
+	Command   string
+	StartedAt time.Time
+
+	dir string
+	// composeProject is the docker compose project the preview runs as, ""
+	// for a custom command
+	composeProject string
+	cmd            *exec.Cmd
+	output         *tailBuffer
+	done           chan struct{}
+}
+
+// Manager starts and stops task previews. Previews live as long as the
+// worker process that started them.
+type Manager struct {
+	config *config.PreviewConfig
+
+	mu       sync.Mutex
+	previews map[uuid.UUID]*Preview
+}
+
+// NewManager creates a preview manager handing out ports from cfg's range
+func NewManager(cfg *config.PreviewConfig) *Manager {
+	return &Manager{
+		config:   cfg,
+		previews: make(map[uuid.UUID]*Preview),
+	}
+}
+
+// Start runs the preview of the task in dir and waits until it listens on
+// its port. The command gets the port as $PORT; when it is empty the
+// worktree's docker compose stack is brought up instead. A preview already
+// running for the task is stopped first.
+func (m *Manager) Start(ctx context.Context, taskID uuid.UUID, dir, command string) (*Preview, error) {
+	if err := m.Stop(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("failed to stop previous preview: %w", err)
+	}
+
+	preview := &Preview{
+		TaskID:  taskID,
+		Command: command,
+		dir:     dir,
+		output:  &tailBuffer{limit: maxOutputBytes},
+		done:    make(chan struct{}),
+	}
+	if command == "" {
+		if !hasComposeFile(dir) {
+			return nil, ErrNoCommand
+		}
+		preview.composeProject = "auto-devs-preview-" + taskID.String()[:8]
+		preview.Command = "docker compose -p " + preview.composeProject + " up --build"
+	}
+
+	if err := m.launch(preview); err != nil {
+		return nil, err
+	}
+
+	if err := m.waitReady(ctx, preview); err != nil {
+		_ = m.Stop(context.Background(), taskID)
+		return nil, err
+	}
+	return preview, nil
+}
+
+// launch gives the preview a port and starts its command in the background
+func (m *Manager) launch(preview *Preview) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	port, err := m.allocatePort()
+	if err != nil {
+		return err
+	}
+	preview.Port = port
+	preview.URL = fmt.Sprintf("http://%s:%d", m.config.Host, port)
+
+	cmd := exec.Command("bash", "-c", preview.Command)
+	cmd.Dir = preview.dir
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("PORT=%d", port),
+		fmt.Sprintf("WORKTREE_PATH=%s", preview.dir),
+	)
+	cmd.Stdout = preview.output
+	cmd.Stderr = preview.output
+	// The preview and everything it spawns are stopped together
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start preview: %w", err)
+	}
+	preview.cmd = cmd
+	preview.StartedAt = time.Now()
+	m.previews[preview.TaskID] = preview
+
+	go func() {
+		_ = cmd.Wait()
+		close(preview.done)
+	}()
+	return nil
+}
+
+// Get returns the preview running for the task, if any
+func (m *Manager) Get(taskID uuid.UUID) (*Preview, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	preview, ok := m.previews[taskID]
+	return preview, ok
+}
+
+// Stop tears down the preview of the task. Stopping a task without a
+// preview is not an error.
+func (m *Manager) Stop(ctx context.Context, taskID uuid.UUID) error {
+	m.mu.Lock()
+	preview, ok := m.previews[taskID]
+	delete(m.previews, taskID)
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	terminate(preview)
+
+	if preview.composeProject != "" {
+		down := exec.CommandContext(ctx, "docker", "compose", "-p", preview.composeProject, "down", "--volumes", "--remove-orphans")
+		down.Dir = preview.dir
+		if output, err := down.CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to take down compose project %s: %w: %s", preview.composeProject, err, output)
+		}
+	}
+	return nil
+}
+
+// StopAll tears down every running preview and returns the tasks they
+// belonged to
+func (m *Manager) StopAll(ctx context.Context) []uuid.UUID {
+	m.mu.Lock()
+	taskIDs := make([]uuid.UUID, 0, len(m.previews))
+	for taskID := range m.previews {
+		taskIDs = append(taskIDs, taskID)
+	}
+	m.mu.Unlock()
+
+	for _, taskID := range taskIDs {
+		_ = m.Stop(ctx, taskID)
+	}
+	return taskIDs
+}
+
+// allocatePort returns the first port of the range that no preview uses and
+// that nothing else listens on. m.mu must be held.
+func (m *Manager) allocatePort() (int, error) {
+	used := make(map[int]bool, len(m.previews))
+	for _, preview := range m.previews {
+		used[preview.Port] = true
+	}
+	for port := m.config.PortRangeStart; port <= m.config.PortRangeEnd; port++ {
+		if used[port] {
+			continue
+		}
+		listener, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+		if err != nil {
+			continue
+		}
+		listener.Close()
+		return port, nil
+	}
+	return 0, ErrNoFreePort
+}
+
+// waitReady waits until the preview accepts connections on its port
+func (m *Manager) waitReady(ctx context.Context, preview *Preview) error {
+	timeout := time.Duration(m.config.StartTimeout) * time.Second
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	address := net.JoinHostPort("localhost", strconv.Itoa(preview.Port))
+	for {
+		if conn, err := net.DialTimeout("tcp", address, time.Second); err == nil {
+			conn.Close()
+			return nil
+		}
+
+		select {
+		case <-preview.done:
+			return fmt.Errorf("preview exited before listening on port %d: %s", preview.Port, preview.output.String())
+		case <-deadline.C:
+			return fmt.Errorf("preview did not listen on port %d within %v: %s", preview.Port, timeout, preview.output.String())
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// terminate stops the preview's process group, killing it when it does not
+// exit in time
+func terminate(preview *Preview) {
+	if preview.cmd == nil || preview.cmd.Process == nil {
+		return
+	}
+	pgid := preview.cmd.Process.Pid
+	_ = syscall.Kill(-pgid, syscall.SIGTERM)
+	select {
+	case <-preview.done:
+	case <-time.After(stopTimeout):
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+		<-preview.done
+	}
+}
+
+func hasComposeFile(dir string) bool {
+	for _, name := range composeFiles {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// tailBuffer keeps the last limit bytes written to it
+type tailBuffer struct {
+	mu    sync.Mutex
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Write(p)
+	if excess := b.buf.Len() - b.limit; excess > 0 {
+		b.buf.Next(excess)
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
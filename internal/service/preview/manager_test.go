@@ -0,0 +1,93 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"strconv"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) *Manager {
+	// Find a free range of a few ports
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	return NewManager(&config.PreviewConfig{
+		Host:           "preview.example.com",
+		PortRangeStart: port,
+		PortRangeEnd:   port + 2,
+		StartTimeout:   10,
+	})
+}
+
+func TestManager_StartStop(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 not available")
+	}
+	manager := newTestManager(t)
+	taskID := uuid.New()
+	ctx := context.Background()
+
+	preview, err := manager.Start(ctx, taskID, t.TempDir(), "exec python3 -m http.server $PORT")
+	require.NoError(t, err)
+	assert.Equal(t, manager.config.PortRangeStart, preview.Port)
+	assert.Equal(t, fmt.Sprintf("http://preview.example.com:%d", preview.Port), preview.URL)
+
+	resp, err := http.Get("http://localhost:" + strconv.Itoa(preview.Port))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	running, ok := manager.Get(taskID)
+	require.True(t, ok)
+	assert.Same(t, preview, running)
+
+	require.NoError(t, manager.Stop(ctx, taskID))
+	_, ok = manager.Get(taskID)
+	assert.False(t, ok)
+	_, err = net.Dial("tcp", "localhost:"+strconv.Itoa(preview.Port))
+	assert.Error(t, err, "the preview is no longer listening")
+
+	assert.NoError(t, manager.Stop(ctx, taskID), "stopping twice is fine")
+}
+
+func TestManager_StartFailures(t *testing.T) {
+	manager := newTestManager(t)
+	ctx := context.Background()
+
+	_, err := manager.Start(ctx, uuid.New(), t.TempDir(), "")
+	assert.ErrorIs(t, err, ErrNoCommand)
+
+	_, err = manager.Start(ctx, uuid.New(), t.TempDir(), "echo missing dependency >&2; exit 1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing dependency")
+	assert.Empty(t, manager.StopAll(ctx), "failed previews are not kept")
+}
+
+func TestManager_AllocatePort(t *testing.T) {
+	manager := newTestManager(t)
+	start := manager.config.PortRangeStart
+
+	taken, err := net.Listen("tcp", ":"+strconv.Itoa(start))
+	require.NoError(t, err)
+	defer taken.Close()
+	manager.previews[uuid.New()] = &Preview{Port: start + 1}
+
+	port, err := manager.allocatePort()
+	require.NoError(t, err)
+	assert.Equal(t, start+2, port)
+
+	manager.previews[uuid.New()] = &Preview{Port: start + 2}
+	_, err = manager.allocatePort()
+	assert.ErrorIs(t, err, ErrNoFreePort)
+}
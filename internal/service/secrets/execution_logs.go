@@ -0,0 +1,249 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// WithheldLogMessage replaces the message of a log that should be encrypted
+// when no encryption key is configured, so it is never stored in clear
+const WithheldLogMessage = "[withheld: the project encrypts its execution logs but no encryption key is configured]"
+
+// LogSealer encrypts the message bodies of execution logs, with the key of
+// the secrets store.
+type LogSealer struct {
+	cipher *Cipher
+}
+
+// NewLogSealer builds a LogSealer from config. Without an encryption key
+// Seal and Open fail with ErrNoEncryptionKey; a malformed key is an error.
+func NewLogSealer(cfg *config.SecretsConfig) (*LogSealer, error) {
+	cipher, err := cipherFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &LogSealer{cipher: cipher}, nil
+}
+
+// sealedLog is the part of an execution log that is encrypted
+type sealedLog struct {
+	Message       string       `json:"message"`
+	ParsedContent entity.JSONB `json:"parsed_content,omitempty"`
+}
+
+// Seal encrypts the message and parsed content of log into its message and
+// marks it encrypted
+func (s *LogSealer) Seal(log *entity.ExecutionLog) error {
+	if s.cipher == nil {
+		return ErrNoEncryptionKey
+	}
+	if log.Encrypted {
+		return nil
+	}
+
+	plaintext, err := json.Marshal(sealedLog{Message: log.Message, ParsedContent: log.ParsedContent})
+	if err != nil {
+		return fmt.Errorf("failed to encode execution log: %w", err)
+	}
+	sealed, err := s.cipher.Seal(plaintext, logAdditionalData(log.ExecutionID))
+	if err != nil {
+		return err
+	}
+
+	log.Message = sealed
+	log.ParsedContent = nil
+	log.Encrypted = true
+	return nil
+}
+
+// Open reverses Seal on an encrypted log
+func (s *LogSealer) Open(log *entity.ExecutionLog) error {
+	if !log.Encrypted {
+		return nil
+	}
+	if s.cipher == nil {
+		return ErrNoEncryptionKey
+	}
+
+	plaintext, err := s.cipher.Open(log.Message, logAdditionalData(log.ExecutionID))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt execution log %s: %w", log.ID, err)
+	}
+	var opened sealedLog
+	if err := json.Unmarshal(plaintext, &opened); err != nil {
+		return fmt.Errorf("failed to decode execution log %s: %w", log.ID, err)
+	}
+
+	log.Message = opened.Message
+	log.ParsedContent = opened.ParsedContent
+	log.Encrypted = false
+	return nil
+}
+
+// logAdditionalData binds a sealed log to its execution
+func logAdditionalData(executionID uuid.UUID) []byte {
+	return []byte("execution_log/" + executionID.String())
+}
+
+// sealingExecutionLogRepository seals the logs written for executions of
+// projects with EncryptExecutionLogs set before they reach the database.
+type sealingExecutionLogRepository struct {
+	repository.ExecutionLogRepository
+	sealer        *LogSealer
+	executionRepo repository.ExecutionRepository
+	taskRepo      repository.TaskRepository
+	projectRepo   repository.ProjectRepository
+
+	mu       sync.Mutex
+	projects map[uuid.UUID]uuid.UUID // execution ID to project ID
+}
+
+// NewSealingExecutionLogRepository wraps repo so that written logs are
+// sealed when their project encrypts execution logs. Logs handed to it are
+// left as they are; the copies written carry the sealed body.
+func NewSealingExecutionLogRepository(
+	repo repository.ExecutionLogRepository,
+	sealer *LogSealer,
+	executionRepo repository.ExecutionRepository,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+) repository.ExecutionLogRepository {
+	return &sealingExecutionLogRepository{
+		ExecutionLogRepository: repo,
+		sealer:                 sealer,
+		executionRepo:          executionRepo,
+		taskRepo:               taskRepo,
+		projectRepo:            projectRepo,
+		projects:               make(map[uuid.UUID]uuid.UUID),
+	}
+}
+
+func (r *sealingExecutionLogRepository) Create(ctx context.Context, log *entity.ExecutionLog) error {
+	sealed, err := r.seal(ctx, []*entity.ExecutionLog{log})
+	if err != nil {
+		return err
+	}
+	if err := r.ExecutionLogRepository.Create(ctx, sealed[0]); err != nil {
+		return err
+	}
+	copyGenerated(log, sealed[0])
+	return nil
+}
+
+func (r *sealingExecutionLogRepository) BatchCreate(ctx context.Context, logs []*entity.ExecutionLog) error {
+	sealed, err := r.seal(ctx, logs)
+	if err != nil {
+		return err
+	}
+	if err := r.ExecutionLogRepository.BatchCreate(ctx, sealed); err != nil {
+		return err
+	}
+	for i := range logs {
+		copyGenerated(logs[i], sealed[i])
+	}
+	return nil
+}
+
+func (r *sealingExecutionLogRepository) BatchInsertOrUpdate(ctx context.Context, logs []*entity.ExecutionLog) error {
+	sealed, err := r.seal(ctx, logs)
+	if err != nil {
+		return err
+	}
+	if err := r.ExecutionLogRepository.BatchInsertOrUpdate(ctx, sealed); err != nil {
+		return err
+	}
+	for i := range logs {
+		copyGenerated(logs[i], sealed[i])
+	}
+	return nil
+}
+
+// seal returns the logs to write: logs itself when none of them needs
+// sealing, otherwise copies with the bodies of those that do sealed. A
+// project that cannot be looked up is an error, as its logs might then be
+// written in clear.
+func (r *sealingExecutionLogRepository) seal(ctx context.Context, logs []*entity.ExecutionLog) ([]*entity.ExecutionLog, error) {
+	encrypts := make(map[uuid.UUID]bool)
+	needed := false
+	for _, log := range logs {
+		encrypt, ok := encrypts[log.ExecutionID]
+		if !ok {
+			var err error
+			if encrypt, err = r.encrypts(ctx, log.ExecutionID); err != nil {
+				return nil, err
+			}
+			encrypts[log.ExecutionID] = encrypt
+		}
+		needed = needed || encrypt
+	}
+	if !needed {
+		return logs, nil
+	}
+
+	sealed := make([]*entity.ExecutionLog, len(logs))
+	for i, log := range logs {
+		copied := *log
+		sealed[i] = &copied
+		if !encrypts[log.ExecutionID] {
+			continue
+		}
+		if err := r.sealer.Seal(&copied); errors.Is(err, ErrNoEncryptionKey) {
+			copied.Message = WithheldLogMessage
+			copied.ParsedContent = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to encrypt execution log: %w", err)
+		}
+	}
+	return sealed, nil
+}
+
+// encrypts reports whether the project of the execution encrypts its logs.
+// The project of an execution never changes, so it is cached, but its
+// setting is read on each write.
+func (r *sealingExecutionLogRepository) encrypts(ctx context.Context, executionID uuid.UUID) (bool, error) {
+	r.mu.Lock()
+	projectID, ok := r.projects[executionID]
+	r.mu.Unlock()
+
+	if !ok {
+		execution, err := r.executionRepo.GetByID(ctx, executionID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get execution of log: %w", err)
+		}
+		task, err := r.taskRepo.GetByID(ctx, execution.TaskID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get task of log: %w", err)
+		}
+		projectID = task.ProjectID
+
+		r.mu.Lock()
+		r.projects[executionID] = projectID
+		r.mu.Unlock()
+	}
+
+	project, err := r.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get project of log: %w", err)
+	}
+	return project.EncryptExecutionLogs, nil
+}
+
+// copyGenerated copies what the database generated for a written copy of
+// a log back to the log
+func copyGenerated(log, written *entity.ExecutionLog) {
+	if log == written {
+		return
+	}
+	log.ID = written.ID
+	log.Timestamp = written.Timestamp
+	log.CreatedAt = written.CreatedAt
+	log.UpdatedAt = written.UpdatedAt
+}
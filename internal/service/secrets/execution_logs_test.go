@@ -0,0 +1,109 @@
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSealer_SealOpen(t *testing.T) {
+	sealer, err := NewLogSealer(&config.SecretsConfig{EncryptionKey: testKey})
+	require.NoError(t, err)
+
+	log := &entity.ExecutionLog{
+		ExecutionID:   uuid.New(),
+		Message:       "reading internal/billing/keys.go",
+		ParsedContent: entity.JSONB{"text": "api_key = 123"},
+	}
+	original := *log
+
+	require.NoError(t, sealer.Seal(log))
+	assert.True(t, log.Encrypted)
+	assert.NotContains(t, log.Message, "billing")
+	assert.Nil(t, log.ParsedContent)
+
+	// A sealed log is bound to its execution
+	moved := *log
+	moved.ExecutionID = uuid.New()
+	assert.Error(t, sealer.Open(&moved))
+	assert.True(t, moved.Encrypted)
+
+	require.NoError(t, sealer.Open(log))
+	assert.False(t, log.Encrypted)
+	assert.Equal(t, original.Message, log.Message)
+	assert.Equal(t, original.ParsedContent, log.ParsedContent)
+}
+
+func TestSealingExecutionLogRepository(t *testing.T) {
+	sensitiveExecution, plainExecution := uuid.New(), uuid.New()
+	sensitiveTask, plainTask := uuid.New(), uuid.New()
+	sensitiveProject, plainProject := uuid.New(), uuid.New()
+
+	setup := func(t *testing.T, key string) (repository.ExecutionLogRepository, *repository.ExecutionLogRepositoryMock) {
+		sealer, err := NewLogSealer(&config.SecretsConfig{EncryptionKey: key})
+		require.NoError(t, err)
+
+		inner := repository.NewExecutionLogRepositoryMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		executionRepo.EXPECT().GetByID(mock.Anything, sensitiveExecution).Return(&entity.Execution{ID: sensitiveExecution, TaskID: sensitiveTask}, nil).Maybe()
+		executionRepo.EXPECT().GetByID(mock.Anything, plainExecution).Return(&entity.Execution{ID: plainExecution, TaskID: plainTask}, nil).Maybe()
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		taskRepo.EXPECT().GetByID(mock.Anything, sensitiveTask).Return(&entity.Task{ID: sensitiveTask, ProjectID: sensitiveProject}, nil).Maybe()
+		taskRepo.EXPECT().GetByID(mock.Anything, plainTask).Return(&entity.Task{ID: plainTask, ProjectID: plainProject}, nil).Maybe()
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, sensitiveProject).Return(&entity.Project{ID: sensitiveProject, EncryptExecutionLogs: true}, nil).Maybe()
+		projectRepo.EXPECT().GetByID(mock.Anything, plainProject).Return(&entity.Project{ID: plainProject}, nil).Maybe()
+
+		return NewSealingExecutionLogRepository(inner, sealer, executionRepo, taskRepo, projectRepo), inner
+	}
+
+	t.Run("seals only the logs of projects that encrypt them", func(t *testing.T) {
+		repo, inner := setup(t, testKey)
+		var written []*entity.ExecutionLog
+		inner.EXPECT().BatchInsertOrUpdate(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, logs []*entity.ExecutionLog) error {
+			written = logs
+			return nil
+		}).Once()
+
+		logs := []*entity.ExecutionLog{
+			{ExecutionID: sensitiveExecution, Message: "secret plan", Line: 1},
+			{ExecutionID: plainExecution, Message: "public plan", Line: 1},
+		}
+		require.NoError(t, repo.BatchInsertOrUpdate(context.Background(), logs))
+
+		require.Len(t, written, 2)
+		assert.True(t, written[0].Encrypted)
+		assert.NotContains(t, written[0].Message, "secret")
+		assert.False(t, written[1].Encrypted)
+		assert.Equal(t, "public plan", written[1].Message)
+
+		// The caller's logs are left in clear
+		assert.Equal(t, "secret plan", logs[0].Message)
+		assert.False(t, logs[0].Encrypted)
+	})
+
+	t.Run("withholds logs to seal without a key", func(t *testing.T) {
+		repo, inner := setup(t, "")
+		var written *entity.ExecutionLog
+		inner.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, log *entity.ExecutionLog) error {
+			written = log
+			log.ID = uuid.New()
+			return nil
+		}).Once()
+
+		log := &entity.ExecutionLog{ExecutionID: sensitiveExecution, Message: "secret plan"}
+		require.NoError(t, repo.Create(context.Background(), log))
+
+		require.NotNil(t, written)
+		assert.Equal(t, WithheldLogMessage, written.Message)
+		assert.False(t, written.Encrypted)
+		assert.Equal(t, written.ID, log.ID)
+	})
+}
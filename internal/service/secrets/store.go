@@ -0,0 +1,152 @@
+// Package secrets stores per-project credentials encrypted at rest.
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when a project has no secret with the given name
+	ErrNotFound = errors.New("secret not found")
+	// ErrNoEncryptionKey is returned when the store is used without a key
+	// configured
+	ErrNoEncryptionKey = errors.New("secrets encryption key is not configured")
+)
+
+// Store reads and writes project secrets, sealing values with AES-256-GCM
+// before they reach the database.
+type Store interface {
+	Put(ctx context.Context, projectID uuid.UUID, name, value string) error
+	Get(ctx context.Context, projectID uuid.UUID, name string) (string, error)
+	Delete(ctx context.Context, projectID uuid.UUID, name string) error
+}
+
+type store struct {
+	repo   repository.SecretRepository
+	cipher *Cipher
+}
+
+// NewStore builds a Store from config. Without an encryption key every
+// read and write fails with ErrNoEncryptionKey; a malformed key is an error.
+func NewStore(cfg *config.SecretsConfig, repo repository.SecretRepository) (Store, error) {
+	cipher, err := cipherFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &store{repo: repo, cipher: cipher}, nil
+}
+
+// cipherFromConfig returns the Cipher of cfg's key, nil when none is set
+func cipherFromConfig(cfg *config.SecretsConfig) (*Cipher, error) {
+	if cfg.EncryptionKey == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(cfg.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secrets encryption key: %w", err)
+	}
+	return NewCipher(key)
+}
+
+func (s *store) Put(ctx context.Context, projectID uuid.UUID, name, value string) error {
+	if s.cipher == nil {
+		return ErrNoEncryptionKey
+	}
+
+	sealed, err := s.cipher.Seal([]byte(value), additionalData(projectID, name))
+	if err != nil {
+		return err
+	}
+	return s.repo.Upsert(ctx, &entity.ProjectSecret{
+		ProjectID:  projectID,
+		Name:       name,
+		Ciphertext: sealed,
+	})
+}
+
+func (s *store) Get(ctx context.Context, projectID uuid.UUID, name string) (string, error) {
+	if s.cipher == nil {
+		return "", ErrNoEncryptionKey
+	}
+
+	secret, err := s.repo.Get(ctx, projectID, name)
+	if err != nil {
+		return "", err
+	}
+	if secret == nil {
+		return "", ErrNotFound
+	}
+
+	value, err := s.cipher.Open(secret.Ciphertext, additionalData(projectID, name))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %q: %w", name, err)
+	}
+	return string(value), nil
+}
+
+func (s *store) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	return s.repo.Delete(ctx, projectID, name)
+}
+
+// additionalData binds a ciphertext to its row, so a value copied to another
+// project or name fails to decrypt.
+func additionalData(projectID uuid.UUID, name string) []byte {
+	return []byte(projectID.String() + "/" + name)
+}
+
+// Cipher seals values with AES-256-GCM under a random nonce.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a Cipher from a 32-byte key
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("secrets encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal encrypts plaintext and returns base64(nonce || ciphertext)
+func (c *Cipher) Seal(plaintext, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, plaintext, additionalData)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open reverses Seal. It fails if the value was tampered with or sealed with
+// another key or additional data.
+func (c *Cipher) Open(sealed string, additionalData []byte) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < c.aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := data[:c.aead.NonceSize()], data[c.aead.NonceSize():]
+	return c.aead.Open(nil, nonce, ciphertext, additionalData)
+}
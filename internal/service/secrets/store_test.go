@@ -0,0 +1,68 @@
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+func TestStore_PutGet(t *testing.T) {
+	repo := repository.NewSecretRepositoryMock(t)
+	store, err := NewStore(&config.SecretsConfig{EncryptionKey: testKey}, repo)
+	require.NoError(t, err)
+
+	projectID := uuid.New()
+	var saved *entity.ProjectSecret
+	repo.EXPECT().Upsert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, secret *entity.ProjectSecret) error {
+		saved = secret
+		return nil
+	}).Once()
+
+	require.NoError(t, store.Put(context.Background(), projectID, "jira_api_token", "s3cret"))
+	require.NotNil(t, saved)
+	assert.NotContains(t, saved.Ciphertext, "s3cret")
+
+	repo.EXPECT().Get(mock.Anything, projectID, "jira_api_token").Return(saved, nil).Once()
+	value, err := store.Get(context.Background(), projectID, "jira_api_token")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", value)
+
+	// A ciphertext is bound to the project and name it was stored under
+	otherProject := uuid.New()
+	repo.EXPECT().Get(mock.Anything, otherProject, "jira_api_token").Return(saved, nil).Once()
+	_, err = store.Get(context.Background(), otherProject, "jira_api_token")
+	assert.Error(t, err)
+}
+
+func TestStore_NotFound(t *testing.T) {
+	repo := repository.NewSecretRepositoryMock(t)
+	store, err := NewStore(&config.SecretsConfig{EncryptionKey: testKey}, repo)
+	require.NoError(t, err)
+
+	repo.EXPECT().Get(mock.Anything, mock.Anything, "missing").Return(nil, nil).Once()
+	_, err = store.Get(context.Background(), uuid.New(), "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNewStore_Keys(t *testing.T) {
+	store, err := NewStore(&config.SecretsConfig{}, nil)
+	require.NoError(t, err)
+	assert.ErrorIs(t, store.Put(context.Background(), uuid.New(), "name", "value"), ErrNoEncryptionKey)
+
+	_, err = NewStore(&config.SecretsConfig{EncryptionKey: "not base64!"}, nil)
+	assert.Error(t, err)
+
+	_, err = NewStore(&config.SecretsConfig{EncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))}, nil)
+	assert.Error(t, err)
+}
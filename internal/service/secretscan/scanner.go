@@ -0,0 +1,155 @@
+// Package secretscan looks for credentials and keys in the lines a diff adds,
+// with rules modelled on gitleaks' default ones. It needs no external tool,
+// so every implementation is scanned before it is pushed.
+package secretscan
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// allowComment marks a line whose match is known not to be a secret, as
+// gitleaks does
+const allowComment = "gitleaks:allow"
+
+// minGenericEntropy is the Shannon entropy, in bits per character, a value
+// assigned to a secret-looking name needs to be reported. Real keys are
+// random; placeholders and words are not.
+const minGenericEntropy = 3.5
+
+type rule struct {
+	id          string
+	description string
+	// pattern's first group, or the whole match without one, is the secret
+	pattern *regexp.Regexp
+	// minEntropy, when set, is the entropy the secret needs to be reported
+	minEntropy float64
+}
+
+var rules = []rule{
+	{id: "private-key", description: "Private key", pattern: regexp.MustCompile(`-----BEGIN[ A-Z0-9_-]{0,100}PRIVATE KEY(?: BLOCK)?-----`)},
+	{id: "aws-access-key-id", description: "AWS access key ID", pattern: regexp.MustCompile(`\b((?:A3T[A-Z0-9]|AKIA|ASIA|ABIA|ACCA)[A-Z2-7]{16})\b`)},
+	{id: "github-pat", description: "GitHub personal access token", pattern: regexp.MustCompile(`\b(ghp_[0-9a-zA-Z]{36})\b`)},
+	{id: "github-fine-grained-pat", description: "GitHub fine-grained personal access token", pattern: regexp.MustCompile(`\b(github_pat_[0-9a-zA-Z_]{82})\b`)},
+	{id: "github-oauth", description: "GitHub OAuth access token", pattern: regexp.MustCompile(`\b(gho_[0-9a-zA-Z]{36})\b`)},
+	{id: "github-app-token", description: "GitHub app token", pattern: regexp.MustCompile(`\b((?:ghu|ghs)_[0-9a-zA-Z]{36})\b`)},
+	{id: "gitlab-pat", description: "GitLab personal access token", pattern: regexp.MustCompile(`\b(glpat-[0-9a-zA-Z_-]{20})\b`)},
+	{id: "slack-token", description: "Slack token", pattern: regexp.MustCompile(`\b(xox[baprs]-[0-9a-zA-Z-]{10,})\b`)},
+	{id: "slack-webhook-url", description: "Slack webhook URL", pattern: regexp.MustCompile(`(https://hooks\.slack\.com/(?:services|workflows)/[A-Za-z0-9+/]{43,})`)},
+	{id: "stripe-secret-key", description: "Stripe secret key", pattern: regexp.MustCompile(`\b((?:sk|rk)_live_[0-9a-zA-Z]{10,99})\b`)},
+	{id: "google-api-key", description: "Google API key", pattern: regexp.MustCompile(`\b(AIza[0-9A-Za-z_-]{35})\b`)},
+	{id: "anthropic-api-key", description: "Anthropic API key", pattern: regexp.MustCompile(`\b(sk-ant-(?:api03|admin01)-[a-zA-Z0-9_-]{93}AA)\b`)},
+	{id: "openai-api-key", description: "OpenAI API key", pattern: regexp.MustCompile(`\b(sk-(?:proj-|svcacct-|admin-)?[A-Za-z0-9_-]{20,}T3BlbkFJ[A-Za-z0-9_-]{20,})\b`)},
+	{id: "jwt", description: "JSON Web Token", pattern: regexp.MustCompile(`\b(eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,})`)},
+	{
+		id:          "generic-api-key",
+		description: "Generic secret",
+		pattern:     regexp.MustCompile(`(?i)(?:api[_-]?key|secret|token|passw(?:or)?d|access[_-]?key|credential)s?["']?\s*(?::=|=|:)\s*["']([^"'\s]{16,})["']`),
+		minEntropy:  minGenericEntropy,
+	},
+}
+
+// hunkHeader captures the first line number of a hunk in the new file
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// ScanDiff returns the secrets found in the lines a unified diff adds, as
+// high-severity findings with the secret redacted from their message. Lines
+// carrying a gitleaks:allow comment are skipped.
+func ScanDiff(diff string) []entity.SecurityFinding {
+	var findings []entity.SecurityFinding
+	var file string
+	line := 0
+	for _, text := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			file = strings.TrimPrefix(strings.TrimPrefix(text, "+++ "), "b/")
+			if file == "/dev/null" {
+				file = ""
+			}
+		case strings.HasPrefix(text, "--- "), strings.HasPrefix(text, "diff "):
+		case strings.HasPrefix(text, "@@"):
+			if m := hunkHeader.FindStringSubmatch(text); m != nil {
+				line, _ = strconv.Atoi(m[1])
+			}
+		case strings.HasPrefix(text, "+"):
+			if file != "" {
+				findings = append(findings, scanLine(file, line, text[1:])...)
+			}
+			line++
+		case strings.HasPrefix(text, " "):
+			line++
+		}
+	}
+	return findings
+}
+
+func scanLine(file string, line int, text string) []entity.SecurityFinding {
+	if strings.Contains(text, allowComment) {
+		return nil
+	}
+	var findings []entity.SecurityFinding
+	// A secret is reported once, by the most specific rule, which comes first
+	var found []string
+	for _, r := range rules {
+		for _, m := range r.pattern.FindAllStringSubmatch(text, -1) {
+			secret := m[0]
+			if len(m) > 1 && m[1] != "" {
+				secret = m[1]
+			}
+			if r.minEntropy > 0 && entropy(secret) < r.minEntropy {
+				continue
+			}
+			if slices.ContainsFunc(found, func(f string) bool {
+				return strings.Contains(f, secret) || strings.Contains(secret, f)
+			}) {
+				continue
+			}
+			found = append(found, secret)
+			findings = append(findings, entity.SecurityFinding{
+				Scanner:  entity.SecurityScannerSecrets,
+				RuleID:   r.id,
+				FilePath: file,
+				Line:     line,
+				Severity: entity.SecuritySeverityHigh,
+				Message:  fmt.Sprintf("%s: %s", r.description, redact(secret)),
+			})
+		}
+	}
+	return findings
+}
+
+// redact keeps the first four characters of a secret, enough to recognize
+// it without leaking it
+func redact(secret string) string {
+	const shown = 4
+	runes := []rune(secret)
+	if len(runes) <= shown*2 {
+		return strings.Repeat("*", len(runes))
+	}
+	return string(runes[:shown]) + strings.Repeat("*", len(runes)-shown)
+}
+
+// entropy returns the Shannon entropy of s in bits per character
+func entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	n := 0
+	for _, r := range s {
+		counts[r]++
+		n++
+	}
+	var bits float64
+	for _, count := range counts {
+		p := float64(count) / float64(n)
+		bits -= p * math.Log2(p)
+	}
+	return bits
+}
@@ -0,0 +1,185 @@
+// Package securityscan runs static security analysis over the files an
+// implementation changed and normalizes the scanners' reports into findings.
+package securityscan
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+)
+
+// Command returns the bash command scanning files, given relative to the
+// worktree, with scanner and writing its JSON report to reportPath. It
+// returns "" when none of the files is something the scanner checks. The
+// scanners exit with 0 whatever they find; findings are read from the report.
+func Command(scanner entity.SecurityScanner, files []string, reportPath string) (string, error) {
+	switch scanner {
+	case entity.SecurityScannerGosec:
+		// gosec scans packages, so every package with a changed file is
+		// scanned and the findings are filtered afterwards
+		var packages []string
+		for _, file := range files {
+			if !strings.HasSuffix(file, ".go") {
+				continue
+			}
+			pkg := "."
+			if dir := path.Dir(file); dir != "." {
+				pkg = "./" + dir
+			}
+			if !slices.Contains(packages, pkg) {
+				packages = append(packages, pkg)
+			}
+		}
+		if len(packages) == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("gosec -quiet -no-fail -fmt=json -out=%s %s", shellQuote(reportPath), shellQuoteAll(packages)), nil
+	case entity.SecurityScannerSemgrep:
+		if len(files) == 0 {
+			return "", nil
+		}
+		return fmt.Sprintf("semgrep scan --config=auto --quiet --json --output=%s -- %s", shellQuote(reportPath), shellQuoteAll(files)), nil
+	default:
+		return "", fmt.Errorf("unsupported security scanner %q", scanner)
+	}
+}
+
+// ParseReport reads a scanner's JSON report, keeping only the findings in
+// files. Paths in the findings are made relative to worktreePath.
+func ParseReport(scanner entity.SecurityScanner, report []byte, worktreePath string, files []string) ([]entity.SecurityFinding, error) {
+	var findings []entity.SecurityFinding
+	var err error
+	switch scanner {
+	case entity.SecurityScannerGosec:
+		findings, err = parseGosecReport(report)
+	case entity.SecurityScannerSemgrep:
+		findings, err = parseSemgrepReport(report)
+	default:
+		return nil, fmt.Errorf("unsupported security scanner %q", scanner)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s report: %w", scanner, err)
+	}
+
+	changed := make([]entity.SecurityFinding, 0, len(findings))
+	for _, finding := range findings {
+		finding.Scanner = scanner
+		finding.FilePath = relativePath(worktreePath, finding.FilePath)
+		if slices.Contains(files, finding.FilePath) {
+			changed = append(changed, finding)
+		}
+	}
+	return changed, nil
+}
+
+// HasHighSeverity reports whether any of the findings is high severity, which
+// blocks the pull request
+func HasHighSeverity(findings []entity.SecurityFinding) bool {
+	return slices.ContainsFunc(findings, func(f entity.SecurityFinding) bool {
+		return f.Severity == entity.SecuritySeverityHigh
+	})
+}
+
+type gosecReport struct {
+	Issues []struct {
+		Severity string `json:"severity"`
+		RuleID   string `json:"rule_id"`
+		Details  string `json:"details"`
+		File     string `json:"file"`
+		// Line is a line number or a range such as "12-14"
+		Line string `json:"line"`
+	} `json:"Issues"`
+}
+
+func parseGosecReport(report []byte) ([]entity.SecurityFinding, error) {
+	var parsed gosecReport
+	if err := json.Unmarshal(report, &parsed); err != nil {
+		return nil, err
+	}
+
+	findings := make([]entity.SecurityFinding, 0, len(parsed.Issues))
+	for _, issue := range parsed.Issues {
+		line, _ := strconv.Atoi(strings.SplitN(issue.Line, "-", 2)[0])
+		findings = append(findings, entity.SecurityFinding{
+			RuleID:   issue.RuleID,
+			FilePath: issue.File,
+			Line:     line,
+			Severity: normalizeSeverity(issue.Severity),
+			Message:  issue.Details,
+		})
+	}
+	return findings, nil
+}
+
+type semgrepReport struct {
+	Results []struct {
+		CheckID string `json:"check_id"`
+		Path    string `json:"path"`
+		Start   struct {
+			Line int `json:"line"`
+		} `json:"start"`
+		Extra struct {
+			Message  string `json:"message"`
+			Severity string `json:"severity"`
+		} `json:"extra"`
+	} `json:"results"`
+}
+
+func parseSemgrepReport(report []byte) ([]entity.SecurityFinding, error) {
+	var parsed semgrepReport
+	if err := json.Unmarshal(report, &parsed); err != nil {
+		return nil, err
+	}
+
+	findings := make([]entity.SecurityFinding, 0, len(parsed.Results))
+	for _, result := range parsed.Results {
+		findings = append(findings, entity.SecurityFinding{
+			RuleID:   result.CheckID,
+			FilePath: result.Path,
+			Line:     result.Start.Line,
+			Severity: normalizeSeverity(result.Extra.Severity),
+			Message:  result.Extra.Message,
+		})
+	}
+	return findings, nil
+}
+
+// normalizeSeverity maps gosec's HIGH/MEDIUM/LOW and semgrep's
+// ERROR/WARNING/INFO (or CRITICAL/HIGH/MEDIUM/LOW) onto finding severities
+func normalizeSeverity(severity string) entity.SecuritySeverity {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL", "HIGH", "ERROR":
+		return entity.SecuritySeverityHigh
+	case "MEDIUM", "WARNING":
+		return entity.SecuritySeverityMedium
+	default:
+		return entity.SecuritySeverityLow
+	}
+}
+
+func relativePath(worktreePath, file string) string {
+	if filepath.IsAbs(file) {
+		if rel, err := filepath.Rel(worktreePath, file); err == nil {
+			file = rel
+		}
+	}
+	return filepath.ToSlash(filepath.Clean(file))
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func shellQuoteAll(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
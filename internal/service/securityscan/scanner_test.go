@@ -0,0 +1,70 @@
+package securityscan
+
+import (
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommand(t *testing.T) {
+	files := []string{"auth/login.go", "auth/token.go", "main.go", "README.md"}
+
+	command, err := Command(entity.SecurityScannerGosec, files, "/tmp/report.json")
+	require.NoError(t, err)
+	assert.Equal(t, "gosec -quiet -no-fail -fmt=json -out='/tmp/report.json' './auth' '.'", command)
+
+	command, err = Command(entity.SecurityScannerGosec, []string{"README.md"}, "/tmp/report.json")
+	require.NoError(t, err)
+	assert.Empty(t, command, "nothing for gosec to scan")
+
+	command, err = Command(entity.SecurityScannerSemgrep, []string{"app/it's.py"}, "/tmp/report.json")
+	require.NoError(t, err)
+	assert.Equal(t, `semgrep scan --config=auto --quiet --json --output='/tmp/report.json' -- 'app/it'\''s.py'`, command)
+
+	_, err = Command("bandit", files, "/tmp/report.json")
+	assert.Error(t, err)
+}
+
+func TestParseReport_Gosec(t *testing.T) {
+	report := []byte(`{"Issues": [
+		{"severity": "HIGH", "confidence": "HIGH", "rule_id": "G101", "details": "Potential hardcoded credentials", "file": "/work/app/auth/login.go", "line": "12"},
+		{"severity": "MEDIUM", "confidence": "HIGH", "rule_id": "G304", "details": "Potential file inclusion via variable", "file": "/work/app/auth/token.go", "line": "30-32"},
+		{"severity": "HIGH", "confidence": "HIGH", "rule_id": "G401", "details": "Use of weak cryptographic primitive", "file": "/work/app/auth/legacy.go", "line": "8"}
+	]}`)
+
+	findings, err := ParseReport(entity.SecurityScannerGosec, report, "/work/app", []string{"auth/login.go", "auth/token.go"})
+	require.NoError(t, err)
+	require.Len(t, findings, 2, "findings in unchanged files of a scanned package are dropped")
+	assert.Equal(t, entity.SecurityFinding{
+		Scanner:  entity.SecurityScannerGosec,
+		RuleID:   "G101",
+		FilePath: "auth/login.go",
+		Line:     12,
+		Severity: entity.SecuritySeverityHigh,
+		Message:  "Potential hardcoded credentials",
+	}, findings[0])
+	assert.Equal(t, 30, findings[1].Line)
+	assert.Equal(t, entity.SecuritySeverityMedium, findings[1].Severity)
+	assert.True(t, HasHighSeverity(findings))
+	assert.False(t, HasHighSeverity(findings[1:]))
+}
+
+func TestParseReport_Semgrep(t *testing.T) {
+	report := []byte(`{"results": [
+		{"check_id": "python.lang.security.audit.eval-detected", "path": "app/views.py", "start": {"line": 4, "col": 1}, "extra": {"message": "Detected the use of eval()", "severity": "WARNING"}},
+		{"check_id": "python.lang.best-practice.open-never-closed", "path": "app/io.py", "start": {"line": 9}, "extra": {"message": "File is never closed", "severity": "INFO"}}
+	], "errors": []}`)
+
+	findings, err := ParseReport(entity.SecurityScannerSemgrep, report, "/work/app", []string{"app/views.py", "app/io.py"})
+	require.NoError(t, err)
+	require.Len(t, findings, 2)
+	assert.Equal(t, "app/views.py", findings[0].FilePath)
+	assert.Equal(t, entity.SecuritySeverityMedium, findings[0].Severity)
+	assert.Equal(t, entity.SecuritySeverityLow, findings[1].Severity)
+	assert.False(t, HasHighSeverity(findings))
+
+	_, err = ParseReport(entity.SecurityScannerSemgrep, []byte("not json"), "/work/app", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+// Package slack is a minimal Slack client that posts Block Kit messages to
+// incoming webhooks and, with a bot token, to channels.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const requestTimeout = 10 * time.Second
+
+// Message is a Slack message. Text is the fallback shown in notifications
+// and by clients that cannot render the blocks.
+type Message struct {
+	// Channel is the channel posted to with a bot token; webhooks post to
+	// the channel they were created for
+	Channel string  `json:"channel,omitempty"`
+	Text    string  `json:"text"`
+	Blocks  []Block `json:"blocks,omitempty"`
+	// ReplaceOriginal replaces the message an interaction came from, when
+	// posted to its response URL
+	ReplaceOriginal bool `json:"replace_original,omitempty"`
+}
+
+// Block is a Block Kit layout block
+type Block struct {
+	Type     string    `json:"type"`
+	BlockID  string    `json:"block_id,omitempty"`
+	Text     *Text     `json:"text,omitempty"`
+	Elements []Element `json:"elements,omitempty"`
+}
+
+// Text is a Block Kit text object
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Element is a Block Kit interactive element; only buttons are used
+type Element struct {
+	Type     string `json:"type"`
+	Text     *Text  `json:"text,omitempty"`
+	ActionID string `json:"action_id,omitempty"`
+	Value    string `json:"value,omitempty"`
+	URL      string `json:"url,omitempty"`
+	// Style is primary, danger or empty
+	Style string `json:"style,omitempty"`
+}
+
+// Section is a block of markdown text
+func Section(markdown string) Block {
+	return Block{Type: "section", Text: &Text{Type: "mrkdwn", Text: markdown}}
+}
+
+// Actions is a block of interactive elements
+func Actions(blockID string, elements ...Element) Block {
+	return Block{Type: "actions", BlockID: blockID, Elements: elements}
+}
+
+// Button is a button sending actionID and value to the app's interactivity
+// URL when clicked
+func Button(label, actionID, value, style string) Element {
+	return Element{
+		Type:     "button",
+		Text:     &Text{Type: "plain_text", Text: label},
+		ActionID: actionID,
+		Value:    value,
+		Style:    style,
+	}
+}
+
+// LinkButton is a button opening url
+func LinkButton(label, actionID, url string) Element {
+	return Element{
+		Type:     "button",
+		Text:     &Text{Type: "plain_text", Text: label},
+		ActionID: actionID,
+		URL:      url,
+	}
+}
+
+// Client posts messages to Slack
+type Client interface {
+	// PostWebhook posts msg to an incoming webhook or to the response URL of
+	// an interaction
+	PostWebhook(ctx context.Context, webhookURL string, msg Message) error
+	// PostMessage posts msg to msg.Channel as the bot the token belongs to
+	PostMessage(ctx context.Context, botToken string, msg Message) error
+}
+
+type httpClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a Client posting bot messages to the Slack Web API base
+// URL
+func NewClient(apiBaseURL string) Client {
+	return &httpClient{
+		baseURL: strings.TrimRight(apiBaseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+func (c *httpClient) PostWebhook(ctx context.Context, webhookURL string, msg Message) error {
+	resp, err := c.post(ctx, webhookURL, "", msg)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Webhooks answer a plain "ok", and the reason as text when they fail
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("slack webhook returned %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (c *httpClient) PostMessage(ctx context.Context, botToken string, msg Message) error {
+	resp, err := c.post(ctx, c.baseURL+"/chat.postMessage", botToken, msg)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("slack API returned %d: %s", resp.StatusCode, string(body))
+	}
+	// The Web API answers 200 to failed calls too, with ok false
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode slack response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("slack API error: %s", result.Error)
+	}
+	return nil
+}
+
+func (c *httpClient) post(ctx context.Context, url, token string, msg Message) (*http.Response, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("slack request failed: %w", err)
+	}
+	return resp, nil
+}
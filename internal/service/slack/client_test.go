@@ -0,0 +1,70 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/services/T1/B1/secret", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		var msg Message
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		assert.Equal(t, "Plan ready", msg.Text)
+		require.Len(t, msg.Blocks, 2)
+		assert.Equal(t, "approve_plan", msg.Blocks[1].Elements[0].ActionID)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	err := NewClient("").PostWebhook(context.Background(), server.URL+"/services/T1/B1/secret", Message{
+		Text: "Plan ready",
+		Blocks: []Block{
+			Section("*Plan ready*"),
+			Actions("plan", Button("Approve", "approve_plan", "task-1", "primary")),
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestPostWebhook_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("no_service"))
+	}))
+	defer server.Close()
+
+	err := NewClient("").PostWebhook(context.Background(), server.URL, Message{Text: "hi"})
+	assert.ErrorContains(t, err, "no_service")
+}
+
+func TestPostMessage(t *testing.T) {
+	var channels []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		var msg Message
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		channels = append(channels, msg.Channel)
+		if msg.Channel == "#gone" {
+			// Failed calls are answered 200 too
+			_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL + "/api/")
+	require.NoError(t, client.PostMessage(context.Background(), "xoxb-token", Message{Channel: "#dev", Text: "hi"}))
+	err := client.PostMessage(context.Background(), "xoxb-token", Message{Channel: "#gone", Text: "hi"})
+	assert.ErrorContains(t, err, "channel_not_found")
+	assert.Equal(t, []string{"#dev", "#gone"}, channels)
+}
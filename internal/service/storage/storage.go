@@ -0,0 +1,59 @@
+// Package storage keeps the files uploaded as task attachments, on local
+// disk or in an S3(-compatible) bucket.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/config"
+)
+
+var (
+	// ErrNotFound is returned when no file is stored under a key
+	ErrNotFound = errors.New("stored file not found")
+	// ErrInvalidKey is returned for keys that are empty, absolute or leave
+	// the storage root
+	ErrInvalidKey = errors.New("invalid storage key")
+)
+
+// Storage stores files under slash-separated keys
+type Storage interface {
+	// Put stores the size bytes of body under key, replacing any file there
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the file under key; a missing file is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Presigner is implemented by storages that serve downloads themselves
+type Presigner interface {
+	// PresignGet returns a URL the file under key can be downloaded from
+	// until expiry has passed, as filename with contentType
+	PresignGet(key, filename, contentType string, expiry time.Duration) (string, error)
+}
+
+// New builds the storage cfg selects
+func New(cfg *config.AttachmentsConfig) (Storage, error) {
+	switch cfg.Storage {
+	case "", "local":
+		return NewLocalStorage(cfg.LocalDir)
+	case "s3":
+		return NewS3Storage(cfg.S3)
+	}
+	return nil, fmt.Errorf("unknown attachment storage %q, want local or s3", cfg.Storage)
+}
+
+// cleanKey checks that key stays within the storage root
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean(key)
+	if key == "" || path.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,81 @@
+// Package totp checks the time-based one-time passwords of RFC 6238 shown
+// by authenticator apps: 6 digits, HMAC-SHA1, a new code every 30 seconds.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is how long each code is valid
+	Period = 30 * time.Second
+	digits = 6
+	// skew is how many periods before and after the current one a code is
+	// still accepted from, for clocks that drift
+	skew = 1
+)
+
+// Validate reports whether code is the code of secret, a base32 key, at
+// time at or one period around it
+func Validate(secret, code string, at time.Time) (bool, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return false, err
+	}
+	code = strings.TrimSpace(code)
+	if len(code) != digits {
+		return false, nil
+	}
+
+	counter := at.Unix() / int64(Period/time.Second)
+	valid := false
+	for offset := int64(-skew); offset <= skew; offset++ {
+		// Compare every candidate so timing does not reveal which matched
+		if subtle.ConstantTimeCompare([]byte(code), []byte(generate(key, counter+offset))) == 1 {
+			valid = true
+		}
+	}
+	return valid, nil
+}
+
+// Generate returns the code of secret at time at
+func Generate(secret string, at time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return generate(key, at.Unix()/int64(Period/time.Second)), nil
+}
+
+func generate(key []byte, counter int64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, value%1_000_000)
+}
+
+// decodeSecret decodes a base32 secret the way authenticator apps accept
+// it: any case, with or without spaces and padding
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid TOTP secret: empty")
+	}
+	return key, nil
+}
@@ -0,0 +1,56 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SHA1 test vectors of RFC 6238, appendix B, truncated to 6 digits
+func TestGenerate_RFC6238(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for unix, want := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		code, err := Generate(secret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, "at %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret := "jbsw y3dp ehpk 3pxp"
+	now := time.Unix(1700000000, 0)
+	code, err := Generate(secret, now)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		code  string
+		at    time.Time
+		valid bool
+	}{
+		"current code":          {code, now, true},
+		"code of previous step": {code, now.Add(Period), true},
+		"expired code":          {code, now.Add(3 * Period), false},
+		"wrong code":            {"000000", now, code == "000000"},
+		"malformed code":        {"12345", now, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			valid, err := Validate(secret, tc.code, tc.at)
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, valid)
+		})
+	}
+
+	_, err = Validate("not base32!", code, now)
+	assert.Error(t, err)
+}
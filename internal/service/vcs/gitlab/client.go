@@ -0,0 +1,393 @@
+// Package gitlab is a minimal GitLab REST client opening and tracking merge
+// requests, the GitLab implementation of vcs.Service.
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+)
+
+const (
+	// DefaultBaseURL is the GitLab instance used when none is configured
+	DefaultBaseURL = "https://gitlab.com"
+	defaultTimeout = 30 * time.Second
+	diffsPageSize  = 100
+)
+
+// errNotFound is returned by doJSON for 404 responses
+var errNotFound = errors.New("gitlab resource not found")
+
+// Client talks to the REST API of a GitLab instance with a personal, group or
+// project access token
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+var _ vcs.Service = (*Client)(nil)
+
+// NewClient creates a client for the GitLab instance at baseURL, gitlab.com
+// when empty. A zero timeout uses the default of 30 seconds.
+func NewClient(baseURL, token string, timeout time.Duration) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/") + "/api/v4",
+		token:   token,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type apiUser struct {
+	Username string `json:"username"`
+}
+
+type apiMergeRequest struct {
+	IID            int        `json:"iid"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	State          string     `json:"state"`
+	SourceBranch   string     `json:"source_branch"`
+	TargetBranch   string     `json:"target_branch"`
+	WebURL         string     `json:"web_url"`
+	MergeCommitSHA *string    `json:"merge_commit_sha"`
+	MergedAt       *time.Time `json:"merged_at"`
+	ClosedAt       *time.Time `json:"closed_at"`
+	Draft          bool       `json:"draft"`
+	MergeStatus    string     `json:"detailed_merge_status"`
+	Author         *apiUser   `json:"author"`
+	MergedBy       *apiUser   `json:"merge_user"`
+	Assignees      []apiUser  `json:"assignees"`
+	Reviewers      []apiUser  `json:"reviewers"`
+	Labels         []string   `json:"labels"`
+	DiffRefs       *struct {
+		BaseSHA  string `json:"base_sha"`
+		HeadSHA  string `json:"head_sha"`
+		StartSHA string `json:"start_sha"`
+	} `json:"diff_refs"`
+}
+
+func projectPath(repo string) string {
+	return "/projects/" + url.PathEscape(repo)
+}
+
+func mergeRequestPath(repo string, iid int) string {
+	return fmt.Sprintf("%s/merge_requests/%d", projectPath(repo), iid)
+}
+
+// CreatePullRequest opens a merge request from head into base
+func (c *Client) CreatePullRequest(ctx context.Context, repo, base, head, title, body string) (*entity.PullRequest, error) {
+	payload := map[string]any{
+		"source_branch": head,
+		"target_branch": base,
+		"title":         title,
+		"description":   body,
+	}
+	var mr apiMergeRequest
+	if err := c.doJSON(ctx, http.MethodPost, projectPath(repo)+"/merge_requests", payload, &mr); err != nil {
+		return nil, fmt.Errorf("failed to create merge request: %w", err)
+	}
+	return toPullRequest(&mr, repo), nil
+}
+
+// GetPullRequest retrieves a merge request by IID
+func (c *Client) GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error) {
+	mr, err := c.getMergeRequest(ctx, repo, prNumber)
+	if err != nil {
+		return nil, err
+	}
+	return toPullRequest(mr, repo), nil
+}
+
+func (c *Client) getMergeRequest(ctx context.Context, repo string, iid int) (*apiMergeRequest, error) {
+	if iid <= 0 {
+		return nil, fmt.Errorf("invalid merge request IID: %d", iid)
+	}
+	var mr apiMergeRequest
+	if err := c.doJSON(ctx, http.MethodGet, mergeRequestPath(repo, iid), nil, &mr); err != nil {
+		return nil, fmt.Errorf("failed to get merge request: %w", err)
+	}
+	return &mr, nil
+}
+
+// UpdatePullRequest updates a merge request. It takes the keys GitHub pull
+// request updates use: title, body, state ("open" or "closed") and base.
+func (c *Client) UpdatePullRequest(ctx context.Context, repo string, prNumber int, updates map[string]interface{}) error {
+	if prNumber <= 0 {
+		return fmt.Errorf("invalid merge request IID: %d", prNumber)
+	}
+
+	payload := map[string]any{}
+	if title, ok := updates["title"].(string); ok {
+		payload["title"] = title
+	}
+	if body, ok := updates["body"].(string); ok {
+		payload["description"] = body
+	}
+	if state, ok := updates["state"].(string); ok {
+		switch state {
+		case "closed":
+			payload["state_event"] = "close"
+		case "open":
+			payload["state_event"] = "reopen"
+		default:
+			return fmt.Errorf("invalid merge request state: %s", state)
+		}
+	}
+	if base, ok := updates["base"].(string); ok {
+		payload["target_branch"] = base
+	}
+
+	if err := c.doJSON(ctx, http.MethodPut, mergeRequestPath(repo, prNumber), payload, nil); err != nil {
+		return fmt.Errorf("failed to update merge request: %w", err)
+	}
+	return nil
+}
+
+// CreateReview posts each comment as a discussion on its line of the merge
+// request's diff, then body as a note. GitLab has no single review call, so
+// comments posted before a failure are kept.
+func (c *Client) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []vcs.InlineReviewComment) error {
+	if len(comments) > 0 {
+		mr, err := c.getMergeRequest(ctx, repo, prNumber)
+		if err != nil {
+			return err
+		}
+		if mr.DiffRefs == nil {
+			return fmt.Errorf("merge request %d has no diff yet", prNumber)
+		}
+
+		for _, comment := range comments {
+			payload := map[string]any{
+				"body": comment.Body,
+				"position": map[string]any{
+					"position_type": "text",
+					"base_sha":      mr.DiffRefs.BaseSHA,
+					"head_sha":      mr.DiffRefs.HeadSHA,
+					"start_sha":     mr.DiffRefs.StartSHA,
+					"old_path":      comment.Path,
+					"new_path":      comment.Path,
+					"new_line":      comment.Line,
+				},
+			}
+			if err := c.doJSON(ctx, http.MethodPost, mergeRequestPath(repo, prNumber)+"/discussions", payload, nil); err != nil {
+				return fmt.Errorf("failed to comment on %s:%d: %w", comment.Path, comment.Line, err)
+			}
+		}
+	}
+
+	if _, err := c.CreateIssueComment(ctx, repo, prNumber, body); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateIssueComment adds a note to the merge request and returns its ID
+func (c *Client) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	if prNumber <= 0 {
+		return 0, fmt.Errorf("invalid merge request IID: %d", prNumber)
+	}
+	var note struct {
+		ID int64 `json:"id"`
+	}
+	if err := c.doJSON(ctx, http.MethodPost, mergeRequestPath(repo, prNumber)+"/notes", map[string]any{"body": body}, &note); err != nil {
+		return 0, fmt.Errorf("failed to create merge request note: %w", err)
+	}
+	return note.ID, nil
+}
+
+// UpdateIssueComment replaces the body of a note on the merge request
+func (c *Client) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	path := fmt.Sprintf("%s/notes/%d", mergeRequestPath(repo, prNumber), commentID)
+	if err := c.doJSON(ctx, http.MethodPut, path, map[string]any{"body": body}, nil); err != nil {
+		return fmt.Errorf("failed to update merge request note: %w", err)
+	}
+	return nil
+}
+
+// GetDefaultBranch returns the project's default branch
+func (c *Client) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	var project struct {
+		DefaultBranch string `json:"default_branch"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, projectPath(repo), nil, &project); err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+	return project.DefaultBranch, nil
+}
+
+// IsBranchProtected reports whether the branch is protected. A branch that
+// does not exist is not.
+func (c *Client) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	var result struct {
+		Protected bool `json:"protected"`
+	}
+	err := c.doJSON(ctx, http.MethodGet, projectPath(repo)+"/repository/branches/"+url.PathEscape(branch), nil, &result)
+	if errors.Is(err, errNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get branch: %w", err)
+	}
+	return result.Protected, nil
+}
+
+// HasOpenPullRequest reports whether a merge request from the branch is open
+func (c *Client) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	query := url.Values{"state": {"opened"}, "source_branch": {branch}, "per_page": {"1"}}
+	var mrs []apiMergeRequest
+	if err := c.doJSON(ctx, http.MethodGet, projectPath(repo)+"/merge_requests?"+query.Encode(), nil, &mrs); err != nil {
+		return false, fmt.Errorf("failed to list merge requests: %w", err)
+	}
+	return len(mrs) > 0, nil
+}
+
+// GetPullRequestDiff returns the merge request's changes as a unified diff
+func (c *Client) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	if prNumber <= 0 {
+		return "", fmt.Errorf("invalid merge request IID: %d", prNumber)
+	}
+
+	var diff strings.Builder
+	for page := 1; ; page++ {
+		query := url.Values{"page": {fmt.Sprint(page)}, "per_page": {fmt.Sprint(diffsPageSize)}}
+		var files []struct {
+			OldPath     string `json:"old_path"`
+			NewPath     string `json:"new_path"`
+			Diff        string `json:"diff"`
+			NewFile     bool   `json:"new_file"`
+			DeletedFile bool   `json:"deleted_file"`
+		}
+		if err := c.doJSON(ctx, http.MethodGet, mergeRequestPath(repo, prNumber)+"/diffs?"+query.Encode(), nil, &files); err != nil {
+			return "", fmt.Errorf("failed to get merge request diff: %w", err)
+		}
+
+		for _, file := range files {
+			oldPath, newPath := "a/"+file.OldPath, "b/"+file.NewPath
+			fmt.Fprintf(&diff, "diff --git %s %s\n", oldPath, newPath)
+			if file.NewFile {
+				oldPath = "/dev/null"
+			}
+			if file.DeletedFile {
+				newPath = "/dev/null"
+			}
+			fmt.Fprintf(&diff, "--- %s\n+++ %s\n", oldPath, newPath)
+			diff.WriteString(file.Diff)
+			if file.Diff != "" && !strings.HasSuffix(file.Diff, "\n") {
+				diff.WriteString("\n")
+			}
+		}
+
+		if len(files) < diffsPageSize {
+			return diff.String(), nil
+		}
+	}
+}
+
+func (c *Client) doJSON(ctx context.Context, method, path string, payload, out any) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to marshal gitlab payload: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	endpoint := c.baseURL + path
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to create gitlab request: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gitlab request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s %s", errNotFound, method, endpoint)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
+		return fmt.Errorf("gitlab API %s %s returned %d: %s", method, endpoint, resp.StatusCode, string(respBody))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode gitlab response: %w", err)
+	}
+	return nil
+}
+
+// toPullRequest converts a merge request into the pull request auto-devs
+// tracks, with its IID as the number
+func toPullRequest(mr *apiMergeRequest, repo string) *entity.PullRequest {
+	pr := &entity.PullRequest{
+		GitHubPRNumber: mr.IID,
+		Repository:     repo,
+		Title:          mr.Title,
+		Body:           mr.Description,
+		Status:         entity.PullRequestStatusOpen,
+		HeadBranch:     mr.SourceBranch,
+		BaseBranch:     mr.TargetBranch,
+		GitHubURL:      mr.WebURL,
+		MergeCommitSHA: mr.MergeCommitSHA,
+		MergedAt:       mr.MergedAt,
+		ClosedAt:       mr.ClosedAt,
+		IsDraft:        mr.Draft,
+		Labels:         mr.Labels,
+	}
+
+	switch mr.State {
+	case "merged":
+		pr.Status = entity.PullRequestStatusMerged
+	case "closed", "locked":
+		pr.Status = entity.PullRequestStatusClosed
+	}
+
+	if mr.MergeStatus != "" {
+		mergeable := mr.MergeStatus == "mergeable"
+		pr.Mergeable = &mergeable
+		pr.MergeableState = &mr.MergeStatus
+	}
+	if mr.Author != nil {
+		pr.CreatedBy = &mr.Author.Username
+	}
+	if mr.MergedBy != nil {
+		pr.MergedBy = &mr.MergedBy.Username
+	}
+	for _, assignee := range mr.Assignees {
+		pr.Assignees = append(pr.Assignees, assignee.Username)
+	}
+	for _, reviewer := range mr.Reviewers {
+		pr.Reviewers = append(pr.Reviewers, reviewer.Username)
+	}
+	return pr
+}
@@ -0,0 +1,200 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repo = "acme/platform/api"
+
+func TestCreatePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v4/projects/acme%2Fplatform%2Fapi/merge_requests", r.URL.EscapedPath())
+		assert.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"source_branch": "task/login", "target_branch": "main", "title": "Add login", "description": "Closes #1"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"iid":7,"title":"Add login","description":"Closes #1","state":"opened",
+			"source_branch":"task/login","target_branch":"main","web_url":"https://gitlab.com/acme/platform/api/-/merge_requests/7",
+			"author":{"username":"bot"},"detailed_merge_status":"mergeable"}`))
+	}))
+	defer server.Close()
+
+	pr, err := NewClient(server.URL, "token", 0).CreatePullRequest(context.Background(), repo, "main", "task/login", "Add login", "Closes #1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, pr.GitHubPRNumber)
+	assert.Equal(t, repo, pr.Repository)
+	assert.Equal(t, entity.PullRequestStatusOpen, pr.Status)
+	assert.Equal(t, "https://gitlab.com/acme/platform/api/-/merge_requests/7", pr.GitHubURL)
+	require.NotNil(t, pr.CreatedBy)
+	assert.Equal(t, "bot", *pr.CreatedBy)
+	require.NotNil(t, pr.Mergeable)
+	assert.True(t, *pr.Mergeable)
+}
+
+func TestGetPullRequest_Status(t *testing.T) {
+	for state, want := range map[string]entity.PullRequestStatus{
+		"opened": entity.PullRequestStatusOpen,
+		"merged": entity.PullRequestStatusMerged,
+		"closed": entity.PullRequestStatusClosed,
+		"locked": entity.PullRequestStatusClosed,
+	} {
+		t.Run(state, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v4/projects/acme%2Fplatform%2Fapi/merge_requests/7", r.URL.EscapedPath())
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"iid": 7, "state": state, "merge_commit_sha": "abc123", "merged_at": "2026-01-02T03:04:05Z",
+					"merge_user": map[string]string{"username": "ada"},
+				})
+			}))
+			defer server.Close()
+
+			pr, err := NewClient(server.URL, "token", 0).GetPullRequest(context.Background(), repo, 7)
+			require.NoError(t, err)
+			assert.Equal(t, want, pr.Status)
+			require.NotNil(t, pr.MergedBy)
+			assert.Equal(t, "ada", *pr.MergedBy)
+		})
+	}
+}
+
+func TestUpdatePullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"description": "Updated", "state_event": "close", "target_branch": "develop"}, body)
+		_, _ = w.Write([]byte(`{"iid":7}`))
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL, "token", 0).UpdatePullRequest(context.Background(), repo, 7, map[string]interface{}{
+		"body": "Updated", "state": "closed", "base": "develop",
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateReview(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/v4/projects/acme/platform/api/merge_requests/7":
+			_, _ = w.Write([]byte(`{"iid":7,"diff_refs":{"base_sha":"b","head_sha":"h","start_sha":"s"}}`))
+		case "/api/v4/projects/acme/platform/api/merge_requests/7/discussions":
+			var body struct {
+				Body     string         `json:"body"`
+				Position map[string]any `json:"position"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "Missing check", body.Body)
+			assert.Equal(t, "h", body.Position["head_sha"])
+			assert.Equal(t, "login.go", body.Position["new_path"])
+			assert.EqualValues(t, 12, body.Position["new_line"])
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":1}`))
+		}
+	}))
+	defer server.Close()
+
+	err := NewClient(server.URL, "token", 0).CreateReview(context.Background(), repo, 7, "Looks fine", []vcs.InlineReviewComment{
+		{Path: "login.go", Line: 12, Body: "Missing check"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /api/v4/projects/acme/platform/api/merge_requests/7",
+		"POST /api/v4/projects/acme/platform/api/merge_requests/7/discussions",
+		"POST /api/v4/projects/acme/platform/api/merge_requests/7/notes",
+	}, requests)
+}
+
+func TestNotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/api/v4/projects/acme/platform/api/merge_requests/7/notes", r.URL.Path)
+			_, _ = w.Write([]byte(`{"id":42}`))
+		case http.MethodPut:
+			assert.Equal(t, "/api/v4/projects/acme/platform/api/merge_requests/7/notes/42", r.URL.Path)
+			_, _ = w.Write([]byte(`{"id":42}`))
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "token", 0)
+
+	id, err := client.CreateIssueComment(context.Background(), repo, 7, "Quality gate")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), id)
+	require.NoError(t, client.UpdateIssueComment(context.Background(), repo, 7, id, "Quality gate passed"))
+}
+
+func TestBranches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/projects/acme/platform/api":
+			_, _ = w.Write([]byte(`{"default_branch":"trunk"}`))
+		case "/api/v4/projects/acme/platform/api/repository/branches/trunk":
+			_, _ = w.Write([]byte(`{"protected":true}`))
+		case "/api/v4/projects/acme/platform/api/merge_requests":
+			assert.Equal(t, "opened", r.URL.Query().Get("state"))
+			if r.URL.Query().Get("source_branch") == "task/login" {
+				_, _ = w.Write([]byte(`[{"iid":7}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(server.URL, "token", 0)
+	ctx := context.Background()
+
+	branch, err := client.GetDefaultBranch(ctx, repo)
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+
+	protected, err := client.IsBranchProtected(ctx, repo, "trunk")
+	require.NoError(t, err)
+	assert.True(t, protected)
+	protected, err = client.IsBranchProtected(ctx, repo, "missing")
+	require.NoError(t, err, "a missing branch is not protected")
+	assert.False(t, protected)
+
+	open, err := client.HasOpenPullRequest(ctx, repo, "task/login")
+	require.NoError(t, err)
+	assert.True(t, open)
+	open, err = client.HasOpenPullRequest(ctx, repo, "task/other")
+	require.NoError(t, err)
+	assert.False(t, open)
+}
+
+func TestGetPullRequestDiff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/acme/platform/api/merge_requests/7/diffs", r.URL.Path)
+		_, _ = w.Write([]byte(`[
+			{"old_path":"login.go","new_path":"login.go","new_file":true,"diff":"@@ -0,0 +1 @@\n+package main\n"},
+			{"old_path":"old.go","new_path":"old.go","deleted_file":true,"diff":"@@ -1 +0,0 @@\n-package main"}
+		]`))
+	}))
+	defer server.Close()
+
+	diff, err := NewClient(server.URL, "token", 0).GetPullRequestDiff(context.Background(), repo, 7)
+	require.NoError(t, err)
+	assert.Equal(t, "diff --git a/login.go b/login.go\n--- /dev/null\n+++ b/login.go\n@@ -0,0 +1 @@\n+package main\n"+
+		"diff --git a/old.go b/old.go\n--- a/old.go\n+++ /dev/null\n@@ -1 +0,0 @@\n-package main\n", diff)
+}
@@ -0,0 +1,149 @@
+package vcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ErrProviderNotConfigured is returned for calls on a project hosted on a
+// provider auto-devs has no service for
+var ErrProviderNotConfigured = errors.New("git provider is not configured")
+
+// ProjectLookup loads the project a call is scoped to
+type ProjectLookup interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Project, error)
+}
+
+// Router sends each call to the provider hosting the repository of the
+// project its context is scoped to, see WithProjectID. Calls outside a
+// project go to GitHub.
+type Router struct {
+	projects  ProjectLookup
+	providers map[entity.GitProvider]Service
+}
+
+// NewRouter creates a router over the given providers' services. A nil
+// service leaves its provider unconfigured.
+func NewRouter(projects ProjectLookup, github, gitlab Service) *Router {
+	providers := make(map[entity.GitProvider]Service)
+	if github != nil {
+		providers[entity.GitProviderGitHub] = github
+	}
+	if gitlab != nil {
+		providers[entity.GitProviderGitLab] = gitlab
+	}
+	return &Router{projects: projects, providers: providers}
+}
+
+// serviceFor returns the service of the provider of the project ctx is
+// scoped to
+func (r *Router) serviceFor(ctx context.Context) (Service, error) {
+	provider := entity.GitProviderGitHub
+	if projectID, ok := ProjectIDFromContext(ctx); ok && r.projects != nil {
+		project, err := r.projects.GetByID(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		provider = ProviderFor(project)
+	}
+
+	service, ok := r.providers[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotConfigured, provider)
+	}
+	return service, nil
+}
+
+// CreatePullRequest opens a pull request, a merge request on GitLab
+func (r *Router) CreatePullRequest(ctx context.Context, repo, base, head, title, body string) (*entity.PullRequest, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.CreatePullRequest(ctx, repo, base, head, title, body)
+}
+
+// UpdatePullRequest updates an existing pull request
+func (r *Router) UpdatePullRequest(ctx context.Context, repo string, prNumber int, updates map[string]interface{}) error {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.UpdatePullRequest(ctx, repo, prNumber, updates)
+}
+
+// GetPullRequest retrieves a pull request by number
+func (r *Router) GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return service.GetPullRequest(ctx, repo, prNumber)
+}
+
+// CreateReview adds a review that only comments on the pull request
+func (r *Router) CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.CreateReview(ctx, repo, prNumber, body, comments)
+}
+
+// CreateIssueComment adds a comment to the pull request's conversation
+func (r *Router) CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return service.CreateIssueComment(ctx, repo, prNumber, body)
+}
+
+// UpdateIssueComment replaces the body of a comment on the pull request
+func (r *Router) UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return err
+	}
+	return service.UpdateIssueComment(ctx, repo, prNumber, commentID, body)
+}
+
+// GetDefaultBranch returns the repository's default branch
+func (r *Router) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GetDefaultBranch(ctx, repo)
+}
+
+// IsBranchProtected reports whether the branch has protection rules
+func (r *Router) IsBranchProtected(ctx context.Context, repo, branch string) (bool, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return false, err
+	}
+	return service.IsBranchProtected(ctx, repo, branch)
+}
+
+// HasOpenPullRequest reports whether a pull request from the branch is open
+func (r *Router) HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return false, err
+	}
+	return service.HasOpenPullRequest(ctx, repo, branch)
+}
+
+// GetPullRequestDiff returns the pull request's changes as a unified diff
+func (r *Router) GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error) {
+	service, err := r.serviceFor(ctx)
+	if err != nil {
+		return "", err
+	}
+	return service.GetPullRequestDiff(ctx, repo, prNumber)
+}
@@ -0,0 +1,117 @@
+// Package vcs abstracts the git hosting providers auto-devs opens pull
+// requests on, GitHub and GitLab, where they are called merge requests.
+package vcs
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// Service is what auto-devs needs from a git hosting provider. Repositories
+// are given by their path, owner/repo on GitHub and the full namespace path
+// on GitLab, and pull requests by their number, the IID of a merge request.
+type Service interface {
+	CreatePullRequest(ctx context.Context, repo, base, head, title, body string) (*entity.PullRequest, error)
+	UpdatePullRequest(ctx context.Context, repo string, prNumber int, updates map[string]interface{}) error
+	GetPullRequest(ctx context.Context, repo string, prNumber int) (*entity.PullRequest, error)
+	// CreateReview adds a review that only comments, with body and the given
+	// comments on lines of the pull request's diff
+	CreateReview(ctx context.Context, repo string, prNumber int, body string, comments []InlineReviewComment) error
+	// CreateIssueComment adds a comment to the pull request's conversation
+	// and returns its ID
+	CreateIssueComment(ctx context.Context, repo string, prNumber int, body string) (int64, error)
+	// UpdateIssueComment replaces the body of a comment on the pull request
+	UpdateIssueComment(ctx context.Context, repo string, prNumber int, commentID int64, body string) error
+	// GetDefaultBranch returns the repository's default branch
+	GetDefaultBranch(ctx context.Context, repo string) (string, error)
+	// IsBranchProtected reports whether the branch has protection rules
+	IsBranchProtected(ctx context.Context, repo, branch string) (bool, error)
+	// HasOpenPullRequest reports whether a pull request from the branch is
+	// open
+	HasOpenPullRequest(ctx context.Context, repo, branch string) (bool, error)
+	// GetPullRequestDiff returns the pull request's changes as a unified diff
+	GetPullRequestDiff(ctx context.Context, repo string, prNumber int) (string, error)
+}
+
+// InlineReviewComment is a review comment on a line of a pull request's diff
+type InlineReviewComment struct {
+	Path string
+	// Line is the line in the new version of the file
+	Line int
+	Body string
+}
+
+type projectIDKey struct{}
+
+// WithProjectID scopes ctx to a project, so provider calls made with it go
+// to the project's provider with the project's token
+func WithProjectID(ctx context.Context, projectID uuid.UUID) context.Context {
+	return context.WithValue(ctx, projectIDKey{}, projectID)
+}
+
+// ProjectIDFromContext returns the project ctx is scoped to, if any
+func ProjectIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	projectID, ok := ctx.Value(projectIDKey{}).(uuid.UUID)
+	return projectID, ok && projectID != uuid.Nil
+}
+
+// ProviderFor returns the provider hosting the project's repository: the one
+// set on the project, or else GitLab for repositories on gitlab.com or a
+// gitlab.* host and GitHub for the rest
+func ProviderFor(project *entity.Project) entity.GitProvider {
+	if project.GitProvider.IsValid() {
+		return project.GitProvider
+	}
+	host, _ := splitRepositoryURL(project.RepositoryURL)
+	if host == "gitlab.com" || strings.HasPrefix(host, "gitlab.") {
+		return entity.GitProviderGitLab
+	}
+	return entity.GitProviderGitHub
+}
+
+// RepositoryFor returns the path of the project's repository as its provider
+// takes it, or "" when its URL has none
+func RepositoryFor(project *entity.Project) string {
+	_, path := splitRepositoryURL(project.RepositoryURL)
+	path = strings.TrimSuffix(path, ".git")
+	if ProviderFor(project) == entity.GitProviderGitLab {
+		// Pages of a GitLab project are under /-/, and its namespace may
+		// have subgroups
+		path, _, _ = strings.Cut(path+"/", "/-/")
+		path = strings.TrimSuffix(path, "/")
+		if !strings.Contains(path, "/") {
+			return ""
+		}
+		return path
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) >= 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0] + "/" + parts[1]
+	}
+	return ""
+}
+
+// splitRepositoryURL splits an HTTPS, SSH or scp-like git URL into its host
+// and path, both empty when it is none of these
+func splitRepositoryURL(repoURL string) (host, path string) {
+	repoURL = strings.TrimSpace(repoURL)
+	if user, rest, ok := strings.Cut(repoURL, "@"); ok && !strings.Contains(user, "/") && !strings.Contains(repoURL, "://") {
+		// git@host:owner/repo
+		host, path, ok = strings.Cut(rest, ":")
+		if !ok {
+			return "", ""
+		}
+		return strings.ToLower(host), strings.Trim(path, "/")
+	}
+
+	parsed, err := url.Parse(repoURL)
+	if err != nil || parsed.Host == "" {
+		return "", ""
+	}
+	return strings.ToLower(parsed.Hostname()), strings.Trim(parsed.Path, "/")
+}
@@ -0,0 +1,80 @@
+package vcs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryFor(t *testing.T) {
+	tests := []struct {
+		url      string
+		provider entity.GitProvider
+		want     string
+		wantHost entity.GitProvider
+	}{
+		{url: "https://github.com/acme/api.git", want: "acme/api", wantHost: entity.GitProviderGitHub},
+		{url: "git@github.com:acme/api.git", want: "acme/api", wantHost: entity.GitProviderGitHub},
+		{url: "https://github.com/acme/api/tree/main", want: "acme/api", wantHost: entity.GitProviderGitHub},
+		{url: "https://gitlab.com/acme/platform/api.git", want: "acme/platform/api", wantHost: entity.GitProviderGitLab},
+		{url: "git@gitlab.example.com:acme/api.git", want: "acme/api", wantHost: entity.GitProviderGitLab},
+		{url: "https://gitlab.com/acme/api/-/merge_requests/7", want: "acme/api", wantHost: entity.GitProviderGitLab},
+		{url: "https://git.acme.dev/team/sub/api.git", provider: entity.GitProviderGitLab, want: "team/sub/api", wantHost: entity.GitProviderGitLab},
+		{url: "invalid-url", want: "", wantHost: entity.GitProviderGitHub},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			project := &entity.Project{RepositoryURL: tt.url, GitProvider: tt.provider}
+			assert.Equal(t, tt.wantHost, ProviderFor(project))
+			assert.Equal(t, tt.want, RepositoryFor(project))
+		})
+	}
+}
+
+// branchService is a Service answering GetDefaultBranch with its name
+type branchService struct {
+	Service
+	name string
+}
+
+func (s branchService) GetDefaultBranch(ctx context.Context, repo string) (string, error) {
+	return s.name, nil
+}
+
+type projectLookup map[uuid.UUID]*entity.Project
+
+func (l projectLookup) GetByID(ctx context.Context, id uuid.UUID) (*entity.Project, error) {
+	if project, ok := l[id]; ok {
+		return project, nil
+	}
+	return nil, errors.New("project not found")
+}
+
+func TestRouter(t *testing.T) {
+	githubProject := &entity.Project{ID: uuid.New(), RepositoryURL: "https://github.com/acme/api"}
+	gitlabProject := &entity.Project{ID: uuid.New(), RepositoryURL: "https://gitlab.com/acme/api"}
+	projects := projectLookup{githubProject.ID: githubProject, gitlabProject.ID: gitlabProject}
+	ctx := context.Background()
+
+	router := NewRouter(projects, branchService{name: "github"}, branchService{name: "gitlab"})
+	for ctx, want := range map[context.Context]string{
+		ctx:                                  "github",
+		WithProjectID(ctx, githubProject.ID): "github",
+		WithProjectID(ctx, gitlabProject.ID): "gitlab",
+	} {
+		got, err := router.GetDefaultBranch(ctx, "acme/api")
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := router.GetDefaultBranch(WithProjectID(ctx, uuid.New()), "acme/api")
+	assert.Error(t, err, "an unknown project")
+
+	_, err = NewRouter(projects, branchService{name: "github"}, nil).GetDefaultBranch(WithProjectID(ctx, gitlabProject.ID), "acme/api")
+	assert.ErrorIs(t, err, ErrProviderNotConfigured)
+}
@@ -0,0 +1,85 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds a single verification command
+	DefaultTimeout = 15 * time.Minute
+	// maxOutputBytes is how much of a command's output is kept; the tail is
+	// kept since that is where test runners print their summary
+	maxOutputBytes = 64 * 1024
+)
+
+// Result is the outcome of a verification command
+type Result struct {
+	Command string
+	Output  string
+	// ExitCode is -1 when the command could not be started or timed out
+	ExitCode    int
+	Passed      bool
+	StartedAt   time.Time
+	CompletedAt time.Time
+}
+
+// Runner runs verification commands (tests and the like) in task worktrees
+type Runner struct {
+	timeout time.Duration
+}
+
+// NewRunner creates a runner whose commands are killed after timeout
+func NewRunner(timeout time.Duration) *Runner {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Runner{timeout: timeout}
+}
+
+// Run executes command with bash in worktreePath. A failing command is a
+// failed Result, not an error.
+func (r *Runner) Run(ctx context.Context, worktreePath, command string) *Result {
+	runCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(runCtx, "bash", "-c", command)
+	cmd.Dir = worktreePath
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("WORKTREE_PATH=%s", worktreePath),
+		"CI=true",
+	)
+	// Don't wait on pipes held open by processes the command left behind
+	cmd.WaitDelay = 10 * time.Second
+
+	result := &Result{Command: command, StartedAt: time.Now()}
+	output, err := cmd.CombinedOutput()
+	result.CompletedAt = time.Now()
+	result.Output = tail(string(output), maxOutputBytes)
+
+	var exitErr *exec.ExitError
+	switch {
+	case runCtx.Err() == context.DeadlineExceeded:
+		result.ExitCode = -1
+		result.Output += fmt.Sprintf("\n[auto-devs] command timed out after %s", r.timeout)
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+	case err != nil:
+		result.ExitCode = -1
+		result.Output += fmt.Sprintf("\n[auto-devs] failed to run command: %v", err)
+	}
+	result.Passed = err == nil && result.ExitCode == 0
+
+	return result
+}
+
+func tail(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return "[auto-devs] output truncated\n" + s[len(s)-limit:]
+}
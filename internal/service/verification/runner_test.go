@@ -0,0 +1,38 @@
+package verification
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunner_Run(t *testing.T) {
+	dir := t.TempDir()
+	runner := NewRunner(time.Minute)
+
+	result := runner.Run(context.Background(), dir, `echo "ok in $WORKTREE_PATH"`)
+	assert.True(t, result.Passed)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "ok in "+dir+"\n", result.Output)
+
+	result = runner.Run(context.Background(), dir, "echo FAIL >&2; exit 3")
+	assert.False(t, result.Passed)
+	assert.Equal(t, 3, result.ExitCode)
+	assert.Equal(t, "FAIL\n", result.Output, "stderr is captured with stdout")
+}
+
+func TestRunner_RunTimeout(t *testing.T) {
+	result := NewRunner(50*time.Millisecond).Run(context.Background(), t.TempDir(), "sleep 5")
+	assert.False(t, result.Passed)
+	assert.Equal(t, -1, result.ExitCode)
+	assert.Contains(t, result.Output, "timed out")
+}
+
+func TestTail(t *testing.T) {
+	assert.Equal(t, "short", tail("short", 10))
+	long := strings.Repeat("a", 10) + "summary"
+	assert.Equal(t, "[auto-devs] output truncated\nsummary", tail(long, 7))
+}
@@ -0,0 +1,164 @@
+// Package webhook posts project events to the URLs of outbound webhooks,
+// signed so receivers can tell they come from this server.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const requestTimeout = 10 * time.Second
+
+// ErrAddressNotAllowed is returned for receivers on loopback, private,
+// link-local or other internal addresses, such as the cloud metadata
+// service at 169.254.169.254, so webhooks cannot reach into the network
+// this server runs in
+var ErrAddressNotAllowed = errors.New("webhook receiver address is not allowed")
+
+// internalNetworks are the ranges besides the private ones that reach no
+// public host: "this network", which Linux routes to the local host, and
+// the carrier-grade NAT range
+var internalNetworks = []*net.IPNet{
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+}
+
+// Headers of a delivery
+const (
+	EventHeader     = "X-AutoDevs-Event"
+	DeliveryHeader  = "X-AutoDevs-Delivery"
+	SignatureHeader = "X-AutoDevs-Signature-256"
+)
+
+// Delivery is a JSON payload to post
+type Delivery struct {
+	ID      string
+	Event   string
+	URL     string
+	Secret  string
+	Payload []byte
+}
+
+// Response is what the receiver answered. Its body is not kept, so
+// webhooks cannot be used to read what a receiver serves.
+type Response struct {
+	StatusCode int
+}
+
+// Sender posts deliveries
+type Sender interface {
+	// Send posts the delivery. It returns an error when the receiver could
+	// not be reached or did not answer with a 2xx status, along with the
+	// response if there was one.
+	Send(ctx context.Context, delivery Delivery) (*Response, error)
+}
+
+type httpSender struct {
+	httpClient *http.Client
+}
+
+// NewSender creates a Sender posting over HTTP. It connects only to
+// allowed addresses, checked once the receiver's name is resolved, and
+// does not follow redirects.
+func NewSender() Sender {
+	return newSender(allowedIP)
+}
+
+func newSender(allowed func(net.IP) bool) *httpSender {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !allowed(ip) {
+				return fmt.Errorf("%w: %s", ErrAddressNotAllowed, host)
+			}
+			return nil
+		},
+	}
+	return &httpSender{httpClient: &http.Client{
+		Timeout: requestTimeout,
+		// No proxy, as the dialer would check the proxy's address instead
+		// of the receiver's
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: requestTimeout},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// CheckURL returns ErrAddressNotAllowed when the URL's host is localhost
+// or an address that is not allowed. Host names are checked when posting,
+// against the addresses they resolve to then.
+func CheckURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !allowedIP(ip) {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, host)
+	}
+	return nil
+}
+
+// allowedIP reports whether ip is a public unicast address
+func allowedIP(ip net.IP) bool {
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *httpSender) Send(ctx context.Context, delivery Delivery) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "auto-devs-webhook")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, delivery.Payload))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	response := &Response{StatusCode: resp.StatusCode}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return response, fmt.Errorf("webhook receiver answered %d", resp.StatusCode)
+	}
+	return response, nil
+}
+
+// Sign returns the signature header value of payload: the hex HMAC-SHA256
+// of payload keyed with secret, prefixed with "sha256=" as GitHub does
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
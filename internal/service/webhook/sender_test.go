@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	payload := []byte(`{"event":"plan_ready"}`)
+	var received *http.Request
+	var body []byte
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("thanks"))
+	}))
+	defer server.Close()
+
+	// The test server listens on the loopback address
+	sender := newSender(func(net.IP) bool { return true })
+	delivery := Delivery{ID: "delivery-1", Event: "plan_ready", URL: server.URL, Secret: "s3cret", Payload: payload}
+	response, err := sender.Send(context.Background(), delivery)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, payload, body)
+	assert.Equal(t, "plan_ready", received.Header.Get(EventHeader))
+	assert.Equal(t, "delivery-1", received.Header.Get(DeliveryHeader))
+	// echo -n '{"event":"plan_ready"}' | openssl dgst -sha256 -hmac s3cret
+	assert.Equal(t, "sha256=154114439cbc933d4e7c47f91cc0a75738346408f31891120d1810989af9ca92", received.Header.Get(SignatureHeader))
+
+	status = http.StatusBadGateway
+	response, err = sender.Send(context.Background(), delivery)
+	require.Error(t, err, "answers other than 2xx fail the delivery")
+	assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+
+	server.Close()
+	response, err = sender.Send(context.Background(), delivery)
+	assert.Error(t, err)
+	assert.Nil(t, response)
+}
+
+func TestSend_InternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err := NewSender().Send(context.Background(), Delivery{URL: server.URL})
+	assert.ErrorIs(t, err, ErrAddressNotAllowed, "the address is checked when connecting")
+
+	redirecting := httptest.NewServer(http.RedirectHandler(server.URL, http.StatusFound))
+	defer redirecting.Close()
+	response, err := newSender(func(net.IP) bool { return true }).Send(context.Background(), Delivery{URL: redirecting.URL})
+	require.Error(t, err, "redirects are not followed")
+	assert.Equal(t, http.StatusFound, response.StatusCode)
+}
+
+func TestCheckURL(t *testing.T) {
+	for _, rawURL := range []string{
+		"http://localhost:8080/hook",
+		"http://127.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.5/hook",
+		"http://192.168.1.10/hook",
+		"http://100.64.0.1/hook",
+		"http://[::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		assert.ErrorIs(t, CheckURL(rawURL), ErrAddressNotAllowed, rawURL)
+	}
+	assert.NoError(t, CheckURL("https://hooks.acme.com/auto-devs"))
+	assert.NoError(t, CheckURL("https://203.0.113.7/hook"))
+}
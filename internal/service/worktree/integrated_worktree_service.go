@@ -2,6 +2,7 @@ package worktree
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -14,10 +15,15 @@ import (
 	"github.com/auto-devs/auto-devs/internal/service/git"
 )
 
+// ErrWorktreeExists is returned when recreating a worktree that is still on
+// disk
+var ErrWorktreeExists = errors.New("worktree already exists")
+
 // IntegratedWorktreeService combines worktree and git operations
 type IntegratedWorktreeService struct {
 	worktreeManager *WorktreeManager
 	gitManager      *git.GitManager
+	pool            *Pool
 	logger          *slog.Logger
 }
 
@@ -46,11 +52,13 @@ func NewIntegratedWorktreeService(config *IntegratedConfig) (*IntegratedWorktree
 		return nil, fmt.Errorf("failed to initialize git manager: %w", err)
 	}
 
-	return &IntegratedWorktreeService{
+	service := &IntegratedWorktreeService{
 		worktreeManager: worktreeManager,
 		gitManager:      gitManager,
 		logger:          slog.Default().With("component", "integrated-worktree-service"),
-	}, nil
+	}
+	service.pool = NewPool(config.Worktree.PoolSize, worktreeManager, gitManager, service.executeInitScript)
+	return service, nil
 }
 
 // GenerateWorktreePath returns the deterministic worktree path for a task without
@@ -60,6 +68,12 @@ func (iws *IntegratedWorktreeService) GenerateWorktreePath(projectID, taskID str
 	return iws.worktreeManager.GenerateWorktreePath(projectID, taskID)
 }
 
+// GenerateVariantWorktreePath returns the worktree path of one side of an
+// executor comparison, kept apart from the task worktree
+func (iws *IntegratedWorktreeService) GenerateVariantWorktreePath(projectID, taskID, variant string) (string, error) {
+	return iws.worktreeManager.GenerateVariantWorktreePath(projectID, taskID, variant)
+}
+
 // CreateTaskWorktree creates a complete worktree setup for a task
 func (iws *IntegratedWorktreeService) CreateTaskWorktree(ctx context.Context, request *CreateTaskWorktreeRequest) (*TaskWorktreeInfo, error) {
 	iws.logger.Info("Creating task worktree",
@@ -92,20 +106,27 @@ func (iws *IntegratedWorktreeService) CreateTaskWorktree(ctx context.Context, re
 		return nil, fmt.Errorf("failed to generate branch name: %w", err)
 	}
 
-	// Create branch from main
-	if err := iws.gitManager.CreateWorktree(ctx, &git.CreateWorktreeRequest{
-		BaseWorkingDir:     request.ProjectWorkDir,
-		BaseBranchName:     request.ProjectMainBranch,
-		WorktreeWorkingDir: worktreePath,
-		WorktreeBranchName: branchName,
-		UseRemoteBranch:    request.UseRemoteBranch,
-	}); err != nil {
-		// Clean up worktree on error
-		iws.worktreeManager.CleanupWorktree(ctx, worktreePath)
-		return nil, fmt.Errorf("failed to create branch: %w", err)
+	// Create branch from main, from a warm worktree of the project's pool
+	// when there is one
+	pooled := iws.pool.Take(ctx, request, worktreePath, branchName)
+	if !pooled {
+		if err := iws.gitManager.CreateWorktree(ctx, &git.CreateWorktreeRequest{
+			BaseWorkingDir:     request.ProjectWorkDir,
+			BaseBranchName:     request.ProjectMainBranch,
+			WorktreeWorkingDir: worktreePath,
+			WorktreeBranchName: branchName,
+			UseRemoteBranch:    request.UseRemoteBranch,
+		}); err != nil {
+			// Clean up worktree on error
+			iws.worktreeManager.CleanupWorktree(ctx, worktreePath)
+			return nil, fmt.Errorf("failed to create branch: %w", err)
+		}
 	}
+	// Top the pool up for the next task
+	iws.pool.RefillAsync(*request)
 
-	// Execute init workspace script if provided
+	// Execute init workspace script if provided. On a pooled worktree it
+	// already ran, so it only has to catch up with the base branch.
 	if request.InitWorkspaceScript != "" {
 		if err := iws.executeInitScript(ctx, worktreePath, request.InitWorkspaceScript); err != nil {
 			iws.logger.Warn("Failed to execute init workspace script", "error", err)
@@ -138,11 +159,75 @@ func (iws *IntegratedWorktreeService) CreateTaskWorktree(ctx context.Context, re
 
 	iws.logger.Info("Task worktree created successfully",
 		"worktree_path", worktreePath,
-		"branch_name", branchName)
+		"branch_name", branchName,
+		"pooled", pooled)
 
 	return info, nil
 }
 
+// RecreateTaskWorktree checks the task branch out again at its worktree path
+// after the directory was removed. When the branch is gone too it is created
+// again from the base branch.
+func (iws *IntegratedWorktreeService) RecreateTaskWorktree(ctx context.Context, request *RecreateTaskWorktreeRequest) (*RecreatedTaskWorktree, error) {
+	iws.logger.Info("Recreating task worktree",
+		"worktree_path", request.WorktreePath,
+		"branch_name", request.BranchName,
+		"base_branch_name", request.BaseBranchName,
+		"project_work_dir", request.ProjectWorkDir)
+
+	if _, err := os.Stat(filepath.Join(request.WorktreePath, ".git")); err == nil {
+		return nil, ErrWorktreeExists
+	}
+	// Whatever is left of a broken worktree is in the way of the checkout
+	if err := os.RemoveAll(request.WorktreePath); err != nil {
+		return nil, fmt.Errorf("failed to clear worktree path: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(request.WorktreePath), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create worktree parent directory: %w", err)
+	}
+
+	// git still records the removed worktree, which keeps its branch from
+	// being checked out anywhere else
+	if err := iws.gitManager.PruneWorktrees(ctx, request.ProjectWorkDir); err != nil {
+		return nil, err
+	}
+
+	branchExists, err := iws.gitManager.BranchExists(ctx, request.ProjectWorkDir, request.BranchName)
+	if err != nil {
+		return nil, err
+	}
+	if branchExists {
+		err = iws.gitManager.AddWorktree(ctx, request.ProjectWorkDir, request.BranchName, request.WorktreePath)
+	} else {
+		err = iws.gitManager.CreateWorktree(ctx, &git.CreateWorktreeRequest{
+			BaseWorkingDir:     request.ProjectWorkDir,
+			BaseBranchName:     request.BaseBranchName,
+			WorktreeWorkingDir: request.WorktreePath,
+			WorktreeBranchName: request.BranchName,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if request.InitWorkspaceScript != "" {
+		if err := iws.executeInitScript(ctx, request.WorktreePath, request.InitWorkspaceScript); err != nil {
+			iws.logger.Warn("Failed to execute init workspace script", "error", err)
+		}
+	}
+
+	iws.logger.Info("Task worktree recreated",
+		"worktree_path", request.WorktreePath,
+		"branch_name", request.BranchName,
+		"from_base_branch", !branchExists)
+
+	return &RecreatedTaskWorktree{
+		WorktreePath:   request.WorktreePath,
+		BranchName:     request.BranchName,
+		FromBaseBranch: !branchExists,
+	}, nil
+}
+
 // CleanupTaskWorktree cleans up a complete task worktree
 func (iws *IntegratedWorktreeService) CleanupTaskWorktree(ctx context.Context, request *CleanupTaskWorktreeRequest) error {
 	iws.logger.Info("Cleaning up task worktree",
@@ -417,6 +502,25 @@ type CreateTaskWorktreeRequest struct {
 	UseRemoteBranch     bool   `json:"use_remote_branch"`
 }
 
+// RecreateTaskWorktreeRequest represents a request to recreate a removed task
+// worktree
+type RecreateTaskWorktreeRequest struct {
+	ProjectWorkDir      string `json:"project_work_dir"`
+	WorktreePath        string `json:"worktree_path"`
+	BranchName          string `json:"branch_name"`
+	BaseBranchName      string `json:"base_branch_name"`
+	InitWorkspaceScript string `json:"init_workspace_script"`
+}
+
+// RecreatedTaskWorktree describes a recreated task worktree
+type RecreatedTaskWorktree struct {
+	WorktreePath string `json:"worktree_path"`
+	BranchName   string `json:"branch_name"`
+	// FromBaseBranch is set when the task branch was gone and was created
+	// again from the base branch
+	FromBaseBranch bool `json:"from_base_branch"`
+}
+
 // CleanupTaskWorktreeRequest represents a request to cleanup a task worktree
 type CleanupTaskWorktreeRequest struct {
 	ProjectID string `json:"project_id"`
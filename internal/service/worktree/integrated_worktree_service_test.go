@@ -10,6 +10,8 @@ import (
 	"github.com/auto-devs/auto-devs/config"
 	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewIntegratedWorktreeService(t *testing.T) {
@@ -276,3 +278,45 @@ func TestListProjectWorktrees(t *testing.T) {
 		TaskID:    dummyTaskID2,
 	})
 }
+
+func TestRecreateTaskWorktree(t *testing.T) {
+	service := newPoolService(t, 0)
+	repo := initPoolRepo(t)
+	ctx := context.Background()
+
+	worktreePath := filepath.Join(t.TempDir(), "project-1", "task-1")
+	gitOutput(t, repo, "worktree", "add", "-q", "-b", "task/add-login", worktreePath)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "login.go"), []byte("package main\n"), 0o644))
+	gitOutput(t, worktreePath, "add", "login.go")
+	gitOutput(t, worktreePath, "commit", "-q", "-m", "Add login")
+
+	request := &RecreateTaskWorktreeRequest{
+		ProjectWorkDir:      repo,
+		WorktreePath:        worktreePath,
+		BranchName:          "task/add-login",
+		BaseBranchName:      "main",
+		InitWorkspaceScript: "touch .initialized",
+	}
+	_, err := service.RecreateTaskWorktree(ctx, request)
+	assert.ErrorIs(t, err, ErrWorktreeExists)
+
+	// Removed by hand, without telling git
+	require.NoError(t, os.RemoveAll(worktreePath))
+	recreated, err := service.RecreateTaskWorktree(ctx, request)
+	require.NoError(t, err)
+	assert.False(t, recreated.FromBaseBranch)
+	assert.Equal(t, "task/add-login", gitOutput(t, worktreePath, "rev-parse", "--abbrev-ref", "HEAD"))
+	assert.FileExists(t, filepath.Join(worktreePath, "login.go"), "the task branch commits are back")
+	assert.FileExists(t, filepath.Join(worktreePath, ".initialized"))
+
+	t.Run("branch deleted", func(t *testing.T) {
+		gitOutput(t, repo, "worktree", "remove", "--force", worktreePath)
+		gitOutput(t, repo, "branch", "-D", "task/add-login")
+
+		recreated, err := service.RecreateTaskWorktree(ctx, request)
+		require.NoError(t, err)
+		assert.True(t, recreated.FromBaseBranch)
+		assert.Equal(t, "task/add-login", gitOutput(t, worktreePath, "rev-parse", "--abbrev-ref", "HEAD"))
+		assert.Equal(t, gitOutput(t, repo, "rev-parse", "main"), gitOutput(t, worktreePath, "rev-parse", "HEAD"))
+	})
+}
@@ -0,0 +1,298 @@
+package worktree
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+)
+
+const (
+	// poolReadyPrefix names pooled worktrees that are ready to be taken
+	poolReadyPrefix = "ready-"
+	// poolWarmingPrefix names pooled worktrees still being checked out or
+	// initialized
+	poolWarmingPrefix = "warming-"
+	// poolWarmingTimeout is how long a worktree may stay warming before it
+	// is taken for a leftover of a crashed refill and removed
+	poolWarmingTimeout = time.Hour
+	// poolRefillTimeout bounds a background refill
+	poolRefillTimeout = 30 * time.Minute
+)
+
+// Pool keeps warm worktrees of each project's base branch, detached and with
+// the init workspace script already run, so a task worktree can be branched
+// from one instead of checked out from scratch.
+//
+// The pool lives on disk under the project's worktree directory. A warm
+// worktree is taken by moving it with git, so workers sharing the directory
+// never hand out the same one.
+type Pool struct {
+	size            int
+	worktreeManager *WorktreeManager
+	gitManager      *git.GitManager
+	initScript      func(ctx context.Context, worktreePath, script string) error
+	logger          *slog.Logger
+
+	mu        sync.Mutex
+	refilling map[string]bool
+}
+
+// NewPool creates a pool keeping size warm worktrees per project. A size of
+// 0 or less turns pooling off.
+func NewPool(size int, worktreeManager *WorktreeManager, gitManager *git.GitManager, initScript func(ctx context.Context, worktreePath, script string) error) *Pool {
+	return &Pool{
+		size:            size,
+		worktreeManager: worktreeManager,
+		gitManager:      gitManager,
+		initScript:      initScript,
+		logger:          slog.Default().With("component", "worktree-pool"),
+		refilling:       make(map[string]bool),
+	}
+}
+
+// Enabled reports whether the pool keeps any worktrees
+func (p *Pool) Enabled() bool {
+	return p != nil && p.size > 0
+}
+
+// Take moves a warm worktree of the project to worktreePath and creates
+// branchName there from the request's base branch. It returns false when
+// there is no warm worktree to take or it could not be used, leaving
+// worktreePath empty for a fresh checkout.
+func (p *Pool) Take(ctx context.Context, request *CreateTaskWorktreeRequest, worktreePath, branchName string) bool {
+	if !p.Enabled() {
+		return false
+	}
+	ready, err := p.list(request.ProjectID, poolReadyPrefix)
+	if err != nil {
+		p.logger.Warn("Failed to list pooled worktrees", "project_id", request.ProjectID, "error", err)
+		return false
+	}
+
+	for _, pooled := range ready {
+		// git worktree move needs the destination to be missing
+		if err := os.Remove(worktreePath); err != nil && !os.IsNotExist(err) {
+			p.logger.Warn("Failed to clear worktree path for pooled worktree", "path", worktreePath, "error", err)
+			return false
+		}
+		if err := p.gitManager.MoveWorktree(ctx, request.ProjectWorkDir, pooled, worktreePath); err != nil {
+			if _, statErr := os.Stat(pooled); os.IsNotExist(statErr) {
+				// Another worker took it first
+				continue
+			}
+			p.logger.Warn("Failed to take pooled worktree, removing it", "path", pooled, "error", err)
+			p.remove(ctx, request.ProjectWorkDir, pooled)
+			continue
+		}
+
+		if err := p.branch(ctx, request, worktreePath, branchName); err != nil {
+			p.logger.Warn("Failed to branch pooled worktree, falling back to a fresh checkout", "path", worktreePath, "error", err)
+			p.remove(ctx, request.ProjectWorkDir, worktreePath)
+			break
+		}
+		p.logger.Info("Took pooled worktree", "project_id", request.ProjectID, "path", worktreePath, "branch_name", branchName)
+		return true
+	}
+
+	// Leave the empty directory CreateTaskWorktree expects
+	if err := os.MkdirAll(worktreePath, 0o755); err != nil {
+		p.logger.Warn("Failed to recreate worktree directory", "path", worktreePath, "error", err)
+	}
+	return false
+}
+
+// branch switches a taken worktree to a new branch at the latest base branch
+func (p *Pool) branch(ctx context.Context, request *CreateTaskWorktreeRequest, worktreePath, branchName string) error {
+	startPoint := request.ProjectMainBranch
+	if request.UseRemoteBranch {
+		if err := p.gitManager.Fetch(ctx, request.ProjectWorkDir, "origin"); err != nil {
+			return err
+		}
+		startPoint = "origin/" + request.ProjectMainBranch
+	}
+	return p.gitManager.CheckoutNewBranch(ctx, worktreePath, branchName, startPoint)
+}
+
+// RefillAsync tops up the project's pool in the background. A refill
+// already running for the project is not repeated.
+func (p *Pool) RefillAsync(request CreateTaskWorktreeRequest) {
+	if !p.Enabled() {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), poolRefillTimeout)
+		defer cancel()
+		if err := p.Refill(ctx, &request); err != nil {
+			p.logger.Warn("Failed to refill worktree pool", "project_id", request.ProjectID, "error", err)
+		}
+	}()
+}
+
+// Refill checks out warm worktrees of the project's base branch until the
+// pool is full
+func (p *Pool) Refill(ctx context.Context, request *CreateTaskWorktreeRequest) error {
+	if !p.Enabled() {
+		return nil
+	}
+	p.mu.Lock()
+	if p.refilling[request.ProjectID] {
+		p.mu.Unlock()
+		return nil
+	}
+	p.refilling[request.ProjectID] = true
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.refilling, request.ProjectID)
+		p.mu.Unlock()
+	}()
+
+	poolPath, err := p.worktreeManager.GeneratePoolPath(request.ProjectID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(poolPath, 0o755); err != nil {
+		return fmt.Errorf("failed to create pool directory: %w", err)
+	}
+
+	count, err := p.count(ctx, request)
+	if err != nil {
+		return err
+	}
+	if count >= p.size {
+		return nil
+	}
+
+	ref := request.ProjectMainBranch
+	if request.UseRemoteBranch {
+		if err := p.gitManager.Fetch(ctx, request.ProjectWorkDir, "origin"); err != nil {
+			return err
+		}
+		ref = "origin/" + request.ProjectMainBranch
+	}
+	for ; count < p.size; count++ {
+		if err := p.warm(ctx, request, poolPath, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// warm adds one worktree of ref to the pool
+func (p *Pool) warm(ctx context.Context, request *CreateTaskWorktreeRequest, poolPath, ref string) error {
+	id := uuid.New().String()
+	warming := filepath.Join(poolPath, poolWarmingPrefix+id)
+	if err := p.gitManager.CreateDetachedWorktree(ctx, request.ProjectWorkDir, ref, warming); err != nil {
+		return err
+	}
+	if request.InitWorkspaceScript != "" {
+		if err := p.initScript(ctx, warming, request.InitWorkspaceScript); err != nil {
+			// As for task worktrees, a failing script does not make the
+			// worktree unusable
+			p.logger.Warn("Failed to execute init workspace script in pooled worktree", "path", warming, "error", err)
+		}
+	}
+
+	ready := filepath.Join(poolPath, poolReadyPrefix+id)
+	if err := p.gitManager.MoveWorktree(ctx, request.ProjectWorkDir, warming, ready); err != nil {
+		p.remove(ctx, request.ProjectWorkDir, warming)
+		return err
+	}
+	p.logger.Info("Added worktree to pool", "project_id", request.ProjectID, "path", ready, "ref", ref)
+	return nil
+}
+
+// count returns how many worktrees the project's pool holds or is warming,
+// removing warming ones left behind by a crashed refill
+func (p *Pool) count(ctx context.Context, request *CreateTaskWorktreeRequest) (int, error) {
+	ready, err := p.list(request.ProjectID, poolReadyPrefix)
+	if err != nil {
+		return 0, err
+	}
+	warming, err := p.list(request.ProjectID, poolWarmingPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	count := len(ready)
+	for _, path := range warming {
+		info, err := os.Stat(path)
+		if err == nil && time.Since(info.ModTime()) > poolWarmingTimeout {
+			p.logger.Warn("Removing stale pooled worktree", "path", path)
+			p.remove(ctx, request.ProjectWorkDir, path)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// Size returns how many worktrees of the project are ready to be taken
+func (p *Pool) Size(projectID string) int {
+	ready, err := p.list(projectID, poolReadyPrefix)
+	if err != nil {
+		return 0
+	}
+	return len(ready)
+}
+
+// list returns the paths of the project's pooled worktrees whose name starts
+// with prefix, oldest first
+func (p *Pool) list(projectID, prefix string) ([]string, error) {
+	poolPath, err := p.worktreeManager.GeneratePoolPath(projectID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(poolPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pool directory: %w", err)
+	}
+
+	type pooled struct {
+		path    string
+		modTime time.Time
+	}
+	var found []pooled
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		found = append(found, pooled{path: filepath.Join(poolPath, entry.Name()), modTime: info.ModTime()})
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].modTime.Before(found[j].modTime) })
+
+	paths := make([]string, len(found))
+	for i, f := range found {
+		paths[i] = f.path
+	}
+	return paths, nil
+}
+
+// remove deletes a pooled worktree from git and from disk
+func (p *Pool) remove(ctx context.Context, workingDir, path string) {
+	if err := p.gitManager.DeleteWorktree(ctx, &git.DeleteWorktreeRequest{
+		WorkingDir:   workingDir,
+		WorktreePath: path,
+	}); err != nil {
+		p.logger.Warn("Failed to delete pooled worktree", "path", path, "error", err)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		p.logger.Warn("Failed to remove pooled worktree directory", "path", path, "error", err)
+	}
+}
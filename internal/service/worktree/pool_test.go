@@ -0,0 +1,135 @@
+package worktree
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initPoolRepo creates a repository with one commit on main
+func initPoolRepo(t *testing.T) string {
+	repo := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test User"},
+		{"commit", "-q", "--allow-empty", "-m", "Initial commit"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repo}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return repo
+}
+
+func newPoolService(t *testing.T, poolSize int) *IntegratedWorktreeService {
+	service, err := NewIntegratedWorktreeService(&IntegratedConfig{
+		Worktree: &config.WorktreeConfig{
+			BaseDirectory: t.TempDir(),
+			MaxPathLength: 1024,
+			PoolSize:      poolSize,
+		},
+		Git: &git.ManagerConfig{},
+	})
+	require.NoError(t, err)
+	return service
+}
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func TestPool_CreateTaskWorktreeFromPool(t *testing.T) {
+	service := newPoolService(t, 1)
+	repo := initPoolRepo(t)
+	ctx := context.Background()
+	request := &CreateTaskWorktreeRequest{
+		ProjectID:           uuid.New().String(),
+		TaskID:              uuid.New().String(),
+		TaskTitle:           "Add login",
+		ProjectWorkDir:      repo,
+		ProjectMainBranch:   "main",
+		InitWorkspaceScript: "touch .initialized",
+	}
+
+	require.NoError(t, service.pool.Refill(ctx, request))
+	ready, err := service.pool.list(request.ProjectID, poolReadyPrefix)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+
+	// The base branch moved on since the pooled worktree was warmed
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "login.go"), []byte("package main\n"), 0o644))
+	gitOutput(t, repo, "add", "login.go")
+	gitOutput(t, repo, "commit", "-q", "-m", "Add login.go")
+
+	info, err := service.CreateTaskWorktree(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, info.BranchName, gitOutput(t, info.WorktreePath, "rev-parse", "--abbrev-ref", "HEAD"))
+	assert.FileExists(t, filepath.Join(info.WorktreePath, "login.go"), "the branch starts at the latest base branch")
+	assert.FileExists(t, filepath.Join(info.WorktreePath, ".initialized"))
+	assert.Contains(t, gitOutput(t, repo, "worktree", "list"), info.WorktreePath)
+	assert.NoDirExists(t, ready[0], "the pooled worktree was taken")
+
+	// The pool is topped up in the background
+	require.Eventually(t, func() bool {
+		return service.pool.Size(request.ProjectID) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Eventually(t, func() bool {
+		service.pool.mu.Lock()
+		defer service.pool.mu.Unlock()
+		return !service.pool.refilling[request.ProjectID]
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestPool_EmptyOrDisabled(t *testing.T) {
+	repo := initPoolRepo(t)
+	ctx := context.Background()
+
+	for _, size := range []int{0, 1} {
+		service := newPoolService(t, size)
+		request := &CreateTaskWorktreeRequest{
+			ProjectID:         uuid.New().String(),
+			TaskID:            uuid.New().String(),
+			ProjectWorkDir:    repo,
+			ProjectMainBranch: "main",
+		}
+		worktreePath, err := service.worktreeManager.CreateWorktree(ctx, request.ProjectID, request.TaskID)
+		require.NoError(t, err)
+
+		assert.False(t, service.pool.Take(ctx, request, worktreePath, "task/empty-pool"))
+		assert.DirExists(t, worktreePath, "the empty worktree directory is left for a fresh checkout")
+	}
+}
+
+func TestPool_RemovesStaleWarming(t *testing.T) {
+	service := newPoolService(t, 1)
+	repo := initPoolRepo(t)
+	ctx := context.Background()
+	request := &CreateTaskWorktreeRequest{
+		ProjectID:         uuid.New().String(),
+		ProjectWorkDir:    repo,
+		ProjectMainBranch: "main",
+	}
+
+	poolPath, err := service.worktreeManager.GeneratePoolPath(request.ProjectID)
+	require.NoError(t, err)
+	stale := filepath.Join(poolPath, poolWarmingPrefix+uuid.New().String())
+	require.NoError(t, os.MkdirAll(stale, 0o755))
+	old := time.Now().Add(-2 * poolWarmingTimeout)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	require.NoError(t, service.pool.Refill(ctx, request))
+	assert.NoDirExists(t, stale)
+	assert.Equal(t, 1, service.pool.Size(request.ProjectID))
+}
@@ -115,6 +115,31 @@ func (wm *WorktreeManager) GenerateWorktreePath(projectID string, taskID string)
 	return worktreePath, nil
 }
 
+// GeneratePoolPath returns the directory holding the project's pool of warm
+// worktrees, /worktrees/project-{id}/pool/
+func (wm *WorktreeManager) GeneratePoolPath(projectID string) (string, error) {
+	cleanProjectID := wm.cleanPathComponent(projectID)
+	if cleanProjectID == "" {
+		return "", fmt.Errorf("invalid project_id")
+	}
+
+	return filepath.Join(wm.config.BaseDirectory, fmt.Sprintf("project-%s", cleanProjectID), "pool"), nil
+}
+
+// GenerateVariantWorktreePath returns the path of one side of an executor
+// comparison for a task, /worktrees/project-{id}/variants/task-{id}-{variant}/
+func (wm *WorktreeManager) GenerateVariantWorktreePath(projectID, taskID, variant string) (string, error) {
+	cleanProjectID := wm.cleanPathComponent(projectID)
+	cleanTaskID := wm.cleanPathComponent(taskID)
+	cleanVariant := wm.cleanPathComponent(variant)
+	if cleanProjectID == "" || cleanTaskID == "" || cleanVariant == "" {
+		return "", fmt.Errorf("invalid project_id, task_id or variant")
+	}
+
+	return filepath.Join(wm.config.BaseDirectory, fmt.Sprintf("project-%s", cleanProjectID), "variants",
+		fmt.Sprintf("task-%s-%s", cleanTaskID, cleanVariant)), nil
+}
+
 // cleanPathComponent cleans and validates a path component
 func (wm *WorktreeManager) cleanPathComponent(component string) string {
 	// Remove leading/trailing whitespace
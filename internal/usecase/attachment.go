@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/google/uuid"
+)
+
+// anonymousUploader is recorded as the uploader of attachments uploaded
+// without an API key
+const anonymousUploader = "anonymous"
+
+// sniffLength is how much of an upload its media type is sniffed from
+const sniffLength = 512
+
+// mediaReferencePattern matches the URLs of uploaded images, as pasted in
+// task descriptions and comments
+var mediaReferencePattern = regexp.MustCompile(`/api/v[0-9]+/media/([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+var (
+	ErrTaskNotFound             = errors.New("task not found")
+	ErrAttachmentNotFound       = errors.New("attachment not found")
+	ErrAttachmentEmpty          = errors.New("attachment is empty")
+	ErrAttachmentTooLarge       = errors.New("attachment is larger than allowed")
+	ErrAttachmentTypeNotAllowed = errors.New("attachment type is not allowed")
+	ErrDownloadURLInvalid       = errors.New("download URL is invalid or has expired")
+)
+
+type AttachmentUsecase interface {
+	// Upload stores a file attached to a task
+	Upload(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error)
+	// ListByTaskID returns the attachments of a task, oldest first
+	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskAttachment, error)
+	// DownloadURL returns a URL the attachment can be downloaded from until
+	// the time returned
+	DownloadURL(ctx context.Context, attachment *entity.TaskAttachment) (string, time.Time, error)
+	// OpenSigned opens the attachment a download URL signed with expires
+	// and signature points at
+	OpenSigned(ctx context.Context, id uuid.UUID, expires int64, signature string) (*entity.TaskAttachment, io.ReadCloser, error)
+	// UploadMedia stores an image pasted into a task's description or
+	// comments, as an attachment of the task
+	UploadMedia(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error)
+	// UploadArtifact stores a file auto-devs generated for a task, such as
+	// its changes exported as a patch; its type is not restricted
+	UploadArtifact(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error)
+	// MediaURL returns the lasting URL an uploaded image is served from
+	MediaURL(attachment *entity.TaskAttachment) string
+	// OpenMedia opens an uploaded image; attachments that are not images
+	// are not found
+	OpenMedia(ctx context.Context, id uuid.UUID) (*entity.TaskAttachment, io.ReadCloser, error)
+	// ReferencedMedia returns the uploaded images whose URLs appear in texts,
+	// in order of first appearance
+	ReferencedMedia(ctx context.Context, texts []string) ([]*entity.TaskAttachment, error)
+}
+
+type UploadAttachmentRequest struct {
+	TaskID   uuid.UUID
+	Filename string
+	// Size is the length of Content, which is read no further
+	Size    int64
+	Content io.Reader
+	// UploadedBy defaults to anonymousUploader
+	UploadedBy string
+}
+
+// AttachmentOptions limit what may be uploaded and configure download URLs
+type AttachmentOptions struct {
+	MaxSize int64
+	// AllowedTypes are media types, or whole types such as image/*
+	AllowedTypes []string
+	// BaseURL is where this server is reached, for the download URLs of
+	// storages that do not presign their own
+	BaseURL    string
+	SigningKey []byte
+	URLExpiry  time.Duration
+}
+
+type attachmentUsecase struct {
+	projectAccess
+	attachmentRepo repository.TaskAttachmentRepository
+	taskRepo       repository.TaskRepository
+	storage        storage.Storage
+	options        AttachmentOptions
+	now            func() time.Time
+}
+
+func NewAttachmentUsecase(
+	attachmentRepo repository.TaskAttachmentRepository,
+	taskRepo repository.TaskRepository,
+	memberRepo repository.ProjectMemberRepository,
+	store storage.Storage,
+	options AttachmentOptions,
+) AttachmentUsecase {
+	return &attachmentUsecase{
+		projectAccess:  projectAccess{memberRepo: memberRepo},
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		storage:        store,
+		options:        options,
+		now:            time.Now,
+	}
+}
+
+func (u *attachmentUsecase) Upload(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error) {
+	return u.upload(ctx, req, u.typeAllowed)
+}
+
+func (u *attachmentUsecase) UploadMedia(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error) {
+	return u.upload(ctx, req, func(mimeType string) bool {
+		return isImage(mimeType) && u.typeAllowed(mimeType)
+	})
+}
+
+func (u *attachmentUsecase) UploadArtifact(ctx context.Context, req UploadAttachmentRequest) (*entity.TaskAttachment, error) {
+	return u.upload(ctx, req, func(string) bool {
+		return true
+	})
+}
+
+// upload stores a file of a type allowed accepts
+func (u *attachmentUsecase) upload(ctx context.Context, req UploadAttachmentRequest, allowed func(mimeType string) bool) (*entity.TaskAttachment, error) {
+	if err := u.authorizeTask(ctx, u.taskRepo, req.TaskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, err
+	}
+	if req.Size <= 0 {
+		return nil, ErrAttachmentEmpty
+	}
+	if req.Size > u.options.MaxSize {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d", ErrAttachmentTooLarge, req.Size, u.options.MaxSize)
+	}
+
+	// The media type is sniffed, as the one the client claims is not trusted
+	head := make([]byte, min(req.Size, sniffLength))
+	if _, err := io.ReadFull(req.Content, head); err != nil {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	mimeType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !allowed(mimeType) {
+		return nil, fmt.Errorf("%w: %s", ErrAttachmentTypeNotAllowed, mimeType)
+	}
+
+	uploadedBy := req.UploadedBy
+	if uploadedBy == "" {
+		uploadedBy = anonymousUploader
+	}
+	attachment := &entity.TaskAttachment{
+		ID:         uuid.New(),
+		TaskID:     req.TaskID,
+		Filename:   sanitizeFilename(req.Filename),
+		FileSize:   req.Size,
+		MimeType:   mimeType,
+		UploadedBy: uploadedBy,
+	}
+	attachment.FilePath = fmt.Sprintf("tasks/%s/%s", attachment.TaskID, attachment.ID)
+
+	content := io.MultiReader(bytes.NewReader(head), req.Content)
+	if err := u.storage.Put(ctx, attachment.FilePath, content, req.Size, mimeType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+	if err := u.attachmentRepo.Create(ctx, attachment); err != nil {
+		// Not to leave a file no attachment points at
+		_ = u.storage.Delete(context.WithoutCancel(ctx), attachment.FilePath)
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (u *attachmentUsecase) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskAttachment, error) {
+	if err := u.authorizeTask(ctx, u.taskRepo, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.attachmentRepo.ListByTaskID(ctx, taskID)
+}
+
+// DownloadURL presigns a URL of the storage when it can; otherwise the URL
+// points at this server and carries a signature of the attachment and its
+// expiry
+func (u *attachmentUsecase) DownloadURL(ctx context.Context, attachment *entity.TaskAttachment) (string, time.Time, error) {
+	expiresAt := u.now().Add(u.options.URLExpiry).Truncate(time.Second)
+
+	if presigner, ok := u.storage.(storage.Presigner); ok {
+		downloadURL, err := presigner.PresignGet(attachment.FilePath, attachment.Filename, attachment.MimeType, u.options.URLExpiry)
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("failed to presign download URL: %w", err)
+		}
+		return downloadURL, expiresAt, nil
+	}
+
+	expires := expiresAt.Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", u.sign(attachment.ID, expires))
+	downloadURL := fmt.Sprintf("%s/api/v1/attachments/%s/download?%s", strings.TrimSuffix(u.options.BaseURL, "/"), attachment.ID, query.Encode())
+	return downloadURL, expiresAt, nil
+}
+
+func (u *attachmentUsecase) OpenSigned(ctx context.Context, id uuid.UUID, expires int64, signature string) (*entity.TaskAttachment, io.ReadCloser, error) {
+	if u.now().Unix() > expires || !hmac.Equal([]byte(signature), []byte(u.sign(id, expires))) {
+		return nil, nil, ErrDownloadURLInvalid
+	}
+
+	attachment, err := u.attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrAttachmentNotFound, err)
+	}
+	return u.open(ctx, attachment)
+}
+
+func (u *attachmentUsecase) MediaURL(attachment *entity.TaskAttachment) string {
+	return fmt.Sprintf("%s/api/v1/media/%s", strings.TrimSuffix(u.options.BaseURL, "/"), attachment.ID)
+}
+
+func (u *attachmentUsecase) OpenMedia(ctx context.Context, id uuid.UUID) (*entity.TaskAttachment, io.ReadCloser, error) {
+	attachment, err := u.attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrAttachmentNotFound, err)
+	}
+	if !isImage(attachment.MimeType) {
+		return nil, nil, fmt.Errorf("%w: %s is not an image", ErrAttachmentNotFound, id)
+	}
+	return u.open(ctx, attachment)
+}
+
+// ReferencedMedia skips references to images that no longer exist, as a
+// text may outlive what it links to
+func (u *attachmentUsecase) ReferencedMedia(ctx context.Context, texts []string) ([]*entity.TaskAttachment, error) {
+	seen := make(map[uuid.UUID]bool)
+	var media []*entity.TaskAttachment
+	for _, text := range texts {
+		for _, match := range mediaReferencePattern.FindAllStringSubmatch(text, -1) {
+			id, err := uuid.Parse(match[1])
+			if err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			attachment, err := u.attachmentRepo.GetByID(ctx, id)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				continue
+			}
+			if isImage(attachment.MimeType) {
+				media = append(media, attachment)
+			}
+		}
+	}
+	return media, nil
+}
+
+// open opens the stored file of attachment
+func (u *attachmentUsecase) open(ctx context.Context, attachment *entity.TaskAttachment) (*entity.TaskAttachment, io.ReadCloser, error) {
+	content, err := u.storage.Open(ctx, attachment.FilePath)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, fmt.Errorf("%w: %v", ErrAttachmentNotFound, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return attachment, content, nil
+}
+
+// sign returns the signature of a download URL of the attachment valid
+// until expires
+func (u *attachmentUsecase) sign(id uuid.UUID, expires int64) string {
+	mac := hmac.New(sha256.New, u.options.SigningKey)
+	fmt.Fprintf(mac, "%s.%d", id, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (u *attachmentUsecase) typeAllowed(mimeType string) bool {
+	for _, allowed := range u.options.AllowedTypes {
+		if whole, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(mimeType, whole+"/") {
+				return true
+			}
+		} else if mimeType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func isImage(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "image/")
+}
+
+// sanitizeFilename keeps the base name of an uploaded file without control
+// characters, short enough for the database
+func sanitizeFilename(filename string) string {
+	filename = path.Base(strings.ReplaceAll(filename, `\`, "/"))
+	filename = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, filename)
+	filename = strings.TrimSpace(filename)
+	if filename == "" || filename == "." || filename == "/" {
+		return "attachment"
+	}
+	return truncateRunes(filename, 255)
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAttachmentTestUsecase(t *testing.T, taskID uuid.UUID) (*attachmentUsecase, *repository.TaskAttachmentRepositoryMock) {
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID}, nil).Maybe()
+	attachmentRepo := repository.NewTaskAttachmentRepositoryMock(t)
+
+	uc := NewAttachmentUsecase(attachmentRepo, taskRepo, nil, store, AttachmentOptions{
+		MaxSize:      64,
+		AllowedTypes: []string{"image/*", "text/plain"},
+		BaseURL:      "http://localhost:8098/",
+		SigningKey:   []byte("test-key"),
+		URLExpiry:    15 * time.Minute,
+	}).(*attachmentUsecase)
+	return uc, attachmentRepo
+}
+
+func TestAttachmentUsecase_Upload(t *testing.T) {
+	taskID := uuid.New()
+	uc, attachmentRepo := newAttachmentTestUsecase(t, taskID)
+	ctx := context.Background()
+	upload := func(filename, content string) (*entity.TaskAttachment, error) {
+		return uc.Upload(ctx, UploadAttachmentRequest{TaskID: taskID, Filename: filename, Size: int64(len(content)), Content: strings.NewReader(content), UploadedBy: "alice"})
+	}
+
+	attachmentRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	attachment, err := upload(`C:\Users\alice\notes.txt`, "steps to reproduce")
+	require.NoError(t, err)
+	assert.Equal(t, "notes.txt", attachment.Filename)
+	assert.Equal(t, "text/plain", attachment.MimeType)
+	assert.Equal(t, "alice", attachment.UploadedBy)
+	assert.Equal(t, "tasks/"+taskID.String()+"/"+attachment.ID.String(), attachment.FilePath)
+
+	content, err := uc.storage.Open(ctx, attachment.FilePath)
+	require.NoError(t, err)
+	stored, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "steps to reproduce", string(stored))
+
+	_, err = upload("empty.txt", "")
+	assert.ErrorIs(t, err, ErrAttachmentEmpty)
+	_, err = upload("big.txt", strings.Repeat("a", 65))
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+	_, err = upload("report.pdf", "%PDF-1.7 looks like a document")
+	assert.ErrorIs(t, err, ErrAttachmentTypeNotAllowed)
+
+	// The type is sniffed from the content, not taken from the name
+	attachmentRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	attachment, err = upload("notes.txt", "\x89PNG\r\n\x1a\n image")
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", attachment.MimeType)
+}
+
+func TestAttachmentUsecase_UploadArtifact(t *testing.T) {
+	taskID := uuid.New()
+	uc, attachmentRepo := newAttachmentTestUsecase(t, taskID)
+
+	attachmentRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	content := "%PDF-1.7 looks like a document"
+	artifact, err := uc.UploadArtifact(context.Background(), UploadAttachmentRequest{TaskID: taskID, Filename: "report.pdf", Size: int64(len(content)), Content: strings.NewReader(content)})
+	require.NoError(t, err, "artifacts are not restricted to the allowed types")
+	assert.Equal(t, "application/pdf", artifact.MimeType)
+
+	content = strings.Repeat("a", 65)
+	_, err = uc.UploadArtifact(context.Background(), UploadAttachmentRequest{TaskID: taskID, Filename: "big.patch", Size: int64(len(content)), Content: strings.NewReader(content)})
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+}
+
+func TestAttachmentUsecase_SignedDownload(t *testing.T) {
+	taskID := uuid.New()
+	uc, attachmentRepo := newAttachmentTestUsecase(t, taskID)
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	uc.now = func() time.Time { return now }
+
+	attachment := &entity.TaskAttachment{ID: uuid.New(), TaskID: taskID, Filename: "notes.txt", FilePath: "tasks/notes", FileSize: 5, MimeType: "text/plain"}
+	require.NoError(t, uc.storage.Put(ctx, attachment.FilePath, strings.NewReader("hello"), 5, "text/plain"))
+	attachmentRepo.EXPECT().GetByID(mock.Anything, attachment.ID).Return(attachment, nil)
+
+	downloadURL, expiresAt, err := uc.DownloadURL(ctx, attachment)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(15*time.Minute), expiresAt)
+	parsed, err := url.Parse(downloadURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/attachments/"+attachment.ID.String()+"/download", parsed.Path)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	signature := parsed.Query().Get("signature")
+
+	_, content, err := uc.OpenSigned(ctx, attachment.ID, expires, signature)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "hello", string(body))
+
+	_, _, err = uc.OpenSigned(ctx, attachment.ID, expires+60, signature)
+	assert.ErrorIs(t, err, ErrDownloadURLInvalid, "a later expiry is not signed")
+	_, _, err = uc.OpenSigned(ctx, uuid.New(), expires, signature)
+	assert.ErrorIs(t, err, ErrDownloadURLInvalid, "the signature is bound to the attachment")
+
+	now = now.Add(16 * time.Minute)
+	_, _, err = uc.OpenSigned(ctx, attachment.ID, expires, signature)
+	assert.ErrorIs(t, err, ErrDownloadURLInvalid, "expired")
+}
+
+func TestAttachmentUsecase_Media(t *testing.T) {
+	taskID := uuid.New()
+	uc, attachmentRepo := newAttachmentTestUsecase(t, taskID)
+	ctx := context.Background()
+	upload := func(filename, content string) (*entity.TaskAttachment, error) {
+		return uc.UploadMedia(ctx, UploadAttachmentRequest{TaskID: taskID, Filename: filename, Size: int64(len(content)), Content: strings.NewReader(content)})
+	}
+
+	_, err := upload("notes.txt", "steps to reproduce")
+	assert.ErrorIs(t, err, ErrAttachmentTypeNotAllowed, "an allowed attachment type that is not an image")
+
+	attachmentRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	image, err := upload("screenshot.png", "\x89PNG\r\n\x1a\n image")
+	require.NoError(t, err)
+	assert.Equal(t, anonymousUploader, image.UploadedBy)
+	mediaURL := uc.MediaURL(image)
+	assert.Equal(t, "http://localhost:8098/api/v1/media/"+image.ID.String(), mediaURL)
+
+	attachmentRepo.EXPECT().GetByID(mock.Anything, image.ID).Return(image, nil)
+	_, content, err := uc.OpenMedia(ctx, image.ID)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "\x89PNG\r\n\x1a\n image", string(body))
+
+	document := &entity.TaskAttachment{ID: uuid.New(), TaskID: taskID, FilePath: "tasks/notes", MimeType: "text/plain"}
+	attachmentRepo.EXPECT().GetByID(mock.Anything, document.ID).Return(document, nil)
+	_, _, err = uc.OpenMedia(ctx, document.ID)
+	assert.ErrorIs(t, err, ErrAttachmentNotFound, "only images are served as media")
+
+	deleted := uuid.New()
+	attachmentRepo.EXPECT().GetByID(mock.Anything, deleted).Return(nil, assert.AnError)
+	media, err := uc.ReferencedMedia(ctx, []string{
+		"Login fails:\n![screenshot.png](" + mediaURL + ")",
+		"See also /api/v2/media/" + document.ID.String() + " and /api/v1/media/" + deleted.String(),
+		"Same again: " + mediaURL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.TaskAttachment{image}, media)
+}
@@ -12,10 +12,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// RejectedRequestAuditEntity is the entity type of the audit logs of
+// requests refused by the access policy
+const RejectedRequestAuditEntity = "request"
+
 type AuditUsecase interface {
 	LogProjectOperation(ctx context.Context, action entity.AuditAction, projectID uuid.UUID, oldProject, newProject *entity.Project, description string) error
 	LogTaskOperation(ctx context.Context, action entity.AuditAction, taskID uuid.UUID, oldTask, newTask *entity.Task, description string) error
 	GetAuditLogs(ctx context.Context, entityType string, entityID *uuid.UUID, limit int) ([]*entity.AuditLog, error)
+	// LogRejectedRequest records a request refused by the access policy
+	LogRejectedRequest(ctx context.Context, clientIP, userAgent, description string) error
 }
 
 type auditUsecase struct {
@@ -36,6 +42,20 @@ func (s *auditUsecase) LogTaskOperation(ctx context.Context, action entity.Audit
 	return s.logOperation(ctx, "task", taskID, action, oldTask, newTask, description)
 }
 
+func (s *auditUsecase) LogRejectedRequest(ctx context.Context, clientIP, userAgent, description string) error {
+	// Rejected requests are about no entity, so the nil ID is recorded
+	return s.auditRepo.Create(ctx, &entity.AuditLog{
+		ID:          uuid.New(),
+		EntityType:  RejectedRequestAuditEntity,
+		EntityID:    uuid.Nil,
+		Action:      entity.AuditActionReject,
+		IPAddress:   clientIP,
+		UserAgent:   truncateRunes(userAgent, 500),
+		Description: truncateRunes(description, 500),
+		CreatedAt:   time.Now(),
+	})
+}
+
 func (s *auditUsecase) logOperation(ctx context.Context, entityType string, entityID uuid.UUID, action entity.AuditAction, oldEntity, newEntity interface{}, description string) error {
 	auditLog := &entity.AuditLog{
 		ID:          uuid.New(),
@@ -148,6 +148,54 @@ func (_c *AuditUsecaseMock_LogProjectOperation_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// LogRejectedRequest provides a mock function for the type AuditUsecaseMock
+func (_mock *AuditUsecaseMock) LogRejectedRequest(ctx context.Context, clientIP string, userAgent string, description string) error {
+	ret := _mock.Called(ctx, clientIP, userAgent, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogRejectedRequest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, clientIP, userAgent, description)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuditUsecaseMock_LogRejectedRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogRejectedRequest'
+type AuditUsecaseMock_LogRejectedRequest_Call struct {
+	*mock.Call
+}
+
+// LogRejectedRequest is a helper method to define mock.On call
+//   - ctx
+//   - clientIP
+//   - userAgent
+//   - description
+func (_e *AuditUsecaseMock_Expecter) LogRejectedRequest(ctx interface{}, clientIP interface{}, userAgent interface{}, description interface{}) *AuditUsecaseMock_LogRejectedRequest_Call {
+	return &AuditUsecaseMock_LogRejectedRequest_Call{Call: _e.mock.On("LogRejectedRequest", ctx, clientIP, userAgent, description)}
+}
+
+func (_c *AuditUsecaseMock_LogRejectedRequest_Call) Run(run func(ctx context.Context, clientIP string, userAgent string, description string)) *AuditUsecaseMock_LogRejectedRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *AuditUsecaseMock_LogRejectedRequest_Call) Return(err error) *AuditUsecaseMock_LogRejectedRequest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuditUsecaseMock_LogRejectedRequest_Call) RunAndReturn(run func(ctx context.Context, clientIP string, userAgent string, description string) error) *AuditUsecaseMock_LogRejectedRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogTaskOperation provides a mock function for the type AuditUsecaseMock
 func (_mock *AuditUsecaseMock) LogTaskOperation(ctx context.Context, action entity.AuditAction, taskID uuid.UUID, oldTask *entity.Task, newTask *entity.Task, description string) error {
 	ret := _mock.Called(ctx, action, taskID, oldTask, newTask, description)
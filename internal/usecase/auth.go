@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserAPIKeyPrefix starts the API keys users create, telling them apart
+// from tokens and the static API keys
+const UserAPIKeyPrefix = "adk_"
+
+const (
+	minPasswordLength = 8
+	// maxPasswordLength is the most bcrypt hashes
+	maxPasswordLength = 72
+)
+
+var (
+	ErrRegistrationDisabled = errors.New("registration is disabled")
+	ErrUsernameTaken        = errors.New("username is already taken")
+	ErrPasswordInvalid      = errors.New("password must be 8 to 72 bytes")
+	ErrEmailInvalid         = errors.New("email address is invalid")
+	ErrInvalidCredentials   = errors.New("invalid username or password")
+	ErrInvalidToken         = errors.New("token or API key is invalid or expired")
+	ErrAPIKeyNameInvalid    = errors.New("API key name must be 1 to 255 characters")
+	ErrAPIKeyNotFound       = errors.New("API key not found")
+)
+
+type AuthUsecase interface {
+	// Register creates a user account
+	Register(ctx context.Context, req RegisterUserRequest) (*entity.User, error)
+	// Login checks the user's password and returns a token authenticating
+	// them until it expires
+	Login(ctx context.Context, username, password string) (*entity.User, *AuthToken, error)
+	// Authenticate returns the user a token from Login or one of their API
+	// keys authenticates, or ErrInvalidToken
+	Authenticate(ctx context.Context, credential string) (*entity.User, error)
+	// CreateAPIKey creates an API key for the user, returned along with the
+	// key itself, which is not kept
+	CreateAPIKey(ctx context.Context, userID uuid.UUID, name string) (*entity.UserAPIKey, string, error)
+	ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.UserAPIKey, error)
+	// DeleteAPIKey revokes one of the user's API keys
+	DeleteAPIKey(ctx context.Context, userID, keyID uuid.UUID) error
+}
+
+type RegisterUserRequest struct {
+	Username string
+	Email    string
+	Password string
+}
+
+// AuthToken is a signed JWT authenticating a user
+type AuthToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type authUsecase struct {
+	userRepo            repository.UserRepository
+	secret              []byte
+	tokenTTL            time.Duration
+	registrationEnabled bool
+	now                 func() time.Time
+}
+
+// NewAuthUsecase creates an auth usecase signing tokens valid for tokenTTL
+// with secret
+func NewAuthUsecase(userRepo repository.UserRepository, secret []byte, tokenTTL time.Duration, registrationEnabled bool) AuthUsecase {
+	return &authUsecase{
+		userRepo:            userRepo,
+		secret:              secret,
+		tokenTTL:            tokenTTL,
+		registrationEnabled: registrationEnabled,
+		now:                 time.Now,
+	}
+}
+
+func (u *authUsecase) Register(ctx context.Context, req RegisterUserRequest) (*entity.User, error) {
+	if !u.registrationEnabled {
+		return nil, ErrRegistrationDisabled
+	}
+	username := strings.TrimSpace(req.Username)
+	if err := validateUsername(username); err != nil {
+		return nil, err
+	}
+	if len(req.Password) < minPasswordLength || len(req.Password) > maxPasswordLength {
+		return nil, ErrPasswordInvalid
+	}
+	var email *string
+	if address := strings.TrimSpace(req.Email); address != "" {
+		if _, err := mail.ParseAddress(address); err != nil {
+			return nil, ErrEmailInvalid
+		}
+		email = &address
+	}
+
+	existing, err := u.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check username: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrUsernameTaken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	user := &entity.User{
+		ID:           uuid.New(),
+		Username:     username,
+		Email:        email,
+		PasswordHash: string(hash),
+	}
+	if err := u.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+func (u *authUsecase) Login(ctx context.Context, username, password string) (*entity.User, *AuthToken, error) {
+	user, err := u.userRepo.GetByUsername(ctx, strings.TrimSpace(username))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return nil, nil, ErrInvalidCredentials
+	}
+
+	now := u.now()
+	expiresAt := now.Add(u.tokenTTL)
+	token, err := signToken(tokenClaims{
+		Subject:   user.ID.String(),
+		Username:  user.Username,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	}, u.secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+	return user, &AuthToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+func (u *authUsecase) Authenticate(ctx context.Context, credential string) (*entity.User, error) {
+	if strings.HasPrefix(credential, UserAPIKeyPrefix) {
+		return u.authenticateAPIKey(ctx, credential)
+	}
+
+	claims, err := parseToken(credential, u.secret, u.now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	// Tokens of deleted users no longer authenticate
+	user, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return user, nil
+}
+
+// authenticateAPIKey returns the user owning the API key
+func (u *authUsecase) authenticateAPIKey(ctx context.Context, key string) (*entity.User, error) {
+	apiKey, err := u.userRepo.GetAPIKeyByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	if apiKey == nil {
+		return nil, ErrInvalidToken
+	}
+	user, err := u.userRepo.GetByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if err := u.userRepo.TouchAPIKey(ctx, apiKey.ID, u.now()); err != nil {
+		slog.Warn("Failed to record API key use", "api_key_id", apiKey.ID, "error", err)
+	}
+	return user, nil
+}
+
+func (u *authUsecase) CreateAPIKey(ctx context.Context, userID uuid.UUID, name string) (*entity.UserAPIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 255 {
+		return nil, "", ErrAPIKeyNameInvalid
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key := UserAPIKeyPrefix + hex.EncodeToString(secret)
+
+	apiKey := &entity.UserAPIKey{
+		ID:      uuid.New(),
+		UserID:  userID,
+		Name:    name,
+		Prefix:  key[:len(UserAPIKeyPrefix)+8],
+		KeyHash: hashAPIKey(key),
+	}
+	if err := u.userRepo.CreateAPIKey(ctx, apiKey); err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+	return apiKey, key, nil
+}
+
+func (u *authUsecase) ListAPIKeys(ctx context.Context, userID uuid.UUID) ([]*entity.UserAPIKey, error) {
+	return u.userRepo.ListAPIKeys(ctx, userID)
+}
+
+func (u *authUsecase) DeleteAPIKey(ctx context.Context, userID, keyID uuid.UUID) error {
+	if err := u.userRepo.DeleteAPIKey(ctx, userID, keyID); err != nil {
+		return fmt.Errorf("%w: %v", ErrAPIKeyNotFound, err)
+	}
+	return nil
+}
+
+// hashAPIKey returns the hash API keys are looked up by. Keys are random,
+// so an unsalted hash is enough.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// recordedActor returns who a change is recorded as done by: the user
+// authenticated for the request, or else the name the request gives
+func recordedActor(ctx context.Context, claimed *string) *string {
+	if actor := repository.ActorFromContext(ctx); actor != "" {
+		return &actor
+	}
+	return claimed
+}
+
+// recordedActorName is recordedActor for names that are always recorded
+func recordedActorName(ctx context.Context, claimed string) string {
+	if actor := repository.ActorFromContext(ctx); actor != "" {
+		return actor
+	}
+	return claimed
+}
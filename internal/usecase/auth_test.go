@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newAuthTestUsecase(t *testing.T) (*authUsecase, *repository.UserRepositoryMock) {
+	userRepo := repository.NewUserRepositoryMock(t)
+	uc := NewAuthUsecase(userRepo, []byte("test-secret"), time.Hour, true).(*authUsecase)
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return uc, userRepo
+}
+
+func TestAuthUsecase_Register(t *testing.T) {
+	uc, userRepo := newAuthTestUsecase(t)
+	ctx := context.Background()
+
+	userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(nil, nil).Once()
+	userRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+	user, err := uc.Register(ctx, RegisterUserRequest{Username: " alice ", Email: "alice@example.com", Password: "correct-horse"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+	require.NotNil(t, user.Email)
+	assert.Equal(t, "alice@example.com", *user.Email)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte("correct-horse")), "only the password's hash is kept")
+
+	userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(user, nil).Once()
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "alice", Password: "correct-horse"})
+	assert.ErrorIs(t, err, ErrUsernameTaken)
+
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "bob", Password: "short"})
+	assert.ErrorIs(t, err, ErrPasswordInvalid)
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "bob", Password: strings.Repeat("a", 73)})
+	assert.ErrorIs(t, err, ErrPasswordInvalid)
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "bob", Email: "not an address", Password: "correct-horse"})
+	assert.ErrorIs(t, err, ErrEmailInvalid)
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "a/b", Password: "correct-horse"})
+	assert.ErrorIs(t, err, ErrUsernameInvalid)
+
+	uc.registrationEnabled = false
+	_, err = uc.Register(ctx, RegisterUserRequest{Username: "bob", Password: "correct-horse"})
+	assert.ErrorIs(t, err, ErrRegistrationDisabled)
+}
+
+func TestAuthUsecase_LoginAndAuthenticate(t *testing.T) {
+	uc, userRepo := newAuthTestUsecase(t)
+	ctx := context.Background()
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct-horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	alice := &entity.User{ID: uuid.New(), Username: "alice", PasswordHash: string(hash)}
+	userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(alice, nil)
+	userRepo.EXPECT().GetByUsername(mock.Anything, "bob").Return(nil, nil)
+
+	_, _, err = uc.Login(ctx, "alice", "wrong-horse")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, _, err = uc.Login(ctx, "bob", "correct-horse")
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "unknown users fail like wrong passwords")
+
+	_, token, err := uc.Login(ctx, "alice", "correct-horse")
+	require.NoError(t, err)
+	assert.Equal(t, uc.now().Add(time.Hour), token.ExpiresAt)
+
+	userRepo.EXPECT().GetByID(mock.Anything, alice.ID).Return(alice, nil).Once()
+	user, err := uc.Authenticate(ctx, token.Token)
+	require.NoError(t, err)
+	assert.Same(t, alice, user)
+
+	_, err = uc.Authenticate(ctx, token.Token[:len(token.Token)-2]+"xx")
+	assert.ErrorIs(t, err, ErrInvalidToken, "tampered tokens are rejected")
+	_, err = uc.Authenticate(ctx, "not-a-token")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	other := NewAuthUsecase(userRepo, []byte("other-secret"), time.Hour, true)
+	_, err = other.Authenticate(ctx, token.Token)
+	assert.ErrorIs(t, err, ErrInvalidToken, "tokens signed with another secret are rejected")
+
+	uc.now = func() time.Time { return time.Unix(1700000000, 0).Add(time.Hour) }
+	_, err = uc.Authenticate(ctx, token.Token)
+	assert.ErrorIs(t, err, ErrInvalidToken, "expired tokens are rejected")
+
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+	userRepo.EXPECT().GetByID(mock.Anything, alice.ID).Return(nil, errors.New("user not found")).Once()
+	_, err = uc.Authenticate(ctx, token.Token)
+	assert.ErrorIs(t, err, ErrInvalidToken, "tokens of deleted users are rejected")
+}
+
+func TestAuthUsecase_APIKeys(t *testing.T) {
+	uc, userRepo := newAuthTestUsecase(t)
+	ctx := context.Background()
+	alice := &entity.User{ID: uuid.New(), Username: "alice"}
+
+	var stored *entity.UserAPIKey
+	userRepo.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, apiKey *entity.UserAPIKey) error {
+		stored = apiKey
+		return nil
+	}).Once()
+	apiKey, key, err := uc.CreateAPIKey(ctx, alice.ID, " CI ")
+	require.NoError(t, err)
+	assert.Equal(t, "CI", apiKey.Name)
+	assert.True(t, strings.HasPrefix(key, UserAPIKeyPrefix))
+	assert.True(t, strings.HasPrefix(key, apiKey.Prefix))
+	assert.NotContains(t, stored.KeyHash, key[len(UserAPIKeyPrefix):], "only the key's hash is kept")
+
+	userRepo.EXPECT().GetAPIKeyByHash(mock.Anything, stored.KeyHash).Return(stored, nil).Once()
+	userRepo.EXPECT().GetByID(mock.Anything, alice.ID).Return(alice, nil).Once()
+	userRepo.EXPECT().TouchAPIKey(mock.Anything, stored.ID, uc.now()).Return(nil).Once()
+	user, err := uc.Authenticate(ctx, key)
+	require.NoError(t, err)
+	assert.Same(t, alice, user)
+
+	userRepo.EXPECT().GetAPIKeyByHash(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	_, err = uc.Authenticate(ctx, UserAPIKeyPrefix+"revoked")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, _, err = uc.CreateAPIKey(ctx, alice.ID, "  ")
+	assert.ErrorIs(t, err, ErrAPIKeyNameInvalid)
+
+	userRepo.EXPECT().DeleteAPIKey(mock.Anything, alice.ID, stored.ID).Return(errors.New("API key not found")).Once()
+	assert.ErrorIs(t, uc.DeleteAPIKey(ctx, alice.ID, stored.ID), ErrAPIKeyNotFound)
+}
+
+func TestRecordedActor(t *testing.T) {
+	claimed := "mallory"
+	ctx := context.Background()
+	assert.Equal(t, &claimed, recordedActor(ctx, &claimed), "unauthenticated requests keep the name they give")
+	assert.Nil(t, recordedActor(ctx, nil))
+
+	ctx = repository.WithActor(ctx, "alice")
+	assert.Equal(t, "alice", *recordedActor(ctx, &claimed), "the authenticated user overrides the name given")
+	assert.Equal(t, "alice", recordedActorName(ctx, claimed))
+}
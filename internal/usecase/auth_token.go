@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// tokenHeader is the header of the tokens signed, HS256 being the only
+// algorithm accepted
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+var errTokenMalformed = errors.New("token is malformed")
+
+// tokenClaims are the claims of the JWTs users sign in for
+type tokenClaims struct {
+	Subject   string `json:"sub"`
+	Username  string `json:"name"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// signToken returns the JWT of the claims, signed with HS256
+func signToken(claims tokenClaims, secret []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + tokenSignature(unsigned, secret), nil
+}
+
+// parseToken returns the claims of a JWT signed by signToken with the
+// secret, failing for tokens otherwise signed or expired at now
+func parseToken(token string, secret []byte, now time.Time) (*tokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, errTokenMalformed
+	}
+	unsigned := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(tokenSignature(unsigned, secret))) {
+		return nil, errors.New("token signature is invalid")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errTokenMalformed
+	}
+	var claims tokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errTokenMalformed
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, errors.New("token has expired")
+	}
+	return &claims, nil
+}
+
+func tokenSignature(unsigned string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
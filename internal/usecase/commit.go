@@ -0,0 +1,384 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DefaultWebhookMaxAge is how old a webhook delivery may be when it is
+// received, unless configured otherwise
+const DefaultWebhookMaxAge = 10 * time.Minute
+
+var (
+	ErrWebhookDeliveryIDRequired = errors.New("webhook delivery ID is required")
+	ErrWebhookDeliveryExpired    = errors.New("webhook delivery is too old or from the future")
+	ErrWebhookDeliveryReplayed   = errors.New("webhook delivery was already processed")
+)
+
+type CommitUsecase interface {
+	// LinkPushedCommits attaches each pushed commit to the tasks its message
+	// references, in the projects tracking the pushed repository. A push
+	// sent longer ago than the maximum webhook age, or delivered before, is
+	// rejected.
+	LinkPushedCommits(ctx context.Context, push PushEvent) (*CommitLinkResult, error)
+	// SyncPullRequests queues a sync of the tracked PRs a webhook reported a
+	// change of, and records that their repository sends PR webhooks so it
+	// is no longer polled. Deliveries are checked like pushes.
+	SyncPullRequests(ctx context.Context, event PullRequestEvent) (*PullRequestSyncResult, error)
+	ListTaskCommits(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskCommit, error)
+}
+
+// PushEvent is a git push reported by a repository host webhook
+type PushEvent struct {
+	// Provider and DeliveryID identify the webhook delivery
+	Provider   string
+	DeliveryID string
+	// SentAt is when the host says the push happened, from the signed payload
+	SentAt time.Time
+	// RepositoryURLs are the URLs the repository is known by (HTTPS, SSH, ...)
+	RepositoryURLs []string
+	Branch         string
+	Commits        []PushedCommit
+}
+
+// PullRequestEvent is a change of pull requests reported by a repository
+// host webhook, such as a PR being merged or a check suite completing
+type PullRequestEvent struct {
+	// Provider and DeliveryID identify the webhook delivery
+	Provider   string
+	DeliveryID string
+	// SentAt is when the host says the change happened
+	SentAt time.Time
+	// Repository is the full name of the repository, owner/name
+	Repository string
+	// Numbers are the numbers of the pull requests changed
+	Numbers []int
+}
+
+type PullRequestSyncResult struct {
+	// Queued is the number of tracked PRs queued for a sync
+	Queued int `json:"queued"`
+}
+
+type PushedCommit struct {
+	SHA         string
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+	URL         string
+	Timestamp   *time.Time
+}
+
+type CommitLinkResult struct {
+	// Projects is the number of projects tracking the pushed repository
+	Projects int `json:"projects"`
+	// Linked is the number of new commit-task links
+	Linked int `json:"linked"`
+}
+
+// CommitEventData is the snapshot stored with task.commit_linked events
+type CommitEventData struct {
+	TaskEventData
+	SHA     string `json:"sha"`
+	Message string `json:"message"`
+	URL     string `json:"url,omitempty"`
+	Branch  string `json:"branch,omitempty"`
+}
+
+// TaskReferences are the task references found in a commit message
+type TaskReferences struct {
+	// Numbers are referenced as AD-<number>
+	Numbers []int64
+	// IDPrefixes are task UUIDs or prefixes of at least 8 hex characters
+	IDPrefixes []string
+}
+
+var (
+	taskKeyPattern      = regexp.MustCompile(`(?i)\b` + entity.TaskKeyPrefix + `-(\d+)\b`)
+	taskIDPrefixPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}(?:-[0-9a-f]{4}(?:-[0-9a-f]{4}(?:-[0-9a-f]{4}(?:-[0-9a-f]{12})?)?)?)?\b`)
+)
+
+// ParseTaskReferences extracts the task references from a commit message
+func ParseTaskReferences(message string) TaskReferences {
+	var refs TaskReferences
+	for _, match := range taskKeyPattern.FindAllStringSubmatch(message, -1) {
+		if number, err := strconv.ParseInt(match[1], 10, 64); err == nil {
+			refs.Numbers = append(refs.Numbers, number)
+		}
+	}
+	for _, match := range taskIDPrefixPattern.FindAllString(message, -1) {
+		refs.IDPrefixes = append(refs.IDPrefixes, strings.ToLower(match))
+	}
+	return refs
+}
+
+type commitUsecase struct {
+	projectAccess
+	commitRepo   repository.TaskCommitRepository
+	taskRepo     repository.TaskRepository
+	projectRepo  repository.ProjectRepository
+	eventRepo    repository.EventRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	prRepo       repository.PullRequestRepository
+	jobClient    JobClientInterface
+	// webhookMaxAge is how far SentAt may be from the time a push is received
+	webhookMaxAge time.Duration
+	now           func() time.Time
+}
+
+// NewCommitUsecase creates a commit usecase accepting pushes sent at most
+// webhookMaxAge ago, DefaultWebhookMaxAge when it is not positive
+func NewCommitUsecase(
+	commitRepo repository.TaskCommitRepository,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	eventRepo repository.EventRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	prRepo repository.PullRequestRepository,
+	memberRepo repository.ProjectMemberRepository,
+	jobClient JobClientInterface,
+	webhookMaxAge time.Duration,
+) CommitUsecase {
+	if webhookMaxAge <= 0 {
+		webhookMaxAge = DefaultWebhookMaxAge
+	}
+	return &commitUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		commitRepo:    commitRepo,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		eventRepo:     eventRepo,
+		deliveryRepo:  deliveryRepo,
+		prRepo:        prRepo,
+		jobClient:     jobClient,
+		webhookMaxAge: webhookMaxAge,
+		now:           time.Now,
+	}
+}
+
+func (u *commitUsecase) LinkPushedCommits(ctx context.Context, push PushEvent) (*CommitLinkResult, error) {
+	if err := u.claimDelivery(ctx, push.Provider, push.DeliveryID, push.SentAt); err != nil {
+		return nil, err
+	}
+
+	result, err := u.linkPushedCommits(ctx, push)
+	if err != nil {
+		// Let the host deliver the push again
+		u.releaseDelivery(ctx, push.Provider, push.DeliveryID)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (u *commitUsecase) SyncPullRequests(ctx context.Context, event PullRequestEvent) (*PullRequestSyncResult, error) {
+	if err := u.claimDelivery(ctx, event.Provider, event.DeliveryID, event.SentAt); err != nil {
+		return nil, err
+	}
+	if event.Repository == "" {
+		return &PullRequestSyncResult{}, nil
+	}
+
+	if err := u.deliveryRepo.TouchSource(ctx, event.Provider, event.Repository, u.now()); err != nil {
+		// The repository is only polled for a while longer
+		slog.Error("Failed to record webhook source", "provider", event.Provider, "repository", event.Repository, "error", err)
+	}
+
+	result, err := u.queuePullRequestSyncs(ctx, event)
+	if err != nil {
+		u.releaseDelivery(ctx, event.Provider, event.DeliveryID)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (u *commitUsecase) queuePullRequestSyncs(ctx context.Context, event PullRequestEvent) (*PullRequestSyncResult, error) {
+	if len(event.Numbers) == 0 {
+		return &PullRequestSyncResult{}, nil
+	}
+	prs, err := u.prRepo.GetByRepository(ctx, event.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository pull requests: %w", err)
+	}
+
+	numbers := make(map[int]bool, len(event.Numbers))
+	for _, number := range event.Numbers {
+		numbers[number] = true
+	}
+	result := &PullRequestSyncResult{}
+	for _, pr := range prs {
+		if !numbers[pr.GitHubPRNumber] {
+			continue
+		}
+		if _, err := u.jobClient.EnqueuePRSync(&PRSyncPayload{PullRequestID: pr.ID}); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrJobQueueUnavailable, err)
+		}
+		result.Queued++
+	}
+	return result, nil
+}
+
+// claimDelivery checks that a delivery sent at sentAt is fresh and records
+// it, failing when it was delivered before. Deliveries too old to be
+// accepted again are pruned on the way.
+func (u *commitUsecase) claimDelivery(ctx context.Context, provider, deliveryID string, sentAt time.Time) error {
+	if deliveryID == "" {
+		return ErrWebhookDeliveryIDRequired
+	}
+	now := u.now()
+	if sentAt.IsZero() || sentAt.Before(now.Add(-u.webhookMaxAge)) || sentAt.After(now.Add(u.webhookMaxAge)) {
+		return ErrWebhookDeliveryExpired
+	}
+
+	claimed, err := u.deliveryRepo.Claim(ctx, provider, deliveryID, now)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ErrWebhookDeliveryReplayed
+	}
+
+	// A delivery received before the cutoff could only be replayed with a
+	// SentAt that is rejected above
+	if _, err := u.deliveryRepo.DeleteReceivedBefore(ctx, now.Add(-2*u.webhookMaxAge)); err != nil {
+		slog.Error("Failed to prune webhook deliveries", "error", err)
+	}
+	return nil
+}
+
+// releaseDelivery forgets a delivery that failed, so the host can deliver it
+// again
+func (u *commitUsecase) releaseDelivery(ctx context.Context, provider, deliveryID string) {
+	if err := u.deliveryRepo.Release(context.WithoutCancel(ctx), provider, deliveryID); err != nil {
+		slog.Error("Failed to release webhook delivery", "provider", provider, "delivery_id", deliveryID, "error", err)
+	}
+}
+
+func (u *commitUsecase) linkPushedCommits(ctx context.Context, push PushEvent) (*CommitLinkResult, error) {
+	urls := repositoryURLVariants(push.RepositoryURLs)
+	if len(urls) == 0 {
+		return &CommitLinkResult{}, nil
+	}
+	projects, err := u.projectRepo.GetByRepositoryURLs(ctx, urls)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CommitLinkResult{Projects: len(projects)}
+	for _, project := range projects {
+		tasks, err := u.taskRepo.GetByProjectID(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project tasks: %w", err)
+		}
+
+		for _, commit := range push.Commits {
+			for _, task := range resolveTaskReferences(ParseTaskReferences(commit.Message), tasks) {
+				linked, err := u.commitRepo.Link(ctx, &entity.TaskCommit{
+					TaskID:      task.ID,
+					SHA:         commit.SHA,
+					Message:     commit.Message,
+					AuthorName:  commit.AuthorName,
+					AuthorEmail: commit.AuthorEmail,
+					URL:         commit.URL,
+					Branch:      push.Branch,
+					CommittedAt: commit.Timestamp,
+				})
+				if err != nil {
+					return nil, err
+				}
+				if !linked {
+					continue
+				}
+
+				result.Linked++
+				recordEvent(ctx, u.eventRepo, entity.EventTypeTaskCommitLinked, task, CommitEventData{
+					TaskEventData: taskEventData(task, ""),
+					SHA:           commit.SHA,
+					Message:       commit.Message,
+					URL:           commit.URL,
+					Branch:        push.Branch,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (u *commitUsecase) ListTaskCommits(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskCommit, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorize(ctx, task.ProjectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.commitRepo.ListByTaskID(ctx, taskID)
+}
+
+// resolveTaskReferences returns the tasks refs point at, each once. ID
+// prefixes matching more than one task are ambiguous and ignored.
+func resolveTaskReferences(refs TaskReferences, tasks []*entity.Task) []*entity.Task {
+	var resolved []*entity.Task
+	seen := make(map[uuid.UUID]bool)
+	add := func(task *entity.Task) {
+		if !seen[task.ID] {
+			seen[task.ID] = true
+			resolved = append(resolved, task)
+		}
+	}
+
+	for _, number := range refs.Numbers {
+		for _, task := range tasks {
+			if task.Number == number {
+				add(task)
+			}
+		}
+	}
+
+	for _, prefix := range refs.IDPrefixes {
+		var match *entity.Task
+		ambiguous := false
+		for _, task := range tasks {
+			if strings.HasPrefix(task.ID.String(), prefix) {
+				ambiguous = match != nil
+				match = task
+			}
+		}
+		if match != nil && !ambiguous {
+			add(match)
+		}
+	}
+
+	return resolved
+}
+
+// repositoryURLVariants expands urls with the spellings a project's
+// repository URL may have been entered in: with or without the .git suffix
+// and a trailing slash.
+func repositoryURLVariants(urls []string) []string {
+	seen := make(map[string]bool)
+	var variants []string
+	for _, raw := range urls {
+		base := strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(raw), "/"), ".git")
+		if base == "" {
+			continue
+		}
+		for _, variant := range []string{base, base + ".git", base + "/"} {
+			if !seen[variant] {
+				seen[variant] = true
+				variants = append(variants, variant)
+			}
+		}
+	}
+	return variants
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaskReferences(t *testing.T) {
+	refs := ParseTaskReferences("AD-42: fix login (ad-7)\n\nRefs 3F2A9C1E and 3f2a9c1e-4b5d, reverts 9fceb02d0ae598e95dc970b74767f19372d61af8; BAD-9 AD-x")
+
+	assert.Equal(t, []int64{42, 7}, refs.Numbers)
+	assert.Equal(t, []string{"3f2a9c1e", "3f2a9c1e-4b5d"}, refs.IDPrefixes)
+}
+
+func TestCommitUsecase_LinkPushedCommits(t *testing.T) {
+	projectID := uuid.New()
+	byNumber := &entity.Task{ID: uuid.MustParse("11111111-0000-0000-0000-000000000001"), ProjectID: projectID, Number: 42}
+	byPrefix := &entity.Task{ID: uuid.MustParse("3f2a9c1e-0000-0000-0000-000000000002"), ProjectID: projectID, Number: 43}
+	ambiguousA := &entity.Task{ID: uuid.MustParse("abcdef01-0000-0000-0000-000000000003"), ProjectID: projectID, Number: 44}
+	ambiguousB := &entity.Task{ID: uuid.MustParse("abcdef01-1111-0000-0000-000000000004"), ProjectID: projectID, Number: 45}
+
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	commitRepo := repository.NewTaskCommitRepositoryMock(t)
+	eventRepo := repository.NewEventRepositoryMock(t)
+	deliveryRepo := repository.NewWebhookDeliveryRepositoryMock(t)
+	uc := NewCommitUsecase(commitRepo, taskRepo, projectRepo, eventRepo, deliveryRepo, nil, nil, nil, 0)
+
+	deliveryRepo.EXPECT().Claim(mock.Anything, "github", "delivery-1", mock.Anything).Return(true, nil)
+	deliveryRepo.EXPECT().DeleteReceivedBefore(mock.Anything, mock.Anything).Return(0, nil)
+
+	projectRepo.EXPECT().GetByRepositoryURLs(mock.Anything, []string{
+		"https://github.com/acme/app", "https://github.com/acme/app.git", "https://github.com/acme/app/",
+	}).Return([]*entity.Project{{ID: projectID}}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{byNumber, byPrefix, ambiguousA, ambiguousB}, nil)
+
+	var linked []uuid.UUID
+	commitRepo.EXPECT().Link(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, commit *entity.TaskCommit) (bool, error) {
+		assert.Equal(t, "main", commit.Branch)
+		linked = append(linked, commit.TaskID)
+		// The second commit was delivered before
+		return commit.SHA == "aaa", nil
+	})
+	eventRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(event *entity.Event) bool {
+		return event.Type == entity.EventTypeTaskCommitLinked
+	})).Return(nil).Times(2)
+
+	result, err := uc.LinkPushedCommits(context.Background(), PushEvent{
+		Provider:       "github",
+		DeliveryID:     "delivery-1",
+		SentAt:         time.Now(),
+		RepositoryURLs: []string{"https://github.com/acme/app", "https://github.com/acme/app.git"},
+		Branch:         "main",
+		Commits: []PushedCommit{
+			{SHA: "aaa", Message: "AD-42 and 3f2a9c1e, also AD-42 again and abcdef01"},
+			{SHA: "bbb", Message: "AD-43"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &CommitLinkResult{Projects: 1, Linked: 2}, result)
+	assert.Equal(t, []uuid.UUID{byNumber.ID, byPrefix.ID, byPrefix.ID}, linked)
+}
+
+func TestCommitUsecase_LinkPushedCommits_ReplayProtection(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	push := PushEvent{Provider: "github", DeliveryID: "delivery-1", SentAt: now.Add(-time.Minute)}
+
+	newUsecase := func(t *testing.T) (*commitUsecase, *repository.WebhookDeliveryRepositoryMock, *repository.ProjectRepositoryMock) {
+		deliveryRepo := repository.NewWebhookDeliveryRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		uc := NewCommitUsecase(nil, nil, projectRepo, nil, deliveryRepo, nil, nil, nil, 5*time.Minute).(*commitUsecase)
+		uc.now = func() time.Time { return now }
+		return uc, deliveryRepo, projectRepo
+	}
+
+	t.Run("rejects pushes outside the accepted age", func(t *testing.T) {
+		uc, _, _ := newUsecase(t)
+
+		for _, sentAt := range []time.Time{{}, now.Add(-6 * time.Minute), now.Add(6 * time.Minute)} {
+			stale := push
+			stale.SentAt = sentAt
+			_, err := uc.LinkPushedCommits(context.Background(), stale)
+			assert.ErrorIs(t, err, ErrWebhookDeliveryExpired)
+		}
+	})
+
+	t.Run("requires a delivery ID", func(t *testing.T) {
+		uc, _, _ := newUsecase(t)
+
+		anonymous := push
+		anonymous.DeliveryID = ""
+		_, err := uc.LinkPushedCommits(context.Background(), anonymous)
+		assert.ErrorIs(t, err, ErrWebhookDeliveryIDRequired)
+	})
+
+	t.Run("rejects a delivery processed before", func(t *testing.T) {
+		uc, deliveryRepo, _ := newUsecase(t)
+		deliveryRepo.EXPECT().Claim(mock.Anything, "github", "delivery-1", now).Return(false, nil)
+
+		_, err := uc.LinkPushedCommits(context.Background(), push)
+		assert.ErrorIs(t, err, ErrWebhookDeliveryReplayed)
+	})
+
+	t.Run("releases the delivery when linking fails", func(t *testing.T) {
+		uc, deliveryRepo, projectRepo := newUsecase(t)
+		deliveryRepo.EXPECT().Claim(mock.Anything, "github", "delivery-1", now).Return(true, nil)
+		deliveryRepo.EXPECT().DeleteReceivedBefore(mock.Anything, now.Add(-10*time.Minute)).Return(3, nil)
+		projectRepo.EXPECT().GetByRepositoryURLs(mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+		deliveryRepo.EXPECT().Release(mock.Anything, "github", "delivery-1").Return(nil)
+
+		failing := push
+		failing.RepositoryURLs = []string{"https://github.com/acme/app"}
+		_, err := uc.LinkPushedCommits(context.Background(), failing)
+		assert.EqualError(t, err, "connection refused")
+	})
+}
+
+func TestCommitUsecase_SyncPullRequests(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	event := PullRequestEvent{Provider: "github", DeliveryID: "delivery-1", SentAt: now, Repository: "acme/app", Numbers: []int{7, 9}}
+	merged := &entity.PullRequest{ID: uuid.New(), GitHubPRNumber: 7, Repository: "acme/app"}
+	untouched := &entity.PullRequest{ID: uuid.New(), GitHubPRNumber: 8, Repository: "acme/app"}
+
+	newUsecase := func(t *testing.T) (*commitUsecase, *repository.WebhookDeliveryRepositoryMock, *repository.PullRequestRepositoryMock, *JobClientInterfaceMock) {
+		deliveryRepo := repository.NewWebhookDeliveryRepositoryMock(t)
+		prRepo := repository.NewPullRequestRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := NewCommitUsecase(nil, nil, nil, nil, deliveryRepo, prRepo, nil, jobClient, 5*time.Minute).(*commitUsecase)
+		uc.now = func() time.Time { return now }
+		deliveryRepo.EXPECT().Claim(mock.Anything, "github", "delivery-1", now).Return(true, nil)
+		deliveryRepo.EXPECT().DeleteReceivedBefore(mock.Anything, mock.Anything).Return(0, nil)
+		deliveryRepo.EXPECT().TouchSource(mock.Anything, "github", "acme/app", now).Return(nil)
+		prRepo.EXPECT().GetByRepository(mock.Anything, "acme/app").Return([]*entity.PullRequest{merged, untouched}, nil)
+		return uc, deliveryRepo, prRepo, jobClient
+	}
+
+	t.Run("queues the tracked PRs changed", func(t *testing.T) {
+		uc, _, _, jobClient := newUsecase(t)
+		jobClient.EXPECT().EnqueuePRSync(&PRSyncPayload{PullRequestID: merged.ID}).Return("job-1", nil).Once()
+
+		result, err := uc.SyncPullRequests(context.Background(), event)
+		require.NoError(t, err)
+		assert.Equal(t, &PullRequestSyncResult{Queued: 1}, result)
+	})
+
+	t.Run("releases the delivery when the queue is down", func(t *testing.T) {
+		uc, deliveryRepo, _, jobClient := newUsecase(t)
+		jobClient.EXPECT().EnqueuePRSync(mock.Anything).Return("", errors.New("redis: connection refused"))
+		deliveryRepo.EXPECT().Release(mock.Anything, "github", "delivery-1").Return(nil)
+
+		_, err := uc.SyncPullRequests(context.Background(), event)
+		assert.ErrorIs(t, err, ErrJobQueueUnavailable)
+	})
+}
@@ -0,0 +1,405 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/email"
+	"github.com/google/uuid"
+)
+
+type EmailUsecase interface {
+	// ListTemplates returns the template of each type of email, the
+	// project's own or the default one
+	ListTemplates(ctx context.Context, projectID uuid.UUID) ([]*entity.EmailTemplate, error)
+	// UpdateTemplate replaces the project's template of a type of email
+	UpdateTemplate(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType, req UpdateEmailTemplateRequest) (*entity.EmailTemplate, error)
+	// ResetTemplate goes back to the default template of a type of email
+	// and returns it
+	ResetTemplate(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) (*entity.EmailTemplate, error)
+	// SendDigests emails the task movement over [from, to) of every active
+	// project with email notifications on, and returns how many digests
+	// were sent. Projects where nothing moved are skipped; a project that
+	// fails is logged and does not stop the others.
+	SendDigests(ctx context.Context, from, to time.Time) (int, error)
+}
+
+type UpdateEmailTemplateRequest struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Validation errors
+var (
+	ErrEmailNotConfigured       = errors.New("email delivery is not configured, set SMTP_HOST")
+	ErrEmailTemplateTypeInvalid = errors.New("notification type has no email template")
+	ErrEmailTemplateInvalid     = errors.New("email template is invalid")
+	ErrEmailSubjectRequired     = errors.New("email subject is required")
+)
+
+// defaultEmailTemplates are the emails of projects that did not replace
+// them. Notification messages are in the project's language.
+var defaultEmailTemplates = map[entity.NotificationType]entity.EmailTemplate{
+	entity.NotificationTypePlanReady: {
+		Subject: "[{{.ProjectName}}] {{.TaskKey}} plan ready for review",
+		Body: `{{.Message}}
+{{if .HighRisk}}
+This task is high-risk: its plan needs two approvals, or one with a TOTP code.
+{{end}}
+{{.Plan}}
+
+Review it: {{.TaskURL}}
+`,
+	},
+	entity.NotificationTypeExecutionFailed: {
+		Subject: "[{{.ProjectName}}] {{.TaskKey}} {{.Phase}} failed",
+		Body: `{{.Message}}
+{{if .Error}}
+{{.Error}}
+{{end}}
+Open the task: {{.TaskURL}}
+`,
+	},
+	entity.NotificationTypeDailyDigest: {
+		Subject: `[{{.ProjectName}}] Daily digest for {{.From.Format "2006-01-02"}}`,
+		Body: `Task movement in {{.ProjectName}} on {{.From.Format "2006-01-02"}} (UTC):
+{{range .Movements}}
+- {{.TaskKey}} {{.Title}}: {{if .From}}{{.From}} -> {{.To}}{{else}}created in {{.To}}{{end}}{{if .ChangedBy}} by {{.ChangedBy}}{{end}}
+{{- end}}
+
+{{.ProjectURL}}
+`,
+	},
+}
+
+// sampleEmailTemplateData is what templates are tried with before they are
+// saved, so they cannot fail on every email
+var sampleEmailTemplateData = entity.EmailTemplateData{
+	ProjectName: "Shop",
+	ProjectURL:  "https://auto-devs.example.com/projects/1",
+	Message:     "The plan for task 'Add login' in project 'Shop' is ready for review",
+	TaskKey:     "AD-1",
+	TaskTitle:   "Add login",
+	TaskURL:     "https://auto-devs.example.com/projects/1/tasks/1",
+	Plan:        "1. Add the login form",
+	Phase:       string(entity.ExecutionPhasePlanning),
+	Error:       "exit status 1",
+	From:        time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	To:          time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+	Movements: []entity.TaskMovement{{
+		TaskKey:   "AD-1",
+		Title:     "Add login",
+		From:      entity.TaskStatusTODO,
+		To:        entity.TaskStatusPLANNING,
+		ChangedBy: "alice",
+		ChangedAt: time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+	}},
+}
+
+type emailUsecase struct {
+	projectAccess
+	templateRepo repository.EmailTemplateRepository
+	projectRepo  repository.ProjectRepository
+	taskRepo     repository.TaskRepository
+	memberRepo   repository.ProjectMemberRepository
+	// sender is nil when no SMTP server is configured
+	sender email.Sender
+	// baseURL is where the auto-devs UI is served, for the links
+	baseURL string
+}
+
+func NewEmailUsecase(
+	templateRepo repository.EmailTemplateRepository,
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	memberRepo repository.ProjectMemberRepository,
+	sender email.Sender,
+	baseURL string,
+) EmailUsecase {
+	return &emailUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		templateRepo:  templateRepo,
+		projectRepo:   projectRepo,
+		taskRepo:      taskRepo,
+		memberRepo:    memberRepo,
+		sender:        sender,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (u *emailUsecase) ListTemplates(ctx context.Context, projectID uuid.UUID) ([]*entity.EmailTemplate, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	stored, err := u.templateRepo.ListByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entity.EmailTemplate, 0, len(entity.EmailNotificationTypes))
+	for _, notificationType := range entity.EmailNotificationTypes {
+		emailTemplate := defaultEmailTemplate(projectID, notificationType)
+		for _, s := range stored {
+			if s.Type == notificationType {
+				emailTemplate = s
+				break
+			}
+		}
+		templates = append(templates, emailTemplate)
+	}
+	return templates, nil
+}
+
+func (u *emailUsecase) UpdateTemplate(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType, req UpdateEmailTemplateRequest) (*entity.EmailTemplate, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(entity.EmailNotificationTypes, notificationType) {
+		return nil, fmt.Errorf("%w: %s", ErrEmailTemplateTypeInvalid, notificationType)
+	}
+	if strings.TrimSpace(req.Subject) == "" {
+		return nil, ErrEmailSubjectRequired
+	}
+
+	emailTemplate := &entity.EmailTemplate{
+		ProjectID: projectID,
+		Type:      notificationType,
+		Subject:   req.Subject,
+		Body:      req.Body,
+	}
+	if _, _, err := renderEmailTemplate(emailTemplate, sampleEmailTemplateData); err != nil {
+		return nil, err
+	}
+	if actor := repository.ActorFromContext(ctx); actor != "" {
+		emailTemplate.UpdatedBy = &actor
+	}
+	if err := u.templateRepo.Save(ctx, emailTemplate); err != nil {
+		return nil, err
+	}
+	return u.templateRepo.Get(ctx, projectID, notificationType)
+}
+
+func (u *emailUsecase) ResetTemplate(ctx context.Context, projectID uuid.UUID, notificationType entity.NotificationType) (*entity.EmailTemplate, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(entity.EmailNotificationTypes, notificationType) {
+		return nil, fmt.Errorf("%w: %s", ErrEmailTemplateTypeInvalid, notificationType)
+	}
+	if err := u.templateRepo.Delete(ctx, projectID, notificationType); err != nil {
+		return nil, err
+	}
+	return defaultEmailTemplate(projectID, notificationType), nil
+}
+
+func (u *emailUsecase) SendDigests(ctx context.Context, from, to time.Time) (int, error) {
+	if u.sender == nil {
+		return 0, ErrEmailNotConfigured
+	}
+	archived := false
+	projects, _, err := u.projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{Archived: &archived})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	sent := 0
+	var errs []error
+	for _, project := range projects {
+		ok, err := u.sendDigest(ctx, project, from, to)
+		if err != nil {
+			slog.Warn("Failed to send project email digest", "project_id", project.ID, "error", err)
+			errs = append(errs, fmt.Errorf("project %s: %w", project.ID, err))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// sendDigest emails the project's digest, reporting whether there was one
+// to send
+func (u *emailUsecase) sendDigest(ctx context.Context, project *entity.Project, from, to time.Time) (bool, error) {
+	if !emailNotificationsOn(ctx, u.projectRepo, project.ID) {
+		return false, nil
+	}
+	digest, err := u.collectDigest(ctx, project, from, to)
+	if err != nil {
+		return false, err
+	}
+	if len(digest.Movements) == 0 {
+		return false, nil
+	}
+	recipients, err := emailRecipients(ctx, u.memberRepo, project.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(recipients) == 0 {
+		return false, nil
+	}
+
+	emailTemplate, err := emailTemplateFor(ctx, u.templateRepo, project.ID, entity.NotificationTypeDailyDigest)
+	if err != nil {
+		return false, err
+	}
+	subject, body, err := renderEmailTemplate(emailTemplate, entity.EmailTemplateData{
+		ProjectName: project.Name,
+		ProjectURL:  projectURL(u.baseURL, project.ID),
+		From:        digest.From,
+		To:          digest.To,
+		Movements:   digest.Movements,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := u.sender.Send(ctx, email.Message{To: recipients, Subject: subject, Body: body}); err != nil {
+		return false, fmt.Errorf("failed to send email digest: %w", err)
+	}
+	return true, nil
+}
+
+// collectDigest lists the status changes of the project's tasks in [from, to)
+func (u *emailUsecase) collectDigest(ctx context.Context, project *entity.Project, from, to time.Time) (*entity.EmailDigest, error) {
+	digest := &entity.EmailDigest{
+		ProjectID:   project.ID,
+		ProjectName: project.Name,
+		From:        from,
+		To:          to,
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return digest, nil
+	}
+	taskByID := make(map[uuid.UUID]*entity.Task, len(tasks))
+	for _, task := range tasks {
+		taskByID[task.ID] = task
+	}
+	histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, history := range histories {
+		if history.CreatedAt.Before(from) || !history.CreatedAt.Before(to) {
+			continue
+		}
+		task, ok := taskByID[history.TaskID]
+		if !ok {
+			continue
+		}
+		movement := entity.TaskMovement{
+			TaskKey:   entity.TaskKey(task.Number),
+			Title:     task.Title,
+			To:        history.ToStatus,
+			ChangedAt: history.CreatedAt,
+		}
+		if history.FromStatus != nil {
+			movement.From = *history.FromStatus
+		}
+		if history.ChangedBy != nil {
+			movement.ChangedBy = *history.ChangedBy
+		}
+		digest.Movements = append(digest.Movements, movement)
+	}
+	sort.SliceStable(digest.Movements, func(i, j int) bool {
+		return digest.Movements[i].ChangedAt.Before(digest.Movements[j].ChangedAt)
+	})
+	return digest, nil
+}
+
+// emailNotificationsOn reports whether the project emails its members.
+// Projects without settings keep the default, emails off.
+func emailNotificationsOn(ctx context.Context, projectRepo repository.ProjectRepository, projectID uuid.UUID) bool {
+	settings, err := projectRepo.GetSettings(ctx, projectID)
+	return err == nil && settings.EmailNotifications
+}
+
+// emailRecipients returns the email addresses of the project's members, in
+// the order they were added
+func emailRecipients(ctx context.Context, memberRepo repository.ProjectMemberRepository, projectID uuid.UUID) ([]string, error) {
+	members, err := memberRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project members: %w", err)
+	}
+	var recipients []string
+	for _, member := range members {
+		if member.User == nil || member.User.Email == nil {
+			continue
+		}
+		address := strings.TrimSpace(*member.User.Email)
+		if address != "" && !slices.Contains(recipients, address) {
+			recipients = append(recipients, address)
+		}
+	}
+	return recipients, nil
+}
+
+// emailTemplateFor returns the project's template of the type, or the
+// default one
+func emailTemplateFor(ctx context.Context, templateRepo repository.EmailTemplateRepository, projectID uuid.UUID, notificationType entity.NotificationType) (*entity.EmailTemplate, error) {
+	emailTemplate, err := templateRepo.Get(ctx, projectID, notificationType)
+	if err != nil {
+		return nil, err
+	}
+	if emailTemplate == nil {
+		emailTemplate = defaultEmailTemplate(projectID, notificationType)
+	}
+	return emailTemplate, nil
+}
+
+func defaultEmailTemplate(projectID uuid.UUID, notificationType entity.NotificationType) *entity.EmailTemplate {
+	emailTemplate := defaultEmailTemplates[notificationType]
+	emailTemplate.ProjectID = projectID
+	emailTemplate.Type = notificationType
+	return &emailTemplate
+}
+
+// renderEmailTemplate executes the subject and body of tmpl with data.
+// The subject is kept on one line.
+func renderEmailTemplate(tmpl *entity.EmailTemplate, data entity.EmailTemplateData) (string, string, error) {
+	execute := func(name, text string) (string, error) {
+		t, err := template.New(name).Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrEmailTemplateInvalid, err)
+		}
+		var out strings.Builder
+		if err := t.Execute(&out, data); err != nil {
+			return "", fmt.Errorf("%w: %v", ErrEmailTemplateInvalid, err)
+		}
+		return out.String(), nil
+	}
+
+	subject, err := execute("subject", tmpl.Subject)
+	if err != nil {
+		return "", "", err
+	}
+	body, err := execute("body", tmpl.Body)
+	if err != nil {
+		return "", "", err
+	}
+	return strings.Join(strings.Fields(subject), " "), body, nil
+}
+
+func projectURL(baseURL string, projectID uuid.UUID) string {
+	if baseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s", baseURL, projectID)
+}
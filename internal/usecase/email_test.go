@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/email"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEmailSender struct {
+	sent []email.Message
+}
+
+func (f *fakeEmailSender) Send(ctx context.Context, msg email.Message) error {
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func emailMembers(addresses ...string) []*entity.ProjectMember {
+	members := []*entity.ProjectMember{{User: &entity.User{Username: "no-email"}}}
+	for _, address := range addresses {
+		members = append(members, &entity.ProjectMember{User: &entity.User{Email: &address}})
+	}
+	return members
+}
+
+func TestEmailNotificationHandler_Deliver(t *testing.T) {
+	projectID, taskID := uuid.New(), uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	templateRepo := repository.NewEmailTemplateRepositoryMock(t)
+	sender := &fakeEmailSender{}
+	handler := NewEmailNotificationHandler(projectRepo, memberRepo, templateRepo, sender, "https://auto-devs.acme.com/")
+
+	projectRepo.EXPECT().GetSettings(mock.Anything, projectID).Return(&entity.ProjectSettings{EmailNotifications: true}, nil)
+	memberRepo.EXPECT().ListByProject(mock.Anything, projectID).Return(emailMembers("alice@acme.com", "bob@acme.com", "alice@acme.com"), nil)
+	templateRepo.EXPECT().Get(mock.Anything, projectID, entity.NotificationTypePlanReady).Return(nil, nil)
+	templateRepo.EXPECT().Get(mock.Anything, projectID, entity.NotificationTypeExecutionFailed).
+		Return(&entity.EmailTemplate{Subject: "{{.TaskKey}}\nfailed", Body: "{{.Phase}}: {{.Error}}"}, nil)
+
+	// Capture the events the notification usecase sends
+	events := &recordingNotificationHandler{}
+	notifications := NewNotificationUsecase()
+	require.NoError(t, notifications.RegisterHandler(entity.NotificationTypePlanReady, events))
+	require.NoError(t, notifications.RegisterHandler(entity.NotificationTypeExecutionFailed, events))
+	task := &entity.Task{ID: taskID, ProjectID: projectID, Number: 7, Title: "Add login", HighRisk: true}
+	project := &entity.Project{ID: projectID, Name: "Shop"}
+	require.NoError(t, notifications.SendPlanReadyNotification(context.Background(), task, project, &entity.Plan{Content: "1. Add the form"}))
+	require.NoError(t, notifications.SendExecutionFailedNotification(context.Background(), task, project, &entity.Execution{Phase: entity.ExecutionPhaseImplementation}, "exit status 1"))
+
+	for _, event := range events.events {
+		require.NoError(t, handler.Deliver(context.Background(), event))
+	}
+
+	require.Len(t, sender.sent, 2)
+	plan := sender.sent[0]
+	assert.Equal(t, []string{"alice@acme.com", "bob@acme.com"}, plan.To)
+	assert.Equal(t, "[Shop] AD-7 plan ready for review", plan.Subject)
+	assert.Contains(t, plan.Body, "The plan for task 'Add login' in project 'Shop' is ready for review")
+	assert.Contains(t, plan.Body, "This task is high-risk")
+	assert.Contains(t, plan.Body, "1. Add the form")
+	assert.Contains(t, plan.Body, "Review it: https://auto-devs.acme.com/projects/"+projectID.String()+"/tasks/"+taskID.String())
+	// The project's template, with the subject on one line
+	assert.Equal(t, email.Message{To: plan.To, Subject: "AD-7 failed", Body: "implementation: exit status 1"}, sender.sent[1])
+}
+
+func TestEmailNotificationHandler_Deliver_Skips(t *testing.T) {
+	projectID := uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	sender := &fakeEmailSender{}
+	handler := NewEmailNotificationHandler(projectRepo, repository.NewProjectMemberRepositoryMock(t), repository.NewEmailTemplateRepositoryMock(t), sender, "")
+
+	// Other types wait for the digest
+	require.NoError(t, handler.Deliver(context.Background(), entity.NotificationEvent{Type: entity.NotificationTypeTaskCreated, ProjectID: projectID}))
+	// Projects without email notifications are not emailed
+	projectRepo.EXPECT().GetSettings(mock.Anything, projectID).Return(&entity.ProjectSettings{EmailNotifications: false}, nil)
+	require.NoError(t, handler.Deliver(context.Background(), entity.NotificationEvent{Type: entity.NotificationTypePlanReady, ProjectID: projectID}))
+
+	assert.Empty(t, sender.sent)
+}
+
+func TestEmailUpdateTemplate(t *testing.T) {
+	projectID := uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	templateRepo := repository.NewEmailTemplateRepositoryMock(t)
+	usecase := NewEmailUsecase(templateRepo, projectRepo, nil, nil, nil, "")
+	ctx := repository.WithActor(context.Background(), "alice")
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+
+	_, err := usecase.UpdateTemplate(ctx, projectID, entity.NotificationTypeTaskCreated, UpdateEmailTemplateRequest{Subject: "New task"})
+	assert.ErrorIs(t, err, ErrEmailTemplateTypeInvalid)
+	_, err = usecase.UpdateTemplate(ctx, projectID, entity.NotificationTypePlanReady, UpdateEmailTemplateRequest{Subject: " "})
+	assert.ErrorIs(t, err, ErrEmailSubjectRequired)
+	_, err = usecase.UpdateTemplate(ctx, projectID, entity.NotificationTypePlanReady, UpdateEmailTemplateRequest{Subject: "{{.TaskKey", Body: "x"})
+	assert.ErrorIs(t, err, ErrEmailTemplateInvalid, "templates must parse")
+	_, err = usecase.UpdateTemplate(ctx, projectID, entity.NotificationTypePlanReady, UpdateEmailTemplateRequest{Subject: "Plan", Body: "{{.Assignee}}"})
+	assert.ErrorIs(t, err, ErrEmailTemplateInvalid, "templates must only use known fields")
+
+	saved := &entity.EmailTemplate{ProjectID: projectID, Type: entity.NotificationTypePlanReady, Subject: "Review {{.TaskKey}}", Body: "{{.Plan}}"}
+	templateRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(template *entity.EmailTemplate) bool {
+		return template.Subject == "Review {{.TaskKey}}" && template.UpdatedBy != nil && *template.UpdatedBy == "alice"
+	})).Return(nil)
+	templateRepo.EXPECT().Get(mock.Anything, projectID, entity.NotificationTypePlanReady).Return(saved, nil)
+
+	template, err := usecase.UpdateTemplate(ctx, projectID, entity.NotificationTypePlanReady, UpdateEmailTemplateRequest{Subject: "Review {{.TaskKey}}", Body: "{{.Plan}}"})
+	require.NoError(t, err)
+	assert.Equal(t, saved, template)
+}
+
+func TestEmailListTemplates(t *testing.T) {
+	projectID := uuid.New()
+	templateRepo := repository.NewEmailTemplateRepositoryMock(t)
+	custom := &entity.EmailTemplate{ID: uuid.New(), ProjectID: projectID, Type: entity.NotificationTypeDailyDigest, Subject: "Digest"}
+	templateRepo.EXPECT().ListByProjectID(mock.Anything, projectID).Return([]*entity.EmailTemplate{custom}, nil)
+
+	templates, err := NewEmailUsecase(templateRepo, nil, nil, nil, nil, "").ListTemplates(context.Background(), projectID)
+
+	require.NoError(t, err)
+	require.Len(t, templates, 3)
+	assert.Equal(t, entity.NotificationTypePlanReady, templates[0].Type)
+	assert.Equal(t, uuid.Nil, templates[0].ID, "types the project did not change use the default")
+	assert.Equal(t, custom, templates[2])
+}
+
+func TestEmailSendDigests(t *testing.T) {
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	active, quiet, off := &entity.Project{ID: uuid.New(), Name: "Shop"}, &entity.Project{ID: uuid.New()}, &entity.Project{ID: uuid.New()}
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	templateRepo := repository.NewEmailTemplateRepositoryMock(t)
+	sender := &fakeEmailSender{}
+
+	projectRepo.EXPECT().GetAllWithParams(mock.Anything, mock.Anything).Return([]*entity.Project{active, quiet, off}, 3, nil)
+	projectRepo.EXPECT().GetSettings(mock.Anything, active.ID).Return(&entity.ProjectSettings{EmailNotifications: true}, nil)
+	projectRepo.EXPECT().GetSettings(mock.Anything, quiet.ID).Return(&entity.ProjectSettings{EmailNotifications: true}, nil)
+	projectRepo.EXPECT().GetSettings(mock.Anything, off.ID).Return(&entity.ProjectSettings{EmailNotifications: false}, nil)
+
+	login, search := &entity.Task{ID: uuid.New(), Number: 1, Title: "Add login"}, &entity.Task{ID: uuid.New(), Number: 2, Title: "Search"}
+	todo, alice := entity.TaskStatusTODO, "alice"
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, active.ID).Return([]*entity.Task{login, search}, nil)
+	taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, active.ID).Return([]*entity.TaskStatusHistory{
+		{TaskID: login.ID, FromStatus: &todo, ToStatus: entity.TaskStatusPLANNING, ChangedBy: &alice, CreatedAt: from.Add(9 * time.Hour)},
+		{TaskID: search.ID, ToStatus: entity.TaskStatusTODO, CreatedAt: from.Add(8 * time.Hour)},
+		// Outside the day
+		{TaskID: login.ID, ToStatus: entity.TaskStatusTODO, CreatedAt: from.Add(-time.Hour)},
+	}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, quiet.ID).Return(nil, nil)
+	memberRepo.EXPECT().ListByProject(mock.Anything, active.ID).Return(emailMembers("alice@acme.com"), nil)
+	templateRepo.EXPECT().Get(mock.Anything, active.ID, entity.NotificationTypeDailyDigest).Return(nil, nil)
+
+	sent, err := NewEmailUsecase(templateRepo, projectRepo, taskRepo, memberRepo, sender, "https://auto-devs.acme.com").SendDigests(context.Background(), from, to)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, []string{"alice@acme.com"}, sender.sent[0].To)
+	assert.Equal(t, "[Shop] Daily digest for 2024-03-04", sender.sent[0].Subject)
+	assert.Equal(t, "Task movement in Shop on 2024-03-04 (UTC):\n\n"+
+		"- AD-2 Search: created in TODO\n"+
+		"- AD-1 Add login: TODO -> PLANNING by alice\n\n"+
+		"https://auto-devs.acme.com/projects/"+active.ID.String()+"\n", sender.sent[0].Body)
+}
+
+func TestEmailSendDigests_NotConfigured(t *testing.T) {
+	_, err := NewEmailUsecase(nil, nil, nil, nil, nil, "").SendDigests(context.Background(), time.Now(), time.Now())
+	assert.ErrorIs(t, err, ErrEmailNotConfigured)
+}
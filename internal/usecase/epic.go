@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEpicNotFound        = errors.New("epic not found")
+	ErrEpicTitleRequired   = errors.New("epic title is required")
+	ErrEpicProjectMismatch = errors.New("epic belongs to another project")
+)
+
+type EpicUsecase interface {
+	Create(ctx context.Context, req CreateEpicRequest) (*EpicSummary, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*EpicSummary, error)
+	// ListByProjectID returns the project's epics with their progress, those
+	// due first and then the newest
+	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*EpicSummary, error)
+	Update(ctx context.Context, id uuid.UUID, req UpdateEpicRequest) (*EpicSummary, error)
+	// Delete deletes the epic, leaving its tasks ungrouped
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetProgress rolls up the epic's tasks, e.g. to notify clients after
+	// one of them changed
+	GetProgress(ctx context.Context, id uuid.UUID) (*entity.EpicProgress, error)
+}
+
+// EpicSummary is an epic with the rollup of its tasks
+type EpicSummary struct {
+	Epic     *entity.Epic
+	Progress *entity.EpicProgress
+}
+
+type CreateEpicRequest struct {
+	ProjectID   uuid.UUID
+	Title       string
+	Description string
+	DueDate     *time.Time
+}
+
+// UpdateEpicRequest changes the fields set. ClearDueDate removes the due
+// date, as a nil DueDate leaves it unchanged.
+type UpdateEpicRequest struct {
+	Title        *string
+	Description  *string
+	DueDate      *time.Time
+	ClearDueDate bool
+}
+
+type epicUsecase struct {
+	projectAccess
+	epicRepo    repository.EpicRepository
+	projectRepo repository.ProjectRepository
+}
+
+func NewEpicUsecase(epicRepo repository.EpicRepository, projectRepo repository.ProjectRepository, memberRepo repository.ProjectMemberRepository) EpicUsecase {
+	return &epicUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		epicRepo:      epicRepo,
+		projectRepo:   projectRepo,
+	}
+}
+
+func (u *epicUsecase) Create(ctx context.Context, req CreateEpicRequest) (*EpicSummary, error) {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, ErrEpicTitleRequired
+	}
+	if _, err := u.projectRepo.GetByID(ctx, req.ProjectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, req.ProjectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+
+	epic := &entity.Epic{
+		ID:          uuid.New(),
+		ProjectID:   req.ProjectID,
+		Title:       title,
+		Description: strings.TrimSpace(req.Description),
+		DueDate:     req.DueDate,
+	}
+	if err := u.epicRepo.Create(ctx, epic); err != nil {
+		return nil, fmt.Errorf("failed to create epic: %w", err)
+	}
+
+	return &EpicSummary{Epic: epic, Progress: &entity.EpicProgress{EpicID: epic.ID}}, nil
+}
+
+func (u *epicUsecase) GetByID(ctx context.Context, id uuid.UUID) (*EpicSummary, error) {
+	epic, err := u.getEpic(ctx, id, entity.ProjectRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	return u.summarize(ctx, epic)
+}
+
+func (u *epicUsecase) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*EpicSummary, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	epics, err := u.epicRepo.ListByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(epics))
+	for i, epic := range epics {
+		ids[i] = epic.ID
+	}
+	progress, err := u.epicRepo.GetProgress(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*EpicSummary, len(epics))
+	for i, epic := range epics {
+		summaries[i] = &EpicSummary{Epic: epic, Progress: progress[epic.ID]}
+	}
+	return summaries, nil
+}
+
+func (u *epicUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateEpicRequest) (*EpicSummary, error) {
+	epic, err := u.getEpic(ctx, id, entity.ProjectRoleMaintainer)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			return nil, ErrEpicTitleRequired
+		}
+		epic.Title = title
+	}
+	if req.Description != nil {
+		epic.Description = strings.TrimSpace(*req.Description)
+	}
+	if req.DueDate != nil {
+		epic.DueDate = req.DueDate
+	} else if req.ClearDueDate {
+		epic.DueDate = nil
+	}
+
+	if err := u.epicRepo.Update(ctx, epic); err != nil {
+		return nil, fmt.Errorf("failed to update epic: %w", err)
+	}
+	return u.summarize(ctx, epic)
+}
+
+func (u *epicUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := u.getEpic(ctx, id, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+	return u.epicRepo.Delete(ctx, id)
+}
+
+func (u *epicUsecase) GetProgress(ctx context.Context, id uuid.UUID) (*entity.EpicProgress, error) {
+	progress, err := u.epicRepo.GetProgress(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	return progress[id], nil
+}
+
+// getEpic returns the epic if the request may act with role in its project
+func (u *epicUsecase) getEpic(ctx context.Context, id uuid.UUID, role entity.ProjectRole) (*entity.Epic, error) {
+	epic, err := u.epicRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEpicNotFound, err)
+	}
+	if err := u.authorize(ctx, epic.ProjectID, role); err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+func (u *epicUsecase) summarize(ctx context.Context, epic *entity.Epic) (*EpicSummary, error) {
+	progress, err := u.GetProgress(ctx, epic.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &EpicSummary{Epic: epic, Progress: progress}, nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpicUsecase_Create(t *testing.T) {
+	projectID := uuid.New()
+
+	t.Run("creates an epic without progress", func(t *testing.T) {
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+		epicRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.Epic")).Return(nil)
+		uc := NewEpicUsecase(epicRepo, projectRepo, nil)
+
+		summary, err := uc.Create(context.Background(), CreateEpicRequest{ProjectID: projectID, Title: "  Invoicing v2 "})
+		require.NoError(t, err)
+		assert.Equal(t, "Invoicing v2", summary.Epic.Title)
+		assert.Equal(t, projectID, summary.Epic.ProjectID)
+		assert.Equal(t, &entity.EpicProgress{EpicID: summary.Epic.ID}, summary.Progress)
+	})
+
+	t.Run("requires a title", func(t *testing.T) {
+		uc := NewEpicUsecase(repository.NewEpicRepositoryMock(t), repository.NewProjectRepositoryMock(t), nil)
+
+		_, err := uc.Create(context.Background(), CreateEpicRequest{ProjectID: projectID, Title: " "})
+		assert.ErrorIs(t, err, ErrEpicTitleRequired)
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(nil, errors.New("record not found"))
+		uc := NewEpicUsecase(repository.NewEpicRepositoryMock(t), projectRepo, nil)
+
+		_, err := uc.Create(context.Background(), CreateEpicRequest{ProjectID: projectID, Title: "Invoicing v2"})
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+	})
+}
+
+func TestEpicUsecase_ListByProjectID(t *testing.T) {
+	projectID := uuid.New()
+	first := &entity.Epic{ID: uuid.New(), ProjectID: projectID, Title: "Invoicing v2"}
+	second := &entity.Epic{ID: uuid.New(), ProjectID: projectID, Title: "Refunds"}
+
+	epicRepo := repository.NewEpicRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	epicRepo.EXPECT().ListByProjectID(mock.Anything, projectID).Return([]*entity.Epic{first, second}, nil)
+	epicRepo.EXPECT().GetProgress(mock.Anything, []uuid.UUID{first.ID, second.ID}).Return(map[uuid.UUID]*entity.EpicProgress{
+		first.ID:  {EpicID: first.ID, TotalTasks: 4, DoneTasks: 2},
+		second.ID: {EpicID: second.ID},
+	}, nil).Once()
+	uc := NewEpicUsecase(epicRepo, projectRepo, nil)
+
+	summaries, err := uc.ListByProjectID(context.Background(), projectID)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, first, summaries[0].Epic)
+	assert.Equal(t, 4, summaries[0].Progress.TotalTasks)
+	assert.Equal(t, 50.0, summaries[0].Progress.PercentComplete())
+	assert.Equal(t, 0, summaries[1].Progress.TotalTasks)
+}
+
+func TestEpicUsecase_Update(t *testing.T) {
+	epicID := uuid.New()
+	due := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	newEpic := func() *entity.Epic {
+		return &entity.Epic{ID: epicID, Title: "Invoicing v2", Description: "New invoices", DueDate: &due}
+	}
+
+	t.Run("updates the fields set", func(t *testing.T) {
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(newEpic(), nil)
+		epicRepo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*entity.Epic")).Return(nil)
+		epicRepo.EXPECT().GetProgress(mock.Anything, []uuid.UUID{epicID}).Return(map[uuid.UUID]*entity.EpicProgress{
+			epicID: {EpicID: epicID, TotalTasks: 1},
+		}, nil)
+		uc := NewEpicUsecase(epicRepo, repository.NewProjectRepositoryMock(t), nil)
+
+		title := "Invoicing v3"
+		summary, err := uc.Update(context.Background(), epicID, UpdateEpicRequest{Title: &title, ClearDueDate: true})
+		require.NoError(t, err)
+		assert.Equal(t, "Invoicing v3", summary.Epic.Title)
+		assert.Equal(t, "New invoices", summary.Epic.Description)
+		assert.Nil(t, summary.Epic.DueDate)
+		assert.Equal(t, 1, summary.Progress.TotalTasks)
+	})
+
+	t.Run("rejects an empty title", func(t *testing.T) {
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(newEpic(), nil)
+		uc := NewEpicUsecase(epicRepo, repository.NewProjectRepositoryMock(t), nil)
+
+		title := ""
+		_, err := uc.Update(context.Background(), epicID, UpdateEpicRequest{Title: &title})
+		assert.ErrorIs(t, err, ErrEpicTitleRequired)
+	})
+
+	t.Run("unknown epic", func(t *testing.T) {
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(nil, errors.New("epic not found with id"))
+		uc := NewEpicUsecase(epicRepo, repository.NewProjectRepositoryMock(t), nil)
+
+		_, err := uc.Update(context.Background(), epicID, UpdateEpicRequest{})
+		assert.ErrorIs(t, err, ErrEpicNotFound)
+	})
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultEventLimit = 50
+	maxEventLimit     = 200
+)
+
+var ErrEventTypeInvalid = errors.New("invalid event type")
+
+var eventTypes = map[entity.EventType]bool{
+	entity.EventTypeTaskCreated:       true,
+	entity.EventTypeTaskStatusChanged: true,
+	entity.EventTypeTaskDeleted:       true,
+	entity.EventTypeTaskCommitLinked:  true,
+	entity.EventTypeBudgetThreshold:   true,
+}
+
+type EventUsecase interface {
+	// List returns the newest events matching req, newest first
+	List(ctx context.Context, req ListEventsRequest) ([]*entity.Event, error)
+}
+
+type ListEventsRequest struct {
+	ProjectID *uuid.UUID
+	Types     []entity.EventType
+	AfterID   int64
+	// Limit defaults to 50 and is capped at 200
+	Limit int
+}
+
+// TaskEventData is the snapshot stored with task events. It is flat so
+// no-code tools can map its fields directly.
+type TaskEventData struct {
+	TaskID      uuid.UUID           `json:"task_id"`
+	ProjectID   uuid.UUID           `json:"project_id"`
+	Title       string              `json:"title"`
+	Status      entity.TaskStatus   `json:"status"`
+	OldStatus   entity.TaskStatus   `json:"old_status,omitempty"`
+	Priority    entity.TaskPriority `json:"priority"`
+	AssignedTo  *string             `json:"assigned_to,omitempty"`
+	BranchName  *string             `json:"branch_name,omitempty"`
+	PullRequest *string             `json:"pull_request,omitempty"`
+}
+
+type eventUsecase struct {
+	eventRepo repository.EventRepository
+}
+
+func NewEventUsecase(eventRepo repository.EventRepository) EventUsecase {
+	return &eventUsecase{
+		eventRepo: eventRepo,
+	}
+}
+
+func (u *eventUsecase) List(ctx context.Context, req ListEventsRequest) ([]*entity.Event, error) {
+	for _, t := range req.Types {
+		if !eventTypes[t] {
+			return nil, ErrEventTypeInvalid
+		}
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultEventLimit
+	}
+	if limit > maxEventLimit {
+		limit = maxEventLimit
+	}
+
+	return u.eventRepo.List(ctx, entity.EventFilter{
+		ProjectID: req.ProjectID,
+		Types:     req.Types,
+		AfterID:   req.AfterID,
+		Limit:     limit,
+	})
+}
+
+// taskEventData snapshots task for an event
+func taskEventData(task *entity.Task, oldStatus entity.TaskStatus) TaskEventData {
+	return TaskEventData{
+		TaskID:      task.ID,
+		ProjectID:   task.ProjectID,
+		Title:       task.Title,
+		Status:      task.Status,
+		OldStatus:   oldStatus,
+		Priority:    task.Priority,
+		AssignedTo:  task.AssignedTo,
+		BranchName:  task.BranchName,
+		PullRequest: task.PullRequest,
+	}
+}
+
+// recordTaskEvent appends a task event to the feed with a snapshot of task
+func recordTaskEvent(ctx context.Context, eventRepo repository.EventRepository, eventType entity.EventType, task *entity.Task, oldStatus entity.TaskStatus) {
+	if task == nil {
+		return
+	}
+	recordEvent(ctx, eventRepo, eventType, task, taskEventData(task, oldStatus))
+}
+
+// recordEvent appends an event about task to the feed. Like the job callbacks
+// it is best-effort: failures are logged and never fail the operation.
+func recordEvent(ctx context.Context, eventRepo repository.EventRepository, eventType entity.EventType, task *entity.Task, data any) {
+	if eventRepo == nil {
+		return
+	}
+
+	encoded, err := json.Marshal(data)
+	if err == nil {
+		taskID := task.ID
+		err = eventRepo.Create(ctx, &entity.Event{
+			Type:      eventType,
+			ProjectID: task.ProjectID,
+			TaskID:    &taskID,
+			Data:      string(encoded),
+		})
+	}
+	if err != nil {
+		slog.Warn("Failed to record task event",
+			"task_id", task.ID,
+			"type", eventType,
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventUsecase_List(t *testing.T) {
+	t.Run("defaults and caps the limit", func(t *testing.T) {
+		eventRepo := repository.NewEventRepositoryMock(t)
+		uc := NewEventUsecase(eventRepo)
+
+		eventRepo.EXPECT().List(mock.Anything, entity.EventFilter{Limit: defaultEventLimit}).Return(nil, nil)
+		eventRepo.EXPECT().List(mock.Anything, entity.EventFilter{AfterID: 7, Limit: maxEventLimit}).Return(nil, nil)
+
+		_, err := uc.List(context.Background(), ListEventsRequest{})
+		require.NoError(t, err)
+		_, err = uc.List(context.Background(), ListEventsRequest{AfterID: 7, Limit: 1000})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects unknown event types", func(t *testing.T) {
+		uc := NewEventUsecase(repository.NewEventRepositoryMock(t))
+
+		_, err := uc.List(context.Background(), ListEventsRequest{Types: []entity.EventType{"task.exploded"}})
+		assert.ErrorIs(t, err, ErrEventTypeInvalid)
+	})
+}
+
+func TestTaskUsecase_RecordsStatusChangeEvent(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	eventRepo := repository.NewEventRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, eventRepo: eventRepo}
+	id := uuid.New()
+	projectID := uuid.New()
+
+	taskRepo.EXPECT().GetByID(mock.Anything, id).Return(&entity.Task{ID: id, ProjectID: projectID, Title: "Task", Status: entity.TaskStatusTODO}, nil).Once()
+	taskRepo.EXPECT().UpdateStatus(mock.Anything, id, entity.TaskStatusPLANNING).Return(nil)
+	taskRepo.EXPECT().GetByID(mock.Anything, id).Return(&entity.Task{ID: id, ProjectID: projectID, Title: "Task", Status: entity.TaskStatusPLANNING}, nil).Once()
+
+	var recorded *entity.Event
+	eventRepo.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, event *entity.Event) {
+		recorded = event
+	}).Return(nil)
+
+	_, err := uc.UpdateStatus(context.Background(), id, entity.TaskStatusPLANNING)
+	require.NoError(t, err)
+
+	require.NotNil(t, recorded)
+	assert.Equal(t, entity.EventTypeTaskStatusChanged, recorded.Type)
+	assert.Equal(t, projectID, recorded.ProjectID)
+	var data TaskEventData
+	require.NoError(t, json.Unmarshal([]byte(recorded.Data), &data))
+	assert.Equal(t, entity.TaskStatusTODO, data.OldStatus)
+	assert.Equal(t, entity.TaskStatusPLANNING, data.Status)
+}
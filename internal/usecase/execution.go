@@ -9,6 +9,7 @@ import (
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +41,16 @@ type ExecutionUsecase interface {
 	BatchAddLogs(ctx context.Context, logs []AddExecutionLogRequest) error
 	GetLogStats(ctx context.Context, executionID uuid.UUID) (*repository.LogStats, error)
 
+	// Verification
+	GetVerificationRuns(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error)
+	GetReviewComments(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error)
+	GetSecurityFindings(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error)
+
+	// Retries
+	// GetAttempts returns the attempt chain of an execution, from its first
+	// attempt to its latest retry
+	GetAttempts(ctx context.Context, executionID uuid.UUID) ([]*entity.Execution, error)
+
 	// Validation
 	ValidateExecutionExists(ctx context.Context, id uuid.UUID) error
 	ValidateTaskExists(ctx context.Context, taskID uuid.UUID) error
@@ -79,6 +90,9 @@ type GetExecutionLogsRequest struct {
 	Offset     int
 	OrderBy    string
 	OrderDir   string
+	// Decrypt opens encrypted logs for maintainers of the project; they are
+	// returned still encrypted otherwise
+	Decrypt bool
 }
 
 type AddExecutionLogRequest struct {
@@ -93,9 +107,14 @@ type AddExecutionLogRequest struct {
 
 // ExecutionUsecaseImpl implements ExecutionUsecase
 type ExecutionUsecaseImpl struct {
-	executionRepo    repository.ExecutionRepository
-	executionLogRepo repository.ExecutionLogRepository
-	taskRepo         repository.TaskRepository
+	projectAccess
+	executionRepo       repository.ExecutionRepository
+	executionLogRepo    repository.ExecutionLogRepository
+	taskRepo            repository.TaskRepository
+	verificationRunRepo repository.VerificationRunRepository
+	reviewCommentRepo   repository.ReviewCommentRepository
+	securityFindingRepo repository.SecurityFindingRepository
+	logSealer           *secrets.LogSealer
 }
 
 // NewExecutionUsecase creates a new execution usecase
@@ -103,11 +122,21 @@ func NewExecutionUsecase(
 	executionRepo repository.ExecutionRepository,
 	executionLogRepo repository.ExecutionLogRepository,
 	taskRepo repository.TaskRepository,
+	verificationRunRepo repository.VerificationRunRepository,
+	reviewCommentRepo repository.ReviewCommentRepository,
+	securityFindingRepo repository.SecurityFindingRepository,
+	memberRepo repository.ProjectMemberRepository,
+	logSealer *secrets.LogSealer,
 ) ExecutionUsecase {
 	return &ExecutionUsecaseImpl{
-		executionRepo:    executionRepo,
-		executionLogRepo: executionLogRepo,
-		taskRepo:         taskRepo,
+		projectAccess:       projectAccess{memberRepo: memberRepo},
+		executionRepo:       executionRepo,
+		executionLogRepo:    executionLogRepo,
+		taskRepo:            taskRepo,
+		verificationRunRepo: verificationRunRepo,
+		reviewCommentRepo:   reviewCommentRepo,
+		securityFindingRepo: securityFindingRepo,
+		logSealer:           logSealer,
 	}
 }
 
@@ -302,7 +331,7 @@ func (u *ExecutionUsecaseImpl) GetRecentExecutions(ctx context.Context, limit in
 
 // GetExecutionLogs retrieves execution logs with filtering
 func (u *ExecutionUsecaseImpl) GetExecutionLogs(ctx context.Context, executionID uuid.UUID, req GetExecutionLogsRequest) ([]*entity.ExecutionLog, int64, error) {
-	if err := u.ValidateExecutionExists(ctx, executionID); err != nil {
+	if err := u.authorizeExecution(ctx, u.executionRepo, u.taskRepo, executionID, entity.ProjectRoleViewer); err != nil {
 		return nil, 0, err
 	}
 
@@ -312,6 +341,17 @@ func (u *ExecutionUsecaseImpl) GetExecutionLogs(ctx context.Context, executionID
 		return nil, 0, fmt.Errorf("failed to get execution logs: %w", err)
 	}
 
+	// Logs that cannot be opened, e.g. without a key or sealed under one
+	// since replaced, are returned still encrypted, as are the logs of
+	// callers below maintainer
+	decrypt := req.Decrypt && u.logSealer != nil &&
+		u.authorizeExecution(ctx, u.executionRepo, u.taskRepo, executionID, entity.ProjectRoleMaintainer) == nil
+	if decrypt {
+		for _, log := range logs {
+			_ = u.logSealer.Open(log)
+		}
+	}
+
 	return logs, int64(len(logs)), nil
 }
 
@@ -399,6 +439,58 @@ func (u *ExecutionUsecaseImpl) GetLogStats(ctx context.Context, executionID uuid
 	return stats, nil
 }
 
+// GetVerificationRuns retrieves the verification runs of an execution
+func (u *ExecutionUsecaseImpl) GetVerificationRuns(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error) {
+	if err := u.ValidateExecutionExists(ctx, executionID); err != nil {
+		return nil, err
+	}
+
+	runs, err := u.verificationRunRepo.ListByExecutionID(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verification runs: %w", err)
+	}
+	return runs, nil
+}
+
+// GetAttempts retrieves the attempt chain of an execution
+func (u *ExecutionUsecaseImpl) GetAttempts(ctx context.Context, executionID uuid.UUID) ([]*entity.Execution, error) {
+	if err := u.ValidateExecutionExists(ctx, executionID); err != nil {
+		return nil, err
+	}
+
+	attempts, err := u.executionRepo.GetAttempts(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get execution attempts: %w", err)
+	}
+	return attempts, nil
+}
+
+// GetReviewComments retrieves the AI review findings of an execution
+func (u *ExecutionUsecaseImpl) GetReviewComments(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error) {
+	if err := u.ValidateExecutionExists(ctx, executionID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.reviewCommentRepo.ListByExecutionID(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review comments: %w", err)
+	}
+	return comments, nil
+}
+
+// GetSecurityFindings retrieves the security scan findings of an execution
+func (u *ExecutionUsecaseImpl) GetSecurityFindings(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error) {
+	if err := u.ValidateExecutionExists(ctx, executionID); err != nil {
+		return nil, err
+	}
+
+	findings, err := u.securityFindingRepo.ListByExecutionID(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security findings: %w", err)
+	}
+	return findings, nil
+}
+
 // ValidateExecutionExists validates that an execution exists
 func (u *ExecutionUsecaseImpl) ValidateExecutionExists(ctx context.Context, id uuid.UUID) error {
 	exists, err := u.executionRepo.ValidateExecutionExists(ctx, id)
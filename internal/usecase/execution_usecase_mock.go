@@ -246,6 +246,63 @@ func (_c *ExecutionUsecaseMock_Delete_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// GetAttempts provides a mock function for the type ExecutionUsecaseMock
+func (_mock *ExecutionUsecaseMock) GetAttempts(ctx context.Context, executionID uuid.UUID) ([]*entity.Execution, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttempts")
+	}
+
+	var r0 []*entity.Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Execution, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Execution); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionUsecaseMock_GetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttempts'
+type ExecutionUsecaseMock_GetAttempts_Call struct {
+	*mock.Call
+}
+
+// GetAttempts is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+func (_e *ExecutionUsecaseMock_Expecter) GetAttempts(ctx interface{}, executionID interface{}) *ExecutionUsecaseMock_GetAttempts_Call {
+	return &ExecutionUsecaseMock_GetAttempts_Call{Call: _e.mock.On("GetAttempts", ctx, executionID)}
+}
+
+func (_c *ExecutionUsecaseMock_GetAttempts_Call) Run(run func(ctx context.Context, executionID uuid.UUID)) *ExecutionUsecaseMock_GetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetAttempts_Call) Return(_a0 []*entity.Execution, _a1 error) *ExecutionUsecaseMock_GetAttempts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetAttempts_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID) ([]*entity.Execution, error)) *ExecutionUsecaseMock_GetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type ExecutionUsecaseMock
 func (_mock *ExecutionUsecaseMock) GetByID(ctx context.Context, id uuid.UUID) (*entity.Execution, error) {
 	ret := _mock.Called(ctx, id)
@@ -658,6 +715,177 @@ func (_c *ExecutionUsecaseMock_GetRecentExecutions_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GetReviewComments provides a mock function for the type ExecutionUsecaseMock
+func (_mock *ExecutionUsecaseMock) GetReviewComments(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewComments")
+	}
+
+	var r0 []*entity.ReviewComment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ReviewComment, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ReviewComment); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ReviewComment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionUsecaseMock_GetReviewComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReviewComments'
+type ExecutionUsecaseMock_GetReviewComments_Call struct {
+	*mock.Call
+}
+
+// GetReviewComments is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+func (_e *ExecutionUsecaseMock_Expecter) GetReviewComments(ctx interface{}, executionID interface{}) *ExecutionUsecaseMock_GetReviewComments_Call {
+	return &ExecutionUsecaseMock_GetReviewComments_Call{Call: _e.mock.On("GetReviewComments", ctx, executionID)}
+}
+
+func (_c *ExecutionUsecaseMock_GetReviewComments_Call) Run(run func(ctx context.Context, executionID uuid.UUID)) *ExecutionUsecaseMock_GetReviewComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetReviewComments_Call) Return(reviewComments []*entity.ReviewComment, err error) *ExecutionUsecaseMock_GetReviewComments_Call {
+	_c.Call.Return(reviewComments, err)
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetReviewComments_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID) ([]*entity.ReviewComment, error)) *ExecutionUsecaseMock_GetReviewComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecurityFindings provides a mock function for the type ExecutionUsecaseMock
+func (_mock *ExecutionUsecaseMock) GetSecurityFindings(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecurityFindings")
+	}
+
+	var r0 []*entity.SecurityFinding
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.SecurityFinding, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.SecurityFinding); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SecurityFinding)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionUsecaseMock_GetSecurityFindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecurityFindings'
+type ExecutionUsecaseMock_GetSecurityFindings_Call struct {
+	*mock.Call
+}
+
+// GetSecurityFindings is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+func (_e *ExecutionUsecaseMock_Expecter) GetSecurityFindings(ctx interface{}, executionID interface{}) *ExecutionUsecaseMock_GetSecurityFindings_Call {
+	return &ExecutionUsecaseMock_GetSecurityFindings_Call{Call: _e.mock.On("GetSecurityFindings", ctx, executionID)}
+}
+
+func (_c *ExecutionUsecaseMock_GetSecurityFindings_Call) Run(run func(ctx context.Context, executionID uuid.UUID)) *ExecutionUsecaseMock_GetSecurityFindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetSecurityFindings_Call) Return(securityFindings []*entity.SecurityFinding, err error) *ExecutionUsecaseMock_GetSecurityFindings_Call {
+	_c.Call.Return(securityFindings, err)
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetSecurityFindings_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID) ([]*entity.SecurityFinding, error)) *ExecutionUsecaseMock_GetSecurityFindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerificationRuns provides a mock function for the type ExecutionUsecaseMock
+func (_mock *ExecutionUsecaseMock) GetVerificationRuns(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationRuns")
+	}
+
+	var r0 []*entity.VerificationRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.VerificationRun, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.VerificationRun); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.VerificationRun)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ExecutionUsecaseMock_GetVerificationRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationRuns'
+type ExecutionUsecaseMock_GetVerificationRuns_Call struct {
+	*mock.Call
+}
+
+// GetVerificationRuns is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+func (_e *ExecutionUsecaseMock_Expecter) GetVerificationRuns(ctx interface{}, executionID interface{}) *ExecutionUsecaseMock_GetVerificationRuns_Call {
+	return &ExecutionUsecaseMock_GetVerificationRuns_Call{Call: _e.mock.On("GetVerificationRuns", ctx, executionID)}
+}
+
+func (_c *ExecutionUsecaseMock_GetVerificationRuns_Call) Run(run func(ctx context.Context, executionID uuid.UUID)) *ExecutionUsecaseMock_GetVerificationRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetVerificationRuns_Call) Return(verificationRuns []*entity.VerificationRun, err error) *ExecutionUsecaseMock_GetVerificationRuns_Call {
+	_c.Call.Return(verificationRuns, err)
+	return _c
+}
+
+func (_c *ExecutionUsecaseMock_GetVerificationRuns_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID) ([]*entity.VerificationRun, error)) *ExecutionUsecaseMock_GetVerificationRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithLogs provides a mock function for the type ExecutionUsecaseMock
 func (_mock *ExecutionUsecaseMock) GetWithLogs(ctx context.Context, id uuid.UUID, logLimit int) (*entity.Execution, error) {
 	ret := _mock.Called(ctx, id, logLimit)
@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/githubissues"
+	"github.com/auto-devs/auto-devs/internal/service/vcs"
+	"github.com/google/uuid"
+)
+
+type GitHubIssueUsecase interface {
+	// Import creates a task for every selected issue of a GitHub repository
+	// that has not been imported yet, and refreshes the fields of those that
+	// have. Each new task is linked back from its issue with a comment.
+	Import(ctx context.Context, projectID uuid.UUID, req ImportGitHubIssuesRequest) (*GitHubIssueImportResult, error)
+	// CommentPlan posts the plan to the GitHub issue of the task, if it was
+	// imported with comments on
+	CommentPlan(ctx context.Context, task *entity.Task, plan *entity.Plan) error
+	// CommentPullRequest posts a link to the task's pull request to its
+	// GitHub issue, if it was imported with comments on
+	CommentPullRequest(ctx context.Context, task *entity.Task, pr *entity.PullRequest) error
+}
+
+type ImportGitHubIssuesRequest struct {
+	// Repository is the full name, owner/name, of the repository to import
+	// from; empty means the project's repository
+	Repository string `json:"repository"`
+	// Labels are required on every issue imported
+	Labels []string `json:"labels"`
+	// State is open, closed or all; empty means open
+	State string `json:"state"`
+	// Numbers select the issues to import among those matching; empty
+	// imports them all
+	Numbers []int `json:"numbers"`
+	// PostComments posts the plan and the pull request of the tasks to
+	// their issues
+	PostComments bool `json:"post_comments"`
+}
+
+type GitHubIssueImportResult struct {
+	Repository string `json:"repository"`
+	Created    int    `json:"created"`
+	Updated    int    `json:"updated"`
+	Unchanged  int    `json:"unchanged"`
+	// Missing are the selected numbers no matching issue has
+	Missing []int `json:"missing,omitempty"`
+}
+
+// Validation errors
+var (
+	ErrGitHubIssueRepositoryInvalid = errors.New("github issue repository must be given as owner/name, or the project must have a GitHub repository")
+	ErrGitHubIssueStateInvalid      = errors.New("github issue state must be open, closed or all")
+)
+
+var githubRepositoryPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// maxGitHubCommentLength keeps comments under GitHub's limit of 65536
+// characters
+const maxGitHubCommentLength = 60000
+
+type githubIssueUsecase struct {
+	projectAccess
+	taskRepo    repository.TaskRepository
+	projectRepo repository.ProjectRepository
+	client      githubissues.Client
+	// baseURL is where the auto-devs UI is served, for the task links posted
+	// to issues
+	baseURL string
+}
+
+func NewGitHubIssueUsecase(
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	client githubissues.Client,
+	baseURL string,
+) GitHubIssueUsecase {
+	return &githubIssueUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		client:        client,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (u *githubIssueUsecase) Import(ctx context.Context, projectID uuid.UUID, req ImportGitHubIssuesRequest) (*GitHubIssueImportResult, error) {
+	state := strings.TrimSpace(req.State)
+	if state != "" && state != "open" && state != "closed" && state != "all" {
+		return nil, ErrGitHubIssueStateInvalid
+	}
+
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	repo := strings.TrimSpace(req.Repository)
+	if repo == "" && vcs.ProviderFor(project) == entity.GitProviderGitHub {
+		repo = vcs.RepositoryFor(project)
+	}
+	if !githubRepositoryPattern.MatchString(repo) {
+		return nil, ErrGitHubIssueRepositoryInvalid
+	}
+
+	issues, err := u.client.ListIssues(ctx, repo, githubissues.ListFilter{State: state, Labels: req.Labels})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list github issues: %w", err)
+	}
+	if len(req.Numbers) > 0 {
+		issues = slices.DeleteFunc(issues, func(issue githubissues.Issue) bool {
+			return !slices.Contains(req.Numbers, issue.Number)
+		})
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+	imported := make(map[int]*entity.Task)
+	for _, task := range tasks {
+		if task.GitHubIssueNumber != nil && task.GitHubIssueRepository != nil && strings.EqualFold(*task.GitHubIssueRepository, repo) {
+			imported[*task.GitHubIssueNumber] = task
+		}
+	}
+
+	result := &GitHubIssueImportResult{Repository: repo}
+	found := make(map[int]bool, len(issues))
+	for _, issue := range issues {
+		found[issue.Number] = true
+		task, ok := imported[issue.Number]
+		if !ok {
+			issueRepo, number := repo, issue.Number
+			status := entity.TaskStatusTODO
+			if issue.State == "closed" {
+				status = entity.TaskStatusDONE
+			}
+			task = &entity.Task{
+				ID:                    uuid.New(),
+				ProjectID:             projectID,
+				Status:                status,
+				Priority:              entity.TaskPriorityMedium,
+				GitHubIssueRepository: &issueRepo,
+				GitHubIssueNumber:     &number,
+				GitHubIssueComments:   req.PostComments,
+			}
+			applyGitHubIssueFields(task, issue)
+			if err := u.taskRepo.Create(ctx, task); err != nil {
+				return nil, fmt.Errorf("failed to create task for issue #%d: %w", issue.Number, err)
+			}
+			result.Created++
+			// The task stays linked to the issue when the comment fails
+			if err := u.client.CreateComment(ctx, repo, issue.Number, u.linkComment(task)); err != nil {
+				slog.Warn("Failed to link github issue to its task", "repository", repo, "issue", issue.Number, "task_id", task.ID, "error", err)
+			}
+			continue
+		}
+
+		// As with the other imports, the status of an imported task belongs
+		// to the auto-devs workflow from then on
+		changed := applyGitHubIssueFields(task, issue)
+		if task.GitHubIssueComments != req.PostComments {
+			task.GitHubIssueComments = req.PostComments
+			changed = true
+		}
+		if !changed {
+			result.Unchanged++
+			continue
+		}
+		if err := u.taskRepo.Update(ctx, task); err != nil {
+			return nil, fmt.Errorf("failed to update task for issue #%d: %w", issue.Number, err)
+		}
+		result.Updated++
+	}
+
+	for _, number := range req.Numbers {
+		if !found[number] && !slices.Contains(result.Missing, number) {
+			result.Missing = append(result.Missing, number)
+		}
+	}
+	return result, nil
+}
+
+func (u *githubIssueUsecase) CommentPlan(ctx context.Context, task *entity.Task, plan *entity.Plan) error {
+	if !task.GitHubIssueComments || task.GitHubIssueRepository == nil || task.GitHubIssueNumber == nil {
+		return nil
+	}
+	body := fmt.Sprintf("The plan for %s is ready for review:\n\n%s", u.taskReference(task), plan.Content)
+	return u.client.CreateComment(ctx, *task.GitHubIssueRepository, *task.GitHubIssueNumber, truncateRunes(body, maxGitHubCommentLength))
+}
+
+func (u *githubIssueUsecase) CommentPullRequest(ctx context.Context, task *entity.Task, pr *entity.PullRequest) error {
+	if !task.GitHubIssueComments || task.GitHubIssueRepository == nil || task.GitHubIssueNumber == nil {
+		return nil
+	}
+	body := fmt.Sprintf("%s was implemented in %s", u.taskReference(task), pr.GitHubURL)
+	return u.client.CreateComment(ctx, *task.GitHubIssueRepository, *task.GitHubIssueNumber, body)
+}
+
+// linkComment is the comment linking an issue to the task imported from it
+func (u *githubIssueUsecase) linkComment(task *entity.Task) string {
+	return fmt.Sprintf("Imported into auto-devs as %s.", u.taskReference(task))
+}
+
+// taskReference is the task's key, linked to the task when the UI's URL is
+// known
+func (u *githubIssueUsecase) taskReference(task *entity.Task) string {
+	key := entity.TaskKey(task.Number)
+	if u.baseURL == "" {
+		return key
+	}
+	return fmt.Sprintf("[%s](%s/projects/%s/tasks/%s)", key, u.baseURL, task.ProjectID, task.ID)
+}
+
+// applyGitHubIssueFields copies the issue's fields onto the task and reports
+// whether anything changed.
+func applyGitHubIssueFields(task *entity.Task, issue githubissues.Issue) bool {
+	return applyImportedFields(task, issue.Title, issue.Body, issue.URL, issue.Assignees, issue.Labels)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/githubissues"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGitHubIssuesClient struct {
+	filter   githubissues.ListFilter
+	issues   []githubissues.Issue
+	comments map[int][]string
+}
+
+func (f *fakeGitHubIssuesClient) ListIssues(ctx context.Context, repo string, filter githubissues.ListFilter) ([]githubissues.Issue, error) {
+	f.filter = filter
+	return f.issues, nil
+}
+
+func (f *fakeGitHubIssuesClient) CreateComment(ctx context.Context, repo string, number int, body string) error {
+	if f.comments == nil {
+		f.comments = make(map[int][]string)
+	}
+	f.comments[number] = append(f.comments[number], body)
+	return nil
+}
+
+func TestGitHubIssueImport_CreatesAndRefreshesTasks(t *testing.T) {
+	projectID := uuid.New()
+	client := &fakeGitHubIssuesClient{issues: []githubissues.Issue{
+		{Number: 1, Title: "Crash on login", Body: "Stack trace", URL: "https://github.com/acme/app/issues/1", State: "open", Labels: []string{"bug"}, Assignees: []string{"ada"}},
+		{Number: 2, Title: "Renamed upstream", State: "open", Labels: []string{"bug"}},
+		{Number: 3, Title: "Not selected", State: "open", Labels: []string{"bug"}},
+		{Number: 4, Title: "Fixed already", State: "closed", Labels: []string{"bug"}},
+	}}
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	uc := NewGitHubIssueUsecase(taskRepo, projectRepo, nil, client, "https://auto-devs.acme.com/")
+
+	repo, issue2 := "acme/app", 2
+	existing := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Old title", Status: entity.TaskStatusIMPLEMENTING, GitHubIssueRepository: &repo, GitHubIssueNumber: &issue2}
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID, RepositoryURL: "git@github.com:acme/app.git"}, nil).Once()
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{existing}, nil).Once()
+
+	var created []*entity.Task
+	taskRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, task *entity.Task) error {
+		task.Number = int64(40 + len(created))
+		created = append(created, task)
+		return nil
+	}).Times(2)
+	taskRepo.EXPECT().Update(mock.Anything, existing).Return(nil).Once()
+
+	result, err := uc.Import(context.Background(), projectID, ImportGitHubIssuesRequest{
+		Labels:       []string{"bug"},
+		State:        "all",
+		Numbers:      []int{1, 2, 4, 9},
+		PostComments: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, githubissues.ListFilter{State: "all", Labels: []string{"bug"}}, client.filter)
+	assert.Equal(t, &GitHubIssueImportResult{Repository: "acme/app", Created: 2, Updated: 1, Missing: []int{9}}, result)
+
+	require.Len(t, created, 2)
+	assert.Equal(t, 1, *created[0].GitHubIssueNumber)
+	assert.Equal(t, "acme/app", *created[0].GitHubIssueRepository)
+	assert.True(t, created[0].GitHubIssueComments)
+	assert.Equal(t, entity.TaskStatusTODO, created[0].Status)
+	assert.Equal(t, "Stack trace\n\nhttps://github.com/acme/app/issues/1", created[0].Description)
+	assert.Equal(t, "ada", *created[0].AssignedTo)
+	assert.Equal(t, []string{"bug"}, created[0].Tags)
+	assert.Equal(t, entity.TaskStatusDONE, created[1].Status)
+
+	// The workflow status of the task is kept
+	assert.Equal(t, "Renamed upstream", existing.Title)
+	assert.Equal(t, entity.TaskStatusIMPLEMENTING, existing.Status)
+	assert.True(t, existing.GitHubIssueComments)
+
+	// Only the new tasks are linked from their issue
+	assert.Equal(t, map[int][]string{
+		1: {"Imported into auto-devs as [AD-40](https://auto-devs.acme.com/projects/" + projectID.String() + "/tasks/" + created[0].ID.String() + ")."},
+		4: {"Imported into auto-devs as [AD-41](https://auto-devs.acme.com/projects/" + projectID.String() + "/tasks/" + created[1].ID.String() + ")."},
+	}, client.comments)
+}
+
+func TestGitHubIssueImport_Validation(t *testing.T) {
+	projectID := uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := NewGitHubIssueUsecase(nil, projectRepo, nil, &fakeGitHubIssuesClient{}, "")
+
+	_, err := uc.Import(context.Background(), projectID, ImportGitHubIssuesRequest{State: "merged"})
+	assert.ErrorIs(t, err, ErrGitHubIssueStateInvalid)
+
+	// Without a repository given, a GitLab project has none to import from
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID, RepositoryURL: "https://gitlab.com/acme/app.git"}, nil)
+	_, err = uc.Import(context.Background(), projectID, ImportGitHubIssuesRequest{})
+	assert.ErrorIs(t, err, ErrGitHubIssueRepositoryInvalid)
+}
+
+func TestGitHubIssueComments(t *testing.T) {
+	repo, number := "acme/app", 7
+	client := &fakeGitHubIssuesClient{}
+	uc := NewGitHubIssueUsecase(nil, nil, nil, client, "")
+	task := &entity.Task{ID: uuid.New(), Number: 12, GitHubIssueRepository: &repo, GitHubIssueNumber: &number, GitHubIssueComments: true}
+
+	require.NoError(t, uc.CommentPlan(context.Background(), task, &entity.Plan{Content: "1. Fix the token refresh"}))
+	require.NoError(t, uc.CommentPullRequest(context.Background(), task, &entity.PullRequest{GitHubURL: "https://github.com/acme/app/pull/8"}))
+	// Tasks imported without comments are left alone
+	task.GitHubIssueComments = false
+	require.NoError(t, uc.CommentPlan(context.Background(), task, &entity.Plan{Content: "ignored"}))
+
+	assert.Equal(t, []string{
+		"The plan for AD-12 is ready for review:\n\n1. Fix the token refresh",
+		"AD-12 was implemented in https://github.com/acme/app/pull/8",
+	}, client.comments[7])
+}
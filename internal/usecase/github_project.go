@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/githubprojects"
+	"github.com/google/uuid"
+)
+
+type GitHubProjectUsecase interface {
+	// Import creates a task for every item on a GitHub Projects (v2) board
+	// that has not been imported yet, and refreshes the fields of those that
+	// have.
+	Import(ctx context.Context, projectID uuid.UUID, req ImportGitHubProjectRequest) (*GitHubProjectImportResult, error)
+}
+
+type ImportGitHubProjectRequest struct {
+	// Owner is the user or organization login owning the board
+	Owner  string `json:"owner"`
+	Number int    `json:"number"`
+	// StatusField is the single-select field holding the board columns;
+	// empty means "Status"
+	StatusField   string                       `json:"status_field"`
+	ColumnMapping map[string]entity.TaskStatus `json:"column_mapping"`
+}
+
+type GitHubProjectImportResult struct {
+	Board string `json:"board"`
+	// Columns is the status new tasks in each board column start in
+	Columns   map[string]entity.TaskStatus `json:"columns"`
+	Created   int                          `json:"created"`
+	Updated   int                          `json:"updated"`
+	Unchanged int                          `json:"unchanged"`
+}
+
+// Validation errors
+var (
+	ErrGitHubProjectOwnerRequired       = errors.New("github project owner is required")
+	ErrGitHubProjectNumberInvalid       = errors.New("github project number must be positive")
+	ErrGitHubProjectImportStatusInvalid = errors.New("github project column mapping may only import tasks as TODO, DONE or CANCELLED")
+)
+
+const defaultGitHubProjectStatusField = "Status"
+
+// doneColumns and cancelledColumns are the column names of the built-in
+// GitHub project templates (and common variations) that unmapped columns are
+// matched against
+var (
+	doneColumns      = []string{"done", "completed", "closed", "shipped"}
+	cancelledColumns = []string{"cancelled", "canceled", "won't do", "wont do"}
+)
+
+type githubProjectUsecase struct {
+	projectAccess
+	taskRepo    repository.TaskRepository
+	projectRepo repository.ProjectRepository
+	client      githubprojects.Client
+}
+
+func NewGitHubProjectUsecase(
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	client githubprojects.Client,
+) GitHubProjectUsecase {
+	return &githubProjectUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		taskRepo:    taskRepo,
+		projectRepo: projectRepo,
+		client:      client,
+	}
+}
+
+func (u *githubProjectUsecase) Import(ctx context.Context, projectID uuid.UUID, req ImportGitHubProjectRequest) (*GitHubProjectImportResult, error) {
+	owner := strings.TrimSpace(req.Owner)
+	if owner == "" {
+		return nil, ErrGitHubProjectOwnerRequired
+	}
+	if req.Number <= 0 {
+		return nil, ErrGitHubProjectNumberInvalid
+	}
+	for _, status := range req.ColumnMapping {
+		if !importStatuses[status] {
+			return nil, ErrGitHubProjectImportStatusInvalid
+		}
+	}
+	statusField := strings.TrimSpace(req.StatusField)
+	if statusField == "" {
+		statusField = defaultGitHubProjectStatusField
+	}
+
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+
+	board, err := u.client.GetBoard(ctx, owner, req.Number, statusField)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read github project: %w", err)
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+	imported := make(map[string]*entity.Task)
+	for _, task := range tasks {
+		if task.GitHubProjectItemID != nil {
+			imported[*task.GitHubProjectItemID] = task
+		}
+	}
+
+	result := &GitHubProjectImportResult{
+		Board:   board.Title,
+		Columns: make(map[string]entity.TaskStatus, len(board.Columns)),
+	}
+	for _, column := range board.Columns {
+		result.Columns[column] = columnStatus(req.ColumnMapping, column)
+	}
+
+	for _, item := range board.Items {
+		task, ok := imported[item.ID]
+		if !ok {
+			itemID := item.ID
+			task = &entity.Task{
+				ID:                  uuid.New(),
+				ProjectID:           projectID,
+				Status:              itemImportStatus(req.ColumnMapping, item),
+				Priority:            entity.TaskPriorityMedium,
+				GitHubProjectItemID: &itemID,
+			}
+			applyProjectItemFields(task, item)
+			if err := u.taskRepo.Create(ctx, task); err != nil {
+				return nil, fmt.Errorf("failed to create task for item %s: %w", item.ID, err)
+			}
+			result.Created++
+			continue
+		}
+
+		// As with Jira, the status of an imported task belongs to the
+		// auto-devs workflow from then on
+		if !applyProjectItemFields(task, item) {
+			result.Unchanged++
+			continue
+		}
+		if err := u.taskRepo.Update(ctx, task); err != nil {
+			return nil, fmt.Errorf("failed to update task for item %s: %w", item.ID, err)
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
+
+// columnStatus returns the status tasks in column start in: the mapped one if
+// the column is mapped, DONE or CANCELLED for columns named like it, and TODO
+// for everything else.
+func columnStatus(mapping map[string]entity.TaskStatus, column string) entity.TaskStatus {
+	for name, status := range mapping {
+		if strings.EqualFold(name, column) {
+			return status
+		}
+	}
+	normalized := strings.ToLower(strings.TrimSpace(column))
+	switch {
+	case slices.Contains(doneColumns, normalized):
+		return entity.TaskStatusDONE
+	case slices.Contains(cancelledColumns, normalized):
+		return entity.TaskStatusCANCELLED
+	}
+	return entity.TaskStatusTODO
+}
+
+// itemImportStatus picks the status a new task starts in. Items outside any
+// column follow their issue or pull request instead: closed or merged ones
+// are DONE.
+func itemImportStatus(mapping map[string]entity.TaskStatus, item githubprojects.Item) entity.TaskStatus {
+	if item.Column != "" {
+		return columnStatus(mapping, item.Column)
+	}
+	if item.State == "CLOSED" || item.State == "MERGED" {
+		return entity.TaskStatusDONE
+	}
+	return entity.TaskStatusTODO
+}
+
+// applyProjectItemFields copies the item's fields onto the task and reports
+// whether anything changed.
+func applyProjectItemFields(task *entity.Task, item githubprojects.Item) bool {
+	return applyImportedFields(task, item.Title, item.Body, item.URL, item.Assignees, item.Labels)
+}
+
+// applyImportedFields copies the fields of an issue or project item imported
+// from GitHub onto the task and reports whether anything changed.
+func applyImportedFields(task *entity.Task, title, body, url string, assignees, labels []string) bool {
+	title = truncateRunes(title, maxTaskTitleLength)
+	description := body
+	if url != "" {
+		// Keep the link back to the issue even when the body is cut short
+		description = truncateRunes(description, maxTaskDescriptionLength-len([]rune(url))-2)
+		description = strings.TrimSpace(description + "\n\n" + url)
+	} else {
+		description = truncateRunes(description, maxTaskDescriptionLength)
+	}
+	var assignee *string
+	if len(assignees) > 0 {
+		assignee = &assignees[0]
+	}
+
+	changed := task.Title != title ||
+		task.Description != description ||
+		!equalStringPtr(task.AssignedTo, assignee) ||
+		!slices.Equal(task.Tags, labels)
+
+	task.Title = title
+	task.Description = description
+	task.AssignedTo = assignee
+	task.Tags = labels
+	return changed
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/githubprojects"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeGitHubProjectsClient struct {
+	statusField string
+	board       *githubprojects.Board
+}
+
+func (f *fakeGitHubProjectsClient) GetBoard(ctx context.Context, owner string, number int, statusField string) (*githubprojects.Board, error) {
+	f.statusField = statusField
+	return f.board, nil
+}
+
+func TestGitHubProjectImport_CreatesAndRefreshesTasks(t *testing.T) {
+	projectID := uuid.New()
+	client := &fakeGitHubProjectsClient{board: &githubprojects.Board{
+		Title:   "Roadmap",
+		Columns: []string{"Todo", "In Progress", "Done", "Icebox"},
+		Items: []githubprojects.Item{
+			{ID: "I1", Column: "Todo", Title: "Add login", Body: "Use JWT", URL: "https://github.com/acme/app/issues/1", Assignees: []string{"ada"}, Labels: []string{"auth"}},
+			{ID: "I2", Column: "Done", Title: "Renamed upstream"},
+			{ID: "I3", Column: "In Progress", Title: "Unchanged"},
+			{ID: "I4", Column: "Icebox", Title: "Someday"},
+			{ID: "I5", Title: "Merged without a column", State: "MERGED"},
+		},
+	}}
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	uc := NewGitHubProjectUsecase(taskRepo, projectRepo, nil, client)
+
+	item2, item3 := "I2", "I3"
+	existing := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Old title", Status: entity.TaskStatusIMPLEMENTING, GitHubProjectItemID: &item2}
+	unchanged := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Unchanged", Status: entity.TaskStatusTODO, GitHubProjectItemID: &item3}
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil).Once()
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{existing, unchanged}, nil).Once()
+
+	var created []*entity.Task
+	taskRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, task *entity.Task) error {
+		created = append(created, task)
+		return nil
+	}).Times(3)
+	taskRepo.EXPECT().Update(mock.Anything, existing).Return(nil).Once()
+
+	result, err := uc.Import(context.Background(), projectID, ImportGitHubProjectRequest{
+		Owner:         "acme",
+		Number:        3,
+		ColumnMapping: map[string]entity.TaskStatus{"icebox": entity.TaskStatusCANCELLED},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Status", client.statusField)
+	assert.Equal(t, &GitHubProjectImportResult{
+		Board: "Roadmap",
+		Columns: map[string]entity.TaskStatus{
+			"Todo":        entity.TaskStatusTODO,
+			"In Progress": entity.TaskStatusTODO,
+			"Done":        entity.TaskStatusDONE,
+			"Icebox":      entity.TaskStatusCANCELLED,
+		},
+		Created:   3,
+		Updated:   1,
+		Unchanged: 1,
+	}, result)
+
+	require.Len(t, created, 3)
+	assert.Equal(t, "I1", *created[0].GitHubProjectItemID)
+	assert.Equal(t, entity.TaskStatusTODO, created[0].Status)
+	assert.Equal(t, "Use JWT\n\nhttps://github.com/acme/app/issues/1", created[0].Description)
+	assert.Equal(t, "ada", *created[0].AssignedTo)
+	assert.Equal(t, []string{"auth"}, created[0].Tags)
+	assert.Equal(t, entity.TaskStatusCANCELLED, created[1].Status, "mapped columns match case-insensitively")
+	assert.Equal(t, entity.TaskStatusDONE, created[2].Status, "items outside a column follow their pull request")
+
+	// Re-imports refresh fields but leave the workflow status alone
+	assert.Equal(t, "Renamed upstream", existing.Title)
+	assert.Equal(t, entity.TaskStatusIMPLEMENTING, existing.Status)
+}
+
+func TestGitHubProjectImport_Validation(t *testing.T) {
+	uc := NewGitHubProjectUsecase(nil, nil, nil, &fakeGitHubProjectsClient{})
+	valid := ImportGitHubProjectRequest{Owner: "acme", Number: 1}
+
+	req := valid
+	req.Owner = " "
+	_, err := uc.Import(context.Background(), uuid.New(), req)
+	assert.ErrorIs(t, err, ErrGitHubProjectOwnerRequired)
+
+	req = valid
+	req.Number = 0
+	_, err = uc.Import(context.Background(), uuid.New(), req)
+	assert.ErrorIs(t, err, ErrGitHubProjectNumberInvalid)
+
+	req = valid
+	req.ColumnMapping = map[string]entity.TaskStatus{"In Progress": entity.TaskStatusIMPLEMENTING}
+	_, err = uc.Import(context.Background(), uuid.New(), req)
+	assert.ErrorIs(t, err, ErrGitHubProjectImportStatusInvalid)
+}
@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/jira"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/google/uuid"
+)
+
+type JiraUsecase interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*entity.JiraIntegration, error)
+	Configure(ctx context.Context, projectID uuid.UUID, req ConfigureJiraRequest) (*entity.JiraIntegration, error)
+	Delete(ctx context.Context, projectID uuid.UUID) error
+	// Import creates a task for every issue matching the integration's JQL
+	// that has not been imported yet, and refreshes the fields of those that
+	// have.
+	Import(ctx context.Context, projectID uuid.UUID) (*JiraImportResult, error)
+	// SyncTaskStatus transitions the task's Jira issue to the Jira status
+	// mapped to status. It does nothing when the task has since moved on
+	// from status, so late retries cannot roll the issue back.
+	SyncTaskStatus(ctx context.Context, taskID uuid.UUID, status entity.TaskStatus) error
+}
+
+type ConfigureJiraRequest struct {
+	BaseURL string `json:"base_url"`
+	Email   string `json:"email"`
+	// APIToken replaces the stored token; nil keeps the current one
+	APIToken          *string                      `json:"api_token,omitempty"`
+	JQL               string                       `json:"jql"`
+	StatusMapping     map[string]entity.TaskStatus `json:"status_mapping"`
+	TransitionMapping map[entity.TaskStatus]string `json:"transition_mapping"`
+	SyncStatusBack    bool                         `json:"sync_status_back"`
+}
+
+type JiraImportResult struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+// Validation errors
+var (
+	ErrJiraNotConfigured           = errors.New("jira integration is not configured for this project")
+	ErrJiraBaseURLInvalid          = errors.New("jira base URL must be an absolute http(s) URL")
+	ErrJiraEmailRequired           = errors.New("jira account email is required")
+	ErrJiraJQLRequired             = errors.New("jira JQL query is required")
+	ErrJiraAPITokenRequired        = errors.New("jira API token is required")
+	ErrJiraImportStatusInvalid     = errors.New("jira status mapping may only import tasks as TODO, DONE or CANCELLED")
+	ErrJiraTransitionStatusInvalid = errors.New("jira transition mapping references an invalid task status")
+)
+
+// importStatuses are the statuses a task can be imported in; the others need
+// the plan and worktree the workflow creates on the way there
+var importStatuses = map[entity.TaskStatus]bool{
+	entity.TaskStatusTODO:      true,
+	entity.TaskStatusDONE:      true,
+	entity.TaskStatusCANCELLED: true,
+}
+
+// Task field limits, see entity.Task
+const (
+	maxTaskTitleLength       = 255
+	maxTaskDescriptionLength = 1000
+)
+
+type jiraUsecase struct {
+	projectAccess
+	jiraRepo      repository.JiraIntegrationRepository
+	taskRepo      repository.TaskRepository
+	projectRepo   repository.ProjectRepository
+	secretStore   secrets.Store
+	newJiraClient func(baseURL, email, token string) jira.Client
+}
+
+func NewJiraUsecase(
+	jiraRepo repository.JiraIntegrationRepository,
+	taskRepo repository.TaskRepository,
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	secretStore secrets.Store,
+) JiraUsecase {
+	return &jiraUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		jiraRepo:      jiraRepo,
+		taskRepo:      taskRepo,
+		projectRepo:   projectRepo,
+		secretStore:   secretStore,
+		newJiraClient: jira.NewClient,
+	}
+}
+
+func (u *jiraUsecase) Get(ctx context.Context, projectID uuid.UUID) (*entity.JiraIntegration, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.get(ctx, projectID)
+}
+
+// get returns the project's integration, or ErrJiraNotConfigured
+func (u *jiraUsecase) get(ctx context.Context, projectID uuid.UUID) (*entity.JiraIntegration, error) {
+	integration, err := u.jiraRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if integration == nil {
+		return nil, ErrJiraNotConfigured
+	}
+	return integration, nil
+}
+
+func (u *jiraUsecase) Configure(ctx context.Context, projectID uuid.UUID, req ConfigureJiraRequest) (*entity.JiraIntegration, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	// The API token is sent to the base URL, so only owners may change it
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+
+	baseURL := strings.TrimRight(strings.TrimSpace(req.BaseURL), "/")
+	if parsed, err := url.Parse(baseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrJiraBaseURLInvalid
+	}
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return nil, ErrJiraEmailRequired
+	}
+	jql := strings.TrimSpace(req.JQL)
+	if jql == "" {
+		return nil, ErrJiraJQLRequired
+	}
+	for _, status := range req.StatusMapping {
+		if !importStatuses[status] {
+			return nil, ErrJiraImportStatusInvalid
+		}
+	}
+	for status := range req.TransitionMapping {
+		if !status.IsValid() {
+			return nil, ErrJiraTransitionStatusInvalid
+		}
+	}
+
+	integration, err := u.jiraRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if integration == nil {
+		if req.APIToken == nil || *req.APIToken == "" {
+			return nil, ErrJiraAPITokenRequired
+		}
+		integration = &entity.JiraIntegration{ProjectID: projectID}
+	}
+
+	if req.APIToken != nil {
+		if *req.APIToken == "" {
+			return nil, ErrJiraAPITokenRequired
+		}
+		if err := u.secretStore.Put(ctx, projectID, entity.JiraAPITokenSecret, *req.APIToken); err != nil {
+			return nil, fmt.Errorf("failed to store jira API token: %w", err)
+		}
+	}
+
+	integration.BaseURL = baseURL
+	integration.Email = email
+	integration.JQL = jql
+	integration.StatusMapping = req.StatusMapping
+	integration.TransitionMapping = req.TransitionMapping
+	integration.SyncStatusBack = req.SyncStatusBack
+
+	if err := u.jiraRepo.Save(ctx, integration); err != nil {
+		return nil, fmt.Errorf("failed to save jira integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+func (u *jiraUsecase) Delete(ctx context.Context, projectID uuid.UUID) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
+	if _, err := u.get(ctx, projectID); err != nil {
+		return err
+	}
+	if err := u.jiraRepo.Delete(ctx, projectID); err != nil {
+		return err
+	}
+	return u.secretStore.Delete(ctx, projectID, entity.JiraAPITokenSecret)
+}
+
+func (u *jiraUsecase) Import(ctx context.Context, projectID uuid.UUID) (*JiraImportResult, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	integration, err := u.get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := u.client(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := client.SearchIssues(ctx, integration.JQL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search jira issues: %w", err)
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+	imported := make(map[string]*entity.Task)
+	for _, task := range tasks {
+		if task.JiraIssueKey != nil {
+			imported[*task.JiraIssueKey] = task
+		}
+	}
+
+	result := &JiraImportResult{}
+	for _, issue := range issues {
+		task, ok := imported[issue.Key]
+		if !ok {
+			key := issue.Key
+			task = &entity.Task{
+				ID:           uuid.New(),
+				ProjectID:    projectID,
+				Status:       importStatus(integration, issue),
+				JiraIssueKey: &key,
+			}
+			applyIssueFields(task, issue)
+			if err := u.taskRepo.Create(ctx, task); err != nil {
+				return nil, fmt.Errorf("failed to create task for %s: %w", issue.Key, err)
+			}
+			result.Created++
+			continue
+		}
+
+		// Once imported the status is driven by the auto-devs workflow, so
+		// only the descriptive fields are refreshed
+		if !applyIssueFields(task, issue) {
+			result.Unchanged++
+			continue
+		}
+		if err := u.taskRepo.Update(ctx, task); err != nil {
+			return nil, fmt.Errorf("failed to update task for %s: %w", issue.Key, err)
+		}
+		result.Updated++
+	}
+
+	now := time.Now()
+	integration.LastImportedAt = &now
+	if err := u.jiraRepo.Save(ctx, integration); err != nil {
+		return nil, fmt.Errorf("failed to save jira integration: %w", err)
+	}
+
+	return result, nil
+}
+
+func (u *jiraUsecase) SyncTaskStatus(ctx context.Context, taskID uuid.UUID, status entity.TaskStatus) error {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if task.JiraIssueKey == nil || task.Status != status {
+		return nil
+	}
+
+	integration, err := u.jiraRepo.GetByProjectID(ctx, task.ProjectID)
+	if err != nil {
+		return err
+	}
+	if integration == nil || !integration.SyncStatusBack {
+		return nil
+	}
+	jiraStatus, ok := integration.TransitionMapping[task.Status]
+	if !ok || jiraStatus == "" {
+		return nil
+	}
+
+	client, err := u.client(ctx, integration)
+	if err != nil {
+		return err
+	}
+	return client.TransitionIssue(ctx, *task.JiraIssueKey, jiraStatus)
+}
+
+func (u *jiraUsecase) client(ctx context.Context, integration *entity.JiraIntegration) (jira.Client, error) {
+	token, err := u.secretStore.Get(ctx, integration.ProjectID, entity.JiraAPITokenSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jira API token: %w", err)
+	}
+	return u.newJiraClient(integration.BaseURL, integration.Email, token), nil
+}
+
+// importStatus picks the status a new task starts in: the mapped one if the
+// issue's status is mapped, otherwise DONE for issues already done and TODO
+// for everything else.
+func importStatus(integration *entity.JiraIntegration, issue jira.Issue) entity.TaskStatus {
+	for name, status := range integration.StatusMapping {
+		if strings.EqualFold(name, issue.Status) {
+			return status
+		}
+	}
+	if issue.StatusCategory == "done" {
+		return entity.TaskStatusDONE
+	}
+	return entity.TaskStatusTODO
+}
+
+// jiraPriorities maps Jira's default priority scheme onto task priorities
+var jiraPriorities = map[string]entity.TaskPriority{
+	"highest": entity.TaskPriorityUrgent,
+	"high":    entity.TaskPriorityHigh,
+	"medium":  entity.TaskPriorityMedium,
+	"low":     entity.TaskPriorityLow,
+	"lowest":  entity.TaskPriorityLow,
+}
+
+// applyIssueFields copies the issue's fields onto the task and reports
+// whether anything changed.
+func applyIssueFields(task *entity.Task, issue jira.Issue) bool {
+	title := truncateRunes(issue.Summary, maxTaskTitleLength)
+	description := truncateRunes(issue.Description, maxTaskDescriptionLength)
+	priority, ok := jiraPriorities[strings.ToLower(issue.Priority)]
+	if !ok {
+		priority = entity.TaskPriorityMedium
+	}
+	var assignee *string
+	if issue.Assignee != "" {
+		assignee = &issue.Assignee
+	}
+
+	changed := task.Title != title ||
+		task.Description != description ||
+		task.Priority != priority ||
+		!equalStringPtr(task.AssignedTo, assignee) ||
+		!equalTimePtr(task.DueDate, issue.DueDate) ||
+		!slices.Equal(task.Tags, issue.Labels)
+
+	task.Title = title
+	task.Description = description
+	task.Priority = priority
+	task.AssignedTo = assignee
+	task.DueDate = issue.DueDate
+	task.Tags = issue.Labels
+	return changed
+}
+
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit-1]) + "…"
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
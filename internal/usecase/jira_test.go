@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/jira"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSecretStore map[string]string
+
+func (f fakeSecretStore) Put(ctx context.Context, projectID uuid.UUID, name, value string) error {
+	f[projectID.String()+"/"+name] = value
+	return nil
+}
+
+func (f fakeSecretStore) Get(ctx context.Context, projectID uuid.UUID, name string) (string, error) {
+	return f[projectID.String()+"/"+name], nil
+}
+
+func (f fakeSecretStore) Delete(ctx context.Context, projectID uuid.UUID, name string) error {
+	delete(f, projectID.String()+"/"+name)
+	return nil
+}
+
+type fakeJiraClient struct {
+	token       string
+	issues      []jira.Issue
+	transitions map[string]string
+}
+
+func (f *fakeJiraClient) SearchIssues(ctx context.Context, jql string) ([]jira.Issue, error) {
+	return f.issues, nil
+}
+
+func (f *fakeJiraClient) TransitionIssue(ctx context.Context, key, status string) error {
+	f.transitions[key] = status
+	return nil
+}
+
+func newJiraTestUsecase(t *testing.T, client *fakeJiraClient) (*jiraUsecase, *repository.JiraIntegrationRepositoryMock, *repository.TaskRepositoryMock, fakeSecretStore) {
+	jiraRepo := repository.NewJiraIntegrationRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	store := fakeSecretStore{}
+	uc := &jiraUsecase{
+		jiraRepo:    jiraRepo,
+		taskRepo:    taskRepo,
+		secretStore: store,
+		newJiraClient: func(baseURL, email, token string) jira.Client {
+			client.token = token
+			return client
+		},
+	}
+	return uc, jiraRepo, taskRepo, store
+}
+
+func TestJiraImport_CreatesAndRefreshesTasks(t *testing.T) {
+	projectID := uuid.New()
+	client := &fakeJiraClient{issues: []jira.Issue{
+		{Key: "PROJ-1", Summary: "Add login", Status: "To Do", StatusCategory: "new", Priority: "Highest", Labels: []string{"auth"}},
+		{Key: "PROJ-2", Summary: "Renamed upstream", Status: "In Progress", StatusCategory: "indeterminate"},
+		{Key: "PROJ-3", Summary: "Unchanged", Status: "Done", StatusCategory: "done", Priority: "Medium"},
+		{Key: "PROJ-4", Summary: "Won't do", Status: "Rejected", StatusCategory: "done"},
+	}}
+	uc, jiraRepo, taskRepo, store := newJiraTestUsecase(t, client)
+	store[projectID.String()+"/"+entity.JiraAPITokenSecret] = "token"
+
+	integration := &entity.JiraIntegration{
+		ProjectID:     projectID,
+		JQL:           "project = PROJ",
+		StatusMapping: map[string]entity.TaskStatus{"rejected": entity.TaskStatusCANCELLED},
+	}
+	jiraRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(integration, nil).Once()
+
+	key2, key3 := "PROJ-2", "PROJ-3"
+	existing := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Old title", Status: entity.TaskStatusIMPLEMENTING, Priority: entity.TaskPriorityMedium, JiraIssueKey: &key2}
+	unchanged := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Unchanged", Status: entity.TaskStatusDONE, Priority: entity.TaskPriorityMedium, JiraIssueKey: &key3}
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{existing, unchanged}, nil).Once()
+
+	var created []*entity.Task
+	taskRepo.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, task *entity.Task) error {
+		created = append(created, task)
+		return nil
+	}).Twice()
+	taskRepo.EXPECT().Update(mock.Anything, existing).Return(nil).Once()
+	jiraRepo.EXPECT().Save(mock.Anything, integration).Return(nil).Once()
+
+	result, err := uc.Import(context.Background(), projectID)
+	require.NoError(t, err)
+
+	assert.Equal(t, &JiraImportResult{Created: 2, Updated: 1, Unchanged: 1}, result)
+	assert.Equal(t, "token", client.token)
+	assert.NotNil(t, integration.LastImportedAt)
+
+	require.Len(t, created, 2)
+	assert.Equal(t, "PROJ-1", *created[0].JiraIssueKey)
+	assert.Equal(t, entity.TaskStatusTODO, created[0].Status)
+	assert.Equal(t, entity.TaskPriorityUrgent, created[0].Priority)
+	assert.Equal(t, []string{"auth"}, created[0].Tags)
+	assert.Equal(t, entity.TaskStatusCANCELLED, created[1].Status, "mapped status wins over the done category")
+
+	// Re-imports refresh fields but leave the workflow status alone
+	assert.Equal(t, "Renamed upstream", existing.Title)
+	assert.Equal(t, entity.TaskStatusIMPLEMENTING, existing.Status)
+}
+
+func TestJiraImport_NotConfigured(t *testing.T) {
+	uc, jiraRepo, _, _ := newJiraTestUsecase(t, &fakeJiraClient{})
+	jiraRepo.EXPECT().GetByProjectID(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	_, err := uc.Import(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, ErrJiraNotConfigured)
+}
+
+func TestJiraSyncTaskStatus(t *testing.T) {
+	projectID, taskID := uuid.New(), uuid.New()
+	key := "PROJ-1"
+	client := &fakeJiraClient{transitions: map[string]string{}}
+	uc, jiraRepo, taskRepo, _ := newJiraTestUsecase(t, client)
+
+	task := &entity.Task{ID: taskID, ProjectID: projectID, Status: entity.TaskStatusCODEREVIEWING, JiraIssueKey: &key}
+	integration := &entity.JiraIntegration{
+		ProjectID:         projectID,
+		SyncStatusBack:    true,
+		TransitionMapping: map[entity.TaskStatus]string{entity.TaskStatusCODEREVIEWING: "In Review"},
+	}
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(task, nil)
+	jiraRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(integration, nil)
+
+	require.NoError(t, uc.SyncTaskStatus(context.Background(), taskID, entity.TaskStatusCODEREVIEWING))
+	assert.Equal(t, map[string]string{"PROJ-1": "In Review"}, client.transitions)
+
+	// Stale: the task has moved on since the job was enqueued
+	client.transitions = map[string]string{}
+	require.NoError(t, uc.SyncTaskStatus(context.Background(), taskID, entity.TaskStatusIMPLEMENTING))
+	assert.Empty(t, client.transitions)
+
+	// Unmapped statuses are not synced
+	task.Status = entity.TaskStatusDONE
+	require.NoError(t, uc.SyncTaskStatus(context.Background(), taskID, entity.TaskStatusDONE))
+	assert.Empty(t, client.transitions)
+}
+
+func TestJiraConfigure_Validation(t *testing.T) {
+	projectID := uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	jiraRepo := repository.NewJiraIntegrationRepositoryMock(t)
+	uc := &jiraUsecase{jiraRepo: jiraRepo, projectRepo: projectRepo, secretStore: fakeSecretStore{}}
+
+	valid := ConfigureJiraRequest{BaseURL: "https://acme.atlassian.net", Email: "bot@acme.com", JQL: "project = PROJ"}
+
+	req := valid
+	req.BaseURL = "acme.atlassian.net"
+	_, err := uc.Configure(context.Background(), projectID, req)
+	assert.ErrorIs(t, err, ErrJiraBaseURLInvalid)
+
+	req = valid
+	req.StatusMapping = map[string]entity.TaskStatus{"In Progress": entity.TaskStatusIMPLEMENTING}
+	_, err = uc.Configure(context.Background(), projectID, req)
+	assert.ErrorIs(t, err, ErrJiraImportStatusInvalid)
+
+	jiraRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(nil, nil).Once()
+	_, err = uc.Configure(context.Background(), projectID, valid)
+	assert.ErrorIs(t, err, ErrJiraAPITokenRequired, "a new integration needs a token")
+}
+
+func TestJiraUsecase_ProjectRoles(t *testing.T) {
+	projectID := uuid.New()
+	viewer, maintainer := uuid.New(), uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, maintainer).Return(&entity.ProjectMember{Role: entity.ProjectRoleMaintainer}, nil)
+	uc := NewJiraUsecase(repository.NewJiraIntegrationRepositoryMock(t), repository.NewTaskRepositoryMock(t), projectRepo, memberRepo, fakeSecretStore{})
+	viewerCtx := repository.WithUserID(context.Background(), viewer)
+	maintainerCtx := repository.WithUserID(context.Background(), maintainer)
+
+	_, err := uc.Configure(maintainerCtx, projectID, ConfigureJiraRequest{BaseURL: "https://attacker.example", Email: "bot@acme.com", JQL: "project = PROJ"})
+	assert.ErrorIs(t, err, ErrProjectForbidden, "only owners may point the stored token at another host")
+	assert.ErrorIs(t, uc.Delete(maintainerCtx, projectID), ErrProjectForbidden)
+	_, err = uc.Import(viewerCtx, projectID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+}
@@ -7,6 +7,7 @@ package usecase
 import (
 	"time"
 
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,6 +38,214 @@ func (_m *JobClientInterfaceMock) EXPECT() *JobClientInterfaceMock_Expecter {
 	return &JobClientInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledJob provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) CancelScheduledJob(taskID uuid.UUID, jobID string) error {
+	ret := _mock.Called(taskID, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduledJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID, string) error); ok {
+		r0 = returnFunc(taskID, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// JobClientInterfaceMock_CancelScheduledJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledJob'
+type JobClientInterfaceMock_CancelScheduledJob_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledJob is a helper method to define mock.On call
+//   - taskID
+//   - jobID
+func (_e *JobClientInterfaceMock_Expecter) CancelScheduledJob(taskID interface{}, jobID interface{}) *JobClientInterfaceMock_CancelScheduledJob_Call {
+	return &JobClientInterfaceMock_CancelScheduledJob_Call{Call: _e.mock.On("CancelScheduledJob", taskID, jobID)}
+}
+
+func (_c *JobClientInterfaceMock_CancelScheduledJob_Call) Run(run func(taskID uuid.UUID, jobID string)) *JobClientInterfaceMock_CancelScheduledJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_CancelScheduledJob_Call) Return(_a0 error) *JobClientInterfaceMock_CancelScheduledJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_CancelScheduledJob_Call) RunAndReturn(run func(taskID uuid.UUID, jobID string) error) *JobClientInterfaceMock_CancelScheduledJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueComparisonSelect provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueComparisonSelect(payload *ComparisonSelectPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueComparisonSelect")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*ComparisonSelectPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*ComparisonSelectPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*ComparisonSelectPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueComparisonSelect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueComparisonSelect'
+type JobClientInterfaceMock_EnqueueComparisonSelect_Call struct {
+	*mock.Call
+}
+
+// EnqueueComparisonSelect is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueueComparisonSelect(payload interface{}) *JobClientInterfaceMock_EnqueueComparisonSelect_Call {
+	return &JobClientInterfaceMock_EnqueueComparisonSelect_Call{Call: _e.mock.On("EnqueueComparisonSelect", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueComparisonSelect_Call) Run(run func(payload *ComparisonSelectPayload)) *JobClientInterfaceMock_EnqueueComparisonSelect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ComparisonSelectPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueComparisonSelect_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueueComparisonSelect_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueComparisonSelect_Call) RunAndReturn(run func(payload *ComparisonSelectPayload) (string, error)) *JobClientInterfaceMock_EnqueueComparisonSelect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueExecutionCancel provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueExecutionCancel(payload *ExecutionCancelPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueExecutionCancel")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*ExecutionCancelPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*ExecutionCancelPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*ExecutionCancelPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueExecutionCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueExecutionCancel'
+type JobClientInterfaceMock_EnqueueExecutionCancel_Call struct {
+	*mock.Call
+}
+
+// EnqueueExecutionCancel is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueueExecutionCancel(payload interface{}) *JobClientInterfaceMock_EnqueueExecutionCancel_Call {
+	return &JobClientInterfaceMock_EnqueueExecutionCancel_Call{Call: _e.mock.On("EnqueueExecutionCancel", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueExecutionCancel_Call) Run(run func(payload *ExecutionCancelPayload)) *JobClientInterfaceMock_EnqueueExecutionCancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ExecutionCancelPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueExecutionCancel_Call) Return(_a0 string, _a1 error) *JobClientInterfaceMock_EnqueueExecutionCancel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueExecutionCancel_Call) RunAndReturn(run func(payload *ExecutionCancelPayload) (string, error)) *JobClientInterfaceMock_EnqueueExecutionCancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueJiraSync provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueJiraSync(payload *JiraSyncPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJiraSync")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*JiraSyncPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*JiraSyncPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*JiraSyncPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueJiraSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueJiraSync'
+type JobClientInterfaceMock_EnqueueJiraSync_Call struct {
+	*mock.Call
+}
+
+// EnqueueJiraSync is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueueJiraSync(payload interface{}) *JobClientInterfaceMock_EnqueueJiraSync_Call {
+	return &JobClientInterfaceMock_EnqueueJiraSync_Call{Call: _e.mock.On("EnqueueJiraSync", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueJiraSync_Call) Run(run func(payload *JiraSyncPayload)) *JobClientInterfaceMock_EnqueueJiraSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*JiraSyncPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueJiraSync_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueueJiraSync_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueJiraSync_Call) RunAndReturn(run func(payload *JiraSyncPayload) (string, error)) *JobClientInterfaceMock_EnqueueJiraSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnqueueKanbanNotify provides a mock function for the type JobClientInterfaceMock
 func (_mock *JobClientInterfaceMock) EnqueueKanbanNotify(payload *KanbanNotifyPayload) (string, error) {
 	ret := _mock.Called(payload)
@@ -91,6 +300,223 @@ func (_c *JobClientInterfaceMock_EnqueueKanbanNotify_Call) RunAndReturn(run func
 	return _c
 }
 
+// EnqueuePRSync provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueuePRSync(payload *PRSyncPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueuePRSync")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*PRSyncPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*PRSyncPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*PRSyncPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueuePRSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePRSync'
+type JobClientInterfaceMock_EnqueuePRSync_Call struct {
+	*mock.Call
+}
+
+// EnqueuePRSync is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueuePRSync(payload interface{}) *JobClientInterfaceMock_EnqueuePRSync_Call {
+	return &JobClientInterfaceMock_EnqueuePRSync_Call{Call: _e.mock.On("EnqueuePRSync", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePRSync_Call) Run(run func(payload *PRSyncPayload)) *JobClientInterfaceMock_EnqueuePRSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*PRSyncPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePRSync_Call) Return(r0 string, r1 error) *JobClientInterfaceMock_EnqueuePRSync_Call {
+	_c.Call.Return(r0, r1)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePRSync_Call) RunAndReturn(run func(payload *PRSyncPayload) (string, error)) *JobClientInterfaceMock_EnqueuePRSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueuePreviewStop provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueuePreviewStop(payload *PreviewStopPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueuePreviewStop")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*PreviewStopPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*PreviewStopPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*PreviewStopPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueuePreviewStop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueuePreviewStop'
+type JobClientInterfaceMock_EnqueuePreviewStop_Call struct {
+	*mock.Call
+}
+
+// EnqueuePreviewStop is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueuePreviewStop(payload interface{}) *JobClientInterfaceMock_EnqueuePreviewStop_Call {
+	return &JobClientInterfaceMock_EnqueuePreviewStop_Call{Call: _e.mock.On("EnqueuePreviewStop", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePreviewStop_Call) Run(run func(payload *PreviewStopPayload)) *JobClientInterfaceMock_EnqueuePreviewStop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*PreviewStopPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePreviewStop_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueuePreviewStop_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueuePreviewStop_Call) RunAndReturn(run func(payload *PreviewStopPayload) (string, error)) *JobClientInterfaceMock_EnqueuePreviewStop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueReleaseNotes provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueReleaseNotes(payload *ReleaseNotesPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueReleaseNotes")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*ReleaseNotesPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*ReleaseNotesPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*ReleaseNotesPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueReleaseNotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueReleaseNotes'
+type JobClientInterfaceMock_EnqueueReleaseNotes_Call struct {
+	*mock.Call
+}
+
+// EnqueueReleaseNotes is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueueReleaseNotes(payload interface{}) *JobClientInterfaceMock_EnqueueReleaseNotes_Call {
+	return &JobClientInterfaceMock_EnqueueReleaseNotes_Call{Call: _e.mock.On("EnqueueReleaseNotes", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueReleaseNotes_Call) Run(run func(payload *ReleaseNotesPayload)) *JobClientInterfaceMock_EnqueueReleaseNotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*ReleaseNotesPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueReleaseNotes_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueueReleaseNotes_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueReleaseNotes_Call) RunAndReturn(run func(payload *ReleaseNotesPayload) (string, error)) *JobClientInterfaceMock_EnqueueReleaseNotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueTaskComparison provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueTaskComparison(payload *TaskComparisonPayload, delay time.Duration) (string, error) {
+	ret := _mock.Called(payload, delay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueTaskComparison")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*TaskComparisonPayload, time.Duration) (string, error)); ok {
+		return returnFunc(payload, delay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*TaskComparisonPayload, time.Duration) string); ok {
+		r0 = returnFunc(payload, delay)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*TaskComparisonPayload, time.Duration) error); ok {
+		r1 = returnFunc(payload, delay)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueTaskComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueTaskComparison'
+type JobClientInterfaceMock_EnqueueTaskComparison_Call struct {
+	*mock.Call
+}
+
+// EnqueueTaskComparison is a helper method to define mock.On call
+//   - payload
+//   - delay
+func (_e *JobClientInterfaceMock_Expecter) EnqueueTaskComparison(payload interface{}, delay interface{}) *JobClientInterfaceMock_EnqueueTaskComparison_Call {
+	return &JobClientInterfaceMock_EnqueueTaskComparison_Call{Call: _e.mock.On("EnqueueTaskComparison", payload, delay)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueTaskComparison_Call) Run(run func(payload *TaskComparisonPayload, delay time.Duration)) *JobClientInterfaceMock_EnqueueTaskComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*TaskComparisonPayload), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueTaskComparison_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueueTaskComparison_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueTaskComparison_Call) RunAndReturn(run func(payload *TaskComparisonPayload, delay time.Duration) (string, error)) *JobClientInterfaceMock_EnqueueTaskComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnqueueTaskImplementation provides a mock function for the type JobClientInterfaceMock
 func (_mock *JobClientInterfaceMock) EnqueueTaskImplementation(payload *TaskImplementationPayload, delay time.Duration) (string, error) {
 	ret := _mock.Called(payload, delay)
@@ -201,6 +627,60 @@ func (_c *JobClientInterfaceMock_EnqueueTaskPlanning_Call) RunAndReturn(run func
 	return _c
 }
 
+// EnqueueWebhookDelivery provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) EnqueueWebhookDelivery(payload *WebhookDeliveryPayload) (string, error) {
+	ret := _mock.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueWebhookDelivery")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*WebhookDeliveryPayload) (string, error)); ok {
+		return returnFunc(payload)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*WebhookDeliveryPayload) string); ok {
+		r0 = returnFunc(payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*WebhookDeliveryPayload) error); ok {
+		r1 = returnFunc(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_EnqueueWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueWebhookDelivery'
+type JobClientInterfaceMock_EnqueueWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// EnqueueWebhookDelivery is a helper method to define mock.On call
+//   - payload
+func (_e *JobClientInterfaceMock_Expecter) EnqueueWebhookDelivery(payload interface{}) *JobClientInterfaceMock_EnqueueWebhookDelivery_Call {
+	return &JobClientInterfaceMock_EnqueueWebhookDelivery_Call{Call: _e.mock.On("EnqueueWebhookDelivery", payload)}
+}
+
+func (_c *JobClientInterfaceMock_EnqueueWebhookDelivery_Call) Run(run func(payload *WebhookDeliveryPayload)) *JobClientInterfaceMock_EnqueueWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*WebhookDeliveryPayload))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueWebhookDelivery_Call) Return(s string, err error) *JobClientInterfaceMock_EnqueueWebhookDelivery_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_EnqueueWebhookDelivery_Call) RunAndReturn(run func(payload *WebhookDeliveryPayload) (string, error)) *JobClientInterfaceMock_EnqueueWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnqueueWorktreeCreate provides a mock function for the type JobClientInterfaceMock
 func (_mock *JobClientInterfaceMock) EnqueueWorktreeCreate(payload *WorktreeCreatePayload, delay time.Duration) (string, error) {
 	ret := _mock.Called(payload, delay)
@@ -255,3 +735,112 @@ func (_c *JobClientInterfaceMock_EnqueueWorktreeCreate_Call) RunAndReturn(run fu
 	_c.Call.Return(run)
 	return _c
 }
+
+// PlanningQueueDepth provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) PlanningQueueDepth() (int, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanningQueueDepth")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_PlanningQueueDepth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanningQueueDepth'
+type JobClientInterfaceMock_PlanningQueueDepth_Call struct {
+	*mock.Call
+}
+
+// PlanningQueueDepth is a helper method to define mock.On call
+func (_e *JobClientInterfaceMock_Expecter) PlanningQueueDepth() *JobClientInterfaceMock_PlanningQueueDepth_Call {
+	return &JobClientInterfaceMock_PlanningQueueDepth_Call{Call: _e.mock.On("PlanningQueueDepth")}
+}
+
+func (_c *JobClientInterfaceMock_PlanningQueueDepth_Call) Run(run func()) *JobClientInterfaceMock_PlanningQueueDepth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_PlanningQueueDepth_Call) Return(n int, err error) *JobClientInterfaceMock_PlanningQueueDepth_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_PlanningQueueDepth_Call) RunAndReturn(run func() (int, error)) *JobClientInterfaceMock_PlanningQueueDepth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduledJobs provides a mock function for the type JobClientInterfaceMock
+func (_mock *JobClientInterfaceMock) ScheduledJobs(taskID uuid.UUID) ([]ScheduledJob, error) {
+	ret := _mock.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduledJobs")
+	}
+
+	var r0 []ScheduledJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) ([]ScheduledJob, error)); ok {
+		return returnFunc(taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uuid.UUID) []ScheduledJob); ok {
+		r0 = returnFunc(taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ScheduledJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uuid.UUID) error); ok {
+		r1 = returnFunc(taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// JobClientInterfaceMock_ScheduledJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduledJobs'
+type JobClientInterfaceMock_ScheduledJobs_Call struct {
+	*mock.Call
+}
+
+// ScheduledJobs is a helper method to define mock.On call
+//   - taskID
+func (_e *JobClientInterfaceMock_Expecter) ScheduledJobs(taskID interface{}) *JobClientInterfaceMock_ScheduledJobs_Call {
+	return &JobClientInterfaceMock_ScheduledJobs_Call{Call: _e.mock.On("ScheduledJobs", taskID)}
+}
+
+func (_c *JobClientInterfaceMock_ScheduledJobs_Call) Run(run func(taskID uuid.UUID)) *JobClientInterfaceMock_ScheduledJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_ScheduledJobs_Call) Return(_a0 []ScheduledJob, _a1 error) *JobClientInterfaceMock_ScheduledJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobClientInterfaceMock_ScheduledJobs_Call) RunAndReturn(run func(taskID uuid.UUID) ([]ScheduledJob, error)) *JobClientInterfaceMock_ScheduledJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
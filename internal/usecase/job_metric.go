@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+)
+
+// DefaultJobMetricsDays is how many days of job metrics are returned when
+// the range does not start
+const DefaultJobMetricsDays = 30
+
+// MaxJobMetricsDays is the longest range of job metrics returned at once
+const MaxJobMetricsDays = 366
+
+var ErrJobMetricsRangeTooLong = errors.New("job metrics cover at most 366 days at once")
+
+type JobMetricUsecase interface {
+	// GetJobMetrics returns the daily rollups of the background jobs, of
+	// jobType only when set, for the UTC days from and to fall on. A nil to
+	// is today and a nil from is DefaultJobMetricsDays before to.
+	GetJobMetrics(ctx context.Context, from, to *time.Time, jobType string) (*JobMetrics, error)
+}
+
+// JobMetrics covers how the background jobs were processed over a range of
+// UTC days
+type JobMetrics struct {
+	// From is the first day and To the day after the last one
+	From time.Time
+	To   time.Time
+	// Days holds the rollup of each day and job type with runs, oldest
+	// first
+	Days []*entity.JobMetric
+	// Totals sums the days of each job type, by job type
+	Totals []*JobTypeMetrics
+}
+
+// JobTypeMetrics sums the runs of a job type over a range of days
+type JobTypeMetrics struct {
+	JobType         string
+	Processed       int64
+	Failed          int64
+	AverageDuration time.Duration
+	MaxDuration     time.Duration
+}
+
+// FailureRate returns the share of runs that failed, 0 without runs
+func (m *JobTypeMetrics) FailureRate() float64 {
+	if m.Processed == 0 {
+		return 0
+	}
+	return float64(m.Failed) / float64(m.Processed)
+}
+
+type jobMetricUsecase struct {
+	jobMetricRepo repository.JobMetricRepository
+	now           func() time.Time
+}
+
+func NewJobMetricUsecase(jobMetricRepo repository.JobMetricRepository) JobMetricUsecase {
+	return &jobMetricUsecase{
+		jobMetricRepo: jobMetricRepo,
+		now:           time.Now,
+	}
+}
+
+func (u *jobMetricUsecase) GetJobMetrics(ctx context.Context, from, to *time.Time, jobType string) (*JobMetrics, error) {
+	last := u.now()
+	if to != nil {
+		last = *to
+	}
+	end := last.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	start := end.AddDate(0, 0, -DefaultJobMetricsDays)
+	if from != nil {
+		if to != nil && to.Before(*from) {
+			return nil, ErrDateRangeInvalid
+		}
+		start = from.UTC().Truncate(24 * time.Hour)
+	}
+	if end.Sub(start) > MaxJobMetricsDays*24*time.Hour {
+		return nil, ErrJobMetricsRangeTooLong
+	}
+
+	days, err := u.jobMetricRepo.List(ctx, start, end, jobType)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]*JobTypeMetrics)
+	totalDurationMs := make(map[string]int64)
+	for _, day := range days {
+		total, ok := totals[day.JobType]
+		if !ok {
+			total = &JobTypeMetrics{JobType: day.JobType}
+			totals[day.JobType] = total
+		}
+		total.Processed += day.Processed
+		total.Failed += day.Failed
+		totalDurationMs[day.JobType] += day.TotalDurationMs
+		if maxDuration := time.Duration(day.MaxDurationMs) * time.Millisecond; maxDuration > total.MaxDuration {
+			total.MaxDuration = maxDuration
+		}
+	}
+
+	metrics := &JobMetrics{
+		From:   start,
+		To:     end,
+		Days:   days,
+		Totals: make([]*JobTypeMetrics, 0, len(totals)),
+	}
+	for jobType, total := range totals {
+		if total.Processed > 0 {
+			total.AverageDuration = time.Duration(totalDurationMs[jobType]/total.Processed) * time.Millisecond
+		}
+		metrics.Totals = append(metrics.Totals, total)
+	}
+	sort.Slice(metrics.Totals, func(i, j int) bool {
+		return metrics.Totals[i].JobType < metrics.Totals[j].JobType
+	})
+
+	return metrics, nil
+}
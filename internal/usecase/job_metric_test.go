@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobMetricUsecase_GetJobMetrics(t *testing.T) {
+	now := time.Date(2024, 3, 31, 15, 4, 5, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	newUsecase := func(repo repository.JobMetricRepository) *jobMetricUsecase {
+		return &jobMetricUsecase{jobMetricRepo: repo, now: func() time.Time { return now }}
+	}
+
+	t.Run("sums the days of each job type", func(t *testing.T) {
+		repo := repository.NewJobMetricRepositoryMock(t)
+		repo.EXPECT().List(context.Background(), time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), "").Return([]*entity.JobMetric{
+			{Day: day(5), JobType: "task:planning", Processed: 4, Failed: 1, TotalDurationMs: 8000, MaxDurationMs: 5000},
+			{Day: day(5), JobType: "pr:status_sync", Processed: 10, TotalDurationMs: 1000, MaxDurationMs: 200},
+			{Day: day(6), JobType: "task:planning", Processed: 6, Failed: 2, TotalDurationMs: 12000, MaxDurationMs: 3000},
+		}, nil)
+
+		metrics, err := newUsecase(repo).GetJobMetrics(context.Background(), nil, nil, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, day(2), metrics.From)
+		assert.Len(t, metrics.Days, 3)
+		require.Len(t, metrics.Totals, 2)
+		assert.Equal(t, "pr:status_sync", metrics.Totals[0].JobType)
+		planning := metrics.Totals[1]
+		assert.Equal(t, "task:planning", planning.JobType)
+		assert.Equal(t, int64(10), planning.Processed)
+		assert.Equal(t, int64(3), planning.Failed)
+		assert.InDelta(t, 0.3, planning.FailureRate(), 1e-9)
+		assert.Equal(t, 2*time.Second, planning.AverageDuration)
+		assert.Equal(t, 5*time.Second, planning.MaxDuration)
+	})
+
+	t.Run("covers the UTC days of the range", func(t *testing.T) {
+		repo := repository.NewJobMetricRepositoryMock(t)
+		repo.EXPECT().List(context.Background(), day(1), day(3), "task:planning").Return([]*entity.JobMetric{}, nil)
+
+		from := time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 3, 3, 5, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+		metrics, err := newUsecase(repo).GetJobMetrics(context.Background(), &from, &to, "task:planning")
+		require.NoError(t, err)
+		assert.Empty(t, metrics.Totals)
+	})
+
+	t.Run("rejects an inverted range", func(t *testing.T) {
+		from, to := day(5), day(4)
+		_, err := newUsecase(repository.NewJobMetricRepositoryMock(t)).GetJobMetrics(context.Background(), &from, &to, "")
+		assert.ErrorIs(t, err, ErrDateRangeInvalid)
+	})
+
+	t.Run("rejects a range over a year", func(t *testing.T) {
+		from := now.AddDate(-2, 0, 0)
+		_, err := newUsecase(repository.NewJobMetricRepositoryMock(t)).GetJobMetrics(context.Background(), &from, nil, "")
+		assert.ErrorIs(t, err, ErrJobMetricsRangeTooLong)
+	})
+}
@@ -3,11 +3,12 @@ package usecase
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
 	"github.com/google/uuid"
 )
 
@@ -15,18 +16,29 @@ import (
 type NotificationUsecase interface {
 	SendTaskStatusChangeNotification(ctx context.Context, data entity.TaskStatusChangeNotificationData) error
 	SendTaskCreatedNotification(ctx context.Context, task *entity.Task, project *entity.Project) error
+	// SendDailyStandupNotification sends summary, written from report, as
+	// the project's daily standup
+	SendDailyStandupNotification(ctx context.Context, report *entity.StandupReport, summary string) error
+	// SendPlanReadyNotification sends the plan of a task waiting for review
+	SendPlanReadyNotification(ctx context.Context, task *entity.Task, project *entity.Project, plan *entity.Plan) error
+	// SendExecutionFailedNotification sends an execution of the task that
+	// failed with errorMessage
+	SendExecutionFailedNotification(ctx context.Context, task *entity.Task, project *entity.Project, execution *entity.Execution, errorMessage string) error
+	// SendPRMergedNotification sends the merged pull request of a task
+	SendPRMergedNotification(ctx context.Context, task *entity.Task, project *entity.Project, pr *entity.PullRequest) error
 	RegisterHandler(notificationType entity.NotificationType, handler entity.NotificationHandler) error
 	UnregisterHandler(notificationType entity.NotificationType) error
 }
 
 type notificationUsecase struct {
-	handlers map[entity.NotificationType]entity.NotificationHandler
+	// handlers are the channels of each type, such as Slack and email
+	handlers map[entity.NotificationType][]entity.NotificationHandler
 }
 
 // NewNotificationUsecase creates a new notification usecase
 func NewNotificationUsecase() NotificationUsecase {
 	return &notificationUsecase{
-		handlers: make(map[entity.NotificationType]entity.NotificationHandler),
+		handlers: make(map[entity.NotificationType][]entity.NotificationHandler),
 	}
 }
 
@@ -42,15 +54,15 @@ func (n *notificationUsecase) SendTaskStatusChangeNotification(ctx context.Conte
 		CreatedAt: time.Now(),
 	}
 
-	// Create message
-	fromStatusStr := "initial"
+	// Create message in the project's language
+	locale := i18n.Parse(data.ProjectLocale)
+	fromStatusStr := i18n.T(locale, "notification.initial_status")
 	if data.FromStatus != nil {
-		fromStatusStr = data.FromStatus.GetDisplayName()
+		fromStatusStr = i18n.DisplayName(locale, "task.status."+string(*data.FromStatus), data.FromStatus.GetDisplayName())
 	}
-	toStatusStr := data.ToStatus.GetDisplayName()
-	
-	event.Message = fmt.Sprintf("Task '%s' status changed from %s to %s", 
-		data.TaskTitle, fromStatusStr, toStatusStr)
+	toStatusStr := i18n.DisplayName(locale, "task.status."+string(data.ToStatus), data.ToStatus.GetDisplayName())
+
+	event.Message = i18n.T(locale, "notification.task_status_changed", data.TaskTitle, fromStatusStr, toStatusStr)
 
 	// Add structured data
 	dataMap := make(map[string]interface{})
@@ -68,9 +80,10 @@ func (n *notificationUsecase) SendTaskCreatedNotification(ctx context.Context, t
 		Type:      entity.NotificationTypeTaskCreated,
 		ProjectID: task.ProjectID,
 		TaskID:    &task.ID,
-		Message:   fmt.Sprintf("New task '%s' created in project '%s'", task.Title, project.Name),
+		Message:   i18n.T(i18n.Parse(project.Locale), "notification.task_created", task.Title, project.Name),
 		Data: map[string]interface{}{
 			"task_id":      task.ID,
+			"task_key":     entity.TaskKey(task.Number),
 			"task_title":   task.Title,
 			"project_id":   task.ProjectID,
 			"project_name": project.Name,
@@ -82,28 +95,134 @@ func (n *notificationUsecase) SendTaskCreatedNotification(ctx context.Context, t
 	return n.sendNotification(event)
 }
 
-// RegisterHandler registers a handler for a specific notification type
+// SendDailyStandupNotification sends the daily standup summary of a project
+func (n *notificationUsecase) SendDailyStandupNotification(ctx context.Context, report *entity.StandupReport, summary string) error {
+	locale := i18n.Parse(report.ProjectLocale)
+	event := entity.NotificationEvent{
+		ID:        uuid.New(),
+		Type:      entity.NotificationTypeDailyStandup,
+		ProjectID: report.ProjectID,
+		Message:   i18n.T(locale, "notification.daily_standup", report.ProjectName, report.From.Format("2006-01-02")) + "\n\n" + summary,
+		Data: map[string]interface{}{
+			"project_id":      report.ProjectID,
+			"project_name":    report.ProjectName,
+			"from":            report.From,
+			"to":              report.To,
+			"summary":         summary,
+			"executions":      report.Executions,
+			"completed_tasks": len(report.CompletedTasks),
+			"failed_tasks":    len(report.FailedTasks),
+			"pending_reviews": len(report.PendingReviews),
+		},
+		CreatedAt: time.Now(),
+	}
+
+	return n.sendNotification(event)
+}
+
+// SendPlanReadyNotification sends a notification when a plan waits for review
+func (n *notificationUsecase) SendPlanReadyNotification(ctx context.Context, task *entity.Task, project *entity.Project, plan *entity.Plan) error {
+	event := entity.NotificationEvent{
+		ID:        uuid.New(),
+		Type:      entity.NotificationTypePlanReady,
+		ProjectID: task.ProjectID,
+		TaskID:    &task.ID,
+		Message:   i18n.T(i18n.Parse(project.Locale), "notification.plan_ready", task.Title, project.Name),
+		Data: map[string]interface{}{
+			"task_id":      task.ID,
+			"task_key":     entity.TaskKey(task.Number),
+			"task_title":   task.Title,
+			"project_id":   task.ProjectID,
+			"project_name": project.Name,
+			"plan_id":      plan.ID,
+			"plan":         plan.Content,
+			"high_risk":    task.HighRisk,
+		},
+		CreatedAt: time.Now(),
+	}
+
+	return n.sendNotification(event)
+}
+
+// SendExecutionFailedNotification sends a notification when an AI execution fails
+func (n *notificationUsecase) SendExecutionFailedNotification(ctx context.Context, task *entity.Task, project *entity.Project, execution *entity.Execution, errorMessage string) error {
+	event := entity.NotificationEvent{
+		ID:        uuid.New(),
+		Type:      entity.NotificationTypeExecutionFailed,
+		ProjectID: task.ProjectID,
+		TaskID:    &task.ID,
+		Message:   i18n.T(i18n.Parse(project.Locale), "notification.execution_failed", execution.Phase, task.Title, project.Name),
+		Data: map[string]interface{}{
+			"task_id":      task.ID,
+			"task_key":     entity.TaskKey(task.Number),
+			"task_title":   task.Title,
+			"project_id":   task.ProjectID,
+			"project_name": project.Name,
+			"execution_id": execution.ID,
+			"phase":        execution.Phase,
+			"ai_type":      execution.AIType,
+			"error":        errorMessage,
+		},
+		CreatedAt: time.Now(),
+	}
+
+	return n.sendNotification(event)
+}
+
+// SendPRMergedNotification sends a notification when a task's pull request is merged
+func (n *notificationUsecase) SendPRMergedNotification(ctx context.Context, task *entity.Task, project *entity.Project, pr *entity.PullRequest) error {
+	event := entity.NotificationEvent{
+		ID:        uuid.New(),
+		Type:      entity.NotificationTypePRMerged,
+		ProjectID: task.ProjectID,
+		TaskID:    &task.ID,
+		Message:   i18n.T(i18n.Parse(project.Locale), "notification.pr_merged", pr.GitHubPRNumber, task.Title, project.Name),
+		Data: map[string]interface{}{
+			"task_id":      task.ID,
+			"task_key":     entity.TaskKey(task.Number),
+			"task_title":   task.Title,
+			"project_id":   task.ProjectID,
+			"project_name": project.Name,
+			"pr_number":    pr.GitHubPRNumber,
+			"pr_url":       pr.GitHubURL,
+			"merged_by":    pr.MergedBy,
+		},
+		CreatedAt: time.Now(),
+	}
+
+	return n.sendNotification(event)
+}
+
+// RegisterHandler adds a handler for a specific notification type, after
+// those already registered for it
 func (n *notificationUsecase) RegisterHandler(notificationType entity.NotificationType, handler entity.NotificationHandler) error {
-	n.handlers[notificationType] = handler
+	n.handlers[notificationType] = append(n.handlers[notificationType], handler)
 	return nil
 }
 
-// UnregisterHandler removes a handler for a specific notification type
+// UnregisterHandler removes the handlers for a specific notification type
 func (n *notificationUsecase) UnregisterHandler(notificationType entity.NotificationType) error {
 	delete(n.handlers, notificationType)
 	return nil
 }
 
-// sendNotification sends a notification to the appropriate handler
+// sendNotification sends a notification to the handlers of its type. A
+// handler failing does not keep the notification from the others.
 func (n *notificationUsecase) sendNotification(event entity.NotificationEvent) error {
-	handler, exists := n.handlers[event.Type]
-	if !exists {
+	handlers := n.handlers[event.Type]
+	if len(handlers) == 0 {
 		// Log that no handler is registered, but don't return an error
 		log.Printf("No handler registered for notification type: %s", event.Type)
 		return nil
 	}
 
-	return handler.HandleNotification(event)
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler.HandleNotification(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // WebSocketNotificationHandler implements NotificationHandler for WebSocket notifications
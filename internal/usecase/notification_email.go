@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/email"
+)
+
+// emailNotificationTimeout bounds sending one notification email
+const emailNotificationTimeout = 30 * time.Second
+
+// EmailNotificationHandler implements NotificationHandler for email, in the
+// background. Plans waiting for review and failed executions are emailed
+// right away to the members of projects with email notifications on; the
+// other types wait for the daily digest.
+type EmailNotificationHandler struct {
+	projectRepo  repository.ProjectRepository
+	memberRepo   repository.ProjectMemberRepository
+	templateRepo repository.EmailTemplateRepository
+	sender       email.Sender
+	// baseURL is where the auto-devs UI is served, for the task links
+	baseURL string
+}
+
+func NewEmailNotificationHandler(
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	templateRepo repository.EmailTemplateRepository,
+	sender email.Sender,
+	baseURL string,
+) *EmailNotificationHandler {
+	return &EmailNotificationHandler{
+		projectRepo:  projectRepo,
+		memberRepo:   memberRepo,
+		templateRepo: templateRepo,
+		sender:       sender,
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// HandleNotification sends the email without waiting for the SMTP server,
+// so a slow server never holds up the change it is about
+func (h *EmailNotificationHandler) HandleNotification(event entity.NotificationEvent) error {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), emailNotificationTimeout)
+		defer cancel()
+		if err := h.Deliver(ctx, event); err != nil {
+			slog.Warn("Failed to email notification", "type", event.Type, "project_id", event.ProjectID, "error", err)
+		}
+	}()
+	return nil
+}
+
+// Deliver emails the notification to the members of its project
+func (h *EmailNotificationHandler) Deliver(ctx context.Context, event entity.NotificationEvent) error {
+	if event.Type != entity.NotificationTypePlanReady && event.Type != entity.NotificationTypeExecutionFailed {
+		return nil
+	}
+	if !emailNotificationsOn(ctx, h.projectRepo, event.ProjectID) {
+		return nil
+	}
+	recipients, err := emailRecipients(ctx, h.memberRepo, event.ProjectID)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	emailTemplate, err := emailTemplateFor(ctx, h.templateRepo, event.ProjectID, event.Type)
+	if err != nil {
+		return err
+	}
+	subject, body, err := renderEmailTemplate(emailTemplate, h.templateData(event))
+	if err != nil {
+		return err
+	}
+	if err := h.sender.Send(ctx, email.Message{To: recipients, Subject: subject, Body: body}); err != nil {
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+// templateData is what the email of the notification is written from
+func (h *EmailNotificationHandler) templateData(event entity.NotificationEvent) entity.EmailTemplateData {
+	data := entity.EmailTemplateData{
+		ProjectName: eventString(event, "project_name"),
+		ProjectURL:  projectURL(h.baseURL, event.ProjectID),
+		Message:     event.Message,
+		TaskKey:     eventString(event, "task_key"),
+		TaskTitle:   eventString(event, "task_title"),
+		Plan:        eventString(event, "plan"),
+		HighRisk:    event.Data["high_risk"] == true,
+		Phase:       eventString(event, "phase"),
+		Error:       eventString(event, "error"),
+	}
+	if event.TaskID != nil && data.ProjectURL != "" {
+		data.TaskURL = fmt.Sprintf("%s/tasks/%s", data.ProjectURL, *event.TaskID)
+	}
+	return data
+}
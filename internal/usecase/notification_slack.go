@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/service/slack"
+)
+
+// Action IDs of the buttons on plans ready for review, see
+// SlackUsecase.HandleInteraction
+const (
+	SlackActionApprovePlan = "approve_plan"
+	SlackActionRejectPlan  = "reject_plan"
+	slackActionOpenTask    = "open_task"
+)
+
+const (
+	// slackNotificationTimeout bounds posting one notification to Slack
+	slackNotificationTimeout = 15 * time.Second
+	// maxSlackSectionLength keeps section texts under Slack's limit of 3000
+	// characters
+	maxSlackSectionLength = 2900
+)
+
+// SlackNotificationHandler implements NotificationHandler for the Slack
+// channels of the projects, in the background. Projects without a Slack
+// integration, and types of notification they muted, are skipped.
+type SlackNotificationHandler struct {
+	slackRepo      repository.SlackIntegrationRepository
+	preferenceRepo repository.NotificationPreferenceRepository
+	secretStore    secrets.Store
+	client         slack.Client
+	// baseURL is where the auto-devs UI is served, for the task links
+	baseURL string
+}
+
+func NewSlackNotificationHandler(
+	slackRepo repository.SlackIntegrationRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	secretStore secrets.Store,
+	client slack.Client,
+	baseURL string,
+) *SlackNotificationHandler {
+	return &SlackNotificationHandler{
+		slackRepo:      slackRepo,
+		preferenceRepo: preferenceRepo,
+		secretStore:    secretStore,
+		client:         client,
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// HandleNotification posts the notification without waiting for Slack, so a
+// slow or unreachable Slack never holds up the change it is about
+func (s *SlackNotificationHandler) HandleNotification(event entity.NotificationEvent) error {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), slackNotificationTimeout)
+		defer cancel()
+		if err := s.Deliver(ctx, event); err != nil {
+			slog.Warn("Failed to post notification to Slack", "type", event.Type, "project_id", event.ProjectID, "error", err)
+		}
+	}()
+	return nil
+}
+
+// Deliver posts the notification to the Slack channel of its project
+func (s *SlackNotificationHandler) Deliver(ctx context.Context, event entity.NotificationEvent) error {
+	integration, err := s.slackRepo.GetByProjectID(ctx, event.ProjectID)
+	if err != nil {
+		return err
+	}
+	if integration == nil {
+		return nil
+	}
+	muted, err := s.preferenceRepo.IsMuted(ctx, event.ProjectID, entity.NotificationChannelSlack, event.Type)
+	if err != nil {
+		return err
+	}
+	if muted {
+		return nil
+	}
+
+	msg := s.message(event, integration)
+	switch integration.Delivery {
+	case entity.SlackDeliveryBot:
+		token, err := s.secretStore.Get(ctx, event.ProjectID, entity.SlackBotTokenSecret)
+		if err != nil {
+			return fmt.Errorf("failed to get slack bot token: %w", err)
+		}
+		msg.Channel = integration.Channel
+		return s.client.PostMessage(ctx, token, msg)
+	default:
+		webhookURL, err := s.secretStore.Get(ctx, event.ProjectID, entity.SlackWebhookURLSecret)
+		if err != nil {
+			return fmt.Errorf("failed to get slack webhook URL: %w", err)
+		}
+		return s.client.PostWebhook(ctx, webhookURL, msg)
+	}
+}
+
+// message renders the notification from the template of its type
+func (s *SlackNotificationHandler) message(event entity.NotificationEvent, integration *entity.SlackIntegration) slack.Message {
+	taskURL := ""
+	if event.TaskID != nil && s.baseURL != "" {
+		taskURL = fmt.Sprintf("%s/projects/%s/tasks/%s", s.baseURL, event.ProjectID, *event.TaskID)
+	}
+	headline := slackEscape(event.Message)
+	if key := eventString(event, "task_key"); key != "" {
+		if taskURL != "" {
+			key = fmt.Sprintf("<%s|%s>", taskURL, key)
+		}
+		headline = fmt.Sprintf("*%s* %s", key, headline)
+	}
+
+	msg := slack.Message{Text: event.Message, Blocks: []slack.Block{slack.Section(headline)}}
+	var buttons []slack.Element
+	switch event.Type {
+	case entity.NotificationTypePlanReady:
+		msg.Blocks = append(msg.Blocks, slack.Section(slackEscape(truncateRunes(eventString(event, "plan"), maxSlackSectionLength))))
+		if event.Data["high_risk"] == true {
+			msg.Blocks = append(msg.Blocks, slack.Section(":warning: This task is high-risk: its plan needs two approvals, or one with a TOTP code."))
+		}
+		if integration.Interactive && event.TaskID != nil {
+			value := event.TaskID.String() + "/" + eventString(event, "plan_id")
+			buttons = append(buttons,
+				slack.Button("Approve", SlackActionApprovePlan, value, "primary"),
+				slack.Button("Reject", SlackActionRejectPlan, value, "danger"),
+			)
+		}
+	case entity.NotificationTypeExecutionFailed:
+		if errorMessage := eventString(event, "error"); errorMessage != "" {
+			msg.Blocks = append(msg.Blocks, slack.Section("```"+slackEscape(truncateRunes(errorMessage, maxSlackSectionLength))+"```"))
+		}
+	case entity.NotificationTypePRMerged:
+		if prURL := eventString(event, "pr_url"); prURL != "" {
+			merged := fmt.Sprintf("<%s|#%s>", prURL, eventString(event, "pr_number"))
+			if mergedBy := eventString(event, "merged_by"); mergedBy != "" {
+				merged += " by " + slackEscape(mergedBy)
+			}
+			msg.Blocks = append(msg.Blocks, slack.Section(merged))
+		}
+	}
+	if taskURL != "" {
+		buttons = append(buttons, slack.LinkButton("Open task", slackActionOpenTask, taskURL))
+	}
+	if len(buttons) > 0 {
+		msg.Blocks = append(msg.Blocks, slack.Actions(string(event.Type), buttons...))
+	}
+	return msg
+}
+
+// eventString returns a field of the notification's data as text
+func eventString(event entity.NotificationEvent, key string) string {
+	switch value := event.Data[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case *string:
+		if value == nil {
+			return ""
+		}
+		return *value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// slackEscape escapes the characters Slack reads as markup in message text
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
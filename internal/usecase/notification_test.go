@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotificationHandler struct {
+	events []entity.NotificationEvent
+}
+
+func (h *recordingNotificationHandler) HandleNotification(event entity.NotificationEvent) error {
+	h.events = append(h.events, event)
+	return nil
+}
+
+func TestSendTaskStatusChangeNotification_UsesProjectLocale(t *testing.T) {
+	handler := &recordingNotificationHandler{}
+	n := NewNotificationUsecase()
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeTaskStatusChanged, handler))
+	data := entity.TaskStatusChangeNotificationData{TaskID: uuid.New(), TaskTitle: "Login", ToStatus: entity.TaskStatusDONE}
+
+	require.NoError(t, n.SendTaskStatusChangeNotification(context.Background(), data))
+	data.ProjectLocale = "vi"
+	require.NoError(t, n.SendTaskStatusChangeNotification(context.Background(), data))
+
+	require.Len(t, handler.events, 2)
+	assert.Equal(t, "Task 'Login' status changed from initial to Done", handler.events[0].Message)
+	assert.Equal(t, "Trạng thái của task 'Login' đã chuyển từ ban đầu sang Hoàn thành", handler.events[1].Message)
+}
+
+func TestSendTaskCreatedNotification_UsesProjectLocale(t *testing.T) {
+	handler := &recordingNotificationHandler{}
+	n := NewNotificationUsecase()
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeTaskCreated, handler))
+	task := &entity.Task{ID: uuid.New(), Title: "Login"}
+
+	require.NoError(t, n.SendTaskCreatedNotification(context.Background(), task, &entity.Project{Name: "Shop", Locale: "vi"}))
+
+	require.Len(t, handler.events, 1)
+	assert.Equal(t, "Task mới 'Login' đã được tạo trong dự án 'Shop'", handler.events[0].Message)
+}
+
+func TestSendDailyStandupNotification(t *testing.T) {
+	handler := &recordingNotificationHandler{}
+	n := NewNotificationUsecase()
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeDailyStandup, handler))
+	report := &entity.StandupReport{
+		ProjectID:      uuid.New(),
+		ProjectName:    "Shop",
+		From:           time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+		CompletedTasks: []entity.StandupTask{{Title: "Add login"}},
+	}
+
+	require.NoError(t, n.SendDailyStandupNotification(context.Background(), report, "- Login shipped"))
+
+	require.Len(t, handler.events, 1)
+	assert.Equal(t, report.ProjectID, handler.events[0].ProjectID)
+	assert.Equal(t, "Daily standup for 'Shop', 2024-03-04\n\n- Login shipped", handler.events[0].Message)
+	assert.Equal(t, 1, handler.events[0].Data["completed_tasks"])
+}
+
+type failingNotificationHandler struct{}
+
+func (failingNotificationHandler) HandleNotification(event entity.NotificationEvent) error {
+	return errors.New("channel down")
+}
+
+func TestSendNotification_EveryHandlerOfTheType(t *testing.T) {
+	slack, email := &recordingNotificationHandler{}, &recordingNotificationHandler{}
+	n := NewNotificationUsecase()
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeTaskCreated, failingNotificationHandler{}))
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeTaskCreated, slack))
+	require.NoError(t, n.RegisterHandler(entity.NotificationTypeTaskCreated, email))
+
+	err := n.SendTaskCreatedNotification(context.Background(), &entity.Task{ID: uuid.New(), Title: "Login"}, &entity.Project{Name: "Shop"})
+
+	assert.ErrorContains(t, err, "channel down")
+	assert.Len(t, slack.events, 1, "a failing handler does not stop the others")
+	assert.Len(t, email.events, 1)
+}
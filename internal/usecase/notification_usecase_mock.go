@@ -84,6 +84,198 @@ func (_c *NotificationUsecaseMock_RegisterHandler_Call) RunAndReturn(run func(no
 	return _c
 }
 
+// SendDailyStandupNotification provides a mock function for the type NotificationUsecaseMock
+func (_mock *NotificationUsecaseMock) SendDailyStandupNotification(ctx context.Context, report *entity.StandupReport, summary string) error {
+	ret := _mock.Called(ctx, report, summary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDailyStandupNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.StandupReport, string) error); ok {
+		r0 = returnFunc(ctx, report, summary)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationUsecaseMock_SendDailyStandupNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDailyStandupNotification'
+type NotificationUsecaseMock_SendDailyStandupNotification_Call struct {
+	*mock.Call
+}
+
+// SendDailyStandupNotification is a helper method to define mock.On call
+//   - ctx
+//   - report
+//   - summary
+func (_e *NotificationUsecaseMock_Expecter) SendDailyStandupNotification(ctx interface{}, report interface{}, summary interface{}) *NotificationUsecaseMock_SendDailyStandupNotification_Call {
+	return &NotificationUsecaseMock_SendDailyStandupNotification_Call{Call: _e.mock.On("SendDailyStandupNotification", ctx, report, summary)}
+}
+
+func (_c *NotificationUsecaseMock_SendDailyStandupNotification_Call) Run(run func(ctx context.Context, report *entity.StandupReport, summary string)) *NotificationUsecaseMock_SendDailyStandupNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.StandupReport), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendDailyStandupNotification_Call) Return(err error) *NotificationUsecaseMock_SendDailyStandupNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendDailyStandupNotification_Call) RunAndReturn(run func(ctx context.Context, report *entity.StandupReport, summary string) error) *NotificationUsecaseMock_SendDailyStandupNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendExecutionFailedNotification provides a mock function for the type NotificationUsecaseMock
+func (_mock *NotificationUsecaseMock) SendExecutionFailedNotification(ctx context.Context, task *entity.Task, project *entity.Project, execution *entity.Execution, errorMessage string) error {
+	ret := _mock.Called(ctx, task, project, execution, errorMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendExecutionFailedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Task, *entity.Project, *entity.Execution, string) error); ok {
+		r0 = returnFunc(ctx, task, project, execution, errorMessage)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationUsecaseMock_SendExecutionFailedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendExecutionFailedNotification'
+type NotificationUsecaseMock_SendExecutionFailedNotification_Call struct {
+	*mock.Call
+}
+
+// SendExecutionFailedNotification is a helper method to define mock.On call
+//   - ctx
+//   - task
+//   - project
+//   - execution
+//   - errorMessage
+func (_e *NotificationUsecaseMock_Expecter) SendExecutionFailedNotification(ctx interface{}, task interface{}, project interface{}, execution interface{}, errorMessage interface{}) *NotificationUsecaseMock_SendExecutionFailedNotification_Call {
+	return &NotificationUsecaseMock_SendExecutionFailedNotification_Call{Call: _e.mock.On("SendExecutionFailedNotification", ctx, task, project, execution, errorMessage)}
+}
+
+func (_c *NotificationUsecaseMock_SendExecutionFailedNotification_Call) Run(run func(ctx context.Context, task *entity.Task, project *entity.Project, execution *entity.Execution, errorMessage string)) *NotificationUsecaseMock_SendExecutionFailedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Task), args[2].(*entity.Project), args[3].(*entity.Execution), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendExecutionFailedNotification_Call) Return(_a0 error) *NotificationUsecaseMock_SendExecutionFailedNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendExecutionFailedNotification_Call) RunAndReturn(run func(ctx context.Context, task *entity.Task, project *entity.Project, execution *entity.Execution, errorMessage string) error) *NotificationUsecaseMock_SendExecutionFailedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendPRMergedNotification provides a mock function for the type NotificationUsecaseMock
+func (_mock *NotificationUsecaseMock) SendPRMergedNotification(ctx context.Context, task *entity.Task, project *entity.Project, pr *entity.PullRequest) error {
+	ret := _mock.Called(ctx, task, project, pr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPRMergedNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Task, *entity.Project, *entity.PullRequest) error); ok {
+		r0 = returnFunc(ctx, task, project, pr)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationUsecaseMock_SendPRMergedNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPRMergedNotification'
+type NotificationUsecaseMock_SendPRMergedNotification_Call struct {
+	*mock.Call
+}
+
+// SendPRMergedNotification is a helper method to define mock.On call
+//   - ctx
+//   - task
+//   - project
+//   - pr
+func (_e *NotificationUsecaseMock_Expecter) SendPRMergedNotification(ctx interface{}, task interface{}, project interface{}, pr interface{}) *NotificationUsecaseMock_SendPRMergedNotification_Call {
+	return &NotificationUsecaseMock_SendPRMergedNotification_Call{Call: _e.mock.On("SendPRMergedNotification", ctx, task, project, pr)}
+}
+
+func (_c *NotificationUsecaseMock_SendPRMergedNotification_Call) Run(run func(ctx context.Context, task *entity.Task, project *entity.Project, pr *entity.PullRequest)) *NotificationUsecaseMock_SendPRMergedNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Task), args[2].(*entity.Project), args[3].(*entity.PullRequest))
+	})
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendPRMergedNotification_Call) Return(_a0 error) *NotificationUsecaseMock_SendPRMergedNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendPRMergedNotification_Call) RunAndReturn(run func(ctx context.Context, task *entity.Task, project *entity.Project, pr *entity.PullRequest) error) *NotificationUsecaseMock_SendPRMergedNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendPlanReadyNotification provides a mock function for the type NotificationUsecaseMock
+func (_mock *NotificationUsecaseMock) SendPlanReadyNotification(ctx context.Context, task *entity.Task, project *entity.Project, plan *entity.Plan) error {
+	ret := _mock.Called(ctx, task, project, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendPlanReadyNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Task, *entity.Project, *entity.Plan) error); ok {
+		r0 = returnFunc(ctx, task, project, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationUsecaseMock_SendPlanReadyNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendPlanReadyNotification'
+type NotificationUsecaseMock_SendPlanReadyNotification_Call struct {
+	*mock.Call
+}
+
+// SendPlanReadyNotification is a helper method to define mock.On call
+//   - ctx
+//   - task
+//   - project
+//   - plan
+func (_e *NotificationUsecaseMock_Expecter) SendPlanReadyNotification(ctx interface{}, task interface{}, project interface{}, plan interface{}) *NotificationUsecaseMock_SendPlanReadyNotification_Call {
+	return &NotificationUsecaseMock_SendPlanReadyNotification_Call{Call: _e.mock.On("SendPlanReadyNotification", ctx, task, project, plan)}
+}
+
+func (_c *NotificationUsecaseMock_SendPlanReadyNotification_Call) Run(run func(ctx context.Context, task *entity.Task, project *entity.Project, plan *entity.Plan)) *NotificationUsecaseMock_SendPlanReadyNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Task), args[2].(*entity.Project), args[3].(*entity.Plan))
+	})
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendPlanReadyNotification_Call) Return(_a0 error) *NotificationUsecaseMock_SendPlanReadyNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationUsecaseMock_SendPlanReadyNotification_Call) RunAndReturn(run func(ctx context.Context, task *entity.Task, project *entity.Project, plan *entity.Plan) error) *NotificationUsecaseMock_SendPlanReadyNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendTaskCreatedNotification provides a mock function for the type NotificationUsecaseMock
 func (_mock *NotificationUsecaseMock) SendTaskCreatedNotification(ctx context.Context, task *entity.Task, project *entity.Project) error {
 	ret := _mock.Called(ctx, task, project)
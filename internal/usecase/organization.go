@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+type OrganizationUsecase interface {
+	Create(ctx context.Context, req CreateOrganizationRequest) (*entity.Organization, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+	GetAll(ctx context.Context) ([]*entity.Organization, error)
+	Update(ctx context.Context, id uuid.UUID, req UpdateOrganizationRequest) (*entity.Organization, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// AddUser moves the user with the username, of no organization yet,
+	// into the organization
+	AddUser(ctx context.Context, id uuid.UUID, username string) error
+	// HasOrganizations reports whether any organization exists, from when
+	// on every request must be scoped to one
+	HasOrganizations(ctx context.Context) (bool, error)
+}
+
+type CreateOrganizationRequest struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Validation errors
+var (
+	ErrOrganizationNameRequired = errors.New("organization name is required")
+	ErrOrganizationSlugInvalid  = errors.New("organization slug must be 1-100 lowercase letters, digits or dashes")
+	ErrOrganizationSlugExists   = errors.New("organization slug already exists")
+	ErrOrganizationNotFound     = errors.New("organization not found")
+	ErrOrganizationForbidden    = errors.New("members of an organization cannot create another one")
+	ErrOrganizationRequired     = errors.New("requests must be scoped to an organization once organizations exist")
+	ErrOrganizationUserNotFound = errors.New("no user of no organization has this username")
+)
+
+var organizationSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,99}$`)
+
+type organizationUsecase struct {
+	orgRepo repository.OrganizationRepository
+}
+
+func NewOrganizationUsecase(orgRepo repository.OrganizationRepository) OrganizationUsecase {
+	return &organizationUsecase{
+		orgRepo: orgRepo,
+	}
+}
+
+// Create creates an organization. The first one adopts the projects and
+// users there are, so existing deployments keep working once requests are
+// scoped; the user creating a later one joins it.
+func (u *organizationUsecase) Create(ctx context.Context, req CreateOrganizationRequest) (*entity.Organization, error) {
+	if _, ok := repository.OrganizationIDFromContext(ctx); ok {
+		return nil, ErrOrganizationForbidden
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrOrganizationNameRequired
+	}
+
+	slug := strings.TrimSpace(req.Slug)
+	if !organizationSlugPattern.MatchString(slug) {
+		return nil, ErrOrganizationSlugInvalid
+	}
+
+	if existing, err := u.orgRepo.GetBySlug(ctx, slug); err == nil && existing != nil {
+		return nil, ErrOrganizationSlugExists
+	}
+
+	org := &entity.Organization{
+		ID:          uuid.New(),
+		Name:        name,
+		Slug:        slug,
+		Description: strings.TrimSpace(req.Description),
+	}
+
+	if err := u.orgRepo.Create(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	count, err := u.orgRepo.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 1 {
+		if err := u.orgRepo.AdoptUnassigned(ctx, org.ID); err != nil {
+			return nil, err
+		}
+	} else if userID, ok := repository.UserIDFromContext(ctx); ok {
+		if _, err := u.orgRepo.AddUser(ctx, org.ID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return org, nil
+}
+
+// GetByID returns the organization, only the request's own when it is
+// scoped to one
+func (u *organizationUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok && orgID != id {
+		return nil, ErrOrganizationNotFound
+	}
+	return u.orgRepo.GetByID(ctx, id)
+}
+
+// GetAll returns the organizations, only the request's own when it is
+// scoped to one
+func (u *organizationUsecase) GetAll(ctx context.Context) ([]*entity.Organization, error) {
+	if orgID, ok := repository.OrganizationIDFromContext(ctx); ok {
+		org, err := u.orgRepo.GetByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		return []*entity.Organization{org}, nil
+	}
+	return u.orgRepo.GetAll(ctx)
+}
+
+func (u *organizationUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateOrganizationRequest) (*entity.Organization, error) {
+	org, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		name := strings.TrimSpace(*req.Name)
+		if name == "" {
+			return nil, ErrOrganizationNameRequired
+		}
+		org.Name = name
+	}
+	if req.Description != nil {
+		org.Description = strings.TrimSpace(*req.Description)
+	}
+
+	if err := u.orgRepo.Update(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	return org, nil
+}
+
+func (u *organizationUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return u.orgRepo.Delete(ctx, id)
+}
+
+func (u *organizationUsecase) AddUser(ctx context.Context, id uuid.UUID, username string) error {
+	if _, err := u.GetByID(ctx, id); err != nil {
+		return err
+	}
+	added, err := u.orgRepo.AddUserByUsername(ctx, id, strings.TrimSpace(username))
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrOrganizationUserNotFound
+	}
+	return nil
+}
+
+func (u *organizationUsecase) HasOrganizations(ctx context.Context) (bool, error) {
+	count, err := u.orgRepo.Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationUsecase_Create(t *testing.T) {
+	t.Run("the first organization adopts the existing projects and users", func(t *testing.T) {
+		orgRepo := repository.NewOrganizationRepositoryMock(t)
+		orgRepo.EXPECT().GetBySlug(mock.Anything, "platform").Return(nil, errors.New("not found"))
+		orgRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		orgRepo.EXPECT().Count(mock.Anything).Return(1, nil)
+		orgRepo.EXPECT().AdoptUnassigned(mock.Anything, mock.Anything).Return(nil)
+
+		org, err := NewOrganizationUsecase(orgRepo).Create(context.Background(), CreateOrganizationRequest{Name: "Platform", Slug: "platform"})
+
+		require.NoError(t, err)
+		orgRepo.AssertCalled(t, "AdoptUnassigned", mock.Anything, org.ID)
+	})
+
+	t.Run("the user creating a later one joins it", func(t *testing.T) {
+		userID := uuid.New()
+		orgRepo := repository.NewOrganizationRepositoryMock(t)
+		orgRepo.EXPECT().GetBySlug(mock.Anything, "platform").Return(nil, errors.New("not found"))
+		orgRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil)
+		orgRepo.EXPECT().Count(mock.Anything).Return(2, nil)
+		orgRepo.EXPECT().AddUser(mock.Anything, mock.Anything, userID).Return(true, nil)
+
+		_, err := NewOrganizationUsecase(orgRepo).Create(repository.WithUserID(context.Background(), userID), CreateOrganizationRequest{Name: "Platform", Slug: "platform"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("members of an organization cannot create another", func(t *testing.T) {
+		ctx := repository.WithOrganizationID(context.Background(), uuid.New())
+
+		_, err := NewOrganizationUsecase(repository.NewOrganizationRepositoryMock(t)).Create(ctx, CreateOrganizationRequest{Name: "Platform", Slug: "platform"})
+
+		assert.ErrorIs(t, err, ErrOrganizationForbidden)
+	})
+}
+
+func TestOrganizationUsecase_Scoped(t *testing.T) {
+	ours, theirs := uuid.New(), uuid.New()
+	ctx := repository.WithOrganizationID(context.Background(), ours)
+	orgRepo := repository.NewOrganizationRepositoryMock(t)
+	orgRepo.EXPECT().GetByID(mock.Anything, ours).Return(&entity.Organization{ID: ours}, nil)
+	orgRepo.EXPECT().AddUserByUsername(mock.Anything, ours, "bob").Return(false, nil)
+	organizationUsecase := NewOrganizationUsecase(orgRepo)
+
+	orgs, err := organizationUsecase.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, ours, orgs[0].ID)
+
+	_, err = organizationUsecase.GetByID(ctx, theirs)
+	assert.ErrorIs(t, err, ErrOrganizationNotFound)
+	assert.ErrorIs(t, organizationUsecase.Delete(ctx, theirs), ErrOrganizationNotFound)
+	assert.ErrorIs(t, organizationUsecase.AddUser(ctx, theirs, "bob"), ErrOrganizationNotFound)
+	assert.ErrorIs(t, organizationUsecase.AddUser(ctx, ours, "bob"), ErrOrganizationUserNotFound, "bob is in another organization")
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPlanNotFound        = errors.New("plan not found")
+	ErrPlanNotEditable     = errors.New("plan can only be edited while its task is in PLAN_REVIEWING")
+	ErrPlanContentRequired = errors.New("plan content is required")
+	ErrPlanAlreadyApproved = errors.New("plan already has step-up approvals and can no longer be edited")
+)
+
+type PlanUsecase interface {
+	// UpdateContent replaces the content of a plan under review with the
+	// user's edit, recorded as the plan's next version. Approving the plan
+	// then implements the edited content.
+	UpdateContent(ctx context.Context, taskID, planID uuid.UUID, req UpdatePlanContentRequest) (*entity.Plan, *entity.PlanVersion, error)
+	// GetVersions returns the versions of the task's plan, the generated
+	// one first
+	GetVersions(ctx context.Context, taskID, planID uuid.UUID) ([]*entity.PlanVersion, error)
+}
+
+// UpdatePlanContentRequest is an edit of a plan, steps included, as
+// markdown
+type UpdatePlanContentRequest struct {
+	Content string
+	// EditedBy is recorded on the version, "user" when empty
+	EditedBy string
+}
+
+type planUsecase struct {
+	projectAccess
+	planRepo         repository.PlanRepository
+	planApprovalRepo repository.PlanApprovalRepository
+	taskRepo         repository.TaskRepository
+	projectRepo      repository.ProjectRepository
+}
+
+func NewPlanUsecase(planRepo repository.PlanRepository, planApprovalRepo repository.PlanApprovalRepository, taskRepo repository.TaskRepository, projectRepo repository.ProjectRepository, memberRepo repository.ProjectMemberRepository) PlanUsecase {
+	return &planUsecase{
+		projectAccess:    projectAccess{memberRepo: memberRepo},
+		planRepo:         planRepo,
+		planApprovalRepo: planApprovalRepo,
+		taskRepo:         taskRepo,
+		projectRepo:      projectRepo,
+	}
+}
+
+func (u *planUsecase) UpdateContent(ctx context.Context, taskID, planID uuid.UUID, req UpdatePlanContentRequest) (*entity.Plan, *entity.PlanVersion, error) {
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, nil, ErrPlanContentRequired
+	}
+	editedBy := recordedActorName(ctx, strings.TrimSpace(req.EditedBy))
+	if editedBy == "" {
+		editedBy = "user"
+	}
+
+	plan, err := u.getPlan(ctx, taskID, planID)
+	if err != nil {
+		return nil, nil, err
+	}
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if err := u.authorize(ctx, task.ProjectID, entity.ProjectRoleReviewer); err != nil {
+		return nil, nil, err
+	}
+	if plan.Status != entity.PlanStatusREVIEWING || task.Status != entity.TaskStatusPLANREVIEWING {
+		return nil, nil, fmt.Errorf("%w, task status: %s, plan status: %s", ErrPlanNotEditable, task.Status, plan.Status)
+	}
+	if task.HighRisk {
+		// The approvals recorded so far were given to the content before
+		// the edit
+		approvals, err := u.planApprovalRepo.ListByPlanID(ctx, planID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get plan approvals: %w", err)
+		}
+		if len(approvals) > 0 {
+			return nil, nil, ErrPlanAlreadyApproved
+		}
+	}
+
+	version, err := u.planRepo.Revise(ctx, planID, content, editedBy)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+	plan.Content = content
+
+	// An edit touching high-risk paths needs step-up approval as much as a
+	// generated plan does
+	if err := u.flagHighRisk(ctx, task, content); err != nil {
+		slog.Warn("Failed to check the edited plan for high-risk paths", "task_id", taskID, "plan_id", planID, "error", err)
+	}
+
+	return plan, version, nil
+}
+
+func (u *planUsecase) GetVersions(ctx context.Context, taskID, planID uuid.UUID) ([]*entity.PlanVersion, error) {
+	if err := u.authorizeTask(ctx, u.taskRepo, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := u.getPlan(ctx, taskID, planID); err != nil {
+		return nil, err
+	}
+	return u.planRepo.GetVersions(ctx, planID)
+}
+
+// getPlan returns the plan, or ErrPlanNotFound when the task has no such
+// plan
+func (u *planUsecase) getPlan(ctx context.Context, taskID, planID uuid.UUID) (*entity.Plan, error) {
+	plan, err := u.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPlanNotFound, err)
+	}
+	if plan.TaskID != taskID {
+		return nil, ErrPlanNotFound
+	}
+	return plan, nil
+}
+
+// flagHighRisk marks the task high-risk when its edited plan touches the
+// project's high-risk paths
+func (u *planUsecase) flagHighRisk(ctx context.Context, task *entity.Task, content string) error {
+	if task.HighRisk {
+		return nil
+	}
+	project, err := u.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	paths := entity.HighRiskPathsIn(content, project.HighRiskPathPatterns())
+	if len(paths) == 0 {
+		return nil
+	}
+
+	reason := entity.HighRiskReason(paths)
+	task.HighRisk = true
+	task.HighRiskReason = &reason
+	return u.taskRepo.Update(ctx, task)
+}
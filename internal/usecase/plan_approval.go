@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/totp"
+	"github.com/google/uuid"
+)
+
+// defaultHighRiskReason is the reason of tasks marked high-risk without one
+const defaultHighRiskReason = "Marked high-risk"
+
+var (
+	ErrStepUpApprovalRequired = errors.New("the plan of a high-risk task needs approvals with two different API keys or a TOTP code")
+	ErrTOTPCodeInvalid        = errors.New("invalid TOTP code")
+	ErrTOTPNotConfigured      = errors.New("TOTP confirmation of plans is not configured")
+)
+
+// PlanApprovalRequest is an approval of a task's plan
+type PlanApprovalRequest struct {
+	// Approver is the owner of the API key the approval was made with, ""
+	// when it was made without one
+	Approver string
+	// TOTPCode, when set, confirms the approval on its own
+	TOTPCode string
+}
+
+func (u *taskUsecase) RecordPlanApproval(ctx context.Context, taskID uuid.UUID, approval PlanApprovalRequest) (bool, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleReviewer); err != nil {
+		return false, err
+	}
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get task: %w", err)
+	}
+	if !task.HighRisk {
+		return true, nil
+	}
+	if task.Status != entity.TaskStatusPLANREVIEWING {
+		return false, fmt.Errorf("%w, current status: %s", ErrTaskNotInPlanReview, task.Status)
+	}
+
+	plan, err := u.planRepo.GetLatestByTaskID(ctx, taskID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	record := &entity.PlanApproval{TaskID: taskID, PlanID: plan.ID, Approver: approval.Approver, Method: entity.PlanApprovalAPIKey}
+	switch {
+	case approval.TOTPCode != "":
+		if u.approvalTOTPSecret == "" {
+			return false, ErrTOTPNotConfigured
+		}
+		valid, err := totp.Validate(u.approvalTOTPSecret, approval.TOTPCode, time.Now())
+		if err != nil {
+			return false, fmt.Errorf("failed to check TOTP code: %w", err)
+		}
+		if !valid {
+			return false, ErrTOTPCodeInvalid
+		}
+		// Kept apart from the approver's API key approval of the same plan
+		record.Method = entity.PlanApprovalTOTP
+		record.Approver = "totp"
+		if approval.Approver != "" {
+			record.Approver += ":" + approval.Approver
+		}
+	case approval.Approver == "":
+		return false, ErrStepUpApprovalRequired
+	}
+
+	if err := u.planApprovalRepo.Create(ctx, record); err != nil {
+		return false, err
+	}
+
+	approvals, err := u.planApprovalRepo.ListByPlanID(ctx, plan.ID)
+	if err != nil {
+		return false, err
+	}
+	return entity.HasStepUpApproval(approvals), nil
+}
+
+// checkStepUpApproval fails with ErrStepUpApprovalRequired unless task is
+// not high-risk or its latest plan has step-up approval
+func (u *taskUsecase) checkStepUpApproval(ctx context.Context, task *entity.Task) error {
+	if !task.HighRisk {
+		return nil
+	}
+
+	plan, err := u.planRepo.GetLatestByTaskID(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get plan: %w", err)
+	}
+	approvals, err := u.planApprovalRepo.ListByPlanID(ctx, plan.ID)
+	if err != nil {
+		return err
+	}
+	if !entity.HasStepUpApproval(approvals) {
+		return ErrStepUpApprovalRequired
+	}
+	return nil
+}
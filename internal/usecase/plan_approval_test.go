@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/totp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+// planApprovalStore is an in-memory PlanApprovalRepository keeping one
+// approval per plan and approver
+type planApprovalStore struct {
+	approvals []*entity.PlanApproval
+}
+
+func (s *planApprovalStore) Create(ctx context.Context, approval *entity.PlanApproval) error {
+	for _, existing := range s.approvals {
+		if existing.PlanID == approval.PlanID && existing.Approver == approval.Approver {
+			return nil
+		}
+	}
+	s.approvals = append(s.approvals, approval)
+	return nil
+}
+
+func (s *planApprovalStore) ListByPlanID(ctx context.Context, planID uuid.UUID) ([]*entity.PlanApproval, error) {
+	var approvals []*entity.PlanApproval
+	for _, approval := range s.approvals {
+		if approval.PlanID == planID {
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals, nil
+}
+
+func newPlanApprovalTestUsecase(t *testing.T, task *entity.Task) (*taskUsecase, *planApprovalStore) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	planRepo := repository.NewPlanRepositoryMock(t)
+	store := &planApprovalStore{}
+
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Maybe()
+	planRepo.EXPECT().GetLatestByTaskID(mock.Anything, task.ID).Return(&entity.Plan{ID: uuid.New(), TaskID: task.ID}, nil).Maybe()
+
+	return &taskUsecase{
+		taskRepo:           taskRepo,
+		planRepo:           planRepo,
+		planApprovalRepo:   store,
+		approvalTOTPSecret: testTOTPSecret,
+	}, store
+}
+
+func highRiskTask() *entity.Task {
+	return &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusPLANREVIEWING, HighRisk: true}
+}
+
+func TestRecordPlanApproval_NotHighRisk(t *testing.T) {
+	task := highRiskTask()
+	task.HighRisk = false
+	uc, store := newPlanApprovalTestUsecase(t, task)
+
+	approved, err := uc.RecordPlanApproval(context.Background(), task.ID, PlanApprovalRequest{})
+	require.NoError(t, err)
+	assert.True(t, approved)
+	assert.Empty(t, store.approvals)
+}
+
+func TestRecordPlanApproval_TwoApprovers(t *testing.T) {
+	task := highRiskTask()
+	uc, _ := newPlanApprovalTestUsecase(t, task)
+	ctx := context.Background()
+
+	_, err := uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{})
+	assert.ErrorIs(t, err, ErrStepUpApprovalRequired)
+
+	approved, err := uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: "alice"})
+	require.NoError(t, err)
+	assert.False(t, approved)
+	assert.ErrorIs(t, uc.checkStepUpApproval(ctx, task), ErrStepUpApprovalRequired)
+
+	approved, err = uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: "alice"})
+	require.NoError(t, err)
+	assert.False(t, approved)
+
+	approved, err = uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: "bob"})
+	require.NoError(t, err)
+	assert.True(t, approved)
+	assert.NoError(t, uc.checkStepUpApproval(ctx, task))
+}
+
+func TestRecordPlanApproval_TOTP(t *testing.T) {
+	task := highRiskTask()
+	uc, store := newPlanApprovalTestUsecase(t, task)
+	ctx := context.Background()
+
+	_, err := uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: "alice", TOTPCode: "000000"})
+	assert.ErrorIs(t, err, ErrTOTPCodeInvalid)
+
+	code, err := totp.Generate(testTOTPSecret, time.Now())
+	require.NoError(t, err)
+	approved, err := uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: "alice", TOTPCode: code})
+	require.NoError(t, err)
+	assert.True(t, approved)
+	require.Len(t, store.approvals, 1)
+	assert.Equal(t, "totp:alice", store.approvals[0].Approver)
+
+	uc.approvalTOTPSecret = ""
+	_, err = uc.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{TOTPCode: code})
+	assert.ErrorIs(t, err, ErrTOTPNotConfigured)
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUsecase_UpdateContent(t *testing.T) {
+	ctx := context.Background()
+	newPlan := func(taskStatus entity.TaskStatus, planStatus entity.PlanStatus) (*entity.Task, *entity.Plan) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: taskStatus}
+		plan := &entity.Plan{ID: uuid.New(), TaskID: task.ID, Status: planStatus, Content: "# Plan\n\n1. Add login"}
+		return task, plan
+	}
+
+	t.Run("saves the edit as the next version", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		task, plan := newPlan(entity.TaskStatusPLANREVIEWING, entity.PlanStatusREVIEWING)
+
+		planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+		planRepo.EXPECT().Revise(ctx, plan.ID, "# Plan\n\n1. Add login\n2. Add logout", "alice").
+			Return(&entity.PlanVersion{PlanID: plan.ID, Version: 2}, nil)
+		projectRepo.EXPECT().GetByID(ctx, task.ProjectID).Return(&entity.Project{ID: task.ProjectID}, nil)
+
+		uc := NewPlanUsecase(planRepo, nil, taskRepo, projectRepo, nil)
+		edited, version, err := uc.UpdateContent(ctx, task.ID, plan.ID, UpdatePlanContentRequest{
+			Content:  " # Plan\n\n1. Add login\n2. Add logout\n",
+			EditedBy: "alice",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "# Plan\n\n1. Add login\n2. Add logout", edited.Content)
+		assert.Equal(t, 2, version.Version)
+	})
+
+	t.Run("marks the task high-risk when the edit touches high-risk paths", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		task, plan := newPlan(entity.TaskStatusPLANREVIEWING, entity.PlanStatusREVIEWING)
+
+		planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+		planRepo.EXPECT().Revise(ctx, plan.ID, mock.Anything, "user").Return(&entity.PlanVersion{Version: 2}, nil)
+		projectRepo.EXPECT().GetByID(ctx, task.ProjectID).Return(&entity.Project{ID: task.ProjectID, HighRiskPaths: "migrations/"}, nil)
+		taskRepo.EXPECT().Update(ctx, mock.MatchedBy(func(updated *entity.Task) bool {
+			return updated.HighRisk && updated.HighRiskReason != nil
+		})).Return(nil)
+
+		uc := NewPlanUsecase(planRepo, nil, taskRepo, projectRepo, nil)
+		_, _, err := uc.UpdateContent(ctx, task.ID, plan.ID, UpdatePlanContentRequest{Content: "Edit migrations/000001_init.up.sql"})
+		require.NoError(t, err)
+	})
+
+	t.Run("refuses a plan no longer under review", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		task, plan := newPlan(entity.TaskStatusIMPLEMENTING, entity.PlanStatusAPPROVED)
+
+		planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+
+		uc := NewPlanUsecase(planRepo, nil, taskRepo, nil, nil)
+		_, _, err := uc.UpdateContent(ctx, task.ID, plan.ID, UpdatePlanContentRequest{Content: "# Plan"})
+		assert.ErrorIs(t, err, ErrPlanNotEditable)
+	})
+
+	t.Run("refuses a high-risk plan with approvals", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		planApprovalRepo := repository.NewPlanApprovalRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		task, plan := newPlan(entity.TaskStatusPLANREVIEWING, entity.PlanStatusREVIEWING)
+		task.HighRisk = true
+
+		planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+		planApprovalRepo.EXPECT().ListByPlanID(ctx, plan.ID).Return([]*entity.PlanApproval{{PlanID: plan.ID, Approver: "alice"}}, nil)
+
+		uc := NewPlanUsecase(planRepo, planApprovalRepo, taskRepo, nil, nil)
+		_, _, err := uc.UpdateContent(ctx, task.ID, plan.ID, UpdatePlanContentRequest{Content: "# Plan"})
+		assert.ErrorIs(t, err, ErrPlanAlreadyApproved)
+	})
+
+	t.Run("refuses a plan of another task", func(t *testing.T) {
+		planRepo := repository.NewPlanRepositoryMock(t)
+		_, plan := newPlan(entity.TaskStatusPLANREVIEWING, entity.PlanStatusREVIEWING)
+
+		planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+
+		uc := NewPlanUsecase(planRepo, nil, nil, nil, nil)
+		_, _, err := uc.UpdateContent(ctx, uuid.New(), plan.ID, UpdatePlanContentRequest{Content: "# Plan"})
+		assert.ErrorIs(t, err, ErrPlanNotFound)
+	})
+
+	t.Run("requires content", func(t *testing.T) {
+		uc := NewPlanUsecase(nil, nil, nil, nil, nil)
+		_, _, err := uc.UpdateContent(ctx, uuid.New(), uuid.New(), UpdatePlanContentRequest{Content: " \n"})
+		assert.ErrorIs(t, err, ErrPlanContentRequired)
+	})
+}
+
+func TestPlanUsecase_GetVersions(t *testing.T) {
+	ctx := context.Background()
+	planRepo := repository.NewPlanRepositoryMock(t)
+	plan := &entity.Plan{ID: uuid.New(), TaskID: uuid.New()}
+
+	planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+	planRepo.EXPECT().GetVersions(ctx, plan.ID).Return([]*entity.PlanVersion{{Version: 1}, {Version: 2}}, nil)
+	planRepo.EXPECT().GetByID(ctx, mock.Anything).Return(nil, errors.New("record not found"))
+
+	uc := NewPlanUsecase(planRepo, nil, nil, nil, nil)
+	versions, err := uc.GetVersions(ctx, plan.TaskID, plan.ID)
+	require.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	_, err = uc.GetVersions(ctx, plan.TaskID, uuid.New())
+	assert.ErrorIs(t, err, ErrPlanNotFound)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"regexp"
 	"sort"
 	"strings"
@@ -13,6 +14,8 @@ import (
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/auto-devs/auto-devs/internal/repository"
 	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/pkg/i18n"
 	"github.com/google/uuid"
 )
 
@@ -24,15 +27,47 @@ type ProjectUsecase interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetWithTasks(ctx context.Context, id uuid.UUID) (*entity.Project, error)
 	GetStatistics(ctx context.Context, id uuid.UUID) (*ProjectStatistics, error)
+	// GetActivity returns a page of the project's activity feed, most recent
+	// first, and the number of entries in the feed
+	GetActivity(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]*entity.ProjectActivity, int, error)
 	Archive(ctx context.Context, id uuid.UUID) error
 	Restore(ctx context.Context, id uuid.UUID) error
 	CheckNameExists(ctx context.Context, name string, excludeID *uuid.UUID) (bool, error)
 	GetSettings(ctx context.Context, projectID uuid.UUID) (*entity.ProjectSettings, error)
 	UpdateSettings(ctx context.Context, projectID uuid.UUID, settings *entity.ProjectSettings) (*entity.ProjectSettings, error)
+	// GetVerificationPipeline returns the project's saved verification
+	// pipeline, or the default one its settings describe
+	GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)
+	SaveVerificationPipeline(ctx context.Context, projectID uuid.UUID, steps []entity.VerificationPipelineStep) (*entity.VerificationPipeline, error)
+	ResetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)
+	// GetWorkflowPresets returns the project's preset of every workflow type,
+	// saved or default
+	GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error)
+	GetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error)
+	SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) (*entity.WorkflowPreset, error)
+	ResetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error)
 	UpdateRepositoryURL(ctx context.Context, projectID uuid.UUID, repositoryURL string) error
 	ReinitGitRepository(ctx context.Context, projectID uuid.UUID) error
 	GetGitStatus(ctx context.Context, projectID uuid.UUID) (*GitStatus, error)
 	ListBranches(ctx context.Context, projectID uuid.UUID, includeRemote bool) ([]GitBranch, error)
+	// GetMonthlySpend reports what the project spent on AI runs in the
+	// calendar month (UTC) holding month, per executor
+	GetMonthlySpend(ctx context.Context, projectID uuid.UUID, month time.Time) (*MonthlySpend, error)
+	// RecordExecutionUsage stores the usage an execution's AI run reported
+	// and returns the budget alerts its cost set off
+	RecordExecutionUsage(ctx context.Context, projectID uuid.UUID, execution *entity.Execution, usage entity.ExecutionUsage) ([]BudgetAlert, error)
+	// HasGitHubToken reports whether the project has its own GitHub token
+	// rather than using the global one
+	HasGitHubToken(ctx context.Context, projectID uuid.UUID) (bool, error)
+	// SetGitHubToken stores the token the project's pull requests are
+	// opened and tracked with
+	SetGitHubToken(ctx context.Context, projectID uuid.UUID, token string) error
+	// DeleteGitHubToken removes the project's token, going back to the
+	// global one
+	DeleteGitHubToken(ctx context.Context, projectID uuid.UUID) error
+	// Search finds the projects, tasks, plans and pull requests matching
+	// query, best match first, returning at most limit results
+	Search(ctx context.Context, query string, limit int) ([]*entity.SearchResult, error)
 }
 
 type CreateProjectRequest struct {
@@ -40,6 +75,41 @@ type CreateProjectRequest struct {
 	Description         string `json:"description"`
 	WorktreeBasePath    string `json:"worktree_base_path" binding:"required"`
 	InitWorkspaceScript string `json:"init_workspace_script"`
+	LintCommand         string `json:"lint_command"`
+	AutoCommitLintFixes bool   `json:"auto_commit_lint_fixes"`
+	BuildCommand        string `json:"build_command"`
+	TestCommand         string `json:"test_command"`
+	TestFailurePolicy   string `json:"test_failure_policy"`
+	CoverageCommand     string `json:"coverage_command"`
+	SecurityScanner     string `json:"security_scanner"`
+	AIReviewEnabled     bool   `json:"ai_review_enabled"`
+	PostReviewComments  bool   `json:"post_review_comments"`
+	AutoFixAttempts     int    `json:"auto_fix_attempts"`
+	PreviewEnabled      bool   `json:"preview_enabled"`
+	PreviewCommand      string `json:"preview_command"` // docker compose is used when empty
+
+	// MonthlyBudgetUSD of 0 means no budget. BudgetAlertThresholds are
+	// percentages of it, entity.DefaultBudgetAlertThresholds when empty.
+	MonthlyBudgetUSD      float64 `json:"monthly_budget_usd"`
+	BudgetAlertThresholds []int   `json:"budget_alert_thresholds"`
+
+	// AllowedCommands, when not empty, are the only shell commands AI
+	// executions may run; DeniedCommands may never run
+	AllowedCommands []string `json:"allowed_commands"`
+	DeniedCommands  []string `json:"denied_commands"`
+
+	EncryptExecutionLogs bool `json:"encrypt_execution_logs"`
+
+	// HighRiskPaths, when not empty, replace entity.DefaultHighRiskPaths
+	HighRiskPaths []string `json:"high_risk_paths"`
+
+	Locale string `json:"locale"` // i18n.DefaultLocale when empty
+
+	ExportPatches bool `json:"export_patches"`
+
+	GitProvider string `json:"git_provider"` // detected from the repository URL when empty
+
+	MaxConcurrentExecutions int `json:"max_concurrent_executions"` // 0 for no limit
 }
 
 type UpdateProjectRequest struct {
@@ -48,6 +118,42 @@ type UpdateProjectRequest struct {
 	RepositoryURL       string `json:"repository_url"`
 	WorktreeBasePath    string `json:"worktree_base_path"`
 	InitWorkspaceScript string `json:"init_workspace_script"`
+	LintCommand         string `json:"lint_command"`
+	AutoCommitLintFixes *bool  `json:"auto_commit_lint_fixes"`
+	BuildCommand        string `json:"build_command"`
+	TestCommand         string `json:"test_command"`
+	TestFailurePolicy   string `json:"test_failure_policy"`
+	CoverageCommand     string `json:"coverage_command"`
+	SecurityScanner     string `json:"security_scanner"` // "none" turns scanning off
+	AIReviewEnabled     *bool  `json:"ai_review_enabled"`
+	PostReviewComments  *bool  `json:"post_review_comments"`
+	AutoFixAttempts     *int   `json:"auto_fix_attempts"`
+	PreviewEnabled      *bool  `json:"preview_enabled"`
+	PreviewCommand      string `json:"preview_command"`
+
+	// BudgetAlertThresholds is left unchanged when nil and reset to the
+	// defaults when empty
+	MonthlyBudgetUSD      *float64 `json:"monthly_budget_usd"`
+	BudgetAlertThresholds []int    `json:"budget_alert_thresholds"`
+
+	// AllowedCommands and DeniedCommands are left unchanged when nil and
+	// cleared when empty
+	AllowedCommands []string `json:"allowed_commands"`
+	DeniedCommands  []string `json:"denied_commands"`
+
+	EncryptExecutionLogs *bool `json:"encrypt_execution_logs"`
+
+	// HighRiskPaths is left unchanged when nil and reset to the defaults
+	// when empty
+	HighRiskPaths []string `json:"high_risk_paths"`
+
+	Locale string `json:"locale"`
+
+	ExportPatches *bool `json:"export_patches"`
+
+	GitProvider string `json:"git_provider"` // "auto" detects it from the repository URL
+
+	MaxConcurrentExecutions *int `json:"max_concurrent_executions"` // 0 lifts the limit
 }
 
 type GetProjectsParams struct {
@@ -113,6 +219,39 @@ var (
 	ErrRepoURLRequired     = errors.New("repository URL is required")
 	ErrRepoURLInvalid      = errors.New("repository URL is invalid")
 	ErrRepoURLTooLong      = errors.New("repository URL must not exceed 500 characters")
+	ErrProjectNotFound     = errors.New("project not found")
+
+	ErrTestFailurePolicyInvalid = errors.New("test failure policy must be block or flag")
+	ErrSecurityScannerInvalid   = errors.New("security scanner must be gosec or semgrep")
+	ErrAutoFixAttemptsInvalid   = errors.New("auto-fix attempts must be between 0 and 5")
+
+	ErrMonthlyBudgetInvalid         = errors.New("monthly budget must not be negative")
+	ErrBudgetAlertThresholdsInvalid = errors.New("budget alert thresholds must be between 1 and 1000 percent")
+
+	ErrGitHubTokenRequired = errors.New("GitHub token is required")
+
+	ErrCommandPolicyInvalid = errors.New("allowed and denied commands must be single lines of at most 200 characters, without parentheses")
+	ErrHighRiskPathsInvalid = errors.New("high-risk paths must be single lines of at most 200 characters")
+
+	ErrLocaleInvalid = errors.New("locale must be en or vi")
+
+	ErrGitProviderInvalid = errors.New("git provider must be github or gitlab")
+
+	ErrMaxConcurrentExecutionsInvalid = errors.New("max concurrent executions must not be negative")
+
+	ErrSearchQueryRequired = errors.New("search query is required")
+
+	ErrVerificationPipelineEmpty         = errors.New("verification pipeline must have at least one step")
+	ErrVerificationStepInvalid           = errors.New("verification step must be setup, lint, build, test, security_scan, review or test_changes")
+	ErrVerificationStepDuplicate         = errors.New("only setup steps may appear more than once in a verification pipeline")
+	ErrVerificationStepCommandRequired   = errors.New("verification step needs a command, either its own or the project's")
+	ErrVerificationStepScannerRequired   = errors.New("security_scan step needs the project's security scanner to be set")
+	ErrVerificationStepCommandNotAllowed = errors.New("security_scan, review and test_changes steps take no command")
+
+	ErrWorkflowTypeInvalid = errors.New("workflow type must be feature, bugfix or refactor")
+
+	ErrPromptTemplateTooLong = errors.New("prompt prefix and suffix must be at most 10000 characters")
+	ErrContextFilesInvalid   = errors.New("context files must be at most 20 relative paths inside the repository")
 )
 
 // validateProjectName validates project name according to business rules
@@ -138,6 +277,78 @@ func validateDescription(description string) error {
 	return nil
 }
 
+// formatCommands validates the commands of a command policy and joins them
+// for storage, one per line and without duplicates. Parentheses would end
+// the permission rules executors are given early.
+func formatCommands(commands []string) (string, error) {
+	seen := make(map[string]bool, len(commands))
+	lines := make([]string, 0, len(commands))
+	for _, command := range commands {
+		command = strings.TrimSpace(command)
+		if command == "" || len(command) > 200 || strings.ContainsAny(command, "\r\n()") {
+			return "", ErrCommandPolicyInvalid
+		}
+		if seen[command] {
+			continue
+		}
+		seen[command] = true
+		lines = append(lines, command)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// formatHighRiskPaths validates high-risk path fragments and joins them for
+// storage, one per line and without duplicates
+func formatHighRiskPaths(paths []string) (string, error) {
+	seen := make(map[string]bool, len(paths))
+	lines := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" || len(path) > 200 || strings.ContainsAny(path, "\r\n") {
+			return "", ErrHighRiskPathsInvalid
+		}
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		lines = append(lines, path)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// maxPromptTemplateLength bounds the prompt prefix and suffix of a project
+const maxPromptTemplateLength = 10000
+
+// maxContextFiles bounds the context files quoted in every prompt
+const maxContextFiles = 20
+
+// formatContextFiles validates the paths of context files and joins them for
+// storage, one per line and without duplicates. Paths are relative to the
+// repository and may not leave it.
+func formatContextFiles(files []string) (string, error) {
+	seen := make(map[string]bool, len(files))
+	lines := make([]string, 0, len(files))
+	for _, file := range files {
+		file = strings.TrimSpace(file)
+		if file == "" || len(file) > 255 || strings.ContainsAny(file, "\r\n\\") || path.IsAbs(file) {
+			return "", ErrContextFilesInvalid
+		}
+		file = path.Clean(file)
+		if file == "." || file == ".." || strings.HasPrefix(file, "../") {
+			return "", ErrContextFilesInvalid
+		}
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+		lines = append(lines, file)
+	}
+	if len(lines) > maxContextFiles {
+		return "", ErrContextFilesInvalid
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
 // validateRepoURL validates repository URL format
 func validateRepoURL(repoURL string) error {
 	repoURL = strings.TrimSpace(repoURL)
@@ -172,16 +383,24 @@ func validateRepoURL(repoURL string) error {
 }
 
 type projectUsecase struct {
-	projectRepo  repository.ProjectRepository
-	auditUsecase AuditUsecase
-	gitService   git.ProjectGitServiceInterface
+	projectAccess
+	projectRepo   repository.ProjectRepository
+	auditUsecase  AuditUsecase
+	gitService    git.ProjectGitServiceInterface
+	executionRepo repository.ExecutionRepository
+	eventRepo     repository.EventRepository
+	secretStore   secrets.Store
 }
 
-func NewProjectUsecase(projectRepo repository.ProjectRepository, auditUsecase AuditUsecase, gitService git.ProjectGitServiceInterface) ProjectUsecase {
+func NewProjectUsecase(projectRepo repository.ProjectRepository, auditUsecase AuditUsecase, gitService git.ProjectGitServiceInterface, executionRepo repository.ExecutionRepository, eventRepo repository.EventRepository, secretStore secrets.Store, memberRepo repository.ProjectMemberRepository) ProjectUsecase {
 	return &projectUsecase{
-		projectRepo:  projectRepo,
-		auditUsecase: auditUsecase,
-		gitService:   gitService,
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		projectRepo:   projectRepo,
+		auditUsecase:  auditUsecase,
+		gitService:    gitService,
+		executionRepo: executionRepo,
+		eventRepo:     eventRepo,
+		secretStore:   secretStore,
 	}
 }
 
@@ -193,6 +412,47 @@ func (u *projectUsecase) Create(ctx context.Context, req CreateProjectRequest) (
 	if err := validateDescription(req.Description); err != nil {
 		return nil, err
 	}
+	if req.TestFailurePolicy != "" && !entity.TestFailurePolicy(req.TestFailurePolicy).IsValid() {
+		return nil, ErrTestFailurePolicyInvalid
+	}
+	if req.SecurityScanner != "" && !entity.SecurityScanner(req.SecurityScanner).IsValid() {
+		return nil, ErrSecurityScannerInvalid
+	}
+	if req.GitProvider != "" && !entity.GitProvider(req.GitProvider).IsValid() {
+		return nil, ErrGitProviderInvalid
+	}
+	if req.AutoFixAttempts < 0 || req.AutoFixAttempts > entity.MaxAutoFixAttempts {
+		return nil, ErrAutoFixAttemptsInvalid
+	}
+	if req.MaxConcurrentExecutions < 0 {
+		return nil, ErrMaxConcurrentExecutionsInvalid
+	}
+	if req.MonthlyBudgetUSD < 0 {
+		return nil, ErrMonthlyBudgetInvalid
+	}
+	budgetAlertThresholds, err := formatBudgetAlertThresholds(req.BudgetAlertThresholds)
+	if err != nil {
+		return nil, err
+	}
+	allowedCommands, err := formatCommands(req.AllowedCommands)
+	if err != nil {
+		return nil, err
+	}
+	deniedCommands, err := formatCommands(req.DeniedCommands)
+	if err != nil {
+		return nil, err
+	}
+	highRiskPaths, err := formatHighRiskPaths(req.HighRiskPaths)
+	if err != nil {
+		return nil, err
+	}
+	locale := i18n.DefaultLocale
+	if req.Locale != "" {
+		locale = i18n.Locale(req.Locale)
+		if !locale.IsValid() {
+			return nil, ErrLocaleInvalid
+		}
+	}
 
 	// Check for duplicate name
 	exists, err := u.CheckNameExists(ctx, req.Name, nil)
@@ -210,14 +470,47 @@ func (u *projectUsecase) Create(ctx context.Context, req CreateProjectRequest) (
 		RepositoryURL:       "", // Will be populated by git service later
 		WorktreeBasePath:    strings.TrimSpace(req.WorktreeBasePath),
 		InitWorkspaceScript: strings.TrimSpace(req.InitWorkspaceScript),
+		LintCommand:         strings.TrimSpace(req.LintCommand),
+		AutoCommitLintFixes: req.AutoCommitLintFixes,
+		BuildCommand:        strings.TrimSpace(req.BuildCommand),
+		TestCommand:         strings.TrimSpace(req.TestCommand),
+		TestFailurePolicy:   entity.TestFailurePolicy(req.TestFailurePolicy),
+		CoverageCommand:     strings.TrimSpace(req.CoverageCommand),
+		SecurityScanner:     entity.SecurityScanner(req.SecurityScanner),
+		AIReviewEnabled:     req.AIReviewEnabled,
+		PostReviewComments:  req.PostReviewComments,
+		AutoFixAttempts:     req.AutoFixAttempts,
+		PreviewEnabled:      req.PreviewEnabled,
+		PreviewCommand:      strings.TrimSpace(req.PreviewCommand),
 		CreatedAt:           time.Now(),
 		UpdatedAt:           time.Now(),
+
+		MonthlyBudgetUSD:      req.MonthlyBudgetUSD,
+		BudgetAlertThresholds: budgetAlertThresholds,
+
+		AllowedCommands: allowedCommands,
+		DeniedCommands:  deniedCommands,
+
+		EncryptExecutionLogs: req.EncryptExecutionLogs,
+		HighRiskPaths:        highRiskPaths,
+		Locale:               string(locale),
+		ExportPatches:        req.ExportPatches,
+		GitProvider:          entity.GitProvider(req.GitProvider),
+
+		MaxConcurrentExecutions: req.MaxConcurrentExecutions,
 	}
 
 	if err := u.projectRepo.Create(ctx, project); err != nil {
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
+	// Users creating a project own it, which restricts it to its members
+	if userID, ok := repository.UserIDFromContext(ctx); ok {
+		if err := u.memberRepo.Save(ctx, &entity.ProjectMember{ProjectID: project.ID, UserID: userID, Role: entity.ProjectRoleOwner}); err != nil {
+			return nil, fmt.Errorf("failed to add project owner: %w", err)
+		}
+	}
+
 	// Log the create operation
 	if u.auditUsecase != nil {
 		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionCreate, project.ID, nil, project, fmt.Sprintf("Created project '%s'", project.Name))
@@ -238,6 +531,9 @@ func (u *projectUsecase) Create(ctx context.Context, req CreateProjectRequest) (
 }
 
 func (u *projectUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Project, error) {
+	if err := u.authorize(ctx, id, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	return u.projectRepo.GetByID(ctx, id)
 }
 
@@ -259,6 +555,10 @@ func (u *projectUsecase) GetAll(ctx context.Context, params GetProjectsParams) (
 		params.SortOrder = "desc"
 	}
 
+	var memberID *uuid.UUID
+	if userID, ok := repository.UserIDFromContext(ctx); ok {
+		memberID = &userID
+	}
 	projects, total, err := u.projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{
 		Search:    params.Search,
 		SortBy:    params.SortBy,
@@ -266,6 +566,7 @@ func (u *projectUsecase) GetAll(ctx context.Context, params GetProjectsParams) (
 		Page:      params.Page,
 		PageSize:  params.PageSize,
 		Archived:  params.Archived,
+		MemberID:  memberID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get projects: %w", err)
@@ -290,6 +591,9 @@ func (u *projectUsecase) GetAll(ctx context.Context, params GetProjectsParams) (
 }
 
 func (u *projectUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateProjectRequest) (*entity.Project, error) {
+	if err := u.authorize(ctx, id, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
 	oldProject, err := u.projectRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -331,6 +635,115 @@ func (u *projectUsecase) Update(ctx context.Context, id uuid.UUID, req UpdatePro
 	if req.InitWorkspaceScript != "" {
 		oldProject.InitWorkspaceScript = strings.TrimSpace(req.InitWorkspaceScript)
 	}
+	if req.LintCommand != "" {
+		oldProject.LintCommand = strings.TrimSpace(req.LintCommand)
+	}
+	if req.AutoCommitLintFixes != nil {
+		oldProject.AutoCommitLintFixes = *req.AutoCommitLintFixes
+	}
+	if req.BuildCommand != "" {
+		oldProject.BuildCommand = strings.TrimSpace(req.BuildCommand)
+	}
+	if req.TestCommand != "" {
+		oldProject.TestCommand = strings.TrimSpace(req.TestCommand)
+	}
+	if req.TestFailurePolicy != "" {
+		if !entity.TestFailurePolicy(req.TestFailurePolicy).IsValid() {
+			return nil, ErrTestFailurePolicyInvalid
+		}
+		oldProject.TestFailurePolicy = entity.TestFailurePolicy(req.TestFailurePolicy)
+	}
+	if req.CoverageCommand != "" {
+		oldProject.CoverageCommand = strings.TrimSpace(req.CoverageCommand)
+	}
+	switch {
+	case req.SecurityScanner == "none":
+		oldProject.SecurityScanner = ""
+	case req.SecurityScanner != "":
+		if !entity.SecurityScanner(req.SecurityScanner).IsValid() {
+			return nil, ErrSecurityScannerInvalid
+		}
+		oldProject.SecurityScanner = entity.SecurityScanner(req.SecurityScanner)
+	}
+	if req.AIReviewEnabled != nil {
+		oldProject.AIReviewEnabled = *req.AIReviewEnabled
+	}
+	if req.PostReviewComments != nil {
+		oldProject.PostReviewComments = *req.PostReviewComments
+	}
+	if req.AutoFixAttempts != nil {
+		if *req.AutoFixAttempts < 0 || *req.AutoFixAttempts > entity.MaxAutoFixAttempts {
+			return nil, ErrAutoFixAttemptsInvalid
+		}
+		oldProject.AutoFixAttempts = *req.AutoFixAttempts
+	}
+	if req.PreviewEnabled != nil {
+		oldProject.PreviewEnabled = *req.PreviewEnabled
+	}
+	if req.PreviewCommand != "" {
+		oldProject.PreviewCommand = strings.TrimSpace(req.PreviewCommand)
+	}
+	if req.MonthlyBudgetUSD != nil {
+		if *req.MonthlyBudgetUSD < 0 {
+			return nil, ErrMonthlyBudgetInvalid
+		}
+		oldProject.MonthlyBudgetUSD = *req.MonthlyBudgetUSD
+	}
+	if req.BudgetAlertThresholds != nil {
+		thresholds, err := formatBudgetAlertThresholds(req.BudgetAlertThresholds)
+		if err != nil {
+			return nil, err
+		}
+		oldProject.BudgetAlertThresholds = thresholds
+	}
+	if req.AllowedCommands != nil {
+		commands, err := formatCommands(req.AllowedCommands)
+		if err != nil {
+			return nil, err
+		}
+		oldProject.AllowedCommands = commands
+	}
+	if req.DeniedCommands != nil {
+		commands, err := formatCommands(req.DeniedCommands)
+		if err != nil {
+			return nil, err
+		}
+		oldProject.DeniedCommands = commands
+	}
+	if req.EncryptExecutionLogs != nil {
+		oldProject.EncryptExecutionLogs = *req.EncryptExecutionLogs
+	}
+	if req.HighRiskPaths != nil {
+		paths, err := formatHighRiskPaths(req.HighRiskPaths)
+		if err != nil {
+			return nil, err
+		}
+		oldProject.HighRiskPaths = paths
+	}
+	if req.Locale != "" {
+		if !i18n.Locale(req.Locale).IsValid() {
+			return nil, ErrLocaleInvalid
+		}
+		oldProject.Locale = req.Locale
+	}
+	if req.ExportPatches != nil {
+		oldProject.ExportPatches = *req.ExportPatches
+	}
+	switch {
+	case req.GitProvider == "auto":
+		oldProject.GitProvider = ""
+	case req.GitProvider != "":
+		if !entity.GitProvider(req.GitProvider).IsValid() {
+			return nil, ErrGitProviderInvalid
+		}
+		oldProject.GitProvider = entity.GitProvider(req.GitProvider)
+	}
+	if req.MaxConcurrentExecutions != nil {
+		if *req.MaxConcurrentExecutions < 0 {
+			return nil, ErrMaxConcurrentExecutionsInvalid
+		}
+		oldProject.MaxConcurrentExecutions = *req.MaxConcurrentExecutions
+	}
 
 	oldProject.UpdatedAt = time.Now()
 
@@ -347,6 +760,9 @@ func (u *projectUsecase) Update(ctx context.Context, id uuid.UUID, req UpdatePro
 }
 
 func (u *projectUsecase) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := u.authorize(ctx, id, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
 	// Get project for audit logging
 	project, err := u.projectRepo.GetByID(ctx, id)
 	if err != nil {
@@ -367,6 +783,9 @@ func (u *projectUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (u *projectUsecase) GetWithTasks(ctx context.Context, id uuid.UUID) (*entity.Project, error) {
+	if err := u.authorize(ctx, id, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	project, err := u.projectRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -378,6 +797,9 @@ func (u *projectUsecase) GetWithTasks(ctx context.Context, id uuid.UUID) (*entit
 }
 
 func (u *projectUsecase) GetStatistics(ctx context.Context, id uuid.UUID) (*ProjectStatistics, error) {
+	if err := u.authorize(ctx, id, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	// Get project to ensure it exists
 	_, err := u.projectRepo.GetByID(ctx, id)
 	if err != nil {
@@ -420,6 +842,9 @@ func (u *projectUsecase) GetStatistics(ctx context.Context, id uuid.UUID) (*Proj
 }
 
 func (u *projectUsecase) Archive(ctx context.Context, id uuid.UUID) error {
+	if err := u.authorize(ctx, id, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
 	project, err := u.projectRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -439,6 +864,9 @@ func (u *projectUsecase) Archive(ctx context.Context, id uuid.UUID) error {
 }
 
 func (u *projectUsecase) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := u.authorize(ctx, id, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
 	err := u.projectRepo.Restore(ctx, id)
 	if err != nil {
 		return err
@@ -458,6 +886,9 @@ func (u *projectUsecase) CheckNameExists(ctx context.Context, name string, exclu
 }
 
 func (u *projectUsecase) GetSettings(ctx context.Context, projectID uuid.UUID) (*entity.ProjectSettings, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	// Verify project exists
 	_, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
@@ -491,12 +922,26 @@ func (u *projectUsecase) GetSettings(ctx context.Context, projectID uuid.UUID) (
 }
 
 func (u *projectUsecase) UpdateSettings(ctx context.Context, projectID uuid.UUID, settings *entity.ProjectSettings) (*entity.ProjectSettings, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
 	// Verify project exists
 	_, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
 
+	settings.PromptPrefix = strings.TrimSpace(settings.PromptPrefix)
+	settings.PromptSuffix = strings.TrimSpace(settings.PromptSuffix)
+	if len(settings.PromptPrefix) > maxPromptTemplateLength || len(settings.PromptSuffix) > maxPromptTemplateLength {
+		return nil, ErrPromptTemplateTooLong
+	}
+	contextFiles, err := formatContextFiles(settings.ContextFileList())
+	if err != nil {
+		return nil, err
+	}
+	settings.ContextFiles = contextFiles
+
 	settings.ProjectID = projectID
 	settings.UpdatedAt = time.Now()
 
@@ -508,7 +953,220 @@ func (u *projectUsecase) UpdateSettings(ctx context.Context, projectID uuid.UUID
 	return settings, nil
 }
 
+func (u *projectUsecase) GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := u.projectRepo.GetVerificationPipeline(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline == nil {
+		return entity.DefaultVerificationPipeline(project), nil
+	}
+
+	return pipeline, nil
+}
+
+func (u *projectUsecase) SaveVerificationPipeline(ctx context.Context, projectID uuid.UUID, steps []entity.VerificationPipelineStep) (*entity.VerificationPipeline, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range steps {
+		steps[i].Command = strings.TrimSpace(steps[i].Command)
+	}
+	if err := validateVerificationPipeline(project, steps); err != nil {
+		return nil, err
+	}
+
+	pipeline := &entity.VerificationPipeline{ProjectID: projectID, Steps: steps}
+	if err := u.projectRepo.SaveVerificationPipeline(ctx, pipeline); err != nil {
+		return nil, fmt.Errorf("failed to save verification pipeline: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, project, fmt.Sprintf("Updated verification pipeline of project '%s'", project.Name))
+	}
+
+	return pipeline, nil
+}
+
+func (u *projectUsecase) ResetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.projectRepo.DeleteVerificationPipeline(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("failed to reset verification pipeline: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, project, fmt.Sprintf("Reset verification pipeline of project '%s' to the default", project.Name))
+	}
+
+	return entity.DefaultVerificationPipeline(project), nil
+}
+
+func (u *projectUsecase) GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	saved, err := u.projectRepo.GetWorkflowPresets(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	byWorkflow := make(map[entity.WorkflowType]*entity.WorkflowPreset, len(saved))
+	for _, preset := range saved {
+		byWorkflow[preset.Workflow] = preset
+	}
+
+	presets := make([]*entity.WorkflowPreset, len(entity.WorkflowTypes))
+	for i, workflow := range entity.WorkflowTypes {
+		if preset, ok := byWorkflow[workflow]; ok {
+			presets[i] = preset
+		} else {
+			presets[i] = entity.DefaultWorkflowPreset(projectID, workflow)
+		}
+	}
+
+	return presets, nil
+}
+
+func (u *projectUsecase) GetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error) {
+	if !workflow.IsValid() {
+		return nil, ErrWorkflowTypeInvalid
+	}
+
+	presets, err := u.GetWorkflowPresets(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, preset := range presets {
+		if preset.Workflow == workflow {
+			return preset, nil
+		}
+	}
+
+	return entity.DefaultWorkflowPreset(projectID, workflow), nil
+}
+
+func (u *projectUsecase) SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) (*entity.WorkflowPreset, error) {
+	if err := u.authorize(ctx, preset.ProjectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	if !preset.Workflow.IsValid() {
+		return nil, ErrWorkflowTypeInvalid
+	}
+
+	project, err := u.projectRepo.GetByID(ctx, preset.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	preset.PlanningInstructions = strings.TrimSpace(preset.PlanningInstructions)
+	preset.ImplementationInstructions = strings.TrimSpace(preset.ImplementationInstructions)
+	if err := u.projectRepo.SaveWorkflowPreset(ctx, preset); err != nil {
+		return nil, fmt.Errorf("failed to save workflow preset: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, project, fmt.Sprintf("Updated %s workflow preset of project '%s'", preset.Workflow, project.Name))
+	}
+
+	return preset, nil
+}
+
+func (u *projectUsecase) ResetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	if !workflow.IsValid() {
+		return nil, ErrWorkflowTypeInvalid
+	}
+
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.projectRepo.DeleteWorkflowPreset(ctx, projectID, workflow); err != nil {
+		return nil, fmt.Errorf("failed to reset workflow preset: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, project, fmt.Sprintf("Reset %s workflow preset of project '%s' to the default", workflow, project.Name))
+	}
+
+	return entity.DefaultWorkflowPreset(projectID, workflow), nil
+}
+
+// validateVerificationPipeline checks that every step can run with the
+// project's settings
+func validateVerificationPipeline(project *entity.Project, steps []entity.VerificationPipelineStep) error {
+	if len(steps) == 0 {
+		return ErrVerificationPipelineEmpty
+	}
+
+	seen := make(map[entity.VerificationStep]bool, len(steps))
+	for _, step := range steps {
+		if !step.Step.IsValid() {
+			return ErrVerificationStepInvalid
+		}
+		if step.Step != entity.VerificationStepSetup {
+			if seen[step.Step] {
+				return ErrVerificationStepDuplicate
+			}
+			seen[step.Step] = true
+		}
+
+		switch step.Step {
+		case entity.VerificationStepSetup:
+			if step.Command == "" {
+				return ErrVerificationStepCommandRequired
+			}
+		case entity.VerificationStepLint, entity.VerificationStepBuild, entity.VerificationStepTest:
+			if step.CommandFor(project) == "" {
+				return ErrVerificationStepCommandRequired
+			}
+		case entity.VerificationStepSecurityScan:
+			if step.Command != "" {
+				return ErrVerificationStepCommandNotAllowed
+			}
+			if project.SecurityScanner == "" {
+				return ErrVerificationStepScannerRequired
+			}
+		case entity.VerificationStepReview, entity.VerificationStepTestChanges:
+			if step.Command != "" {
+				return ErrVerificationStepCommandNotAllowed
+			}
+		}
+	}
+
+	return nil
+}
+
 func (u *projectUsecase) UpdateRepositoryURL(ctx context.Context, projectID uuid.UUID, repositoryURL string) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
 	project, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
@@ -531,6 +1189,9 @@ func (u *projectUsecase) UpdateRepositoryURL(ctx context.Context, projectID uuid
 }
 
 func (u *projectUsecase) ReinitGitRepository(ctx context.Context, projectID uuid.UUID) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
 	project, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
@@ -549,6 +1210,9 @@ func (u *projectUsecase) ReinitGitRepository(ctx context.Context, projectID uuid
 }
 
 func (u *projectUsecase) GetGitStatus(ctx context.Context, projectID uuid.UUID) (*GitStatus, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	project, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
@@ -573,6 +1237,9 @@ func (u *projectUsecase) GetGitStatus(ctx context.Context, projectID uuid.UUID)
 
 // ListBranches lists all Git branches for a project
 func (u *projectUsecase) ListBranches(ctx context.Context, projectID uuid.UUID, includeRemote bool) ([]GitBranch, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	// Get project to ensure it exists and has Git configuration
 	project, err := u.projectRepo.GetByID(ctx, projectID)
 	if err != nil {
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// GetActivity combines the creations, status changes, plan approvals,
+// executions and pull request events of the project's tasks
+func (u *projectUsecase) GetActivity(ctx context.Context, projectID uuid.UUID, limit, offset int) ([]*entity.ProjectActivity, int, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, 0, err
+	}
+	return u.projectRepo.GetActivity(ctx, projectID, limit, offset)
+}
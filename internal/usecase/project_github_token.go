@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/google/uuid"
+)
+
+func (u *projectUsecase) HasGitHubToken(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return false, err
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return false, err
+	}
+
+	_, err := u.secretStore.Get(ctx, projectID, entity.GitHubTokenSecret)
+	if errors.Is(err, secrets.ErrNotFound) || errors.Is(err, secrets.ErrNoEncryptionKey) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read GitHub token: %w", err)
+	}
+	return true, nil
+}
+
+func (u *projectUsecase) SetGitHubToken(ctx context.Context, projectID uuid.UUID, token string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return ErrGitHubTokenRequired
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.secretStore.Put(ctx, projectID, entity.GitHubTokenSecret, token); err != nil {
+		return fmt.Errorf("failed to store GitHub token: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, nil, fmt.Sprintf("Set the GitHub token of project '%s'", project.Name))
+	}
+	return nil
+}
+
+func (u *projectUsecase) DeleteGitHubToken(ctx context.Context, projectID uuid.UUID) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.secretStore.Delete(ctx, projectID, entity.GitHubTokenSecret); err != nil {
+		return fmt.Errorf("failed to delete GitHub token: %w", err)
+	}
+
+	if u.auditUsecase != nil {
+		_ = u.auditUsecase.LogProjectOperation(ctx, entity.AuditActionUpdate, project.ID, nil, nil, fmt.Sprintf("Removed the GitHub token of project '%s', going back to the global one", project.Name))
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrProjectForbidden      = errors.New("your role in the project does not allow this")
+	ErrProjectRoleInvalid    = errors.New("project role must be owner, maintainer, reviewer or viewer")
+	ErrProjectMemberNotFound = errors.New("project member not found")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrProjectOwnerRequired  = errors.New("a project with members must keep an owner")
+)
+
+// ProjectMemberUsecase manages who may do what in a project. Users see and
+// change only the projects they are members of, as their role allows.
+type ProjectMemberUsecase interface {
+	ListMembers(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectMember, error)
+	// SetMember adds the user to the project with role, or changes their
+	// role. The first member of a project must be an owner.
+	SetMember(ctx context.Context, projectID uuid.UUID, username string, role entity.ProjectRole) (*entity.ProjectMember, error)
+	// RemoveMember removes the user from the project, keeping at least
+	// one owner
+	RemoveMember(ctx context.Context, projectID uuid.UUID, username string) error
+	// Authorize returns ErrProjectForbidden unless the request may act in
+	// the project with role
+	Authorize(ctx context.Context, projectID uuid.UUID, role entity.ProjectRole) error
+}
+
+// projectAccess checks the project roles of the users requests authenticate
+// as. Requests made with a static API key and background jobs are not
+// restricted; anonymous requests, served when authentication is optional,
+// only reach the projects without members.
+type projectAccess struct {
+	memberRepo repository.ProjectMemberRepository
+}
+
+// authorize returns ErrProjectForbidden unless the request's user has at
+// least role in the project
+func (a projectAccess) authorize(ctx context.Context, projectID uuid.UUID, role entity.ProjectRole) error {
+	userID, ok := repository.UserIDFromContext(ctx)
+	if !ok {
+		if !repository.IsAnonymous(ctx) {
+			return nil
+		}
+		members, err := a.memberRepo.CountByProject(ctx, projectID, "")
+		if err != nil {
+			return fmt.Errorf("failed to check project role: %w", err)
+		}
+		if members > 0 {
+			return ErrProjectForbidden
+		}
+		return nil
+	}
+
+	member, err := a.memberRepo.Get(ctx, projectID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check project role: %w", err)
+	}
+	if member == nil || !member.Role.AtLeast(role) {
+		return ErrProjectForbidden
+	}
+	return nil
+}
+
+type projectMemberUsecase struct {
+	projectAccess
+	userRepo    repository.UserRepository
+	projectRepo repository.ProjectRepository
+}
+
+func NewProjectMemberUsecase(memberRepo repository.ProjectMemberRepository, userRepo repository.UserRepository, projectRepo repository.ProjectRepository) ProjectMemberUsecase {
+	return &projectMemberUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		userRepo:      userRepo,
+		projectRepo:   projectRepo,
+	}
+}
+
+func (u *projectMemberUsecase) ListMembers(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectMember, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return u.memberRepo.ListByProject(ctx, projectID)
+}
+
+func (u *projectMemberUsecase) SetMember(ctx context.Context, projectID uuid.UUID, username string, role entity.ProjectRole) (*entity.ProjectMember, error) {
+	if !role.IsValid() {
+		return nil, ErrProjectRoleInvalid
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	user, err := u.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := u.memberRepo.Get(ctx, projectID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project member: %w", err)
+	}
+	if role != entity.ProjectRoleOwner {
+		if err := u.keepOwner(ctx, projectID, current); err != nil {
+			return nil, err
+		}
+	}
+
+	member := &entity.ProjectMember{ProjectID: projectID, UserID: user.ID, Role: role}
+	if err := u.memberRepo.Save(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to save project member: %w", err)
+	}
+	member.User = user
+	return member, nil
+}
+
+func (u *projectMemberUsecase) RemoveMember(ctx context.Context, projectID uuid.UUID, username string) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return err
+	}
+	user, err := u.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	current, err := u.memberRepo.Get(ctx, projectID, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get project member: %w", err)
+	}
+	if current == nil {
+		return ErrProjectMemberNotFound
+	}
+	// The last owner may not leave, which would lock the users out
+	if err := u.keepOwner(ctx, projectID, current); err != nil {
+		return err
+	}
+
+	if err := u.memberRepo.Delete(ctx, projectID, user.ID); err != nil {
+		return fmt.Errorf("%w: %v", ErrProjectMemberNotFound, err)
+	}
+	return nil
+}
+
+func (u *projectMemberUsecase) Authorize(ctx context.Context, projectID uuid.UUID, role entity.ProjectRole) error {
+	return u.authorize(ctx, projectID, role)
+}
+
+// keepOwner returns ErrProjectOwnerRequired when the member stopping being
+// an owner would leave the project without one. current is nil for users
+// not yet members, who are only added as owners to projects without any.
+func (u *projectMemberUsecase) keepOwner(ctx context.Context, projectID uuid.UUID, current *entity.ProjectMember) error {
+	if current != nil && current.Role != entity.ProjectRoleOwner {
+		return nil
+	}
+	owners, err := u.memberRepo.CountByProject(ctx, projectID, entity.ProjectRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to count project owners: %w", err)
+	}
+	if current == nil && owners == 0 || current != nil && owners == 1 {
+		return ErrProjectOwnerRequired
+	}
+	return nil
+}
+
+func (u *projectMemberUsecase) getUser(ctx context.Context, username string) (*entity.User, error) {
+	user, err := u.userRepo.GetByUsername(ctx, strings.TrimSpace(username))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectAccess_Authorize(t *testing.T) {
+	projectID, openProjectID := uuid.New(), uuid.New()
+	reviewer, outsider := uuid.New(), uuid.New()
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, reviewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleReviewer}, nil)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, outsider).Return(nil, nil)
+	memberRepo.EXPECT().Get(mock.Anything, openProjectID, outsider).Return(nil, nil)
+	memberRepo.EXPECT().CountByProject(mock.Anything, projectID, entity.ProjectRole("")).Return(2, nil)
+	memberRepo.EXPECT().CountByProject(mock.Anything, openProjectID, entity.ProjectRole("")).Return(0, nil)
+	anonymous := repository.WithAnonymous(context.Background())
+	access := projectAccess{memberRepo: memberRepo}
+	as := func(userID uuid.UUID) context.Context {
+		return repository.WithUserID(context.Background(), userID)
+	}
+
+	assert.NoError(t, access.authorize(context.Background(), projectID, entity.ProjectRoleOwner), "static API keys and jobs are not restricted")
+	assert.NoError(t, access.authorize(as(reviewer), projectID, entity.ProjectRoleViewer))
+	assert.NoError(t, access.authorize(as(reviewer), projectID, entity.ProjectRoleReviewer))
+	assert.ErrorIs(t, access.authorize(as(reviewer), projectID, entity.ProjectRoleMaintainer), ErrProjectForbidden)
+	assert.ErrorIs(t, access.authorize(as(outsider), projectID, entity.ProjectRoleViewer), ErrProjectForbidden)
+	assert.ErrorIs(t, access.authorize(as(outsider), openProjectID, entity.ProjectRoleViewer), ErrProjectForbidden, "users reach only the projects they are members of")
+	assert.ErrorIs(t, access.authorize(anonymous, projectID, entity.ProjectRoleViewer), ErrProjectForbidden)
+	assert.NoError(t, access.authorize(anonymous, openProjectID, entity.ProjectRoleOwner), "anonymous requests reach the projects without members")
+}
+
+func TestProjectMemberUsecase_Owners(t *testing.T) {
+	projectID := uuid.New()
+	alice := &entity.User{ID: uuid.New(), Username: "alice"}
+	bob := &entity.User{ID: uuid.New(), Username: "bob"}
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	userRepo := repository.NewUserRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	userRepo.EXPECT().GetByUsername(mock.Anything, "alice").Return(alice, nil)
+	userRepo.EXPECT().GetByUsername(mock.Anything, "bob").Return(bob, nil)
+	userRepo.EXPECT().GetByUsername(mock.Anything, "carol").Return(nil, nil)
+	uc := NewProjectMemberUsecase(memberRepo, userRepo, projectRepo)
+	ctx := context.Background()
+
+	_, err := uc.SetMember(ctx, projectID, "alice", "admin")
+	assert.ErrorIs(t, err, ErrProjectRoleInvalid)
+	_, err = uc.SetMember(ctx, projectID, "carol", entity.ProjectRoleViewer)
+	assert.ErrorIs(t, err, ErrUserNotFound)
+
+	memberRepo.EXPECT().Get(mock.Anything, projectID, bob.ID).Return(nil, nil).Once()
+	memberRepo.EXPECT().CountByProject(mock.Anything, projectID, entity.ProjectRoleOwner).Return(0, nil).Once()
+	_, err = uc.SetMember(ctx, projectID, "bob", entity.ProjectRoleViewer)
+	assert.ErrorIs(t, err, ErrProjectOwnerRequired, "the first member must be an owner")
+
+	memberRepo.EXPECT().Get(mock.Anything, projectID, alice.ID).Return(nil, nil).Once()
+	memberRepo.EXPECT().Save(mock.Anything, &entity.ProjectMember{ProjectID: projectID, UserID: alice.ID, Role: entity.ProjectRoleOwner}).Return(nil).Once()
+	member, err := uc.SetMember(ctx, projectID, " alice ", entity.ProjectRoleOwner)
+	require.NoError(t, err)
+	assert.Same(t, alice, member.User)
+
+	aliceOwner := &entity.ProjectMember{ProjectID: projectID, UserID: alice.ID, Role: entity.ProjectRoleOwner}
+	memberRepo.EXPECT().Get(mock.Anything, projectID, alice.ID).Return(aliceOwner, nil)
+	memberRepo.EXPECT().CountByProject(mock.Anything, projectID, entity.ProjectRoleOwner).Return(1, nil)
+	_, err = uc.SetMember(ctx, projectID, "alice", entity.ProjectRoleMaintainer)
+	assert.ErrorIs(t, err, ErrProjectOwnerRequired, "the last owner is not demoted")
+
+	assert.ErrorIs(t, uc.RemoveMember(ctx, projectID, "alice"), ErrProjectOwnerRequired, "the last owner does not leave")
+
+	memberRepo.EXPECT().Get(mock.Anything, projectID, bob.ID).Return(&entity.ProjectMember{ProjectID: projectID, UserID: bob.ID, Role: entity.ProjectRoleViewer}, nil).Once()
+	memberRepo.EXPECT().Delete(mock.Anything, projectID, bob.ID).Return(nil).Once()
+	assert.NoError(t, uc.RemoveMember(ctx, projectID, "bob"))
+}
+
+func TestTaskUsecase_ProjectRoles(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusPLANREVIEWING}
+	viewer := uuid.New()
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	memberRepo.EXPECT().Get(mock.Anything, task.ProjectID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	u := &taskUsecase{projectAccess: projectAccess{memberRepo: memberRepo}, taskRepo: taskRepo}
+	ctx := repository.WithUserID(context.Background(), viewer)
+
+	got, err := u.GetByID(ctx, task.ID)
+	require.NoError(t, err, "viewers read tasks")
+	assert.Same(t, task, got)
+
+	assert.ErrorIs(t, u.Delete(ctx, task.ID), ErrProjectForbidden)
+	_, err = u.UpdateStatus(ctx, task.ID, entity.TaskStatusTODO)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = u.ApprovePlan(ctx, task.ID, "claude-code", nil)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = u.RejectPlan(ctx, task.ID, nil, nil)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = u.Create(ctx, CreateTaskRequest{ProjectID: task.ProjectID, Title: "New"})
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	assert.ErrorIs(t, u.BulkAssign(ctx, []uuid.UUID{task.ID}, "bob"), ErrProjectForbidden)
+	assert.ErrorIs(t, u.CancelScheduledJob(ctx, task.ID, "job-1"), ErrProjectForbidden)
+	assert.ErrorIs(t, u.RestoreWorktreeSnapshot(ctx, task.ID, "snapshot-1"), ErrProjectForbidden)
+	_, err = u.RecreateWorktree(ctx, task.ID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+
+	missing := uuid.New()
+	taskRepo.EXPECT().GetByID(mock.Anything, missing).Return(nil, errors.New("record not found"))
+	_, err = u.GetByID(ctx, missing)
+	assert.ErrorIs(t, err, ErrTaskNotFound, "tasks that cannot be loaded are not served")
+}
+
+func TestProjectUsecase_ProjectRoles(t *testing.T) {
+	projectID := uuid.New()
+	viewer, outsider := uuid.New(), uuid.New()
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	memberRepo.EXPECT().Get(mock.Anything, projectID, outsider).Return(nil, nil)
+	uc := NewProjectUsecase(repository.NewProjectRepositoryMock(t), nil, nil, nil, nil, nil, memberRepo)
+	viewerCtx := repository.WithUserID(context.Background(), viewer)
+	outsiderCtx := repository.WithUserID(context.Background(), outsider)
+
+	assert.ErrorIs(t, uc.ReinitGitRepository(viewerCtx, projectID), ErrProjectForbidden)
+	assert.ErrorIs(t, uc.UpdateRepositoryURL(viewerCtx, projectID, "https://github.com/acme/billing.git"), ErrProjectForbidden)
+	_, err := uc.GetGitStatus(outsiderCtx, projectID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = uc.ListBranches(outsiderCtx, projectID, true)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = uc.GetVerificationPipeline(outsiderCtx, projectID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = uc.GetWorkflowPreset(outsiderCtx, projectID, entity.WorkflowTypeRefactor)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+}
+
+func TestProjectRoles_ImportsAndReports(t *testing.T) {
+	project := &entity.Project{ID: uuid.New()}
+	task := &entity.Task{ID: uuid.New(), ProjectID: project.ID}
+	notes := &entity.ReleaseNotes{ID: uuid.New(), ProjectID: project.ID}
+	viewer, outsider := uuid.New(), uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	releaseNotesRepo := repository.NewReleaseNotesRepositoryMock(t)
+	releaseNotesRepo.EXPECT().GetByID(mock.Anything, notes.ID).Return(notes, nil)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	memberRepo.EXPECT().Get(mock.Anything, project.ID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	memberRepo.EXPECT().Get(mock.Anything, project.ID, outsider).Return(nil, nil)
+	viewerCtx := repository.WithUserID(context.Background(), viewer)
+	outsiderCtx := repository.WithUserID(context.Background(), outsider)
+
+	_, err := NewGitHubProjectUsecase(taskRepo, projectRepo, memberRepo, &fakeGitHubProjectsClient{}).
+		Import(viewerCtx, project.ID, ImportGitHubProjectRequest{Owner: "acme", Number: 1})
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+
+	releaseNotes := NewReleaseNotesUsecase(releaseNotesRepo, projectRepo, taskRepo, nil, memberRepo, nil)
+	_, err = releaseNotes.Create(viewerCtx, CreateReleaseNotesRequest{ProjectID: project.ID, From: time.Now().AddDate(0, 0, -7), To: time.Now()})
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = releaseNotes.GetByID(outsiderCtx, notes.ID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = releaseNotes.ListByProjectID(outsiderCtx, project.ID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+
+	_, err = NewCommitUsecase(nil, taskRepo, projectRepo, nil, nil, nil, memberRepo, nil, 0).ListTaskCommits(outsiderCtx, task.ID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+
+	tasks := &taskUsecase{projectAccess: projectAccess{memberRepo: memberRepo}, taskRepo: taskRepo, projectRepo: projectRepo}
+	_, err = tasks.GetCycleTimeAnalytics(outsiderCtx, project.ID, nil, nil)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = tasks.GetWorkload(outsiderCtx, project.ID)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = tasks.GetExecutorAnalytics(outsiderCtx, project.ID, nil, nil)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+
+	projects := NewProjectUsecase(projectRepo, nil, nil, nil, nil, nil, memberRepo)
+	_, _, err = projects.GetActivity(outsiderCtx, project.ID, 20, 0)
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	_, err = projects.GetMonthlySpend(outsiderCtx, project.ID, time.Now())
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+}
+
+func TestEpicUsecase_ProjectRoles(t *testing.T) {
+	epic := &entity.Epic{ID: uuid.New(), ProjectID: uuid.New(), Title: "Billing"}
+	viewer := uuid.New()
+	epicRepo := repository.NewEpicRepositoryMock(t)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	epicRepo.EXPECT().GetByID(mock.Anything, epic.ID).Return(epic, nil)
+	memberRepo.EXPECT().Get(mock.Anything, epic.ProjectID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	uc := NewEpicUsecase(epicRepo, repository.NewProjectRepositoryMock(t), memberRepo)
+	ctx := repository.WithUserID(context.Background(), viewer)
+
+	title := "Payments"
+	_, err := uc.Update(ctx, epic.ID, UpdateEpicRequest{Title: &title})
+	assert.ErrorIs(t, err, ErrProjectForbidden)
+	assert.ErrorIs(t, uc.Delete(ctx, epic.ID), ErrProjectForbidden)
+}
+
+func TestExecutionUsecase_DecryptLogsForMaintainers(t *testing.T) {
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	execution := &entity.Execution{ID: uuid.New(), TaskID: task.ID}
+	viewer, maintainer := uuid.New(), uuid.New()
+	sealer, err := secrets.NewLogSealer(&config.SecretsConfig{EncryptionKey: base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))})
+	require.NoError(t, err)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	executionLogRepo := repository.NewExecutionLogRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	memberRepo := repository.NewProjectMemberRepositoryMock(t)
+	executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	memberRepo.EXPECT().Get(mock.Anything, task.ProjectID, viewer).Return(&entity.ProjectMember{Role: entity.ProjectRoleViewer}, nil)
+	memberRepo.EXPECT().Get(mock.Anything, task.ProjectID, maintainer).Return(&entity.ProjectMember{Role: entity.ProjectRoleMaintainer}, nil)
+	executionLogRepo.EXPECT().GetByExecutionID(mock.Anything, execution.ID).RunAndReturn(func(context.Context, uuid.UUID) ([]*entity.ExecutionLog, error) {
+		log := &entity.ExecutionLog{ExecutionID: execution.ID, Message: "reading billing keys"}
+		return []*entity.ExecutionLog{log}, sealer.Seal(log)
+	})
+	uc := NewExecutionUsecase(executionRepo, executionLogRepo, taskRepo, nil, nil, nil, memberRepo, sealer)
+
+	logs, _, err := uc.GetExecutionLogs(repository.WithUserID(context.Background(), viewer), execution.ID, GetExecutionLogsRequest{Decrypt: true})
+	require.NoError(t, err)
+	assert.True(t, logs[0].Encrypted, "viewers get logs still encrypted")
+
+	logs, _, err = uc.GetExecutionLogs(repository.WithUserID(context.Background(), maintainer), execution.ID, GetExecutionLogsRequest{Decrypt: true})
+	require.NoError(t, err)
+	assert.False(t, logs[0].Encrypted)
+	assert.Equal(t, "reading billing keys", logs[0].Message)
+}
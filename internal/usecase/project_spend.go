@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// MonthlySpend is what a project spent on AI runs in a calendar month. Runs
+// count towards the month they completed in.
+type MonthlySpend struct {
+	ProjectID uuid.UUID
+	// Month is the first instant of the month, UTC
+	Month        time.Time
+	Executions   int64
+	InputTokens  int64
+	OutputTokens int64
+	CostUSD      float64
+	ByExecutor   []*repository.ExecutorSpend
+	// BudgetUSD is 0 and BudgetUsedPercent nil when the project has no
+	// budget
+	BudgetUSD         float64
+	BudgetUsedPercent *float64
+	AlertThresholds   []int
+}
+
+// BudgetAlert is raised the first time a project's monthly AI spend reaches
+// one of its budget alert thresholds. It is the data of
+// project.budget_threshold_reached events.
+type BudgetAlert struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Month     string    `json:"month"`
+	// Threshold is the percentage of the budget that was reached
+	Threshold int     `json:"threshold"`
+	BudgetUSD float64 `json:"budget_usd"`
+	SpentUSD  float64 `json:"spent_usd"`
+	// TaskID and ExecutionID identify the run whose cost reached the
+	// threshold
+	TaskID      uuid.UUID `json:"task_id"`
+	ExecutionID uuid.UUID `json:"execution_id"`
+}
+
+func (u *projectUsecase) GetMonthlySpend(ctx context.Context, projectID uuid.UUID, month time.Time) (*MonthlySpend, error) {
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.monthlySpend(ctx, project, month)
+}
+
+// RecordExecutionUsage stores usage on the execution, which must already be
+// completed so it counts towards this month's spend. A threshold alerts once
+// a month: when the spend goes from below it to at or above it.
+func (u *projectUsecase) RecordExecutionUsage(ctx context.Context, projectID uuid.UUID, execution *entity.Execution, usage entity.ExecutionUsage) ([]BudgetAlert, error) {
+	if err := u.executionRepo.UpdateUsage(ctx, execution.ID, usage); err != nil {
+		return nil, err
+	}
+	if usage.CostUSD <= 0 {
+		return nil, nil
+	}
+
+	project, err := u.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if project.MonthlyBudgetUSD <= 0 {
+		return nil, nil
+	}
+
+	spend, err := u.monthlySpend(ctx, project, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	before := spend.CostUSD - usage.CostUSD
+	var alerts []BudgetAlert
+	for _, threshold := range spend.AlertThresholds {
+		limit := project.MonthlyBudgetUSD * float64(threshold) / 100
+		if before >= limit || spend.CostUSD < limit {
+			continue
+		}
+		alert := BudgetAlert{
+			ProjectID:   project.ID,
+			Month:       spend.Month.Format("2006-01"),
+			Threshold:   threshold,
+			BudgetUSD:   project.MonthlyBudgetUSD,
+			SpentUSD:    spend.CostUSD,
+			TaskID:      execution.TaskID,
+			ExecutionID: execution.ID,
+		}
+		alerts = append(alerts, alert)
+		u.recordBudgetAlert(ctx, alert)
+	}
+	return alerts, nil
+}
+
+func (u *projectUsecase) monthlySpend(ctx context.Context, project *entity.Project, month time.Time) (*MonthlySpend, error) {
+	from := monthStart(month)
+	byExecutor, err := u.executionRepo.GetSpendByAIType(ctx, project.ID, from, from.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	spend := &MonthlySpend{
+		ProjectID:       project.ID,
+		Month:           from,
+		ByExecutor:      byExecutor,
+		BudgetUSD:       project.MonthlyBudgetUSD,
+		AlertThresholds: project.AlertThresholds(),
+	}
+	for _, executor := range byExecutor {
+		spend.Executions += executor.Executions
+		spend.InputTokens += executor.InputTokens
+		spend.OutputTokens += executor.OutputTokens
+		spend.CostUSD += executor.CostUSD
+	}
+	if project.MonthlyBudgetUSD > 0 {
+		used := spend.CostUSD / project.MonthlyBudgetUSD * 100
+		spend.BudgetUsedPercent = &used
+	}
+	return spend, nil
+}
+
+// recordBudgetAlert appends the alert to the automation feed. Like task
+// events it is best-effort.
+func (u *projectUsecase) recordBudgetAlert(ctx context.Context, alert BudgetAlert) {
+	if u.eventRepo == nil {
+		return
+	}
+
+	encoded, err := json.Marshal(alert)
+	if err == nil {
+		taskID := alert.TaskID
+		err = u.eventRepo.Create(ctx, &entity.Event{
+			Type:      entity.EventTypeBudgetThreshold,
+			ProjectID: alert.ProjectID,
+			TaskID:    &taskID,
+			Data:      string(encoded),
+		})
+	}
+	if err != nil {
+		slog.Warn("Failed to record budget alert event",
+			"project_id", alert.ProjectID,
+			"threshold", alert.Threshold,
+			"error", err,
+		)
+	}
+}
+
+// monthStart returns the first instant of the calendar month, UTC, holding t
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// formatBudgetAlertThresholds validates budget alert thresholds and joins
+// them for storage, sorted and without duplicates
+func formatBudgetAlertThresholds(thresholds []int) (string, error) {
+	sorted := append([]int(nil), thresholds...)
+	sort.Ints(sorted)
+
+	parts := make([]string, 0, len(sorted))
+	for i, threshold := range sorted {
+		if threshold < 1 || threshold > entity.MaxBudgetAlertThreshold {
+			return "", ErrBudgetAlertThresholdsInvalid
+		}
+		if i > 0 && threshold == sorted[i-1] {
+			continue
+		}
+		parts = append(parts, strconv.Itoa(threshold))
+	}
+	return strings.Join(parts, ","), nil
+}
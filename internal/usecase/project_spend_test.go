@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMonthlySpend(t *testing.T) {
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	uc := &projectUsecase{projectRepo: projectRepo, executionRepo: executionRepo}
+	project := &entity.Project{ID: uuid.New(), MonthlyBudgetUSD: 200}
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	projectRepo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+	executionRepo.EXPECT().GetSpendByAIType(mock.Anything, project.ID, from, from.AddDate(0, 1, 0)).Return([]*repository.ExecutorSpend{
+		{AIType: "claude-code", Executions: 3, InputTokens: 9000, OutputTokens: 700, CostUSD: 60},
+		{AIType: "cursor-agent", Executions: 2, InputTokens: 1000, OutputTokens: 300, CostUSD: 20},
+	}, nil)
+
+	spend, err := uc.GetMonthlySpend(context.Background(), project.ID, time.Date(2024, 3, 17, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, from, spend.Month)
+	assert.Equal(t, int64(5), spend.Executions)
+	assert.Equal(t, int64(10000), spend.InputTokens)
+	assert.Equal(t, 80.0, spend.CostUSD)
+	require.NotNil(t, spend.BudgetUsedPercent)
+	assert.Equal(t, 40.0, *spend.BudgetUsedPercent)
+	assert.Equal(t, entity.DefaultBudgetAlertThresholds, spend.AlertThresholds)
+}
+
+func TestRecordExecutionUsage(t *testing.T) {
+	projectID := uuid.New()
+	execution := &entity.Execution{ID: uuid.New(), TaskID: uuid.New()}
+
+	// setup returns a usecase whose project spent spent this month, the
+	// execution's cost included
+	setup := func(t *testing.T, project *entity.Project, spent float64) (*projectUsecase, *repository.EventRepositoryMock) {
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		eventRepo := repository.NewEventRepositoryMock(t)
+		executionRepo.EXPECT().UpdateUsage(mock.Anything, execution.ID, mock.Anything).Return(nil)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(project, nil).Maybe()
+		executionRepo.EXPECT().GetSpendByAIType(mock.Anything, projectID, mock.Anything, mock.Anything).
+			Return([]*repository.ExecutorSpend{{AIType: "claude-code", CostUSD: spent}}, nil).Maybe()
+		return &projectUsecase{projectRepo: projectRepo, executionRepo: executionRepo, eventRepo: eventRepo}, eventRepo
+	}
+
+	t.Run("crossing thresholds", func(t *testing.T) {
+		project := &entity.Project{ID: projectID, MonthlyBudgetUSD: 100, BudgetAlertThresholds: "50,80,100"}
+		uc, eventRepo := setup(t, project, 85)
+		var recorded []BudgetAlert
+		eventRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(event *entity.Event) bool {
+			return event.Type == entity.EventTypeBudgetThreshold && *event.TaskID == execution.TaskID
+		})).Run(func(_ context.Context, event *entity.Event) {
+			var alert BudgetAlert
+			require.NoError(t, json.Unmarshal([]byte(event.Data), &alert))
+			recorded = append(recorded, alert)
+		}).Return(nil).Times(2)
+
+		// From 40 to 85 passes 50 and 80 but not 100
+		alerts, err := uc.RecordExecutionUsage(context.Background(), projectID, execution, entity.ExecutionUsage{CostUSD: 45})
+		require.NoError(t, err)
+		require.Len(t, alerts, 2)
+		assert.Equal(t, 50, alerts[0].Threshold)
+		assert.Equal(t, 80, alerts[1].Threshold)
+		assert.Equal(t, 85.0, alerts[1].SpentUSD)
+		assert.Equal(t, execution.ID, alerts[1].ExecutionID)
+		assert.Equal(t, alerts, recorded)
+	})
+
+	t.Run("already past", func(t *testing.T) {
+		project := &entity.Project{ID: projectID, MonthlyBudgetUSD: 100}
+		uc, _ := setup(t, project, 95)
+
+		alerts, err := uc.RecordExecutionUsage(context.Background(), projectID, execution, entity.ExecutionUsage{CostUSD: 5})
+		require.NoError(t, err)
+		assert.Empty(t, alerts, "80% was reached by an earlier run")
+	})
+
+	t.Run("no budget", func(t *testing.T) {
+		uc, _ := setup(t, &entity.Project{ID: projectID}, 500)
+
+		alerts, err := uc.RecordExecutionUsage(context.Background(), projectID, execution, entity.ExecutionUsage{CostUSD: 500})
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+	})
+}
+
+func TestFormatBudgetAlertThresholds(t *testing.T) {
+	thresholds, err := formatBudgetAlertThresholds([]int{100, 50, 80, 50})
+	require.NoError(t, err)
+	assert.Equal(t, "50,80,100", thresholds)
+	assert.Equal(t, []int{50, 80, 100}, (&entity.Project{BudgetAlertThresholds: thresholds}).AlertThresholds())
+
+	thresholds, err = formatBudgetAlertThresholds([]int{})
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	_, err = formatBudgetAlertThresholds([]int{80, 0})
+	assert.ErrorIs(t, err, ErrBudgetAlertThresholdsInvalid)
+	_, err = formatBudgetAlertThresholds([]int{entity.MaxBudgetAlertThreshold + 1})
+	assert.ErrorIs(t, err, ErrBudgetAlertThresholdsInvalid)
+}
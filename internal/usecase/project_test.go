@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateVerificationPipeline(t *testing.T) {
+	project := &entity.Project{TestCommand: "go test ./..."}
+	step := func(s entity.VerificationStep, command string) entity.VerificationPipelineStep {
+		return entity.VerificationPipelineStep{Step: s, Command: command}
+	}
+
+	tests := []struct {
+		name  string
+		steps []entity.VerificationPipelineStep
+		want  error
+	}{
+		{"valid", []entity.VerificationPipelineStep{step("setup", "make deps"), step("test", ""), step("setup", "make fixtures"), step("review", "")}, nil},
+		{"empty", nil, ErrVerificationPipelineEmpty},
+		{"unknown step", []entity.VerificationPipelineStep{step("deploy", "make deploy")}, ErrVerificationStepInvalid},
+		{"duplicate step", []entity.VerificationPipelineStep{step("test", ""), step("test", "go test -race ./...")}, ErrVerificationStepDuplicate},
+		{"setup without command", []entity.VerificationPipelineStep{step("setup", "")}, ErrVerificationStepCommandRequired},
+		{"build without any command", []entity.VerificationPipelineStep{step("build", "")}, ErrVerificationStepCommandRequired},
+		{"build with its own command", []entity.VerificationPipelineStep{step("build", "go build ./...")}, nil},
+		{"scan without scanner", []entity.VerificationPipelineStep{step("security_scan", "")}, ErrVerificationStepScannerRequired},
+		{"review with command", []entity.VerificationPipelineStep{step("review", "claude")}, ErrVerificationStepCommandNotAllowed},
+		{"test changes with command", []entity.VerificationPipelineStep{step("test_changes", "git diff")}, ErrVerificationStepCommandNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, validateVerificationPipeline(project, tt.steps), tt.want)
+		})
+	}
+}
+
+func TestFormatCommands(t *testing.T) {
+	commands, err := formatCommands([]string{" npm publish ", "curl", "npm publish"})
+	require.NoError(t, err)
+	assert.Equal(t, "npm publish\ncurl", commands)
+	assert.Equal(t, []string{"npm publish", "curl"}, (&entity.Project{DeniedCommands: commands}).CommandPolicy().Denied)
+
+	commands, err = formatCommands([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, commands)
+
+	for _, invalid := range []string{"", "rm -rf /\nnpm publish", "echo $(cat .env)"} {
+		_, err = formatCommands([]string{invalid})
+		assert.ErrorIs(t, err, ErrCommandPolicyInvalid, invalid)
+	}
+}
+
+func TestFormatContextFiles(t *testing.T) {
+	files, err := formatContextFiles([]string{" docs/ARCHITECTURE.md ", "./CONTRIBUTING.md", "docs/ARCHITECTURE.md"})
+	require.NoError(t, err)
+	assert.Equal(t, "docs/ARCHITECTURE.md\nCONTRIBUTING.md", files)
+	assert.Equal(t, []string{"docs/ARCHITECTURE.md", "CONTRIBUTING.md"}, (&entity.ProjectSettings{ContextFiles: files}).ContextFileList())
+
+	for _, invalid := range []string{"", "/etc/passwd", "../secrets.md", "docs/../../secrets.md", ".", `docs\guide.md`} {
+		_, err = formatContextFiles([]string{invalid})
+		assert.ErrorIs(t, err, ErrContextFilesInvalid, invalid)
+	}
+
+	tooMany := make([]string, maxContextFiles+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("docs/%d.md", i)
+	}
+	_, err = formatContextFiles(tooMany)
+	assert.ErrorIs(t, err, ErrContextFilesInvalid)
+}
+
+func TestProjectUsecase_UpdateSettingsPromptTemplate(t *testing.T) {
+	repo := repository.NewProjectRepositoryMock(t)
+	uc := NewProjectUsecase(repo, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	repo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	repo.EXPECT().UpdateSettings(mock.Anything, mock.Anything).Return(nil).Once()
+
+	settings, err := uc.UpdateSettings(ctx, projectID, &entity.ProjectSettings{
+		PromptPrefix: "  Follow docs/CONVENTIONS.md.\n",
+		ContextFiles: "docs/CONVENTIONS.md\n\n ./docs/CONVENTIONS.md",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Follow docs/CONVENTIONS.md.", settings.PromptPrefix)
+	assert.Equal(t, "docs/CONVENTIONS.md", settings.ContextFiles)
+
+	_, err = uc.UpdateSettings(ctx, projectID, &entity.ProjectSettings{PromptSuffix: strings.Repeat("a", maxPromptTemplateLength+1)})
+	assert.ErrorIs(t, err, ErrPromptTemplateTooLong)
+}
+
+func TestProjectUsecase_MaxConcurrentExecutions(t *testing.T) {
+	repo := repository.NewProjectRepositoryMock(t)
+	uc := NewProjectUsecase(repo, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	_, err := uc.Create(ctx, CreateProjectRequest{Name: "limited", MaxConcurrentExecutions: -1})
+	assert.ErrorIs(t, err, ErrMaxConcurrentExecutionsInvalid)
+
+	project := &entity.Project{ID: uuid.New(), Name: "limited", MaxConcurrentExecutions: 2}
+	repo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+	negative := -1
+	_, err = uc.Update(ctx, project.ID, UpdateProjectRequest{MaxConcurrentExecutions: &negative})
+	assert.ErrorIs(t, err, ErrMaxConcurrentExecutionsInvalid)
+	assert.Equal(t, 2, project.MaxConcurrentExecutions, "an invalid limit is not applied")
+}
+
+func TestProjectUsecase_VerificationPipeline(t *testing.T) {
+	repo := repository.NewProjectRepositoryMock(t)
+	uc := NewProjectUsecase(repo, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	project := &entity.Project{ID: uuid.New(), LintCommand: "make lint", TestCommand: "make test"}
+	repo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+	repo.EXPECT().GetVerificationPipeline(mock.Anything, project.ID).Return(nil, nil).Once()
+
+	pipeline, err := uc.GetVerificationPipeline(ctx, project.ID)
+	require.NoError(t, err)
+	assert.True(t, pipeline.IsDefault())
+	assert.Equal(t, []entity.VerificationPipelineStep{{Step: entity.VerificationStepLint}, {Step: entity.VerificationStepTest}}, pipeline.Steps)
+
+	repo.EXPECT().SaveVerificationPipeline(mock.Anything, mock.Anything).Return(nil).Once()
+	pipeline, err = uc.SaveVerificationPipeline(ctx, project.ID, []entity.VerificationPipelineStep{
+		{Step: entity.VerificationStepSetup, Command: "  npm ci  "},
+		{Step: entity.VerificationStepTest},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, pipeline.ProjectID)
+	assert.Equal(t, "npm ci", pipeline.Steps[0].Command)
+
+	_, err = uc.SaveVerificationPipeline(ctx, project.ID, []entity.VerificationPipelineStep{{Step: entity.VerificationStepBuild}})
+	assert.ErrorIs(t, err, ErrVerificationStepCommandRequired, "invalid pipelines are not saved")
+}
+
+func TestProjectUsecase_WorkflowPresets(t *testing.T) {
+	repo := repository.NewProjectRepositoryMock(t)
+	uc := NewProjectUsecase(repo, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	project := &entity.Project{ID: uuid.New(), Name: "payments"}
+	saved := &entity.WorkflowPreset{ID: uuid.New(), ProjectID: project.ID, Workflow: entity.WorkflowTypeRefactor, PlanningInstructions: "Keep the API"}
+	repo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+	repo.EXPECT().GetWorkflowPresets(mock.Anything, project.ID).Return([]*entity.WorkflowPreset{saved}, nil)
+
+	presets, err := uc.GetWorkflowPresets(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, presets, 3, "every workflow type has a preset")
+	assert.Equal(t, entity.WorkflowTypeFeature, presets[0].Workflow)
+	assert.True(t, presets[0].IsDefault())
+	assert.True(t, presets[1].RequireTestChanges, "bugfixes require test changes by default")
+	assert.Same(t, saved, presets[2])
+
+	preset, err := uc.GetWorkflowPreset(ctx, project.ID, entity.WorkflowTypeRefactor)
+	require.NoError(t, err)
+	assert.Equal(t, "Keep the API", preset.Instructions(true))
+	assert.Empty(t, preset.Instructions(false))
+
+	repo.EXPECT().SaveWorkflowPreset(mock.Anything, mock.Anything).Return(nil).Once()
+	preset, err = uc.SaveWorkflowPreset(ctx, &entity.WorkflowPreset{ProjectID: project.ID, Workflow: entity.WorkflowTypeBugfix, ImplementationInstructions: "  Add a regression test \n"})
+	require.NoError(t, err)
+	assert.Equal(t, "Add a regression test", preset.ImplementationInstructions)
+
+	_, err = uc.SaveWorkflowPreset(ctx, &entity.WorkflowPreset{ProjectID: project.ID, Workflow: "hotfix"})
+	assert.ErrorIs(t, err, ErrWorkflowTypeInvalid)
+
+	repo.EXPECT().DeleteWorkflowPreset(mock.Anything, project.ID, entity.WorkflowTypeRefactor).Return(nil).Once()
+	preset, err = uc.ResetWorkflowPreset(ctx, project.ID, entity.WorkflowTypeRefactor)
+	require.NoError(t, err)
+	assert.True(t, preset.IsDefault())
+}
+
+func TestProjectUsecase_GitHubToken(t *testing.T) {
+	repo := repository.NewProjectRepositoryMock(t)
+	store := fakeSecretStore{}
+	uc := NewProjectUsecase(repo, nil, nil, nil, nil, store, nil)
+	ctx := context.Background()
+
+	project := &entity.Project{ID: uuid.New(), Name: "payments"}
+	repo.EXPECT().GetByID(mock.Anything, project.ID).Return(project, nil)
+
+	assert.ErrorIs(t, uc.SetGitHubToken(ctx, project.ID, "  "), ErrGitHubTokenRequired)
+
+	require.NoError(t, uc.SetGitHubToken(ctx, project.ID, " ghp_project \n"))
+	token, err := store.Get(ctx, project.ID, entity.GitHubTokenSecret)
+	require.NoError(t, err)
+	assert.Equal(t, "ghp_project", token)
+
+	require.NoError(t, uc.DeleteGitHubToken(ctx, project.ID))
+	assert.Empty(t, store)
+
+	t.Run("only owners may remove it", func(t *testing.T) {
+		maintainer := uuid.New()
+		memberRepo := repository.NewProjectMemberRepositoryMock(t)
+		memberRepo.EXPECT().Get(mock.Anything, project.ID, maintainer).Return(&entity.ProjectMember{Role: entity.ProjectRoleMaintainer}, nil)
+		store := fakeSecretStore{project.ID.String() + "/" + entity.GitHubTokenSecret: "ghp_project"}
+		uc := NewProjectUsecase(repo, nil, nil, nil, nil, store, memberRepo)
+
+		assert.ErrorIs(t, uc.DeleteGitHubToken(repository.WithUserID(ctx, maintainer), project.ID), ErrProjectForbidden)
+		assert.Len(t, store, 1)
+	})
+}
@@ -6,6 +6,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
 	"github.com/google/uuid"
@@ -244,6 +245,117 @@ func (_c *ProjectUsecaseMock_Delete_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// DeleteGitHubToken provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) DeleteGitHubToken(ctx context.Context, projectID uuid.UUID) error {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGitHubToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectUsecaseMock_DeleteGitHubToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGitHubToken'
+type ProjectUsecaseMock_DeleteGitHubToken_Call struct {
+	*mock.Call
+}
+
+// DeleteGitHubToken is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectUsecaseMock_Expecter) DeleteGitHubToken(ctx interface{}, projectID interface{}) *ProjectUsecaseMock_DeleteGitHubToken_Call {
+	return &ProjectUsecaseMock_DeleteGitHubToken_Call{Call: _e.mock.On("DeleteGitHubToken", ctx, projectID)}
+}
+
+func (_c *ProjectUsecaseMock_DeleteGitHubToken_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectUsecaseMock_DeleteGitHubToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_DeleteGitHubToken_Call) Return(err error) *ProjectUsecaseMock_DeleteGitHubToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_DeleteGitHubToken_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) error) *ProjectUsecaseMock_DeleteGitHubToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivity provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) GetActivity(ctx context.Context, projectID uuid.UUID, limit int, offset int) ([]*entity.ProjectActivity, int, error) {
+	ret := _mock.Called(ctx, projectID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivity")
+	}
+
+	var r0 []*entity.ProjectActivity
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) ([]*entity.ProjectActivity, int, error)); ok {
+		return returnFunc(ctx, projectID, limit, offset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, int) []*entity.ProjectActivity); ok {
+		r0 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ProjectActivity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, int) int); ok {
+		r1 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, int, int) error); ok {
+		r2 = returnFunc(ctx, projectID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// ProjectUsecaseMock_GetActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivity'
+type ProjectUsecaseMock_GetActivity_Call struct {
+	*mock.Call
+}
+
+// GetActivity is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - limit
+//   - offset
+func (_e *ProjectUsecaseMock_Expecter) GetActivity(ctx interface{}, projectID interface{}, limit interface{}, offset interface{}) *ProjectUsecaseMock_GetActivity_Call {
+	return &ProjectUsecaseMock_GetActivity_Call{Call: _e.mock.On("GetActivity", ctx, projectID, limit, offset)}
+}
+
+func (_c *ProjectUsecaseMock_GetActivity_Call) Run(run func(ctx context.Context, projectID uuid.UUID, limit int, offset int)) *ProjectUsecaseMock_GetActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetActivity_Call) Return(projectActivitys []*entity.ProjectActivity, n int, err error) *ProjectUsecaseMock_GetActivity_Call {
+	_c.Call.Return(projectActivitys, n, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetActivity_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, limit int, offset int) ([]*entity.ProjectActivity, int, error)) *ProjectUsecaseMock_GetActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) GetAll(ctx context.Context, params GetProjectsParams) (*GetProjectsResult, error) {
 	ret := _mock.Called(ctx, params)
@@ -415,6 +527,64 @@ func (_c *ProjectUsecaseMock_GetGitStatus_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetMonthlySpend provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) GetMonthlySpend(ctx context.Context, projectID uuid.UUID, month time.Time) (*MonthlySpend, error) {
+	ret := _mock.Called(ctx, projectID, month)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMonthlySpend")
+	}
+
+	var r0 *MonthlySpend
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) (*MonthlySpend, error)); ok {
+		return returnFunc(ctx, projectID, month)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *MonthlySpend); ok {
+		r0 = returnFunc(ctx, projectID, month)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MonthlySpend)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, projectID, month)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_GetMonthlySpend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMonthlySpend'
+type ProjectUsecaseMock_GetMonthlySpend_Call struct {
+	*mock.Call
+}
+
+// GetMonthlySpend is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - month
+func (_e *ProjectUsecaseMock_Expecter) GetMonthlySpend(ctx interface{}, projectID interface{}, month interface{}) *ProjectUsecaseMock_GetMonthlySpend_Call {
+	return &ProjectUsecaseMock_GetMonthlySpend_Call{Call: _e.mock.On("GetMonthlySpend", ctx, projectID, month)}
+}
+
+func (_c *ProjectUsecaseMock_GetMonthlySpend_Call) Run(run func(ctx context.Context, projectID uuid.UUID, month time.Time)) *ProjectUsecaseMock_GetMonthlySpend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetMonthlySpend_Call) Return(monthlySpend *MonthlySpend, err error) *ProjectUsecaseMock_GetMonthlySpend_Call {
+	_c.Call.Return(monthlySpend, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetMonthlySpend_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, month time.Time) (*MonthlySpend, error)) *ProjectUsecaseMock_GetMonthlySpend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSettings provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) GetSettings(ctx context.Context, projectID uuid.UUID) (*entity.ProjectSettings, error) {
 	ret := _mock.Called(ctx, projectID)
@@ -529,6 +699,63 @@ func (_c *ProjectUsecaseMock_GetStatistics_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// GetVerificationPipeline provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) GetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationPipeline")
+	}
+
+	var r0 *entity.VerificationPipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.VerificationPipeline, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.VerificationPipeline); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.VerificationPipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_GetVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationPipeline'
+type ProjectUsecaseMock_GetVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// GetVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectUsecaseMock_Expecter) GetVerificationPipeline(ctx interface{}, projectID interface{}) *ProjectUsecaseMock_GetVerificationPipeline_Call {
+	return &ProjectUsecaseMock_GetVerificationPipeline_Call{Call: _e.mock.On("GetVerificationPipeline", ctx, projectID)}
+}
+
+func (_c *ProjectUsecaseMock_GetVerificationPipeline_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectUsecaseMock_GetVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetVerificationPipeline_Call) Return(verificationPipeline *entity.VerificationPipeline, err error) *ProjectUsecaseMock_GetVerificationPipeline_Call {
+	_c.Call.Return(verificationPipeline, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)) *ProjectUsecaseMock_GetVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithTasks provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) GetWithTasks(ctx context.Context, id uuid.UUID) (*entity.Project, error) {
 	ret := _mock.Called(ctx, id)
@@ -586,6 +813,176 @@ func (_c *ProjectUsecaseMock_GetWithTasks_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// GetWorkflowPreset provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) GetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error) {
+	ret := _mock.Called(ctx, projectID, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowPreset")
+	}
+
+	var r0 *entity.WorkflowPreset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WorkflowType) (*entity.WorkflowPreset, error)); ok {
+		return returnFunc(ctx, projectID, workflow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WorkflowType) *entity.WorkflowPreset); ok {
+		r0 = returnFunc(ctx, projectID, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WorkflowPreset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.WorkflowType) error); ok {
+		r1 = returnFunc(ctx, projectID, workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_GetWorkflowPreset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowPreset'
+type ProjectUsecaseMock_GetWorkflowPreset_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowPreset is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - workflow
+func (_e *ProjectUsecaseMock_Expecter) GetWorkflowPreset(ctx interface{}, projectID interface{}, workflow interface{}) *ProjectUsecaseMock_GetWorkflowPreset_Call {
+	return &ProjectUsecaseMock_GetWorkflowPreset_Call{Call: _e.mock.On("GetWorkflowPreset", ctx, projectID, workflow)}
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPreset_Call) Run(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType)) *ProjectUsecaseMock_GetWorkflowPreset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.WorkflowType))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPreset_Call) Return(preset *entity.WorkflowPreset, err error) *ProjectUsecaseMock_GetWorkflowPreset_Call {
+	_c.Call.Return(preset, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPreset_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error)) *ProjectUsecaseMock_GetWorkflowPreset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkflowPresets provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) GetWorkflowPresets(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowPresets")
+	}
+
+	var r0 []*entity.WorkflowPreset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.WorkflowPreset, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.WorkflowPreset); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.WorkflowPreset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_GetWorkflowPresets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowPresets'
+type ProjectUsecaseMock_GetWorkflowPresets_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowPresets is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectUsecaseMock_Expecter) GetWorkflowPresets(ctx interface{}, projectID interface{}) *ProjectUsecaseMock_GetWorkflowPresets_Call {
+	return &ProjectUsecaseMock_GetWorkflowPresets_Call{Call: _e.mock.On("GetWorkflowPresets", ctx, projectID)}
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPresets_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectUsecaseMock_GetWorkflowPresets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPresets_Call) Return(presets []*entity.WorkflowPreset, err error) *ProjectUsecaseMock_GetWorkflowPresets_Call {
+	_c.Call.Return(presets, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_GetWorkflowPresets_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]*entity.WorkflowPreset, error)) *ProjectUsecaseMock_GetWorkflowPresets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasGitHubToken provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) HasGitHubToken(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasGitHubToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_HasGitHubToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasGitHubToken'
+type ProjectUsecaseMock_HasGitHubToken_Call struct {
+	*mock.Call
+}
+
+// HasGitHubToken is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectUsecaseMock_Expecter) HasGitHubToken(ctx interface{}, projectID interface{}) *ProjectUsecaseMock_HasGitHubToken_Call {
+	return &ProjectUsecaseMock_HasGitHubToken_Call{Call: _e.mock.On("HasGitHubToken", ctx, projectID)}
+}
+
+func (_c *ProjectUsecaseMock_HasGitHubToken_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectUsecaseMock_HasGitHubToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_HasGitHubToken_Call) Return(b bool, err error) *ProjectUsecaseMock_HasGitHubToken_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_HasGitHubToken_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) (bool, error)) *ProjectUsecaseMock_HasGitHubToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListBranches provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) ListBranches(ctx context.Context, projectID uuid.UUID, includeRemote bool) ([]GitBranch, error) {
 	ret := _mock.Called(ctx, projectID, includeRemote)
@@ -644,6 +1041,65 @@ func (_c *ProjectUsecaseMock_ListBranches_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// RecordExecutionUsage provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) RecordExecutionUsage(ctx context.Context, projectID uuid.UUID, execution *entity.Execution, usage entity.ExecutionUsage) ([]BudgetAlert, error) {
+	ret := _mock.Called(ctx, projectID, execution, usage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordExecutionUsage")
+	}
+
+	var r0 []BudgetAlert
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Execution, entity.ExecutionUsage) ([]BudgetAlert, error)); ok {
+		return returnFunc(ctx, projectID, execution, usage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *entity.Execution, entity.ExecutionUsage) []BudgetAlert); ok {
+		r0 = returnFunc(ctx, projectID, execution, usage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]BudgetAlert)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *entity.Execution, entity.ExecutionUsage) error); ok {
+		r1 = returnFunc(ctx, projectID, execution, usage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_RecordExecutionUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordExecutionUsage'
+type ProjectUsecaseMock_RecordExecutionUsage_Call struct {
+	*mock.Call
+}
+
+// RecordExecutionUsage is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - execution
+//   - usage
+func (_e *ProjectUsecaseMock_Expecter) RecordExecutionUsage(ctx interface{}, projectID interface{}, execution interface{}, usage interface{}) *ProjectUsecaseMock_RecordExecutionUsage_Call {
+	return &ProjectUsecaseMock_RecordExecutionUsage_Call{Call: _e.mock.On("RecordExecutionUsage", ctx, projectID, execution, usage)}
+}
+
+func (_c *ProjectUsecaseMock_RecordExecutionUsage_Call) Run(run func(ctx context.Context, projectID uuid.UUID, execution *entity.Execution, usage entity.ExecutionUsage)) *ProjectUsecaseMock_RecordExecutionUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*entity.Execution), args[3].(entity.ExecutionUsage))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_RecordExecutionUsage_Call) Return(budgetAlerts []BudgetAlert, err error) *ProjectUsecaseMock_RecordExecutionUsage_Call {
+	_c.Call.Return(budgetAlerts, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_RecordExecutionUsage_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, execution *entity.Execution, usage entity.ExecutionUsage) ([]BudgetAlert, error)) *ProjectUsecaseMock_RecordExecutionUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReinitGitRepository provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) ReinitGitRepository(ctx context.Context, projectID uuid.UUID) error {
 	ret := _mock.Called(ctx, projectID)
@@ -690,6 +1146,121 @@ func (_c *ProjectUsecaseMock_ReinitGitRepository_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// ResetVerificationPipeline provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) ResetVerificationPipeline(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetVerificationPipeline")
+	}
+
+	var r0 *entity.VerificationPipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.VerificationPipeline, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.VerificationPipeline); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.VerificationPipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_ResetVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetVerificationPipeline'
+type ProjectUsecaseMock_ResetVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// ResetVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *ProjectUsecaseMock_Expecter) ResetVerificationPipeline(ctx interface{}, projectID interface{}) *ProjectUsecaseMock_ResetVerificationPipeline_Call {
+	return &ProjectUsecaseMock_ResetVerificationPipeline_Call{Call: _e.mock.On("ResetVerificationPipeline", ctx, projectID)}
+}
+
+func (_c *ProjectUsecaseMock_ResetVerificationPipeline_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *ProjectUsecaseMock_ResetVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_ResetVerificationPipeline_Call) Return(verificationPipeline *entity.VerificationPipeline, err error) *ProjectUsecaseMock_ResetVerificationPipeline_Call {
+	_c.Call.Return(verificationPipeline, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_ResetVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) (*entity.VerificationPipeline, error)) *ProjectUsecaseMock_ResetVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetWorkflowPreset provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) ResetWorkflowPreset(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error) {
+	ret := _mock.Called(ctx, projectID, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetWorkflowPreset")
+	}
+
+	var r0 *entity.WorkflowPreset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WorkflowType) (*entity.WorkflowPreset, error)); ok {
+		return returnFunc(ctx, projectID, workflow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.WorkflowType) *entity.WorkflowPreset); ok {
+		r0 = returnFunc(ctx, projectID, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WorkflowPreset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.WorkflowType) error); ok {
+		r1 = returnFunc(ctx, projectID, workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_ResetWorkflowPreset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetWorkflowPreset'
+type ProjectUsecaseMock_ResetWorkflowPreset_Call struct {
+	*mock.Call
+}
+
+// ResetWorkflowPreset is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - workflow
+func (_e *ProjectUsecaseMock_Expecter) ResetWorkflowPreset(ctx interface{}, projectID interface{}, workflow interface{}) *ProjectUsecaseMock_ResetWorkflowPreset_Call {
+	return &ProjectUsecaseMock_ResetWorkflowPreset_Call{Call: _e.mock.On("ResetWorkflowPreset", ctx, projectID, workflow)}
+}
+
+func (_c *ProjectUsecaseMock_ResetWorkflowPreset_Call) Run(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType)) *ProjectUsecaseMock_ResetWorkflowPreset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.WorkflowType))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_ResetWorkflowPreset_Call) Return(preset *entity.WorkflowPreset, err error) *ProjectUsecaseMock_ResetWorkflowPreset_Call {
+	_c.Call.Return(preset, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_ResetWorkflowPreset_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, workflow entity.WorkflowType) (*entity.WorkflowPreset, error)) *ProjectUsecaseMock_ResetWorkflowPreset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Restore provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) Restore(ctx context.Context, id uuid.UUID) error {
 	ret := _mock.Called(ctx, id)
@@ -736,6 +1307,226 @@ func (_c *ProjectUsecaseMock_Restore_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// SaveVerificationPipeline provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) SaveVerificationPipeline(ctx context.Context, projectID uuid.UUID, steps []entity.VerificationPipelineStep) (*entity.VerificationPipeline, error) {
+	ret := _mock.Called(ctx, projectID, steps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVerificationPipeline")
+	}
+
+	var r0 *entity.VerificationPipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []entity.VerificationPipelineStep) (*entity.VerificationPipeline, error)); ok {
+		return returnFunc(ctx, projectID, steps)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []entity.VerificationPipelineStep) *entity.VerificationPipeline); ok {
+		r0 = returnFunc(ctx, projectID, steps)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.VerificationPipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []entity.VerificationPipelineStep) error); ok {
+		r1 = returnFunc(ctx, projectID, steps)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_SaveVerificationPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveVerificationPipeline'
+type ProjectUsecaseMock_SaveVerificationPipeline_Call struct {
+	*mock.Call
+}
+
+// SaveVerificationPipeline is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - steps
+func (_e *ProjectUsecaseMock_Expecter) SaveVerificationPipeline(ctx interface{}, projectID interface{}, steps interface{}) *ProjectUsecaseMock_SaveVerificationPipeline_Call {
+	return &ProjectUsecaseMock_SaveVerificationPipeline_Call{Call: _e.mock.On("SaveVerificationPipeline", ctx, projectID, steps)}
+}
+
+func (_c *ProjectUsecaseMock_SaveVerificationPipeline_Call) Run(run func(ctx context.Context, projectID uuid.UUID, steps []entity.VerificationPipelineStep)) *ProjectUsecaseMock_SaveVerificationPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]entity.VerificationPipelineStep))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SaveVerificationPipeline_Call) Return(verificationPipeline *entity.VerificationPipeline, err error) *ProjectUsecaseMock_SaveVerificationPipeline_Call {
+	_c.Call.Return(verificationPipeline, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SaveVerificationPipeline_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, steps []entity.VerificationPipelineStep) (*entity.VerificationPipeline, error)) *ProjectUsecaseMock_SaveVerificationPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWorkflowPreset provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) SaveWorkflowPreset(ctx context.Context, preset *entity.WorkflowPreset) (*entity.WorkflowPreset, error) {
+	ret := _mock.Called(ctx, preset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWorkflowPreset")
+	}
+
+	var r0 *entity.WorkflowPreset
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.WorkflowPreset) (*entity.WorkflowPreset, error)); ok {
+		return returnFunc(ctx, preset)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.WorkflowPreset) *entity.WorkflowPreset); ok {
+		r0 = returnFunc(ctx, preset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WorkflowPreset)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.WorkflowPreset) error); ok {
+		r1 = returnFunc(ctx, preset)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_SaveWorkflowPreset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWorkflowPreset'
+type ProjectUsecaseMock_SaveWorkflowPreset_Call struct {
+	*mock.Call
+}
+
+// SaveWorkflowPreset is a helper method to define mock.On call
+//   - ctx
+//   - preset
+func (_e *ProjectUsecaseMock_Expecter) SaveWorkflowPreset(ctx interface{}, preset interface{}) *ProjectUsecaseMock_SaveWorkflowPreset_Call {
+	return &ProjectUsecaseMock_SaveWorkflowPreset_Call{Call: _e.mock.On("SaveWorkflowPreset", ctx, preset)}
+}
+
+func (_c *ProjectUsecaseMock_SaveWorkflowPreset_Call) Run(run func(ctx context.Context, preset *entity.WorkflowPreset)) *ProjectUsecaseMock_SaveWorkflowPreset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WorkflowPreset))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SaveWorkflowPreset_Call) Return(saved *entity.WorkflowPreset, err error) *ProjectUsecaseMock_SaveWorkflowPreset_Call {
+	_c.Call.Return(saved, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SaveWorkflowPreset_Call) RunAndReturn(run func(ctx context.Context, preset *entity.WorkflowPreset) (*entity.WorkflowPreset, error)) *ProjectUsecaseMock_SaveWorkflowPreset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) Search(ctx context.Context, query string, limit int) ([]*entity.SearchResult, error) {
+	ret := _mock.Called(ctx, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*entity.SearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*entity.SearchResult, error)); ok {
+		return returnFunc(ctx, query, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*entity.SearchResult); ok {
+		r0 = returnFunc(ctx, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectUsecaseMock_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type ProjectUsecaseMock_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - limit
+func (_e *ProjectUsecaseMock_Expecter) Search(ctx interface{}, query interface{}, limit interface{}) *ProjectUsecaseMock_Search_Call {
+	return &ProjectUsecaseMock_Search_Call{Call: _e.mock.On("Search", ctx, query, limit)}
+}
+
+func (_c *ProjectUsecaseMock_Search_Call) Run(run func(ctx context.Context, query string, limit int)) *ProjectUsecaseMock_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_Search_Call) Return(searchResults []*entity.SearchResult, err error) *ProjectUsecaseMock_Search_Call {
+	_c.Call.Return(searchResults, err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_Search_Call) RunAndReturn(run func(ctx context.Context, query string, limit int) ([]*entity.SearchResult, error)) *ProjectUsecaseMock_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGitHubToken provides a mock function for the type ProjectUsecaseMock
+func (_mock *ProjectUsecaseMock) SetGitHubToken(ctx context.Context, projectID uuid.UUID, token string) error {
+	ret := _mock.Called(ctx, projectID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetGitHubToken")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, projectID, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ProjectUsecaseMock_SetGitHubToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGitHubToken'
+type ProjectUsecaseMock_SetGitHubToken_Call struct {
+	*mock.Call
+}
+
+// SetGitHubToken is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - token
+func (_e *ProjectUsecaseMock_Expecter) SetGitHubToken(ctx interface{}, projectID interface{}, token interface{}) *ProjectUsecaseMock_SetGitHubToken_Call {
+	return &ProjectUsecaseMock_SetGitHubToken_Call{Call: _e.mock.On("SetGitHubToken", ctx, projectID, token)}
+}
+
+func (_c *ProjectUsecaseMock_SetGitHubToken_Call) Run(run func(ctx context.Context, projectID uuid.UUID, token string)) *ProjectUsecaseMock_SetGitHubToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SetGitHubToken_Call) Return(err error) *ProjectUsecaseMock_SetGitHubToken_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ProjectUsecaseMock_SetGitHubToken_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, token string) error) *ProjectUsecaseMock_SetGitHubToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type ProjectUsecaseMock
 func (_mock *ProjectUsecaseMock) Update(ctx context.Context, id uuid.UUID, req UpdateProjectRequest) (*entity.Project, error) {
 	ret := _mock.Called(ctx, id, req)
@@ -0,0 +1,447 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/service/webhook"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookURLInvalid       = errors.New("webhook URL must be an absolute http(s) URL")
+	ErrWebhookURLNotAllowed    = errors.New("webhook URL must not point at a loopback, private or link-local address")
+	ErrWebhookEventInvalid     = errors.New("webhook events must be task_created, status_changed, plan_ready, execution_completed or pr_merged")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+const (
+	webhookSecretBytes = 32
+	// DefaultWebhookDeliveryLimit is how many deliveries of a webhook are
+	// listed unless asked otherwise
+	DefaultWebhookDeliveryLimit = 50
+	// MaxWebhookDeliveryLimit caps how many deliveries are listed at once
+	MaxWebhookDeliveryLimit = 200
+)
+
+// ProjectWebhookUsecase posts the task lifecycle events of projects to
+// their outbound webhooks, the same events the WebSocket service
+// broadcasts to the project's clients. Each event is recorded as a delivery
+// and posted by a job, retried while the receiver fails.
+type ProjectWebhookUsecase interface {
+	ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectWebhook, error)
+	// CreateWebhook adds a webhook to the project and returns it with the
+	// secret its deliveries are signed with, generated unless given
+	CreateWebhook(ctx context.Context, projectID uuid.UUID, req ProjectWebhookRequest) (*entity.ProjectWebhook, string, error)
+	// UpdateWebhook changes the webhook, and its secret when one is given
+	UpdateWebhook(ctx context.Context, id uuid.UUID, req ProjectWebhookRequest) (*entity.ProjectWebhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	// ListDeliveries returns up to limit of the webhook's deliveries, the
+	// latest first
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*entity.ProjectWebhookDelivery, error)
+	// Redeliver posts the payload of a delivery again, as a new delivery
+	Redeliver(ctx context.Context, deliveryID uuid.UUID) (*entity.ProjectWebhookDelivery, error)
+
+	// Publish queues a delivery of event, carrying data, to each active
+	// webhook of the project subscribed to it. Failures are logged, never
+	// failing what happened.
+	Publish(ctx context.Context, projectID uuid.UUID, event entity.WebhookEvent, data interface{})
+	// Deliver posts a delivery and records the outcome. A failed delivery
+	// stays pending, to be retried, until its last attempt.
+	Deliver(ctx context.Context, deliveryID uuid.UUID, lastAttempt bool) error
+}
+
+// ProjectWebhookRequest configures a webhook
+type ProjectWebhookRequest struct {
+	URL string
+	// Events the webhook is sent, every event when empty
+	Events []entity.WebhookEvent
+	// Secret signs the deliveries; nil generates one for a new webhook and
+	// keeps the current one otherwise
+	Secret *string
+	// Active defaults to true for a new webhook and is kept otherwise
+	Active *bool
+}
+
+// WebhookPayload is the JSON body posted to webhooks
+type WebhookPayload struct {
+	Event      entity.WebhookEvent `json:"event"`
+	ProjectID  uuid.UUID           `json:"project_id"`
+	OccurredAt time.Time           `json:"occurred_at"`
+	Data       interface{}         `json:"data"`
+}
+
+// WebhookTaskData is the data of task_created events
+type WebhookTaskData struct {
+	TaskID      uuid.UUID           `json:"task_id"`
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Status      entity.TaskStatus   `json:"status"`
+	Priority    entity.TaskPriority `json:"priority"`
+	EpicID      *uuid.UUID          `json:"epic_id,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+// NewWebhookTaskData returns the task_created data of task
+func NewWebhookTaskData(task *entity.Task) WebhookTaskData {
+	return WebhookTaskData{
+		TaskID:      task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		Status:      task.Status,
+		Priority:    task.Priority,
+		EpicID:      task.EpicID,
+		CreatedAt:   task.CreatedAt,
+	}
+}
+
+// WebhookStatusChangedData is the data of status_changed events
+type WebhookStatusChangedData struct {
+	TaskID    uuid.UUID         `json:"task_id"`
+	TaskTitle string            `json:"task_title"`
+	OldStatus entity.TaskStatus `json:"old_status"`
+	NewStatus entity.TaskStatus `json:"new_status"`
+}
+
+// WebhookPlanReadyData is the data of plan_ready events
+type WebhookPlanReadyData struct {
+	TaskID    uuid.UUID `json:"task_id"`
+	TaskTitle string    `json:"task_title"`
+	PlanID    uuid.UUID `json:"plan_id"`
+	Content   string    `json:"content"`
+}
+
+// WebhookExecutionCompletedData is the data of execution_completed events
+type WebhookExecutionCompletedData struct {
+	ExecutionID uuid.UUID             `json:"execution_id"`
+	TaskID      uuid.UUID             `json:"task_id"`
+	TaskTitle   string                `json:"task_title"`
+	Phase       entity.ExecutionPhase `json:"phase,omitempty"`
+	AIType      string                `json:"ai_type,omitempty"`
+	// Status is completed or failed
+	Status      entity.ExecutionStatus `json:"status"`
+	Error       string                 `json:"error,omitempty"`
+	CompletedAt time.Time              `json:"completed_at"`
+}
+
+// WebhookPRMergedData is the data of pr_merged events
+type WebhookPRMergedData struct {
+	TaskID         uuid.UUID  `json:"task_id"`
+	TaskTitle      string     `json:"task_title"`
+	Number         int        `json:"number"`
+	Repository     string     `json:"repository"`
+	URL            string     `json:"url"`
+	MergeCommitSHA *string    `json:"merge_commit_sha,omitempty"`
+	MergedBy       *string    `json:"merged_by,omitempty"`
+	MergedAt       *time.Time `json:"merged_at,omitempty"`
+}
+
+type projectWebhookUsecase struct {
+	projectAccess
+	webhookRepo repository.ProjectWebhookRepository
+	projectRepo repository.ProjectRepository
+	secretStore secrets.Store
+	jobClient   JobClientInterface
+	sender      webhook.Sender
+}
+
+func NewProjectWebhookUsecase(
+	webhookRepo repository.ProjectWebhookRepository,
+	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
+	secretStore secrets.Store,
+	jobClient JobClientInterface,
+	sender webhook.Sender,
+) ProjectWebhookUsecase {
+	return &projectWebhookUsecase{
+		projectAccess: projectAccess{memberRepo: memberRepo},
+		webhookRepo:   webhookRepo,
+		projectRepo:   projectRepo,
+		secretStore:   secretStore,
+		jobClient:     jobClient,
+		sender:        sender,
+	}
+}
+
+func (u *projectWebhookUsecase) ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]*entity.ProjectWebhook, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	return u.webhookRepo.ListByProject(ctx, projectID)
+}
+
+func (u *projectWebhookUsecase) CreateWebhook(ctx context.Context, projectID uuid.UUID, req ProjectWebhookRequest) (*entity.ProjectWebhook, string, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleOwner); err != nil {
+		return nil, "", err
+	}
+	hook := &entity.ProjectWebhook{ProjectID: projectID, Active: true}
+	if err := applyWebhookRequest(hook, req); err != nil {
+		return nil, "", err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+	if secret == "" {
+		generated, err := newWebhookSecret()
+		if err != nil {
+			return nil, "", err
+		}
+		secret = generated
+	}
+
+	hook.ID = uuid.New()
+	if err := u.secretStore.Put(ctx, projectID, entity.WebhookSecretName(hook.ID), secret); err != nil {
+		return nil, "", fmt.Errorf("failed to store webhook secret: %w", err)
+	}
+	if err := u.webhookRepo.Create(ctx, hook); err != nil {
+		return nil, "", fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return hook, secret, nil
+}
+
+func (u *projectWebhookUsecase) UpdateWebhook(ctx context.Context, id uuid.UUID, req ProjectWebhookRequest) (*entity.ProjectWebhook, error) {
+	hook, err := u.getWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyWebhookRequest(hook, req); err != nil {
+		return nil, err
+	}
+
+	if req.Secret != nil && *req.Secret != "" {
+		if err := u.secretStore.Put(ctx, hook.ProjectID, entity.WebhookSecretName(hook.ID), *req.Secret); err != nil {
+			return nil, fmt.Errorf("failed to store webhook secret: %w", err)
+		}
+	}
+	if err := u.webhookRepo.Update(ctx, hook); err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return hook, nil
+}
+
+func (u *projectWebhookUsecase) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	hook, err := u.getWebhook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := u.webhookRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return u.secretStore.Delete(ctx, hook.ProjectID, entity.WebhookSecretName(id))
+}
+
+func (u *projectWebhookUsecase) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*entity.ProjectWebhookDelivery, error) {
+	if _, err := u.getWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultWebhookDeliveryLimit
+	}
+	if limit > MaxWebhookDeliveryLimit {
+		limit = MaxWebhookDeliveryLimit
+	}
+	return u.webhookRepo.ListDeliveries(ctx, webhookID, limit)
+}
+
+func (u *projectWebhookUsecase) Redeliver(ctx context.Context, deliveryID uuid.UUID) (*entity.ProjectWebhookDelivery, error) {
+	original, err := u.getDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorize(ctx, original.Webhook.ProjectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+
+	delivery := &entity.ProjectWebhookDelivery{
+		WebhookID:    original.WebhookID,
+		Event:        original.Event,
+		Payload:      original.Payload,
+		Status:       entity.WebhookDeliveryPending,
+		RedeliveryOf: &original.ID,
+	}
+	if err := u.queue(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (u *projectWebhookUsecase) Publish(ctx context.Context, projectID uuid.UUID, event entity.WebhookEvent, data interface{}) {
+	hooks, err := u.webhookRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		slog.Warn("Failed to list project webhooks", "project_id", projectID, "event", event, "error", err)
+		return
+	}
+
+	var payload []byte
+	for _, hook := range hooks {
+		if !hook.Subscribes(event) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(WebhookPayload{Event: event, ProjectID: projectID, OccurredAt: time.Now().UTC(), Data: data})
+			if err != nil {
+				slog.Warn("Failed to encode webhook payload", "project_id", projectID, "event", event, "error", err)
+				return
+			}
+		}
+
+		delivery := &entity.ProjectWebhookDelivery{
+			WebhookID: hook.ID,
+			Event:     event,
+			Payload:   string(payload),
+			Status:    entity.WebhookDeliveryPending,
+		}
+		if err := u.queue(ctx, delivery); err != nil {
+			slog.Warn("Failed to queue webhook delivery", "webhook_id", hook.ID, "event", event, "error", err)
+		}
+	}
+}
+
+func (u *projectWebhookUsecase) Deliver(ctx context.Context, deliveryID uuid.UUID, lastAttempt bool) error {
+	delivery, err := u.getDelivery(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if delivery.Status != entity.WebhookDeliveryPending {
+		return nil
+	}
+
+	response, sendErr := u.send(ctx, delivery)
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = nil
+	delivery.Error = ""
+	if response != nil {
+		delivery.ResponseStatus = &response.StatusCode
+	}
+	switch {
+	case sendErr == nil:
+		delivery.Status = entity.WebhookDeliverySucceeded
+	case lastAttempt:
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.Error = sendErr.Error()
+	default:
+		delivery.Error = sendErr.Error()
+	}
+	if err := u.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return sendErr
+}
+
+// send posts the delivery, signed with its webhook's secret
+func (u *projectWebhookUsecase) send(ctx context.Context, delivery *entity.ProjectWebhookDelivery) (*webhook.Response, error) {
+	secret, err := u.secretStore.Get(ctx, delivery.Webhook.ProjectID, entity.WebhookSecretName(delivery.WebhookID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook secret: %w", err)
+	}
+	return u.sender.Send(ctx, webhook.Delivery{
+		ID:      delivery.ID.String(),
+		Event:   string(delivery.Event),
+		URL:     delivery.Webhook.URL,
+		Secret:  secret,
+		Payload: []byte(delivery.Payload),
+	})
+}
+
+// queue records the delivery and enqueues the job posting it. A delivery
+// that cannot be enqueued is recorded as failed.
+func (u *projectWebhookUsecase) queue(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+	if err := u.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	if _, err := u.jobClient.EnqueueWebhookDelivery(&WebhookDeliveryPayload{DeliveryID: delivery.ID}); err != nil {
+		delivery.Status = entity.WebhookDeliveryFailed
+		delivery.Error = fmt.Sprintf("failed to enqueue delivery: %v", err)
+		if updateErr := u.webhookRepo.UpdateDelivery(ctx, delivery); updateErr != nil {
+			slog.Warn("Failed to record webhook delivery", "delivery_id", delivery.ID, "error", updateErr)
+		}
+		return fmt.Errorf("%w: %v", ErrJobQueueUnavailable, err)
+	}
+	return nil
+}
+
+// getWebhook returns the webhook if the request may manage the webhooks of
+// its project
+func (u *projectWebhookUsecase) getWebhook(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhook, error) {
+	hook, err := u.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		return nil, ErrWebhookNotFound
+	}
+	if err := u.authorize(ctx, hook.ProjectID, entity.ProjectRoleOwner); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (u *projectWebhookUsecase) getDelivery(ctx context.Context, id uuid.UUID) (*entity.ProjectWebhookDelivery, error) {
+	delivery, err := u.webhookRepo.GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery == nil || delivery.Webhook == nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+// applyWebhookRequest validates req and sets its fields on hook
+func applyWebhookRequest(hook *entity.ProjectWebhook, req ProjectWebhookRequest) error {
+	rawURL := strings.TrimSpace(req.URL)
+	if parsed, err := url.Parse(rawURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrWebhookURLInvalid
+	}
+	if err := webhook.CheckURL(rawURL); err != nil {
+		return fmt.Errorf("%w: %v", ErrWebhookURLNotAllowed, err)
+	}
+	events := make([]entity.WebhookEvent, 0, len(req.Events))
+	for _, event := range req.Events {
+		if !event.IsValid() {
+			return ErrWebhookEventInvalid
+		}
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+
+	hook.URL = rawURL
+	hook.Events = events
+	if req.Active != nil {
+		hook.Active = *req.Active
+	}
+	return nil
+}
+
+// newWebhookSecret returns a random, hex-encoded webhook secret
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
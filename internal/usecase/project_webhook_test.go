@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/webhook"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWebhookSender struct {
+	sent     []webhook.Delivery
+	response *webhook.Response
+	err      error
+}
+
+func (f *fakeWebhookSender) Send(ctx context.Context, delivery webhook.Delivery) (*webhook.Response, error) {
+	f.sent = append(f.sent, delivery)
+	return f.response, f.err
+}
+
+func newWebhookTestUsecase(t *testing.T, sender *fakeWebhookSender) (*projectWebhookUsecase, *repository.ProjectWebhookRepositoryMock, *JobClientInterfaceMock, fakeSecretStore) {
+	webhookRepo := repository.NewProjectWebhookRepositoryMock(t)
+	jobClient := NewJobClientInterfaceMock(t)
+	store := fakeSecretStore{}
+	uc := &projectWebhookUsecase{
+		webhookRepo: webhookRepo,
+		projectRepo: repository.NewProjectRepositoryMock(t),
+		secretStore: store,
+		jobClient:   jobClient,
+		sender:      sender,
+	}
+	return uc, webhookRepo, jobClient, store
+}
+
+func TestProjectWebhookPublish_QueuesSubscribedWebhooks(t *testing.T) {
+	projectID := uuid.New()
+	uc, webhookRepo, jobClient, _ := newWebhookTestUsecase(t, &fakeWebhookSender{})
+
+	everything := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID, Active: true}
+	statuses := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID, Active: true, Events: []entity.WebhookEvent{entity.WebhookEventStatusChanged}}
+	merges := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID, Active: true, Events: []entity.WebhookEvent{entity.WebhookEventPRMerged}}
+	inactive := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID}
+	webhookRepo.EXPECT().ListByProject(mock.Anything, projectID).Return([]*entity.ProjectWebhook{everything, statuses, merges, inactive}, nil).Once()
+
+	var queued []*entity.ProjectWebhookDelivery
+	webhookRepo.EXPECT().CreateDelivery(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+		delivery.ID = uuid.New()
+		queued = append(queued, delivery)
+		return nil
+	}).Twice()
+	jobClient.EXPECT().EnqueueWebhookDelivery(mock.Anything).Return("job-1", nil).Twice()
+
+	taskID := uuid.New()
+	uc.Publish(context.Background(), projectID, entity.WebhookEventStatusChanged, WebhookStatusChangedData{
+		TaskID:    taskID,
+		TaskTitle: "Add login",
+		OldStatus: entity.TaskStatusTODO,
+		NewStatus: entity.TaskStatusPLANNING,
+	})
+
+	require.Len(t, queued, 2)
+	assert.Equal(t, everything.ID, queued[0].WebhookID)
+	assert.Equal(t, statuses.ID, queued[1].WebhookID)
+	assert.Equal(t, entity.WebhookDeliveryPending, queued[0].Status)
+	assert.Equal(t, queued[0].Payload, queued[1].Payload)
+
+	var payload struct {
+		Event     entity.WebhookEvent `json:"event"`
+		ProjectID uuid.UUID           `json:"project_id"`
+		Data      struct {
+			TaskID    uuid.UUID         `json:"task_id"`
+			NewStatus entity.TaskStatus `json:"new_status"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(queued[0].Payload), &payload))
+	assert.Equal(t, entity.WebhookEventStatusChanged, payload.Event)
+	assert.Equal(t, projectID, payload.ProjectID)
+	assert.Equal(t, taskID, payload.Data.TaskID)
+	assert.Equal(t, entity.TaskStatusPLANNING, payload.Data.NewStatus)
+}
+
+func TestProjectWebhookPublish_EnqueueFailureMarksDeliveryFailed(t *testing.T) {
+	projectID := uuid.New()
+	uc, webhookRepo, jobClient, _ := newWebhookTestUsecase(t, &fakeWebhookSender{})
+
+	hook := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID, Active: true}
+	webhookRepo.EXPECT().ListByProject(mock.Anything, projectID).Return([]*entity.ProjectWebhook{hook}, nil).Once()
+	webhookRepo.EXPECT().CreateDelivery(mock.Anything, mock.Anything).Return(nil).Once()
+	jobClient.EXPECT().EnqueueWebhookDelivery(mock.Anything).Return("", errors.New("redis down")).Once()
+
+	var recorded *entity.ProjectWebhookDelivery
+	webhookRepo.EXPECT().UpdateDelivery(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+		recorded = delivery
+		return nil
+	}).Once()
+
+	uc.Publish(context.Background(), projectID, entity.WebhookEventTaskCreated, WebhookTaskData{TaskID: uuid.New()})
+
+	require.NotNil(t, recorded)
+	assert.Equal(t, entity.WebhookDeliveryFailed, recorded.Status)
+	assert.Contains(t, recorded.Error, "redis down")
+}
+
+func TestProjectWebhookDeliver(t *testing.T) {
+	projectID := uuid.New()
+	hook := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: projectID, URL: "https://hooks.acme.com/auto-devs", Active: true}
+	newDelivery := func() *entity.ProjectWebhookDelivery {
+		return &entity.ProjectWebhookDelivery{
+			ID:        uuid.New(),
+			WebhookID: hook.ID,
+			Event:     entity.WebhookEventPlanReady,
+			Payload:   `{"event":"plan_ready"}`,
+			Status:    entity.WebhookDeliveryPending,
+			Webhook:   hook,
+		}
+	}
+
+	t.Run("succeeds", func(t *testing.T) {
+		sender := &fakeWebhookSender{response: &webhook.Response{StatusCode: 200}}
+		uc, webhookRepo, _, store := newWebhookTestUsecase(t, sender)
+		store[projectID.String()+"/"+entity.WebhookSecretName(hook.ID)] = "s3cret"
+		delivery := newDelivery()
+		webhookRepo.EXPECT().GetDelivery(mock.Anything, delivery.ID).Return(delivery, nil).Once()
+		webhookRepo.EXPECT().UpdateDelivery(mock.Anything, delivery).Return(nil).Once()
+
+		require.NoError(t, uc.Deliver(context.Background(), delivery.ID, false))
+
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, "s3cret", sender.sent[0].Secret)
+		assert.Equal(t, hook.URL, sender.sent[0].URL)
+		assert.Equal(t, delivery.ID.String(), sender.sent[0].ID)
+		assert.Equal(t, entity.WebhookDeliverySucceeded, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, 200, *delivery.ResponseStatus)
+		assert.NotNil(t, delivery.LastAttemptAt)
+	})
+
+	t.Run("stays pending until the last attempt", func(t *testing.T) {
+		sender := &fakeWebhookSender{response: &webhook.Response{StatusCode: 502}, err: errors.New("webhook answered 502")}
+		uc, webhookRepo, _, _ := newWebhookTestUsecase(t, sender)
+		delivery := newDelivery()
+		webhookRepo.EXPECT().GetDelivery(mock.Anything, delivery.ID).Return(delivery, nil).Twice()
+		webhookRepo.EXPECT().UpdateDelivery(mock.Anything, delivery).Return(nil).Twice()
+
+		assert.Error(t, uc.Deliver(context.Background(), delivery.ID, false))
+		assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, "webhook answered 502", delivery.Error)
+
+		assert.Error(t, uc.Deliver(context.Background(), delivery.ID, true))
+		assert.Equal(t, entity.WebhookDeliveryFailed, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+	})
+
+	t.Run("skips finished deliveries", func(t *testing.T) {
+		sender := &fakeWebhookSender{}
+		uc, webhookRepo, _, _ := newWebhookTestUsecase(t, sender)
+		delivery := newDelivery()
+		delivery.Status = entity.WebhookDeliverySucceeded
+		webhookRepo.EXPECT().GetDelivery(mock.Anything, delivery.ID).Return(delivery, nil).Once()
+
+		require.NoError(t, uc.Deliver(context.Background(), delivery.ID, false))
+		assert.Empty(t, sender.sent)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		uc, webhookRepo, _, _ := newWebhookTestUsecase(t, &fakeWebhookSender{})
+		webhookRepo.EXPECT().GetDelivery(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		assert.ErrorIs(t, uc.Deliver(context.Background(), uuid.New(), false), ErrWebhookDeliveryNotFound)
+	})
+}
+
+func TestProjectWebhookRedeliver(t *testing.T) {
+	uc, webhookRepo, jobClient, _ := newWebhookTestUsecase(t, &fakeWebhookSender{})
+	hook := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: uuid.New(), Active: true}
+	original := &entity.ProjectWebhookDelivery{
+		ID:        uuid.New(),
+		WebhookID: hook.ID,
+		Event:     entity.WebhookEventPRMerged,
+		Payload:   `{"event":"pr_merged"}`,
+		Status:    entity.WebhookDeliveryFailed,
+		Webhook:   hook,
+	}
+	webhookRepo.EXPECT().GetDelivery(mock.Anything, original.ID).Return(original, nil).Once()
+	webhookRepo.EXPECT().CreateDelivery(mock.Anything, mock.Anything).Return(nil).Once()
+	jobClient.EXPECT().EnqueueWebhookDelivery(mock.Anything).Return("job-1", nil).Once()
+
+	delivery, err := uc.Redeliver(context.Background(), original.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, original.Payload, delivery.Payload)
+	assert.Equal(t, original.Event, delivery.Event)
+	assert.Equal(t, &original.ID, delivery.RedeliveryOf)
+}
+
+func TestProjectWebhookCreate(t *testing.T) {
+	projectID := uuid.New()
+	uc, webhookRepo, _, store := newWebhookTestUsecase(t, &fakeWebhookSender{})
+	uc.projectRepo.(*repository.ProjectRepositoryMock).EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil).Once()
+	webhookRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+
+	hook, secret, err := uc.CreateWebhook(context.Background(), projectID, ProjectWebhookRequest{
+		URL:    " https://hooks.acme.com/auto-devs ",
+		Events: []entity.WebhookEvent{entity.WebhookEventPRMerged, entity.WebhookEventPRMerged},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://hooks.acme.com/auto-devs", hook.URL)
+	assert.Equal(t, []entity.WebhookEvent{entity.WebhookEventPRMerged}, hook.Events)
+	assert.True(t, hook.Active)
+	assert.Len(t, secret, 64, "a 32 byte secret is generated")
+	assert.Equal(t, secret, store[projectID.String()+"/"+entity.WebhookSecretName(hook.ID)])
+
+	_, _, err = uc.CreateWebhook(context.Background(), projectID, ProjectWebhookRequest{URL: "ftp://hooks.acme.com"})
+	assert.ErrorIs(t, err, ErrWebhookURLInvalid)
+	_, _, err = uc.CreateWebhook(context.Background(), projectID, ProjectWebhookRequest{URL: "http://169.254.169.254/latest/meta-data/"})
+	assert.ErrorIs(t, err, ErrWebhookURLNotAllowed)
+	_, _, err = uc.CreateWebhook(context.Background(), projectID, ProjectWebhookRequest{URL: "https://hooks.acme.com", Events: []entity.WebhookEvent{"task_deleted"}})
+	assert.ErrorIs(t, err, ErrWebhookEventInvalid)
+}
+
+func TestUpdateStatus_PublishesStatusChanged(t *testing.T) {
+	webhooks, webhookRepo, jobClient, _ := newWebhookTestUsecase(t, &fakeWebhookSender{})
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, webhookUsecase: webhooks}
+	taskID := uuid.New()
+	oldTask := kanbanTestTask(taskID, entity.TaskStatusTODO, nil)
+	newTask := kanbanTestTask(taskID, entity.TaskStatusPLANNING, nil)
+	newTask.ProjectID = oldTask.ProjectID
+
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(oldTask, nil).Once()
+	taskRepo.EXPECT().UpdateStatus(context.Background(), taskID, entity.TaskStatusPLANNING).Return(nil).Once()
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(newTask, nil).Once()
+
+	hook := &entity.ProjectWebhook{ID: uuid.New(), ProjectID: oldTask.ProjectID, Active: true}
+	webhookRepo.EXPECT().ListByProject(context.Background(), oldTask.ProjectID).Return([]*entity.ProjectWebhook{hook}, nil).Once()
+	var queued *entity.ProjectWebhookDelivery
+	webhookRepo.EXPECT().CreateDelivery(context.Background(), mock.Anything).RunAndReturn(func(ctx context.Context, delivery *entity.ProjectWebhookDelivery) error {
+		queued = delivery
+		return nil
+	}).Once()
+	jobClient.EXPECT().EnqueueWebhookDelivery(mock.Anything).Return("job-1", nil).Once()
+
+	_, err := uc.UpdateStatus(context.Background(), taskID, entity.TaskStatusPLANNING)
+	require.NoError(t, err)
+
+	require.NotNil(t, queued)
+	assert.Equal(t, entity.WebhookEventStatusChanged, queued.Event)
+	assert.Contains(t, queued.Payload, `"old_status":"TODO"`)
+	assert.Contains(t, queued.Payload, `"new_status":"PLANNING"`)
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// DefaultReleaseNotesAIType is the executor writing release notes when the
+// request names none
+const DefaultReleaseNotesAIType = "claude-code"
+
+var (
+	ErrReleaseNotesNotFound      = errors.New("release notes not found")
+	ErrReleaseNotesSourceInvalid = errors.New("release notes source must be tasks or pull_requests")
+	ErrReleaseNotesNoChanges     = errors.New("no changes to write release notes on in the date range")
+	ErrReleaseNotesNotReady      = errors.New("release notes have not been generated")
+)
+
+type ReleaseNotesUsecase interface {
+	// Create records release notes on the project's changes in the request's
+	// date range and queues their generation
+	Create(ctx context.Context, req CreateReleaseNotesRequest) (*entity.ReleaseNotes, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error)
+	// ListByProjectID returns the project's release notes, newest first
+	ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.ReleaseNotes, error)
+	// CollectChanges returns the tasks completed or pull requests merged that
+	// the notes cover, oldest first
+	CollectChanges(ctx context.Context, notes *entity.ReleaseNotes) ([]entity.ReleaseChange, error)
+	// MarkGenerating, Complete and Fail record the progress of the
+	// generation job
+	MarkGenerating(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error)
+	Complete(ctx context.Context, id uuid.UUID, content string) error
+	Fail(ctx context.Context, id uuid.UUID, reason string) error
+}
+
+type CreateReleaseNotesRequest struct {
+	ProjectID uuid.UUID
+	Version   string
+	// Source defaults to the completed tasks
+	Source entity.ReleaseNotesSource
+	From   time.Time
+	To     time.Time
+	// AIType defaults to DefaultReleaseNotesAIType
+	AIType string
+}
+
+type releaseNotesUsecase struct {
+	projectAccess
+	releaseNotesRepo repository.ReleaseNotesRepository
+	projectRepo      repository.ProjectRepository
+	taskRepo         repository.TaskRepository
+	pullRequestRepo  repository.PullRequestRepository
+	jobClient        JobClientInterface
+}
+
+func NewReleaseNotesUsecase(
+	releaseNotesRepo repository.ReleaseNotesRepository,
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	pullRequestRepo repository.PullRequestRepository,
+	memberRepo repository.ProjectMemberRepository,
+	jobClient JobClientInterface,
+) ReleaseNotesUsecase {
+	return &releaseNotesUsecase{
+		projectAccess:    projectAccess{memberRepo: memberRepo},
+		releaseNotesRepo: releaseNotesRepo,
+		projectRepo:      projectRepo,
+		taskRepo:         taskRepo,
+		pullRequestRepo:  pullRequestRepo,
+		jobClient:        jobClient,
+	}
+}
+
+func (u *releaseNotesUsecase) Create(ctx context.Context, req CreateReleaseNotesRequest) (*entity.ReleaseNotes, error) {
+	if req.Source == "" {
+		req.Source = entity.ReleaseNotesSourceTasks
+	}
+	if !req.Source.IsValid() {
+		return nil, ErrReleaseNotesSourceInvalid
+	}
+	if req.To.Before(req.From) {
+		return nil, ErrDateRangeInvalid
+	}
+	if req.AIType == "" {
+		req.AIType = DefaultReleaseNotesAIType
+	}
+	if _, err := u.projectRepo.GetByID(ctx, req.ProjectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, req.ProjectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+
+	notes := &entity.ReleaseNotes{
+		ID:        uuid.New(),
+		ProjectID: req.ProjectID,
+		Version:   strings.TrimSpace(req.Version),
+		Source:    req.Source,
+		From:      req.From,
+		To:        req.To,
+		AIType:    req.AIType,
+		Status:    entity.ReleaseNotesStatusPending,
+	}
+	changes, err := u.CollectChanges(ctx, notes)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, ErrReleaseNotesNoChanges
+	}
+	notes.Changes = len(changes)
+
+	if err := u.releaseNotesRepo.Create(ctx, notes); err != nil {
+		return nil, fmt.Errorf("failed to create release notes: %w", err)
+	}
+	if _, err := u.jobClient.EnqueueReleaseNotes(&ReleaseNotesPayload{ReleaseNotesID: notes.ID}); err != nil {
+		// Record why the notes will never be generated
+		_ = u.Fail(ctx, notes.ID, fmt.Sprintf("failed to queue generation: %v", err))
+		return nil, fmt.Errorf("failed to enqueue release notes generation: %w", err)
+	}
+
+	return notes, nil
+}
+
+func (u *releaseNotesUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error) {
+	notes, err := u.releaseNotesRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReleaseNotesNotFound, err)
+	}
+	if err := u.authorize(ctx, notes.ProjectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+func (u *releaseNotesUsecase) ListByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.ReleaseNotes, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.releaseNotesRepo.ListByProjectID(ctx, projectID)
+}
+
+// CollectChanges lists the tasks that went to DONE within the notes' date
+// range and are still DONE, or the pull requests merged within it, each with
+// its task's description
+func (u *releaseNotesUsecase) CollectChanges(ctx context.Context, notes *entity.ReleaseNotes) ([]entity.ReleaseChange, error) {
+	tasks, err := u.taskRepo.GetByProjectID(ctx, notes.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	within := func(t time.Time) bool {
+		return !t.Before(notes.From) && !t.After(notes.To)
+	}
+
+	var changes []entity.ReleaseChange
+	switch notes.Source {
+	case entity.ReleaseNotesSourcePullRequests:
+		taskByID := make(map[uuid.UUID]*entity.Task, len(tasks))
+		taskIDs := make([]uuid.UUID, len(tasks))
+		for i, task := range tasks {
+			taskByID[task.ID] = task
+			taskIDs[i] = task.ID
+		}
+		pullRequests, err := u.pullRequestRepo.GetByTaskIDs(ctx, taskIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull requests: %w", err)
+		}
+		for _, pr := range pullRequests {
+			if pr.MergedAt == nil || !within(*pr.MergedAt) {
+				continue
+			}
+			change := entity.ReleaseChange{
+				TaskID:            pr.TaskID,
+				Title:             pr.Title,
+				Description:       pr.Body,
+				PullRequestNumber: pr.GitHubPRNumber,
+				PullRequestURL:    pr.GitHubURL,
+				CompletedAt:       *pr.MergedAt,
+			}
+			if task, ok := taskByID[pr.TaskID]; ok && strings.TrimSpace(task.Description) != "" {
+				change.Description = task.Description
+			}
+			changes = append(changes, change)
+		}
+
+	default:
+		histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, notes.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		historyByTask := make(map[uuid.UUID][]*entity.TaskStatusHistory)
+		for _, history := range histories {
+			historyByTask[history.TaskID] = append(historyByTask[history.TaskID], history)
+		}
+		for _, task := range tasks {
+			cycleTime := measureTaskCycleTime(task, historyByTask[task.ID])
+			if cycleTime == nil || !within(cycleTime.CompletedAt) {
+				continue
+			}
+			changes = append(changes, entity.ReleaseChange{
+				TaskID:      task.ID,
+				Title:       task.Title,
+				Description: task.Description,
+				CompletedAt: cycleTime.CompletedAt,
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].CompletedAt.Before(changes[j].CompletedAt)
+	})
+	return changes, nil
+}
+
+func (u *releaseNotesUsecase) MarkGenerating(ctx context.Context, id uuid.UUID) (*entity.ReleaseNotes, error) {
+	notes, err := u.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	notes.Status = entity.ReleaseNotesStatusGenerating
+	notes.Error = ""
+	if err := u.releaseNotesRepo.Update(ctx, notes); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+func (u *releaseNotesUsecase) Complete(ctx context.Context, id uuid.UUID, content string) error {
+	notes, err := u.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	notes.Status = entity.ReleaseNotesStatusCompleted
+	notes.Content = content
+	notes.Error = ""
+	notes.CompletedAt = &now
+	return u.releaseNotesRepo.Update(ctx, notes)
+}
+
+func (u *releaseNotesUsecase) Fail(ctx context.Context, id uuid.UUID, reason string) error {
+	notes, err := u.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	notes.Status = entity.ReleaseNotesStatusFailed
+	notes.Error = reason
+	notes.CompletedAt = &now
+	return u.releaseNotesRepo.Update(ctx, notes)
+}
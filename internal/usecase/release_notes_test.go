@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseNotesUsecase_Create(t *testing.T) {
+	projectID := uuid.New()
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	from := created
+	to := created.Add(48 * time.Hour)
+
+	login := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Add login page", Description: "Sign in with email", Status: entity.TaskStatusDONE, CreatedAt: created}
+	search := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Fix search", Status: entity.TaskStatusDONE, CreatedAt: created}
+	export := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Export CSV", Status: entity.TaskStatusDONE, CreatedAt: created}
+	var histories []*entity.TaskStatusHistory
+	histories = append(histories, statusChanges(login.ID, created, entity.TaskStatusIMPLEMENTING, 1, entity.TaskStatusDONE, 30)...)
+	histories = append(histories, statusChanges(search.ID, created, entity.TaskStatusIMPLEMENTING, 1, entity.TaskStatusDONE, 5)...)
+	// Completed after the range
+	histories = append(histories, statusChanges(export.ID, created, entity.TaskStatusDONE, 72)...)
+
+	setup := func(t *testing.T) (*releaseNotesUsecase, *repository.ReleaseNotesRepositoryMock, *JobClientInterfaceMock) {
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		releaseNotesRepo := repository.NewReleaseNotesRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil).Maybe()
+		taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{login, search, export}, nil).Maybe()
+		taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, projectID).Return(histories, nil).Maybe()
+		uc := &releaseNotesUsecase{releaseNotesRepo: releaseNotesRepo, projectRepo: projectRepo, taskRepo: taskRepo, jobClient: jobClient}
+		return uc, releaseNotesRepo, jobClient
+	}
+
+	t.Run("completed tasks", func(t *testing.T) {
+		uc, releaseNotesRepo, jobClient := setup(t)
+		releaseNotesRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.ReleaseNotes")).Return(nil).Once()
+		jobClient.EXPECT().EnqueueReleaseNotes(mock.Anything).Return("job-1", nil).Once()
+
+		notes, err := uc.Create(context.Background(), CreateReleaseNotesRequest{ProjectID: projectID, Version: " v1.4.0 ", From: from, To: to})
+		require.NoError(t, err)
+		assert.Equal(t, "v1.4.0", notes.Version)
+		assert.Equal(t, entity.ReleaseNotesSourceTasks, notes.Source)
+		assert.Equal(t, DefaultReleaseNotesAIType, notes.AIType)
+		assert.Equal(t, entity.ReleaseNotesStatusPending, notes.Status)
+		assert.Equal(t, 2, notes.Changes)
+
+		changes, err := uc.CollectChanges(context.Background(), notes)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, "Fix search", changes[0].Title, "oldest first")
+		assert.Equal(t, "Sign in with email", changes[1].Description)
+	})
+
+	t.Run("enqueue failure", func(t *testing.T) {
+		uc, releaseNotesRepo, jobClient := setup(t)
+		var stored *entity.ReleaseNotes
+		releaseNotesRepo.EXPECT().Create(mock.Anything, mock.Anything).Run(func(_ context.Context, notes *entity.ReleaseNotes) {
+			stored = notes
+		}).Return(nil).Once()
+		jobClient.EXPECT().EnqueueReleaseNotes(mock.Anything).Return("", errors.New("redis down")).Once()
+		releaseNotesRepo.EXPECT().GetByID(mock.Anything, mock.Anything).RunAndReturn(func(context.Context, uuid.UUID) (*entity.ReleaseNotes, error) {
+			return stored, nil
+		}).Once()
+		releaseNotesRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := uc.Create(context.Background(), CreateReleaseNotesRequest{ProjectID: projectID, From: from, To: to})
+		require.Error(t, err)
+		assert.Equal(t, entity.ReleaseNotesStatusFailed, stored.Status)
+		assert.Contains(t, stored.Error, "redis down")
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		uc, _, _ := setup(t)
+		_, err := uc.Create(context.Background(), CreateReleaseNotesRequest{ProjectID: projectID, Source: "commits", From: from, To: to})
+		assert.ErrorIs(t, err, ErrReleaseNotesSourceInvalid)
+
+		_, err = uc.Create(context.Background(), CreateReleaseNotesRequest{ProjectID: projectID, From: to, To: from})
+		assert.ErrorIs(t, err, ErrDateRangeInvalid)
+
+		_, err = uc.Create(context.Background(), CreateReleaseNotesRequest{ProjectID: projectID, From: to.Add(time.Hour), To: to.Add(2 * time.Hour)})
+		assert.ErrorIs(t, err, ErrReleaseNotesNoChanges)
+	})
+}
+
+func TestReleaseNotesUsecase_CollectChanges_PullRequests(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	uc := &releaseNotesUsecase{taskRepo: taskRepo, pullRequestRepo: prRepo}
+
+	merged := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	login := &entity.Task{ID: uuid.New(), Title: "Add login page", Description: "Sign in with email"}
+	search := &entity.Task{ID: uuid.New(), Title: "Fix search"}
+	notes := &entity.ReleaseNotes{
+		ProjectID: uuid.New(),
+		Source:    entity.ReleaseNotesSourcePullRequests,
+		From:      merged.Add(-24 * time.Hour),
+		To:        merged.Add(24 * time.Hour),
+	}
+	later := merged.Add(48 * time.Hour)
+
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, notes.ProjectID).Return([]*entity.Task{login, search}, nil)
+	prRepo.EXPECT().GetByTaskIDs(mock.Anything, []uuid.UUID{login.ID, search.ID}).Return([]*entity.PullRequest{
+		{TaskID: login.ID, GitHubPRNumber: 12, Title: "feat: login page", Body: "Closes AD-1", GitHubURL: "https://github.com/acme/app/pull/12", Status: entity.PullRequestStatusMerged, MergedAt: &merged},
+		{TaskID: search.ID, GitHubPRNumber: 13, Title: "fix: search", Body: "Escape the query", Status: entity.PullRequestStatusMerged, MergedAt: &later},
+		{TaskID: search.ID, GitHubPRNumber: 14, Title: "fix: search again", Status: entity.PullRequestStatusOpen},
+	}, nil)
+
+	changes, err := uc.CollectChanges(context.Background(), notes)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, entity.ReleaseChange{
+		TaskID:            login.ID,
+		Title:             "feat: login page",
+		Description:       "Sign in with email",
+		PullRequestNumber: 12,
+		PullRequestURL:    "https://github.com/acme/app/pull/12",
+		CompletedAt:       merged,
+	}, changes[0])
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+)
+
+// searchTaskKeyPattern matches queries naming a task by its key (AD-42) or
+// number (#42)
+var searchTaskKeyPattern = regexp.MustCompile(`(?i)^(?:` + entity.TaskKeyPrefix + `-|#)(\d+)$`)
+
+// searchTypeWeights favour the results users most often jump to when
+// matches are otherwise equally good
+var searchTypeWeights = map[entity.SearchResultType]float64{
+	entity.SearchResultProject:     1.0,
+	entity.SearchResultTask:        0.95,
+	entity.SearchResultPullRequest: 0.9,
+	entity.SearchResultPlan:        0.85,
+}
+
+// searchSnippetContext is how many characters around the match a snippet
+// quotes on each side
+const searchSnippetContext = 60
+
+// Search asks the repository for up to limit results of each type, ranks
+// them together and keeps the best limit
+func (u *projectUsecase) Search(ctx context.Context, query string, limit int) ([]*entity.SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrSearchQueryRequired
+	}
+
+	params := repository.SearchParams{Query: query, Limit: limit}
+	if match := searchTaskKeyPattern.FindStringSubmatch(query); match != nil {
+		if number, err := strconv.ParseInt(match[1], 10, 64); err == nil {
+			params.TaskNumber = &number
+		}
+	}
+	results, err := u.projectRepo.Search(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerQuery := strings.ToLower(query)
+	for _, result := range results {
+		result.Score = searchScore(result, lowerQuery, params.TaskNumber)
+		result.Snippet = searchSnippet(result.Text, lowerQuery)
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].UpdatedAt.After(results[j].UpdatedAt)
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// searchScore ranks how well result matches the lower-cased query: an exact
+// title or task key first, then titles starting with it, titles with a word
+// starting with it, titles containing it and last results only matching in
+// their text
+func searchScore(result *entity.SearchResult, query string, taskNumber *int64) float64 {
+	title := strings.ToLower(result.Title)
+	score := 0.2
+	switch {
+	case title == query, taskNumber != nil && result.TaskNumber != nil && *result.TaskNumber == *taskNumber:
+		score = 1.0
+	case strings.HasPrefix(title, query):
+		score = 0.8
+	case strings.Contains(title, " "+query):
+		score = 0.6
+	case strings.Contains(title, query):
+		score = 0.5
+	}
+	return score * searchTypeWeights[result.Type]
+}
+
+// searchSnippet quotes the part of text around the first match of the
+// lower-cased query, or returns "" when text does not contain it
+func searchSnippet(text, query string) string {
+	// Lower-casing can change the byte length of some runes, so the match
+	// is located on runes of the lower-cased text, which map one to one
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		return ""
+	}
+	at := strings.Index(string(lower), query)
+	if at < 0 {
+		return ""
+	}
+	start := len([]rune(string(lower)[:at]))
+	end := start + len([]rune(query))
+
+	from, to := start-searchSnippetContext, end+searchSnippetContext
+	prefix, suffix := "…", "…"
+	if from <= 0 {
+		from, prefix = 0, ""
+	}
+	if to >= len(runes) {
+		to, suffix = len(runes), ""
+	}
+	snippet := strings.Join(strings.Fields(string(runes[from:to])), " ")
+	return prefix + snippet + suffix
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch_RanksResults(t *testing.T) {
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := &projectUsecase{projectRepo: projectRepo}
+	now := time.Now()
+	results := []*entity.SearchResult{
+		{Type: entity.SearchResultPlan, ID: uuid.New(), Title: "Refactor", Text: "Move the login form", UpdatedAt: now},
+		{Type: entity.SearchResultTask, ID: uuid.New(), Title: "Fix login redirect", UpdatedAt: now},
+		{Type: entity.SearchResultProject, ID: uuid.New(), Title: "Login", UpdatedAt: now.Add(-time.Hour)},
+		{Type: entity.SearchResultPullRequest, ID: uuid.New(), Title: "Login page styles", UpdatedAt: now},
+	}
+	projectRepo.EXPECT().Search(mock.Anything, repository.SearchParams{Query: "Login", Limit: 3}).Return(results, nil)
+
+	found, err := uc.Search(context.Background(), "  Login ", 3)
+	require.NoError(t, err)
+	require.Len(t, found, 3)
+	assert.Equal(t, []string{"Login", "Login page styles", "Fix login redirect"}, []string{found[0].Title, found[1].Title, found[2].Title})
+	assert.InDelta(t, 1.0, found[0].Score, 1e-9)
+	assert.InDelta(t, 0.72, found[1].Score, 1e-9)
+	assert.InDelta(t, 0.57, found[2].Score, 1e-9)
+}
+
+func TestSearch_TaskKey(t *testing.T) {
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := &projectUsecase{projectRepo: projectRepo}
+	number := int64(42)
+	projectRepo.EXPECT().Search(mock.Anything, repository.SearchParams{Query: "ad-42", TaskNumber: &number, Limit: 20}).
+		Return([]*entity.SearchResult{{Type: entity.SearchResultTask, Title: "Anything", TaskNumber: &number}}, nil)
+
+	found, err := uc.Search(context.Background(), "ad-42", 20)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.InDelta(t, 0.95, found[0].Score, 1e-9)
+}
+
+func TestSearch_EmptyQuery(t *testing.T) {
+	uc := &projectUsecase{projectRepo: repository.NewProjectRepositoryMock(t)}
+
+	_, err := uc.Search(context.Background(), "   ", 20)
+	assert.ErrorIs(t, err, ErrSearchQueryRequired)
+}
+
+func TestSearchSnippet(t *testing.T) {
+	assert.Equal(t, "Move the login form", searchSnippet("Move the  login\nform", "login"), "whitespace is collapsed")
+	assert.Equal(t, "", searchSnippet("Nothing here", "login"))
+
+	long := strings.Repeat("a", 100) + " needle " + strings.Repeat("b", 100)
+	snippet := searchSnippet(long, "needle")
+	assert.True(t, strings.HasPrefix(snippet, "…") && strings.HasSuffix(snippet, "…"), snippet)
+	assert.Contains(t, snippet, " needle ")
+	assert.Equal(t, 2*searchSnippetContext+len("needle")+2, len([]rune(snippet)))
+}
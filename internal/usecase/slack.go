@@ -0,0 +1,387 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/secrets"
+	"github.com/auto-devs/auto-devs/internal/service/slack"
+	"github.com/google/uuid"
+)
+
+type SlackUsecase interface {
+	Get(ctx context.Context, projectID uuid.UUID) (*entity.SlackIntegration, error)
+	Configure(ctx context.Context, projectID uuid.UUID, req ConfigureSlackRequest) (*entity.SlackIntegration, error)
+	Delete(ctx context.Context, projectID uuid.UUID) error
+	// ListPreferences returns whether each type of notification posted to
+	// Slack is muted for the project
+	ListPreferences(ctx context.Context, projectID uuid.UUID) ([]*entity.NotificationPreference, error)
+	// UpdatePreferences mutes or unmutes the given types of notification
+	UpdatePreferences(ctx context.Context, projectID uuid.UUID, muted map[entity.NotificationType]bool) ([]*entity.NotificationPreference, error)
+	// HandleInteraction approves or rejects the plan a button was clicked
+	// on, and replaces the buttons of the message with the outcome
+	HandleInteraction(ctx context.Context, interaction SlackInteraction) error
+}
+
+type ConfigureSlackRequest struct {
+	// WebhookURL switches the project to posting to an incoming webhook
+	WebhookURL *string `json:"webhook_url,omitempty"`
+	// BotToken switches the project to posting to Channel as a bot; nil
+	// with neither keeps the current credentials
+	BotToken    *string `json:"bot_token,omitempty"`
+	Channel     string  `json:"channel"`
+	Interactive bool    `json:"interactive"`
+}
+
+// SlackInteraction is a click on a button of a Slack message
+type SlackInteraction struct {
+	ActionID string
+	Value    string
+	// UserName is the Slack user who clicked
+	UserName string
+	// ResponseURL is where the message the button is on can be replaced
+	ResponseURL string
+	// Blocks are those of the message the button is on
+	Blocks []slack.Block
+}
+
+// Validation errors
+var (
+	ErrSlackNotConfigured       = errors.New("slack integration is not configured for this project")
+	ErrSlackCredentialsRequired = errors.New("slack webhook URL or bot token is required")
+	ErrSlackCredentialsConflict = errors.New("slack webhook URL and bot token cannot both be given")
+	ErrSlackWebhookURLInvalid   = errors.New("slack webhook URL must be an absolute https URL")
+	ErrSlackChannelRequired     = errors.New("slack channel is required to post with a bot token")
+	ErrSlackActionInvalid       = errors.New("slack action is not one auto-devs sent")
+	ErrNotificationTypeInvalid  = errors.New("notification type cannot be muted on slack")
+	ErrSlackInteractivityOff    = errors.New("slack interactivity is turned off for this project")
+	errSlackPlanReplaced        = errors.New("this plan was replaced or already reviewed")
+	errSlackPlanAIUnknown       = errors.New("the AI that wrote this plan is unknown; approve it in auto-devs")
+)
+
+type slackUsecase struct {
+	projectAccess
+	slackRepo      repository.SlackIntegrationRepository
+	preferenceRepo repository.NotificationPreferenceRepository
+	projectRepo    repository.ProjectRepository
+	taskRepo       repository.TaskRepository
+	planRepo       repository.PlanRepository
+	executionRepo  repository.ExecutionRepository
+	taskUsecase    TaskUsecase
+	secretStore    secrets.Store
+	client         slack.Client
+}
+
+func NewSlackUsecase(
+	slackRepo repository.SlackIntegrationRepository,
+	preferenceRepo repository.NotificationPreferenceRepository,
+	projectRepo repository.ProjectRepository,
+	taskRepo repository.TaskRepository,
+	planRepo repository.PlanRepository,
+	executionRepo repository.ExecutionRepository,
+	memberRepo repository.ProjectMemberRepository,
+	taskUsecase TaskUsecase,
+	secretStore secrets.Store,
+	client slack.Client,
+) SlackUsecase {
+	return &slackUsecase{
+		projectAccess:  projectAccess{memberRepo: memberRepo},
+		slackRepo:      slackRepo,
+		preferenceRepo: preferenceRepo,
+		projectRepo:    projectRepo,
+		taskRepo:       taskRepo,
+		planRepo:       planRepo,
+		executionRepo:  executionRepo,
+		taskUsecase:    taskUsecase,
+		secretStore:    secretStore,
+		client:         client,
+	}
+}
+
+func (u *slackUsecase) Get(ctx context.Context, projectID uuid.UUID) (*entity.SlackIntegration, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	integration, err := u.slackRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if integration == nil {
+		return nil, ErrSlackNotConfigured
+	}
+	return integration, nil
+}
+
+func (u *slackUsecase) Configure(ctx context.Context, projectID uuid.UUID, req ConfigureSlackRequest) (*entity.SlackIntegration, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	if req.WebhookURL != nil && req.BotToken != nil {
+		return nil, ErrSlackCredentialsConflict
+	}
+
+	integration, err := u.slackRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if integration == nil {
+		if req.WebhookURL == nil && req.BotToken == nil {
+			return nil, ErrSlackCredentialsRequired
+		}
+		integration = &entity.SlackIntegration{ProjectID: projectID}
+	}
+
+	// The credentials of the other delivery are dropped on a switch
+	var secretName, secretValue, staleSecret string
+	switch {
+	case req.WebhookURL != nil:
+		webhookURL := strings.TrimSpace(*req.WebhookURL)
+		if parsed, err := url.Parse(webhookURL); err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return nil, ErrSlackWebhookURLInvalid
+		}
+		integration.Delivery = entity.SlackDeliveryWebhook
+		secretName, secretValue, staleSecret = entity.SlackWebhookURLSecret, webhookURL, entity.SlackBotTokenSecret
+	case req.BotToken != nil:
+		token := strings.TrimSpace(*req.BotToken)
+		if token == "" {
+			return nil, ErrSlackCredentialsRequired
+		}
+		integration.Delivery = entity.SlackDeliveryBot
+		secretName, secretValue, staleSecret = entity.SlackBotTokenSecret, token, entity.SlackWebhookURLSecret
+	}
+
+	integration.Channel = strings.TrimSpace(req.Channel)
+	if integration.Delivery == entity.SlackDeliveryBot && integration.Channel == "" {
+		return nil, ErrSlackChannelRequired
+	}
+	integration.Interactive = req.Interactive
+
+	if secretName != "" {
+		if err := u.secretStore.Put(ctx, projectID, secretName, secretValue); err != nil {
+			return nil, fmt.Errorf("failed to store slack credentials: %w", err)
+		}
+		if err := u.secretStore.Delete(ctx, projectID, staleSecret); err != nil {
+			return nil, fmt.Errorf("failed to remove previous slack credentials: %w", err)
+		}
+	}
+	if err := u.slackRepo.Save(ctx, integration); err != nil {
+		return nil, fmt.Errorf("failed to save slack integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+func (u *slackUsecase) Delete(ctx context.Context, projectID uuid.UUID) error {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+	if _, err := u.Get(ctx, projectID); err != nil {
+		return err
+	}
+	if err := u.slackRepo.Delete(ctx, projectID); err != nil {
+		return err
+	}
+	if err := u.secretStore.Delete(ctx, projectID, entity.SlackWebhookURLSecret); err != nil {
+		return err
+	}
+	return u.secretStore.Delete(ctx, projectID, entity.SlackBotTokenSecret)
+}
+
+func (u *slackUsecase) ListPreferences(ctx context.Context, projectID uuid.UUID) ([]*entity.NotificationPreference, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	stored, err := u.preferenceRepo.ListByProjectID(ctx, projectID, entity.NotificationChannelSlack)
+	if err != nil {
+		return nil, err
+	}
+
+	// Types without a stored preference are delivered
+	preferences := make([]*entity.NotificationPreference, 0, len(entity.SlackNotificationTypes))
+	for _, notificationType := range entity.SlackNotificationTypes {
+		preference := &entity.NotificationPreference{ProjectID: projectID, Channel: entity.NotificationChannelSlack, Type: notificationType}
+		for _, s := range stored {
+			if s.Type == notificationType {
+				preference = s
+				break
+			}
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, nil
+}
+
+func (u *slackUsecase) UpdatePreferences(ctx context.Context, projectID uuid.UUID, muted map[entity.NotificationType]bool) ([]*entity.NotificationPreference, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	for notificationType := range muted {
+		if !slices.Contains(entity.SlackNotificationTypes, notificationType) {
+			return nil, fmt.Errorf("%w: %s", ErrNotificationTypeInvalid, notificationType)
+		}
+	}
+
+	var updatedBy *string
+	if actor := repository.ActorFromContext(ctx); actor != "" {
+		updatedBy = &actor
+	}
+	for _, notificationType := range entity.SlackNotificationTypes {
+		isMuted, ok := muted[notificationType]
+		if !ok {
+			continue
+		}
+		if err := u.preferenceRepo.Save(ctx, &entity.NotificationPreference{
+			ProjectID: projectID,
+			Channel:   entity.NotificationChannelSlack,
+			Type:      notificationType,
+			Muted:     isMuted,
+			UpdatedBy: updatedBy,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return u.ListPreferences(ctx, projectID)
+}
+
+func (u *slackUsecase) HandleInteraction(ctx context.Context, interaction SlackInteraction) error {
+	if interaction.ActionID != SlackActionApprovePlan && interaction.ActionID != SlackActionRejectPlan {
+		return ErrSlackActionInvalid
+	}
+	taskPart, planPart, _ := strings.Cut(interaction.Value, "/")
+	taskID, err := uuid.Parse(taskPart)
+	if err != nil {
+		return ErrSlackActionInvalid
+	}
+	planID, err := uuid.Parse(planPart)
+	if err != nil {
+		return ErrSlackActionInvalid
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	integration, err := u.slackRepo.GetByProjectID(ctx, task.ProjectID)
+	if err != nil {
+		return err
+	}
+	if integration == nil {
+		return ErrSlackNotConfigured
+	}
+	if !integration.Interactive {
+		return ErrSlackInteractivityOff
+	}
+
+	// Changes are recorded as made by the Slack user
+	reviewer := "slack:" + interaction.UserName
+	ctx = repository.WithActor(ctx, reviewer)
+	var outcome string
+	if interaction.ActionID == SlackActionApprovePlan {
+		outcome, err = u.approvePlan(ctx, task, planID, reviewer)
+	} else {
+		outcome, err = u.rejectPlan(ctx, task, planID, reviewer)
+	}
+	if err != nil {
+		outcome = fmt.Sprintf(":x: @%s could not review the plan: %s", interaction.UserName, err)
+	}
+
+	if err := u.respond(ctx, interaction, outcome); err != nil {
+		slog.Warn("Failed to update the Slack message of a plan review", "task_id", task.ID, "error", err)
+	}
+	return nil
+}
+
+// approvePlan approves the plan the message was posted for and starts its
+// implementation with the AI that wrote it, or records the approval when
+// the plan needs another one
+func (u *slackUsecase) approvePlan(ctx context.Context, task *entity.Task, planID uuid.UUID, reviewer string) (string, error) {
+	if err := u.checkPlanUnderReview(ctx, task, planID); err != nil {
+		return "", err
+	}
+	aiType, err := u.planningAIType(ctx, task.ID)
+	if err != nil {
+		return "", err
+	}
+	approved, err := u.taskUsecase.RecordPlanApproval(ctx, task.ID, PlanApprovalRequest{Approver: reviewer})
+	if err != nil {
+		return "", err
+	}
+	user := strings.TrimPrefix(reviewer, "slack:")
+	if !approved {
+		return fmt.Sprintf(":hourglass: Approval by @%s recorded; this high-risk plan needs another approval before implementation starts", user), nil
+	}
+	if _, err := u.taskUsecase.ApprovePlan(ctx, task.ID, aiType, nil); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(":white_check_mark: Plan approved by @%s; implementation started", user), nil
+}
+
+// rejectPlan sends the task back to TODO
+func (u *slackUsecase) rejectPlan(ctx context.Context, task *entity.Task, planID uuid.UUID, reviewer string) (string, error) {
+	if err := u.checkPlanUnderReview(ctx, task, planID); err != nil {
+		return "", err
+	}
+	reason := "Plan rejected in Slack"
+	if _, err := u.taskUsecase.RejectPlan(ctx, task.ID, &reviewer, &reason); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(":no_entry: Plan rejected by @%s", strings.TrimPrefix(reviewer, "slack:")), nil
+}
+
+// checkPlanUnderReview makes sure a button acts on the plan its message was
+// posted for, which must still be under review
+func (u *slackUsecase) checkPlanUnderReview(ctx context.Context, task *entity.Task, planID uuid.UUID) error {
+	if task.Status != entity.TaskStatusPLANREVIEWING {
+		return errSlackPlanReplaced
+	}
+	plan, err := u.planRepo.GetLatestByTaskID(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPlanNotFound, err)
+	}
+	if plan.ID != planID {
+		return errSlackPlanReplaced
+	}
+	return nil
+}
+
+// planningAIType is the AI of the task's latest planning run
+func (u *slackUsecase) planningAIType(ctx context.Context, taskID uuid.UUID) (string, error) {
+	executions, err := u.executionRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+	for _, execution := range executions {
+		if execution.Phase == entity.ExecutionPhasePlanning && execution.AIType != "" {
+			return execution.AIType, nil
+		}
+	}
+	return "", errSlackPlanAIUnknown
+}
+
+// respond replaces the buttons of the interaction's message with outcome
+func (u *slackUsecase) respond(ctx context.Context, interaction SlackInteraction, outcome string) error {
+	if interaction.ResponseURL == "" {
+		return nil
+	}
+	blocks := slices.DeleteFunc(slices.Clone(interaction.Blocks), func(block slack.Block) bool {
+		return block.Type == "actions"
+	})
+	return u.client.PostWebhook(ctx, interaction.ResponseURL, slack.Message{
+		Text:            outcome,
+		Blocks:          append(blocks, slack.Section(outcome)),
+		ReplaceOriginal: true,
+	})
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/slack"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type slackPost struct {
+	target string
+	msg    slack.Message
+}
+
+type fakeSlackClient struct {
+	posts []slackPost
+}
+
+func (f *fakeSlackClient) PostWebhook(ctx context.Context, webhookURL string, msg slack.Message) error {
+	f.posts = append(f.posts, slackPost{target: webhookURL, msg: msg})
+	return nil
+}
+
+func (f *fakeSlackClient) PostMessage(ctx context.Context, botToken string, msg slack.Message) error {
+	f.posts = append(f.posts, slackPost{target: botToken, msg: msg})
+	return nil
+}
+
+func TestSlackNotificationHandler_Deliver(t *testing.T) {
+	projectID, taskID, planID := uuid.New(), uuid.New(), uuid.New()
+	slackRepo := repository.NewSlackIntegrationRepositoryMock(t)
+	preferenceRepo := repository.NewNotificationPreferenceRepositoryMock(t)
+	store := fakeSecretStore{}
+	require.NoError(t, store.Put(context.Background(), projectID, entity.SlackWebhookURLSecret, "https://hooks.slack.com/services/T1/B1/x"))
+	client := &fakeSlackClient{}
+	handler := NewSlackNotificationHandler(slackRepo, preferenceRepo, store, client, "https://auto-devs.acme.com/")
+
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(&entity.SlackIntegration{ProjectID: projectID, Delivery: entity.SlackDeliveryWebhook, Interactive: true}, nil)
+	preferenceRepo.EXPECT().IsMuted(mock.Anything, projectID, entity.NotificationChannelSlack, entity.NotificationTypePlanReady).Return(false, nil).Once()
+	preferenceRepo.EXPECT().IsMuted(mock.Anything, projectID, entity.NotificationChannelSlack, entity.NotificationTypeTaskCreated).Return(true, nil).Once()
+
+	notifications := NewNotificationUsecase()
+	require.NoError(t, notifications.RegisterHandler(entity.NotificationTypePlanReady, deliverNow{handler}))
+	require.NoError(t, notifications.RegisterHandler(entity.NotificationTypeTaskCreated, deliverNow{handler}))
+	task := &entity.Task{ID: taskID, ProjectID: projectID, Number: 7, Title: "Fix <login>"}
+	project := &entity.Project{ID: projectID, Name: "Shop"}
+
+	require.NoError(t, notifications.SendPlanReadyNotification(context.Background(), task, project, &entity.Plan{ID: planID, Content: "1. Refresh tokens"}))
+	// Muted types are not posted
+	require.NoError(t, notifications.SendTaskCreatedNotification(context.Background(), task, project))
+
+	require.Len(t, client.posts, 1)
+	post := client.posts[0]
+	assert.Equal(t, "https://hooks.slack.com/services/T1/B1/x", post.target)
+	assert.Equal(t, "The plan for task 'Fix <login>' in project 'Shop' is ready for review", post.msg.Text)
+	taskURL := "https://auto-devs.acme.com/projects/" + projectID.String() + "/tasks/" + taskID.String()
+	assert.Equal(t, []slack.Block{
+		slack.Section("*<" + taskURL + "|AD-7>* The plan for task 'Fix &lt;login&gt;' in project 'Shop' is ready for review"),
+		slack.Section("1. Refresh tokens"),
+		slack.Actions("PLAN_READY",
+			slack.Button("Approve", SlackActionApprovePlan, taskID.String()+"/"+planID.String(), "primary"),
+			slack.Button("Reject", SlackActionRejectPlan, taskID.String()+"/"+planID.String(), "danger"),
+			slack.LinkButton("Open task", slackActionOpenTask, taskURL),
+		),
+	}, post.msg.Blocks)
+}
+
+func TestSlackNotificationHandler_DeliverAsBot(t *testing.T) {
+	projectID := uuid.New()
+	slackRepo := repository.NewSlackIntegrationRepositoryMock(t)
+	preferenceRepo := repository.NewNotificationPreferenceRepositoryMock(t)
+	store := fakeSecretStore{}
+	require.NoError(t, store.Put(context.Background(), projectID, entity.SlackBotTokenSecret, "xoxb-token"))
+	client := &fakeSlackClient{}
+	handler := NewSlackNotificationHandler(slackRepo, preferenceRepo, store, client, "")
+
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(&entity.SlackIntegration{ProjectID: projectID, Delivery: entity.SlackDeliveryBot, Channel: "#shop"}, nil)
+	preferenceRepo.EXPECT().IsMuted(mock.Anything, projectID, entity.NotificationChannelSlack, entity.NotificationTypeExecutionFailed).Return(false, nil)
+
+	require.NoError(t, handler.Deliver(context.Background(), entity.NotificationEvent{
+		Type:      entity.NotificationTypeExecutionFailed,
+		ProjectID: projectID,
+		Message:   "The planning of task 'Login' in project 'Shop' failed",
+		Data:      map[string]interface{}{"task_key": "AD-3", "error": "exit status 1"},
+	}))
+
+	require.Len(t, client.posts, 1)
+	assert.Equal(t, "xoxb-token", client.posts[0].target)
+	assert.Equal(t, "#shop", client.posts[0].msg.Channel)
+	assert.Equal(t, []slack.Block{
+		slack.Section("*AD-3* The planning of task 'Login' in project 'Shop' failed"),
+		slack.Section("```exit status 1```"),
+	}, client.posts[0].msg.Blocks)
+}
+
+// deliverNow posts notifications synchronously, for the test to see them
+type deliverNow struct {
+	handler *SlackNotificationHandler
+}
+
+func (d deliverNow) HandleNotification(event entity.NotificationEvent) error {
+	return d.handler.Deliver(context.Background(), event)
+}
+
+func TestSlackConfigure(t *testing.T) {
+	projectID := uuid.New()
+	slackRepo := repository.NewSlackIntegrationRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	store := fakeSecretStore{}
+	uc := NewSlackUsecase(slackRepo, nil, projectRepo, nil, nil, nil, nil, nil, store, nil)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+
+	webhookURL, token := "http://hooks.slack.com/services/x", "xoxb-token"
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(nil, nil).Times(3)
+	_, err := uc.Configure(context.Background(), projectID, ConfigureSlackRequest{})
+	assert.ErrorIs(t, err, ErrSlackCredentialsRequired)
+	_, err = uc.Configure(context.Background(), projectID, ConfigureSlackRequest{WebhookURL: &webhookURL})
+	assert.ErrorIs(t, err, ErrSlackWebhookURLInvalid)
+	_, err = uc.Configure(context.Background(), projectID, ConfigureSlackRequest{BotToken: &token})
+	assert.ErrorIs(t, err, ErrSlackChannelRequired)
+	_, err = uc.Configure(context.Background(), projectID, ConfigureSlackRequest{WebhookURL: &webhookURL, BotToken: &token})
+	assert.ErrorIs(t, err, ErrSlackCredentialsConflict)
+
+	// Switching from a webhook to a bot drops the webhook URL
+	require.NoError(t, store.Put(context.Background(), projectID, entity.SlackWebhookURLSecret, "https://hooks.slack.com/services/x"))
+	existing := &entity.SlackIntegration{ID: uuid.New(), ProjectID: projectID, Delivery: entity.SlackDeliveryWebhook}
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(existing, nil).Once()
+	slackRepo.EXPECT().Save(mock.Anything, existing).Return(nil).Once()
+
+	integration, err := uc.Configure(context.Background(), projectID, ConfigureSlackRequest{BotToken: &token, Channel: " #shop ", Interactive: true})
+	require.NoError(t, err)
+	assert.Equal(t, entity.SlackDeliveryBot, integration.Delivery)
+	assert.Equal(t, "#shop", integration.Channel)
+	assert.True(t, integration.Interactive)
+	assert.Equal(t, fakeSecretStore{projectID.String() + "/" + entity.SlackBotTokenSecret: "xoxb-token"}, store)
+}
+
+func TestSlackUpdatePreferences(t *testing.T) {
+	projectID := uuid.New()
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	preferenceRepo := repository.NewNotificationPreferenceRepositoryMock(t)
+	uc := NewSlackUsecase(nil, preferenceRepo, projectRepo, nil, nil, nil, nil, nil, nil, nil)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+
+	_, err := uc.UpdatePreferences(context.Background(), projectID, map[entity.NotificationType]bool{entity.NotificationTypeDailyStandup: true})
+	assert.ErrorIs(t, err, ErrNotificationTypeInvalid)
+
+	muted := &entity.NotificationPreference{ProjectID: projectID, Channel: entity.NotificationChannelSlack, Type: entity.NotificationTypeTaskCreated, Muted: true}
+	preferenceRepo.EXPECT().Save(mock.Anything, muted).Return(nil).Once()
+	preferenceRepo.EXPECT().ListByProjectID(mock.Anything, projectID, entity.NotificationChannelSlack).Return([]*entity.NotificationPreference{muted}, nil).Once()
+
+	preferences, err := uc.UpdatePreferences(context.Background(), projectID, map[entity.NotificationType]bool{entity.NotificationTypeTaskCreated: true})
+	require.NoError(t, err)
+	require.Len(t, preferences, len(entity.SlackNotificationTypes))
+	assert.Same(t, muted, preferences[0])
+	assert.Equal(t, entity.NotificationTypePlanReady, preferences[1].Type)
+	assert.False(t, preferences[1].Muted)
+}
+
+func TestSlackHandleInteraction_ApprovesPlan(t *testing.T) {
+	projectID, taskID, planID := uuid.New(), uuid.New(), uuid.New()
+	slackRepo := repository.NewSlackIntegrationRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	planRepo := repository.NewPlanRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	taskUsecase := NewTaskUsecaseMock(t)
+	client := &fakeSlackClient{}
+	uc := NewSlackUsecase(slackRepo, nil, nil, taskRepo, planRepo, executionRepo, nil, taskUsecase, nil, client)
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, ProjectID: projectID, Status: entity.TaskStatusPLANREVIEWING}, nil)
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(&entity.SlackIntegration{ProjectID: projectID, Interactive: true}, nil)
+	planRepo.EXPECT().GetLatestByTaskID(mock.Anything, taskID).Return(&entity.Plan{ID: planID}, nil)
+	executionRepo.EXPECT().GetByTaskID(mock.Anything, taskID).Return([]*entity.Execution{
+		{Phase: entity.ExecutionPhaseImplementation, AIType: "cursor-agent"},
+		{Phase: entity.ExecutionPhasePlanning, AIType: "claude-code"},
+	}, nil)
+	taskUsecase.EXPECT().RecordPlanApproval(mock.Anything, taskID, PlanApprovalRequest{Approver: "slack:ada"}).Return(true, nil).Once()
+	taskUsecase.EXPECT().ApprovePlan(mock.MatchedBy(func(ctx context.Context) bool {
+		return repository.ActorFromContext(ctx) == "slack:ada"
+	}), taskID, "claude-code", (*time.Time)(nil)).Return("job-1", nil).Once()
+
+	plan := slack.Section("1. Refresh tokens")
+	err := uc.HandleInteraction(context.Background(), SlackInteraction{
+		ActionID:    SlackActionApprovePlan,
+		Value:       taskID.String() + "/" + planID.String(),
+		UserName:    "ada",
+		ResponseURL: "https://hooks.slack.com/actions/1",
+		Blocks:      []slack.Block{plan, slack.Actions("PLAN_READY", slack.Button("Approve", SlackActionApprovePlan, "x", "primary"))},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, client.posts, 1)
+	assert.Equal(t, "https://hooks.slack.com/actions/1", client.posts[0].target)
+	assert.True(t, client.posts[0].msg.ReplaceOriginal)
+	assert.Equal(t, []slack.Block{plan, slack.Section(":white_check_mark: Plan approved by @ada; implementation started")}, client.posts[0].msg.Blocks)
+}
+
+func TestSlackHandleInteraction_ReplacedPlan(t *testing.T) {
+	projectID, taskID := uuid.New(), uuid.New()
+	slackRepo := repository.NewSlackIntegrationRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	planRepo := repository.NewPlanRepositoryMock(t)
+	client := &fakeSlackClient{}
+	uc := NewSlackUsecase(slackRepo, nil, nil, taskRepo, planRepo, nil, nil, nil, nil, client)
+
+	assert.ErrorIs(t, uc.HandleInteraction(context.Background(), SlackInteraction{ActionID: "delete_everything"}), ErrSlackActionInvalid)
+	assert.ErrorIs(t, uc.HandleInteraction(context.Background(), SlackInteraction{ActionID: SlackActionRejectPlan, Value: "nope"}), ErrSlackActionInvalid)
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, ProjectID: projectID, Status: entity.TaskStatusPLANREVIEWING}, nil)
+	slackRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(&entity.SlackIntegration{ProjectID: projectID, Interactive: true}, nil)
+	// A newer plan is under review; the task is left as it is
+	planRepo.EXPECT().GetLatestByTaskID(mock.Anything, taskID).Return(&entity.Plan{ID: uuid.New()}, nil)
+
+	require.NoError(t, uc.HandleInteraction(context.Background(), SlackInteraction{
+		ActionID:    SlackActionRejectPlan,
+		Value:       taskID.String() + "/" + uuid.NewString(),
+		UserName:    "ada",
+		ResponseURL: "https://hooks.slack.com/actions/2",
+	}))
+
+	require.Len(t, client.posts, 1)
+	assert.Equal(t, ":x: @ada could not review the plan: this plan was replaced or already reviewed", client.posts[0].msg.Text)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -13,15 +14,36 @@ import (
 	"github.com/auto-devs/auto-devs/internal/repository"
 	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/auto-devs/auto-devs/internal/service/github"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
 	"github.com/google/uuid"
 )
 
+// ErrJobQueueUnavailable is returned when a job could not be enqueued
+// because the job queue cannot be reached, after retrying
+var ErrJobQueueUnavailable = errors.New("job queue is unavailable")
+
 // JobClientInterface defines the interface for job client operations
 type JobClientInterface interface {
 	EnqueueTaskPlanning(payload *TaskPlanningPayload, delay time.Duration) (string, error)
 	EnqueueTaskImplementation(payload *TaskImplementationPayload, delay time.Duration) (string, error)
+	EnqueueTaskComparison(payload *TaskComparisonPayload, delay time.Duration) (string, error)
+	EnqueueComparisonSelect(payload *ComparisonSelectPayload) (string, error)
 	EnqueueWorktreeCreate(payload *WorktreeCreatePayload, delay time.Duration) (string, error)
 	EnqueueKanbanNotify(payload *KanbanNotifyPayload) (string, error)
+	EnqueueJiraSync(payload *JiraSyncPayload) (string, error)
+	EnqueuePreviewStop(payload *PreviewStopPayload) (string, error)
+	EnqueueReleaseNotes(payload *ReleaseNotesPayload) (string, error)
+	EnqueueExecutionCancel(payload *ExecutionCancelPayload) (string, error)
+	EnqueueWebhookDelivery(payload *WebhookDeliveryPayload) (string, error)
+	EnqueuePRSync(payload *PRSyncPayload) (string, error)
+	// PlanningQueueDepth returns how many planning jobs wait to be run
+	PlanningQueueDepth() (int, error)
+	// ScheduledJobs returns the task's planning and implementation jobs
+	// waiting for their start time
+	ScheduledJobs(taskID uuid.UUID) ([]ScheduledJob, error)
+	// CancelScheduledJob deletes one of the task's scheduled jobs, or
+	// returns ErrScheduledJobNotFound
+	CancelScheduledJob(taskID uuid.UUID, jobID string) error
 }
 
 // TaskPlanningPayload represents the payload for task planning jobs
@@ -32,6 +54,18 @@ type TaskPlanningPayload struct {
 	AIType          string    `json:"ai_type"`
 	AutoImplement   bool      `json:"auto_implement"`
 	UseRemoteBranch bool      `json:"use_remote_branch"`
+	// Scheduled jobs were delayed until a start time, and are skipped when
+	// the task has moved on by then
+	Scheduled bool `json:"scheduled,omitempty"`
+	// Feedback is the reviewer's reason for rejecting the previous plan,
+	// which the new plan addresses
+	Feedback string `json:"feedback,omitempty"`
+	// RetryOfID is the failed execution the job retries as the given
+	// Attempt, and the job is skipped when the task has moved on by then
+	RetryOfID *uuid.UUID `json:"retry_of_id,omitempty"`
+	Attempt   int        `json:"attempt,omitempty"`
+	// TraceContext continues the trace of the request enqueueing the job
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 // TaskImplementationPayload represents the payload for task implementation jobs
@@ -40,6 +74,39 @@ type TaskImplementationPayload struct {
 	ProjectID       uuid.UUID `json:"project_id"`
 	AIType          string    `json:"ai_type"`
 	UseRemoteBranch bool      `json:"use_remote_branch"`
+	// ChangeRequest is the reviewer feedback a follow-up implementation of
+	// a task in code review addresses, empty for a first implementation
+	ChangeRequest string `json:"change_request,omitempty"`
+	// ResumeExecutionID is the execution waiting for input that the job
+	// resumes with Answer, the user's answer to its question
+	ResumeExecutionID *uuid.UUID `json:"resume_execution_id,omitempty"`
+	Answer            string     `json:"answer,omitempty"`
+	Scheduled         bool       `json:"scheduled,omitempty"`
+	// DryRun runs the executor in a throwaway worktree and attaches the diff
+	// it produced to the task; nothing is committed or pushed and the task
+	// keeps its status
+	DryRun bool `json:"dry_run,omitempty"`
+	// RetryOfID is the failed execution the job retries as the given
+	// Attempt, and the job is skipped when the task has moved on by then
+	RetryOfID *uuid.UUID `json:"retry_of_id,omitempty"`
+	Attempt   int        `json:"attempt,omitempty"`
+	// TraceContext continues the trace of the request enqueueing the job
+	TraceContext map[string]string `json:"trace_context,omitempty"`
+}
+
+// TaskComparisonPayload represents the payload for executor comparison jobs
+type TaskComparisonPayload struct {
+	TaskID    uuid.UUID `json:"task_id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	AITypes   []string  `json:"ai_types"`
+}
+
+// ComparisonSelectPayload represents the payload for jobs continuing the
+// winning variant of an executor comparison
+type ComparisonSelectPayload struct {
+	TaskID      uuid.UUID `json:"task_id"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	ExecutionID uuid.UUID `json:"execution_id"`
 }
 
 // KanbanNotifyPayload represents the payload for Hermes kanban callback jobs
@@ -50,6 +117,42 @@ type KanbanNotifyPayload struct {
 	NewStatus    entity.TaskStatus `json:"new_status"`
 }
 
+// JiraSyncPayload represents the payload for Jira status sync jobs
+type JiraSyncPayload struct {
+	TaskID    uuid.UUID         `json:"task_id"`
+	NewStatus entity.TaskStatus `json:"new_status"`
+}
+
+// PreviewStopPayload represents the payload for preview teardown jobs
+type PreviewStopPayload struct {
+	TaskID uuid.UUID `json:"task_id"`
+}
+
+// ReleaseNotesPayload represents the payload for release notes generation jobs
+type ReleaseNotesPayload struct {
+	ReleaseNotesID uuid.UUID `json:"release_notes_id"`
+}
+
+// ExecutionCancelPayload represents the payload for execution cancellation
+// jobs
+type ExecutionCancelPayload struct {
+	ExecutionID uuid.UUID `json:"execution_id"`
+	// Worker runs the execution's AI CLI process, empty when any worker can
+	// handle the cancellation
+	Worker string `json:"worker,omitempty"`
+}
+
+// WebhookDeliveryPayload represents the payload for jobs posting a delivery
+// of a project webhook
+type WebhookDeliveryPayload struct {
+	DeliveryID uuid.UUID `json:"delivery_id"`
+}
+
+// PRSyncPayload represents the payload for jobs syncing a single PR
+type PRSyncPayload struct {
+	PullRequestID uuid.UUID `json:"pull_request_id"`
+}
+
 // WorktreeCreatePayload represents the payload for worktree creation jobs
 type WorktreeCreatePayload struct {
 	WorktreeID      uuid.UUID `json:"worktree_id"`
@@ -66,14 +169,51 @@ type TaskUsecase interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Task, error)
 	Update(ctx context.Context, id uuid.UUID, req UpdateTaskRequest) (*entity.Task, error)
 	UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TaskStatus) (*entity.Task, error)
+	// UpdateStatusBy is UpdateStatus recording who made the change and why
+	// in the status history. The transition must be valid.
+	UpdateStatusBy(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy, reason *string) (*entity.Task, error)
 	UpdateStatusWithHistory(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByStatus(ctx context.Context, status entity.TaskStatus) ([]*entity.Task, error)
 	GetByStatuses(ctx context.Context, statuses []entity.TaskStatus) ([]*entity.Task, error)
 	GetWithProject(ctx context.Context, id uuid.UUID) (*entity.Task, error)
+	// GetDetail returns the task with its latest plan, execution and pull
+	// request and its comments, for its detail view
+	GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error)
 	BulkUpdateStatus(ctx context.Context, req BulkUpdateStatusRequest) error
 	GetStatusHistory(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskStatusHistory, error)
 	GetStatusAnalytics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatusAnalytics, error)
+	// GetCycleTimeAnalytics measures the lead and cycle times of the
+	// project's tasks completed between from and to, either of which may be nil
+	GetCycleTimeAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*CycleTimeAnalytics, error)
+	// GetExecutorAnalytics compares the executors that ran the project's
+	// tasks on success rate, duration, fix attempts and pull request merges
+	GetExecutorAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*ExecutorAnalytics, error)
+	// RollupVelocity recomputes the weekly velocity of every project over the
+	// given number of weeks up to the current one
+	RollupVelocity(ctx context.Context, weeks int) error
+	// GetWeeklyVelocity returns the project's rolled up velocity over the
+	// given number of weeks up to the current one
+	GetWeeklyVelocity(ctx context.Context, projectID uuid.UUID, weeks int) (*VelocityReport, error)
+	// CollectStandupReports reports what happened over [from, to) in each
+	// project with notifications on, for its daily standup
+	CollectStandupReports(ctx context.Context, from, to time.Time) ([]*entity.StandupReport, error)
+	// SendStandup delivers the summary written from a standup report
+	SendStandup(ctx context.Context, report *entity.StandupReport, summary string) error
+	// GetPlanReviewAnalytics measures how long the project's plans waited
+	// for review, per project and per reviewer, over the reviews decided
+	// between from and to, either of which may be nil
+	GetPlanReviewAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*PlanReviewAnalytics, error)
+	// GetPullRequestAnalytics reports the merge rate and time to merge of the
+	// project's pull requests opened between from and to, either of which may
+	// be nil, broken down by period unless period is empty
+	GetPullRequestAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time, period AnalyticsPeriod) (*PullRequestAnalytics, error)
+	// GetWorkload reports the project's open tasks, their estimated hours
+	// and the AI runs in flight on them by assignee
+	GetWorkload(ctx context.Context, projectID uuid.UUID) (*WorkloadReport, error)
+	// GetBoard returns the project's tasks in the columns of its Kanban
+	// board, leaving out the cards of done tasks unless includeDone
+	GetBoard(ctx context.Context, projectID uuid.UUID, includeDone bool, epicID *uuid.UUID) (*TaskBoard, error)
 	GetTasksWithFilters(ctx context.Context, req GetTasksFilterRequest) ([]*entity.Task, error)
 	ValidateStatusTransition(ctx context.Context, taskID uuid.UUID, newStatus entity.TaskStatus) error
 
@@ -90,9 +230,12 @@ type TaskUsecase interface {
 	UpdateParentTask(ctx context.Context, taskID uuid.UUID, parentTaskID *uuid.UUID) error
 	CreateSubtask(ctx context.Context, parentTaskID uuid.UUID, req CreateTaskRequest) (*entity.Task, error)
 
-	// Bulk operations
-	BulkDelete(ctx context.Context, taskIDs []uuid.UUID) error
-	BulkArchive(ctx context.Context, taskIDs []uuid.UUID) error
+	// Bulk operations. BulkDelete and BulkArchive change the given tasks of
+	// the project and return the operation that Undo reverts with its token
+	// until it expires.
+	BulkDelete(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error)
+	BulkArchive(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error)
+	Undo(ctx context.Context, token string) (*entity.UndoOperation, error)
 	BulkUnarchive(ctx context.Context, taskIDs []uuid.UUID) error
 	BulkUpdatePriority(ctx context.Context, taskIDs []uuid.UUID, priority entity.TaskPriority) error
 	BulkAssign(ctx context.Context, taskIDs []uuid.UUID, assignedTo string) error
@@ -133,27 +276,113 @@ type TaskUsecase interface {
 	UpdateGitStatus(ctx context.Context, taskID uuid.UUID, gitStatus entity.TaskGitStatus) (*entity.Task, error)
 	ValidateGitStatusTransition(ctx context.Context, taskID uuid.UUID, newGitStatus entity.TaskGitStatus) error
 
+	// Preview environments
+	UpdatePreviewURL(ctx context.Context, taskID uuid.UUID, previewURL *string) (*entity.Task, error)
+
+	// Execution concurrency limits
+	// UpdateQueuePosition records the task's place in the queue of tasks
+	// waiting for one of their project's execution slots, or clears it
+	// when position is nil
+	UpdateQueuePosition(ctx context.Context, taskID uuid.UUID, position *int) (*entity.Task, error)
+
 	// Planning workflow
-	StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool) (string, error) // returns job ID
-	ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string) (string, error)                      // returns job ID
-	StartImplementingDirect(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, useRemoteBranch bool) (string, error) // returns job ID
+	// StartPlanning and ApprovePlan start the job right away when
+	// scheduledAt is nil, and at scheduledAt otherwise
+	StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool, scheduledAt *time.Time) (string, error) // returns job ID
+	ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string, scheduledAt *time.Time) (string, error)                                                                // returns job ID
+	StartImplementingDirect(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, useRemoteBranch bool) (string, error)                                   // returns job ID
+	// ListScheduledJobs returns the task's planning and implementation jobs
+	// waiting for their start time, soonest first
+	ListScheduledJobs(ctx context.Context, taskID uuid.UUID) ([]ScheduledJob, error)
+	// CancelScheduledJob removes one of the task's scheduled jobs
+	CancelScheduledJob(ctx context.Context, taskID uuid.UUID, jobID string) error
+	// CheckPlanningCapacity returns a PlanningOverloadError when new
+	// planning would queue behind too much work
+	CheckPlanningCapacity(ctx context.Context) error
+	// RecordPlanApproval records an approval of the plan of a high-risk
+	// task and reports whether the plan now has the step-up approval
+	// ApprovePlan needs. Other tasks need none and nothing is recorded.
+	RecordPlanApproval(ctx context.Context, taskID uuid.UUID, approval PlanApprovalRequest) (bool, error)
+	// RejectPlan sends a task in plan review back to TODO, recording the
+	// reviewer and the reason, either of which may be nil
+	RejectPlan(ctx context.Context, taskID uuid.UUID, reviewer, reason *string) (*entity.Task, error)
+	// RejectPlanWithFeedback rejects the plan of a task in plan review,
+	// keeping the reviewer's feedback on it, and plans the task again with
+	// the rejected plan and the feedback in the prompt; returns the job ID
+	RejectPlanWithFeedback(ctx context.Context, taskID uuid.UUID, req RejectPlanWithFeedbackRequest) (*entity.Task, string, error)
+	// RecordPlanningIteration counts a planning run of the task, returning
+	// the number of runs so far
+	RecordPlanningIteration(ctx context.Context, taskID uuid.UUID) (int, error)
+	// RequestChanges sends a task in code review back to IMPLEMENTING with
+	// the reviewer's feedback, also saved as a comment. The follow-up runs
+	// in the task's worktree and pushes to its pull request; returns the
+	// job ID.
+	RequestChanges(ctx context.Context, taskID uuid.UUID, req RequestChangesRequest) (*entity.Task, string, error)
+	// AnswerQuestion answers the question an execution waits on, also saved
+	// as a comment, and resumes the execution with the answer; returns the
+	// job ID
+	AnswerQuestion(ctx context.Context, executionID uuid.UUID, req AnswerQuestionRequest) (*entity.Execution, string, error)
+	// CancelExecution enqueues the job stopping an unfinished planning or
+	// implementation execution, returning the execution and the job ID
+	CancelExecution(ctx context.Context, executionID uuid.UUID) (*entity.Execution, string, error)
+	// RetryExecution enqueues, after delay, the job running a failed
+	// planning or implementation execution again as its next attempt;
+	// returns the execution and the job ID
+	RetryExecution(ctx context.Context, executionID uuid.UUID, delay time.Duration) (*entity.Execution, string, error)
 	ListGitBranches(ctx context.Context, projectID uuid.UUID) ([]GitBranch, error)
 
+	// Executor comparison
+	// StartComparison runs the task once per executor in aiTypes, side by
+	// side in worktrees of their own; returns the job ID
+	StartComparison(ctx context.Context, taskID uuid.UUID, branchName string, aiTypes []string) (string, error)
+	// GetComparison returns the task's latest executor comparison with the
+	// changes each variant made
+	GetComparison(ctx context.Context, taskID uuid.UUID) (*ExecutorComparison, error)
+	// SelectComparisonWinner keeps the variant's worktree as the task worktree
+	// and carries it on toward a pull request; returns the job ID
+	SelectComparisonWinner(ctx context.Context, taskID uuid.UUID, variant string) (string, error)
+
+	// StartDryRun runs an implementation of the task in a throwaway worktree
+	// and attaches its diff to the task for preview; returns the job ID
+	StartDryRun(ctx context.Context, taskID uuid.UUID, aiType string) (string, error)
+
 	// Pull requests
 	GetPullRequest(ctx context.Context, taskID uuid.UUID) (*entity.PullRequest, error)
 	CreatePullRequest(ctx context.Context, taskID uuid.UUID) (*entity.PullRequest, error)
 
 	// Plans
 	GetPlansByTaskID(ctx context.Context, taskID uuid.UUID) ([]entity.Plan, error)
-	UpdateTaskPlan(ctx context.Context, taskID uuid.UUID, planID uuid.UUID, req UpdateTaskPlanRequest) (*entity.Plan, error)
 	// Open with Cursor
 	OpenWithCursor(ctx context.Context, taskID uuid.UUID, worktreePath string) error
 
 	// Worktree cleanup
 	GetTasksEligibleForWorktreeCleanup(ctx context.Context, cutoffTime time.Time) ([]*entity.Task, error)
+	GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error)
 
 	// Git diff
 	GetTaskDiff(ctx context.Context, taskID uuid.UUID) (string, error)
+	// GetTaskChanges returns the task's changes parsed file by file, read
+	// from its worktree or its pull request; see TaskChangesSource
+	GetTaskChanges(ctx context.Context, taskID uuid.UUID, source string) (*TaskChanges, error)
+	// GetWorktreeStatus returns the live git state of the task's worktree
+	GetWorktreeStatus(ctx context.Context, taskID uuid.UUID) (*TaskWorktreeStatus, error)
+	// RecreateWorktree checks the task branch out again in a worktree whose
+	// directory was removed
+	RecreateWorktree(ctx context.Context, taskID uuid.UUID) (*entity.Task, error)
+	// ListWorktreeSnapshots returns the snapshots taken of the task's worktree
+	// before implementation and auto-fix runs, newest first
+	ListWorktreeSnapshots(ctx context.Context, taskID uuid.UUID) ([]git.Snapshot, error)
+	// RestoreWorktreeSnapshot reverts the task's worktree to a snapshot
+	RestoreWorktreeSnapshot(ctx context.Context, taskID uuid.UUID, snapshotID string) error
+	// ListGitOperations returns the audit trail of the git operations done
+	// for the task, oldest first
+	ListGitOperations(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error)
+	// ListWorktreeFiles lists a directory of the task's worktree, given
+	// relative to its root
+	ListWorktreeFiles(ctx context.Context, taskID uuid.UUID, dir string) ([]WorktreeFileEntry, error)
+	// ReadWorktreeFile reads a file of the task's worktree, given relative to
+	// its root
+	ReadWorktreeFile(ctx context.Context, taskID uuid.UUID, path string) (*WorktreeFileContent, error)
 
 	// Error logs
 	AppendErrorLog(ctx context.Context, taskID uuid.UUID, errorMsg string) error
@@ -172,6 +401,10 @@ type CreateTaskRequest struct {
 	BranchName     *string             `json:"branch_name"`
 	PullRequest    *string             `json:"pull_request"`
 	KanbanTaskID   *string             `json:"kanban_task_id"`
+	// EpicID groups the task under an epic of the same project
+	EpicID *uuid.UUID `json:"epic_id"`
+	// WorkflowType selects the project's workflow preset for the task
+	WorkflowType entity.WorkflowType `json:"workflow_type"`
 }
 
 type UpdateTaskRequest struct {
@@ -188,10 +421,105 @@ type UpdateTaskRequest struct {
 	BranchName     *string              `json:"branch_name"`
 	PullRequest    *string              `json:"pull_request"`
 	WorktreePath   *string              `json:"worktree_path"`
+	// HighRiskReason marks the task high-risk for the reason given. A
+	// high-risk task stays high-risk.
+	HighRiskReason *string `json:"high_risk_reason"`
+	// EpicID moves the task under an epic of the same project
+	EpicID *uuid.UUID `json:"epic_id"`
+	// ClearFields lists optional fields to reset to their zero value. Zero
+	// values in the fields above mean "leave unchanged", so clearing has to
+	// be requested explicitly.
+	ClearFields []string `json:"clear_fields"`
+}
+
+// Task fields that can be cleared through UpdateTaskRequest.ClearFields
+const (
+	TaskFieldDescription    = "description"
+	TaskFieldAssignedTo     = "assigned_to"
+	TaskFieldDueDate        = "due_date"
+	TaskFieldEstimatedHours = "estimated_hours"
+	TaskFieldActualHours    = "actual_hours"
+	TaskFieldTags           = "tags"
+	TaskFieldEpicID         = "epic_id"
+)
+
+var (
+	ErrTaskFieldNotClearable = errors.New("task field cannot be cleared")
+	ErrTaskFieldConflict     = errors.New("task field cannot be both set and cleared")
+	ErrTaskNotInPlanReview   = errors.New("task must be in PLAN_REVIEWING status to review its plan")
+	ErrTaskNotInCodeReview   = errors.New("task must be in CODE_REVIEWING status to request changes")
+	ErrFeedbackRequired      = errors.New("change request feedback is required")
+	ErrTaskHasNoWorktree     = errors.New("task has no worktree")
+	ErrWorktreeMissing       = errors.New("task worktree directory does not exist")
+	ErrWorktreeExists        = errors.New("task worktree directory already exists")
+	ErrTaskHasNoPullRequest  = errors.New("task has no pull request")
+	ErrChangesSourceInvalid  = errors.New("changes source must be worktree or pr")
+
+	ErrComparisonExecutorsInvalid    = errors.New("an executor comparison needs two executors")
+	ErrTaskNotReadyForComparison     = errors.New("task must be in TODO or PLAN_REVIEWING status to compare executors")
+	ErrComparisonNotFound            = errors.New("task has no executor comparison")
+	ErrComparisonVariantNotFound     = errors.New("comparison variant not found")
+	ErrComparisonVariantNotCompleted = errors.New("comparison variant has not completed")
+
+	ErrExecutionNotFound        = errors.New("execution not found")
+	ErrExecutionNotWaitingInput = errors.New("execution must be in WAITING_INPUT status to answer its question")
+	ErrAnswerRequired           = errors.New("answer is required")
+	ErrExecutionNotCancellable  = errors.New("execution cannot be cancelled")
+	ErrExecutionNotRetryable    = errors.New("execution cannot be retried")
+)
+
+// ExecutorComparison is a task run by two executors side by side
+type ExecutorComparison struct {
+	ID             uuid.UUID
+	TaskID         uuid.UUID
+	BaseBranchName string
+	Variants       []*ComparisonVariant
+}
+
+// ComparisonVariant is one side of an executor comparison
+type ComparisonVariant struct {
+	Execution *entity.Execution
+	// Diff holds the variant's changes against the base branch, once its
+	// execution has completed
+	Diff string
+	// Selected is set once the variant's worktree became the task worktree
+	Selected bool
+}
+
+// ComparisonVariantName names the variant run by the i-th executor of a
+// comparison: a, b, ...
+func ComparisonVariantName(i int) string {
+	return string(rune('a' + i))
+}
+
+// ComparisonResultSnapshotID is the worktree snapshot holding what a
+// comparison variant changed
+func ComparisonResultSnapshotID(executionID uuid.UUID) string {
+	return executionID.String() + "-result"
+}
+
+// TaskWorktreeStatus is the state of a task's worktree on disk
+type TaskWorktreeStatus struct {
+	TaskID         uuid.UUID
+	WorktreePath   string
+	BaseBranchName string
+	*git.WorktreeStatus
 }
 
-type UpdateTaskPlanRequest struct {
-	Content string `json:"content" binding:"required"`
+// Sources of a task's changes. With no source given, the worktree is read
+// while it is on disk and the pull request otherwise.
+const (
+	TaskChangesSourceWorktree = "worktree"
+	TaskChangesSourcePR       = "pr"
+)
+
+// TaskChanges is what a task changed against its base branch, file by file
+type TaskChanges struct {
+	TaskID uuid.UUID
+	// Source is where the changes were read from, empty when the task has
+	// neither a worktree nor a pull request
+	Source string
+	Files  []git.FileDiff
 }
 
 type UpdateStatusRequest struct {
@@ -260,11 +588,35 @@ type AddCommentRequest struct {
 	CreatedBy string    `json:"created_by" binding:"required"`
 }
 
+// RequestChangesRequest is a reviewer's feedback on a task in code review.
+// AIType is the executor making the changes.
+type RequestChangesRequest struct {
+	Reviewer *string
+	Feedback string
+	AIType   string
+}
+
+// RejectPlanWithFeedbackRequest is a reviewer's feedback on a plan to
+// replace. AIType is the executor planning again.
+type RejectPlanWithFeedbackRequest struct {
+	Reviewer *string
+	Feedback string
+	AIType   string
+}
+
+// AnswerQuestionRequest is the user's answer to the question an execution
+// waits on
+type AnswerQuestionRequest struct {
+	Answerer *string
+	Answer   string
+}
+
 type UpdateCommentRequest struct {
 	Comment string `json:"comment" binding:"required"`
 }
 
 type taskUsecase struct {
+	projectAccess
 	taskRepo            repository.TaskRepository
 	pullRequestRepo     repository.PullRequestRepository
 	projectRepo         repository.ProjectRepository
@@ -274,6 +626,19 @@ type taskUsecase struct {
 	jobClient           JobClientInterface
 	gitManager          *git.GitManager
 	prCreator           *github.PRCreator
+	eventRepo           repository.EventRepository
+	executionRepo       repository.ExecutionRepository
+	velocityRepo        repository.VelocityRollupRepository
+	gitOperationRepo    repository.GitOperationRepository
+	planApprovalRepo    repository.PlanApprovalRepository
+	undoRepo            repository.UndoOperationRepository
+	epicRepo            repository.EpicRepository
+	webhookUsecase      ProjectWebhookUsecase
+	approvalTOTPSecret  string
+	// undoWindow is how long bulk deletes and archives can be undone
+	undoWindow time.Duration
+	// planningLimits shed new planning while the system is overloaded
+	planningLimits PlanningLimits
 }
 
 func NewTaskUsecase(
@@ -286,8 +651,21 @@ func NewTaskUsecase(
 	jobClient JobClientInterface,
 	gitManager *git.GitManager,
 	prCreator *github.PRCreator,
+	eventRepo repository.EventRepository,
+	executionRepo repository.ExecutionRepository,
+	velocityRepo repository.VelocityRollupRepository,
+	gitOperationRepo repository.GitOperationRepository,
+	planApprovalRepo repository.PlanApprovalRepository,
+	undoRepo repository.UndoOperationRepository,
+	epicRepo repository.EpicRepository,
+	memberRepo repository.ProjectMemberRepository,
+	webhookUsecase ProjectWebhookUsecase,
+	approvalTOTPSecret string,
+	undoWindow time.Duration,
+	planningLimits PlanningLimits,
 ) TaskUsecase {
 	return &taskUsecase{
+		projectAccess:       projectAccess{memberRepo: memberRepo},
 		taskRepo:            taskRepo,
 		pullRequestRepo:     pullRequestRepo,
 		projectRepo:         projectRepo,
@@ -297,10 +675,24 @@ func NewTaskUsecase(
 		jobClient:           jobClient,
 		gitManager:          gitManager,
 		prCreator:           prCreator,
+		eventRepo:           eventRepo,
+		executionRepo:       executionRepo,
+		velocityRepo:        velocityRepo,
+		gitOperationRepo:    gitOperationRepo,
+		planApprovalRepo:    planApprovalRepo,
+		undoRepo:            undoRepo,
+		epicRepo:            epicRepo,
+		webhookUsecase:      webhookUsecase,
+		approvalTOTPSecret:  approvalTOTPSecret,
+		undoWindow:          undoWindow,
+		planningLimits:      planningLimits,
 	}
 }
 
 func (u *taskUsecase) Create(ctx context.Context, req CreateTaskRequest) (*entity.Task, error) {
+	if err := u.authorize(ctx, req.ProjectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
 	// Validate project exists
 	if exists, err := u.taskRepo.ValidateProjectExists(ctx, req.ProjectID); err != nil {
 		return nil, fmt.Errorf("failed to validate project: %w", err)
@@ -324,6 +716,16 @@ func (u *taskUsecase) Create(ctx context.Context, req CreateTaskRequest) (*entit
 		}
 	}
 
+	if req.EpicID != nil {
+		if err := u.validateTaskEpic(ctx, req.ProjectID, *req.EpicID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.WorkflowType != "" && !req.WorkflowType.IsValid() {
+		return nil, ErrWorkflowTypeInvalid
+	}
+
 	// Set default priority if not provided
 	if req.Priority == "" {
 		req.Priority = entity.TaskPriorityMedium
@@ -344,6 +746,8 @@ func (u *taskUsecase) Create(ctx context.Context, req CreateTaskRequest) (*entit
 		BranchName:     req.BranchName,
 		PullRequest:    req.PullRequest,
 		KanbanTaskID:   req.KanbanTaskID,
+		EpicID:         req.EpicID,
+		WorkflowType:   req.WorkflowType,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
@@ -352,6 +756,9 @@ func (u *taskUsecase) Create(ctx context.Context, req CreateTaskRequest) (*entit
 		return nil, err
 	}
 
+	recordTaskEvent(ctx, u.eventRepo, entity.EventTypeTaskCreated, task, "")
+	u.publishWebhook(ctx, task.ProjectID, entity.WebhookEventTaskCreated, NewWebhookTaskData(task))
+
 	// Send task created notification
 	if u.notificationUsecase != nil {
 		project, err := u.projectRepo.GetByID(ctx, task.ProjectID)
@@ -365,20 +772,33 @@ func (u *taskUsecase) Create(ctx context.Context, req CreateTaskRequest) (*entit
 }
 
 func (u *taskUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	return u.taskRepo.GetByID(ctx, id)
 }
 
 func (u *taskUsecase) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Task, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	return u.taskRepo.GetByProjectID(ctx, projectID)
 }
 
 func (u *taskUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateTaskRequest) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
 	task, err := u.taskRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	oldStatus := task.Status
 
+	if err := applyTaskFieldClears(task, req); err != nil {
+		return nil, err
+	}
+
 	// Check for duplicate title if title is being changed
 	if req.Title != "" && req.Title != task.Title {
 		if isDuplicate, err := u.taskRepo.CheckDuplicateTitle(ctx, task.ProjectID, req.Title, &id); err != nil {
@@ -426,6 +846,20 @@ func (u *taskUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateTaskRe
 	if req.PullRequest != nil {
 		task.PullRequest = req.PullRequest
 	}
+	if req.HighRiskReason != nil {
+		reason := strings.TrimSpace(*req.HighRiskReason)
+		if reason == "" {
+			reason = defaultHighRiskReason
+		}
+		task.HighRisk = true
+		task.HighRiskReason = &reason
+	}
+	if req.EpicID != nil {
+		if err := u.validateTaskEpic(ctx, task.ProjectID, *req.EpicID); err != nil {
+			return nil, err
+		}
+		task.EpicID = req.EpicID
+	}
 
 	task.UpdatedAt = time.Now()
 	if req.WorktreePath != nil {
@@ -439,11 +873,72 @@ func (u *taskUsecase) Update(ctx context.Context, id uuid.UUID, req UpdateTaskRe
 	}
 
 	u.maybeEnqueueKanbanNotify(task, oldStatus, task.Status)
+	u.maybeEnqueueJiraSync(task, oldStatus, task.Status)
+	u.maybeEnqueuePreviewStop(task, oldStatus, task.Status)
+	u.recordStatusChange(ctx, task, oldStatus)
 
 	return task, nil
 }
 
+// validateTaskEpic checks the epic exists and belongs to the task's project
+func (u *taskUsecase) validateTaskEpic(ctx context.Context, projectID, epicID uuid.UUID) error {
+	epic, err := u.epicRepo.GetByID(ctx, epicID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrEpicNotFound, err)
+	}
+	if epic.ProjectID != projectID {
+		return ErrEpicProjectMismatch
+	}
+	return nil
+}
+
+// applyTaskFieldClears resets the fields listed in req.ClearFields, rejecting
+// unknown fields and fields that the same request also sets.
+func applyTaskFieldClears(task *entity.Task, req UpdateTaskRequest) error {
+	for _, field := range req.ClearFields {
+		var isSet bool
+		switch field {
+		case TaskFieldDescription:
+			isSet = req.Description != ""
+			task.Description = ""
+		case TaskFieldAssignedTo:
+			isSet = req.AssignedTo != nil
+			task.AssignedTo = nil
+		case TaskFieldDueDate:
+			isSet = req.DueDate != nil
+			task.DueDate = nil
+		case TaskFieldEstimatedHours:
+			isSet = req.EstimatedHours != nil
+			task.EstimatedHours = nil
+		case TaskFieldActualHours:
+			isSet = req.ActualHours != nil
+			task.ActualHours = nil
+		case TaskFieldTags:
+			isSet = req.Tags != nil
+			task.Tags = nil
+		case TaskFieldEpicID:
+			isSet = req.EpicID != nil
+			task.EpicID = nil
+		default:
+			return fmt.Errorf("%w: %s", ErrTaskFieldNotClearable, field)
+		}
+		if isSet {
+			return fmt.Errorf("%w: %s", ErrTaskFieldConflict, field)
+		}
+	}
+	return nil
+}
+
 func (u *taskUsecase) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TaskStatus) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	return u.updateStatus(ctx, id, status)
+}
+
+// updateStatus is UpdateStatus without checking the project role, for the
+// reviewer actions moving the task on
+func (u *taskUsecase) updateStatus(ctx context.Context, id uuid.UUID, status entity.TaskStatus) (*entity.Task, error) {
 	task, err := u.taskRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -460,6 +955,43 @@ func (u *taskUsecase) UpdateStatus(ctx context.Context, id uuid.UUID, status ent
 	}
 
 	u.maybeEnqueueKanbanNotify(updatedTask, oldStatus, status)
+	u.maybeEnqueueJiraSync(updatedTask, oldStatus, status)
+	u.maybeEnqueuePreviewStop(updatedTask, oldStatus, status)
+	u.recordStatusChange(ctx, updatedTask, oldStatus)
+
+	return updatedTask, nil
+}
+
+func (u *taskUsecase) UpdateStatusBy(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy, reason *string) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	return u.updateStatusBy(ctx, id, status, changedBy, reason)
+}
+
+// updateStatusBy is UpdateStatusBy without checking the project role, for the
+// reviewer actions moving the task on
+func (u *taskUsecase) updateStatusBy(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy, reason *string) (*entity.Task, error) {
+	changedBy = recordedActor(ctx, changedBy)
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldStatus := task.Status
+
+	if err := u.taskRepo.UpdateStatusWithHistory(ctx, id, status, changedBy, reason); err != nil {
+		return nil, err
+	}
+
+	updatedTask, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.maybeEnqueueKanbanNotify(updatedTask, oldStatus, status)
+	u.maybeEnqueueJiraSync(updatedTask, oldStatus, status)
+	u.maybeEnqueuePreviewStop(updatedTask, oldStatus, status)
+	u.recordStatusChange(ctx, updatedTask, oldStatus)
 
 	return updatedTask, nil
 }
@@ -506,8 +1038,93 @@ func (u *taskUsecase) maybeEnqueueKanbanNotify(task *entity.Task, oldStatus, new
 	}
 }
 
+// maybeEnqueueJiraSync enqueues a jira:sync job when a task imported from
+// Jira changes status. Whether the project syncs status back, and to which
+// Jira status, is decided by the job. Like the kanban callback, enqueue
+// failures are only logged.
+func (u *taskUsecase) maybeEnqueueJiraSync(task *entity.Task, oldStatus, newStatus entity.TaskStatus) {
+	if u.jobClient == nil || task == nil {
+		return
+	}
+	if oldStatus == newStatus || task.JiraIssueKey == nil {
+		return
+	}
+
+	payload := &JiraSyncPayload{
+		TaskID:    task.ID,
+		NewStatus: newStatus,
+	}
+	if _, err := u.jobClient.EnqueueJiraSync(payload); err != nil {
+		slog.Warn("Failed to enqueue jira sync job",
+			"task_id", task.ID,
+			"jira_issue_key", *task.JiraIssueKey,
+			"new_status", newStatus,
+			"error", err,
+		)
+	}
+}
+
+// maybeEnqueuePreviewStop enqueues a preview:stop job when a task with a
+// running preview is done or cancelled. The preview runs in the worker that
+// started it, so the job tears it down there. Enqueue failures are only
+// logged.
+func (u *taskUsecase) maybeEnqueuePreviewStop(task *entity.Task, oldStatus, newStatus entity.TaskStatus) {
+	if u.jobClient == nil || task == nil || task.PreviewURL == nil {
+		return
+	}
+	if oldStatus == newStatus || (newStatus != entity.TaskStatusDONE && newStatus != entity.TaskStatusCANCELLED) {
+		return
+	}
+
+	if _, err := u.jobClient.EnqueuePreviewStop(&PreviewStopPayload{TaskID: task.ID}); err != nil {
+		slog.Warn("Failed to enqueue preview stop job",
+			"task_id", task.ID,
+			"preview_url", *task.PreviewURL,
+			"new_status", newStatus,
+			"error", err,
+		)
+	}
+}
+
+// recordStatusChange appends a task.status_changed event to the automation
+// feed, and sends it to the project's webhooks, when the task's status
+// actually changed.
+func (u *taskUsecase) recordStatusChange(ctx context.Context, task *entity.Task, oldStatus entity.TaskStatus) {
+	if task == nil || task.Status == oldStatus {
+		return
+	}
+	recordTaskEvent(ctx, u.eventRepo, entity.EventTypeTaskStatusChanged, task, oldStatus)
+	u.publishWebhook(ctx, task.ProjectID, entity.WebhookEventStatusChanged, WebhookStatusChangedData{
+		TaskID:    task.ID,
+		TaskTitle: task.Title,
+		OldStatus: oldStatus,
+		NewStatus: task.Status,
+	})
+}
+
+// publishWebhook sends event to the project's webhooks, when the usecase has
+// them
+func (u *taskUsecase) publishWebhook(ctx context.Context, projectID uuid.UUID, event entity.WebhookEvent, data interface{}) {
+	if u.webhookUsecase == nil {
+		return
+	}
+	u.webhookUsecase.Publish(ctx, projectID, event, data)
+}
+
 func (u *taskUsecase) Delete(ctx context.Context, id uuid.UUID) error {
-	return u.taskRepo.Delete(ctx, id)
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+	task, err := u.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := u.taskRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	recordTaskEvent(ctx, u.eventRepo, entity.EventTypeTaskDeleted, task, "")
+	return nil
 }
 
 func (u *taskUsecase) GetWithProject(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
@@ -521,12 +1138,22 @@ func (u *taskUsecase) GetWithProject(ctx context.Context, id uuid.UUID) (*entity
 	return task, nil
 }
 
+func (u *taskUsecase) GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error) {
+	if err := u.authorizeTask(ctx, id, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.taskRepo.GetDetail(ctx, id)
+}
+
 func (u *taskUsecase) GetByStatus(ctx context.Context, status entity.TaskStatus) ([]*entity.Task, error) {
 	return u.taskRepo.GetByStatus(ctx, status)
 }
 
 // UpdateStatusWithHistory updates task status with validation and history tracking
 func (u *taskUsecase) UpdateStatusWithHistory(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, req.TaskID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
 	// Get current task to capture the old status for the kanban callback
 	currentTask, err := u.taskRepo.GetByID(ctx, req.TaskID)
 	if err != nil {
@@ -540,6 +1167,7 @@ func (u *taskUsecase) UpdateStatusWithHistory(ctx context.Context, req UpdateSta
 	}
 
 	// Update status with history
+	req.ChangedBy = recordedActor(ctx, req.ChangedBy)
 	if err := u.taskRepo.UpdateStatusWithHistory(ctx, req.TaskID, req.Status, req.ChangedBy, req.Reason); err != nil {
 		return nil, err
 	}
@@ -551,6 +1179,9 @@ func (u *taskUsecase) UpdateStatusWithHistory(ctx context.Context, req UpdateSta
 	}
 
 	u.maybeEnqueueKanbanNotify(updatedTask, oldStatus, req.Status)
+	u.maybeEnqueueJiraSync(updatedTask, oldStatus, req.Status)
+	u.maybeEnqueuePreviewStop(updatedTask, oldStatus, req.Status)
+	u.recordStatusChange(ctx, updatedTask, oldStatus)
 
 	// Handle worktree operations based on status change
 	if u.worktreeUsecase != nil {
@@ -573,6 +1204,8 @@ func (u *taskUsecase) UpdateStatusWithHistory(ctx context.Context, req UpdateSta
 				Reason:      req.Reason,
 				ProjectID:   updatedTask.ProjectID,
 				ProjectName: project.Name,
+
+				ProjectLocale: project.Locale,
 			}
 			// Don't fail status update if notification fails
 			_ = u.notificationUsecase.SendTaskStatusChangeNotification(ctx, notificationData)
@@ -599,6 +1232,9 @@ func (u *taskUsecase) BulkUpdateStatus(ctx context.Context, req BulkUpdateStatus
 	if len(req.TaskIDs) == 0 {
 		return fmt.Errorf("no task IDs provided")
 	}
+	if err := u.authorizeTasks(ctx, req.TaskIDs, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
 
 	// Validate target status
 	if !req.Status.IsValid() {
@@ -617,12 +1253,18 @@ func (u *taskUsecase) BulkUpdateStatus(ctx context.Context, req BulkUpdateStatus
 	}
 
 	// This will validate transitions for each task individually in the repository
-	if err := u.taskRepo.BulkUpdateStatus(ctx, req.TaskIDs, req.Status, req.ChangedBy); err != nil {
+	if err := u.taskRepo.BulkUpdateStatus(ctx, req.TaskIDs, req.Status, recordedActor(ctx, req.ChangedBy)); err != nil {
 		return err
 	}
 
 	for _, task := range previousTasks {
 		u.maybeEnqueueKanbanNotify(task, task.Status, req.Status)
+		u.maybeEnqueueJiraSync(task, task.Status, req.Status)
+		u.maybeEnqueuePreviewStop(task, task.Status, req.Status)
+
+		oldStatus := task.Status
+		task.Status = req.Status
+		u.recordStatusChange(ctx, task, oldStatus)
 	}
 
 	return nil
@@ -632,14 +1274,30 @@ func (u *taskUsecase) BulkUpdateStatus(ctx context.Context, req BulkUpdateStatus
 func (u *taskUsecase) GetStatusHistory(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskStatusHistory, error) {
 	// Verify task exists
 	if _, err := u.taskRepo.GetByID(ctx, taskID); err != nil {
-		return nil, fmt.Errorf("task not found: %w", err)
+		return nil, fmt.Errorf("%w: %v", ErrTaskNotFound, err)
 	}
 
-	return u.taskRepo.GetStatusHistory(ctx, taskID)
+	history, err := u.taskRepo.GetStatusHistory(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The history is oldest first, so planning runs are numbered in order
+	iteration := 0
+	for _, entry := range history {
+		if entry.ToStatus == entity.TaskStatusPLANNING {
+			iteration++
+			entry.PlanningIteration = iteration
+		}
+	}
+	return history, nil
 }
 
 // GetStatusAnalytics generates comprehensive status analytics for a project
 func (u *taskUsecase) GetStatusAnalytics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatusAnalytics, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	return u.taskRepo.GetStatusAnalytics(ctx, projectID)
 }
 
@@ -783,30 +1441,16 @@ func (u *taskUsecase) CreateSubtask(ctx context.Context, parentTaskID uuid.UUID,
 	return u.Create(ctx, req)
 }
 
-// BulkDelete deletes multiple tasks
-func (u *taskUsecase) BulkDelete(ctx context.Context, taskIDs []uuid.UUID) error {
-	if len(taskIDs) == 0 {
-		return fmt.Errorf("no task IDs provided")
-	}
-
-	return u.taskRepo.BulkDelete(ctx, taskIDs)
-}
-
-// BulkArchive archives multiple tasks
-func (u *taskUsecase) BulkArchive(ctx context.Context, taskIDs []uuid.UUID) error {
-	if len(taskIDs) == 0 {
-		return fmt.Errorf("no task IDs provided")
-	}
-
-	return u.taskRepo.BulkArchive(ctx, taskIDs)
-}
-
 // BulkUnarchive unarchives multiple tasks
 func (u *taskUsecase) BulkUnarchive(ctx context.Context, taskIDs []uuid.UUID) error {
 	if len(taskIDs) == 0 {
 		return fmt.Errorf("no task IDs provided")
 	}
 
+	if err := u.authorizeTasks(ctx, taskIDs, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+
 	return u.taskRepo.BulkUnarchive(ctx, taskIDs)
 }
 
@@ -820,6 +1464,10 @@ func (u *taskUsecase) BulkUpdatePriority(ctx context.Context, taskIDs []uuid.UUI
 		return fmt.Errorf("invalid priority: %s", priority)
 	}
 
+	if err := u.authorizeTasks(ctx, taskIDs, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+
 	return u.taskRepo.BulkUpdatePriority(ctx, taskIDs, priority)
 }
 
@@ -833,6 +1481,10 @@ func (u *taskUsecase) BulkAssign(ctx context.Context, taskIDs []uuid.UUID, assig
 		return fmt.Errorf("assigned_to cannot be empty")
 	}
 
+	if err := u.authorizeTasks(ctx, taskIDs, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+
 	return u.taskRepo.BulkAssign(ctx, taskIDs, assignedTo)
 }
 
@@ -855,7 +1507,7 @@ func (u *taskUsecase) CreateTemplate(ctx context.Context, req CreateTemplateRequ
 		EstimatedHours: req.EstimatedHours,
 		Tags:           req.Tags,
 		IsGlobal:       req.IsGlobal,
-		CreatedBy:      &req.CreatedBy,
+		CreatedBy:      recordedActor(ctx, &req.CreatedBy),
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
@@ -988,6 +1640,9 @@ func (u *taskUsecase) GetDependents(ctx context.Context, taskID uuid.UUID) ([]*e
 
 // AddComment adds a comment to a task
 func (u *taskUsecase) AddComment(ctx context.Context, req AddCommentRequest) (*entity.TaskComment, error) {
+	if err := u.authorizeTask(ctx, req.TaskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, err
+	}
 	// Validate task exists
 	if exists, err := u.taskRepo.ValidateTaskExists(ctx, req.TaskID); err != nil {
 		return nil, fmt.Errorf("failed to validate task: %w", err)
@@ -999,7 +1654,7 @@ func (u *taskUsecase) AddComment(ctx context.Context, req AddCommentRequest) (*e
 		ID:        uuid.New(),
 		TaskID:    req.TaskID,
 		Comment:   req.Comment,
-		CreatedBy: req.CreatedBy,
+		CreatedBy: recordedActorName(ctx, req.CreatedBy),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -1013,28 +1668,20 @@ func (u *taskUsecase) AddComment(ctx context.Context, req AddCommentRequest) (*e
 
 // GetComments retrieves comments for a task
 func (u *taskUsecase) GetComments(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskComment, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	return u.taskRepo.GetComments(ctx, taskID)
 }
 
 // UpdateComment updates a comment
 func (u *taskUsecase) UpdateComment(ctx context.Context, commentID uuid.UUID, req UpdateCommentRequest) (*entity.TaskComment, error) {
-	// Get existing comment
-	comments, err := u.taskRepo.GetComments(ctx, uuid.Nil) // We need to get the comment by ID, but the interface doesn't support it yet
+	comment, err := u.taskRepo.GetCommentByID(ctx, commentID)
 	if err != nil {
 		return nil, err
 	}
-
-	// Find the comment (this is a temporary workaround)
-	var comment *entity.TaskComment
-	for _, c := range comments {
-		if c.ID == commentID {
-			comment = c
-			break
-		}
-	}
-
-	if comment == nil {
-		return nil, fmt.Errorf("comment not found")
+	if err := u.authorizeTask(ctx, comment.TaskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, err
 	}
 
 	comment.Comment = req.Comment
@@ -1049,6 +1696,13 @@ func (u *taskUsecase) UpdateComment(ctx context.Context, commentID uuid.UUID, re
 
 // DeleteComment deletes a comment
 func (u *taskUsecase) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
+	comment, err := u.taskRepo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if err := u.authorizeTask(ctx, comment.TaskID, entity.ProjectRoleReviewer); err != nil {
+		return err
+	}
 	return u.taskRepo.DeleteComment(ctx, commentID)
 }
 
@@ -1190,6 +1844,36 @@ func (u *taskUsecase) UpdateGitStatus(ctx context.Context, taskID uuid.UUID, git
 	return u.taskRepo.GetByID(ctx, taskID)
 }
 
+// UpdatePreviewURL records the URL of the task's running preview
+// environment, or clears it when previewURL is nil
+func (u *taskUsecase) UpdatePreviewURL(ctx context.Context, taskID uuid.UUID, previewURL *string) (*entity.Task, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	task.PreviewURL = previewURL
+	if err := u.taskRepo.Update(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// UpdateQueuePosition records the task's place in its project's queue of
+// tasks waiting for an execution slot
+func (u *taskUsecase) UpdateQueuePosition(ctx context.Context, taskID uuid.UUID, position *int) (*entity.Task, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	task.QueuePosition = position
+	if err := u.taskRepo.Update(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
 // ValidateGitStatusTransition validates if a Git status transition is allowed for a specific task
 func (u *taskUsecase) ValidateGitStatusTransition(ctx context.Context, taskID uuid.UUID, newGitStatus entity.TaskGitStatus) error {
 	// Get current task
@@ -1203,7 +1887,10 @@ func (u *taskUsecase) ValidateGitStatusTransition(ctx context.Context, taskID uu
 }
 
 // StartPlanning starts the planning process for a task
-func (u *taskUsecase) StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool) (string, error) {
+func (u *taskUsecase) StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool, scheduledAt *time.Time) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return "", err
+	}
 	// Get task to validate it exists and is in TODO status
 	task, err := u.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
@@ -1214,6 +1901,16 @@ func (u *taskUsecase) StartPlanning(ctx context.Context, taskID uuid.UUID, branc
 		// Need check with PLANNING status for case status is changed by handler
 		return "", fmt.Errorf("task must be in TODO or PLANNING status to start planning, current status: %s", task.Status)
 	}
+	delay, err := u.scheduleDelay(taskID, scheduledAt)
+	if err != nil {
+		return "", err
+	}
+	// Scheduled planning runs off-peak, when the queue has drained
+	if scheduledAt == nil {
+		if err := u.CheckPlanningCapacity(ctx); err != nil {
+			return "", err
+		}
+	}
 
 	// Persist base branch only when the caller selected one (not when reusing an
 	// existing worktree, which often passes the worktree/feature branch name).
@@ -1234,9 +1931,11 @@ func (u *taskUsecase) StartPlanning(ctx context.Context, taskID uuid.UUID, branc
 		AIType:          aiType,
 		AutoImplement:   autoImplement,
 		UseRemoteBranch: useRemoteBranch,
+		Scheduled:       scheduledAt != nil,
+		TraceContext:    tracing.Inject(ctx),
 	}
 
-	jobID, err := u.jobClient.EnqueueTaskPlanning(payload, 0)
+	jobID, err := u.jobClient.EnqueueTaskPlanning(payload, delay)
 	if err != nil {
 		return "", fmt.Errorf("failed to enqueue planning job: %w", err)
 	}
@@ -1245,7 +1944,10 @@ func (u *taskUsecase) StartPlanning(ctx context.Context, taskID uuid.UUID, branc
 }
 
 // ApprovePlan approves the plan for a task and starts implementation
-func (u *taskUsecase) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string) (string, error) {
+func (u *taskUsecase) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string, scheduledAt *time.Time) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleReviewer); err != nil {
+		return "", err
+	}
 	// Get task to validate it exists and is in PLAN_REVIEWING status
 	task, err := u.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
@@ -1254,7 +1956,14 @@ func (u *taskUsecase) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType
 
 	if task.Status != entity.TaskStatusPLANREVIEWING && task.Status != entity.TaskStatusIMPLEMENTING {
 		// Need check with IMPLEMENTING status for case status is changed by handler
-		return "", fmt.Errorf("task must be in PLAN_REVIEWING status to approve plan, current status: %s", task.Status)
+		return "", fmt.Errorf("%w, current status: %s", ErrTaskNotInPlanReview, task.Status)
+	}
+	if err := u.checkStepUpApproval(ctx, task); err != nil {
+		return "", err
+	}
+	delay, err := u.scheduleDelay(taskID, scheduledAt)
+	if err != nil {
+		return "", err
 	}
 
 	// Note: Status update to IMPLEMENTING is now handled by the WebSocket handler
@@ -1262,12 +1971,14 @@ func (u *taskUsecase) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType
 
 	// Enqueue the implementation job using asynq client
 	payload := &TaskImplementationPayload{
-		TaskID:    taskID,
-		ProjectID: task.ProjectID,
-		AIType:    aiType,
+		TaskID:       taskID,
+		ProjectID:    task.ProjectID,
+		AIType:       aiType,
+		Scheduled:    scheduledAt != nil,
+		TraceContext: tracing.Inject(ctx),
 	}
 
-	jobID, err := u.jobClient.EnqueueTaskImplementation(payload, 0)
+	jobID, err := u.jobClient.EnqueueTaskImplementation(payload, delay)
 	if err != nil {
 		return "", fmt.Errorf("failed to enqueue implementation job: %w", err)
 	}
@@ -1275,8 +1986,154 @@ func (u *taskUsecase) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType
 	return jobID, nil
 }
 
+// RejectPlan sends the task back to TODO, from where it can be planned again
+func (u *taskUsecase) RejectPlan(ctx context.Context, taskID uuid.UUID, reviewer, reason *string) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, err
+	}
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.Status != entity.TaskStatusPLANREVIEWING {
+		return nil, fmt.Errorf("%w, current status: %s", ErrTaskNotInPlanReview, task.Status)
+	}
+
+	return u.updateStatusBy(ctx, taskID, entity.TaskStatusTODO, reviewer, reason)
+}
+
+// RejectPlanWithFeedback rejects the plan under review and enqueues the
+// planning run replacing it. The plan keeps the feedback, which the new run
+// gets along with the rejected plan.
+func (u *taskUsecase) RejectPlanWithFeedback(ctx context.Context, taskID uuid.UUID, req RejectPlanWithFeedbackRequest) (*entity.Task, string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, "", err
+	}
+	feedback := strings.TrimSpace(req.Feedback)
+	if feedback == "" {
+		return nil, "", ErrFeedbackRequired
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if task.Status != entity.TaskStatusPLANREVIEWING {
+		return nil, "", fmt.Errorf("%w, current status: %s", ErrTaskNotInPlanReview, task.Status)
+	}
+	plan, err := u.planRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrPlanNotFound, err)
+	}
+
+	if err := u.planRepo.Reject(ctx, plan.ID, feedback); err != nil {
+		return nil, "", err
+	}
+	reason := "Plan rejected with feedback"
+	updated, err := u.updateStatusBy(ctx, taskID, entity.TaskStatusPLANNING, req.Reviewer, &reason)
+	if err != nil {
+		u.restorePlanReview(ctx, plan.ID)
+		return nil, "", err
+	}
+
+	// The new run plans in the task's worktree
+	jobID, err := u.jobClient.EnqueueTaskPlanning(&TaskPlanningPayload{
+		TaskID:       taskID,
+		ProjectID:    task.ProjectID,
+		AIType:       req.AIType,
+		Feedback:     feedback,
+		TraceContext: tracing.Inject(ctx),
+	}, 0)
+	if err != nil {
+		if _, revertErr := u.updateStatus(ctx, taskID, entity.TaskStatusPLANREVIEWING); revertErr != nil {
+			slog.Warn("Failed to revert task status after the re-planning could not be enqueued",
+				"task_id", taskID, "error", revertErr)
+		}
+		u.restorePlanReview(ctx, plan.ID)
+		return nil, "", fmt.Errorf("failed to enqueue planning job: %w", err)
+	}
+
+	return updated, jobID, nil
+}
+
+// restorePlanReview puts a plan whose rejection could not be followed
+// through back under review
+func (u *taskUsecase) restorePlanReview(ctx context.Context, planID uuid.UUID) {
+	if err := u.planRepo.UpdateStatus(ctx, planID, entity.PlanStatusREVIEWING); err != nil {
+		slog.Warn("Failed to put the plan back under review", "plan_id", planID, "error", err)
+	}
+}
+
+// RecordPlanningIteration counts a planning run of the task
+func (u *taskUsecase) RecordPlanningIteration(ctx context.Context, taskID uuid.UUID) (int, error) {
+	return u.taskRepo.IncrementPlanningIterations(ctx, taskID)
+}
+
+// RequestChanges sends a task in code review back to implementation. The
+// status change bypasses the transition rules, which only let a reviewed
+// task move on: going back needs feedback for the executor to address.
+func (u *taskUsecase) RequestChanges(ctx context.Context, taskID uuid.UUID, req RequestChangesRequest) (*entity.Task, string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleReviewer); err != nil {
+		return nil, "", err
+	}
+	feedback := strings.TrimSpace(req.Feedback)
+	if feedback == "" {
+		return nil, "", ErrFeedbackRequired
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if task.Status != entity.TaskStatusCODEREVIEWING {
+		return nil, "", fmt.Errorf("%w, current status: %s", ErrTaskNotInCodeReview, task.Status)
+	}
+	if task.WorktreePath == nil || *task.WorktreePath == "" {
+		return nil, "", ErrTaskHasNoWorktree
+	}
+
+	// Saved first, so images it references are passed to the executor
+	author := "reviewer"
+	if reviewer := recordedActor(ctx, req.Reviewer); reviewer != nil {
+		author = *reviewer
+	}
+	if _, err := u.AddComment(ctx, AddCommentRequest{
+		TaskID:    taskID,
+		Comment:   "Changes requested:\n\n" + feedback,
+		CreatedBy: author,
+	}); err != nil {
+		return nil, "", fmt.Errorf("failed to save change request: %w", err)
+	}
+
+	reason := "Changes requested"
+	updated, err := u.updateStatusBy(ctx, taskID, entity.TaskStatusIMPLEMENTING, req.Reviewer, &reason)
+	if err != nil {
+		return nil, "", err
+	}
+
+	jobID, err := u.jobClient.EnqueueTaskImplementation(&TaskImplementationPayload{
+		TaskID:        taskID,
+		ProjectID:     task.ProjectID,
+		AIType:        req.AIType,
+		ChangeRequest: feedback,
+		TraceContext:  tracing.Inject(ctx),
+	}, 0)
+	if err != nil {
+		if _, revertErr := u.updateStatus(ctx, taskID, entity.TaskStatusCODEREVIEWING); revertErr != nil {
+			slog.Warn("Failed to revert task status after the change request could not be enqueued",
+				"task_id", taskID, "error", revertErr)
+		}
+		return nil, "", fmt.Errorf("failed to enqueue implementation job: %w", err)
+	}
+
+	return updated, jobID, nil
+}
+
 // StartImplementingDirect skips planning and goes directly from TODO to IMPLEMENTING
 func (u *taskUsecase) StartImplementingDirect(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, useRemoteBranch bool) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return "", err
+	}
 	task, err := u.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
 		return "", fmt.Errorf("failed to get task: %w", err)
@@ -1303,6 +2160,7 @@ func (u *taskUsecase) StartImplementingDirect(ctx context.Context, taskID uuid.U
 		ProjectID:       task.ProjectID,
 		AIType:          aiType,
 		UseRemoteBranch: useRemoteBranch,
+		TraceContext:    tracing.Inject(ctx),
 	}
 
 	jobID, err := u.jobClient.EnqueueTaskImplementation(payload, 0)
@@ -1313,6 +2171,142 @@ func (u *taskUsecase) StartImplementingDirect(ctx context.Context, taskID uuid.U
 	return jobID, nil
 }
 
+// StartComparison enqueues a run of the task by each of the two executors,
+// each in a worktree and on a branch of its own
+func (u *taskUsecase) StartComparison(ctx context.Context, taskID uuid.UUID, branchName string, aiTypes []string) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return "", err
+	}
+	if len(aiTypes) != 2 {
+		return "", ErrComparisonExecutorsInvalid
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.Status != entity.TaskStatusTODO && task.Status != entity.TaskStatusPLANREVIEWING {
+		return "", fmt.Errorf("%w, current status: %s", ErrTaskNotReadyForComparison, task.Status)
+	}
+
+	if branchName != "" && (task.BaseBranchName == nil || branchName != *task.BaseBranchName) {
+		_, err = u.Update(ctx, taskID, UpdateTaskRequest{
+			BaseBranchName: &branchName,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to update task with base branch name: %w", err)
+		}
+	}
+
+	jobID, err := u.jobClient.EnqueueTaskComparison(&TaskComparisonPayload{
+		TaskID:    taskID,
+		ProjectID: task.ProjectID,
+		AITypes:   aiTypes,
+	}, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue comparison job: %w", err)
+	}
+
+	return jobID, nil
+}
+
+// GetComparison loads the task's latest executor comparison and the diff of
+// every completed variant against the base branch
+func (u *taskUsecase) GetComparison(ctx context.Context, taskID uuid.UUID) (*ExecutorComparison, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	executions, err := u.executionRepo.GetLatestComparison(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if len(executions) == 0 {
+		return nil, ErrComparisonNotFound
+	}
+	project, err := u.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	comparison := &ExecutorComparison{
+		ID:             *executions[0].ComparisonID,
+		TaskID:         taskID,
+		BaseBranchName: resolveBaseBranchName("", task.BaseBranchName),
+	}
+
+	// The snapshot refs are shared by every worktree, so the diffs are read
+	// from the project repository even after a variant's worktree is gone
+	snapshots, err := u.gitManager.ListSnapshots(ctx, project.WorktreeBasePath, taskID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comparison snapshots: %w", err)
+	}
+	results := make(map[string]git.Snapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		results[snapshot.ID] = snapshot
+	}
+
+	for _, execution := range executions {
+		variant := &ComparisonVariant{
+			Execution: execution,
+			Selected:  task.WorktreePath != nil && *task.WorktreePath == execution.WorktreePath,
+		}
+		if result, ok := results[ComparisonResultSnapshotID(execution.ID)]; ok {
+			variant.Diff, err = u.gitManager.GetDiff(ctx, project.WorktreeBasePath, comparison.BaseBranchName, result.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get diff of variant %s: %w", execution.Variant, err)
+			}
+		}
+		comparison.Variants = append(comparison.Variants, variant)
+	}
+
+	return comparison, nil
+}
+
+// SelectComparisonWinner enqueues the job that adopts the variant's
+// worktree, removes the other one and verifies and opens a pull request for
+// the winning changes
+func (u *taskUsecase) SelectComparisonWinner(ctx context.Context, taskID uuid.UUID, variant string) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return "", err
+	}
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get task: %w", err)
+	}
+	executions, err := u.executionRepo.GetLatestComparison(ctx, taskID)
+	if err != nil {
+		return "", err
+	}
+	if len(executions) == 0 {
+		return "", ErrComparisonNotFound
+	}
+
+	var winner *entity.Execution
+	for _, execution := range executions {
+		if execution.Variant == variant {
+			winner = execution
+		}
+	}
+	if winner == nil {
+		return "", ErrComparisonVariantNotFound
+	}
+	if winner.Status != entity.ExecutionStatusCompleted {
+		return "", fmt.Errorf("%w, current status: %s", ErrComparisonVariantNotCompleted, winner.Status)
+	}
+
+	jobID, err := u.jobClient.EnqueueComparisonSelect(&ComparisonSelectPayload{
+		TaskID:      taskID,
+		ProjectID:   task.ProjectID,
+		ExecutionID: winner.ID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue comparison select job: %w", err)
+	}
+
+	return jobID, nil
+}
+
 // ListGitBranches lists all Git branches for a project (delegated to project usecase)
 func (u *taskUsecase) ListGitBranches(ctx context.Context, projectID uuid.UUID) ([]GitBranch, error) {
 	// This is a bit awkward - we'd need project usecase here
@@ -1330,6 +2324,9 @@ func (u *taskUsecase) GetPullRequest(ctx context.Context, taskID uuid.UUID) (*en
 }
 
 func (u *taskUsecase) CreatePullRequest(ctx context.Context, taskID uuid.UUID) (*entity.PullRequest, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
 	// Get the task and validate it exists
 	task, err := u.taskRepo.GetByID(ctx, taskID)
 	if err != nil {
@@ -1443,18 +2440,8 @@ func (u *taskUsecase) GetTasksEligibleForWorktreeCleanup(ctx context.Context, cu
 	return u.taskRepo.GetTasksEligibleForWorktreeCleanup(ctx, cutoffTime)
 }
 
-func (u *taskUsecase) UpdateTaskPlan(ctx context.Context, taskID uuid.UUID, planID uuid.UUID, req UpdateTaskPlanRequest) (*entity.Plan, error) {
-	plan, err := u.planRepo.GetByID(ctx, planID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get plan: %w", err)
-	}
-
-	err = u.planRepo.UpdateContent(ctx, planID, req.Content)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update plan: %w", err)
-	}
-
-	return plan, nil
+func (u *taskUsecase) GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error) {
+	return u.taskRepo.GetTasksWithWorktree(ctx)
 }
 
 // GetTaskDiff returns the git diff between base branch and task branch
@@ -1470,6 +2457,12 @@ func (u *taskUsecase) GetTaskDiff(ctx context.Context, taskID uuid.UUID) (string
 		return "", nil
 	}
 
+	return u.worktreeDiff(ctx, task)
+}
+
+// worktreeDiff returns the diff between the task's base branch and its
+// branch, read in its worktree
+func (u *taskUsecase) worktreeDiff(ctx context.Context, task *entity.Task) (string, error) {
 	// Get the base branch name (default to "main" if not set)
 	baseBranch := "main"
 	if task.BaseBranchName != nil && *task.BaseBranchName != "" {
@@ -1492,6 +2485,150 @@ func (u *taskUsecase) GetTaskDiff(ctx context.Context, taskID uuid.UUID) (string
 	return diff, nil
 }
 
+// GetTaskChanges parses the diff of the task's worktree or pull request
+func (u *taskUsecase) GetTaskChanges(ctx context.Context, taskID uuid.UUID, source string) (*TaskChanges, error) {
+	switch source {
+	case "", TaskChangesSourceWorktree, TaskChangesSourcePR:
+	default:
+		return nil, ErrChangesSourceInvalid
+	}
+
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	hasWorktree := task.WorktreePath != nil && *task.WorktreePath != ""
+	worktreeMissing := false
+	if hasWorktree {
+		_, err := os.Stat(*task.WorktreePath)
+		worktreeMissing = os.IsNotExist(err)
+	}
+
+	auto := source == ""
+	if auto {
+		source = TaskChangesSourcePR
+		if hasWorktree && !worktreeMissing {
+			source = TaskChangesSourceWorktree
+		}
+	}
+
+	var diff string
+	switch source {
+	case TaskChangesSourceWorktree:
+		if !hasWorktree {
+			return nil, ErrTaskHasNoWorktree
+		}
+		if worktreeMissing {
+			return nil, ErrWorktreeMissing
+		}
+		diff, err = u.worktreeDiff(ctx, task)
+	case TaskChangesSourcePR:
+		pr, prErr := u.pullRequestRepo.GetByTaskID(ctx, taskID)
+		if prErr != nil {
+			return nil, fmt.Errorf("failed to get pull request: %w", prErr)
+		}
+		if pr == nil {
+			if auto {
+				// Nothing to read the changes from yet
+				return &TaskChanges{TaskID: taskID, Files: []git.FileDiff{}}, nil
+			}
+			return nil, ErrTaskHasNoPullRequest
+		}
+		diff, err = u.prCreator.GetPullRequestDiff(ctx, *task, pr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskChanges{TaskID: taskID, Source: source, Files: git.ParseUnifiedDiff(diff)}, nil
+}
+
+// GetWorktreeStatus reads the branch, uncommitted changes, position relative
+// to the base branch and last commit of the task's worktree
+func (u *taskUsecase) GetWorktreeStatus(ctx context.Context, taskID uuid.UUID) (*TaskWorktreeStatus, error) {
+	task, err := u.getTaskWithWorktree(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	baseBranch := "main"
+	if task.BaseBranchName != nil && *task.BaseBranchName != "" {
+		baseBranch = *task.BaseBranchName
+	}
+
+	status, err := u.gitManager.GetWorktreeStatus(ctx, *task.WorktreePath, baseBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	return &TaskWorktreeStatus{
+		TaskID:         task.ID,
+		WorktreePath:   *task.WorktreePath,
+		BaseBranchName: baseBranch,
+		WorktreeStatus: status,
+	}, nil
+}
+
+// ListWorktreeSnapshots lists the snapshots of the task's worktree
+func (u *taskUsecase) ListWorktreeSnapshots(ctx context.Context, taskID uuid.UUID) ([]git.Snapshot, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	task, err := u.getTaskWithWorktree(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return u.gitManager.ListSnapshots(ctx, *task.WorktreePath, task.ID.String())
+}
+
+// RestoreWorktreeSnapshot reverts the task's worktree to the snapshot taken
+// before a run, dropping what the run and later ones did
+func (u *taskUsecase) RestoreWorktreeSnapshot(ctx context.Context, taskID uuid.UUID, snapshotID string) error {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+	task, err := u.getTaskWithWorktree(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return u.gitManager.RestoreSnapshot(ctx, *task.WorktreePath, task.ID.String(), snapshotID)
+}
+
+// ListGitOperations lists the audit records of the task's git operations
+func (u *taskUsecase) ListGitOperations(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return u.gitOperationRepo.ListByTaskID(ctx, taskID)
+}
+
+// getTaskWithWorktree returns the task, checking that its worktree is on disk
+func (u *taskUsecase) getTaskWithWorktree(ctx context.Context, taskID uuid.UUID) (*entity.Task, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	if task.WorktreePath == nil || *task.WorktreePath == "" {
+		return nil, ErrTaskHasNoWorktree
+	}
+	if _, err := os.Stat(*task.WorktreePath); os.IsNotExist(err) {
+		return nil, ErrWorktreeMissing
+	}
+	return task, nil
+}
+
+// RecreateWorktree rebuilds the task's worktree from its branch and returns
+// the updated task
+func (u *taskUsecase) RecreateWorktree(ctx context.Context, taskID uuid.UUID) (*entity.Task, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	if _, err := u.worktreeUsecase.RecreateWorktreeForTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	return u.taskRepo.GetByID(ctx, taskID)
+}
+
 func (u *taskUsecase) AppendErrorLog(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
 	return u.taskRepo.AppendErrorLog(ctx, taskID, errorMsg)
 }
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// authorizeTask returns ErrProjectForbidden unless the request may act with
+// role in the task's project, and ErrTaskNotFound when the task cannot be
+// loaded
+func (a projectAccess) authorizeTask(ctx context.Context, taskRepo repository.TaskRepository, taskID uuid.UUID, role entity.ProjectRole) error {
+	if !restricted(ctx) {
+		return nil
+	}
+	task, err := taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	return a.authorize(ctx, task.ProjectID, role)
+}
+
+// authorizeExecution is authorizeTask for the task of an execution, and
+// returns ErrExecutionNotFound when the execution cannot be loaded
+func (a projectAccess) authorizeExecution(ctx context.Context, executionRepo repository.ExecutionRepository, taskRepo repository.TaskRepository, executionID uuid.UUID, role entity.ProjectRole) error {
+	if !restricted(ctx) {
+		return nil
+	}
+	execution, err := executionRepo.GetByID(ctx, executionID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrExecutionNotFound, err)
+	}
+	return a.authorizeTask(ctx, taskRepo, execution.TaskID, role)
+}
+
+// restricted reports whether project roles apply to the request, see
+// projectAccess
+func restricted(ctx context.Context) bool {
+	_, ok := repository.UserIDFromContext(ctx)
+	return ok || repository.IsAnonymous(ctx)
+}
+
+func (u *taskUsecase) authorizeTask(ctx context.Context, taskID uuid.UUID, role entity.ProjectRole) error {
+	return u.projectAccess.authorizeTask(ctx, u.taskRepo, taskID, role)
+}
+
+// authorizeTasks is authorizeTask for each of taskIDs
+func (u *taskUsecase) authorizeTasks(ctx context.Context, taskIDs []uuid.UUID, role entity.ProjectRole) error {
+	for _, taskID := range taskIDs {
+		if err := u.authorizeTask(ctx, taskID, role); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *taskUsecase) authorizeExecution(ctx context.Context, executionID uuid.UUID, role entity.ProjectRole) error {
+	return u.projectAccess.authorizeExecution(ctx, u.executionRepo, u.taskRepo, executionID, role)
+}
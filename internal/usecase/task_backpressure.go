@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ErrPlanningOverloaded is returned when new planning is refused because
+// too much work is already queued or running
+var ErrPlanningOverloaded = errors.New("too much planning work in progress, try again later")
+
+const (
+	// recentExecutionsSample is how many recent executions the wait is
+	// estimated from
+	recentExecutionsSample = 20
+	// defaultExecutionDuration is assumed when no execution finished yet
+	defaultExecutionDuration = 10 * time.Minute
+)
+
+// PlanningLimits are the load past which new planning is refused, rather
+// than queued behind hours of work. Zero disables a limit.
+type PlanningLimits struct {
+	// MaxQueueDepth is how many planning jobs may wait in the queue
+	MaxQueueDepth int
+	// MaxActiveExecutions is how many AI executions may be in flight
+	MaxActiveExecutions int
+}
+
+// PlanningOverloadError tells how loaded the system was when planning was
+// refused, and how long until it could likely start
+type PlanningOverloadError struct {
+	QueueDepth       int
+	ActiveExecutions int
+	EstimatedWait    time.Duration
+}
+
+func (e *PlanningOverloadError) Error() string {
+	return fmt.Sprintf("%s: %d planning jobs queued, %d executions in flight, estimated wait %s",
+		ErrPlanningOverloaded, e.QueueDepth, e.ActiveExecutions, e.EstimatedWait.Round(time.Second))
+}
+
+func (e *PlanningOverloadError) Unwrap() error {
+	return ErrPlanningOverloaded
+}
+
+// CheckPlanningCapacity returns a PlanningOverloadError when the planning
+// queue or the executions in flight are past their limits. The load is not
+// checked when it cannot be read, the limits being a safeguard only.
+func (u *taskUsecase) CheckPlanningCapacity(ctx context.Context) error {
+	limits := u.planningLimits
+	if limits.MaxQueueDepth <= 0 && limits.MaxActiveExecutions <= 0 {
+		return nil
+	}
+
+	queueDepth, err := u.jobClient.PlanningQueueDepth()
+	if err != nil {
+		slog.Warn("Failed to read planning queue depth", "error", err)
+		return nil
+	}
+	active, err := u.executionRepo.GetActive(ctx)
+	if err != nil {
+		slog.Warn("Failed to read active executions", "error", err)
+		return nil
+	}
+
+	overloaded := (limits.MaxQueueDepth > 0 && queueDepth >= limits.MaxQueueDepth) ||
+		(limits.MaxActiveExecutions > 0 && len(active) >= limits.MaxActiveExecutions)
+	if !overloaded {
+		return nil
+	}
+	return &PlanningOverloadError{
+		QueueDepth:       queueDepth,
+		ActiveExecutions: len(active),
+		EstimatedWait:    u.estimatePlanningWait(ctx, queueDepth, len(active)),
+	}
+}
+
+// estimatePlanningWait estimates when a job queued behind queueDepth others
+// would start, the executions in flight running in parallel and lasting as
+// long as the recent ones did
+func (u *taskUsecase) estimatePlanningWait(ctx context.Context, queueDepth, active int) time.Duration {
+	average := defaultExecutionDuration
+	recent, err := u.executionRepo.GetCompleted(ctx, recentExecutionsSample)
+	if err != nil {
+		slog.Warn("Failed to read recent executions", "error", err)
+	}
+	var total time.Duration
+	var finished int
+	for _, execution := range recent {
+		if execution.CompletedAt != nil {
+			total += execution.GetDuration()
+			finished++
+		}
+	}
+	if finished > 0 {
+		average = total / time.Duration(finished)
+	}
+
+	parallel := max(active, 1)
+	return time.Duration(queueDepth/parallel+1) * average
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func completedExecution(duration time.Duration) *entity.Execution {
+	started := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	completed := started.Add(duration)
+	return &entity.Execution{ID: uuid.New(), Status: entity.ExecutionStatusCompleted, StartedAt: started, CompletedAt: &completed}
+}
+
+func TestCheckPlanningCapacity(t *testing.T) {
+	ctx := context.Background()
+	active := []*entity.Execution{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	t.Run("no limits", func(t *testing.T) {
+		uc := &taskUsecase{jobClient: NewJobClientInterfaceMock(t), executionRepo: repository.NewExecutionRepositoryMock(t)}
+
+		assert.NoError(t, uc.CheckPlanningCapacity(ctx))
+	})
+
+	t.Run("under the limits", func(t *testing.T) {
+		jobClient := NewJobClientInterfaceMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient.EXPECT().PlanningQueueDepth().Return(3, nil).Once()
+		executionRepo.EXPECT().GetActive(ctx).Return(active, nil).Once()
+		uc := &taskUsecase{jobClient: jobClient, executionRepo: executionRepo, planningLimits: PlanningLimits{MaxQueueDepth: 10, MaxActiveExecutions: 4}}
+
+		assert.NoError(t, uc.CheckPlanningCapacity(ctx))
+	})
+
+	t.Run("queue too deep", func(t *testing.T) {
+		jobClient := NewJobClientInterfaceMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient.EXPECT().PlanningQueueDepth().Return(10, nil).Once()
+		executionRepo.EXPECT().GetActive(ctx).Return(active, nil).Once()
+		executionRepo.EXPECT().GetCompleted(ctx, recentExecutionsSample).Return([]*entity.Execution{
+			completedExecution(20 * time.Minute),
+			completedExecution(40 * time.Minute),
+		}, nil).Once()
+		uc := &taskUsecase{jobClient: jobClient, executionRepo: executionRepo, planningLimits: PlanningLimits{MaxQueueDepth: 10}}
+
+		err := uc.CheckPlanningCapacity(ctx)
+
+		require.ErrorIs(t, err, ErrPlanningOverloaded)
+		var overload *PlanningOverloadError
+		require.ErrorAs(t, err, &overload)
+		assert.Equal(t, 10, overload.QueueDepth)
+		assert.Equal(t, 2, overload.ActiveExecutions)
+		// 10 jobs ahead, 2 at a time, 30 minutes each
+		assert.Equal(t, 6*30*time.Minute, overload.EstimatedWait)
+	})
+
+	t.Run("too many executions without history", func(t *testing.T) {
+		jobClient := NewJobClientInterfaceMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient.EXPECT().PlanningQueueDepth().Return(0, nil).Once()
+		executionRepo.EXPECT().GetActive(ctx).Return(active, nil).Once()
+		executionRepo.EXPECT().GetCompleted(ctx, recentExecutionsSample).Return(nil, nil).Once()
+		uc := &taskUsecase{jobClient: jobClient, executionRepo: executionRepo, planningLimits: PlanningLimits{MaxActiveExecutions: 2}}
+
+		var overload *PlanningOverloadError
+		require.ErrorAs(t, uc.CheckPlanningCapacity(ctx), &overload)
+		assert.Equal(t, defaultExecutionDuration, overload.EstimatedWait)
+	})
+
+	t.Run("load unreadable", func(t *testing.T) {
+		jobClient := NewJobClientInterfaceMock(t)
+		jobClient.EXPECT().PlanningQueueDepth().Return(0, errors.New("redis: connection refused")).Once()
+		uc := &taskUsecase{jobClient: jobClient, executionRepo: repository.NewExecutionRepositoryMock(t), planningLimits: PlanningLimits{MaxQueueDepth: 1}}
+
+		assert.NoError(t, uc.CheckPlanningCapacity(ctx), "planning is not refused on a load it cannot read")
+	})
+}
+
+func TestStartPlanning_Overloaded(t *testing.T) {
+	ctx := context.Background()
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusTODO}
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	jobClient := NewJobClientInterfaceMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil).Once()
+	jobClient.EXPECT().PlanningQueueDepth().Return(5, nil).Once()
+	executionRepo.EXPECT().GetActive(ctx).Return(nil, nil).Once()
+	executionRepo.EXPECT().GetCompleted(ctx, mock.Anything).Return(nil, nil).Once()
+	uc := &taskUsecase{taskRepo: taskRepo, jobClient: jobClient, executionRepo: executionRepo, planningLimits: PlanningLimits{MaxQueueDepth: 5}}
+
+	// Nothing is enqueued nor the task updated
+	_, err := uc.StartPlanning(ctx, task.ID, "main", "claude-code", false, false, nil)
+
+	assert.ErrorIs(t, err, ErrPlanningOverloaded)
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// TaskBoard is a project's tasks in the columns of its Kanban board
+type TaskBoard struct {
+	ProjectID uuid.UUID
+	// Columns has one column per task status, in workflow order
+	Columns []*TaskBoardColumn
+}
+
+// TaskBoardColumn is the tasks in one status, most urgent and then most
+// recently updated first. Count is every task in the status, even when the
+// cards of the column were left out.
+type TaskBoardColumn struct {
+	Status entity.TaskStatus
+	Count  int
+	Tasks  []*entity.Task
+}
+
+// GetBoard groups the project's unarchived tasks by status with a single
+// query. The cards of the done column are left out unless includeDone, as
+// the board loads them separately; its count is still given. A non-nil
+// epicID limits the board, counts included, to the epic's tasks.
+func (u *taskUsecase) GetBoard(ctx context.Context, projectID uuid.UUID, includeDone bool, epicID *uuid.UUID) (*TaskBoard, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if epicID != nil {
+		if err := u.validateTaskEpic(ctx, projectID, *epicID); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, err := u.taskRepo.GetBoardTasks(ctx, projectID, epicID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	board := &TaskBoard{ProjectID: projectID}
+	columns := make(map[entity.TaskStatus]*TaskBoardColumn)
+	for _, status := range entity.GetAllTaskStatuses() {
+		column := &TaskBoardColumn{Status: status, Tasks: []*entity.Task{}}
+		columns[status] = column
+		board.Columns = append(board.Columns, column)
+	}
+	for _, task := range tasks {
+		column, ok := columns[task.Status]
+		if !ok {
+			continue
+		}
+		column.Count++
+		if task.Status == entity.TaskStatusDONE && !includeDone {
+			continue
+		}
+		column.Tasks = append(column.Tasks, task)
+	}
+	return board, nil
+}
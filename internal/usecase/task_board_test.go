@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBoard(t *testing.T) {
+	projectID := uuid.New()
+	task := func(status entity.TaskStatus) *entity.Task {
+		return &entity.Task{ID: uuid.New(), ProjectID: projectID, Status: status}
+	}
+	urgent := task(entity.TaskStatusTODO)
+	todo := task(entity.TaskStatusTODO)
+	implementing := task(entity.TaskStatusIMPLEMENTING)
+	done := task(entity.TaskStatusDONE)
+	boardTasks := []*entity.Task{urgent, implementing, todo, done}
+
+	newUsecase := func(t *testing.T) *taskUsecase {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+		taskRepo.EXPECT().GetBoardTasks(mock.Anything, projectID, (*uuid.UUID)(nil)).Return(boardTasks, nil)
+		return &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo}
+	}
+
+	t.Run("groups tasks into a column per status", func(t *testing.T) {
+		board, err := newUsecase(t).GetBoard(context.Background(), projectID, false, nil)
+		require.NoError(t, err)
+
+		require.Len(t, board.Columns, len(entity.GetAllTaskStatuses()))
+		columns := make(map[entity.TaskStatus]*TaskBoardColumn)
+		for i, column := range board.Columns {
+			assert.Equal(t, entity.GetAllTaskStatuses()[i], column.Status)
+			columns[column.Status] = column
+		}
+		assert.Equal(t, 2, columns[entity.TaskStatusTODO].Count)
+		assert.Equal(t, []*entity.Task{urgent, todo}, columns[entity.TaskStatusTODO].Tasks, "the repository's order is kept")
+		assert.Equal(t, []*entity.Task{implementing}, columns[entity.TaskStatusIMPLEMENTING].Tasks)
+		assert.Equal(t, 0, columns[entity.TaskStatusPLANNING].Count)
+		assert.NotNil(t, columns[entity.TaskStatusPLANNING].Tasks)
+
+		assert.Equal(t, 1, columns[entity.TaskStatusDONE].Count, "done tasks are counted")
+		assert.Empty(t, columns[entity.TaskStatusDONE].Tasks)
+	})
+
+	t.Run("includes done cards on request", func(t *testing.T) {
+		board, err := newUsecase(t).GetBoard(context.Background(), projectID, true, nil)
+		require.NoError(t, err)
+
+		for _, column := range board.Columns {
+			if column.Status == entity.TaskStatusDONE {
+				assert.Equal(t, []*entity.Task{done}, column.Tasks)
+			}
+		}
+	})
+
+	t.Run("filters by epic", func(t *testing.T) {
+		epicID := uuid.New()
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(&entity.Epic{ID: epicID, ProjectID: projectID}, nil)
+		taskRepo.EXPECT().GetBoardTasks(mock.Anything, projectID, &epicID).Return([]*entity.Task{implementing}, nil)
+		uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, epicRepo: epicRepo}
+
+		board, err := uc.GetBoard(context.Background(), projectID, false, &epicID)
+		require.NoError(t, err)
+		for _, column := range board.Columns {
+			if column.Status == entity.TaskStatusTODO {
+				assert.Equal(t, 0, column.Count, "only the epic's tasks are counted")
+			}
+		}
+	})
+
+	t.Run("epic of another project", func(t *testing.T) {
+		epicID := uuid.New()
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(&entity.Epic{ID: epicID, ProjectID: uuid.New()}, nil)
+		uc := &taskUsecase{projectRepo: projectRepo, epicRepo: epicRepo}
+
+		_, err := uc.GetBoard(context.Background(), projectID, false, &epicID)
+		assert.ErrorIs(t, err, ErrEpicProjectMismatch)
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(nil, errors.New("record not found"))
+		uc := &taskUsecase{projectRepo: projectRepo}
+
+		_, err := uc.GetBoard(context.Background(), projectID, false, nil)
+		assert.ErrorIs(t, err, ErrProjectNotFound)
+	})
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newComparisonTestUsecase(t *testing.T) (*taskUsecase, *repository.TaskRepositoryMock, *repository.ExecutionRepositoryMock, *JobClientInterfaceMock) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	jobClient := NewJobClientInterfaceMock(t)
+	uc := &taskUsecase{
+		taskRepo:      taskRepo,
+		executionRepo: executionRepo,
+		jobClient:     jobClient,
+		gitManager:    gitManager,
+	}
+	return uc, taskRepo, executionRepo, jobClient
+}
+
+func comparisonTestExecutions(taskID uuid.UUID, statuses ...entity.ExecutionStatus) []*entity.Execution {
+	comparisonID := uuid.New()
+	executions := make([]*entity.Execution, len(statuses))
+	for i, status := range statuses {
+		variant := ComparisonVariantName(i)
+		executions[i] = &entity.Execution{
+			ID:           uuid.New(),
+			TaskID:       taskID,
+			Status:       status,
+			ComparisonID: &comparisonID,
+			Variant:      variant,
+			WorktreePath: "/worktrees/variants/task-" + variant,
+			BranchName:   "task-branch-" + variant,
+		}
+	}
+	return executions
+}
+
+func TestStartComparison(t *testing.T) {
+	uc, taskRepo, _, jobClient := newComparisonTestUsecase(t)
+	baseBranch := "main"
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusTODO, BaseBranchName: &baseBranch}
+
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+	jobClient.EXPECT().EnqueueTaskComparison(&TaskComparisonPayload{
+		TaskID:    task.ID,
+		ProjectID: task.ProjectID,
+		AITypes:   []string{"claude-code", "cursor-agent"},
+	}, mock.Anything).Return("job-1", nil).Once()
+
+	jobID, err := uc.StartComparison(context.Background(), task.ID, "main", []string{"claude-code", "cursor-agent"})
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", jobID)
+}
+
+func TestStartComparison_Invalid(t *testing.T) {
+	uc, taskRepo, _, _ := newComparisonTestUsecase(t)
+	taskID := uuid.New()
+
+	_, err := uc.StartComparison(context.Background(), taskID, "", []string{"claude-code"})
+	assert.ErrorIs(t, err, ErrComparisonExecutorsInvalid)
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, Status: entity.TaskStatusIMPLEMENTING}, nil).Once()
+	_, err = uc.StartComparison(context.Background(), taskID, "", []string{"claude-code", "cursor-agent"})
+	assert.ErrorIs(t, err, ErrTaskNotReadyForComparison)
+}
+
+func TestGetComparison(t *testing.T) {
+	uc, taskRepo, executionRepo, _ := newComparisonTestUsecase(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc.projectRepo = projectRepo
+	ctx := context.Background()
+
+	repo, _, worktreePath := initTaskWorktree(t)
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), WorktreePath: &worktreePath}
+	executions := comparisonTestExecutions(task.ID, entity.ExecutionStatusCompleted, entity.ExecutionStatusRunning)
+	executions[0].WorktreePath = worktreePath
+
+	// Variant a finished with an uncommitted file; b is still running
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "login.go"), []byte("package login\n"), 0o644))
+	_, err := uc.gitManager.CreateSnapshot(ctx, worktreePath, task.ID.String(), ComparisonResultSnapshotID(executions[0].ID), "Result of variant a")
+	require.NoError(t, err)
+
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Once()
+	executionRepo.EXPECT().GetLatestComparison(mock.Anything, task.ID).Return(executions, nil).Once()
+	projectRepo.EXPECT().GetByID(mock.Anything, task.ProjectID).Return(&entity.Project{ID: task.ProjectID, WorktreeBasePath: repo}, nil).Once()
+
+	comparison, err := uc.GetComparison(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, *executions[0].ComparisonID, comparison.ID)
+	assert.Equal(t, "main", comparison.BaseBranchName)
+	require.Len(t, comparison.Variants, 2)
+
+	assert.Contains(t, comparison.Variants[0].Diff, "+package login")
+	assert.True(t, comparison.Variants[0].Selected)
+	assert.Empty(t, comparison.Variants[1].Diff)
+	assert.False(t, comparison.Variants[1].Selected)
+}
+
+func TestGetComparison_NotFound(t *testing.T) {
+	uc, taskRepo, executionRepo, _ := newComparisonTestUsecase(t)
+	taskID := uuid.New()
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID}, nil).Once()
+	executionRepo.EXPECT().GetLatestComparison(mock.Anything, taskID).Return([]*entity.Execution{}, nil).Once()
+
+	_, err := uc.GetComparison(context.Background(), taskID)
+	assert.ErrorIs(t, err, ErrComparisonNotFound)
+}
+
+func TestSelectComparisonWinner(t *testing.T) {
+	uc, taskRepo, executionRepo, jobClient := newComparisonTestUsecase(t)
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	executions := comparisonTestExecutions(task.ID, entity.ExecutionStatusCompleted, entity.ExecutionStatusFailed)
+
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	executionRepo.EXPECT().GetLatestComparison(mock.Anything, task.ID).Return(executions, nil)
+	jobClient.EXPECT().EnqueueComparisonSelect(&ComparisonSelectPayload{
+		TaskID:      task.ID,
+		ProjectID:   task.ProjectID,
+		ExecutionID: executions[0].ID,
+	}).Return("job-1", nil).Once()
+
+	jobID, err := uc.SelectComparisonWinner(context.Background(), task.ID, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", jobID)
+
+	_, err = uc.SelectComparisonWinner(context.Background(), task.ID, "b")
+	assert.ErrorIs(t, err, ErrComparisonVariantNotCompleted)
+
+	_, err = uc.SelectComparisonWinner(context.Background(), task.ID, "c")
+	assert.ErrorIs(t, err, ErrComparisonVariantNotFound)
+}
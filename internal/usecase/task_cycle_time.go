@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+var ErrDateRangeInvalid = errors.New("the end of the date range is before its start")
+
+// TaskCycleTime is how long a completed task took. LeadTime runs from the
+// task's creation to its completion, CycleTime from when work on it started.
+type TaskCycleTime struct {
+	TaskID      uuid.UUID
+	Title       string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	CompletedAt time.Time
+	LeadTime    time.Duration
+	// CycleTime is nil for a task that went to DONE without being worked on
+	CycleTime *time.Duration
+	// TimeInStatus is the time spent in each status before completion
+	TimeInStatus map[entity.TaskStatus]time.Duration
+}
+
+// DurationPercentiles summarises a set of durations
+type DurationPercentiles struct {
+	Count   int
+	Average time.Duration
+	P50     time.Duration
+	P75     time.Duration
+	P90     time.Duration
+	P95     time.Duration
+}
+
+// CycleTimeAnalytics covers the tasks of a project completed within a date
+// range
+type CycleTimeAnalytics struct {
+	ProjectID           uuid.UUID
+	From                *time.Time
+	To                  *time.Time
+	LeadTime            DurationPercentiles
+	CycleTime           DurationPercentiles
+	AverageTimeInStatus map[entity.TaskStatus]time.Duration
+	Tasks               []*TaskCycleTime
+	GeneratedAt         time.Time
+}
+
+// GetCycleTimeAnalytics measures the lead time, cycle time and time in each
+// status of the project's tasks that were completed between from and to.
+// Either bound may be nil. Tasks are measured from their status history, so
+// a task completed without a recorded history is left out.
+func (u *taskUsecase) GetCycleTimeAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*CycleTimeAnalytics, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, ErrDateRangeInvalid
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	historyByTask := make(map[uuid.UUID][]*entity.TaskStatusHistory)
+	for _, history := range histories {
+		historyByTask[history.TaskID] = append(historyByTask[history.TaskID], history)
+	}
+
+	analytics := &CycleTimeAnalytics{
+		ProjectID:           projectID,
+		From:                from,
+		To:                  to,
+		AverageTimeInStatus: make(map[entity.TaskStatus]time.Duration),
+		Tasks:               []*TaskCycleTime{},
+		GeneratedAt:         time.Now(),
+	}
+
+	var leadTimes, cycleTimes []time.Duration
+	totalInStatus := make(map[entity.TaskStatus]time.Duration)
+	for _, task := range tasks {
+		cycleTime := measureTaskCycleTime(task, historyByTask[task.ID])
+		if cycleTime == nil ||
+			(from != nil && cycleTime.CompletedAt.Before(*from)) ||
+			(to != nil && cycleTime.CompletedAt.After(*to)) {
+			continue
+		}
+
+		analytics.Tasks = append(analytics.Tasks, cycleTime)
+		leadTimes = append(leadTimes, cycleTime.LeadTime)
+		if cycleTime.CycleTime != nil {
+			cycleTimes = append(cycleTimes, *cycleTime.CycleTime)
+		}
+		for status, d := range cycleTime.TimeInStatus {
+			totalInStatus[status] += d
+		}
+	}
+
+	sort.Slice(analytics.Tasks, func(i, j int) bool {
+		return analytics.Tasks[i].CompletedAt.Before(analytics.Tasks[j].CompletedAt)
+	})
+	analytics.LeadTime = durationPercentiles(leadTimes)
+	analytics.CycleTime = durationPercentiles(cycleTimes)
+	for status, total := range totalInStatus {
+		analytics.AverageTimeInStatus[status] = total / time.Duration(len(analytics.Tasks))
+	}
+
+	return analytics, nil
+}
+
+// measureTaskCycleTime replays a task's status history, oldest first, and
+// returns nil unless the task ended up DONE. Work starts the first time the
+// task leaves TODO for a status other than DONE or CANCELLED.
+func measureTaskCycleTime(task *entity.Task, history []*entity.TaskStatusHistory) *TaskCycleTime {
+	status := entity.TaskStatusTODO
+	if len(history) > 0 && history[0].FromStatus != nil {
+		status = *history[0].FromStatus
+	}
+	since := task.CreatedAt
+
+	timeInStatus := make(map[entity.TaskStatus]time.Duration)
+	var startedAt, completedAt *time.Time
+	for _, change := range history {
+		// The initial entry has no previous status to account for
+		if change.FromStatus != nil && status != entity.TaskStatusDONE && status != entity.TaskStatusCANCELLED {
+			timeInStatus[status] += change.CreatedAt.Sub(since)
+		}
+		status, since = change.ToStatus, change.CreatedAt
+
+		changedAt := change.CreatedAt
+		switch status {
+		case entity.TaskStatusDONE:
+			completedAt = &changedAt
+		case entity.TaskStatusTODO, entity.TaskStatusCANCELLED:
+			completedAt = nil
+		default:
+			completedAt = nil
+			if startedAt == nil {
+				startedAt = &changedAt
+			}
+		}
+	}
+	if status != entity.TaskStatusDONE || completedAt == nil {
+		return nil
+	}
+
+	cycleTime := &TaskCycleTime{
+		TaskID:       task.ID,
+		Title:        task.Title,
+		CreatedAt:    task.CreatedAt,
+		StartedAt:    startedAt,
+		CompletedAt:  *completedAt,
+		LeadTime:     completedAt.Sub(task.CreatedAt),
+		TimeInStatus: timeInStatus,
+	}
+	if startedAt != nil {
+		d := completedAt.Sub(*startedAt)
+		cycleTime.CycleTime = &d
+	}
+	return cycleTime
+}
+
+// durationPercentiles computes the average and percentiles of durations,
+// interpolating between the closest ranks
+func durationPercentiles(durations []time.Duration) DurationPercentiles {
+	stats := DurationPercentiles{Count: len(durations)}
+	if len(durations) == 0 {
+		return stats
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	stats.Average = total / time.Duration(len(sorted))
+
+	percentile := func(p float64) time.Duration {
+		rank := p / 100 * float64(len(sorted)-1)
+		lower := int(math.Floor(rank))
+		upper := int(math.Ceil(rank))
+		frac := rank - float64(lower)
+		return sorted[lower] + time.Duration(math.Round(frac*float64(sorted[upper]-sorted[lower])))
+	}
+	stats.P50 = percentile(50)
+	stats.P75 = percentile(75)
+	stats.P90 = percentile(90)
+	stats.P95 = percentile(95)
+	return stats
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// statusChanges builds a task's status history from status and hours after
+// created pairs
+func statusChanges(taskID uuid.UUID, created time.Time, changes ...any) []*entity.TaskStatusHistory {
+	var history []*entity.TaskStatusHistory
+	from := entity.TaskStatusTODO
+	for i := 0; i < len(changes); i += 2 {
+		to := changes[i].(entity.TaskStatus)
+		previous := from
+		history = append(history, &entity.TaskStatusHistory{
+			TaskID:     taskID,
+			FromStatus: &previous,
+			ToStatus:   to,
+			CreatedAt:  created.Add(time.Duration(changes[i+1].(int)) * time.Hour),
+		})
+		from = to
+	}
+	return history
+}
+
+func TestMeasureTaskCycleTime(t *testing.T) {
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	task := &entity.Task{ID: uuid.New(), Title: "Add login", CreatedAt: created}
+
+	history := statusChanges(task.ID, created,
+		entity.TaskStatusPLANNING, 10,
+		entity.TaskStatusPLANREVIEWING, 12,
+		entity.TaskStatusIMPLEMENTING, 20,
+		entity.TaskStatusCODEREVIEWING, 26,
+		entity.TaskStatusDONE, 30,
+	)
+	cycleTime := measureTaskCycleTime(task, history)
+	require.NotNil(t, cycleTime)
+	assert.Equal(t, 30*time.Hour, cycleTime.LeadTime)
+	require.NotNil(t, cycleTime.CycleTime)
+	assert.Equal(t, 20*time.Hour, *cycleTime.CycleTime)
+	assert.Equal(t, map[entity.TaskStatus]time.Duration{
+		entity.TaskStatusTODO:          10 * time.Hour,
+		entity.TaskStatusPLANNING:      2 * time.Hour,
+		entity.TaskStatusPLANREVIEWING: 8 * time.Hour,
+		entity.TaskStatusIMPLEMENTING:  6 * time.Hour,
+		entity.TaskStatusCODEREVIEWING: 4 * time.Hour,
+	}, cycleTime.TimeInStatus)
+
+	t.Run("not done", func(t *testing.T) {
+		assert.Nil(t, measureTaskCycleTime(task, history[:4]))
+		assert.Nil(t, measureTaskCycleTime(task, nil))
+	})
+
+	t.Run("straight to done", func(t *testing.T) {
+		cycleTime := measureTaskCycleTime(task, statusChanges(task.ID, created, entity.TaskStatusDONE, 5))
+		require.NotNil(t, cycleTime)
+		assert.Equal(t, 5*time.Hour, cycleTime.LeadTime)
+		assert.Nil(t, cycleTime.CycleTime)
+	})
+
+	t.Run("reopened", func(t *testing.T) {
+		reopened := statusChanges(task.ID, created,
+			entity.TaskStatusIMPLEMENTING, 1,
+			entity.TaskStatusDONE, 2,
+			entity.TaskStatusIMPLEMENTING, 10,
+			entity.TaskStatusDONE, 12,
+		)
+		cycleTime := measureTaskCycleTime(task, reopened)
+		require.NotNil(t, cycleTime)
+		assert.Equal(t, 12*time.Hour, cycleTime.LeadTime, "measured to the last completion")
+		assert.Equal(t, 11*time.Hour, *cycleTime.CycleTime)
+		assert.Equal(t, 3*time.Hour, cycleTime.TimeInStatus[entity.TaskStatusIMPLEMENTING])
+		assert.NotContains(t, cycleTime.TimeInStatus, entity.TaskStatusDONE)
+	})
+}
+
+func TestDurationPercentiles(t *testing.T) {
+	var durations []time.Duration
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Hour)
+	}
+
+	stats := durationPercentiles(durations)
+	assert.Equal(t, 10, stats.Count)
+	assert.Equal(t, 5*time.Hour+30*time.Minute, stats.Average)
+	assert.Equal(t, 5*time.Hour+30*time.Minute, stats.P50)
+	assert.Equal(t, 7*time.Hour+45*time.Minute, stats.P75)
+	assert.Equal(t, 9*time.Hour+6*time.Minute, stats.P90)
+	assert.Equal(t, 9*time.Hour+33*time.Minute, stats.P95)
+
+	assert.Equal(t, DurationPercentiles{}, durationPercentiles(nil))
+}
+
+func TestGetCycleTimeAnalytics(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo}
+	projectID := uuid.New()
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	march := &entity.Task{ID: uuid.New(), ProjectID: projectID, CreatedAt: created}
+	april := &entity.Task{ID: uuid.New(), ProjectID: projectID, CreatedAt: created}
+	open := &entity.Task{ID: uuid.New(), ProjectID: projectID, CreatedAt: created}
+	var histories []*entity.TaskStatusHistory
+	histories = append(histories, statusChanges(march.ID, created, entity.TaskStatusIMPLEMENTING, 2, entity.TaskStatusDONE, 10)...)
+	histories = append(histories, statusChanges(april.ID, created, entity.TaskStatusIMPLEMENTING, 24*31, entity.TaskStatusDONE, 24*31+4)...)
+	histories = append(histories, statusChanges(open.ID, created, entity.TaskStatusIMPLEMENTING, 1)...)
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{march, april, open}, nil)
+	taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, projectID).Return(histories, nil)
+
+	analytics, err := uc.GetCycleTimeAnalytics(context.Background(), projectID, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Tasks, 2)
+	assert.Equal(t, march.ID, analytics.Tasks[0].TaskID, "oldest completion first")
+	assert.Equal(t, 2, analytics.LeadTime.Count)
+	assert.Equal(t, 6*time.Hour, analytics.CycleTime.Average)
+
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	analytics, err = uc.GetCycleTimeAnalytics(context.Background(), projectID, &from, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Tasks, 1)
+	assert.Equal(t, april.ID, analytics.Tasks[0].TaskID)
+	assert.Equal(t, 4*time.Hour, analytics.CycleTime.P50)
+	assert.Equal(t, 4*time.Hour, analytics.AverageTimeInStatus[entity.TaskStatusIMPLEMENTING])
+
+	to := from.Add(-time.Hour)
+	_, err = uc.GetCycleTimeAnalytics(context.Background(), projectID, &from, &to)
+	assert.ErrorIs(t, err, ErrDateRangeInvalid)
+}
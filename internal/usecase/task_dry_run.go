@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+)
+
+// DryRunVariant names the throwaway worktree a dry run executes in
+const DryRunVariant = "dry-run"
+
+var ErrTaskNotReadyForDryRun = errors.New("task must be in TODO or PLAN_REVIEWING status for a dry run")
+
+// StartDryRun enqueues an implementation of the task that runs in a
+// throwaway worktree. Its diff is attached to the task for preview; nothing
+// is committed or pushed and the task keeps its status and worktree.
+func (u *taskUsecase) StartDryRun(ctx context.Context, taskID uuid.UUID, aiType string) (string, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return "", err
+	}
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if task.Status != entity.TaskStatusTODO && task.Status != entity.TaskStatusPLANREVIEWING {
+		return "", fmt.Errorf("%w, current status: %s", ErrTaskNotReadyForDryRun, task.Status)
+	}
+
+	jobID, err := u.jobClient.EnqueueTaskImplementation(&TaskImplementationPayload{
+		TaskID:       taskID,
+		ProjectID:    task.ProjectID,
+		AIType:       aiType,
+		DryRun:       true,
+		TraceContext: tracing.Inject(ctx),
+	}, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue dry run job: %w", err)
+	}
+
+	return jobID, nil
+}
+
+// DryRunDiffFilename names the attachment holding the diff of a dry run
+func DryRunDiffFilename(executionID uuid.UUID) string {
+	return fmt.Sprintf("dry-run-%s.diff", executionID.String()[:8])
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enqueues a dry run implementation", func(t *testing.T) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusPLANREVIEWING}
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil).Once()
+		jobClient := NewJobClientInterfaceMock(t)
+		jobClient.EXPECT().EnqueueTaskImplementation(&TaskImplementationPayload{
+			TaskID:    task.ID,
+			ProjectID: task.ProjectID,
+			AIType:    "claude-code",
+			DryRun:    true,
+		}, time.Duration(0)).Return("job-1", nil).Once()
+		uc := &taskUsecase{taskRepo: taskRepo, jobClient: jobClient}
+
+		jobID, err := uc.StartDryRun(ctx, task.ID, "claude-code")
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+	})
+
+	t.Run("task being implemented", func(t *testing.T) {
+		task := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING}
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil).Once()
+		uc := &taskUsecase{taskRepo: taskRepo, jobClient: NewJobClientInterfaceMock(t)}
+
+		_, err := uc.StartDryRun(ctx, task.ID, "claude-code")
+		assert.ErrorIs(t, err, ErrTaskNotReadyForDryRun)
+	})
+}
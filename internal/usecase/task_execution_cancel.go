@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// CancelExecution enqueues the job cancelling an execution. The job runs on
+// the worker running the execution's AI CLI process, which kills it; an
+// execution waiting for input has no process, and is cancelled by any
+// worker.
+func (u *taskUsecase) CancelExecution(ctx context.Context, executionID uuid.UUID) (*entity.Execution, string, error) {
+	if err := u.authorizeExecution(ctx, executionID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, "", err
+	}
+	execution, err := u.executionRepo.GetByID(ctx, executionID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrExecutionNotFound, err)
+	}
+	if execution.IsCompleted() {
+		return nil, "", fmt.Errorf("%w: it already finished with status %s", ErrExecutionNotCancellable, execution.Status)
+	}
+	if execution.Variant != "" {
+		// Comparison and dry-run executions are not tracked by the worker
+		return nil, "", fmt.Errorf("%w: %s executions run to completion", ErrExecutionNotCancellable, execution.Variant)
+	}
+
+	worker := execution.Worker
+	if execution.Status == entity.ExecutionStatusWaitingInput {
+		worker = ""
+	}
+	jobID, err := u.jobClient.EnqueueExecutionCancel(&ExecutionCancelPayload{
+		ExecutionID: execution.ID,
+		Worker:      worker,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to enqueue execution cancel job: %w", err)
+	}
+	return execution, jobID, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelExecution(t *testing.T) {
+	newExecution := func(status entity.ExecutionStatus) *entity.Execution {
+		return &entity.Execution{ID: uuid.New(), TaskID: uuid.New(), Status: status, Worker: "worker-1"}
+	}
+
+	t.Run("routes the job to the worker running the execution", func(t *testing.T) {
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo, jobClient: jobClient}
+		execution := newExecution(entity.ExecutionStatusRunning)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		jobClient.EXPECT().EnqueueExecutionCancel(&ExecutionCancelPayload{ExecutionID: execution.ID, Worker: "worker-1"}).Return("job-1", nil)
+
+		cancelled, jobID, err := uc.CancelExecution(context.Background(), execution.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+		assert.Equal(t, execution.ID, cancelled.ID)
+	})
+
+	t.Run("lets any worker cancel an execution waiting for input", func(t *testing.T) {
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo, jobClient: jobClient}
+		execution := newExecution(entity.ExecutionStatusWaitingInput)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		jobClient.EXPECT().EnqueueExecutionCancel(&ExecutionCancelPayload{ExecutionID: execution.ID}).Return("job-1", nil)
+
+		_, _, err := uc.CancelExecution(context.Background(), execution.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects finished and variant executions", func(t *testing.T) {
+		finished := newExecution(entity.ExecutionStatusCompleted)
+		variant := newExecution(entity.ExecutionStatusRunning)
+		variant.Variant = DryRunVariant
+		for _, execution := range []*entity.Execution{finished, variant} {
+			executionRepo := repository.NewExecutionRepositoryMock(t)
+			uc := &taskUsecase{executionRepo: executionRepo}
+			executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+
+			_, _, err := uc.CancelExecution(context.Background(), execution.ID)
+			assert.ErrorIs(t, err, ErrExecutionNotCancellable)
+		}
+	})
+}
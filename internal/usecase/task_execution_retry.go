@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+)
+
+// RetryExecution enqueues the job running a failed execution's phase again.
+// The job records its execution as the next attempt, linked to the failed
+// one, and is skipped when the failed execution was retried or its task
+// moved on in the meantime.
+func (u *taskUsecase) RetryExecution(ctx context.Context, executionID uuid.UUID, delay time.Duration) (*entity.Execution, string, error) {
+	if err := u.authorizeExecution(ctx, executionID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, "", err
+	}
+	execution, err := u.executionRepo.GetByID(ctx, executionID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrExecutionNotFound, err)
+	}
+	if execution.Status != entity.ExecutionStatusFailed {
+		return nil, "", fmt.Errorf("%w: only failed executions are retried, its status is %s", ErrExecutionNotRetryable, execution.Status)
+	}
+	if execution.Phase == "" {
+		// Comparison and dry-run executions are started again from the task
+		return nil, "", fmt.Errorf("%w: only planning and implementation executions are retried", ErrExecutionNotRetryable)
+	}
+	retries, err := u.executionRepo.GetRetries(ctx, execution.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get execution retries: %w", err)
+	}
+	if len(retries) > 0 {
+		return nil, "", fmt.Errorf("%w: it was already retried by execution %s", ErrExecutionNotRetryable, retries[0].ID)
+	}
+	task, err := u.taskRepo.GetByID(ctx, execution.TaskID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if !CanRetryExecution(execution.Phase, task.Status) {
+		return nil, "", fmt.Errorf("%w: its task moved on to %s", ErrExecutionNotRetryable, task.Status)
+	}
+
+	attempt := execution.CurrentAttempt() + 1
+	var jobID string
+	if execution.Phase == entity.ExecutionPhasePlanning {
+		if delay == 0 {
+			if err := u.CheckPlanningCapacity(ctx); err != nil {
+				return nil, "", err
+			}
+		}
+		jobID, err = u.jobClient.EnqueueTaskPlanning(&TaskPlanningPayload{
+			TaskID:       task.ID,
+			ProjectID:    task.ProjectID,
+			AIType:       execution.AIType,
+			RetryOfID:    &execution.ID,
+			Attempt:      attempt,
+			TraceContext: tracing.Inject(ctx),
+		}, delay)
+	} else {
+		jobID, err = u.jobClient.EnqueueTaskImplementation(&TaskImplementationPayload{
+			TaskID:       task.ID,
+			ProjectID:    task.ProjectID,
+			AIType:       execution.AIType,
+			RetryOfID:    &execution.ID,
+			Attempt:      attempt,
+			TraceContext: tracing.Inject(ctx),
+		}, delay)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to enqueue %s retry job: %w", execution.Phase, err)
+	}
+	return execution, jobID, nil
+}
+
+// CanRetryExecution reports whether a failed execution of phase can be
+// retried while its task is in status, the status its failure left the task
+// in. Follow-ups of a change request, whose task went back to code review,
+// are requested again instead.
+func CanRetryExecution(phase entity.ExecutionPhase, status entity.TaskStatus) bool {
+	switch phase {
+	case entity.ExecutionPhasePlanning:
+		return status == entity.TaskStatusTODO
+	case entity.ExecutionPhaseImplementation:
+		return status == entity.TaskStatusTODO || status == entity.TaskStatusPLANREVIEWING
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryExecution(t *testing.T) {
+	newFailed := func(phase entity.ExecutionPhase, attempt int) (*entity.Execution, *entity.Task) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusTODO}
+		return &entity.Execution{ID: uuid.New(), TaskID: task.ID, Status: entity.ExecutionStatusFailed,
+			Phase: phase, Attempt: attempt, AIType: "claude-code"}, task
+	}
+
+	t.Run("enqueues the next attempt of a failed implementation", func(t *testing.T) {
+		execution, task := newFailed(entity.ExecutionPhaseImplementation, 1)
+		task.Status = entity.TaskStatusPLANREVIEWING
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo, taskRepo: taskRepo, jobClient: jobClient}
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		executionRepo.EXPECT().GetRetries(mock.Anything, execution.ID).Return([]*entity.Execution{}, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		jobClient.EXPECT().EnqueueTaskImplementation(&TaskImplementationPayload{
+			TaskID:    task.ID,
+			ProjectID: task.ProjectID,
+			AIType:    "claude-code",
+			RetryOfID: &execution.ID,
+			Attempt:   2,
+		}, 30*time.Second).Return("job-1", nil)
+
+		retried, jobID, err := uc.RetryExecution(context.Background(), execution.ID, 30*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+		assert.Equal(t, execution.ID, retried.ID)
+	})
+
+	t.Run("counts executions recorded before attempts as the first", func(t *testing.T) {
+		execution, task := newFailed(entity.ExecutionPhasePlanning, 0)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo, taskRepo: taskRepo, jobClient: jobClient}
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		executionRepo.EXPECT().GetRetries(mock.Anything, execution.ID).Return(nil, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		jobClient.EXPECT().EnqueueTaskPlanning(&TaskPlanningPayload{
+			TaskID:    task.ID,
+			ProjectID: task.ProjectID,
+			AIType:    "claude-code",
+			RetryOfID: &execution.ID,
+			Attempt:   2,
+		}, time.Duration(0)).Return("job-1", nil)
+
+		_, _, err := uc.RetryExecution(context.Background(), execution.ID, 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects executions that did not fail or have no phase", func(t *testing.T) {
+		completed, _ := newFailed(entity.ExecutionPhasePlanning, 1)
+		completed.Status = entity.ExecutionStatusCompleted
+		variant, _ := newFailed("", 1)
+		for _, execution := range []*entity.Execution{completed, variant} {
+			executionRepo := repository.NewExecutionRepositoryMock(t)
+			uc := &taskUsecase{executionRepo: executionRepo}
+			executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+
+			_, _, err := uc.RetryExecution(context.Background(), execution.ID, 0)
+			assert.ErrorIs(t, err, ErrExecutionNotRetryable)
+		}
+	})
+
+	t.Run("rejects an execution already retried", func(t *testing.T) {
+		execution, _ := newFailed(entity.ExecutionPhasePlanning, 1)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo}
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		executionRepo.EXPECT().GetRetries(mock.Anything, execution.ID).Return([]*entity.Execution{{ID: uuid.New()}}, nil)
+
+		_, _, err := uc.RetryExecution(context.Background(), execution.ID, 0)
+		assert.ErrorIs(t, err, ErrExecutionNotRetryable)
+	})
+
+	t.Run("rejects an execution whose task moved on", func(t *testing.T) {
+		execution, task := newFailed(entity.ExecutionPhaseImplementation, 1)
+		// A failed follow-up of a change request left the task in code review
+		task.Status = entity.TaskStatusCODEREVIEWING
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo, taskRepo: taskRepo}
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		executionRepo.EXPECT().GetRetries(mock.Anything, execution.ID).Return(nil, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+
+		_, _, err := uc.RetryExecution(context.Background(), execution.ID, 0)
+		assert.ErrorIs(t, err, ErrExecutionNotRetryable)
+	})
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// ExecutorStats is how one executor fared on a project's tasks
+type ExecutorStats struct {
+	AIType     string
+	Executions int
+	Completed  int
+	Failed     int
+	Cancelled  int
+	// SuccessRate is the share of finished runs that completed, nil when
+	// none finished. Cancelled runs are left out.
+	SuccessRate *float64
+	// AverageDuration is over completed runs
+	AverageDuration time.Duration
+	// AverageFixAttempts is how many times, on average, a completed run
+	// needed the AI to fix a failing build or test
+	AverageFixAttempts float64
+	Tasks              int
+	AverageRunsPerTask float64
+	// PullRequests counts the pull requests opened from the executor's
+	// changes. MergeRate is the share of those merged among the merged and
+	// closed ones, nil while all are open.
+	PullRequests int
+	Merged       int
+	Closed       int
+	MergeRate    *float64
+	CostUSD      float64
+}
+
+// ExecutorAnalytics compares the executors that ran a project's tasks
+type ExecutorAnalytics struct {
+	ProjectID   uuid.UUID
+	From        *time.Time
+	To          *time.Time
+	Executors   []*ExecutorStats
+	GeneratedAt time.Time
+}
+
+// GetExecutorAnalytics compares the executors on the project's executions
+// started between from and to, either of which may be nil. Executions from
+// before the executor was recorded are left out. A pull request is credited
+// to the executor of the last completed execution of its task started before
+// the pull request was opened.
+func (u *taskUsecase) GetExecutorAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*ExecutorAnalytics, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, ErrDateRangeInvalid
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	analytics := &ExecutorAnalytics{
+		ProjectID:   projectID,
+		From:        from,
+		To:          to,
+		Executors:   []*ExecutorStats{},
+		GeneratedAt: time.Now(),
+	}
+	if len(tasks) == 0 {
+		return analytics, nil
+	}
+	taskIDs := make([]uuid.UUID, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+
+	executions, err := u.executionRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get executions: %w", err)
+	}
+	pullRequests, err := u.pullRequestRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	byType := make(map[string]*ExecutorStats)
+	tasksByType := make(map[string]map[uuid.UUID]bool)
+	durations := make(map[string]time.Duration)
+	fixAttempts := make(map[string]int)
+	completedByTask := make(map[uuid.UUID][]*entity.Execution)
+	for _, execution := range executions {
+		if execution.AIType == "" ||
+			(from != nil && execution.StartedAt.Before(*from)) ||
+			(to != nil && execution.StartedAt.After(*to)) {
+			continue
+		}
+		stats, ok := byType[execution.AIType]
+		if !ok {
+			stats = &ExecutorStats{AIType: execution.AIType}
+			byType[execution.AIType] = stats
+			tasksByType[execution.AIType] = make(map[uuid.UUID]bool)
+		}
+
+		stats.Executions++
+		stats.CostUSD += execution.CostUSD
+		tasksByType[execution.AIType][execution.TaskID] = true
+		switch execution.Status {
+		case entity.ExecutionStatusCompleted:
+			stats.Completed++
+			fixAttempts[execution.AIType] += execution.FixAttempts
+			if execution.CompletedAt != nil {
+				durations[execution.AIType] += execution.CompletedAt.Sub(execution.StartedAt)
+			}
+			completedByTask[execution.TaskID] = append(completedByTask[execution.TaskID], execution)
+		case entity.ExecutionStatusFailed:
+			stats.Failed++
+		case entity.ExecutionStatusCancelled:
+			stats.Cancelled++
+		}
+	}
+
+	for _, pr := range pullRequests {
+		execution := executionBefore(completedByTask[pr.TaskID], pr.CreatedAt)
+		if execution == nil {
+			continue
+		}
+		stats := byType[execution.AIType]
+		stats.PullRequests++
+		switch pr.Status {
+		case entity.PullRequestStatusMerged:
+			stats.Merged++
+		case entity.PullRequestStatusClosed:
+			stats.Closed++
+		}
+	}
+
+	for aiType, stats := range byType {
+		if finished := stats.Completed + stats.Failed; finished > 0 {
+			rate := float64(stats.Completed) / float64(finished)
+			stats.SuccessRate = &rate
+		}
+		if stats.Completed > 0 {
+			stats.AverageDuration = durations[aiType] / time.Duration(stats.Completed)
+			stats.AverageFixAttempts = float64(fixAttempts[aiType]) / float64(stats.Completed)
+		}
+		stats.Tasks = len(tasksByType[aiType])
+		stats.AverageRunsPerTask = float64(stats.Executions) / float64(stats.Tasks)
+		if decided := stats.Merged + stats.Closed; decided > 0 {
+			rate := float64(stats.Merged) / float64(decided)
+			stats.MergeRate = &rate
+		}
+		analytics.Executors = append(analytics.Executors, stats)
+	}
+	sort.Slice(analytics.Executors, func(i, j int) bool {
+		return analytics.Executors[i].AIType < analytics.Executors[j].AIType
+	})
+
+	return analytics, nil
+}
+
+// executionBefore returns the last of the completed executions started
+// before t
+func executionBefore(executions []*entity.Execution, t time.Time) *entity.Execution {
+	var latest *entity.Execution
+	for _, execution := range executions {
+		if execution.StartedAt.After(t) {
+			continue
+		}
+		if latest == nil || execution.StartedAt.After(latest.StartedAt) {
+			latest = execution
+		}
+	}
+	return latest
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExecutorAnalytics(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, executionRepo: executionRepo, pullRequestRepo: prRepo}
+	projectID := uuid.New()
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	login := &entity.Task{ID: uuid.New(), ProjectID: projectID}
+	search := &entity.Task{ID: uuid.New(), ProjectID: projectID}
+	taskIDs := []uuid.UUID{login.ID, search.ID}
+
+	run := func(task *entity.Task, aiType string, status entity.ExecutionStatus, startHours, minutes, fixAttempts int) *entity.Execution {
+		startedAt := start.Add(time.Duration(startHours) * time.Hour)
+		completedAt := startedAt.Add(time.Duration(minutes) * time.Minute)
+		return &entity.Execution{ID: uuid.New(), TaskID: task.ID, AIType: aiType, Status: status, StartedAt: startedAt, CompletedAt: &completedAt, FixAttempts: fixAttempts, CostUSD: 1.5}
+	}
+	executions := []*entity.Execution{
+		run(login, "claude-code", entity.ExecutionStatusFailed, 0, 5, 0),
+		run(login, "claude-code", entity.ExecutionStatusCompleted, 1, 10, 2),
+		run(search, "claude-code", entity.ExecutionStatusCompleted, 2, 20, 0),
+		run(search, "cursor-agent", entity.ExecutionStatusCompleted, 24, 30, 1),
+		run(search, "cursor-agent", entity.ExecutionStatusCancelled, 25, 1, 0),
+		// Recorded before the executor was
+		run(login, "", entity.ExecutionStatusCompleted, 0, 1, 0),
+	}
+	pullRequests := []*entity.PullRequest{
+		{TaskID: login.ID, Status: entity.PullRequestStatusMerged, CreatedAt: start.Add(2 * time.Hour)},
+		{TaskID: search.ID, Status: entity.PullRequestStatusClosed, CreatedAt: start.Add(3 * time.Hour)},
+	}
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{login, search}, nil)
+	executionRepo.EXPECT().GetByTaskIDs(mock.Anything, taskIDs).Return(executions, nil)
+	prRepo.EXPECT().GetByTaskIDs(mock.Anything, taskIDs).Return(pullRequests, nil)
+
+	analytics, err := uc.GetExecutorAnalytics(context.Background(), projectID, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Executors, 2)
+
+	claude := analytics.Executors[0]
+	assert.Equal(t, "claude-code", claude.AIType)
+	assert.Equal(t, 3, claude.Executions)
+	require.NotNil(t, claude.SuccessRate)
+	assert.InDelta(t, 2.0/3, *claude.SuccessRate, 1e-9)
+	assert.Equal(t, 15*time.Minute, claude.AverageDuration)
+	assert.Equal(t, 1.0, claude.AverageFixAttempts)
+	assert.Equal(t, 2, claude.Tasks)
+	assert.Equal(t, 1.5, claude.AverageRunsPerTask)
+	assert.Equal(t, 4.5, claude.CostUSD)
+	assert.Equal(t, 2, claude.PullRequests, "both pull requests were opened from its runs")
+	require.NotNil(t, claude.MergeRate)
+	assert.Equal(t, 0.5, *claude.MergeRate)
+
+	cursor := analytics.Executors[1]
+	assert.Equal(t, 1, cursor.Cancelled)
+	require.NotNil(t, cursor.SuccessRate)
+	assert.Equal(t, 1.0, *cursor.SuccessRate, "cancelled runs are left out")
+	assert.Zero(t, cursor.PullRequests)
+	assert.Nil(t, cursor.MergeRate)
+
+	from := start.Add(12 * time.Hour)
+	analytics, err = uc.GetExecutorAnalytics(context.Background(), projectID, &from, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Executors, 1)
+	assert.Equal(t, "cursor-agent", analytics.Executors[0].AIType)
+
+	to := from.Add(-time.Hour)
+	_, err = uc.GetExecutorAnalytics(context.Background(), projectID, &from, &to)
+	assert.ErrorIs(t, err, ErrDateRangeInvalid)
+}
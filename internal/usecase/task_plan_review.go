@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// PlanReviewOutcome is how a plan review ended
+type PlanReviewOutcome string
+
+const (
+	// PlanReviewApproved means the task went on to IMPLEMENTING
+	PlanReviewApproved PlanReviewOutcome = "approved"
+	// PlanReviewRejected means the task went back to TODO or PLANNING, or
+	// was cancelled
+	PlanReviewRejected PlanReviewOutcome = "rejected"
+)
+
+// PlanReview is one stay of a task in PLAN_REVIEWING that ended in a
+// decision
+type PlanReview struct {
+	TaskID      uuid.UUID
+	Title       string
+	SubmittedAt time.Time
+	DecidedAt   time.Time
+	Outcome     PlanReviewOutcome
+	// Reviewer is who made the decision, empty when it was not recorded
+	Reviewer string
+	Latency  time.Duration
+}
+
+// PendingPlanReview is a task waiting in PLAN_REVIEWING
+type PendingPlanReview struct {
+	TaskID      uuid.UUID
+	Title       string
+	SubmittedAt time.Time
+	Waiting     time.Duration
+}
+
+// ReviewerLatency is how fast one reviewer decided on plans
+type ReviewerLatency struct {
+	Reviewer string
+	Approved int
+	Rejected int
+	Latency  DurationPercentiles
+}
+
+// PlanReviewAnalytics covers the plan reviews of a project decided within a
+// date range
+type PlanReviewAnalytics struct {
+	ProjectID uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	Approved  int
+	Rejected  int
+	Latency   DurationPercentiles
+	// ByReviewer is sorted by reviewer, with the decisions of unrecorded
+	// reviewers under an empty name
+	ByReviewer []*ReviewerLatency
+	Reviews    []*PlanReview
+	// Pending lists the reviews still waiting, longest waiting first,
+	// regardless of the date range
+	Pending     []*PendingPlanReview
+	GeneratedAt time.Time
+}
+
+// GetPlanReviewAnalytics measures the time from a task entering
+// PLAN_REVIEWING to the decision on its plan, for the project's reviews
+// decided between from and to. Either bound may be nil. A task reviewed
+// several times counts once per review. Reviews are read from the status
+// history, so a decision made without a recorded status change is left out.
+func (u *taskUsecase) GetPlanReviewAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time) (*PlanReviewAnalytics, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, ErrDateRangeInvalid
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	historyByTask := make(map[uuid.UUID][]*entity.TaskStatusHistory)
+	for _, history := range histories {
+		historyByTask[history.TaskID] = append(historyByTask[history.TaskID], history)
+	}
+
+	now := time.Now()
+	analytics := &PlanReviewAnalytics{
+		ProjectID:   projectID,
+		From:        from,
+		To:          to,
+		ByReviewer:  []*ReviewerLatency{},
+		Reviews:     []*PlanReview{},
+		Pending:     []*PendingPlanReview{},
+		GeneratedAt: now,
+	}
+
+	var latencies []time.Duration
+	byReviewer := make(map[string]*ReviewerLatency)
+	reviewerLatencies := make(map[string][]time.Duration)
+	for _, task := range tasks {
+		reviews, pendingSince := replayPlanReviews(task, historyByTask[task.ID])
+		if pendingSince != nil && task.Status == entity.TaskStatusPLANREVIEWING {
+			analytics.Pending = append(analytics.Pending, &PendingPlanReview{
+				TaskID:      task.ID,
+				Title:       task.Title,
+				SubmittedAt: *pendingSince,
+				Waiting:     now.Sub(*pendingSince),
+			})
+		}
+
+		for _, review := range reviews {
+			if (from != nil && review.DecidedAt.Before(*from)) ||
+				(to != nil && review.DecidedAt.After(*to)) {
+				continue
+			}
+			analytics.Reviews = append(analytics.Reviews, review)
+			latencies = append(latencies, review.Latency)
+
+			reviewer, ok := byReviewer[review.Reviewer]
+			if !ok {
+				reviewer = &ReviewerLatency{Reviewer: review.Reviewer}
+				byReviewer[review.Reviewer] = reviewer
+			}
+			reviewerLatencies[review.Reviewer] = append(reviewerLatencies[review.Reviewer], review.Latency)
+			if review.Outcome == PlanReviewApproved {
+				analytics.Approved++
+				reviewer.Approved++
+			} else {
+				analytics.Rejected++
+				reviewer.Rejected++
+			}
+		}
+	}
+
+	analytics.Latency = durationPercentiles(latencies)
+	for name, reviewer := range byReviewer {
+		reviewer.Latency = durationPercentiles(reviewerLatencies[name])
+		analytics.ByReviewer = append(analytics.ByReviewer, reviewer)
+	}
+	sort.Slice(analytics.ByReviewer, func(i, j int) bool {
+		return analytics.ByReviewer[i].Reviewer < analytics.ByReviewer[j].Reviewer
+	})
+	sort.Slice(analytics.Reviews, func(i, j int) bool {
+		return analytics.Reviews[i].DecidedAt.Before(analytics.Reviews[j].DecidedAt)
+	})
+	sort.Slice(analytics.Pending, func(i, j int) bool {
+		return analytics.Pending[i].SubmittedAt.Before(analytics.Pending[j].SubmittedAt)
+	})
+
+	return analytics, nil
+}
+
+// replayPlanReviews walks a task's status history, oldest first, and returns
+// its decided plan reviews and, when the last change took it into
+// PLAN_REVIEWING, since when it has been waiting. A review only ends at a
+// change recorded as leaving PLAN_REVIEWING; one whose end went unrecorded
+// is dropped.
+func replayPlanReviews(task *entity.Task, history []*entity.TaskStatusHistory) ([]*PlanReview, *time.Time) {
+	var reviews []*PlanReview
+	var submittedAt *time.Time
+	for _, change := range history {
+		if submittedAt != nil && change.FromStatus != nil && *change.FromStatus == entity.TaskStatusPLANREVIEWING {
+			review := &PlanReview{
+				TaskID:      task.ID,
+				Title:       task.Title,
+				SubmittedAt: *submittedAt,
+				DecidedAt:   change.CreatedAt,
+				Outcome:     PlanReviewRejected,
+				Latency:     change.CreatedAt.Sub(*submittedAt),
+			}
+			if change.ToStatus == entity.TaskStatusIMPLEMENTING {
+				review.Outcome = PlanReviewApproved
+			}
+			if change.ChangedBy != nil {
+				review.Reviewer = *change.ChangedBy
+			}
+			reviews = append(reviews, review)
+		}
+
+		submittedAt = nil
+		if change.ToStatus == entity.TaskStatusPLANREVIEWING {
+			changedAt := change.CreatedAt
+			submittedAt = &changedAt
+		}
+	}
+	return reviews, submittedAt
+}
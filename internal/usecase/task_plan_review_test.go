@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlanReviewAnalytics(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo}
+	projectID := uuid.New()
+	created := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	// reviewedBy sets who made the change at index i of a history
+	reviewedBy := func(history []*entity.TaskStatusHistory, i int, reviewer string) []*entity.TaskStatusHistory {
+		history[i].ChangedBy = &reviewer
+		return history
+	}
+
+	login := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Add login", Status: entity.TaskStatusIMPLEMENTING, CreatedAt: created}
+	loginHistory := reviewedBy(reviewedBy(statusChanges(login.ID, created,
+		entity.TaskStatusPLANNING, 1,
+		entity.TaskStatusPLANREVIEWING, 2,
+		entity.TaskStatusTODO, 6,
+		entity.TaskStatusPLANNING, 7,
+		entity.TaskStatusPLANREVIEWING, 8,
+		entity.TaskStatusIMPLEMENTING, 10,
+	), 2, "alice"), 5, "bob")
+
+	search := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Add search", Status: entity.TaskStatusIMPLEMENTING, CreatedAt: created}
+	searchHistory := reviewedBy(statusChanges(search.ID, created,
+		entity.TaskStatusPLANNING, 1,
+		entity.TaskStatusPLANREVIEWING, 3,
+		entity.TaskStatusIMPLEMENTING, 9,
+	), 2, "alice")
+
+	waiting := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Add export", Status: entity.TaskStatusPLANREVIEWING, CreatedAt: created}
+	waitingHistory := statusChanges(waiting.ID, created,
+		entity.TaskStatusPLANNING, 1,
+		entity.TaskStatusPLANREVIEWING, 2,
+	)
+
+	histories := append(append(loginHistory, searchHistory...), waitingHistory...)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{login, search, waiting}, nil)
+	taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, projectID).Return(histories, nil)
+
+	analytics, err := uc.GetPlanReviewAnalytics(context.Background(), projectID, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Reviews, 3)
+	assert.Equal(t, 2, analytics.Approved)
+	assert.Equal(t, 1, analytics.Rejected)
+	assert.Equal(t, PlanReviewRejected, analytics.Reviews[0].Outcome)
+	assert.Equal(t, 4*time.Hour, analytics.Reviews[0].Latency)
+	assert.Equal(t, 3, analytics.Latency.Count)
+	assert.Equal(t, 4*time.Hour, analytics.Latency.Average, "4, 2 and 6 hours")
+
+	require.Len(t, analytics.ByReviewer, 2)
+	alice := analytics.ByReviewer[0]
+	assert.Equal(t, "alice", alice.Reviewer)
+	assert.Equal(t, 1, alice.Approved)
+	assert.Equal(t, 1, alice.Rejected)
+	assert.Equal(t, 5*time.Hour, alice.Latency.Average)
+	assert.Equal(t, "bob", analytics.ByReviewer[1].Reviewer)
+
+	require.Len(t, analytics.Pending, 1)
+	assert.Equal(t, waiting.ID, analytics.Pending[0].TaskID)
+	assert.Equal(t, created.Add(2*time.Hour), analytics.Pending[0].SubmittedAt)
+
+	from := created.Add(9 * time.Hour)
+	analytics, err = uc.GetPlanReviewAnalytics(context.Background(), projectID, &from, nil)
+	require.NoError(t, err)
+	require.Len(t, analytics.Reviews, 2)
+	assert.Len(t, analytics.Pending, 1, "pending reviews ignore the date range")
+
+	to := from.Add(-time.Hour)
+	_, err = uc.GetPlanReviewAnalytics(context.Background(), projectID, &from, &to)
+	assert.ErrorIs(t, err, ErrDateRangeInvalid)
+}
+
+func TestRejectPlan(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo}
+	reviewer, reason := "alice", "Misses the migration"
+
+	task := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusPLANREVIEWING}
+	rejected := &entity.Task{ID: task.ID, Status: entity.TaskStatusTODO}
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil).Twice()
+	taskRepo.EXPECT().UpdateStatusWithHistory(mock.Anything, task.ID, entity.TaskStatusTODO, &reviewer, &reason).Return(nil)
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(rejected, nil).Once()
+
+	updated, err := uc.RejectPlan(context.Background(), task.ID, &reviewer, &reason)
+	require.NoError(t, err)
+	assert.Equal(t, entity.TaskStatusTODO, updated.Status)
+
+	implementing := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING}
+	taskRepo.EXPECT().GetByID(mock.Anything, implementing.ID).Return(implementing, nil)
+	_, err = uc.RejectPlan(context.Background(), implementing.ID, nil, nil)
+	assert.ErrorIs(t, err, ErrTaskNotInPlanReview)
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func previewTestTask(id uuid.UUID, status entity.TaskStatus, previewURL *string) *entity.Task {
+	return &entity.Task{
+		ID:         id,
+		ProjectID:  uuid.New(),
+		Title:      "Test task",
+		Status:     status,
+		PreviewURL: previewURL,
+	}
+}
+
+func TestUpdateStatus_EnqueuesPreviewStop(t *testing.T) {
+	previewURL := "http://localhost:4100"
+
+	for _, status := range []entity.TaskStatus{entity.TaskStatusDONE, entity.TaskStatusCANCELLED} {
+		t.Run(string(status), func(t *testing.T) {
+			uc, taskRepo, jobClient := newKanbanTestUsecase(t)
+			taskID := uuid.New()
+
+			taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusCODEREVIEWING, &previewURL), nil).Once()
+			taskRepo.EXPECT().UpdateStatus(context.Background(), taskID, status).Return(nil).Once()
+			taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, status, &previewURL), nil).Once()
+
+			jobClient.EXPECT().EnqueuePreviewStop(&PreviewStopPayload{TaskID: taskID}).Return("job-1", nil).Once()
+
+			_, err := uc.UpdateStatus(context.Background(), taskID, status)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateStatus_NoPreviewStop(t *testing.T) {
+	previewURL := "http://localhost:4100"
+
+	t.Run("task still in review", func(t *testing.T) {
+		uc, taskRepo, _ := newKanbanTestUsecase(t)
+		taskID := uuid.New()
+
+		taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusCODEREVIEWING, &previewURL), nil).Once()
+		taskRepo.EXPECT().UpdateStatus(context.Background(), taskID, entity.TaskStatusIMPLEMENTING).Return(nil).Once()
+		taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusIMPLEMENTING, &previewURL), nil).Once()
+
+		_, err := uc.UpdateStatus(context.Background(), taskID, entity.TaskStatusIMPLEMENTING)
+		require.NoError(t, err)
+	})
+
+	t.Run("no preview running", func(t *testing.T) {
+		uc, taskRepo, _ := newKanbanTestUsecase(t)
+		taskID := uuid.New()
+
+		taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusCODEREVIEWING, nil), nil).Once()
+		taskRepo.EXPECT().UpdateStatus(context.Background(), taskID, entity.TaskStatusDONE).Return(nil).Once()
+		taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusDONE, nil), nil).Once()
+
+		_, err := uc.UpdateStatus(context.Background(), taskID, entity.TaskStatusDONE)
+		require.NoError(t, err)
+	})
+	// jobClient mock asserts no unexpected calls on cleanup
+}
+
+func TestUpdatePreviewURL(t *testing.T) {
+	uc, taskRepo, _ := newKanbanTestUsecase(t)
+	taskID := uuid.New()
+	previewURL := "http://localhost:4100"
+
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(previewTestTask(taskID, entity.TaskStatusCODEREVIEWING, nil), nil).Once()
+	taskRepo.EXPECT().Update(context.Background(), mock.MatchedBy(func(task *entity.Task) bool {
+		return task.ID == taskID && task.PreviewURL != nil && *task.PreviewURL == previewURL
+	})).Return(nil).Once()
+
+	task, err := uc.UpdatePreviewURL(context.Background(), taskID, &previewURL)
+	require.NoError(t, err)
+	assert.Equal(t, &previewURL, task.PreviewURL)
+}
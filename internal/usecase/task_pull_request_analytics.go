@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// AnalyticsPeriod is the length of the periods a report is broken down into
+type AnalyticsPeriod string
+
+const (
+	// AnalyticsPeriodWeek runs Monday to Monday, UTC
+	AnalyticsPeriodWeek AnalyticsPeriod = "week"
+	// AnalyticsPeriodMonth is a calendar month, UTC
+	AnalyticsPeriodMonth AnalyticsPeriod = "month"
+)
+
+var ErrAnalyticsPeriodInvalid = errors.New("period must be week or month")
+
+// PullRequestStats sums up a set of pull requests
+type PullRequestStats struct {
+	Opened             int
+	Merged             int
+	ClosedWithoutMerge int
+	Open               int
+	// MergeRate is the share of merged pull requests among the merged and
+	// closed ones, nil while all are open
+	MergeRate   *float64
+	TimeToMerge DurationPercentiles
+}
+
+// PullRequestPeriod is the stats of the pull requests opened in one period
+type PullRequestPeriod struct {
+	Start time.Time
+	PullRequestStats
+}
+
+// PullRequestAnalytics covers the pull requests of a project opened within
+// a date range
+type PullRequestAnalytics struct {
+	ProjectID uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	PullRequestStats
+	// Period and Periods are empty unless a breakdown was asked for
+	Period      AnalyticsPeriod
+	Periods     []*PullRequestPeriod
+	GeneratedAt time.Time
+}
+
+// GetPullRequestAnalytics reports the merge rate, time to merge and pull
+// requests closed without merge of the project's pull requests opened
+// between from and to, either of which may be nil. With a period the
+// pull requests are also broken down by when they were opened, every period
+// from the first to the last included.
+func (u *taskUsecase) GetPullRequestAnalytics(ctx context.Context, projectID uuid.UUID, from, to *time.Time, period AnalyticsPeriod) (*PullRequestAnalytics, error) {
+	if from != nil && to != nil && to.Before(*from) {
+		return nil, ErrDateRangeInvalid
+	}
+	if period != "" && period != AnalyticsPeriodWeek && period != AnalyticsPeriodMonth {
+		return nil, ErrAnalyticsPeriodInvalid
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	var pullRequests []*entity.PullRequest
+	if len(tasks) > 0 {
+		taskIDs := make([]uuid.UUID, len(tasks))
+		for i, task := range tasks {
+			taskIDs[i] = task.ID
+		}
+		pullRequests, err = u.pullRequestRepo.GetByTaskIDs(ctx, taskIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pull requests: %w", err)
+		}
+	}
+
+	var opened []*entity.PullRequest
+	for _, pr := range pullRequests {
+		if (from != nil && pr.CreatedAt.Before(*from)) ||
+			(to != nil && pr.CreatedAt.After(*to)) {
+			continue
+		}
+		opened = append(opened, pr)
+	}
+
+	analytics := &PullRequestAnalytics{
+		ProjectID:        projectID,
+		From:             from,
+		To:               to,
+		PullRequestStats: pullRequestStats(opened),
+		Period:           period,
+		GeneratedAt:      time.Now(),
+	}
+	if period != "" {
+		analytics.Periods = pullRequestPeriods(opened, period, from, to, analytics.GeneratedAt)
+	}
+
+	return analytics, nil
+}
+
+// pullRequestStats sums up pull requests
+func pullRequestStats(pullRequests []*entity.PullRequest) PullRequestStats {
+	stats := PullRequestStats{Opened: len(pullRequests)}
+	var timesToMerge []time.Duration
+	for _, pr := range pullRequests {
+		switch pr.Status {
+		case entity.PullRequestStatusMerged:
+			stats.Merged++
+			if pr.MergedAt != nil {
+				timesToMerge = append(timesToMerge, pr.MergedAt.Sub(pr.CreatedAt))
+			}
+		case entity.PullRequestStatusClosed:
+			stats.ClosedWithoutMerge++
+		default:
+			stats.Open++
+		}
+	}
+	if decided := stats.Merged + stats.ClosedWithoutMerge; decided > 0 {
+		rate := float64(stats.Merged) / float64(decided)
+		stats.MergeRate = &rate
+	}
+	stats.TimeToMerge = durationPercentiles(timesToMerge)
+	return stats
+}
+
+// pullRequestPeriods breaks pull requests down by the period they were
+// opened in. The periods run from the one holding from, or else the first
+// pull request, to the one holding to, or else now.
+func pullRequestPeriods(pullRequests []*entity.PullRequest, period AnalyticsPeriod, from, to *time.Time, now time.Time) []*PullRequestPeriod {
+	periodStart, next := weekStart, func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	if period == AnalyticsPeriodMonth {
+		periodStart, next = monthStart, func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	}
+
+	var first time.Time
+	switch {
+	case from != nil:
+		first = *from
+	case len(pullRequests) > 0:
+		first = pullRequests[0].CreatedAt
+		for _, pr := range pullRequests {
+			if pr.CreatedAt.Before(first) {
+				first = pr.CreatedAt
+			}
+		}
+	default:
+		return []*PullRequestPeriod{}
+	}
+	last := now
+	if to != nil {
+		last = *to
+	}
+
+	byPeriod := make(map[time.Time][]*entity.PullRequest)
+	for _, pr := range pullRequests {
+		start := periodStart(pr.CreatedAt)
+		byPeriod[start] = append(byPeriod[start], pr)
+	}
+	periods := []*PullRequestPeriod{}
+	for start := periodStart(first); !start.After(last); start = next(start) {
+		periods = append(periods, &PullRequestPeriod{Start: start, PullRequestStats: pullRequestStats(byPeriod[start])})
+	}
+	return periods
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPullRequestAnalytics(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, pullRequestRepo: prRepo}
+	projectID := uuid.New()
+	task := &entity.Task{ID: uuid.New(), ProjectID: projectID}
+	// Monday 4 March 2024
+	monday := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	pr := func(status entity.PullRequestStatus, openedDays int, mergeHours int) *entity.PullRequest {
+		pr := &entity.PullRequest{TaskID: task.ID, Status: status, CreatedAt: monday.AddDate(0, 0, openedDays)}
+		if status == entity.PullRequestStatusMerged {
+			mergedAt := pr.CreatedAt.Add(time.Duration(mergeHours) * time.Hour)
+			pr.MergedAt = &mergedAt
+		}
+		return pr
+	}
+	pullRequests := []*entity.PullRequest{
+		pr(entity.PullRequestStatusMerged, 0, 4),
+		pr(entity.PullRequestStatusMerged, 2, 8),
+		pr(entity.PullRequestStatusClosed, 3, 0),
+		pr(entity.PullRequestStatusMerged, 14, 12),
+		pr(entity.PullRequestStatusOpen, 15, 0),
+	}
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{task}, nil)
+	prRepo.EXPECT().GetByTaskIDs(mock.Anything, []uuid.UUID{task.ID}).Return(pullRequests, nil)
+
+	to := monday.AddDate(0, 0, 20)
+	analytics, err := uc.GetPullRequestAnalytics(context.Background(), projectID, nil, &to, AnalyticsPeriodWeek)
+	require.NoError(t, err)
+	assert.Equal(t, 5, analytics.Opened)
+	assert.Equal(t, 3, analytics.Merged)
+	assert.Equal(t, 1, analytics.ClosedWithoutMerge)
+	assert.Equal(t, 1, analytics.Open)
+	require.NotNil(t, analytics.MergeRate)
+	assert.Equal(t, 0.75, *analytics.MergeRate)
+	assert.Equal(t, 8*time.Hour, analytics.TimeToMerge.Average)
+
+	require.Len(t, analytics.Periods, 3, "the empty week in between is kept")
+	assert.Equal(t, time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC), analytics.Periods[0].Start)
+	assert.Equal(t, 3, analytics.Periods[0].Opened)
+	require.NotNil(t, analytics.Periods[0].MergeRate)
+	assert.InDelta(t, 2.0/3, *analytics.Periods[0].MergeRate, 1e-9)
+	assert.Zero(t, analytics.Periods[1].Opened)
+	assert.Nil(t, analytics.Periods[1].MergeRate)
+	assert.Equal(t, 12*time.Hour, analytics.Periods[2].TimeToMerge.Average)
+
+	from := monday.AddDate(0, 0, 1)
+	analytics, err = uc.GetPullRequestAnalytics(context.Background(), projectID, &from, &to, AnalyticsPeriodMonth)
+	require.NoError(t, err)
+	assert.Equal(t, 4, analytics.Opened)
+	require.Len(t, analytics.Periods, 1)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), analytics.Periods[0].Start)
+
+	analytics, err = uc.GetPullRequestAnalytics(context.Background(), projectID, nil, nil, "")
+	require.NoError(t, err)
+	assert.Nil(t, analytics.Periods)
+
+	_, err = uc.GetPullRequestAnalytics(context.Background(), projectID, nil, nil, "day")
+	assert.ErrorIs(t, err, ErrAnalyticsPeriodInvalid)
+}
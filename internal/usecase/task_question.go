@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/pkg/tracing"
+	"github.com/google/uuid"
+)
+
+// AnswerQuestion resumes an execution waiting for input with the user's
+// answer. The answer is taken once: the execution goes back to PENDING
+// before the job resuming it is enqueued, and waits again if that fails.
+func (u *taskUsecase) AnswerQuestion(ctx context.Context, executionID uuid.UUID, req AnswerQuestionRequest) (*entity.Execution, string, error) {
+	if err := u.authorizeExecution(ctx, executionID, entity.ProjectRoleReviewer); err != nil {
+		return nil, "", err
+	}
+	answer := strings.TrimSpace(req.Answer)
+	if answer == "" {
+		return nil, "", ErrAnswerRequired
+	}
+
+	execution, err := u.executionRepo.GetByID(ctx, executionID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrExecutionNotFound, err)
+	}
+	if execution.Status != entity.ExecutionStatusWaitingInput {
+		return nil, "", fmt.Errorf("%w, current status: %s", ErrExecutionNotWaitingInput, execution.Status)
+	}
+	task, err := u.taskRepo.GetByID(ctx, execution.TaskID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+
+	answered, err := u.executionRepo.MarkAnswered(ctx, executionID, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	if !answered {
+		return nil, "", fmt.Errorf("%w: the question was already answered", ErrExecutionNotWaitingInput)
+	}
+
+	jobID, err := u.jobClient.EnqueueTaskImplementation(&TaskImplementationPayload{
+		TaskID:            task.ID,
+		ProjectID:         task.ProjectID,
+		AIType:            execution.AIType,
+		ResumeExecutionID: &execution.ID,
+		Answer:            answer,
+		TraceContext:      tracing.Inject(ctx),
+	}, 0)
+	if err != nil {
+		if revertErr := u.executionRepo.MarkWaitingInput(ctx, executionID, execution.Question, execution.SessionID); revertErr != nil {
+			slog.Warn("Failed to put execution back to waiting for input after the answer could not be enqueued",
+				"execution_id", executionID, "error", revertErr)
+		}
+		return nil, "", fmt.Errorf("failed to enqueue implementation job: %w", err)
+	}
+
+	// The conversation is kept on the task; failing to save it does not undo
+	// the answer, which is on its way to the executor
+	author := "user"
+	if answerer := recordedActor(ctx, req.Answerer); answerer != nil {
+		author = *answerer
+	}
+	if _, err := u.AddComment(ctx, AddCommentRequest{
+		TaskID:    task.ID,
+		Comment:   fmt.Sprintf("Question:\n\n%s\n\nAnswer:\n\n%s", execution.Question, answer),
+		CreatedBy: author,
+	}); err != nil {
+		slog.Warn("Failed to save answered question", "execution_id", executionID, "error", err)
+	}
+
+	execution.Status = entity.ExecutionStatusPending
+	return execution, jobID, nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerQuestion(t *testing.T) {
+	answerer := "alice"
+	newExecution := func(status entity.ExecutionStatus) (*entity.Task, *entity.Execution) {
+		task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING}
+		execution := &entity.Execution{
+			ID:        uuid.New(),
+			TaskID:    task.ID,
+			Status:    status,
+			AIType:    "claude-code",
+			Question:  "Keep the old endpoint?",
+			SessionID: "session-1",
+		}
+		return task, execution
+	}
+
+	t.Run("enqueues the resumed execution and saves the answer", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, executionRepo: executionRepo, jobClient: jobClient}
+		task, execution := newExecution(entity.ExecutionStatusWaitingInput)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		executionRepo.EXPECT().MarkAnswered(mock.Anything, execution.ID, mock.Anything).Return(true, nil)
+		jobClient.EXPECT().EnqueueTaskImplementation(&TaskImplementationPayload{
+			TaskID:            task.ID,
+			ProjectID:         task.ProjectID,
+			AIType:            "claude-code",
+			ResumeExecutionID: &execution.ID,
+			Answer:            "No, remove it",
+		}, mock.Anything).Return("job-1", nil)
+		taskRepo.EXPECT().ValidateTaskExists(mock.Anything, task.ID).Return(true, nil)
+		taskRepo.EXPECT().AddComment(mock.Anything, mock.MatchedBy(func(comment *entity.TaskComment) bool {
+			return comment.CreatedBy == answerer && comment.Comment == "Question:\n\nKeep the old endpoint?\n\nAnswer:\n\nNo, remove it"
+		})).Return(nil)
+
+		answered, jobID, err := uc.AnswerQuestion(context.Background(), execution.ID, AnswerQuestionRequest{
+			Answerer: &answerer,
+			Answer:   " No, remove it\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+		assert.Equal(t, entity.ExecutionStatusPending, answered.Status)
+	})
+
+	t.Run("waits again when the job cannot be enqueued", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, executionRepo: executionRepo, jobClient: jobClient}
+		task, execution := newExecution(entity.ExecutionStatusWaitingInput)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		executionRepo.EXPECT().MarkAnswered(mock.Anything, execution.ID, mock.Anything).Return(true, nil)
+		jobClient.EXPECT().EnqueueTaskImplementation(mock.Anything, mock.Anything).Return("", ErrJobQueueUnavailable)
+		executionRepo.EXPECT().MarkWaitingInput(mock.Anything, execution.ID, "Keep the old endpoint?", "session-1").Return(nil)
+
+		_, _, err := uc.AnswerQuestion(context.Background(), execution.ID, AnswerQuestionRequest{Answer: "No"})
+		assert.ErrorIs(t, err, ErrJobQueueUnavailable)
+	})
+
+	t.Run("rejects an execution not waiting for input", func(t *testing.T) {
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo}
+		_, execution := newExecution(entity.ExecutionStatusRunning)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+
+		_, _, err := uc.AnswerQuestion(context.Background(), execution.ID, AnswerQuestionRequest{Answer: "No"})
+		assert.ErrorIs(t, err, ErrExecutionNotWaitingInput)
+	})
+
+	t.Run("takes an answer once", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, executionRepo: executionRepo}
+		task, execution := newExecution(entity.ExecutionStatusWaitingInput)
+
+		executionRepo.EXPECT().GetByID(mock.Anything, execution.ID).Return(execution, nil)
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		executionRepo.EXPECT().MarkAnswered(mock.Anything, execution.ID, mock.Anything).Return(false, nil)
+
+		_, _, err := uc.AnswerQuestion(context.Background(), execution.ID, AnswerQuestionRequest{Answer: "No"})
+		assert.ErrorIs(t, err, ErrExecutionNotWaitingInput)
+	})
+
+	t.Run("requires an answer", func(t *testing.T) {
+		uc := &taskUsecase{}
+
+		_, _, err := uc.AnswerQuestion(context.Background(), uuid.New(), AnswerQuestionRequest{Answer: "  "})
+		assert.ErrorIs(t, err, ErrAnswerRequired)
+	})
+
+	t.Run("unknown execution", func(t *testing.T) {
+		executionRepo := repository.NewExecutionRepositoryMock(t)
+		uc := &taskUsecase{executionRepo: executionRepo}
+		id := uuid.New()
+
+		executionRepo.EXPECT().GetByID(mock.Anything, id).Return(nil, errors.New("execution not found with id"))
+
+		_, _, err := uc.AnswerQuestion(context.Background(), id, AnswerQuestionRequest{Answer: "No"})
+		assert.ErrorIs(t, err, ErrExecutionNotFound)
+	})
+}
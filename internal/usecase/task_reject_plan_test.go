@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectPlanWithFeedback(t *testing.T) {
+	reviewer := "alice"
+	newTask := func(status entity.TaskStatus) *entity.Task {
+		return &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: status}
+	}
+	// expectReplanning makes the repositories reject the task's plan and
+	// move the task to PLANNING
+	expectReplanning := func(taskRepo *repository.TaskRepositoryMock, planRepo *repository.PlanRepositoryMock, task *entity.Task, plan *entity.Plan) {
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		planRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(plan, nil)
+		planRepo.EXPECT().Reject(mock.Anything, plan.ID, "Use the SSO provider").Return(nil)
+		taskRepo.EXPECT().UpdateStatusWithHistory(mock.Anything, task.ID, entity.TaskStatusPLANNING, &reviewer, mock.Anything).
+			RunAndReturn(func(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy, reason *string) error {
+				task.Status = status
+				return nil
+			})
+	}
+
+	t.Run("rejects the plan and enqueues planning with the feedback", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		planRepo := repository.NewPlanRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, planRepo: planRepo, jobClient: jobClient}
+		task := newTask(entity.TaskStatusPLANREVIEWING)
+		plan := &entity.Plan{ID: uuid.New(), TaskID: task.ID, Status: entity.PlanStatusREVIEWING}
+
+		expectReplanning(taskRepo, planRepo, task, plan)
+		jobClient.EXPECT().EnqueueTaskPlanning(&TaskPlanningPayload{
+			TaskID:    task.ID,
+			ProjectID: task.ProjectID,
+			AIType:    "claude-code",
+			Feedback:  "Use the SSO provider",
+		}, mock.Anything).Return("job-1", nil)
+
+		updated, jobID, err := uc.RejectPlanWithFeedback(context.Background(), task.ID, RejectPlanWithFeedbackRequest{
+			Reviewer: &reviewer,
+			Feedback: " Use the SSO provider\n",
+			AIType:   "claude-code",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+		assert.Equal(t, entity.TaskStatusPLANNING, updated.Status)
+	})
+
+	t.Run("puts the plan back under review when the job cannot be enqueued", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		planRepo := repository.NewPlanRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, planRepo: planRepo, jobClient: jobClient}
+		task := newTask(entity.TaskStatusPLANREVIEWING)
+		plan := &entity.Plan{ID: uuid.New(), TaskID: task.ID, Status: entity.PlanStatusREVIEWING}
+
+		expectReplanning(taskRepo, planRepo, task, plan)
+		jobClient.EXPECT().EnqueueTaskPlanning(mock.Anything, mock.Anything).Return("", ErrJobQueueUnavailable)
+		taskRepo.EXPECT().UpdateStatus(mock.Anything, task.ID, entity.TaskStatusPLANREVIEWING).Return(nil)
+		planRepo.EXPECT().UpdateStatus(mock.Anything, plan.ID, entity.PlanStatusREVIEWING).Return(nil)
+
+		_, _, err := uc.RejectPlanWithFeedback(context.Background(), task.ID, RejectPlanWithFeedbackRequest{Reviewer: &reviewer, Feedback: "Use the SSO provider"})
+		assert.ErrorIs(t, err, ErrJobQueueUnavailable)
+	})
+
+	t.Run("rejects a task not in plan review", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		task := newTask(entity.TaskStatusIMPLEMENTING)
+
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+
+		_, _, err := uc.RejectPlanWithFeedback(context.Background(), task.ID, RejectPlanWithFeedbackRequest{Feedback: "Use the SSO provider"})
+		assert.ErrorIs(t, err, ErrTaskNotInPlanReview)
+	})
+
+	t.Run("requires feedback", func(t *testing.T) {
+		uc := &taskUsecase{}
+
+		_, _, err := uc.RejectPlanWithFeedback(context.Background(), uuid.New(), RejectPlanWithFeedbackRequest{Feedback: "  "})
+		assert.ErrorIs(t, err, ErrFeedbackRequired)
+	})
+}
+
+func TestGetStatusHistory_NumbersPlanningIterations(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo}
+	taskID := uuid.New()
+	todo, planning, reviewing := entity.TaskStatusTODO, entity.TaskStatusPLANNING, entity.TaskStatusPLANREVIEWING
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID}, nil)
+	taskRepo.EXPECT().GetStatusHistory(mock.Anything, taskID).Return([]*entity.TaskStatusHistory{
+		{FromStatus: &todo, ToStatus: planning},
+		{FromStatus: &planning, ToStatus: reviewing},
+		{FromStatus: &reviewing, ToStatus: planning},
+		{FromStatus: &planning, ToStatus: reviewing},
+	}, nil)
+
+	history, err := uc.GetStatusHistory(context.Background(), taskID)
+	require.NoError(t, err)
+	iterations := make([]int, len(history))
+	for i, entry := range history {
+		iterations[i] = entry.PlanningIteration
+	}
+	assert.Equal(t, []int{1, 0, 2, 0}, iterations)
+}
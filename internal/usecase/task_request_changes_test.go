@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestChanges(t *testing.T) {
+	worktree := "/tmp/worktrees/task"
+	reviewer := "alice"
+	newTask := func(status entity.TaskStatus) *entity.Task {
+		return &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: status, WorktreePath: &worktree}
+	}
+	// expectStatusChanges makes the repository apply the status changes
+	// made to task
+	expectStatusChanges := func(taskRepo *repository.TaskRepositoryMock, task *entity.Task) {
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+		taskRepo.EXPECT().UpdateStatusWithHistory(mock.Anything, task.ID, entity.TaskStatusIMPLEMENTING, &reviewer, mock.Anything).
+			RunAndReturn(func(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy, reason *string) error {
+				task.Status = status
+				return nil
+			})
+	}
+
+	t.Run("saves the feedback and enqueues the follow-up", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, jobClient: jobClient}
+		task := newTask(entity.TaskStatusCODEREVIEWING)
+
+		expectStatusChanges(taskRepo, task)
+		taskRepo.EXPECT().ValidateTaskExists(mock.Anything, task.ID).Return(true, nil)
+		taskRepo.EXPECT().AddComment(mock.Anything, mock.MatchedBy(func(comment *entity.TaskComment) bool {
+			return comment.CreatedBy == reviewer && comment.Comment == "Changes requested:\n\nValidate the email"
+		})).Return(nil)
+		jobClient.EXPECT().EnqueueTaskImplementation(&TaskImplementationPayload{
+			TaskID:        task.ID,
+			ProjectID:     task.ProjectID,
+			AIType:        "claude-code",
+			ChangeRequest: "Validate the email",
+		}, mock.Anything).Return("job-1", nil)
+
+		updated, jobID, err := uc.RequestChanges(context.Background(), task.ID, RequestChangesRequest{
+			Reviewer: &reviewer,
+			Feedback: "  Validate the email\n",
+			AIType:   "claude-code",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+		assert.Equal(t, entity.TaskStatusIMPLEMENTING, updated.Status)
+	})
+
+	t.Run("returns to code review when the job cannot be enqueued", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		jobClient := NewJobClientInterfaceMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, jobClient: jobClient}
+		task := newTask(entity.TaskStatusCODEREVIEWING)
+
+		expectStatusChanges(taskRepo, task)
+		taskRepo.EXPECT().ValidateTaskExists(mock.Anything, task.ID).Return(true, nil)
+		taskRepo.EXPECT().AddComment(mock.Anything, mock.Anything).Return(nil)
+		jobClient.EXPECT().EnqueueTaskImplementation(mock.Anything, mock.Anything).Return("", ErrJobQueueUnavailable)
+		taskRepo.EXPECT().UpdateStatus(mock.Anything, task.ID, entity.TaskStatusCODEREVIEWING).Return(nil)
+
+		_, _, err := uc.RequestChanges(context.Background(), task.ID, RequestChangesRequest{Reviewer: &reviewer, Feedback: "Validate the email"})
+		assert.ErrorIs(t, err, ErrJobQueueUnavailable)
+	})
+
+	t.Run("rejects a task not in code review", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		task := newTask(entity.TaskStatusPLANREVIEWING)
+
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+
+		_, _, err := uc.RequestChanges(context.Background(), task.ID, RequestChangesRequest{Feedback: "Validate the email"})
+		assert.ErrorIs(t, err, ErrTaskNotInCodeReview)
+	})
+
+	t.Run("rejects a task without worktree", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		task := newTask(entity.TaskStatusCODEREVIEWING)
+		task.WorktreePath = nil
+
+		taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+
+		_, _, err := uc.RequestChanges(context.Background(), task.ID, RequestChangesRequest{Feedback: "Validate the email"})
+		assert.ErrorIs(t, err, ErrTaskHasNoWorktree)
+	})
+
+	t.Run("requires feedback", func(t *testing.T) {
+		uc := &taskUsecase{}
+
+		_, _, err := uc.RequestChanges(context.Background(), uuid.New(), RequestChangesRequest{Feedback: "  "})
+		assert.ErrorIs(t, err, ErrFeedbackRequired)
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		id := uuid.New()
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(nil, errors.New("task not found with id"))
+
+		_, _, err := uc.RequestChanges(context.Background(), id, RequestChangesRequest{Feedback: "Validate the email"})
+		assert.ErrorIs(t, err, ErrTaskNotFound)
+	})
+}
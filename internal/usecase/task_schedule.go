@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// maxScheduleAhead bounds how far ahead planning or implementation can be
+// scheduled
+const maxScheduleAhead = 30 * 24 * time.Hour
+
+var (
+	ErrScheduleInvalid      = errors.New("scheduled time must be in the future and at most 30 days ahead")
+	ErrTaskAlreadyScheduled = errors.New("task already has a scheduled job, cancel it first")
+	ErrScheduledJobNotFound = errors.New("scheduled job not found")
+)
+
+// Kinds of scheduled jobs
+const (
+	ScheduledJobPlanning       = "planning"
+	ScheduledJobImplementation = "implementation"
+)
+
+// ScheduledJob is a planning or implementation job of a task waiting for
+// its start time
+type ScheduledJob struct {
+	ID          string    `json:"id"`
+	TaskID      uuid.UUID `json:"task_id"`
+	Kind        string    `json:"kind"`
+	AIType      string    `json:"ai_type"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// scheduleDelay returns how long the task's job waits to start at
+// scheduledAt, 0 for nil. A task has one scheduled job at most, so a run
+// cannot be scheduled twice by mistake.
+func (u *taskUsecase) scheduleDelay(taskID uuid.UUID, scheduledAt *time.Time) (time.Duration, error) {
+	if scheduledAt == nil {
+		return 0, nil
+	}
+	delay := time.Until(*scheduledAt)
+	if delay <= 0 || delay > maxScheduleAhead {
+		return 0, ErrScheduleInvalid
+	}
+
+	scheduled, err := u.jobClient.ScheduledJobs(taskID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get scheduled jobs: %w", err)
+	}
+	if len(scheduled) > 0 {
+		return 0, fmt.Errorf("%w: %s job %s at %s", ErrTaskAlreadyScheduled, scheduled[0].Kind, scheduled[0].ID, scheduled[0].ScheduledAt.Format(time.RFC3339))
+	}
+	return delay, nil
+}
+
+func (u *taskUsecase) ListScheduledJobs(ctx context.Context, taskID uuid.UUID) ([]ScheduledJob, error) {
+	task, err := u.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTaskNotFound, err)
+	}
+	if err := u.authorize(ctx, task.ProjectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	jobs, err := u.jobClient.ScheduledJobs(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled jobs: %w", err)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].ScheduledAt.Before(jobs[j].ScheduledAt)
+	})
+	return jobs, nil
+}
+
+func (u *taskUsecase) CancelScheduledJob(ctx context.Context, taskID uuid.UUID, jobID string) error {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleMaintainer); err != nil {
+		return err
+	}
+	return u.jobClient.CancelScheduledJob(taskID, jobID)
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartPlanning_Scheduled(t *testing.T) {
+	ctx := context.Background()
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusTODO}
+	newUsecase := func(t *testing.T) (*taskUsecase, *JobClientInterfaceMock) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+		jobClient := NewJobClientInterfaceMock(t)
+		// A full queue does not refuse planning scheduled for later
+		return &taskUsecase{taskRepo: taskRepo, jobClient: jobClient, planningLimits: PlanningLimits{MaxQueueDepth: 1}}, jobClient
+	}
+
+	t.Run("enqueued with a delay", func(t *testing.T) {
+		uc, jobClient := newUsecase(t)
+		scheduledAt := time.Now().Add(8 * time.Hour)
+		jobClient.EXPECT().ScheduledJobs(task.ID).Return(nil, nil).Once()
+		jobClient.EXPECT().EnqueueTaskPlanning(mock.MatchedBy(func(payload *TaskPlanningPayload) bool {
+			return payload.TaskID == task.ID && payload.Scheduled
+		}), mock.MatchedBy(func(delay time.Duration) bool {
+			return delay > 7*time.Hour && delay <= 8*time.Hour
+		})).Return("job-1", nil).Once()
+
+		jobID, err := uc.StartPlanning(ctx, task.ID, "", "claude-code", false, false, &scheduledAt)
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", jobID)
+	})
+
+	t.Run("in the past or too far ahead", func(t *testing.T) {
+		uc, _ := newUsecase(t)
+		for _, scheduledAt := range []time.Time{time.Now().Add(-time.Minute), time.Now().Add(maxScheduleAhead + time.Hour)} {
+			_, err := uc.StartPlanning(ctx, task.ID, "", "claude-code", false, false, &scheduledAt)
+			assert.ErrorIs(t, err, ErrScheduleInvalid)
+		}
+	})
+
+	t.Run("already scheduled", func(t *testing.T) {
+		uc, jobClient := newUsecase(t)
+		scheduledAt := time.Now().Add(time.Hour)
+		jobClient.EXPECT().ScheduledJobs(task.ID).Return([]ScheduledJob{{ID: "job-1", TaskID: task.ID, Kind: ScheduledJobPlanning, ScheduledAt: scheduledAt}}, nil).Once()
+
+		_, err := uc.StartPlanning(ctx, task.ID, "", "claude-code", false, false, &scheduledAt)
+		assert.ErrorIs(t, err, ErrTaskAlreadyScheduled)
+	})
+}
+
+func TestListScheduledJobs(t *testing.T) {
+	ctx := context.Background()
+	taskID := uuid.New()
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	taskRepo.EXPECT().GetByID(ctx, taskID).Return(&entity.Task{ID: taskID}, nil)
+	jobClient := NewJobClientInterfaceMock(t)
+	now := time.Now()
+	jobClient.EXPECT().ScheduledJobs(taskID).Return([]ScheduledJob{
+		{ID: "later", TaskID: taskID, ScheduledAt: now.Add(2 * time.Hour)},
+		{ID: "sooner", TaskID: taskID, ScheduledAt: now.Add(time.Hour)},
+	}, nil).Once()
+	jobClient.EXPECT().CancelScheduledJob(taskID, "missing").Return(ErrScheduledJobNotFound).Once()
+	uc := &taskUsecase{taskRepo: taskRepo, jobClient: jobClient}
+
+	jobs, err := uc.ListScheduledJobs(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "sooner", jobs[0].ID)
+	assert.Equal(t, "later", jobs[1].ID)
+
+	assert.ErrorIs(t, uc.CancelScheduledJob(ctx, taskID, "missing"), ErrScheduledJobNotFound)
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// CollectStandupReports reports what happened in [from, to) in every active
+// project with notifications on. Projects with nothing to report are left
+// out; a project that fails is logged and does not stop the others.
+func (u *taskUsecase) CollectStandupReports(ctx context.Context, from, to time.Time) ([]*entity.StandupReport, error) {
+	archived := false
+	projects, _, err := u.projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{Archived: &archived})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	var reports []*entity.StandupReport
+	var errs []error
+	for _, project := range projects {
+		// Projects without settings keep the default, notifications on
+		if settings, err := u.projectRepo.GetSettings(ctx, project.ID); err == nil && !settings.NotificationsEnabled {
+			continue
+		}
+		report, err := u.collectStandupReport(ctx, project, from, to)
+		if err != nil {
+			slog.Warn("Failed to collect project standup report",
+				"project_id", project.ID,
+				"error", err,
+			)
+			errs = append(errs, fmt.Errorf("project %s: %w", project.ID, err))
+			continue
+		}
+		if !report.IsEmpty() {
+			reports = append(reports, report)
+		}
+	}
+	return reports, errors.Join(errs...)
+}
+
+// collectStandupReport lists the project's tasks completed and executions
+// run in [from, to), and the tasks waiting for review now
+func (u *taskUsecase) collectStandupReport(ctx context.Context, project *entity.Project, from, to time.Time) (*entity.StandupReport, error) {
+	report := &entity.StandupReport{
+		ProjectID:     project.ID,
+		ProjectName:   project.Name,
+		ProjectLocale: project.Locale,
+		From:          from,
+		To:            to,
+	}
+	within := func(t time.Time) bool {
+		return !t.Before(from) && t.Before(to)
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return report, nil
+	}
+	histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	historyByTask := make(map[uuid.UUID][]*entity.TaskStatusHistory)
+	for _, history := range histories {
+		historyByTask[history.TaskID] = append(historyByTask[history.TaskID], history)
+	}
+
+	taskByID := make(map[uuid.UUID]*entity.Task, len(tasks))
+	taskIDs := make([]uuid.UUID, len(tasks))
+	for i, task := range tasks {
+		taskByID[task.ID] = task
+		taskIDs[i] = task.ID
+
+		if cycleTime := measureTaskCycleTime(task, historyByTask[task.ID]); cycleTime != nil && within(cycleTime.CompletedAt) {
+			report.CompletedTasks = append(report.CompletedTasks, standupTask(task))
+		}
+		if task.Status == entity.TaskStatusPLANREVIEWING || task.Status == entity.TaskStatusCODEREVIEWING {
+			report.PendingReviews = append(report.PendingReviews, standupTask(task))
+		}
+	}
+
+	executions, err := u.executionRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get executions: %w", err)
+	}
+	// Most recent first, so a task failing more than once is reported with
+	// its last error
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].StartedAt.After(executions[j].StartedAt)
+	})
+	failed := make(map[uuid.UUID]bool)
+	for _, execution := range executions {
+		if within(execution.StartedAt) {
+			report.Executions++
+		}
+		if execution.Status != entity.ExecutionStatusFailed || execution.CompletedAt == nil || !within(*execution.CompletedAt) || failed[execution.TaskID] {
+			continue
+		}
+		task, ok := taskByID[execution.TaskID]
+		if !ok {
+			continue
+		}
+		failed[execution.TaskID] = true
+		failure := standupTask(task)
+		failure.Detail = execution.ErrorMessage
+		report.FailedTasks = append(report.FailedTasks, failure)
+	}
+	return report, nil
+}
+
+func standupTask(task *entity.Task) entity.StandupTask {
+	return entity.StandupTask{TaskID: task.ID, Title: task.Title, Status: task.Status}
+}
+
+// SendStandup sends the project's daily standup summary through the
+// notification channels
+func (u *taskUsecase) SendStandup(ctx context.Context, report *entity.StandupReport, summary string) error {
+	if u.notificationUsecase == nil {
+		return nil
+	}
+	return u.notificationUsecase.SendDailyStandupNotification(ctx, report, summary)
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectStandupReports(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, executionRepo: executionRepo}
+	to := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -1)
+	at := func(hours int) *time.Time {
+		t := from.Add(time.Duration(hours) * time.Hour)
+		return &t
+	}
+
+	project := &entity.Project{ID: uuid.New(), Name: "Shop", Locale: "vi"}
+	quiet := &entity.Project{ID: uuid.New(), Name: "Quiet"}
+	muted := &entity.Project{ID: uuid.New(), Name: "Muted"}
+
+	created := from.AddDate(0, 0, -2)
+	doneYesterday := &entity.Task{ID: uuid.New(), Title: "Add login", Status: entity.TaskStatusDONE, CreatedAt: created}
+	doneBefore := &entity.Task{ID: uuid.New(), Title: "Add logout", Status: entity.TaskStatusDONE, CreatedAt: created}
+	inReview := &entity.Task{ID: uuid.New(), Title: "Add search", Status: entity.TaskStatusCODEREVIEWING, CreatedAt: created}
+	histories := append(statusChanges(doneYesterday.ID, created, entity.TaskStatusIMPLEMENTING, 1, entity.TaskStatusDONE, 50),
+		statusChanges(doneBefore.ID, created, entity.TaskStatusIMPLEMENTING, 1, entity.TaskStatusDONE, 2)...)
+
+	archived := false
+	projectRepo.EXPECT().GetAllWithParams(mock.Anything, repository.GetProjectsParams{Archived: &archived}).
+		Return([]*entity.Project{project, quiet, muted}, 3, nil)
+	projectRepo.EXPECT().GetSettings(mock.Anything, project.ID).Return(nil, errors.New("settings not found"))
+	projectRepo.EXPECT().GetSettings(mock.Anything, quiet.ID).Return(&entity.ProjectSettings{NotificationsEnabled: true}, nil)
+	projectRepo.EXPECT().GetSettings(mock.Anything, muted.ID).Return(&entity.ProjectSettings{NotificationsEnabled: false}, nil)
+
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, project.ID).Return([]*entity.Task{doneYesterday, doneBefore, inReview}, nil)
+	taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, project.ID).Return(histories, nil)
+	executionRepo.EXPECT().GetByTaskIDs(mock.Anything, []uuid.UUID{doneYesterday.ID, doneBefore.ID, inReview.ID}).Return([]*entity.Execution{
+		{TaskID: doneYesterday.ID, Status: entity.ExecutionStatusCompleted, StartedAt: *at(1), CompletedAt: at(2)},
+		{TaskID: inReview.ID, Status: entity.ExecutionStatusFailed, StartedAt: *at(3), CompletedAt: at(4), ErrorMessage: "tests failed"},
+		{TaskID: inReview.ID, Status: entity.ExecutionStatusFailed, StartedAt: *at(5), CompletedAt: at(6), ErrorMessage: "lint failed"},
+		{TaskID: doneBefore.ID, Status: entity.ExecutionStatusFailed, StartedAt: *at(-20), CompletedAt: at(-19)},
+	}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, quiet.ID).Return(nil, nil)
+
+	reports, err := uc.CollectStandupReports(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, reports, 1, "quiet and muted projects get no report")
+
+	report := reports[0]
+	assert.Equal(t, project.ID, report.ProjectID)
+	assert.Equal(t, "vi", report.ProjectLocale)
+	assert.Equal(t, 3, report.Executions)
+	assert.Equal(t, []entity.StandupTask{{TaskID: doneYesterday.ID, Title: "Add login", Status: entity.TaskStatusDONE}}, report.CompletedTasks)
+	assert.Equal(t, []entity.StandupTask{{TaskID: inReview.ID, Title: "Add search", Status: entity.TaskStatusCODEREVIEWING, Detail: "lint failed"}}, report.FailedTasks,
+		"a task failing twice is reported once, with its last error")
+	assert.Equal(t, []entity.StandupTask{{TaskID: inReview.ID, Title: "Add search", Status: entity.TaskStatusCODEREVIEWING}}, report.PendingReviews)
+}
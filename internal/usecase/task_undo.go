@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTaskIDsRequired   = errors.New("no task IDs provided")
+	ErrUndoTokenNotFound = errors.New("undo token not found")
+	ErrUndoExpired       = errors.New("undo window has expired")
+	ErrUndoAlreadyUsed   = errors.New("operation has already been undone")
+)
+
+// undoTokenBytes is how many random bytes an undo token is made of
+const undoTokenBytes = 32
+
+// BulkDelete deletes the given tasks of the project. IDs of tasks of other
+// projects are ignored.
+func (u *taskUsecase) BulkDelete(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	tasks, err := u.getBulkTasks(ctx, projectID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	if len(ids) > 0 {
+		if err := u.taskRepo.BulkDelete(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+	for _, task := range tasks {
+		recordTaskEvent(ctx, u.eventRepo, entity.EventTypeTaskDeleted, task, "")
+	}
+
+	return u.recordUndo(ctx, projectID, entity.UndoOperationBulkDelete, ids)
+}
+
+// BulkArchive archives the given tasks of the project. Tasks already
+// archived are left out of the operation, so undoing it keeps them archived.
+func (u *taskUsecase) BulkArchive(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error) {
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	tasks, err := u.getBulkTasks(ctx, projectID, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(tasks))
+	for _, task := range tasks {
+		if !task.IsArchived {
+			ids = append(ids, task.ID)
+		}
+	}
+	if len(ids) > 0 {
+		if err := u.taskRepo.BulkArchive(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.recordUndo(ctx, projectID, entity.UndoOperationBulkArchive, ids)
+}
+
+// Undo restores the tasks of a bulk operation, once and only within the
+// window after it
+func (u *taskUsecase) Undo(ctx context.Context, token string) (*entity.UndoOperation, error) {
+	operation, err := u.undoRepo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUndoTokenNotFound, err)
+	}
+	// Tokens of projects outside the caller's organization are not found
+	if _, err := u.projectRepo.GetByID(ctx, operation.ProjectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUndoTokenNotFound, err)
+	}
+	if err := u.authorize(ctx, operation.ProjectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+	if operation.UndoneAt != nil {
+		return nil, ErrUndoAlreadyUsed
+	}
+	now := time.Now()
+	if now.After(operation.ExpiresAt) {
+		return nil, ErrUndoExpired
+	}
+
+	if len(operation.TaskIDs) > 0 {
+		switch operation.Operation {
+		case entity.UndoOperationBulkDelete:
+			err = u.taskRepo.BulkRestore(ctx, operation.TaskIDs)
+		case entity.UndoOperationBulkArchive:
+			err = u.taskRepo.BulkUnarchive(ctx, operation.TaskIDs)
+		default:
+			err = fmt.Errorf("unknown undo operation %q", operation.Operation)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Restoring is idempotent, so an undo racing this one is harmless; only
+	// one of them is reported as done
+	undone, err := u.undoRepo.MarkUndone(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+	if !undone {
+		return nil, ErrUndoAlreadyUsed
+	}
+	operation.UndoneAt = &now
+
+	return operation, nil
+}
+
+// getBulkTasks returns the tasks of the project among taskIDs
+func (u *taskUsecase) getBulkTasks(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) ([]*entity.Task, error) {
+	if len(taskIDs) == 0 {
+		return nil, ErrTaskIDsRequired
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+
+	tasks, err := u.taskRepo.GetByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	projectTasks := make([]*entity.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.ProjectID == projectID {
+			projectTasks = append(projectTasks, task)
+		}
+	}
+	return projectTasks, nil
+}
+
+// recordUndo records a bulk operation under a new undo token, pruning the
+// operations that can no longer be undone
+func (u *taskUsecase) recordUndo(ctx context.Context, projectID uuid.UUID, operationType entity.UndoOperationType, taskIDs []uuid.UUID) (*entity.UndoOperation, error) {
+	token, err := newUndoToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	operation := &entity.UndoOperation{
+		Token:     token,
+		ProjectID: projectID,
+		Operation: operationType,
+		TaskIDs:   taskIDs,
+		ExpiresAt: now.Add(u.undoWindow),
+	}
+	if err := u.undoRepo.Create(ctx, operation); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.undoRepo.DeleteExpiredBefore(ctx, now); err != nil {
+		slog.Warn("Failed to prune expired undo operations", "error", err)
+	}
+
+	return operation, nil
+}
+
+// newUndoToken returns a random, hex-encoded undo token
+func newUndoToken() (string, error) {
+	b := make([]byte, undoTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate undo token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkDelete(t *testing.T) {
+	projectID := uuid.New()
+	ownTask := &entity.Task{ID: uuid.New(), ProjectID: projectID}
+	otherTask := &entity.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	taskIDs := []uuid.UUID{ownTask.ID, otherTask.ID}
+
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	undoRepo := repository.NewUndoOperationRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByIDs(mock.Anything, taskIDs).Return([]*entity.Task{ownTask, otherTask}, nil)
+	taskRepo.EXPECT().BulkDelete(mock.Anything, []uuid.UUID{ownTask.ID}).Return(nil)
+	undoRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.UndoOperation")).Return(nil)
+	undoRepo.EXPECT().DeleteExpiredBefore(mock.Anything, mock.Anything).Return(0, nil)
+	u := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, undoRepo: undoRepo, undoWindow: 5 * time.Minute}
+
+	operation, err := u.BulkDelete(context.Background(), projectID, taskIDs)
+	require.NoError(t, err)
+	assert.Equal(t, entity.UndoOperationBulkDelete, operation.Operation)
+	assert.Equal(t, []uuid.UUID{ownTask.ID}, operation.TaskIDs, "tasks of other projects are left alone")
+	assert.Len(t, operation.Token, 2*undoTokenBytes)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), operation.ExpiresAt, time.Second)
+}
+
+func TestBulkArchive_SkipsArchivedTasks(t *testing.T) {
+	projectID := uuid.New()
+	active := &entity.Task{ID: uuid.New(), ProjectID: projectID}
+	archived := &entity.Task{ID: uuid.New(), ProjectID: projectID, IsArchived: true}
+	taskIDs := []uuid.UUID{active.ID, archived.ID}
+
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	undoRepo := repository.NewUndoOperationRepositoryMock(t)
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByIDs(mock.Anything, taskIDs).Return([]*entity.Task{active, archived}, nil)
+	taskRepo.EXPECT().BulkArchive(mock.Anything, []uuid.UUID{active.ID}).Return(nil)
+	undoRepo.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.UndoOperation")).Return(nil)
+	undoRepo.EXPECT().DeleteExpiredBefore(mock.Anything, mock.Anything).Return(0, nil)
+	u := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, undoRepo: undoRepo, undoWindow: time.Minute}
+
+	operation, err := u.BulkArchive(context.Background(), projectID, taskIDs)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{active.ID}, operation.TaskIDs, "undoing keeps already archived tasks archived")
+}
+
+func TestBulkDelete_RequiresTaskIDs(t *testing.T) {
+	u := &taskUsecase{}
+
+	_, err := u.BulkDelete(context.Background(), uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrTaskIDsRequired)
+}
+
+func TestUndo(t *testing.T) {
+	projectID := uuid.New()
+	taskIDs := []uuid.UUID{uuid.New()}
+	newOperation := func(operationType entity.UndoOperationType, expiresAt time.Time) *entity.UndoOperation {
+		return &entity.UndoOperation{Token: "token", ProjectID: projectID, Operation: operationType, TaskIDs: taskIDs, ExpiresAt: expiresAt}
+	}
+	setup := func(t *testing.T, operation *entity.UndoOperation) (*taskUsecase, *repository.TaskRepositoryMock, *repository.UndoOperationRepositoryMock) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		undoRepo := repository.NewUndoOperationRepositoryMock(t)
+		undoRepo.EXPECT().GetByToken(mock.Anything, "token").Return(operation, nil)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+		return &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, undoRepo: undoRepo}, taskRepo, undoRepo
+	}
+
+	t.Run("restores deleted tasks", func(t *testing.T) {
+		u, taskRepo, undoRepo := setup(t, newOperation(entity.UndoOperationBulkDelete, time.Now().Add(time.Minute)))
+		taskRepo.EXPECT().BulkRestore(mock.Anything, taskIDs).Return(nil)
+		undoRepo.EXPECT().MarkUndone(mock.Anything, "token", mock.Anything).Return(true, nil)
+
+		operation, err := u.Undo(context.Background(), "token")
+		require.NoError(t, err)
+		assert.NotNil(t, operation.UndoneAt)
+	})
+
+	t.Run("unarchives archived tasks", func(t *testing.T) {
+		u, taskRepo, undoRepo := setup(t, newOperation(entity.UndoOperationBulkArchive, time.Now().Add(time.Minute)))
+		taskRepo.EXPECT().BulkUnarchive(mock.Anything, taskIDs).Return(nil)
+		undoRepo.EXPECT().MarkUndone(mock.Anything, "token", mock.Anything).Return(true, nil)
+
+		_, err := u.Undo(context.Background(), "token")
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects expired tokens", func(t *testing.T) {
+		u, _, _ := setup(t, newOperation(entity.UndoOperationBulkDelete, time.Now().Add(-time.Second)))
+
+		_, err := u.Undo(context.Background(), "token")
+		assert.ErrorIs(t, err, ErrUndoExpired)
+	})
+
+	t.Run("undoes once", func(t *testing.T) {
+		operation := newOperation(entity.UndoOperationBulkDelete, time.Now().Add(time.Minute))
+		undoneAt := time.Now()
+		operation.UndoneAt = &undoneAt
+		u, _, _ := setup(t, operation)
+
+		_, err := u.Undo(context.Background(), "token")
+		assert.ErrorIs(t, err, ErrUndoAlreadyUsed)
+	})
+
+	t.Run("hides tokens of other organizations", func(t *testing.T) {
+		projectRepo := repository.NewProjectRepositoryMock(t)
+		undoRepo := repository.NewUndoOperationRepositoryMock(t)
+		undoRepo.EXPECT().GetByToken(mock.Anything, "token").Return(newOperation(entity.UndoOperationBulkDelete, time.Now().Add(time.Minute)), nil)
+		projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(nil, errors.New("project not found"))
+		u := &taskUsecase{projectRepo: projectRepo, undoRepo: undoRepo}
+
+		_, err := u.Undo(context.Background(), "token")
+		assert.ErrorIs(t, err, ErrUndoTokenNotFound)
+	})
+}
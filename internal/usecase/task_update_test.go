@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate_ClearFields(t *testing.T) {
+	assignee := "user-1"
+	dueDate := time.Now().Add(24 * time.Hour)
+	hours := 3.5
+	newTask := func(id uuid.UUID) *entity.Task {
+		return &entity.Task{
+			ID:             id,
+			ProjectID:      uuid.New(),
+			Title:          "Task",
+			Description:    "Some description",
+			Status:         entity.TaskStatusTODO,
+			AssignedTo:     &assignee,
+			DueDate:        &dueDate,
+			EstimatedHours: &hours,
+			Tags:           []string{"backend"},
+		}
+	}
+
+	t.Run("clears the listed fields", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		id := uuid.New()
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(newTask(id), nil)
+		taskRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		task, err := uc.Update(context.Background(), id, UpdateTaskRequest{
+			ClearFields: []string{TaskFieldDescription, TaskFieldAssignedTo, TaskFieldDueDate, TaskFieldEstimatedHours, TaskFieldTags},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, task.Description)
+		assert.Nil(t, task.AssignedTo)
+		assert.Nil(t, task.DueDate)
+		assert.Nil(t, task.EstimatedHours)
+		assert.Nil(t, task.Tags)
+		assert.Equal(t, "Task", task.Title)
+	})
+
+	t.Run("zero values leave fields unchanged", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		id := uuid.New()
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(newTask(id), nil)
+		taskRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		task, err := uc.Update(context.Background(), id, UpdateTaskRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "Some description", task.Description)
+		assert.Equal(t, &assignee, task.AssignedTo)
+		assert.Equal(t, &dueDate, task.DueDate)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		id := uuid.New()
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(newTask(id), nil)
+
+		_, err := uc.Update(context.Background(), id, UpdateTaskRequest{ClearFields: []string{"title"}})
+		assert.ErrorIs(t, err, ErrTaskFieldNotClearable)
+	})
+
+	t.Run("rejects setting and clearing the same field", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+		id := uuid.New()
+		other := "user-2"
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(newTask(id), nil)
+
+		_, err := uc.Update(context.Background(), id, UpdateTaskRequest{
+			AssignedTo:  &other,
+			ClearFields: []string{TaskFieldAssignedTo},
+		})
+		assert.ErrorIs(t, err, ErrTaskFieldConflict)
+	})
+}
+
+func TestUpdate_Epic(t *testing.T) {
+	projectID := uuid.New()
+	epicID := uuid.New()
+	id := uuid.New()
+
+	t.Run("moves the task under an epic of its project", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, epicRepo: epicRepo}
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(&entity.Task{ID: id, ProjectID: projectID, Status: entity.TaskStatusTODO}, nil)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(&entity.Epic{ID: epicID, ProjectID: projectID}, nil)
+		taskRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		task, err := uc.Update(context.Background(), id, UpdateTaskRequest{EpicID: &epicID})
+		require.NoError(t, err)
+		assert.Equal(t, &epicID, task.EpicID)
+	})
+
+	t.Run("rejects an epic of another project", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		epicRepo := repository.NewEpicRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo, epicRepo: epicRepo}
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(&entity.Task{ID: id, ProjectID: projectID, Status: entity.TaskStatusTODO}, nil)
+		epicRepo.EXPECT().GetByID(mock.Anything, epicID).Return(&entity.Epic{ID: epicID, ProjectID: uuid.New()}, nil)
+
+		_, err := uc.Update(context.Background(), id, UpdateTaskRequest{EpicID: &epicID})
+		assert.ErrorIs(t, err, ErrEpicProjectMismatch)
+	})
+
+	t.Run("clears the epic", func(t *testing.T) {
+		taskRepo := repository.NewTaskRepositoryMock(t)
+		uc := &taskUsecase{taskRepo: taskRepo}
+
+		taskRepo.EXPECT().GetByID(mock.Anything, id).Return(&entity.Task{ID: id, ProjectID: projectID, Status: entity.TaskStatusTODO, EpicID: &epicID}, nil)
+		taskRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil)
+
+		task, err := uc.Update(context.Background(), id, UpdateTaskRequest{ClearFields: []string{TaskFieldEpicID}})
+		require.NoError(t, err)
+		assert.Nil(t, task.EpicID)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
 	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -145,6 +146,70 @@ func (_c *TaskUsecaseMock_AddDependency_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// AnswerQuestion provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) AnswerQuestion(ctx context.Context, executionID uuid.UUID, req AnswerQuestionRequest) (*entity.Execution, string, error) {
+	ret := _mock.Called(ctx, executionID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnswerQuestion")
+	}
+
+	var r0 *entity.Execution
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, AnswerQuestionRequest) (*entity.Execution, string, error)); ok {
+		return returnFunc(ctx, executionID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, AnswerQuestionRequest) *entity.Execution); ok {
+		r0 = returnFunc(ctx, executionID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, AnswerQuestionRequest) string); ok {
+		r1 = returnFunc(ctx, executionID, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, AnswerQuestionRequest) error); ok {
+		r2 = returnFunc(ctx, executionID, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TaskUsecaseMock_AnswerQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnswerQuestion'
+type TaskUsecaseMock_AnswerQuestion_Call struct {
+	*mock.Call
+}
+
+// AnswerQuestion is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+//   - req
+func (_e *TaskUsecaseMock_Expecter) AnswerQuestion(ctx interface{}, executionID interface{}, req interface{}) *TaskUsecaseMock_AnswerQuestion_Call {
+	return &TaskUsecaseMock_AnswerQuestion_Call{Call: _e.mock.On("AnswerQuestion", ctx, executionID, req)}
+}
+
+func (_c *TaskUsecaseMock_AnswerQuestion_Call) Run(run func(ctx context.Context, executionID uuid.UUID, req AnswerQuestionRequest)) *TaskUsecaseMock_AnswerQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(AnswerQuestionRequest))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_AnswerQuestion_Call) Return(execution *entity.Execution, s string, err error) *TaskUsecaseMock_AnswerQuestion_Call {
+	_c.Call.Return(execution, s, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_AnswerQuestion_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID, req AnswerQuestionRequest) (*entity.Execution, string, error)) *TaskUsecaseMock_AnswerQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AppendErrorLog provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) AppendErrorLog(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
 	ret := _mock.Called(ctx, taskID, errorMsg)
@@ -193,8 +258,8 @@ func (_c *TaskUsecaseMock_AppendErrorLog_Call) RunAndReturn(run func(ctx context
 }
 
 // ApprovePlan provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string) (string, error) {
-	ret := _mock.Called(ctx, taskID, aiType)
+func (_mock *TaskUsecaseMock) ApprovePlan(ctx context.Context, taskID uuid.UUID, aiType string, scheduledAt *time.Time) (string, error) {
+	ret := _mock.Called(ctx, taskID, aiType, scheduledAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ApprovePlan")
@@ -202,16 +267,16 @@ func (_mock *TaskUsecaseMock) ApprovePlan(ctx context.Context, taskID uuid.UUID,
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
-		return returnFunc(ctx, taskID, aiType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *time.Time) (string, error)); ok {
+		return returnFunc(ctx, taskID, aiType, scheduledAt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
-		r0 = returnFunc(ctx, taskID, aiType)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, *time.Time) string); ok {
+		r0 = returnFunc(ctx, taskID, aiType, scheduledAt)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = returnFunc(ctx, taskID, aiType)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, *time.Time) error); ok {
+		r1 = returnFunc(ctx, taskID, aiType, scheduledAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -227,42 +292,54 @@ type TaskUsecaseMock_ApprovePlan_Call struct {
 //   - ctx
 //   - taskID
 //   - aiType
-func (_e *TaskUsecaseMock_Expecter) ApprovePlan(ctx interface{}, taskID interface{}, aiType interface{}) *TaskUsecaseMock_ApprovePlan_Call {
-	return &TaskUsecaseMock_ApprovePlan_Call{Call: _e.mock.On("ApprovePlan", ctx, taskID, aiType)}
+//   - scheduledAt
+func (_e *TaskUsecaseMock_Expecter) ApprovePlan(ctx interface{}, taskID interface{}, aiType interface{}, scheduledAt interface{}) *TaskUsecaseMock_ApprovePlan_Call {
+	return &TaskUsecaseMock_ApprovePlan_Call{Call: _e.mock.On("ApprovePlan", ctx, taskID, aiType, scheduledAt)}
 }
 
-func (_c *TaskUsecaseMock_ApprovePlan_Call) Run(run func(ctx context.Context, taskID uuid.UUID, aiType string)) *TaskUsecaseMock_ApprovePlan_Call {
+func (_c *TaskUsecaseMock_ApprovePlan_Call) Run(run func(ctx context.Context, taskID uuid.UUID, aiType string, scheduledAt *time.Time)) *TaskUsecaseMock_ApprovePlan_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(*time.Time))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_ApprovePlan_Call) Return(s string, err error) *TaskUsecaseMock_ApprovePlan_Call {
-	_c.Call.Return(s, err)
+func (_c *TaskUsecaseMock_ApprovePlan_Call) Return(_a0 string, _a1 error) *TaskUsecaseMock_ApprovePlan_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_ApprovePlan_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, aiType string) (string, error)) *TaskUsecaseMock_ApprovePlan_Call {
+func (_c *TaskUsecaseMock_ApprovePlan_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, aiType string, scheduledAt *time.Time) (string, error)) *TaskUsecaseMock_ApprovePlan_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // BulkArchive provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) BulkArchive(ctx context.Context, taskIDs []uuid.UUID) error {
-	ret := _mock.Called(ctx, taskIDs)
+func (_mock *TaskUsecaseMock) BulkArchive(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error) {
+	ret := _mock.Called(ctx, projectID, taskIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BulkArchive")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, taskIDs)
+	var r0 *entity.UndoOperation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (*entity.UndoOperation, error)); ok {
+		return returnFunc(ctx, projectID, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) *entity.UndoOperation); ok {
+		r0 = returnFunc(ctx, projectID, taskIDs)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.UndoOperation)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // TaskUsecaseMock_BulkArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkArchive'
@@ -272,24 +349,25 @@ type TaskUsecaseMock_BulkArchive_Call struct {
 
 // BulkArchive is a helper method to define mock.On call
 //   - ctx
+//   - projectID
 //   - taskIDs
-func (_e *TaskUsecaseMock_Expecter) BulkArchive(ctx interface{}, taskIDs interface{}) *TaskUsecaseMock_BulkArchive_Call {
-	return &TaskUsecaseMock_BulkArchive_Call{Call: _e.mock.On("BulkArchive", ctx, taskIDs)}
+func (_e *TaskUsecaseMock_Expecter) BulkArchive(ctx interface{}, projectID interface{}, taskIDs interface{}) *TaskUsecaseMock_BulkArchive_Call {
+	return &TaskUsecaseMock_BulkArchive_Call{Call: _e.mock.On("BulkArchive", ctx, projectID, taskIDs)}
 }
 
-func (_c *TaskUsecaseMock_BulkArchive_Call) Run(run func(ctx context.Context, taskIDs []uuid.UUID)) *TaskUsecaseMock_BulkArchive_Call {
+func (_c *TaskUsecaseMock_BulkArchive_Call) Run(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID)) *TaskUsecaseMock_BulkArchive_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_BulkArchive_Call) Return(err error) *TaskUsecaseMock_BulkArchive_Call {
-	_c.Call.Return(err)
+func (_c *TaskUsecaseMock_BulkArchive_Call) Return(undoOperation *entity.UndoOperation, err error) *TaskUsecaseMock_BulkArchive_Call {
+	_c.Call.Return(undoOperation, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_BulkArchive_Call) RunAndReturn(run func(ctx context.Context, taskIDs []uuid.UUID) error) *TaskUsecaseMock_BulkArchive_Call {
+func (_c *TaskUsecaseMock_BulkArchive_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error)) *TaskUsecaseMock_BulkArchive_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -342,20 +420,31 @@ func (_c *TaskUsecaseMock_BulkAssign_Call) RunAndReturn(run func(ctx context.Con
 }
 
 // BulkDelete provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) BulkDelete(ctx context.Context, taskIDs []uuid.UUID) error {
-	ret := _mock.Called(ctx, taskIDs)
+func (_mock *TaskUsecaseMock) BulkDelete(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error) {
+	ret := _mock.Called(ctx, projectID, taskIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for BulkDelete")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, taskIDs)
+	var r0 *entity.UndoOperation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) (*entity.UndoOperation, error)); ok {
+		return returnFunc(ctx, projectID, taskIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID) *entity.UndoOperation); ok {
+		r0 = returnFunc(ctx, projectID, taskIDs)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.UndoOperation)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID, taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // TaskUsecaseMock_BulkDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDelete'
@@ -365,24 +454,25 @@ type TaskUsecaseMock_BulkDelete_Call struct {
 
 // BulkDelete is a helper method to define mock.On call
 //   - ctx
+//   - projectID
 //   - taskIDs
-func (_e *TaskUsecaseMock_Expecter) BulkDelete(ctx interface{}, taskIDs interface{}) *TaskUsecaseMock_BulkDelete_Call {
-	return &TaskUsecaseMock_BulkDelete_Call{Call: _e.mock.On("BulkDelete", ctx, taskIDs)}
+func (_e *TaskUsecaseMock_Expecter) BulkDelete(ctx interface{}, projectID interface{}, taskIDs interface{}) *TaskUsecaseMock_BulkDelete_Call {
+	return &TaskUsecaseMock_BulkDelete_Call{Call: _e.mock.On("BulkDelete", ctx, projectID, taskIDs)}
 }
 
-func (_c *TaskUsecaseMock_BulkDelete_Call) Run(run func(ctx context.Context, taskIDs []uuid.UUID)) *TaskUsecaseMock_BulkDelete_Call {
+func (_c *TaskUsecaseMock_BulkDelete_Call) Run(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID)) *TaskUsecaseMock_BulkDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_BulkDelete_Call) Return(err error) *TaskUsecaseMock_BulkDelete_Call {
-	_c.Call.Return(err)
+func (_c *TaskUsecaseMock_BulkDelete_Call) Return(undoOperation *entity.UndoOperation, err error) *TaskUsecaseMock_BulkDelete_Call {
+	_c.Call.Return(undoOperation, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_BulkDelete_Call) RunAndReturn(run func(ctx context.Context, taskIDs []uuid.UUID) error) *TaskUsecaseMock_BulkDelete_Call {
+func (_c *TaskUsecaseMock_BulkDelete_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID) (*entity.UndoOperation, error)) *TaskUsecaseMock_BulkDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -526,6 +616,116 @@ func (_c *TaskUsecaseMock_BulkUpdateStatus_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// CancelExecution provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) CancelExecution(ctx context.Context, executionID uuid.UUID) (*entity.Execution, string, error) {
+	ret := _mock.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelExecution")
+	}
+
+	var r0 *entity.Execution
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Execution, string, error)); ok {
+		return returnFunc(ctx, executionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Execution); ok {
+		r0 = returnFunc(ctx, executionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) string); ok {
+		r1 = returnFunc(ctx, executionID)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID) error); ok {
+		r2 = returnFunc(ctx, executionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TaskUsecaseMock_CancelExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExecution'
+type TaskUsecaseMock_CancelExecution_Call struct {
+	*mock.Call
+}
+
+// CancelExecution is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+func (_e *TaskUsecaseMock_Expecter) CancelExecution(ctx interface{}, executionID interface{}) *TaskUsecaseMock_CancelExecution_Call {
+	return &TaskUsecaseMock_CancelExecution_Call{Call: _e.mock.On("CancelExecution", ctx, executionID)}
+}
+
+func (_c *TaskUsecaseMock_CancelExecution_Call) Run(run func(ctx context.Context, executionID uuid.UUID)) *TaskUsecaseMock_CancelExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CancelExecution_Call) Return(_a0 *entity.Execution, _a1 string, _a2 error) *TaskUsecaseMock_CancelExecution_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CancelExecution_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID) (*entity.Execution, string, error)) *TaskUsecaseMock_CancelExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelScheduledJob provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) CancelScheduledJob(ctx context.Context, taskID uuid.UUID, jobID string) error {
+	ret := _mock.Called(ctx, taskID, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduledJob")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, taskID, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_CancelScheduledJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledJob'
+type TaskUsecaseMock_CancelScheduledJob_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledJob is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - jobID
+func (_e *TaskUsecaseMock_Expecter) CancelScheduledJob(ctx interface{}, taskID interface{}, jobID interface{}) *TaskUsecaseMock_CancelScheduledJob_Call {
+	return &TaskUsecaseMock_CancelScheduledJob_Call{Call: _e.mock.On("CancelScheduledJob", ctx, taskID, jobID)}
+}
+
+func (_c *TaskUsecaseMock_CancelScheduledJob_Call) Run(run func(ctx context.Context, taskID uuid.UUID, jobID string)) *TaskUsecaseMock_CancelScheduledJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CancelScheduledJob_Call) Return(_a0 error) *TaskUsecaseMock_CancelScheduledJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CancelScheduledJob_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, jobID string) error) *TaskUsecaseMock_CancelScheduledJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckDuplicateTitle provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) CheckDuplicateTitle(ctx context.Context, projectID uuid.UUID, title string, excludeID *uuid.UUID) (bool, error) {
 	ret := _mock.Called(ctx, projectID, title, excludeID)
@@ -583,6 +783,109 @@ func (_c *TaskUsecaseMock_CheckDuplicateTitle_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// CheckPlanningCapacity provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) CheckPlanningCapacity(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPlanningCapacity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_CheckPlanningCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPlanningCapacity'
+type TaskUsecaseMock_CheckPlanningCapacity_Call struct {
+	*mock.Call
+}
+
+// CheckPlanningCapacity is a helper method to define mock.On call
+//   - ctx
+func (_e *TaskUsecaseMock_Expecter) CheckPlanningCapacity(ctx interface{}) *TaskUsecaseMock_CheckPlanningCapacity_Call {
+	return &TaskUsecaseMock_CheckPlanningCapacity_Call{Call: _e.mock.On("CheckPlanningCapacity", ctx)}
+}
+
+func (_c *TaskUsecaseMock_CheckPlanningCapacity_Call) Run(run func(ctx context.Context)) *TaskUsecaseMock_CheckPlanningCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CheckPlanningCapacity_Call) Return(err error) *TaskUsecaseMock_CheckPlanningCapacity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CheckPlanningCapacity_Call) RunAndReturn(run func(ctx context.Context) error) *TaskUsecaseMock_CheckPlanningCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CollectStandupReports provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) CollectStandupReports(ctx context.Context, from time.Time, to time.Time) ([]*entity.StandupReport, error) {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectStandupReports")
+	}
+
+	var r0 []*entity.StandupReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entity.StandupReport, error)); ok {
+		return returnFunc(ctx, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entity.StandupReport); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.StandupReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_CollectStandupReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CollectStandupReports'
+type TaskUsecaseMock_CollectStandupReports_Call struct {
+	*mock.Call
+}
+
+// CollectStandupReports is a helper method to define mock.On call
+//   - ctx
+//   - from
+//   - to
+func (_e *TaskUsecaseMock_Expecter) CollectStandupReports(ctx interface{}, from interface{}, to interface{}) *TaskUsecaseMock_CollectStandupReports_Call {
+	return &TaskUsecaseMock_CollectStandupReports_Call{Call: _e.mock.On("CollectStandupReports", ctx, from, to)}
+}
+
+func (_c *TaskUsecaseMock_CollectStandupReports_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *TaskUsecaseMock_CollectStandupReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CollectStandupReports_Call) Return(standupReports []*entity.StandupReport, err error) *TaskUsecaseMock_CollectStandupReports_Call {
+	_c.Call.Return(standupReports, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_CollectStandupReports_Call) RunAndReturn(run func(ctx context.Context, from time.Time, to time.Time) ([]*entity.StandupReport, error)) *TaskUsecaseMock_CollectStandupReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) Create(ctx context.Context, req CreateTaskRequest) (*entity.Task, error) {
 	ret := _mock.Called(ctx, req)
@@ -1057,8 +1360,8 @@ func (_c *TaskUsecaseMock_ExportTasks_Call) Run(run func(ctx context.Context, fi
 	return _c
 }
 
-func (_c *TaskUsecaseMock_ExportTasks_Call) Return(bytes []byte, err error) *TaskUsecaseMock_ExportTasks_Call {
-	_c.Call.Return(bytes, err)
+func (_c *TaskUsecaseMock_ExportTasks_Call) Return(bytes1 []byte, err error) *TaskUsecaseMock_ExportTasks_Call {
+	_c.Call.Return(bytes1, err)
 	return _c
 }
 
@@ -1182,6 +1485,65 @@ func (_c *TaskUsecaseMock_GetAuditLogs_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetBoard provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetBoard(ctx context.Context, projectID uuid.UUID, includeDone bool, epicID *uuid.UUID) (*TaskBoard, error) {
+	ret := _mock.Called(ctx, projectID, includeDone, epicID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoard")
+	}
+
+	var r0 *TaskBoard
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID) (*TaskBoard, error)); ok {
+		return returnFunc(ctx, projectID, includeDone, epicID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, *uuid.UUID) *TaskBoard); ok {
+		r0 = returnFunc(ctx, projectID, includeDone, epicID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TaskBoard)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID, includeDone, epicID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoard'
+type TaskUsecaseMock_GetBoard_Call struct {
+	*mock.Call
+}
+
+// GetBoard is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - includeDone
+//   - epicID
+func (_e *TaskUsecaseMock_Expecter) GetBoard(ctx interface{}, projectID interface{}, includeDone interface{}, epicID interface{}) *TaskUsecaseMock_GetBoard_Call {
+	return &TaskUsecaseMock_GetBoard_Call{Call: _e.mock.On("GetBoard", ctx, projectID, includeDone, epicID)}
+}
+
+func (_c *TaskUsecaseMock_GetBoard_Call) Run(run func(ctx context.Context, projectID uuid.UUID, includeDone bool, epicID *uuid.UUID)) *TaskUsecaseMock_GetBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetBoard_Call) Return(taskBoard *TaskBoard, err error) *TaskUsecaseMock_GetBoard_Call {
+	_c.Call.Return(taskBoard, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetBoard_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, includeDone bool, epicID *uuid.UUID) (*TaskBoard, error)) *TaskUsecaseMock_GetBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetByID(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
 	ret := _mock.Called(ctx, id)
@@ -1467,24 +1829,24 @@ func (_c *TaskUsecaseMock_GetComments_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
-// GetDependencies provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) GetDependencies(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskDependency, error) {
+// GetComparison provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetComparison(ctx context.Context, taskID uuid.UUID) (*ExecutorComparison, error) {
 	ret := _mock.Called(ctx, taskID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDependencies")
+		panic("no return value specified for GetComparison")
 	}
 
-	var r0 []*entity.TaskDependency
+	var r0 *ExecutorComparison
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.TaskDependency, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*ExecutorComparison, error)); ok {
 		return returnFunc(ctx, taskID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.TaskDependency); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *ExecutorComparison); ok {
 		r0 = returnFunc(ctx, taskID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entity.TaskDependency)
+			r0 = ret.Get(0).(*ExecutorComparison)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
@@ -1495,26 +1857,142 @@ func (_mock *TaskUsecaseMock) GetDependencies(ctx context.Context, taskID uuid.U
 	return r0, r1
 }
 
-// TaskUsecaseMock_GetDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencies'
-type TaskUsecaseMock_GetDependencies_Call struct {
+// TaskUsecaseMock_GetComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComparison'
+type TaskUsecaseMock_GetComparison_Call struct {
 	*mock.Call
 }
 
-// GetDependencies is a helper method to define mock.On call
+// GetComparison is a helper method to define mock.On call
 //   - ctx
 //   - taskID
-func (_e *TaskUsecaseMock_Expecter) GetDependencies(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetDependencies_Call {
-	return &TaskUsecaseMock_GetDependencies_Call{Call: _e.mock.On("GetDependencies", ctx, taskID)}
+func (_e *TaskUsecaseMock_Expecter) GetComparison(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetComparison_Call {
+	return &TaskUsecaseMock_GetComparison_Call{Call: _e.mock.On("GetComparison", ctx, taskID)}
 }
 
-func (_c *TaskUsecaseMock_GetDependencies_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_GetDependencies_Call {
+func (_c *TaskUsecaseMock_GetComparison_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_GetComparison_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_GetDependencies_Call) Return(taskDependencys []*entity.TaskDependency, err error) *TaskUsecaseMock_GetDependencies_Call {
+func (_c *TaskUsecaseMock_GetComparison_Call) Return(executorComparison *ExecutorComparison, err error) *TaskUsecaseMock_GetComparison_Call {
+	_c.Call.Return(executorComparison, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetComparison_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (*ExecutorComparison, error)) *TaskUsecaseMock_GetComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCycleTimeAnalytics provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetCycleTimeAnalytics(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*CycleTimeAnalytics, error) {
+	ret := _mock.Called(ctx, projectID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCycleTimeAnalytics")
+	}
+
+	var r0 *CycleTimeAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) (*CycleTimeAnalytics, error)); ok {
+		return returnFunc(ctx, projectID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) *CycleTimeAnalytics); ok {
+		r0 = returnFunc(ctx, projectID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CycleTimeAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, *time.Time) error); ok {
+		r1 = returnFunc(ctx, projectID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetCycleTimeAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCycleTimeAnalytics'
+type TaskUsecaseMock_GetCycleTimeAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetCycleTimeAnalytics is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - from
+//   - to
+func (_e *TaskUsecaseMock_Expecter) GetCycleTimeAnalytics(ctx interface{}, projectID interface{}, from interface{}, to interface{}) *TaskUsecaseMock_GetCycleTimeAnalytics_Call {
+	return &TaskUsecaseMock_GetCycleTimeAnalytics_Call{Call: _e.mock.On("GetCycleTimeAnalytics", ctx, projectID, from, to)}
+}
+
+func (_c *TaskUsecaseMock_GetCycleTimeAnalytics_Call) Run(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time)) *TaskUsecaseMock_GetCycleTimeAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetCycleTimeAnalytics_Call) Return(cycleTimeAnalytics *CycleTimeAnalytics, err error) *TaskUsecaseMock_GetCycleTimeAnalytics_Call {
+	_c.Call.Return(cycleTimeAnalytics, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetCycleTimeAnalytics_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*CycleTimeAnalytics, error)) *TaskUsecaseMock_GetCycleTimeAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencies provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetDependencies(ctx context.Context, taskID uuid.UUID) ([]*entity.TaskDependency, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencies")
+	}
+
+	var r0 []*entity.TaskDependency
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.TaskDependency, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.TaskDependency); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TaskDependency)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencies'
+type TaskUsecaseMock_GetDependencies_Call struct {
+	*mock.Call
+}
+
+// GetDependencies is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) GetDependencies(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetDependencies_Call {
+	return &TaskUsecaseMock_GetDependencies_Call{Call: _e.mock.On("GetDependencies", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_GetDependencies_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_GetDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetDependencies_Call) Return(taskDependencys []*entity.TaskDependency, err error) *TaskUsecaseMock_GetDependencies_Call {
 	_c.Call.Return(taskDependencys, err)
 	return _c
 }
@@ -1581,6 +2059,122 @@ func (_c *TaskUsecaseMock_GetDependents_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetDetail provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetDetail(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDetail")
+	}
+
+	var r0 *entity.TaskDetail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.TaskDetail, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.TaskDetail); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.TaskDetail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDetail'
+type TaskUsecaseMock_GetDetail_Call struct {
+	*mock.Call
+}
+
+// GetDetail is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *TaskUsecaseMock_Expecter) GetDetail(ctx interface{}, id interface{}) *TaskUsecaseMock_GetDetail_Call {
+	return &TaskUsecaseMock_GetDetail_Call{Call: _e.mock.On("GetDetail", ctx, id)}
+}
+
+func (_c *TaskUsecaseMock_GetDetail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *TaskUsecaseMock_GetDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetDetail_Call) Return(taskDetail *entity.TaskDetail, err error) *TaskUsecaseMock_GetDetail_Call {
+	_c.Call.Return(taskDetail, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetDetail_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (*entity.TaskDetail, error)) *TaskUsecaseMock_GetDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExecutorAnalytics provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetExecutorAnalytics(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*ExecutorAnalytics, error) {
+	ret := _mock.Called(ctx, projectID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutorAnalytics")
+	}
+
+	var r0 *ExecutorAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) (*ExecutorAnalytics, error)); ok {
+		return returnFunc(ctx, projectID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) *ExecutorAnalytics); ok {
+		r0 = returnFunc(ctx, projectID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ExecutorAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, *time.Time) error); ok {
+		r1 = returnFunc(ctx, projectID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetExecutorAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutorAnalytics'
+type TaskUsecaseMock_GetExecutorAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetExecutorAnalytics is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - from
+//   - to
+func (_e *TaskUsecaseMock_Expecter) GetExecutorAnalytics(ctx interface{}, projectID interface{}, from interface{}, to interface{}) *TaskUsecaseMock_GetExecutorAnalytics_Call {
+	return &TaskUsecaseMock_GetExecutorAnalytics_Call{Call: _e.mock.On("GetExecutorAnalytics", ctx, projectID, from, to)}
+}
+
+func (_c *TaskUsecaseMock_GetExecutorAnalytics_Call) Run(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time)) *TaskUsecaseMock_GetExecutorAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetExecutorAnalytics_Call) Return(executorAnalytics *ExecutorAnalytics, err error) *TaskUsecaseMock_GetExecutorAnalytics_Call {
+	_c.Call.Return(executorAnalytics, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetExecutorAnalytics_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*ExecutorAnalytics, error)) *TaskUsecaseMock_GetExecutorAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetParentTask provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetParentTask(ctx context.Context, taskID uuid.UUID) (*entity.Task, error) {
 	ret := _mock.Called(ctx, taskID)
@@ -1638,6 +2232,65 @@ func (_c *TaskUsecaseMock_GetParentTask_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// GetPlanReviewAnalytics provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetPlanReviewAnalytics(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*PlanReviewAnalytics, error) {
+	ret := _mock.Called(ctx, projectID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlanReviewAnalytics")
+	}
+
+	var r0 *PlanReviewAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) (*PlanReviewAnalytics, error)); ok {
+		return returnFunc(ctx, projectID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time) *PlanReviewAnalytics); ok {
+		r0 = returnFunc(ctx, projectID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PlanReviewAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, *time.Time) error); ok {
+		r1 = returnFunc(ctx, projectID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetPlanReviewAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlanReviewAnalytics'
+type TaskUsecaseMock_GetPlanReviewAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetPlanReviewAnalytics is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - from
+//   - to
+func (_e *TaskUsecaseMock_Expecter) GetPlanReviewAnalytics(ctx interface{}, projectID interface{}, from interface{}, to interface{}) *TaskUsecaseMock_GetPlanReviewAnalytics_Call {
+	return &TaskUsecaseMock_GetPlanReviewAnalytics_Call{Call: _e.mock.On("GetPlanReviewAnalytics", ctx, projectID, from, to)}
+}
+
+func (_c *TaskUsecaseMock_GetPlanReviewAnalytics_Call) Run(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time)) *TaskUsecaseMock_GetPlanReviewAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetPlanReviewAnalytics_Call) Return(planReviewAnalytics *PlanReviewAnalytics, err error) *TaskUsecaseMock_GetPlanReviewAnalytics_Call {
+	_c.Call.Return(planReviewAnalytics, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetPlanReviewAnalytics_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time) (*PlanReviewAnalytics, error)) *TaskUsecaseMock_GetPlanReviewAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPlansByTaskID provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetPlansByTaskID(ctx context.Context, taskID uuid.UUID) ([]entity.Plan, error) {
 	ret := _mock.Called(ctx, taskID)
@@ -1752,6 +2405,66 @@ func (_c *TaskUsecaseMock_GetPullRequest_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetPullRequestAnalytics provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetPullRequestAnalytics(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time, period AnalyticsPeriod) (*PullRequestAnalytics, error) {
+	ret := _mock.Called(ctx, projectID, from, to, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPullRequestAnalytics")
+	}
+
+	var r0 *PullRequestAnalytics
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time, AnalyticsPeriod) (*PullRequestAnalytics, error)); ok {
+		return returnFunc(ctx, projectID, from, to, period)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, *time.Time, AnalyticsPeriod) *PullRequestAnalytics); ok {
+		r0 = returnFunc(ctx, projectID, from, to, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PullRequestAnalytics)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, *time.Time, AnalyticsPeriod) error); ok {
+		r1 = returnFunc(ctx, projectID, from, to, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetPullRequestAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPullRequestAnalytics'
+type TaskUsecaseMock_GetPullRequestAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetPullRequestAnalytics is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - from
+//   - to
+//   - period
+func (_e *TaskUsecaseMock_Expecter) GetPullRequestAnalytics(ctx interface{}, projectID interface{}, from interface{}, to interface{}, period interface{}) *TaskUsecaseMock_GetPullRequestAnalytics_Call {
+	return &TaskUsecaseMock_GetPullRequestAnalytics_Call{Call: _e.mock.On("GetPullRequestAnalytics", ctx, projectID, from, to, period)}
+}
+
+func (_c *TaskUsecaseMock_GetPullRequestAnalytics_Call) Run(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time, period AnalyticsPeriod)) *TaskUsecaseMock_GetPullRequestAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(*time.Time), args[4].(AnalyticsPeriod))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetPullRequestAnalytics_Call) Return(pullRequestAnalytics *PullRequestAnalytics, err error) *TaskUsecaseMock_GetPullRequestAnalytics_Call {
+	_c.Call.Return(pullRequestAnalytics, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetPullRequestAnalytics_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, from *time.Time, to *time.Time, period AnalyticsPeriod) (*PullRequestAnalytics, error)) *TaskUsecaseMock_GetPullRequestAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatusAnalytics provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetStatusAnalytics(ctx context.Context, projectID uuid.UUID) (*entity.TaskStatusAnalytics, error) {
 	ret := _mock.Called(ctx, projectID)
@@ -1923,42 +2636,100 @@ func (_c *TaskUsecaseMock_GetSubtasks_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
-// GetTaskDiff provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) GetTaskDiff(ctx context.Context, taskID uuid.UUID) (string, error) {
-	ret := _mock.Called(ctx, taskID)
+// GetTaskChanges provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetTaskChanges(ctx context.Context, taskID uuid.UUID, source string) (*TaskChanges, error) {
+	ret := _mock.Called(ctx, taskID, source)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTaskDiff")
+		panic("no return value specified for GetTaskChanges")
 	}
 
-	var r0 string
+	var r0 *TaskChanges
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
-		return returnFunc(ctx, taskID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*TaskChanges, error)); ok {
+		return returnFunc(ctx, taskID, source)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
-		r0 = returnFunc(ctx, taskID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *TaskChanges); ok {
+		r0 = returnFunc(ctx, taskID, source)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TaskChanges)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, taskID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, source)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// TaskUsecaseMock_GetTaskDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskDiff'
-type TaskUsecaseMock_GetTaskDiff_Call struct {
+// TaskUsecaseMock_GetTaskChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskChanges'
+type TaskUsecaseMock_GetTaskChanges_Call struct {
 	*mock.Call
 }
 
-// GetTaskDiff is a helper method to define mock.On call
+// GetTaskChanges is a helper method to define mock.On call
 //   - ctx
 //   - taskID
-func (_e *TaskUsecaseMock_Expecter) GetTaskDiff(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetTaskDiff_Call {
-	return &TaskUsecaseMock_GetTaskDiff_Call{Call: _e.mock.On("GetTaskDiff", ctx, taskID)}
+//   - source
+func (_e *TaskUsecaseMock_Expecter) GetTaskChanges(ctx interface{}, taskID interface{}, source interface{}) *TaskUsecaseMock_GetTaskChanges_Call {
+	return &TaskUsecaseMock_GetTaskChanges_Call{Call: _e.mock.On("GetTaskChanges", ctx, taskID, source)}
+}
+
+func (_c *TaskUsecaseMock_GetTaskChanges_Call) Run(run func(ctx context.Context, taskID uuid.UUID, source string)) *TaskUsecaseMock_GetTaskChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetTaskChanges_Call) Return(taskChanges *TaskChanges, err error) *TaskUsecaseMock_GetTaskChanges_Call {
+	_c.Call.Return(taskChanges, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetTaskChanges_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, source string) (*TaskChanges, error)) *TaskUsecaseMock_GetTaskChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTaskDiff provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetTaskDiff(ctx context.Context, taskID uuid.UUID) (string, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskDiff")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetTaskDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTaskDiff'
+type TaskUsecaseMock_GetTaskDiff_Call struct {
+	*mock.Call
+}
+
+// GetTaskDiff is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) GetTaskDiff(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetTaskDiff_Call {
+	return &TaskUsecaseMock_GetTaskDiff_Call{Call: _e.mock.On("GetTaskDiff", ctx, taskID)}
 }
 
 func (_c *TaskUsecaseMock_GetTaskDiff_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_GetTaskDiff_Call {
@@ -2320,6 +3091,62 @@ func (_c *TaskUsecaseMock_GetTasksWithSubtasks_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetTasksWithWorktree provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetTasksWithWorktree(ctx context.Context) ([]*entity.Task, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTasksWithWorktree")
+	}
+
+	var r0 []*entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*entity.Task, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*entity.Task); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetTasksWithWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTasksWithWorktree'
+type TaskUsecaseMock_GetTasksWithWorktree_Call struct {
+	*mock.Call
+}
+
+// GetTasksWithWorktree is a helper method to define mock.On call
+//   - ctx
+func (_e *TaskUsecaseMock_Expecter) GetTasksWithWorktree(ctx interface{}) *TaskUsecaseMock_GetTasksWithWorktree_Call {
+	return &TaskUsecaseMock_GetTasksWithWorktree_Call{Call: _e.mock.On("GetTasksWithWorktree", ctx)}
+}
+
+func (_c *TaskUsecaseMock_GetTasksWithWorktree_Call) Run(run func(ctx context.Context)) *TaskUsecaseMock_GetTasksWithWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetTasksWithWorktree_Call) Return(tasks []*entity.Task, err error) *TaskUsecaseMock_GetTasksWithWorktree_Call {
+	_c.Call.Return(tasks, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetTasksWithWorktree_Call) RunAndReturn(run func(ctx context.Context) ([]*entity.Task, error)) *TaskUsecaseMock_GetTasksWithWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTemplateByID provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetTemplateByID(ctx context.Context, id uuid.UUID) (*entity.TaskTemplate, error) {
 	ret := _mock.Called(ctx, id)
@@ -2435,6 +3262,64 @@ func (_c *TaskUsecaseMock_GetTemplates_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetWeeklyVelocity provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetWeeklyVelocity(ctx context.Context, projectID uuid.UUID, weeks int) (*VelocityReport, error) {
+	ret := _mock.Called(ctx, projectID, weeks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeeklyVelocity")
+	}
+
+	var r0 *VelocityReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (*VelocityReport, error)); ok {
+		return returnFunc(ctx, projectID, weeks)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) *VelocityReport); ok {
+		r0 = returnFunc(ctx, projectID, weeks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VelocityReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, projectID, weeks)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetWeeklyVelocity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeeklyVelocity'
+type TaskUsecaseMock_GetWeeklyVelocity_Call struct {
+	*mock.Call
+}
+
+// GetWeeklyVelocity is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - weeks
+func (_e *TaskUsecaseMock_Expecter) GetWeeklyVelocity(ctx interface{}, projectID interface{}, weeks interface{}) *TaskUsecaseMock_GetWeeklyVelocity_Call {
+	return &TaskUsecaseMock_GetWeeklyVelocity_Call{Call: _e.mock.On("GetWeeklyVelocity", ctx, projectID, weeks)}
+}
+
+func (_c *TaskUsecaseMock_GetWeeklyVelocity_Call) Run(run func(ctx context.Context, projectID uuid.UUID, weeks int)) *TaskUsecaseMock_GetWeeklyVelocity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWeeklyVelocity_Call) Return(velocityReport *VelocityReport, err error) *TaskUsecaseMock_GetWeeklyVelocity_Call {
+	_c.Call.Return(velocityReport, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWeeklyVelocity_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, weeks int) (*VelocityReport, error)) *TaskUsecaseMock_GetWeeklyVelocity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithProject provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) GetWithProject(ctx context.Context, id uuid.UUID) (*entity.Task, error) {
 	ret := _mock.Called(ctx, id)
@@ -2492,6 +3377,120 @@ func (_c *TaskUsecaseMock_GetWithProject_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// GetWorkload provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetWorkload(ctx context.Context, projectID uuid.UUID) (*WorkloadReport, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkload")
+	}
+
+	var r0 *WorkloadReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*WorkloadReport, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *WorkloadReport); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*WorkloadReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetWorkload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkload'
+type TaskUsecaseMock_GetWorkload_Call struct {
+	*mock.Call
+}
+
+// GetWorkload is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *TaskUsecaseMock_Expecter) GetWorkload(ctx interface{}, projectID interface{}) *TaskUsecaseMock_GetWorkload_Call {
+	return &TaskUsecaseMock_GetWorkload_Call{Call: _e.mock.On("GetWorkload", ctx, projectID)}
+}
+
+func (_c *TaskUsecaseMock_GetWorkload_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *TaskUsecaseMock_GetWorkload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWorkload_Call) Return(workloadReport *WorkloadReport, err error) *TaskUsecaseMock_GetWorkload_Call {
+	_c.Call.Return(workloadReport, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWorkload_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) (*WorkloadReport, error)) *TaskUsecaseMock_GetWorkload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorktreeStatus provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) GetWorktreeStatus(ctx context.Context, taskID uuid.UUID) (*TaskWorktreeStatus, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorktreeStatus")
+	}
+
+	var r0 *TaskWorktreeStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*TaskWorktreeStatus, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *TaskWorktreeStatus); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TaskWorktreeStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_GetWorktreeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorktreeStatus'
+type TaskUsecaseMock_GetWorktreeStatus_Call struct {
+	*mock.Call
+}
+
+// GetWorktreeStatus is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) GetWorktreeStatus(ctx interface{}, taskID interface{}) *TaskUsecaseMock_GetWorktreeStatus_Call {
+	return &TaskUsecaseMock_GetWorktreeStatus_Call{Call: _e.mock.On("GetWorktreeStatus", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_GetWorktreeStatus_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_GetWorktreeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWorktreeStatus_Call) Return(taskWorktreeStatus *TaskWorktreeStatus, err error) *TaskUsecaseMock_GetWorktreeStatus_Call {
+	_c.Call.Return(taskWorktreeStatus, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_GetWorktreeStatus_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (*TaskWorktreeStatus, error)) *TaskUsecaseMock_GetWorktreeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListGitBranches provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) ListGitBranches(ctx context.Context, projectID uuid.UUID) ([]GitBranch, error) {
 	ret := _mock.Called(ctx, projectID)
@@ -2520,183 +3519,1198 @@ func (_mock *TaskUsecaseMock) ListGitBranches(ctx context.Context, projectID uui
 	return r0, r1
 }
 
-// TaskUsecaseMock_ListGitBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGitBranches'
-type TaskUsecaseMock_ListGitBranches_Call struct {
+// TaskUsecaseMock_ListGitBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGitBranches'
+type TaskUsecaseMock_ListGitBranches_Call struct {
+	*mock.Call
+}
+
+// ListGitBranches is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *TaskUsecaseMock_Expecter) ListGitBranches(ctx interface{}, projectID interface{}) *TaskUsecaseMock_ListGitBranches_Call {
+	return &TaskUsecaseMock_ListGitBranches_Call{Call: _e.mock.On("ListGitBranches", ctx, projectID)}
+}
+
+func (_c *TaskUsecaseMock_ListGitBranches_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *TaskUsecaseMock_ListGitBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListGitBranches_Call) Return(gitBranchs []GitBranch, err error) *TaskUsecaseMock_ListGitBranches_Call {
+	_c.Call.Return(gitBranchs, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListGitBranches_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]GitBranch, error)) *TaskUsecaseMock_ListGitBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGitOperations provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) ListGitOperations(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGitOperations")
+	}
+
+	var r0 []*entity.GitOperation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.GitOperation, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.GitOperation); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.GitOperation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_ListGitOperations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGitOperations'
+type TaskUsecaseMock_ListGitOperations_Call struct {
+	*mock.Call
+}
+
+// ListGitOperations is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) ListGitOperations(ctx interface{}, taskID interface{}) *TaskUsecaseMock_ListGitOperations_Call {
+	return &TaskUsecaseMock_ListGitOperations_Call{Call: _e.mock.On("ListGitOperations", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_ListGitOperations_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_ListGitOperations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListGitOperations_Call) Return(gitOperations []*entity.GitOperation, err error) *TaskUsecaseMock_ListGitOperations_Call {
+	_c.Call.Return(gitOperations, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListGitOperations_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) ([]*entity.GitOperation, error)) *TaskUsecaseMock_ListGitOperations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledJobs provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) ListScheduledJobs(ctx context.Context, taskID uuid.UUID) ([]ScheduledJob, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduledJobs")
+	}
+
+	var r0 []ScheduledJob
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]ScheduledJob, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []ScheduledJob); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ScheduledJob)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_ListScheduledJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledJobs'
+type TaskUsecaseMock_ListScheduledJobs_Call struct {
+	*mock.Call
+}
+
+// ListScheduledJobs is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) ListScheduledJobs(ctx interface{}, taskID interface{}) *TaskUsecaseMock_ListScheduledJobs_Call {
+	return &TaskUsecaseMock_ListScheduledJobs_Call{Call: _e.mock.On("ListScheduledJobs", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_ListScheduledJobs_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_ListScheduledJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListScheduledJobs_Call) Return(_a0 []ScheduledJob, _a1 error) *TaskUsecaseMock_ListScheduledJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListScheduledJobs_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) ([]ScheduledJob, error)) *TaskUsecaseMock_ListScheduledJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorktreeFiles provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) ListWorktreeFiles(ctx context.Context, taskID uuid.UUID, dir string) ([]WorktreeFileEntry, error) {
+	ret := _mock.Called(ctx, taskID, dir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorktreeFiles")
+	}
+
+	var r0 []WorktreeFileEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]WorktreeFileEntry, error)); ok {
+		return returnFunc(ctx, taskID, dir)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []WorktreeFileEntry); ok {
+		r0 = returnFunc(ctx, taskID, dir)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WorktreeFileEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, dir)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_ListWorktreeFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorktreeFiles'
+type TaskUsecaseMock_ListWorktreeFiles_Call struct {
+	*mock.Call
+}
+
+// ListWorktreeFiles is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - dir
+func (_e *TaskUsecaseMock_Expecter) ListWorktreeFiles(ctx interface{}, taskID interface{}, dir interface{}) *TaskUsecaseMock_ListWorktreeFiles_Call {
+	return &TaskUsecaseMock_ListWorktreeFiles_Call{Call: _e.mock.On("ListWorktreeFiles", ctx, taskID, dir)}
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeFiles_Call) Run(run func(ctx context.Context, taskID uuid.UUID, dir string)) *TaskUsecaseMock_ListWorktreeFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeFiles_Call) Return(worktreeFileEntrys []WorktreeFileEntry, err error) *TaskUsecaseMock_ListWorktreeFiles_Call {
+	_c.Call.Return(worktreeFileEntrys, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeFiles_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, dir string) ([]WorktreeFileEntry, error)) *TaskUsecaseMock_ListWorktreeFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorktreeSnapshots provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) ListWorktreeSnapshots(ctx context.Context, taskID uuid.UUID) ([]git.Snapshot, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorktreeSnapshots")
+	}
+
+	var r0 []git.Snapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]git.Snapshot, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []git.Snapshot); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]git.Snapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_ListWorktreeSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorktreeSnapshots'
+type TaskUsecaseMock_ListWorktreeSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListWorktreeSnapshots is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) ListWorktreeSnapshots(ctx interface{}, taskID interface{}) *TaskUsecaseMock_ListWorktreeSnapshots_Call {
+	return &TaskUsecaseMock_ListWorktreeSnapshots_Call{Call: _e.mock.On("ListWorktreeSnapshots", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeSnapshots_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_ListWorktreeSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeSnapshots_Call) Return(snapshots []git.Snapshot, err error) *TaskUsecaseMock_ListWorktreeSnapshots_Call {
+	_c.Call.Return(snapshots, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ListWorktreeSnapshots_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) ([]git.Snapshot, error)) *TaskUsecaseMock_ListWorktreeSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenWithCursor provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) OpenWithCursor(ctx context.Context, taskID uuid.UUID, worktreePath string) error {
+	ret := _mock.Called(ctx, taskID, worktreePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenWithCursor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, taskID, worktreePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_OpenWithCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenWithCursor'
+type TaskUsecaseMock_OpenWithCursor_Call struct {
+	*mock.Call
+}
+
+// OpenWithCursor is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - worktreePath
+func (_e *TaskUsecaseMock_Expecter) OpenWithCursor(ctx interface{}, taskID interface{}, worktreePath interface{}) *TaskUsecaseMock_OpenWithCursor_Call {
+	return &TaskUsecaseMock_OpenWithCursor_Call{Call: _e.mock.On("OpenWithCursor", ctx, taskID, worktreePath)}
+}
+
+func (_c *TaskUsecaseMock_OpenWithCursor_Call) Run(run func(ctx context.Context, taskID uuid.UUID, worktreePath string)) *TaskUsecaseMock_OpenWithCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_OpenWithCursor_Call) Return(err error) *TaskUsecaseMock_OpenWithCursor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_OpenWithCursor_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, worktreePath string) error) *TaskUsecaseMock_OpenWithCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadWorktreeFile provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) ReadWorktreeFile(ctx context.Context, taskID uuid.UUID, path string) (*WorktreeFileContent, error) {
+	ret := _mock.Called(ctx, taskID, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadWorktreeFile")
+	}
+
+	var r0 *WorktreeFileContent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*WorktreeFileContent, error)); ok {
+		return returnFunc(ctx, taskID, path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *WorktreeFileContent); ok {
+		r0 = returnFunc(ctx, taskID, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*WorktreeFileContent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_ReadWorktreeFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadWorktreeFile'
+type TaskUsecaseMock_ReadWorktreeFile_Call struct {
+	*mock.Call
+}
+
+// ReadWorktreeFile is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - path
+func (_e *TaskUsecaseMock_Expecter) ReadWorktreeFile(ctx interface{}, taskID interface{}, path interface{}) *TaskUsecaseMock_ReadWorktreeFile_Call {
+	return &TaskUsecaseMock_ReadWorktreeFile_Call{Call: _e.mock.On("ReadWorktreeFile", ctx, taskID, path)}
+}
+
+func (_c *TaskUsecaseMock_ReadWorktreeFile_Call) Run(run func(ctx context.Context, taskID uuid.UUID, path string)) *TaskUsecaseMock_ReadWorktreeFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ReadWorktreeFile_Call) Return(worktreeFileContent *WorktreeFileContent, err error) *TaskUsecaseMock_ReadWorktreeFile_Call {
+	_c.Call.Return(worktreeFileContent, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_ReadWorktreeFile_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, path string) (*WorktreeFileContent, error)) *TaskUsecaseMock_ReadWorktreeFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPlanApproval provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RecordPlanApproval(ctx context.Context, taskID uuid.UUID, approval PlanApprovalRequest) (bool, error) {
+	ret := _mock.Called(ctx, taskID, approval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPlanApproval")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, PlanApprovalRequest) (bool, error)); ok {
+		return returnFunc(ctx, taskID, approval)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, PlanApprovalRequest) bool); ok {
+		r0 = returnFunc(ctx, taskID, approval)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, PlanApprovalRequest) error); ok {
+		r1 = returnFunc(ctx, taskID, approval)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_RecordPlanApproval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPlanApproval'
+type TaskUsecaseMock_RecordPlanApproval_Call struct {
+	*mock.Call
+}
+
+// RecordPlanApproval is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - approval
+func (_e *TaskUsecaseMock_Expecter) RecordPlanApproval(ctx interface{}, taskID interface{}, approval interface{}) *TaskUsecaseMock_RecordPlanApproval_Call {
+	return &TaskUsecaseMock_RecordPlanApproval_Call{Call: _e.mock.On("RecordPlanApproval", ctx, taskID, approval)}
+}
+
+func (_c *TaskUsecaseMock_RecordPlanApproval_Call) Run(run func(ctx context.Context, taskID uuid.UUID, approval PlanApprovalRequest)) *TaskUsecaseMock_RecordPlanApproval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(PlanApprovalRequest))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecordPlanApproval_Call) Return(b bool, err error) *TaskUsecaseMock_RecordPlanApproval_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecordPlanApproval_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, approval PlanApprovalRequest) (bool, error)) *TaskUsecaseMock_RecordPlanApproval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPlanningIteration provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RecordPlanningIteration(ctx context.Context, taskID uuid.UUID) (int, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPlanningIteration")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) int); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_RecordPlanningIteration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPlanningIteration'
+type TaskUsecaseMock_RecordPlanningIteration_Call struct {
+	*mock.Call
+}
+
+// RecordPlanningIteration is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) RecordPlanningIteration(ctx interface{}, taskID interface{}) *TaskUsecaseMock_RecordPlanningIteration_Call {
+	return &TaskUsecaseMock_RecordPlanningIteration_Call{Call: _e.mock.On("RecordPlanningIteration", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_RecordPlanningIteration_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_RecordPlanningIteration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecordPlanningIteration_Call) Return(r0 int, r1 error) *TaskUsecaseMock_RecordPlanningIteration_Call {
+	_c.Call.Return(r0, r1)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecordPlanningIteration_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (int, error)) *TaskUsecaseMock_RecordPlanningIteration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecreateWorktree provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RecreateWorktree(ctx context.Context, taskID uuid.UUID) (*entity.Task, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecreateWorktree")
+	}
+
+	var r0 *entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Task, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_RecreateWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecreateWorktree'
+type TaskUsecaseMock_RecreateWorktree_Call struct {
+	*mock.Call
+}
+
+// RecreateWorktree is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *TaskUsecaseMock_Expecter) RecreateWorktree(ctx interface{}, taskID interface{}) *TaskUsecaseMock_RecreateWorktree_Call {
+	return &TaskUsecaseMock_RecreateWorktree_Call{Call: _e.mock.On("RecreateWorktree", ctx, taskID)}
+}
+
+func (_c *TaskUsecaseMock_RecreateWorktree_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *TaskUsecaseMock_RecreateWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecreateWorktree_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_RecreateWorktree_Call {
+	_c.Call.Return(task, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RecreateWorktree_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (*entity.Task, error)) *TaskUsecaseMock_RecreateWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectPlan provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RejectPlan(ctx context.Context, taskID uuid.UUID, reviewer *string, reason *string) (*entity.Task, error) {
+	ret := _mock.Called(ctx, taskID, reviewer, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectPlan")
+	}
+
+	var r0 *entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string) (*entity.Task, error)); ok {
+		return returnFunc(ctx, taskID, reviewer, reason)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string, *string) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID, reviewer, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string, *string) error); ok {
+		r1 = returnFunc(ctx, taskID, reviewer, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_RejectPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectPlan'
+type TaskUsecaseMock_RejectPlan_Call struct {
+	*mock.Call
+}
+
+// RejectPlan is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - reviewer
+//   - reason
+func (_e *TaskUsecaseMock_Expecter) RejectPlan(ctx interface{}, taskID interface{}, reviewer interface{}, reason interface{}) *TaskUsecaseMock_RejectPlan_Call {
+	return &TaskUsecaseMock_RejectPlan_Call{Call: _e.mock.On("RejectPlan", ctx, taskID, reviewer, reason)}
+}
+
+func (_c *TaskUsecaseMock_RejectPlan_Call) Run(run func(ctx context.Context, taskID uuid.UUID, reviewer *string, reason *string)) *TaskUsecaseMock_RejectPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RejectPlan_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_RejectPlan_Call {
+	_c.Call.Return(task, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RejectPlan_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, reviewer *string, reason *string) (*entity.Task, error)) *TaskUsecaseMock_RejectPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectPlanWithFeedback provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RejectPlanWithFeedback(ctx context.Context, taskID uuid.UUID, req RejectPlanWithFeedbackRequest) (*entity.Task, string, error) {
+	ret := _mock.Called(ctx, taskID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectPlanWithFeedback")
+	}
+
+	var r0 *entity.Task
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, RejectPlanWithFeedbackRequest) (*entity.Task, string, error)); ok {
+		return returnFunc(ctx, taskID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, RejectPlanWithFeedbackRequest) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, RejectPlanWithFeedbackRequest) string); ok {
+		r1 = returnFunc(ctx, taskID, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, RejectPlanWithFeedbackRequest) error); ok {
+		r2 = returnFunc(ctx, taskID, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TaskUsecaseMock_RejectPlanWithFeedback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectPlanWithFeedback'
+type TaskUsecaseMock_RejectPlanWithFeedback_Call struct {
+	*mock.Call
+}
+
+// RejectPlanWithFeedback is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - req
+func (_e *TaskUsecaseMock_Expecter) RejectPlanWithFeedback(ctx interface{}, taskID interface{}, req interface{}) *TaskUsecaseMock_RejectPlanWithFeedback_Call {
+	return &TaskUsecaseMock_RejectPlanWithFeedback_Call{Call: _e.mock.On("RejectPlanWithFeedback", ctx, taskID, req)}
+}
+
+func (_c *TaskUsecaseMock_RejectPlanWithFeedback_Call) Run(run func(ctx context.Context, taskID uuid.UUID, req RejectPlanWithFeedbackRequest)) *TaskUsecaseMock_RejectPlanWithFeedback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(RejectPlanWithFeedbackRequest))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RejectPlanWithFeedback_Call) Return(r0 *entity.Task, r1 string, r2 error) *TaskUsecaseMock_RejectPlanWithFeedback_Call {
+	_c.Call.Return(r0, r1, r2)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RejectPlanWithFeedback_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, req RejectPlanWithFeedbackRequest) (*entity.Task, string, error)) *TaskUsecaseMock_RejectPlanWithFeedback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDependency provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RemoveDependency(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID) error {
+	ret := _mock.Called(ctx, taskID, dependsOnTaskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, taskID, dependsOnTaskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type TaskUsecaseMock_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - dependsOnTaskID
+func (_e *TaskUsecaseMock_Expecter) RemoveDependency(ctx interface{}, taskID interface{}, dependsOnTaskID interface{}) *TaskUsecaseMock_RemoveDependency_Call {
+	return &TaskUsecaseMock_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, taskID, dependsOnTaskID)}
+}
+
+func (_c *TaskUsecaseMock_RemoveDependency_Call) Run(run func(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID)) *TaskUsecaseMock_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RemoveDependency_Call) Return(err error) *TaskUsecaseMock_RemoveDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RemoveDependency_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID) error) *TaskUsecaseMock_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestChanges provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RequestChanges(ctx context.Context, taskID uuid.UUID, req RequestChangesRequest) (*entity.Task, string, error) {
+	ret := _mock.Called(ctx, taskID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestChanges")
+	}
+
+	var r0 *entity.Task
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, RequestChangesRequest) (*entity.Task, string, error)); ok {
+		return returnFunc(ctx, taskID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, RequestChangesRequest) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, RequestChangesRequest) string); ok {
+		r1 = returnFunc(ctx, taskID, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, RequestChangesRequest) error); ok {
+		r2 = returnFunc(ctx, taskID, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TaskUsecaseMock_RequestChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestChanges'
+type TaskUsecaseMock_RequestChanges_Call struct {
+	*mock.Call
+}
+
+// RequestChanges is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - req
+func (_e *TaskUsecaseMock_Expecter) RequestChanges(ctx interface{}, taskID interface{}, req interface{}) *TaskUsecaseMock_RequestChanges_Call {
+	return &TaskUsecaseMock_RequestChanges_Call{Call: _e.mock.On("RequestChanges", ctx, taskID, req)}
+}
+
+func (_c *TaskUsecaseMock_RequestChanges_Call) Run(run func(ctx context.Context, taskID uuid.UUID, req RequestChangesRequest)) *TaskUsecaseMock_RequestChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(RequestChangesRequest))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RequestChanges_Call) Return(task *entity.Task, s string, err error) *TaskUsecaseMock_RequestChanges_Call {
+	_c.Call.Return(task, s, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RequestChanges_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, req RequestChangesRequest) (*entity.Task, string, error)) *TaskUsecaseMock_RequestChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreWorktreeSnapshot provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RestoreWorktreeSnapshot(ctx context.Context, taskID uuid.UUID, snapshotID string) error {
+	ret := _mock.Called(ctx, taskID, snapshotID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWorktreeSnapshot")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, taskID, snapshotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_RestoreWorktreeSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreWorktreeSnapshot'
+type TaskUsecaseMock_RestoreWorktreeSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreWorktreeSnapshot is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - snapshotID
+func (_e *TaskUsecaseMock_Expecter) RestoreWorktreeSnapshot(ctx interface{}, taskID interface{}, snapshotID interface{}) *TaskUsecaseMock_RestoreWorktreeSnapshot_Call {
+	return &TaskUsecaseMock_RestoreWorktreeSnapshot_Call{Call: _e.mock.On("RestoreWorktreeSnapshot", ctx, taskID, snapshotID)}
+}
+
+func (_c *TaskUsecaseMock_RestoreWorktreeSnapshot_Call) Run(run func(ctx context.Context, taskID uuid.UUID, snapshotID string)) *TaskUsecaseMock_RestoreWorktreeSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RestoreWorktreeSnapshot_Call) Return(err error) *TaskUsecaseMock_RestoreWorktreeSnapshot_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RestoreWorktreeSnapshot_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, snapshotID string) error) *TaskUsecaseMock_RestoreWorktreeSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryExecution provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RetryExecution(ctx context.Context, executionID uuid.UUID, delay time.Duration) (*entity.Execution, string, error) {
+	ret := _mock.Called(ctx, executionID, delay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryExecution")
+	}
+
+	var r0 *entity.Execution
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) (*entity.Execution, string, error)); ok {
+		return returnFunc(ctx, executionID, delay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Duration) *entity.Execution); ok {
+		r0 = returnFunc(ctx, executionID, delay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Duration) string); ok {
+		r1 = returnFunc(ctx, executionID, delay)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, uuid.UUID, time.Duration) error); ok {
+		r2 = returnFunc(ctx, executionID, delay)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// TaskUsecaseMock_RetryExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryExecution'
+type TaskUsecaseMock_RetryExecution_Call struct {
+	*mock.Call
+}
+
+// RetryExecution is a helper method to define mock.On call
+//   - ctx
+//   - executionID
+//   - delay
+func (_e *TaskUsecaseMock_Expecter) RetryExecution(ctx interface{}, executionID interface{}, delay interface{}) *TaskUsecaseMock_RetryExecution_Call {
+	return &TaskUsecaseMock_RetryExecution_Call{Call: _e.mock.On("RetryExecution", ctx, executionID, delay)}
+}
+
+func (_c *TaskUsecaseMock_RetryExecution_Call) Run(run func(ctx context.Context, executionID uuid.UUID, delay time.Duration)) *TaskUsecaseMock_RetryExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RetryExecution_Call) Return(_a0 *entity.Execution, _a1 string, _a2 error) *TaskUsecaseMock_RetryExecution_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RetryExecution_Call) RunAndReturn(run func(ctx context.Context, executionID uuid.UUID, delay time.Duration) (*entity.Execution, string, error)) *TaskUsecaseMock_RetryExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollupVelocity provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) RollupVelocity(ctx context.Context, weeks int) error {
+	ret := _mock.Called(ctx, weeks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollupVelocity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, weeks)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TaskUsecaseMock_RollupVelocity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollupVelocity'
+type TaskUsecaseMock_RollupVelocity_Call struct {
+	*mock.Call
+}
+
+// RollupVelocity is a helper method to define mock.On call
+//   - ctx
+//   - weeks
+func (_e *TaskUsecaseMock_Expecter) RollupVelocity(ctx interface{}, weeks interface{}) *TaskUsecaseMock_RollupVelocity_Call {
+	return &TaskUsecaseMock_RollupVelocity_Call{Call: _e.mock.On("RollupVelocity", ctx, weeks)}
+}
+
+func (_c *TaskUsecaseMock_RollupVelocity_Call) Run(run func(ctx context.Context, weeks int)) *TaskUsecaseMock_RollupVelocity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RollupVelocity_Call) Return(err error) *TaskUsecaseMock_RollupVelocity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_RollupVelocity_Call) RunAndReturn(run func(ctx context.Context, weeks int) error) *TaskUsecaseMock_RollupVelocity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTasks provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) SearchTasks(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.TaskSearchResult, error) {
+	ret := _mock.Called(ctx, query, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTasks")
+	}
+
+	var r0 []*entity.TaskSearchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *uuid.UUID) ([]*entity.TaskSearchResult, error)); ok {
+		return returnFunc(ctx, query, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *uuid.UUID) []*entity.TaskSearchResult); ok {
+		r0 = returnFunc(ctx, query, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.TaskSearchResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, query, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_SearchTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTasks'
+type TaskUsecaseMock_SearchTasks_Call struct {
+	*mock.Call
+}
+
+// SearchTasks is a helper method to define mock.On call
+//   - ctx
+//   - query
+//   - projectID
+func (_e *TaskUsecaseMock_Expecter) SearchTasks(ctx interface{}, query interface{}, projectID interface{}) *TaskUsecaseMock_SearchTasks_Call {
+	return &TaskUsecaseMock_SearchTasks_Call{Call: _e.mock.On("SearchTasks", ctx, query, projectID)}
+}
+
+func (_c *TaskUsecaseMock_SearchTasks_Call) Run(run func(ctx context.Context, query string, projectID *uuid.UUID)) *TaskUsecaseMock_SearchTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_SearchTasks_Call) Return(taskSearchResults []*entity.TaskSearchResult, err error) *TaskUsecaseMock_SearchTasks_Call {
+	_c.Call.Return(taskSearchResults, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_SearchTasks_Call) RunAndReturn(run func(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.TaskSearchResult, error)) *TaskUsecaseMock_SearchTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SelectComparisonWinner provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) SelectComparisonWinner(ctx context.Context, taskID uuid.UUID, variant string) (string, error) {
+	ret := _mock.Called(ctx, taskID, variant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SelectComparisonWinner")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
+		return returnFunc(ctx, taskID, variant)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = returnFunc(ctx, taskID, variant)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, variant)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_SelectComparisonWinner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SelectComparisonWinner'
+type TaskUsecaseMock_SelectComparisonWinner_Call struct {
 	*mock.Call
 }
 
-// ListGitBranches is a helper method to define mock.On call
+// SelectComparisonWinner is a helper method to define mock.On call
 //   - ctx
-//   - projectID
-func (_e *TaskUsecaseMock_Expecter) ListGitBranches(ctx interface{}, projectID interface{}) *TaskUsecaseMock_ListGitBranches_Call {
-	return &TaskUsecaseMock_ListGitBranches_Call{Call: _e.mock.On("ListGitBranches", ctx, projectID)}
+//   - taskID
+//   - variant
+func (_e *TaskUsecaseMock_Expecter) SelectComparisonWinner(ctx interface{}, taskID interface{}, variant interface{}) *TaskUsecaseMock_SelectComparisonWinner_Call {
+	return &TaskUsecaseMock_SelectComparisonWinner_Call{Call: _e.mock.On("SelectComparisonWinner", ctx, taskID, variant)}
 }
 
-func (_c *TaskUsecaseMock_ListGitBranches_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *TaskUsecaseMock_ListGitBranches_Call {
+func (_c *TaskUsecaseMock_SelectComparisonWinner_Call) Run(run func(ctx context.Context, taskID uuid.UUID, variant string)) *TaskUsecaseMock_SelectComparisonWinner_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_ListGitBranches_Call) Return(gitBranchs []GitBranch, err error) *TaskUsecaseMock_ListGitBranches_Call {
-	_c.Call.Return(gitBranchs, err)
+func (_c *TaskUsecaseMock_SelectComparisonWinner_Call) Return(s string, err error) *TaskUsecaseMock_SelectComparisonWinner_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_ListGitBranches_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]GitBranch, error)) *TaskUsecaseMock_ListGitBranches_Call {
+func (_c *TaskUsecaseMock_SelectComparisonWinner_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, variant string) (string, error)) *TaskUsecaseMock_SelectComparisonWinner_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OpenWithCursor provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) OpenWithCursor(ctx context.Context, taskID uuid.UUID, worktreePath string) error {
-	ret := _mock.Called(ctx, taskID, worktreePath)
+// SendStandup provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) SendStandup(ctx context.Context, report *entity.StandupReport, summary string) error {
+	ret := _mock.Called(ctx, report, summary)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OpenWithCursor")
+		panic("no return value specified for SendStandup")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
-		r0 = returnFunc(ctx, taskID, worktreePath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.StandupReport, string) error); ok {
+		r0 = returnFunc(ctx, report, summary)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// TaskUsecaseMock_OpenWithCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenWithCursor'
-type TaskUsecaseMock_OpenWithCursor_Call struct {
+// TaskUsecaseMock_SendStandup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendStandup'
+type TaskUsecaseMock_SendStandup_Call struct {
 	*mock.Call
 }
 
-// OpenWithCursor is a helper method to define mock.On call
+// SendStandup is a helper method to define mock.On call
 //   - ctx
-//   - taskID
-//   - worktreePath
-func (_e *TaskUsecaseMock_Expecter) OpenWithCursor(ctx interface{}, taskID interface{}, worktreePath interface{}) *TaskUsecaseMock_OpenWithCursor_Call {
-	return &TaskUsecaseMock_OpenWithCursor_Call{Call: _e.mock.On("OpenWithCursor", ctx, taskID, worktreePath)}
+//   - report
+//   - summary
+func (_e *TaskUsecaseMock_Expecter) SendStandup(ctx interface{}, report interface{}, summary interface{}) *TaskUsecaseMock_SendStandup_Call {
+	return &TaskUsecaseMock_SendStandup_Call{Call: _e.mock.On("SendStandup", ctx, report, summary)}
 }
 
-func (_c *TaskUsecaseMock_OpenWithCursor_Call) Run(run func(ctx context.Context, taskID uuid.UUID, worktreePath string)) *TaskUsecaseMock_OpenWithCursor_Call {
+func (_c *TaskUsecaseMock_SendStandup_Call) Run(run func(ctx context.Context, report *entity.StandupReport, summary string)) *TaskUsecaseMock_SendStandup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(*entity.StandupReport), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_OpenWithCursor_Call) Return(err error) *TaskUsecaseMock_OpenWithCursor_Call {
+func (_c *TaskUsecaseMock_SendStandup_Call) Return(err error) *TaskUsecaseMock_SendStandup_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_OpenWithCursor_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, worktreePath string) error) *TaskUsecaseMock_OpenWithCursor_Call {
+func (_c *TaskUsecaseMock_SendStandup_Call) RunAndReturn(run func(ctx context.Context, report *entity.StandupReport, summary string) error) *TaskUsecaseMock_SendStandup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveDependency provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) RemoveDependency(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID) error {
-	ret := _mock.Called(ctx, taskID, dependsOnTaskID)
+// StartComparison provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) StartComparison(ctx context.Context, taskID uuid.UUID, branchName string, aiTypes []string) (string, error) {
+	ret := _mock.Called(ctx, taskID, branchName, aiTypes)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveDependency")
+		panic("no return value specified for StartComparison")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, taskID, dependsOnTaskID)
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string) (string, error)); ok {
+		return returnFunc(ctx, taskID, branchName, aiTypes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string) string); ok {
+		r0 = returnFunc(ctx, taskID, branchName, aiTypes)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string) error); ok {
+		r1 = returnFunc(ctx, taskID, branchName, aiTypes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// TaskUsecaseMock_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
-type TaskUsecaseMock_RemoveDependency_Call struct {
+// TaskUsecaseMock_StartComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartComparison'
+type TaskUsecaseMock_StartComparison_Call struct {
 	*mock.Call
 }
 
-// RemoveDependency is a helper method to define mock.On call
+// StartComparison is a helper method to define mock.On call
 //   - ctx
 //   - taskID
-//   - dependsOnTaskID
-func (_e *TaskUsecaseMock_Expecter) RemoveDependency(ctx interface{}, taskID interface{}, dependsOnTaskID interface{}) *TaskUsecaseMock_RemoveDependency_Call {
-	return &TaskUsecaseMock_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, taskID, dependsOnTaskID)}
+//   - branchName
+//   - aiTypes
+func (_e *TaskUsecaseMock_Expecter) StartComparison(ctx interface{}, taskID interface{}, branchName interface{}, aiTypes interface{}) *TaskUsecaseMock_StartComparison_Call {
+	return &TaskUsecaseMock_StartComparison_Call{Call: _e.mock.On("StartComparison", ctx, taskID, branchName, aiTypes)}
 }
 
-func (_c *TaskUsecaseMock_RemoveDependency_Call) Run(run func(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID)) *TaskUsecaseMock_RemoveDependency_Call {
+func (_c *TaskUsecaseMock_StartComparison_Call) Run(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiTypes []string)) *TaskUsecaseMock_StartComparison_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]string))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_RemoveDependency_Call) Return(err error) *TaskUsecaseMock_RemoveDependency_Call {
-	_c.Call.Return(err)
+func (_c *TaskUsecaseMock_StartComparison_Call) Return(s string, err error) *TaskUsecaseMock_StartComparison_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_RemoveDependency_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, dependsOnTaskID uuid.UUID) error) *TaskUsecaseMock_RemoveDependency_Call {
+func (_c *TaskUsecaseMock_StartComparison_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiTypes []string) (string, error)) *TaskUsecaseMock_StartComparison_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SearchTasks provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) SearchTasks(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.TaskSearchResult, error) {
-	ret := _mock.Called(ctx, query, projectID)
+// StartDryRun provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) StartDryRun(ctx context.Context, taskID uuid.UUID, aiType string) (string, error) {
+	ret := _mock.Called(ctx, taskID, aiType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SearchTasks")
+		panic("no return value specified for StartDryRun")
 	}
 
-	var r0 []*entity.TaskSearchResult
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *uuid.UUID) ([]*entity.TaskSearchResult, error)); ok {
-		return returnFunc(ctx, query, projectID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
+		return returnFunc(ctx, taskID, aiType)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *uuid.UUID) []*entity.TaskSearchResult); ok {
-		r0 = returnFunc(ctx, query, projectID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = returnFunc(ctx, taskID, aiType)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*entity.TaskSearchResult)
-		}
+		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *uuid.UUID) error); ok {
-		r1 = returnFunc(ctx, query, projectID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, aiType)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// TaskUsecaseMock_SearchTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTasks'
-type TaskUsecaseMock_SearchTasks_Call struct {
+// TaskUsecaseMock_StartDryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDryRun'
+type TaskUsecaseMock_StartDryRun_Call struct {
 	*mock.Call
 }
 
-// SearchTasks is a helper method to define mock.On call
+// StartDryRun is a helper method to define mock.On call
 //   - ctx
-//   - query
-//   - projectID
-func (_e *TaskUsecaseMock_Expecter) SearchTasks(ctx interface{}, query interface{}, projectID interface{}) *TaskUsecaseMock_SearchTasks_Call {
-	return &TaskUsecaseMock_SearchTasks_Call{Call: _e.mock.On("SearchTasks", ctx, query, projectID)}
+//   - taskID
+//   - aiType
+func (_e *TaskUsecaseMock_Expecter) StartDryRun(ctx interface{}, taskID interface{}, aiType interface{}) *TaskUsecaseMock_StartDryRun_Call {
+	return &TaskUsecaseMock_StartDryRun_Call{Call: _e.mock.On("StartDryRun", ctx, taskID, aiType)}
 }
 
-func (_c *TaskUsecaseMock_SearchTasks_Call) Run(run func(ctx context.Context, query string, projectID *uuid.UUID)) *TaskUsecaseMock_SearchTasks_Call {
+func (_c *TaskUsecaseMock_StartDryRun_Call) Run(run func(ctx context.Context, taskID uuid.UUID, aiType string)) *TaskUsecaseMock_StartDryRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_SearchTasks_Call) Return(taskSearchResults []*entity.TaskSearchResult, err error) *TaskUsecaseMock_SearchTasks_Call {
-	_c.Call.Return(taskSearchResults, err)
+func (_c *TaskUsecaseMock_StartDryRun_Call) Return(r0 string, r1 error) *TaskUsecaseMock_StartDryRun_Call {
+	_c.Call.Return(r0, r1)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_SearchTasks_Call) RunAndReturn(run func(ctx context.Context, query string, projectID *uuid.UUID) ([]*entity.TaskSearchResult, error)) *TaskUsecaseMock_SearchTasks_Call {
+func (_c *TaskUsecaseMock_StartDryRun_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, aiType string) (string, error)) *TaskUsecaseMock_StartDryRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2760,8 +4774,8 @@ func (_c *TaskUsecaseMock_StartImplementingDirect_Call) RunAndReturn(run func(ct
 }
 
 // StartPlanning provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool) (string, error) {
-	ret := _mock.Called(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch)
+func (_mock *TaskUsecaseMock) StartPlanning(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool, scheduledAt *time.Time) (string, error) {
+	ret := _mock.Called(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch, scheduledAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartPlanning")
@@ -2769,16 +4783,16 @@ func (_mock *TaskUsecaseMock) StartPlanning(ctx context.Context, taskID uuid.UUI
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, bool) (string, error)); ok {
-		return returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, bool, *time.Time) (string, error)); ok {
+		return returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch, scheduledAt)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, bool) string); ok {
-		r0 = returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, bool, bool, *time.Time) string); ok {
+		r0 = returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch, scheduledAt)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, bool, bool) error); ok {
-		r1 = returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, bool, bool, *time.Time) error); ok {
+		r1 = returnFunc(ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch, scheduledAt)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2797,23 +4811,81 @@ type TaskUsecaseMock_StartPlanning_Call struct {
 //   - aiType
 //   - autoImplement
 //   - useRemoteBranch
-func (_e *TaskUsecaseMock_Expecter) StartPlanning(ctx interface{}, taskID interface{}, branchName interface{}, aiType interface{}, autoImplement interface{}, useRemoteBranch interface{}) *TaskUsecaseMock_StartPlanning_Call {
-	return &TaskUsecaseMock_StartPlanning_Call{Call: _e.mock.On("StartPlanning", ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch)}
+//   - scheduledAt
+func (_e *TaskUsecaseMock_Expecter) StartPlanning(ctx interface{}, taskID interface{}, branchName interface{}, aiType interface{}, autoImplement interface{}, useRemoteBranch interface{}, scheduledAt interface{}) *TaskUsecaseMock_StartPlanning_Call {
+	return &TaskUsecaseMock_StartPlanning_Call{Call: _e.mock.On("StartPlanning", ctx, taskID, branchName, aiType, autoImplement, useRemoteBranch, scheduledAt)}
 }
 
-func (_c *TaskUsecaseMock_StartPlanning_Call) Run(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool)) *TaskUsecaseMock_StartPlanning_Call {
+func (_c *TaskUsecaseMock_StartPlanning_Call) Run(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool, scheduledAt *time.Time)) *TaskUsecaseMock_StartPlanning_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(bool), args[5].(bool))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(bool), args[5].(bool), args[6].(*time.Time))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_StartPlanning_Call) Return(s string, err error) *TaskUsecaseMock_StartPlanning_Call {
-	_c.Call.Return(s, err)
+func (_c *TaskUsecaseMock_StartPlanning_Call) Return(_a0 string, _a1 error) *TaskUsecaseMock_StartPlanning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_StartPlanning_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool, scheduledAt *time.Time) (string, error)) *TaskUsecaseMock_StartPlanning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Undo provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) Undo(ctx context.Context, token string) (*entity.UndoOperation, error) {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Undo")
+	}
+
+	var r0 *entity.UndoOperation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.UndoOperation, error)); ok {
+		return returnFunc(ctx, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.UndoOperation); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.UndoOperation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_Undo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Undo'
+type TaskUsecaseMock_Undo_Call struct {
+	*mock.Call
+}
+
+// Undo is a helper method to define mock.On call
+//   - ctx
+//   - token
+func (_e *TaskUsecaseMock_Expecter) Undo(ctx interface{}, token interface{}) *TaskUsecaseMock_Undo_Call {
+	return &TaskUsecaseMock_Undo_Call{Call: _e.mock.On("Undo", ctx, token)}
+}
+
+func (_c *TaskUsecaseMock_Undo_Call) Run(run func(ctx context.Context, token string)) *TaskUsecaseMock_Undo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_Undo_Call) Return(undoOperation *entity.UndoOperation, err error) *TaskUsecaseMock_Undo_Call {
+	_c.Call.Return(undoOperation, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_StartPlanning_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, branchName string, aiType string, autoImplement bool, useRemoteBranch bool) (string, error)) *TaskUsecaseMock_StartPlanning_Call {
+func (_c *TaskUsecaseMock_Undo_Call) RunAndReturn(run func(ctx context.Context, token string) (*entity.UndoOperation, error)) *TaskUsecaseMock_Undo_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3039,6 +5111,122 @@ func (_c *TaskUsecaseMock_UpdateParentTask_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// UpdatePreviewURL provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) UpdatePreviewURL(ctx context.Context, taskID uuid.UUID, previewURL *string) (*entity.Task, error) {
+	ret := _mock.Called(ctx, taskID, previewURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreviewURL")
+	}
+
+	var r0 *entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string) (*entity.Task, error)); ok {
+		return returnFunc(ctx, taskID, previewURL)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *string) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID, previewURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *string) error); ok {
+		r1 = returnFunc(ctx, taskID, previewURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_UpdatePreviewURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreviewURL'
+type TaskUsecaseMock_UpdatePreviewURL_Call struct {
+	*mock.Call
+}
+
+// UpdatePreviewURL is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - previewURL
+func (_e *TaskUsecaseMock_Expecter) UpdatePreviewURL(ctx interface{}, taskID interface{}, previewURL interface{}) *TaskUsecaseMock_UpdatePreviewURL_Call {
+	return &TaskUsecaseMock_UpdatePreviewURL_Call{Call: _e.mock.On("UpdatePreviewURL", ctx, taskID, previewURL)}
+}
+
+func (_c *TaskUsecaseMock_UpdatePreviewURL_Call) Run(run func(ctx context.Context, taskID uuid.UUID, previewURL *string)) *TaskUsecaseMock_UpdatePreviewURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_UpdatePreviewURL_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_UpdatePreviewURL_Call {
+	_c.Call.Return(task, err)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_UpdatePreviewURL_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, previewURL *string) (*entity.Task, error)) *TaskUsecaseMock_UpdatePreviewURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateQueuePosition provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) UpdateQueuePosition(ctx context.Context, taskID uuid.UUID, position *int) (*entity.Task, error) {
+	ret := _mock.Called(ctx, taskID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateQueuePosition")
+	}
+
+	var r0 *entity.Task
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int) (*entity.Task, error)); ok {
+		return returnFunc(ctx, taskID, position)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *int) *entity.Task); ok {
+		r0 = returnFunc(ctx, taskID, position)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Task)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *int) error); ok {
+		r1 = returnFunc(ctx, taskID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TaskUsecaseMock_UpdateQueuePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateQueuePosition'
+type TaskUsecaseMock_UpdateQueuePosition_Call struct {
+	*mock.Call
+}
+
+// UpdateQueuePosition is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - position
+func (_e *TaskUsecaseMock_Expecter) UpdateQueuePosition(ctx interface{}, taskID interface{}, position interface{}) *TaskUsecaseMock_UpdateQueuePosition_Call {
+	return &TaskUsecaseMock_UpdateQueuePosition_Call{Call: _e.mock.On("UpdateQueuePosition", ctx, taskID, position)}
+}
+
+func (_c *TaskUsecaseMock_UpdateQueuePosition_Call) Run(run func(ctx context.Context, taskID uuid.UUID, position *int)) *TaskUsecaseMock_UpdateQueuePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *TaskUsecaseMock_UpdateQueuePosition_Call) Return(_a0 *entity.Task, _a1 error) *TaskUsecaseMock_UpdateQueuePosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaskUsecaseMock_UpdateQueuePosition_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, position *int) (*entity.Task, error)) *TaskUsecaseMock_UpdateQueuePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function for the type TaskUsecaseMock
 func (_mock *TaskUsecaseMock) UpdateStatus(ctx context.Context, id uuid.UUID, status entity.TaskStatus) (*entity.Task, error) {
 	ret := _mock.Called(ctx, id, status)
@@ -3097,118 +5285,119 @@ func (_c *TaskUsecaseMock_UpdateStatus_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
-// UpdateStatusWithHistory provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) UpdateStatusWithHistory(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error) {
-	ret := _mock.Called(ctx, req)
+// UpdateStatusBy provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) UpdateStatusBy(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy *string, reason *string) (*entity.Task, error) {
+	ret := _mock.Called(ctx, id, status, changedBy, reason)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStatusWithHistory")
+		panic("no return value specified for UpdateStatusBy")
 	}
 
 	var r0 *entity.Task
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateStatusRequest) (*entity.Task, error)); ok {
-		return returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.TaskStatus, *string, *string) (*entity.Task, error)); ok {
+		return returnFunc(ctx, id, status, changedBy, reason)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateStatusRequest) *entity.Task); ok {
-		r0 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, entity.TaskStatus, *string, *string) *entity.Task); ok {
+		r0 = returnFunc(ctx, id, status, changedBy, reason)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Task)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, UpdateStatusRequest) error); ok {
-		r1 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, entity.TaskStatus, *string, *string) error); ok {
+		r1 = returnFunc(ctx, id, status, changedBy, reason)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// TaskUsecaseMock_UpdateStatusWithHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatusWithHistory'
-type TaskUsecaseMock_UpdateStatusWithHistory_Call struct {
+// TaskUsecaseMock_UpdateStatusBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatusBy'
+type TaskUsecaseMock_UpdateStatusBy_Call struct {
 	*mock.Call
 }
 
-// UpdateStatusWithHistory is a helper method to define mock.On call
+// UpdateStatusBy is a helper method to define mock.On call
 //   - ctx
-//   - req
-func (_e *TaskUsecaseMock_Expecter) UpdateStatusWithHistory(ctx interface{}, req interface{}) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
-	return &TaskUsecaseMock_UpdateStatusWithHistory_Call{Call: _e.mock.On("UpdateStatusWithHistory", ctx, req)}
+//   - id
+//   - status
+//   - changedBy
+//   - reason
+func (_e *TaskUsecaseMock_Expecter) UpdateStatusBy(ctx interface{}, id interface{}, status interface{}, changedBy interface{}, reason interface{}) *TaskUsecaseMock_UpdateStatusBy_Call {
+	return &TaskUsecaseMock_UpdateStatusBy_Call{Call: _e.mock.On("UpdateStatusBy", ctx, id, status, changedBy, reason)}
 }
 
-func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) Run(run func(ctx context.Context, req UpdateStatusRequest)) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
+func (_c *TaskUsecaseMock_UpdateStatusBy_Call) Run(run func(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy *string, reason *string)) *TaskUsecaseMock_UpdateStatusBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(UpdateStatusRequest))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(entity.TaskStatus), args[3].(*string), args[4].(*string))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
+func (_c *TaskUsecaseMock_UpdateStatusBy_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_UpdateStatusBy_Call {
 	_c.Call.Return(task, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) RunAndReturn(run func(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error)) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
+func (_c *TaskUsecaseMock_UpdateStatusBy_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, status entity.TaskStatus, changedBy *string, reason *string) (*entity.Task, error)) *TaskUsecaseMock_UpdateStatusBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTaskPlan provides a mock function for the type TaskUsecaseMock
-func (_mock *TaskUsecaseMock) UpdateTaskPlan(ctx context.Context, taskID uuid.UUID, planID uuid.UUID, req UpdateTaskPlanRequest) (*entity.Plan, error) {
-	ret := _mock.Called(ctx, taskID, planID, req)
+// UpdateStatusWithHistory provides a mock function for the type TaskUsecaseMock
+func (_mock *TaskUsecaseMock) UpdateStatusWithHistory(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error) {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTaskPlan")
+		panic("no return value specified for UpdateStatusWithHistory")
 	}
 
-	var r0 *entity.Plan
+	var r0 *entity.Task
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, UpdateTaskPlanRequest) (*entity.Plan, error)); ok {
-		return returnFunc(ctx, taskID, planID, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateStatusRequest) (*entity.Task, error)); ok {
+		return returnFunc(ctx, req)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, UpdateTaskPlanRequest) *entity.Plan); ok {
-		r0 = returnFunc(ctx, taskID, planID, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateStatusRequest) *entity.Task); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entity.Plan)
+			r0 = ret.Get(0).(*entity.Task)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, UpdateTaskPlanRequest) error); ok {
-		r1 = returnFunc(ctx, taskID, planID, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, UpdateStatusRequest) error); ok {
+		r1 = returnFunc(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// TaskUsecaseMock_UpdateTaskPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTaskPlan'
-type TaskUsecaseMock_UpdateTaskPlan_Call struct {
+// TaskUsecaseMock_UpdateStatusWithHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatusWithHistory'
+type TaskUsecaseMock_UpdateStatusWithHistory_Call struct {
 	*mock.Call
 }
 
-// UpdateTaskPlan is a helper method to define mock.On call
+// UpdateStatusWithHistory is a helper method to define mock.On call
 //   - ctx
-//   - taskID
-//   - planID
 //   - req
-func (_e *TaskUsecaseMock_Expecter) UpdateTaskPlan(ctx interface{}, taskID interface{}, planID interface{}, req interface{}) *TaskUsecaseMock_UpdateTaskPlan_Call {
-	return &TaskUsecaseMock_UpdateTaskPlan_Call{Call: _e.mock.On("UpdateTaskPlan", ctx, taskID, planID, req)}
+func (_e *TaskUsecaseMock_Expecter) UpdateStatusWithHistory(ctx interface{}, req interface{}) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
+	return &TaskUsecaseMock_UpdateStatusWithHistory_Call{Call: _e.mock.On("UpdateStatusWithHistory", ctx, req)}
 }
 
-func (_c *TaskUsecaseMock_UpdateTaskPlan_Call) Run(run func(ctx context.Context, taskID uuid.UUID, planID uuid.UUID, req UpdateTaskPlanRequest)) *TaskUsecaseMock_UpdateTaskPlan_Call {
+func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) Run(run func(ctx context.Context, req UpdateStatusRequest)) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(UpdateTaskPlanRequest))
+		run(args[0].(context.Context), args[1].(UpdateStatusRequest))
 	})
 	return _c
 }
 
-func (_c *TaskUsecaseMock_UpdateTaskPlan_Call) Return(plan *entity.Plan, err error) *TaskUsecaseMock_UpdateTaskPlan_Call {
-	_c.Call.Return(plan, err)
+func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) Return(task *entity.Task, err error) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
+	_c.Call.Return(task, err)
 	return _c
 }
 
-func (_c *TaskUsecaseMock_UpdateTaskPlan_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, planID uuid.UUID, req UpdateTaskPlanRequest) (*entity.Plan, error)) *TaskUsecaseMock_UpdateTaskPlan_Call {
+func (_c *TaskUsecaseMock_UpdateStatusWithHistory_Call) RunAndReturn(run func(ctx context.Context, req UpdateStatusRequest) (*entity.Task, error)) *TaskUsecaseMock_UpdateStatusWithHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+)
+
+// MaxVelocityWeeks bounds how far back velocity is rolled up and reported
+const MaxVelocityWeeks = 104
+
+var ErrVelocityWeeksInvalid = fmt.Errorf("weeks must be between 1 and %d", MaxVelocityWeeks)
+
+const week = 7 * 24 * time.Hour
+
+// VelocityReport is a project's weekly velocity, oldest week first. Weeks the
+// rollup job has not covered yet count as zero.
+type VelocityReport struct {
+	ProjectID          uuid.UUID
+	Weeks              []*entity.VelocityRollup
+	TasksCompleted     int
+	PullRequestsMerged int
+	Executions         int
+	// AverageTasksPerWeek is over all the reported weeks, the current one
+	// included
+	AverageTasksPerWeek      float64
+	AverageExecutionsPerTask float64
+	// RolledUpAt is when the most recent of the weeks was last rolled up,
+	// nil when none was
+	RolledUpAt  *time.Time
+	GeneratedAt time.Time
+}
+
+// RollupVelocity recomputes and stores the velocity of every project over
+// the last weeks weeks, the current one included. A project that fails is
+// logged and does not stop the others.
+func (u *taskUsecase) RollupVelocity(ctx context.Context, weeks int) error {
+	if weeks < 1 || weeks > MaxVelocityWeeks {
+		return ErrVelocityWeeksInvalid
+	}
+
+	projects, _, err := u.projectRepo.GetAllWithParams(ctx, repository.GetProjectsParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	from := weekStart(time.Now()).Add(-time.Duration(weeks-1) * week)
+	var errs []error
+	for _, project := range projects {
+		if err := u.rollupProjectVelocity(ctx, project.ID, from, weeks); err != nil {
+			slog.Warn("Failed to roll up project velocity",
+				"project_id", project.ID,
+				"error", err,
+			)
+			errs = append(errs, fmt.Errorf("project %s: %w", project.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GetWeeklyVelocity returns the project's rollups over the last weeks weeks,
+// the current one included
+func (u *taskUsecase) GetWeeklyVelocity(ctx context.Context, projectID uuid.UUID, weeks int) (*VelocityReport, error) {
+	if weeks < 1 || weeks > MaxVelocityWeeks {
+		return nil, ErrVelocityWeeksInvalid
+	}
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	from := weekStart(time.Now()).Add(-time.Duration(weeks-1) * week)
+	rollups, err := u.velocityRepo.ListByProjectID(ctx, projectID, from, from.Add(time.Duration(weeks)*week))
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[time.Time]*entity.VelocityRollup, len(rollups))
+	for _, rollup := range rollups {
+		stored[rollup.WeekStart.UTC()] = rollup
+	}
+
+	report := &VelocityReport{
+		ProjectID:   projectID,
+		Weeks:       make([]*entity.VelocityRollup, weeks),
+		GeneratedAt: time.Now(),
+	}
+	for i := range report.Weeks {
+		start := from.Add(time.Duration(i) * week)
+		rollup, ok := stored[start]
+		if !ok {
+			rollup = &entity.VelocityRollup{ProjectID: projectID, WeekStart: start}
+		} else if report.RolledUpAt == nil || rollup.UpdatedAt.After(*report.RolledUpAt) {
+			rolledUpAt := rollup.UpdatedAt
+			report.RolledUpAt = &rolledUpAt
+		}
+		report.Weeks[i] = rollup
+		report.TasksCompleted += rollup.TasksCompleted
+		report.PullRequestsMerged += rollup.PullRequestsMerged
+		report.Executions += rollup.Executions
+	}
+	report.AverageTasksPerWeek = float64(report.TasksCompleted) / float64(weeks)
+	if report.TasksCompleted > 0 {
+		report.AverageExecutionsPerTask = float64(report.Executions) / float64(report.TasksCompleted)
+	}
+
+	return report, nil
+}
+
+// rollupProjectVelocity computes the project's velocity for the weeks
+// starting at from and stores it. Tasks are counted in the week their
+// status history last took them to DONE, as in the cycle time analytics.
+func (u *taskUsecase) rollupProjectVelocity(ctx context.Context, projectID uuid.UUID, from time.Time, weeks int) error {
+	rollups := make([]*entity.VelocityRollup, weeks)
+	for i := range rollups {
+		rollups[i] = &entity.VelocityRollup{ProjectID: projectID, WeekStart: from.Add(time.Duration(i) * week)}
+	}
+	weekOf := func(t time.Time) int {
+		if t.Before(from) {
+			return -1
+		}
+		if i := int(t.Sub(from) / week); i < weeks {
+			return i
+		}
+		return -1
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get tasks: %w", err)
+	}
+	if len(tasks) > 0 {
+		histories, err := u.taskRepo.GetStatusHistoriesByProjectID(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		historyByTask := make(map[uuid.UUID][]*entity.TaskStatusHistory)
+		for _, history := range histories {
+			historyByTask[history.TaskID] = append(historyByTask[history.TaskID], history)
+		}
+
+		taskIDs := make([]uuid.UUID, len(tasks))
+		completedIn := make(map[uuid.UUID]int)
+		var completedIDs []uuid.UUID
+		for i, task := range tasks {
+			taskIDs[i] = task.ID
+			cycleTime := measureTaskCycleTime(task, historyByTask[task.ID])
+			if cycleTime == nil {
+				continue
+			}
+			if w := weekOf(cycleTime.CompletedAt); w >= 0 {
+				rollups[w].TasksCompleted++
+				completedIn[task.ID] = w
+				completedIDs = append(completedIDs, task.ID)
+			}
+		}
+
+		if len(completedIDs) > 0 {
+			executions, err := u.executionRepo.GetByTaskIDs(ctx, completedIDs)
+			if err != nil {
+				return fmt.Errorf("failed to get executions: %w", err)
+			}
+			for _, execution := range executions {
+				if w, ok := completedIn[execution.TaskID]; ok {
+					rollups[w].Executions++
+				}
+			}
+		}
+
+		pullRequests, err := u.pullRequestRepo.GetByTaskIDs(ctx, taskIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get pull requests: %w", err)
+		}
+		for _, pr := range pullRequests {
+			if pr.MergedAt == nil {
+				continue
+			}
+			if w := weekOf(*pr.MergedAt); w >= 0 {
+				rollups[w].PullRequestsMerged++
+			}
+		}
+	}
+
+	for _, rollup := range rollups {
+		if err := u.velocityRepo.Upsert(ctx, rollup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// weekStart returns the Monday 00:00 UTC starting the week holding t
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
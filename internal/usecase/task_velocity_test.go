@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, weekStart(monday))
+	assert.Equal(t, monday, weekStart(time.Date(2024, 3, 10, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, monday, weekStart(time.Date(2024, 3, 5, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*3600))), "weeks are UTC")
+}
+
+func TestRollupVelocity(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	prRepo := repository.NewPullRequestRepositoryMock(t)
+	velocityRepo := repository.NewVelocityRollupRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, executionRepo: executionRepo, pullRequestRepo: prRepo, velocityRepo: velocityRepo}
+	projectID := uuid.New()
+	thisWeek := weekStart(time.Now())
+	lastWeek := thisWeek.Add(-week)
+
+	// task builds a task created at created that went to DONE doneHours later,
+	// or never when doneHours is negative
+	var tasks []*entity.Task
+	var histories []*entity.TaskStatusHistory
+	task := func(created time.Time, doneHours int) *entity.Task {
+		task := &entity.Task{ID: uuid.New(), ProjectID: projectID, CreatedAt: created}
+		tasks = append(tasks, task)
+		if doneHours >= 0 {
+			histories = append(histories, statusChanges(task.ID, created,
+				entity.TaskStatusIMPLEMENTING, 1,
+				entity.TaskStatusDONE, doneHours,
+			)...)
+		}
+		return task
+	}
+	doneLastWeek := task(lastWeek, 30)
+	doneThisWeek := task(lastWeek.Add(-3*week), 4*24*7+2)
+	task(lastWeek, -1)
+	task(lastWeek.Add(-5*week), 2)
+
+	merged := lastWeek.Add(40 * time.Hour)
+	projectRepo.EXPECT().GetAllWithParams(mock.Anything, repository.GetProjectsParams{}).Return([]*entity.Project{{ID: projectID}}, 1, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(tasks, nil)
+	taskRepo.EXPECT().GetStatusHistoriesByProjectID(mock.Anything, projectID).Return(histories, nil)
+	executionRepo.EXPECT().GetByTaskIDs(mock.Anything, []uuid.UUID{doneLastWeek.ID, doneThisWeek.ID}).Return([]*entity.Execution{
+		{TaskID: doneLastWeek.ID},
+		{TaskID: doneThisWeek.ID},
+		{TaskID: doneThisWeek.ID},
+		{TaskID: doneThisWeek.ID},
+	}, nil)
+	prRepo.EXPECT().GetByTaskIDs(mock.Anything, mock.Anything).Return([]*entity.PullRequest{
+		{TaskID: doneLastWeek.ID, MergedAt: &merged},
+		{TaskID: doneThisWeek.ID},
+	}, nil)
+	var rollups []entity.VelocityRollup
+	velocityRepo.EXPECT().Upsert(mock.Anything, mock.Anything).Run(func(_ context.Context, rollup *entity.VelocityRollup) {
+		rollups = append(rollups, *rollup)
+	}).Return(nil).Times(2)
+
+	require.NoError(t, uc.RollupVelocity(context.Background(), 2))
+	require.Len(t, rollups, 2)
+	assert.Equal(t, entity.VelocityRollup{ProjectID: projectID, WeekStart: lastWeek, TasksCompleted: 1, PullRequestsMerged: 1, Executions: 1}, rollups[0])
+	assert.Equal(t, entity.VelocityRollup{ProjectID: projectID, WeekStart: thisWeek, TasksCompleted: 1, Executions: 3}, rollups[1])
+
+	assert.ErrorIs(t, uc.RollupVelocity(context.Background(), 0), ErrVelocityWeeksInvalid)
+}
+
+func TestGetWeeklyVelocity(t *testing.T) {
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	velocityRepo := repository.NewVelocityRollupRepositoryMock(t)
+	uc := &taskUsecase{projectRepo: projectRepo, velocityRepo: velocityRepo}
+	projectID := uuid.New()
+	thisWeek := weekStart(time.Now())
+	from := thisWeek.Add(-3 * week)
+	rolledUpAt := time.Now().Add(-time.Hour)
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	velocityRepo.EXPECT().ListByProjectID(mock.Anything, projectID, from, thisWeek.Add(week)).Return([]*entity.VelocityRollup{
+		{ProjectID: projectID, WeekStart: from, TasksCompleted: 3, PullRequestsMerged: 2, Executions: 6, UpdatedAt: rolledUpAt.Add(-week)},
+		{ProjectID: projectID, WeekStart: thisWeek, TasksCompleted: 1, PullRequestsMerged: 1, Executions: 2, UpdatedAt: rolledUpAt},
+	}, nil)
+
+	report, err := uc.GetWeeklyVelocity(context.Background(), projectID, 4)
+	require.NoError(t, err)
+	require.Len(t, report.Weeks, 4)
+	assert.Equal(t, from.Add(week), report.Weeks[1].WeekStart, "missing weeks are filled in")
+	assert.Zero(t, report.Weeks[1].TasksCompleted)
+	assert.Equal(t, 4, report.TasksCompleted)
+	assert.Equal(t, 3, report.PullRequestsMerged)
+	assert.Equal(t, 1.0, report.AverageTasksPerWeek)
+	assert.Equal(t, 2.0, report.AverageExecutionsPerTask)
+	require.NotNil(t, report.RolledUpAt)
+	assert.Equal(t, rolledUpAt, *report.RolledUpAt)
+
+	_, err = uc.GetWeeklyVelocity(context.Background(), projectID, MaxVelocityWeeks+1)
+	assert.ErrorIs(t, err, ErrVelocityWeeksInvalid)
+}
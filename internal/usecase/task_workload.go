@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// AssigneeWorkload is the open work of one assignee
+type AssigneeWorkload struct {
+	// Assignee is empty for unassigned tasks
+	Assignee  string
+	OpenTasks int
+	// ByStatus counts the open tasks in each status
+	ByStatus map[entity.TaskStatus]int
+	// EstimatedHours sums the estimates of the open tasks; Unestimated
+	// counts the open tasks without one
+	EstimatedHours float64
+	Unestimated    int
+	// InFlightExecutions counts the AI runs pending, running or paused on
+	// the open tasks, and AITasks the open tasks with at least one
+	InFlightExecutions int
+	AITasks            int
+}
+
+// WorkloadReport is a project's open work by assignee
+type WorkloadReport struct {
+	ProjectID uuid.UUID
+	// Assignees is sorted by assignee, with unassigned tasks under an empty
+	// name
+	Assignees []*AssigneeWorkload
+	// Total sums up every assignee, Assignee left empty
+	Total       AssigneeWorkload
+	GeneratedAt time.Time
+}
+
+// inFlight reports whether the execution is still holding an AI executor
+func inFlight(status entity.ExecutionStatus) bool {
+	return status == entity.ExecutionStatusPending ||
+		status == entity.ExecutionStatusRunning ||
+		status == entity.ExecutionStatusPaused
+}
+
+// GetWorkload reports the project's open tasks, their estimates and the AI
+// runs in flight on them by assignee. Done, cancelled and archived tasks and
+// templates are left out.
+func (u *taskUsecase) GetWorkload(ctx context.Context, projectID uuid.UUID) (*WorkloadReport, error) {
+	if _, err := u.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := u.authorize(ctx, projectID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	tasks, err := u.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	report := &WorkloadReport{
+		ProjectID:   projectID,
+		Assignees:   []*AssigneeWorkload{},
+		Total:       AssigneeWorkload{ByStatus: map[entity.TaskStatus]int{}},
+		GeneratedAt: time.Now(),
+	}
+
+	var open []*entity.Task
+	for _, task := range tasks {
+		if task.Status == entity.TaskStatusDONE || task.Status == entity.TaskStatusCANCELLED ||
+			task.IsArchived || task.IsTemplate {
+			continue
+		}
+		open = append(open, task)
+	}
+	if len(open) == 0 {
+		return report, nil
+	}
+	taskIDs := make([]uuid.UUID, len(open))
+	for i, task := range open {
+		taskIDs[i] = task.ID
+	}
+
+	executions, err := u.executionRepo.GetByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get executions: %w", err)
+	}
+	inFlightByTask := make(map[uuid.UUID]int)
+	for _, execution := range executions {
+		if inFlight(execution.Status) {
+			inFlightByTask[execution.TaskID]++
+		}
+	}
+
+	byAssignee := make(map[string]*AssigneeWorkload)
+	for _, task := range open {
+		var assignee string
+		if task.AssignedTo != nil {
+			assignee = *task.AssignedTo
+		}
+		workload, ok := byAssignee[assignee]
+		if !ok {
+			workload = &AssigneeWorkload{Assignee: assignee, ByStatus: map[entity.TaskStatus]int{}}
+			byAssignee[assignee] = workload
+			report.Assignees = append(report.Assignees, workload)
+		}
+
+		for _, w := range []*AssigneeWorkload{workload, &report.Total} {
+			w.OpenTasks++
+			w.ByStatus[task.Status]++
+			if task.EstimatedHours != nil {
+				w.EstimatedHours += *task.EstimatedHours
+			} else {
+				w.Unestimated++
+			}
+			if n := inFlightByTask[task.ID]; n > 0 {
+				w.InFlightExecutions += n
+				w.AITasks++
+			}
+		}
+	}
+	sort.Slice(report.Assignees, func(i, j int) bool {
+		return report.Assignees[i].Assignee < report.Assignees[j].Assignee
+	})
+
+	return report, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWorkload(t *testing.T) {
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	executionRepo := repository.NewExecutionRepositoryMock(t)
+	uc := &taskUsecase{taskRepo: taskRepo, projectRepo: projectRepo, executionRepo: executionRepo}
+	projectID := uuid.New()
+
+	alice, bob := "alice", "bob"
+	hours := func(h float64) *float64 { return &h }
+	task := func(assignee *string, status entity.TaskStatus, estimate *float64) *entity.Task {
+		return &entity.Task{ID: uuid.New(), ProjectID: projectID, AssignedTo: assignee, Status: status, EstimatedHours: estimate}
+	}
+	login := task(&alice, entity.TaskStatusIMPLEMENTING, hours(5))
+	search := task(&alice, entity.TaskStatusTODO, nil)
+	billing := task(&bob, entity.TaskStatusPLANREVIEWING, hours(3.5))
+	docs := task(nil, entity.TaskStatusTODO, hours(1))
+	done := task(&alice, entity.TaskStatusDONE, hours(8))
+	archived := task(&bob, entity.TaskStatusTODO, hours(2))
+	archived.IsArchived = true
+	openIDs := []uuid.UUID{login.ID, search.ID, billing.ID, docs.ID}
+
+	executions := []*entity.Execution{
+		{TaskID: login.ID, Status: entity.ExecutionStatusRunning},
+		{TaskID: login.ID, Status: entity.ExecutionStatusPending},
+		{TaskID: login.ID, Status: entity.ExecutionStatusFailed},
+		{TaskID: billing.ID, Status: entity.ExecutionStatusCompleted},
+	}
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{login, search, billing, docs, done, archived}, nil)
+	executionRepo.EXPECT().GetByTaskIDs(mock.Anything, openIDs).Return(executions, nil)
+
+	report, err := uc.GetWorkload(context.Background(), projectID)
+	require.NoError(t, err)
+	require.Len(t, report.Assignees, 3)
+
+	unassigned := report.Assignees[0]
+	assert.Empty(t, unassigned.Assignee, "unassigned tasks sort first")
+	assert.Equal(t, 1, unassigned.OpenTasks)
+
+	a := report.Assignees[1]
+	assert.Equal(t, alice, a.Assignee)
+	assert.Equal(t, 2, a.OpenTasks, "done tasks are left out")
+	assert.Equal(t, map[entity.TaskStatus]int{entity.TaskStatusIMPLEMENTING: 1, entity.TaskStatusTODO: 1}, a.ByStatus)
+	assert.Equal(t, 5.0, a.EstimatedHours)
+	assert.Equal(t, 1, a.Unestimated)
+	assert.Equal(t, 2, a.InFlightExecutions)
+	assert.Equal(t, 1, a.AITasks)
+
+	b := report.Assignees[2]
+	assert.Equal(t, 1, b.OpenTasks, "archived tasks are left out")
+	assert.Zero(t, b.InFlightExecutions)
+
+	assert.Equal(t, 4, report.Total.OpenTasks)
+	assert.Equal(t, 9.5, report.Total.EstimatedHours)
+	assert.Equal(t, 2, report.Total.InFlightExecutions)
+	assert.Equal(t, 2, report.Total.ByStatus[entity.TaskStatusTODO])
+}
+
+func TestGetWorkload_ProjectNotFound(t *testing.T) {
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := &taskUsecase{projectRepo: projectRepo}
+	projectID := uuid.New()
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(nil, assert.AnError)
+
+	_, err := uc.GetWorkload(context.Background(), projectID)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
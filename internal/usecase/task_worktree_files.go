@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+)
+
+// MaxWorktreeFileSize is how much of a file ReadWorktreeFile returns; larger
+// files are truncated
+const MaxWorktreeFileSize = 1 << 20
+
+// binarySniffSize is how much of a file is checked for NUL bytes, as git does
+const binarySniffSize = 8000
+
+var (
+	ErrWorktreePathInvalid   = errors.New("path is outside the task worktree")
+	ErrWorktreeFileNotFound  = errors.New("file not found in the task worktree")
+	ErrWorktreePathNotDir    = errors.New("path is not a directory")
+	ErrWorktreePathIsDir     = errors.New("path is a directory")
+	ErrWorktreeFileIrregular = errors.New("path is not a regular file")
+)
+
+// WorktreeFileType is the kind of a worktree file entry
+type WorktreeFileType string
+
+const (
+	WorktreeFileTypeFile    WorktreeFileType = "file"
+	WorktreeFileTypeDir     WorktreeFileType = "dir"
+	WorktreeFileTypeSymlink WorktreeFileType = "symlink"
+)
+
+// WorktreeFileEntry is a file or directory in a task worktree. Path is
+// relative to the worktree root and uses forward slashes.
+type WorktreeFileEntry struct {
+	Name       string
+	Path       string
+	Type       WorktreeFileType
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// WorktreeFileContent is a file read from a task worktree. Content is empty
+// for binary files and holds the first MaxWorktreeFileSize bytes of larger
+// ones.
+type WorktreeFileContent struct {
+	Path       string
+	Size       int64
+	ModifiedAt time.Time
+	Content    string
+	Binary     bool
+	Truncated  bool
+}
+
+// ListWorktreeFiles lists the directory at dir in the task's worktree,
+// directories first. The worktree's .git file is left out.
+func (u *taskUsecase) ListWorktreeFiles(ctx context.Context, taskID uuid.UUID, dir string) ([]WorktreeFileEntry, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	task, err := u.getTaskWithWorktree(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	rel, fullPath, err := resolveWorktreeFilePath(*task.WorktreePath, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return nil, worktreeFileError(err)
+	}
+	if !info.IsDir() {
+		return nil, ErrWorktreePathNotDir
+	}
+
+	dirEntries, err := os.ReadDir(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	entries := make([]WorktreeFileEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		if rel == "" && dirEntry.Name() == ".git" {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			// Removed while listing
+			continue
+		}
+		entry := WorktreeFileEntry{
+			Name:       dirEntry.Name(),
+			Path:       filepath.ToSlash(filepath.Join(rel, dirEntry.Name())),
+			Type:       WorktreeFileTypeFile,
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		}
+		switch {
+		case info.Mode()&os.ModeSymlink != 0:
+			entry.Type = WorktreeFileTypeSymlink
+		case info.IsDir():
+			entry.Type = WorktreeFileTypeDir
+			entry.Size = 0
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		iDir, jDir := entries[i].Type == WorktreeFileTypeDir, entries[j].Type == WorktreeFileTypeDir
+		if iDir != jDir {
+			return iDir
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// ReadWorktreeFile reads the file at path in the task's worktree. Symlinks
+// are followed as long as they stay inside the worktree.
+func (u *taskUsecase) ReadWorktreeFile(ctx context.Context, taskID uuid.UUID, path string) (*WorktreeFileContent, error) {
+	if err := u.authorizeTask(ctx, taskID, entity.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	task, err := u.getTaskWithWorktree(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	rel, fullPath, err := resolveWorktreeFilePath(*task.WorktreePath, path)
+	if err != nil {
+		return nil, err
+	}
+	if rel == "" {
+		return nil, ErrWorktreePathIsDir
+	}
+
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return nil, worktreeFileError(err)
+	}
+	if info.IsDir() {
+		return nil, ErrWorktreePathIsDir
+	}
+	if !info.Mode().IsRegular() {
+		return nil, ErrWorktreeFileIrregular
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return nil, worktreeFileError(err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxWorktreeFileSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	content := &WorktreeFileContent{
+		Path:       rel,
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime(),
+		Truncated:  info.Size() > MaxWorktreeFileSize,
+	}
+	if bytes.IndexByte(data[:min(len(data), binarySniffSize)], 0) >= 0 {
+		content.Binary = true
+		content.Truncated = false
+		return content, nil
+	}
+	content.Content = string(data)
+	return content, nil
+}
+
+// resolveWorktreeFilePath turns a path relative to the worktree root into
+// its cleaned form and full path, refusing any path that leaves the
+// worktree, directly or through a symlink, or that points into .git
+func resolveWorktreeFilePath(root, path string) (string, string, error) {
+	rel := strings.TrimPrefix(filepath.Clean("/"+filepath.FromSlash(path)), string(filepath.Separator))
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if part == ".git" {
+			return "", "", ErrWorktreePathInvalid
+		}
+	}
+	fullPath := filepath.Join(root, rel)
+
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve worktree path: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(fullPath)
+	if err != nil {
+		return "", "", worktreeFileError(err)
+	}
+	if inside, err := filepath.Rel(resolvedRoot, resolved); err != nil || inside == ".." || strings.HasPrefix(inside, ".."+string(filepath.Separator)) {
+		return "", "", ErrWorktreePathInvalid
+	}
+
+	return filepath.ToSlash(rel), fullPath, nil
+}
+
+func worktreeFileError(err error) error {
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrWorktreeFileNotFound
+	}
+	return fmt.Errorf("failed to read worktree file: %w", err)
+}
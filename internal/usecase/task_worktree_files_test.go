@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newWorktreeFilesTestUsecase returns a usecase whose task has a worktree
+// holding a few files, a .git file and a symlink leaving the worktree
+func newWorktreeFilesTestUsecase(t *testing.T) (*taskUsecase, uuid.UUID, string) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "cmd", "server"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".git"), []byte("gitdir: /repo/.git/worktrees/task\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "README.md"), []byte("# Project\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cmd", "server", "main.go"), []byte("package main\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "logo.png"), []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0o644))
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0o644))
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "secret.txt")))
+
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	taskID := uuid.New()
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID, WorktreePath: &root}, nil)
+	return &taskUsecase{taskRepo: taskRepo}, taskID, root
+}
+
+func TestListWorktreeFiles(t *testing.T) {
+	uc, taskID, _ := newWorktreeFilesTestUsecase(t)
+	ctx := context.Background()
+
+	entries, err := uc.ListWorktreeFiles(ctx, taskID, "")
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	assert.Equal(t, []string{"cmd", "README.md", "logo.png", "secret.txt"}, names, "directories first, without .git")
+	assert.Equal(t, WorktreeFileTypeDir, entries[0].Type)
+	assert.Equal(t, WorktreeFileTypeSymlink, entries[3].Type)
+
+	entries, err = uc.ListWorktreeFiles(ctx, taskID, "/cmd/server/")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "cmd/server/main.go", entries[0].Path)
+	assert.Equal(t, int64(len("package main\n")), entries[0].Size)
+
+	_, err = uc.ListWorktreeFiles(ctx, taskID, "README.md")
+	assert.ErrorIs(t, err, ErrWorktreePathNotDir)
+	_, err = uc.ListWorktreeFiles(ctx, taskID, "missing")
+	assert.ErrorIs(t, err, ErrWorktreeFileNotFound)
+}
+
+func TestReadWorktreeFile(t *testing.T) {
+	uc, taskID, root := newWorktreeFilesTestUsecase(t)
+	ctx := context.Background()
+
+	content, err := uc.ReadWorktreeFile(ctx, taskID, "cmd/server/main.go")
+	require.NoError(t, err)
+	assert.Equal(t, "cmd/server/main.go", content.Path)
+	assert.Equal(t, "package main\n", content.Content)
+	assert.False(t, content.Binary)
+	assert.False(t, content.Truncated)
+
+	content, err = uc.ReadWorktreeFile(ctx, taskID, "logo.png")
+	require.NoError(t, err)
+	assert.True(t, content.Binary)
+	assert.Empty(t, content.Content)
+
+	large := strings.Repeat("a", MaxWorktreeFileSize+10)
+	require.NoError(t, os.WriteFile(filepath.Join(root, "large.txt"), []byte(large), 0o644))
+	content, err = uc.ReadWorktreeFile(ctx, taskID, "large.txt")
+	require.NoError(t, err)
+	assert.True(t, content.Truncated)
+	assert.Equal(t, int64(len(large)), content.Size)
+	assert.Len(t, content.Content, MaxWorktreeFileSize)
+
+	_, err = uc.ReadWorktreeFile(ctx, taskID, "cmd")
+	assert.ErrorIs(t, err, ErrWorktreePathIsDir)
+	_, err = uc.ReadWorktreeFile(ctx, taskID, "missing.go")
+	assert.ErrorIs(t, err, ErrWorktreeFileNotFound)
+}
+
+func TestReadWorktreeFile_StaysInsideWorktree(t *testing.T) {
+	uc, taskID, _ := newWorktreeFilesTestUsecase(t)
+	ctx := context.Background()
+
+	for _, path := range []string{"secret.txt", ".git", "cmd/../.git"} {
+		_, err := uc.ReadWorktreeFile(ctx, taskID, path)
+		assert.ErrorIs(t, err, ErrWorktreePathInvalid, path)
+	}
+
+	// Parent references cannot climb above the root
+	content, err := uc.ReadWorktreeFile(ctx, taskID, "../../README.md")
+	require.NoError(t, err)
+	assert.Equal(t, "README.md", content.Path)
+}
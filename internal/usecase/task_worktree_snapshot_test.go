@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorktreeSnapshots(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	uc, taskRepo, _ := newKanbanTestUsecase(t)
+	uc.gitManager = gitManager
+	ctx := context.Background()
+
+	_, _, worktree := initTaskWorktree(t)
+	task := &entity.Task{ID: uuid.New(), WorktreePath: &worktree}
+	taskRepo.EXPECT().GetByID(ctx, task.ID).Return(task, nil)
+
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "login.go"), []byte("package main\n"), 0o644))
+	_, err = gitManager.CreateSnapshot(ctx, worktree, task.ID.String(), "exec-1", "Before implementation execution exec-1")
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(worktree, "login.go")))
+
+	snapshots, err := uc.ListWorktreeSnapshots(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, "exec-1", snapshots[0].ID)
+
+	require.NoError(t, uc.RestoreWorktreeSnapshot(ctx, task.ID, "exec-1"))
+	assert.FileExists(t, filepath.Join(worktree, "login.go"))
+
+	assert.ErrorIs(t, uc.RestoreWorktreeSnapshot(ctx, task.ID, "exec-2"), git.ErrSnapshotNotFound)
+}
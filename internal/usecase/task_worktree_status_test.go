@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runGit(t *testing.T, dir string, args ...string) {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestGetWorktreeStatus(t *testing.T) {
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+	uc, taskRepo, _ := newKanbanTestUsecase(t)
+	uc.gitManager = gitManager
+
+	// A task branch one commit ahead of and one behind main, with an
+	// uncommitted change and an untracked file
+	worktree := t.TempDir()
+	runGit(t, worktree, "init", "-q", "-b", "main")
+	runGit(t, worktree, "config", "user.email", "test@example.com")
+	runGit(t, worktree, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "main.go"), []byte("package main\n"), 0o644))
+	runGit(t, worktree, "add", "main.go")
+	runGit(t, worktree, "commit", "-q", "-m", "Initial commit")
+	runGit(t, worktree, "checkout", "-q", "-b", "task/add-login")
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "login.go"), []byte("package main\n"), 0o644))
+	runGit(t, worktree, "add", "login.go")
+	runGit(t, worktree, "commit", "-q", "-m", "Add login")
+	runGit(t, worktree, "checkout", "-q", "main")
+	runGit(t, worktree, "commit", "-q", "--allow-empty", "-m", "Unrelated change")
+	runGit(t, worktree, "checkout", "-q", "task/add-login")
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, "notes.txt"), []byte("todo\n"), 0o644))
+
+	taskID := uuid.New()
+	baseBranch := "main"
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(&entity.Task{ID: taskID, WorktreePath: &worktree, BaseBranchName: &baseBranch}, nil).Once()
+
+	status, err := uc.GetWorktreeStatus(context.Background(), taskID)
+	require.NoError(t, err)
+	assert.Equal(t, worktree, status.WorktreePath)
+	assert.Equal(t, "task/add-login", status.Branch)
+	assert.Equal(t, "main", status.BaseBranchName)
+	assert.Equal(t, 2, status.DirtyFiles)
+	require.NotNil(t, status.Ahead)
+	require.NotNil(t, status.Behind)
+	assert.Equal(t, 1, *status.Ahead)
+	assert.Equal(t, 1, *status.Behind)
+	require.NotNil(t, status.LastCommit)
+	assert.Equal(t, "Add login", status.LastCommit.Subject)
+
+	t.Run("base branch missing", func(t *testing.T) {
+		missing := "develop"
+		taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(&entity.Task{ID: taskID, WorktreePath: &worktree, BaseBranchName: &missing}, nil).Once()
+
+		status, err := uc.GetWorktreeStatus(context.Background(), taskID)
+		require.NoError(t, err)
+		assert.Nil(t, status.Ahead)
+		assert.Nil(t, status.Behind)
+	})
+}
+
+func TestGetWorktreeStatus_NoWorktree(t *testing.T) {
+	uc, taskRepo, _ := newKanbanTestUsecase(t)
+	taskID := uuid.New()
+
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(&entity.Task{ID: taskID}, nil).Once()
+	_, err := uc.GetWorktreeStatus(context.Background(), taskID)
+	assert.ErrorIs(t, err, ErrTaskHasNoWorktree)
+
+	removed := filepath.Join(t.TempDir(), "removed")
+	taskRepo.EXPECT().GetByID(context.Background(), taskID).Return(&entity.Task{ID: taskID, WorktreePath: &removed}, nil).Once()
+	_, err = uc.GetWorktreeStatus(context.Background(), taskID)
+	assert.ErrorIs(t, err, ErrWorktreeMissing)
+}
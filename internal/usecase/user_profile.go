@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	// Time zones are validated against the embedded database, as servers
+	// and containers may not ship one
+	_ "time/tzdata"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/google/uuid"
+)
+
+// MaxAvatarSize is the largest avatar image that may be uploaded
+const MaxAvatarSize = 2 << 20
+
+// avatarTypes are the media types of the images accepted as avatars
+var avatarTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+var (
+	ErrUsernameInvalid      = errors.New("username must be 1 to 255 characters, without slashes or control characters")
+	ErrDisplayNameTooLong   = errors.New("display name must not exceed 255 characters")
+	ErrTimezoneInvalid      = errors.New("timezone must be an IANA time zone name such as Asia/Ho_Chi_Minh")
+	ErrAvatarNotFound       = errors.New("avatar not found")
+	ErrAvatarEmpty          = errors.New("avatar is empty")
+	ErrAvatarTooLarge       = errors.New("avatar is larger than allowed")
+	ErrAvatarTypeNotAllowed = errors.New("avatar must be a PNG, JPEG, GIF or WebP image")
+)
+
+type UserProfileUsecase interface {
+	// Get returns the user's profile, or the default one when they have not
+	// saved one
+	Get(ctx context.Context, username string) (*entity.UserProfile, error)
+	// List returns the profiles of the users in the order asked for, once
+	// each, with default profiles for users who have not saved one
+	List(ctx context.Context, usernames []string) ([]*entity.UserProfile, error)
+	// Update changes the fields of the user's profile the request sets,
+	// creating the profile if needed
+	Update(ctx context.Context, username string, req UpdateUserProfileRequest) (*entity.UserProfile, error)
+	// UploadAvatar stores the user's avatar, replacing the previous one
+	UploadAvatar(ctx context.Context, req UploadAvatarRequest) (*entity.UserProfile, error)
+	// DeleteAvatar removes the user's avatar
+	DeleteAvatar(ctx context.Context, username string) (*entity.UserProfile, error)
+	// OpenAvatar opens the user's avatar image
+	OpenAvatar(ctx context.Context, username string) (*entity.UserProfile, io.ReadCloser, error)
+	// AvatarURL returns the URL the user's avatar is served from, which
+	// changes with the avatar, or "" when they have none
+	AvatarURL(profile *entity.UserProfile) string
+}
+
+// UpdateUserProfileRequest holds the profile fields to change; nil fields
+// are left as they are
+type UpdateUserProfileRequest struct {
+	DisplayName *string
+	Timezone    *string
+}
+
+type UploadAvatarRequest struct {
+	Username string
+	// Size is the length of Content, which is read no further
+	Size    int64
+	Content io.Reader
+}
+
+type userProfileUsecase struct {
+	profileRepo repository.UserProfileRepository
+	storage     storage.Storage
+	baseURL     string
+	now         func() time.Time
+}
+
+// NewUserProfileUsecase creates the user profile usecase. Avatars are kept
+// in the attachment storage and served from baseURL.
+func NewUserProfileUsecase(profileRepo repository.UserProfileRepository, store storage.Storage, baseURL string) UserProfileUsecase {
+	return &userProfileUsecase{
+		profileRepo: profileRepo,
+		storage:     store,
+		baseURL:     baseURL,
+		now:         time.Now,
+	}
+}
+
+func (u *userProfileUsecase) Get(ctx context.Context, username string) (*entity.UserProfile, error) {
+	if err := validateUsername(username); err != nil {
+		return nil, err
+	}
+	profiles, err := u.profileRepo.ListByUsernames(ctx, []string{username})
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return entity.NewUserProfile(username), nil
+	}
+	return profiles[0], nil
+}
+
+func (u *userProfileUsecase) List(ctx context.Context, usernames []string) ([]*entity.UserProfile, error) {
+	seen := make(map[string]bool, len(usernames))
+	unique := make([]string, 0, len(usernames))
+	for _, username := range usernames {
+		if err := validateUsername(username); err != nil {
+			return nil, err
+		}
+		if !seen[username] {
+			seen[username] = true
+			unique = append(unique, username)
+		}
+	}
+
+	saved, err := u.profileRepo.ListByUsernames(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+	byUsername := make(map[string]*entity.UserProfile, len(saved))
+	for _, profile := range saved {
+		byUsername[profile.Username] = profile
+	}
+
+	profiles := make([]*entity.UserProfile, len(unique))
+	for i, username := range unique {
+		if profile, ok := byUsername[username]; ok {
+			profiles[i] = profile
+		} else {
+			profiles[i] = entity.NewUserProfile(username)
+		}
+	}
+	return profiles, nil
+}
+
+func (u *userProfileUsecase) Update(ctx context.Context, username string, req UpdateUserProfileRequest) (*entity.UserProfile, error) {
+	profile, err := u.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DisplayName != nil {
+		displayName := strings.TrimSpace(*req.DisplayName)
+		if utf8.RuneCountInString(displayName) > 255 {
+			return nil, ErrDisplayNameTooLong
+		}
+		profile.DisplayName = displayName
+	}
+	if req.Timezone != nil {
+		timezone := strings.TrimSpace(*req.Timezone)
+		if timezone == "" {
+			timezone = entity.DefaultTimezone
+		}
+		// LoadLocation also accepts "Local", which means nothing to others
+		if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+			return nil, fmt.Errorf("%w: %q", ErrTimezoneInvalid, timezone)
+		}
+		profile.Timezone = timezone
+	}
+
+	if err := u.profileRepo.Save(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (u *userProfileUsecase) UploadAvatar(ctx context.Context, req UploadAvatarRequest) (*entity.UserProfile, error) {
+	profile, err := u.Get(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if req.Size <= 0 {
+		return nil, ErrAvatarEmpty
+	}
+	if req.Size > MaxAvatarSize {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d", ErrAvatarTooLarge, req.Size, MaxAvatarSize)
+	}
+
+	// The media type is sniffed, as the one the client claims is not trusted
+	head := make([]byte, min(req.Size, sniffLength))
+	if _, err := io.ReadFull(req.Content, head); err != nil {
+		return nil, fmt.Errorf("failed to read avatar: %w", err)
+	}
+	mimeType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if !avatarTypes[mimeType] {
+		return nil, fmt.Errorf("%w: %s", ErrAvatarTypeNotAllowed, mimeType)
+	}
+
+	// Each avatar gets its own key, so the previous one keeps being served
+	// until the profile points at the new one
+	avatarPath := fmt.Sprintf("avatars/%s", uuid.New())
+	content := io.MultiReader(bytes.NewReader(head), req.Content)
+	if err := u.storage.Put(ctx, avatarPath, content, req.Size, mimeType); err != nil {
+		return nil, fmt.Errorf("failed to store avatar: %w", err)
+	}
+
+	previous := profile.AvatarPath
+	updatedAt := u.now().UTC().Truncate(time.Second)
+	profile.AvatarPath = &avatarPath
+	profile.AvatarMimeType = mimeType
+	profile.AvatarSize = req.Size
+	profile.AvatarUpdatedAt = &updatedAt
+	if err := u.profileRepo.Save(ctx, profile); err != nil {
+		// Not to leave a file no profile points at
+		_ = u.storage.Delete(context.WithoutCancel(ctx), avatarPath)
+		return nil, err
+	}
+	if previous != nil {
+		_ = u.storage.Delete(context.WithoutCancel(ctx), *previous)
+	}
+	return profile, nil
+}
+
+func (u *userProfileUsecase) DeleteAvatar(ctx context.Context, username string) (*entity.UserProfile, error) {
+	profile, err := u.Get(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if !profile.HasAvatar() {
+		return nil, fmt.Errorf("%w: %s has none", ErrAvatarNotFound, username)
+	}
+
+	previous := *profile.AvatarPath
+	profile.AvatarPath = nil
+	profile.AvatarMimeType = ""
+	profile.AvatarSize = 0
+	profile.AvatarUpdatedAt = nil
+	if err := u.profileRepo.Save(ctx, profile); err != nil {
+		return nil, err
+	}
+	_ = u.storage.Delete(context.WithoutCancel(ctx), previous)
+	return profile, nil
+}
+
+func (u *userProfileUsecase) OpenAvatar(ctx context.Context, username string) (*entity.UserProfile, io.ReadCloser, error) {
+	profile, err := u.Get(ctx, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !profile.HasAvatar() {
+		return nil, nil, fmt.Errorf("%w: %s has none", ErrAvatarNotFound, username)
+	}
+
+	content, err := u.storage.Open(ctx, *profile.AvatarPath)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil, fmt.Errorf("%w: %v", ErrAvatarNotFound, err)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return profile, content, nil
+}
+
+// AvatarURL versions the URL with the time the avatar was uploaded, so it
+// can be cached until it changes
+func (u *userProfileUsecase) AvatarURL(profile *entity.UserProfile) string {
+	if !profile.HasAvatar() || profile.AvatarUpdatedAt == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/api/v1/users/%s/avatar?v=%d", strings.TrimSuffix(u.baseURL, "/"), url.PathEscape(profile.Username), profile.AvatarUpdatedAt.Unix())
+}
+
+// validateUsername checks that username can name a user, as recorded in the
+// fields profiles describe and in URL paths
+func validateUsername(username string) error {
+	if username == "" || utf8.RuneCountInString(username) > 255 || strings.ContainsRune(username, '/') {
+		return ErrUsernameInvalid
+	}
+	for _, r := range username {
+		if unicode.IsControl(r) {
+			return ErrUsernameInvalid
+		}
+	}
+	return nil
+}
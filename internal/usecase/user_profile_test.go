@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserProfileTestUsecase(t *testing.T) (*userProfileUsecase, *repository.UserProfileRepositoryMock) {
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	profileRepo := repository.NewUserProfileRepositoryMock(t)
+	return NewUserProfileUsecase(profileRepo, store, "http://localhost:8098/").(*userProfileUsecase), profileRepo
+}
+
+func TestUserProfileUsecase_List(t *testing.T) {
+	uc, profileRepo := newUserProfileTestUsecase(t)
+	bob := &entity.UserProfile{Username: "bob", DisplayName: "Bob Tran", Timezone: "Europe/Paris"}
+	profileRepo.EXPECT().ListByUsernames(mock.Anything, []string{"carol", "bob"}).Return([]*entity.UserProfile{bob}, nil)
+
+	profiles, err := uc.List(context.Background(), []string{"carol", "bob", "carol"})
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, entity.NewUserProfile("carol"), profiles[0], "users without a profile get the default one")
+	assert.Equal(t, "carol", profiles[0].Name())
+	assert.Same(t, bob, profiles[1])
+	assert.Equal(t, "Bob Tran", profiles[1].Name())
+
+	_, err = uc.List(context.Background(), []string{"a/b"})
+	assert.ErrorIs(t, err, ErrUsernameInvalid)
+}
+
+func TestUserProfileUsecase_Update(t *testing.T) {
+	uc, profileRepo := newUserProfileTestUsecase(t)
+	ctx := context.Background()
+	profileRepo.EXPECT().ListByUsernames(mock.Anything, []string{"alice"}).Return(nil, nil)
+	str := func(s string) *string { return &s }
+
+	profileRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Once()
+	profile, err := uc.Update(ctx, "alice", UpdateUserProfileRequest{DisplayName: str("  Alice Nguyen "), Timezone: str("Asia/Ho_Chi_Minh")})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice Nguyen", profile.DisplayName)
+	assert.Equal(t, "Asia/Ho_Chi_Minh", profile.Timezone)
+
+	profileRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil).Once()
+	profile, err = uc.Update(ctx, "alice", UpdateUserProfileRequest{Timezone: str("")})
+	require.NoError(t, err)
+	assert.Equal(t, entity.DefaultTimezone, profile.Timezone, "an empty timezone resets it")
+
+	_, err = uc.Update(ctx, "alice", UpdateUserProfileRequest{Timezone: str("Mars/Olympus")})
+	assert.ErrorIs(t, err, ErrTimezoneInvalid)
+	_, err = uc.Update(ctx, "alice", UpdateUserProfileRequest{Timezone: str("Local")})
+	assert.ErrorIs(t, err, ErrTimezoneInvalid)
+	_, err = uc.Update(ctx, "alice", UpdateUserProfileRequest{DisplayName: str(strings.Repeat("a", 256))})
+	assert.ErrorIs(t, err, ErrDisplayNameTooLong)
+}
+
+func TestUserProfileUsecase_Avatar(t *testing.T) {
+	uc, profileRepo := newUserProfileTestUsecase(t)
+	ctx := context.Background()
+	uc.now = func() time.Time { return time.Unix(1700000000, 0) }
+	profile := entity.NewUserProfile("alice")
+	profileRepo.EXPECT().ListByUsernames(mock.Anything, []string{"alice"}).Return([]*entity.UserProfile{profile}, nil)
+	profileRepo.EXPECT().Save(mock.Anything, profile).Return(nil)
+	upload := func(content string) (*entity.UserProfile, error) {
+		return uc.UploadAvatar(ctx, UploadAvatarRequest{Username: "alice", Size: int64(len(content)), Content: strings.NewReader(content)})
+	}
+
+	_, _, err := uc.OpenAvatar(ctx, "alice")
+	assert.ErrorIs(t, err, ErrAvatarNotFound)
+	assert.Empty(t, uc.AvatarURL(profile))
+
+	_, err = upload("")
+	assert.ErrorIs(t, err, ErrAvatarEmpty)
+	_, err = upload("plain text is not an image")
+	assert.ErrorIs(t, err, ErrAvatarTypeNotAllowed)
+	_, err = upload("\x89PNG\r\n\x1a\n" + strings.Repeat("a", MaxAvatarSize))
+	assert.ErrorIs(t, err, ErrAvatarTooLarge)
+
+	_, err = upload("\x89PNG\r\n\x1a\n first")
+	require.NoError(t, err)
+	first := *profile.AvatarPath
+	_, err = upload("\x89PNG\r\n\x1a\n second")
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", profile.AvatarMimeType)
+	assert.Equal(t, "http://localhost:8098/api/v1/users/alice/avatar?v=1700000000", uc.AvatarURL(profile))
+	_, err = uc.storage.Open(ctx, first)
+	assert.ErrorIs(t, err, storage.ErrNotFound, "the replaced avatar is deleted")
+
+	_, content, err := uc.OpenAvatar(ctx, "alice")
+	require.NoError(t, err)
+	stored, _ := io.ReadAll(content)
+	content.Close()
+	assert.Equal(t, "\x89PNG\r\n\x1a\n second", string(stored))
+
+	_, err = uc.DeleteAvatar(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, profile.HasAvatar())
+	_, err = uc.DeleteAvatar(ctx, "alice")
+	assert.ErrorIs(t, err, ErrAvatarNotFound)
+}
@@ -2,8 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/auto-devs/auto-devs/internal/entity"
@@ -24,8 +29,29 @@ type WorktreeUsecase interface {
 	// previously enqueued record. It is meant to be called from the background worker.
 	ProcessWorktreeCreation(ctx context.Context, worktreeID uuid.UUID, useRemoteBranch bool) error
 	CleanupWorktreeForTask(ctx context.Context, req CleanupWorktreeRequest) error
+	// RecreateWorktreeForTask checks the task branch out again at the task's
+	// worktree path after the directory was removed, falling back to the base
+	// branch when the task branch is gone as well
+	RecreateWorktreeForTask(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error)
+	// RepairTaskWorktree checks that the task's worktree path is a usable git
+	// worktree and fixes the task when it is not
+	RepairTaskWorktree(ctx context.Context, task *entity.Task) (WorktreeRepairAction, error)
+	// CreateVariantWorktree checks out a fresh worktree from the task's base
+	// branch for one side of an executor comparison
+	CreateVariantWorktree(ctx context.Context, taskID uuid.UUID, variant string) (*VariantWorktree, error)
+	// RemoveVariantWorktree removes a comparison worktree and its branch
+	RemoveVariantWorktree(ctx context.Context, projectID uuid.UUID, worktreePath, branchName string) error
+	// AdoptVariantWorktree makes the winning comparison worktree the task
+	// worktree, removing the one the task had before
+	AdoptVariantWorktree(ctx context.Context, taskID uuid.UUID, worktreePath, branchName string) (*entity.Worktree, error)
 	GetWorktreeByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error)
 	GetWorktreesByProjectID(ctx context.Context, projectID uuid.UUID) ([]*entity.Worktree, error)
+	// ListProjectWorktreeUsage lists the project's worktrees with their task,
+	// age and size on disk, largest first
+	ListProjectWorktreeUsage(ctx context.Context, projectID uuid.UUID) ([]*WorktreeUsage, error)
+	// CleanupProjectWorktrees removes the worktrees of the given project tasks
+	// one by one and reports the outcome for each task
+	CleanupProjectWorktrees(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID, force bool) ([]*WorktreeCleanupResult, error)
 	UpdateWorktreeStatus(ctx context.Context, worktreeID uuid.UUID, status entity.WorktreeStatus) error
 
 	// Worktree validation and health monitoring
@@ -66,6 +92,54 @@ type CleanupWorktreeRequest struct {
 	Force      bool      `json:"force"`                 // Force cleanup even if worktree is active
 }
 
+// WorktreeUsage is a worktree with the task it belongs to and the space it
+// takes on disk
+type WorktreeUsage struct {
+	Worktree   *entity.Worktree
+	TaskTitle  string
+	TaskStatus entity.TaskStatus
+	Age        time.Duration
+	DiskUsage  int64 // in bytes
+	// OnDisk is false when the worktree directory no longer exists
+	OnDisk bool
+}
+
+// WorktreeCleanupResult is the outcome of removing one task's worktree in a
+// bulk cleanup
+type WorktreeCleanupResult struct {
+	TaskID         uuid.UUID `json:"task_id"`
+	Cleaned        bool      `json:"cleaned"`
+	ReclaimedBytes int64     `json:"reclaimed_bytes"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// VariantWorktree is the worktree one side of an executor comparison runs in
+type VariantWorktree struct {
+	Variant      string
+	WorktreePath string
+	BranchName   string
+}
+
+// WorktreeRepairAction is what RepairTaskWorktree did to a task
+type WorktreeRepairAction string
+
+const (
+	// WorktreeRepairNone means the worktree was fine, or is still being created
+	WorktreeRepairNone WorktreeRepairAction = "none"
+	// WorktreeRepairReconciled means the worktree was fine but the task's git
+	// status said otherwise
+	WorktreeRepairReconciled WorktreeRepairAction = "reconciled"
+	// WorktreeRepairRecreated means the worktree was checked out again
+	WorktreeRepairRecreated WorktreeRepairAction = "recreated"
+	// WorktreeRepairCleared means the stale worktree path was removed from a
+	// finished task
+	WorktreeRepairCleared WorktreeRepairAction = "cleared"
+)
+
+// worktreeCreationTimeout is how long a task may stay in the creating git
+// status before its worktree is repaired
+const worktreeCreationTimeout = time.Hour
+
 type WorktreeValidationResult struct {
 	IsValid         bool      `json:"is_valid"`
 	Errors          []string  `json:"errors,omitempty"`
@@ -104,6 +178,7 @@ type BranchInfo struct {
 }
 
 type worktreeUsecase struct {
+	projectAccess
 	worktreeRepo          repository.WorktreeRepository
 	taskRepo              repository.TaskRepository
 	projectRepo           repository.ProjectRepository
@@ -117,11 +192,13 @@ func NewWorktreeUsecase(
 	worktreeRepo repository.WorktreeRepository,
 	taskRepo repository.TaskRepository,
 	projectRepo repository.ProjectRepository,
+	memberRepo repository.ProjectMemberRepository,
 	integratedWorktreeSvc *worktreesvc.IntegratedWorktreeService,
 	gitManager *git.GitManager,
 	jobClient JobClientInterface,
 ) WorktreeUsecase {
 	return &worktreeUsecase{
+		projectAccess:         projectAccess{memberRepo: memberRepo},
 		worktreeRepo:          worktreeRepo,
 		taskRepo:              taskRepo,
 		projectRepo:           projectRepo,
@@ -134,6 +211,7 @@ func NewWorktreeUsecase(
 
 // CreateWorktreeForTask implements the basic worktree creation workflow
 func (w *worktreeUsecase) CreateWorktreeForTask(ctx context.Context, req CreateWorktreeRequest) (*entity.Worktree, error) {
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ProjectID: req.ProjectID, TaskID: req.TaskID})
 	w.logger.Info("Creating worktree for task",
 		"task_id", req.TaskID,
 		"project_id", req.ProjectID,
@@ -398,6 +476,7 @@ func (w *worktreeUsecase) ProcessWorktreeCreation(ctx context.Context, worktreeI
 
 // CleanupWorktreeForTask implements basic worktree cleanup
 func (w *worktreeUsecase) CleanupWorktreeForTask(ctx context.Context, req CleanupWorktreeRequest) error {
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ProjectID: req.ProjectID, TaskID: req.TaskID})
 	w.logger.Info("Cleaning up worktree for task",
 		"task_id", req.TaskID,
 		"project_id", req.ProjectID,
@@ -445,6 +524,276 @@ func (w *worktreeUsecase) CleanupWorktreeForTask(ctx context.Context, req Cleanu
 	return nil
 }
 
+// RecreateWorktreeForTask rebuilds a task worktree removed by cleanup or by
+// hand, so a task left without one can run again
+func (w *worktreeUsecase) RecreateWorktreeForTask(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error) {
+	task, err := w.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ProjectID: task.ProjectID, TaskID: task.ID})
+	if task.BranchName == nil || *task.BranchName == "" || task.WorktreePath == nil || *task.WorktreePath == "" {
+		return nil, ErrTaskHasNoWorktree
+	}
+
+	project, err := w.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	w.logger.Info("Recreating worktree for task",
+		"task_id", taskID,
+		"worktree_path", *task.WorktreePath,
+		"branch_name", *task.BranchName)
+
+	recreated, err := w.integratedWorktreeSvc.RecreateTaskWorktree(ctx, &worktreesvc.RecreateTaskWorktreeRequest{
+		ProjectWorkDir:      project.WorktreeBasePath,
+		WorktreePath:        *task.WorktreePath,
+		BranchName:          *task.BranchName,
+		BaseBranchName:      resolveBaseBranchName("", task.BaseBranchName),
+		InitWorkspaceScript: project.InitWorkspaceScript,
+	})
+	if errors.Is(err, worktreesvc.ErrWorktreeExists) {
+		return nil, ErrWorktreeExists
+	}
+	if err != nil {
+		if gitErr := w.updateTaskGitStatus(ctx, taskID, entity.TaskGitStatusError); gitErr != nil {
+			w.logger.Warn("Failed to update task git status to error", "error", gitErr)
+		}
+		return nil, fmt.Errorf("failed to recreate worktree: %w", err)
+	}
+
+	worktree, err := w.activeWorktreeRecord(ctx, task, recreated)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.updateTaskWithGitInfo(ctx, taskID, recreated.BranchName, recreated.WorktreePath); err != nil {
+		w.logger.Warn("Failed to update task with Git info", "error", err)
+	}
+
+	w.logger.Info("Successfully recreated worktree for task",
+		"task_id", taskID,
+		"worktree_path", recreated.WorktreePath,
+		"from_base_branch", recreated.FromBaseBranch)
+
+	return worktree, nil
+}
+
+// activeWorktreeRecord points the task's worktree record at a recreated
+// worktree. Cleanup soft deletes the record, and since a task has at most
+// one, it is restored rather than created again.
+func (w *worktreeUsecase) activeWorktreeRecord(ctx context.Context, task *entity.Task, recreated *worktreesvc.RecreatedTaskWorktree) (*entity.Worktree, error) {
+	worktree, err := w.worktreeRepo.GetByTaskID(ctx, task.ID)
+	if err != nil {
+		if restoreErr := w.worktreeRepo.RestoreByTaskID(ctx, task.ID); restoreErr == nil {
+			worktree, err = w.worktreeRepo.GetByTaskID(ctx, task.ID)
+		}
+	}
+	if err != nil {
+		worktree = &entity.Worktree{
+			TaskID:       task.ID,
+			ProjectID:    task.ProjectID,
+			BranchName:   recreated.BranchName,
+			WorktreePath: recreated.WorktreePath,
+			Status:       entity.WorktreeStatusActive,
+		}
+		if err := w.worktreeRepo.Create(ctx, worktree); err != nil {
+			return nil, fmt.Errorf("failed to create worktree record: %w", err)
+		}
+		return worktree, nil
+	}
+
+	worktree.BranchName = recreated.BranchName
+	worktree.WorktreePath = recreated.WorktreePath
+	worktree.Status = entity.WorktreeStatusActive
+	if err := w.worktreeRepo.Update(ctx, worktree); err != nil {
+		return nil, fmt.Errorf("failed to update worktree record: %w", err)
+	}
+	return worktree, nil
+}
+
+// RepairTaskWorktree reconciles the task with the state of its worktree on
+// disk. A broken worktree directory is moved aside. A missing worktree is
+// recreated for a task still in progress, and forgotten for a finished one.
+func (w *worktreeUsecase) RepairTaskWorktree(ctx context.Context, task *entity.Task) (WorktreeRepairAction, error) {
+	if task.WorktreePath == nil || *task.WorktreePath == "" {
+		return WorktreeRepairNone, nil
+	}
+	worktreePath := *task.WorktreePath
+
+	if w.gitManager.IsWorktree(ctx, worktreePath) {
+		if task.GitStatus != entity.TaskGitStatusNone && task.GitStatus != entity.TaskGitStatusError {
+			return WorktreeRepairNone, nil
+		}
+		w.logger.Info("Reconciling git status of valid worktree",
+			"task_id", task.ID, "worktree_path", worktreePath, "git_status", task.GitStatus)
+		task.GitStatus = entity.TaskGitStatusActive
+		if err := w.taskRepo.Update(ctx, task); err != nil {
+			return WorktreeRepairNone, fmt.Errorf("failed to update task git status: %w", err)
+		}
+		return WorktreeRepairReconciled, nil
+	}
+
+	// The worktree creation job may still be checking it out
+	if task.GitStatus == entity.TaskGitStatusCreating && time.Since(task.UpdatedAt) < worktreeCreationTimeout {
+		return WorktreeRepairNone, nil
+	}
+
+	if _, err := os.Stat(worktreePath); err == nil {
+		// Keep whatever is in the broken directory, in case it holds work
+		brokenPath := fmt.Sprintf("%s.broken-%d", worktreePath, time.Now().Unix())
+		w.logger.Warn("Moving broken worktree aside",
+			"task_id", task.ID, "worktree_path", worktreePath, "moved_to", brokenPath)
+		if err := os.Rename(worktreePath, brokenPath); err != nil {
+			return WorktreeRepairNone, fmt.Errorf("failed to move broken worktree aside: %w", err)
+		}
+	}
+
+	finished := task.Status == entity.TaskStatusDONE || task.Status == entity.TaskStatusCANCELLED
+	if finished || task.BranchName == nil || *task.BranchName == "" {
+		w.logger.Info("Clearing stale worktree path", "task_id", task.ID, "worktree_path", worktreePath)
+		task.WorktreePath = nil
+		task.GitStatus = entity.TaskGitStatusNone
+		if err := w.taskRepo.Update(ctx, task); err != nil {
+			return WorktreeRepairNone, fmt.Errorf("failed to clear task worktree path: %w", err)
+		}
+		if worktree, err := w.worktreeRepo.GetByTaskID(ctx, task.ID); err == nil {
+			if err := w.worktreeRepo.Delete(ctx, worktree.ID); err != nil {
+				w.logger.Warn("Failed to delete stale worktree record", "task_id", task.ID, "error", err)
+			}
+		}
+		return WorktreeRepairCleared, nil
+	}
+
+	if _, err := w.RecreateWorktreeForTask(ctx, task.ID); err != nil {
+		return WorktreeRepairNone, err
+	}
+	return WorktreeRepairRecreated, nil
+}
+
+// CreateVariantWorktree gives one side of an executor comparison its own
+// worktree and branch, so both sides start from the same base branch
+func (w *worktreeUsecase) CreateVariantWorktree(ctx context.Context, taskID uuid.UUID, variant string) (*VariantWorktree, error) {
+	task, err := w.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ProjectID: task.ProjectID, TaskID: task.ID})
+	project, err := w.projectRepo.GetByID(ctx, task.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	worktreePath, err := w.integratedWorktreeSvc.GenerateVariantWorktreePath(project.ID.String(), task.ID.String(), variant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate variant worktree path: %w", err)
+	}
+	branchName, err := w.gitManager.GenerateBranchName(task.ID.String(), task.Title)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate branch name: %w", err)
+	}
+	branchName += "-" + variant
+
+	// Start over from the base branch rather than from a previous comparison
+	if err := w.removeWorktreeCheckout(ctx, project.WorktreeBasePath, worktreePath, branchName); err != nil {
+		return nil, err
+	}
+
+	created, err := w.integratedWorktreeSvc.RecreateTaskWorktree(ctx, &worktreesvc.RecreateTaskWorktreeRequest{
+		ProjectWorkDir:      project.WorktreeBasePath,
+		WorktreePath:        worktreePath,
+		BranchName:          branchName,
+		BaseBranchName:      resolveBaseBranchName("", task.BaseBranchName),
+		InitWorkspaceScript: project.InitWorkspaceScript,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create variant worktree: %w", err)
+	}
+
+	w.logger.Info("Created variant worktree",
+		"task_id", taskID, "variant", variant, "worktree_path", created.WorktreePath, "branch_name", created.BranchName)
+	return &VariantWorktree{Variant: variant, WorktreePath: created.WorktreePath, BranchName: created.BranchName}, nil
+}
+
+// RemoveVariantWorktree removes the worktree and branch of a comparison
+// variant that lost, or is being run again
+func (w *worktreeUsecase) RemoveVariantWorktree(ctx context.Context, projectID uuid.UUID, worktreePath, branchName string) error {
+	project, err := w.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	return w.removeWorktreeCheckout(ctx, project.WorktreeBasePath, worktreePath, branchName)
+}
+
+// AdoptVariantWorktree points the task and its worktree record at the
+// winning comparison worktree. The task's previous worktree, if any, is
+// removed along with its branch.
+func (w *worktreeUsecase) AdoptVariantWorktree(ctx context.Context, taskID uuid.UUID, worktreePath, branchName string) (*entity.Worktree, error) {
+	task, err := w.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	ctx = git.WithOperationScope(ctx, git.OperationScope{ProjectID: task.ProjectID, TaskID: task.ID})
+
+	if task.WorktreePath != nil && *task.WorktreePath != "" && *task.WorktreePath != worktreePath {
+		previousBranch := ""
+		if task.BranchName != nil {
+			previousBranch = *task.BranchName
+		}
+		if err := w.RemoveVariantWorktree(ctx, task.ProjectID, *task.WorktreePath, previousBranch); err != nil {
+			w.logger.Warn("Failed to remove previous task worktree", "task_id", taskID, "worktree_path", *task.WorktreePath, "error", err)
+		}
+	}
+
+	worktree, err := w.activeWorktreeRecord(ctx, task, &worktreesvc.RecreatedTaskWorktree{
+		WorktreePath: worktreePath,
+		BranchName:   branchName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.updateTaskWithGitInfo(ctx, taskID, branchName, worktreePath); err != nil {
+		return nil, fmt.Errorf("failed to update task with git info: %w", err)
+	}
+
+	w.logger.Info("Adopted variant worktree", "task_id", taskID, "worktree_path", worktreePath, "branch_name", branchName)
+	return worktree, nil
+}
+
+// removeWorktreeCheckout removes a worktree from the project repository and
+// disk, then deletes its branch. Nothing missing is an error.
+func (w *worktreeUsecase) removeWorktreeCheckout(ctx context.Context, projectWorkDir, worktreePath, branchName string) error {
+	if _, err := os.Stat(worktreePath); err == nil {
+		if err := w.gitManager.DeleteWorktree(ctx, &git.DeleteWorktreeRequest{
+			WorkingDir:   projectWorkDir,
+			WorktreePath: worktreePath,
+		}); err != nil {
+			w.logger.Warn("Failed to delete git worktree", "worktree_path", worktreePath, "error", err)
+		}
+	}
+	if err := os.RemoveAll(worktreePath); err != nil {
+		return fmt.Errorf("failed to remove worktree directory: %w", err)
+	}
+	if err := w.gitManager.PruneWorktrees(ctx, projectWorkDir); err != nil {
+		return err
+	}
+
+	if branchName == "" {
+		return nil
+	}
+	exists, err := w.gitManager.BranchExists(ctx, projectWorkDir, branchName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := w.gitManager.DeleteBranch(ctx, projectWorkDir, branchName, true); err != nil {
+			return fmt.Errorf("failed to delete branch %s: %w", branchName, err)
+		}
+	}
+	return nil
+}
+
 // GetWorktreeByTaskID retrieves worktree information for a specific task
 func (w *worktreeUsecase) GetWorktreeByTaskID(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error) {
 	return w.worktreeRepo.GetByTaskID(ctx, taskID)
@@ -455,6 +804,93 @@ func (w *worktreeUsecase) GetWorktreesByProjectID(ctx context.Context, projectID
 	return w.worktreeRepo.GetByProjectID(ctx, projectID)
 }
 
+// ListProjectWorktreeUsage measures every worktree of the project on disk so
+// operators can see where the space goes
+func (w *worktreeUsecase) ListProjectWorktreeUsage(ctx context.Context, projectID uuid.UUID) ([]*WorktreeUsage, error) {
+	if _, err := w.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+
+	worktrees, err := w.worktreeRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+	tasks, err := w.taskRepo.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	tasksByID := make(map[uuid.UUID]*entity.Task, len(tasks))
+	for _, task := range tasks {
+		tasksByID[task.ID] = task
+	}
+
+	now := time.Now()
+	usages := make([]*WorktreeUsage, 0, len(worktrees))
+	for _, worktree := range worktrees {
+		usage := &WorktreeUsage{
+			Worktree: worktree,
+			Age:      now.Sub(worktree.CreatedAt),
+		}
+		if task, ok := tasksByID[worktree.TaskID]; ok {
+			usage.TaskTitle = task.Title
+			usage.TaskStatus = task.Status
+		}
+		if size, err := diskUsage(worktree.WorktreePath); err == nil {
+			usage.DiskUsage = size
+			usage.OnDisk = true
+		} else if !os.IsNotExist(err) {
+			w.logger.Warn("Failed to measure worktree", "worktree_path", worktree.WorktreePath, "error", err)
+		}
+		usages = append(usages, usage)
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].DiskUsage > usages[j].DiskUsage
+	})
+	return usages, nil
+}
+
+// CleanupProjectWorktrees cleans up the worktree of each task in turn. A
+// failure is recorded in the task's result and does not stop the others.
+func (w *worktreeUsecase) CleanupProjectWorktrees(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID, force bool) ([]*WorktreeCleanupResult, error) {
+	if _, err := w.projectRepo.GetByID(ctx, projectID); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectNotFound, err)
+	}
+	if err := w.authorize(ctx, projectID, entity.ProjectRoleMaintainer); err != nil {
+		return nil, err
+	}
+
+	results := make([]*WorktreeCleanupResult, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		result := &WorktreeCleanupResult{TaskID: taskID}
+		results = append(results, result)
+
+		worktree, err := w.worktreeRepo.GetByTaskID(ctx, taskID)
+		if err != nil {
+			result.Error = ErrTaskHasNoWorktree.Error()
+			continue
+		}
+		if worktree.ProjectID != projectID {
+			result.Error = "worktree belongs to another project"
+			continue
+		}
+
+		size, _ := diskUsage(worktree.WorktreePath)
+		if err := w.CleanupWorktreeForTask(ctx, CleanupWorktreeRequest{
+			TaskID:     taskID,
+			ProjectID:  projectID,
+			BranchName: worktree.BranchName,
+			Force:      force,
+		}); err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		result.Cleaned = true
+		result.ReclaimedBytes = size
+	}
+	return results, nil
+}
+
 // UpdateWorktreeStatus updates the status of a worktree
 func (w *worktreeUsecase) UpdateWorktreeStatus(ctx context.Context, worktreeID uuid.UUID, status entity.WorktreeStatus) error {
 	worktree, err := w.worktreeRepo.GetByID(ctx, worktreeID)
@@ -801,6 +1237,26 @@ func (w *worktreeUsecase) updateTaskGitStatus(ctx context.Context, taskID uuid.U
 	return w.taskRepo.Update(ctx, task)
 }
 
+// diskUsage returns the total size in bytes of the regular files under path
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
+
 func (w *worktreeUsecase) calculateHealthScore(health *WorktreeHealthInfo) int {
 	score := 100
 
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/auto-devs/auto-devs/internal/repository"
+	"github.com/auto-devs/auto-devs/internal/service/git"
+	worktreesvc "github.com/auto-devs/auto-devs/internal/service/worktree"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecreateTestUsecase(t *testing.T) (*worktreeUsecase, *repository.WorktreeRepositoryMock, *repository.TaskRepositoryMock, *repository.ProjectRepositoryMock) {
+	integrated, err := worktreesvc.NewIntegratedWorktreeService(&worktreesvc.IntegratedConfig{
+		Worktree: &config.WorktreeConfig{BaseDirectory: t.TempDir(), MaxPathLength: 1024},
+		Git:      &git.ManagerConfig{},
+	})
+	require.NoError(t, err)
+	gitManager, err := git.NewGitManager(nil)
+	require.NoError(t, err)
+
+	worktreeRepo := repository.NewWorktreeRepositoryMock(t)
+	taskRepo := repository.NewTaskRepositoryMock(t)
+	projectRepo := repository.NewProjectRepositoryMock(t)
+	uc := NewWorktreeUsecase(worktreeRepo, taskRepo, projectRepo, nil, integrated, gitManager, nil).(*worktreeUsecase)
+	return uc, worktreeRepo, taskRepo, projectRepo
+}
+
+// initTaskWorktree creates a repository with a task worktree checked out on
+// its own branch
+func initTaskWorktree(t *testing.T) (repo, branch, worktreePath string) {
+	repo = t.TempDir()
+	runGit(t, repo, "init", "-q", "-b", "main")
+	runGit(t, repo, "config", "user.email", "test@example.com")
+	runGit(t, repo, "config", "user.name", "Test User")
+	runGit(t, repo, "commit", "-q", "--allow-empty", "-m", "Initial commit")
+	branch = "task-123-add-login"
+	worktreePath = filepath.Join(t.TempDir(), "task-123")
+	runGit(t, repo, "worktree", "add", "-q", "-b", branch, worktreePath)
+	return repo, branch, worktreePath
+}
+
+func TestRecreateWorktreeForTask(t *testing.T) {
+	uc, worktreeRepo, taskRepo, projectRepo := newRecreateTestUsecase(t)
+	ctx := context.Background()
+
+	repo, branch, worktreePath := initTaskWorktree(t)
+	// Removed by cleanup: the directory is gone along with the record
+	require.NoError(t, os.RemoveAll(worktreePath))
+
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING, BranchName: &branch, WorktreePath: &worktreePath}
+	record := &entity.Worktree{ID: uuid.New(), TaskID: task.ID, ProjectID: task.ProjectID, BranchName: branch, WorktreePath: worktreePath, Status: entity.WorktreeStatusCleaning}
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	projectRepo.EXPECT().GetByID(mock.Anything, task.ProjectID).Return(&entity.Project{ID: task.ProjectID, WorktreeBasePath: repo}, nil).Once()
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(nil, assert.AnError).Once()
+	worktreeRepo.EXPECT().RestoreByTaskID(mock.Anything, task.ID).Return(nil).Once()
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(record, nil).Once()
+	worktreeRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(w *entity.Worktree) bool {
+		return w.ID == record.ID && w.Status == entity.WorktreeStatusActive
+	})).Return(nil).Once()
+	taskRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *entity.Task) bool {
+		return t.GitStatus == entity.TaskGitStatusActive
+	})).Return(nil).Once()
+
+	worktree, err := uc.RecreateWorktreeForTask(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, record.ID, worktree.ID)
+	assert.FileExists(t, filepath.Join(worktreePath, ".git"))
+
+	t.Run("still on disk", func(t *testing.T) {
+		projectRepo.EXPECT().GetByID(mock.Anything, task.ProjectID).Return(&entity.Project{ID: task.ProjectID, WorktreeBasePath: repo}, nil).Once()
+
+		_, err := uc.RecreateWorktreeForTask(ctx, task.ID)
+		assert.ErrorIs(t, err, ErrWorktreeExists)
+	})
+}
+
+func TestRecreateWorktreeForTask_NoWorktree(t *testing.T) {
+	uc, _, taskRepo, _ := newRecreateTestUsecase(t)
+	taskID := uuid.New()
+
+	taskRepo.EXPECT().GetByID(mock.Anything, taskID).Return(&entity.Task{ID: taskID}, nil).Once()
+	_, err := uc.RecreateWorktreeForTask(context.Background(), taskID)
+	assert.ErrorIs(t, err, ErrTaskHasNoWorktree)
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepairTaskWorktree_Valid(t *testing.T) {
+	uc, _, taskRepo, _ := newRecreateTestUsecase(t)
+	_, branch, worktreePath := initTaskWorktree(t)
+	task := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING, BranchName: &branch, WorktreePath: &worktreePath, GitStatus: entity.TaskGitStatusActive}
+
+	action, err := uc.RepairTaskWorktree(context.Background(), task)
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeRepairNone, action)
+
+	task.GitStatus = entity.TaskGitStatusError
+	taskRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *entity.Task) bool {
+		return t.GitStatus == entity.TaskGitStatusActive
+	})).Return(nil).Once()
+
+	action, err = uc.RepairTaskWorktree(context.Background(), task)
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeRepairReconciled, action)
+}
+
+func TestRepairTaskWorktree_StillCreating(t *testing.T) {
+	uc, _, _, _ := newRecreateTestUsecase(t)
+	worktreePath := filepath.Join(t.TempDir(), "task-123")
+	task := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING, WorktreePath: &worktreePath, GitStatus: entity.TaskGitStatusCreating, UpdatedAt: time.Now()}
+
+	action, err := uc.RepairTaskWorktree(context.Background(), task)
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeRepairNone, action)
+}
+
+func TestRepairTaskWorktree_ClearsFinishedTask(t *testing.T) {
+	uc, worktreeRepo, taskRepo, _ := newRecreateTestUsecase(t)
+	_, branch, worktreePath := initTaskWorktree(t)
+	require.NoError(t, os.RemoveAll(worktreePath))
+	task := &entity.Task{ID: uuid.New(), Status: entity.TaskStatusDONE, BranchName: &branch, WorktreePath: &worktreePath, GitStatus: entity.TaskGitStatusCompleted}
+	record := &entity.Worktree{ID: uuid.New(), TaskID: task.ID}
+
+	taskRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(t *entity.Task) bool {
+		return t.WorktreePath == nil && t.GitStatus == entity.TaskGitStatusNone
+	})).Return(nil).Once()
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(record, nil).Once()
+	worktreeRepo.EXPECT().Delete(mock.Anything, record.ID).Return(nil).Once()
+
+	action, err := uc.RepairTaskWorktree(context.Background(), task)
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeRepairCleared, action)
+}
+
+func TestRepairTaskWorktree_RecreatesBrokenWorktree(t *testing.T) {
+	uc, worktreeRepo, taskRepo, projectRepo := newRecreateTestUsecase(t)
+	repo, branch, worktreePath := initTaskWorktree(t)
+	// The worktree's administrative files were pruned, leaving an unusable
+	// checkout with uncommitted work in it
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "wip.txt"), []byte("wip\n"), 0o644))
+	runGit(t, repo, "worktree", "remove", "--force", worktreePath)
+	require.NoError(t, os.MkdirAll(worktreePath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, ".git"), []byte("gitdir: /nonexistent\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "wip.txt"), []byte("wip\n"), 0o644))
+
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: entity.TaskStatusIMPLEMENTING, BranchName: &branch, WorktreePath: &worktreePath, GitStatus: entity.TaskGitStatusActive}
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	projectRepo.EXPECT().GetByID(mock.Anything, task.ProjectID).Return(&entity.Project{ID: task.ProjectID, WorktreeBasePath: repo}, nil).Once()
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, task.ID).Return(&entity.Worktree{ID: uuid.New(), TaskID: task.ID}, nil).Once()
+	worktreeRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+	taskRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil).Once()
+
+	action, err := uc.RepairTaskWorktree(context.Background(), task)
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeRepairRecreated, action)
+	assert.True(t, uc.gitManager.IsWorktree(context.Background(), worktreePath))
+
+	broken, err := filepath.Glob(worktreePath + ".broken-*")
+	require.NoError(t, err)
+	require.Len(t, broken, 1)
+	assert.FileExists(t, filepath.Join(broken[0], "wip.txt"), "the broken worktree is kept")
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListProjectWorktreeUsage(t *testing.T) {
+	uc, worktreeRepo, taskRepo, projectRepo := newRecreateTestUsecase(t)
+	projectID := uuid.New()
+
+	small := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(small, "main.go"), make([]byte, 10), 0o644))
+	large := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(large, "pkg"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(large, "main.go"), make([]byte, 100), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(large, "pkg", "util.go"), make([]byte, 50), 0o644))
+
+	task := &entity.Task{ID: uuid.New(), ProjectID: projectID, Title: "Add login page", Status: entity.TaskStatusDONE}
+	worktrees := []*entity.Worktree{
+		{ID: uuid.New(), TaskID: task.ID, ProjectID: projectID, WorktreePath: small, CreatedAt: time.Now().Add(-2 * time.Hour)},
+		{ID: uuid.New(), TaskID: uuid.New(), ProjectID: projectID, WorktreePath: large, CreatedAt: time.Now()},
+		{ID: uuid.New(), TaskID: uuid.New(), ProjectID: projectID, WorktreePath: filepath.Join(small, "removed"), CreatedAt: time.Now()},
+	}
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil).Once()
+	worktreeRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return(worktrees, nil).Once()
+	taskRepo.EXPECT().GetByProjectID(mock.Anything, projectID).Return([]*entity.Task{task}, nil).Once()
+
+	usages, err := uc.ListProjectWorktreeUsage(context.Background(), projectID)
+	require.NoError(t, err)
+	require.Len(t, usages, 3)
+
+	assert.Equal(t, worktrees[1].ID, usages[0].Worktree.ID, "largest first")
+	assert.Equal(t, int64(150), usages[0].DiskUsage)
+	assert.True(t, usages[0].OnDisk)
+
+	assert.Equal(t, worktrees[0].ID, usages[1].Worktree.ID)
+	assert.Equal(t, int64(10), usages[1].DiskUsage)
+	assert.Equal(t, "Add login page", usages[1].TaskTitle)
+	assert.Equal(t, entity.TaskStatusDONE, usages[1].TaskStatus)
+	assert.GreaterOrEqual(t, usages[1].Age, 2*time.Hour)
+
+	assert.False(t, usages[2].OnDisk)
+	assert.Zero(t, usages[2].DiskUsage)
+}
+
+func TestListProjectWorktreeUsage_ProjectNotFound(t *testing.T) {
+	uc, _, _, projectRepo := newRecreateTestUsecase(t)
+	projectID := uuid.New()
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(nil, assert.AnError).Once()
+	_, err := uc.ListProjectWorktreeUsage(context.Background(), projectID)
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+}
+
+func TestCleanupProjectWorktrees_ReportsEachTask(t *testing.T) {
+	uc, worktreeRepo, _, projectRepo := newRecreateTestUsecase(t)
+	projectID := uuid.New()
+	missing, otherProject, active := uuid.New(), uuid.New(), uuid.New()
+
+	projectRepo.EXPECT().GetByID(mock.Anything, projectID).Return(&entity.Project{ID: projectID}, nil)
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, missing).Return(nil, assert.AnError)
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, otherProject).Return(&entity.Worktree{TaskID: otherProject, ProjectID: uuid.New()}, nil)
+	worktreeRepo.EXPECT().GetByTaskID(mock.Anything, active).Return(&entity.Worktree{TaskID: active, ProjectID: projectID, Status: entity.WorktreeStatusActive}, nil)
+
+	results, err := uc.CleanupProjectWorktrees(context.Background(), projectID, []uuid.UUID{missing, otherProject, active}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for i, taskID := range []uuid.UUID{missing, otherProject, active} {
+		assert.Equal(t, taskID, results[i].TaskID)
+		assert.False(t, results[i].Cleaned)
+	}
+	assert.Equal(t, ErrTaskHasNoWorktree.Error(), results[0].Error)
+	assert.Equal(t, "worktree belongs to another project", results[1].Error)
+	assert.Contains(t, results[2].Error, "without force flag")
+}
@@ -39,6 +39,124 @@ func (_m *WorktreeUsecaseMock) EXPECT() *WorktreeUsecaseMock_Expecter {
 	return &WorktreeUsecaseMock_Expecter{mock: &_m.Mock}
 }
 
+// AdoptVariantWorktree provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) AdoptVariantWorktree(ctx context.Context, taskID uuid.UUID, worktreePath string, branchName string) (*entity.Worktree, error) {
+	ret := _mock.Called(ctx, taskID, worktreePath, branchName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdoptVariantWorktree")
+	}
+
+	var r0 *entity.Worktree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (*entity.Worktree, error)); ok {
+		return returnFunc(ctx, taskID, worktreePath, branchName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) *entity.Worktree); ok {
+		r0 = returnFunc(ctx, taskID, worktreePath, branchName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Worktree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = returnFunc(ctx, taskID, worktreePath, branchName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_AdoptVariantWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdoptVariantWorktree'
+type WorktreeUsecaseMock_AdoptVariantWorktree_Call struct {
+	*mock.Call
+}
+
+// AdoptVariantWorktree is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - worktreePath
+//   - branchName
+func (_e *WorktreeUsecaseMock_Expecter) AdoptVariantWorktree(ctx interface{}, taskID interface{}, worktreePath interface{}, branchName interface{}) *WorktreeUsecaseMock_AdoptVariantWorktree_Call {
+	return &WorktreeUsecaseMock_AdoptVariantWorktree_Call{Call: _e.mock.On("AdoptVariantWorktree", ctx, taskID, worktreePath, branchName)}
+}
+
+func (_c *WorktreeUsecaseMock_AdoptVariantWorktree_Call) Run(run func(ctx context.Context, taskID uuid.UUID, worktreePath string, branchName string)) *WorktreeUsecaseMock_AdoptVariantWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_AdoptVariantWorktree_Call) Return(worktree1 *entity.Worktree, err error) *WorktreeUsecaseMock_AdoptVariantWorktree_Call {
+	_c.Call.Return(worktree1, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_AdoptVariantWorktree_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, worktreePath string, branchName string) (*entity.Worktree, error)) *WorktreeUsecaseMock_AdoptVariantWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CleanupProjectWorktrees provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) CleanupProjectWorktrees(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID, force bool) ([]*WorktreeCleanupResult, error) {
+	ret := _mock.Called(ctx, projectID, taskIDs, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupProjectWorktrees")
+	}
+
+	var r0 []*WorktreeCleanupResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, bool) ([]*WorktreeCleanupResult, error)); ok {
+		return returnFunc(ctx, projectID, taskIDs, force)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []uuid.UUID, bool) []*WorktreeCleanupResult); ok {
+		r0 = returnFunc(ctx, projectID, taskIDs, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WorktreeCleanupResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, []uuid.UUID, bool) error); ok {
+		r1 = returnFunc(ctx, projectID, taskIDs, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_CleanupProjectWorktrees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CleanupProjectWorktrees'
+type WorktreeUsecaseMock_CleanupProjectWorktrees_Call struct {
+	*mock.Call
+}
+
+// CleanupProjectWorktrees is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - taskIDs
+//   - force
+func (_e *WorktreeUsecaseMock_Expecter) CleanupProjectWorktrees(ctx interface{}, projectID interface{}, taskIDs interface{}, force interface{}) *WorktreeUsecaseMock_CleanupProjectWorktrees_Call {
+	return &WorktreeUsecaseMock_CleanupProjectWorktrees_Call{Call: _e.mock.On("CleanupProjectWorktrees", ctx, projectID, taskIDs, force)}
+}
+
+func (_c *WorktreeUsecaseMock_CleanupProjectWorktrees_Call) Run(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID, force bool)) *WorktreeUsecaseMock_CleanupProjectWorktrees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]uuid.UUID), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_CleanupProjectWorktrees_Call) Return(worktreeCleanupResults []*WorktreeCleanupResult, err error) *WorktreeUsecaseMock_CleanupProjectWorktrees_Call {
+	_c.Call.Return(worktreeCleanupResults, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_CleanupProjectWorktrees_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, taskIDs []uuid.UUID, force bool) ([]*WorktreeCleanupResult, error)) *WorktreeUsecaseMock_CleanupProjectWorktrees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CleanupWorktreeForTask provides a mock function for the type WorktreeUsecaseMock
 func (_mock *WorktreeUsecaseMock) CleanupWorktreeForTask(ctx context.Context, req CleanupWorktreeRequest) error {
 	ret := _mock.Called(ctx, req)
@@ -179,6 +297,64 @@ func (_c *WorktreeUsecaseMock_CreateBranchForTask_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// CreateVariantWorktree provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) CreateVariantWorktree(ctx context.Context, taskID uuid.UUID, variant string) (*VariantWorktree, error) {
+	ret := _mock.Called(ctx, taskID, variant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariantWorktree")
+	}
+
+	var r0 *VariantWorktree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*VariantWorktree, error)); ok {
+		return returnFunc(ctx, taskID, variant)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *VariantWorktree); ok {
+		r0 = returnFunc(ctx, taskID, variant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VariantWorktree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = returnFunc(ctx, taskID, variant)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_CreateVariantWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVariantWorktree'
+type WorktreeUsecaseMock_CreateVariantWorktree_Call struct {
+	*mock.Call
+}
+
+// CreateVariantWorktree is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+//   - variant
+func (_e *WorktreeUsecaseMock_Expecter) CreateVariantWorktree(ctx interface{}, taskID interface{}, variant interface{}) *WorktreeUsecaseMock_CreateVariantWorktree_Call {
+	return &WorktreeUsecaseMock_CreateVariantWorktree_Call{Call: _e.mock.On("CreateVariantWorktree", ctx, taskID, variant)}
+}
+
+func (_c *WorktreeUsecaseMock_CreateVariantWorktree_Call) Run(run func(ctx context.Context, taskID uuid.UUID, variant string)) *WorktreeUsecaseMock_CreateVariantWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_CreateVariantWorktree_Call) Return(variantWorktree *VariantWorktree, err error) *WorktreeUsecaseMock_CreateVariantWorktree_Call {
+	_c.Call.Return(variantWorktree, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_CreateVariantWorktree_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID, variant string) (*VariantWorktree, error)) *WorktreeUsecaseMock_CreateVariantWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorktreeForTask provides a mock function for the type WorktreeUsecaseMock
 func (_mock *WorktreeUsecaseMock) CreateWorktreeForTask(ctx context.Context, req CreateWorktreeRequest) (*entity.Worktree, error) {
 	ret := _mock.Called(ctx, req)
@@ -726,6 +902,63 @@ func (_c *WorktreeUsecaseMock_InitializeWorktree_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// ListProjectWorktreeUsage provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) ListProjectWorktreeUsage(ctx context.Context, projectID uuid.UUID) ([]*WorktreeUsage, error) {
+	ret := _mock.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectWorktreeUsage")
+	}
+
+	var r0 []*WorktreeUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*WorktreeUsage, error)); ok {
+		return returnFunc(ctx, projectID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*WorktreeUsage); ok {
+		r0 = returnFunc(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*WorktreeUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_ListProjectWorktreeUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectWorktreeUsage'
+type WorktreeUsecaseMock_ListProjectWorktreeUsage_Call struct {
+	*mock.Call
+}
+
+// ListProjectWorktreeUsage is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+func (_e *WorktreeUsecaseMock_Expecter) ListProjectWorktreeUsage(ctx interface{}, projectID interface{}) *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call {
+	return &WorktreeUsecaseMock_ListProjectWorktreeUsage_Call{Call: _e.mock.On("ListProjectWorktreeUsage", ctx, projectID)}
+}
+
+func (_c *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call) Run(run func(ctx context.Context, projectID uuid.UUID)) *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call) Return(worktreeUsages []*WorktreeUsage, err error) *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call {
+	_c.Call.Return(worktreeUsages, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID) ([]*WorktreeUsage, error)) *WorktreeUsecaseMock_ListProjectWorktreeUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessWorktreeCreation provides a mock function for the type WorktreeUsecaseMock
 func (_mock *WorktreeUsecaseMock) ProcessWorktreeCreation(ctx context.Context, worktreeID uuid.UUID, useRemoteBranch bool) error {
 	ret := _mock.Called(ctx, worktreeID, useRemoteBranch)
@@ -819,6 +1052,166 @@ func (_c *WorktreeUsecaseMock_RecoverFailedWorktree_Call) RunAndReturn(run func(
 	return _c
 }
 
+// RecreateWorktreeForTask provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) RecreateWorktreeForTask(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error) {
+	ret := _mock.Called(ctx, taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecreateWorktreeForTask")
+	}
+
+	var r0 *entity.Worktree
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Worktree, error)); ok {
+		return returnFunc(ctx, taskID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Worktree); ok {
+		r0 = returnFunc(ctx, taskID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Worktree)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, taskID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_RecreateWorktreeForTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecreateWorktreeForTask'
+type WorktreeUsecaseMock_RecreateWorktreeForTask_Call struct {
+	*mock.Call
+}
+
+// RecreateWorktreeForTask is a helper method to define mock.On call
+//   - ctx
+//   - taskID
+func (_e *WorktreeUsecaseMock_Expecter) RecreateWorktreeForTask(ctx interface{}, taskID interface{}) *WorktreeUsecaseMock_RecreateWorktreeForTask_Call {
+	return &WorktreeUsecaseMock_RecreateWorktreeForTask_Call{Call: _e.mock.On("RecreateWorktreeForTask", ctx, taskID)}
+}
+
+func (_c *WorktreeUsecaseMock_RecreateWorktreeForTask_Call) Run(run func(ctx context.Context, taskID uuid.UUID)) *WorktreeUsecaseMock_RecreateWorktreeForTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RecreateWorktreeForTask_Call) Return(worktree1 *entity.Worktree, err error) *WorktreeUsecaseMock_RecreateWorktreeForTask_Call {
+	_c.Call.Return(worktree1, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RecreateWorktreeForTask_Call) RunAndReturn(run func(ctx context.Context, taskID uuid.UUID) (*entity.Worktree, error)) *WorktreeUsecaseMock_RecreateWorktreeForTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveVariantWorktree provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) RemoveVariantWorktree(ctx context.Context, projectID uuid.UUID, worktreePath string, branchName string) error {
+	ret := _mock.Called(ctx, projectID, worktreePath, branchName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveVariantWorktree")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = returnFunc(ctx, projectID, worktreePath, branchName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WorktreeUsecaseMock_RemoveVariantWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveVariantWorktree'
+type WorktreeUsecaseMock_RemoveVariantWorktree_Call struct {
+	*mock.Call
+}
+
+// RemoveVariantWorktree is a helper method to define mock.On call
+//   - ctx
+//   - projectID
+//   - worktreePath
+//   - branchName
+func (_e *WorktreeUsecaseMock_Expecter) RemoveVariantWorktree(ctx interface{}, projectID interface{}, worktreePath interface{}, branchName interface{}) *WorktreeUsecaseMock_RemoveVariantWorktree_Call {
+	return &WorktreeUsecaseMock_RemoveVariantWorktree_Call{Call: _e.mock.On("RemoveVariantWorktree", ctx, projectID, worktreePath, branchName)}
+}
+
+func (_c *WorktreeUsecaseMock_RemoveVariantWorktree_Call) Run(run func(ctx context.Context, projectID uuid.UUID, worktreePath string, branchName string)) *WorktreeUsecaseMock_RemoveVariantWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RemoveVariantWorktree_Call) Return(err error) *WorktreeUsecaseMock_RemoveVariantWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RemoveVariantWorktree_Call) RunAndReturn(run func(ctx context.Context, projectID uuid.UUID, worktreePath string, branchName string) error) *WorktreeUsecaseMock_RemoveVariantWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RepairTaskWorktree provides a mock function for the type WorktreeUsecaseMock
+func (_mock *WorktreeUsecaseMock) RepairTaskWorktree(ctx context.Context, task *entity.Task) (WorktreeRepairAction, error) {
+	ret := _mock.Called(ctx, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairTaskWorktree")
+	}
+
+	var r0 WorktreeRepairAction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Task) (WorktreeRepairAction, error)); ok {
+		return returnFunc(ctx, task)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.Task) WorktreeRepairAction); ok {
+		r0 = returnFunc(ctx, task)
+	} else {
+		r0 = ret.Get(0).(WorktreeRepairAction)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *entity.Task) error); ok {
+		r1 = returnFunc(ctx, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorktreeUsecaseMock_RepairTaskWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepairTaskWorktree'
+type WorktreeUsecaseMock_RepairTaskWorktree_Call struct {
+	*mock.Call
+}
+
+// RepairTaskWorktree is a helper method to define mock.On call
+//   - ctx
+//   - task
+func (_e *WorktreeUsecaseMock_Expecter) RepairTaskWorktree(ctx interface{}, task interface{}) *WorktreeUsecaseMock_RepairTaskWorktree_Call {
+	return &WorktreeUsecaseMock_RepairTaskWorktree_Call{Call: _e.mock.On("RepairTaskWorktree", ctx, task)}
+}
+
+func (_c *WorktreeUsecaseMock_RepairTaskWorktree_Call) Run(run func(ctx context.Context, task *entity.Task)) *WorktreeUsecaseMock_RepairTaskWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Task))
+	})
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RepairTaskWorktree_Call) Return(worktreeRepairAction WorktreeRepairAction, err error) *WorktreeUsecaseMock_RepairTaskWorktree_Call {
+	_c.Call.Return(worktreeRepairAction, err)
+	return _c
+}
+
+func (_c *WorktreeUsecaseMock_RepairTaskWorktree_Call) RunAndReturn(run func(ctx context.Context, task *entity.Task) (WorktreeRepairAction, error)) *WorktreeUsecaseMock_RepairTaskWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SwitchToBranch provides a mock function for the type WorktreeUsecaseMock
 func (_mock *WorktreeUsecaseMock) SwitchToBranch(ctx context.Context, worktreeID uuid.UUID, branchName string) error {
 	ret := _mock.Called(ctx, worktreeID, branchName)
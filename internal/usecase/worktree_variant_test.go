@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndRemoveVariantWorktree(t *testing.T) {
+	uc, _, taskRepo, projectRepo := newRecreateTestUsecase(t)
+	ctx := context.Background()
+
+	repo, _, _ := initTaskWorktree(t)
+	task := &entity.Task{ID: uuid.New(), ProjectID: uuid.New(), Title: "Add login"}
+	project := &entity.Project{ID: task.ProjectID, WorktreeBasePath: repo}
+	taskRepo.EXPECT().GetByID(mock.Anything, task.ID).Return(task, nil)
+	projectRepo.EXPECT().GetByID(mock.Anything, task.ProjectID).Return(project, nil)
+
+	a, err := uc.CreateVariantWorktree(ctx, task.ID, "a")
+	require.NoError(t, err)
+	b, err := uc.CreateVariantWorktree(ctx, task.ID, "b")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, a.WorktreePath, b.WorktreePath)
+	assert.Equal(t, "variants", filepath.Base(filepath.Dir(a.WorktreePath)))
+	for _, branch := range []string{a.BranchName, b.BranchName} {
+		exists, err := uc.gitManager.BranchExists(ctx, repo, branch)
+		require.NoError(t, err)
+		assert.True(t, exists, branch)
+	}
+	assert.FileExists(t, filepath.Join(a.WorktreePath, ".git"))
+
+	// Running the comparison again starts the variant over
+	again, err := uc.CreateVariantWorktree(ctx, task.ID, "a")
+	require.NoError(t, err)
+	assert.Equal(t, a.WorktreePath, again.WorktreePath)
+
+	require.NoError(t, uc.RemoveVariantWorktree(ctx, task.ProjectID, b.WorktreePath, b.BranchName))
+	assert.NoDirExists(t, b.WorktreePath)
+	exists, err := uc.gitManager.BranchExists(ctx, repo, b.BranchName)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -45,7 +45,7 @@ func (h *Handler) GetWebSocketHandler() gin.HandlerFunc {
 		// Check if server is ready
 		if h.server == nil || h.server.node == nil {
 			log.Printf("WebSocket server not ready")
-			c.JSON(503, gin.H{"error": "WebSocket server not ready"})
+			c.JSON(http.StatusServiceUnavailable, newErrorResponse(http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "WebSocket server not ready", nil))
 			return
 		}
 
@@ -82,13 +82,13 @@ func (h *Handler) BroadcastMessage(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, newErrorResponse(http.StatusBadRequest, "INVALID_REQUEST", "Invalid request data", err))
 		return
 	}
 
 	message, err := NewMessage(request.Type, request.Data)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create message"})
+		c.JSON(http.StatusInternalServerError, newErrorResponse(http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create message", err))
 		return
 	}
 
@@ -106,3 +106,18 @@ func (h *Handler) BroadcastMessage(c *gin.Context) {
 		"type":    request.Type,
 	})
 }
+
+// newErrorResponse builds the API's standard error body (dto.ErrorResponse).
+// The dto package depends on this one, so the shape is reproduced here.
+func newErrorResponse(status int, errorCode, message string, err error) gin.H {
+	errMsg := message
+	if err != nil {
+		errMsg = err.Error()
+	}
+	return gin.H{
+		"error":      errMsg,
+		"message":    message,
+		"code":       status,
+		"error_code": errorCode,
+	}
+}
@@ -5,14 +5,32 @@ import (
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/centrifugal/centrifuge"
 	"github.com/google/uuid"
 )
 
+// maxBufferedPublishes bounds the messages held while the broker is
+// unreachable; past it the oldest are dropped
+const maxBufferedPublishes = 1000
+
 // Hub maintains the set of active connections and broadcasts messages to them
 type Hub struct {
 	node *centrifuge.Node
+	// publish sends a message to the subscribers of a channel, through the
+	// Redis broker when one is set
+	publish func(channel string, data []byte) error
+
+	// buffered holds the messages that could not be published, oldest
+	// first, until the broker can be reached again
+	buffered   []bufferedPublish
+	bufferSeq  uint64
+	flushing   bool
+	bufferMu   sync.Mutex
+	retryMin   time.Duration
+	retryMax   time.Duration
+	shutdownCh chan struct{}
 
 	// Metrics
 	metrics *HubMetrics
@@ -21,6 +39,13 @@ type Hub struct {
 	mu sync.RWMutex
 }
 
+// bufferedPublish is a message waiting for the broker
+type bufferedPublish struct {
+	seq     uint64
+	channel string
+	data    []byte
+}
+
 // BroadcastMessage represents a message to be broadcasted
 type BroadcastMessage struct {
 	Message     *Message
@@ -49,8 +74,15 @@ type HubMetrics struct {
 // NewHub creates a new Hub
 func NewHub(node *centrifuge.Node) *Hub {
 	hub := &Hub{
-		node:    node,
-		metrics: &HubMetrics{},
+		node: node,
+		publish: func(channel string, data []byte) error {
+			_, err := node.Publish(channel, data, publishOptions(channel)...)
+			return err
+		},
+		retryMin:   time.Second,
+		retryMax:   30 * time.Second,
+		shutdownCh: make(chan struct{}),
+		metrics:    &HubMetrics{},
 	}
 
 	return hub
@@ -87,7 +119,88 @@ func (h *Hub) Broadcast(message *Message, projectID *uuid.UUID, userID *string,
 		log.Printf("Error converting message to bytes: %v", err)
 		return
 	}
-	h.node.Publish(channel, messageBytes)
+
+	// Publish behind the buffered messages, so clients get them in order
+	if h.BufferedCount() > 0 {
+		h.buffer(channel, messageBytes)
+		return
+	}
+	if err := h.publish(channel, messageBytes); err != nil {
+		log.Printf("Failed to publish to %s, buffering until the broker is reachable: %v", channel, err)
+		h.buffer(channel, messageBytes)
+	}
+}
+
+// BufferedCount returns how many messages wait for the broker
+func (h *Hub) BufferedCount() int {
+	h.bufferMu.Lock()
+	defer h.bufferMu.Unlock()
+	return len(h.buffered)
+}
+
+// buffer holds a message until the broker can be reached, dropping the
+// oldest one when the buffer is full
+func (h *Hub) buffer(channel string, data []byte) {
+	h.bufferMu.Lock()
+	defer h.bufferMu.Unlock()
+
+	if len(h.buffered) >= maxBufferedPublishes {
+		log.Printf("Publish buffer is full, dropping the oldest message to %s", h.buffered[0].channel)
+		h.buffered = h.buffered[1:]
+	}
+	h.bufferSeq++
+	h.buffered = append(h.buffered, bufferedPublish{seq: h.bufferSeq, channel: channel, data: data})
+
+	if !h.flushing {
+		h.flushing = true
+		go h.flushBuffered()
+	}
+}
+
+// flushBuffered publishes the buffered messages in order once the broker
+// can be reached, retrying with backoff until the buffer is empty or the
+// hub shuts down
+func (h *Hub) flushBuffered() {
+	delay := h.retryMin
+	for {
+		select {
+		case <-h.shutdownCh:
+			return
+		case <-time.After(delay):
+		}
+
+		if h.flush() {
+			return
+		}
+		delay = min(delay*2, h.retryMax)
+	}
+}
+
+// flush publishes the buffered messages until the buffer is empty, which it
+// reports, or a publish fails
+func (h *Hub) flush() bool {
+	for {
+		h.bufferMu.Lock()
+		if len(h.buffered) == 0 {
+			h.flushing = false
+			h.bufferMu.Unlock()
+			return true
+		}
+		next := h.buffered[0]
+		h.bufferMu.Unlock()
+
+		if err := h.publish(next.channel, next.data); err != nil {
+			log.Printf("Broker still unreachable, %d messages buffered: %v", h.BufferedCount(), err)
+			return false
+		}
+
+		h.bufferMu.Lock()
+		// The message may have been dropped from a full buffer meanwhile
+		if len(h.buffered) > 0 && h.buffered[0].seq == next.seq {
+			h.buffered = h.buffered[1:]
+		}
+		h.bufferMu.Unlock()
+	}
 }
 
 // BroadcastToProject sends a message to all connections subscribed to a project
@@ -114,6 +227,7 @@ func (h *Hub) GetMetrics() HubMetrics {
 
 // Shutdown gracefully shuts down the hub and closes all connections
 func (h *Hub) Shutdown() {
+	close(h.shutdownCh)
 	h.node.Shutdown(context.Background())
 	log.Printf("Hub shutdown complete")
 }
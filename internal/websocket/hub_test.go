@@ -0,0 +1,95 @@
+package websocket
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBroker records the messages published while it is up
+type fakeBroker struct {
+	mu        sync.Mutex
+	down      bool
+	published []string
+}
+
+func (b *fakeBroker) publish(channel string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.down {
+		return errors.New("redis: connection refused")
+	}
+	b.published = append(b.published, string(data))
+	return nil
+}
+
+func (b *fakeBroker) setDown(down bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.down = down
+}
+
+func (b *fakeBroker) messages() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.published...)
+}
+
+func newTestHub(broker *fakeBroker) *Hub {
+	return &Hub{
+		publish:    broker.publish,
+		retryMin:   time.Millisecond,
+		retryMax:   5 * time.Millisecond,
+		shutdownCh: make(chan struct{}),
+		metrics:    &HubMetrics{},
+	}
+}
+
+func broadcastText(t *testing.T, hub *Hub, projectID uuid.UUID, text string) string {
+	message, err := NewMessage(TaskUpdated, text)
+	require.NoError(t, err)
+	hub.BroadcastToProject(message, projectID, nil)
+	data, err := message.ToBytes()
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestHub_BuffersWhileTheBrokerIsDown(t *testing.T) {
+	broker := &fakeBroker{down: true}
+	hub := newTestHub(broker)
+	defer close(hub.shutdownCh)
+	projectID := uuid.New()
+
+	first := broadcastText(t, hub, projectID, "first")
+	second := broadcastText(t, hub, projectID, "second")
+	assert.Equal(t, 2, hub.BufferedCount())
+	assert.Empty(t, broker.messages())
+
+	broker.setDown(false)
+	assert.Eventually(t, func() bool { return hub.BufferedCount() == 0 }, time.Second, time.Millisecond)
+	third := broadcastText(t, hub, projectID, "third")
+
+	assert.Equal(t, []string{first, second, third}, broker.messages())
+}
+
+func TestHub_DropsTheOldestWhenTheBufferIsFull(t *testing.T) {
+	broker := &fakeBroker{down: true}
+	hub := newTestHub(broker)
+	close(hub.shutdownCh)
+	projectID := uuid.New()
+
+	first := broadcastText(t, hub, projectID, "first")
+	for i := 0; i < maxBufferedPublishes; i++ {
+		broadcastText(t, hub, projectID, "later")
+	}
+
+	assert.Equal(t, maxBufferedPublishes, hub.BufferedCount())
+	hub.bufferMu.Lock()
+	defer hub.bufferMu.Unlock()
+	assert.NotEqual(t, first, string(hub.buffered[0].data))
+}
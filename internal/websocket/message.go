@@ -46,6 +46,24 @@ const (
 
 	// Execution logs updated
 	ExecutionLogsCreated MessageType = "execution_logs_created"
+
+	// Verification pipeline step started or finished
+	VerificationProgress MessageType = "verification_progress"
+
+	// Project AI spend reached one of its budget alert thresholds
+	BudgetThresholdReached MessageType = "budget_threshold_reached"
+
+	// Progress of an epic changed with one of its tasks
+	EpicProgressUpdated MessageType = "epic_progress_updated"
+
+	// Daily standup summary of a project was written
+	DailyStandup MessageType = "daily_standup"
+
+	// AI asked the user a question and its execution waits for the answer
+	ExecutionWaitingInput MessageType = "execution_waiting_input"
+
+	// Log lines an AI execution just printed, for clients tailing its output
+	MessageTypeExecutionLog MessageType = "execution_log"
 )
 
 // Message represents a WebSocket message
@@ -80,6 +98,46 @@ type StatusData struct {
 	ProjectID  uuid.UUID `json:"project_id"`
 }
 
+// EpicProgressData represents the progress of an epic after one of its
+// tasks changed
+type EpicProgressData struct {
+	EpicID    uuid.UUID   `json:"epic_id"`
+	ProjectID uuid.UUID   `json:"project_id"`
+	Progress  interface{} `json:"progress"`
+}
+
+// DailyStandupData represents the daily standup summary of a project
+type DailyStandupData struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	// Date is the day summarized
+	Date    string `json:"date"`
+	Summary string `json:"summary"`
+}
+
+// ExecutionQuestionData represents the question an execution waits on
+type ExecutionQuestionData struct {
+	ExecutionID uuid.UUID `json:"execution_id"`
+	TaskID      uuid.UUID `json:"task_id"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	Question    string    `json:"question"`
+}
+
+// ExecutionLogData represents log lines an execution of a task printed
+type ExecutionLogData struct {
+	ExecutionID uuid.UUID   `json:"execution_id"`
+	TaskID      uuid.UUID   `json:"task_id"`
+	ProjectID   uuid.UUID   `json:"project_id"`
+	Logs        interface{} `json:"logs"`
+}
+
+// StreamSubscriptionData opens a project's event stream. Resumed is false
+// when the messages since the client's last event were not all replayed,
+// so the client reloads what it shows.
+type StreamSubscriptionData struct {
+	ProjectID uuid.UUID `json:"project_id"`
+	Resumed   bool      `json:"resumed"`
+}
+
 // UserPresenceData represents user presence message data
 type UserPresenceData struct {
 	UserID    string    `json:"user_id"`
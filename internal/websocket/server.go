@@ -8,10 +8,29 @@ import (
 
 	"github.com/auto-devs/auto-devs/config"
 	"github.com/centrifugal/centrifuge"
+	"github.com/google/uuid"
 )
 
 type Server struct {
 	node *centrifuge.Node
+	// authorizer, when set, authenticates connections and checks their
+	// subscriptions to project channels. It is set before connections are
+	// served.
+	authorizer Authorizer
+}
+
+// AnonymousUserID is the user ID of connections without a token
+const AnonymousUserID = "anonymous"
+
+// Authorizer decides who connects and which projects' events they receive
+type Authorizer interface {
+	// AuthenticateConnection returns the user ID a connection's token
+	// authenticates, AnonymousUserID for connections without one, or an
+	// error refusing the connection
+	AuthenticateConnection(ctx context.Context, token string) (string, error)
+	// AuthorizeProjectSubscription returns an error unless the user may
+	// receive the events of the project
+	AuthorizeProjectSubscription(ctx context.Context, userID string, projectID uuid.UUID) error
 }
 
 type UserInfo struct {
@@ -46,14 +65,31 @@ func NewServer(appConfig *config.CentrifugeRedisBrokerConfig) (*Server, error) {
 	// Try to setup Redis broker, but don't fail if it doesn't work
 	setupRedisBroker(node, appConfig)
 
+	server := &Server{node: node}
+
 	node.OnConnecting(func(ctx context.Context, e centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
+		// Event streams connect as the user the API authenticated
+		if credentials, ok := centrifuge.GetCredentials(ctx); ok {
+			return centrifuge.ConnectReply{Credentials: credentials}, nil
+		}
+		if server.authorizer != nil {
+			userID, err := server.authorizer.AuthenticateConnection(ctx, e.Token)
+			if err != nil {
+				log.Printf("Refusing WebSocket connection: %v", err)
+				return centrifuge.ConnectReply{}, centrifuge.DisconnectInvalidToken
+			}
+			return centrifuge.ConnectReply{
+				Credentials: &centrifuge.Credentials{UserID: userID},
+			}, nil
+		}
+
 		log.Println("on connecting", e.Token)
 		claims, err := parseJwtToken(e.Token)
 		if err != nil {
 			log.Printf("Failed to parse JWT token: %v, using anonymous user", err)
 			return centrifuge.ConnectReply{
 				Credentials: &centrifuge.Credentials{
-					UserID: "anonymous",
+					UserID: AnonymousUserID,
 				},
 			}, nil
 		}
@@ -90,6 +126,20 @@ func NewServer(appConfig *config.CentrifugeRedisBrokerConfig) (*Server, error) {
 				}
 				log.Printf("user %s subscribed to private channel %s", client.UserID(), e.Channel)
 				cb(centrifuge.SubscribeReply{}, nil)
+			} else if projectID, ok := strings.CutPrefix(e.Channel, "project:"); ok && server.authorizer != nil {
+				// Project channels carry the events of one project, only
+				// for the users who may see it
+				id, err := uuid.Parse(projectID)
+				if err != nil {
+					cb(centrifuge.SubscribeReply{}, centrifuge.ErrorBadRequest)
+					return
+				}
+				if err := server.authorizer.AuthorizeProjectSubscription(client.Context(), client.UserID(), id); err != nil {
+					log.Printf("[%s] error adding subscription: %v", e.Channel, err)
+					cb(centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied)
+					return
+				}
+				cb(centrifuge.SubscribeReply{}, nil)
 			} else {
 				// Handle specific channels like task_created, task_updated, task_deleted
 				switch e.Channel {
@@ -120,7 +170,13 @@ func NewServer(appConfig *config.CentrifugeRedisBrokerConfig) (*Server, error) {
 	})
 
 	log.Printf("WebSocket server created successfully")
-	return &Server{node: node}, nil
+	return server, nil
+}
+
+// SetAuthorizer has connections authenticated and their subscriptions to
+// project channels checked by authorizer
+func (s *Server) SetAuthorizer(authorizer Authorizer) {
+	s.authorizer = authorizer
 }
 
 func setupRedisBroker(node *centrifuge.Node, appConfig *config.CentrifugeRedisBrokerConfig) {
@@ -1,6 +1,8 @@
 package websocket
 
 import (
+	"context"
+	"errors"
 	"log"
 	"log/slog"
 	"os"
@@ -80,6 +82,23 @@ func (s *Service) GetHandler() *Handler {
 	return s.handler
 }
 
+// SetAuthorizer has the server's connections and project subscriptions
+// checked by authorizer
+func (s *Service) SetAuthorizer(authorizer Authorizer) {
+	if s.handler != nil && s.handler.server != nil {
+		s.handler.server.SetAuthorizer(authorizer)
+	}
+}
+
+// StreamProject streams the messages of a project to a client that cannot
+// use WebSocket, see Server.StreamProject
+func (s *Service) StreamProject(ctx context.Context, userID string, projectID uuid.UUID, lastEventID string) (*ProjectStream, error) {
+	if s.handler == nil || s.handler.server == nil {
+		return nil, errors.New("WebSocket server not ready")
+	}
+	return s.handler.server.StreamProject(ctx, userID, projectID, lastEventID)
+}
+
 // GetHub returns the WebSocket hub
 func (s *Service) GetHub() *Hub {
 	return s.hub
@@ -114,6 +133,46 @@ func (s *Service) NotifyTaskDeleted(taskID, projectID uuid.UUID) error {
 	return s.taskProcessor.BroadcastTaskDeleted(taskID, projectID, nil)
 }
 
+// NotifyEpicProgressUpdated notifies about the progress of an epic after
+// one of its tasks changed
+func (s *Service) NotifyEpicProgressUpdated(epicID, projectID uuid.UUID, progress interface{}) error {
+	return s.SendProjectMessage(projectID, EpicProgressUpdated, EpicProgressData{
+		EpicID:    epicID,
+		ProjectID: projectID,
+		Progress:  progress,
+	})
+}
+
+// NotifyDailyStandup sends the daily standup summary of a project for day
+func (s *Service) NotifyDailyStandup(projectID uuid.UUID, day time.Time, summary string) error {
+	return s.SendProjectMessage(projectID, DailyStandup, DailyStandupData{
+		ProjectID: projectID,
+		Date:      day.Format("2006-01-02"),
+		Summary:   summary,
+	})
+}
+
+// NotifyExecutionWaitingInput sends the question an execution of a task
+// stopped on, waiting for the user's answer
+func (s *Service) NotifyExecutionWaitingInput(executionID, taskID, projectID uuid.UUID, question string) error {
+	return s.SendProjectMessage(projectID, ExecutionWaitingInput, ExecutionQuestionData{
+		ExecutionID: executionID,
+		TaskID:      taskID,
+		ProjectID:   projectID,
+		Question:    question,
+	})
+}
+
+// NotifyExecutionLog sends log lines an execution of a task printed
+func (s *Service) NotifyExecutionLog(executionID, taskID, projectID uuid.UUID, logs interface{}) error {
+	return s.SendProjectMessage(projectID, MessageTypeExecutionLog, ExecutionLogData{
+		ExecutionID: executionID,
+		TaskID:      taskID,
+		ProjectID:   projectID,
+		Logs:        logs,
+	})
+}
+
 // Project event methods
 
 // NotifyProjectUpdated notifies about a project update
@@ -182,6 +241,12 @@ func (s *Service) IsHealthy() bool {
 	return true
 }
 
+// BufferedNotifications returns how many notifications wait for the Redis
+// broker to be reachable again
+func (s *Service) BufferedNotifications() int {
+	return s.hub.BufferedCount()
+}
+
 // GetHealthStatus returns detailed health status
 func (s *Service) GetHealthStatus() map[string]interface{} {
 	metrics := s.hub.GetMetrics()
@@ -192,6 +257,7 @@ func (s *Service) GetHealthStatus() map[string]interface{} {
 		"total_connections":  metrics.TotalConnections,
 		"messages_sent":      metrics.MessagesSent,
 		"messages_received":  metrics.MessagesReceived,
+		"buffered_messages":  s.hub.BufferedCount(),
 		"uptime":             time.Since(time.Now()).String(), // This would need to track actual start time
 		"timestamp":          time.Now(),
 	}
@@ -0,0 +1,33 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_NotifyExecutionLog(t *testing.T) {
+	broker := &fakeBroker{}
+	service := &Service{hub: newTestHub(broker)}
+	executionID, taskID, projectID := uuid.New(), uuid.New(), uuid.New()
+
+	require.NoError(t, service.NotifyExecutionLog(executionID, taskID, projectID, []map[string]interface{}{{"line": 1, "message": "Reading main.go"}}))
+
+	published := broker.messages()
+	require.Len(t, published, 1)
+	message, err := FromBytes([]byte(published[0]))
+	require.NoError(t, err)
+	assert.Equal(t, MessageTypeExecutionLog, message.Type)
+
+	var data struct {
+		ExecutionID uuid.UUID                `json:"execution_id"`
+		TaskID      uuid.UUID                `json:"task_id"`
+		Logs        []map[string]interface{} `json:"logs"`
+	}
+	require.NoError(t, message.ParseData(&data))
+	assert.Equal(t, executionID, data.ExecutionID)
+	assert.Equal(t, taskID, data.TaskID)
+	assert.Equal(t, "Reading main.go", data.Logs[0]["message"])
+}
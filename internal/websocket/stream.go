@@ -0,0 +1,338 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/google/uuid"
+)
+
+const (
+	// projectHistorySize and projectHistoryTTL bound the messages kept per
+	// project for the event streams resuming after a disconnect
+	projectHistorySize = 1000
+	projectHistoryTTL  = 15 * time.Minute
+
+	// streamBufferSize bounds the messages waiting for a slow event stream
+	// client; past it the stream is closed and the client resumes
+	streamBufferSize = 256
+)
+
+// ErrInvalidStreamPosition is returned for a Last-Event-ID this server did
+// not send
+var ErrInvalidStreamPosition = errors.New("invalid last event ID")
+
+// StreamEvent is one message of a project's event stream
+type StreamEvent struct {
+	// ID is the position of the message in the project's stream; the
+	// stream resumes after it
+	ID   string
+	Type MessageType
+	// Data is the message as WebSocket clients get it
+	Data []byte
+}
+
+// ProjectStream delivers a project's messages to a client that cannot use
+// WebSocket, such as a Server-Sent Events response
+type ProjectStream struct {
+	events    chan StreamEvent
+	done      chan struct{}
+	closeOnce sync.Once
+	closeFn   func()
+}
+
+// Events returns the stream's messages, in order
+func (s *ProjectStream) Events() <-chan StreamEvent {
+	return s.events
+}
+
+// Done is closed when the stream ends, e.g. when the server shuts down or
+// the client fell too far behind
+func (s *ProjectStream) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close ends the stream
+func (s *ProjectStream) Close() {
+	s.closeOnce.Do(func() {
+		s.closeFn()
+	})
+}
+
+// publishOptions keeps the history of project channels, which event
+// streams resume from
+func publishOptions(channel string) []centrifuge.PublishOption {
+	if strings.HasPrefix(channel, "project:") {
+		return []centrifuge.PublishOption{centrifuge.WithHistory(projectHistorySize, projectHistoryTTL)}
+	}
+	return nil
+}
+
+// StreamProject streams the messages of a project the user may see. The
+// stream starts with a Subscription message telling whether it resumed
+// after lastEventID; when it did not, e.g. for a new stream or a position
+// too old to resume from, the client reloads what it shows. The messages
+// are those WebSocket clients get, through the same broker.
+func (s *Server) StreamProject(ctx context.Context, userID string, projectID uuid.UUID, lastEventID string) (*ProjectStream, error) {
+	var since *centrifuge.StreamPosition
+	if lastEventID != "" {
+		position, err := parseStreamPosition(lastEventID)
+		if err != nil {
+			return nil, err
+		}
+		since = &position
+	}
+
+	channel := "project:" + projectID.String()
+	transport := newStreamTransport()
+	// The client was authenticated and authorized by the API
+	ctx = centrifuge.SetCredentials(ctx, &centrifuge.Credentials{UserID: userID})
+	client, closeClient, err := centrifuge.NewClient(ctx, s.node, transport)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stream client: %w", err)
+	}
+	if err := client.ConnectNoErrorToDisconnect(centrifuge.ConnectRequest{}); err != nil {
+		_ = closeClient()
+		return nil, fmt.Errorf("failed to connect stream client: %w", err)
+	}
+	// Subscribe before reading the history, so no message falls between
+	// the two
+	if err := client.Subscribe(channel); err != nil {
+		_ = closeClient()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", channel, err)
+	}
+
+	replay, top, resumed, err := s.streamHistory(channel, since)
+	if err != nil {
+		_ = closeClient()
+		return nil, err
+	}
+
+	stream := &ProjectStream{
+		events: make(chan StreamEvent, streamBufferSize),
+		done:   make(chan struct{}),
+	}
+	stop := make(chan struct{})
+	stream.closeFn = func() {
+		close(stop)
+		_ = closeClient()
+	}
+
+	go func() {
+		defer close(stream.done)
+		send := func(event StreamEvent) bool {
+			select {
+			case stream.events <- event:
+				return true
+			case <-stop:
+				return false
+			case <-transport.closed:
+				return false
+			}
+		}
+
+		opened, err := NewMessage(Subscription, StreamSubscriptionData{ProjectID: projectID, Resumed: resumed})
+		if err != nil {
+			return
+		}
+		openedID := top
+		if resumed {
+			openedID = *since
+		}
+		data, _ := opened.ToBytes()
+		if !send(StreamEvent{ID: formatStreamPosition(openedID), Type: Subscription, Data: data}) {
+			return
+		}
+		for _, publication := range replay {
+			event, ok := streamEvent(publication.Data, publication.Offset, top.Epoch)
+			if ok && !send(event) {
+				return
+			}
+		}
+
+		// Messages published before the history was read were replayed
+		replayed := top.Offset
+		for {
+			select {
+			case <-stop:
+				return
+			case <-transport.closed:
+				return
+			case publication := <-transport.publications:
+				if publication.Offset <= replayed {
+					continue
+				}
+				replayed = 0
+				event, ok := streamEvent(publication.Data, publication.Offset, top.Epoch)
+				if ok && !send(event) {
+					return
+				}
+			}
+		}
+	}()
+
+	return stream, nil
+}
+
+// streamHistory returns the messages of channel after since and the
+// position of the channel's last message. resumed is false when the
+// messages after since are no longer all kept.
+func (s *Server) streamHistory(channel string, since *centrifuge.StreamPosition) ([]*centrifuge.Publication, centrifuge.StreamPosition, bool, error) {
+	if since == nil {
+		history, err := s.node.History(channel, centrifuge.WithHistoryMetaTTL(projectHistoryTTL))
+		if err != nil {
+			return nil, centrifuge.StreamPosition{}, false, fmt.Errorf("failed to read %s history: %w", channel, err)
+		}
+		return nil, history.StreamPosition, false, nil
+	}
+
+	history, err := s.node.History(channel,
+		centrifuge.WithSince(since),
+		centrifuge.WithLimit(centrifuge.NoLimit),
+		centrifuge.WithHistoryMetaTTL(projectHistoryTTL))
+	if errors.Is(err, centrifuge.ErrorUnrecoverablePosition) {
+		history, err = s.node.History(channel, centrifuge.WithHistoryMetaTTL(projectHistoryTTL))
+		if err != nil {
+			return nil, centrifuge.StreamPosition{}, false, fmt.Errorf("failed to read %s history: %w", channel, err)
+		}
+		return nil, history.StreamPosition, false, nil
+	}
+	if err != nil {
+		return nil, centrifuge.StreamPosition{}, false, fmt.Errorf("failed to read %s history: %w", channel, err)
+	}
+
+	resumed := history.Epoch == since.Epoch
+	if resumed && len(history.Publications) > 0 {
+		resumed = history.Publications[0].Offset == since.Offset+1
+	} else if resumed {
+		resumed = history.Offset == since.Offset
+	}
+	if !resumed {
+		return nil, history.StreamPosition, false, nil
+	}
+	return history.Publications, history.StreamPosition, true, nil
+}
+
+// streamEvent returns the event of a message published at offset
+func streamEvent(data []byte, offset uint64, epoch string) (StreamEvent, bool) {
+	message, err := FromBytes(data)
+	if err != nil {
+		log.Printf("Skipping a project message the event stream cannot read: %v", err)
+		return StreamEvent{}, false
+	}
+	return StreamEvent{
+		ID:   formatStreamPosition(centrifuge.StreamPosition{Offset: offset, Epoch: epoch}),
+		Type: message.Type,
+		Data: data,
+	}, true
+}
+
+// formatStreamPosition and parseStreamPosition convert a position in a
+// project's stream to and from an event ID
+func formatStreamPosition(position centrifuge.StreamPosition) string {
+	return position.Epoch + ":" + strconv.FormatUint(position.Offset, 10)
+}
+
+func parseStreamPosition(id string) (centrifuge.StreamPosition, error) {
+	epoch, offset, ok := strings.Cut(id, ":")
+	if !ok || epoch == "" {
+		return centrifuge.StreamPosition{}, fmt.Errorf("%w: %q", ErrInvalidStreamPosition, id)
+	}
+	n, err := strconv.ParseUint(offset, 10, 64)
+	if err != nil {
+		return centrifuge.StreamPosition{}, fmt.Errorf("%w: %q", ErrInvalidStreamPosition, id)
+	}
+	return centrifuge.StreamPosition{Offset: n, Epoch: epoch}, nil
+}
+
+// streamPublication is a message published to the channel of a stream
+type streamPublication struct {
+	Data   json.RawMessage `json:"data"`
+	Offset uint64          `json:"offset"`
+}
+
+// streamTransport is the unidirectional transport of a ProjectStream's
+// client. It hands the publications of the client's channel over to the
+// stream and ignores the other pushes.
+type streamTransport struct {
+	publications chan streamPublication
+	closed       chan struct{}
+	closeOnce    sync.Once
+}
+
+func newStreamTransport() *streamTransport {
+	return &streamTransport{
+		publications: make(chan streamPublication, streamBufferSize),
+		closed:       make(chan struct{}),
+	}
+}
+
+func (t *streamTransport) Name() string {
+	return "sse"
+}
+
+func (t *streamTransport) Protocol() centrifuge.ProtocolType {
+	return centrifuge.ProtocolTypeJSON
+}
+
+func (t *streamTransport) ProtocolVersion() centrifuge.ProtocolVersion {
+	return centrifuge.ProtocolVersion2
+}
+
+func (t *streamTransport) Unidirectional() bool {
+	return true
+}
+
+func (t *streamTransport) Emulation() bool {
+	return false
+}
+
+func (t *streamTransport) DisabledPushFlags() uint64 {
+	return 0
+}
+
+// PingPongConfig disables pings; the event stream sends its own keepalives
+func (t *streamTransport) PingPongConfig() centrifuge.PingPongConfig {
+	return centrifuge.PingPongConfig{PingInterval: -1, PongTimeout: -1}
+}
+
+func (t *streamTransport) Write(data []byte) error {
+	var push struct {
+		Pub *streamPublication `json:"pub"`
+	}
+	if err := json.Unmarshal(data, &push); err != nil || push.Pub == nil {
+		return nil
+	}
+	select {
+	case t.publications <- *push.Pub:
+		return nil
+	case <-t.closed:
+		return nil
+	default:
+		return errors.New("event stream client is too slow")
+	}
+}
+
+func (t *streamTransport) WriteMany(data ...[]byte) error {
+	for _, d := range data {
+		if err := t.Write(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *streamTransport) Close(centrifuge.Disconnect) error {
+	t.closeOnce.Do(func() {
+		close(t.closed)
+	})
+	return nil
+}
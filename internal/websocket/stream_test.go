@@ -0,0 +1,115 @@
+package websocket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStreamServer(t *testing.T) (*Server, *Hub) {
+	node, err := centrifuge.New(centrifuge.Config{})
+	require.NoError(t, err)
+	node.OnConnecting(func(ctx context.Context, e centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
+		credentials, _ := centrifuge.GetCredentials(ctx)
+		return centrifuge.ConnectReply{Credentials: credentials}, nil
+	})
+	node.OnConnect(func(client *centrifuge.Client) {})
+	require.NoError(t, node.Run())
+	t.Cleanup(func() { _ = node.Shutdown(context.Background()) })
+	return &Server{node: node}, NewHub(node)
+}
+
+func nextStreamEvent(t *testing.T, stream *ProjectStream) StreamEvent {
+	t.Helper()
+	select {
+	case event := <-stream.Events():
+		return event
+	case <-time.After(2 * time.Second):
+		t.Fatal("no stream event")
+		return StreamEvent{}
+	}
+}
+
+func streamText(t *testing.T, event StreamEvent) string {
+	t.Helper()
+	message, err := FromBytes(event.Data)
+	require.NoError(t, err)
+	var text string
+	require.NoError(t, message.ParseData(&text))
+	return text
+}
+
+func streamSubscription(t *testing.T, event StreamEvent) StreamSubscriptionData {
+	t.Helper()
+	require.Equal(t, Subscription, event.Type)
+	message, err := FromBytes(event.Data)
+	require.NoError(t, err)
+	var data StreamSubscriptionData
+	require.NoError(t, message.ParseData(&data))
+	return data
+}
+
+func publishText(t *testing.T, hub *Hub, projectID uuid.UUID, text string) {
+	t.Helper()
+	message, err := NewMessage(TaskUpdated, text)
+	require.NoError(t, err)
+	hub.BroadcastToProject(message, projectID, nil)
+}
+
+func TestServer_StreamProject_Resume(t *testing.T) {
+	server, hub := newTestStreamServer(t)
+	projectID := uuid.New()
+
+	stream, err := server.StreamProject(context.Background(), "alice", projectID, "")
+	require.NoError(t, err)
+	opened := nextStreamEvent(t, stream)
+	assert.Equal(t, StreamSubscriptionData{ProjectID: projectID, Resumed: false}, streamSubscription(t, opened))
+
+	publishText(t, hub, projectID, "first")
+	first := nextStreamEvent(t, stream)
+	assert.Equal(t, TaskUpdated, first.Type)
+	assert.Equal(t, "first", streamText(t, first))
+	assert.NotEqual(t, opened.ID, first.ID)
+	stream.Close()
+
+	// Missed while disconnected
+	publishText(t, hub, projectID, "second")
+	publishText(t, hub, projectID, "third")
+	publishText(t, hub, uuid.New(), "other project")
+
+	resumed, err := server.StreamProject(context.Background(), "alice", projectID, first.ID)
+	require.NoError(t, err)
+	defer resumed.Close()
+	assert.True(t, streamSubscription(t, nextStreamEvent(t, resumed)).Resumed)
+	assert.Equal(t, "second", streamText(t, nextStreamEvent(t, resumed)))
+	third := nextStreamEvent(t, resumed)
+	assert.Equal(t, "third", streamText(t, third))
+
+	publishText(t, hub, projectID, "live")
+	live := nextStreamEvent(t, resumed)
+	assert.Equal(t, "live", streamText(t, live))
+	assert.NotEqual(t, third.ID, live.ID)
+}
+
+func TestServer_StreamProject_CannotResume(t *testing.T) {
+	server, hub := newTestStreamServer(t)
+	projectID := uuid.New()
+	publishText(t, hub, projectID, "first")
+
+	// A position of another stream, e.g. from before a broker restart
+	stream, err := server.StreamProject(context.Background(), "alice", projectID, "gone:1")
+	require.NoError(t, err)
+	defer stream.Close()
+	assert.False(t, streamSubscription(t, nextStreamEvent(t, stream)).Resumed, "the client reloads instead")
+
+	publishText(t, hub, projectID, "second")
+	assert.Equal(t, "second", streamText(t, nextStreamEvent(t, stream)))
+
+	_, err = server.StreamProject(context.Background(), "alice", projectID, "not-a-position")
+	assert.ErrorIs(t, err, ErrInvalidStreamPosition)
+}
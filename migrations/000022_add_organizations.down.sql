@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_projects_organization_id;
+ALTER TABLE projects DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_project_jira_issue_key;
+ALTER TABLE tasks DROP COLUMN IF EXISTS jira_issue_key;
+
+DROP TABLE IF EXISTS jira_integrations;
+DROP TABLE IF EXISTS project_secrets;
@@ -0,0 +1,32 @@
+-- Encrypted per-project secrets (API tokens for external integrations).
+-- Values are sealed by the application; the database only sees ciphertext.
+CREATE TABLE IF NOT EXISTS project_secrets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    ciphertext TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_project_secrets_project_name ON project_secrets(project_id, name);
+
+-- At most one Jira integration per project
+CREATE TABLE IF NOT EXISTS jira_integrations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    base_url VARCHAR(500) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    jql TEXT NOT NULL,
+    status_mapping JSONB NOT NULL DEFAULT '{}',
+    transition_mapping JSONB NOT NULL DEFAULT '{}',
+    sync_status_back BOOLEAN NOT NULL DEFAULT FALSE,
+    last_imported_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_jira_integrations_project_id ON jira_integrations(project_id);
+
+ALTER TABLE tasks ADD COLUMN jira_issue_key VARCHAR(64);
+CREATE UNIQUE INDEX idx_tasks_project_jira_issue_key ON tasks(project_id, jira_issue_key) WHERE jira_issue_key IS NOT NULL AND deleted_at IS NULL;
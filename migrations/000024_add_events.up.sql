@@ -0,0 +1,13 @@
+-- Append-only feed of task events for polling integrations (Zapier, n8n).
+-- The sequential id doubles as the polling cursor.
+CREATE TABLE IF NOT EXISTS events (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(64) NOT NULL,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    task_id UUID,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_events_project_id ON events(project_id, id);
+CREATE INDEX IF NOT EXISTS idx_events_type ON events(type, id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS task_commits;
+
+DROP INDEX IF EXISTS idx_tasks_number;
+ALTER TABLE tasks DROP COLUMN IF EXISTS number;
@@ -0,0 +1,21 @@
+-- Short sequential task numbers, referenced as AD-<number> in commit messages
+CREATE SEQUENCE IF NOT EXISTS tasks_number_seq;
+ALTER TABLE tasks ADD COLUMN number BIGINT NOT NULL DEFAULT nextval('tasks_number_seq');
+ALTER SEQUENCE tasks_number_seq OWNED BY tasks.number;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tasks_number ON tasks(number);
+
+-- Pushed commits whose message references a task
+CREATE TABLE IF NOT EXISTS task_commits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    sha VARCHAR(40) NOT NULL,
+    message TEXT NOT NULL,
+    author_name VARCHAR(255),
+    author_email VARCHAR(255),
+    url VARCHAR(500),
+    branch VARCHAR(255),
+    committed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_task_commits_task_sha ON task_commits(task_id, sha);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tasks_project_github_project_item;
+ALTER TABLE tasks DROP COLUMN IF EXISTS github_project_item_id;
@@ -0,0 +1,2 @@
+ALTER TABLE tasks ADD COLUMN github_project_item_id VARCHAR(100);
+CREATE UNIQUE INDEX idx_tasks_project_github_project_item ON tasks(project_id, github_project_item_id) WHERE github_project_item_id IS NOT NULL AND deleted_at IS NULL;
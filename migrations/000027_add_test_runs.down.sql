@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS test_runs;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS test_failure_policy;
+ALTER TABLE projects DROP COLUMN IF EXISTS test_command;
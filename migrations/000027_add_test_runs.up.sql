@@ -0,0 +1,22 @@
+-- Test command run in the worktree after an implementation, before the PR
+ALTER TABLE projects ADD COLUMN test_command TEXT;
+ALTER TABLE projects ADD COLUMN test_failure_policy VARCHAR(20);
+
+COMMENT ON COLUMN projects.test_command IS 'Bash command run in the task worktree after implementation to verify the changes';
+COMMENT ON COLUMN projects.test_failure_policy IS 'What a failing test command does to the pull request: block (default) or flag';
+
+CREATE TABLE IF NOT EXISTS test_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    execution_id UUID NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    command TEXT NOT NULL,
+    passed BOOLEAN NOT NULL,
+    exit_code INTEGER,
+    output TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_runs_execution_id ON test_runs(execution_id);
+CREATE INDEX IF NOT EXISTS idx_test_runs_task_id ON test_runs(task_id);
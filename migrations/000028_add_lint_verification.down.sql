@@ -0,0 +1,9 @@
+DELETE FROM verification_runs WHERE step <> 'test';
+ALTER TABLE verification_runs DROP COLUMN IF EXISTS fixes_committed;
+ALTER TABLE verification_runs DROP COLUMN IF EXISTS step;
+ALTER INDEX IF EXISTS idx_verification_runs_task_id RENAME TO idx_test_runs_task_id;
+ALTER INDEX IF EXISTS idx_verification_runs_execution_id RENAME TO idx_test_runs_execution_id;
+ALTER TABLE verification_runs RENAME TO test_runs;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS auto_commit_lint_fixes;
+ALTER TABLE projects DROP COLUMN IF EXISTS lint_command;
@@ -0,0 +1,13 @@
+-- Lint/format command run in the worktree after an implementation
+ALTER TABLE projects ADD COLUMN lint_command TEXT;
+ALTER TABLE projects ADD COLUMN auto_commit_lint_fixes BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN projects.lint_command IS 'Bash command run in the task worktree after implementation to lint or format the changes';
+COMMENT ON COLUMN projects.auto_commit_lint_fixes IS 'Commit the files changed by lint_command instead of discarding them';
+
+-- Test runs become the runs of any verification step
+ALTER TABLE test_runs RENAME TO verification_runs;
+ALTER INDEX IF EXISTS idx_test_runs_execution_id RENAME TO idx_verification_runs_execution_id;
+ALTER INDEX IF EXISTS idx_test_runs_task_id RENAME TO idx_verification_runs_task_id;
+ALTER TABLE verification_runs ADD COLUMN step VARCHAR(20) NOT NULL DEFAULT 'test';
+ALTER TABLE verification_runs ADD COLUMN fixes_committed BOOLEAN NOT NULL DEFAULT FALSE;
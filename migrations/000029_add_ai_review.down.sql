@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS review_comments;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS post_review_comments;
+ALTER TABLE projects DROP COLUMN IF EXISTS ai_review_enabled;
@@ -0,0 +1,21 @@
+-- AI review of the implementation diff before the PR is opened
+ALTER TABLE projects ADD COLUMN ai_review_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE projects ADD COLUMN post_review_comments BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN projects.ai_review_enabled IS 'Run an AI review of the implementation diff before opening the pull request';
+COMMENT ON COLUMN projects.post_review_comments IS 'Post the AI review findings as a review on the pull request';
+
+CREATE TABLE IF NOT EXISTS review_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    execution_id UUID NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    file_path VARCHAR(500),
+    line INTEGER,
+    severity VARCHAR(20) NOT NULL,
+    body TEXT NOT NULL,
+    posted BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_comments_execution_id ON review_comments(execution_id);
+CREATE INDEX IF NOT EXISTS idx_review_comments_task_id ON review_comments(task_id);
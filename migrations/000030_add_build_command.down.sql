@@ -0,0 +1,2 @@
+DELETE FROM verification_runs WHERE step = 'build';
+ALTER TABLE projects DROP COLUMN IF EXISTS build_command;
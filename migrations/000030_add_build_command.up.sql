@@ -0,0 +1,4 @@
+-- Build command run in the worktree after an implementation, before the tests
+ALTER TABLE projects ADD COLUMN build_command TEXT;
+
+COMMENT ON COLUMN projects.build_command IS 'Bash command run in the task worktree after implementation to check that the changes build; a failure blocks the pull request';
@@ -0,0 +1,3 @@
+ALTER TABLE executions DROP COLUMN IF EXISTS base_coverage;
+ALTER TABLE executions DROP COLUMN IF EXISTS coverage;
+ALTER TABLE projects DROP COLUMN IF EXISTS coverage_command;
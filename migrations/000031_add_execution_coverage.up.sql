@@ -0,0 +1,8 @@
+-- Test coverage measured after an implementation and on its base branch
+ALTER TABLE projects ADD COLUMN coverage_command TEXT;
+ALTER TABLE executions ADD COLUMN coverage DOUBLE PRECISION;
+ALTER TABLE executions ADD COLUMN base_coverage DOUBLE PRECISION;
+
+COMMENT ON COLUMN projects.coverage_command IS 'Bash command running the tests with coverage; the last percentage it prints is the total coverage';
+COMMENT ON COLUMN executions.coverage IS 'Total test coverage in percent of the worktree after the execution';
+COMMENT ON COLUMN executions.base_coverage IS 'Total test coverage in percent of the base branch';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS security_findings;
+
+DELETE FROM verification_runs WHERE step = 'security_scan';
+
+ALTER TABLE projects DROP COLUMN IF EXISTS security_scanner;
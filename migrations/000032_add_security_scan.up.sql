@@ -0,0 +1,20 @@
+-- Security scan of the files changed by an implementation
+ALTER TABLE projects ADD COLUMN security_scanner VARCHAR(20);
+
+COMMENT ON COLUMN projects.security_scanner IS 'Static analysis tool (gosec or semgrep) run over the changed files before opening the pull request';
+
+CREATE TABLE IF NOT EXISTS security_findings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    execution_id UUID NOT NULL REFERENCES executions(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    scanner VARCHAR(20) NOT NULL,
+    rule_id VARCHAR(255) NOT NULL,
+    file_path VARCHAR(500) NOT NULL,
+    line INTEGER,
+    severity VARCHAR(20) NOT NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_findings_execution_id ON security_findings(execution_id);
+CREATE INDEX IF NOT EXISTS idx_security_findings_task_id ON security_findings(task_id);
@@ -0,0 +1,5 @@
+DELETE FROM verification_runs WHERE step IN ('setup', 'review') OR status IN ('pending', 'running', 'skipped');
+ALTER TABLE verification_runs DROP COLUMN IF EXISTS status;
+ALTER TABLE verification_runs DROP COLUMN IF EXISTS position;
+
+DROP TABLE IF EXISTS verification_pipelines;
@@ -0,0 +1,15 @@
+-- Ordered, configurable verification steps per project
+CREATE TABLE IF NOT EXISTS verification_pipelines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL UNIQUE REFERENCES projects(id) ON DELETE CASCADE,
+    steps JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+COMMENT ON TABLE verification_pipelines IS 'Steps run in order after an implementation, replacing the pipeline derived from the project settings';
+
+-- Runs are created pending when the pipeline starts and report their progress
+ALTER TABLE verification_runs ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE verification_runs ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pending';
+UPDATE verification_runs SET status = CASE WHEN passed THEN 'passed' ELSE 'failed' END;
@@ -0,0 +1,4 @@
+DELETE FROM verification_runs WHERE attempt > 0;
+ALTER TABLE verification_runs DROP COLUMN IF EXISTS attempt;
+ALTER TABLE executions DROP COLUMN IF EXISTS fix_attempts;
+ALTER TABLE projects DROP COLUMN IF EXISTS auto_fix_attempts;
@@ -0,0 +1,6 @@
+-- Ask the AI to fix failing builds and tests before giving up on a task
+ALTER TABLE projects ADD COLUMN auto_fix_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE executions ADD COLUMN fix_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE verification_runs ADD COLUMN attempt INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN projects.auto_fix_attempts IS 'How many times the AI may try to fix a failing build or test run, 0 to give up at once';
@@ -0,0 +1,3 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS preview_url;
+ALTER TABLE projects DROP COLUMN IF EXISTS preview_command;
+ALTER TABLE projects DROP COLUMN IF EXISTS preview_enabled;
@@ -0,0 +1,7 @@
+-- Run a preview environment of the task worktree for reviewers
+ALTER TABLE projects ADD COLUMN preview_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE projects ADD COLUMN preview_command TEXT;
+ALTER TABLE tasks ADD COLUMN preview_url VARCHAR(255);
+
+COMMENT ON COLUMN projects.preview_command IS 'Command starting the preview on $PORT, docker compose when empty';
+COMMENT ON COLUMN tasks.preview_url IS 'URL of the running preview environment, cleared when it is torn down';
@@ -0,0 +1 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS quality_gate_comment_id;
@@ -0,0 +1,4 @@
+-- Keep one quality gate summary comment per pull request, edited on every push
+ALTER TABLE pull_requests ADD COLUMN quality_gate_comment_id BIGINT;
+
+COMMENT ON COLUMN pull_requests.quality_gate_comment_id IS 'GitHub ID of the quality gate summary comment';
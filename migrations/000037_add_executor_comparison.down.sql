@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_executions_comparison_id;
+ALTER TABLE executions DROP COLUMN IF EXISTS branch_name;
+ALTER TABLE executions DROP COLUMN IF EXISTS worktree_path;
+ALTER TABLE executions DROP COLUMN IF EXISTS ai_type;
+ALTER TABLE executions DROP COLUMN IF EXISTS variant;
+ALTER TABLE executions DROP COLUMN IF EXISTS comparison_id;
@@ -0,0 +1,11 @@
+-- Run one task with two executors side by side and keep the better result
+ALTER TABLE executions ADD COLUMN comparison_id UUID;
+ALTER TABLE executions ADD COLUMN variant VARCHAR(20);
+ALTER TABLE executions ADD COLUMN ai_type VARCHAR(50);
+ALTER TABLE executions ADD COLUMN worktree_path TEXT;
+ALTER TABLE executions ADD COLUMN branch_name VARCHAR(255);
+CREATE INDEX idx_executions_comparison_id ON executions(comparison_id);
+
+COMMENT ON COLUMN executions.comparison_id IS 'Groups the executions of one executor comparison, NULL for a regular run';
+COMMENT ON COLUMN executions.variant IS 'Which side of the comparison the execution is, a or b';
+COMMENT ON COLUMN executions.worktree_path IS 'Worktree the comparison variant ran in, apart from the task worktree';
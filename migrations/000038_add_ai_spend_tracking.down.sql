@@ -0,0 +1,6 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS budget_alert_thresholds;
+ALTER TABLE projects DROP COLUMN IF EXISTS monthly_budget_usd;
+DROP INDEX IF EXISTS idx_executions_completed_at;
+ALTER TABLE executions DROP COLUMN IF EXISTS cost_usd;
+ALTER TABLE executions DROP COLUMN IF EXISTS output_tokens;
+ALTER TABLE executions DROP COLUMN IF EXISTS input_tokens;
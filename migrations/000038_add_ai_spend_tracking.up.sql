@@ -0,0 +1,13 @@
+-- Track what each AI run cost and let projects set a monthly budget
+ALTER TABLE executions ADD COLUMN input_tokens BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE executions ADD COLUMN output_tokens BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE executions ADD COLUMN cost_usd NUMERIC(12, 6) NOT NULL DEFAULT 0;
+CREATE INDEX idx_executions_completed_at ON executions(completed_at);
+
+ALTER TABLE projects ADD COLUMN monthly_budget_usd NUMERIC(12, 2) NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN budget_alert_thresholds VARCHAR(100);
+
+COMMENT ON COLUMN executions.input_tokens IS 'Input tokens the AI run reported, cached ones included';
+COMMENT ON COLUMN executions.cost_usd IS 'Cost in USD the AI run reported, 0 when the executor does not report it';
+COMMENT ON COLUMN projects.monthly_budget_usd IS 'Monthly AI spend budget in USD, 0 for no budget';
+COMMENT ON COLUMN projects.budget_alert_thresholds IS 'Comma separated percentages of the budget that trigger an alert when reached';
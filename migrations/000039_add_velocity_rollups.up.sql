@@ -0,0 +1,16 @@
+-- Weekly throughput of each project, recomputed by the velocity rollup job
+CREATE TABLE IF NOT EXISTS velocity_rollups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    week_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    tasks_completed INTEGER NOT NULL DEFAULT 0,
+    pull_requests_merged INTEGER NOT NULL DEFAULT 0,
+    executions INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_velocity_rollups_project_week ON velocity_rollups(project_id, week_start);
+
+COMMENT ON COLUMN velocity_rollups.week_start IS 'Monday 00:00 UTC starting the week';
+COMMENT ON COLUMN velocity_rollups.executions IS 'AI runs of the tasks completed during the week, whenever they ran';
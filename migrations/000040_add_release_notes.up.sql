@@ -0,0 +1,25 @@
+-- Release notes written by an AI executor from a project's completed tasks
+-- or merged pull requests
+CREATE TABLE IF NOT EXISTS release_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    version VARCHAR(100),
+    source VARCHAR(20) NOT NULL,
+    from_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    to_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    ai_type VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    changes INTEGER NOT NULL DEFAULT 0,
+    content TEXT,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT chk_release_notes_source CHECK (source IN ('tasks', 'pull_requests')),
+    CONSTRAINT chk_release_notes_status CHECK (status IN ('pending', 'generating', 'completed', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_release_notes_project_id ON release_notes(project_id, created_at DESC);
+
+COMMENT ON COLUMN release_notes.changes IS 'Number of tasks or pull requests the notes cover';
+COMMENT ON COLUMN release_notes.content IS 'The notes in Markdown, empty until generated';
@@ -0,0 +1,2 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS denied_commands;
+ALTER TABLE projects DROP COLUMN IF EXISTS allowed_commands;
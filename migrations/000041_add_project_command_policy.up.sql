@@ -0,0 +1,6 @@
+-- Let projects restrict the shell commands their AI executions may run
+ALTER TABLE projects ADD COLUMN allowed_commands TEXT;
+ALTER TABLE projects ADD COLUMN denied_commands TEXT;
+
+COMMENT ON COLUMN projects.allowed_commands IS 'Command prefixes AI executions may run, one per line; any command when empty';
+COMMENT ON COLUMN projects.denied_commands IS 'Command prefixes AI executions may never run, one per line';
@@ -0,0 +1,23 @@
+-- Audit trail of the git operations done by Auto-Devs. Records have no
+-- foreign keys so they outlive the tasks and projects they were done for.
+CREATE TABLE IF NOT EXISTS git_operations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID,
+    task_id UUID,
+    execution_id UUID,
+    operation VARCHAR(30) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    working_dir VARCHAR(1000) NOT NULL,
+    target VARCHAR(1000),
+    commit_sha VARCHAR(64),
+    succeeded BOOLEAN NOT NULL,
+    error TEXT,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_git_operations_operation CHECK (operation IN ('worktree_create', 'worktree_delete', 'commit', 'push', 'branch_delete'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_git_operations_task_id ON git_operations(task_id, created_at);
+
+COMMENT ON COLUMN git_operations.actor IS 'job:<type> for background jobs, api or api:<key owner> for API requests, system otherwise';
+COMMENT ON COLUMN git_operations.target IS 'Worktree path, branch or remote branch operated on';
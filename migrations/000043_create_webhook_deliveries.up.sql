@@ -0,0 +1,10 @@
+-- Webhook deliveries already processed, so replayed deliveries are ignored.
+-- Rows older than the accepted delivery age are pruned.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    provider VARCHAR(30) NOT NULL,
+    delivery_id VARCHAR(255) NOT NULL,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, delivery_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_received_at ON webhook_deliveries(received_at);
@@ -0,0 +1,2 @@
+ALTER TABLE execution_logs DROP COLUMN IF EXISTS encrypted;
+ALTER TABLE projects DROP COLUMN IF EXISTS encrypt_execution_logs;
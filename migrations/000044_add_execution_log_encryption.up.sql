@@ -0,0 +1,5 @@
+-- Encrypt the message bodies of a project's execution logs at rest
+ALTER TABLE projects ADD COLUMN encrypt_execution_logs BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE execution_logs ADD COLUMN encrypted BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN execution_logs.encrypted IS 'The message holds the sealed message and parsed content, parsed_content is empty';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS plan_approvals;
+ALTER TABLE projects DROP COLUMN IF EXISTS high_risk_paths;
+ALTER TABLE tasks DROP COLUMN IF EXISTS high_risk_reason;
+ALTER TABLE tasks DROP COLUMN IF EXISTS high_risk;
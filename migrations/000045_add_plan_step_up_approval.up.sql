@@ -0,0 +1,19 @@
+-- High-risk tasks need step-up approval of their plan before it is implemented
+ALTER TABLE tasks ADD COLUMN high_risk BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE tasks ADD COLUMN high_risk_reason VARCHAR(500);
+ALTER TABLE projects ADD COLUMN high_risk_paths TEXT;
+
+COMMENT ON COLUMN projects.high_risk_paths IS 'Path fragments making a task high-risk when its plan touches them, one per line; defaults apply when empty';
+
+CREATE TABLE IF NOT EXISTS plan_approvals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    plan_id UUID NOT NULL REFERENCES plans(id) ON DELETE CASCADE,
+    approver VARCHAR(255) NOT NULL,
+    method VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_plan_approvals_method CHECK (method IN ('api_key', 'totp'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_plan_approvals_plan_approver ON plan_approvals(plan_id, approver);
+CREATE INDEX IF NOT EXISTS idx_plan_approvals_task_id ON plan_approvals(task_id);
@@ -0,0 +1,16 @@
+-- Destructive bulk operations on tasks, kept so they can be undone with
+-- their token for a short window. Expired rows are pruned.
+CREATE TABLE IF NOT EXISTS undo_operations (
+    token VARCHAR(64) PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    operation VARCHAR(30) NOT NULL,
+    task_ids TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    undone_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT chk_undo_operations_operation CHECK (operation IN ('bulk_delete', 'bulk_archive'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_undo_operations_expires_at ON undo_operations(expires_at);
+
+COMMENT ON COLUMN undo_operations.task_ids IS 'JSON array of the IDs of the tasks the operation changed';
@@ -0,0 +1,4 @@
+-- Projects pick the language of their notifications and generated pull request and commit texts
+ALTER TABLE projects ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en';
+
+COMMENT ON COLUMN projects.locale IS 'Locale of the notifications and generated pull request and commit texts: en or vi';
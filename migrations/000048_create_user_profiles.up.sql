@@ -0,0 +1,15 @@
+-- Profiles of the users recorded by name in created_by, assigned_to,
+-- approver and comment author fields, keyed by that name
+CREATE TABLE IF NOT EXISTS user_profiles (
+    username VARCHAR(255) PRIMARY KEY,
+    display_name VARCHAR(255) NOT NULL DEFAULT '',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    avatar_path VARCHAR(500),
+    avatar_mime_type VARCHAR(100) NOT NULL DEFAULT '',
+    avatar_size BIGINT NOT NULL DEFAULT 0,
+    avatar_updated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+COMMENT ON COLUMN user_profiles.avatar_path IS 'Key of the avatar image in the attachment storage';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_executions_worker;
+ALTER TABLE executions DROP COLUMN IF EXISTS heartbeat_at;
+ALTER TABLE executions DROP COLUMN IF EXISTS worker;
@@ -0,0 +1,7 @@
+-- Workers report the executions they run, so those orphaned by a crash can be recovered
+ALTER TABLE executions ADD COLUMN worker VARCHAR(255);
+ALTER TABLE executions ADD COLUMN heartbeat_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX idx_executions_worker ON executions(worker);
+
+COMMENT ON COLUMN executions.worker IS 'Worker running the execution, as name@host';
+COMMENT ON COLUMN executions.heartbeat_at IS 'When the worker last reported the execution alive';
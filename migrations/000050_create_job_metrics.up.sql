@@ -0,0 +1,18 @@
+-- Daily rollups of the background jobs processed, per job type, kept for
+-- longer than asynq keeps its stats in Redis
+CREATE TABLE IF NOT EXISTS job_metrics (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    day TIMESTAMP WITH TIME ZONE NOT NULL,
+    job_type VARCHAR(100) NOT NULL,
+    processed BIGINT NOT NULL DEFAULT 0,
+    failed BIGINT NOT NULL DEFAULT 0,
+    total_duration_ms BIGINT NOT NULL DEFAULT 0,
+    max_duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_job_metrics_day_type ON job_metrics(day, job_type);
+
+COMMENT ON COLUMN job_metrics.day IS 'Start of the day UTC the jobs finished on';
+COMMENT ON COLUMN job_metrics.processed IS 'Runs of the job type, retries included';
@@ -0,0 +1,17 @@
+-- Epics group a project's tasks toward a larger goal or milestone
+CREATE TABLE IF NOT EXISTS epics (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    due_date TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_epics_project_id ON epics(project_id);
+
+ALTER TABLE tasks ADD COLUMN epic_id UUID REFERENCES epics(id) ON DELETE SET NULL;
+CREATE INDEX idx_tasks_epic_id ON tasks(epic_id);
+
+COMMENT ON COLUMN tasks.epic_id IS 'Epic the task is grouped under';
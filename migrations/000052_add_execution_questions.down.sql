@@ -0,0 +1,9 @@
+ALTER TABLE executions DROP COLUMN IF EXISTS session_id;
+ALTER TABLE executions DROP COLUMN IF EXISTS question;
+
+UPDATE executions SET status = 'FAILED' WHERE status IN ('PAUSED', 'WAITING_INPUT');
+ALTER TABLE executions DROP CONSTRAINT IF EXISTS valid_execution_status;
+ALTER TABLE executions
+ADD CONSTRAINT valid_execution_status CHECK (
+    status IN ('PENDING', 'RUNNING', 'COMPLETED', 'FAILED', 'CANCELLED')
+);
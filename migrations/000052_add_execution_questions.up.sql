@@ -0,0 +1,12 @@
+-- Executions pause in WAITING_INPUT when the AI asks the user a question
+ALTER TABLE executions DROP CONSTRAINT IF EXISTS valid_execution_status;
+ALTER TABLE executions
+ADD CONSTRAINT valid_execution_status CHECK (
+    status IN ('PENDING', 'RUNNING', 'PAUSED', 'WAITING_INPUT', 'COMPLETED', 'FAILED', 'CANCELLED')
+);
+
+ALTER TABLE executions ADD COLUMN question TEXT;
+ALTER TABLE executions ADD COLUMN session_id VARCHAR(255);
+
+COMMENT ON COLUMN executions.question IS 'Question the AI asked the user, pending while the execution waits for input';
+COMMENT ON COLUMN executions.session_id IS 'AI CLI session resumed with the user''s answer';
@@ -0,0 +1,4 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS workflow_type;
+DELETE FROM verification_runs WHERE step = 'test_changes';
+
+DROP TABLE IF EXISTS workflow_presets;
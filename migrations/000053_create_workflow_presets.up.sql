@@ -0,0 +1,21 @@
+-- Workflow presets adjust the prompts and verification of a project's tasks
+-- of one workflow type
+CREATE TABLE IF NOT EXISTS workflow_presets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    workflow VARCHAR(20) NOT NULL,
+    planning_instructions TEXT,
+    implementation_instructions TEXT,
+    require_test_changes BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT valid_workflow_preset_workflow CHECK (workflow IN ('feature', 'bugfix', 'refactor'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workflow_presets_project_workflow ON workflow_presets(project_id, workflow);
+
+COMMENT ON TABLE workflow_presets IS 'Per-project presets replacing the built-in ones of the feature, bugfix and refactor workflows';
+
+ALTER TABLE tasks ADD COLUMN workflow_type VARCHAR(20);
+
+COMMENT ON COLUMN tasks.workflow_type IS 'Workflow type selecting the preset the task is planned, implemented and verified with';
@@ -0,0 +1,3 @@
+ALTER TABLE project_settings DROP COLUMN IF EXISTS context_files;
+ALTER TABLE project_settings DROP COLUMN IF EXISTS prompt_suffix;
+ALTER TABLE project_settings DROP COLUMN IF EXISTS prompt_prefix;
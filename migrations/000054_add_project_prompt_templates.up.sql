@@ -0,0 +1,8 @@
+-- Prompt template applied to every AI run on a project's tasks
+ALTER TABLE project_settings ADD COLUMN prompt_prefix TEXT;
+ALTER TABLE project_settings ADD COLUMN prompt_suffix TEXT;
+ALTER TABLE project_settings ADD COLUMN context_files TEXT;
+
+COMMENT ON COLUMN project_settings.prompt_prefix IS 'Text added before the prompt of every AI run on the project''s tasks';
+COMMENT ON COLUMN project_settings.prompt_suffix IS 'Text added after the prompt of every AI run on the project''s tasks';
+COMMENT ON COLUMN project_settings.context_files IS 'Repository paths, one per line, of files quoted in every AI run on the project''s tasks';
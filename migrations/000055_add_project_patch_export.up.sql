@@ -0,0 +1,3 @@
+-- Projects without a remote git provider get their tasks' changes as patch
+-- and bundle attachments instead of pull requests
+ALTER TABLE projects ADD COLUMN export_patches BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+-- Preview an implementation in a throwaway worktree, keeping only its diff
+ALTER TABLE executions ADD COLUMN dry_run BOOLEAN NOT NULL DEFAULT FALSE;
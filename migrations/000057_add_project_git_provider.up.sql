@@ -0,0 +1,3 @@
+-- Projects hosted on GitLab get merge requests instead of GitHub pull
+-- requests; NULL detects the provider from the repository URL
+ALTER TABLE projects ADD COLUMN git_provider VARCHAR(20);
@@ -0,0 +1,3 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS planning_iterations;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_plans_unique_task_id ON plans (task_id) WHERE deleted_at IS NULL;
+ALTER TABLE plans DROP COLUMN IF EXISTS feedback;
@@ -0,0 +1,5 @@
+-- A rejected plan keeps the reviewer's feedback, and the task is planned
+-- again with it: a task now has one plan per planning iteration
+ALTER TABLE plans ADD COLUMN feedback TEXT;
+DROP INDEX IF EXISTS idx_plans_unique_task_id;
+ALTER TABLE tasks ADD COLUMN planning_iterations INTEGER NOT NULL DEFAULT 0;
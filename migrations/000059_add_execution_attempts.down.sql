@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_executions_retry_of_id;
+ALTER TABLE executions DROP COLUMN IF EXISTS retry_of_id;
+ALTER TABLE executions DROP COLUMN IF EXISTS attempt;
+ALTER TABLE executions DROP COLUMN IF EXISTS phase;
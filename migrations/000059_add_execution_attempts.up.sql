@@ -0,0 +1,6 @@
+-- Failed planning and implementation executions are retried: each retry is
+-- a new execution numbered by attempt and linked to the one it retries
+ALTER TABLE executions ADD COLUMN phase VARCHAR(20);
+ALTER TABLE executions ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE executions ADD COLUMN retry_of_id UUID REFERENCES executions(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_executions_retry_of_id ON executions (retry_of_id);
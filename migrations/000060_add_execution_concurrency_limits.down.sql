@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS queue_position;
+ALTER TABLE projects DROP COLUMN IF EXISTS max_concurrent_executions;
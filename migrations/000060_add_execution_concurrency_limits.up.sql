@@ -0,0 +1,4 @@
+-- Projects may bound how many of their AI executions run at once; tasks
+-- waiting for a free slot keep their position in the project's queue
+ALTER TABLE projects ADD COLUMN max_concurrent_executions INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN queue_position INTEGER;
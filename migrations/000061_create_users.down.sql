@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_api_keys;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_executions_trace_id;
+ALTER TABLE execution_logs DROP COLUMN IF EXISTS trace_id;
+ALTER TABLE executions DROP COLUMN IF EXISTS trace_id;
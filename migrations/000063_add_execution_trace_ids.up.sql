@@ -0,0 +1,5 @@
+-- Executions and their logs record the OpenTelemetry trace they ran in, to
+-- find the request and jobs that led to them
+ALTER TABLE executions ADD COLUMN trace_id VARCHAR(32);
+ALTER TABLE execution_logs ADD COLUMN trace_id VARCHAR(32);
+CREATE INDEX IF NOT EXISTS idx_executions_trace_id ON executions (trace_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS project_webhook_deliveries;
+DROP TABLE IF EXISTS project_webhooks;
@@ -0,0 +1,33 @@
+-- Outbound webhooks of a project: URLs its task lifecycle events are posted
+-- to. The secret each delivery is signed with is kept in project_secrets.
+CREATE TABLE IF NOT EXISTS project_webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    events JSONB NOT NULL DEFAULT '[]',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_webhooks_project_id ON project_webhooks(project_id);
+
+-- Events posted to the webhooks, with the outcome of their last attempt
+CREATE TABLE IF NOT EXISTS project_webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES project_webhooks(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    response_status INTEGER,
+    response_body TEXT,
+    error TEXT,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    redelivery_of UUID REFERENCES project_webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_webhook_deliveries_webhook_id ON project_webhook_deliveries(webhook_id);
+CREATE INDEX IF NOT EXISTS idx_project_webhook_deliveries_created_at ON project_webhook_deliveries(created_at);
@@ -0,0 +1,10 @@
+-- Repositories sending pull request webhooks. Their open pull requests are
+-- synced as the events arrive and left out of the status polling.
+CREATE TABLE IF NOT EXISTS webhook_sources (
+    provider VARCHAR(30) NOT NULL,
+    repository VARCHAR(255) NOT NULL,
+    last_event_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (provider, repository)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_sources_last_event_at ON webhook_sources(last_event_at);
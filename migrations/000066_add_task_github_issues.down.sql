@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_project_github_issue;
+ALTER TABLE tasks DROP COLUMN IF EXISTS github_issue_comments;
+ALTER TABLE tasks DROP COLUMN IF EXISTS github_issue_number;
+ALTER TABLE tasks DROP COLUMN IF EXISTS github_issue_repository;
@@ -0,0 +1,4 @@
+ALTER TABLE tasks ADD COLUMN github_issue_repository VARCHAR(255);
+ALTER TABLE tasks ADD COLUMN github_issue_number INTEGER;
+ALTER TABLE tasks ADD COLUMN github_issue_comments BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE UNIQUE INDEX idx_tasks_project_github_issue ON tasks(project_id, github_issue_repository, github_issue_number) WHERE github_issue_number IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,27 @@
+-- At most one Slack channel per project. Its webhook URL or bot token is
+-- kept in project_secrets.
+CREATE TABLE IF NOT EXISTS slack_integrations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    delivery VARCHAR(20) NOT NULL,
+    channel VARCHAR(255),
+    interactive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_slack_integrations_project_id ON slack_integrations(project_id);
+
+-- Notification types muted on a channel of a project
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    channel VARCHAR(30) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    muted BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_by VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_preferences_project_channel_type ON notification_preferences(project_id, channel, type);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_users_organization_id;
+ALTER TABLE users DROP COLUMN IF EXISTS organization_id;
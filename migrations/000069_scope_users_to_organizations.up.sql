@@ -0,0 +1,15 @@
+-- Users belong to at most one organization, which scopes every request
+-- they make. Users without one can only reach their account and the
+-- organizations routes once organizations exist.
+ALTER TABLE users ADD COLUMN organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX idx_users_organization_id ON users(organization_id) WHERE organization_id IS NOT NULL;
+
+-- Projects and users left unscoped by 000022 join the first organization,
+-- so they stay reachable now that scoping is enforced
+UPDATE projects SET organization_id = (
+    SELECT id FROM organizations WHERE deleted_at IS NULL ORDER BY created_at LIMIT 1
+) WHERE organization_id IS NULL;
+
+UPDATE users SET organization_id = (
+    SELECT id FROM organizations WHERE deleted_at IS NULL ORDER BY created_at LIMIT 1
+) WHERE organization_id IS NULL;
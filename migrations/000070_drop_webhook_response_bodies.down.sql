@@ -0,0 +1 @@
+ALTER TABLE project_webhook_deliveries ADD COLUMN IF NOT EXISTS response_body TEXT;
@@ -0,0 +1,3 @@
+-- Receivers' answers are no longer kept, so webhooks cannot be used to
+-- read what internal services serve
+ALTER TABLE project_webhook_deliveries DROP COLUMN IF EXISTS response_body;
@@ -18,6 +18,10 @@ type GormDB struct {
 
 // NewGormDB creates a new GORM database connection
 func NewGormDB(cfg *config.Config) (*GormDB, error) {
+	if cfg.Database.Driver == DriverSQLite {
+		return newSQLiteGormDB(cfg)
+	}
+
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=UTC",
 		cfg.Database.Host,
 		cfg.Database.Username,
@@ -27,19 +31,8 @@ func NewGormDB(cfg *config.Config) (*GormDB, error) {
 		cfg.Database.SSLMode,
 	)
 
-	// Configure GORM logger
-	gormLogger := logger.New(
-		log.New(log.Writer(), "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             time.Second,
-			LogLevel:                  logger.Info,
-			IgnoreRecordNotFoundError: true,
-			Colorful:                  true,
-		},
-	)
-
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: gormLogger,
+		Logger: newGormLogger(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
@@ -58,6 +51,25 @@ func NewGormDB(cfg *config.Config) (*GormDB, error) {
 	return &GormDB{DB: db}, nil
 }
 
+// newGormLogger builds the GORM logger shared by all drivers
+func newGormLogger() logger.Interface {
+	return logger.New(
+		log.New(log.Writer(), "\r\n", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             time.Second,
+			LogLevel:                  logger.Info,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  true,
+		},
+	)
+}
+
+// IsSQLite reports whether db uses the SQLite driver. Repositories use it to
+// fall back from Postgres-only SQL (ILIKE, full-text search, JSONB operators).
+func IsSQLite(db *gorm.DB) bool {
+	return db.Dialector.Name() == DriverSQLite
+}
+
 // AutoMigrate runs database migrations for all models
 func (g *GormDB) AutoMigrate(models ...interface{}) error {
 	return g.DB.AutoMigrate(models...)
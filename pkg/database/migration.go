@@ -16,3 +16,58 @@ func RunMigrations(db *GormDB) error {
 		&entity.ExecutionLog{},
 	)
 }
+
+// RunSQLiteMigrations builds the SQLite schema from the entities. The SQL
+// files under migrations/ are Postgres-specific and are not used for SQLite.
+func RunSQLiteMigrations(db *GormDB) error {
+	return db.MigrateSQLite(
+		&entity.Organization{},
+		&entity.UserProfile{},
+		&entity.User{},
+		&entity.UserAPIKey{},
+		&entity.Project{},
+		&entity.ProjectMember{},
+		&entity.ProjectSettings{},
+		&entity.ProjectSecret{},
+		&entity.JiraIntegration{},
+		&entity.SlackIntegration{},
+		&entity.NotificationPreference{},
+		&entity.EmailTemplate{},
+		&entity.ProjectWebhook{},
+		&entity.ProjectWebhookDelivery{},
+		&entity.Event{},
+		&entity.Epic{},
+		&entity.Task{},
+		&entity.TaskStatusHistory{},
+		&entity.TaskCommit{},
+		&entity.VelocityRollup{},
+		&entity.JobMetric{},
+		&entity.ReleaseNotes{},
+		&entity.TaskAuditLog{},
+		&entity.TaskTemplate{},
+		&entity.TaskDependency{},
+		&entity.TaskComment{},
+		&entity.TaskAttachment{},
+		&entity.AuditLog{},
+		&entity.GitOperation{},
+		&entity.WebhookDelivery{},
+		&entity.WebhookSource{},
+		&entity.UndoOperation{},
+		&entity.PlanApproval{},
+		&entity.Plan{},
+		&entity.PlanVersion{},
+		&entity.Worktree{},
+		&entity.Execution{},
+		&entity.Process{},
+		&entity.ExecutionLog{},
+		&entity.VerificationRun{},
+		&entity.ReviewComment{},
+		&entity.SecurityFinding{},
+		&entity.VerificationPipeline{},
+		&entity.WorkflowPreset{},
+		&entity.PullRequest{},
+		&entity.PullRequestComment{},
+		&entity.PullRequestReview{},
+		&entity.PullRequestCheck{},
+	)
+}
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Supported database drivers
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// newSQLiteGormDB opens a SQLite database file. The SQL migrations target
+// Postgres, so SQLite schemas are created with MigrateSQLite instead.
+func newSQLiteGormDB(cfg *config.Config) (*GormDB, error) {
+	dsn := cfg.Database.SQLitePath + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: newGormLogger(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	// SQLite allows a single writer; serialize access to avoid SQLITE_BUSY
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := db.Callback().Create().Before("gorm:create").Register("autodevs:assign_uuid_primary_key", assignUUIDPrimaryKey); err != nil {
+		return nil, fmt.Errorf("failed to register uuid callback: %w", err)
+	}
+	if err := db.Callback().Create().Before("gorm:create").Register("autodevs:assign_sequence_numbers", assignSequenceNumbers); err != nil {
+		return nil, fmt.Errorf("failed to register sequence callback: %w", err)
+	}
+
+	return &GormDB{DB: db}, nil
+}
+
+// MigrateSQLite creates or updates the SQLite schema for the given models.
+// Column defaults that call Postgres functions (gen_random_uuid(), nextval()
+// and friends) are dropped first; primary keys and sequence numbers are
+// generated client-side instead.
+func (g *GormDB) MigrateSQLite(models ...interface{}) error {
+	if !IsSQLite(g.DB) {
+		return fmt.Errorf("MigrateSQLite called on %s connection", g.DB.Dialector.Name())
+	}
+
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: g.DB}
+		if err := stmt.Parse(model); err != nil {
+			return fmt.Errorf("failed to parse model %T: %w", model, err)
+		}
+		stripFunctionDefaults(stmt.Schema)
+	}
+
+	return g.DB.AutoMigrate(models...)
+}
+
+// stripFunctionDefaults removes Postgres function-call defaults from a parsed
+// (and cached) schema so neither DDL nor INSERT ... RETURNING rely on them.
+func stripFunctionDefaults(s *schema.Schema) {
+	for _, field := range s.Fields {
+		if strings.HasSuffix(field.DefaultValue, "()") || isSequenceDefault(field) {
+			field.DefaultValue = ""
+			field.DefaultValueInterface = nil
+			field.HasDefaultValue = false
+		}
+	}
+
+	kept := s.FieldsWithDefaultDBValue[:0]
+	for _, field := range s.FieldsWithDefaultDBValue {
+		if field.HasDefaultValue {
+			kept = append(kept, field)
+		}
+	}
+	s.FieldsWithDefaultDBValue = kept
+}
+
+// assignUUIDPrimaryKey fills zero uuid primary keys before insert, standing in
+// for the gen_random_uuid() column default used on Postgres.
+func assignUUIDPrimaryKey(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	field := db.Statement.Schema.PrioritizedPrimaryField
+	if field == nil || field.FieldType != reflect.TypeOf(uuid.UUID{}) {
+		return
+	}
+
+	ctx := db.Statement.Context
+	assign := func(rv reflect.Value) {
+		if _, isZero := field.ValueOf(ctx, rv); isZero {
+			_ = field.Set(ctx, rv, uuid.New())
+		}
+	}
+
+	rv := db.Statement.ReflectValue
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			assign(reflect.Indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		assign(rv)
+	}
+}
+
+// isSequenceDefault reports whether the field defaults to a Postgres sequence
+func isSequenceDefault(field *schema.Field) bool {
+	return strings.HasPrefix(field.TagSettings["DEFAULT"], "nextval(")
+}
+
+// assignSequenceNumbers fills zero sequence-backed columns before insert with
+// the next number after the table's current maximum, standing in for
+// nextval(). SQLite's single writer keeps this free of races.
+func assignSequenceNumbers(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	ctx := db.Statement.Context
+	for _, field := range db.Statement.Schema.Fields {
+		if !isSequenceDefault(field) {
+			continue
+		}
+
+		var next int64
+		if err := db.Session(&gorm.Session{NewDB: true}).
+			Table(db.Statement.Table).
+			Select("COALESCE(MAX(" + field.DBName + "), 0)").
+			Scan(&next).Error; err != nil {
+			_ = db.AddError(fmt.Errorf("failed to read %s.%s sequence: %w", db.Statement.Table, field.DBName, err))
+			return
+		}
+
+		assign := func(rv reflect.Value) {
+			if _, isZero := field.ValueOf(ctx, rv); isZero {
+				next++
+				_ = field.Set(ctx, rv, next)
+			}
+		}
+
+		rv := db.Statement.ReflectValue
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < rv.Len(); i++ {
+				assign(reflect.Indirect(rv.Index(i)))
+			}
+		case reflect.Struct:
+			assign(rv)
+		}
+	}
+}
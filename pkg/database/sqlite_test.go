@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/auto-devs/auto-devs/config"
+	"github.com/auto-devs/auto-devs/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSQLiteDB(t *testing.T) *GormDB {
+	cfg := &config.Config{Database: config.DatabaseConfig{
+		Driver:     DriverSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "autodevs.db"),
+	}}
+
+	db, err := NewGormDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	require.NoError(t, RunSQLiteMigrations(db))
+	return db
+}
+
+func TestSQLite_MigrateAndCreate(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	assert.True(t, IsSQLite(db.DB))
+
+	project := &entity.Project{Name: "Local Project"}
+	require.NoError(t, db.WithContext(context.Background()).Create(project).Error)
+	assert.NotEqual(t, uuid.Nil, project.ID, "uuid primary key should be generated client-side")
+
+	task := &entity.Task{ProjectID: project.ID, Title: "First task"}
+	require.NoError(t, db.Create(task).Error)
+	assert.NotEqual(t, uuid.Nil, task.ID)
+	assert.Equal(t, int64(1), task.Number, "sequence number should be generated client-side")
+
+	second := &entity.Task{ProjectID: project.ID, Title: "Second task"}
+	require.NoError(t, db.Create(second).Error)
+	assert.Equal(t, int64(2), second.Number)
+
+	var loaded entity.Task
+	require.NoError(t, db.First(&loaded, "id = ?", task.ID).Error)
+	assert.Equal(t, "First task", loaded.Title)
+	assert.Equal(t, project.ID, loaded.ProjectID)
+}
+
+func TestSQLite_MigrateIsIdempotent(t *testing.T) {
+	db := newTestSQLiteDB(t)
+	assert.NoError(t, RunSQLiteMigrations(db))
+}
@@ -0,0 +1,221 @@
+package i18n
+
+// catalogs holds the texts of every supported locale by key. Texts taking
+// arguments are fmt format strings; translations must keep the verbs of the
+// English text in the same order.
+var catalogs = map[Locale]map[string]string{
+	LocaleEnglish: {
+		// Validation errors
+		"validation.failed":         "Validation failed",
+		"validation.failed_message": "The provided data failed validation",
+		"validation.required":       "This field is required",
+		"validation.min":            "This field must be at least %s characters long",
+		"validation.max":            "This field must be at most %s characters long",
+		"validation.email":          "This field must be a valid email address",
+		"validation.url":            "This field must be a valid URL",
+		"validation.uuid":           "This field must be a valid UUID",
+		"validation.oneof":          "This field must be one of: %s",
+		"validation.invalid":        "This field is invalid",
+
+		// Notifications
+		"notification.task_status_changed": "Task '%s' status changed from %s to %s",
+		"notification.initial_status":      "initial",
+		"notification.task_created":        "New task '%s' created in project '%s'",
+		"notification.daily_standup":       "Daily standup for '%s', %s",
+		"notification.plan_ready":          "The plan for task '%s' in project '%s' is ready for review",
+		"notification.execution_failed":    "The %s of task '%s' in project '%s' failed",
+		"notification.pr_merged":           "Pull request #%d of task '%s' in project '%s' was merged",
+
+		// Commit messages
+		"commit.implementation": "Implement task: %s\n\nTask ID: %s\nAI Implementation completed via Auto-Devs\n\n- %s",
+		"commit.lint_fixes":     "Apply lint and format fixes\n\nAutomated fixes from the project's lint command via Auto-Devs",
+		"commit.fix":            "Fix %s failure\n\nAuto-fix attempt %d for `%s` via Auto-Devs",
+		"commit.change_request": "Address review feedback: %s\n\nTask ID: %s\nChanges requested in code review, made via Auto-Devs",
+
+		// Pull request descriptions
+		"pr.task_information":          "## Task Information",
+		"pr.task_id":                   "**Task ID:** %s",
+		"pr.title":                     "**Title:** %s",
+		"pr.description":               "**Description:**",
+		"pr.priority":                  "**Priority:** %s",
+		"pr.status":                    "**Status:** %s",
+		"pr.task_url":                  "**Task URL:** %s",
+		"pr.implementation_plan":       "## Implementation Plan",
+		"pr.plan_status":               "**Plan Status:** %s",
+		"pr.plan_id":                   "**Plan ID:** %s",
+		"pr.plan_truncated":            "[See full plan in task details]",
+		"pr.plan_summary":              "**Plan Summary:**",
+		"pr.implementation_summary":    "## Implementation Summary",
+		"pr.execution_id":              "**Execution ID:** %s",
+		"pr.execution_status":          "**Execution Status:** %s",
+		"pr.started_at":                "**Started At:** %s",
+		"pr.completed_at":              "**Completed At:** %s",
+		"pr.duration":                  "**Duration:** %v",
+		"pr.implementation_result":     "**Implementation Result:**",
+		"pr.fix_attempts":              "🔧 Verification passed after %d auto-fix attempt(s); each fix is a separate commit.",
+		"pr.testing_instructions":      "## Testing Instructions",
+		"pr.testing_checkout":          "1. Check out this branch locally",
+		"pr.testing_run":               "2. Run the application and verify the implemented functionality",
+		"pr.testing_tests":             "3. Run tests to ensure no regressions:",
+		"pr.testing_requirements":      "4. Verify the changes meet the requirements outlined in the task description",
+		"pr.review_checklist":          "## Review Checklist",
+		"pr.checklist_conventions":     "- [ ] Code follows project conventions and style guidelines",
+		"pr.checklist_tests":           "- [ ] All tests pass",
+		"pr.checklist_breaking":        "- [ ] No breaking changes introduced",
+		"pr.checklist_docs":            "- [ ] Documentation updated if needed",
+		"pr.checklist_security":        "- [ ] Security considerations addressed",
+		"pr.checklist_performance":     "- [ ] Performance impact assessed",
+		"pr.footer":                    "*This pull request was automatically generated by Auto-Devs AI system*",
+		"pr.step_results":              "## %s Results",
+		"pr.fixes_committed":           "Fixes made by the command were committed separately.",
+		"pr.step_skipped":              "⏭️ Skipped",
+		"pr.step_passed":               "✅ `%s` passed in %v",
+		"pr.step_failed":               "⚠️ **`%s` failed** (exit code %d) after %v. Review the failures before merging.",
+		"pr.output":                    "Output",
+		"pr.security_findings":         "%d finding(s) in the changed files:",
+		"pr.more":                      "- ...and %d more",
+		"pr.coverage":                  "## Coverage",
+		"pr.coverage_unmeasured":       "Total coverage: **%.1f%%** (base branch not measured)",
+		"pr.coverage_delta":            "%s Total coverage: **%.1f%%** (%+.1f points, base branch %.1f%%)",
+		"pr.ai_review":                 "## AI Review",
+		"pr.ai_review_clean":           "✅ The AI review found no issues.",
+		"pr.ai_review_findings":        "The AI review made %d finding(s): %d error, %d warning, %d info.",
+		"pr.quality_gate":              "# 🚦 Quality Gate",
+		"pr.quality_gate_none":         "No verification steps ran for this push.",
+		"pr.quality_gate_passed":       "✅ **Passed**: every verification step succeeded.",
+		"pr.quality_gate_failed":       "❌ **Failed**: %s.",
+		"pr.quality_gate_execution":    "Execution `%s`, last updated %s.",
+		"pr.quality_gate_fix_attempts": "🔧 %d auto-fix attempt(s) were committed.",
+		"pr.quality_gate_footer":       "*This comment is updated by Auto-Devs on every push*",
+
+		// Display names
+		"task.priority.LOW":               "Low",
+		"task.priority.MEDIUM":            "Medium",
+		"task.priority.HIGH":              "High",
+		"task.priority.URGENT":            "Urgent",
+		"task.status.TODO":                "To Do",
+		"task.status.PLANNING":            "Planning",
+		"task.status.PLAN_REVIEWING":      "Plan Review",
+		"task.status.IMPLEMENTING":        "Implementing",
+		"task.status.CODE_REVIEWING":      "Code Review",
+		"task.status.DONE":                "Done",
+		"task.status.CANCELLED":           "Cancelled",
+		"plan.status.DRAFT":               "Draft",
+		"plan.status.REVIEWING":           "Reviewing",
+		"plan.status.APPROVED":            "Approved",
+		"plan.status.REJECTED":            "Rejected",
+		"verification.step.setup":         "Setup",
+		"verification.step.lint":          "Lint",
+		"verification.step.build":         "Build",
+		"verification.step.test":          "Test",
+		"verification.step.security_scan": "Security Scan",
+		"verification.step.review":        "Review",
+	},
+	LocaleVietnamese: {
+		// Validation errors
+		"validation.failed":         "Xác thực thất bại",
+		"validation.failed_message": "Dữ liệu gửi lên không hợp lệ",
+		"validation.required":       "Trường này là bắt buộc",
+		"validation.min":            "Trường này phải dài ít nhất %s ký tự",
+		"validation.max":            "Trường này chỉ được dài tối đa %s ký tự",
+		"validation.email":          "Trường này phải là địa chỉ email hợp lệ",
+		"validation.url":            "Trường này phải là URL hợp lệ",
+		"validation.uuid":           "Trường này phải là UUID hợp lệ",
+		"validation.oneof":          "Trường này phải là một trong: %s",
+		"validation.invalid":        "Trường này không hợp lệ",
+
+		// Notifications
+		"notification.task_status_changed": "Trạng thái của task '%s' đã chuyển từ %s sang %s",
+		"notification.initial_status":      "ban đầu",
+		"notification.task_created":        "Task mới '%s' đã được tạo trong dự án '%s'",
+		"notification.daily_standup":       "Báo cáo hằng ngày của dự án '%s', %s",
+		"notification.plan_ready":          "Kế hoạch của task '%s' trong dự án '%s' đã sẵn sàng để duyệt",
+		"notification.execution_failed":    "Bước %s của task '%s' trong dự án '%s' đã thất bại",
+		"notification.pr_merged":           "Pull request #%d của task '%s' trong dự án '%s' đã được merge",
+
+		// Commit messages
+		"commit.implementation": "Triển khai task: %s\n\nTask ID: %s\nAI đã hoàn thành triển khai qua Auto-Devs\n\n- %s",
+		"commit.lint_fixes":     "Áp dụng các sửa lỗi lint và định dạng\n\nSửa tự động từ lệnh lint của dự án qua Auto-Devs",
+		"commit.fix":            "Sửa lỗi %s\n\nLần tự sửa thứ %d cho `%s` qua Auto-Devs",
+		"commit.change_request": "Xử lý góp ý review: %s\n\nTask ID: %s\nThay đổi được yêu cầu khi review code, thực hiện qua Auto-Devs",
+
+		// Pull request descriptions
+		"pr.task_information":          "## Thông tin task",
+		"pr.task_id":                   "**Task ID:** %s",
+		"pr.title":                     "**Tiêu đề:** %s",
+		"pr.description":               "**Mô tả:**",
+		"pr.priority":                  "**Độ ưu tiên:** %s",
+		"pr.status":                    "**Trạng thái:** %s",
+		"pr.task_url":                  "**URL của task:** %s",
+		"pr.implementation_plan":       "## Kế hoạch triển khai",
+		"pr.plan_status":               "**Trạng thái kế hoạch:** %s",
+		"pr.plan_id":                   "**Plan ID:** %s",
+		"pr.plan_truncated":            "[Xem toàn bộ kế hoạch trong chi tiết task]",
+		"pr.plan_summary":              "**Tóm tắt kế hoạch:**",
+		"pr.implementation_summary":    "## Tóm tắt triển khai",
+		"pr.execution_id":              "**Execution ID:** %s",
+		"pr.execution_status":          "**Trạng thái execution:** %s",
+		"pr.started_at":                "**Bắt đầu lúc:** %s",
+		"pr.completed_at":              "**Hoàn thành lúc:** %s",
+		"pr.duration":                  "**Thời lượng:** %v",
+		"pr.implementation_result":     "**Kết quả triển khai:**",
+		"pr.fix_attempts":              "🔧 Kiểm tra đã đạt sau %d lần tự sửa; mỗi lần sửa là một commit riêng.",
+		"pr.testing_instructions":      "## Hướng dẫn kiểm thử",
+		"pr.testing_checkout":          "1. Checkout nhánh này về máy",
+		"pr.testing_run":               "2. Chạy ứng dụng và kiểm tra chức năng đã triển khai",
+		"pr.testing_tests":             "3. Chạy test để đảm bảo không có lỗi hồi quy:",
+		"pr.testing_requirements":      "4. Kiểm tra các thay đổi đáp ứng yêu cầu trong mô tả task",
+		"pr.review_checklist":          "## Danh sách kiểm tra khi review",
+		"pr.checklist_conventions":     "- [ ] Code tuân theo quy ước và phong cách của dự án",
+		"pr.checklist_tests":           "- [ ] Tất cả test đều đạt",
+		"pr.checklist_breaking":        "- [ ] Không có thay đổi phá vỡ tương thích",
+		"pr.checklist_docs":            "- [ ] Đã cập nhật tài liệu nếu cần",
+		"pr.checklist_security":        "- [ ] Đã xem xét các vấn đề bảo mật",
+		"pr.checklist_performance":     "- [ ] Đã đánh giá ảnh hưởng đến hiệu năng",
+		"pr.footer":                    "*Pull request này được tạo tự động bởi hệ thống AI Auto-Devs*",
+		"pr.step_results":              "## Kết quả %s",
+		"pr.fixes_committed":           "Các sửa đổi của lệnh đã được commit riêng.",
+		"pr.step_skipped":              "⏭️ Bỏ qua",
+		"pr.step_passed":               "✅ `%s` đạt trong %v",
+		"pr.step_failed":               "⚠️ **`%s` thất bại** (mã thoát %d) sau %v. Hãy xem lại các lỗi trước khi merge.",
+		"pr.output":                    "Kết quả đầu ra",
+		"pr.security_findings":         "%d phát hiện trong các file đã thay đổi:",
+		"pr.more":                      "- ...và %d mục khác",
+		"pr.coverage":                  "## Độ phủ test",
+		"pr.coverage_unmeasured":       "Tổng độ phủ: **%.1f%%** (chưa đo nhánh gốc)",
+		"pr.coverage_delta":            "%s Tổng độ phủ: **%.1f%%** (%+.1f điểm, nhánh gốc %.1f%%)",
+		"pr.ai_review":                 "## AI review",
+		"pr.ai_review_clean":           "✅ AI review không tìm thấy vấn đề nào.",
+		"pr.ai_review_findings":        "AI review có %d phát hiện: %d lỗi, %d cảnh báo, %d thông tin.",
+		"pr.quality_gate":              "# 🚦 Cổng chất lượng",
+		"pr.quality_gate_none":         "Không có bước kiểm tra nào chạy cho lần push này.",
+		"pr.quality_gate_passed":       "✅ **Đạt**: mọi bước kiểm tra đều thành công.",
+		"pr.quality_gate_failed":       "❌ **Không đạt**: %s.",
+		"pr.quality_gate_execution":    "Execution `%s`, cập nhật lần cuối lúc %s.",
+		"pr.quality_gate_fix_attempts": "🔧 Đã commit %d lần tự sửa.",
+		"pr.quality_gate_footer":       "*Bình luận này được Auto-Devs cập nhật sau mỗi lần push*",
+
+		// Display names
+		"task.priority.LOW":               "Thấp",
+		"task.priority.MEDIUM":            "Trung bình",
+		"task.priority.HIGH":              "Cao",
+		"task.priority.URGENT":            "Khẩn cấp",
+		"task.status.TODO":                "Cần làm",
+		"task.status.PLANNING":            "Lập kế hoạch",
+		"task.status.PLAN_REVIEWING":      "Duyệt kế hoạch",
+		"task.status.IMPLEMENTING":        "Đang triển khai",
+		"task.status.CODE_REVIEWING":      "Review code",
+		"task.status.DONE":                "Hoàn thành",
+		"task.status.CANCELLED":           "Đã hủy",
+		"plan.status.DRAFT":               "Nháp",
+		"plan.status.REVIEWING":           "Đang duyệt",
+		"plan.status.APPROVED":            "Đã duyệt",
+		"plan.status.REJECTED":            "Bị từ chối",
+		"verification.step.setup":         "Chuẩn bị",
+		"verification.step.lint":          "Lint",
+		"verification.step.build":         "Build",
+		"verification.step.test":          "Test",
+		"verification.step.security_scan": "Quét bảo mật",
+		"verification.step.review":        "Review",
+	},
+}
@@ -0,0 +1,134 @@
+// Package i18n translates the texts the server generates, validation
+// errors, notifications and pull request and commit texts, into the
+// supported locales. Texts are looked up by key in the locale's catalog,
+// falling back to English when the locale has no translation.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale is a supported language, by its ISO 639-1 code
+type Locale string
+
+const (
+	LocaleEnglish    Locale = "en"
+	LocaleVietnamese Locale = "vi"
+)
+
+// DefaultLocale is used when no supported locale is asked for
+const DefaultLocale = LocaleEnglish
+
+// Locales lists the supported locales
+var Locales = []Locale{LocaleEnglish, LocaleVietnamese}
+
+// IsValid checks if the locale is supported
+func (l Locale) IsValid() bool {
+	_, ok := catalogs[l]
+	return ok
+}
+
+// Parse returns the supported locale of a language tag such as "vi",
+// "vi-VN" or "en_US", or DefaultLocale for unsupported or empty tags
+func Parse(tag string) Locale {
+	if locale, ok := parse(tag); ok {
+		return locale
+	}
+	return DefaultLocale
+}
+
+func parse(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	locale := Locale(tag)
+	return locale, locale.IsValid()
+}
+
+// FromAcceptLanguage returns the supported locale the client prefers most
+// in an Accept-Language header, or DefaultLocale when it accepts none of
+// them
+func FromAcceptLanguage(header string) Locale {
+	type candidate struct {
+		locale Locale
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, ok := parse(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{locale, q})
+		}
+	}
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}
+
+// T returns the text of key in locale formatted with args, falling back to
+// English and then to the key itself
+func T(locale Locale, key string, args ...interface{}) string {
+	text, ok := Lookup(locale, key)
+	if !ok {
+		text = key
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// Lookup returns the unformatted text of key in locale, falling back to
+// English. It reports whether the key is known.
+func Lookup(locale Locale, key string) (string, bool) {
+	if text, ok := catalogs[locale][key]; ok {
+		return text, true
+	}
+	text, ok := catalogs[DefaultLocale][key]
+	return text, ok
+}
+
+// DisplayName returns the text of key in locale for the display name of an
+// enum value, or fallback when the value has none
+func DisplayName(locale Locale, key, fallback string) string {
+	if text, ok := Lookup(locale, key); ok {
+		return text
+	}
+	return fallback
+}
+
+type contextKey struct{}
+
+// WithLocale returns a copy of ctx carrying locale
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale carried by ctx, or DefaultLocale
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return locale
+	}
+	return DefaultLocale
+}
@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogs_TranslateEveryKey(t *testing.T) {
+	verbs := regexp.MustCompile(`%[-+# 0]*[0-9.]*[a-zA-Z%]`)
+	for _, locale := range Locales {
+		if locale == DefaultLocale {
+			continue
+		}
+		for key, text := range catalogs[DefaultLocale] {
+			translation, ok := catalogs[locale][key]
+			if assert.True(t, ok, "%s has no %s translation", key, locale) {
+				assert.Equal(t, verbs.FindAllString(text, -1), verbs.FindAllString(translation, -1), "%s translation of %s", locale, key)
+			}
+		}
+		for key := range catalogs[locale] {
+			assert.Contains(t, catalogs[DefaultLocale], key, "%s translates an unknown key", locale)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "This field must be one of: a b", T(LocaleEnglish, "validation.oneof", "a b"))
+	assert.Equal(t, "Trường này là bắt buộc", T(LocaleVietnamese, "validation.required"))
+	assert.Equal(t, "This field is required", T(Locale("fr"), "validation.required"), "unsupported locales fall back to English")
+	assert.Equal(t, "unknown.key", T(LocaleVietnamese, "unknown.key"))
+}
+
+func TestParse(t *testing.T) {
+	assert.Equal(t, LocaleVietnamese, Parse("vi"))
+	assert.Equal(t, LocaleVietnamese, Parse("vi-VN"))
+	assert.Equal(t, LocaleEnglish, Parse("en_US"))
+	assert.Equal(t, DefaultLocale, Parse("fr"))
+	assert.Equal(t, DefaultLocale, Parse(""))
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Locale
+	}{
+		{"", DefaultLocale},
+		{"vi-VN,vi;q=0.9,en;q=0.8", LocaleVietnamese},
+		{"fr-FR,fr;q=0.9,vi;q=0.5", LocaleVietnamese},
+		{"en;q=0.5,vi;q=0.7", LocaleVietnamese},
+		{"vi;q=0,en", LocaleEnglish},
+		{"fr,de", DefaultLocale},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, FromAcceptLanguage(tt.header), tt.header)
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, DefaultLocale, FromContext(context.Background()))
+	assert.Equal(t, LocaleVietnamese, FromContext(WithLocale(context.Background(), LocaleVietnamese)))
+}
@@ -0,0 +1,232 @@
+// Package pdf renders simple text reports, headings, paragraphs and tables,
+// as PDF documents on A4 pages. It only uses the standard Helvetica and
+// Courier fonts, which every PDF reader provides, so characters outside
+// Latin-1 are printed as '?'.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	pageWidth  = 595
+	pageHeight = 842
+	margin     = 50
+
+	headingSize = 16
+	textSize    = 10
+	tableSize   = 8
+
+	// Characters per line, with the average Helvetica glyph about half the
+	// font size wide and Courier glyphs exactly 0.6 of it
+	textColumns  = 2 * (pageWidth - 2*margin) / textSize
+	tableColumns = 5 * (pageWidth - 2*margin) / (3 * tableSize)
+)
+
+// Fonts, by their resource name in every page
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+	fontMono    = "F3"
+)
+
+type line struct {
+	font string
+	size int
+	text string
+	// gap is the space above the line
+	gap int
+}
+
+// Document is a report being laid out. Its methods append to it, starting
+// new pages as needed.
+type Document struct {
+	title string
+	pages [][]line
+	y     int
+}
+
+// New starts a document with the given title, which is also its first
+// heading
+func New(title string) *Document {
+	d := &Document{title: title}
+	d.Heading(title)
+	return d
+}
+
+// Heading adds a bold heading
+func (d *Document) Heading(text string) {
+	gap := headingSize
+	if len(d.pages) == 0 {
+		gap = 0
+	}
+	for _, l := range wrap(text, textColumns*textSize/headingSize) {
+		d.add(line{font: fontBold, size: headingSize, text: l, gap: gap})
+		gap = 0
+	}
+}
+
+// Text adds a paragraph, wrapped to the page width
+func (d *Document) Text(text string) {
+	gap := textSize / 2
+	for _, l := range wrap(text, textColumns) {
+		d.add(line{font: fontRegular, size: textSize, text: l, gap: gap})
+		gap = 0
+	}
+}
+
+// Table adds a table in a monospaced font, each column as wide as its
+// widest cell. Cells that would not fit the page are cut short.
+func (d *Document) Table(header []string, rows [][]string) {
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if i < len(widths) && len([]rune(cell)) > widths[i] {
+				widths[i] = len([]rune(cell))
+			}
+		}
+	}
+
+	format := func(row []string) string {
+		cells := make([]string, len(widths))
+		for i := range widths {
+			if i < len(row) {
+				cells[i] = row[i] + strings.Repeat(" ", widths[i]-len([]rune(row[i])))
+			} else {
+				cells[i] = strings.Repeat(" ", widths[i])
+			}
+		}
+		text := []rune(strings.TrimRight(strings.Join(cells, "  "), " "))
+		if len(text) > tableColumns {
+			text = append(text[:tableColumns-3], []rune("...")...)
+		}
+		return string(text)
+	}
+
+	d.add(line{font: fontBold, size: tableSize, text: format(header), gap: textSize})
+	for _, row := range rows {
+		d.add(line{font: fontMono, size: tableSize, text: format(row)})
+	}
+}
+
+func (d *Document) add(l line) {
+	height := l.gap + l.size*3/2
+	if len(d.pages) == 0 || d.y-height < margin {
+		d.pages = append(d.pages, nil)
+		d.y = pageHeight - margin
+		l.gap = 0
+		height = l.size * 3 / 2
+	}
+	d.y -= height
+	d.pages[len(d.pages)-1] = append(d.pages[len(d.pages)-1], l)
+}
+
+// Bytes renders the document
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 to 5 are fixed; pages follow, each page object with its
+	// content stream right after it
+	const firstPage = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+			"/Resources << /Font << /%s 3 0 R /%s 4 0 R /%s 5 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, fontRegular, fontBold, fontMono, firstPage+2*i+1))
+
+		var content strings.Builder
+		y := pageHeight - margin
+		for _, l := range lines {
+			y -= l.gap + l.size*3/2
+			fmt.Fprintf(&content, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", l.font, l.size, margin, y+l.size/2, escape(l.text))
+		}
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+	object(fmt.Sprintf("<< /Title (%s) /Producer (auto-devs) >>", escape(d.title)))
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, len(offsets), xref)
+	return buf.Bytes()
+}
+
+// WriteTo renders the document to w
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(d.Bytes())
+	return int64(n), err
+}
+
+// escape encodes text as the body of a PDF string in WinAnsiEncoding
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		case r == '\t':
+			b.WriteByte(' ')
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// wrap breaks text into lines of at most width characters, at spaces where
+// possible. Line breaks in the text are kept.
+func wrap(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		var current []rune
+		for _, word := range strings.Fields(paragraph) {
+			w := []rune(word)
+			for len(w) > width {
+				if len(current) > 0 {
+					lines = append(lines, string(current))
+					current = nil
+				}
+				lines = append(lines, string(w[:width]))
+				w = w[width:]
+			}
+			switch {
+			case len(current) == 0:
+				current = w
+			case len(current)+1+len(w) <= width:
+				current = append(append(current, ' '), w...)
+			default:
+				lines = append(lines, string(current))
+				current = w
+			}
+		}
+		lines = append(lines, string(current))
+	}
+	return lines
+}
@@ -0,0 +1,53 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	doc := New("Velocity (Q1)")
+	doc.Text("Generated for the sprint review")
+	rows := make([][]string, 120)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("2024-W%02d", i), strconv.Itoa(i)}
+	}
+	doc.Table([]string{"week", "tasks"}, rows)
+
+	out := doc.Bytes()
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "(Velocity \\(Q1\\)) Tj")
+	assert.Greater(t, len(doc.pages), 1, "a long table spans pages")
+	assert.Contains(t, string(out), fmt.Sprintf("/Count %d", len(doc.pages)))
+
+	// Every xref entry points at the object it numbers
+	xref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, xref)
+	start, err := strconv.Atoi(string(xref[1]))
+	require.NoError(t, err)
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[start:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	assert.Equal(t, `a\\b \(c\) caf\351 ?`, escape("a\\b (c) café ✓"))
+}
+
+func TestWrap(t *testing.T) {
+	assert.Equal(t, []string{"one two", "three", "abcdefg", "hi"}, wrap("one two three abcdefghi", 7))
+	assert.Equal(t, []string{"one", "", "two"}, wrap("one\n\ntwo", 10))
+	assert.Equal(t, []string{strings.Repeat("x", 5)}, wrap(strings.Repeat("x", 5), 5))
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTraceContextCarriedAcrossJobs(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	assert.Nil(t, Inject(context.Background()), "no trace, no trace context")
+	assert.Empty(t, TraceID(context.Background()))
+
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, request := Start(ExtractHTTP(context.Background(), header), "request")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", TraceID(ctx), "the caller's trace goes on")
+
+	carrier := Inject(ctx)
+	require.NotNil(t, carrier)
+	jobCtx, job := Start(Extract(context.Background(), carrier), "job")
+	End(job, errors.New("worktree busy"))
+	End(request, nil)
+
+	assert.Equal(t, TraceID(ctx), TraceID(jobCtx))
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, request.SpanContext().SpanID(), spans[0].Parent().SpanID(), "the job span is the request span's child")
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "worktree busy", spans[0].Status().Description)
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
@@ -15,6 +15,10 @@ NC='\033[0m' # No Color
 # Default values
 WORKER_NAME="worker-$(date +%s)"
 VERBOSE=false
+QUEUES=""
+CONCURRENCY=""
+MAX_EXECUTIONS=""
+SCHEDULER=true
 REDIS_HOST=${REDIS_HOST:-"localhost"}
 REDIS_PORT=${REDIS_PORT:-"6379"}
 
@@ -42,6 +46,10 @@ show_usage() {
     echo "Options:"
     echo "  -n, --name NAME     Worker name (default: auto-generated)"
     echo "  -v, --verbose       Enable verbose logging"
+    echo "  -q, --queues LIST   Queues to serve, e.g. implementation:4,default (default: all)"
+    echo "  -c, --concurrency N Number of jobs to run at once (default: 4)"
+    echo "  -m, --max-executions N  Maximum AI executions run at once, 0 for no limit"
+    echo "  --no-scheduler      Keep this worker out of the periodic job scheduler election"
     echo "  -h, --help          Show this help message"
     echo ""
     echo "Environment Variables:"
@@ -54,6 +62,7 @@ show_usage() {
     echo "  $0                           # Run with default settings"
     echo "  $0 -n planning-worker-1      # Run with custom name"
     echo "  $0 -v                        # Run with verbose logging"
+    echo "  $0 -q implementation -c 8 --no-scheduler  # Run an implementation-only worker"
     echo "  REDIS_HOST=redis.example.com $0  # Run with custom Redis host"
 }
 
@@ -68,6 +77,22 @@ while [[ $# -gt 0 ]]; do
             VERBOSE=true
             shift
             ;;
+        -q|--queues)
+            QUEUES="$2"
+            shift 2
+            ;;
+        -c|--concurrency)
+            CONCURRENCY="$2"
+            shift 2
+            ;;
+        -m|--max-executions)
+            MAX_EXECUTIONS="$2"
+            shift 2
+            ;;
+        --no-scheduler)
+            SCHEDULER=false
+            shift
+            ;;
         -h|--help)
             show_usage
             exit 0
@@ -131,6 +156,18 @@ main() {
     if [ "$VERBOSE" = true ]; then
         CMD="$CMD -verbose"
     fi
+    if [ -n "$QUEUES" ]; then
+        CMD="$CMD -queues=$QUEUES"
+    fi
+    if [ -n "$CONCURRENCY" ]; then
+        CMD="$CMD -concurrency=$CONCURRENCY"
+    fi
+    if [ -n "$MAX_EXECUTIONS" ]; then
+        CMD="$CMD -max-executions=$MAX_EXECUTIONS"
+    fi
+    if [ "$SCHEDULER" = false ]; then
+        CMD="$CMD -scheduler=false"
+    fi
 
     print_info "Starting worker with command: $CMD"
     print_info "Press Ctrl+C to stop the worker"